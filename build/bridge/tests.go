@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,6 +29,11 @@ const (
 	TestStress    = "stress"
 )
 
+// IntegrationTestsCoverageDir is the directory the coverage profiles of the integration tests are written to.
+const IntegrationTestsCoverageDir = repoPath + "/coverage/integration"
+
+const relayerPackagesPattern = "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/..."
+
 // RunAllIntegrationTests runs all the bridge integration tests.
 func RunAllIntegrationTests(ctx context.Context, deps types.DepsFunc) error {
 	entries, err := os.ReadDir(testsDir)
@@ -50,52 +56,97 @@ func RunAllIntegrationTests(ctx context.Context, deps types.DepsFunc) error {
 // RunIntegrationTests returns function running integration tests.
 func RunIntegrationTests(name string) types.CommandFunc {
 	return func(ctx context.Context, deps types.DepsFunc) error {
-		deps(BuildRelayerDockerImage, BuildSmartContract, tools.EnsureBridgeXRPLWASM,
-			coreum.BuildCoredLocally, coreum.BuildCoredDockerImage)
-
-		znetConfig := &infra.ConfigFactory{
-			Profiles:      []string{apps.ProfileXRPLBridge},
-			EnvName:       "znet",
-			TimeoutCommit: 500 * time.Millisecond,
-			HomeDir:       filepath.Join(lo.Must(os.UserHomeDir()), ".crust", "znet"),
-			RootDir:       ".",
-		}
+		return runIntegrationTests(ctx, deps, name)
+	}
+}
 
-		if err := znet.Remove(ctx, znetConfig); err != nil {
-			return err
-		}
-		if err := znet.Start(ctx, znetConfig); err != nil {
-			return err
-		}
-		if err := golang.RunTests(ctx, deps, golang.TestConfig{
-			PackagePath: filepath.Join(testsDir, name),
-			Flags: []string{
-				"-timeout=30m",
-				"-tags=integrationtests",
-				fmt.Sprintf("-parallel=%d", 2*runtime.NumCPU()),
-			},
-		}); err != nil {
+// RunIntegrationTestsWithCoverage runs the integration tests, except the stress ones, and writes the coverage profiles
+// of the relayer packages to the IntegrationTestsCoverageDir, one profile per tests package.
+func RunIntegrationTestsWithCoverage(ctx context.Context, deps types.DepsFunc) error {
+	coverageDir, err := filepath.Abs(IntegrationTestsCoverageDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(coverageDir, 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, name := range []string{TestContract, TestProcesses, TestXRPL} {
+		if err := runIntegrationTests(
+			ctx,
+			deps,
+			name,
+			"-coverpkg="+relayerPackagesPattern,
+			"-coverprofile="+filepath.Join(coverageDir, name+".out"),
+		); err != nil {
 			return err
 		}
-		return znet.Remove(ctx, znetConfig)
 	}
+	return nil
+}
+
+func runIntegrationTests(ctx context.Context, deps types.DepsFunc, name string, extraFlags ...string) error {
+	deps(BuildRelayerDockerImage, BuildSmartContract, tools.EnsureBridgeXRPLWASM,
+		coreum.BuildCoredLocally, coreum.BuildCoredDockerImage)
+
+	znetConfig := &infra.ConfigFactory{
+		Profiles:      []string{apps.ProfileXRPLBridge},
+		EnvName:       "znet",
+		TimeoutCommit: 500 * time.Millisecond,
+		HomeDir:       filepath.Join(lo.Must(os.UserHomeDir()), ".crust", "znet"),
+		RootDir:       ".",
+	}
+
+	if err := znet.Remove(ctx, znetConfig); err != nil {
+		return err
+	}
+	if err := znet.Start(ctx, znetConfig); err != nil {
+		return err
+	}
+	if err := golang.RunTests(ctx, deps, golang.TestConfig{
+		PackagePath: filepath.Join(testsDir, name),
+		Flags: append([]string{
+			"-timeout=30m",
+			"-tags=integrationtests",
+			fmt.Sprintf("-parallel=%d", 2*runtime.NumCPU()),
+			fmt.Sprintf("-contract-versions=%s", strings.Join(tools.ContractWASMVersions(), ",")),
+		}, extraFlags...),
+	}); err != nil {
+		return err
+	}
+	return znet.Remove(ctx, znetConfig)
 }
 
 // RunFuzzTests runs fuzz tests.
 func RunFuzzTests(ctx context.Context, deps types.DepsFunc) error {
-	if err := runFuzzTest(ctx, deps, "FuzzAmountConversionCoreumToXRPLAndBack"); err != nil {
+	if err := runFuzzTest(ctx, deps, "relayer/processes", "FuzzAmountConversionCoreumToXRPLAndBack", "20s"); err != nil {
+		return err
+	}
+	if err := runFuzzTest(
+		ctx, deps, "relayer/processes", "FuzzAmountConversionCoreumToXRPLAndBack_ExceedingSignificantNumber", "20s",
+	); err != nil {
+		return err
+	}
+	if err := runFuzzTest(
+		ctx, deps, "relayer/processes", "FuzzConvertXRPLPaymentToTransferEvidence", "30s",
+	); err != nil {
+		return err
+	}
+	if err := runFuzzTest(ctx, deps, "relayer/xrpl", "FuzzDecodeCoreumRecipientFromMemo", "20s"); err != nil {
+		return err
+	}
+	if err := runFuzzTest(ctx, deps, "relayer/xrpl", "FuzzConvertCurrencyToString", "20s"); err != nil {
 		return err
 	}
-	return runFuzzTest(ctx, deps, "FuzzAmountConversionCoreumToXRPLAndBack_ExceedingSignificantNumber")
+	return runFuzzTest(ctx, deps, "relayer/xrpl", "FuzzDecodePayment", "30s")
 }
 
-func runFuzzTest(ctx context.Context, deps types.DepsFunc, name string) error {
+func runFuzzTest(ctx context.Context, deps types.DepsFunc, packagePath, name, fuzzTime string) error {
 	return golang.RunTests(ctx, deps, golang.TestConfig{
-		PackagePath: "relayer/processes",
+		PackagePath: packagePath,
 		Flags: []string{
 			"-run", "^$",
 			"-fuzz", fmt.Sprintf("^%s$", name),
-			"-fuzztime", "20s",
+			"-fuzztime", fuzzTime,
 		},
 	})
 }
@@ -0,0 +1,364 @@
+// Package main contains the generator of the integration tests coverage report.
+//
+// The generator merges the coverage profiles produced by the integration tests, splits the merged profile by package,
+// renders an HTML report per package using `go tool cover` and prints the coverage summary together with the list of
+// the uncovered functions of the selected file.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultProfilesDir = "coverage/integration"
+	defaultModulePath  = "github.com/CoreumFoundation/coreumbridge-xrpl"
+	defaultTargetFile  = "relayer/coreum/contract.go"
+	profileExt         = ".out"
+	modePrefix         = "mode: "
+	// outputDirName is the name of the directory, inside the profiles directory, the report is written to.
+	outputDirName = "report"
+)
+
+type config struct {
+	ProfilesDir string
+	OutputDir   string
+	ModulePath  string
+	TargetFile  string
+}
+
+// block is the key of the coverage profile line, the part of the line before the statements number and count.
+type block struct {
+	File  string
+	Range string
+}
+
+type blockStats struct {
+	Statements int
+	Count      int
+}
+
+type profile struct {
+	Mode   string
+	Blocks map[block]blockStats
+}
+
+func main() {
+	cfg := config{}
+	flag.StringVar(&cfg.ProfilesDir, "profiles-dir", defaultProfilesDir, "Directory with the coverage profiles (*.out)")
+	flag.StringVar(&cfg.OutputDir, "output-dir", "", "Report output directory, <profiles-dir>/report by default")
+	flag.StringVar(&cfg.ModulePath, "module", defaultModulePath, "Go module path prefix of the covered files")
+	flag.StringVar(
+		&cfg.TargetFile, "file", defaultTargetFile, "File, relative to the module root, to list the uncovered functions of",
+	)
+	flag.Parse()
+
+	if cfg.OutputDir == "" {
+		cfg.OutputDir = filepath.Join(cfg.ProfilesDir, outputDirName)
+	}
+
+	if err := run(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config) error {
+	profilePaths, err := filepath.Glob(filepath.Join(cfg.ProfilesDir, "*"+profileExt))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(profilePaths) == 0 {
+		return errors.Errorf("no coverage profiles found in %s", cfg.ProfilesDir)
+	}
+
+	merged, err := mergeProfiles(profilePaths)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cfg.OutputDir, 0o700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	packages := splitByPackage(merged)
+	pkgNames := make([]string, 0, len(packages))
+	for pkg := range packages {
+		pkgNames = append(pkgNames, pkg)
+	}
+	sort.Strings(pkgNames)
+
+	fmt.Printf("Coverage report (profiles: %s, output: %s)\n\n", cfg.ProfilesDir, cfg.OutputDir)
+	summary := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(summary, "PACKAGE\tSTATEMENTS\tCOVERED\tCOVERAGE\tHTML")
+
+	targetFile := path.Join(cfg.ModulePath, filepath.ToSlash(cfg.TargetFile))
+	var targetProfilePath string
+	for _, pkg := range pkgNames {
+		pkgProfile := packages[pkg]
+		name := strings.ReplaceAll(strings.TrimPrefix(strings.TrimPrefix(pkg, cfg.ModulePath), "/"), "/", "_")
+		if name == "" {
+			name = "root"
+		}
+		profilePath := filepath.Join(cfg.OutputDir, name+profileExt)
+		if err := writeProfile(profilePath, pkgProfile); err != nil {
+			return err
+		}
+		htmlPath := filepath.Join(cfg.OutputDir, name+".html")
+		if _, err := goToolCover("-html="+profilePath, "-o="+htmlPath); err != nil {
+			return err
+		}
+		if pkg == path.Dir(targetFile) {
+			targetProfilePath = profilePath
+		}
+
+		statements, covered := pkgProfile.coverage()
+		fmt.Fprintf(summary, "%s\t%d\t%d\t%s\t%s\n", pkg, statements, covered, percent(covered, statements), htmlPath)
+	}
+	statements, covered := merged.coverage()
+	fmt.Fprintf(summary, "TOTAL\t%d\t%d\t%s\t\n", statements, covered, percent(covered, statements))
+	if err := summary.Flush(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if targetProfilePath == "" {
+		return errors.Errorf("no coverage data found for %s", targetFile)
+	}
+
+	return printUncoveredFunctions(targetProfilePath, targetFile)
+}
+
+func mergeProfiles(profilePaths []string) (profile, error) {
+	merged := profile{
+		Blocks: make(map[block]blockStats),
+	}
+	for _, profilePath := range profilePaths {
+		p, err := readProfile(profilePath)
+		if err != nil {
+			return profile{}, err
+		}
+		if merged.Mode == "" {
+			merged.Mode = p.Mode
+		}
+		if merged.Mode != p.Mode {
+			return profile{}, errors.Errorf(
+				"coverage mode mismatch, profile %s has mode %s, expected %s", profilePath, p.Mode, merged.Mode,
+			)
+		}
+		for b, stats := range p.Blocks {
+			mergedStats, ok := merged.Blocks[b]
+			if !ok {
+				merged.Blocks[b] = stats
+				continue
+			}
+			// in the "set" mode the count is 0 or 1, so it must not be summed up
+			if merged.Mode == "set" {
+				mergedStats.Count = max(mergedStats.Count, stats.Count)
+			} else {
+				mergedStats.Count += stats.Count
+			}
+			merged.Blocks[b] = mergedStats
+		}
+	}
+
+	return merged, nil
+}
+
+func readProfile(profilePath string) (profile, error) {
+	f, err := os.Open(profilePath)
+	if err != nil {
+		return profile{}, errors.Wrapf(err, "failed to open coverage profile %s", profilePath)
+	}
+	defer f.Close()
+
+	p := profile{
+		Blocks: make(map[block]blockStats),
+	}
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if lineNumber == 1 {
+			if !strings.HasPrefix(line, modePrefix) {
+				return profile{}, errors.Errorf("invalid coverage profile %s, missing mode line", profilePath)
+			}
+			p.Mode = strings.TrimPrefix(line, modePrefix)
+			continue
+		}
+		b, stats, err := parseProfileLine(line)
+		if err != nil {
+			return profile{}, errors.Wrapf(err, "invalid line %d of coverage profile %s", lineNumber, profilePath)
+		}
+		// the same block might be reported several times by one profile if the tests package is built for
+		// several covered packages
+		existingStats, ok := p.Blocks[b]
+		if ok {
+			if p.Mode == "set" {
+				stats.Count = max(stats.Count, existingStats.Count)
+			} else {
+				stats.Count += existingStats.Count
+			}
+		}
+		p.Blocks[b] = stats
+	}
+	if err := scanner.Err(); err != nil {
+		return profile{}, errors.Wrapf(err, "failed to read coverage profile %s", profilePath)
+	}
+
+	return p, nil
+}
+
+// parseProfileLine parses the profile line in the format "file:startLine.startCol,endLine.endCol statements count".
+func parseProfileLine(line string) (block, blockStats, error) {
+	fileAndRange, numbers, ok := strings.Cut(line, " ")
+	if !ok {
+		return block{}, blockStats{}, errors.Errorf("unexpected line format: %q", line)
+	}
+	colonIndex := strings.LastIndex(fileAndRange, ":")
+	if colonIndex < 0 {
+		return block{}, blockStats{}, errors.Errorf("unexpected line format: %q", line)
+	}
+	statementsStr, countStr, ok := strings.Cut(numbers, " ")
+	if !ok {
+		return block{}, blockStats{}, errors.Errorf("unexpected line format: %q", line)
+	}
+	statements, err := strconv.Atoi(statementsStr)
+	if err != nil {
+		return block{}, blockStats{}, errors.Wrapf(err, "invalid statements number in line: %q", line)
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		return block{}, blockStats{}, errors.Wrapf(err, "invalid count in line: %q", line)
+	}
+
+	return block{
+		File:  fileAndRange[:colonIndex],
+		Range: fileAndRange[colonIndex+1:],
+	}, blockStats{
+		Statements: statements,
+		Count:      count,
+	}, nil
+}
+
+func splitByPackage(p profile) map[string]profile {
+	packages := make(map[string]profile)
+	for b, stats := range p.Blocks {
+		pkg := path.Dir(b.File)
+		pkgProfile, ok := packages[pkg]
+		if !ok {
+			pkgProfile = profile{
+				Mode:   p.Mode,
+				Blocks: make(map[block]blockStats),
+			}
+			packages[pkg] = pkgProfile
+		}
+		pkgProfile.Blocks[b] = stats
+	}
+
+	return packages
+}
+
+func writeProfile(profilePath string, p profile) error {
+	blocks := make([]block, 0, len(p.Blocks))
+	for b := range p.Blocks {
+		blocks = append(blocks, b)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].File != blocks[j].File {
+			return blocks[i].File < blocks[j].File
+		}
+		return blocks[i].Range < blocks[j].Range
+	})
+
+	var sb strings.Builder
+	sb.WriteString(modePrefix + p.Mode + "\n")
+	for _, b := range blocks {
+		stats := p.Blocks[b]
+		fmt.Fprintf(&sb, "%s:%s %d %d\n", b.File, b.Range, stats.Statements, stats.Count)
+	}
+
+	return errors.Wrapf(os.WriteFile(profilePath, []byte(sb.String()), 0o600), "failed to write %s", profilePath)
+}
+
+func (p profile) coverage() (int, int) {
+	var statements, covered int
+	for _, stats := range p.Blocks {
+		statements += stats.Statements
+		if stats.Count > 0 {
+			covered += stats.Statements
+		}
+	}
+
+	return statements, covered
+}
+
+// printUncoveredFunctions prints the functions of the target file with zero coverage reported by `go tool cover -func`.
+func printUncoveredFunctions(profilePath, targetFile string) error {
+	out, err := goToolCover("-func=" + profilePath)
+	if err != nil {
+		return err
+	}
+
+	var total int
+	uncovered := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(uncovered, "LINE\tFUNCTION")
+	uncoveredCount := 0
+	for _, line := range strings.Split(out, "\n") {
+		// line format: "<file>:<line>:<tab><func><tabs><percent>%"
+		fields := strings.Fields(line)
+		if len(fields) != 3 || !strings.HasPrefix(fields[0], targetFile+":") {
+			continue
+		}
+		total++
+		if fields[2] != "0.0%" {
+			continue
+		}
+		uncoveredCount++
+		fileLine := strings.TrimSuffix(strings.TrimPrefix(fields[0], targetFile+":"), ":")
+		fmt.Fprintf(uncovered, "%s\t%s\n", fileLine, fields[1])
+	}
+	if total == 0 {
+		return errors.Errorf("no functions of %s found in the coverage profile %s", targetFile, profilePath)
+	}
+
+	fmt.Printf("\nUncovered functions of %s: %d of %d\n\n", targetFile, uncoveredCount, total)
+	if uncoveredCount == 0 {
+		return nil
+	}
+
+	return errors.WithStack(uncovered.Flush())
+}
+
+func goToolCover(args ...string) (string, error) {
+	cmd := exec.Command("go", append([]string{"tool", "cover"}, args...)...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrapf(err, "go tool cover %s failed", strings.Join(args, " "))
+	}
+
+	return string(out), nil
+}
+
+func percent(covered, statements int) string {
+	if statements == 0 {
+		return "0.0%"
+	}
+
+	return fmt.Sprintf("%.1f%%", 100*float64(covered)/float64(statements))
+}
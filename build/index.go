@@ -34,6 +34,8 @@ var Commands = map[string]types.Command{
 		Description: "Runs smart contract integration tests"},
 	"integration-tests/stress": {Fn: bridge.RunIntegrationTests(bridge.TestStress),
 		Description: "Runs stress integration tests"},
+	"integration-tests/coverage": {Fn: bridge.RunIntegrationTestsWithCoverage,
+		Description: "Runs integration tests with the relayer coverage profiles"},
 	"lint":           {Fn: bridge.Lint, Description: "lints code"},
 	"release":        {Fn: bridge.ReleaseRelayer, Description: "Releases relayer binary"},
 	"release/images": {Fn: bridge.ReleaseRelayerImage, Description: "Releases relayer docker image"},
@@ -2,34 +2,69 @@ package tools
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/samber/lo"
 
 	"github.com/CoreumFoundation/crust/build/tools"
 	"github.com/CoreumFoundation/crust/build/types"
 )
 
-// CoreumBridgeXRPLWASMV110 is the previous version of bridge smart contract.
-const CoreumBridgeXRPLWASMV110 tools.Name = "coreumbridge-xrpl-wasm-v1.1.0"
+// ContractWASMRelease is the released version of the bridge smart contract.
+type ContractWASMRelease struct {
+	Version string
+	Hash    string
+}
+
+// ContractWASMReleases are the released versions of the bridge smart contract the compatibility of the relayer is
+// tested with.
+var ContractWASMReleases = []ContractWASMRelease{
+	{
+		Version: "v1.1.0",
+		Hash:    "sha256:9e458f31599f20a8c608056ca89ed82cc00f97c8d2ff415dd83fb95389e3e32f",
+	},
+}
 
 // Tools is a list of tools required by the bridge builder.
-var Tools = []tools.Tool{
+var Tools = lo.Map(ContractWASMReleases, func(release ContractWASMRelease, _ int) tools.Tool {
 	// https://github.com/CoreumFoundation/coreumbridge-xrpl/releases
-	tools.BinaryTool{
-		Name:    CoreumBridgeXRPLWASMV110,
-		Version: "v1.1.0",
+	return tools.BinaryTool{
+		Name:    ContractWASMToolName(release.Version),
+		Version: release.Version,
 		Local:   true,
 		Sources: tools.Sources{
 			tools.TargetPlatformLocal: {
-				URL:  "https://github.com/CoreumFoundation/coreumbridge-xrpl/releases/download/v1.1.0/coreumbridge_xrpl.wasm",
-				Hash: "sha256:9e458f31599f20a8c608056ca89ed82cc00f97c8d2ff415dd83fb95389e3e32f",
+				URL: fmt.Sprintf(
+					"https://github.com/CoreumFoundation/coreumbridge-xrpl/releases/download/%s/coreumbridge_xrpl.wasm",
+					release.Version,
+				),
+				Hash: release.Hash,
 				Binaries: map[string]string{
-					"bin/coreumbridge-xrpl-v1.1.0.wasm": "coreumbridge_xrpl.wasm",
+					fmt.Sprintf("bin/coreumbridge-xrpl-%s.wasm", release.Version): "coreumbridge_xrpl.wasm",
 				},
 			},
 		},
-	},
+	}
+})
+
+// ContractWASMToolName returns the tool name of the released bridge smart contract version.
+func ContractWASMToolName(version string) tools.Name {
+	return tools.Name(fmt.Sprintf("coreumbridge-xrpl-wasm-%s", version))
+}
+
+// ContractWASMVersions returns the versions of the released bridge smart contracts.
+func ContractWASMVersions() []string {
+	return lo.Map(ContractWASMReleases, func(release ContractWASMRelease, _ int) string {
+		return release.Version
+	})
 }
 
-// EnsureBridgeXRPLWASM ensures bridge smart contract is available.
+// EnsureBridgeXRPLWASM ensures all released bridge smart contracts are available.
 func EnsureBridgeXRPLWASM(ctx context.Context, _ types.DepsFunc) error {
-	return tools.Ensure(ctx, CoreumBridgeXRPLWASMV110, tools.TargetPlatformLocal)
+	for _, release := range ContractWASMReleases {
+		if err := tools.Ensure(ctx, ContractWASMToolName(release.Version), tools.TargetPlatformLocal); err != nil {
+			return err
+		}
+	}
+	return nil
 }
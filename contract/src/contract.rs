@@ -10,26 +10,34 @@ use crate::{
     fees::{amount_after_bridge_fees, handle_fee_collection, substract_relayer_fees},
     msg::{
         AvailableTicketsResponse, BridgeStateResponse, CoreumTokensResponse, ExecuteMsg,
-        FeesCollectedResponse, InstantiateMsg, PendingOperationsResponse, PendingRefund,
-        PendingRefundsResponse, ProcessedTxsResponse, ProhibitedXRPLAddressesResponse, QueryMsg,
+        FeesCollectedResponse, InstantiateMsg, PendingIBCTransfersResponse,
+        PendingOperationsResponse, PendingRefund, PendingRefundsResponse, ProcessedTxsResponse,
+        ProhibitedXRPLAddressesResponse, QueryMsg, RelayerEvidenceRateLimitResponse,
         TransactionEvidence, TransactionEvidencesResponse, XRPLTokensResponse,
     },
     operation::{
-        check_operation_exists, create_pending_operation, handle_operation, remove_pending_refund,
-        Operation, OperationType,
+        bump_pending_operations_version, check_operation_exists, create_pending_operation,
+        handle_operation, remove_pending_refund, store_pending_refund, Operation, OperationType,
+    },
+    relayer::{
+        is_relayer, query_relayer_evidence_counter, register_relayer_evidence, validate_relayers,
+        Relayer,
     },
-    relayer::{is_relayer, validate_relayers, Relayer},
     signatures::add_signature,
     state::{
-        BridgeState, Config, ContractActions, CoreumToken, TokenState, UserType, XRPLToken,
-        AVAILABLE_TICKETS, CONFIG, COREUM_TOKENS, FEES_COLLECTED, PENDING_OPERATIONS,
+        BridgeState, Config, ContractActions, CoreumToken, PendingIBCTransfer, TokenState,
+        UserType, XRPLToken, XRPLTokenDeliveryMode, AVAILABLE_TICKETS, CONFIG, COREUM_TOKENS,
+        FEES_COLLECTED, MIGRATED_XRPL_TOKENS, PENDING_IBC_TRANSFERS, PENDING_OPERATIONS,
         PENDING_REFUNDS, PENDING_ROTATE_KEYS, PENDING_TICKET_UPDATE, PROCESSED_TXS,
-        PROHIBITED_XRPL_ADDRESSES, TX_EVIDENCES, USED_TICKETS_COUNTER, XRPL_TOKENS,
+        PROHIBITED_XRPL_ADDRESSES, TREASURY_FEES_COLLECTED, TX_EVIDENCES, USED_TICKETS_COUNTER,
+        XRPL_TOKENS,
     },
     tickets::{allocate_ticket, register_used_ticket},
     token::{
-        build_xrpl_token_key, is_token_xrp, set_token_bridging_fee, set_token_max_holding_amount,
-        set_token_sending_precision, set_token_state,
+        build_delivery_msgs, build_token_state_changed_event, build_xrpl_token_key, is_token_xrp,
+        register_daily_send, set_token_bridging_fee, set_token_max_holding_amount,
+        set_token_max_sends_per_address_per_day, set_token_sending_precision, set_token_state,
+        set_token_treasury_fee, validate_delivery_mode,
     },
 };
 
@@ -138,6 +146,24 @@ pub fn instantiate(
     // We validate the trust set amount is a valid XRPL amount
     validate_xrpl_amount(msg.trust_set_limit_amount)?;
 
+    // The fee can't be capped below the fee of the transactions signed by the threshold number of relayers since they
+    // wouldn't be accepted by XRPL
+    validate_max_xrpl_tx_fee(
+        msg.max_xrpl_tx_fee,
+        msg.xrpl_base_fee,
+        msg.evidence_threshold,
+    )?;
+
+    // A limit of 0 evidences would block the relayers from saving any evidence
+    if msg.max_evidences_per_relayer_per_block == Some(0) {
+        return Err(ContractError::InvalidMaxEvidencesPerRelayerPerBlock {});
+    }
+
+    let treasury_address = msg
+        .treasury_address
+        .map(|address| deps.api.addr_validate(address.as_str()))
+        .transpose()?;
+
     // We initialize these values here so that we can immediately start working with them
     USED_TICKETS_COUNTER.save(deps.storage, &0)?;
     PENDING_TICKET_UPDATE.save(deps.storage, &false)?;
@@ -152,6 +178,10 @@ pub fn instantiate(
         bridge_xrpl_address: msg.bridge_xrpl_address,
         bridge_state: BridgeState::Active,
         xrpl_base_fee: msg.xrpl_base_fee,
+        max_xrpl_tx_fee: msg.max_xrpl_tx_fee,
+        source_tag: msg.source_tag,
+        max_evidences_per_relayer_per_block: msg.max_evidences_per_relayer_per_block,
+        treasury_address,
     };
 
     CONFIG.save(deps.storage, &config)?;
@@ -182,6 +212,9 @@ pub fn instantiate(
         // The XRP token is enabled from the start because it doesn't need approval to be received on the XRPL side
         state: TokenState::Enabled,
         bridging_fee: XRP_DEFAULT_FEE,
+        max_sends_per_address_per_day: None,
+        treasury_fee: None,
+        delivery_mode: None,
     };
 
     let key = build_xrpl_token_key(XRP_ISSUER, XRP_CURRENCY);
@@ -213,6 +246,7 @@ pub fn execute(
             sending_precision,
             max_holding_amount,
             bridging_fee,
+            max_sends_per_address_per_day,
         } => register_coreum_token(
             deps.into_empty(),
             env,
@@ -222,6 +256,7 @@ pub fn execute(
             sending_precision,
             max_holding_amount,
             bridging_fee,
+            max_sends_per_address_per_day,
         ),
         ExecuteMsg::RegisterXRPLToken {
             issuer,
@@ -229,6 +264,8 @@ pub fn execute(
             sending_precision,
             max_holding_amount,
             bridging_fee,
+            max_sends_per_address_per_day,
+            delivery_mode,
         } => register_xrpl_token(
             deps,
             env,
@@ -238,6 +275,8 @@ pub fn execute(
             sending_precision,
             max_holding_amount,
             bridging_fee,
+            max_sends_per_address_per_day,
+            delivery_mode,
         ),
         ExecuteMsg::SaveEvidence { evidence } => {
             save_evidence(deps.into_empty(), env, info.sender, evidence)
@@ -283,8 +322,11 @@ pub fn execute(
             sending_precision,
             bridging_fee,
             max_holding_amount,
+            max_sends_per_address_per_day,
+            treasury_fee,
         } => update_xrpl_token(
             deps.into_empty(),
+            env,
             info.sender,
             issuer,
             currency,
@@ -292,13 +334,34 @@ pub fn execute(
             sending_precision,
             bridging_fee,
             max_holding_amount,
+            max_sends_per_address_per_day,
+            treasury_fee,
+        ),
+        ExecuteMsg::MigrateXRPLToken {
+            old_issuer,
+            old_currency,
+            new_issuer,
+            new_currency,
+        } => migrate_xrpl_token(
+            deps.into_empty(),
+            env,
+            info.sender,
+            old_issuer,
+            old_currency,
+            new_issuer,
+            new_currency,
         ),
+        ExecuteMsg::RemoveMigratedXRPLToken { issuer, currency } => {
+            remove_migrated_xrpl_token(deps.into_empty(), info.sender, issuer, currency)
+        }
         ExecuteMsg::UpdateCoreumToken {
             denom,
             state,
             sending_precision,
             bridging_fee,
             max_holding_amount,
+            max_sends_per_address_per_day,
+            treasury_fee,
         } => update_coreum_token(
             deps.into_empty(),
             env,
@@ -308,6 +371,8 @@ pub fn execute(
             sending_precision,
             bridging_fee,
             max_holding_amount,
+            max_sends_per_address_per_day,
+            treasury_fee,
         ),
         ExecuteMsg::UpdateXRPLBaseFee { xrpl_base_fee } => {
             update_xrpl_base_fee(deps.into_empty(), info.sender, xrpl_base_fee)
@@ -318,6 +383,7 @@ pub fn execute(
         ExecuteMsg::ClaimRelayerFees { amounts } => {
             claim_relayer_fees(deps.into_empty(), info.sender, amounts)
         }
+        ExecuteMsg::ClaimTreasuryFees {} => claim_treasury_fees(deps.into_empty(), info.sender),
         ExecuteMsg::HaltBridge {} => halt_bridge(deps.into_empty(), info.sender),
         ExecuteMsg::ResumeBridge {} => resume_bridge(deps.into_empty(), info.sender),
         ExecuteMsg::RotateKeys {
@@ -365,6 +431,7 @@ fn register_coreum_token(
     sending_precision: i32,
     max_holding_amount: Uint128,
     bridging_fee: Uint128,
+    max_sends_per_address_per_day: Option<u32>,
 ) -> CoreumResult<ContractError> {
     check_authorization(deps.storage, &sender, &ContractActions::RegisterCoreumToken)?;
     assert_bridge_active(deps.as_ref())?;
@@ -403,7 +470,7 @@ fn register_coreum_token(
         return Err(ContractError::RegistrationFailure {});
     }
 
-    let token = CoreumToken {
+    let mut token = CoreumToken {
         denom: denom.clone(),
         decimals,
         xrpl_currency: xrpl_currency.clone(),
@@ -412,7 +479,13 @@ fn register_coreum_token(
         // All registered Coreum originated tokens will start as enabled because they don't need a TrustSet operation to be bridged because issuer for such tokens is bridge address
         state: TokenState::Enabled,
         bridging_fee,
+        max_sends_per_address_per_day: None,
+        treasury_fee: None,
     };
+    set_token_max_sends_per_address_per_day(
+        &mut token.max_sends_per_address_per_day,
+        max_sends_per_address_per_day,
+    );
     COREUM_TOKENS.save(deps.storage, denom.clone(), &token)?;
 
     Ok(Response::new()
@@ -433,6 +506,8 @@ fn register_xrpl_token(
     sending_precision: i32,
     max_holding_amount: Uint128,
     bridging_fee: Uint128,
+    max_sends_per_address_per_day: Option<u32>,
+    delivery_mode: Option<XRPLTokenDeliveryMode>,
 ) -> CoreumResult<ContractError> {
     check_authorization(
         deps.as_ref().storage,
@@ -442,6 +517,9 @@ fn register_xrpl_token(
 
     validate_xrpl_address(deps.storage, issuer.clone())?;
     validate_xrpl_currency(&currency)?;
+    if let Some(delivery_mode) = &delivery_mode {
+        validate_delivery_mode(delivery_mode)?;
+    }
 
     validate_sending_precision(sending_precision, XRPL_TOKENS_DECIMALS)?;
 
@@ -449,7 +527,10 @@ fn register_xrpl_token(
     check_issue_fee(&deps, &info)?;
     let key = build_xrpl_token_key(&issuer, &currency);
 
-    if XRPL_TOKENS.has(deps.storage, key.clone()) {
+    // The old issuer and currency of a migrated token are reserved until the old token is removed
+    if XRPL_TOKENS.has(deps.storage, key.clone())
+        || MIGRATED_XRPL_TOKENS.has(deps.storage, key.clone())
+    {
         return Err(ContractError::XRPLTokenAlreadyRegistered { issuer, currency });
     }
 
@@ -487,7 +568,7 @@ fn register_xrpl_token(
         return Err(ContractError::RegistrationFailure {});
     };
 
-    let token = XRPLToken {
+    let mut token = XRPLToken {
         issuer: issuer.clone(),
         currency: currency.clone(),
         coreum_denom: denom.clone(),
@@ -496,7 +577,14 @@ fn register_xrpl_token(
         // Registered tokens will start in processing until TrustSet operation is accepted/rejected
         state: TokenState::Processing,
         bridging_fee,
+        max_sends_per_address_per_day: None,
+        treasury_fee: None,
+        delivery_mode,
     };
+    set_token_max_sends_per_address_per_day(
+        &mut token.max_sends_per_address_per_day,
+        max_sends_per_address_per_day,
+    );
 
     XRPL_TOKENS.save(deps.storage, key, &token)?;
 
@@ -506,7 +594,7 @@ fn register_xrpl_token(
 
     // We create the TrustSet operation. If this operation is accepted, the token will be enabled, if not, it will be in Inactive state
     // waiting for owner to recover this operation
-    create_pending_operation(
+    let operation_created_event = create_pending_operation(
         deps.storage,
         env.block.time.seconds(),
         Some(ticket),
@@ -519,6 +607,7 @@ fn register_xrpl_token(
     )?;
 
     Ok(Response::new()
+        .add_event(operation_created_event)
         .add_message(issue_msg)
         .add_attribute("action", ContractActions::RegisterXRPLToken.as_str())
         .add_attribute("sender", info.sender)
@@ -545,6 +634,13 @@ fn save_evidence(
 
     evidence.validate_basic()?;
 
+    register_relayer_evidence(
+        deps.storage,
+        env.block.height,
+        &sender,
+        config.max_evidences_per_relayer_per_block,
+    )?;
+
     let threshold_reached = handle_evidence(deps.storage, sender.clone(), &evidence)?;
 
     let mut response = Response::new()
@@ -558,6 +654,7 @@ fn save_evidence(
             currency,
             amount,
             recipient,
+            destination_chain_recipient,
         } => {
             if config.bridge_state == BridgeState::Halted {
                 return Err(ContractError::BridgeHalted {});
@@ -571,49 +668,19 @@ fn save_evidence(
 
             // This means the token is not a Coreum originated token (the issuer is not the XRPL multisig address)
             if issuer.ne(&config.bridge_xrpl_address) {
-                // Create issuer+currency key to find denom on coreum.
-                let key = build_xrpl_token_key(&issuer, &currency);
+                let token = load_enabled_xrpl_token(deps.storage, &issuer, &currency)?;
 
-                // To transfer a token it must be registered and activated
-                let token = XRPL_TOKENS
-                    .load(deps.storage, key)
-                    .map_err(|_| ContractError::TokenNotRegistered {})?;
-
-                if token.state.ne(&TokenState::Enabled) {
-                    return Err(ContractError::TokenNotEnabled {});
-                }
-
-                let decimals = if is_token_xrp(&token.issuer, &token.currency) {
-                    XRP_DECIMALS
-                } else {
-                    XRPL_TOKENS_DECIMALS
-                };
-
-                // We calculate the amount to send after applying the bridging fees for that token
-                let amount_after_bridge_fees =
-                    amount_after_bridge_fees(amount, token.bridging_fee)?;
-
-                // Here we simply truncate because the Coreum tokens corresponding to XRPL originated tokens have the same decimals as their corresponding Coreum tokens
-                let (amount_to_send, remainder) =
-                    truncate_amount(token.sending_precision, decimals, amount_after_bridge_fees)?;
+                let (amount_to_send, remainder) = xrpl_originated_amount_to_send(&token, amount)?;
 
                 // The amount the bridge can mint cannot exceed the max_holding_amount
-                if amount
-                    .checked_add(
-                        deps.querier
-                            .query_supply(token.coreum_denom.clone())?
-                            .amount,
-                    )?
-                    .gt(&token.max_holding_amount)
-                {
-                    return Err(ContractError::MaximumBridgedAmountReached {});
-                }
+                validate_xrpl_token_max_holding_amount(deps.as_ref(), &token, amount)?;
 
                 // If enough evidences are provided (threshold reached), we collect fees and mint the token for the recipient
                 if threshold_reached {
                     let fee_collected = handle_fee_collection(
                         deps.storage,
                         token.bridging_fee,
+                        token.treasury_fee,
                         token.coreum_denom.clone(),
                         remainder,
                     )?;
@@ -623,13 +690,20 @@ fn save_evidence(
                         recipient: None,
                     }));
 
-                    let mint_msg_for_recipient =
-                        CosmosMsg::from(CoreumMsg::AssetFT(assetft::Msg::Mint {
-                            coin: coin(amount_to_send.u128(), token.coreum_denom),
-                            recipient: Some(recipient.to_string()),
-                        }));
+                    let delivery_msgs = build_delivery_msgs(
+                        deps.storage,
+                        &token.delivery_mode,
+                        &tx_hash,
+                        token.coreum_denom,
+                        amount_to_send,
+                        &recipient,
+                        destination_chain_recipient,
+                        env.block.time,
+                    )?;
 
-                    response = response.add_messages([mint_msg_fees, mint_msg_for_recipient]);
+                    response = response
+                        .add_message(mint_msg_fees)
+                        .add_messages(delivery_msgs);
                 }
             } else {
                 // We check that the token is registered and enabled
@@ -664,6 +738,7 @@ fn save_evidence(
                     handle_fee_collection(
                         deps.storage,
                         token.bridging_fee,
+                        token.treasury_fee,
                         token.denom.clone(),
                         remainder,
                     )?;
@@ -697,8 +772,10 @@ fn save_evidence(
 
             // Validation for certain operation types that can't have account sequences
             match &operation.operation_type {
-                // A TrustSet operation or CoreumToXRPLTransfer operation are only executed with tickets
-                OperationType::TrustSet { .. } | OperationType::CoreumToXRPLTransfer { .. } => {
+                // A TrustSet, CoreumToXRPLTransfer or CheckCash operation are only executed with tickets
+                OperationType::TrustSet { .. }
+                | OperationType::CoreumToXRPLTransfer { .. }
+                | OperationType::CheckCash { .. } => {
                     if account_sequence.is_some() {
                         return Err(ContractError::InvalidTransactionResultEvidence {});
                     }
@@ -723,15 +800,22 @@ fn save_evidence(
                 // If the operation was not Invalid, we must register a used ticket
                 if transaction_result.ne(&TransactionResult::Invalid) && ticket_sequence.is_some() {
                     // If the operation must trigger a new ticket allocation we must know if we can trigger it
-                    // or not (if we have tickets available). Therefore we will return a false flag if
-                    // we don't have available tickets left and we will notify with an attribute.
+                    // or not (if we have tickets available). Therefore we will receive a NoAvailableTickets error
+                    // if we don't have available tickets left and we will notify with an attribute.
                     // NOTE: This will only happen in the particular case of a rejected ticket allocation
                     // operation.
-                    if !register_used_ticket(deps.storage, env.block.time.seconds())? {
-                        response = response.add_attribute(
-                            "adding_ticket_allocation_operation_success",
-                            false.to_string(),
-                        );
+                    match register_used_ticket(deps.storage, env.block.time.seconds()) {
+                        Ok(Some(operation_created_event)) => {
+                            response = response.add_event(operation_created_event);
+                        }
+                        Ok(None) => {}
+                        Err(ContractError::NoAvailableTickets {}) => {
+                            response = response.add_attribute(
+                                "adding_ticket_allocation_operation_success",
+                                false.to_string(),
+                            );
+                        }
+                        Err(e) => return Err(e),
                     }
                 }
             }
@@ -746,6 +830,91 @@ fn save_evidence(
                 response = response.add_attribute("tx_hash", tx_hash);
             }
         }
+        Evidence::XRPLCheckCash {
+            tx_hash,
+            check_id,
+            issuer,
+            currency,
+            amount,
+            sender,
+            recipient,
+        } => {
+            if config.bridge_state == BridgeState::Halted {
+                return Err(ContractError::BridgeHalted {});
+            }
+            deps.api.addr_validate(recipient.as_ref())?;
+
+            // If the recipient of the operation is the bridge contract address, we error
+            if recipient.eq(&env.contract.address) {
+                return Err(ContractError::ProhibitedAddress {});
+            }
+
+            // The bridge account can't cash the check of the token it issues itself
+            if issuer.eq(&config.bridge_xrpl_address) {
+                return Err(ContractError::CheckCashTokenNotSupported {});
+            }
+
+            // The check is validated the same way as the transfer, so it's cashed only if the cashed amount can be
+            // minted for the recipient
+            let token = load_enabled_xrpl_token(deps.storage, &issuer, &currency)?;
+            xrpl_originated_amount_to_send(&token, amount)?;
+            validate_xrpl_token_max_holding_amount(deps.as_ref(), &token, amount)?;
+
+            // If enough evidences are provided (threshold reached), we create the operation to cash the check, the
+            // amount is minted for the recipient once the check is cashed
+            if threshold_reached {
+                let ticket = allocate_ticket(deps.storage)?;
+                let operation_created_event = create_pending_operation(
+                    deps.storage,
+                    env.block.time.seconds(),
+                    Some(ticket),
+                    None,
+                    OperationType::CheckCash {
+                        check_id: check_id.clone(),
+                        issuer: issuer.clone(),
+                        currency: currency.clone(),
+                        amount,
+                        recipient: recipient.clone(),
+                    },
+                )?;
+                response = response.add_event(operation_created_event);
+            }
+
+            response = response
+                .add_attribute("hash", tx_hash)
+                .add_attribute("check_id", check_id)
+                .add_attribute("issuer", issuer)
+                .add_attribute("currency", currency)
+                .add_attribute("amount", amount.to_string())
+                .add_attribute("check_sender", sender)
+                .add_attribute("recipient", recipient.to_string())
+                .add_attribute("threshold_reached", threshold_reached.to_string());
+        }
+        Evidence::IBCTransferResult { tx_hash, success } => {
+            let pending_ibc_transfer = PENDING_IBC_TRANSFERS
+                .load(deps.storage, tx_hash.to_uppercase())
+                .map_err(|_| ContractError::PendingIBCTransferNotFound {})?;
+
+            if threshold_reached {
+                PENDING_IBC_TRANSFERS.remove(deps.storage, pending_ibc_transfer.tx_hash.clone());
+                // The tokens of the failed or timed out transfer are returned to the bridge contract by the IBC module,
+                // so we store them as a pending refund the Coreum recipient can claim
+                if !success {
+                    store_pending_refund(
+                        deps.storage,
+                        pending_ibc_transfer.tx_hash.clone(),
+                        Some(pending_ibc_transfer.tx_hash),
+                        pending_ibc_transfer.recipient,
+                        pending_ibc_transfer.coin,
+                    )?;
+                }
+            }
+
+            response = response
+                .add_attribute("tx_hash", tx_hash)
+                .add_attribute("ibc_transfer_success", success.to_string())
+                .add_attribute("threshold_reached", threshold_reached.to_string());
+        }
     }
 
     Ok(response)
@@ -793,7 +962,7 @@ fn recover_tickets(
         return Err(ContractError::InvalidTicketSequenceToAllocate {});
     }
 
-    create_pending_operation(
+    let operation_created_event = create_pending_operation(
         deps.storage,
         timestamp,
         None,
@@ -804,6 +973,7 @@ fn recover_tickets(
     )?;
 
     Ok(Response::new()
+        .add_event(operation_created_event)
         .add_attribute("action", ContractActions::RecoverTickets.as_str())
         .add_attribute("sender", sender)
         .add_attribute("account_sequence", account_sequence.to_string()))
@@ -842,7 +1012,7 @@ fn recover_xrpl_token_registration(
     let config = CONFIG.load(deps.storage)?;
     let ticket = allocate_ticket(deps.storage)?;
 
-    create_pending_operation(
+    let operation_created_event = create_pending_operation(
         deps.storage,
         timestamp,
         Some(ticket),
@@ -855,6 +1025,7 @@ fn recover_xrpl_token_registration(
     )?;
 
     Ok(Response::new()
+        .add_event(operation_created_event)
         .add_attribute(
             "action",
             ContractActions::RecoverXRPLTokenRegistration.as_str(),
@@ -929,6 +1100,14 @@ fn send_to_xrpl(
             return Err(ContractError::TokenNotEnabled {});
         }
 
+        register_daily_send(
+            deps.storage,
+            env.block.time.seconds(),
+            &info.sender,
+            xrpl_token.coreum_denom.clone(),
+            xrpl_token.max_sends_per_address_per_day,
+        )?;
+
         issuer = xrpl_token.issuer;
         currency = xrpl_token.currency;
         if is_token_xrp(&issuer, &currency) {
@@ -977,6 +1156,7 @@ fn send_to_xrpl(
         handle_fee_collection(
             deps.storage,
             xrpl_token.bridging_fee,
+            xrpl_token.treasury_fee,
             xrpl_token.coreum_denom,
             remainder,
         )?;
@@ -994,6 +1174,14 @@ fn send_to_xrpl(
             return Err(ContractError::DeliverAmountIsProhibited {});
         }
 
+        register_daily_send(
+            deps.storage,
+            env.block.time.seconds(),
+            &info.sender,
+            coreum_token.denom.clone(),
+            coreum_token.max_sends_per_address_per_day,
+        )?;
+
         let config = CONFIG.load(deps.storage)?;
 
         decimals = coreum_token.decimals;
@@ -1014,6 +1202,7 @@ fn send_to_xrpl(
         handle_fee_collection(
             deps.storage,
             coreum_token.bridging_fee,
+            coreum_token.treasury_fee,
             coreum_token.denom.clone(),
             remainder,
         )?;
@@ -1041,7 +1230,7 @@ fn send_to_xrpl(
 
     // Get a ticket and store the pending operation
     let ticket = allocate_ticket(deps.storage)?;
-    create_pending_operation(
+    let operation_created_event = create_pending_operation(
         deps.storage,
         env.block.time.seconds(),
         Some(ticket),
@@ -1057,6 +1246,7 @@ fn send_to_xrpl(
     )?;
 
     Ok(Response::new()
+        .add_event(operation_created_event)
         .add_attribute("action", ContractActions::SendToXRPL.as_str())
         .add_attribute("sender", info.sender)
         .add_attribute("recipient", recipient)
@@ -1066,6 +1256,7 @@ fn send_to_xrpl(
 #[allow(clippy::too_many_arguments)]
 fn update_xrpl_token(
     deps: DepsMut,
+    env: Env,
     sender: Addr,
     issuer: String,
     currency: String,
@@ -1073,6 +1264,8 @@ fn update_xrpl_token(
     sending_precision: Option<i32>,
     bridging_fee: Option<Uint128>,
     max_holding_amount: Option<Uint128>,
+    max_sends_per_address_per_day: Option<u32>,
+    treasury_fee: Option<Uint128>,
 ) -> CoreumResult<ContractError> {
     check_authorization(
         deps.as_ref().storage,
@@ -1087,6 +1280,7 @@ fn update_xrpl_token(
         .load(deps.storage, key.clone())
         .map_err(|_| ContractError::TokenNotRegistered {})?;
 
+    let old_state = token.state.clone();
     set_token_state(&mut token.state, state)?;
 
     let decimals = if is_token_xrp(&issuer, &currency) {
@@ -1110,13 +1304,177 @@ fn update_xrpl_token(
         max_holding_amount,
     )?;
 
+    set_token_max_sends_per_address_per_day(
+        &mut token.max_sends_per_address_per_day,
+        max_sends_per_address_per_day,
+    );
+
+    set_token_treasury_fee(&mut token.treasury_fee, treasury_fee);
+
     XRPL_TOKENS.save(deps.storage, key, &token)?;
 
+    let state_changed_event = build_token_state_changed_event(
+        &env,
+        &sender,
+        &token.coreum_denom,
+        &old_state,
+        &token.state,
+    );
+
     Ok(Response::new()
         .add_attribute("action", ContractActions::UpdateXRPLToken.as_str())
         .add_attribute("sender", sender)
         .add_attribute("issuer", issuer)
-        .add_attribute("currency", currency))
+        .add_attribute("currency", currency)
+        .add_events(state_changed_event))
+}
+
+fn migrate_xrpl_token(
+    deps: DepsMut,
+    env: Env,
+    sender: Addr,
+    old_issuer: String,
+    old_currency: String,
+    new_issuer: String,
+    new_currency: String,
+) -> CoreumResult<ContractError> {
+    check_authorization(
+        deps.as_ref().storage,
+        &sender,
+        &ContractActions::MigrateXRPLToken,
+    )?;
+    assert_bridge_active(deps.as_ref())?;
+
+    if is_token_xrp(&old_issuer, &old_currency) || is_token_xrp(&new_issuer, &new_currency) {
+        return Err(ContractError::XRPTokenNotMigratable {});
+    }
+
+    validate_xrpl_address(deps.storage, new_issuer.clone())?;
+    validate_xrpl_currency(&new_currency)?;
+
+    let old_key = build_xrpl_token_key(&old_issuer, &old_currency);
+    let mut token = XRPL_TOKENS
+        .load(deps.storage, old_key.clone())
+        .map_err(|_| ContractError::TokenNotRegistered {})?;
+
+    // The migration enables the token once the TrustSet operation is accepted, so the disabled token must be enabled
+    // by the owner first
+    if token.state.eq(&TokenState::Disabled) {
+        return Err(ContractError::DisabledXRPLTokenNotMigratable {});
+    }
+
+    let new_key = build_xrpl_token_key(&new_issuer, &new_currency);
+    if XRPL_TOKENS.has(deps.storage, new_key.clone())
+        || MIGRATED_XRPL_TOKENS.has(deps.storage, new_key.clone())
+    {
+        return Err(ContractError::XRPLTokenAlreadyRegistered {
+            issuer: new_issuer,
+            currency: new_currency,
+        });
+    }
+
+    // The results of the TrustSet, CoreumToXRPLTransfer and CheckCash operations find the token by its issuer and
+    // currency, so the operations of the old issuer and currency must be completed before the migration
+    for item in PENDING_OPERATIONS.range(deps.storage, None, None, Order::Ascending) {
+        let (_, operation) = item?;
+        let references_token = match &operation.operation_type {
+            OperationType::TrustSet {
+                issuer, currency, ..
+            }
+            | OperationType::CoreumToXRPLTransfer {
+                issuer, currency, ..
+            }
+            | OperationType::CheckCash {
+                issuer, currency, ..
+            } => issuer == &old_issuer && currency == &old_currency,
+            _ => false,
+        };
+        if references_token {
+            return Err(ContractError::XRPLTokenHasPendingOperations {});
+        }
+    }
+
+    // The token is moved to the new key with the same Coreum denom, so the coreum_denom index points to the new
+    // issuer and currency and the bridged balances aren't affected
+    XRPL_TOKENS.remove(deps.storage, old_key.clone())?;
+
+    // The old token is kept disabled until the owner removes it once the balance of its trust line has been handled
+    MIGRATED_XRPL_TOKENS.save(
+        deps.storage,
+        old_key,
+        &XRPLToken {
+            state: TokenState::Disabled,
+            ..token.clone()
+        },
+    )?;
+
+    let old_state = token.state.clone();
+    token.issuer = new_issuer.clone();
+    token.currency = new_currency.clone();
+    // The token will be enabled once the TrustSet operation for the new issuer and currency is accepted
+    token.state = TokenState::Processing;
+    XRPL_TOKENS.save(deps.storage, new_key, &token)?;
+
+    let config = CONFIG.load(deps.storage)?;
+    let ticket = allocate_ticket(deps.storage)?;
+
+    let operation_created_event = create_pending_operation(
+        deps.storage,
+        env.block.time.seconds(),
+        Some(ticket),
+        None,
+        OperationType::TrustSet {
+            issuer: new_issuer.clone(),
+            currency: new_currency.clone(),
+            trust_set_limit_amount: config.trust_set_limit_amount,
+        },
+    )?;
+
+    let state_changed_event = build_token_state_changed_event(
+        &env,
+        &sender,
+        &token.coreum_denom,
+        &old_state,
+        &token.state,
+    );
+
+    Ok(Response::new()
+        .add_event(operation_created_event)
+        .add_events(state_changed_event)
+        .add_attribute("action", ContractActions::MigrateXRPLToken.as_str())
+        .add_attribute("sender", sender)
+        .add_attribute("old_issuer", old_issuer)
+        .add_attribute("old_currency", old_currency)
+        .add_attribute("new_issuer", new_issuer)
+        .add_attribute("new_currency", new_currency)
+        .add_attribute("denom", token.coreum_denom))
+}
+
+fn remove_migrated_xrpl_token(
+    deps: DepsMut,
+    sender: Addr,
+    issuer: String,
+    currency: String,
+) -> CoreumResult<ContractError> {
+    check_authorization(
+        deps.as_ref().storage,
+        &sender,
+        &ContractActions::RemoveMigratedXRPLToken,
+    )?;
+    assert_bridge_active(deps.as_ref())?;
+
+    let key = build_xrpl_token_key(&issuer, &currency);
+    let token = MIGRATED_XRPL_TOKENS
+        .load(deps.storage, key.clone())
+        .map_err(|_| ContractError::MigratedXRPLTokenNotFound {})?;
+    MIGRATED_XRPL_TOKENS.remove(deps.storage, key);
+
+    Ok(Response::new()
+        .add_attribute("action", ContractActions::RemoveMigratedXRPLToken.as_str())
+        .add_attribute("sender", sender)
+        .add_attribute("issuer", issuer)
+        .add_attribute("currency", currency)
+        .add_attribute("denom", token.coreum_denom))
 }
 
 #[allow(clippy::too_many_arguments)]
@@ -1129,6 +1487,8 @@ fn update_coreum_token(
     sending_precision: Option<i32>,
     bridging_fee: Option<Uint128>,
     max_holding_amount: Option<Uint128>,
+    max_sends_per_address_per_day: Option<u32>,
+    treasury_fee: Option<Uint128>,
 ) -> CoreumResult<ContractError> {
     check_authorization(
         deps.as_ref().storage,
@@ -1141,6 +1501,7 @@ fn update_coreum_token(
         .load(deps.storage, denom.clone())
         .map_err(|_| ContractError::TokenNotRegistered {})?;
 
+    let old_state = token.state.clone();
     set_token_state(&mut token.state, state)?;
     set_token_sending_precision(
         &mut token.sending_precision,
@@ -1152,7 +1513,7 @@ fn update_coreum_token(
     // Get the current bridged amount for this token to verify that we are not setting a max_holding_amount that is less than the current amount
     let current_bridged_amount = deps
         .querier
-        .query_balance(env.contract.address, token.denom.clone())?
+        .query_balance(env.contract.address.clone(), token.denom.clone())?
         .amount;
     set_token_max_holding_amount(
         current_bridged_amount,
@@ -1160,12 +1521,23 @@ fn update_coreum_token(
         max_holding_amount,
     )?;
 
+    set_token_max_sends_per_address_per_day(
+        &mut token.max_sends_per_address_per_day,
+        max_sends_per_address_per_day,
+    );
+
+    set_token_treasury_fee(&mut token.treasury_fee, treasury_fee);
+
     COREUM_TOKENS.save(deps.storage, denom.clone(), &token)?;
 
+    let state_changed_event =
+        build_token_state_changed_event(&env, &sender, &denom, &old_state, &token.state);
+
     Ok(Response::new()
         .add_attribute("action", ContractActions::UpdateCoreumToken.as_str())
         .add_attribute("sender", sender)
-        .add_attribute("denom", denom))
+        .add_attribute("denom", denom)
+        .add_events(state_changed_event))
 }
 
 fn update_xrpl_base_fee(
@@ -1181,36 +1553,21 @@ fn update_xrpl_base_fee(
 
     // Update the value in config
     let mut config = CONFIG.load(deps.storage)?;
+    validate_max_xrpl_tx_fee(
+        config.max_xrpl_tx_fee,
+        xrpl_base_fee,
+        config.evidence_threshold,
+    )?;
     config.xrpl_base_fee = xrpl_base_fee;
     CONFIG.save(deps.storage, &config)?;
 
-    // Let's collect all operations in storage and update them
-    let operations: Vec<(u64, Operation)> = PENDING_OPERATIONS
-        .range(deps.storage, None, None, Order::Ascending)
-        .filter_map(Result::ok)
-        .collect();
-
-    // For each operation in PENDING_OPERATIONS we increase the version by 1 and delete all signatures
-    for operation in &operations {
-        PENDING_OPERATIONS.save(
-            deps.storage,
-            operation.0,
-            &Operation {
-                id: operation.1.id.clone(),
-                version: operation.1.version + 1,
-                ticket_sequence: operation.1.ticket_sequence,
-                account_sequence: operation.1.account_sequence,
-                signatures: vec![],
-                operation_type: operation.1.operation_type.clone(),
-                xrpl_base_fee,
-            },
-        )?;
-    }
+    let version_bumped_event = bump_pending_operations_version(deps.storage, xrpl_base_fee)?;
 
     Ok(Response::new()
         .add_attribute("action", ContractActions::UpdateXRPLBaseFee.as_str())
         .add_attribute("sender", sender)
-        .add_attribute("new_xrpl_base_fee", xrpl_base_fee.to_string()))
+        .add_attribute("new_xrpl_base_fee", xrpl_base_fee.to_string())
+        .add_event(version_bumped_event))
 }
 
 fn claim_relayer_fees(
@@ -1241,6 +1598,36 @@ fn claim_relayer_fees(
         .add_message(send_msg))
 }
 
+fn claim_treasury_fees(deps: DepsMut, sender: Addr) -> CoreumResult<ContractError> {
+    check_authorization(deps.storage, &sender, &ContractActions::ClaimTreasuryFees)?;
+    assert_bridge_active(deps.as_ref())?;
+
+    let treasury_fees_collected = TREASURY_FEES_COLLECTED
+        .may_load(deps.storage)?
+        .unwrap_or_default();
+    if treasury_fees_collected.is_empty() {
+        return Err(ContractError::NoTreasuryFeesToClaim {});
+    }
+    TREASURY_FEES_COLLECTED.save(deps.storage, &vec![])?;
+
+    // The fees are sent to the owner if the treasury address is not set
+    let recipient = CONFIG
+        .load(deps.storage)?
+        .treasury_address
+        .unwrap_or(sender.clone());
+
+    let send_msg = BankMsg::Send {
+        to_address: recipient.to_string(),
+        amount: treasury_fees_collected,
+    };
+
+    Ok(Response::new()
+        .add_attribute("action", ContractActions::ClaimTreasuryFees.as_str())
+        .add_attribute("sender", sender)
+        .add_attribute("recipient", recipient)
+        .add_message(send_msg))
+}
+
 fn claim_pending_refund(
     deps: DepsMut,
     sender: Addr,
@@ -1312,9 +1699,17 @@ fn rotate_keys(
     // Validate the new relayer set so that we are sure that the new set is valid (e.g. no duplicated relayers, etc.)
     validate_relayers(deps.as_ref(), &new_relayers, new_evidence_threshold)?;
 
+    // The transactions signed by the new relayers must still be able to pay the fee for the new threshold
+    let config = CONFIG.load(deps.storage)?;
+    validate_max_xrpl_tx_fee(
+        config.max_xrpl_tx_fee,
+        config.xrpl_base_fee,
+        new_evidence_threshold,
+    )?;
+
     let ticket = allocate_ticket(deps.storage)?;
 
-    create_pending_operation(
+    let operation_created_event = create_pending_operation(
         deps.storage,
         env.block.time.seconds(),
         Some(ticket),
@@ -1326,6 +1721,7 @@ fn rotate_keys(
     )?;
 
     Ok(Response::new()
+        .add_event(operation_created_event)
         .add_attribute("action", ContractActions::RotateKeys.as_str())
         .add_attribute("sender", sender))
 }
@@ -1402,13 +1798,17 @@ fn cancel_pending_operation(
 
 // ********** Queries **********
 #[cfg_attr(not(feature = "library"), entry_point)]
-pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+pub fn query(deps: Deps, env: Env, msg: QueryMsg) -> StdResult<Binary> {
     match msg {
         QueryMsg::Config {} => to_json_binary(&query_config(deps)?),
         QueryMsg::XRPLTokens {
             start_after_key,
             limit,
         } => to_json_binary(&query_xrpl_tokens(deps, start_after_key, limit)),
+        QueryMsg::MigratedXRPLTokens {
+            start_after_key,
+            limit,
+        } => to_json_binary(&query_migrated_xrpl_tokens(deps, start_after_key, limit)),
         QueryMsg::CoreumTokens {
             start_after_key,
             limit,
@@ -1432,6 +1832,7 @@ pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
         QueryMsg::FeesCollected { relayer_address } => {
             to_json_binary(&query_fees_collected(deps, relayer_address)?)
         }
+        QueryMsg::TreasuryFeesCollected {} => to_json_binary(&query_treasury_fees_collected(deps)?),
         QueryMsg::BridgeState {} => to_json_binary(&query_bridge_state(deps)?),
         QueryMsg::TransactionEvidence { hash } => {
             to_json_binary(&query_transaction_evidence(deps, hash)?)
@@ -1448,6 +1849,13 @@ pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
         QueryMsg::ProhibitedXRPLAddresses {} => {
             to_json_binary(&query_prohibited_xrpl_addresses(deps))
         }
+        QueryMsg::RelayerEvidenceRateLimit { relayer_address } => to_json_binary(
+            &query_relayer_evidence_rate_limit(deps, env, relayer_address)?,
+        ),
+        QueryMsg::PendingIBCTransfers {
+            start_after_key,
+            limit,
+        } => to_json_binary(&query_pending_ibc_transfers(deps, start_after_key, limit)),
     }
 }
 
@@ -1456,6 +1864,21 @@ fn query_config(deps: Deps) -> StdResult<Config> {
     Ok(config)
 }
 
+fn query_relayer_evidence_rate_limit(
+    deps: Deps,
+    env: Env,
+    relayer_address: Addr,
+) -> StdResult<RelayerEvidenceRateLimitResponse> {
+    let config = CONFIG.load(deps.storage)?;
+    let counter = query_relayer_evidence_counter(deps.storage, env.block.height, &relayer_address)?;
+
+    Ok(RelayerEvidenceRateLimitResponse {
+        max_evidences_per_relayer_per_block: config.max_evidences_per_relayer_per_block,
+        block_height: counter.block_height,
+        evidences_count: counter.count,
+    })
+}
+
 fn query_bridge_state(deps: Deps) -> StdResult<BridgeStateResponse> {
     let config = CONFIG.load(deps.storage)?;
     Ok(BridgeStateResponse {
@@ -1484,6 +1907,27 @@ fn query_xrpl_tokens(
     XRPLTokensResponse { last_key, tokens }
 }
 
+fn query_migrated_xrpl_tokens(
+    deps: Deps,
+    start_after_key: Option<String>,
+    limit: Option<u32>,
+) -> XRPLTokensResponse {
+    let limit = limit.unwrap_or(MAX_PAGE_LIMIT).min(MAX_PAGE_LIMIT);
+    let start = start_after_key.map(Bound::exclusive);
+    let mut last_key = None;
+    let tokens: Vec<XRPLToken> = MIGRATED_XRPL_TOKENS
+        .range(deps.storage, start, None, Order::Ascending)
+        .take(limit as usize)
+        .filter_map(Result::ok)
+        .map(|(key, v)| {
+            last_key = Some(key);
+            v
+        })
+        .collect();
+
+    XRPLTokensResponse { last_key, tokens }
+}
+
 fn query_coreum_tokens(
     deps: Deps,
     start_after_key: Option<String>,
@@ -1545,6 +1989,14 @@ fn query_fees_collected(deps: Deps, relayer_address: Addr) -> StdResult<FeesColl
     Ok(FeesCollectedResponse { fees_collected })
 }
 
+fn query_treasury_fees_collected(deps: Deps) -> StdResult<FeesCollectedResponse> {
+    let fees_collected = TREASURY_FEES_COLLECTED
+        .may_load(deps.storage)?
+        .unwrap_or_default();
+
+    Ok(FeesCollectedResponse { fees_collected })
+}
+
 fn query_pending_refunds(
     deps: Deps,
     address: Addr,
@@ -1644,6 +2096,30 @@ fn query_processed_txs(
     }
 }
 
+fn query_pending_ibc_transfers(
+    deps: Deps,
+    start_after_key: Option<String>,
+    limit: Option<u32>,
+) -> PendingIBCTransfersResponse {
+    let limit = limit.unwrap_or(MAX_PAGE_LIMIT).min(MAX_PAGE_LIMIT);
+    let start = start_after_key.map(Bound::exclusive);
+    let mut last_key = None;
+    let pending_ibc_transfers: Vec<PendingIBCTransfer> = PENDING_IBC_TRANSFERS
+        .range(deps.storage, start, None, Order::Ascending)
+        .take(limit as usize)
+        .filter_map(Result::ok)
+        .map(|(tx_hash, pending_ibc_transfer)| {
+            last_key = Some(tx_hash);
+            pending_ibc_transfer
+        })
+        .collect();
+
+    PendingIBCTransfersResponse {
+        last_key,
+        pending_ibc_transfers,
+    }
+}
+
 fn query_prohibited_xrpl_addresses(deps: Deps) -> ProhibitedXRPLAddressesResponse {
     let prohibited_xrpl_addresses: Vec<String> = PROHIBITED_XRPL_ADDRESSES
         .range(deps.storage, None, None, Order::Ascending)
@@ -1750,6 +2226,69 @@ fn validate_coreum_denom(denom: &str) -> Result<(), ContractError> {
     Ok(())
 }
 
+// Helper function to load the XRPL originated token which must be registered and enabled to be bridged, the old token
+// of a migrated token is kept disabled
+fn load_enabled_xrpl_token(
+    storage: &dyn Storage,
+    issuer: &str,
+    currency: &str,
+) -> Result<XRPLToken, ContractError> {
+    // Create issuer+currency key to find denom on coreum.
+    let key = build_xrpl_token_key(issuer, currency);
+
+    let token = match XRPL_TOKENS.may_load(storage, key.clone())? {
+        Some(token) => token,
+        None if MIGRATED_XRPL_TOKENS.has(storage, key) => {
+            return Err(ContractError::TokenNotEnabled {})
+        }
+        None => return Err(ContractError::TokenNotRegistered {}),
+    };
+
+    if token.state.ne(&TokenState::Enabled) {
+        return Err(ContractError::TokenNotEnabled {});
+    }
+
+    Ok(token)
+}
+
+// Helper function to calculate the amount of the XRPL originated token minted for the recipient and the remainder
+// collected as fees. The amount is simply truncated after applying the bridging fees because the Coreum tokens
+// corresponding to XRPL originated tokens have the same decimals as their corresponding XRPL tokens
+pub fn xrpl_originated_amount_to_send(
+    token: &XRPLToken,
+    amount: Uint128,
+) -> Result<(Uint128, Uint128), ContractError> {
+    let decimals = if is_token_xrp(&token.issuer, &token.currency) {
+        XRP_DECIMALS
+    } else {
+        XRPL_TOKENS_DECIMALS
+    };
+
+    let amount_after_bridge_fees = amount_after_bridge_fees(amount, token.bridging_fee)?;
+
+    truncate_amount(token.sending_precision, decimals, amount_after_bridge_fees)
+}
+
+// Helper function to validate that the amount the bridge mints doesn't exceed the max_holding_amount of the token
+fn validate_xrpl_token_max_holding_amount(
+    deps: Deps,
+    token: &XRPLToken,
+    amount: Uint128,
+) -> Result<(), ContractError> {
+    if amount
+        .checked_add(
+            deps.querier
+                .query_supply(token.coreum_denom.clone())?
+                .amount,
+        )?
+        .gt(&token.max_holding_amount)
+    {
+        return Err(ContractError::MaximumBridgedAmountReached {});
+    }
+
+    Ok(())
+}
+
 // Function used to truncate the amount to not send tokens over the sending precision.
 fn truncate_amount(
     sending_precision: i32,
@@ -1846,6 +2385,25 @@ fn validate_xrpl_amount(amount: Uint128) -> Result<(), ContractError> {
     Ok(())
 }
 
+// Helper function to validate that the fee of the multi-signed XRPL transactions isn't capped below the fee XRPL
+// requires for them. The multi-signed transaction is submitted with the evidence threshold signatures and XRPL charges
+// the base fee for each of them plus the base fee of the transaction itself
+fn validate_max_xrpl_tx_fee(
+    max_xrpl_tx_fee: Option<u64>,
+    xrpl_base_fee: u64,
+    evidence_threshold: u32,
+) -> Result<(), ContractError> {
+    if let Some(max_xrpl_tx_fee) = max_xrpl_tx_fee {
+        let required_xrpl_tx_fee =
+            xrpl_base_fee.saturating_mul(u64::from(evidence_threshold).saturating_add(1));
+        if max_xrpl_tx_fee == 0 || max_xrpl_tx_fee < required_xrpl_tx_fee {
+            return Err(ContractError::InvalidMaxXRPLTxFee {});
+        }
+    }
+
+    Ok(())
+}
+
 fn convert_currency_to_xrpl_hexadecimal(currency: String) -> String {
     // Fill with zeros to get the correct hex representation in XRPL of our currency.
     format!("{:0<40}", hex::encode(currency)).to_uppercase()
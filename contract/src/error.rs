@@ -200,4 +200,57 @@ pub enum ContractError {
 
     #[error("InvalidDenom: A valid denom must fulfil the following Regex criteria: [a-zA-Z][a-zA-Z0-9/:._-]{{2,127}}")]
     InvalidDenom {},
+
+    #[error("DailyTransferLimitExceeded: The sender reached the maximum amount of transfers allowed in a day for this token")]
+    DailyTransferLimitExceeded {},
+
+    #[error(
+        "RateLimitExceeded: The relayer reached the maximum amount of evidences allowed in a block"
+    )]
+    RateLimitExceeded {},
+
+    #[error("InvalidMaxXRPLTxFee: The maximum XRPL transaction fee can't be lower than the XRPL base fee multiplied by the evidence threshold plus one")]
+    InvalidMaxXRPLTxFee {},
+
+    #[error("InvalidMaxEvidencesPerRelayerPerBlock: The maximum amount of evidences per relayer per block must be greater than 0")]
+    InvalidMaxEvidencesPerRelayerPerBlock {},
+
+    #[error("InvalidIBCChannelID: The IBC channel ID must have the channel-{{sequence}} format")]
+    InvalidIBCChannelID {},
+
+    #[error("InvalidDestinationChainRecipient: The recipient on the IBC-connected chain can't be empty or contain whitespaces")]
+    InvalidDestinationChainRecipient {},
+
+    #[error("XRPTokenNotMigratable: The XRP token can't be migrated")]
+    XRPTokenNotMigratable {},
+
+    #[error("XRPLTokenHasPendingOperations: The token can't be migrated until its pending operations are completed")]
+    XRPLTokenHasPendingOperations {},
+
+    #[error(
+        "DisabledXRPLTokenNotMigratable: The disabled token must be enabled before the migration"
+    )]
+    DisabledXRPLTokenNotMigratable {},
+
+    #[error("MigratedXRPLTokenNotFound: There is no migrated token with this issuer and currency")]
+    MigratedXRPLTokenNotFound {},
+
+    #[error("NoTreasuryFeesToClaim: There are no treasury fees collected to claim")]
+    NoTreasuryFeesToClaim {},
+
+    #[error("InvalidIBCTransferTimeout: The IBC transfer timeout must be greater than 0")]
+    InvalidIBCTransferTimeout {},
+
+    #[error(
+        "PendingIBCTransferNotFound: There is no pending IBC transfer for this transaction hash"
+    )]
+    PendingIBCTransferNotFound {},
+
+    #[error("InvalidCheckCashEvidence: A check cash evidence must contain the check ID")]
+    InvalidCheckCashEvidence {},
+
+    #[error(
+        "CheckCashTokenNotSupported: Only the checks of the XRPL originated tokens can be cashed"
+    )]
+    CheckCashTokenNotSupported {},
 }
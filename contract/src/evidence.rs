@@ -5,8 +5,13 @@ use sha2::{Digest, Sha256};
 use crate::{
     error::ContractError,
     state::{CONFIG, PROCESSED_TXS, TX_EVIDENCES},
+    token::validate_destination_chain_recipient,
 };
 
+// Prefix of the processed tx key of the IBC transfer results, since the XRPL transaction hash of the transfer itself is
+// processed already
+const IBC_TRANSFER_RESULT_TX_HASH_PREFIX: &str = "IBC-";
+
 #[cw_serde]
 pub enum Evidence {
     // This evidence is only used for token transfers from XRPL to Coreum
@@ -17,6 +22,8 @@ pub enum Evidence {
         currency: String,
         amount: Uint128,
         recipient: Addr,
+        // Recipient on the IBC-connected chain for the tokens with the IBC delivery mode
+        destination_chain_recipient: Option<String>,
     },
     // This type will be used for ANY transaction that comes from XRPL and that is notifying a confirmation or rejection
     #[serde(rename = "xrpl_transaction_result")]
@@ -27,6 +34,21 @@ pub enum Evidence {
         transaction_result: TransactionResult,
         operation_result: Option<OperationResult>,
     },
+    // This evidence is used to notify the result of the IBC transfer sent by the bridge for the XRPL to Coreum transfer
+    #[serde(rename = "ibc_transfer_result")]
+    IBCTransferResult { tx_hash: String, success: bool },
+    // This evidence is used for the XRPL Checks created for the bridge account, the tx_hash is the hash of the
+    // CheckCreate transaction, and the check is cashed by the CheckCash operation
+    #[serde(rename = "xrpl_check_cash")]
+    XRPLCheckCash {
+        tx_hash: String,
+        check_id: String,
+        issuer: String,
+        currency: String,
+        amount: Uint128,
+        sender: String,
+        recipient: Addr,
+    },
 }
 
 #[cw_serde]
@@ -66,6 +88,10 @@ impl Evidence {
         match self {
             Self::XRPLToCoreumTransfer { tx_hash, .. } => tx_hash.clone(),
             Self::XRPLTransactionResult { tx_hash, .. } => tx_hash.clone().unwrap(),
+            Self::IBCTransferResult { tx_hash, .. } => {
+                format!("{IBC_TRANSFER_RESULT_TX_HASH_PREFIX}{tx_hash}")
+            }
+            Self::XRPLCheckCash { tx_hash, .. } => tx_hash.clone(),
         }
         .to_uppercase()
     }
@@ -73,6 +99,10 @@ impl Evidence {
         match self {
             // All transfers are valid operations
             Self::XRPLToCoreumTransfer { .. } => true,
+            // The IBC transfer results are only provided for the transfers that were sent
+            Self::IBCTransferResult { .. } => true,
+            // All checks are valid operations, the same as the transfers
+            Self::XRPLCheckCash { .. } => true,
             // All rejected/confirmed transactions are valid operations
             Self::XRPLTransactionResult {
                 transaction_result, ..
@@ -82,10 +112,29 @@ impl Evidence {
     // Function for basic validation of evidences in case relayers send something that is not valid
     pub fn validate_basic(&self) -> Result<(), ContractError> {
         match self {
-            Self::XRPLToCoreumTransfer { amount, .. } => {
+            Self::XRPLToCoreumTransfer {
+                amount,
+                destination_chain_recipient,
+                ..
+            } => {
                 if amount.is_zero() {
                     return Err(ContractError::InvalidAmount {});
                 }
+                if let Some(destination_chain_recipient) = destination_chain_recipient {
+                    validate_destination_chain_recipient(destination_chain_recipient)?;
+                }
+                Ok(())
+            }
+            Self::IBCTransferResult { .. } => Ok(()),
+            Self::XRPLCheckCash {
+                check_id, amount, ..
+            } => {
+                if amount.is_zero() {
+                    return Err(ContractError::InvalidAmount {});
+                }
+                if check_id.is_empty() {
+                    return Err(ContractError::InvalidCheckCashEvidence {});
+                }
                 Ok(())
             }
             Self::XRPLTransactionResult {
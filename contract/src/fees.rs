@@ -2,7 +2,7 @@ use cosmwasm_std::{coin, Addr, Coin, Storage, Uint128};
 
 use crate::{
     error::ContractError,
-    state::{CONFIG, FEES_COLLECTED, FEE_REMAINDERS},
+    state::{CONFIG, FEES_COLLECTED, FEE_REMAINDERS, TREASURY_FEES_COLLECTED},
 };
 
 pub fn amount_after_bridge_fees(
@@ -19,14 +19,42 @@ pub fn amount_after_bridge_fees(
 pub fn handle_fee_collection(
     storage: &mut dyn Storage,
     bridging_fee: Uint128,
+    treasury_fee: Option<Uint128>,
     token_denom: String,
     remainder: Uint128,
 ) -> Result<Uint128, ContractError> {
-    // We add the bridging fee we charged and the truncated portion after all fees were charged
-    let fee_collected = bridging_fee.checked_add(remainder)?;
+    // The treasury fee is taken out of the bridging fee, so it can never exceed it
+    let treasury_fee = treasury_fee.unwrap_or_default().min(bridging_fee);
+    collect_treasury_fees(storage, coin(treasury_fee.u128(), token_denom.clone()))?;
+
+    // We add the rest of the bridging fee we charged and the truncated portion after all fees were charged
+    let relayer_fee = bridging_fee
+        .checked_sub(treasury_fee)?
+        .checked_add(remainder)?;
+    collect_fees(storage, coin(relayer_fee.u128(), token_denom))?;
+
+    // The total is returned to mint it if needed
+    Ok(bridging_fee.checked_add(remainder)?)
+}
+
+fn collect_treasury_fees(storage: &mut dyn Storage, fee: Coin) -> Result<(), ContractError> {
+    if fee.amount.is_zero() {
+        return Ok(());
+    }
 
-    collect_fees(storage, coin(fee_collected.u128(), token_denom))?;
-    Ok(fee_collected)
+    let mut treasury_fees_collected = TREASURY_FEES_COLLECTED
+        .may_load(storage)?
+        .unwrap_or_default();
+    match treasury_fees_collected
+        .iter_mut()
+        .find(|c| c.denom == fee.denom)
+    {
+        Some(coin) => coin.amount += fee.amount,
+        None => treasury_fees_collected.push(fee),
+    }
+    TREASURY_FEES_COLLECTED.save(storage, &treasury_fees_collected)?;
+
+    Ok(())
 }
 
 fn collect_fees(storage: &mut dyn Storage, fee: Coin) -> Result<(), ContractError> {
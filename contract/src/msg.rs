@@ -3,12 +3,12 @@ use cosmwasm_std::{Addr, Coin, Uint128};
 use cw_ownable::{cw_ownable_execute, cw_ownable_query};
 
 #[allow(unused_imports)]
-use crate::state::{Config, CoreumToken, XRPLToken};
+use crate::state::{Config, CoreumToken, XRPLToken, XRPLTokenDeliveryMode};
 use crate::{
     evidence::Evidence,
     operation::Operation,
     relayer::Relayer,
-    state::{BridgeState, TokenState},
+    state::{BridgeState, PendingIBCTransfer, TokenState},
 };
 
 #[cw_serde]
@@ -26,6 +26,15 @@ pub struct InstantiateMsg {
     pub bridge_xrpl_address: String,
     // XRPL base fee used for executing transactions on XRPL
     pub xrpl_base_fee: u64,
+    // Maximum fee in drops of the multi-signed XRPL transactions, the fee computed from the XRPL base fee is capped by
+    // it. None means no limit
+    pub max_xrpl_tx_fee: Option<u64>,
+    // SourceTag set by the relayers on the outgoing XRPL Payment and TrustSet transactions
+    pub source_tag: Option<u32>,
+    // Maximum amount of evidences a single relayer can save in a block. None means no limit
+    pub max_evidences_per_relayer_per_block: Option<u32>,
+    // Address receiving the claimed treasury fees. None means the owner receives them
+    pub treasury_address: Option<Addr>,
 }
 
 #[cw_serde]
@@ -42,6 +51,8 @@ pub enum ExecuteMsg {
         sending_precision: i32,
         max_holding_amount: Uint128,
         bridging_fee: Uint128,
+        // Optional maximum amount of SendToXRPL operations a single address can perform for this token in a day
+        max_sends_per_address_per_day: Option<u32>,
     },
     // Registers an XRPL originated token so that it can be bridge to Coreum
     // Only the owner can do this
@@ -52,6 +63,10 @@ pub enum ExecuteMsg {
         sending_precision: i32,
         max_holding_amount: Uint128,
         bridging_fee: Uint128,
+        // Optional maximum amount of SendToXRPL operations a single address can perform for this token in a day
+        max_sends_per_address_per_day: Option<u32>,
+        // Optional delivery mode of the bridged tokens, AssetFT is used if not provided
+        delivery_mode: Option<XRPLTokenDeliveryMode>,
     },
     // Perform a ticket recovery in case the bridge has run out of tickets due to rejected ticket allocation operations on XRPL
     // Only the owner can do this
@@ -103,6 +118,30 @@ pub enum ExecuteMsg {
         sending_precision: Option<i32>,
         bridging_fee: Option<Uint128>,
         max_holding_amount: Option<Uint128>,
+        // Sending 0 removes the daily limit
+        max_sends_per_address_per_day: Option<u32>,
+        // Sending 0 removes the treasury fee
+        treasury_fee: Option<Uint128>,
+    },
+    // Migrate an XRPL originated token to a new issuer and currency, e.g. after the issuer account rotation.
+    // The token keeps its Coreum denom and configuration, so the bridged balances aren't affected. The token is
+    // enabled again once the TrustSet operation for the new issuer and currency is accepted. The old token is kept
+    // disabled until it's removed, so the old issuer and currency can't be bridged. Disabled tokens can't be migrated.
+    // Only the owner can do this
+    #[serde(rename = "migrate_xrpl_token")]
+    MigrateXRPLToken {
+        old_issuer: String,
+        old_currency: String,
+        new_issuer: String,
+        new_currency: String,
+    },
+    // Remove the disabled old token of a migrated XRPL token once the balance of its trust line has been handled, so
+    // the old issuer and currency can be registered again
+    // Only the owner can do this
+    #[serde(rename = "remove_migrated_xrpl_token")]
+    RemoveMigratedXRPLToken {
+        issuer: String,
+        currency: String,
     },
     // Update the configuration of a Coreum originated token
     UpdateCoreumToken {
@@ -113,6 +152,10 @@ pub enum ExecuteMsg {
         sending_precision: Option<i32>,
         bridging_fee: Option<Uint128>,
         max_holding_amount: Option<Uint128>,
+        // Sending 0 removes the daily limit
+        max_sends_per_address_per_day: Option<u32>,
+        // Sending 0 removes the treasury fee
+        treasury_fee: Option<Uint128>,
     },
     // Updates the XRPL base fee in config. When this operation is completed, all signatures on current pending operations will be deleted
     // and we will increase the version of all current pending operations.
@@ -131,6 +174,9 @@ pub enum ExecuteMsg {
     ClaimRelayerFees {
         amounts: Vec<Coin>,
     },
+    // Claim all the treasury fees collected. They are sent to the treasury address or to the owner if it's not set
+    // Only the owner can do this
+    ClaimTreasuryFees {},
     // Halt the bridge. This will prevent certain new operations to be created
     // Only the owner or a relayer can do this
     HaltBridge {},
@@ -169,6 +215,13 @@ pub enum QueryMsg {
         start_after_key: Option<String>,
         limit: Option<u32>,
     },
+    // Returns the disabled old tokens of the migrated XRPL tokens, keyed by the old issuer and currency
+    #[returns(XRPLTokensResponse)]
+    #[serde(rename = "migrated_xrpl_tokens")]
+    MigratedXRPLTokens {
+        start_after_key: Option<String>,
+        limit: Option<u32>,
+    },
     #[returns(CoreumTokensResponse)]
     CoreumTokens {
         start_after_key: Option<String>,
@@ -183,6 +236,8 @@ pub enum QueryMsg {
     AvailableTickets {},
     #[returns(FeesCollectedResponse)]
     FeesCollected { relayer_address: Addr },
+    #[returns(FeesCollectedResponse)]
+    TreasuryFeesCollected {},
     #[returns(PendingRefundsResponse)]
     PendingRefunds {
         address: Addr,
@@ -208,6 +263,14 @@ pub enum QueryMsg {
     #[returns(ProhibitedXRPLAddressesResponse)]
     #[serde(rename = "prohibited_xrpl_addresses")]
     ProhibitedXRPLAddresses {},
+    #[returns(RelayerEvidenceRateLimitResponse)]
+    RelayerEvidenceRateLimit { relayer_address: Addr },
+    #[returns(PendingIBCTransfersResponse)]
+    #[serde(rename = "pending_ibc_transfers")]
+    PendingIBCTransfers {
+        start_after_key: Option<String>,
+        limit: Option<u32>,
+    },
 }
 
 #[cw_serde]
@@ -251,6 +314,12 @@ pub struct PendingRefund {
     pub coin: Coin,
 }
 
+#[cw_serde]
+pub struct PendingIBCTransfersResponse {
+    pub last_key: Option<String>,
+    pub pending_ibc_transfers: Vec<PendingIBCTransfer>,
+}
+
 #[cw_serde]
 pub struct BridgeStateResponse {
     pub state: BridgeState,
@@ -278,3 +347,12 @@ pub struct ProcessedTxsResponse {
 pub struct ProhibitedXRPLAddressesResponse {
     pub prohibited_xrpl_addresses: Vec<String>,
 }
+
+#[cw_serde]
+pub struct RelayerEvidenceRateLimitResponse {
+    pub max_evidences_per_relayer_per_block: Option<u32>,
+    // Height of the block the query is executed at
+    pub block_height: u64,
+    // Amount of evidences saved by the relayer in the block the query is executed at
+    pub evidences_count: u32,
+}
@@ -1,11 +1,12 @@
 use coreum_wasm_sdk::{assetft, core::CoreumMsg};
 use cosmwasm_schema::cw_serde;
-use cosmwasm_std::{coin, Addr, Coin, CosmosMsg, Response, Storage, Uint128};
+use cosmwasm_std::{coin, Addr, Coin, CosmosMsg, Event, Order, Response, Storage, Uint128};
 
 use crate::{
-    contract::{convert_amount_decimals, XRPL_TOKENS_DECIMALS},
+    contract::{convert_amount_decimals, xrpl_originated_amount_to_send, XRPL_TOKENS_DECIMALS},
     error::ContractError,
     evidence::{OperationResult, TransactionResult},
+    fees::handle_fee_collection,
     relayer::{handle_rotate_keys_confirmation, Relayer},
     signatures::Signature,
     state::{
@@ -16,6 +17,9 @@ use crate::{
     token::build_xrpl_token_key,
 };
 
+pub const OPERATION_CREATED_EVENT: &str = "operation_created";
+pub const OPERATIONS_VERSION_BUMPED_EVENT: &str = "operations_version_bumped";
+
 #[cw_serde]
 pub struct Operation {
     pub id: String,
@@ -54,6 +58,14 @@ pub enum OperationType {
         sender: Addr,
         recipient: String,
     },
+    // Cashes the XRPL Check created for the bridge account, the amount is sent to the recipient once it's cashed
+    CheckCash {
+        check_id: String,
+        issuer: String,
+        currency: String,
+        amount: Uint128,
+        recipient: Addr,
+    },
 }
 
 // For responses
@@ -64,6 +76,7 @@ impl OperationType {
             Self::TrustSet { .. } => "trust_set",
             Self::RotateKeys { .. } => "rotate_keys",
             Self::CoreumToXRPLTransfer { .. } => "coreum_to_xrpl_transfer",
+            Self::CheckCash { .. } => "check_cash",
         }
     }
 }
@@ -85,7 +98,7 @@ pub fn create_pending_operation(
     ticket_sequence: Option<u64>,
     account_sequence: Option<u64>,
     operation_type: OperationType,
-) -> Result<(), ContractError> {
+) -> Result<Event, ContractError> {
     let config = CONFIG.load(storage)?;
 
     // If bridge is halted we prohibit all operation creations except allowed ones
@@ -110,7 +123,46 @@ pub fn create_pending_operation(
     }
     PENDING_OPERATIONS.save(storage, operation_id, &operation)?;
 
-    Ok(())
+    // The event allows relayers to react to the new operation without polling the pending operations
+    Ok(Event::new(OPERATION_CREATED_EVENT)
+        .add_attribute("operation_id", operation_id.to_string())
+        .add_attribute("operation_unique_id", operation.id.clone())
+        .add_attribute("operation_type", operation.operation_type.as_str())
+        .add_attribute("xrpl_base_fee", operation.xrpl_base_fee.to_string()))
+}
+
+// Increases the version of all pending operations by 1 and deletes their signatures, so that the relayers sign them
+// again with the new XRPL base fee or the new relayer set
+pub fn bump_pending_operations_version(
+    storage: &mut dyn Storage,
+    xrpl_base_fee: u64,
+) -> Result<Event, ContractError> {
+    let operations: Vec<(u64, Operation)> = PENDING_OPERATIONS
+        .range(storage, None, None, Order::Ascending)
+        .filter_map(Result::ok)
+        .collect();
+
+    for (operation_id, operation) in &operations {
+        PENDING_OPERATIONS.save(
+            storage,
+            *operation_id,
+            &Operation {
+                id: operation.id.clone(),
+                version: operation.version + 1,
+                ticket_sequence: operation.ticket_sequence,
+                account_sequence: operation.account_sequence,
+                signatures: vec![],
+                operation_type: operation.operation_type.clone(),
+                xrpl_base_fee,
+            },
+        )?;
+    }
+
+    // The event allows relayers to drop the invalidated signatures and re-sign without waiting for the version mismatch
+    // The size of the event doesn't depend on the amount of pending operations, all of them are bumped
+    Ok(Event::new(OPERATIONS_VERSION_BUMPED_EVENT)
+        .add_attribute("xrpl_base_fee", xrpl_base_fee.to_string())
+        .add_attribute("operations_count", operations.len().to_string()))
 }
 
 #[allow(clippy::too_many_arguments)]
@@ -161,10 +213,37 @@ pub fn handle_operation(
                 response,
             )?;
         }
+        OperationType::CheckCash {
+            issuer,
+            currency,
+            amount,
+            recipient,
+            ..
+        } => {
+            handle_check_cash_confirmation(
+                storage,
+                issuer,
+                currency,
+                amount.to_owned(),
+                recipient,
+                transaction_result,
+                response,
+            )?;
+        }
     }
     // Operation is removed because it was confirmed
     PENDING_OPERATIONS.remove(storage, operation_id);
 
+    // The fee of the multi-signed transactions depends on the evidence threshold, and the signatures of the old
+    // relayers can't be used anymore, so the pending operations must be signed again after the keys rotation
+    if matches!(operation.operation_type, OperationType::RotateKeys { .. })
+        && transaction_result.eq(&TransactionResult::Accepted)
+    {
+        let config = CONFIG.load(storage)?;
+        let version_bumped_event = bump_pending_operations_version(storage, config.xrpl_base_fee)?;
+        *response = response.to_owned().add_event(version_bumped_event);
+    }
+
     // If an operation was invalid, the ticket was never consumed, so we must return it to the ticket array.
     if transaction_result.eq(&TransactionResult::Invalid) && ticket_sequence.is_some() {
         return_ticket(storage, ticket_sequence.unwrap())?;
@@ -281,6 +360,50 @@ pub fn handle_coreum_to_xrpl_transfer_confirmation(
     Ok(())
 }
 
+pub fn handle_check_cash_confirmation(
+    storage: &mut dyn Storage,
+    issuer: &str,
+    currency: &str,
+    amount: Uint128,
+    recipient: &Addr,
+    transaction_result: &TransactionResult,
+    response: &mut Response<CoreumMsg>,
+) -> Result<(), ContractError> {
+    // If the check wasn't cashed, it stays in XRPL and the sender can cancel it, so there is nothing to do
+    if transaction_result.ne(&TransactionResult::Accepted) {
+        return Ok(());
+    }
+
+    // The cashed amount is already held by the bridge account, so it's minted even if the token was disabled meanwhile
+    let token = XRPL_TOKENS
+        .load(storage, build_xrpl_token_key(issuer, currency))
+        .map_err(|_| ContractError::TokenNotRegistered {})?;
+
+    let (amount_to_send, remainder) = xrpl_originated_amount_to_send(&token, amount)?;
+    let fee_collected = handle_fee_collection(
+        storage,
+        token.bridging_fee,
+        token.treasury_fee,
+        token.coreum_denom.clone(),
+        remainder,
+    )?;
+
+    let mint_msg_fees = CosmosMsg::from(CoreumMsg::AssetFT(assetft::Msg::Mint {
+        coin: coin(fee_collected.u128(), token.coreum_denom.clone()),
+        recipient: None,
+    }));
+    let mint_msg_recipient = CosmosMsg::from(CoreumMsg::AssetFT(assetft::Msg::Mint {
+        coin: coin(amount_to_send.u128(), token.coreum_denom),
+        recipient: Some(recipient.to_string()),
+    }));
+    *response = response
+        .to_owned()
+        .add_message(mint_msg_fees)
+        .add_message(mint_msg_recipient);
+
+    Ok(())
+}
+
 pub fn store_pending_refund(
     storage: &mut dyn Storage,
     pending_operation_id: String,
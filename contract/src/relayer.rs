@@ -1,14 +1,17 @@
 use std::collections::HashSet;
 
 use cosmwasm_schema::cw_serde;
-use cosmwasm_std::{Addr, Deps, Storage};
+use cosmwasm_std::{Addr, Deps, StdResult, Storage};
 
 use crate::{
     address::validate_xrpl_address,
     contract::MAX_RELAYERS,
     error::ContractError,
     evidence::TransactionResult,
-    state::{CONFIG, PENDING_ROTATE_KEYS, TX_EVIDENCES},
+    state::{
+        RelayerEvidenceCounter, CONFIG, PENDING_ROTATE_KEYS, RELAYER_EVIDENCE_COUNTERS,
+        TX_EVIDENCES,
+    },
 };
 
 #[cw_serde]
@@ -61,6 +64,46 @@ pub fn is_relayer(storage: &dyn Storage, sender: &Addr) -> Result<bool, Contract
     Ok(config.relayers.iter().any(|r| r.coreum_address == sender))
 }
 
+// Helper function to register an evidence saved by a relayer, returning an error if the relayer already saved the maximum amount of evidences in the block
+pub fn register_relayer_evidence(
+    storage: &mut dyn Storage,
+    block_height: u64,
+    sender: &Addr,
+    max_evidences_per_relayer_per_block: Option<u32>,
+) -> Result<(), ContractError> {
+    let max_evidences = match max_evidences_per_relayer_per_block {
+        Some(max_evidences) => max_evidences,
+        None => return Ok(()),
+    };
+
+    let mut counter = query_relayer_evidence_counter(storage, block_height, sender)?;
+    if counter.count >= max_evidences {
+        return Err(ContractError::RateLimitExceeded {});
+    }
+
+    counter.count += 1;
+    RELAYER_EVIDENCE_COUNTERS.save(storage, sender.clone(), &counter)?;
+
+    Ok(())
+}
+
+// Helper function to get the evidences counter of a relayer in the block, the counter of a previous block is reset
+pub fn query_relayer_evidence_counter(
+    storage: &dyn Storage,
+    block_height: u64,
+    relayer: &Addr,
+) -> StdResult<RelayerEvidenceCounter> {
+    let counter = match RELAYER_EVIDENCE_COUNTERS.may_load(storage, relayer.clone())? {
+        Some(counter) if counter.block_height == block_height => counter,
+        _ => RelayerEvidenceCounter {
+            count: 0,
+            block_height,
+        },
+    };
+
+    Ok(counter)
+}
+
 pub fn handle_rotate_keys_confirmation(
     storage: &mut dyn Storage,
     relayers: Vec<Relayer>,
@@ -25,6 +25,11 @@ pub enum TopKey {
     FeeRemainders = b'd',
     PendingRotateKeys = b'e',
     ProhibitedXRPLAddresses = b'f',
+    DailySendCounters = b'g',
+    RelayerEvidenceCounters = b'h',
+    TreasuryFeesCollected = b'i',
+    PendingIBCTransfers = b'j',
+    MigratedXRPLTokens = b'k',
 }
 
 impl TopKey {
@@ -46,6 +51,17 @@ pub struct Config {
     pub bridge_xrpl_address: String,
     pub bridge_state: BridgeState,
     pub xrpl_base_fee: u64,
+    // Maximum fee in drops of the multi-signed XRPL transactions. None means no limit.
+    // The field is optional to keep the contracts instantiated before its introduction readable
+    pub max_xrpl_tx_fee: Option<u64>,
+    // The field is optional to keep the contracts instantiated before its introduction readable
+    pub source_tag: Option<u32>,
+    // Maximum amount of evidences a single relayer can save in a block. None means no limit.
+    // The field is optional to keep the contracts instantiated before its introduction readable
+    pub max_evidences_per_relayer_per_block: Option<u32>,
+    // Address receiving the claimed treasury fees, None means the owner receives them
+    // The field is optional to keep the contracts instantiated before its introduction readable
+    pub treasury_address: Option<Addr>,
 }
 
 #[cw_serde]
@@ -65,6 +81,31 @@ pub struct XRPLToken {
     pub max_holding_amount: Uint128,
     pub state: TokenState,
     pub bridging_fee: Uint128,
+    // Maximum amount of SendToXRPL operations a single address can perform for this token in a day. None means no limit.
+    // The field is optional to keep the tokens registered before its introduction readable
+    pub max_sends_per_address_per_day: Option<u32>,
+    // Part of the bridging fee kept for the bridge operator treasury, capped by the bridging fee.
+    // None means no treasury fee.
+    // The field is optional to keep the tokens registered before its introduction readable
+    pub treasury_fee: Option<Uint128>,
+    // How the bridged tokens are delivered to the recipient. None means AssetFT, it keeps the tokens registered before its
+    // introduction readable
+    pub delivery_mode: Option<XRPLTokenDeliveryMode>,
+}
+
+#[cw_serde]
+pub enum XRPLTokenDeliveryMode {
+    // The tokens are minted to the recipient on Coreum
+    #[serde(rename = "assetft")]
+    AssetFT,
+    // The tokens are minted to the bridge contract and transferred over the IBC channel to the recipient on the
+    // IBC-connected chain provided in the XRPL transaction memo
+    #[serde(rename = "ibc")]
+    IBC {
+        ibc_channel_id: String,
+        // Time after which the IBC transfer is timed out and the tokens are returned to the bridge contract
+        ibc_transfer_timeout_seconds: u64,
+    },
 }
 
 #[cw_serde]
@@ -79,6 +120,17 @@ pub enum TokenState {
     Inactive,
 }
 
+impl TokenState {
+    pub const fn as_str(&self) -> &'static str {
+        match self {
+            Self::Enabled => "enabled",
+            Self::Disabled => "disabled",
+            Self::Processing => "processing",
+            Self::Inactive => "inactive",
+        }
+    }
+}
+
 #[cw_serde]
 pub struct CoreumToken {
     pub denom: String,
@@ -88,6 +140,27 @@ pub struct CoreumToken {
     pub max_holding_amount: Uint128,
     pub state: TokenState,
     pub bridging_fee: Uint128,
+    // Maximum amount of SendToXRPL operations a single address can perform for this token in a day. None means no limit.
+    // The field is optional to keep the tokens registered before its introduction readable
+    pub max_sends_per_address_per_day: Option<u32>,
+    // Part of the bridging fee kept for the bridge operator treasury, capped by the bridging fee.
+    // None means no treasury fee.
+    // The field is optional to keep the tokens registered before its introduction readable
+    pub treasury_fee: Option<Uint128>,
+}
+
+#[cw_serde]
+pub struct DailySendCounter {
+    pub count: u32,
+    // Timestamp (in seconds) when the counter window started, once a day has passed since then the counter is reset
+    pub window_start: u64,
+}
+
+#[cw_serde]
+pub struct RelayerEvidenceCounter {
+    pub count: u32,
+    // Height of the block the evidences are counted in, the counter is reset in the next block
+    pub block_height: u64,
 }
 
 #[cw_serde]
@@ -101,6 +174,17 @@ pub struct PendingRefund {
     pub coin: Coin,
 }
 
+#[cw_serde]
+pub struct PendingIBCTransfer {
+    // Hash of the XRPL transaction the transfer was bridged with
+    pub tx_hash: String,
+    // Coreum recipient of the XRPL transaction, the tokens are refunded to it if the IBC transfer fails
+    pub recipient: Addr,
+    pub ibc_channel_id: String,
+    pub destination_chain_recipient: String,
+    pub coin: Coin,
+}
+
 pub const CONFIG: Item<Config> = Item::new(TopKey::Config.as_str());
 // Tokens registered from XRPL side. These tokens are XRPL originated tokens - primary key is issuer+currency on XRPL
 // XRPLTokens will have coreum_denom as a secondary index so that we can get the XRPLToken corresponding to a coreum_denom
@@ -115,6 +199,12 @@ impl<'a> IndexList<XRPLToken> for XRPLTokensIndexes<'a> {
     }
 }
 
+// XRPL tokens moved to a new issuer and currency, the key is the old issuer+currency. The old token is kept disabled,
+// so the old issuer and currency can't be bridged or registered again, until the owner removes it once the balance of
+// its trust line has been handled
+pub const MIGRATED_XRPL_TOKENS: Map<String, XRPLToken> =
+    Map::new(TopKey::MigratedXRPLTokens.as_str());
+
 pub const XRPL_TOKENS: IndexedMap<String, XRPLToken, XRPLTokensIndexes> = IndexedMap::new(
     TopKey::XRPLTokens.as_str(),
     XRPLTokensIndexes {
@@ -195,9 +285,23 @@ pub const FEES_COLLECTED: Map<Addr, Vec<Coin>> = Map::new(TopKey::FeesCollected.
 // Fees Remainders in case that we have some small amounts left after dividing fees between our relayers we will keep them here until next time we collect fees and can add them to the new amount
 // Key is Coin denom and value is Coin amount
 pub const FEE_REMAINDERS: Map<String, Uint128> = Map::new(TopKey::FeeRemainders.as_str());
+// Treasury fees accumulated for the bridge operator until they are claimed by the owner
+pub const TREASURY_FEES_COLLECTED: Item<Vec<Coin>> =
+    Item::new(TopKey::TreasuryFeesCollected.as_str());
 // XRPL addresses that have been marked as prohibited and can't be used for receiving funds, issuing tokens, or multisigning transactions
 pub const PROHIBITED_XRPL_ADDRESSES: Map<String, Empty> =
     Map::new(TopKey::ProhibitedXRPLAddresses.as_str());
+// Amount of SendToXRPL operations performed by an address for a token during the current daily window
+// Key is the tuple (sender_address, coreum_denom)
+pub const DAILY_SEND_COUNTERS: Map<(Addr, String), DailySendCounter> =
+    Map::new(TopKey::DailySendCounters.as_str());
+// Amount of evidences saved by a relayer in the current block
+pub const RELAYER_EVIDENCE_COUNTERS: Map<Addr, RelayerEvidenceCounter> =
+    Map::new(TopKey::RelayerEvidenceCounters.as_str());
+// IBC transfers sent by the bridge which result is not confirmed by the relayers yet
+// Key is the XRPL transaction hash
+pub const PENDING_IBC_TRANSFERS: Map<String, PendingIBCTransfer> =
+    Map::new(TopKey::PendingIBCTransfers.as_str());
 
 pub enum ContractActions {
     Instantiation,
@@ -210,6 +314,8 @@ pub enum ContractActions {
     SendToXRPL,
     ClaimFees,
     UpdateXRPLToken,
+    MigrateXRPLToken,
+    RemoveMigratedXRPLToken,
     UpdateCoreumToken,
     UpdateXRPLBaseFee,
     UpdateProhibitedXRPLAddresses,
@@ -218,6 +324,7 @@ pub enum ContractActions {
     ResumeBridge,
     RotateKeys,
     CancelPendingOperation,
+    ClaimTreasuryFees,
 }
 
 pub enum UserType {
@@ -238,6 +345,8 @@ impl UserType {
             ContractActions::SendToXRPL => true,
             ContractActions::ClaimFees => matches!(self, Self::Relayer),
             ContractActions::UpdateXRPLToken => matches!(self, Self::Owner),
+            ContractActions::MigrateXRPLToken => matches!(self, Self::Owner),
+            ContractActions::RemoveMigratedXRPLToken => matches!(self, Self::Owner),
             ContractActions::UpdateCoreumToken => matches!(self, Self::Owner),
             ContractActions::UpdateXRPLBaseFee => matches!(self, Self::Owner),
             ContractActions::UpdateProhibitedXRPLAddresses => matches!(self, Self::Owner),
@@ -246,6 +355,7 @@ impl UserType {
             ContractActions::ResumeBridge => matches!(self, Self::Owner),
             ContractActions::RotateKeys => matches!(self, Self::Owner),
             ContractActions::CancelPendingOperation => matches!(self, Self::Owner),
+            ContractActions::ClaimTreasuryFees => matches!(self, Self::Owner),
         }
     }
 }
@@ -264,6 +374,8 @@ impl ContractActions {
             Self::ClaimFees => "claim_fees",
             Self::ClaimRefunds => "claim_refunds",
             Self::UpdateXRPLToken => "update_xrpl_token",
+            Self::MigrateXRPLToken => "migrate_xrpl_token",
+            Self::RemoveMigratedXRPLToken => "remove_migrated_xrpl_token",
             Self::UpdateCoreumToken => "update_coreum_token",
             Self::UpdateXRPLBaseFee => "update_xrpl_base_fee",
             Self::UpdateProhibitedXRPLAddresses => "update_invalid_xrpl_addresses",
@@ -271,6 +383,7 @@ impl ContractActions {
             Self::ResumeBridge => "resume_bridge",
             Self::RotateKeys => "rotate_keys",
             Self::CancelPendingOperation => "cancel_pending_operation",
+            Self::ClaimTreasuryFees => "claim_treasury_fees",
         }
     }
 }
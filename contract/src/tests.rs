@@ -24,8 +24,9 @@ mod tests {
         INITIAL_PROHIBITED_XRPL_ADDRESSES, MAX_COREUM_TOKEN_DECIMALS, MAX_RELAYERS,
     };
     use crate::msg::{
-        BridgeStateResponse, ProcessedTxsResponse, ProhibitedXRPLAddressesResponse,
-        TransactionEvidence, TransactionEvidencesResponse,
+        BridgeStateResponse, PendingIBCTransfersResponse, ProcessedTxsResponse,
+        ProhibitedXRPLAddressesResponse, RelayerEvidenceRateLimitResponse, TransactionEvidence,
+        TransactionEvidencesResponse,
     };
     use crate::state::BridgeState;
     use crate::{
@@ -40,7 +41,7 @@ mod tests {
         operation::{Operation, OperationType},
         relayer::Relayer,
         signatures::Signature,
-        state::{Config, TokenState, XRPLToken as QueriedXRPLToken},
+        state::{Config, TokenState, XRPLToken as QueriedXRPLToken, XRPLTokenDeliveryMode},
     };
 
     const FEE_DENOM: &str = "ucore";
@@ -99,6 +100,10 @@ mod tests {
                 trust_set_limit_amount,
                 bridge_xrpl_address,
                 xrpl_base_fee,
+                max_xrpl_tx_fee: None,
+                source_tag: None,
+                max_evidences_per_relayer_per_block: None,
+                treasury_address: None,
             },
             None,
             "coreumbridge-xrpl".into(),
@@ -253,6 +258,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -277,6 +286,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -301,6 +314,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -325,6 +342,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -350,6 +371,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: invalid_address.clone(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -378,6 +403,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -402,6 +431,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -428,6 +461,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -462,6 +499,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -486,6 +527,10 @@ mod tests {
                     trust_set_limit_amount: Uint128::new(10000000000000001),
                     bridge_xrpl_address: generate_xrpl_address(),
                     xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: None,
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
                 },
                 None,
                 "label".into(),
@@ -617,6 +662,275 @@ mod tests {
         ));
     }
 
+    #[test]
+    fn contract_instantiation_with_source_tag() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&[coin(100_000_000_000, FEE_DENOM)])
+            .unwrap();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let wasm_byte_code = std::fs::read("../contract/artifacts/coreumbridge_xrpl.wasm").unwrap();
+        let code_id = wasm
+            .store_code(&wasm_byte_code, None, &signer)
+            .unwrap()
+            .data
+            .code_id;
+
+        let source_tag = 1234;
+        let contract_addr = wasm
+            .instantiate(
+                code_id,
+                &InstantiateMsg {
+                    owner: Addr::unchecked(signer.address()),
+                    relayers: vec![relayer],
+                    evidence_threshold: 1,
+                    used_ticket_sequence_threshold: 50,
+                    trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+                    bridge_xrpl_address: generate_xrpl_address(),
+                    xrpl_base_fee: 10,
+                    max_xrpl_tx_fee: None,
+                    source_tag: Some(source_tag),
+                    max_evidences_per_relayer_per_block: None,
+                    treasury_address: None,
+                },
+                None,
+                "label".into(),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap()
+            .data
+            .address;
+
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+            .unwrap();
+
+        assert_eq!(query_config.source_tag, Some(source_tag));
+    }
+
+    #[test]
+    fn contract_instantiation_with_max_xrpl_tx_fee() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&[coin(100_000_000_000, FEE_DENOM)])
+            .unwrap();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let wasm_byte_code = std::fs::read("../contract/artifacts/coreumbridge_xrpl.wasm").unwrap();
+        let code_id = wasm
+            .store_code(&wasm_byte_code, None, &signer)
+            .unwrap()
+            .data
+            .code_id;
+
+        let instantiate_msg = InstantiateMsg {
+            owner: Addr::unchecked(signer.address()),
+            relayers: vec![relayer],
+            evidence_threshold: 1,
+            used_ticket_sequence_threshold: 50,
+            trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            bridge_xrpl_address: generate_xrpl_address(),
+            xrpl_base_fee: 10,
+            max_xrpl_tx_fee: Some(19),
+            source_tag: None,
+            max_evidences_per_relayer_per_block: None,
+            treasury_address: None,
+        };
+
+        // The max fee lower than the fee of the transaction signed by the evidence threshold relayers is rejected
+        let instantiate_error = wasm
+            .instantiate(
+                code_id,
+                &instantiate_msg,
+                None,
+                "label".into(),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(instantiate_error
+            .to_string()
+            .contains(ContractError::InvalidMaxXRPLTxFee {}.to_string().as_str()));
+
+        let contract_addr = wasm
+            .instantiate(
+                code_id,
+                &InstantiateMsg {
+                    max_xrpl_tx_fee: Some(1_000_000),
+                    ..instantiate_msg
+                },
+                None,
+                "label".into(),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap()
+            .data
+            .address;
+
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+            .unwrap();
+        assert_eq!(query_config.max_xrpl_tx_fee, Some(1_000_000));
+
+        // The XRPL base fee can't be updated above the max fee divided by the evidence threshold plus one
+        let update_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::UpdateXRPLBaseFee {
+                    xrpl_base_fee: 500_001,
+                },
+                &[],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(update_error
+            .to_string()
+            .contains(ContractError::InvalidMaxXRPLTxFee {}.to_string().as_str()));
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLBaseFee {
+                xrpl_base_fee: 500_000,
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
+    }
+
+    #[test]
+    fn evidences_rate_limit() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&[coin(100_000_000_000, FEE_DENOM)])
+            .unwrap();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let wasm_byte_code = std::fs::read("../contract/artifacts/coreumbridge_xrpl.wasm").unwrap();
+        let code_id = wasm
+            .store_code(&wasm_byte_code, None, &signer)
+            .unwrap()
+            .data
+            .code_id;
+
+        let instantiate_msg = InstantiateMsg {
+            owner: Addr::unchecked(signer.address()),
+            relayers: vec![relayer],
+            evidence_threshold: 1,
+            used_ticket_sequence_threshold: 50,
+            trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            bridge_xrpl_address: generate_xrpl_address(),
+            xrpl_base_fee: 10,
+            max_xrpl_tx_fee: None,
+            source_tag: None,
+            max_evidences_per_relayer_per_block: Some(0),
+            treasury_address: None,
+        };
+
+        // The limit of 0 evidences is rejected
+        let instantiate_error = wasm
+            .instantiate(
+                code_id,
+                &instantiate_msg,
+                None,
+                "label".into(),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(instantiate_error.to_string().contains(
+            ContractError::InvalidMaxEvidencesPerRelayerPerBlock {}
+                .to_string()
+                .as_str()
+        ));
+
+        let contract_addr = wasm
+            .instantiate(
+                code_id,
+                &InstantiateMsg {
+                    max_evidences_per_relayer_per_block: Some(1),
+                    ..instantiate_msg
+                },
+                None,
+                "label".into(),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap()
+            .data
+            .address;
+
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+            .unwrap();
+        assert_eq!(query_config.max_evidences_per_relayer_per_block, Some(1));
+
+        // Every execution is done in its own block, so the relayer can save one evidence in each of them
+        for _ in 0..2 {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        amount: Uint128::one(),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                &signer,
+            )
+            .unwrap();
+        }
+
+        // The counter of the previous block isn't applied to the current one
+        let query_rate_limit = wasm
+            .query::<QueryMsg, RelayerEvidenceRateLimitResponse>(
+                &contract_addr,
+                &QueryMsg::RelayerEvidenceRateLimit {
+                    relayer_address: Addr::unchecked(signer.address()),
+                },
+            )
+            .unwrap();
+        assert_eq!(
+            query_rate_limit.max_evidences_per_relayer_per_block,
+            Some(1)
+        );
+        assert_eq!(query_rate_limit.evidences_count, 0);
+    }
+
     #[test]
     fn queries() {
         let app = CoreumTestApp::new();
@@ -681,6 +995,10 @@ mod tests {
                 bridge_xrpl_address: bridge_xrpl_address.clone(),
                 bridge_state: BridgeState::Active,
                 xrpl_base_fee: 10,
+                max_xrpl_tx_fee: None,
+                source_tag: None,
+                max_evidences_per_relayer_per_block: None,
+                treasury_address: None,
             }
         );
 
@@ -705,6 +1023,9 @@ mod tests {
                 max_holding_amount: Uint128::new(XRP_DEFAULT_MAX_HOLDING_AMOUNT),
                 state: TokenState::Enabled,
                 bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+                delivery_mode: None,
             }
         );
 
@@ -945,6 +1266,7 @@ mod tests {
                     sending_precision: token.sending_precision,
                     max_holding_amount: token.max_holding_amount,
                     bridging_fee: token.bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -962,6 +1284,7 @@ mod tests {
                     sending_precision: 6,
                     max_holding_amount: Uint128::one(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -986,6 +1309,7 @@ mod tests {
                     sending_precision: -17,
                     max_holding_amount: Uint128::one(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1008,6 +1332,7 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: Uint128::one(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1028,6 +1353,7 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: test_tokens[0].max_holding_amount,
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1047,6 +1373,7 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: test_tokens[0].max_holding_amount,
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1066,6 +1393,7 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: test_tokens[0].max_holding_amount,
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1085,6 +1413,7 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: test_tokens[0].max_holding_amount,
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &signer,
@@ -1200,6 +1529,8 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision.clone(),
                     max_holding_amount: test_tokens[0].max_holding_amount.clone(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1224,6 +1555,8 @@ mod tests {
                     sending_precision: -16,
                     max_holding_amount: test_tokens[0].max_holding_amount.clone(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1246,6 +1579,8 @@ mod tests {
                     sending_precision: 16,
                     max_holding_amount: test_tokens[0].max_holding_amount.clone(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1268,6 +1603,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1288,6 +1625,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1308,6 +1647,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1328,6 +1669,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1348,6 +1691,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1368,6 +1713,8 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision.clone(),
                     max_holding_amount: test_tokens[0].max_holding_amount.clone(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &coins(20_000_000, FEE_DENOM),
                 &signer,
@@ -1388,6 +1735,8 @@ mod tests {
                     sending_precision: test_tokens[1].sending_precision.clone(),
                     max_holding_amount: test_tokens[1].max_holding_amount.clone(),
                     bridging_fee: test_tokens[1].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1408,6 +1757,8 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision,
                     max_holding_amount: test_tokens[0].max_holding_amount,
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1458,6 +1809,8 @@ mod tests {
                     sending_precision: token.sending_precision,
                     max_holding_amount: token.max_holding_amount,
                     bridging_fee: token.bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1483,6 +1836,8 @@ mod tests {
                     sending_precision: extra_token.sending_precision,
                     max_holding_amount: extra_token.max_holding_amount,
                     bridging_fee: extra_token.bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1520,6 +1875,8 @@ mod tests {
                     sending_precision: test_tokens[0].sending_precision.clone(),
                     max_holding_amount: test_tokens[0].max_holding_amount.clone(),
                     bridging_fee: test_tokens[0].bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &signer,
@@ -1573,40 +1930,25 @@ mod tests {
     }
 
     #[test]
-    fn send_xrpl_originated_tokens_from_xrpl_to_coreum() {
+    fn register_xrpl_token_with_ibc_delivery_mode() {
         let app = CoreumTestApp::new();
-        let accounts_number = 4;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
             .unwrap();
 
-        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
-        let receiver = accounts.get((accounts_number - 2) as usize).unwrap();
-        let xrpl_addresses = vec![generate_xrpl_address(), generate_xrpl_address()];
-
-        let xrpl_pub_keys = vec![generate_xrpl_pub_key(), generate_xrpl_pub_key()];
-
-        let mut relayer_accounts = vec![];
-        let mut relayers = vec![];
-
-        for i in 0..accounts_number - 2 {
-            relayer_accounts.push(accounts.get(i as usize).unwrap());
-            relayers.push(Relayer {
-                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
-                xrpl_address: xrpl_addresses[i as usize].to_string(),
-                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
-            });
-        }
-
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        // Test with 1 relayer and 1 evidence threshold first
         let contract_addr = store_and_instantiate(
             &wasm,
-            signer,
+            &signer,
             Addr::unchecked(signer.address()),
-            vec![relayers[0].clone()],
+            vec![relayer],
             1,
             2,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
@@ -1615,15 +1957,205 @@ mod tests {
             10,
         );
 
-        let test_token = XRPLToken {
-            issuer: generate_xrpl_address(),
-            currency: "USD".to_string(),
+        let issuer = generate_xrpl_address();
+        let currency = "USD".to_string();
+        let register_msg = |delivery_mode: XRPLTokenDeliveryMode| ExecuteMsg::RegisterXRPLToken {
+            issuer: issuer.clone(),
+            currency: currency.clone(),
             sending_precision: 15,
-            max_holding_amount: Uint128::new(50000),
+            max_holding_amount: Uint128::new(100000),
             bridging_fee: Uint128::zero(),
+            max_sends_per_address_per_day: None,
+            delivery_mode: Some(delivery_mode),
         };
 
-        // Set up enough tickets first to allow registering tokens.
+        // Registering a token with an invalid IBC channel ID should fail
+        for ibc_channel_id in ["", "channel-", "channel-x", "connection-0"] {
+            let channel_error = wasm
+                .execute::<ExecuteMsg>(
+                    &contract_addr,
+                    &register_msg(XRPLTokenDeliveryMode::IBC {
+                        ibc_channel_id: ibc_channel_id.to_string(),
+                        ibc_transfer_timeout_seconds: 600,
+                    }),
+                    &query_issue_fee(&asset_ft),
+                    &signer,
+                )
+                .unwrap_err();
+
+            assert!(channel_error
+                .to_string()
+                .contains(ContractError::InvalidIBCChannelID {}.to_string().as_str()));
+        }
+
+        // Registering a token with a zero IBC transfer timeout should fail
+        let timeout_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &register_msg(XRPLTokenDeliveryMode::IBC {
+                    ibc_channel_id: "channel-0".to_string(),
+                    ibc_transfer_timeout_seconds: 0,
+                }),
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(timeout_error.to_string().contains(
+            ContractError::InvalidIBCTransferTimeout {}
+                .to_string()
+                .as_str()
+        ));
+
+        let delivery_mode = XRPLTokenDeliveryMode::IBC {
+            ibc_channel_id: "channel-0".to_string(),
+            ibc_transfer_timeout_seconds: 600,
+        };
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &register_msg(delivery_mode.clone()),
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
+
+        // The delivery mode is stored with the token, the XRP token keeps the default one
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+        let token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == issuer && t.currency == currency)
+            .unwrap();
+        assert_eq!(token.delivery_mode, Some(delivery_mode));
+        let xrp_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == XRP_ISSUER && t.currency == XRP_CURRENCY)
+            .unwrap();
+        assert_eq!(xrp_token.delivery_mode, None);
+
+        // Sending evidences with an invalid recipient on the IBC-connected chain should fail
+        for destination_chain_recipient in ["", "cosmos1 recipient"] {
+            let recipient_error = wasm
+                .execute::<ExecuteMsg>(
+                    &contract_addr,
+                    &ExecuteMsg::SaveEvidence {
+                        evidence: Evidence::XRPLToCoreumTransfer {
+                            tx_hash: generate_hash(),
+                            issuer: issuer.clone(),
+                            currency: currency.clone(),
+                            amount: Uint128::one(),
+                            recipient: Addr::unchecked(signer.address()),
+                            destination_chain_recipient: Some(
+                                destination_chain_recipient.to_string(),
+                            ),
+                        },
+                    },
+                    &[],
+                    &signer,
+                )
+                .unwrap_err();
+
+            assert!(recipient_error.to_string().contains(
+                ContractError::InvalidDestinationChainRecipient {}
+                    .to_string()
+                    .as_str()
+            ));
+        }
+
+        // Sending an IBC transfer result for a transaction without a pending IBC transfer should fail
+        let result_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::IBCTransferResult {
+                        tx_hash: generate_hash(),
+                        success: false,
+                    },
+                },
+                &[],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(result_error.to_string().contains(
+            ContractError::PendingIBCTransferNotFound {}
+                .to_string()
+                .as_str()
+        ));
+
+        let query_pending_ibc_transfers = wasm
+            .query::<QueryMsg, PendingIBCTransfersResponse>(
+                &contract_addr,
+                &QueryMsg::PendingIBCTransfers {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+        assert!(query_pending_ibc_transfers.pending_ibc_transfers.is_empty());
+    }
+
+    #[test]
+    fn send_xrpl_originated_tokens_from_xrpl_to_coreum() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 4;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
+
+        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
+        let receiver = accounts.get((accounts_number - 2) as usize).unwrap();
+        let xrpl_addresses = vec![generate_xrpl_address(), generate_xrpl_address()];
+
+        let xrpl_pub_keys = vec![generate_xrpl_pub_key(), generate_xrpl_pub_key()];
+
+        let mut relayer_accounts = vec![];
+        let mut relayers = vec![];
+
+        for i in 0..accounts_number - 2 {
+            relayer_accounts.push(accounts.get(i as usize).unwrap());
+            relayers.push(Relayer {
+                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
+                xrpl_address: xrpl_addresses[i as usize].to_string(),
+                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
+            });
+        }
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        // Test with 1 relayer and 1 evidence threshold first
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            signer,
+            Addr::unchecked(signer.address()),
+            vec![relayers[0].clone()],
+            1,
+            2,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            10,
+        );
+
+        let test_token = XRPLToken {
+            issuer: generate_xrpl_address(),
+            currency: "USD".to_string(),
+            sending_precision: 15,
+            max_holding_amount: Uint128::new(50000),
+            bridging_fee: Uint128::zero(),
+        };
+
+        // Set up enough tickets first to allow registering tokens.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
@@ -1661,6 +2193,8 @@ mod tests {
                 sending_precision: test_token.sending_precision.clone(),
                 max_holding_amount: test_token.max_holding_amount.clone(),
                 bridging_fee: test_token.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
             &query_issue_fee(&asset_ft),
             signer,
@@ -1699,6 +2233,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -1749,6 +2284,7 @@ mod tests {
                     currency: test_token.currency.clone(),
                     amount: amount.clone(),
                     recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -1776,6 +2312,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(contract_addr.clone()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -1859,6 +2396,8 @@ mod tests {
                 sending_precision: test_token.sending_precision,
                 max_holding_amount: test_token.max_holding_amount,
                 bridging_fee: test_token.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
             &query_issue_fee(&asset_ft),
             signer,
@@ -1938,6 +2477,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -1960,6 +2500,7 @@ mod tests {
                         currency: "not_registered".to_string(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -1982,6 +2523,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: Uint128::new(0),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -2003,6 +2545,7 @@ mod tests {
                     currency: test_token.currency.clone(),
                     amount: amount.clone(),
                     recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -2031,6 +2574,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -2054,6 +2598,7 @@ mod tests {
                     currency: test_token.currency.clone(),
                     amount: amount.clone(),
                     recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -2082,6 +2627,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -2107,6 +2653,7 @@ mod tests {
                         currency: test_token.currency.clone(),
                         amount: new_amount.clone(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -2122,48 +2669,47 @@ mod tests {
     }
 
     #[test]
-    fn send_coreum_originated_tokens_from_xrpl_to_coreum() {
+    fn cash_xrpl_check_for_bridge() {
         let app = CoreumTestApp::new();
-        let accounts_number = 3;
         let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), 3)
             .unwrap();
 
-        let signer = accounts.get(0).unwrap();
-        let sender = accounts.get(1).unwrap();
-        let relayer_account = accounts.get(2).unwrap();
+        let signer = accounts.get(2).unwrap();
+        let receiver = accounts.get(1).unwrap();
+        let relayer_account = accounts.get(0).unwrap();
         let relayer = Relayer {
             coreum_address: Addr::unchecked(relayer_account.address()),
             xrpl_address: generate_xrpl_address(),
             xrpl_pub_key: generate_xrpl_pub_key(),
         };
 
-        let xrpl_receiver_address = generate_xrpl_address();
-        let bridge_xrpl_address = generate_xrpl_address();
-
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
+        let bridge_xrpl_address = generate_xrpl_address();
 
         let contract_addr = store_and_instantiate(
             &wasm,
             signer,
             Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
+            vec![relayer],
             1,
-            9,
+            2,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
             bridge_xrpl_address.clone(),
             10,
         );
 
-        // Add enough tickets for all our test operations
+        let issuer = generate_xrpl_address();
+        let currency = "USD".to_string();
 
+        // Set up enough tickets first to allow registering tokens.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(10),
+                number_of_tickets: Some(3),
             },
             &vec![],
             &signer,
@@ -2179,7 +2725,7 @@ mod tests {
                     ticket_sequence: None,
                     transaction_result: TransactionResult::Accepted,
                     operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..11).collect()),
+                        tickets: Some((1..4).collect()),
                     }),
                 },
             },
@@ -2188,156 +2734,141 @@ mod tests {
         )
         .unwrap();
 
-        // Let's issue a token where decimals are less than an XRPL token decimals to the sender and register it.
-        let asset_ft = AssetFT::new(&app);
-        let symbol = "TEST".to_string();
-        let subunit = "utest".to_string();
-        let decimals = 6;
-        let initial_amount = Uint128::new(100000000000000000000);
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: signer.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32, FREEZING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
-                },
-                &signer,
-            )
-            .unwrap();
-
-        let denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
-
-        // Send all initial amount tokens to the sender so that we can correctly test freezing without sending to the issuer
-        let bank = Bank::new(&app);
-        bank.send(
-            MsgSend {
-                from_address: signer.address(),
-                to_address: sender.address(),
-                amount: vec![BaseCoin {
-                    amount: initial_amount.to_string(),
-                    denom: denom.to_string(),
-                }],
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: issuer.clone(),
+                currency: currency.clone(),
+                sending_precision: 15,
+                max_holding_amount: Uint128::new(50000),
+                bridging_fee: Uint128::new(10),
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
-            &signer,
+            &query_issue_fee(&asset_ft),
+            signer,
         )
         .unwrap();
 
+        // Activate the token
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: denom.clone(),
-                decimals,
-                sending_precision: 5,
-                max_holding_amount: Uint128::new(100000000000000000000),
-                bridging_fee: Uint128::zero(),
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
             },
-            &vec![],
-            &signer,
+            &[],
+            relayer_account,
         )
         .unwrap();
 
-        // It should truncate 1 because sending precision is 5
-        let amount_to_send = Uint128::new(1000001);
+        let denom = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap()
+            .tokens
+            .iter()
+            .find(|t| t.issuer == issuer && t.currency == currency)
+            .unwrap()
+            .coreum_denom
+            .clone();
 
-        // If we try to send an amount in the optional field it should fail.
-        let send_error = wasm
+        let check_create_tx_hash = generate_hash();
+        let check_id = generate_hash();
+        let amount = Uint128::new(100);
+        let check_cash_evidence = Evidence::XRPLCheckCash {
+            tx_hash: check_create_tx_hash.clone(),
+            check_id: check_id.clone(),
+            issuer: issuer.clone(),
+            currency: currency.clone(),
+            amount,
+            sender: generate_xrpl_address(),
+            recipient: Addr::unchecked(receiver.address()),
+        };
+
+        // The check of the token issued by the bridge account can't be cashed
+        let check_cash_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(Uint128::new(100)),
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLCheckCash {
+                        tx_hash: generate_hash(),
+                        check_id: generate_hash(),
+                        issuer: bridge_xrpl_address.clone(),
+                        currency: currency.clone(),
+                        amount,
+                        sender: generate_xrpl_address(),
+                        recipient: Addr::unchecked(receiver.address()),
+                    },
                 },
-                &coins(amount_to_send.u128(), denom.clone()),
-                &sender,
+                &[],
+                relayer_account,
             )
             .unwrap_err();
 
-        assert!(send_error.to_string().contains(
-            ContractError::DeliverAmountIsProhibited {}
+        assert!(check_cash_error.to_string().contains(
+            ContractError::CheckCashTokenNotSupported {}
                 .to_string()
                 .as_str()
         ));
 
-        // If we try to send an amount that will become an invalid XRPL amount, it should fail
-        let send_error = wasm
+        // The check exceeding the max holding amount isn't cashed
+        let check_cash_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLCheckCash {
+                        tx_hash: generate_hash(),
+                        check_id: generate_hash(),
+                        issuer: issuer.clone(),
+                        currency: currency.clone(),
+                        amount: Uint128::new(50001),
+                        sender: generate_xrpl_address(),
+                        recipient: Addr::unchecked(receiver.address()),
+                    },
                 },
-                &coins(10000000000000000010, denom.clone()), // Nothing is truncated, and after transforming into XRPL amount it will have more than 17 digits
-                &sender,
+                &[],
+                relayer_account,
             )
             .unwrap_err();
 
-        assert!(send_error
-            .to_string()
-            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
+        assert!(check_cash_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
 
-        // Try to bridge the token to the xrpl receiver address so that we can send it back.
+        // The threshold is reached, so the check cash operation is created
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
+            &ExecuteMsg::SaveEvidence {
+                evidence: check_cash_evidence.clone(),
             },
-            &coins(amount_to_send.u128(), denom.clone()),
-            &sender,
+            &[],
+            relayer_account,
         )
         .unwrap();
 
-        // Check balance of sender and contract
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            initial_amount
-                .checked_sub(amount_to_send)
-                .unwrap()
-                .to_string()
-        );
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, amount_to_send.to_string());
-
-        // Get the token information
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let coreum_originated_token = query_coreum_tokens
-            .tokens
-            .iter()
-            .find(|t| t.denom == denom)
-            .unwrap();
-
-        // Confirm the operation to remove it from pending operations.
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -2348,335 +2879,263 @@ mod tests {
             )
             .unwrap();
 
-        let amount_truncated_and_converted = Uint128::new(1000000000000000); // 100001 -> truncate -> 100000 -> convert -> 1e15
         assert_eq!(query_pending_operations.operations.len(), 1);
         assert_eq!(
             query_pending_operations.operations[0].operation_type,
-            OperationType::CoreumToXRPLTransfer {
-                issuer: bridge_xrpl_address.clone(),
-                currency: coreum_originated_token.xrpl_currency.clone(),
-                amount: amount_truncated_and_converted,
-                max_amount: Some(amount_truncated_and_converted),
-                sender: Addr::unchecked(sender.address()),
-                recipient: xrpl_receiver_address.clone(),
+            OperationType::CheckCash {
+                check_id: check_id.clone(),
+                issuer: issuer.clone(),
+                currency: currency.clone(),
+                amount,
+                recipient: Addr::unchecked(receiver.address()),
             }
         );
+        assert!(query_pending_operations.operations[0]
+            .ticket_sequence
+            .is_some());
+
+        // The same check can't be cashed twice
+        let check_cash_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: check_cash_evidence,
+                },
+                &[],
+                relayer_account,
+            )
+            .unwrap_err();
+
+        assert!(check_cash_error.to_string().contains(
+            ContractError::OperationAlreadyExecuted {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Nothing is minted until the check is cashed
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "0".to_string());
 
-        let tx_hash = generate_hash();
-        // Reject the operation, therefore the tokens should be stored in the pending refunds (except for truncated amount).
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: query_pending_operations.operations[0].account_sequence,
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
                     ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Rejected,
+                    transaction_result: TransactionResult::Accepted,
                     operation_result: None,
                 },
             },
-            &vec![],
+            &[],
             relayer_account,
         )
         .unwrap();
 
-        // Truncated amount and amount to be refunded will stay in the contract until relayers and users to be refunded claim
+        // The cashed amount is minted for the receiver after the bridging fee
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
+                account: receiver.address(),
                 denom: denom.clone(),
             })
             .unwrap();
-        assert_eq!(request_balance.balance, amount_to_send.to_string());
 
-        // If we try to query pending refunds for any address that has no pending refunds, it should return an empty array
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        assert_eq!(request_balance.balance, "90".to_string());
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked("any_address"),
+                &QueryMsg::PendingOperations {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_pending_refunds.pending_refunds, vec![]);
+        assert!(query_pending_operations.operations.is_empty());
+    }
 
-        // Let's verify the pending refunds and try to claim them
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
+    #[test]
+    fn send_coreum_originated_tokens_from_xrpl_to_coreum() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 3;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
             .unwrap();
 
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
-        assert_eq!(
-            query_pending_refunds.pending_refunds[0].xrpl_tx_hash,
-            Some(tx_hash)
-        );
-        // Truncated amount (1) is not refundable
-        assert_eq!(
-            query_pending_refunds.pending_refunds[0].coin,
-            coin(
-                amount_to_send.checked_sub(Uint128::one()).unwrap().u128(),
-                denom.clone()
-            )
-        );
+        let signer = accounts.get(0).unwrap();
+        let sender = accounts.get(1).unwrap();
+        let relayer_account = accounts.get(2).unwrap();
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(relayer_account.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        // Trying to claim a refund with an invalid pending refund operation id should fail
-        let claim_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::ClaimRefund {
-                    pending_refund_id: "random_id".to_string(),
-                },
-                &[],
-                &sender,
-            )
-            .unwrap_err();
+        let xrpl_receiver_address = generate_xrpl_address();
+        let bridge_xrpl_address = generate_xrpl_address();
 
-        assert!(claim_error
-            .to_string()
-            .contains(ContractError::PendingRefundNotFound {}.to_string().as_str()));
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            9,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            bridge_xrpl_address.clone(),
+            10,
+        );
+
+        // Add enough tickets for all our test operations
 
-        // Try to claim a pending refund with a valid pending refund operation id but not as a different user, should also fail
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(10),
             },
-            &[],
+            &vec![],
             &signer,
         )
-        .unwrap_err();
-
-        // Let's freeze the token to verify that claiming will fail
-        asset_ft
-            .freeze(
-                MsgFreeze {
-                    sender: signer.address(),
-                    account: contract_addr.clone(),
-                    coin: Some(BaseCoin {
-                        denom: denom.clone(),
-                        amount: "100000".to_string(),
-                    }),
-                },
-                &signer,
-            )
-            .unwrap();
+        .unwrap();
 
-        // Can't claim because token is frozen
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
-            },
-            &[],
-            &sender,
-        )
-        .unwrap_err();
-
-        // Let's unfreeze token so we can claim
-        asset_ft
-            .unfreeze(
-                MsgUnfreeze {
-                    sender: signer.address(),
-                    account: contract_addr.clone(),
-                    coin: Some(BaseCoin {
-                        denom: denom.clone(),
-                        amount: "100000".to_string(),
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..11).collect()),
                     }),
                 },
-                &signer,
-            )
-            .unwrap();
-
-        // Let's claim our pending refund
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
             },
-            &[],
-            &sender,
+            &vec![],
+            relayer_account,
         )
         .unwrap();
 
-        // Verify balance of sender (to check it was correctly refunded) and verify that the amount refunded was removed from pending refunds
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            initial_amount
-                .checked_sub(Uint128::one()) // truncated amount
-                .unwrap()
-                .to_string()
-        );
-
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
-                    start_after_key: None,
-                    limit: None,
+        // Let's issue a token where decimals are less than an XRPL token decimals to the sender and register it.
+        let asset_ft = AssetFT::new(&app);
+        let symbol = "TEST".to_string();
+        let subunit = "utest".to_string();
+        let decimals = 6;
+        let initial_amount = Uint128::new(100000000000000000000);
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: signer.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32, FREEZING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
                 },
+                &signer,
             )
             .unwrap();
 
-        // We verify our pending refund operation was removed from the pending refunds
-        assert!(query_pending_refunds.pending_refunds.is_empty());
+        let denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
 
-        // Try to send again
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
+        // Send all initial amount tokens to the sender so that we can correctly test freezing without sending to the issuer
+        let bank = Bank::new(&app);
+        bank.send(
+            MsgSend {
+                from_address: signer.address(),
+                to_address: sender.address(),
+                amount: vec![BaseCoin {
+                    amount: initial_amount.to_string(),
+                    denom: denom.to_string(),
+                }],
             },
-            &coins(amount_to_send.u128(), denom.clone()),
-            &sender,
+            &signer,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        // Send successfull evidence to remove from queue (tokens should be released on XRPL to the receiver)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: query_pending_operations.operations[0].account_sequence,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
-                },
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: denom.clone(),
+                decimals,
+                sending_precision: 5,
+                max_holding_amount: Uint128::new(100000000000000000000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
             },
             &vec![],
-            relayer_account,
+            &signer,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 0);
-
-        // Test sending the amount back from XRPL to Coreum
-        // 10000000000 (1e10) is the minimum we can send back (15 - 5 (sending precision))
-        let amount_to_send_back = Uint128::new(10000000000);
+        // It should truncate 1 because sending precision is 5
+        let amount_to_send = Uint128::new(1000001);
 
-        // If we send the token with a different issuer (not multisig address) it should fail
-        let transfer_error = wasm
+        // If we try to send an amount in the optional field it should fail.
+        let send_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: generate_xrpl_address(),
-                        currency: coreum_originated_token.xrpl_currency.clone(),
-                        amount: amount_to_send_back.clone(),
-                        recipient: Addr::unchecked(sender.address()),
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: Some(Uint128::new(100)),
                 },
-                &[],
-                relayer_account,
+                &coins(amount_to_send.u128(), denom.clone()),
+                &sender,
             )
             .unwrap_err();
 
-        assert!(transfer_error
-            .to_string()
-            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
+        assert!(send_error.to_string().contains(
+            ContractError::DeliverAmountIsProhibited {}
+                .to_string()
+                .as_str()
+        ));
 
-        // If we send the token with a different currency (one that is not the one in the registered token list) it should fail
-        let transfer_error = wasm
+        // If we try to send an amount that will become an invalid XRPL amount, it should fail
+        let send_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: bridge_xrpl_address.clone(),
-                        currency: "invalid_currency".to_string(),
-                        amount: amount_to_send_back.clone(),
-                        recipient: Addr::unchecked(sender.address()),
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: None,
                 },
-                &[],
-                relayer_account,
+                &coins(10000000000000000010, denom.clone()), // Nothing is truncated, and after transforming into XRPL amount it will have more than 17 digits
+                &sender,
             )
             .unwrap_err();
 
-        assert!(transfer_error
+        assert!(send_error
             .to_string()
-            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
+            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
 
-        // Sending under the minimum should fail (minimum - 1)
-        let transfer_error = wasm
+        // Try to bridge the token to the xrpl receiver address so that we can send it back.
+        let send_result = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: bridge_xrpl_address.clone(),
-                        currency: coreum_originated_token.xrpl_currency.clone(),
-                        amount: amount_to_send_back.checked_sub(Uint128::one()).unwrap(),
-                        recipient: Addr::unchecked(sender.address()),
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: None,
                 },
-                &[],
-                relayer_account,
+                &coins(amount_to_send.u128(), denom.clone()),
+                &sender,
             )
-            .unwrap_err();
-
-        assert!(transfer_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Sending the right evidence should move tokens from the contract to the sender's account
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: bridge_xrpl_address.clone(),
-                    currency: coreum_originated_token.xrpl_currency.clone(),
-                    amount: amount_to_send_back.clone(),
-                    recipient: Addr::unchecked(sender.address()),
-                },
-            },
-            &[],
-            relayer_account,
-        )
-        .unwrap();
+            .unwrap();
 
         // Check balance of sender and contract
         let request_balance = asset_ft
@@ -2689,11 +3148,7 @@ mod tests {
         assert_eq!(
             request_balance.balance,
             initial_amount
-                .checked_sub(amount_to_send) // initial amount
-                .unwrap()
-                .checked_sub(Uint128::one()) // amount lost during truncation of first rejection
-                .unwrap()
-                .checked_add(Uint128::new(10)) // Amount that we sent back (10) after conversion, the minimum
+                .checked_sub(amount_to_send)
                 .unwrap()
                 .to_string()
         );
@@ -2705,112 +3160,24 @@ mod tests {
             })
             .unwrap();
 
-        assert_eq!(
-            request_balance.balance,
-            amount_to_send
-                .checked_add(Uint128::one()) // Truncated amount staying in contract
-                .unwrap()
-                .checked_sub(Uint128::new(10))
-                .unwrap()
-                .to_string()
-        );
+        assert_eq!(request_balance.balance, amount_to_send.to_string());
 
-        // Now let's issue a token where decimals are more than an XRPL token decimals to the sender and register it.
-        let symbol = "TEST2".to_string();
-        let subunit = "utest2".to_string();
-        let decimals = 20;
-        let initial_amount = Uint128::new(200000000000000000000); // 2e20
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: sender.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
+        // Get the token information
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
+                &contract_addr,
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &sender,
             )
             .unwrap();
 
-        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: denom.clone(),
-                decimals,
-                sending_precision: 10,
-                max_holding_amount: Uint128::new(200000000000000000000), //2e20
-                bridging_fee: Uint128::zero(),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        // It should truncate and remove all 9s because they are under precision
-        let amount_to_send = Uint128::new(100000000019999999999);
-
-        // Bridge the token to the xrpl receiver address so that we can send it back.
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
-            },
-            &coins(amount_to_send.u128(), denom.clone()),
-            &sender,
-        )
-        .unwrap();
-
-        // Check balance of sender and contract
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            initial_amount
-                .checked_sub(amount_to_send)
-                .unwrap()
-                .to_string()
-        );
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, amount_to_send.to_string());
-
-        // Get the token information
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let coreum_originated_token = query_coreum_tokens
-            .tokens
-            .iter()
-            .find(|t| t.denom == denom)
-            .unwrap();
+        let coreum_originated_token = query_coreum_tokens
+            .tokens
+            .iter()
+            .find(|t| t.denom == denom)
+            .unwrap();
 
         // Confirm the operation to remove it from pending operations.
         let query_pending_operations = wasm
@@ -2823,7 +3190,7 @@ mod tests {
             )
             .unwrap();
 
-        let amount_truncated_and_converted = Uint128::new(1000000000100000); // 100000000019999999999 -> truncate -> 100000000010000000000  -> convert -> 1000000000100000
+        let amount_truncated_and_converted = Uint128::new(1000000000000000); // 100001 -> truncate -> 100000 -> convert -> 1e15
         assert_eq!(query_pending_operations.operations.len(), 1);
         assert_eq!(
             query_pending_operations.operations[0].operation_type,
@@ -2837,12 +3204,52 @@ mod tests {
             }
         );
 
-        // Reject the operation so that tokens are sent back to sender
+        // The operation creation is announced with the event
+        let operation_created_event = send_result
+            .events
+            .iter()
+            .find(|e| e.ty == "wasm-operation_created")
+            .unwrap();
+        let expected_attributes = [
+            (
+                "operation_id",
+                query_pending_operations.operations[0]
+                    .ticket_sequence
+                    .unwrap()
+                    .to_string(),
+            ),
+            (
+                "operation_unique_id",
+                query_pending_operations.operations[0].id.clone(),
+            ),
+            (
+                "operation_type",
+                query_pending_operations.operations[0]
+                    .operation_type
+                    .as_str()
+                    .to_string(),
+            ),
+            (
+                "xrpl_base_fee",
+                query_pending_operations.operations[0]
+                    .xrpl_base_fee
+                    .to_string(),
+            ),
+        ];
+        for (key, value) in expected_attributes {
+            assert!(operation_created_event
+                .attributes
+                .iter()
+                .any(|a| a.key == key && a.value == value));
+        }
+
+        let tx_hash = generate_hash();
+        // Reject the operation, therefore the tokens should be stored in the pending refunds (except for truncated amount).
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
+                    tx_hash: Some(tx_hash.clone()),
                     account_sequence: query_pending_operations.operations[0].account_sequence,
                     ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
                     transaction_result: TransactionResult::Rejected,
@@ -2854,23 +3261,7 @@ mod tests {
         )
         .unwrap();
 
-        // Truncated amount won't be sent back (goes to relayer fees) and the rest will be stored in refundable array for the user to claim
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            initial_amount
-                .checked_sub(amount_to_send)
-                .unwrap()
-                .to_string()
-        );
-
-        // Truncated amount and refundable fees will stay in contract
+        // Truncated amount and amount to be refunded will stay in the contract until relayers and users to be refunded claim
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: contract_addr.clone(),
@@ -2879,7 +3270,21 @@ mod tests {
             .unwrap();
         assert_eq!(request_balance.balance, amount_to_send.to_string());
 
-        // If we query the refundable tokens that the user can claim, we should see the amount that was truncated is claimable
+        // If we try to query pending refunds for any address that has no pending refunds, it should return an empty array
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked("any_address"),
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_refunds.pending_refunds, vec![]);
+
+        // Let's verify the pending refunds and try to claim them
         let query_pending_refunds = wasm
             .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
@@ -2891,20 +3296,89 @@ mod tests {
             )
             .unwrap();
 
-        // We verify that these tokens are refundable
         assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].xrpl_tx_hash,
+            Some(tx_hash)
+        );
+        // Truncated amount (1) is not refundable
         assert_eq!(
             query_pending_refunds.pending_refunds[0].coin,
             coin(
-                amount_to_send
-                    .checked_sub(Uint128::new(9999999999)) // Amount truncated is not refunded to user
-                    .unwrap()
-                    .u128(),
+                amount_to_send.checked_sub(Uint128::one()).unwrap().u128(),
                 denom.clone()
             )
         );
 
-        // Claim it, should work
+        // Trying to claim a refund with an invalid pending refund operation id should fail
+        let claim_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::ClaimRefund {
+                    pending_refund_id: "random_id".to_string(),
+                },
+                &[],
+                &sender,
+            )
+            .unwrap_err();
+
+        assert!(claim_error
+            .to_string()
+            .contains(ContractError::PendingRefundNotFound {}.to_string().as_str()));
+
+        // Try to claim a pending refund with a valid pending refund operation id but not as a different user, should also fail
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            },
+            &[],
+            &signer,
+        )
+        .unwrap_err();
+
+        // Let's freeze the token to verify that claiming will fail
+        asset_ft
+            .freeze(
+                MsgFreeze {
+                    sender: signer.address(),
+                    account: contract_addr.clone(),
+                    coin: Some(BaseCoin {
+                        denom: denom.clone(),
+                        amount: "100000".to_string(),
+                    }),
+                },
+                &signer,
+            )
+            .unwrap();
+
+        // Can't claim because token is frozen
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            },
+            &[],
+            &sender,
+        )
+        .unwrap_err();
+
+        // Let's unfreeze token so we can claim
+        asset_ft
+            .unfreeze(
+                MsgUnfreeze {
+                    sender: signer.address(),
+                    account: contract_addr.clone(),
+                    coin: Some(BaseCoin {
+                        denom: denom.clone(),
+                        amount: "100000".to_string(),
+                    }),
+                },
+                &signer,
+            )
+            .unwrap();
+
+        // Let's claim our pending refund
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::ClaimRefund {
@@ -2915,7 +3389,22 @@ mod tests {
         )
         .unwrap();
 
-        // pending refunds should now be empty
+        // Verify balance of sender (to check it was correctly refunded) and verify that the amount refunded was removed from pending refunds
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(Uint128::one()) // truncated amount
+                .unwrap()
+                .to_string()
+        );
+
         let query_pending_refunds = wasm
             .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
@@ -2927,7 +3416,7 @@ mod tests {
             )
             .unwrap();
 
-        // We verify that there are no pending refunds left
+        // We verify our pending refund operation was removed from the pending refunds
         assert!(query_pending_refunds.pending_refunds.is_empty());
 
         // Try to send again
@@ -2982,8 +3471,8 @@ mod tests {
         assert_eq!(query_pending_operations.operations.len(), 0);
 
         // Test sending the amount back from XRPL to Coreum
-        // 100000 (1e5) is the minimum we can send back (15 - 10 (sending precision))
-        let amount_to_send_back = Uint128::new(100000);
+        // 10000000000 (1e10) is the minimum we can send back (15 - 5 (sending precision))
+        let amount_to_send_back = Uint128::new(10000000000);
 
         // If we send the token with a different issuer (not multisig address) it should fail
         let transfer_error = wasm
@@ -2996,6 +3485,7 @@ mod tests {
                         currency: coreum_originated_token.xrpl_currency.clone(),
                         amount: amount_to_send_back.clone(),
                         recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -3018,6 +3508,7 @@ mod tests {
                         currency: "invalid_currency".to_string(),
                         amount: amount_to_send_back.clone(),
                         recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -3040,6 +3531,7 @@ mod tests {
                         currency: coreum_originated_token.xrpl_currency.clone(),
                         amount: amount_to_send_back.checked_sub(Uint128::one()).unwrap(),
                         recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -3063,6 +3555,7 @@ mod tests {
                     currency: coreum_originated_token.xrpl_currency.clone(),
                     amount: amount_to_send_back.clone(),
                     recipient: Addr::unchecked(sender.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -3083,9 +3576,9 @@ mod tests {
             initial_amount
                 .checked_sub(amount_to_send) // initial amount
                 .unwrap()
-                .checked_sub(Uint128::new(9999999999)) // Amount lost during first truncation that was rejected
+                .checked_sub(Uint128::one()) // amount lost during truncation of first rejection
                 .unwrap()
-                .checked_add(Uint128::new(10000000000)) // Amount that we sent back after conversion (1e10), the minimum
+                .checked_add(Uint128::new(10)) // Amount that we sent back (10) after conversion, the minimum
                 .unwrap()
                 .to_string()
         );
@@ -3100,187 +3593,241 @@ mod tests {
         assert_eq!(
             request_balance.balance,
             amount_to_send
-                .checked_add(Uint128::new(9999999999)) // Amount that was kept during truncation of rejected operation
+                .checked_add(Uint128::one()) // Truncated amount staying in contract
                 .unwrap()
-                .checked_sub(Uint128::new(10000000000)) // Amount sent from XRPL to the user
+                .checked_sub(Uint128::new(10))
                 .unwrap()
                 .to_string()
         );
-    }
 
-    #[test]
-    fn send_from_coreum_to_xrpl() {
-        let app = CoreumTestApp::new();
-        let accounts_number = 3;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+        // Now let's issue a token where decimals are more than an XRPL token decimals to the sender and register it.
+        let symbol = "TEST2".to_string();
+        let subunit = "utest2".to_string();
+        let decimals = 20;
+        let initial_amount = Uint128::new(200000000000000000000); // 2e20
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: sender.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
+                },
+                &sender,
+            )
             .unwrap();
 
-        let signer = accounts.get(0).unwrap();
-        let sender = accounts.get(1).unwrap();
-        let relayer_account = accounts.get(2).unwrap();
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(relayer_account.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
-
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
-        let xrpl_base_fee = 10;
-        let multisig_address = generate_xrpl_address();
-
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            signer,
-            Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
-            1,
-            10,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            multisig_address.clone(),
-            xrpl_base_fee,
-        );
-
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
-                &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let denom_xrp = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == XRP_ISSUER && t.currency == XRP_CURRENCY)
-            .unwrap()
-            .coreum_denom
-            .clone();
-
-        // Add enough tickets for all our test operations
+        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(11),
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: denom.clone(),
+                decimals,
+                sending_precision: 10,
+                max_holding_amount: Uint128::new(200000000000000000000), //2e20
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
+        // It should truncate and remove all 9s because they are under precision
+        let amount_to_send = Uint128::new(100000000019999999999);
+
+        // Bridge the token to the xrpl receiver address so that we can send it back.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..12).collect()),
-                    }),
-                },
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
             },
-            &vec![],
-            relayer_account,
+            &coins(amount_to_send.u128(), denom.clone()),
+            &sender,
         )
         .unwrap();
 
-        // If we query processed Txes with this tx_hash it should return true
-        let query_processed_tx = wasm
-            .query::<QueryMsg, bool>(
+        // Check balance of sender and contract
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(amount_to_send)
+                .unwrap()
+                .to_string()
+        );
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, amount_to_send.to_string());
+
+        // Get the token information
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &QueryMsg::ProcessedTx {
-                    hash: tx_hash.to_uppercase(),
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_processed_tx, true);
+        let coreum_originated_token = query_coreum_tokens
+            .tokens
+            .iter()
+            .find(|t| t.denom == denom)
+            .unwrap();
 
-        // If we query something that is not processed it should return false
-        let query_processed_tx = wasm
-            .query::<QueryMsg, bool>(
+        // Confirm the operation to remove it from pending operations.
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &QueryMsg::ProcessedTx {
-                    hash: generate_hash(),
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_processed_tx, false);
-
-        // *** Test sending XRP back to XRPL, which is already enabled so we can bridge it directly ***
+        let amount_truncated_and_converted = Uint128::new(1000000000100000); // 100000000019999999999 -> truncate -> 100000000010000000000  -> convert -> 1000000000100000
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0].operation_type,
+            OperationType::CoreumToXRPLTransfer {
+                issuer: bridge_xrpl_address.clone(),
+                currency: coreum_originated_token.xrpl_currency.clone(),
+                amount: amount_truncated_and_converted,
+                max_amount: Some(amount_truncated_and_converted),
+                sender: Addr::unchecked(sender.address()),
+                recipient: xrpl_receiver_address.clone(),
+            }
+        );
 
-        let amount_to_send_xrp = Uint128::new(50000);
-        let amount_to_send_back = Uint128::new(10000);
-        let final_balance_xrp = amount_to_send_xrp.checked_sub(amount_to_send_back).unwrap();
+        // Reject the operation so that tokens are sent back to sender
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    amount: amount_to_send_xrp.clone(),
-                    recipient: Addr::unchecked(sender.address()),
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: query_pending_operations.operations[0].account_sequence,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Rejected,
+                    operation_result: None,
                 },
             },
-            &[],
+            &vec![],
             relayer_account,
         )
         .unwrap();
 
-        // Check that balance is in the sender's account
+        // Truncated amount won't be sent back (goes to relayer fees) and the rest will be stored in refundable array for the user to claim
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
-                denom: denom_xrp.clone(),
+                denom: denom.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, amount_to_send_xrp.to_string());
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(amount_to_send)
+                .unwrap()
+                .to_string()
+        );
 
-        let xrpl_receiver_address = generate_xrpl_address();
-        // Trying to send XRP back with a deliver_amount should fail
-        let deliver_error = wasm
-            .execute::<ExecuteMsg>(
+        // Truncated amount and refundable fees will stay in contract
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+        assert_eq!(request_balance.balance, amount_to_send.to_string());
+
+        // If we query the refundable tokens that the user can claim, we should see the amount that was truncated is claimable
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(Uint128::one()),
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
+                    start_after_key: None,
+                    limit: None,
                 },
-                &coins(amount_to_send_back.u128(), denom_xrp.clone()),
-                sender,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(deliver_error.to_string().contains(
-            ContractError::DeliverAmountIsProhibited {}
-                .to_string()
-                .as_str()
-        ));
+        // We verify that these tokens are refundable
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].coin,
+            coin(
+                amount_to_send
+                    .checked_sub(Uint128::new(9999999999)) // Amount truncated is not refunded to user
+                    .unwrap()
+                    .u128(),
+                denom.clone()
+            )
+        );
 
-        // Send the XRP back to XRPL successfully
+        // Claim it, should work
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            },
+            &[],
+            &sender,
+        )
+        .unwrap();
+
+        // pending refunds should now be empty
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        // We verify that there are no pending refunds left
+        assert!(query_pending_refunds.pending_refunds.is_empty());
+
+        // Try to send again
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
                 deliver_amount: None,
             },
-            &coins(amount_to_send_back.u128(), denom_xrp.clone()),
-            sender,
+            &coins(amount_to_send.u128(), denom.clone()),
+            &sender,
         )
         .unwrap();
 
-        // Check that operation is in the queue
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -3291,154 +3838,244 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(1),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    amount: amount_to_send_back,
-                    max_amount: None,
-                    sender: Addr::unchecked(sender.address()),
-                    recipient: xrpl_receiver_address.clone(),
+        // Send successfull evidence to remove from queue (tokens should be released on XRPL to the receiver)
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: query_pending_operations.operations[0].account_sequence,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
                 },
-                xrpl_base_fee,
-            }
-        );
+            },
+            &vec![],
+            relayer_account,
+        )
+        .unwrap();
 
-        // If we try to send tokens from Coreum to XRPL using the multisig address as recipient, it should fail.
-        let bridge_error = wasm
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 0);
+
+        // Test sending the amount back from XRPL to Coreum
+        // 100000 (1e5) is the minimum we can send back (15 - 10 (sending precision))
+        let amount_to_send_back = Uint128::new(100000);
+
+        // If we send the token with a different issuer (not multisig address) it should fail
+        let transfer_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: multisig_address,
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: generate_xrpl_address(),
+                        currency: coreum_originated_token.xrpl_currency.clone(),
+                        amount: amount_to_send_back.clone(),
+                        recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(1, denom_xrp.clone()),
-                sender,
+                &[],
+                relayer_account,
             )
             .unwrap_err();
 
-        assert!(bridge_error
+        assert!(transfer_error
             .to_string()
-            .contains(ContractError::ProhibitedAddress {}.to_string().as_str()));
+            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
 
-        // If we try to send tokens from Coreum to XRPL using a prohibited address, it should fail.
-        let bridge_error = wasm
+        // If we send the token with a different currency (one that is not the one in the registered token list) it should fail
+        let transfer_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: INITIAL_PROHIBITED_XRPL_ADDRESSES[0].to_string(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: bridge_xrpl_address.clone(),
+                        currency: "invalid_currency".to_string(),
+                        amount: amount_to_send_back.clone(),
+                        recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(1, denom_xrp.clone()),
-                sender,
+                &[],
+                relayer_account,
             )
             .unwrap_err();
 
-        assert!(bridge_error
+        assert!(transfer_error
             .to_string()
-            .contains(ContractError::ProhibitedAddress {}.to_string().as_str()));
+            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
 
-        // Sending a CoreumToXRPLTransfer evidence with account sequence should fail.
-        let invalid_evidence = wasm
+        // Sending under the minimum should fail (minimum - 1)
+        let transfer_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(generate_hash()),
-                        account_sequence: Some(1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: None,
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: bridge_xrpl_address.clone(),
+                        currency: coreum_originated_token.xrpl_currency.clone(),
+                        amount: amount_to_send_back.checked_sub(Uint128::one()).unwrap(),
+                        recipient: Addr::unchecked(sender.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &vec![],
+                &[],
                 relayer_account,
             )
             .unwrap_err();
 
-        assert!(invalid_evidence.to_string().contains(
-            ContractError::InvalidTransactionResultEvidence {}
+        assert!(transfer_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
                 .to_string()
                 .as_str()
         ));
 
-        // Send successful evidence to remove from queue (tokens should be released on XRPL to the receiver)
+        // Sending the right evidence should move tokens from the contract to the sender's account
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: None,
-                    ticket_sequence: Some(1),
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: bridge_xrpl_address.clone(),
+                    currency: coreum_originated_token.xrpl_currency.clone(),
+                    amount: amount_to_send_back.clone(),
+                    recipient: Addr::unchecked(sender.address()),
+                    destination_chain_recipient: None,
                 },
             },
-            &vec![],
+            &[],
             relayer_account,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 0);
-
-        // Since transaction result was Accepted, the tokens must have been burnt
+        // Check balance of sender and contract
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
-                denom: denom_xrp.clone(),
+                denom: denom.clone(),
             })
             .unwrap();
-        assert_eq!(request_balance.balance, final_balance_xrp.to_string());
+
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(amount_to_send) // initial amount
+                .unwrap()
+                .checked_sub(Uint128::new(9999999999)) // Amount lost during first truncation that was rejected
+                .unwrap()
+                .checked_add(Uint128::new(10000000000)) // Amount that we sent back after conversion (1e10), the minimum
+                .unwrap()
+                .to_string()
+        );
 
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: contract_addr.clone(),
-                denom: denom_xrp.clone(),
+                denom: denom.clone(),
             })
             .unwrap();
-        assert_eq!(request_balance.balance, Uint128::zero().to_string());
 
-        // Now we will try to send back again but this time reject it, thus balance must be sent back to the sender.
+        assert_eq!(
+            request_balance.balance,
+            amount_to_send
+                .checked_add(Uint128::new(9999999999)) // Amount that was kept during truncation of rejected operation
+                .unwrap()
+                .checked_sub(Uint128::new(10000000000)) // Amount sent from XRPL to the user
+                .unwrap()
+                .to_string()
+        );
+    }
+
+    #[test]
+    fn send_from_coreum_to_xrpl() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 3;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
+
+        let signer = accounts.get(0).unwrap();
+        let sender = accounts.get(1).unwrap();
+        let relayer_account = accounts.get(2).unwrap();
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(relayer_account.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let xrpl_base_fee = 10;
+        let multisig_address = generate_xrpl_address();
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            10,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            multisig_address.clone(),
+            xrpl_base_fee,
+        );
+
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let denom_xrp = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == XRP_ISSUER && t.currency == XRP_CURRENCY)
+            .unwrap()
+            .coreum_denom
+            .clone();
+
+        // Add enough tickets for all our test operations
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(11),
             },
-            &coins(amount_to_send_back.u128(), denom_xrp.clone()),
-            sender,
+            &vec![],
+            &signer,
         )
         .unwrap();
 
-        // Transaction was rejected
+        let tx_hash = generate_hash();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: None,
-                    ticket_sequence: Some(2),
-                    transaction_result: TransactionResult::Rejected,
-                    operation_result: None,
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..12).collect()),
+                    }),
                 },
             },
             &vec![],
@@ -3446,99 +4083,45 @@ mod tests {
         )
         .unwrap();
 
-        // Since transaction result was Rejected, the tokens must have been sent to pending refunds
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom_xrp.clone(),
-            })
-            .unwrap();
-        assert_eq!(request_balance.balance, amount_to_send_back.to_string());
-
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        // If we query processed Txes with this tx_hash it should return true
+        let query_processed_tx = wasm
+            .query::<QueryMsg, bool>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
-                    start_after_key: None,
-                    limit: None,
+                &QueryMsg::ProcessedTx {
+                    hash: tx_hash.to_uppercase(),
                 },
             )
             .unwrap();
 
-        // We verify that these tokens are refundable
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
-        assert_eq!(
-            query_pending_refunds.pending_refunds[0].coin,
-            coin(amount_to_send_back.u128(), denom_xrp.clone())
-        );
-
-        // *** Test sending an XRPL originated token back to XRPL ***
-
-        let test_token = XRPLToken {
-            issuer: generate_xrpl_address(),
-            currency: "TST".to_string(),
-            sending_precision: 15,
-            max_holding_amount: Uint128::new(50000000000000000000), // 5e20
-            bridging_fee: Uint128::zero(),
-        };
-
-        // First we need to register and activate it
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: test_token.issuer.clone(),
-                currency: test_token.currency.clone(),
-                sending_precision: test_token.sending_precision,
-                max_holding_amount: test_token.max_holding_amount,
-                bridging_fee: test_token.bridging_fee,
-            },
-            &query_issue_fee(&asset_ft),
-            signer,
-        )
-        .unwrap();
+        assert_eq!(query_processed_tx, true);
 
-        // Activate the token
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // If we query something that is not processed it should return false
+        let query_processed_tx = wasm
+            .query::<QueryMsg, bool>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &QueryMsg::ProcessedTx {
+                    hash: generate_hash(),
                 },
             )
             .unwrap();
 
-        let tx_hash = generate_hash();
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: None,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
-                },
-            },
-            &[],
-            relayer_account,
-        )
-        .unwrap();
+        assert_eq!(query_processed_tx, false);
 
-        let amount_to_send = Uint128::new(10000000000000000000); // 1e20
-        let final_balance = amount_to_send.checked_sub(amount_to_send_back).unwrap();
-        // Bridge some tokens to the sender address
+        // *** Test sending XRP back to XRPL, which is already enabled so we can bridge it directly ***
+
+        let amount_to_send_xrp = Uint128::new(50000);
+        let amount_to_send_back = Uint128::new(10000);
+        let final_balance_xrp = amount_to_send_xrp.checked_sub(amount_to_send_back).unwrap();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLToCoreumTransfer {
                     tx_hash: generate_hash(),
-                    issuer: test_token.issuer.to_string(),
-                    currency: test_token.currency.to_string(),
-                    amount: amount_to_send.clone(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    amount: amount_to_send_xrp.clone(),
                     recipient: Addr::unchecked(sender.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -3546,90 +4129,49 @@ mod tests {
         )
         .unwrap();
 
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
-                &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let xrpl_originated_token = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == test_token.issuer && t.currency == test_token.currency)
-            .unwrap();
-        let denom_xrpl_origin_token = xrpl_originated_token.coreum_denom.clone();
-
+        // Check that balance is in the sender's account
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
-                denom: denom_xrpl_origin_token.clone(),
+                denom: denom_xrp.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, amount_to_send.to_string());
+        assert_eq!(request_balance.balance, amount_to_send_xrp.to_string());
 
-        // If we send more than one token in the funds we should get an error
-        let invalid_funds_error = wasm
+        let xrpl_receiver_address = generate_xrpl_address();
+        // Trying to send XRP back with a deliver_amount should fail
+        let deliver_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SendToXRPL {
                     recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: None,
+                    deliver_amount: Some(Uint128::one()),
                 },
-                &vec![
-                    coin(1, FEE_DENOM),
-                    coin(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
-                ],
+                &coins(amount_to_send_back.u128(), denom_xrp.clone()),
                 sender,
             )
             .unwrap_err();
 
-        assert!(invalid_funds_error.to_string().contains(
-            ContractError::Payment(cw_utils::PaymentError::MultipleDenoms {})
+        assert!(deliver_error.to_string().contains(
+            ContractError::DeliverAmountIsProhibited {}
                 .to_string()
                 .as_str()
         ));
 
-        // If we send to an invalid XRPL address we should get an error
-        let invalid_address_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: "invalid_address".to_string(),
-                    deliver_amount: None,
-                },
-                &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
-                sender,
-            )
-            .unwrap_err();
-
-        assert!(invalid_address_error.to_string().contains(
-            ContractError::InvalidXRPLAddress {
-                address: "invalid_address".to_string()
-            }
-            .to_string()
-            .as_str()
-        ));
-
-        // We will send a successful transfer to XRPL considering the token has no transfer rate
-
+        // Send the XRP back to XRPL successfully
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
                 deliver_amount: None,
             },
-            &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
+            &coins(amount_to_send_back.u128(), denom_xrp.clone()),
             sender,
         )
         .unwrap();
 
-        // Check that the operation was added to the queue
-
+        // Check that operation is in the queue
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -3646,29 +4188,87 @@ mod tests {
             Operation {
                 id: query_pending_operations.operations[0].id.clone(),
                 version: 1,
-                ticket_sequence: Some(4),
+                ticket_sequence: Some(1),
                 account_sequence: None,
                 signatures: vec![],
                 operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: xrpl_originated_token.issuer.clone(),
-                    currency: xrpl_originated_token.currency.clone(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
                     amount: amount_to_send_back,
-                    max_amount: Some(amount_to_send_back),
+                    max_amount: None,
                     sender: Addr::unchecked(sender.address()),
                     recipient: xrpl_receiver_address.clone(),
                 },
-                xrpl_base_fee
+                xrpl_base_fee,
             }
         );
 
-        // Send successful should burn the tokens
+        // If we try to send tokens from Coreum to XRPL using the multisig address as recipient, it should fail.
+        let bridge_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: multisig_address,
+                    deliver_amount: None,
+                },
+                &coins(1, denom_xrp.clone()),
+                sender,
+            )
+            .unwrap_err();
+
+        assert!(bridge_error
+            .to_string()
+            .contains(ContractError::ProhibitedAddress {}.to_string().as_str()));
+
+        // If we try to send tokens from Coreum to XRPL using a prohibited address, it should fail.
+        let bridge_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: INITIAL_PROHIBITED_XRPL_ADDRESSES[0].to_string(),
+                    deliver_amount: None,
+                },
+                &coins(1, denom_xrp.clone()),
+                sender,
+            )
+            .unwrap_err();
+
+        assert!(bridge_error
+            .to_string()
+            .contains(ContractError::ProhibitedAddress {}.to_string().as_str()));
+
+        // Sending a CoreumToXRPLTransfer evidence with account sequence should fail.
+        let invalid_evidence = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(generate_hash()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: None,
+                    },
+                },
+                &vec![],
+                relayer_account,
+            )
+            .unwrap_err();
+
+        assert!(invalid_evidence.to_string().contains(
+            ContractError::InvalidTransactionResultEvidence {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Send successful evidence to remove from queue (tokens should be released on XRPL to the receiver)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: Some(4),
+                    ticket_sequence: Some(1),
                     transaction_result: TransactionResult::Accepted,
                     operation_result: None,
                 },
@@ -3690,44 +4290,44 @@ mod tests {
 
         assert_eq!(query_pending_operations.operations.len(), 0);
 
-        // Tokens should have been burnt since transaction was accepted
+        // Since transaction result was Accepted, the tokens must have been burnt
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
-                denom: denom_xrpl_origin_token.clone(),
+                denom: denom_xrp.clone(),
             })
             .unwrap();
-        assert_eq!(request_balance.balance, final_balance.to_string());
+        assert_eq!(request_balance.balance, final_balance_xrp.to_string());
 
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: contract_addr.clone(),
-                denom: denom_xrpl_origin_token.clone(),
+                denom: denom_xrp.clone(),
             })
             .unwrap();
-
         assert_eq!(request_balance.balance, Uint128::zero().to_string());
 
-        // Now we will try to send back again but this time reject it, thus balance must be sent back to the sender
+        // Now we will try to send back again but this time reject it, thus balance must be sent back to the sender.
+
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
                 deliver_amount: None,
             },
-            &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
+            &coins(amount_to_send_back.u128(), denom_xrp.clone()),
             sender,
         )
         .unwrap();
 
-        // Send rejected should store tokens minus truncated amount in refundable amount for the sender
+        // Transaction was rejected
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: Some(5),
+                    ticket_sequence: Some(2),
                     transaction_result: TransactionResult::Rejected,
                     operation_result: None,
                 },
@@ -3737,22 +4337,16 @@ mod tests {
         )
         .unwrap();
 
+        // Since transaction result was Rejected, the tokens must have been sent to pending refunds
+
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
+                account: contract_addr.clone(),
                 denom: denom_xrp.clone(),
             })
             .unwrap();
+        assert_eq!(request_balance.balance, amount_to_send_back.to_string());
 
-        assert_eq!(
-            request_balance.balance,
-            final_balance_xrp
-                .checked_sub(amount_to_send_back)
-                .unwrap()
-                .to_string()
-        );
-
-        // Let's check the pending refunds for the sender and also check that pagination works correctly.
         let query_pending_refunds = wasm
             .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
@@ -3764,152 +4358,172 @@ mod tests {
             )
             .unwrap();
 
-        // There was one pending refund from previous test, we are going to claim both
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 2);
+        // We verify that these tokens are refundable
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].coin,
+            coin(amount_to_send_back.u128(), denom_xrp.clone())
+        );
 
-        // Test with limit 1 and starting after first one
-        let query_pending_refunds_with_limit = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        // *** Test sending an XRPL originated token back to XRPL ***
+
+        let test_token = XRPLToken {
+            issuer: generate_xrpl_address(),
+            currency: "TST".to_string(),
+            sending_precision: 15,
+            max_holding_amount: Uint128::new(50000000000000000000), // 5e20
+            bridging_fee: Uint128::zero(),
+        };
+
+        // First we need to register and activate it
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: test_token.issuer.clone(),
+                currency: test_token.currency.clone(),
+                sending_precision: test_token.sending_precision,
+                max_holding_amount: test_token.max_holding_amount,
+                bridging_fee: test_token.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            signer,
+        )
+        .unwrap();
+
+        // Activate the token
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
+                &QueryMsg::PendingOperations {
                     start_after_key: None,
-                    limit: Some(1),
+                    limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_pending_refunds_with_limit.pending_refunds.len(), 1);
-
-        // Test with limit 1 and starting from first key
-        let query_pending_refunds_with_limit_and_start_after_key = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
-                    start_after_key: query_pending_refunds_with_limit.last_key,
-                    limit: Some(1),
+        let tx_hash = generate_hash();
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
                 },
-            )
-            .unwrap();
+            },
+            &[],
+            relayer_account,
+        )
+        .unwrap();
 
-        assert_eq!(
-            query_pending_refunds_with_limit_and_start_after_key
-                .pending_refunds
-                .len(),
-            1
-        );
-        assert_eq!(
-            query_pending_refunds_with_limit_and_start_after_key.pending_refunds[0],
-            query_pending_refunds.pending_refunds[1]
-        );
+        let amount_to_send = Uint128::new(10000000000000000000); // 1e20
+        let final_balance = amount_to_send.checked_sub(amount_to_send_back).unwrap();
+        // Bridge some tokens to the sender address
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: test_token.issuer.to_string(),
+                    currency: test_token.currency.to_string(),
+                    amount: amount_to_send.clone(),
+                    recipient: Addr::unchecked(sender.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            relayer_account,
+        )
+        .unwrap();
 
-        // Let's claim all pending refunds and check that they are gone from the contract and in the senders address
-        for refund in query_pending_refunds.pending_refunds.iter() {
-            wasm.execute::<ExecuteMsg>(
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::ClaimRefund {
-                    pending_refund_id: refund.id.clone(),
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &[],
-                &sender,
             )
             .unwrap();
-        }
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom_xrp.clone(),
-            })
+        let xrpl_originated_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == test_token.issuer && t.currency == test_token.currency)
             .unwrap();
-
-        assert_eq!(request_balance.balance, final_balance_xrp.to_string());
+        let denom_xrpl_origin_token = xrpl_originated_token.coreum_denom.clone();
 
         let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom_xrp.clone(),
-            })
-            .unwrap();
-        assert_eq!(request_balance.balance, Uint128::zero().to_string());
-
-        // Let's test sending a token with optional amount
-
-        let max_amount = Uint128::new(9999999999999999);
-        let deliver_amount = Some(Uint128::new(6000));
-
-        // Store balance first so we can check it later
-        let request_initial_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
                 denom: denom_xrpl_origin_token.clone(),
             })
             .unwrap();
 
-        // If we send amount that is higher than max amount, it should fail
-        let max_amount_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(max_amount.checked_add(Uint128::one()).unwrap()),
-                },
-                &coins(max_amount.u128(), denom_xrpl_origin_token.clone()),
-                sender,
-            )
-            .unwrap_err();
-
-        assert!(max_amount_error
-            .to_string()
-            .contains(ContractError::InvalidDeliverAmount {}.to_string().as_str()));
+        assert_eq!(request_balance.balance, amount_to_send.to_string());
 
-        // If we send a deliver amount that is an invalid XRPL amount, it should fail
-        let invalid_amount_error = wasm
+        // If we send more than one token in the funds we should get an error
+        let invalid_funds_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SendToXRPL {
                     recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(Uint128::new(99999999999999999)),
+                    deliver_amount: None,
                 },
-                &coins(1000000000000000000, denom_xrpl_origin_token.clone()),
+                &vec![
+                    coin(1, FEE_DENOM),
+                    coin(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
+                ],
                 sender,
             )
             .unwrap_err();
 
-        assert!(invalid_amount_error
-            .to_string()
-            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
+        assert!(invalid_funds_error.to_string().contains(
+            ContractError::Payment(cw_utils::PaymentError::MultipleDenoms {})
+                .to_string()
+                .as_str()
+        ));
 
-        // If we send an amount that is an invalid XRPL amount, it should fail
-        let invalid_amount_error = wasm
+        // If we send to an invalid XRPL address we should get an error
+        let invalid_address_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(Uint128::new(10000000000000000)),
+                    recipient: "invalid_address".to_string(),
+                    deliver_amount: None,
                 },
-                &coins(10000000000000001, denom_xrpl_origin_token.clone()),
+                &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
                 sender,
             )
             .unwrap_err();
 
-        assert!(invalid_amount_error
+        assert!(invalid_address_error.to_string().contains(
+            ContractError::InvalidXRPLAddress {
+                address: "invalid_address".to_string()
+            }
             .to_string()
-            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
+            .as_str()
+        ));
+
+        // We will send a successful transfer to XRPL considering the token has no transfer rate
 
-        // Send it correctly
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
-                deliver_amount,
+                deliver_amount: None,
             },
-            &coins(max_amount.u128(), denom_xrpl_origin_token.clone()),
+            &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
             sender,
         )
         .unwrap();
 
+        // Check that the operation was added to the queue
+
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -3926,14 +4540,14 @@ mod tests {
             Operation {
                 id: query_pending_operations.operations[0].id.clone(),
                 version: 1,
-                ticket_sequence: Some(6),
+                ticket_sequence: Some(4),
                 account_sequence: None,
                 signatures: vec![],
                 operation_type: OperationType::CoreumToXRPLTransfer {
                     issuer: xrpl_originated_token.issuer.clone(),
                     currency: xrpl_originated_token.currency.clone(),
-                    amount: deliver_amount.unwrap(),
-                    max_amount: Some(max_amount),
+                    amount: amount_to_send_back,
+                    max_amount: Some(amount_to_send_back),
                     sender: Addr::unchecked(sender.address()),
                     recipient: xrpl_receiver_address.clone(),
                 },
@@ -3941,15 +4555,15 @@ mod tests {
             }
         );
 
-        // If we reject the operation, the refund should be stored for the amount of funds that were sent
+        // Send successful should burn the tokens
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: Some(6),
-                    transaction_result: TransactionResult::Rejected,
+                    ticket_sequence: Some(4),
+                    transaction_result: TransactionResult::Accepted,
                     operation_result: None,
                 },
             },
@@ -3958,234 +4572,56 @@ mod tests {
         )
         .unwrap();
 
-        // Check balances and pending refunds are all correct
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom_xrpl_origin_token.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            request_initial_balance
-                .balance
-                .parse::<u128>()
-                .unwrap()
-                .checked_sub(max_amount.u128())
-                .unwrap()
-                .to_string()
-        );
-
-        // Let's check the pending refunds for the sender and also check that pagination works correctly.
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(sender.address()),
+                &QueryMsg::PendingOperations {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
-        assert_eq!(
-            query_pending_refunds.pending_refunds[0].coin,
-            coin(max_amount.u128(), denom_xrpl_origin_token.clone())
-        );
-
-        // Claim it back
+        assert_eq!(query_pending_operations.operations.len(), 0);
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
-            },
-            &[],
-            &sender,
-        )
-        .unwrap();
-
-        // Check balance again
+        // Tokens should have been burnt since transaction was accepted
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
                 denom: denom_xrpl_origin_token.clone(),
             })
             .unwrap();
+        assert_eq!(request_balance.balance, final_balance.to_string());
 
-        assert_eq!(request_balance.balance, request_initial_balance.balance);
-
-        // *** Test sending Coreum originated tokens to XRPL
-
-        // Let's issue a token to the sender and register it.
-        let asset_ft = AssetFT::new(&app);
-        let symbol = "TEST".to_string();
-        let subunit = "utest".to_string();
-        let initial_amount = Uint128::new(1000000000);
-        let decimals = 6;
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: sender.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
-                },
-                &sender,
-            )
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom_xrpl_origin_token.clone(),
+            })
             .unwrap();
 
-        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: denom.clone(),
-                decimals,
-                sending_precision: 5,
-                max_holding_amount: Uint128::new(10000000),
-                bridging_fee: Uint128::zero(),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        let amount_to_send = Uint128::new(1000001); // 1000001 -> truncate -> 1e6 -> decimal conversion -> 1e15
-
-        // Bridge the token to the xrpl receiver address two times and check pending operations
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
-            },
-            &coins(amount_to_send.u128(), denom.clone()),
-            &sender,
-        )
-        .unwrap();
+        assert_eq!(request_balance.balance, Uint128::zero().to_string());
 
+        // Now we will try to send back again but this time reject it, thus balance must be sent back to the sender
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
                 deliver_amount: None,
             },
-            &coins(amount_to_send.u128(), denom.clone()),
-            &sender,
-        )
-        .unwrap();
-
-        let multisig_address = wasm
-            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
-            .unwrap()
-            .bridge_xrpl_address;
-
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let coreum_originated_token = query_coreum_tokens
-            .tokens
-            .iter()
-            .find(|t| t.denom == denom)
-            .unwrap();
-
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 2);
-        let amount = amount_to_send
-            .checked_sub(Uint128::one()) //Truncated amount
-            .unwrap()
-            .checked_mul(Uint128::new(10u128.pow(9))) // XRPL Decimals - Coreum Decimals -> (15 - 6) = 9
-            .unwrap();
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(7),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: multisig_address.clone(),
-                    currency: coreum_originated_token.xrpl_currency.clone(),
-                    amount: amount.clone(),
-                    max_amount: Some(amount.clone()),
-                    sender: Addr::unchecked(sender.address()),
-                    recipient: xrpl_receiver_address.clone(),
-                },
-                xrpl_base_fee
-            }
-        );
-
-        assert_eq!(
-            query_pending_operations.operations[1],
-            Operation {
-                id: query_pending_operations.operations[1].id.clone(),
-                version: 1,
-                ticket_sequence: Some(8),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: multisig_address,
-                    currency: coreum_originated_token.xrpl_currency.clone(),
-                    amount: amount.clone(),
-                    max_amount: Some(amount.clone()),
-                    sender: Addr::unchecked(sender.address()),
-                    recipient: xrpl_receiver_address,
-                },
-                xrpl_base_fee
-            }
-        );
-
-        // If we reject both operations, the tokens should be kept in pending refunds with different ids for the sender to claim (except truncated amount)
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: None,
-                    ticket_sequence: Some(7),
-                    transaction_result: TransactionResult::Rejected,
-                    operation_result: None,
-                },
-            },
-            &vec![],
-            relayer_account,
+            &coins(amount_to_send_back.u128(), denom_xrpl_origin_token.clone()),
+            sender,
         )
         .unwrap();
 
+        // Send rejected should store tokens minus truncated amount in refundable amount for the sender
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: Some(8),
+                    ticket_sequence: Some(5),
                     transaction_result: TransactionResult::Rejected,
                     operation_result: None,
                 },
@@ -4198,21 +4634,19 @@ mod tests {
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: sender.address(),
-                denom: denom.clone(),
+                denom: denom_xrp.clone(),
             })
             .unwrap();
 
-        // Refundable amount (amount to send x 2 - truncated amount x 2) won't be sent back until claimed individually
         assert_eq!(
             request_balance.balance,
-            initial_amount
-                .checked_sub(amount_to_send)
-                .unwrap()
-                .checked_sub(amount_to_send)
+            final_balance_xrp
+                .checked_sub(amount_to_send_back)
                 .unwrap()
                 .to_string()
         );
 
+        // Let's check the pending refunds for the sender and also check that pagination works correctly.
         let query_pending_refunds = wasm
             .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
@@ -4224,216 +4658,152 @@ mod tests {
             )
             .unwrap();
 
+        // There was one pending refund from previous test, we are going to claim both
         assert_eq!(query_pending_refunds.pending_refunds.len(), 2);
 
-        // Claiming pending refund should work for both operations
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
-            },
-            &[],
-            &sender,
-        )
-        .unwrap();
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[1].id.clone(),
-            },
-            &[],
-            &sender,
-        )
-        .unwrap();
-
-        // Check that balance was correctly sent back
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(
-            request_balance.balance,
-            initial_amount
-                .checked_sub(Uint128::new(2))
-                .unwrap()
-                .to_string()
-        );
-
-        // Truncated amount will stay in contract
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-        assert_eq!(request_balance.balance, Uint128::new(2).to_string());
-
-        // Let's query all processed transactions
-        let query_processed_txs = wasm
-            .query::<QueryMsg, ProcessedTxsResponse>(
+        // Test with limit 1 and starting after first one
+        let query_pending_refunds_with_limit = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
-                &QueryMsg::ProcessedTxs {
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
                     start_after_key: None,
-                    limit: None,
+                    limit: Some(1),
                 },
             )
             .unwrap();
 
-        assert_eq!(query_processed_txs.processed_txs.len(), 11);
+        assert_eq!(query_pending_refunds_with_limit.pending_refunds.len(), 1);
 
-        // Let's query with pagination
-        let query_processed_txs = wasm
-            .query::<QueryMsg, ProcessedTxsResponse>(
+        // Test with limit 1 and starting from first key
+        let query_pending_refunds_with_limit_and_start_after_key = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
-                &QueryMsg::ProcessedTxs {
-                    start_after_key: None,
-                    limit: Some(4),
-                },
-            )
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
+                    start_after_key: query_pending_refunds_with_limit.last_key,
+                    limit: Some(1),
+                },
+            )
             .unwrap();
 
-        assert_eq!(query_processed_txs.processed_txs.len(), 4);
+        assert_eq!(
+            query_pending_refunds_with_limit_and_start_after_key
+                .pending_refunds
+                .len(),
+            1
+        );
+        assert_eq!(
+            query_pending_refunds_with_limit_and_start_after_key.pending_refunds[0],
+            query_pending_refunds.pending_refunds[1]
+        );
 
-        let query_processed_txs = wasm
-            .query::<QueryMsg, ProcessedTxsResponse>(
+        // Let's claim all pending refunds and check that they are gone from the contract and in the senders address
+        for refund in query_pending_refunds.pending_refunds.iter() {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::ProcessedTxs {
-                    start_after_key: query_processed_txs.last_key,
-                    limit: None,
+                &ExecuteMsg::ClaimRefund {
+                    pending_refund_id: refund.id.clone(),
                 },
+                &[],
+                &sender,
             )
             .unwrap();
+        }
 
-        assert_eq!(query_processed_txs.processed_txs.len(), 7);
-    }
-
-    #[test]
-    fn precisions() {
-        let app = CoreumTestApp::new();
-        let signer = app
-            .init_account(&coins(100_000_000_000, FEE_DENOM))
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom_xrp.clone(),
+            })
             .unwrap();
 
-        let receiver = app
-            .init_account(&coins(100_000_000_000, FEE_DENOM))
+        assert_eq!(request_balance.balance, final_balance_xrp.to_string());
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom_xrp.clone(),
+            })
             .unwrap();
+        assert_eq!(request_balance.balance, Uint128::zero().to_string());
 
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(signer.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
+        // Let's test sending a token with optional amount
 
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            &signer,
-            Addr::unchecked(signer.address()),
-            vec![relayer],
-            1,
-            7,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
-            10,
-        );
+        let max_amount = Uint128::new(9999999999999999);
+        let deliver_amount = Some(Uint128::new(6000));
 
-        // *** Test with XRPL originated tokens ***
+        // Store balance first so we can check it later
+        let request_initial_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom_xrpl_origin_token.clone(),
+            })
+            .unwrap();
 
-        let test_token1 = XRPLToken {
-            issuer: generate_xrpl_address(),
-            currency: "TT1".to_string(),
-            sending_precision: -2,
-            max_holding_amount: Uint128::new(200000000000000000),
-            bridging_fee: Uint128::zero(),
-        };
-        let test_token2 = XRPLToken {
-            issuer: generate_xrpl_address().to_string(),
-            currency: "TT2".to_string(),
-            sending_precision: 13,
-            max_holding_amount: Uint128::new(499),
-            bridging_fee: Uint128::zero(),
-        };
+        // If we send amount that is higher than max amount, it should fail
+        let max_amount_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: Some(max_amount.checked_add(Uint128::one()).unwrap()),
+                },
+                &coins(max_amount.u128(), denom_xrpl_origin_token.clone()),
+                sender,
+            )
+            .unwrap_err();
 
-        let test_token3 = XRPLToken {
-            issuer: generate_xrpl_address().to_string(),
-            currency: "TT3".to_string(),
-            sending_precision: 0,
-            max_holding_amount: Uint128::new(5000000000000000),
-            bridging_fee: Uint128::zero(),
-        };
+        assert!(max_amount_error
+            .to_string()
+            .contains(ContractError::InvalidDeliverAmount {}.to_string().as_str()));
 
-        // Set up enough tickets first to allow registering tokens.
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(8),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        // If we send a deliver amount that is an invalid XRPL amount, it should fail
+        let invalid_amount_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: Some(Uint128::new(99999999999999999)),
+                },
+                &coins(1000000000000000000, denom_xrpl_origin_token.clone()),
+                sender,
+            )
+            .unwrap_err();
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..9).collect()),
-                    }),
+        assert!(invalid_amount_error
+            .to_string()
+            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
+
+        // If we send an amount that is an invalid XRPL amount, it should fail
+        let invalid_amount_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: Some(Uint128::new(10000000000000000)),
                 },
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+                &coins(10000000000000001, denom_xrpl_origin_token.clone()),
+                sender,
+            )
+            .unwrap_err();
 
-        // Test negative sending precisions
+        assert!(invalid_amount_error
+            .to_string()
+            .contains(ContractError::InvalidXRPLAmount {}.to_string().as_str()));
 
-        // Register token
+        // Send it correctly
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: test_token1.issuer.clone(),
-                currency: test_token1.currency.clone(),
-                sending_precision: test_token1.sending_precision.clone(),
-                max_holding_amount: test_token1.max_holding_amount.clone(),
-                bridging_fee: test_token1.bridging_fee,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount,
             },
-            &query_issue_fee(&asset_ft),
-            &signer,
+            &coins(max_amount.u128(), denom_xrpl_origin_token.clone()),
+            sender,
         )
         .unwrap();
 
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
-                &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let denom = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == test_token1.issuer && t.currency == test_token1.currency)
-            .unwrap()
-            .coreum_denom
-            .clone();
-
-        // Activate the token
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -4444,124 +4814,193 @@ mod tests {
             )
             .unwrap();
 
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(6),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::CoreumToXRPLTransfer {
+                    issuer: xrpl_originated_token.issuer.clone(),
+                    currency: xrpl_originated_token.currency.clone(),
+                    amount: deliver_amount.unwrap(),
+                    max_amount: Some(max_amount),
+                    sender: Addr::unchecked(sender.address()),
+                    recipient: xrpl_receiver_address.clone(),
+                },
+                xrpl_base_fee
+            }
+        );
+
+        // If we reject the operation, the refund should be stored for the amount of funds that were sent
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
+                    ticket_sequence: Some(6),
+                    transaction_result: TransactionResult::Rejected,
                     operation_result: None,
                 },
             },
-            &[],
-            &signer,
+            &vec![],
+            relayer_account,
         )
         .unwrap();
 
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token1.issuer.clone(),
-                        currency: test_token1.currency.clone(),
-                        // Sending less than 100000000000000000, in this case 99999999999999999 (1 less digit) should return an error because it will truncate to zero
-                        amount: Uint128::new(99999999999999999),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
-                },
-                &[],
-                &signer,
-            )
-            .unwrap_err();
+        // Check balances and pending refunds are all correct
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom_xrpl_origin_token.clone(),
+            })
+            .unwrap();
 
-        assert!(precision_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
+        assert_eq!(
+            request_balance.balance,
+            request_initial_balance
+                .balance
+                .parse::<u128>()
+                .unwrap()
+                .checked_sub(max_amount.u128())
+                .unwrap()
                 .to_string()
-                .as_str()
-        ));
+        );
+
+        // Let's check the pending refunds for the sender and also check that pagination works correctly.
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].coin,
+            coin(max_amount.u128(), denom_xrpl_origin_token.clone())
+        );
+
+        // Claim it back
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: test_token1.issuer.clone(),
-                    currency: test_token1.currency.clone(),
-                    // Sending more than 199999999999999999 will truncate to 100000000000000000 and send it to the user and keep the remainder in the contract as fees to collect.
-                    amount: Uint128::new(199999999999999999),
-                    recipient: Addr::unchecked(receiver.address()),
-                },
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
             },
             &[],
-            &signer,
+            &sender,
         )
         .unwrap();
 
+        // Check balance again
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: denom.clone(),
+                account: sender.address(),
+                denom: denom_xrpl_origin_token.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "100000000000000000".to_string());
+        assert_eq!(request_balance.balance, request_initial_balance.balance);
 
-        // Sending anything again should not work because we already sent the maximum amount possible including the fees in the contract.
-        let maximum_amount_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token1.issuer.clone(),
-                        currency: test_token1.currency.clone(),
-                        amount: Uint128::new(100000000000000000),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+        // *** Test sending Coreum originated tokens to XRPL
+
+        // Let's issue a token to the sender and register it.
+        let asset_ft = AssetFT::new(&app);
+        let symbol = "TEST".to_string();
+        let subunit = "utest".to_string();
+        let initial_amount = Uint128::new(1000000000);
+        let decimals = 6;
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: sender.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
                 },
-                &[],
-                &signer,
+                &sender,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(maximum_amount_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
-                .to_string()
-                .as_str()
-        ));
+        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterCoreumToken {
                 denom: denom.clone(),
-            })
-            .unwrap();
+                decimals,
+                sending_precision: 5,
+                max_holding_amount: Uint128::new(10000000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
 
-        // Fees collected
-        assert_eq!(request_balance.balance, "99999999999999999".to_string());
+        let amount_to_send = Uint128::new(1000001); // 1000001 -> truncate -> 1e6 -> decimal conversion -> 1e15
 
-        // Test positive sending precisions
+        // Bridge the token to the xrpl receiver address two times and check pending operations
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
+            },
+            &coins(amount_to_send.u128(), denom.clone()),
+            &sender,
+        )
+        .unwrap();
 
-        // Register token
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: test_token2.issuer.clone(),
-                currency: test_token2.currency.clone(),
-                sending_precision: test_token2.sending_precision.clone(),
-                max_holding_amount: test_token2.max_holding_amount.clone(),
-                bridging_fee: test_token2.bridging_fee,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
             },
-            &query_issue_fee(&asset_ft),
-            &signer,
+            &coins(amount_to_send.u128(), denom.clone()),
+            &sender,
         )
         .unwrap();
 
-        // Activate the token
+        let multisig_address = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+            .unwrap()
+            .bridge_xrpl_address;
+
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
+                &contract_addr,
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let coreum_originated_token = query_coreum_tokens
+            .tokens
+            .iter()
+            .find(|t| t.denom == denom)
+            .unwrap();
+
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -4572,388 +5011,364 @@ mod tests {
             )
             .unwrap();
 
+        assert_eq!(query_pending_operations.operations.len(), 2);
+        let amount = amount_to_send
+            .checked_sub(Uint128::one()) //Truncated amount
+            .unwrap()
+            .checked_mul(Uint128::new(10u128.pow(9))) // XRPL Decimals - Coreum Decimals -> (15 - 6) = 9
+            .unwrap();
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(7),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::CoreumToXRPLTransfer {
+                    issuer: multisig_address.clone(),
+                    currency: coreum_originated_token.xrpl_currency.clone(),
+                    amount: amount.clone(),
+                    max_amount: Some(amount.clone()),
+                    sender: Addr::unchecked(sender.address()),
+                    recipient: xrpl_receiver_address.clone(),
+                },
+                xrpl_base_fee
+            }
+        );
+
+        assert_eq!(
+            query_pending_operations.operations[1],
+            Operation {
+                id: query_pending_operations.operations[1].id.clone(),
+                version: 1,
+                ticket_sequence: Some(8),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::CoreumToXRPLTransfer {
+                    issuer: multisig_address,
+                    currency: coreum_originated_token.xrpl_currency.clone(),
+                    amount: amount.clone(),
+                    max_amount: Some(amount.clone()),
+                    sender: Addr::unchecked(sender.address()),
+                    recipient: xrpl_receiver_address,
+                },
+                xrpl_base_fee
+            }
+        );
+
+        // If we reject both operations, the tokens should be kept in pending refunds with different ids for the sender to claim (except truncated amount)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
                     tx_hash: Some(generate_hash()),
                     account_sequence: None,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
+                    ticket_sequence: Some(7),
+                    transaction_result: TransactionResult::Rejected,
                     operation_result: None,
                 },
             },
-            &[],
-            &signer,
+            &vec![],
+            relayer_account,
         )
         .unwrap();
 
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
-                &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: Some(8),
+                    transaction_result: TransactionResult::Rejected,
+                    operation_result: None,
                 },
-            )
-            .unwrap();
+            },
+            &vec![],
+            relayer_account,
+        )
+        .unwrap();
 
-        let denom = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == test_token2.issuer && t.currency == test_token2.currency)
-            .unwrap()
-            .coreum_denom
-            .clone();
-
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token2.issuer.clone(),
-                        currency: test_token2.currency.clone(),
-                        // Sending more than 499 should fail because maximum holding amount is 499
-                        amount: Uint128::new(500),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
-                },
-                &[],
-                &signer,
-            )
-            .unwrap_err();
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
 
-        assert!(precision_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
+        // Refundable amount (amount to send x 2 - truncated amount x 2) won't be sent back until claimed individually
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(amount_to_send)
+                .unwrap()
+                .checked_sub(amount_to_send)
+                .unwrap()
                 .to_string()
-                .as_str()
-        ));
+        );
 
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token2.issuer.clone(),
-                        currency: test_token2.currency.clone(),
-                        // Sending less than 100 will truncate to 0 so should fail
-                        amount: Uint128::new(99),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(sender.address()),
+                    start_after_key: None,
+                    limit: None,
                 },
-                &[],
-                &signer,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(precision_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 2);
 
+        // Claiming pending refund should work for both operations
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: test_token2.issuer.clone(),
-                    currency: test_token2.currency.clone(),
-                    // Sending 299 should truncate the amount to 200 and keep the 99 in the contract as fees to collect
-                    amount: Uint128::new(299),
-                    recipient: Addr::unchecked(receiver.address()),
-                },
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
             },
             &[],
-            &signer,
+            &sender,
         )
         .unwrap();
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "200".to_string());
-
-        // Sending 200 should work because we will reach exactly the maximum bridged amount.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: test_token2.issuer.clone(),
-                    currency: test_token2.currency.clone(),
-                    amount: Uint128::new(200),
-                    recipient: Addr::unchecked(receiver.address()),
-                },
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[1].id.clone(),
             },
             &[],
-            &signer,
+            &sender,
         )
         .unwrap();
 
+        // Check that balance was correctly sent back
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
+                account: sender.address(),
                 denom: denom.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "400".to_string());
+        assert_eq!(
+            request_balance.balance,
+            initial_amount
+                .checked_sub(Uint128::new(2))
+                .unwrap()
+                .to_string()
+        );
 
+        // Truncated amount will stay in contract
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: contract_addr.clone(),
                 denom: denom.clone(),
             })
             .unwrap();
+        assert_eq!(request_balance.balance, Uint128::new(2).to_string());
 
-        assert_eq!(request_balance.balance, "99".to_string());
-
-        // Sending anything again should fail because we passed the maximum bridged amount
-        let maximum_amount_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token2.issuer.clone(),
-                        currency: test_token2.currency.clone(),
-                        amount: Uint128::new(199),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
-                },
-                &[],
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(maximum_amount_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Test 0 sending precision
-
-        // Register token
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: test_token3.issuer.clone(),
-                currency: test_token3.currency.clone(),
-                sending_precision: test_token3.sending_precision.clone(),
-                max_holding_amount: test_token3.max_holding_amount.clone(),
-                bridging_fee: test_token3.bridging_fee,
-            },
-            &query_issue_fee(&asset_ft),
-            &signer,
-        )
-        .unwrap();
-
-        // Activate the token
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // Let's query all processed transactions
+        let query_processed_txs = wasm
+            .query::<QueryMsg, ProcessedTxsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
+                &QueryMsg::ProcessedTxs {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: None,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
-                },
-            },
-            &[],
-            &signer,
-        )
-        .unwrap();
+        assert_eq!(query_processed_txs.processed_txs.len(), 11);
 
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
+        // Let's query with pagination
+        let query_processed_txs = wasm
+            .query::<QueryMsg, ProcessedTxsResponse>(
                 &contract_addr,
-                &QueryMsg::XRPLTokens {
+                &QueryMsg::ProcessedTxs {
                     start_after_key: None,
-                    limit: None,
+                    limit: Some(4),
                 },
             )
             .unwrap();
 
-        let denom = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == test_token3.issuer && t.currency == test_token3.currency)
-            .unwrap()
-            .coreum_denom
-            .clone();
+        assert_eq!(query_processed_txs.processed_txs.len(), 4);
 
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_processed_txs = wasm
+            .query::<QueryMsg, ProcessedTxsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token3.issuer.clone(),
-                        currency: test_token3.currency.clone(),
-                        // Sending more than 5000000000000000 should fail because maximum holding amount is 5000000000000000
-                        amount: Uint128::new(6000000000000000),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+                &QueryMsg::ProcessedTxs {
+                    start_after_key: query_processed_txs.last_key,
+                    limit: None,
                 },
-                &[],
-                &signer,
             )
-            .unwrap_err();
-
-        assert!(precision_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
-                .to_string()
-                .as_str()
-        ));
+            .unwrap();
 
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token3.issuer.clone(),
-                        currency: test_token3.currency.clone(),
-                        // Sending less than 1000000000000000 will truncate to 0 so should fail
-                        amount: Uint128::new(900000000000000),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
-                },
-                &[],
-                &signer,
-            )
-            .unwrap_err();
+        assert_eq!(query_processed_txs.processed_txs.len(), 7);
+    }
 
-        assert!(precision_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
+    #[test]
+    fn precisions() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
 
+        let receiver = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer],
+            1,
+            7,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            10,
+        );
+
+        // *** Test with XRPL originated tokens ***
+
+        let test_token1 = XRPLToken {
+            issuer: generate_xrpl_address(),
+            currency: "TT1".to_string(),
+            sending_precision: -2,
+            max_holding_amount: Uint128::new(200000000000000000),
+            bridging_fee: Uint128::zero(),
+        };
+        let test_token2 = XRPLToken {
+            issuer: generate_xrpl_address().to_string(),
+            currency: "TT2".to_string(),
+            sending_precision: 13,
+            max_holding_amount: Uint128::new(499),
+            bridging_fee: Uint128::zero(),
+        };
+
+        let test_token3 = XRPLToken {
+            issuer: generate_xrpl_address().to_string(),
+            currency: "TT3".to_string(),
+            sending_precision: 0,
+            max_holding_amount: Uint128::new(5000000000000000),
+            bridging_fee: Uint128::zero(),
+        };
+
+        // Set up enough tickets first to allow registering tokens.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: test_token3.issuer.clone(),
-                    currency: test_token3.currency.clone(),
-                    // Sending 1111111111111111 should truncate the amount to 1000000000000000 and keep 111111111111111 as fees to collect
-                    amount: Uint128::new(1111111111111111),
-                    recipient: Addr::unchecked(receiver.address()),
-                },
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(8),
             },
-            &[],
+            &vec![],
             &signer,
         )
         .unwrap();
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "1000000000000000".to_string());
-
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: test_token3.issuer.clone(),
-                    currency: test_token3.currency.clone(),
-                    // Sending 3111111111111111 should truncate the amount to 3000000000000000 and keep another 111111111111111 as fees to collect
-                    amount: Uint128::new(3111111111111111),
-                    recipient: Addr::unchecked(receiver.address()),
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..9).collect()),
+                    }),
                 },
             },
-            &[],
+            &vec![],
             &signer,
         )
         .unwrap();
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "4000000000000000".to_string());
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
-            .unwrap();
+        // Test negative sending precisions
 
-        assert_eq!(request_balance.balance, "222222222222222".to_string());
+        // Register token
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: test_token1.issuer.clone(),
+                currency: test_token1.currency.clone(),
+                sending_precision: test_token1.sending_precision.clone(),
+                max_holding_amount: test_token1.max_holding_amount.clone(),
+                bridging_fee: test_token1.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
 
-        let maximum_amount_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: test_token2.issuer.clone(),
-                        currency: test_token2.currency.clone(),
-                        // Sending 1111111111111111 should truncate the amount to 1000000000000000 and should fail because bridge is already holding maximum
-                        amount: Uint128::new(1111111111111111),
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &[],
-                &signer,
             )
-            .unwrap_err();
-
-        assert!(maximum_amount_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
-                .to_string()
-                .as_str()
-        ));
+            .unwrap();
 
-        // Test sending XRP
         let denom = query_xrpl_tokens
             .tokens
             .iter()
-            .find(|t| t.issuer == XRP_ISSUER.to_string() && t.currency == XRP_CURRENCY.to_string())
+            .find(|t| t.issuer == test_token1.issuer && t.currency == test_token1.currency)
             .unwrap()
             .coreum_denom
             .clone();
 
+        // Activate the token
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
+
         let precision_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
                         tx_hash: generate_hash(),
-                        issuer: XRP_ISSUER.to_string(),
-                        currency: XRP_CURRENCY.to_string(),
-                        // Sending more than 100000000000000000 should fail because maximum holding amount is 10000000000000000 (1 less zero)
-                        amount: Uint128::new(100000000000000000),
+                        issuer: test_token1.issuer.clone(),
+                        currency: test_token1.currency.clone(),
+                        // Sending less than 100000000000000000, in this case 99999999999999999 (1 less digit) should return an error because it will truncate to zero
+                        amount: Uint128::new(99999999999999999),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -4962,7 +5377,7 @@ mod tests {
             .unwrap_err();
 
         assert!(precision_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
+            ContractError::AmountSentIsZeroAfterTruncation {}
                 .to_string()
                 .as_str()
         ));
@@ -4972,37 +5387,12 @@ mod tests {
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLToCoreumTransfer {
                     tx_hash: generate_hash(),
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    // There should never be truncation because we allow full precision for XRP initially
-                    amount: Uint128::one(),
-                    recipient: Addr::unchecked(receiver.address()),
-                },
-            },
-            &[],
-            &signer,
-        )
-        .unwrap();
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "1".to_string());
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    // This should work because we are sending the rest to reach the maximum amount
-                    amount: Uint128::new(9999999999999999),
+                    issuer: test_token1.issuer.clone(),
+                    currency: test_token1.currency.clone(),
+                    // Sending more than 199999999999999999 will truncate to 100000000000000000 and send it to the user and keep the remainder in the contract as fees to collect.
+                    amount: Uint128::new(199999999999999999),
                     recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -5017,19 +5407,20 @@ mod tests {
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "10000000000000000".to_string());
+        assert_eq!(request_balance.balance, "100000000000000000".to_string());
 
+        // Sending anything again should not work because we already sent the maximum amount possible including the fees in the contract.
         let maximum_amount_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
                         tx_hash: generate_hash(),
-                        issuer: XRP_ISSUER.to_string(),
-                        currency: XRP_CURRENCY.to_string(),
-                        // Sending 1 more token would surpass the maximum so should fail
-                        amount: Uint128::one(),
+                        issuer: test_token1.issuer.clone(),
+                        currency: test_token1.currency.clone(),
+                        amount: Uint128::new(100000000000000000),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -5043,158 +5434,120 @@ mod tests {
                 .as_str()
         ));
 
-        // *** Test with Coreum originated tokens ***
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
 
-        // Let's issue a few assets to the sender and registering them with different precisions and max sending amounts.
-        let asset_ft = AssetFT::new(&app);
-        for i in 1..=3 {
-            let symbol = "TEST".to_string() + &i.to_string();
-            let subunit = "utest".to_string() + &i.to_string();
-            asset_ft
-                .issue(
-                    MsgIssue {
-                        issuer: signer.address(),
-                        symbol,
-                        subunit,
-                        precision: 6,
-                        initial_amount: "100000000000000".to_string(),
-                        description: "description".to_string(),
-                        features: vec![MINTING as i32],
-                        burn_rate: "0".to_string(),
-                        send_commission_rate: "0".to_string(),
-                        uri: "uri".to_string(),
-                        uri_hash: "uri_hash".to_string(),
-                    },
-                    &signer,
-                )
-                .unwrap();
-        }
+        // Fees collected
+        assert_eq!(request_balance.balance, "99999999999999999".to_string());
 
-        let denom1 = format!("{}-{}", "utest1", signer.address()).to_lowercase();
-        let denom2 = format!("{}-{}", "utest2", signer.address()).to_lowercase();
-        let denom3 = format!("{}-{}", "utest3", signer.address()).to_lowercase();
+        // Test positive sending precisions
 
-        let test_tokens = vec![
-            CoreumToken {
-                denom: denom1.clone(),
-                decimals: 6,
-                sending_precision: 6,
-                max_holding_amount: Uint128::new(3),
-                bridging_fee: Uint128::zero(),
-            },
-            CoreumToken {
-                denom: denom2.clone(),
-                decimals: 6,
-                sending_precision: 0,
-                max_holding_amount: Uint128::new(3990000),
-                bridging_fee: Uint128::zero(),
-            },
-            CoreumToken {
-                denom: denom3.clone(),
-                decimals: 6,
-                sending_precision: -6,
-                max_holding_amount: Uint128::new(2000000000000),
-                bridging_fee: Uint128::zero(),
+        // Register token
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: test_token2.issuer.clone(),
+                currency: test_token2.currency.clone(),
+                sending_precision: test_token2.sending_precision.clone(),
+                max_holding_amount: test_token2.max_holding_amount.clone(),
+                bridging_fee: test_token2.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
-        ];
-
-        // Register the tokens
-
-        for token in test_tokens.clone() {
-            wasm.execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::RegisterCoreumToken {
-                    denom: token.denom,
-                    decimals: token.decimals,
-                    sending_precision: token.sending_precision,
-                    max_holding_amount: token.max_holding_amount,
-                    bridging_fee: token.bridging_fee,
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap();
-        }
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
 
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
+        // Activate the token
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &QueryMsg::CoreumTokens {
+                &QueryMsg::PendingOperations {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
-        assert_eq!(query_coreum_tokens.tokens.len(), 3);
-        assert_eq!(query_coreum_tokens.tokens[0].denom, test_tokens[0].denom);
-        assert_eq!(query_coreum_tokens.tokens[1].denom, test_tokens[1].denom);
-        assert_eq!(query_coreum_tokens.tokens[2].denom, test_tokens[2].denom);
-
-        // Test sending token 1 with high precision
 
-        // Sending 2 would work as it hasn't reached the maximum holding amount yet
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: generate_xrpl_address(),
-                deliver_amount: None,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
             },
-            &coins(2, denom1.clone()),
+            &[],
             &signer,
         )
         .unwrap();
 
-        // Sending 1 more will hit max amount but will not fail
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: generate_xrpl_address(),
-                deliver_amount: None,
-            },
-            &coins(1, denom1.clone()),
-            &signer,
-        )
-        .unwrap();
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
 
-        // Trying to send 1 again would fail because we go over max bridge amount
-        let maximum_amount_error = wasm
+        let denom = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == test_token2.issuer && t.currency == test_token2.currency)
+            .unwrap()
+            .coreum_denom
+            .clone();
+
+        let precision_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token2.issuer.clone(),
+                        currency: test_token2.currency.clone(),
+                        // Sending more than 499 should fail because maximum holding amount is 499
+                        amount: Uint128::new(500),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(1, denom1.clone()),
+                &[],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(maximum_amount_error.to_string().contains(
+        assert!(precision_error.to_string().contains(
             ContractError::MaximumBridgedAmountReached {}
                 .to_string()
                 .as_str()
         ));
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom1.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "3".to_string());
-
-        // Test sending token 2 with medium precision
-
-        // Sending under sending precision would return error because it will be truncated to 0.
         let precision_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token2.issuer.clone(),
+                        currency: test_token2.currency.clone(),
+                        // Sending less than 100 will truncate to 0 so should fail
+                        amount: Uint128::new(99),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(100000, denom2.clone()),
+                &[],
                 &signer,
             )
             .unwrap_err();
@@ -5205,46 +5558,84 @@ mod tests {
                 .as_str()
         ));
 
-        // Sending 3990000 would work as it is the maximum bridgable amount
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: generate_xrpl_address(),
-                deliver_amount: None,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: test_token2.issuer.clone(),
+                    currency: test_token2.currency.clone(),
+                    // Sending 299 should truncate the amount to 200 and keep the 99 in the contract as fees to collect
+                    amount: Uint128::new(299),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
+                },
             },
-            &coins(3990000, denom2.clone()),
+            &[],
             &signer,
         )
         .unwrap();
 
-        // Sending 100000 will fail because truncating will truncate to 0.
-        let precision_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "200".to_string());
+
+        // Sending 200 should work because we will reach exactly the maximum bridged amount.
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: test_token2.issuer.clone(),
+                    currency: test_token2.currency.clone(),
+                    amount: Uint128::new(200),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
                 },
-                &coins(100000, denom2.clone()),
-                &signer,
-            )
-            .unwrap_err();
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
 
-        assert!(precision_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
 
-        // Trying to send 1000000 would fail because we go over max bridge amount
+        assert_eq!(request_balance.balance, "400".to_string());
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "99".to_string());
+
+        // Sending anything again should fail because we passed the maximum bridged amount
         let maximum_amount_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token2.issuer.clone(),
+                        currency: test_token2.currency.clone(),
+                        amount: Uint128::new(199),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(1000000, denom2.clone()),
+                &[],
                 &signer,
             )
             .unwrap_err();
@@ -5255,589 +5646,744 @@ mod tests {
                 .as_str()
         ));
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom2.clone(),
-            })
-            .unwrap();
+        // Test 0 sending precision
 
-        assert_eq!(request_balance.balance, "3990000".to_string());
+        // Register token
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: test_token3.issuer.clone(),
+                currency: test_token3.currency.clone(),
+                sending_precision: test_token3.sending_precision.clone(),
+                max_holding_amount: test_token3.max_holding_amount.clone(),
+                bridging_fee: test_token3.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
 
-        // Test sending token 3 with low precision
+        // Activate the token
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
 
-        // Sending 2000000000000 would work as it is the maximum bridgable amount
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: generate_xrpl_address(),
-                deliver_amount: None,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
             },
-            &coins(2000000000000, denom3.clone()),
+            &[],
             &signer,
         )
         .unwrap();
 
-        // Sending 200000000000 (1 less zero) will fail because truncating will truncate to 0.
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let denom = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == test_token3.issuer && t.currency == test_token3.currency)
+            .unwrap()
+            .coreum_denom
+            .clone();
+
         let precision_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token3.issuer.clone(),
+                        currency: test_token3.currency.clone(),
+                        // Sending more than 5000000000000000 should fail because maximum holding amount is 5000000000000000
+                        amount: Uint128::new(6000000000000000),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(200000000000, denom3.clone()),
+                &[],
                 &signer,
             )
             .unwrap_err();
 
         assert!(precision_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
+            ContractError::MaximumBridgedAmountReached {}
                 .to_string()
                 .as_str()
         ));
 
-        // Trying to send 1000000000000 would fail because we go over max bridge amount
-        let maximum_amount_error = wasm
+        let precision_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token3.issuer.clone(),
+                        currency: test_token3.currency.clone(),
+                        // Sending less than 1000000000000000 will truncate to 0 so should fail
+                        amount: Uint128::new(900000000000000),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &coins(1000000000000, denom3.clone()),
+                &[],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(maximum_amount_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
+        assert!(precision_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
                 .to_string()
                 .as_str()
         ));
 
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: test_token3.issuer.clone(),
+                    currency: test_token3.currency.clone(),
+                    // Sending 1111111111111111 should truncate the amount to 1000000000000000 and keep 111111111111111 as fees to collect
+                    amount: Uint128::new(1111111111111111),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
+
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom3.clone(),
+                account: receiver.address(),
+                denom: denom.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "2000000000000".to_string());
-    }
-
-    #[test]
-    fn bridge_fee_collection_and_claiming() {
-        let app = CoreumTestApp::new();
-        let accounts_number = 5;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
-            .unwrap();
-
-        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
-        let receiver = accounts.get((accounts_number - 2) as usize).unwrap();
-        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
-        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
-
-        let mut relayer_accounts = vec![];
-        let mut relayers = vec![];
-
-        for i in 0..accounts_number - 2 {
-            relayer_accounts.push(accounts.get(i as usize).unwrap());
-            relayers.push(Relayer {
-                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
-                xrpl_address: xrpl_addresses[i as usize].to_string(),
-                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
-            });
-        }
-
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
-        let xrpl_base_fee = 10;
-
-        let bridge_xrpl_address = generate_xrpl_address();
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            &signer,
-            Addr::unchecked(signer.address()),
-            vec![
-                relayers[0].clone(),
-                relayers[1].clone(),
-                relayers[2].clone(),
-            ],
-            3,
-            14,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            bridge_xrpl_address.clone(),
-            xrpl_base_fee,
-        );
+        assert_eq!(request_balance.balance, "1000000000000000".to_string());
 
-        // Recover enough tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(15),
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: test_token3.issuer.clone(),
+                    currency: test_token3.currency.clone(),
+                    // Sending 3111111111111111 should truncate the amount to 3000000000000000 and keep another 111111111111111 as fees to collect
+                    amount: Uint128::new(3111111111111111),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
+                },
             },
-            &vec![],
+            &[],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "4000000000000000".to_string());
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "222222222222222".to_string());
+
+        let maximum_amount_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: Some((1..16).collect()),
-                        }),
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: test_token2.issuer.clone(),
+                        currency: test_token2.currency.clone(),
+                        // Sending 1111111111111111 should truncate the amount to 1000000000000000 and should fail because bridge is already holding maximum
+                        amount: Uint128::new(1111111111111111),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &vec![],
-                relayer,
+                &[],
+                &signer,
             )
-            .unwrap();
-        }
+            .unwrap_err();
 
-        // We are going to issue 2 tokens, one XRPL originated and one Coreum originated, with different fees.
-        let test_token_xrpl = XRPLToken {
-            issuer: generate_xrpl_address(), // Valid issuer
-            currency: "USD".to_string(),     // Valid standard currency code
-            sending_precision: 10,
-            max_holding_amount: Uint128::new(5000000000000000), // 5e15
-            bridging_fee: Uint128::new(50000),                  // 5e4
-        };
+        assert!(maximum_amount_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
 
-        let symbol = "TEST".to_string();
-        let subunit = "utest".to_string();
-        let decimals = 6;
-        let initial_amount = Uint128::new(100000000);
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: receiver.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
+        // Test sending XRP
+        let denom = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == XRP_ISSUER.to_string() && t.currency == XRP_CURRENCY.to_string())
+            .unwrap()
+            .coreum_denom
+            .clone();
+
+        let precision_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        // Sending more than 100000000000000000 should fail because maximum holding amount is 10000000000000000 (1 less zero)
+                        amount: Uint128::new(100000000000000000),
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &receiver,
+                &[],
+                &signer,
             )
-            .unwrap();
-
-        let coreum_token_denom = format!("{}-{}", subunit, receiver.address()).to_lowercase();
+            .unwrap_err();
 
-        let test_token_coreum = CoreumToken {
-            denom: coreum_token_denom.clone(),
-            decimals,
-            sending_precision: 4,
-            max_holding_amount: Uint128::new(10000000000), // 1e10
-            bridging_fee: Uint128::new(300000),            // 3e5
-        };
+        assert!(precision_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
 
-        // Register XRPL originated token and confirm trust set
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: test_token_xrpl.issuer.clone(),
-                currency: test_token_xrpl.currency.clone(),
-                sending_precision: test_token_xrpl.sending_precision,
-                max_holding_amount: test_token_xrpl.max_holding_amount,
-                bridging_fee: test_token_xrpl.bridging_fee,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    // There should never be truncation because we allow full precision for XRP initially
+                    amount: Uint128::one(),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
+                },
             },
-            &query_issue_fee(&asset_ft),
+            &[],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: None,
-                        ticket_sequence: Some(1),
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: None,
-                    },
-                },
-                &vec![],
-                relayer,
-            )
-            .unwrap();
-        }
-
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
-                &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
             .unwrap();
 
-        let xrpl_token = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == test_token_xrpl.issuer && t.currency == test_token_xrpl.currency)
-            .unwrap();
+        assert_eq!(request_balance.balance, "1".to_string());
 
-        // Register Coreum originated token
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: test_token_coreum.denom,
-                decimals: test_token_coreum.decimals,
-                sending_precision: test_token_coreum.sending_precision,
-                max_holding_amount: test_token_coreum.max_holding_amount,
-                bridging_fee: test_token_coreum.bridging_fee,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: generate_hash(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    // This should work because we are sending the rest to reach the maximum amount
+                    amount: Uint128::new(9999999999999999),
+                    recipient: Addr::unchecked(receiver.address()),
+                    destination_chain_recipient: None,
+                },
             },
-            &vec![],
+            &[],
             &signer,
         )
         .unwrap();
 
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: denom.clone(),
+            })
             .unwrap();
 
-        let coreum_token = query_coreum_tokens
-            .tokens
-            .iter()
-            .find(|t| t.denom == coreum_token_denom)
-            .unwrap();
+        assert_eq!(request_balance.balance, "10000000000000000".to_string());
 
-        // Let's bridge some tokens from XRPL to Coreum multiple times and verify that the fees are collected correctly in each step
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        let maximum_amount_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: test_token_xrpl.issuer.clone(),
-                        currency: test_token_xrpl.currency.clone(),
-                        amount: Uint128::new(1000000000050000), // 1e15 + 5e4 --> This should take the bridging fee (5e4) and truncate nothing
+                        tx_hash: generate_hash(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        // Sending 1 more token would surpass the maximum so should fail
+                        amount: Uint128::one(),
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
-                relayer,
+                &signer,
             )
-            .unwrap();
+            .unwrap_err();
+
+        assert!(maximum_amount_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
+
+        // *** Test with Coreum originated tokens ***
+
+        // Let's issue a few assets to the sender and registering them with different precisions and max sending amounts.
+        let asset_ft = AssetFT::new(&app);
+        for i in 1..=3 {
+            let symbol = "TEST".to_string() + &i.to_string();
+            let subunit = "utest".to_string() + &i.to_string();
+            asset_ft
+                .issue(
+                    MsgIssue {
+                        issuer: signer.address(),
+                        symbol,
+                        subunit,
+                        precision: 6,
+                        initial_amount: "100000000000000".to_string(),
+                        description: "description".to_string(),
+                        features: vec![MINTING as i32],
+                        burn_rate: "0".to_string(),
+                        send_commission_rate: "0".to_string(),
+                        uri: "uri".to_string(),
+                        uri_hash: "uri_hash".to_string(),
+                    },
+                    &signer,
+                )
+                .unwrap();
         }
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: xrpl_token.coreum_denom.clone(),
-            })
-            .unwrap();
+        let denom1 = format!("{}-{}", "utest1", signer.address()).to_lowercase();
+        let denom2 = format!("{}-{}", "utest2", signer.address()).to_lowercase();
+        let denom3 = format!("{}-{}", "utest3", signer.address()).to_lowercase();
 
-        assert_eq!(request_balance.balance, "1000000000000000".to_string());
+        let test_tokens = vec![
+            CoreumToken {
+                denom: denom1.clone(),
+                decimals: 6,
+                sending_precision: 6,
+                max_holding_amount: Uint128::new(3),
+                bridging_fee: Uint128::zero(),
+            },
+            CoreumToken {
+                denom: denom2.clone(),
+                decimals: 6,
+                sending_precision: 0,
+                max_holding_amount: Uint128::new(3990000),
+                bridging_fee: Uint128::zero(),
+            },
+            CoreumToken {
+                denom: denom3.clone(),
+                decimals: 6,
+                sending_precision: -6,
+                max_holding_amount: Uint128::new(2000000000000),
+                bridging_fee: Uint128::zero(),
+            },
+        ];
 
-        // If we query fees for any random address that has no fees collected, it should return an empty array
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
+        // Register the tokens
+
+        for token in test_tokens.clone() {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked("any_address"),
+                &ExecuteMsg::RegisterCoreumToken {
+                    denom: token.denom,
+                    decimals: token.decimals,
+                    sending_precision: token.sending_precision,
+                    max_holding_amount: token.max_holding_amount,
+                    bridging_fee: token.bridging_fee,
+                    max_sends_per_address_per_day: None,
                 },
+                &vec![],
+                &signer,
             )
             .unwrap();
+        }
 
-        assert_eq!(query_fees_collected.fees_collected, vec![]);
-
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
             )
             .unwrap();
+        assert_eq!(query_coreum_tokens.tokens.len(), 3);
+        assert_eq!(query_coreum_tokens.tokens[0].denom, test_tokens[0].denom);
+        assert_eq!(query_coreum_tokens.tokens[1].denom, test_tokens[1].denom);
+        assert_eq!(query_coreum_tokens.tokens[2].denom, test_tokens[2].denom);
 
-        // 50000 / 3 = 16666.67 ---> Which means each relayer will have 16666 to claim and 2 tokens will stay in the fee remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![coin(16666, xrpl_token.coreum_denom.clone())]
-        );
+        // Test sending token 1 with high precision
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // Sending 2 would work as it hasn't reached the maximum holding amount yet
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
+            },
+            &coins(2, denom1.clone()),
+            &signer,
+        )
+        .unwrap();
+
+        // Sending 1 more will hit max amount but will not fail
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
+            },
+            &coins(1, denom1.clone()),
+            &signer,
+        )
+        .unwrap();
+
+        // Trying to send 1 again would fail because we go over max bridge amount
+        let maximum_amount_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: test_token_xrpl.issuer.clone(),
-                        currency: test_token_xrpl.currency.clone(),
-                        amount: Uint128::new(1000000000040000), // 1e15 + 4e4 --> This should take the bridging fee -> 1999999999990000 and truncate -> 1999999999900000
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
-                &[],
-                relayer,
+                &coins(1, denom1.clone()),
+                &signer,
             )
-            .unwrap();
-        }
+            .unwrap_err();
+
+        assert!(maximum_amount_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
 
         let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: xrpl_token.coreum_denom.clone(),
+                account: contract_addr.clone(),
+                denom: denom1.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "1999999999900000".to_string());
+        assert_eq!(request_balance.balance, "3".to_string());
 
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
+        // Test sending token 2 with medium precision
+
+        // Sending under sending precision would return error because it will be truncated to 0.
+        let precision_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(100000, denom2.clone()),
+                &signer,
             )
-            .unwrap();
+            .unwrap_err();
 
-        // Each relayer is getting 140000 (+2 that were in the remainder) / 3 -> 140002 / 3 = 46667 and 1 token will stay in the remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![coin(63333, xrpl_token.coreum_denom.clone())] // 16666 from before + 46667
-        );
+        assert!(precision_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
+                .to_string()
+                .as_str()
+        ));
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // Sending 3990000 would work as it is the maximum bridgable amount
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
+            },
+            &coins(3990000, denom2.clone()),
+            &signer,
+        )
+        .unwrap();
+
+        // Sending 100000 will fail because truncating will truncate to 0.
+        let precision_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: test_token_xrpl.issuer.clone(),
-                        currency: test_token_xrpl.currency.clone(),
-                        amount: Uint128::new(1000000000000000), // 1e15 --> This should charge bridging fee -> 1999999999950000 and truncate -> 1999999999900000
-                        recipient: Addr::unchecked(receiver.address()),
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
-                &[],
-                relayer,
+                &coins(100000, denom2.clone()),
+                &signer,
             )
-            .unwrap();
-        }
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: receiver.address(),
-                denom: xrpl_token.coreum_denom.clone(),
-            })
-            .unwrap();
+            .unwrap_err();
 
-        assert_eq!(request_balance.balance, "2999999999800000".to_string());
+        assert!(precision_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
+                .to_string()
+                .as_str()
+        ));
 
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
+        // Trying to send 1000000 would fail because we go over max bridge amount
+        let maximum_amount_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(1000000, denom2.clone()),
+                &signer,
             )
-            .unwrap();
+            .unwrap_err();
 
-        // Each relayer is getting 100000 (+1 from remainder) / 3 -> 100001 / 3 = 33333 and 2 token will stay in the remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![coin(96666, xrpl_token.coreum_denom.clone())] // 63333 from before + 33333
-        );
+        assert!(maximum_amount_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
 
-        // Check that contract holds those tokens.
-        let query_contract_balance = asset_ft
+        let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: contract_addr.clone(),
-                denom: xrpl_token.coreum_denom.clone(),
+                denom: denom2.clone(),
             })
             .unwrap();
-        assert_eq!(query_contract_balance.balance, "290000".to_string()); // 96666 * 3 + 2 in the remainder
 
-        // Let's try to bridge some tokens back from Coreum to XRPL and verify that the fees are also collected correctly
-        let xrpl_receiver_address = generate_xrpl_address();
+        assert_eq!(request_balance.balance, "3990000".to_string());
+
+        // Test sending token 3 with low precision
+
+        // Sending 2000000000000 would work as it is the maximum bridgable amount
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
+                recipient: generate_xrpl_address(),
                 deliver_amount: None,
             },
-            &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // This should charge the bridging fee -> 999999999970000 and then truncate the rest -> 999999999900000
-            &receiver,
+            &coins(2000000000000, denom3.clone()),
+            &signer,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // Sending 200000000000 (1 less zero) will fail because truncating will truncate to 0.
+        let precision_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(200000000000, denom3.clone()),
+                &signer,
             )
-            .unwrap();
+            .unwrap_err();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(2),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: test_token_xrpl.issuer.clone(),
-                    currency: test_token_xrpl.currency.clone(),
-                    amount: Uint128::new(999999999900000),
-                    max_amount: Some(Uint128::new(999999999900000)),
-                    sender: Addr::unchecked(receiver.address()),
-                    recipient: xrpl_receiver_address.clone(),
-                },
-                xrpl_base_fee,
-            }
-        );
+        assert!(precision_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
+                .to_string()
+                .as_str()
+        ));
 
-        // Confirm operation to clear tokens from contract
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // Trying to send 1000000000000 would fail because we go over max bridge amount
+        let maximum_amount_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: query_pending_operations.operations[0].account_sequence,
-                        ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: None,
-                    },
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
-                &[],
-                relayer,
+                &coins(1000000000000, denom3.clone()),
+                &signer,
             )
-            .unwrap();
-        }
+            .unwrap_err();
 
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
-                &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
-                },
-            )
+        assert!(maximum_amount_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom3.clone(),
+            })
             .unwrap();
 
-        // Each relayer is getting 120000 (+2 from remainder) / 3 -> 120002 / 3 = 40000 and 2 token will stay in the remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![coin(136666, xrpl_token.coreum_denom.clone())] // 96666 from before + 40000
-        );
+        assert_eq!(request_balance.balance, "2000000000000".to_string());
+    }
 
-        // Let's bridge some tokens again but this time with the optional amount, to check that bridge fees are collected correctly and
-        // when rejected, full amount without bridge fees is available to be claimed back by user.
-        let deliver_amount = Some(Uint128::new(700000000020000));
+    #[test]
+    fn bridge_fee_collection_and_claiming() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 5;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
 
-        // If we send an amount, that after truncation and bridge fees is higher than max amount, it should fail
-        let max_amount_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: Some(Uint128::new(1000000000010000)),
-                },
-                &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // After fees and truncation -> 1000000000000000 > 999999999900000
-                &receiver,
-            )
-            .unwrap_err();
+        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
+        let receiver = accounts.get((accounts_number - 2) as usize).unwrap();
+        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
+        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
 
-        assert!(max_amount_error
-            .to_string()
-            .contains(ContractError::InvalidDeliverAmount {}.to_string().as_str()));
+        let mut relayer_accounts = vec![];
+        let mut relayers = vec![];
+
+        for i in 0..accounts_number - 2 {
+            relayer_accounts.push(accounts.get(i as usize).unwrap());
+            relayers.push(Relayer {
+                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
+                xrpl_address: xrpl_addresses[i as usize].to_string(),
+                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
+            });
+        }
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let xrpl_base_fee = 10;
+
+        let bridge_xrpl_address = generate_xrpl_address();
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![
+                relayers[0].clone(),
+                relayers[1].clone(),
+                relayers[2].clone(),
+            ],
+            3,
+            14,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            bridge_xrpl_address.clone(),
+            xrpl_base_fee,
+        );
 
+        // Recover enough tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount, // This will be truncated to 700000000000000
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(15),
             },
-            &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // This should charge the bridging fee -> 999999999970000 and then truncate the rest -> 999999999900000
-            &receiver,
+            &vec![],
+            &signer,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some((1..16).collect()),
+                        }),
+                    },
                 },
+                &vec![],
+                relayer,
             )
             .unwrap();
+        }
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(3),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: test_token_xrpl.issuer.clone(),
-                    currency: test_token_xrpl.currency.clone(),
-                    amount: Uint128::new(700000000000000),
-                    max_amount: Some(Uint128::new(999999999900000)),
-                    sender: Addr::unchecked(receiver.address()),
-                    recipient: xrpl_receiver_address.clone(),
-                },
-                xrpl_base_fee
-            }
-        );
+        // We are going to issue 2 tokens, one XRPL originated and one Coreum originated, with different fees.
+        let test_token_xrpl = XRPLToken {
+            issuer: generate_xrpl_address(), // Valid issuer
+            currency: "USD".to_string(),     // Valid standard currency code
+            sending_precision: 10,
+            max_holding_amount: Uint128::new(5000000000000000), // 5e15
+            bridging_fee: Uint128::new(50000),                  // 5e4
+        };
 
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
-                &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+        let symbol = "TEST".to_string();
+        let subunit = "utest".to_string();
+        let decimals = 6;
+        let initial_amount = Uint128::new(100000000);
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: receiver.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
                 },
+                &receiver,
             )
             .unwrap();
 
-        // Each relayer is getting 120000 (+2 from remainder) / 3 -> 120002 / 3 = 40000 and 2 token will stay in the remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![coin(176666, xrpl_token.coreum_denom.clone())] // 136666 from before + 40000
-        );
+        let coreum_token_denom = format!("{}-{}", subunit, receiver.address()).to_lowercase();
+
+        let test_token_coreum = CoreumToken {
+            denom: coreum_token_denom.clone(),
+            decimals,
+            sending_precision: 4,
+            max_holding_amount: Uint128::new(10000000000), // 1e10
+            bridging_fee: Uint128::new(300000),            // 3e5
+        };
+
+        // Register XRPL originated token and confirm trust set
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: test_token_xrpl.issuer.clone(),
+                currency: test_token_xrpl.currency.clone(),
+                sending_precision: test_token_xrpl.sending_precision,
+                max_holding_amount: test_token_xrpl.max_holding_amount,
+                bridging_fee: test_token_xrpl.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
 
-        // If we reject the operation, 999999999900000 (max_amount after bridge fees and truncation) should be able to be claimed back by the user
         let tx_hash = generate_hash();
         for relayer in relayer_accounts.iter() {
             wasm.execute::<ExecuteMsg>(
@@ -5845,74 +6391,229 @@ mod tests {
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLTransactionResult {
                         tx_hash: Some(tx_hash.clone()),
-                        account_sequence: query_pending_operations.operations[0].account_sequence,
-                        ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                        transaction_result: TransactionResult::Rejected,
+                        account_sequence: None,
+                        ticket_sequence: Some(1),
+                        transaction_result: TransactionResult::Accepted,
                         operation_result: None,
                     },
                 },
-                &[],
+                &vec![],
                 relayer,
             )
             .unwrap();
         }
 
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(receiver.address()),
+                &QueryMsg::XRPLTokens {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
-        assert_eq!(
-            query_pending_refunds.pending_refunds[0].coin,
-            coin(999999999900000, xrpl_token.coreum_denom.clone())
-        );
+        let xrpl_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == test_token_xrpl.issuer && t.currency == test_token_xrpl.currency)
+            .unwrap();
 
-        // Let's claim it back
+        // Register Coreum originated token
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ClaimRefund {
-                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: test_token_coreum.denom,
+                decimals: test_token_coreum.decimals,
+                sending_precision: test_token_coreum.sending_precision,
+                max_holding_amount: test_token_coreum.max_holding_amount,
+                bridging_fee: test_token_coreum.bridging_fee,
+                max_sends_per_address_per_day: None,
             },
-            &[],
-            &receiver,
+            &vec![],
+            &signer,
         )
         .unwrap();
 
-        // Now let's bridge tokens from Coreum to XRPL and verify that the fees are collected correctly in each step and accumulated with the previous ones
-
-        // Trying to send less than the bridging fees should fail
-        let bridging_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: xrpl_receiver_address.clone(),
-                    deliver_amount: None,
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &coins(100, coreum_token_denom.clone()),
-                &receiver,
             )
-            .unwrap_err();
-
-        assert!(bridging_error.to_string().contains(
-            ContractError::CannotCoverBridgingFees {}
-                .to_string()
-                .as_str()
-        ));
+            .unwrap();
 
+        let coreum_token = query_coreum_tokens
+            .tokens
+            .iter()
+            .find(|t| t.denom == coreum_token_denom)
+            .unwrap();
+
+        // Let's bridge some tokens from XRPL to Coreum multiple times and verify that the fees are collected correctly in each step
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: test_token_xrpl.issuer.clone(),
+                        currency: test_token_xrpl.currency.clone(),
+                        amount: Uint128::new(1000000000050000), // 1e15 + 5e4 --> This should take the bridging fee (5e4) and truncate nothing
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: xrpl_token.coreum_denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "1000000000000000".to_string());
+
+        // If we query fees for any random address that has no fees collected, it should return an empty array
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked("any_address"),
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_fees_collected.fees_collected, vec![]);
+
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // 50000 / 3 = 16666.67 ---> Which means each relayer will have 16666 to claim and 2 tokens will stay in the fee remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![coin(16666, xrpl_token.coreum_denom.clone())]
+        );
+
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: test_token_xrpl.issuer.clone(),
+                        currency: test_token_xrpl.currency.clone(),
+                        amount: Uint128::new(1000000000040000), // 1e15 + 4e4 --> This should take the bridging fee -> 1999999999990000 and truncate -> 1999999999900000
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: xrpl_token.coreum_denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "1999999999900000".to_string());
+
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // Each relayer is getting 140000 (+2 that were in the remainder) / 3 -> 140002 / 3 = 46667 and 1 token will stay in the remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![coin(63333, xrpl_token.coreum_denom.clone())] // 16666 from before + 46667
+        );
+
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: test_token_xrpl.issuer.clone(),
+                        currency: test_token_xrpl.currency.clone(),
+                        amount: Uint128::new(1000000000000000), // 1e15 --> This should charge bridging fee -> 1999999999950000 and truncate -> 1999999999900000
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: xrpl_token.coreum_denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "2999999999800000".to_string());
+
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // Each relayer is getting 100000 (+1 from remainder) / 3 -> 100001 / 3 = 33333 and 2 token will stay in the remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![coin(96666, xrpl_token.coreum_denom.clone())] // 63333 from before + 33333
+        );
+
+        // Check that contract holds those tokens.
+        let query_contract_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: xrpl_token.coreum_denom.clone(),
+            })
+            .unwrap();
+        assert_eq!(query_contract_balance.balance, "290000".to_string()); // 96666 * 3 + 2 in the remainder
+
+        // Let's try to bridge some tokens back from Coreum to XRPL and verify that the fees are also collected correctly
+        let xrpl_receiver_address = generate_xrpl_address();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
                 deliver_amount: None,
             },
-            &coins(600010, coreum_token_denom.clone()), // This should charge briding fee -> 300010 and then truncate the rest -> 300000
+            &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // This should charge the bridging fee -> 999999999970000 and then truncate the rest -> 999999999900000
             &receiver,
         )
         .unwrap();
@@ -5933,40 +6634,22 @@ mod tests {
             Operation {
                 id: query_pending_operations.operations[0].id.clone(),
                 version: 1,
-                ticket_sequence: Some(4),
+                ticket_sequence: Some(2),
                 account_sequence: None,
                 signatures: vec![],
                 operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: bridge_xrpl_address.clone(),
-                    currency: coreum_token.xrpl_currency.clone(),
-                    amount: Uint128::new(300000000000000),
-                    max_amount: Some(Uint128::new(300000000000000)),
+                    issuer: test_token_xrpl.issuer.clone(),
+                    currency: test_token_xrpl.currency.clone(),
+                    amount: Uint128::new(999999999900000),
+                    max_amount: Some(Uint128::new(999999999900000)),
                     sender: Addr::unchecked(receiver.address()),
                     recipient: xrpl_receiver_address.clone(),
                 },
-                xrpl_base_fee
+                xrpl_base_fee,
             }
         );
 
-        let query_fees_collected = wasm
-            .query::<QueryMsg, FeesCollectedResponse>(
-                &contract_addr,
-                &QueryMsg::FeesCollected {
-                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
-                },
-            )
-            .unwrap();
-
-        // Each relayer is getting 300010 / 3 -> 100003 and 1 token will stay in the remainders for next collection
-        assert_eq!(
-            query_fees_collected.fees_collected,
-            vec![
-                coin(176666, xrpl_token.coreum_denom.clone()),
-                coin(100003, coreum_token_denom.clone())
-            ]
-        );
-
-        // Confirm operation
+        // Confirm operation to clear tokens from contract
         let tx_hash = generate_hash();
         for relayer in relayer_accounts.iter() {
             wasm.execute::<ExecuteMsg>(
@@ -5986,13 +6669,49 @@ mod tests {
             .unwrap();
         }
 
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // Each relayer is getting 120000 (+2 from remainder) / 3 -> 120002 / 3 = 40000 and 2 token will stay in the remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![coin(136666, xrpl_token.coreum_denom.clone())] // 96666 from before + 40000
+        );
+
+        // Let's bridge some tokens again but this time with the optional amount, to check that bridge fees are collected correctly and
+        // when rejected, full amount without bridge fees is available to be claimed back by user.
+        let deliver_amount = Some(Uint128::new(700000000020000));
+
+        // If we send an amount, that after truncation and bridge fees is higher than max amount, it should fail
+        let max_amount_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: Some(Uint128::new(1000000000010000)),
+                },
+                &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // After fees and truncation -> 1000000000000000 > 999999999900000
+                &receiver,
+            )
+            .unwrap_err();
+
+        assert!(max_amount_error
+            .to_string()
+            .contains(ContractError::InvalidDeliverAmount {}.to_string().as_str()));
+
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
                 recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
+                deliver_amount, // This will be truncated to 700000000000000
             },
-            &coins(900000, coreum_token_denom.clone()), // This charge the entire bridging fee (300000) and truncate nothing
+            &coins(1000000000020000, xrpl_token.coreum_denom.clone()), // This should charge the bridging fee -> 999999999970000 and then truncate the rest -> 999999999900000
             &receiver,
         )
         .unwrap();
@@ -6013,18 +6732,18 @@ mod tests {
             Operation {
                 id: query_pending_operations.operations[0].id.clone(),
                 version: 1,
-                ticket_sequence: Some(5),
+                ticket_sequence: Some(3),
                 account_sequence: None,
                 signatures: vec![],
                 operation_type: OperationType::CoreumToXRPLTransfer {
-                    issuer: bridge_xrpl_address.clone(),
-                    currency: coreum_token.xrpl_currency.clone(),
-                    amount: Uint128::new(600000000000000),
-                    max_amount: Some(Uint128::new(600000000000000)),
+                    issuer: test_token_xrpl.issuer.clone(),
+                    currency: test_token_xrpl.currency.clone(),
+                    amount: Uint128::new(700000000000000),
+                    max_amount: Some(Uint128::new(999999999900000)),
                     sender: Addr::unchecked(receiver.address()),
                     recipient: xrpl_receiver_address.clone(),
                 },
-                xrpl_base_fee,
+                xrpl_base_fee
             }
         );
 
@@ -6037,16 +6756,13 @@ mod tests {
             )
             .unwrap();
 
-        // Each relayer is getting 300000 (+1 from remainder) / 3 -> 100000 and 1 token will stay in the remainders for next collection
+        // Each relayer is getting 120000 (+2 from remainder) / 3 -> 120002 / 3 = 40000 and 2 token will stay in the remainders for next collection
         assert_eq!(
             query_fees_collected.fees_collected,
-            vec![
-                coin(176666, xrpl_token.coreum_denom.clone()),
-                coin(200003, coreum_token_denom.clone()) // 100003 + 100000
-            ]
+            vec![coin(176666, xrpl_token.coreum_denom.clone())] // 136666 from before + 40000
         );
 
-        // Confirm operation
+        // If we reject the operation, 999999999900000 (max_amount after bridge fees and truncation) should be able to be claimed back by the user
         let tx_hash = generate_hash();
         for relayer in relayer_accounts.iter() {
             wasm.execute::<ExecuteMsg>(
@@ -6056,7 +6772,216 @@ mod tests {
                         tx_hash: Some(tx_hash.clone()),
                         account_sequence: query_pending_operations.operations[0].account_sequence,
                         ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                        transaction_result: TransactionResult::Accepted,
+                        transaction_result: TransactionResult::Rejected,
+                        operation_result: None,
+                    },
+                },
+                &[],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(receiver.address()),
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].coin,
+            coin(999999999900000, xrpl_token.coreum_denom.clone())
+        );
+
+        // Let's claim it back
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ClaimRefund {
+                pending_refund_id: query_pending_refunds.pending_refunds[0].id.clone(),
+            },
+            &[],
+            &receiver,
+        )
+        .unwrap();
+
+        // Now let's bridge tokens from Coreum to XRPL and verify that the fees are collected correctly in each step and accumulated with the previous ones
+
+        // Trying to send less than the bridging fees should fail
+        let bridging_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: xrpl_receiver_address.clone(),
+                    deliver_amount: None,
+                },
+                &coins(100, coreum_token_denom.clone()),
+                &receiver,
+            )
+            .unwrap_err();
+
+        assert!(bridging_error.to_string().contains(
+            ContractError::CannotCoverBridgingFees {}
+                .to_string()
+                .as_str()
+        ));
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
+            },
+            &coins(600010, coreum_token_denom.clone()), // This should charge briding fee -> 300010 and then truncate the rest -> 300000
+            &receiver,
+        )
+        .unwrap();
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(4),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::CoreumToXRPLTransfer {
+                    issuer: bridge_xrpl_address.clone(),
+                    currency: coreum_token.xrpl_currency.clone(),
+                    amount: Uint128::new(300000000000000),
+                    max_amount: Some(Uint128::new(300000000000000)),
+                    sender: Addr::unchecked(receiver.address()),
+                    recipient: xrpl_receiver_address.clone(),
+                },
+                xrpl_base_fee
+            }
+        );
+
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // Each relayer is getting 300010 / 3 -> 100003 and 1 token will stay in the remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![
+                coin(176666, xrpl_token.coreum_denom.clone()),
+                coin(100003, coreum_token_denom.clone())
+            ]
+        );
+
+        // Confirm operation
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: query_pending_operations.operations[0].account_sequence,
+                        ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: None,
+                    },
+                },
+                &[],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
+            },
+            &coins(900000, coreum_token_denom.clone()), // This charge the entire bridging fee (300000) and truncate nothing
+            &receiver,
+        )
+        .unwrap();
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(5),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::CoreumToXRPLTransfer {
+                    issuer: bridge_xrpl_address.clone(),
+                    currency: coreum_token.xrpl_currency.clone(),
+                    amount: Uint128::new(600000000000000),
+                    max_amount: Some(Uint128::new(600000000000000)),
+                    sender: Addr::unchecked(receiver.address()),
+                    recipient: xrpl_receiver_address.clone(),
+                },
+                xrpl_base_fee,
+            }
+        );
+
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
+                &contract_addr,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(relayer_accounts[0].address()),
+                },
+            )
+            .unwrap();
+
+        // Each relayer is getting 300000 (+1 from remainder) / 3 -> 100000 and 1 token will stay in the remainders for next collection
+        assert_eq!(
+            query_fees_collected.fees_collected,
+            vec![
+                coin(176666, xrpl_token.coreum_denom.clone()),
+                coin(200003, coreum_token_denom.clone()) // 100003 + 100000
+            ]
+        );
+
+        // Confirm operation
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: query_pending_operations.operations[0].account_sequence,
+                        ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                        transaction_result: TransactionResult::Accepted,
                         operation_result: None,
                     },
                 },
@@ -6085,6 +7010,7 @@ mod tests {
                         currency: coreum_token.xrpl_currency.clone(),
                         amount: Uint128::new(650010000000000), // 650010000000000 will convert to 650010, which after charging bridging fees (300000) and truncating (10) will send 350000 to the receiver
                         recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -6334,16 +7260,873 @@ mod tests {
             &wasm,
             &signer,
             Addr::unchecked(signer.address()),
-            vec![relayers[0].clone(), relayers[1].clone()],
-            2,
-            4,
+            vec![relayers[0].clone(), relayers[1].clone()],
+            2,
+            4,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            xrpl_base_fee,
+        );
+
+        // Querying current pending operations and available tickets should return empty results.
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert!(query_pending_operations.operations.is_empty());
+        assert!(query_available_tickets.tickets.is_empty());
+
+        let account_sequence = 1;
+        // Trying to recover tickets with the value less than used_ticket_sequence_threshold
+        let recover_ticket_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverTickets {
+                    account_sequence,
+                    number_of_tickets: Some(1),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_ticket_error.to_string().contains(
+            ContractError::InvalidTicketSequenceToAllocate {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Trying to recover more than max tickets will fail
+        let recover_ticket_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverTickets {
+                    account_sequence,
+                    number_of_tickets: Some(300),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_ticket_error.to_string().contains(
+            ContractError::InvalidTicketSequenceToAllocate {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Trying to recover more than max tickets will fail
+        let recover_ticket_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverTickets {
+                    account_sequence,
+                    number_of_tickets: Some(300),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_ticket_error.to_string().contains(
+            ContractError::InvalidTicketSequenceToAllocate {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Check that we can recover tickets and provide signatures for this operation with the bridge halted
+        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
+            .unwrap();
+
+        // Owner will send a recover tickets operation which will set the pending ticket update flag to true
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence,
+                number_of_tickets: Some(5),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // Try to send another one will fail because there is a pending update operation that hasn't been processed
+        let recover_ticket_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverTickets {
+                    account_sequence,
+                    number_of_tickets: Some(5),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_ticket_error
+            .to_string()
+            .contains(ContractError::PendingTicketUpdate {}.to_string().as_str()));
+
+        // Querying the current pending operations should return 1
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(
+            query_pending_operations.operations,
+            [Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: None,
+                account_sequence: Some(account_sequence),
+                signatures: vec![], // No signatures yet
+                operation_type: OperationType::AllocateTickets { number: 5 },
+                xrpl_base_fee
+            }]
+        );
+
+        let tx_hash = generate_hash();
+        let tickets = vec![1, 2, 3, 4, 5];
+        let correct_signature_example = "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string();
+
+        // Trying to relay the operation with a different sequence number than the one in pending operation should fail.
+        let relayer_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(account_sequence + 1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Rejected,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: None,
+                        }),
+                    },
+                },
+                &vec![],
+                relayer_accounts[0],
+            )
+            .unwrap_err();
+
+        assert!(relayer_error.to_string().contains(
+            ContractError::PendingOperationNotFound {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Providing an invalid signature for the operation should error
+        let signature_error = wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: account_sequence,
+                operation_version: 1,
+                signature: "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA13045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string(),
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap_err();
+
+        assert!(signature_error.to_string().contains(
+            ContractError::InvalidSignatureLength {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Provide signatures for the operation for each relayer
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: account_sequence,
+                operation_version: 1,
+                signature: correct_signature_example.clone(),
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        // Provide the signature again for the operation will fail
+        let signature_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveSignature {
+                    operation_id: account_sequence,
+                    operation_version: 1,
+                    signature: correct_signature_example.clone(),
+                },
+                &vec![],
+                relayer_accounts[0],
+            )
+            .unwrap_err();
+
+        assert!(signature_error.to_string().contains(
+            ContractError::SignatureAlreadyProvided {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Provide a signature for an operation that is not pending should fail
+        let signature_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveSignature {
+                    operation_id: account_sequence + 1,
+                    operation_version: 1,
+                    signature: correct_signature_example.clone(),
+                },
+                &vec![],
+                relayer_accounts[0],
+            )
+            .unwrap_err();
+
+        assert!(signature_error.to_string().contains(
+            ContractError::PendingOperationNotFound {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Provide a signature for an operation with a different version should fail
+        let signature_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveSignature {
+                    operation_id: account_sequence,
+                    operation_version: 2,
+                    signature: correct_signature_example.clone(),
+                },
+                &vec![],
+                relayer_accounts[0],
+            )
+            .unwrap_err();
+
+        assert!(signature_error.to_string().contains(
+            ContractError::OperationVersionMismatch {}
+                .to_string()
+                .as_str()
+        ));
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: account_sequence,
+                operation_version: 1,
+                signature: correct_signature_example.clone(),
+            },
+            &vec![],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        // Verify that we have both signatures in the operation
+        let query_pending_operation = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operation.operations.len(), 1);
+        assert_eq!(
+            query_pending_operation.operations[0].signatures,
+            vec![
+                Signature {
+                    signature: correct_signature_example.clone(),
+                    relayer_coreum_address: Addr::unchecked(relayers[0].coreum_address.clone()),
+                },
+                Signature {
+                    signature: correct_signature_example.clone(),
+                    relayer_coreum_address: Addr::unchecked(relayers[1].coreum_address.clone()),
+                }
+            ]
+        );
+
+        // Relaying the rejected operation twice should remove it from pending operations but not allocate tickets
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Rejected,
+                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                },
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Rejected,
+                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                },
+            },
+            &vec![],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        // Querying current pending operations and tickets should return empty results again
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations, vec![]);
+        assert_eq!(query_available_tickets.tickets, Vec::<u64>::new());
+
+        // Resume bridge
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ResumeBridge {},
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // Let's do the same now but reporting an invalid transaction
+        let account_sequence = 2;
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence,
+                number_of_tickets: Some(5),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // We provide the signatures again
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: account_sequence,
+                operation_version: 1,
+                signature: correct_signature_example.clone(),
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: account_sequence,
+                operation_version: 1,
+                signature: correct_signature_example.clone(),
+            },
+            &vec![],
+            relayer_accounts[1],
+        )
+        .unwrap();
+        // Trying to relay the operation with a same hash as previous rejected one should fail
+        let relayer_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(account_sequence),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some(tickets.clone()),
+                        }),
+                    },
+                },
+                &vec![],
+                relayer_accounts[0],
+            )
+            .unwrap_err();
+
+        assert!(relayer_error.to_string().contains(
+            ContractError::OperationAlreadyExecuted {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Relaying the operation twice as invalid should removed it from pending operations and not allocate tickets
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: None,
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Invalid,
+                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                },
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: None,
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Invalid,
+                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                },
+            },
+            &vec![],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        // Querying the current pending operations should return empty
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations, vec![]);
+        assert_eq!(query_available_tickets.tickets, Vec::<u64>::new());
+
+        // Let's do the same now but confirming the operation
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence,
+                number_of_tickets: Some(5),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        let tx_hash = generate_hash();
+
+        // Relaying the accepted operation twice should remove it from pending operations and allocate tickets
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some(tickets.clone()),
+                    }),
+                },
+            },
+            &vec![],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(tx_hash.clone()),
+                    account_sequence: Some(account_sequence),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some(tickets.clone()),
+                    }),
+                },
+            },
+            &vec![],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        // Querying the current pending operations should return empty
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations, vec![]);
+        assert_eq!(query_available_tickets.tickets, tickets.clone());
+    }
+
+    #[test]
+    fn xrpl_token_registration_recovery() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let token_issuer = generate_xrpl_address();
+        let token_currency = "BTC".to_string();
+        let token = XRPLToken {
+            issuer: token_issuer.clone(),
+            currency: token_currency.clone(),
+            sending_precision: -15,
+            max_holding_amount: Uint128::new(100),
+            bridging_fee: Uint128::zero(),
+        };
+        let xrpl_base_fee = 10;
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            2,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            xrpl_base_fee,
+        );
+
+        // We successfully recover 3 tickets to perform operations
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(3),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..4).collect()),
+                    }),
+                },
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // We perform the register token operation, which should put the token to Processing state and create the PendingOperation
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: token.issuer.clone(),
+                currency: token.currency.clone(),
+                sending_precision: token.sending_precision,
+                max_holding_amount: token.max_holding_amount,
+                bridging_fee: token.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
+
+        // If we try to recover a token that is not in Inactive state, it should fail.
+        let recover_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverXRPLTokenRegistration {
+                    issuer: token.issuer.clone(),
+                    currency: token.currency.clone(),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_error
+            .to_string()
+            .contains(ContractError::XRPLTokenNotInactive {}.to_string().as_str()));
+
+        // If we try to recover a token that is not registered, it should fail
+        let recover_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RecoverXRPLTokenRegistration {
+                    issuer: token.issuer.clone(),
+                    currency: "NOT".to_string(),
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(recover_error
+            .to_string()
+            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
+
+        // Let's fail the trust set operation to put the token to Inactive so that we can recover it
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 1);
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: Some(
+                        query_pending_operations.operations[0]
+                            .ticket_sequence
+                            .unwrap(),
+                    ),
+                    transaction_result: TransactionResult::Rejected,
+                    operation_result: None,
+                },
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert!(query_pending_operations.operations.is_empty());
+
+        // We should be able to recover the token now
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverXRPLTokenRegistration {
+                issuer: token.issuer.clone(),
+                currency: token.currency.clone(),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(
+                    query_pending_operations.operations[0]
+                        .ticket_sequence
+                        .unwrap()
+                ),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::TrustSet {
+                    issuer: token_issuer,
+                    currency: token_currency,
+                    trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+                },
+                xrpl_base_fee,
+            }
+        );
+    }
+
+    #[test]
+    fn xrpl_token_migration() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let old_issuer = generate_xrpl_address();
+        let old_currency = "BTC".to_string();
+        let new_issuer = generate_xrpl_address();
+        let new_currency = "WBT".to_string();
+        let xrpl_base_fee = 10;
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            3,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
             generate_xrpl_address(),
             xrpl_base_fee,
         );
 
-        // Querying current pending operations and available tickets should return empty results.
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(4),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..5).collect()),
+                    }),
+                },
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: old_issuer.clone(),
+                currency: old_currency.clone(),
+                sending_precision: -15,
+                max_holding_amount: Uint128::new(100),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
+
+        let migrate_msg = ExecuteMsg::MigrateXRPLToken {
+            old_issuer: old_issuer.clone(),
+            old_currency: old_currency.clone(),
+            new_issuer: new_issuer.clone(),
+            new_currency: new_currency.clone(),
+        };
+
+        // The token can't be migrated while its TrustSet operation is pending
+        let migrate_error = wasm
+            .execute::<ExecuteMsg>(&contract_addr, &migrate_msg, &vec![], &signer)
+            .unwrap_err();
+
+        assert!(migrate_error.to_string().contains(
+            ContractError::XRPLTokenHasPendingOperations {}
+                .to_string()
+                .as_str()
+        ));
+
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -6354,267 +8137,225 @@ mod tests {
             )
             .unwrap();
 
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
-                &contract_addr,
-                &QueryMsg::AvailableTickets {},
-            )
-            .unwrap();
-
-        assert!(query_pending_operations.operations.is_empty());
-        assert!(query_available_tickets.tickets.is_empty());
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
+            },
+            &[],
+            &signer,
+        )
+        .unwrap();
 
-        let account_sequence = 1;
-        // Trying to recover tickets with the value less than used_ticket_sequence_threshold
-        let recover_ticket_error = wasm
+        // The XRP token can't be migrated
+        let migrate_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RecoverTickets {
-                    account_sequence,
-                    number_of_tickets: Some(1),
+                &ExecuteMsg::MigrateXRPLToken {
+                    old_issuer: XRP_ISSUER.to_string(),
+                    old_currency: XRP_CURRENCY.to_string(),
+                    new_issuer: new_issuer.clone(),
+                    new_currency: new_currency.clone(),
                 },
                 &vec![],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(recover_ticket_error.to_string().contains(
-            ContractError::InvalidTicketSequenceToAllocate {}
-                .to_string()
-                .as_str()
-        ));
+        assert!(migrate_error
+            .to_string()
+            .contains(ContractError::XRPTokenNotMigratable {}.to_string().as_str()));
 
-        // Trying to recover more than max tickets will fail
-        let recover_ticket_error = wasm
+        // The token can't be migrated to the registered issuer and currency
+        let migrate_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RecoverTickets {
-                    account_sequence,
-                    number_of_tickets: Some(300),
+                &ExecuteMsg::MigrateXRPLToken {
+                    old_issuer: old_issuer.clone(),
+                    old_currency: old_currency.clone(),
+                    new_issuer: old_issuer.clone(),
+                    new_currency: old_currency.clone(),
                 },
                 &vec![],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(recover_ticket_error.to_string().contains(
-            ContractError::InvalidTicketSequenceToAllocate {}
-                .to_string()
-                .as_str()
+        assert!(migrate_error.to_string().contains(
+            ContractError::XRPLTokenAlreadyRegistered {
+                issuer: old_issuer.clone(),
+                currency: old_currency.clone(),
+            }
+            .to_string()
+            .as_str()
         ));
 
-        // Trying to recover more than max tickets will fail
-        let recover_ticket_error = wasm
-            .execute::<ExecuteMsg>(
+        // The disabled token can't be migrated, since the migration would enable it
+        for state in [TokenState::Disabled, TokenState::Enabled] {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RecoverTickets {
-                    account_sequence,
-                    number_of_tickets: Some(300),
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: old_issuer.clone(),
+                    currency: old_currency.clone(),
+                    state: Some(state.clone()),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
                 &vec![],
                 &signer,
             )
-            .unwrap_err();
-
-        assert!(recover_ticket_error.to_string().contains(
-            ContractError::InvalidTicketSequenceToAllocate {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Check that we can recover tickets and provide signatures for this operation with the bridge halted
-        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
             .unwrap();
 
-        // Owner will send a recover tickets operation which will set the pending ticket update flag to true
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence,
-                number_of_tickets: Some(5),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        // Try to send another one will fail because there is a pending update operation that hasn't been processed
-        let recover_ticket_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::RecoverTickets {
-                    account_sequence,
-                    number_of_tickets: Some(5),
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
+            if state == TokenState::Disabled {
+                let migrate_error = wasm
+                    .execute::<ExecuteMsg>(&contract_addr, &migrate_msg, &vec![], &signer)
+                    .unwrap_err();
 
-        assert!(recover_ticket_error
-            .to_string()
-            .contains(ContractError::PendingTicketUpdate {}.to_string().as_str()));
+                assert!(migrate_error.to_string().contains(
+                    ContractError::DisabledXRPLTokenNotMigratable {}
+                        .to_string()
+                        .as_str()
+                ));
+            }
+        }
 
-        // Querying the current pending operations should return 1
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
+                &QueryMsg::XRPLTokens {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
+        let old_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == old_issuer && t.currency == old_currency)
+            .unwrap()
+            .clone();
+        assert_eq!(old_token.state, TokenState::Enabled);
 
-        assert_eq!(
-            query_pending_operations.operations,
-            [Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: None,
-                account_sequence: Some(account_sequence),
-                signatures: vec![], // No signatures yet
-                operation_type: OperationType::AllocateTickets { number: 5 },
-                xrpl_base_fee
-            }]
-        );
-
-        let tx_hash = generate_hash();
-        let tickets = vec![1, 2, 3, 4, 5];
-        let correct_signature_example = "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string();
+        wasm.execute::<ExecuteMsg>(&contract_addr, &migrate_msg, &vec![], &signer)
+            .unwrap();
 
-        // Trying to relay the operation with a different sequence number than the one in pending operation should fail.
-        let relayer_error = wasm
-            .execute::<ExecuteMsg>(
+        // The token is moved to the new issuer and currency with the same denom and configuration
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(account_sequence + 1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Rejected,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: None,
-                        }),
-                    },
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &vec![],
-                relayer_accounts[0],
             )
-            .unwrap_err();
-
-        assert!(relayer_error.to_string().contains(
-            ContractError::PendingOperationNotFound {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Providing an invalid signature for the operation should error
-        let signature_error = wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: account_sequence,
-                operation_version: 1,
-                signature: "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA13045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string(),
-            },
-            &vec![],
-            relayer_accounts[0],
-        )
-        .unwrap_err();
-
-        assert!(signature_error.to_string().contains(
-            ContractError::InvalidSignatureLength {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Provide signatures for the operation for each relayer
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: account_sequence,
-                operation_version: 1,
-                signature: correct_signature_example.clone(),
-            },
-            &vec![],
-            relayer_accounts[0],
-        )
-        .unwrap();
+            .unwrap();
+        assert!(!query_xrpl_tokens
+            .tokens
+            .iter()
+            .any(|t| t.issuer == old_issuer && t.currency == old_currency));
+        let new_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == new_issuer && t.currency == new_currency)
+            .unwrap()
+            .clone();
+        assert_eq!(
+            new_token,
+            QueriedXRPLToken {
+                issuer: new_issuer.clone(),
+                currency: new_currency.clone(),
+                state: TokenState::Processing,
+                ..old_token.clone()
+            }
+        );
 
-        // Provide the signature again for the operation will fail
-        let signature_error = wasm
-            .execute::<ExecuteMsg>(
+        // The old issuer and currency can't be migrated again
+        let migrate_error = wasm
+            .execute::<ExecuteMsg>(&contract_addr, &migrate_msg, &vec![], &signer)
+            .unwrap_err();
+
+        assert!(migrate_error
+            .to_string()
+            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
+
+        // The old token is kept disabled until it's removed
+        let query_migrated_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveSignature {
-                    operation_id: account_sequence,
-                    operation_version: 1,
-                    signature: correct_signature_example.clone(),
+                &QueryMsg::MigratedXRPLTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &vec![],
-                relayer_accounts[0],
             )
-            .unwrap_err();
-
-        assert!(signature_error.to_string().contains(
-            ContractError::SignatureAlreadyProvided {}
-                .to_string()
-                .as_str()
-        ));
+            .unwrap();
+        assert_eq!(
+            query_migrated_xrpl_tokens.tokens,
+            vec![QueriedXRPLToken {
+                state: TokenState::Disabled,
+                ..old_token.clone()
+            }]
+        );
 
-        // Provide a signature for an operation that is not pending should fail
-        let signature_error = wasm
+        // The old issuer and currency can't be bridged
+        let transfer_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveSignature {
-                    operation_id: account_sequence + 1,
-                    operation_version: 1,
-                    signature: correct_signature_example.clone(),
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: old_issuer.clone(),
+                        currency: old_currency.clone(),
+                        amount: Uint128::one(),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &vec![],
-                relayer_accounts[0],
+                &[],
+                &signer,
             )
             .unwrap_err();
 
-        assert!(signature_error.to_string().contains(
-            ContractError::PendingOperationNotFound {}
-                .to_string()
-                .as_str()
-        ));
+        assert!(transfer_error
+            .to_string()
+            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
 
-        // Provide a signature for an operation with a different version should fail
-        let signature_error = wasm
+        // The old issuer and currency can't be registered until the old token is removed
+        let register_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveSignature {
-                    operation_id: account_sequence,
-                    operation_version: 2,
-                    signature: correct_signature_example.clone(),
+                &ExecuteMsg::RegisterXRPLToken {
+                    issuer: old_issuer.clone(),
+                    currency: old_currency.clone(),
+                    sending_precision: -15,
+                    max_holding_amount: Uint128::new(100),
+                    bridging_fee: Uint128::zero(),
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
-                &vec![],
-                relayer_accounts[0],
+                &query_issue_fee(&asset_ft),
+                &signer,
             )
             .unwrap_err();
 
-        assert!(signature_error.to_string().contains(
-            ContractError::OperationVersionMismatch {}
-                .to_string()
-                .as_str()
+        assert!(register_error.to_string().contains(
+            ContractError::XRPLTokenAlreadyRegistered {
+                issuer: old_issuer.clone(),
+                currency: old_currency.clone(),
+            }
+            .to_string()
+            .as_str()
         ));
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: account_sequence,
-                operation_version: 1,
-                signature: correct_signature_example.clone(),
-            },
-            &vec![],
-            relayer_accounts[1],
-        )
-        .unwrap();
-
-        // Verify that we have both signatures in the operation
-        let query_pending_operation = wasm
+        let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
                 &QueryMsg::PendingOperations {
@@ -6624,161 +8365,135 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operation.operations.len(), 1);
+        assert_eq!(query_pending_operations.operations.len(), 1);
         assert_eq!(
-            query_pending_operation.operations[0].signatures,
-            vec![
-                Signature {
-                    signature: correct_signature_example.clone(),
-                    relayer_coreum_address: Addr::unchecked(relayers[0].coreum_address.clone()),
-                },
-                Signature {
-                    signature: correct_signature_example.clone(),
-                    relayer_coreum_address: Addr::unchecked(relayers[1].coreum_address.clone()),
-                }
-            ]
+            query_pending_operations.operations[0].operation_type,
+            OperationType::TrustSet {
+                issuer: new_issuer.clone(),
+                currency: new_currency.clone(),
+                trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            }
         );
 
-        // Relaying the rejected operation twice should remove it from pending operations but not allocate tickets
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: Some(account_sequence),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Rejected,
-                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
-                },
-            },
-            &vec![],
-            relayer_accounts[0],
-        )
-        .unwrap();
-
+        // The migrated token is enabled once the TrustSet operation is accepted
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: Some(account_sequence),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Rejected,
-                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: None,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
                 },
             },
-            &vec![],
-            relayer_accounts[1],
+            &[],
+            &signer,
         )
         .unwrap();
 
-        // Querying current pending operations and tickets should return empty results again
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
+                &QueryMsg::XRPLTokens {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
+        let new_token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.coreum_denom == old_token.coreum_denom)
+            .unwrap();
+        assert_eq!(new_token.issuer, new_issuer);
+        assert_eq!(new_token.state, TokenState::Enabled);
 
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
+        // The old token is removed once the balance of its trust line has been handled
+        let remove_migrated_msg = ExecuteMsg::RemoveMigratedXRPLToken {
+            issuer: old_issuer.clone(),
+            currency: old_currency.clone(),
+        };
+        wasm.execute::<ExecuteMsg>(&contract_addr, &remove_migrated_msg, &[], &signer)
+            .unwrap();
+
+        let query_migrated_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
                 &contract_addr,
-                &QueryMsg::AvailableTickets {},
+                &QueryMsg::MigratedXRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
             )
             .unwrap();
+        assert!(query_migrated_xrpl_tokens.tokens.is_empty());
 
-        assert_eq!(query_pending_operations.operations, vec![]);
-        assert_eq!(query_available_tickets.tickets, Vec::<u64>::new());
+        let remove_error = wasm
+            .execute::<ExecuteMsg>(&contract_addr, &remove_migrated_msg, &[], &signer)
+            .unwrap_err();
 
-        // Resume bridge
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::ResumeBridge {},
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        assert!(remove_error.to_string().contains(
+            ContractError::MigratedXRPLTokenNotFound {}
+                .to_string()
+                .as_str()
+        ));
+    }
 
-        // Let's do the same now but reporting an invalid transaction
-        let account_sequence = 2;
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence,
-                number_of_tickets: Some(5),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+    #[test]
+    fn rejected_ticket_allocation_with_no_tickets_left() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
 
-        // We provide the signatures again
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: account_sequence,
-                operation_version: 1,
-                signature: correct_signature_example.clone(),
-            },
-            &vec![],
-            relayer_accounts[0],
-        )
-        .unwrap();
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: account_sequence,
-                operation_version: 1,
-                signature: correct_signature_example.clone(),
+        let test_tokens = vec![
+            XRPLToken {
+                issuer: generate_xrpl_address(), // Valid issuer
+                currency: "USD".to_string(),     // Valid standard currency code
+                sending_precision: -15,
+                max_holding_amount: Uint128::new(100),
+                bridging_fee: Uint128::zero(),
             },
-            &vec![],
-            relayer_accounts[1],
-        )
-        .unwrap();
-        // Trying to relay the operation with a same hash as previous rejected one should fail
-        let relayer_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(account_sequence),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: Some(tickets.clone()),
-                        }),
-                    },
-                },
-                &vec![],
-                relayer_accounts[0],
-            )
-            .unwrap_err();
+            XRPLToken {
+                issuer: generate_xrpl_address(), // Valid issuer
+                currency: "015841551A748AD2C1F76FF6ECB0CCCD00000000".to_string(), // Valid hexadecimal currency
+                sending_precision: 15,
+                max_holding_amount: Uint128::new(50000),
+                bridging_fee: Uint128::zero(),
+            },
+        ];
+        let xrpl_base_fee = 10;
 
-        assert!(relayer_error.to_string().contains(
-            ContractError::OperationAlreadyExecuted {}
-                .to_string()
-                .as_str()
-        ));
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            2,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            xrpl_base_fee,
+        );
 
-        // Relaying the operation twice as invalid should removed it from pending operations and not allocate tickets
+        // We successfully recover 3 tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: None,
-                    account_sequence: Some(account_sequence),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Invalid,
-                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
-                },
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(3),
             },
             &vec![],
-            relayer_accounts[0],
+            &signer,
         )
         .unwrap();
 
@@ -6786,19 +8501,55 @@ mod tests {
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: None,
-                    account_sequence: Some(account_sequence),
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
                     ticket_sequence: None,
-                    transaction_result: TransactionResult::Invalid,
-                    operation_result: Some(OperationResult::TicketsAllocation { tickets: None }),
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..4).collect()),
+                    }),
                 },
             },
             &vec![],
-            relayer_accounts[1],
+            &signer,
         )
         .unwrap();
 
-        // Querying the current pending operations should return empty
+        // We register and enable 2 tokens, which should trigger a second ticket allocation with the last available ticket.
+        for (index, token) in test_tokens.iter().enumerate() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RegisterXRPLToken {
+                    issuer: token.issuer.clone(),
+                    currency: token.currency.clone(),
+                    sending_precision: token.sending_precision,
+                    max_holding_amount: token.max_holding_amount,
+                    bridging_fee: token.bridging_fee,
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
+                },
+                &query_issue_fee(&asset_ft),
+                &signer,
+            )
+            .unwrap();
+
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(generate_hash()),
+                        account_sequence: None,
+                        ticket_sequence: Some(u64::try_from(index).unwrap() + 1),
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: None,
+                    },
+                },
+                &[],
+                &signer,
+            )
+            .unwrap();
+        }
+
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -6816,62 +8567,42 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations, vec![]);
+        assert_eq!(
+            query_pending_operations.operations,
+            [Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(3),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::AllocateTickets { number: 2 },
+                xrpl_base_fee,
+            }]
+        );
         assert_eq!(query_available_tickets.tickets, Vec::<u64>::new());
 
-        // Let's do the same now but confirming the operation
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence,
-                number_of_tickets: Some(5),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        let tx_hash = generate_hash();
-
-        // Relaying the accepted operation twice should remove it from pending operations and allocate tickets
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: Some(account_sequence),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some(tickets.clone()),
-                    }),
-                },
-            },
-            &vec![],
-            relayer_accounts[0],
-        )
-        .unwrap();
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(tx_hash.clone()),
-                    account_sequence: Some(account_sequence),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some(tickets.clone()),
-                    }),
+        // If we reject this operation, it should trigger a new ticket allocation but since we have no tickets available, it should
+        // NOT fail (because otherwise contract will be stuck) but return an additional attribute warning that there are no available tickets left
+        // requiring a manual ticket recovery in the future.
+        let result = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(generate_hash()),
+                        account_sequence: None,
+                        ticket_sequence: Some(3),
+                        transaction_result: TransactionResult::Rejected,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: None,
+                        }),
+                    },
                 },
-            },
-            &vec![],
-            relayer_accounts[1],
-        )
-        .unwrap();
+                &vec![],
+                &signer,
+            )
+            .unwrap();
 
-        // Querying the current pending operations should return empty
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -6889,55 +8620,58 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations, vec![]);
-        assert_eq!(query_available_tickets.tickets, tickets.clone());
+        assert!(query_pending_operations.operations.is_empty());
+        assert!(query_available_tickets.tickets.is_empty());
+        assert!(result.events.iter().any(|e| e.ty == "wasm"
+            && e.attributes
+                .iter()
+                .any(|a| a.key == "adding_ticket_allocation_operation_success"
+                    && a.value == false.to_string())));
     }
 
     #[test]
-    fn xrpl_token_registration_recovery() {
+    fn ticket_return_invalid_transactions() {
         let app = CoreumTestApp::new();
-        let signer = app
-            .init_account(&coins(100_000_000_000, FEE_DENOM))
+        let accounts_number = 3;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
             .unwrap();
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
 
+        let signer = accounts.get(0).unwrap();
+        let sender = accounts.get(1).unwrap();
+        let relayer_account = accounts.get(2).unwrap();
         let relayer = Relayer {
-            coreum_address: Addr::unchecked(signer.address()),
+            coreum_address: Addr::unchecked(relayer_account.address()),
             xrpl_address: generate_xrpl_address(),
             xrpl_pub_key: generate_xrpl_pub_key(),
         };
 
-        let token_issuer = generate_xrpl_address();
-        let token_currency = "BTC".to_string();
-        let token = XRPLToken {
-            issuer: token_issuer.clone(),
-            currency: token_currency.clone(),
-            sending_precision: -15,
-            max_holding_amount: Uint128::new(100),
-            bridging_fee: Uint128::zero(),
-        };
-        let xrpl_base_fee = 10;
+        let xrpl_receiver_address = generate_xrpl_address();
+        let bridge_xrpl_address = generate_xrpl_address();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
 
         let contract_addr = store_and_instantiate(
             &wasm,
-            &signer,
+            signer,
             Addr::unchecked(signer.address()),
             vec![relayer.clone()],
             1,
-            2,
+            5,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
-            xrpl_base_fee,
+            bridge_xrpl_address.clone(),
+            10,
         );
 
-        // We successfully recover 3 tickets to perform operations
+        // Add enough tickets to test that ticket is correctly returned
+
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(3),
+                number_of_tickets: Some(6),
             },
             &vec![],
             &signer,
@@ -6946,73 +8680,77 @@ mod tests {
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..4).collect()),
-                    }),
-                },
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..7).collect()),
+                    }),
+                },
+            },
+            &vec![],
+            relayer_account,
+        )
+        .unwrap();
+
+        // Let's issue a token and register it
+        let asset_ft = AssetFT::new(&app);
+        let symbol = "TEST".to_string();
+        let subunit = "utest".to_string();
+        let decimals = 6;
+        let initial_amount = Uint128::new(100000000);
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: sender.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
+                },
+                &sender,
+            )
+            .unwrap();
+
+        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: denom.clone(),
+                decimals,
+                sending_precision: 6,
+                max_holding_amount: Uint128::new(10000000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // We perform the register token operation, which should put the token to Processing state and create the PendingOperation
+        // We are going to bridge a token and reject the operation
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: token.issuer.clone(),
-                currency: token.currency.clone(),
-                sending_precision: token.sending_precision,
-                max_holding_amount: token.max_holding_amount,
-                bridging_fee: token.bridging_fee,
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
             },
-            &query_issue_fee(&asset_ft),
-            &signer,
+            &coins(1, denom.clone()),
+            &sender,
         )
         .unwrap();
 
-        // If we try to recover a token that is not in Inactive state, it should fail.
-        let recover_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::RecoverXRPLTokenRegistration {
-                    issuer: token.issuer.clone(),
-                    currency: token.currency.clone(),
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(recover_error
-            .to_string()
-            .contains(ContractError::XRPLTokenNotInactive {}.to_string().as_str()));
-
-        // If we try to recover a token that is not registered, it should fail
-        let recover_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::RecoverXRPLTokenRegistration {
-                    issuer: token.issuer.clone(),
-                    currency: "NOT".to_string(),
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(recover_error
-            .to_string()
-            .contains(ContractError::TokenNotRegistered {}.to_string().as_str()));
-
-        // Let's fail the trust set operation to put the token to Inactive so that we can recover it
-
+        // Get the current ticket used to compare later
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -7023,52 +8761,40 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
+        let ticket_used_invalid_operation = query_pending_operations.operations[0]
+            .ticket_sequence
+            .unwrap();
 
+        // Send evidence of invalid operation, which should return the ticket to the ticket array
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: None,
-                    ticket_sequence: Some(
-                        query_pending_operations.operations[0]
-                            .ticket_sequence
-                            .unwrap(),
-                    ),
-                    transaction_result: TransactionResult::Rejected,
+                    tx_hash: None,
+                    account_sequence: query_pending_operations.operations[0].account_sequence,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Invalid,
                     operation_result: None,
                 },
             },
-            &[],
-            &signer,
+            &vec![],
+            relayer_account,
         )
         .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert!(query_pending_operations.operations.is_empty());
-
-        // We should be able to recover the token now
+        // Now let's try to send again and verify that the ticket is the same as before (it was given back)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverXRPLTokenRegistration {
-                issuer: token.issuer.clone(),
-                currency: token.currency.clone(),
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
             },
-            &vec![],
-            &signer,
+            &coins(1, denom.clone()),
+            &sender,
         )
         .unwrap();
 
+        // Get the current ticket used to compare later
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -7079,260 +8805,363 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
         assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(
-                    query_pending_operations.operations[0]
-                        .ticket_sequence
-                        .unwrap()
-                ),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::TrustSet {
-                    issuer: token_issuer,
-                    currency: token_currency,
-                    trust_set_limit_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-                },
-                xrpl_base_fee,
-            }
+            ticket_used_invalid_operation,
+            query_pending_operations.operations[0]
+                .ticket_sequence
+                .unwrap()
         );
     }
 
     #[test]
-    fn rejected_ticket_allocation_with_no_tickets_left() {
+    fn token_update() {
         let app = CoreumTestApp::new();
-        let signer = app
-            .init_account(&coins(100_000_000_000, FEE_DENOM))
+        let accounts_number = 3;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
             .unwrap();
 
+        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
+        let xrpl_addresses: Vec<String> = (0..2).map(|_| generate_xrpl_address()).collect();
+        let xrpl_pub_keys: Vec<String> = (0..2).map(|_| generate_xrpl_pub_key()).collect();
+
+        let mut relayer_accounts = vec![];
+        let mut relayers = vec![];
+
+        for i in 0..accounts_number - 1 {
+            relayer_accounts.push(accounts.get(i as usize).unwrap());
+            relayers.push(Relayer {
+                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
+                xrpl_address: xrpl_addresses[i as usize].to_string(),
+                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
+            });
+        }
+
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(signer.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
 
-        let test_tokens = vec![
-            XRPLToken {
-                issuer: generate_xrpl_address(), // Valid issuer
-                currency: "USD".to_string(),     // Valid standard currency code
-                sending_precision: -15,
-                max_holding_amount: Uint128::new(100),
-                bridging_fee: Uint128::zero(),
-            },
-            XRPLToken {
-                issuer: generate_xrpl_address(), // Valid issuer
-                currency: "015841551A748AD2C1F76FF6ECB0CCCD00000000".to_string(), // Valid hexadecimal currency
-                sending_precision: 15,
-                max_holding_amount: Uint128::new(50000),
-                bridging_fee: Uint128::zero(),
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayers[0].clone(), relayers[1].clone()],
+            2,
+            4,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            10,
+        );
+
+        // Recover enough tickets for testing
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(5),
             },
-        ];
-        let xrpl_base_fee = 10;
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some((1..6).collect()),
+                        }),
+                    },
+                },
+                &vec![],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        // Register one XRPL token and one Coreum token
+        let xrpl_token = XRPLToken {
+            issuer: generate_xrpl_address(),
+            currency: "USD".to_string(),
+            sending_precision: 15,
+            max_holding_amount: Uint128::new(1000000000),
+            bridging_fee: Uint128::zero(),
+        };
+
+        let subunit = "utest".to_string();
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: signer.address(),
+                    symbol: "TEST".to_string(),
+                    subunit: subunit.clone(),
+                    precision: 6,
+                    initial_amount: "100000000".to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "0".to_string(),
+                    send_commission_rate: "0".to_string(),
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
+                },
+                &signer,
+            )
+            .unwrap();
 
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            &signer,
-            Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
-            1,
-            2,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
-            xrpl_base_fee,
-        );
+        let coreum_token_denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
+
+        let coreum_token = CoreumToken {
+            denom: coreum_token_denom.clone(),
+            decimals: 6,
+            sending_precision: 6,
+            max_holding_amount: Uint128::new(1000000000),
+            bridging_fee: Uint128::zero(),
+        };
 
-        // We successfully recover 3 tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(3),
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                sending_precision: xrpl_token.sending_precision,
+                max_holding_amount: xrpl_token.max_holding_amount,
+                bridging_fee: xrpl_token.bridging_fee,
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
-            &vec![],
+            &query_issue_fee(&asset_ft),
             &signer,
         )
         .unwrap();
 
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let xrpl_token_denom = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.issuer == xrpl_token.issuer && t.currency == xrpl_token.currency)
+            .unwrap()
+            .coreum_denom
+            .clone();
+
+        // Updating XRP token to an invalid sending precision (more than decimals, 6) should fail
+        let update_precision_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    state: None,
+                    sending_precision: Some(7),
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(update_precision_error.to_string().contains(
+            ContractError::InvalidSendingPrecision {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Updating XRP token to a valid sending precision (less than decimals, 6) should succeed
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..4).collect()),
-                    }),
-                },
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: XRP_ISSUER.to_string(),
+                currency: XRP_CURRENCY.to_string(),
+                state: None,
+                sending_precision: Some(5),
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // We register and enable 2 tokens, which should trigger a second ticket allocation with the last available ticket.
-        for (index, token) in test_tokens.iter().enumerate() {
-            wasm.execute::<ExecuteMsg>(
+        // If we try to update the status of a token that is in processing state, it should fail
+        let update_status_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RegisterXRPLToken {
-                    issuer: token.issuer.clone(),
-                    currency: token.currency.clone(),
-                    sending_precision: token.sending_precision,
-                    max_holding_amount: token.max_holding_amount,
-                    bridging_fee: token.bridging_fee,
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    state: Some(TokenState::Disabled),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
-                &query_issue_fee(&asset_ft),
+                &vec![],
                 &signer,
             )
-            .unwrap();
+            .unwrap_err();
+
+        assert!(update_status_error
+            .to_string()
+            .contains(ContractError::TokenStateIsImmutable {}.to_string().as_str()));
 
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
             wasm.execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(generate_hash()),
+                        tx_hash: Some(tx_hash.clone()),
                         account_sequence: None,
-                        ticket_sequence: Some(u64::try_from(index).unwrap() + 1),
+                        ticket_sequence: Some(1),
                         transaction_result: TransactionResult::Accepted,
                         operation_result: None,
                     },
                 },
-                &[],
-                &signer,
+                &vec![],
+                relayer,
             )
             .unwrap();
         }
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+        // We will try to send one evidence with the token enabled and the other one with the token disabled, which should fail.
+        let tx_hash = generate_hash();
+        // First evidence should succeed
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::one(),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
-            )
-            .unwrap();
-
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
-                &contract_addr,
-                &QueryMsg::AvailableTickets {},
-            )
-            .unwrap();
-
-        assert_eq!(
-            query_pending_operations.operations,
-            [Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(3),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::AllocateTickets { number: 2 },
-                xrpl_base_fee,
-            }]
-        );
-        assert_eq!(query_available_tickets.tickets, Vec::<u64>::new());
+            },
+            &[],
+            relayer_accounts[0],
+        )
+        .unwrap();
 
-        // If we reject this operation, it should trigger a new ticket allocation but since we have no tickets available, it should
-        // NOT fail (because otherwise contract will be stuck) but return an additional attribute warning that there are no available tickets left
-        // requiring a manual ticket recovery in the future.
-        let result = wasm
+        // Disable the token
+        let disable_result = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(generate_hash()),
-                        account_sequence: None,
-                        ticket_sequence: Some(3),
-                        transaction_result: TransactionResult::Rejected,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: None,
-                        }),
-                    },
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    state: Some(TokenState::Disabled),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
                 &vec![],
                 &signer,
             )
             .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
+        // The state transition is audited with the actor
+        let state_changed_event = disable_result
+            .events
+            .iter()
+            .find(|e| e.ty == "wasm-token_state_changed")
             .unwrap();
+        assert!(state_changed_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "old_state" && a.value == TokenState::Enabled.as_str()));
+        assert!(state_changed_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "new_state" && a.value == TokenState::Disabled.as_str()));
+        assert!(state_changed_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "actor" && a.value == signer.address()));
 
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
+        // If we send second evidence it should fail because token is disabled
+        let disabled_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::AvailableTickets {},
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: xrpl_token.issuer.clone(),
+                        currency: xrpl_token.currency.clone(),
+                        amount: Uint128::one(),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer_accounts[1],
             )
-            .unwrap();
-
-        assert!(query_pending_operations.operations.is_empty());
-        assert!(query_available_tickets.tickets.is_empty());
-        assert!(result.events.iter().any(|e| e.ty == "wasm"
-            && e.attributes
-                .iter()
-                .any(|a| a.key == "adding_ticket_allocation_operation_success"
-                    && a.value == false.to_string())));
-    }
-
-    #[test]
-    fn ticket_return_invalid_transactions() {
-        let app = CoreumTestApp::new();
-        let accounts_number = 3;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
-            .unwrap();
-
-        let signer = accounts.get(0).unwrap();
-        let sender = accounts.get(1).unwrap();
-        let relayer_account = accounts.get(2).unwrap();
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(relayer_account.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
-
-        let xrpl_receiver_address = generate_xrpl_address();
-        let bridge_xrpl_address = generate_xrpl_address();
+            .unwrap_err();
 
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
+        assert!(disabled_error
+            .to_string()
+            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
 
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            signer,
-            Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
-            1,
-            5,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            bridge_xrpl_address.clone(),
-            10,
-        );
+        // If we try to change the status to something that is not disabled or enabled it should fail
+        let update_status_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    state: Some(TokenState::Inactive),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
 
-        // Add enough tickets to test that ticket is correctly returned
+        assert!(update_status_error.to_string().contains(
+            ContractError::InvalidTargetTokenState {}
+                .to_string()
+                .as_str()
+        ));
 
+        // If we try to change the status back to enabled and send the evidence, the balance should be sent to the receiver.
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(6),
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: Some(TokenState::Enabled),
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
@@ -7342,361 +9171,425 @@ mod tests {
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..7).collect()),
-                    }),
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::one(),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
-            &vec![],
-            relayer_account,
+            &[],
+            relayer_accounts[1],
         )
         .unwrap();
 
-        // Let's issue a token and register it
-        let asset_ft = AssetFT::new(&app);
-        let symbol = "TEST".to_string();
-        let subunit = "utest".to_string();
-        let decimals = 6;
-        let initial_amount = Uint128::new(100000000);
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: sender.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
-                },
-                &sender,
-            )
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrpl_token_denom.clone(),
+            })
             .unwrap();
 
-        let denom = format!("{}-{}", subunit, sender.address()).to_lowercase();
+        assert_eq!(request_balance.balance, "1".to_string());
 
+        // If we disable again and we try to send the token back it will fail
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: denom.clone(),
-                decimals,
-                sending_precision: 6,
-                max_holding_amount: Uint128::new(10000000),
-                bridging_fee: Uint128::zero(),
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: Some(TokenState::Disabled),
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // We are going to bridge a token and reject the operation
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
-            },
-            &coins(1, denom.clone()),
-            &sender,
-        )
-        .unwrap();
-
-        // Get the current ticket used to compare later
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let send_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(1, xrpl_token_denom.clone()),
+                &signer,
             )
-            .unwrap();
-
-        let ticket_used_invalid_operation = query_pending_operations.operations[0]
-            .ticket_sequence
-            .unwrap();
+            .unwrap_err();
 
-        // Send evidence of invalid operation, which should return the ticket to the ticket array
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: None,
-                    account_sequence: query_pending_operations.operations[0].account_sequence,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Invalid,
-                    operation_result: None,
-                },
-            },
-            &vec![],
-            relayer_account,
-        )
-        .unwrap();
+        assert!(send_error
+            .to_string()
+            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
 
-        // Now let's try to send again and verify that the ticket is the same as before (it was given back)
+        // Register the Coreum Token
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: coreum_token_denom.clone(),
+                decimals: coreum_token.decimals,
+                sending_precision: coreum_token.sending_precision,
+                max_holding_amount: coreum_token.max_holding_amount,
+                bridging_fee: coreum_token.bridging_fee,
+                max_sends_per_address_per_day: None,
             },
-            &coins(1, denom.clone()),
-            &sender,
+            &query_issue_fee(&asset_ft),
+            &signer,
         )
         .unwrap();
 
-        // Get the current ticket used to compare later
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // If we try to change the status to something that is not disabled or enabled it should fail
+        let update_status_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::UpdateCoreumToken {
+                    denom: coreum_token_denom.clone(),
+                    state: Some(TokenState::Processing),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
+                &vec![],
+                &signer,
             )
-            .unwrap();
-
-        assert_eq!(
-            ticket_used_invalid_operation,
-            query_pending_operations.operations[0]
-                .ticket_sequence
-                .unwrap()
-        );
-    }
-
-    #[test]
-    fn token_update() {
-        let app = CoreumTestApp::new();
-        let accounts_number = 3;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
-            .unwrap();
-
-        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
-        let xrpl_addresses: Vec<String> = (0..2).map(|_| generate_xrpl_address()).collect();
-        let xrpl_pub_keys: Vec<String> = (0..2).map(|_| generate_xrpl_pub_key()).collect();
-
-        let mut relayer_accounts = vec![];
-        let mut relayers = vec![];
-
-        for i in 0..accounts_number - 1 {
-            relayer_accounts.push(accounts.get(i as usize).unwrap());
-            relayers.push(Relayer {
-                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
-                xrpl_address: xrpl_addresses[i as usize].to_string(),
-                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
-            });
-        }
-
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
+            .unwrap_err();
 
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            &signer,
-            Addr::unchecked(signer.address()),
-            vec![relayers[0].clone(), relayers[1].clone()],
-            2,
-            4,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
-            10,
-        );
+        assert!(update_status_error.to_string().contains(
+            ContractError::InvalidTargetTokenState {}
+                .to_string()
+                .as_str()
+        ));
 
-        // Recover enough tickets for testing
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(5),
+        // Disable the Coreum Token
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateCoreumToken {
+                denom: coreum_token_denom.clone(),
+                state: Some(TokenState::Disabled),
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // If we try to send now it will fail because the token is disabled
+        let send_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: Some((1..6).collect()),
-                        }),
-                    },
-                },
-                &vec![],
-                relayer,
-            )
-            .unwrap();
-        }
-
-        // Register one XRPL token and one Coreum token
-        let xrpl_token = XRPLToken {
-            issuer: generate_xrpl_address(),
-            currency: "USD".to_string(),
-            sending_precision: 15,
-            max_holding_amount: Uint128::new(1000000000),
-            bridging_fee: Uint128::zero(),
-        };
-
-        let subunit = "utest".to_string();
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: signer.address(),
-                    symbol: "TEST".to_string(),
-                    subunit: subunit.clone(),
-                    precision: 6,
-                    initial_amount: "100000000".to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "0".to_string(),
-                    send_commission_rate: "0".to_string(),
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(1, coreum_token_denom.clone()),
                 &signer,
             )
-            .unwrap();
-
-        let coreum_token_denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
+            .unwrap_err();
 
-        let coreum_token = CoreumToken {
-            denom: coreum_token_denom.clone(),
-            decimals: 6,
-            sending_precision: 6,
-            max_holding_amount: Uint128::new(1000000000),
-            bridging_fee: Uint128::zero(),
-        };
+        assert!(send_error
+            .to_string()
+            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
 
+        // Enable it again and modify the sending precision
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                sending_precision: xrpl_token.sending_precision,
-                max_holding_amount: xrpl_token.max_holding_amount,
-                bridging_fee: xrpl_token.bridging_fee,
+            &ExecuteMsg::UpdateCoreumToken {
+                denom: coreum_token_denom.clone(),
+                state: Some(TokenState::Enabled),
+                sending_precision: Some(5),
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
-            &query_issue_fee(&asset_ft),
+            &vec![],
             &signer,
         )
         .unwrap();
 
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
+        // Get the token information
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &QueryMsg::XRPLTokens {
+                &QueryMsg::CoreumTokens {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        let xrpl_token_denom = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.issuer == xrpl_token.issuer && t.currency == xrpl_token.currency)
-            .unwrap()
-            .coreum_denom
-            .clone();
+        assert_eq!(query_coreum_tokens.tokens[0].sending_precision, 5);
 
-        // Updating XRP token to an invalid sending precision (more than decimals, 6) should fail
-        let update_precision_error = wasm
+        // If we try to update to an invalid sending precision it should fail
+        let update_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::UpdateXRPLToken {
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
+                &ExecuteMsg::UpdateCoreumToken {
+                    denom: coreum_token_denom.clone(),
                     state: None,
                     sending_precision: Some(7),
                     bridging_fee: None,
                     max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
                 &vec![],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(update_precision_error.to_string().contains(
+        assert!(update_error.to_string().contains(
             ContractError::InvalidSendingPrecision {}
                 .to_string()
                 .as_str()
         ));
 
-        // Updating XRP token to a valid sending precision (less than decimals, 6) should succeed
+        // We will send 1 token and then modify the sending precision which should not allow the token to be sent with second evidence
+
+        // Enable the token again (it was disabled)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::UpdateXRPLToken {
-                issuer: XRP_ISSUER.to_string(),
-                currency: XRP_CURRENCY.to_string(),
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: Some(TokenState::Enabled),
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        let tx_hash = generate_hash();
+        // First evidence should succeed
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::one(),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        // Let's update the sending precision from 15 to 14
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
                 state: None,
-                sending_precision: Some(5),
+                sending_precision: Some(14),
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        let evidence_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: xrpl_token.issuer.clone(),
+                        currency: xrpl_token.currency.clone(),
+                        amount: Uint128::one(),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer_accounts[1],
+            )
+            .unwrap_err();
+
+        assert!(evidence_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
+                .to_string()
+                .as_str()
+        ));
+
+        // If we put it back to 15 and send, it should go through
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: None,
+                sending_precision: Some(15),
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::one(),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        // Let's send a bigger amount and check that it is truncated correctly after updating the sending precision
+        let tx_hash = generate_hash();
+
+        let previous_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrpl_token_denom.clone(),
+            })
+            .unwrap();
+        let amount_to_send = 100001; // This should truncate 1 after updating sending precision and send 100000
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::new(amount_to_send),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        // Let's update the sending precision from 15 to 10
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: None,
+                sending_precision: Some(10),
                 bridging_fee: None,
                 max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // If we try to update the status of a token that is in processing state, it should fail
-        let update_status_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::UpdateXRPLToken {
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
                     issuer: xrpl_token.issuer.clone(),
                     currency: xrpl_token.currency.clone(),
-                    state: Some(TokenState::Disabled),
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: None,
+                    amount: Uint128::new(amount_to_send),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
+            },
+            &[],
+            relayer_accounts[1],
+        )
+        .unwrap();
 
-        assert!(update_status_error
-            .to_string()
-            .contains(ContractError::TokenStateIsImmutable {}.to_string().as_str()));
+        let new_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrpl_token_denom.clone(),
+            })
+            .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        assert_eq!(
+            new_balance.balance.parse::<u128>().unwrap(),
+            previous_balance
+                .balance
+                .parse::<u128>()
+                .unwrap()
+                .checked_add(amount_to_send)
+                .unwrap()
+                .checked_sub(1) // Truncated amount after updating sending precision
+                .unwrap()
+        );
+
+        // Updating bridging fee for Coreum Token should work
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateCoreumToken {
+                denom: coreum_token_denom.clone(),
+                state: None,
+                sending_precision: None,
+                bridging_fee: Some(Uint128::new(1000)),
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // Get the token information
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: None,
-                        ticket_sequence: Some(1),
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: None,
-                    },
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &vec![],
-                relayer,
             )
             .unwrap();
-        }
 
-        // We will try to send one evidence with the token enabled and the other one with the token disabled, which should fail.
+        assert_eq!(
+            query_coreum_tokens.tokens[0].bridging_fee,
+            Uint128::new(1000)
+        );
+
+        // Let's send an XRPL token evidence, modify the bridging fee, check that it's updated, and send the next evidence to see that bridging fee is applied correctly
+        let amount_to_send = 1000000;
+
         let tx_hash = generate_hash();
         // First evidence should succeed
         wasm.execute::<ExecuteMsg>(
@@ -7706,8 +9599,9 @@ mod tests {
                     tx_hash: tx_hash.clone(),
                     issuer: xrpl_token.issuer.clone(),
                     currency: xrpl_token.currency.clone(),
-                    amount: Uint128::one(),
+                    amount: Uint128::new(amount_to_send),
                     recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -7715,24 +9609,26 @@ mod tests {
         )
         .unwrap();
 
-        // Disable the token
+        // Let's update the bridging fee from 0 to 10000000
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::UpdateXRPLToken {
                 issuer: xrpl_token.issuer.clone(),
                 currency: xrpl_token.currency.clone(),
-                state: Some(TokenState::Disabled),
+                state: None,
                 sending_precision: None,
-                bridging_fee: None,
+                bridging_fee: Some(Uint128::new(10000000)),
                 max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // If we send second evidence it should fail because token is disabled
-        let disabled_error = wasm
+        // If we try to send the second evidence it should fail because we can't cover new updated bridging fee
+        let bridging_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
@@ -7740,8 +9636,9 @@ mod tests {
                         tx_hash: tx_hash.clone(),
                         issuer: xrpl_token.issuer.clone(),
                         currency: xrpl_token.currency.clone(),
-                        amount: Uint128::one(),
+                        amount: Uint128::new(amount_to_send),
                         recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -7749,49 +9646,74 @@ mod tests {
             )
             .unwrap_err();
 
-        assert!(disabled_error
-            .to_string()
-            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
+        assert!(bridging_error.to_string().contains(
+            ContractError::CannotCoverBridgingFees {}
+                .to_string()
+                .as_str()
+        ));
 
-        // If we try to change the status to something that is not disabled or enabled it should fail
-        let update_status_error = wasm
+        // Let's update the bridging fee from 0 to 100000
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: None,
+                sending_precision: None,
+                bridging_fee: Some(Uint128::new(1000000)),
+                max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // If we try to send the second evidence it should fail because amount is 0 after applying bridging fees
+        let bridging_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::UpdateXRPLToken {
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    state: Some(TokenState::Inactive),
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: xrpl_token.issuer.clone(),
+                        currency: xrpl_token.currency.clone(),
+                        amount: Uint128::new(amount_to_send),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
-                &vec![],
-                &signer,
+                &[],
+                relayer_accounts[1],
             )
             .unwrap_err();
 
-        assert!(update_status_error.to_string().contains(
-            ContractError::InvalidTargetTokenState {}
+        assert!(bridging_error.to_string().contains(
+            ContractError::AmountSentIsZeroAfterTruncation {}
                 .to_string()
                 .as_str()
         ));
 
-        // If we try to change the status back to enabled and send the evidence, the balance should be sent to the receiver.
+        // Let's update the bridging fee from 0 to 1000
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::UpdateXRPLToken {
                 issuer: xrpl_token.issuer.clone(),
                 currency: xrpl_token.currency.clone(),
-                state: Some(TokenState::Enabled),
+                state: None,
                 sending_precision: None,
-                bridging_fee: None,
+                bridging_fee: Some(Uint128::new(1000)),
                 max_holding_amount: None,
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
+        // Sending evidence should succeed
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
@@ -7799,8 +9721,9 @@ mod tests {
                     tx_hash: tx_hash.clone(),
                     issuer: xrpl_token.issuer.clone(),
                     currency: xrpl_token.currency.clone(),
-                    amount: Uint128::one(),
+                    amount: Uint128::new(amount_to_send),
                     recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
@@ -7808,132 +9731,126 @@ mod tests {
         )
         .unwrap();
 
-        let request_balance = asset_ft
+        let previous_balance = new_balance;
+        let new_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
                 account: signer.address(),
                 denom: xrpl_token_denom.clone(),
             })
             .unwrap();
 
-        assert_eq!(request_balance.balance, "1".to_string());
+        assert_eq!(
+            new_balance.balance.parse::<u128>().unwrap(),
+            previous_balance
+                .balance
+                .parse::<u128>()
+                .unwrap()
+                .checked_add(amount_to_send) // 1000000 - 1000 (bridging fee) = 999000
+                .unwrap()
+                .checked_sub(1000) // bridging fee
+                .unwrap()
+                .checked_sub(99000) // Truncated amount after applying bridging fees (sending precision is 10) = 999000 -> 900000
+                .unwrap()
+        );
 
-        // If we disable again and we try to send the token back it will fail
+        // Let's bridge some tokens from Coreum to XRPL to have some amount in the bridge
+        let current_max_amount = 10000;
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: Some(TokenState::Disabled),
-                sending_precision: None,
-                bridging_fee: None,
-                max_holding_amount: None,
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
             },
-            &vec![],
+            &coins(current_max_amount, coreum_token_denom.clone()),
             &signer,
         )
         .unwrap();
 
-        let send_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &coins(1, xrpl_token_denom.clone()),
-                &signer,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(send_error
-            .to_string()
-            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
+        assert_eq!(query_pending_operations.operations.len(), 1);
 
-        // Register the Coreum Token
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: None,
+                        ticket_sequence: Some(
+                            query_pending_operations.operations[0]
+                                .ticket_sequence
+                                .unwrap(),
+                        ),
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: None,
+                    },
+                },
+                &vec![],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
                 denom: coreum_token_denom.clone(),
-                decimals: coreum_token.decimals,
-                sending_precision: coreum_token.sending_precision,
-                max_holding_amount: coreum_token.max_holding_amount,
-                bridging_fee: coreum_token.bridging_fee,
-            },
-            &query_issue_fee(&asset_ft),
-            &signer,
-        )
-        .unwrap();
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, current_max_amount.to_string());
 
-        // If we try to change the status to something that is not disabled or enabled it should fail
-        let update_status_error = wasm
+        // Updating max holding amount for Coreum Token should work with less than current holding amount should not work
+        let error_update = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::UpdateCoreumToken {
                     denom: coreum_token_denom.clone(),
-                    state: Some(TokenState::Processing),
+                    state: None,
                     sending_precision: None,
                     bridging_fee: None,
-                    max_holding_amount: None,
+                    max_holding_amount: Some(Uint128::new(current_max_amount - 1)),
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
                 &vec![],
                 &signer,
             )
             .unwrap_err();
 
-        assert!(update_status_error.to_string().contains(
-            ContractError::InvalidTargetTokenState {}
+        assert!(error_update.to_string().contains(
+            ContractError::InvalidTargetMaxHoldingAmount {}
                 .to_string()
                 .as_str()
         ));
 
-        // Disable the Coreum Token
+        // Updating max holding amount with more than current holding amount should work
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::UpdateCoreumToken {
                 denom: coreum_token_denom.clone(),
-                state: Some(TokenState::Disabled),
+                state: None,
                 sending_precision: None,
                 bridging_fee: None,
-                max_holding_amount: None,
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        // If we try to send now it will fail because the token is disabled
-        let send_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
-                },
-                &coins(1, coreum_token_denom.clone()),
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(send_error
-            .to_string()
-            .contains(ContractError::TokenNotEnabled {}.to_string().as_str()));
-
-        // Enable it again and modify the sending precision
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateCoreumToken {
-                denom: coreum_token_denom.clone(),
-                state: Some(TokenState::Enabled),
-                sending_precision: Some(5),
-                bridging_fee: None,
-                max_holding_amount: None,
+                max_holding_amount: Some(Uint128::new(current_max_amount + 1)),
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Get the token information
         let query_coreum_tokens = wasm
             .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
@@ -7944,18 +9861,41 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_coreum_tokens.tokens[0].sending_precision, 5);
+        assert_eq!(
+            query_coreum_tokens.tokens[0].max_holding_amount,
+            Uint128::new(current_max_amount + 1)
+        );
 
-        // If we try to update to an invalid sending precision it should fail
+        // Let's send an XRPL token evidence, modify the max_holding_amount, check that it's updated, and send the next evidence to see
+        // that max_holding_amount checks are applied correctly
+
+        // Get current bridged amount
+        let bank = Bank::new(&app);
+        let total_supplies = bank
+            .query_total_supply(&QueryTotalSupplyRequest { pagination: None })
+            .unwrap();
+
+        let mut current_bridged_amount = 0;
+        for total_supply in total_supplies.supply.iter() {
+            if total_supply.denom == xrpl_token_denom {
+                current_bridged_amount = total_supply.amount.clone().parse::<u128>().unwrap();
+                break;
+            }
+        }
+
+        // Let's update the max holding amount with current bridged amount - 1 (it should fail)
         let update_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::UpdateCoreumToken {
-                    denom: coreum_token_denom.clone(),
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
                     state: None,
-                    sending_precision: Some(7),
+                    sending_precision: None,
                     bridging_fee: None,
-                    max_holding_amount: None,
+                    max_holding_amount: Some(Uint128::new(current_bridged_amount - 1)),
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
                 &vec![],
                 &signer,
@@ -7963,31 +9903,102 @@ mod tests {
             .unwrap_err();
 
         assert!(update_error.to_string().contains(
-            ContractError::InvalidSendingPrecision {}
+            ContractError::InvalidTargetMaxHoldingAmount {}
                 .to_string()
                 .as_str()
         ));
 
-        // We will send 1 token and then modify the sending precision which should not allow the token to be sent with second evidence
+        // Let's send the first XRPL transfer evidence
+        let amount_to_send = 1001000;
 
-        // Enable the token again (it was disabled)
+        let tx_hash = generate_hash();
+        // First evidence should succeed
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLToCoreumTransfer {
+                    tx_hash: tx_hash.clone(),
+                    issuer: xrpl_token.issuer.clone(),
+                    currency: xrpl_token.currency.clone(),
+                    amount: Uint128::new(amount_to_send),
+                    recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
+                },
+            },
+            &[],
+            relayer_accounts[0],
+        )
+        .unwrap();
+
+        // Let's update the max holding amount with current bridged amount + amount to send - 1 (it should fail in next evidence send because it won't be enough)
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::UpdateXRPLToken {
                 issuer: xrpl_token.issuer.clone(),
                 currency: xrpl_token.currency.clone(),
-                state: Some(TokenState::Enabled),
+                state: None,
                 sending_precision: None,
                 bridging_fee: None,
-                max_holding_amount: None,
+                max_holding_amount: Some(Uint128::new(current_bridged_amount + amount_to_send - 1)),
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // If we try to send the second evidence it should fail because we can't go over max holding amount
+        let bridging_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: tx_hash.clone(),
+                        issuer: xrpl_token.issuer.clone(),
+                        currency: xrpl_token.currency.clone(),
+                        amount: Uint128::new(amount_to_send),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
+                    },
+                },
+                &[],
+                relayer_accounts[1],
+            )
+            .unwrap_err();
+
+        assert!(bridging_error.to_string().contains(
+            ContractError::MaximumBridgedAmountReached {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Get previous balance of user to compare later
+        let previous_balance_user = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrpl_token_denom.clone(),
+            })
+            .unwrap();
+
+        // Let's update the max holding amount with current bridged amount + amount to send (second evidence should go through)
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLToken {
+                issuer: xrpl_token.issuer.clone(),
+                currency: xrpl_token.currency.clone(),
+                state: None,
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: Some(Uint128::new(current_bridged_amount + amount_to_send)),
+                max_sends_per_address_per_day: None,
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        // First evidence should succeed
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
@@ -7995,64 +10006,166 @@ mod tests {
                     tx_hash: tx_hash.clone(),
                     issuer: xrpl_token.issuer.clone(),
                     currency: xrpl_token.currency.clone(),
-                    amount: Uint128::one(),
+                    amount: Uint128::new(amount_to_send),
                     recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
-            relayer_accounts[0],
+            relayer_accounts[1],
+        )
+        .unwrap();
+
+        let new_balance_user = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrpl_token_denom.clone(),
+            })
+            .unwrap();
+
+        // Check balance has been sent to user
+        assert_eq!(
+            new_balance_user.balance.parse::<u128>().unwrap(),
+            previous_balance_user
+                .balance
+                .parse::<u128>()
+                .unwrap()
+                .checked_add(amount_to_send)
+                .unwrap()
+                .checked_sub(1000) // bridging fee
+                .unwrap()
+        );
+    }
+
+    #[test]
+    fn test_burning_rate_and_commission_fee_coreum_tokens() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 3;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
+
+        let signer = accounts.get(0).unwrap();
+        let relayer_account = accounts.get(1).unwrap();
+        let sender = accounts.get(2).unwrap();
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(relayer_account.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let xrpl_receiver_address = generate_xrpl_address();
+        let bridge_xrpl_address = generate_xrpl_address();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            9,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            bridge_xrpl_address.clone(),
+            10,
+        );
+
+        // Add enough tickets for all our test operations
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(10),
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..11).collect()),
+                    }),
+                },
+            },
+            &vec![],
+            relayer_account,
         )
         .unwrap();
 
-        // Let's update the sending precision from 15 to 14
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: Some(14),
-                bridging_fee: None,
-                max_holding_amount: None,
+        // Let's issue a token with burning and commission fees and make sure it works out of the box
+        let asset_ft = AssetFT::new(&app);
+        let symbol = "TEST".to_string();
+        let subunit = "utest".to_string();
+        let decimals = 6;
+        let initial_amount = Uint128::new(10000000000);
+        asset_ft
+            .issue(
+                MsgIssue {
+                    issuer: signer.address(),
+                    symbol,
+                    subunit: subunit.clone(),
+                    precision: decimals,
+                    initial_amount: initial_amount.to_string(),
+                    description: "description".to_string(),
+                    features: vec![MINTING as i32],
+                    burn_rate: "1000000000000000000".to_string(), // 1e18 = 100%
+                    send_commission_rate: "1000000000000000000".to_string(), // 1e18 = 100%
+                    uri: "uri".to_string(),
+                    uri_hash: "uri_hash".to_string(),
+                },
+                &signer,
+            )
+            .unwrap();
+
+        let denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
+
+        // Let's transfer some tokens to a sender from the issuer so that we can check both rates being applied
+        let bank = Bank::new(&app);
+        bank.send(
+            MsgSend {
+                from_address: signer.address(),
+                to_address: sender.address(),
+                amount: vec![BaseCoin {
+                    amount: "100000000".to_string(),
+                    denom: denom.to_string(),
+                }],
             },
-            &vec![],
             &signer,
         )
         .unwrap();
 
-        let evidence_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: xrpl_token.issuer.clone(),
-                        currency: xrpl_token.currency.clone(),
-                        amount: Uint128::one(),
-                        recipient: Addr::unchecked(signer.address()),
-                    },
-                },
-                &[],
-                relayer_accounts[1],
-            )
-            .unwrap_err();
+        // Check the balance
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
 
-        assert!(evidence_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
+        assert_eq!(request_balance.balance, "100000000".to_string());
 
-        // If we put it back to 15 and send, it should go through
+        // Let's try to bridge some tokens and back and check that everything works correctly
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: Some(15),
-                bridging_fee: None,
-                max_holding_amount: None,
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: denom.clone(),
+                decimals,
+                sending_precision: 6,
+                max_holding_amount: Uint128::new(1000000000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
             },
             &vec![],
             &signer,
@@ -8061,298 +10174,376 @@ mod tests {
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::one(),
-                    recipient: Addr::unchecked(signer.address()),
-                },
+            &ExecuteMsg::SendToXRPL {
+                recipient: xrpl_receiver_address.clone(),
+                deliver_amount: None,
             },
-            &[],
-            relayer_accounts[1],
+            &coins(100, denom.clone()),
+            &sender,
         )
         .unwrap();
 
-        // Let's send a bigger amount and check that it is truncated correctly after updating the sending precision
-        let tx_hash = generate_hash();
+        // This should have burned an extra 100 and charged 100 tokens as commission fee to the sender. Let's check just in case
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: sender.address(),
+                denom: denom.clone(),
+            })
+            .unwrap();
 
-        let previous_balance = asset_ft
+        assert_eq!(request_balance.balance, "99999700".to_string());
+
+        // Let's check that only 100 tokens are in the contract
+        let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: signer.address(),
-                denom: xrpl_token_denom.clone(),
+                account: contract_addr.clone(),
+                denom: denom.clone(),
             })
             .unwrap();
-        let amount_to_send = 100001; // This should truncate 1 after updating sending precision and send 100000
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
-                    recipient: Addr::unchecked(signer.address()),
+        assert_eq!(request_balance.balance, "100".to_string());
+
+        // Let's confirm the briding XRPL and bridge the entire amount back to Coreum
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
-            },
-            &[],
-            relayer_accounts[0],
-        )
-        .unwrap();
+            )
+            .unwrap();
+        assert_eq!(query_pending_operations.operations.len(), 1);
 
-        // Let's update the sending precision from 15 to 10
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: Some(10),
-                bridging_fee: None,
-                max_holding_amount: None,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: query_pending_operations.operations[0].account_sequence,
+                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
             },
             &vec![],
-            &signer,
+            relayer_account,
         )
         .unwrap();
 
+        // Get the token information
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
+                &contract_addr,
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let coreum_originated_token = query_coreum_tokens
+            .tokens
+            .iter()
+            .find(|t| t.denom == denom)
+            .unwrap();
+
+        let amount_to_send_back = Uint128::new(100_000_000_000); // 100 utokens on Coreum are represented as 1e11 on XRPL
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
-                    recipient: Addr::unchecked(signer.address()),
+                    tx_hash: generate_hash(),
+                    issuer: bridge_xrpl_address.clone(),
+                    currency: coreum_originated_token.xrpl_currency.clone(),
+                    amount: amount_to_send_back.clone(),
+                    recipient: Addr::unchecked(sender.address()),
+                    destination_chain_recipient: None,
                 },
             },
             &[],
-            relayer_accounts[1],
+            relayer_account,
         )
         .unwrap();
 
-        let new_balance = asset_ft
+        // Check that the sender received the correct amount (100 tokens) and contract doesn't have anything left
+        // This way we confirm that contract is not affected by commission fees and burn rate
+        let request_balance = asset_ft
             .query_balance(&QueryBalanceRequest {
-                account: signer.address(),
-                denom: xrpl_token_denom.clone(),
+                account: sender.address(),
+                denom: denom.clone(),
             })
             .unwrap();
 
-        assert_eq!(
-            new_balance.balance.parse::<u128>().unwrap(),
-            previous_balance
-                .balance
-                .parse::<u128>()
-                .unwrap()
-                .checked_add(amount_to_send)
-                .unwrap()
-                .checked_sub(1) // Truncated amount after updating sending precision
-                .unwrap()
+        assert_eq!(request_balance.balance, "99999800".to_string());
+
+        let request_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: contract_addr.clone(),
+                denom: denom.clone(),
+            })
+            .unwrap();
+
+        assert_eq!(request_balance.balance, "0".to_string());
+    }
+
+    #[test]
+    fn key_rotation() {
+        let app = CoreumTestApp::new();
+        let accounts_number = 4;
+        let accounts = app
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
+
+        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
+        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
+        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
+
+        let mut relayer_accounts = vec![];
+        let mut relayers = vec![];
+
+        for i in 0..accounts_number - 1 {
+            relayer_accounts.push(accounts.get(i as usize).unwrap());
+            relayers.push(Relayer {
+                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
+                xrpl_address: xrpl_addresses[i as usize].to_string(),
+                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
+            });
+        }
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let xrpl_base_fee = 10;
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![
+                relayers[0].clone(),
+                relayers[1].clone(),
+                relayers[2].clone(),
+            ],
+            3,
+            4,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            xrpl_base_fee,
         );
 
-        // Updating bridging fee for Coreum Token should work
+        // Recover enough tickets for testing
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateCoreumToken {
-                denom: coreum_token_denom.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: Some(Uint128::new(1000)),
-                max_holding_amount: None,
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(5),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Get the token information
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some((1..6).collect()),
+                        }),
+                    },
                 },
+                &vec![],
+                relayer,
             )
             .unwrap();
+        }
 
-        assert_eq!(
-            query_coreum_tokens.tokens[0].bridging_fee,
-            Uint128::new(1000)
-        );
-
-        // Let's send an XRPL token evidence, modify the bridging fee, check that it's updated, and send the next evidence to see that bridging fee is applied correctly
-        let amount_to_send = 1000000;
-
-        let tx_hash = generate_hash();
-        // First evidence should succeed
+        // Let's send a random evidence from 1 relayer that will stay after key rotation to confirm that it will be cleared after key rotation confirmation
+        let tx_hash_old_evidence = generate_hash();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
+                    tx_hash: tx_hash_old_evidence.clone(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    amount: Uint128::one(),
                     recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
-            &[],
-            relayer_accounts[0],
-        )
-        .unwrap();
-
-        // Let's update the bridging fee from 0 to 10000000
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: Some(Uint128::new(10000000)),
-                max_holding_amount: None,
-            },
             &vec![],
-            &signer,
+            &relayer_accounts[0],
         )
         .unwrap();
 
-        // If we try to send the second evidence it should fail because we can't cover new updated bridging fee
-        let bridging_error = wasm
+        // If we send it again it should by same relayer it should fail because it's duplicated
+        let error_duplicated_evidence = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: xrpl_token.issuer.clone(),
-                        currency: xrpl_token.currency.clone(),
-                        amount: Uint128::new(amount_to_send),
+                        tx_hash: tx_hash_old_evidence.clone(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        amount: Uint128::one(),
                         recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &[],
-                relayer_accounts[1],
+                &vec![],
+                &relayer_accounts[0],
             )
             .unwrap_err();
 
-        assert!(bridging_error.to_string().contains(
-            ContractError::CannotCoverBridgingFees {}
+        assert!(error_duplicated_evidence.to_string().contains(
+            ContractError::EvidenceAlreadyProvided {}
                 .to_string()
                 .as_str()
         ));
 
-        // Let's update the bridging fee from 0 to 100000
+        // We are going to perform a key rotation, for that we are going to remove a malicious relayer
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: Some(Uint128::new(1000000)),
-                max_holding_amount: None,
+            &ExecuteMsg::RotateKeys {
+                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                new_evidence_threshold: 2,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // If we try to send the second evidence it should fail because amount is 0 after applying bridging fees
-        let bridging_error = wasm
+        // If we try to perform another key rotation, it should fail because we have one pending ongoing
+        let pending_rotation_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::RotateKeys {
+                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                    new_evidence_threshold: 2,
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(pending_rotation_error
+            .to_string()
+            .contains(ContractError::RotateKeysOngoing {}.to_string().as_str()));
+
+        // Let's confirm that a pending operation is created
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(1),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::RotateKeys {
+                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                    new_evidence_threshold: 2
+                },
+                xrpl_base_fee,
+            }
+        );
+
+        // Any evidence we send now that is not a RotateKeys evidence should fail
+        let error_no_key_rotation_evidence = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: xrpl_token.issuer.clone(),
-                        currency: xrpl_token.currency.clone(),
-                        amount: Uint128::new(amount_to_send),
+                        tx_hash: tx_hash_old_evidence.clone(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        amount: Uint128::one(),
                         recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &[],
-                relayer_accounts[1],
+                &vec![],
+                &relayer_accounts[1],
             )
             .unwrap_err();
 
-        assert!(bridging_error.to_string().contains(
-            ContractError::AmountSentIsZeroAfterTruncation {}
-                .to_string()
-                .as_str()
-        ));
+        assert!(error_no_key_rotation_evidence
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
 
-        // Let's update the bridging fee from 0 to 1000
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: Some(Uint128::new(1000)),
-                max_holding_amount: None,
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        // We are going to confirm the RotateKeys as rejected and check that nothing is changed and bridge is still halted
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: None,
+                        ticket_sequence: Some(1),
+                        transaction_result: TransactionResult::Rejected,
+                        operation_result: None,
+                    },
+                },
+                &vec![],
+                relayer,
+            )
+            .unwrap();
+        }
 
-        // Sending evidence should succeed
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
-                    recipient: Addr::unchecked(signer.address()),
+        // Pending operation should have been removed
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
-            },
-            &[],
-            relayer_accounts[1],
-        )
-        .unwrap();
+            )
+            .unwrap();
 
-        let previous_balance = new_balance;
-        let new_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: signer.address(),
-                denom: xrpl_token_denom.clone(),
-            })
+        assert!(query_pending_operations.operations.is_empty());
+
+        // Check config and see that it's the same as before and bridge is still halted
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
             .unwrap();
 
-        assert_eq!(
-            new_balance.balance.parse::<u128>().unwrap(),
-            previous_balance
-                .balance
-                .parse::<u128>()
-                .unwrap()
-                .checked_add(amount_to_send) // 1000000 - 1000 (bridging fee) = 999000
-                .unwrap()
-                .checked_sub(1000) // bridging fee
-                .unwrap()
-                .checked_sub(99000) // Truncated amount after applying bridging fees (sending precision is 10) = 999000 -> 900000
-                .unwrap()
-        );
+        assert_eq!(query_config.relayers, relayers);
+        assert_eq!(query_config.evidence_threshold, 3);
+        assert_eq!(query_config.bridge_state, BridgeState::Halted);
 
-        // Let's bridge some tokens from Coreum to XRPL to have some amount in the bridge
-        let current_max_amount = 10000;
+        // Let's try to perform a key rotation again and check that it works
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: generate_xrpl_address(),
-                deliver_amount: None,
+            &ExecuteMsg::RotateKeys {
+                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                new_evidence_threshold: 2,
             },
-            &coins(current_max_amount, coreum_token_denom.clone()),
+            &vec![],
             &signer,
         )
         .unwrap();
 
+        // Let's confirm that a pending operation is created
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -8364,7 +10555,23 @@ mod tests {
             .unwrap();
 
         assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0],
+            Operation {
+                id: query_pending_operations.operations[0].id.clone(),
+                version: 1,
+                ticket_sequence: Some(2),
+                account_sequence: None,
+                signatures: vec![],
+                operation_type: OperationType::RotateKeys {
+                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                    new_evidence_threshold: 2
+                },
+                xrpl_base_fee,
+            }
+        );
 
+        // We are going to confirm the RotateKeys as accepted and check that config has been updated correctly
         let tx_hash = generate_hash();
         for relayer in relayer_accounts.iter() {
             wasm.execute::<ExecuteMsg>(
@@ -8373,11 +10580,7 @@ mod tests {
                     evidence: Evidence::XRPLTransactionResult {
                         tx_hash: Some(tx_hash.clone()),
                         account_sequence: None,
-                        ticket_sequence: Some(
-                            query_pending_operations.operations[0]
-                                .ticket_sequence
-                                .unwrap(),
-                        ),
+                        ticket_sequence: Some(2),
                         transaction_result: TransactionResult::Accepted,
                         operation_result: None,
                     },
@@ -8388,395 +10591,154 @@ mod tests {
             .unwrap();
         }
 
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: coreum_token_denom.clone(),
-            })
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
             .unwrap();
 
-        assert_eq!(request_balance.balance, current_max_amount.to_string());
-
-        // Updating max holding amount for Coreum Token should work with less than current holding amount should not work
-        let error_update = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::UpdateCoreumToken {
-                    denom: coreum_token_denom.clone(),
-                    state: None,
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: Some(Uint128::new(current_max_amount - 1)),
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(error_update.to_string().contains(
-            ContractError::InvalidTargetMaxHoldingAmount {}
-                .to_string()
-                .as_str()
-        ));
+        assert_eq!(
+            query_config.relayers,
+            vec![relayers[0].clone(), relayers[1].clone()]
+        );
+        assert_eq!(query_config.evidence_threshold, 2);
+        assert_eq!(query_config.bridge_state, BridgeState::Halted);
 
-        // Updating max holding amount with more than current holding amount should work
+        // Owner can now resume the bridge
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateCoreumToken {
-                denom: coreum_token_denom.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: None,
-                max_holding_amount: Some(Uint128::new(current_max_amount + 1)),
-            },
+            &ExecuteMsg::ResumeBridge {},
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert_eq!(
-            query_coreum_tokens.tokens[0].max_holding_amount,
-            Uint128::new(current_max_amount + 1)
-        );
-
-        // Let's send an XRPL token evidence, modify the max_holding_amount, check that it's updated, and send the next evidence to see
-        // that max_holding_amount checks are applied correctly
-
-        // Get current bridged amount
-        let bank = Bank::new(&app);
-        let total_supplies = bank
-            .query_total_supply(&QueryTotalSupplyRequest { pagination: None })
-            .unwrap();
-
-        let mut current_bridged_amount = 0;
-        for total_supply in total_supplies.supply.iter() {
-            if total_supply.denom == xrpl_token_denom {
-                current_bridged_amount = total_supply.amount.clone().parse::<u128>().unwrap();
-                break;
-            }
-        }
-
-        // Let's update the max holding amount with current bridged amount - 1 (it should fail)
-        let update_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::UpdateXRPLToken {
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    state: None,
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: Some(Uint128::new(current_bridged_amount - 1)),
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
-
-        assert!(update_error.to_string().contains(
-            ContractError::InvalidTargetMaxHoldingAmount {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Let's send the first XRPL transfer evidence
-        let amount_to_send = 1001000;
-
-        let tx_hash = generate_hash();
-        // First evidence should succeed
+        // Let's check that evidences have been cleared by sending again the old evidence and it succeeds
+        // If evidences were cleared, this message will succeed because the evidence is not stored
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
                 evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
+                    tx_hash: tx_hash_old_evidence.clone(),
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    amount: Uint128::one(),
                     recipient: Addr::unchecked(signer.address()),
+                    destination_chain_recipient: None,
                 },
             },
-            &[],
-            relayer_accounts[0],
-        )
-        .unwrap();
-
-        // Let's update the max holding amount with current bridged amount + amount to send - 1 (it should fail in next evidence send because it won't be enough)
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: None,
-                max_holding_amount: Some(Uint128::new(current_bridged_amount + amount_to_send - 1)),
-            },
             &vec![],
-            &signer,
+            &relayer_accounts[0],
         )
         .unwrap();
 
-        // If we try to send the second evidence it should fail because we can't go over max holding amount
-        let bridging_error = wasm
+        // Finally, let's check that the old relayer can not send evidences anymore
+        let error_not_relayer = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
                     evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash.clone(),
-                        issuer: xrpl_token.issuer.clone(),
-                        currency: xrpl_token.currency.clone(),
-                        amount: Uint128::new(amount_to_send),
+                        tx_hash: tx_hash_old_evidence.clone(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        amount: Uint128::one(),
                         recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &[],
-                relayer_accounts[1],
+                &vec![],
+                &relayer_accounts[2],
             )
-            .unwrap_err();
-
-        assert!(bridging_error.to_string().contains(
-            ContractError::MaximumBridgedAmountReached {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Get previous balance of user to compare later
-        let previous_balance_user = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: signer.address(),
-                denom: xrpl_token_denom.clone(),
-            })
-            .unwrap();
-
-        // Let's update the max holding amount with current bridged amount + amount to send (second evidence should go through)
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::UpdateXRPLToken {
-                issuer: xrpl_token.issuer.clone(),
-                currency: xrpl_token.currency.clone(),
-                state: None,
-                sending_precision: None,
-                bridging_fee: None,
-                max_holding_amount: Some(Uint128::new(current_bridged_amount + amount_to_send)),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash.clone(),
-                    issuer: xrpl_token.issuer.clone(),
-                    currency: xrpl_token.currency.clone(),
-                    amount: Uint128::new(amount_to_send),
-                    recipient: Addr::unchecked(signer.address()),
-                },
-            },
-            &[],
-            relayer_accounts[1],
-        )
-        .unwrap();
-
-        let new_balance_user = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: signer.address(),
-                denom: xrpl_token_denom.clone(),
-            })
-            .unwrap();
+            .unwrap_err();
 
-        // Check balance has been sent to user
-        assert_eq!(
-            new_balance_user.balance.parse::<u128>().unwrap(),
-            previous_balance_user
-                .balance
-                .parse::<u128>()
-                .unwrap()
-                .checked_add(amount_to_send)
-                .unwrap()
-                .checked_sub(1000) // bridging fee
-                .unwrap()
-        );
+        assert!(error_not_relayer
+            .to_string()
+            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
     }
 
     #[test]
-    fn test_burning_rate_and_commission_fee_coreum_tokens() {
+    fn key_rotation_bumps_pending_operations_version() {
         let app = CoreumTestApp::new();
-        let accounts_number = 3;
         let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
+            .init_accounts(&coins(100_000_000_000, FEE_DENOM), 3)
             .unwrap();
 
-        let signer = accounts.get(0).unwrap();
-        let relayer_account = accounts.get(1).unwrap();
-        let sender = accounts.get(2).unwrap();
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(relayer_account.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
-
-        let xrpl_receiver_address = generate_xrpl_address();
-        let bridge_xrpl_address = generate_xrpl_address();
+        let signer = accounts.get(2).unwrap();
+        let relayer_accounts = vec![accounts.get(0).unwrap(), accounts.get(1).unwrap()];
+        let relayers: Vec<Relayer> = relayer_accounts
+            .iter()
+            .map(|account| Relayer {
+                coreum_address: Addr::unchecked(account.address()),
+                xrpl_address: generate_xrpl_address(),
+                xrpl_pub_key: generate_xrpl_pub_key(),
+            })
+            .collect();
 
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
+        let xrpl_base_fee = 10;
 
         let contract_addr = store_and_instantiate(
             &wasm,
-            signer,
+            &signer,
             Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
-            1,
-            9,
+            relayers.clone(),
+            2,
+            4,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
-            bridge_xrpl_address.clone(),
-            10,
+            generate_xrpl_address(),
+            xrpl_base_fee,
         );
 
-        // Add enough tickets for all our test operations
-
+        // Recover enough tickets for testing
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(10),
+                number_of_tickets: Some(5),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..11).collect()),
-                    }),
-                },
-            },
-            &vec![],
-            relayer_account,
-        )
-        .unwrap();
-
-        // Let's issue a token with burning and commission fees and make sure it works out of the box
-        let asset_ft = AssetFT::new(&app);
-        let symbol = "TEST".to_string();
-        let subunit = "utest".to_string();
-        let decimals = 6;
-        let initial_amount = Uint128::new(10000000000);
-        asset_ft
-            .issue(
-                MsgIssue {
-                    issuer: signer.address(),
-                    symbol,
-                    subunit: subunit.clone(),
-                    precision: decimals,
-                    initial_amount: initial_amount.to_string(),
-                    description: "description".to_string(),
-                    features: vec![MINTING as i32],
-                    burn_rate: "1000000000000000000".to_string(), // 1e18 = 100%
-                    send_commission_rate: "1000000000000000000".to_string(), // 1e18 = 100%
-                    uri: "uri".to_string(),
-                    uri_hash: "uri_hash".to_string(),
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some((1..6).collect()),
+                        }),
+                    },
                 },
-                &signer,
+                &vec![],
+                relayer,
             )
             .unwrap();
+        }
 
-        let denom = format!("{}-{}", subunit, signer.address()).to_lowercase();
-
-        // Let's transfer some tokens to a sender from the issuer so that we can check both rates being applied
-        let bank = Bank::new(&app);
-        bank.send(
-            MsgSend {
-                from_address: signer.address(),
-                to_address: sender.address(),
-                amount: vec![BaseCoin {
-                    amount: "100000000".to_string(),
-                    denom: denom.to_string(),
-                }],
-            },
-            &signer,
-        )
-        .unwrap();
-
-        // Check the balance
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "100000000".to_string());
-
-        // Let's try to bridge some tokens and back and check that everything works correctly
+        // The registration of the XRPL token creates the TrustSet operation which is signed by one relayer
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: denom.clone(),
-                decimals,
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: generate_xrpl_address(),
+                currency: "USD".to_string(),
                 sending_precision: 6,
-                max_holding_amount: Uint128::new(1000000000),
+                max_holding_amount: Uint128::new(TRUST_SET_LIMIT_AMOUNT),
                 bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
-            &vec![],
+            &query_issue_fee(&asset_ft),
             &signer,
         )
         .unwrap();
 
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SendToXRPL {
-                recipient: xrpl_receiver_address.clone(),
-                deliver_amount: None,
-            },
-            &coins(100, denom.clone()),
-            &sender,
-        )
-        .unwrap();
-
-        // This should have burned an extra 100 and charged 100 tokens as commission fee to the sender. Let's check just in case
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "99999700".to_string());
-
-        // Let's check that only 100 tokens are in the contract
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
-            .unwrap();
-
-        assert_eq!(request_balance.balance, "100".to_string());
-
-        // Let's confirm the briding XRPL and bridge the entire amount back to Coreum
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -8787,101 +10749,125 @@ mod tests {
             )
             .unwrap();
         assert_eq!(query_pending_operations.operations.len(), 1);
+        let trust_set_ticket = query_pending_operations.operations[0]
+            .ticket_sequence
+            .unwrap();
 
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: query_pending_operations.operations[0].account_sequence,
-                    ticket_sequence: query_pending_operations.operations[0].ticket_sequence,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
-                },
+            &ExecuteMsg::SaveSignature {
+                operation_id: trust_set_ticket,
+                operation_version: 1,
+                signature: "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string(),
             },
             &vec![],
-            relayer_account,
+            relayer_accounts[0],
         )
         .unwrap();
 
-        // Get the token information
-        let query_coreum_tokens = wasm
-            .query::<QueryMsg, CoreumTokensResponse>(
-                &contract_addr,
-                &QueryMsg::CoreumTokens {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        let coreum_originated_token = query_coreum_tokens
-            .tokens
-            .iter()
-            .find(|t| t.denom == denom)
-            .unwrap();
-
-        let amount_to_send_back = Uint128::new(100_000_000_000); // 100 utokens on Coreum are represented as 1e11 on XRPL
+        // The keys rotation changes the evidence threshold, so the fee of the signed TrustSet transaction changes too
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: generate_hash(),
-                    issuer: bridge_xrpl_address.clone(),
-                    currency: coreum_originated_token.xrpl_currency.clone(),
-                    amount: amount_to_send_back.clone(),
-                    recipient: Addr::unchecked(sender.address()),
-                },
+            &ExecuteMsg::RotateKeys {
+                new_relayers: vec![relayers[0].clone()],
+                new_evidence_threshold: 1,
             },
-            &[],
-            relayer_account,
+            &vec![],
+            &signer,
         )
         .unwrap();
 
-        // Check that the sender received the correct amount (100 tokens) and contract doesn't have anything left
-        // This way we confirm that contract is not affected by commission fees and burn rate
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: sender.address(),
-                denom: denom.clone(),
-            })
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
             .unwrap();
+        let rotate_keys_ticket = query_pending_operations
+            .operations
+            .iter()
+            .find(|operation| matches!(operation.operation_type, OperationType::RotateKeys { .. }))
+            .unwrap()
+            .ticket_sequence;
 
-        assert_eq!(request_balance.balance, "99999800".to_string());
-
-        let request_balance = asset_ft
-            .query_balance(&QueryBalanceRequest {
-                account: contract_addr.clone(),
-                denom: denom.clone(),
-            })
+        let tx_hash = generate_hash();
+        let mut confirmation_result = None;
+        for relayer in relayer_accounts.iter() {
+            confirmation_result = Some(
+                wasm.execute::<ExecuteMsg>(
+                    &contract_addr,
+                    &ExecuteMsg::SaveEvidence {
+                        evidence: Evidence::XRPLTransactionResult {
+                            tx_hash: Some(tx_hash.clone()),
+                            account_sequence: None,
+                            ticket_sequence: rotate_keys_ticket,
+                            transaction_result: TransactionResult::Accepted,
+                            operation_result: None,
+                        },
+                    },
+                    &vec![],
+                    relayer,
+                )
+                .unwrap(),
+            );
+        }
+
+        // The version bump of the remaining pending operation is announced with the event
+        let version_bumped_event = confirmation_result
+            .unwrap()
+            .events
+            .into_iter()
+            .find(|e| e.ty == "wasm-operations_version_bumped")
             .unwrap();
+        assert!(version_bumped_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "operations_count" && a.value == "1"));
 
-        assert_eq!(request_balance.balance, "0".to_string());
+        // The signature of the old version is dropped, so the new relayers sign the operation again
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+        assert_eq!(query_pending_operations.operations.len(), 1);
+        assert_eq!(
+            query_pending_operations.operations[0].ticket_sequence,
+            Some(trust_set_ticket)
+        );
+        assert_eq!(query_pending_operations.operations[0].version, 2);
+        assert_eq!(
+            query_pending_operations.operations[0].xrpl_base_fee,
+            xrpl_base_fee
+        );
+        assert!(query_pending_operations.operations[0].signatures.is_empty());
     }
 
     #[test]
-    fn key_rotation() {
+    fn bridge_halting_and_resuming() {
         let app = CoreumTestApp::new();
-        let accounts_number = 4;
+        let accounts_number = 3;
         let accounts = app
             .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
             .unwrap();
 
-        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
-        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
-        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
-
-        let mut relayer_accounts = vec![];
-        let mut relayers = vec![];
+        let signer = accounts.get(0).unwrap();
+        let relayer_account = accounts.get(1).unwrap();
+        let new_relayer_account = accounts.get(2).unwrap();
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(relayer_account.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        for i in 0..accounts_number - 1 {
-            relayer_accounts.push(accounts.get(i as usize).unwrap());
-            relayers.push(Relayer {
-                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
-                xrpl_address: xrpl_addresses[i as usize].to_string(),
-                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
-            });
-        }
+        let bridge_xrpl_address = generate_xrpl_address();
 
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
@@ -8889,230 +10875,232 @@ mod tests {
 
         let contract_addr = store_and_instantiate(
             &wasm,
-            &signer,
+            signer,
             Addr::unchecked(signer.address()),
-            vec![
-                relayers[0].clone(),
-                relayers[1].clone(),
-                relayers[2].clone(),
-            ],
-            3,
-            4,
+            vec![relayer.clone()],
+            1,
+            9,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
+            bridge_xrpl_address.clone(),
             xrpl_base_fee,
         );
 
-        // Recover enough tickets for testing
+        // Halt the bridge and check that we can't send any operations except allowed ones
+        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
+            .unwrap();
+
+        // Query bridge state to confirm it's halted
+        let query_bridge_state = wasm
+            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+            .unwrap();
+
+        assert_eq!(query_bridge_state.state, BridgeState::Halted);
+
+        // Setting up some tickets should be allowed
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(5),
+                number_of_tickets: Some(10),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: Some((1..6).collect()),
-                        }),
-                    },
-                },
-                &vec![],
-                relayer,
-            )
-            .unwrap();
-        }
-
-        // Let's send a random evidence from 1 relayer that will stay after key rotation to confirm that it will be cleared after key rotation confirmation
-        let tx_hash_old_evidence = generate_hash();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash_old_evidence.clone(),
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    amount: Uint128::one(),
-                    recipient: Addr::unchecked(signer.address()),
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..11).collect()),
+                    }),
                 },
             },
             &vec![],
-            &relayer_accounts[0],
+            &relayer_account,
         )
         .unwrap();
 
-        // If we send it again it should by same relayer it should fail because it's duplicated
-        let error_duplicated_evidence = wasm
+        // Trying to register tokens should fail
+        let bridge_halted_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash_old_evidence.clone(),
-                        issuer: XRP_ISSUER.to_string(),
-                        currency: XRP_CURRENCY.to_string(),
-                        amount: Uint128::one(),
-                        recipient: Addr::unchecked(signer.address()),
-                    },
+                &ExecuteMsg::RegisterCoreumToken {
+                    denom: "any_denom".to_string(),
+                    decimals: 6,
+                    sending_precision: 1,
+                    max_holding_amount: Uint128::one(),
+                    bridging_fee: Uint128::zero(),
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
-                &relayer_accounts[0],
+                &signer,
             )
             .unwrap_err();
 
-        assert!(error_duplicated_evidence.to_string().contains(
-            ContractError::EvidenceAlreadyProvided {}
-                .to_string()
-                .as_str()
-        ));
-
-        // We are going to perform a key rotation, for that we are going to remove a malicious relayer
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RotateKeys {
-                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                new_evidence_threshold: 2,
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
 
-        // If we try to perform another key rotation, it should fail because we have one pending ongoing
-        let pending_rotation_error = wasm
+        let bridge_halted_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RotateKeys {
-                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                    new_evidence_threshold: 2,
+                &ExecuteMsg::RegisterXRPLToken {
+                    issuer: generate_xrpl_address(),
+                    currency: "USD".to_string(),
+                    sending_precision: 4,
+                    max_holding_amount: Uint128::new(50000),
+                    bridging_fee: Uint128::zero(),
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
-                &vec![],
+                &query_issue_fee(&asset_ft),
                 &signer,
             )
             .unwrap_err();
 
-        assert!(pending_rotation_error
+        assert!(bridge_halted_error
             .to_string()
-            .contains(ContractError::RotateKeysOngoing {}.to_string().as_str()));
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
 
-        // Let's confirm that a pending operation is created
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // Sending from Coreum to XRPL should fail
+        let bridge_halted_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(1, FEE_DENOM),
+                &signer,
             )
-            .unwrap();
+            .unwrap_err();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(1),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::RotateKeys {
-                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                    new_evidence_threshold: 2
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+
+        // Updating tokens should fail too
+        let bridge_halted_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: "any_issuer".to_string(),
+                    currency: "any_currency".to_string(),
+                    state: Some(TokenState::Disabled),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
                 },
-                xrpl_base_fee,
-            }
-        );
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
 
-        // Any evidence we send now that is not a RotateKeys evidence should fail
-        let error_no_key_rotation_evidence = wasm
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+
+        let bridge_halted_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash_old_evidence.clone(),
-                        issuer: XRP_ISSUER.to_string(),
-                        currency: XRP_CURRENCY.to_string(),
-                        amount: Uint128::one(),
-                        recipient: Addr::unchecked(signer.address()),
-                    },
+                &ExecuteMsg::UpdateCoreumToken {
+                    denom: "any_denom".to_string(),
+                    state: Some(TokenState::Disabled),
+                    sending_precision: None,
+                    bridging_fee: None,
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: None,
+                },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+
+        // Claiming pending refunds or relayers fees should fail
+        let bridge_halted_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::ClaimRefund {
+                    pending_refund_id: "any_id".to_string(),
                 },
                 &vec![],
-                &relayer_accounts[1],
+                &signer,
             )
             .unwrap_err();
 
-        assert!(error_no_key_rotation_evidence
+        assert!(bridge_halted_error
             .to_string()
             .contains(ContractError::BridgeHalted {}.to_string().as_str()));
 
-        // We are going to confirm the RotateKeys as rejected and check that nothing is changed and bridge is still halted
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        let bridge_halted_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: None,
-                        ticket_sequence: Some(1),
-                        transaction_result: TransactionResult::Rejected,
-                        operation_result: None,
-                    },
+                &ExecuteMsg::ClaimRelayerFees {
+                    amounts: vec![coin(1, FEE_DENOM)],
                 },
-                &vec![],
-                relayer,
+                &[],
+                relayer_account,
             )
-            .unwrap();
-        }
+            .unwrap_err();
 
-        // Pending operation should have been removed
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+
+        // Resuming the bridge should work
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ResumeBridge {},
+            &vec![],
+            &signer,
+        )
+        .unwrap();
+
+        // Query bridge state to confirm it's active
+        let query_bridge_state = wasm
+            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
             .unwrap();
 
-        assert!(query_pending_operations.operations.is_empty());
+        assert_eq!(query_bridge_state.state, BridgeState::Active);
 
-        // Check config and see that it's the same as before and bridge is still halted
-        let query_config = wasm
-            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+        // Halt it again to send some allowed operations
+        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
             .unwrap();
 
-        assert_eq!(query_config.relayers, relayers);
-        assert_eq!(query_config.evidence_threshold, 3);
-        assert_eq!(query_config.bridge_state, BridgeState::Halted);
+        // Perform a simple key rotation, should be allowed
+        let new_relayer = Relayer {
+            coreum_address: Addr::unchecked(new_relayer_account.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        // Let's try to perform a key rotation again and check that it works
+        // We perform a key rotation
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RotateKeys {
-                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                new_evidence_threshold: 2,
+                new_relayers: vec![new_relayer.clone()],
+                new_evidence_threshold: 1,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Let's confirm that a pending operation is created
+        // Let's query the pending operations to see that this operation was saved correctly
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -9129,49 +11117,99 @@ mod tests {
             Operation {
                 id: query_pending_operations.operations[0].id.clone(),
                 version: 1,
-                ticket_sequence: Some(2),
+                ticket_sequence: Some(1),
                 account_sequence: None,
                 signatures: vec![],
                 operation_type: OperationType::RotateKeys {
-                    new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                    new_evidence_threshold: 2
+                    new_relayers: vec![new_relayer.clone()],
+                    new_evidence_threshold: 1
                 },
                 xrpl_base_fee,
             }
         );
 
-        // We are going to confirm the RotateKeys as accepted and check that config has been updated correctly
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // Resuming now should not be allowed because we have a pending key rotation
+        let resume_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::ResumeBridge {},
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(resume_error
+            .to_string()
+            .contains(ContractError::RotateKeysOngoing {}.to_string().as_str()));
+
+        // Sending signatures should be allowed with the bridge halted and with pending operations
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveSignature {
+                operation_id: 1,
+                operation_version: 1,
+                signature: "signature".to_string(),
+            },
+            &vec![],
+            relayer_account,
+        )
+        .unwrap();
+
+        // Sending an evidence for something that is not a RotateKeys should fail
+        let bridge_halted_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
                 &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: None,
-                        ticket_sequence: Some(2),
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: None,
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: generate_xrpl_address(),
+                        currency: "USD".to_string(),
+                        amount: Uint128::new(100),
+                        recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
-                &vec![],
-                relayer,
+                &[],
+                &relayer_account,
             )
+            .unwrap_err();
+
+        assert!(bridge_halted_error
+            .to_string()
+            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+
+        // Sending an evidence confirming a Key rotation should work and should also activate the bridge
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: None,
+                },
+            },
+            &[],
+            &relayer_account,
+        )
+        .unwrap();
+
+        // Query bridge state to confirm it's still halted
+        let query_bridge_state = wasm
+            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
             .unwrap();
-        }
 
+        assert_eq!(query_bridge_state.state, BridgeState::Halted);
+
+        // Query config to see that relayers have been correctly rotated
         let query_config = wasm
             .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
             .unwrap();
 
-        assert_eq!(
-            query_config.relayers,
-            vec![relayers[0].clone(), relayers[1].clone()]
-        );
-        assert_eq!(query_config.evidence_threshold, 2);
-        assert_eq!(query_config.bridge_state, BridgeState::Halted);
+        assert_eq!(query_config.relayers, vec![new_relayer]);
 
-        // Owner can now resume the bridge
+        // We should now be able to resume the bridge because the key rotation has been confirmed
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::ResumeBridge {},
@@ -9180,291 +11218,305 @@ mod tests {
         )
         .unwrap();
 
-        // Let's check that evidences have been cleared by sending again the old evidence and it succeeds
-        // If evidences were cleared, this message will succeed because the evidence is not stored
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLToCoreumTransfer {
-                    tx_hash: tx_hash_old_evidence.clone(),
-                    issuer: XRP_ISSUER.to_string(),
-                    currency: XRP_CURRENCY.to_string(),
-                    amount: Uint128::one(),
-                    recipient: Addr::unchecked(signer.address()),
-                },
-            },
-            &vec![],
-            &relayer_accounts[0],
-        )
-        .unwrap();
+        // Query bridge state to confirm it's now active
+        let query_bridge_state = wasm
+            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+            .unwrap();
 
-        // Finally, let's check that the old relayer can not send evidences anymore
-        let error_not_relayer = wasm
+        assert_eq!(query_bridge_state.state, BridgeState::Active);
+
+        // Halt the bridge should not be possible by an address that is not owner or current relayer
+        let halt_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: tx_hash_old_evidence.clone(),
-                        issuer: XRP_ISSUER.to_string(),
-                        currency: XRP_CURRENCY.to_string(),
-                        amount: Uint128::one(),
-                        recipient: Addr::unchecked(signer.address()),
-                    },
-                },
+                &ExecuteMsg::HaltBridge {},
                 &vec![],
-                &relayer_accounts[2],
+                &relayer_account,
             )
             .unwrap_err();
 
-        assert!(error_not_relayer
+        assert!(halt_error
             .to_string()
             .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
+
+        // Current relayer should be allowed to halt it
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::HaltBridge {},
+            &vec![],
+            &new_relayer_account,
+        )
+        .unwrap();
+
+        let query_bridge_state = wasm
+            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+            .unwrap();
+
+        assert_eq!(query_bridge_state.state, BridgeState::Halted);
+
+        // Triggering a fee update during halted bridge should work
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::UpdateXRPLBaseFee { xrpl_base_fee: 600 },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
     }
 
     #[test]
-    fn bridge_halting_and_resuming() {
+    fn updating_xrpl_base_fee() {
         let app = CoreumTestApp::new();
-        let accounts_number = 3;
+        let accounts_number = 4;
         let accounts = app
-            .init_accounts(&coins(100_000_000_000, FEE_DENOM), accounts_number)
-            .unwrap();
-
-        let signer = accounts.get(0).unwrap();
-        let relayer_account = accounts.get(1).unwrap();
-        let new_relayer_account = accounts.get(2).unwrap();
-        let relayer = Relayer {
-            coreum_address: Addr::unchecked(relayer_account.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
+            .init_accounts(&coins(100_000_000_000_000, FEE_DENOM), accounts_number)
+            .unwrap();
 
-        let bridge_xrpl_address = generate_xrpl_address();
+        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
+        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
+        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
+
+        let mut relayer_accounts = vec![];
+        let mut relayers = vec![];
 
+        for i in 0..accounts_number - 1 {
+            relayer_accounts.push(accounts.get(i as usize).unwrap());
+            relayers.push(Relayer {
+                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
+                xrpl_address: xrpl_addresses[i as usize].to_string(),
+                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
+            });
+        }
         let wasm = Wasm::new(&app);
         let asset_ft = AssetFT::new(&app);
         let xrpl_base_fee = 10;
 
         let contract_addr = store_and_instantiate(
             &wasm,
-            signer,
+            &signer,
             Addr::unchecked(signer.address()),
-            vec![relayer.clone()],
-            1,
+            relayers.clone(),
+            3,
             9,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
-            bridge_xrpl_address.clone(),
+            generate_xrpl_address(),
             xrpl_base_fee,
         );
 
-        // Halt the bridge and check that we can't send any operations except allowed ones
-        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
-            .unwrap();
-
-        // Query bridge state to confirm it's halted
-        let query_bridge_state = wasm
-            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
-            .unwrap();
-
-        assert_eq!(query_bridge_state.state, BridgeState::Halted);
-
-        // Setting up some tickets should be allowed
+        // Add enough tickets for all our tests
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(10),
+                number_of_tickets: Some(250),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
+        let tx_hash = generate_hash();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLTransactionResult {
+                        tx_hash: Some(tx_hash.clone()),
+                        account_sequence: Some(1),
+                        ticket_sequence: None,
+                        transaction_result: TransactionResult::Accepted,
+                        operation_result: Some(OperationResult::TicketsAllocation {
+                            tickets: Some((1..251).collect()),
+                        }),
+                    },
+                },
+                &vec![],
+                relayer,
+            )
+            .unwrap();
+        }
+
+        // We are going to create the max number of pending operations and add signatures to them to verify that we can update all of them at once
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..11).collect()),
-                    }),
-                },
+            &ExecuteMsg::RegisterXRPLToken {
+                issuer: generate_xrpl_address(),
+                currency: "USD".to_string(),
+                sending_precision: 15,
+                max_holding_amount: Uint128::new(100000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
+            },
+            &query_issue_fee(&asset_ft),
+            &signer,
+        )
+        .unwrap();
+
+        // Register COREUM to send some
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: FEE_DENOM.to_string(),
+                decimals: 6,
+                sending_precision: 6,
+                max_holding_amount: Uint128::new(100000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
             },
             &vec![],
-            &relayer_account,
+            &signer,
         )
         .unwrap();
 
-        // Trying to register tokens should fail
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
+        // Let's create 247 more so that we get up to 250 in the end
+        for _ in 0..247 {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::RegisterCoreumToken {
-                    denom: "any_denom".to_string(),
-                    decimals: 6,
-                    sending_precision: 1,
-                    max_holding_amount: Uint128::one(),
-                    bridging_fee: Uint128::zero(),
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
-                &vec![],
+                &coins(1, FEE_DENOM.to_string()),
                 &signer,
             )
-            .unwrap_err();
-
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+            .unwrap();
+        }
 
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
+        // Query pending operations with limit and start_after_key to verify it works
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &ExecuteMsg::RegisterXRPLToken {
-                    issuer: generate_xrpl_address(),
-                    currency: "USD".to_string(),
-                    sending_precision: 4,
-                    max_holding_amount: Uint128::new(50000),
-                    bridging_fee: Uint128::zero(),
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: Some(100),
                 },
-                &query_issue_fee(&asset_ft),
-                &signer,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+        assert_eq!(query_pending_operations.operations.len(), 100);
 
-        // Sending from Coreum to XRPL should fail
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
+                &QueryMsg::PendingOperations {
+                    start_after_key: query_pending_operations.last_key,
+                    limit: Some(200),
                 },
-                &coins(1, FEE_DENOM),
-                &signer,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+        assert_eq!(query_pending_operations.operations.len(), 148);
 
-        // Updating tokens should fail too
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
+        // Query all pending operations
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
-                &ExecuteMsg::UpdateXRPLToken {
-                    issuer: "any_issuer".to_string(),
-                    currency: "any_currency".to_string(),
-                    state: Some(TokenState::Disabled),
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: None,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
-                &vec![],
-                &signer,
             )
-            .unwrap_err();
-
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+            .unwrap();
 
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::UpdateCoreumToken {
-                    denom: "any_denom".to_string(),
-                    state: Some(TokenState::Disabled),
-                    sending_precision: None,
-                    bridging_fee: None,
-                    max_holding_amount: None,
-                },
-                &vec![],
-                &signer,
-            )
-            .unwrap_err();
+        assert_eq!(query_pending_operations.operations.len(), 248);
 
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+        // Halt the bridge to verify that we can't send signatures of pending operations that are not allowed
+        let correct_signature_example = "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string();
+        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
+            .unwrap();
 
-        // Claiming pending refunds or relayers fees should fail
-        let bridge_halted_error = wasm
+        let signature_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::ClaimRefund {
-                    pending_refund_id: "any_id".to_string(),
+                &ExecuteMsg::SaveSignature {
+                    operation_id: query_pending_operations.operations[0]
+                        .ticket_sequence
+                        .unwrap(),
+                    operation_version: 1,
+                    signature: correct_signature_example.clone(),
                 },
                 &vec![],
-                &signer,
+                relayer_accounts[0],
             )
             .unwrap_err();
 
-        assert!(bridge_halted_error
+        assert!(signature_error
             .to_string()
             .contains(ContractError::BridgeHalted {}.to_string().as_str()));
 
-        let bridge_halted_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::ClaimRelayerFees {
-                    amounts: vec![coin(1, FEE_DENOM)],
-                },
-                &[],
-                relayer_account,
-            )
-            .unwrap_err();
+        // Resume the bridge to add signatures again
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::ResumeBridge {},
+            &vec![],
+            &signer,
+        )
+        .unwrap();
 
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+        // Add some signatures to each pending operation
+        for pending_operation in query_pending_operations.operations.iter() {
+            for relayer in relayer_accounts.iter() {
+                wasm.execute::<ExecuteMsg>(
+                    &contract_addr,
+                    &ExecuteMsg::SaveSignature {
+                        operation_id: pending_operation.ticket_sequence.unwrap(),
+                        operation_version: 1,
+                        signature: correct_signature_example.clone(),
+                    },
+                    &vec![],
+                    relayer,
+                )
+                .unwrap();
+            }
+        }
 
-        // Resuming the bridge should work
+        // Add a Key Rotation, which will verify that we can update the base fee while the bridge is halted
+        // and to check that we can add signatures for key rotations while bridge is halted
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ResumeBridge {},
+            &ExecuteMsg::RotateKeys {
+                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
+                new_evidence_threshold: 2,
+            },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Query bridge state to confirm it's active
-        let query_bridge_state = wasm
-            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+        // Verify that we have 249 pending operations
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
             .unwrap();
 
-        assert_eq!(query_bridge_state.state, BridgeState::Active);
-
-        // Halt it again to send some allowed operations
-        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
-            .unwrap();
+        assert_eq!(query_pending_operations.operations.len(), 249);
 
-        // Perform a simple key rotation, should be allowed
-        let new_relayer = Relayer {
-            coreum_address: Addr::unchecked(new_relayer_account.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
+        // Sign this last operation with the 3 relayers
 
-        // We perform a key rotation
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::RotateKeys {
-                new_relayers: vec![new_relayer.clone()],
-                new_evidence_threshold: 1,
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        for relayer in relayer_accounts.iter() {
+            wasm.execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::SaveSignature {
+                    operation_id: query_pending_operations.operations[248]
+                        .ticket_sequence
+                        .unwrap(),
+                    operation_version: 1,
+                    signature: correct_signature_example.clone(),
+                },
+                &vec![],
+                relayer,
+            )
+            .unwrap();
+        }
 
-        // Let's query the pending operations to see that this operation was saved correctly
+        // Verify that all pending operations are in version 1 and have three signatures each
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -9475,301 +11527,280 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 1);
-        assert_eq!(
-            query_pending_operations.operations[0],
-            Operation {
-                id: query_pending_operations.operations[0].id.clone(),
-                version: 1,
-                ticket_sequence: Some(1),
-                account_sequence: None,
-                signatures: vec![],
-                operation_type: OperationType::RotateKeys {
-                    new_relayers: vec![new_relayer.clone()],
-                    new_evidence_threshold: 1
-                },
-                xrpl_base_fee,
-            }
-        );
+        for pending_operation in query_pending_operations.operations.iter() {
+            assert_eq!(pending_operation.version, 1);
+            assert_eq!(pending_operation.signatures.len(), 3);
+        }
 
-        // Resuming now should not be allowed because we have a pending key rotation
-        let resume_error = wasm
+        // If we trigger an XRPL base fee by some who is not the owner, it should fail.
+        let unauthorized_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::ResumeBridge {},
+                &ExecuteMsg::UpdateXRPLBaseFee { xrpl_base_fee: 600 },
                 &vec![],
-                &signer,
+                &relayer_accounts[0],
             )
             .unwrap_err();
 
-        assert!(resume_error
+        assert!(unauthorized_error
             .to_string()
-            .contains(ContractError::RotateKeysOngoing {}.to_string().as_str()));
-
-        // Sending signatures should be allowed with the bridge halted and with pending operations
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveSignature {
-                operation_id: 1,
-                operation_version: 1,
-                signature: "signature".to_string(),
-            },
-            &vec![],
-            relayer_account,
-        )
-        .unwrap();
+            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
 
-        // Sending an evidence for something that is not a RotateKeys should fail
-        let bridge_halted_error = wasm
+        let new_xrpl_base_fee = 20;
+        // If we trigger an XRPL base fee update, all signatures must be gone, and pending operations must be in version 2, and pending operations base fee must be the new one
+        let update_result = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLToCoreumTransfer {
-                        tx_hash: generate_hash(),
-                        issuer: generate_xrpl_address(),
-                        currency: "USD".to_string(),
-                        amount: Uint128::new(100),
-                        recipient: Addr::unchecked(signer.address()),
-                    },
+                &ExecuteMsg::UpdateXRPLBaseFee {
+                    xrpl_base_fee: new_xrpl_base_fee,
                 },
-                &[],
-                &relayer_account,
+                &vec![],
+                &signer,
             )
-            .unwrap_err();
+            .unwrap();
 
-        assert!(bridge_halted_error
-            .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+        // The version bump of the pending operations is announced with the event
+        let version_bumped_event = update_result
+            .events
+            .iter()
+            .find(|e| e.ty == "wasm-operations_version_bumped")
+            .unwrap();
+        assert!(version_bumped_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "xrpl_base_fee" && a.value == new_xrpl_base_fee.to_string()));
+        assert!(version_bumped_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "operations_count"
+                && a.value == query_pending_operations.operations.len().to_string()));
+        assert!(!version_bumped_event
+            .attributes
+            .iter()
+            .any(|a| a.key == "operation_id"));
 
-        // Sending an evidence confirming a Key rotation should work and should also activate the bridge
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: None,
+        // Let's query all pending operations again to verify
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
                 },
-            },
-            &[],
-            &relayer_account,
-        )
-        .unwrap();
-
-        // Query bridge state to confirm it's still halted
-        let query_bridge_state = wasm
-            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+            )
             .unwrap();
 
-        assert_eq!(query_bridge_state.state, BridgeState::Halted);
+        for pending_operation in query_pending_operations.operations.iter() {
+            assert_eq!(pending_operation.version, 2);
+            assert_eq!(pending_operation.xrpl_base_fee, new_xrpl_base_fee);
+            assert!(pending_operation.signatures.is_empty());
+        }
 
-        // Query config to see that relayers have been correctly rotated
+        // Let's also verify that the XRPL base fee has been updated
         let query_config = wasm
             .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
             .unwrap();
 
-        assert_eq!(query_config.relayers, vec![new_relayer]);
+        assert_eq!(query_config.xrpl_base_fee, new_xrpl_base_fee);
+    }
 
-        // We should now be able to resume the bridge because the key rotation has been confirmed
+    #[test]
+    fn cancel_pending_operation() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+        let not_owner = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let new_relayer = Relayer {
+            coreum_address: Addr::unchecked(not_owner.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
+
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            3,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            10,
+        );
+
+        // Register COREUM Token
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ResumeBridge {},
+            &ExecuteMsg::RegisterCoreumToken {
+                denom: FEE_DENOM.to_string(),
+                decimals: 6,
+                sending_precision: 6,
+                max_holding_amount: Uint128::new(1000000000000),
+                bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+            },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Query bridge state to confirm it's now active
-        let query_bridge_state = wasm
-            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
-            .unwrap();
-
-        assert_eq!(query_bridge_state.state, BridgeState::Active);
-
-        // Halt the bridge should not be possible by an address that is not owner or current relayer
-        let halt_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::HaltBridge {},
-                &vec![],
-                &relayer_account,
-            )
-            .unwrap_err();
-
-        assert!(halt_error
-            .to_string()
-            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
-
-        // Current relayer should be allowed to halt it
+        // Set up enough tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::HaltBridge {},
+            &ExecuteMsg::RecoverTickets {
+                account_sequence: 1,
+                number_of_tickets: Some(10),
+            },
             &vec![],
-            &new_relayer_account,
+            &signer,
         )
         .unwrap();
 
-        let query_bridge_state = wasm
-            .query::<QueryMsg, BridgeStateResponse>(&contract_addr, &QueryMsg::BridgeState {})
+        // Check that the ticket operation is there and cancel it
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
             .unwrap();
 
-        assert_eq!(query_bridge_state.state, BridgeState::Halted);
+        assert_eq!(query_pending_operations.operations.len(), 1);
 
-        // Triggering a fee update during halted bridge should work
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLBaseFee { xrpl_base_fee: 600 },
+            &ExecuteMsg::CancelPendingOperation {
+                operation_id: query_pending_operations.operations[0]
+                    .account_sequence
+                    .unwrap(),
+            },
             &vec![],
             &signer,
         )
         .unwrap();
-    }
 
-    #[test]
-    fn updating_xrpl_base_fee() {
-        let app = CoreumTestApp::new();
-        let accounts_number = 4;
-        let accounts = app
-            .init_accounts(&coins(100_000_000_000_000, FEE_DENOM), accounts_number)
+        // Should be gone and no tickets allocated
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
             .unwrap();
 
-        let signer = accounts.get((accounts_number - 1) as usize).unwrap();
-        let xrpl_addresses: Vec<String> = (0..3).map(|_| generate_xrpl_address()).collect();
-        let xrpl_pub_keys: Vec<String> = (0..3).map(|_| generate_xrpl_pub_key()).collect();
-
-        let mut relayer_accounts = vec![];
-        let mut relayers = vec![];
+        assert!(query_pending_operations.operations.is_empty());
 
-        for i in 0..accounts_number - 1 {
-            relayer_accounts.push(accounts.get(i as usize).unwrap());
-            relayers.push(Relayer {
-                coreum_address: Addr::unchecked(accounts.get(i as usize).unwrap().address()),
-                xrpl_address: xrpl_addresses[i as usize].to_string(),
-                xrpl_pub_key: xrpl_pub_keys[i as usize].to_string(),
-            });
-        }
-        let wasm = Wasm::new(&app);
-        let asset_ft = AssetFT::new(&app);
-        let xrpl_base_fee = 10;
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
 
-        let contract_addr = store_and_instantiate(
-            &wasm,
-            &signer,
-            Addr::unchecked(signer.address()),
-            relayers.clone(),
-            3,
-            9,
-            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
-            query_issue_fee(&asset_ft),
-            generate_xrpl_address(),
-            xrpl_base_fee,
-        );
+        assert!(query_available_tickets.tickets.is_empty());
 
-        // Add enough tickets for all our tests
+        // This time we set them up correctly without cancelling
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(250),
+                number_of_tickets: Some(10),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let tx_hash = generate_hash();
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SaveEvidence {
-                    evidence: Evidence::XRPLTransactionResult {
-                        tx_hash: Some(tx_hash.clone()),
-                        account_sequence: Some(1),
-                        ticket_sequence: None,
-                        transaction_result: TransactionResult::Accepted,
-                        operation_result: Some(OperationResult::TicketsAllocation {
-                            tickets: Some((1..251).collect()),
-                        }),
-                    },
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..11).collect()),
+                    }),
                 },
-                &vec![],
-                relayer,
-            )
-            .unwrap();
-        }
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
 
-        // We are going to create the max number of pending operations and add signatures to them to verify that we can update all of them at once
+        // Create 1 pending operation of each type
+        // TrustSet pending operation
+        let issuer = generate_xrpl_address();
+        let currency = "USD".to_string();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RegisterXRPLToken {
-                issuer: generate_xrpl_address(),
-                currency: "USD".to_string(),
-                sending_precision: 15,
-                max_holding_amount: Uint128::new(100000),
+                issuer: issuer.clone(),
+                currency: currency.clone(),
+                sending_precision: 4,
+                max_holding_amount: Uint128::new(50000),
                 bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: None,
+                delivery_mode: None,
             },
             &query_issue_fee(&asset_ft),
             &signer,
         )
         .unwrap();
 
-        // Register COREUM to send some
+        // CoreumToXRPLTransfer pending operation
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterCoreumToken {
-                denom: FEE_DENOM.to_string(),
-                decimals: 6,
-                sending_precision: 6,
-                max_holding_amount: Uint128::new(100000),
-                bridging_fee: Uint128::zero(),
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
             },
-            &vec![],
+            &coins(1, FEE_DENOM.to_string()),
             &signer,
         )
         .unwrap();
 
-        // Let's create 247 more so that we get up to 250 in the end
-        for _ in 0..247 {
-            wasm.execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::SendToXRPL {
-                    recipient: generate_xrpl_address(),
-                    deliver_amount: None,
-                },
-                &coins(1, FEE_DENOM.to_string()),
-                &signer,
-            )
-            .unwrap();
-        }
-
-        // Query pending operations with limit and start_after_key to verify it works
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: Some(100),
-                },
-            )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 100);
+        // RotateKeys operation
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::RotateKeys {
+                new_relayers: vec![new_relayer.clone()],
+                new_evidence_threshold: 1,
+            },
+            &vec![],
+            &signer,
+        )
+        .unwrap();
 
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // Check that 3 tickets are currently being used
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: query_pending_operations.last_key,
-                    limit: Some(200),
-                },
+                &QueryMsg::AvailableTickets {},
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 148);
+        assert_eq!(query_available_tickets.tickets.len(), 7); // 10 - 3
 
-        // Query all pending operations
+        // Check that we have one of each pending operation types
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -9780,103 +11811,137 @@ mod tests {
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 248);
-
-        // Halt the bridge to verify that we can't send signatures of pending operations that are not allowed
-        let correct_signature_example = "3045022100DFA01DA5D6C9877F9DAA59A06032247F3D7ED6444EAD5C90A3AC33CCB7F19B3F02204D8D50E4D085BB1BC9DFB8281B8F35BDAEB7C74AE4B825F8CAE1217CFBDF4EA1".to_string();
-        wasm.execute::<ExecuteMsg>(&contract_addr, &ExecuteMsg::HaltBridge {}, &vec![], &signer)
-            .unwrap();
+        assert_eq!(query_pending_operations.operations.len(), 3);
 
-        let signature_error = wasm
+        // If someone that is not the owner tries to cancel it should fail
+        let cancel_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::SaveSignature {
+                &ExecuteMsg::CancelPendingOperation {
                     operation_id: query_pending_operations.operations[0]
                         .ticket_sequence
                         .unwrap(),
-                    operation_version: 1,
-                    signature: correct_signature_example.clone(),
                 },
                 &vec![],
-                relayer_accounts[0],
+                &not_owner,
             )
             .unwrap_err();
 
-        assert!(signature_error
+        assert!(cancel_error
             .to_string()
-            .contains(ContractError::BridgeHalted {}.to_string().as_str()));
+            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
 
-        // Resume the bridge to add signatures again
+        // If owner tries to cancel a pending operation that does not exist it should fail
+        let cancel_error = wasm
+            .execute::<ExecuteMsg>(
+                &contract_addr,
+                &ExecuteMsg::CancelPendingOperation { operation_id: 50 },
+                &vec![],
+                &signer,
+            )
+            .unwrap_err();
+
+        assert!(cancel_error.to_string().contains(
+            ContractError::PendingOperationNotFound {}
+                .to_string()
+                .as_str()
+        ));
+
+        // Cancel the first pending operation (trust set) and check that ticket is returned and token is put in Inactive state
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::ResumeBridge {},
+            &ExecuteMsg::CancelPendingOperation {
+                operation_id: query_pending_operations.operations[0]
+                    .ticket_sequence
+                    .unwrap(),
+            },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Add some signatures to each pending operation
-        for pending_operation in query_pending_operations.operations.iter() {
-            for relayer in relayer_accounts.iter() {
-                wasm.execute::<ExecuteMsg>(
-                    &contract_addr,
-                    &ExecuteMsg::SaveSignature {
-                        operation_id: pending_operation.ticket_sequence.unwrap(),
-                        operation_version: 1,
-                        signature: correct_signature_example.clone(),
-                    },
-                    &vec![],
-                    relayer,
-                )
-                .unwrap();
-            }
-        }
+        let query_xrpl_tokens = wasm
+            .query::<QueryMsg, XRPLTokensResponse>(
+                &contract_addr,
+                &QueryMsg::XRPLTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        let token = query_xrpl_tokens
+            .tokens
+            .iter()
+            .find(|t| t.currency == currency && t.issuer == issuer)
+            .unwrap();
+
+        assert_eq!(token.state, TokenState::Inactive);
+
+        // Check that 2 tickets are currently being used (1 has been returned)
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert_eq!(query_available_tickets.tickets.len(), 8);
+
+        // Check that we the cancelled operation was removed
+        let query_pending_operations = wasm
+            .query::<QueryMsg, PendingOperationsResponse>(
+                &contract_addr,
+                &QueryMsg::PendingOperations {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+
+        assert_eq!(query_pending_operations.operations.len(), 2);
 
-        // Add a Key Rotation, which will verify that we can update the base fee while the bridge is halted
-        // and to check that we can add signatures for key rotations while bridge is halted
+        // Cancel the second pending operation (CoreumToXRPLTransfer), which should create a pending refund for the sender
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RotateKeys {
-                new_relayers: vec![relayers[0].clone(), relayers[1].clone()],
-                new_evidence_threshold: 2,
+            &ExecuteMsg::CancelPendingOperation {
+                operation_id: query_pending_operations.operations[0]
+                    .ticket_sequence
+                    .unwrap(),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Verify that we have 249 pending operations
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let query_pending_refunds = wasm
+            .query::<QueryMsg, PendingRefundsResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
+                &QueryMsg::PendingRefunds {
+                    address: Addr::unchecked(signer.address()),
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
 
-        assert_eq!(query_pending_operations.operations.len(), 249);
-
-        // Sign this last operation with the 3 relayers
+        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
+        assert_eq!(
+            query_pending_refunds.pending_refunds[0].coin,
+            coin(1, FEE_DENOM)
+        );
 
-        for relayer in relayer_accounts.iter() {
-            wasm.execute::<ExecuteMsg>(
+        // Check that 1 tickets is currently being used (2 have been returned)
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
                 &contract_addr,
-                &ExecuteMsg::SaveSignature {
-                    operation_id: query_pending_operations.operations[248]
-                        .ticket_sequence
-                        .unwrap(),
-                    operation_version: 1,
-                    signature: correct_signature_example.clone(),
-                },
-                &vec![],
-                relayer,
+                &QueryMsg::AvailableTickets {},
             )
             .unwrap();
-        }
 
-        // Verify that all pending operations are in version 1 and have three signatures each
+        assert_eq!(query_available_tickets.tickets.len(), 9);
+
+        // Check that we the cancelled operation was removed
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -9887,38 +11952,40 @@ mod tests {
             )
             .unwrap();
 
-        for pending_operation in query_pending_operations.operations.iter() {
-            assert_eq!(pending_operation.version, 1);
-            assert_eq!(pending_operation.signatures.len(), 3);
-        }
-
-        // If we trigger an XRPL base fee by some who is not the owner, it should fail.
-        let unauthorized_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::UpdateXRPLBaseFee { xrpl_base_fee: 600 },
-                &vec![],
-                &relayer_accounts[0],
-            )
-            .unwrap_err();
-
-        assert!(unauthorized_error
-            .to_string()
-            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
+        assert_eq!(query_pending_operations.operations.len(), 1);
 
-        let new_xrpl_base_fee = 20;
-        // If we trigger an XRPL base fee update, all signatures must be gone, and pending operations must be in version 2, and pending operations base fee must be the new one
+        // Cancel the RotateKeys operation, it should keep the bridge halted and not rotate the relayers
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::UpdateXRPLBaseFee {
-                xrpl_base_fee: new_xrpl_base_fee,
+            &ExecuteMsg::CancelPendingOperation {
+                operation_id: query_pending_operations.operations[0]
+                    .ticket_sequence
+                    .unwrap(),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Let's query all pending operations again to verify
+        let query_config = wasm
+            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
+            .unwrap();
+
+        assert_eq!(query_config.bridge_state, BridgeState::Halted);
+        assert_eq!(query_config.relayers, vec![relayer]);
+
+        // This should have returned all tickets and removed all pending operations from the queue
+        // Check that all tickets are available (the 10 that we initially allocated)
+        let query_available_tickets = wasm
+            .query::<QueryMsg, AvailableTicketsResponse>(
+                &contract_addr,
+                &QueryMsg::AvailableTickets {},
+            )
+            .unwrap();
+
+        assert_eq!(query_available_tickets.tickets.len(), 10);
+
+        // Check that we the cancelled operation was removed
         let query_pending_operations = wasm
             .query::<QueryMsg, PendingOperationsResponse>(
                 &contract_addr,
@@ -9929,27 +11996,16 @@ mod tests {
             )
             .unwrap();
 
-        for pending_operation in query_pending_operations.operations.iter() {
-            assert_eq!(pending_operation.version, 2);
-            assert_eq!(pending_operation.xrpl_base_fee, new_xrpl_base_fee);
-            assert!(pending_operation.signatures.is_empty());
-        }
-
-        // Let's also verify that the XRPL base fee has been updated
-        let query_config = wasm
-            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
-            .unwrap();
-
-        assert_eq!(query_config.xrpl_base_fee, new_xrpl_base_fee);
+        assert!(query_pending_operations.operations.is_empty());
     }
 
     #[test]
-    fn cancel_pending_operation() {
+    fn daily_send_limit() {
         let app = CoreumTestApp::new();
         let signer = app
             .init_account(&coins(100_000_000_000, FEE_DENOM))
             .unwrap();
-        let not_owner = app
+        let sender = app
             .init_account(&coins(100_000_000_000, FEE_DENOM))
             .unwrap();
 
@@ -9961,26 +12017,20 @@ mod tests {
             xrpl_pub_key: generate_xrpl_pub_key(),
         };
 
-        let new_relayer = Relayer {
-            coreum_address: Addr::unchecked(not_owner.address()),
-            xrpl_address: generate_xrpl_address(),
-            xrpl_pub_key: generate_xrpl_pub_key(),
-        };
-
         let contract_addr = store_and_instantiate(
             &wasm,
             &signer,
             Addr::unchecked(signer.address()),
             vec![relayer.clone()],
             1,
-            3,
+            10,
             Uint128::new(TRUST_SET_LIMIT_AMOUNT),
             query_issue_fee(&asset_ft),
             generate_xrpl_address(),
             10,
         );
 
-        // Register COREUM Token
+        // Register a Coreum token with a daily limit of 2 sends per address
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RegisterCoreumToken {
@@ -9989,120 +12039,129 @@ mod tests {
                 sending_precision: 6,
                 max_holding_amount: Uint128::new(1000000000000),
                 bridging_fee: Uint128::zero(),
+                max_sends_per_address_per_day: Some(2),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
+                &contract_addr,
+                &QueryMsg::CoreumTokens {
+                    start_after_key: None,
+                    limit: None,
+                },
+            )
+            .unwrap();
+        assert_eq!(
+            query_coreum_tokens.tokens[0].max_sends_per_address_per_day,
+            Some(2)
+        );
+
         // Set up enough tickets
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::RecoverTickets {
                 account_sequence: 1,
-                number_of_tickets: Some(10),
+                number_of_tickets: Some(20),
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Check that the ticket operation is there and cancel it
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
-                &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
-            )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 1);
-
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::CancelPendingOperation {
-                operation_id: query_pending_operations.operations[0]
-                    .account_sequence
-                    .unwrap(),
+            &ExecuteMsg::SaveEvidence {
+                evidence: Evidence::XRPLTransactionResult {
+                    tx_hash: Some(generate_hash()),
+                    account_sequence: Some(1),
+                    ticket_sequence: None,
+                    transaction_result: TransactionResult::Accepted,
+                    operation_result: Some(OperationResult::TicketsAllocation {
+                        tickets: Some((1..21).collect()),
+                    }),
+                },
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Should be gone and no tickets allocated
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        for _ in 0..2 {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
                 },
+                &coins(1, FEE_DENOM.to_string()),
+                &sender,
             )
             .unwrap();
+        }
 
-        assert!(query_pending_operations.operations.is_empty());
-
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
+        // Third send of the day must fail
+        let daily_limit_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::AvailableTickets {},
+                &ExecuteMsg::SendToXRPL {
+                    recipient: generate_xrpl_address(),
+                    deliver_amount: None,
+                },
+                &coins(1, FEE_DENOM.to_string()),
+                &sender,
             )
-            .unwrap();
+            .unwrap_err();
 
-        assert!(query_available_tickets.tickets.is_empty());
+        assert!(daily_limit_error.to_string().contains(
+            ContractError::DailyTransferLimitExceeded {}
+                .to_string()
+                .as_str()
+        ));
 
-        // This time we set them up correctly without cancelling
+        // The limit is per address so another address can still send
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RecoverTickets {
-                account_sequence: 1,
-                number_of_tickets: Some(10),
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
             },
-            &vec![],
+            &coins(1, FEE_DENOM.to_string()),
             &signer,
         )
         .unwrap();
 
+        // Increasing the limit allows the sender to send again
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::SaveEvidence {
-                evidence: Evidence::XRPLTransactionResult {
-                    tx_hash: Some(generate_hash()),
-                    account_sequence: Some(1),
-                    ticket_sequence: None,
-                    transaction_result: TransactionResult::Accepted,
-                    operation_result: Some(OperationResult::TicketsAllocation {
-                        tickets: Some((1..11).collect()),
-                    }),
-                },
+            &ExecuteMsg::UpdateCoreumToken {
+                denom: FEE_DENOM.to_string(),
+                state: None,
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: Some(3),
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Create 1 pending operation of each type
-        // TrustSet pending operation
-        let issuer = generate_xrpl_address();
-        let currency = "USD".to_string();
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RegisterXRPLToken {
-                issuer: issuer.clone(),
-                currency: currency.clone(),
-                sending_precision: 4,
-                max_holding_amount: Uint128::new(50000),
-                bridging_fee: Uint128::zero(),
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
             },
-            &query_issue_fee(&asset_ft),
-            &signer,
+            &coins(1, FEE_DENOM.to_string()),
+            &sender,
         )
         .unwrap();
 
-        // CoreumToXRPLTransfer pending operation
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
             &ExecuteMsg::SendToXRPL {
@@ -10110,229 +12169,206 @@ mod tests {
                 deliver_amount: None,
             },
             &coins(1, FEE_DENOM.to_string()),
-            &signer,
+            &sender,
         )
-        .unwrap();
+        .unwrap_err();
 
-        // RotateKeys operation
+        // Removing the limit (setting it to 0) allows unlimited sends
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::RotateKeys {
-                new_relayers: vec![new_relayer.clone()],
-                new_evidence_threshold: 1,
+            &ExecuteMsg::UpdateCoreumToken {
+                denom: FEE_DENOM.to_string(),
+                state: None,
+                sending_precision: None,
+                bridging_fee: None,
+                max_holding_amount: None,
+                max_sends_per_address_per_day: Some(0),
+                treasury_fee: None,
             },
             &vec![],
             &signer,
         )
         .unwrap();
 
-        // Check that 3 tickets are currently being used
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
-                &contract_addr,
-                &QueryMsg::AvailableTickets {},
-            )
-            .unwrap();
-
-        assert_eq!(query_available_tickets.tickets.len(), 7); // 10 - 3
-
-        // Check that we have one of each pending operation types
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let query_coreum_tokens = wasm
+            .query::<QueryMsg, CoreumTokensResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
+                &QueryMsg::CoreumTokens {
                     start_after_key: None,
                     limit: None,
                 },
             )
             .unwrap();
+        assert_eq!(
+            query_coreum_tokens.tokens[0].max_sends_per_address_per_day,
+            None
+        );
 
-        assert_eq!(query_pending_operations.operations.len(), 3);
+        wasm.execute::<ExecuteMsg>(
+            &contract_addr,
+            &ExecuteMsg::SendToXRPL {
+                recipient: generate_xrpl_address(),
+                deliver_amount: None,
+            },
+            &coins(1, FEE_DENOM.to_string()),
+            &sender,
+        )
+        .unwrap();
+    }
 
-        // If someone that is not the owner tries to cancel it should fail
-        let cancel_error = wasm
-            .execute::<ExecuteMsg>(
-                &contract_addr,
-                &ExecuteMsg::CancelPendingOperation {
-                    operation_id: query_pending_operations.operations[0]
-                        .ticket_sequence
-                        .unwrap(),
-                },
-                &vec![],
-                &not_owner,
-            )
-            .unwrap_err();
+    #[test]
+    fn treasury_fee_collection_and_claiming() {
+        let app = CoreumTestApp::new();
+        let signer = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
+        let receiver = app
+            .init_account(&coins(100_000_000_000, FEE_DENOM))
+            .unwrap();
 
-        assert!(cancel_error
-            .to_string()
-            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
+        let wasm = Wasm::new(&app);
+        let asset_ft = AssetFT::new(&app);
+        let relayer = Relayer {
+            coreum_address: Addr::unchecked(signer.address()),
+            xrpl_address: generate_xrpl_address(),
+            xrpl_pub_key: generate_xrpl_pub_key(),
+        };
 
-        // If owner tries to cancel a pending operation that does not exist it should fail
-        let cancel_error = wasm
+        let contract_addr = store_and_instantiate(
+            &wasm,
+            &signer,
+            Addr::unchecked(signer.address()),
+            vec![relayer.clone()],
+            1,
+            10,
+            Uint128::new(TRUST_SET_LIMIT_AMOUNT),
+            query_issue_fee(&asset_ft),
+            generate_xrpl_address(),
+            10,
+        );
+        let xrp_denom = format!("{}-{}", XRP_SUBUNIT, contract_addr).to_lowercase();
+
+        // Nothing is collected before the first transfer
+        let claim_error = wasm
             .execute::<ExecuteMsg>(
                 &contract_addr,
-                &ExecuteMsg::CancelPendingOperation { operation_id: 50 },
+                &ExecuteMsg::ClaimTreasuryFees {},
                 &vec![],
                 &signer,
             )
             .unwrap_err();
+        assert!(claim_error
+            .to_string()
+            .contains(ContractError::NoTreasuryFeesToClaim {}.to_string().as_str()));
 
-        assert!(cancel_error.to_string().contains(
-            ContractError::PendingOperationNotFound {}
-                .to_string()
-                .as_str()
-        ));
-
-        // Cancel the first pending operation (trust set) and check that ticket is returned and token is put in Inactive state
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::CancelPendingOperation {
-                operation_id: query_pending_operations.operations[0]
-                    .ticket_sequence
-                    .unwrap(),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
-
-        let query_xrpl_tokens = wasm
-            .query::<QueryMsg, XRPLTokensResponse>(
+        // The treasury fee is taken out of the bridging fee and capped by it
+        let amount = Uint128::new(1000);
+        for treasury_fee in [Uint128::new(20), Uint128::new(80)] {
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::XRPLTokens {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::UpdateXRPLToken {
+                    issuer: XRP_ISSUER.to_string(),
+                    currency: XRP_CURRENCY.to_string(),
+                    state: None,
+                    sending_precision: None,
+                    bridging_fee: Some(Uint128::new(50)),
+                    max_holding_amount: None,
+                    max_sends_per_address_per_day: None,
+                    treasury_fee: Some(treasury_fee),
                 },
+                &vec![],
+                &signer,
             )
             .unwrap();
 
-        let token = query_xrpl_tokens
-            .tokens
-            .iter()
-            .find(|t| t.currency == currency && t.issuer == issuer)
-            .unwrap();
-
-        assert_eq!(token.state, TokenState::Inactive);
-
-        // Check that 2 tickets are currently being used (1 has been returned)
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
-                &contract_addr,
-                &QueryMsg::AvailableTickets {},
-            )
-            .unwrap();
-
-        assert_eq!(query_available_tickets.tickets.len(), 8);
-
-        // Check that we the cancelled operation was removed
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+            wasm.execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
+                &ExecuteMsg::SaveEvidence {
+                    evidence: Evidence::XRPLToCoreumTransfer {
+                        tx_hash: generate_hash(),
+                        issuer: XRP_ISSUER.to_string(),
+                        currency: XRP_CURRENCY.to_string(),
+                        amount,
+                        recipient: Addr::unchecked(receiver.address()),
+                        destination_chain_recipient: None,
+                    },
                 },
+                &[],
+                &signer,
             )
             .unwrap();
+        }
 
-        assert_eq!(query_pending_operations.operations.len(), 2);
-
-        // Cancel the second pending operation (CoreumToXRPLTransfer), which should create a pending refund for the sender
-        wasm.execute::<ExecuteMsg>(
-            &contract_addr,
-            &ExecuteMsg::CancelPendingOperation {
-                operation_id: query_pending_operations.operations[0]
-                    .ticket_sequence
-                    .unwrap(),
-            },
-            &vec![],
-            &signer,
-        )
-        .unwrap();
+        let receiver_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: receiver.address(),
+                denom: xrp_denom.clone(),
+            })
+            .unwrap();
+        assert_eq!(receiver_balance.balance, "1900".to_string());
 
-        let query_pending_refunds = wasm
-            .query::<QueryMsg, PendingRefundsResponse>(
+        // The relayers only get the rest of the bridging fee
+        let query_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
                 &contract_addr,
-                &QueryMsg::PendingRefunds {
-                    address: Addr::unchecked(signer.address()),
-                    start_after_key: None,
-                    limit: None,
+                &QueryMsg::FeesCollected {
+                    relayer_address: Addr::unchecked(signer.address()),
                 },
             )
             .unwrap();
-
-        assert_eq!(query_pending_refunds.pending_refunds.len(), 1);
         assert_eq!(
-            query_pending_refunds.pending_refunds[0].coin,
-            coin(1, FEE_DENOM)
+            query_fees_collected.fees_collected,
+            vec![coin(30, xrp_denom.clone())]
         );
 
-        // Check that 1 tickets is currently being used (2 have been returned)
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
+        let query_treasury_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
                 &contract_addr,
-                &QueryMsg::AvailableTickets {},
+                &QueryMsg::TreasuryFeesCollected {},
             )
             .unwrap();
+        assert_eq!(
+            query_treasury_fees_collected.fees_collected,
+            vec![coin(70, xrp_denom.clone())]
+        );
 
-        assert_eq!(query_available_tickets.tickets.len(), 9);
-
-        // Check that we the cancelled operation was removed
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        // Only the owner can claim the treasury fees
+        let claim_error = wasm
+            .execute::<ExecuteMsg>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
+                &ExecuteMsg::ClaimTreasuryFees {},
+                &vec![],
+                &receiver,
             )
-            .unwrap();
-
-        assert_eq!(query_pending_operations.operations.len(), 1);
+            .unwrap_err();
+        assert!(claim_error
+            .to_string()
+            .contains(ContractError::UnauthorizedSender {}.to_string().as_str()));
 
-        // Cancel the RotateKeys operation, it should keep the bridge halted and not rotate the relayers
+        // The treasury address is not set so the owner receives the fees
         wasm.execute::<ExecuteMsg>(
             &contract_addr,
-            &ExecuteMsg::CancelPendingOperation {
-                operation_id: query_pending_operations.operations[0]
-                    .ticket_sequence
-                    .unwrap(),
-            },
+            &ExecuteMsg::ClaimTreasuryFees {},
             &vec![],
             &signer,
         )
         .unwrap();
 
-        let query_config = wasm
-            .query::<QueryMsg, Config>(&contract_addr, &QueryMsg::Config {})
-            .unwrap();
-
-        assert_eq!(query_config.bridge_state, BridgeState::Halted);
-        assert_eq!(query_config.relayers, vec![relayer]);
-
-        // This should have returned all tickets and removed all pending operations from the queue
-        // Check that all tickets are available (the 10 that we initially allocated)
-        let query_available_tickets = wasm
-            .query::<QueryMsg, AvailableTicketsResponse>(
-                &contract_addr,
-                &QueryMsg::AvailableTickets {},
-            )
+        let owner_balance = asset_ft
+            .query_balance(&QueryBalanceRequest {
+                account: signer.address(),
+                denom: xrp_denom.clone(),
+            })
             .unwrap();
+        assert_eq!(owner_balance.balance, "70".to_string());
 
-        assert_eq!(query_available_tickets.tickets.len(), 10);
-
-        // Check that we the cancelled operation was removed
-        let query_pending_operations = wasm
-            .query::<QueryMsg, PendingOperationsResponse>(
+        let query_treasury_fees_collected = wasm
+            .query::<QueryMsg, FeesCollectedResponse>(
                 &contract_addr,
-                &QueryMsg::PendingOperations {
-                    start_after_key: None,
-                    limit: None,
-                },
+                &QueryMsg::TreasuryFeesCollected {},
             )
             .unwrap();
-
-        assert!(query_pending_operations.operations.is_empty());
+        assert!(query_treasury_fees_collected.fees_collected.is_empty());
     }
 
     #[test]
@@ -10521,6 +12557,7 @@ mod tests {
                     sending_precision: 1,
                     max_holding_amount: Uint128::one(),
                     bridging_fee: Uint128::zero(),
+                    max_sends_per_address_per_day: None,
                 },
                 &vec![],
                 &not_owner,
@@ -10541,6 +12578,8 @@ mod tests {
                     sending_precision: 4,
                     max_holding_amount: Uint128::new(50000),
                     bridging_fee: Uint128::zero(),
+                    max_sends_per_address_per_day: None,
+                    delivery_mode: None,
                 },
                 &query_issue_fee(&asset_ft),
                 &not_owner,
@@ -10562,6 +12601,7 @@ mod tests {
                         currency: "USD".to_string(),
                         amount: Uint128::new(100),
                         recipient: Addr::unchecked(signer.address()),
+                        destination_chain_recipient: None,
                     },
                 },
                 &[],
@@ -10607,6 +12647,7 @@ mod tests {
                 currency: currency.clone(),
                 amount: amount.clone(),
                 recipient: recipient.clone(),
+                destination_chain_recipient: None,
             },
             Evidence::XRPLToCoreumTransfer {
                 tx_hash: generate_hash(),
@@ -10614,6 +12655,7 @@ mod tests {
                 currency: currency.clone(),
                 amount: amount.clone(),
                 recipient: recipient.clone(),
+                destination_chain_recipient: None,
             },
             Evidence::XRPLToCoreumTransfer {
                 tx_hash: hash.clone(),
@@ -10621,6 +12663,7 @@ mod tests {
                 currency: currency.clone(),
                 amount: amount.clone(),
                 recipient: recipient.clone(),
+                destination_chain_recipient: None,
             },
             Evidence::XRPLToCoreumTransfer {
                 tx_hash: hash.clone(),
@@ -10628,6 +12671,7 @@ mod tests {
                 currency: "new_currency".to_string(),
                 amount: amount.clone(),
                 recipient: recipient.clone(),
+                destination_chain_recipient: None,
             },
             Evidence::XRPLToCoreumTransfer {
                 tx_hash: hash.clone(),
@@ -10635,6 +12679,7 @@ mod tests {
                 currency: currency.clone(),
                 amount: Uint128::one(),
                 recipient: recipient.clone(),
+                destination_chain_recipient: None,
             },
             Evidence::XRPLToCoreumTransfer {
                 tx_hash: hash.clone(),
@@ -10642,6 +12687,7 @@ mod tests {
                 currency: currency.clone(),
                 amount: amount.clone(),
                 recipient: Addr::unchecked("new_recipient"),
+                destination_chain_recipient: None,
             },
         ];
 
@@ -1,6 +1,6 @@
 use std::collections::VecDeque;
 
-use cosmwasm_std::{StdResult, Storage};
+use cosmwasm_std::{Event, StdResult, Storage};
 
 use crate::{
     error::ContractError,
@@ -28,10 +28,11 @@ pub fn allocate_ticket(storage: &mut dyn Storage) -> Result<u64, ContractError>
 }
 
 // Once we confirm/reject a transaction, we need to register a ticket as used
+// The event of the ticket allocation operation is returned if the operation is created
 pub fn register_used_ticket(
     storage: &mut dyn Storage,
     timestamp: u64,
-) -> Result<bool, ContractError> {
+) -> Result<Option<Event>, ContractError> {
     let used_tickets = USED_TICKETS_COUNTER.load(storage)?;
     let config = CONFIG.load(storage)?;
 
@@ -43,24 +44,21 @@ pub fn register_used_ticket(
     {
         // If our creation of a ticket allocation operation failed because we have no tickets left, we need to propagate
         // this so that we are aware that we need to allocate new tickets because we've run out of them
-        match reserve_ticket(storage) {
-            Ok(ticket_to_update) => {
-                create_pending_operation(
-                    storage,
-                    timestamp,
-                    Some(ticket_to_update),
-                    None,
-                    OperationType::AllocateTickets {
-                        number: config.used_ticket_sequence_threshold,
-                    },
-                )?;
-                PENDING_TICKET_UPDATE.save(storage, &true)?;
-            }
-            Err(ContractError::NoAvailableTickets {}) => return Ok(false),
-            Err(e) => return Err(e),
-        }
+        let ticket_to_update = reserve_ticket(storage)?;
+        let operation_created_event = create_pending_operation(
+            storage,
+            timestamp,
+            Some(ticket_to_update),
+            None,
+            OperationType::AllocateTickets {
+                number: config.used_ticket_sequence_threshold,
+            },
+        )?;
+        PENDING_TICKET_UPDATE.save(storage, &true)?;
+
+        return Ok(Some(operation_created_event));
     }
-    Ok(true)
+    Ok(None)
 }
 
 pub fn handle_ticket_allocation_confirmation(
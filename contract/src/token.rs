@@ -1,11 +1,28 @@
-use cosmwasm_std::Uint128;
+use coreum_wasm_sdk::{assetft, core::CoreumMsg};
+use cosmwasm_std::{
+    coin, Addr, CosmosMsg, Env, Event, IbcMsg, IbcTimeout, Order, Storage, Timestamp, Uint128,
+};
+use cw_storage_plus::Bound;
 
 use crate::{
     contract::{validate_sending_precision, XRP_CURRENCY, XRP_ISSUER},
     error::ContractError,
-    state::TokenState,
+    state::{
+        DailySendCounter, PendingIBCTransfer, TokenState, XRPLTokenDeliveryMode,
+        DAILY_SEND_COUNTERS, PENDING_IBC_TRANSFERS,
+    },
 };
 
+// Type of the event emitted when the state of a token is changed
+pub const TOKEN_STATE_CHANGED_EVENT: &str = "token_state_changed";
+
+// Length of the window used for the daily send limits
+pub const DAILY_SEND_WINDOW_SECONDS: u64 = 86400;
+// Maximum amount of daily send counters checked for removal on every send, so that the finished ones don't stay forever
+pub const MAX_DAILY_SEND_COUNTERS_PRUNED: usize = 10;
+
+const IBC_CHANNEL_ID_PREFIX: &str = "channel-";
+
 // Build the key to access the Tokens saved in state
 pub fn build_xrpl_token_key(issuer: &str, currency: &str) -> String {
     // Issuer+currency is the key we use to find an XRPL
@@ -36,6 +53,28 @@ pub fn set_token_state(
     Ok(())
 }
 
+// Helper to build the audit event of a token state transition, it returns None if the state is not changed
+pub fn build_token_state_changed_event(
+    env: &Env,
+    actor: &Addr,
+    token_denom: &str,
+    old_state: &TokenState,
+    new_state: &TokenState,
+) -> Option<Event> {
+    if old_state.eq(new_state) {
+        return None;
+    }
+
+    Some(
+        Event::new(TOKEN_STATE_CHANGED_EVENT)
+            .add_attribute("token_denom", token_denom)
+            .add_attribute("old_state", old_state.as_str())
+            .add_attribute("new_state", new_state.as_str())
+            .add_attribute("actor", actor)
+            .add_attribute("timestamp", env.block.time.seconds().to_string()),
+    )
+}
+
 // Helper function to update the sending precision of a token
 pub fn set_token_sending_precision(
     sending_precision: &mut i32,
@@ -80,3 +119,194 @@ pub fn set_token_max_holding_amount(
 
     Ok(())
 }
+
+// Helper function to update the max sends per address per day of a token, a target of 0 removes the limit
+pub fn set_token_max_sends_per_address_per_day(
+    max_sends_per_address_per_day: &mut Option<u32>,
+    target_max_sends_per_address_per_day: Option<u32>,
+) {
+    if let Some(target_max_sends_per_address_per_day) = target_max_sends_per_address_per_day {
+        if target_max_sends_per_address_per_day == 0 {
+            *max_sends_per_address_per_day = None;
+        } else {
+            *max_sends_per_address_per_day = Some(target_max_sends_per_address_per_day);
+        }
+    }
+}
+
+// Helper function to update the treasury fee of a token, 0 removes it
+pub fn set_token_treasury_fee(
+    treasury_fee: &mut Option<Uint128>,
+    target_treasury_fee: Option<Uint128>,
+) {
+    if let Some(target_treasury_fee) = target_treasury_fee {
+        if target_treasury_fee.is_zero() {
+            *treasury_fee = None;
+        } else {
+            *treasury_fee = Some(target_treasury_fee);
+        }
+    }
+}
+
+// Helper function to register a send of a token by an address, returning an error if the daily limit of the token is exceeded
+pub fn register_daily_send(
+    storage: &mut dyn Storage,
+    timestamp: u64,
+    sender: &Addr,
+    denom: String,
+    max_sends_per_address_per_day: Option<u32>,
+) -> Result<(), ContractError> {
+    let key = (sender.clone(), denom);
+    prune_daily_send_counters(storage, timestamp, &key);
+
+    let counter = DAILY_SEND_COUNTERS.may_load(storage, key.clone())?;
+    let max_sends = match max_sends_per_address_per_day {
+        Some(max_sends) => max_sends,
+        None => {
+            // The finished counter is removed even if the token has no limit anymore
+            if matches!(counter, Some(counter) if !is_daily_send_window_active(&counter, timestamp))
+            {
+                DAILY_SEND_COUNTERS.remove(storage, key);
+            }
+            return Ok(());
+        }
+    };
+
+    let mut counter = match counter {
+        // If the window of the counter has not finished yet we keep counting on it
+        Some(counter) if is_daily_send_window_active(&counter, timestamp) => counter,
+        _ => DailySendCounter {
+            count: 0,
+            window_start: timestamp,
+        },
+    };
+
+    if counter.count >= max_sends {
+        return Err(ContractError::DailyTransferLimitExceeded {});
+    }
+
+    counter.count += 1;
+    DAILY_SEND_COUNTERS.save(storage, key, &counter)?;
+
+    Ok(())
+}
+
+// Helper function to remove the counters whose windows have finished with no send after them. The counters following
+// the key of the current send are checked, so every send cleans a bounded part of the state
+fn prune_daily_send_counters(storage: &mut dyn Storage, timestamp: u64, key: &(Addr, String)) {
+    let finished_keys = DAILY_SEND_COUNTERS
+        .range(
+            storage,
+            Some(Bound::exclusive(key.clone())),
+            None,
+            Order::Ascending,
+        )
+        .take(MAX_DAILY_SEND_COUNTERS_PRUNED)
+        .filter_map(Result::ok)
+        .filter(|(_, counter)| !is_daily_send_window_active(counter, timestamp))
+        .map(|(key, _)| key)
+        .collect::<Vec<(Addr, String)>>();
+
+    for finished_key in finished_keys {
+        DAILY_SEND_COUNTERS.remove(storage, finished_key);
+    }
+}
+
+fn is_daily_send_window_active(counter: &DailySendCounter, timestamp: u64) -> bool {
+    timestamp < counter.window_start + DAILY_SEND_WINDOW_SECONDS
+}
+
+// Helper function to validate the delivery mode of a token provided during the registration
+pub fn validate_delivery_mode(delivery_mode: &XRPLTokenDeliveryMode) -> Result<(), ContractError> {
+    if let XRPLTokenDeliveryMode::IBC {
+        ibc_channel_id,
+        ibc_transfer_timeout_seconds,
+    } = delivery_mode
+    {
+        // The channel ID is generated by the IBC module as channel-{sequence}
+        match ibc_channel_id.strip_prefix(IBC_CHANNEL_ID_PREFIX) {
+            Some(sequence) if sequence.parse::<u64>().is_ok() => {}
+            _ => return Err(ContractError::InvalidIBCChannelID {}),
+        }
+
+        if *ibc_transfer_timeout_seconds == 0 {
+            return Err(ContractError::InvalidIBCTransferTimeout {});
+        }
+    }
+
+    Ok(())
+}
+
+// Helper function to validate the recipient on the IBC-connected chain provided in the XRPL transaction memo
+pub fn validate_destination_chain_recipient(
+    destination_chain_recipient: &str,
+) -> Result<(), ContractError> {
+    // The recipient address format depends on the destination chain so we can only check that it's not malformed
+    if destination_chain_recipient.is_empty()
+        || destination_chain_recipient.contains(char::is_whitespace)
+    {
+        return Err(ContractError::InvalidDestinationChainRecipient {});
+    }
+
+    Ok(())
+}
+
+// Helper function to build the messages delivering the bridged amount to the recipient according to the delivery mode of
+// the token. In the IBC mode the tokens are minted to the bridge contract first, since it's the sender of the IBC
+// transfer, and the transfer is stored as pending until the relayers provide its result. If the transfer has no
+// recipient on the IBC-connected chain, the tokens are minted to the recipient on Coreum
+#[allow(clippy::too_many_arguments)]
+pub fn build_delivery_msgs(
+    storage: &mut dyn Storage,
+    delivery_mode: &Option<XRPLTokenDeliveryMode>,
+    tx_hash: &str,
+    denom: String,
+    amount: Uint128,
+    recipient: &Addr,
+    destination_chain_recipient: Option<String>,
+    block_time: Timestamp,
+) -> Result<Vec<CosmosMsg<CoreumMsg>>, ContractError> {
+    match (delivery_mode, destination_chain_recipient) {
+        (
+            Some(XRPLTokenDeliveryMode::IBC {
+                ibc_channel_id,
+                ibc_transfer_timeout_seconds,
+            }),
+            Some(destination_chain_recipient),
+        ) => {
+            let pending_ibc_transfer = PendingIBCTransfer {
+                tx_hash: tx_hash.to_uppercase(),
+                recipient: recipient.clone(),
+                ibc_channel_id: ibc_channel_id.clone(),
+                destination_chain_recipient,
+                coin: coin(amount.u128(), denom.clone()),
+            };
+            PENDING_IBC_TRANSFERS.save(
+                storage,
+                pending_ibc_transfer.tx_hash.clone(),
+                &pending_ibc_transfer,
+            )?;
+
+            Ok(vec![
+                CosmosMsg::from(CoreumMsg::AssetFT(assetft::Msg::Mint {
+                    coin: pending_ibc_transfer.coin.clone(),
+                    recipient: None,
+                })),
+                CosmosMsg::Ibc(IbcMsg::Transfer {
+                    channel_id: pending_ibc_transfer.ibc_channel_id,
+                    to_address: pending_ibc_transfer.destination_chain_recipient,
+                    amount: pending_ibc_transfer.coin,
+                    timeout: IbcTimeout::with_timestamp(
+                        block_time.plus_seconds(*ibc_transfer_timeout_seconds),
+                    ),
+                }),
+            ])
+        }
+        _ => Ok(vec![CosmosMsg::from(CoreumMsg::AssetFT(
+            assetft::Msg::Mint {
+                coin: coin(amount.u128(), denom),
+                recipient: Some(recipient.to_string()),
+            },
+        ))]),
+    }
+}
@@ -75,7 +75,38 @@ func DeployAndInstantiateContractV110(
 ) (sdk.AccAddress, *coreum.ContractClient) {
 	t.Helper()
 
-	t.Log("Deploying and instantiating contract")
+	return DeployAndInstantiateContract(
+		ctx,
+		t,
+		chains,
+		chains.Coreum.Config().PreviousContractPath,
+		relayers,
+		evidenceThreshold,
+		usedTicketSequenceThreshold,
+		trustSetLimitAmount,
+		bridgeXRPLAddress,
+		xrplBaseFee,
+	)
+}
+
+// DeployAndInstantiateContract deploys and instantiates the contract from the bytecode path. The cfgModifiers are
+// applied to the instantiation config to set its optional fields.
+func DeployAndInstantiateContract(
+	ctx context.Context,
+	t *testing.T,
+	chains Chains,
+	contractPath string,
+	relayers []coreum.Relayer,
+	evidenceThreshold uint32,
+	usedTicketSequenceThreshold uint32,
+	trustSetLimitAmount sdkmath.Int,
+	bridgeXRPLAddress string,
+	xrplBaseFee uint32,
+	cfgModifiers ...func(cfg *coreum.InstantiationConfig),
+) (sdk.AccAddress, *coreum.ContractClient) {
+	t.Helper()
+
+	t.Logf("Deploying and instantiating contract from %s", contractPath)
 	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
 	owner := chains.Coreum.GenAccount()
 
@@ -96,6 +127,8 @@ func DeployAndInstantiateContractV110(
 		contactCfg,
 		chains.Log,
 		chains.Coreum.ClientContext,
+		nil,
+		nil,
 	)
 	instantiationCfg := coreum.InstantiationConfig{
 		Owner:                       owner,
@@ -107,8 +140,13 @@ func DeployAndInstantiateContractV110(
 		BridgeXRPLAddress:           bridgeXRPLAddress,
 		XRPLBaseFee:                 xrplBaseFee,
 	}
+	for _, modify := range cfgModifiers {
+		modify(&instantiationCfg)
+	}
+	// the tests share the bootstrapping validation, so the invalid test setup is reported before the deployment
+	require.NoError(t, instantiationCfg.Validate())
 	contractAddress, err := contractClient.DeployAndInstantiate(
-		ctx, owner, readBuiltContract(t, chains.Coreum.Config().PreviousContractPath), instantiationCfg,
+		ctx, owner, readBuiltContract(t, contractPath), instantiationCfg,
 	)
 	require.NoError(t, err)
 
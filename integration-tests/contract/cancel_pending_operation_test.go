@@ -70,6 +70,8 @@ func TestCancelPendingOperation(t *testing.T) {
 		xrplTokenSendingPrecision,
 		xrplTokenMaxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
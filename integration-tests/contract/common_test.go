@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -28,6 +29,9 @@ const (
 	eventAttributeThresholdReached = "threshold_reached"
 	//nolint:lll // the signature sample doesn't require to be split
 	xrplTxSignature = "304502210097099E9AB2C41DA3F672004924B3557D58D101A5745C57C6336C5CF36B59E8F5022003984E50483C921E3FDF45BC7DE4E6ED9D340F0E0CAA6BB1828C647C6665A1CC"
+	// the genesis account and its public key derived from the "masterpassphrase" seed.
+	genesisXRPLAddress = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	genesisXRPLPubKey  = "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
 )
 
 func recoverTickets(
@@ -252,7 +256,7 @@ func issueAndRegisterCoreumOriginatedToken(
 	require.NoError(t, err)
 	coreumDenom := assetfttypes.BuildDenom(issueMsg.Subunit, issuerAddress)
 	_, err = contractClient.RegisterCoreumToken(
-		ctx, ownerAddress, coreumDenom, tokenDecimals, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, ownerAddress, coreumDenom, tokenDecimals, sendingPrecision, maxHoldingAmount, bridgingFee, nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumToken, err := contractClient.GetCoreumTokenByDenom(ctx, coreumDenom)
@@ -260,3 +264,32 @@ func issueAndRegisterCoreumOriginatedToken(
 
 	return registeredCoreumToken
 }
+
+func requireTokenStateChangedEvent(
+	t *testing.T,
+	txRes *sdk.TxResponse,
+	tokenDenom string,
+	oldState, newState coreum.TokenState,
+	actor sdk.AccAddress,
+) {
+	t.Helper()
+
+	expectedAttributes := map[string]string{
+		"token_denom": tokenDenom,
+		"old_state":   string(oldState),
+		"new_state":   string(newState),
+		"actor":       actor.String(),
+	}
+	for key, expectedValue := range expectedAttributes {
+		value, err := event.FindStringEventAttribute(txRes.Events, coreum.TokenStateChangedEventType, key)
+		require.NoError(t, err)
+		require.Equal(t, expectedValue, value, key)
+	}
+
+	// the timestamp is the block time of the tx
+	timestamp, err := event.FindStringEventAttribute(txRes.Events, coreum.TokenStateChangedEventType, "timestamp")
+	require.NoError(t, err)
+	blockTime, err := time.Parse(time.RFC3339, txRes.Timestamp)
+	require.NoError(t, err)
+	require.Equal(t, strconv.FormatInt(blockTime.Unix(), 10), timestamp)
+}
@@ -0,0 +1,219 @@
+//go:build integrationtests
+// +build integrationtests
+
+package contract_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// contractCompatibilityStep is the step of the contract compatibility suite, the steps share the state and are executed
+// sequentially.
+type contractCompatibilityStep struct {
+	name string
+	run  func(t *testing.T)
+}
+
+// TestContractCompatibility runs the core compatibility suite through the current contract client against each
+// contract version set by the -contract-versions flag. Single version is tested with the
+// -run TestContractCompatibility/<version> flag.
+func TestContractCompatibility(t *testing.T) {
+	t.Parallel()
+
+	_, chains := integrationtests.NewTestingContext(t)
+	contractVersions := chains.Coreum.Config().ContractVersions
+	require.NotEmpty(t, contractVersions, "at least one contract version is required")
+
+	for _, contractVersion := range contractVersions {
+		contractVersion := contractVersion
+		t.Run(contractVersion, func(t *testing.T) {
+			t.Parallel()
+
+			runContractCompatibilitySuite(t, contractVersion)
+		})
+	}
+}
+
+func runContractCompatibilitySuite(t *testing.T, contractVersion string) {
+	ctx, chains := integrationtests.NewTestingContext(t)
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	evidenceThreshold := uint32(len(relayers))
+	usedTicketSequenceThreshold := uint32(150)
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplBaseFee := uint32(10)
+
+	var (
+		owner          sdk.AccAddress
+		contractClient *coreum.ContractClient
+		xrplToken      coreum.XRPLToken
+	)
+	coreumRecipient := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumRecipient, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 6),
+	})
+	xrplRecipient := chains.XRPL.GenAccount(ctx, t, 0)
+	bridgingFee := sdkmath.NewInt(4)
+	amountToSend := sdkmath.NewInt(10_000)
+
+	steps := []contractCompatibilityStep{
+		{
+			name: "deploy",
+			run: func(t *testing.T) {
+				owner, contractClient = integrationtests.DeployAndInstantiateContract(
+					ctx,
+					t,
+					chains,
+					chains.Coreum.ContractPathByVersion(contractVersion),
+					relayers,
+					evidenceThreshold,
+					usedTicketSequenceThreshold,
+					defaultTrustSetLimitAmount,
+					bridgeXRPLAddress,
+					xrplBaseFee,
+				)
+				issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+				chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+					Amount: issueFee.Amount.MulRaw(2).AddRaw(2_000_000),
+				})
+			},
+		},
+		{
+			name: "config_query",
+			run: func(t *testing.T) {
+				contractCfg, err := contractClient.GetContractConfig(ctx)
+				require.NoError(t, err)
+				require.Equal(t, relayers, contractCfg.Relayers)
+				require.Equal(t, evidenceThreshold, contractCfg.EvidenceThreshold)
+				require.Equal(t, usedTicketSequenceThreshold, contractCfg.UsedTicketSequenceThreshold)
+				require.Equal(t, defaultTrustSetLimitAmount.String(), contractCfg.TrustSetLimitAmount.String())
+				require.Equal(t, bridgeXRPLAddress, contractCfg.BridgeXRPLAddress)
+				require.Equal(t, coreum.BridgeStateActive, contractCfg.BridgeState)
+				require.Equal(t, xrplBaseFee, contractCfg.XRPLBaseFee)
+			},
+		},
+		{
+			name: "ticket_recovery",
+			run: func(t *testing.T) {
+				numberOfTickets := uint32(10)
+				recoverTickets(ctx, t, contractClient, owner, relayers, numberOfTickets)
+				availableTickets, err := contractClient.GetAvailableTickets(ctx)
+				require.NoError(t, err)
+				require.Len(t, availableTickets, int(numberOfTickets))
+			},
+		},
+		{
+			name: "token_registration",
+			run: func(t *testing.T) {
+				coreumToken := issueAndRegisterCoreumOriginatedToken(
+					ctx,
+					t,
+					contractClient,
+					chains.Coreum,
+					owner,
+					owner,
+					6,
+					sdkmath.NewIntWithDecimal(1, 10),
+					6,
+					sdkmath.NewIntWithDecimal(1, 10),
+					sdkmath.ZeroInt(),
+				)
+				require.Equal(t, coreum.TokenStateEnabled, coreumToken.State)
+
+				issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+				currency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+				_, err := contractClient.RegisterXRPLToken(
+					ctx, owner, issuer, currency, 15, sdkmath.NewIntWithDecimal(1, 10), bridgingFee, nil, nil,
+				)
+				require.NoError(t, err)
+				activateXRPLToken(ctx, t, contractClient, relayers, issuer, currency)
+				xrplToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+				require.NoError(t, err)
+				require.NotEmpty(t, xrplToken.CoreumDenom)
+			},
+		},
+		{
+			name: "send_from_xrpl_to_coreum",
+			run: func(t *testing.T) {
+				sendFromXRPLToCoreum(
+					ctx, t, contractClient, relayers, xrplToken.Issuer, xrplToken.Currency, amountToSend, coreumRecipient,
+				)
+				balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+					Address: coreumRecipient.String(),
+					Denom:   xrplToken.CoreumDenom,
+				})
+				require.NoError(t, err)
+				require.Equal(t, amountToSend.Sub(bridgingFee).String(), balanceRes.Balance.Amount.String())
+			},
+		},
+		{
+			name: "send_from_coreum_to_xrpl",
+			run: func(t *testing.T) {
+				sendFromCoreumToXRPL(
+					ctx,
+					t,
+					contractClient,
+					relayers,
+					coreumRecipient,
+					sdk.NewCoin(xrplToken.CoreumDenom, amountToSend.Sub(bridgingFee)),
+					xrplRecipient,
+				)
+				balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+					Address: coreumRecipient.String(),
+					Denom:   xrplToken.CoreumDenom,
+				})
+				require.NoError(t, err)
+				require.True(t, balanceRes.Balance.Amount.IsZero())
+				pendingOperations, err := contractClient.GetPendingOperations(ctx)
+				require.NoError(t, err)
+				require.Empty(t, pendingOperations)
+			},
+		},
+		{
+			name: "fee_claim",
+			run: func(t *testing.T) {
+				for _, relayer := range relayers {
+					claimRelayerFees(ctx, t, contractClient, relayer.CoreumAddress)
+				}
+			},
+		},
+	}
+
+	for _, step := range steps {
+		if !t.Run(step.name, step.run) {
+			t.Fatalf("contract version %s is incompatible, step %s failed", contractVersion, step.name)
+		}
+	}
+}
+
+func claimRelayerFees(
+	ctx context.Context,
+	t *testing.T,
+	contractClient *coreum.ContractClient,
+	relayerAddress sdk.AccAddress,
+) {
+	t.Helper()
+
+	fees, err := contractClient.GetFeesCollected(ctx, relayerAddress)
+	require.NoError(t, err)
+	require.False(t, fees.IsZero())
+
+	_, err = contractClient.ClaimRelayerFees(ctx, relayerAddress, fees)
+	require.NoError(t, err)
+
+	fees, err = contractClient.GetFeesCollected(ctx, relayerAddress)
+	require.NoError(t, err)
+	require.True(t, fees.IsZero())
+}
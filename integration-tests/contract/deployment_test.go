@@ -71,6 +71,15 @@ func TestDeployAndInstantiateContract(t *testing.T) {
 		PendingOwner: sdk.AccAddress{},
 	}, contractOwnership)
 
+	contractVersion, err := contractClient.GetContractVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "coreumbridge-xrpl", contractVersion.Contract)
+	require.NotEmpty(t, contractVersion.Version)
+
+	nodeInfo, err := contractClient.GetNodeInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, chains.Coreum.ChainSettings.ChainID, nodeInfo.ChainID)
+
 	contractAddress := contractClient.GetContractAddress()
 	tokensRes, err := assetftClient.Tokens(ctx, &assetfttypes.QueryTokensRequest{
 		Issuer: contractAddress.String(),
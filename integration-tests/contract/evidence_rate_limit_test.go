@@ -0,0 +1,106 @@
+//go:build integrationtests
+// +build integrationtests
+
+package contract_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v4/pkg/client"
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestEvidencesRateLimitPerRelayerPerBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumRecipient, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 6),
+	})
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	_, contractClient := integrationtests.DeployAndInstantiateContract(
+		ctx,
+		t,
+		chains,
+		chains.Coreum.Config().ContractPath,
+		relayers,
+		uint32(len(relayers)),
+		50,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+		func(cfg *coreum.InstantiationConfig) {
+			cfg.MaxEvidencesPerRelayerPerBlock = lo.ToPtr(uint32(1))
+		},
+	)
+
+	contractCfg, err := contractClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lo.ToPtr(uint32(1)), contractCfg.MaxEvidencesPerRelayerPerBlock)
+
+	genXRPToCoreumTransferEvidence := func() coreum.XRPLToCoreumTransferEvidence {
+		return coreum.XRPLToCoreumTransferEvidence{
+			TxHash:    integrationtests.GenXRPLTxHash(t),
+			Issuer:    xrpl.XRPTokenIssuer.String(),
+			Currency:  xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+			Amount:    sdkmath.NewInt(1000),
+			Recipient: coreumRecipient,
+		}
+	}
+
+	// the messages of the same tx are executed in the same block, so the 2nd evidence exceeds the limit
+	relayerAddress := relayers[0].CoreumAddress
+	msgs := make([]sdk.Msg, 0, 2)
+	for i := 0; i < 2; i++ {
+		payload, err := json.Marshal(map[string]any{
+			"save_evidence": map[string]any{
+				"evidence": map[string]any{
+					"xrpl_to_coreum_transfer": genXRPToCoreumTransferEvidence(),
+				},
+			},
+		})
+		require.NoError(t, err)
+		msgs = append(msgs, &wasmtypes.MsgExecuteContract{
+			Sender:   relayerAddress.String(),
+			Contract: contractClient.GetContractAddress().String(),
+			Msg:      payload,
+		})
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(relayerAddress),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		msgs...,
+	)
+	require.True(t, coreum.IsRateLimitExceededError(err), err)
+
+	// the evidences sent in the different blocks are accepted
+	for i := 0; i < 2; i++ {
+		_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayerAddress, genXRPToCoreumTransferEvidence())
+		require.NoError(t, err)
+	}
+
+	// the limit of the other relayers isn't affected
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(
+		ctx, relayers[1].CoreumAddress, genXRPToCoreumTransferEvidence(),
+	)
+	require.NoError(t, err)
+
+	rateLimit, err := contractClient.GetRelayerEvidenceRateLimit(ctx, relayerAddress)
+	require.NoError(t, err)
+	require.Equal(t, lo.ToPtr(uint32(1)), rateLimit.MaxEvidencesPerRelayerPerBlock)
+	require.Positive(t, rateLimit.BlockHeight)
+}
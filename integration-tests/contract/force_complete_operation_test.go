@@ -0,0 +1,100 @@
+//go:build integrationtests
+// +build integrationtests
+
+package contract_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestForceCompleteStuckTicketsAllocation(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 1)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		1,
+		5,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	// the evidence is submitted as a relayer vote, so the owner must be a relayer to force complete the operation
+	newOwner := relayers[0].CoreumAddress
+	_, err := contractClient.TransferOwnership(ctx, owner, newOwner)
+	require.NoError(t, err)
+	_, err = contractClient.AcceptOwnership(ctx, newOwner)
+	require.NoError(t, err)
+
+	bridgeClient := bridgeclient.NewBridgeClient(
+		chains.Log,
+		chains.Coreum.ClientContext,
+		contractClient,
+		chains.XRPL.RPCClient(),
+		xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil),
+	)
+
+	// create the ticket allocation operation which is never submitted to the XRPL
+	bridgeXRPLAccountFirstSeqNumber := uint32(1)
+	_, err = contractClient.RecoverTickets(ctx, newOwner, bridgeXRPLAccountFirstSeqNumber, lo.ToPtr(uint32(5)))
+	require.NoError(t, err)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+
+	// try to force complete from not owner
+	notOwner := chains.Coreum.GenAccount()
+	err = bridgeClient.ForceCompleteOperation(
+		ctx,
+		notOwner,
+		bridgeXRPLAccountFirstSeqNumber,
+		integrationtests.GenXRPLTxHash(t),
+		coreum.TransactionResultRejected,
+	)
+	require.ErrorContains(t, err, "only the contract owner")
+
+	// try to force complete not existing operation
+	err = bridgeClient.ForceCompleteOperation(
+		ctx,
+		newOwner,
+		bridgeXRPLAccountFirstSeqNumber+1,
+		integrationtests.GenXRPLTxHash(t),
+		coreum.TransactionResultRejected,
+	)
+	require.ErrorContains(t, err, "not found")
+
+	require.NoError(t, bridgeClient.ForceCompleteOperation(
+		ctx,
+		newOwner,
+		bridgeXRPLAccountFirstSeqNumber,
+		integrationtests.GenXRPLTxHash(t),
+		coreum.TransactionResultRejected,
+	))
+
+	pendingOperations, err = contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingOperations)
+
+	availableTickets, err := contractClient.GetAvailableTickets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, availableTickets)
+
+	// the tickets allocation is unblocked and can be recovered again
+	_, err = contractClient.RecoverTickets(ctx, newOwner, bridgeXRPLAccountFirstSeqNumber, lo.ToPtr(uint32(5)))
+	require.NoError(t, err)
+}
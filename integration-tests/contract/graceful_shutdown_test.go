@@ -0,0 +1,106 @@
+//go:build integrationtests
+// +build integrationtests
+
+package contract_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSaveSignaturesWithContextCanceledDuringBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	// each registered XRPL token creates the TrustSet operation to sign
+	const operationsCount = 3
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(operationsCount),
+	})
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	for i := 0; i < operationsCount; i++ {
+		_, err := contractClient.RegisterXRPLToken(
+			ctx,
+			owner,
+			issuer,
+			xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t)),
+			15,
+			sdkmath.NewInt(10000),
+			sdkmath.ZeroInt(),
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+	}
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, operationsCount)
+
+	// the relayer contract client with the shutdown timeout
+	shutdownContractClientCfg := coreum.DefaultContractClientConfig(contractClient.GetContractAddress())
+	shutdownContractClientCfg.ShutdownTimeout = time.Minute
+	shutdownContractClient := coreum.NewContractClient(
+		shutdownContractClientCfg, chains.Log, chains.Coreum.ClientContext, nil, nil,
+	)
+
+	// the context is canceled while the first signature tx is awaited, since the block time is longer
+	signCtx, signCtxCancel := context.WithCancel(ctx)
+	defer signCtxCancel()
+	time.AfterFunc(200*time.Millisecond, signCtxCancel)
+	signErrs := make([]error, 0, operationsCount)
+	for _, operation := range pendingOperations {
+		_, err := shutdownContractClient.SaveSignature(
+			signCtx,
+			relayers[0].CoreumAddress,
+			operation.GetOperationID(),
+			operation.Version,
+			xrplTxSignature,
+		)
+		signErrs = append(signErrs, err)
+	}
+
+	// the in-flight signature is completed, and the rest aren't broadcast after the cancellation
+	require.NoError(t, signErrs[0])
+	for _, err := range signErrs[1:] {
+		require.True(t, errors.Is(err, context.Canceled), err)
+	}
+
+	pendingOperations, err = contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, operationsCount)
+	persistedSignatures := 0
+	for _, operation := range pendingOperations {
+		for _, signature := range operation.Signatures {
+			require.Equal(t, relayers[0].CoreumAddress.String(), signature.RelayerCoreumAddress.String())
+			require.Equal(t, xrplTxSignature, signature.Signature)
+			persistedSignatures++
+		}
+	}
+	require.Equal(t, 1, persistedSignatures)
+}
@@ -98,6 +98,8 @@ func TestBridgeHalting(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.True(t, coreum.IsBridgeHaltedError(err), err)
 
@@ -109,6 +111,7 @@ func TestBridgeHalting(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
 	)
 	require.True(t, coreum.IsBridgeHaltedError(err), err)
 
@@ -69,6 +69,8 @@ func TestKeysRotationWithRecovery(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registerXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, xrplIssuer, xrplCurrency)
@@ -89,6 +91,7 @@ func TestKeysRotationWithRecovery(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdk.ZeroInt(),
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -331,6 +334,14 @@ func TestKeysRotationWithProhibitedAddresses(t *testing.T) {
 		newRelayers := genRelayers(ctx, t, chains, 2)
 		// set one address from prohibited list
 		newRelayers[0].XRPLAddress = prohibitedXRPLAddresses[i]
+		// the genesis account is the only prohibited address with the known key, the keys of other addresses
+		// mismatch, so the rotation is rejected before the contract call
+		if prohibitedXRPLAddresses[i] != genesisXRPLAddress {
+			_, err = contractClient.RotateKeys(ctx, owner, newRelayers, 1)
+			require.ErrorContains(t, err, "invalid XRPL keys of the relayer 0")
+			continue
+		}
+		newRelayers[0].XRPLPubKey = genesisXRPLPubKey
 		_, err = contractClient.RotateKeys(ctx,
 			owner,
 			newRelayers,
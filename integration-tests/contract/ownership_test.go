@@ -7,10 +7,12 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -65,3 +67,85 @@ func TestChangeContractOwnership(t *testing.T) {
 	_, err = contractClient.TransferOwnership(ctx, owner, newOwner)
 	require.True(t, coreum.IsNotOwnerError(err), err)
 }
+
+func TestChangeContractOwnershipWithBridgeClient(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 1)
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		10,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	bridgeClient := bridgeclient.NewBridgeClient(
+		chains.Log,
+		chains.Coreum.ClientContext,
+		contractClient,
+		chains.XRPL.RPCClient(),
+		xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil),
+	)
+
+	// the account which isn't on chain can't be resolved
+	_, err := bridgeClient.GetCoreumAccountInfo(ctx, chains.Coreum.GenAccount())
+	require.Error(t, err)
+
+	newOwner := chains.Coreum.GenAccount()
+	notOwner := chains.Coreum.GenAccount()
+	// fund to cover fees
+	for _, acc := range []sdk.AccAddress{newOwner, notOwner} {
+		chains.Coreum.FundAccountWithOptions(ctx, t, acc, coreumintegration.BalancesOptions{
+			Amount: sdkmath.NewIntFromUint64(1_000_000),
+		})
+	}
+
+	// the funded account exists but has never signed a transaction
+	accountInfo, err := bridgeClient.GetCoreumAccountInfo(ctx, newOwner)
+	require.NoError(t, err)
+	require.Equal(t, newOwner.String(), accountInfo.Address.String())
+	require.False(t, accountInfo.PubKeySet)
+
+	// try to accept the ownership without the pending transfer
+	err = bridgeClient.AcceptOwnership(ctx, newOwner)
+	require.ErrorContains(t, err, "there is no pending contract ownership transfer")
+
+	// try to transfer the ownership from not owner
+	err = bridgeClient.TransferOwnership(ctx, notOwner, newOwner)
+	require.True(t, coreum.IsNotOwnerError(err), err)
+
+	// transfer the ownership
+	require.NoError(t, bridgeClient.TransferOwnership(ctx, owner, newOwner))
+	contractOwnership, err := bridgeClient.GetContractOwnership(ctx)
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), contractOwnership.Owner.String())
+	require.Equal(t, newOwner.String(), contractOwnership.PendingOwner.String())
+
+	// try to accept the ownership from the wrong caller
+	err = bridgeClient.AcceptOwnership(ctx, notOwner)
+	require.ErrorContains(t, err, "only the pending owner can accept the ownership")
+
+	// accept the ownership
+	require.NoError(t, bridgeClient.AcceptOwnership(ctx, newOwner))
+	contractOwnership, err = bridgeClient.GetContractOwnership(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newOwner.String(), contractOwnership.Owner.String())
+	require.Empty(t, contractOwnership.PendingOwner)
+
+	// the new owner has signed the transaction, so its pubkey is set
+	accountInfo, err = bridgeClient.GetCoreumAccountInfo(ctx, newOwner)
+	require.NoError(t, err)
+	require.True(t, accountInfo.PubKeySet)
+
+	// the previous owner is not the owner anymore
+	err = bridgeClient.TransferOwnership(ctx, owner, notOwner)
+	require.True(t, coreum.IsNotOwnerError(err), err)
+}
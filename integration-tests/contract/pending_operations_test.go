@@ -0,0 +1,253 @@
+//go:build integrationtests
+// +build integrationtests
+
+package contract_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v4/testutil/event"
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestGetPendingOperationsByType(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		5,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(2).Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	recoverTickets(ctx, t, contractClient, owner, relayers, 6)
+
+	// register two XRPL originated tokens to create trust set operations
+	xrplTokenIssuer := chains.XRPL.GenAccount(ctx, t, 0)
+	for i := 0; i < 2; i++ {
+		_, err := contractClient.RegisterXRPLToken(
+			ctx,
+			owner,
+			xrplTokenIssuer.String(),
+			xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t)),
+			int32(15),
+			sdkmath.NewIntWithDecimal(1, 20),
+			sdkmath.ZeroInt(),
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+	}
+
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSenderAddress,
+		owner,
+		uint32(15),
+		sdkmath.NewIntWithDecimal(1, 8),
+		int32(15),
+		sdkmath.NewIntWithDecimal(1, 10),
+		sdkmath.ZeroInt(),
+	)
+
+	// create send operation
+	_, err := contractClient.SendToXRPL(
+		ctx,
+		coreumSenderAddress,
+		xrplTokenIssuer.String(),
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1000)),
+		nil,
+	)
+	require.NoError(t, err)
+
+	// create rotate key operation
+	_, err = contractClient.RotateKeys(ctx, owner, relayers, 2)
+	require.NoError(t, err)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	// 2 trust sets + send to XRPL + keys rotation
+	require.Len(t, pendingOperations, 4)
+
+	expectedCounts := map[coreum.OperationTypeEnum]int{
+		coreum.OperationTypeEnumAllocateTickets:      0,
+		coreum.OperationTypeEnumTrustSet:             2,
+		coreum.OperationTypeEnumCoreumToXRPLTransfer: 1,
+		coreum.OperationTypeEnumRotateKeys:           1,
+		coreum.OperationTypeEnumNFTokenTransfer:      0,
+	}
+	for opType, expectedCount := range expectedCounts {
+		operations, err := contractClient.GetPendingOperationsByType(ctx, opType)
+		require.NoError(t, err)
+		require.Len(t, operations, expectedCount, opType)
+		for _, operation := range operations {
+			require.Equal(t, opType, operation.GetOperationType())
+		}
+	}
+
+	_, err = contractClient.GetPendingOperationsByType(ctx, coreum.OperationTypeEnumUnknown)
+	require.ErrorContains(t, err, "invalid operation type")
+}
+
+func TestOperationCreatedEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 6),
+	})
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+	registeredXRPToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+	)
+	require.NoError(t, err)
+
+	recoverTickets(ctx, t, contractClient, owner, relayers, 5)
+
+	amountToSend := sdkmath.NewIntWithDecimal(1, 6)
+	sendFromXRPLToCoreum(
+		ctx,
+		t,
+		contractClient,
+		relayers,
+		registeredXRPToken.Issuer,
+		registeredXRPToken.Currency,
+		amountToSend,
+		coreumSenderAddress,
+	)
+
+	txRes, err := contractClient.SendToXRPL(
+		ctx,
+		coreumSenderAddress,
+		chains.XRPL.GenAccount(ctx, t, 0).String(),
+		sdk.NewCoin(registeredXRPToken.CoreumDenom, amountToSend),
+		nil,
+	)
+	require.NoError(t, err)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	operation := pendingOperations[0]
+	require.NotNil(t, operation.OperationType.CoreumToXRPLTransfer)
+
+	expectedAttributes := map[string]string{
+		"operation_id":   strconv.FormatUint(uint64(operation.GetOperationID()), 10),
+		"operation_type": string(coreum.OperationTypeEnumCoreumToXRPLTransfer),
+		"xrpl_base_fee":  strconv.FormatUint(uint64(operation.XRPLBaseFee), 10),
+	}
+	for key, expectedValue := range expectedAttributes {
+		value, err := event.FindStringEventAttribute(txRes.Events, coreum.OperationCreatedEventType, key)
+		require.NoError(t, err)
+		require.Equal(t, expectedValue, value, key)
+	}
+}
+
+func TestGetSignatureProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 3)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		2,
+		5,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	bridgeXRPLAccountFirstSeqNumber := uint32(1)
+	numberOfTicketsToInit := uint32(5)
+	_, err := contractClient.RecoverTickets(ctx, owner, bridgeXRPLAccountFirstSeqNumber, &numberOfTicketsToInit)
+	require.NoError(t, err)
+
+	_, err = contractClient.GetSignatureProgress(ctx, bridgeXRPLAccountFirstSeqNumber+1)
+	require.ErrorContains(t, err, "not found")
+
+	progress, err := contractClient.GetSignatureProgress(ctx, bridgeXRPLAccountFirstSeqNumber)
+	require.NoError(t, err)
+	require.Equal(t, bridgeXRPLAccountFirstSeqNumber, progress.Operation.GetOperationID())
+	require.Empty(t, progress.SignedRelayers)
+	require.ElementsMatch(t, relayers, progress.UnsignedRelayers)
+	require.Equal(t, uint32(2), progress.EvidenceThreshold)
+	require.Equal(t, float64(0), progress.ThresholdPercentage)
+
+	createTicketsTx := rippledata.TicketCreate{
+		TicketCount: lo.ToPtr(numberOfTicketsToInit),
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.TICKET_CREATE,
+		},
+	}
+	for i, relayer := range relayers[:2] {
+		relayerXRPLAcc, err := rippledata.NewAccountFromAddress(relayer.XRPLAddress)
+		require.NoError(t, err)
+		signerItem := chains.XRPL.Multisign(t, &createTicketsTx, *relayerXRPLAcc).Signer
+		_, err = contractClient.SaveSignature(
+			ctx,
+			relayer.CoreumAddress,
+			bridgeXRPLAccountFirstSeqNumber,
+			progress.Operation.Version,
+			signerItem.TxnSignature.String(),
+		)
+		require.NoError(t, err)
+
+		progress, err = contractClient.GetSignatureProgress(ctx, bridgeXRPLAccountFirstSeqNumber)
+		require.NoError(t, err)
+		require.ElementsMatch(t, relayers[:i+1], progress.SignedRelayers)
+		require.ElementsMatch(t, relayers[i+1:], progress.UnsignedRelayers)
+		// 50% after the first signature and 100% once the threshold is reached
+		require.Equal(t, float64(50*(i+1)), progress.ThresholdPercentage)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
@@ -25,6 +26,7 @@ import (
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -79,6 +81,8 @@ func TestSendFromXRPLToCoreumXRPLOriginatedToken(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		bridgingFee,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -212,6 +216,93 @@ func TestSendFromXRPLToCoreumXRPLOriginatedToken(t *testing.T) {
 	)
 }
 
+func TestSendFromXRPLToCoreumXRPLOriginatedTokenWithNonASCIICurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount,
+	})
+
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 100)
+
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	nonASCIIBytes := []byte{0x01, 0x02, 0x03}
+
+	// the standard currency with non-ASCII bytes is converted to hex starting with "00", which is invalid
+	var standardCurrency rippledata.Currency
+	copy(standardCurrency[12:], nonASCIIBytes)
+	standardCurrencyString := xrpl.ConvertCurrencyToString(standardCurrency)
+	require.Equal(t, "0000000000000000000000000102030000000000", standardCurrencyString)
+	_, err := contractClient.RegisterXRPLToken(
+		ctx,
+		owner,
+		issuer,
+		standardCurrencyString,
+		15,
+		sdkmath.NewInt(10000),
+		sdkmath.ZeroInt(),
+		nil,
+		nil,
+	)
+	require.True(t, coreum.IsInvalidXRPLCurrencyError(err), err)
+
+	// the hex currency with the same non-ASCII bytes is valid
+	var hexCurrency rippledata.Currency
+	copy(hexCurrency[:], nonASCIIBytes)
+	currency := xrpl.ConvertCurrencyToString(hexCurrency)
+	require.Equal(t, "0102030000000000000000000000000000000000", currency)
+
+	_, err = contractClient.RegisterXRPLToken(
+		ctx,
+		owner,
+		issuer,
+		currency,
+		15,
+		sdkmath.NewInt(10000),
+		sdkmath.ZeroInt(),
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+	require.NoError(t, err)
+	require.Equal(t, currency, registeredToken.Currency)
+	require.NotEmpty(t, registeredToken.CoreumDenom)
+
+	activateXRPLToken(ctx, t, contractClient, relayers, issuer, currency)
+
+	amount := sdkmath.NewInt(10)
+	sendFromXRPLToCoreum(ctx, t, contractClient, relayers, issuer, currency, amount, coreumRecipient)
+
+	recipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amount.String(), recipientBalanceRes.Balance.Amount.String())
+}
+
 func TestSendFromXRPLToCoreumXRPLOriginatedTokenWithMaxAmount(t *testing.T) {
 	t.Parallel()
 
@@ -257,6 +348,8 @@ func TestSendFromXRPLToCoreumXRPLOriginatedTokenWithMaxAmount(t *testing.T) {
 		sendingPrecision,
 		coreum.MaxContractAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -326,6 +419,8 @@ func TestSendFromXRPLToCoreumXRPLOriginatedTokenTooLowAmounts(t *testing.T) {
 		int32(2),
 		coreum.MaxContractAmount,
 		sdkmath.NewInt(10),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -397,7 +492,7 @@ func TestSendFromXRPLToCoreumModuleAccount(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdk.ZeroInt(),
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdk.ZeroInt(), nil, nil,
 	)
 	require.NoError(t, err)
 
@@ -570,7 +665,7 @@ func TestSendFromXRPLToCoreumXRPLOriginatedTokenWithDifferentSendingPrecision(t
 
 			// register from the owner
 			_, err := contractClient.RegisterXRPLToken(
-				ctx, owner, issuer, currency, tt.sendingPrecision, tt.maxHoldingAmount, sdkmath.ZeroInt(),
+				ctx, owner, issuer, currency, tt.sendingPrecision, tt.maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
 			)
 			require.NoError(t, err)
 			registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -836,6 +931,121 @@ func TestSendFromXRPLToCoreumCoreumOriginatedToken(t *testing.T) {
 	require.Equal(t, coinToSend.Amount.Sub(sdkmath.NewInt(4)).String(), contractBalanceRes.Balance.Amount.String())
 }
 
+func TestSendFromXRPLToCoreumCoreumOriginatedTokenWithZeroContractBalance(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumSender := chains.Coreum.GenAccount()
+	coreumRecipient := chains.Coreum.GenAccount()
+	xrplRecipient := chains.XRPL.GenAccount(ctx, t, 1)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSender, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		bridgeXRPLAddress,
+		10,
+	)
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSender,
+		owner,
+		5,
+		sdkmath.NewIntWithDecimal(1, 11),
+		5,
+		sdkmath.NewIntWithDecimal(1, 11),
+		sdkmath.ZeroInt(),
+	)
+
+	// nothing is sent to XRPL yet, so the contract holds none of the token
+	contractBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: contractClient.GetContractAddress().String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+	require.True(t, contractBalanceRes.Balance.Amount.IsZero())
+
+	// the return transfer of 4 tokens observed before any token is locked in the contract
+	xrplToCoreumTransferEvidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    integrationtests.GenXRPLTxHash(t),
+		Issuer:    bridgeXRPLAddress,
+		Currency:  registeredCoreumOriginatedToken.XRPLCurrency,
+		Amount:    sdkmath.NewInt(40_000_000_000),
+		Recipient: coreumRecipient,
+	}
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[0].CoreumAddress, xrplToCoreumTransferEvidence)
+	require.NoError(t, err)
+
+	// the threshold is reached, but the contract can't release the tokens it doesn't hold
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[1].CoreumAddress, xrplToCoreumTransferEvidence)
+	require.ErrorContains(t, err, cosmoserrors.ErrInsufficientFunds.Error())
+
+	// the failed tx is reverted, so only the first evidence is saved and nothing is sent or collected
+	transactionEvidences, err := contractClient.GetTransactionEvidences(ctx)
+	require.NoError(t, err)
+	require.Len(t, transactionEvidences, 1)
+	require.Equal(t, []sdk.AccAddress{relayers[0].CoreumAddress}, transactionEvidences[0].RelayerAddresses)
+	recipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+	require.True(t, recipientBalanceRes.Balance.Amount.IsZero())
+	for _, relayer := range relayers {
+		fees, err := contractClient.GetFeesCollected(ctx, relayer.CoreumAddress)
+		require.NoError(t, err)
+		require.True(t, fees.IsZero())
+	}
+
+	// once the tokens are locked, the same evidence completes the transfer
+	coinToSend := sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(10))
+	sendFromCoreumToXRPL(ctx, t, contractClient, relayers, coreumSender, coinToSend, xrplRecipient)
+
+	txRes, err := contractClient.SendXRPLToCoreumTransferEvidence(
+		ctx, relayers[1].CoreumAddress, xrplToCoreumTransferEvidence,
+	)
+	require.NoError(t, err)
+	thresholdReached, err := event.FindStringEventAttribute(
+		txRes.Events, wasmtypes.ModuleName, eventAttributeThresholdReached,
+	)
+	require.NoError(t, err)
+	require.Equal(t, strconv.FormatBool(true), thresholdReached)
+
+	recipientBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(4).String(), recipientBalanceRes.Balance.Amount.String())
+
+	contractBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: contractClient.GetContractAddress().String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, coinToSend.Amount.Sub(sdkmath.NewInt(4)).String(), contractBalanceRes.Balance.Amount.String())
+}
+
 //nolint:tparallel // the test is parallel, but test cases are not
 func TestSendFromXRPLToCoreumCoreumOriginatedTokenWithFreezingAndWhitelisting(t *testing.T) {
 	t.Parallel()
@@ -971,7 +1181,7 @@ func TestSendFromXRPLToCoreumCoreumOriginatedTokenWithFreezingAndWhitelisting(t
 			require.NoError(t, err)
 			denom := assetfttypes.BuildDenom(issueMsg.Subunit, coreumSender)
 			_, err = contractClient.RegisterCoreumToken(
-				ctx, owner, denom, tokenDecimals, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(),
+				ctx, owner, denom, tokenDecimals, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil,
 			)
 			require.NoError(t, err)
 			registeredCoreumToken, err := contractClient.GetCoreumTokenByDenom(ctx, denom)
@@ -1279,7 +1489,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 
 	// register new token
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(),
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
 	)
 	require.NoError(t, err)
 	// activate token
@@ -1306,8 +1516,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSendFromXRPLToCoreum.AddRaw(1)),
-		nil,
+		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSendFromXRPLToCoreum.AddRaw(1)), nil,
 	)
 	require.ErrorContains(t, err, cosmoserrors.ErrInsufficientFunds.Error())
 
@@ -1316,8 +1525,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		"invalid",
-		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSend), nil,
 	)
 	require.True(t, coreum.IsInvalidXRPLAddressError(err), err)
 
@@ -1326,8 +1534,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(chains.Coreum.ChainSettings.Denom, sdkmath.NewInt(1)),
-		nil,
+		sdk.NewCoin(chains.Coreum.ChainSettings.Denom, sdkmath.NewInt(1)), nil,
 	)
 	require.True(t, coreum.IsTokenNotRegisteredError(err), err)
 
@@ -1341,8 +1548,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, amountToSend), nil,
 	)
 	require.NoError(t, err)
 	// check the remaining balance
@@ -1405,8 +1611,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 			ctx,
 			coreumSenderAddress,
 			xrplRecipientAddress.String(),
-			sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, sdkmath.NewInt(1)),
-			nil,
+			sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, sdkmath.NewInt(1)), nil,
 		)
 		require.NoError(t, err)
 	}
@@ -1416,8 +1621,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, sdkmath.NewInt(1)),
-		nil,
+		sdk.NewCoin(registeredXRPLOriginatedToken.CoreumDenom, sdkmath.NewInt(1)), nil,
 	)
 	require.True(t, coreum.IsLastTicketReservedError(err))
 }
@@ -1538,7 +1742,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedTokenWithDifferentSendingPrecision(t
 
 			// register from the owner
 			_, err := contractClient.RegisterXRPLToken(
-				ctx, owner, issuer, currency, tt.sendingPrecision, tt.maxHoldingAmount, sdkmath.ZeroInt(),
+				ctx, owner, issuer, currency, tt.sendingPrecision, tt.maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
 			)
 			require.NoError(t, err)
 			registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1564,8 +1768,7 @@ func TestSendFromCoreumToXRPLXRPLOriginatedTokenWithDifferentSendingPrecision(t
 				ctx,
 				coreumSenderAddress,
 				xrplRecipient.String(),
-				sdk.NewCoin(registeredXRPLToken.CoreumDenom, tt.sendingAmount),
-				nil,
+				sdk.NewCoin(registeredXRPLToken.CoreumDenom, tt.sendingAmount), nil,
 			)
 			if tt.wantIsAmountSentIsZeroAfterTruncationError {
 				require.True(t, coreum.IsAmountSentIsZeroAfterTruncationError(err), err)
@@ -1653,8 +1856,7 @@ func TestSendFromCoreumToXRPLXRPToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPToken.CoreumDenom, amountToSend), nil,
 	)
 	require.NoError(t, err)
 	// check the remaining balance
@@ -1748,7 +1950,7 @@ func TestSendFromCoreumXRPLOriginatedTokenWithDeliverAmount(t *testing.T) {
 	// register new XRPL token and test sending
 
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.NoError(t, err)
 
@@ -2028,7 +2230,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 	tokenDecimals2 := uint32(6)
 	maxHoldingAmount2 := sdkmath.NewIntWithDecimal(1, 9)
 	_, err := contractClient.RegisterCoreumToken(
-		ctx, owner, denom2, tokenDecimals2, sendingPrecision2, maxHoldingAmount2, sdkmath.ZeroInt(),
+		ctx, owner, denom2, tokenDecimals2, sendingPrecision2, maxHoldingAmount2, sdkmath.ZeroInt(), nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken2, err := contractClient.GetCoreumTokenByDenom(ctx, denom2)
@@ -2056,8 +2258,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(notRegisteredTokenDenom, sdkmath.NewInt(1)),
-		nil,
+		sdk.NewCoin(notRegisteredTokenDenom, sdkmath.NewInt(1)), nil,
 	)
 	require.True(t, coreum.IsTokenNotRegisteredError(err), err)
 
@@ -2075,8 +2276,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, amountToSendOfToken1),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, amountToSendOfToken1), nil,
 	)
 	require.NoError(t, err)
 	// check the remaining balance
@@ -2137,8 +2337,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredCoreumOriginatedToken2.Denom, amountToSendOfToken2),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken2.Denom, amountToSendOfToken2), nil,
 	)
 	require.NoError(t, err)
 	// check the remaining balance
@@ -2194,8 +2393,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 			ctx,
 			coreumSenderAddress,
 			xrplRecipientAddress.String(),
-			sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, sdkmath.NewInt(1)),
-			nil,
+			sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, sdkmath.NewInt(1)), nil,
 		)
 		require.NoError(t, err)
 	}
@@ -2205,21 +2403,26 @@ func TestSendFromCoreumToXRPLCoreumOriginatedToken(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, sdkmath.NewInt(1)),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken1.Denom, sdkmath.NewInt(1)), nil,
 	)
 	require.True(t, coreum.IsLastTicketReservedError(err))
 }
 
-func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
+func TestSendFromCoreumToXRPLWithFeeGranter(t *testing.T) {
 	t.Parallel()
 
 	ctx, chains := integrationtests.NewTestingContext(t)
 
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
 	coreumSenderAddress := chains.Coreum.GenAccount()
 	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
 	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
-		Amount: issueFee.Amount.MulRaw(2).Add(sdkmath.NewIntWithDecimal(1, 7)),
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+	feeGranterAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, feeGranterAddress, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 7),
 	})
 
 	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
@@ -2237,26 +2440,9 @@ func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
 		bridgeXRPLAddress,
 		10,
 	)
-	// recover tickets to be able to create operations from coreum to XRPL
 	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
 
-	prohibitedXRPLAddresses, err := contractClient.GetProhibitedXRPLAddresses(ctx)
-	require.NoError(t, err)
-
-	initialProhibitedAddresses := []string{
-		"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
-		"rrrrrrrrrrrrrrrrrrrrBZbvji",
-		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
-		"rrrrrrrrrrrrrrrrrNAMEtxvNvQ",
-		"rrrrrrrrrrrrrrrrrrrn5RM1rHd",
-	}
-	initialProhibitedAddresses = append(initialProhibitedAddresses, bridgeXRPLAddress)
-	require.ElementsMatch(t, initialProhibitedAddresses, prohibitedXRPLAddresses)
-
-	tokenDecimals := uint32(6)
-	sendingPrecision := int32(6)
-	maxHoldingAmount := sdkmath.NewIntWithDecimal(1, 9)
-	initialAmount := sdkmath.NewIntWithDecimal(1, 9)
+	tokenDecimals := uint32(5)
 	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
 		ctx,
 		t,
@@ -2265,61 +2451,265 @@ func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
 		coreumSenderAddress,
 		owner,
 		tokenDecimals,
-		initialAmount,
-		sendingPrecision,
-		maxHoldingAmount,
+		sdkmath.NewIntWithDecimal(1, 11),
+		int32(5),
+		sdkmath.NewIntWithDecimal(1, 11),
 		sdkmath.ZeroInt(),
 	)
 
-	for _, recipient := range prohibitedXRPLAddresses {
-		_, err = contractClient.SendToXRPL(
-			ctx,
-			coreumSenderAddress,
-			recipient,
-			sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)),
-			nil,
-		)
-		require.True(t, coreum.IsProhibitedAddressError(err), err)
-	}
+	// the granter sponsors the fees of the sender
+	_, err := client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(feeGranterAddress),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		lo.Must(feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{}, feeGranterAddress, coreumSenderAddress)),
+	)
+	require.NoError(t, err)
 
-	contractCfg, err := contractClient.GetContractConfig(ctx)
+	coreumSenderFeeBalanceBeforeRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   chains.Coreum.ChainSettings.Denom,
+	})
+	require.NoError(t, err)
+	feeGranterBalanceBeforeRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: feeGranterAddress.String(),
+		Denom:   chains.Coreum.ChainSettings.Denom,
+	})
 	require.NoError(t, err)
 
-	// try to send to XRPL bridge account address
-	_, err = contractClient.SendToXRPL(
-		ctx,
-		coreumSenderAddress,
-		contractCfg.BridgeXRPLAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)),
-		nil,
+	// the contract client with the fee granter and fixed gas prices
+	feeGranterContractClientCfg := coreum.DefaultContractClientConfig(contractClient.GetContractAddress())
+	feeGranterContractClientCfg.FeeGranter = feeGranterAddress
+	feeGranterContractClientCfg.GasPrices = sdk.NewDecCoins(
+		chains.Coreum.NewDecCoin(chains.Coreum.ChainSettings.GasPrice.MulInt64(2)),
+	)
+	feeGranterContractClient := coreum.NewContractClient(
+		feeGranterContractClientCfg, chains.Log, chains.Coreum.ClientContext, nil, nil,
 	)
-	require.True(t, coreum.IsProhibitedAddressError(err), err)
 
-	_, err = contractClient.SendToXRPL(
+	amountToSend := sdkmath.NewInt(1_001_001)
+	txRes, err := feeGranterContractClient.SendToXRPL(
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSend), nil,
 	)
 	require.NoError(t, err)
-	//nolint:gocritic // append new item to old list for the assertion
-	newProhibitedAddresses := append(initialProhibitedAddresses, xrplRecipientAddress.String())
-
-	// try to update the prohibited addresses list from not owner
-	_, err = contractClient.UpdateProhibitedXRPLAddresses(ctx, relayers[0].CoreumAddress, newProhibitedAddresses)
-	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
-	// update form owner
-	_, err = contractClient.UpdateProhibitedXRPLAddresses(ctx, owner, newProhibitedAddresses)
+	// the fee is paid by the granter with the fixed gas price
+	coreumSenderFeeBalanceAfterRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   chains.Coreum.ChainSettings.Denom,
+	})
 	require.NoError(t, err)
-
-	prohibitedXRPLAddresses, err = contractClient.GetProhibitedXRPLAddresses(ctx)
+	require.Equal(
+		t,
+		coreumSenderFeeBalanceBeforeRes.Balance.Amount.String(),
+		coreumSenderFeeBalanceAfterRes.Balance.Amount.String(),
+	)
+	feeGranterBalanceAfterRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: feeGranterAddress.String(),
+		Denom:   chains.Coreum.ChainSettings.Denom,
+	})
 	require.NoError(t, err)
-
+	expectedFee := chains.Coreum.ChainSettings.GasPrice.MulInt64(2).MulInt64(txRes.GasWanted).Ceil().TruncateInt()
+	require.Equal(
+		t,
+		feeGranterBalanceBeforeRes.Balance.Amount.Sub(expectedFee).String(),
+		feeGranterBalanceAfterRes.Balance.Amount.String(),
+	)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	operationType := pendingOperations[0].OperationType.CoreumToXRPLTransfer
+	require.NotNil(t, operationType)
+	require.Equal(t, registeredCoreumOriginatedToken.XRPLCurrency, operationType.Currency)
+	// XRPL DECIMALS (15) - TOKEN DECIMALS (5) = 10
+	require.Equal(t, amountToSend.Mul(sdkmath.NewIntWithDecimal(1, 10)), operationType.Amount)
+	require.Equal(t, xrplRecipientAddress.String(), operationType.Recipient)
+}
+
+func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(2).Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		bridgeXRPLAddress,
+		10,
+	)
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	prohibitedXRPLAddresses, err := contractClient.GetProhibitedXRPLAddresses(ctx)
+	require.NoError(t, err)
+
+	initialProhibitedAddresses := []string{
+		"rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+		"rrrrrrrrrrrrrrrrrrrrBZbvji",
+		"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"rrrrrrrrrrrrrrrrrNAMEtxvNvQ",
+		"rrrrrrrrrrrrrrrrrrrn5RM1rHd",
+	}
+	initialProhibitedAddresses = append(initialProhibitedAddresses, bridgeXRPLAddress)
+	require.ElementsMatch(t, initialProhibitedAddresses, prohibitedXRPLAddresses)
+
+	tokenDecimals := uint32(6)
+	sendingPrecision := int32(6)
+	maxHoldingAmount := sdkmath.NewIntWithDecimal(1, 9)
+	initialAmount := sdkmath.NewIntWithDecimal(1, 9)
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSenderAddress,
+		owner,
+		tokenDecimals,
+		initialAmount,
+		sendingPrecision,
+		maxHoldingAmount,
+		sdkmath.ZeroInt(),
+	)
+
+	for _, recipient := range prohibitedXRPLAddresses {
+		_, err = contractClient.SendToXRPL(
+			ctx,
+			coreumSenderAddress,
+			recipient,
+			sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)), nil,
+		)
+		require.True(t, coreum.IsProhibitedAddressError(err), err)
+	}
+
+	contractCfg, err := contractClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+
+	// try to send to XRPL bridge account address
+	_, err = contractClient.SendToXRPL(
+		ctx,
+		coreumSenderAddress,
+		contractCfg.BridgeXRPLAddress,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)), nil,
+	)
+	require.True(t, coreum.IsProhibitedAddressError(err), err)
+
+	_, err = contractClient.SendToXRPL(
+		ctx,
+		coreumSenderAddress,
+		xrplRecipientAddress.String(),
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(1)), nil,
+	)
+	require.NoError(t, err)
+	//nolint:gocritic // append new item to old list for the assertion
+	newProhibitedAddresses := append(initialProhibitedAddresses, xrplRecipientAddress.String())
+
+	// try to update the prohibited addresses list from not owner
+	_, err = contractClient.UpdateProhibitedXRPLAddresses(ctx, relayers[0].CoreumAddress, newProhibitedAddresses)
+	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
+
+	// update form owner
+	_, err = contractClient.UpdateProhibitedXRPLAddresses(ctx, owner, newProhibitedAddresses)
+	require.NoError(t, err)
+
+	prohibitedXRPLAddresses, err = contractClient.GetProhibitedXRPLAddresses(ctx)
+	require.NoError(t, err)
+
 	require.ElementsMatch(t, newProhibitedAddresses, prohibitedXRPLAddresses)
 }
 
+func TestSendFromCoreumToXRPLWithDailyTransferLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 8),
+	})
+	xrplRecipientAddress := xrpl.GenPrivKeyTxSigner().Account()
+
+	relayers := genRelayers(ctx, t, chains, 1)
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		150,
+		defaultTrustSetLimitAmount,
+		bridgeXRPLAddress,
+		10,
+	)
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 200)
+
+	maxSendsPerAddressPerDay := uint32(100)
+	denom := chains.Coreum.ChainSettings.Denom
+	_, err := contractClient.RegisterCoreumToken(
+		ctx,
+		owner,
+		denom,
+		6,
+		6,
+		sdkmath.NewIntWithDecimal(1, 10),
+		sdkmath.ZeroInt(),
+		&maxSendsPerAddressPerDay,
+	)
+	require.NoError(t, err)
+
+	registeredToken, err := contractClient.GetCoreumTokenByDenom(ctx, denom)
+	require.NoError(t, err)
+	require.NotNil(t, registeredToken.MaxSendsPerAddressPerDay)
+	require.Equal(t, maxSendsPerAddressPerDay, *registeredToken.MaxSendsPerAddressPerDay)
+
+	coinToSend := sdk.NewCoin(denom, sdkmath.NewInt(1))
+	for i := 0; i < int(maxSendsPerAddressPerDay); i++ {
+		_, err = contractClient.SendToXRPL(ctx, coreumSenderAddress, xrplRecipientAddress.String(), coinToSend, nil)
+		require.NoError(t, err)
+	}
+
+	// the 101st transfer within the same day is rejected
+	_, err = contractClient.SendToXRPL(ctx, coreumSenderAddress, xrplRecipientAddress.String(), coinToSend, nil)
+	require.True(t, coreum.IsDailyTransferLimitExceededError(err), err)
+
+	// the limit is tracked per address
+	_, err = contractClient.SendToXRPL(ctx, owner, xrplRecipientAddress.String(), coinToSend, nil)
+	require.NoError(t, err)
+
+	// increase the limit and send one more time
+	_, err = contractClient.UpdateCoreumToken(
+		ctx, owner, denom, nil, nil, nil, nil, lo.ToPtr(maxSendsPerAddressPerDay+1),
+		nil,
+	)
+	require.NoError(t, err)
+	_, err = contractClient.SendToXRPL(ctx, coreumSenderAddress, xrplRecipientAddress.String(), coinToSend, nil)
+	require.NoError(t, err)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, int(maxSendsPerAddressPerDay)+2)
+}
+
 //nolint:tparallel // the test is parallel, but test cases are not
 func TestSendFromCoreumToXRPLCoreumOriginatedTokenWithDifferentSendingPrecisionAndDecimals(t *testing.T) {
 	t.Parallel()
@@ -2464,8 +2854,7 @@ func TestSendFromCoreumToXRPLCoreumOriginatedTokenWithDifferentSendingPrecisionA
 				ctx,
 				coreumSenderAddress,
 				xrplRecipient.String(),
-				sdk.NewCoin(registeredCoreumOriginatedToken.Denom, tt.sendingAmount),
-				nil,
+				sdk.NewCoin(registeredCoreumOriginatedToken.Denom, tt.sendingAmount), nil,
 			)
 			if tt.wantIsAmountSentIsZeroAfterTruncationError {
 				require.True(t, coreum.IsAmountSentIsZeroAfterTruncationError(err), err)
@@ -2553,7 +2942,7 @@ func TestSendCoreumOriginatedTokenWithBurningRateAndSendingCommissionFromCoreumT
 	require.NoError(t, err)
 	denom := assetfttypes.BuildDenom(msgIssue.Subunit, coreumIssuerAddress)
 	_, err = contractClient.RegisterCoreumToken(
-		ctx, owner, denom, tokenDecimals, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(),
+		ctx, owner, denom, tokenDecimals, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil,
 	)
 	require.NoError(t, err)
 	registeredToken, err := contractClient.GetCoreumTokenByDenom(ctx, denom)
@@ -2588,8 +2977,7 @@ func TestSendCoreumOriginatedTokenWithBurningRateAndSendingCommissionFromCoreumT
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredToken.Denom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredToken.Denom, amountToSend), nil,
 	)
 	require.NoError(t, err)
 	// check the remaining balance
@@ -2688,6 +3076,201 @@ func TestSendCoreumOriginatedTokenWithBurningRateAndSendingCommissionFromCoreumT
 	)
 }
 
+// TestSendCoreumOriginatedTokenWithBurningRateSendingCommissionAndBridgingFeeFromCoreumToXRPLAndBack tests that the
+// burning rate and sending commission are applied only to the initial send from Coreum, and the bridging fee is
+// applied in both directions.
+func TestSendCoreumOriginatedTokenWithBurningRateSendingCommissionAndBridgingFeeFromCoreumToXRPLAndBack(
+	t *testing.T,
+) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	coreumIssuerAddress := chains.Coreum.GenAccount()
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumIssuerAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 6),
+	})
+
+	coreumRecipient := chains.Coreum.GenAccount()
+
+	xrplRecipientAddress := xrpl.GenPrivKeyTxSigner().Account()
+	relayers := genRelayers(ctx, t, chains, 2)
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		bridgeXRPLAddress,
+		10,
+	)
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	// issue asset ft and register it
+	sendingPrecision := int32(5)
+	tokenDecimals := uint32(5)
+	maxHoldingAmount := sdkmath.NewIntWithDecimal(1, 11)
+	bridgingFee := sdkmath.NewInt(1_000)
+	msgIssue := &assetfttypes.MsgIssue{
+		Issuer:             coreumIssuerAddress.String(),
+		Symbol:             "symbol",
+		Subunit:            "subunit",
+		Precision:          tokenDecimals,
+		InitialAmount:      maxHoldingAmount,
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(coreumIssuerAddress),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		msgIssue,
+	)
+	require.NoError(t, err)
+	denom := assetfttypes.BuildDenom(msgIssue.Subunit, coreumIssuerAddress)
+	_, err = contractClient.RegisterCoreumToken(
+		ctx, owner, denom, tokenDecimals, sendingPrecision, maxHoldingAmount, bridgingFee, nil,
+	)
+	require.NoError(t, err)
+	registeredToken, err := contractClient.GetCoreumTokenByDenom(ctx, denom)
+	require.NoError(t, err)
+
+	// the issuer is exempt from the burning rate and sending commission, so the coins are sent to the sender
+	msgSend := &banktypes.MsgSend{
+		FromAddress: coreumIssuerAddress.String(),
+		ToAddress:   coreumSenderAddress.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000_000)),
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(coreumIssuerAddress),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		msgSend,
+	)
+	require.NoError(t, err)
+
+	// ********** Coreum to XRPL **********
+
+	amountToSend := sdkmath.NewIntWithDecimal(1, 6)
+	coreumSenderBalanceBeforeRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   registeredToken.Denom,
+	})
+	require.NoError(t, err)
+	_, err = contractClient.SendToXRPL(
+		ctx,
+		coreumSenderAddress,
+		xrplRecipientAddress.String(),
+		sdk.NewCoin(registeredToken.Denom, amountToSend), nil,
+	)
+	require.NoError(t, err)
+	coreumSenderBalanceAfterRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   registeredToken.Denom,
+	})
+	require.NoError(t, err)
+	// amountToSend + burning rate + sending commission
+	spentAmount := amountToSend.
+		Add(amountToSend.ToLegacyDec().Mul(msgIssue.BurnRate.Add(msgIssue.SendCommissionRate)).TruncateInt())
+	require.Equal(
+		t,
+		coreumSenderBalanceBeforeRes.Balance.Amount.Sub(spentAmount).String(),
+		coreumSenderBalanceAfterRes.Balance.Amount.String(),
+	)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	operation := pendingOperations[0]
+	operationType := operation.OperationType.CoreumToXRPLTransfer
+	// the bridging fee is deducted from the sent amount
+	amountSentToXRPL := amountToSend.Sub(bridgingFee)
+	// XRPL DECIMALS (15) - TOKEN DECIMALS (5) = 10
+	require.Equal(t, amountSentToXRPL.Mul(sdkmath.NewIntWithDecimal(1, 10)).String(), operationType.Amount.String())
+	require.Equal(t, xrplRecipientAddress.String(), operationType.Recipient)
+
+	acceptedTxEvidence := coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+		XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+			TxHash:            integrationtests.GenXRPLTxHash(t),
+			TicketSequence:    &operation.TicketSequence,
+			TransactionResult: coreum.TransactionResultAccepted,
+		},
+	}
+	for _, relayer := range relayers {
+		_, err = contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+			ctx, relayer.CoreumAddress, acceptedTxEvidence,
+		)
+		require.NoError(t, err)
+	}
+	pendingOperations, err = contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingOperations)
+
+	// the contract holds the full sent amount including the bridging fee
+	bridgeContractBalanceBeforeRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: contractClient.GetContractAddress().String(),
+		Denom:   registeredToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amountToSend.String(), bridgeContractBalanceBeforeRes.Balance.Amount.String())
+
+	// ********** XRPL to Coreum **********
+
+	// the XRPL recipient sends back the full received amount
+	xrplToCoreumTransferEvidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    integrationtests.GenXRPLTxHash(t),
+		Issuer:    bridgeXRPLAddress,
+		Currency:  registeredToken.XRPLCurrency,
+		Amount:    operationType.Amount,
+		Recipient: coreumRecipient,
+	}
+	for _, relayer := range relayers {
+		_, err = contractClient.SendXRPLToCoreumTransferEvidence(
+			ctx, relayer.CoreumAddress, xrplToCoreumTransferEvidence,
+		)
+		require.NoError(t, err)
+	}
+
+	// the recipient receives the amount minus the bridging fee only, without the burning rate and sending commission
+	coreumRecipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amountSentToXRPL.Sub(bridgingFee).String(), coreumRecipientBalanceRes.Balance.Amount.String())
+
+	// the contract keeps the collected bridging fees of both directions only
+	bridgeContractBalanceAfterRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: contractClient.GetContractAddress().String(),
+		Denom:   registeredToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, bridgingFee.MulRaw(2).String(), bridgeContractBalanceAfterRes.Balance.Amount.String())
+
+	claimFeesAndMakeAssertions(
+		ctx,
+		t,
+		contractClient,
+		bankClient,
+		relayers,
+		bridgingFee.MulRaw(2),
+		sdk.ZeroInt(),
+		registeredToken.Denom,
+	)
+}
+
 // TestBridgingFeeForCoreumOriginatedTokens tests that corrects fees are calculated, deducted and
 // are collected by relayers.
 //
@@ -2802,8 +3385,7 @@ func TestBridgingFeeForCoreumOriginatedTokens(t *testing.T) {
 				ctx,
 				coreumSenderAddress,
 				xrplRecipient.String(),
-				sdk.NewCoin(registeredCoreumOriginatedToken.Denom, stringToSDKInt(tt.sendingAmount)),
-				nil,
+				sdk.NewCoin(registeredCoreumOriginatedToken.Denom, stringToSDKInt(tt.sendingAmount)), nil,
 			)
 			require.NoError(t, err)
 
@@ -2905,6 +3487,8 @@ func TestFeeCalculations_MultipleAssetsAndPartialClaim(t *testing.T) {
 			15,
 			maxHoldingAmount,
 			asset.bridgingFee,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, xrplCurrency)
@@ -2999,6 +3583,115 @@ func TestFeeCalculations_MultipleAssetsAndPartialClaim(t *testing.T) {
 	}
 }
 
+func TestSweepRelayerFeesWithBridgeClient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sendingAmount    = sdkmath.NewIntWithDecimal(1, 6)
+		maxHoldingAmount = sdkmath.NewIntWithDecimal(1, 9)
+		bridgingFee      = sdkmath.NewInt(3000)
+	)
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	relayers := genRelayers(ctx, t, chains, 3)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		10,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	bridgeClient := bridgeclient.NewBridgeClient(
+		chains.Log,
+		chains.Coreum.ClientContext,
+		contractClient,
+		chains.XRPL.RPCClient(),
+		xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil),
+	)
+
+	relayerAddresses := lo.Map(relayers, func(relayer coreum.Relayer, _ int) sdk.AccAddress {
+		return relayer.CoreumAddress
+	})
+
+	// nothing to claim
+	totalClaimed, err := bridgeClient.SweepRelayerFees(ctx, relayerAddresses)
+	require.NoError(t, err)
+	require.True(t, totalClaimed.IsZero())
+
+	// fund owner to cover registration fee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplCurrency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	_, err = contractClient.RegisterXRPLToken(
+		ctx,
+		owner,
+		issuer,
+		xrplCurrency,
+		15,
+		maxHoldingAmount,
+		bridgingFee,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, xrplCurrency)
+	require.NoError(t, err)
+	activateXRPLToken(ctx, t, contractClient, relayers, issuer, xrplCurrency)
+
+	xrplToCoreumTransferEvidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    integrationtests.GenXRPLTxHash(t),
+		Issuer:    issuer,
+		Currency:  xrplCurrency,
+		Amount:    sendingAmount,
+		Recipient: chains.Coreum.GenAccount(),
+	}
+	for _, relayer := range relayers {
+		_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayer.CoreumAddress, xrplToCoreumTransferEvidence)
+		require.NoError(t, err)
+	}
+
+	expectedRelayerFee := bridgingFee.QuoRaw(int64(len(relayers)))
+	for _, relayer := range relayers {
+		fees, err := contractClient.GetFeesCollected(ctx, relayer.CoreumAddress)
+		require.NoError(t, err)
+		require.Equal(t, expectedRelayerFee.String(), fees.AmountOf(registeredXRPLToken.CoreumDenom).String())
+	}
+
+	// the relayer without the key in the keyring can't be swept
+	_, err = bridgeClient.SweepRelayerFees(ctx, append(relayerAddresses, coreum.GenAccount()))
+	require.ErrorContains(t, err, "failed to get relayer key from the keyring")
+
+	totalClaimed, err = bridgeClient.SweepRelayerFees(ctx, relayerAddresses)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		expectedRelayerFee.MulRaw(int64(len(relayers))).String(),
+		totalClaimed.AmountOf(registeredXRPLToken.CoreumDenom).String(),
+	)
+
+	for _, relayer := range relayers {
+		balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: relayer.CoreumAddress.String(),
+			Denom:   registeredXRPLToken.CoreumDenom,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedRelayerFee.String(), balanceRes.Balance.Amount.String())
+
+		fees, err := contractClient.GetFeesCollected(ctx, relayer.CoreumAddress)
+		require.NoError(t, err)
+		require.Empty(t, fees)
+	}
+}
+
 // TestFeeCalculations_MultipleAssetsAndPartialClaim tests that corrects remainder fees are calculated, deducted and
 // are collected by relayers.
 func TestFeeCalculations_FeeRemainder(t *testing.T) {
@@ -3047,6 +3740,8 @@ func TestFeeCalculations_FeeRemainder(t *testing.T) {
 		1,
 		maxHoldingAmount,
 		bridgingFee,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, xrplCurrency)
@@ -3271,6 +3966,8 @@ func TestBridgingFeeForXRPLOrginatedTokens(t *testing.T) {
 				tt.sendingPrecision,
 				highMaxHoldingAmount,
 				stringToSDKInt(tt.bridgingFee),
+				nil,
+				nil,
 			)
 			require.NoError(t, err)
 			registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, xrplCurrency)
@@ -3369,6 +4066,160 @@ func TestBridgingFeeForXRPLOrginatedTokens(t *testing.T) {
 	}
 }
 
+func TestTreasuryFeeCollectionAndClaim(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	bridgeAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		10,
+		defaultTrustSetLimitAmount,
+		bridgeAddress,
+		10,
+	)
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 100)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 6)),
+	})
+
+	// the decimals match the XRPL decimals to keep the amounts unconverted
+	tokenDecimals := uint32(15)
+	bridgingFee := sdkmath.NewInt(30)
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSenderAddress,
+		owner,
+		tokenDecimals,
+		sdkmath.NewInt(1_000_000),
+		int32(tokenDecimals),
+		sdkmath.NewInt(1_000_000),
+		bridgingFee,
+	)
+	denom := registeredCoreumOriginatedToken.Denom
+
+	// nothing is collected before the transfers
+	_, err := contractClient.ClaimTreasuryFees(ctx, owner)
+	require.True(t, coreum.IsNoTreasuryFeesToClaimError(err), err)
+
+	updateTreasuryFee := func(treasuryFee sdkmath.Int) {
+		_, err := contractClient.UpdateCoreumToken(
+			ctx, owner, denom, nil, nil, nil, nil, nil, &treasuryFee,
+		)
+		require.NoError(t, err)
+	}
+
+	// send to XRPL with different treasury fees, the last one is capped by the bridging fee
+	sendingAmount := sdkmath.NewInt(1000)
+	xrplRecipient := xrpl.GenPrivKeyTxSigner().Account()
+	for _, treasuryFee := range []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(50)} {
+		updateTreasuryFee(treasuryFee)
+		_, err := contractClient.SendToXRPL(
+			ctx, coreumSenderAddress, xrplRecipient.String(), sdk.NewCoin(denom, sendingAmount), nil,
+		)
+		require.NoError(t, err)
+
+		pendingOperations, err := contractClient.GetPendingOperations(ctx)
+		require.NoError(t, err)
+		require.Len(t, pendingOperations, 1)
+		operationType := pendingOperations[0].OperationType.CoreumToXRPLTransfer
+		require.NotNil(t, operationType)
+		// the treasury fee is taken out of the bridging fee, so only the bridging fee is deducted from the amount
+		require.Equal(t, sendingAmount.Sub(bridgingFee).String(), operationType.Amount.String())
+
+		acceptedTxEvidence := coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+			XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+				TxHash:            integrationtests.GenXRPLTxHash(t),
+				TicketSequence:    &pendingOperations[0].TicketSequence,
+				TransactionResult: coreum.TransactionResultAccepted,
+			},
+		}
+		for _, relayer := range relayers {
+			_, err := contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+				ctx, relayer.CoreumAddress, acceptedTxEvidence,
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	// send back to Coreum with the last treasury fee
+	coreumRecipient := chains.Coreum.GenAccount()
+	sendFromXRPLToCoreum(
+		ctx,
+		t,
+		contractClient,
+		relayers,
+		bridgeAddress,
+		registeredCoreumOriginatedToken.XRPLCurrency,
+		sdkmath.NewInt(500),
+		coreumRecipient,
+	)
+	recipientBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "470", recipientBalance.Balance.Amount.String())
+
+	// the treasury fees are accumulated apart from the relayers' part of the bridging fees
+	treasuryFeesCollected, err := contractClient.GetTreasuryFeesCollected(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 70)).String(), treasuryFeesCollected.String())
+	for _, relayer := range relayers {
+		relayerFees, err := contractClient.GetFeesCollected(ctx, relayer.CoreumAddress)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)).String(), relayerFees.String())
+	}
+
+	// only the owner can claim the treasury fees
+	_, err = contractClient.ClaimTreasuryFees(ctx, relayers[0].CoreumAddress)
+	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
+
+	ownerBalanceBeforeClaim, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: owner.String(),
+		Denom:   denom,
+	})
+	require.NoError(t, err)
+	_, err = contractClient.ClaimTreasuryFees(ctx, owner)
+	require.NoError(t, err)
+	ownerBalanceAfterClaim, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: owner.String(),
+		Denom:   denom,
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"70",
+		ownerBalanceAfterClaim.Balance.Amount.Sub(ownerBalanceBeforeClaim.Balance.Amount).String(),
+	)
+
+	treasuryFeesCollected, err = contractClient.GetTreasuryFeesCollected(ctx)
+	require.NoError(t, err)
+	require.True(t, treasuryFeesCollected.IsZero())
+	_, err = contractClient.ClaimTreasuryFees(ctx, owner)
+	require.True(t, coreum.IsNoTreasuryFeesToClaimError(err), err)
+
+	// the zero treasury fee removes it
+	updateTreasuryFee(sdkmath.ZeroInt())
+	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, denom)
+	require.NoError(t, err)
+	require.Nil(t, registeredCoreumOriginatedToken.TreasuryFee)
+}
+
 func claimFeesAndMakeAssertions(
 	ctx context.Context,
 	t *testing.T,
@@ -3419,3 +4270,118 @@ func claimFeesAndMakeAssertions(
 		}
 	}
 }
+
+func TestClaimRefundAll(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		uint32(150),
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount,
+	})
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 6)),
+	})
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, xrpl.MaxTicketsToAllocate)
+
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSenderAddress,
+		owner,
+		6,
+		sdkmath.NewIntWithDecimal(1, 11),
+		6,
+		sdkmath.NewIntWithDecimal(1, 11),
+		sdkmath.ZeroInt(),
+	)
+
+	// nothing to claim
+	claimedRefunds, err := contractClient.ClaimRefundAll(ctx, coreumSenderAddress)
+	require.NoError(t, err)
+	require.Empty(t, claimedRefunds)
+
+	coreumSenderBalanceBeforeRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+
+	refundsCount := 5
+	sendToXRPLRequests := make([]coreum.SendToXRPLRequest, 0, refundsCount)
+	for i := 0; i < refundsCount; i++ {
+		sendToXRPLRequests = append(sendToXRPLRequests, coreum.SendToXRPLRequest{
+			Recipient: xrplRecipientAddress.String(),
+			Amount:    sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(int64(10*(i+1)))),
+		})
+	}
+	_, err = contractClient.MultiSendToXRPL(ctx, coreumSenderAddress, sendToXRPLRequests...)
+	require.NoError(t, err)
+
+	// reject all operations to create the pending refunds
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, refundsCount)
+	for _, operation := range pendingOperations {
+		rejectedTxEvidence := coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+			XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+				TxHash:            integrationtests.GenXRPLTxHash(t),
+				TicketSequence:    lo.ToPtr(operation.TicketSequence),
+				TransactionResult: coreum.TransactionResultRejected,
+			},
+		}
+		for _, relayer := range relayers {
+			_, err = contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+				ctx, relayer.CoreumAddress, rejectedTxEvidence,
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	pendingRefunds, err := contractClient.GetPendingRefunds(ctx, coreumSenderAddress)
+	require.NoError(t, err)
+	require.Len(t, pendingRefunds, refundsCount)
+
+	// claim all refunds in one call
+	claimedRefunds, err = contractClient.ClaimRefundAll(ctx, coreumSenderAddress)
+	require.NoError(t, err)
+	require.ElementsMatch(t, pendingRefunds, claimedRefunds)
+
+	pendingRefunds, err = contractClient.GetPendingRefunds(ctx, coreumSenderAddress)
+	require.NoError(t, err)
+	require.Empty(t, pendingRefunds)
+
+	coreumSenderBalanceAfterRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumSenderAddress.String(),
+		Denom:   registeredCoreumOriginatedToken.Denom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, coreumSenderBalanceBeforeRes.Balance.String(), coreumSenderBalanceAfterRes.Balance.String())
+
+	claimedAmount := sdkmath.ZeroInt()
+	for _, refund := range claimedRefunds {
+		claimedAmount = claimedAmount.Add(refund.Coin.Amount)
+	}
+	require.Equal(t, sdkmath.NewInt(150).String(), claimedAmount.String())
+}
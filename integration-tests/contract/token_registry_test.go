@@ -4,6 +4,8 @@
 package contract_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -17,10 +19,12 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum/v4/pkg/client"
 	"github.com/CoreumFoundation/coreum/v4/testutil/event"
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -91,6 +95,7 @@ func TestRegisterAndUpdateCoreumToken(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdk.ZeroInt(),
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
@@ -103,12 +108,13 @@ func TestRegisterAndUpdateCoreumToken(t *testing.T) {
 		sendingPrecision,
 		maxHoldingAmount,
 		sdk.ZeroInt(),
+		nil,
 	)
 	require.NoError(t, err)
 
 	// try to register the same denom one more time
 	_, err = contractClient.RegisterCoreumToken(
-		ctx, owner, denom1, denom1Decimals, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, denom1, denom1Decimals, sendingPrecision, maxHoldingAmount, bridgingFee, nil,
 	)
 	require.True(t, coreum.IsCoreumTokenAlreadyRegisteredError(err), err)
 
@@ -182,6 +188,8 @@ func TestRegisterAndUpdateCoreumToken(t *testing.T) {
 		&newSendingPrecision,
 		&newMaxHoldingAmount,
 		&newBridgingFee,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -246,19 +254,19 @@ func TestRegisterAndUpdateXRPLToken(t *testing.T) {
 
 	// try to register from not owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, notOwner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, notOwner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// register from the owner
 	_, err = contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.NoError(t, err)
 
 	// try to register the same denom one more time
 	_, err = contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.True(t, coreum.IsXRPLTokenAlreadyRegisteredError(err), err)
 
@@ -396,7 +404,7 @@ func TestRegisterAndUpdateXRPLToken(t *testing.T) {
 
 	// register one more token and activate it
 	_, err = contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, activeCurrency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, activeCurrency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.NoError(t, err)
 
@@ -439,6 +447,8 @@ func TestRegisterAndUpdateXRPLToken(t *testing.T) {
 		&newSendingPrecision,
 		&newMaxHoldingAmount,
 		&newBridgingFee,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -455,6 +465,88 @@ func TestRegisterAndUpdateXRPLToken(t *testing.T) {
 	}, registeredActiveToken)
 }
 
+func TestRegisterXRPLTokenBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	const tokensCount = 5
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(tokensCount),
+	})
+
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 100)
+
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	requests := make([]coreum.XRPLTokenRegistrationRequest, 0, tokensCount)
+	for i := 0; i < tokensCount; i++ {
+		requests = append(requests, coreum.XRPLTokenRegistrationRequest{
+			Issuer:           issuer,
+			Currency:         xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t)),
+			SendingPrecision: 15,
+			MaxHoldingAmount: sdkmath.NewInt(10000),
+			BridgingFee:      sdkmath.ZeroInt(),
+		})
+	}
+	_, err := contractClient.RegisterXRPLTokenBatch(ctx, owner, requests...)
+	require.NoError(t, err)
+
+	xrplTokens, err := contractClient.GetXRPLTokens(ctx)
+	require.NoError(t, err)
+	// XRP token and registered
+	require.Len(t, xrplTokens, tokensCount+1)
+	for _, req := range requests {
+		registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, req.Issuer, req.Currency)
+		require.NoError(t, err)
+		require.Equal(t, coreum.XRPLToken{
+			Issuer:           req.Issuer,
+			Currency:         req.Currency,
+			CoreumDenom:      registeredToken.CoreumDenom,
+			SendingPrecision: req.SendingPrecision,
+			MaxHoldingAmount: req.MaxHoldingAmount,
+			State:            coreum.TokenStateProcessing,
+			BridgingFee:      req.BridgingFee,
+		}, registeredToken)
+	}
+
+	// each registration creates the trust set operation
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, tokensCount)
+
+	// the batch is registered atomically, so nothing is registered if one request is invalid
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(2),
+	})
+	_, err = contractClient.RegisterXRPLTokenBatch(ctx, owner, coreum.XRPLTokenRegistrationRequest{
+		Issuer:           issuer,
+		Currency:         xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t)),
+		SendingPrecision: 15,
+		MaxHoldingAmount: sdkmath.NewInt(10000),
+		BridgingFee:      sdkmath.ZeroInt(),
+	}, requests[0])
+	require.True(t, coreum.IsXRPLTokenAlreadyRegisteredError(err), err)
+
+	xrplTokens, err = contractClient.GetXRPLTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, xrplTokens, tokensCount+1)
+}
+
 func TestRecoverXRPLTokeRegistration(t *testing.T) {
 	t.Parallel()
 
@@ -495,7 +587,7 @@ func TestRecoverXRPLTokeRegistration(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(),
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
 	)
 	require.NoError(t, err)
 
@@ -621,6 +713,310 @@ func TestRecoverXRPLTokeRegistration(t *testing.T) {
 	require.Equal(t, coreum.TokenStateEnabled, registeredXRPLToken.State)
 }
 
+func TestMigrateXRPLToken(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	notOwner := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, notOwner, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewInt(1_000_000),
+	})
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		5,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount,
+	})
+
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	oldIssuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	oldCurrency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	sendingPrecision := int32(15)
+	maxHoldingAmount := sdkmath.NewInt(10000)
+
+	_, err := contractClient.RegisterXRPLToken(
+		ctx, owner, oldIssuer, oldCurrency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
+	)
+	require.NoError(t, err)
+
+	newIssuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	newCurrency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+
+	// try to migrate the token with the pending TrustSet operation
+	_, err = contractClient.MigrateXRPLToken(ctx, owner, oldIssuer, oldCurrency, newIssuer, newCurrency)
+	require.True(t, coreum.IsXRPLTokenHasPendingOperationsError(err), err)
+
+	activateXRPLToken(ctx, t, contractClient, relayers, oldIssuer, oldCurrency)
+
+	registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, oldIssuer, oldCurrency)
+	require.NoError(t, err)
+
+	amountToSend := sdkmath.NewInt(100)
+	sendFromXRPLToCoreum(ctx, t, contractClient, relayers, oldIssuer, oldCurrency, amountToSend, coreumRecipient)
+
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+	recipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredXRPLToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amountToSend.String(), recipientBalanceRes.Balance.Amount.String())
+
+	// try to migrate from not owner
+	_, err = contractClient.MigrateXRPLToken(ctx, notOwner, oldIssuer, oldCurrency, newIssuer, newCurrency)
+	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
+
+	// try to migrate the XRP token
+	_, err = contractClient.MigrateXRPLToken(
+		ctx, owner, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+		newIssuer, newCurrency,
+	)
+	require.True(t, coreum.IsXRPTokenNotMigratableError(err), err)
+
+	// try to migrate the disabled token
+	_, err = contractClient.UpdateXRPLToken(
+		ctx, owner, oldIssuer, oldCurrency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil, nil,
+	)
+	require.NoError(t, err)
+	_, err = contractClient.MigrateXRPLToken(ctx, owner, oldIssuer, oldCurrency, newIssuer, newCurrency)
+	require.True(t, coreum.IsDisabledXRPLTokenNotMigratableError(err), err)
+	_, err = contractClient.UpdateXRPLToken(
+		ctx, owner, oldIssuer, oldCurrency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil, nil,
+	)
+	require.NoError(t, err)
+
+	txRes, err := contractClient.MigrateXRPLToken(ctx, owner, oldIssuer, oldCurrency, newIssuer, newCurrency)
+	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredXRPLToken.CoreumDenom, coreum.TokenStateEnabled, coreum.TokenStateProcessing, owner,
+	)
+
+	// the old issuer and currency aren't registered anymore, the old token is kept disabled
+	_, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, oldIssuer, oldCurrency)
+	require.Error(t, err)
+	migratedXRPLTokens, err := contractClient.GetMigratedXRPLTokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []coreum.XRPLToken{{
+		Issuer:           oldIssuer,
+		Currency:         oldCurrency,
+		CoreumDenom:      registeredXRPLToken.CoreumDenom,
+		SendingPrecision: sendingPrecision,
+		MaxHoldingAmount: maxHoldingAmount,
+		State:            coreum.TokenStateDisabled,
+		BridgingFee:      sdkmath.ZeroInt(),
+	}}, migratedXRPLTokens)
+
+	// the old issuer and currency can't be bridged
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[0].CoreumAddress,
+		coreum.XRPLToCoreumTransferEvidence{
+			TxHash:    integrationtests.GenXRPLTxHash(t),
+			Issuer:    oldIssuer,
+			Currency:  oldCurrency,
+			Amount:    amountToSend,
+			Recipient: coreumRecipient,
+		})
+	require.True(t, coreum.IsTokenNotEnabledError(err), err)
+
+	migratedXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, newIssuer, newCurrency)
+	require.NoError(t, err)
+	require.Equal(t, coreum.XRPLToken{
+		Issuer:           newIssuer,
+		Currency:         newCurrency,
+		CoreumDenom:      registeredXRPLToken.CoreumDenom,
+		SendingPrecision: sendingPrecision,
+		MaxHoldingAmount: maxHoldingAmount,
+		State:            coreum.TokenStateProcessing,
+		BridgingFee:      sdkmath.ZeroInt(),
+	}, migratedXRPLToken)
+
+	// the bridged balance isn't affected by the migration
+	recipientBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredXRPLToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amountToSend.String(), recipientBalanceRes.Balance.Amount.String())
+
+	// the token is enabled once the TrustSet for the new issuer and currency is accepted
+	activateXRPLToken(ctx, t, contractClient, relayers, newIssuer, newCurrency)
+
+	// the new issuer and currency are bridged to the same denom
+	sendFromXRPLToCoreum(ctx, t, contractClient, relayers, newIssuer, newCurrency, amountToSend, coreumRecipient)
+	recipientBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredXRPLToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amountToSend.MulRaw(2).String(), recipientBalanceRes.Balance.Amount.String())
+
+	// try to remove the migrated token from not owner
+	_, err = contractClient.RemoveMigratedXRPLToken(ctx, notOwner, oldIssuer, oldCurrency)
+	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
+
+	// the old token is removed once the balance of its trust line has been handled
+	_, err = contractClient.RemoveMigratedXRPLToken(ctx, owner, oldIssuer, oldCurrency)
+	require.NoError(t, err)
+	migratedXRPLTokens, err = contractClient.GetMigratedXRPLTokens(ctx)
+	require.NoError(t, err)
+	require.Empty(t, migratedXRPLTokens)
+
+	_, err = contractClient.RemoveMigratedXRPLToken(ctx, owner, oldIssuer, oldCurrency)
+	require.True(t, coreum.IsMigratedXRPLTokenNotFoundError(err), err)
+}
+
+// TestUpdateTrustSetLimitAmount documents that the contract doesn't provide a way to update the trust set limit
+// amount, so the limit set at instantiation is used for all TrustSet operations, including the ones created by the
+// token registration recovery.
+//
+// The proposed contract interface change is an owner-only execute method, validated the same way as the
+// instantiation value and stored in the contract config:
+//
+//	ExecuteMsg::UpdateTrustSetLimitAmount { trust_set_limit_amount: Uint128 }
+//
+// Once the method is added, the test must be updated to call it and expect the new limit in the TrustSet operation
+// of the recovered registration.
+func TestUpdateTrustSetLimitAmount(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+
+	lowTrustSetLimitAmount := sdkmath.NewIntWithDecimal(1, 10)
+	newTrustSetLimitAmount := sdkmath.NewIntWithDecimal(1, 20)
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		lowTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+	contractCfg, err := contractClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lowTrustSetLimitAmount.String(), contractCfg.TrustSetLimitAmount.String())
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.MulRaw(2),
+	})
+
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 100)
+
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	activeCurrency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	inactiveCurrency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	sendingPrecision := int32(15)
+	maxHoldingAmount := sdkmath.NewInt(10000)
+
+	// register and activate the token
+	_, err = contractClient.RegisterXRPLToken(
+		ctx, owner, issuer, activeCurrency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
+	)
+	require.NoError(t, err)
+	activateXRPLToken(ctx, t, contractClient, relayers, issuer, activeCurrency)
+
+	// register the token and reject its trust set to be able to recover it later
+	_, err = contractClient.RegisterXRPLToken(
+		ctx, owner, issuer, inactiveCurrency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
+	)
+	require.NoError(t, err)
+	operation := getTrustSetOperation(ctx, t, contractClient, issuer, inactiveCurrency)
+	require.Equal(t, lowTrustSetLimitAmount.String(), operation.OperationType.TrustSet.TrustSetLimitAmount.String())
+
+	rejectedTxEvidenceTrustSet := coreum.XRPLTransactionResultTrustSetEvidence{
+		XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+			TxHash:            integrationtests.GenXRPLTxHash(t),
+			TicketSequence:    &operation.TicketSequence,
+			TransactionResult: coreum.TransactionResultRejected,
+		},
+	}
+	for _, relayer := range relayers {
+		_, err = contractClient.SendXRPLTrustSetTransactionResultEvidence(
+			ctx, relayer.CoreumAddress, rejectedTxEvidenceTrustSet,
+		)
+		require.NoError(t, err)
+	}
+	registeredXRPLToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, inactiveCurrency)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateInactive, registeredXRPLToken.State)
+
+	// try to update the trust set limit amount, the contract doesn't have such method
+	payload, err := json.Marshal(map[string]any{
+		"update_trust_set_limit_amount": map[string]string{
+			"trust_set_limit_amount": newTrustSetLimitAmount.String(),
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(owner),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		&wasmtypes.MsgExecuteContract{
+			Sender:   owner.String(),
+			Contract: contractClient.GetContractAddress().String(),
+			Msg:      payload,
+		},
+	)
+	require.ErrorContains(t, err, "unknown variant")
+
+	// the recovered registration still uses the limit set at instantiation
+	_, err = contractClient.RecoverXRPLTokenRegistration(ctx, owner, issuer, inactiveCurrency)
+	require.NoError(t, err)
+	operation = getTrustSetOperation(ctx, t, contractClient, issuer, inactiveCurrency)
+	require.Equal(t, coreum.OperationTypeTrustSet{
+		Issuer:              issuer,
+		Currency:            inactiveCurrency,
+		TrustSetLimitAmount: lowTrustSetLimitAmount,
+	}, *operation.OperationType.TrustSet)
+
+	contractCfg, err = contractClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+	require.Equal(t, lowTrustSetLimitAmount.String(), contractCfg.TrustSetLimitAmount.String())
+}
+
+func getTrustSetOperation(
+	ctx context.Context,
+	t *testing.T,
+	contractClient *coreum.ContractClient,
+	issuer, currency string,
+) coreum.Operation {
+	t.Helper()
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	for _, operation := range pendingOperations {
+		operationType := operation.OperationType.TrustSet
+		if operationType != nil && operationType.Issuer == issuer && operationType.Currency == currency {
+			return operation
+		}
+	}
+	require.FailNow(t, "trust set operation not found", "issuer:%s, currency:%s", issuer, currency)
+
+	return coreum.Operation{}
+}
+
 func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	t.Parallel()
 
@@ -668,7 +1064,7 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(),
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
 	)
 	require.NoError(t, err)
 
@@ -682,7 +1078,7 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 
 	// try to change states of inactive token
 	for _, state := range allTokenStates {
-		_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil)
+		_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil, nil, nil)
 		require.True(t, coreum.IsTokenStateIsImmutableError(err), err)
 	}
 
@@ -691,14 +1087,26 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 
 	// try to change states of enabled token to the unchangeable state
 	for _, state := range unchangeableTokenStates {
-		_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil)
+		_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil, nil, nil)
 		require.True(t, coreum.IsInvalidTargetTokenStateError(err), err)
 	}
 
 	// change states of enabled token to the changeable state
+	prevState := coreum.TokenStateEnabled
 	for _, state := range changeableTokenStates {
-		_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil)
+		txRes, err := contractClient.UpdateXRPLToken(
+			ctx, owner, issuer, currency, lo.ToPtr(state), nil, nil, nil, nil,
+			nil,
+		)
 		require.NoError(t, err)
+		// the event is emitted only if the state is changed
+		if state == prevState {
+			_, err = event.FindStringEventAttribute(txRes.Events, coreum.TokenStateChangedEventType, "new_state")
+			require.Error(t, err)
+		} else {
+			requireTokenStateChangedEvent(t, txRes, registeredToken.CoreumDenom, prevState, state, owner)
+		}
+		prevState = state
 		registeredToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
 		require.NoError(t, err)
 		require.Equal(t, state, registeredToken.State)
@@ -706,21 +1114,27 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 
 	// try to call from random address
 	_, err = contractClient.UpdateXRPLToken(
-		ctx, randomCoreumAddress, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		ctx, randomCoreumAddress, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// try to call from relayer address
 	_, err = contractClient.UpdateXRPLToken(
-		ctx, relayers[0].CoreumAddress, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		ctx, relayers[0].CoreumAddress, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// disable token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	txRes, err := contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	// the token is already disabled by the last state change
+	_, err = event.FindStringEventAttribute(txRes.Events, coreum.TokenStateChangedEventType, "new_state")
+	require.Error(t, err)
 
 	xrplToCoreumTransferEvidence := coreum.XRPLToCoreumTransferEvidence{
 		TxHash:    integrationtests.GenXRPLTxHash(t),
@@ -738,10 +1152,14 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.True(t, coreum.IsTokenNotEnabledError(err), err)
 
 	// enable the token now
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateDisabled, coreum.TokenStateEnabled, owner,
+	)
 
 	// call from first relayer one more time
 	_, err = contractClient.SendXRPLToCoreumTransferEvidence(
@@ -752,10 +1170,14 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.NoError(t, err)
 
 	// disable the token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateEnabled, coreum.TokenStateDisabled, owner,
+	)
 
 	// try to use disabled token form second relayer
 	_, err = contractClient.SendXRPLToCoreumTransferEvidence(
@@ -766,10 +1188,14 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.True(t, coreum.IsTokenNotEnabledError(err), err)
 
 	// enable the token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateDisabled, coreum.TokenStateEnabled, owner,
+	)
 
 	// complete the transfer
 	_, err = contractClient.SendXRPLToCoreumTransferEvidence(
@@ -788,10 +1214,14 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.Equal(t, xrplToCoreumTransferEvidence.Amount.String(), recipientBalanceRes.Balance.Amount.String())
 
 	// disable the token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateEnabled, coreum.TokenStateDisabled, owner,
+	)
 
 	// try to send the token back
 	coinToSendBack := *recipientBalanceRes.Balance
@@ -799,20 +1229,28 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.True(t, coreum.IsTokenNotEnabledError(err), err)
 
 	// enable the token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateDisabled, coreum.TokenStateEnabled, owner,
+	)
 
 	// send the token back
 	_, err = contractClient.SendToXRPL(ctx, coreumRecipient, xrplRecipientAddress.String(), coinToSendBack, nil)
 	require.NoError(t, err)
 
 	// disable the token to check that relayers can complete the operation even for the disabled token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateEnabled, coreum.TokenStateDisabled, owner,
+	)
 
 	pendingOperations, err := contractClient.GetPendingOperations(ctx)
 	require.NoError(t, err)
@@ -863,20 +1301,28 @@ func TestEnableAndDisableXRPLOriginatedToken(t *testing.T) {
 	require.Equal(t, coinToSendBack.Amount.String(), recipientBalanceRes.Balance.Amount.String())
 
 	// enable the token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateDisabled, coreum.TokenStateEnabled, owner,
+	)
 
 	// send the token back
 	_, err = contractClient.SendToXRPL(ctx, coreumRecipient, xrplRecipientAddress.String(), coinToSendBack, nil)
 	require.NoError(t, err)
 
 	// disable the token to check that relayers can complete the operation even for the disabled token
-	_, err = contractClient.UpdateXRPLToken(
-		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	txRes, err = contractClient.UpdateXRPLToken(
+		ctx, owner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
+	requireTokenStateChangedEvent(
+		t, txRes, registeredToken.CoreumDenom, coreum.TokenStateEnabled, coreum.TokenStateDisabled, owner,
+	)
 
 	pendingOperations, err = contractClient.GetPendingOperations(ctx)
 	require.NoError(t, err)
@@ -968,7 +1414,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 	// try to change states of enabled token to the unchangeable state
 	for _, state := range unchangeableTokenStates {
 		_, err := contractClient.UpdateCoreumToken(
-			ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(state), nil, nil, nil,
+			ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(state), nil, nil, nil, nil,
+			nil,
 		)
 		require.True(t, coreum.IsInvalidTargetTokenStateError(err), err)
 	}
@@ -976,7 +1423,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 	// change states of enabled token to the changeable state
 	for _, state := range changeableTokenStates {
 		_, err := contractClient.UpdateCoreumToken(
-			ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(state), nil, nil, nil,
+			ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(state), nil, nil, nil, nil,
+			nil,
 		)
 		require.NoError(t, err)
 		registeredToken, err := contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -987,6 +1435,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 	// try to call from random address
 	_, err := contractClient.UpdateCoreumToken(
 		ctx, randomCoreumAddress, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
@@ -999,11 +1449,14 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1020,7 +1473,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 
 	// enable token
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1041,7 +1495,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 
 	// disable the token to check that relayers can complete the operation even for the disabled token
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1088,7 +1543,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 
 	// enable the token
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1100,7 +1556,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 
 	// disable the token to check that relayers can complete the operation even for the disabled token
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1154,7 +1611,8 @@ func TestEnableAndDisableCoreumOriginatedToken(t *testing.T) {
 
 	// enable token and confirm the sending
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -1222,7 +1680,7 @@ func TestUpdateXRPLOriginatedTokenSendingPrecision(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdk.ZeroInt(),
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, sdk.ZeroInt(), nil, nil,
 	)
 	require.NoError(t, err)
 	registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1236,13 +1694,15 @@ func TestUpdateXRPLOriginatedTokenSendingPrecision(t *testing.T) {
 
 	// try to call from random address
 	_, err = contractClient.UpdateXRPLToken(
-		ctx, randomCoreumAddress, issuer, currency, nil, &newSendingPrecision, nil, nil,
+		ctx, randomCoreumAddress, issuer, currency, nil, &newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// try to call from relayer address
 	_, err = contractClient.UpdateXRPLToken(
-		ctx, relayers[0].CoreumAddress, issuer, currency, nil, &newSendingPrecision, nil, nil,
+		ctx, relayers[0].CoreumAddress, issuer, currency, nil, &newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
@@ -1262,7 +1722,7 @@ func TestUpdateXRPLOriginatedTokenSendingPrecision(t *testing.T) {
 	require.NoError(t, err)
 
 	// update sending precision
-	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, &newSendingPrecision, nil, nil)
+	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, &newSendingPrecision, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	registeredToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1361,18 +1821,21 @@ func TestUpdateCoreumOriginatedTokenSendingPrecision(t *testing.T) {
 	newSendingPrecision := lo.ToPtr(int32(14))
 	// try to call from random address
 	_, err := contractClient.UpdateCoreumToken(
-		ctx, randomCoreumAddress, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil,
+		ctx, randomCoreumAddress, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// try to call from relayer address
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, relayers[0].CoreumAddress, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil,
+		ctx, relayers[0].CoreumAddress, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -1418,7 +1881,8 @@ func TestUpdateCoreumOriginatedTokenSendingPrecision(t *testing.T) {
 	// update sending precision one more time
 	newSendingPrecision = lo.ToPtr(int32(13))
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, newSendingPrecision, nil, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -1490,7 +1954,7 @@ func TestUpdateXRPLOriginatedTokenBridgingFee(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.NoError(t, err)
 	registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1503,12 +1967,15 @@ func TestUpdateXRPLOriginatedTokenBridgingFee(t *testing.T) {
 	newBridgingFee := sdkmath.NewInt(200)
 
 	// try to call from random address
-	_, err = contractClient.UpdateXRPLToken(ctx, randomCoreumAddress, issuer, currency, nil, nil, nil, &newBridgingFee)
+	_, err = contractClient.UpdateXRPLToken(
+		ctx, randomCoreumAddress, issuer, currency, nil, nil, nil, &newBridgingFee, nil, nil,
+	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// try to call from relayer address
 	_, err = contractClient.UpdateXRPLToken(
-		ctx, relayers[0].CoreumAddress, issuer, currency, nil, nil, nil, &newBridgingFee,
+		ctx, relayers[0].CoreumAddress, issuer, currency, nil, nil, nil, &newBridgingFee, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
@@ -1528,7 +1995,7 @@ func TestUpdateXRPLOriginatedTokenBridgingFee(t *testing.T) {
 	require.NoError(t, err)
 
 	// update bridging fee
-	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, nil, &newBridgingFee)
+	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, nil, &newBridgingFee, nil, nil)
 	require.NoError(t, err)
 
 	registeredToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1628,18 +2095,21 @@ func TestUpdateCoreumOriginatedTokenBridgingFee(t *testing.T) {
 	newBridgingFee := sdkmath.NewInt(200)
 	// try to call from random address
 	_, err := contractClient.UpdateCoreumToken(
-		ctx, randomCoreumAddress, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee,
+		ctx, randomCoreumAddress, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	// try to call from relayer address
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, relayers[0].CoreumAddress, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee,
+		ctx, relayers[0].CoreumAddress, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee, nil,
+		nil,
 	)
 	require.True(t, coreum.IsUnauthorizedSenderError(err), err)
 
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee, nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -1685,7 +2155,8 @@ func TestUpdateCoreumOriginatedTokenBridgingFee(t *testing.T) {
 	// update bridging fee one more time
 	newBridgingFee = sdkmath.NewInt(400)
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, nil, &newBridgingFee, nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -1755,7 +2226,7 @@ func TestUpdateXRPLOriginatedTokenMaxHoldingAmount(t *testing.T) {
 
 	// register from the owner
 	_, err := contractClient.RegisterXRPLToken(
-		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 	)
 	require.NoError(t, err)
 	registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1783,7 +2254,7 @@ func TestUpdateXRPLOriginatedTokenMaxHoldingAmount(t *testing.T) {
 	newMaxHoldingAmount := sdkmath.NewInt(900)
 
 	// update max holding amount
-	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil)
+	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil, nil, nil)
 	require.NoError(t, err)
 
 	registeredToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
@@ -1800,7 +2271,7 @@ func TestUpdateXRPLOriginatedTokenMaxHoldingAmount(t *testing.T) {
 
 	newMaxHoldingAmount = sdkmath.NewInt(1100)
 	// update max holding amount to all the tx to pass
-	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil)
+	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil, nil, nil)
 	require.NoError(t, err)
 	registeredToken, err = contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
 	require.NoError(t, err)
@@ -1825,10 +2296,106 @@ func TestUpdateXRPLOriginatedTokenMaxHoldingAmount(t *testing.T) {
 
 	newMaxHoldingAmount = sdkmath.NewInt(900)
 	// try update max holding amount with the values less than balance
-	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil)
+	_, err = contractClient.UpdateXRPLToken(ctx, owner, issuer, currency, nil, nil, &newMaxHoldingAmount, nil, nil, nil)
 	require.True(t, coreum.IsInvalidTargetMaxHoldingAmountError(err), err)
 }
 
+func TestXRPLOriginatedTokenMaxHoldingAmountReachedAndReleased(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	bankClient := banktypes.NewQueryClient(chains.Coreum.ClientContext)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumRecipient, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntWithDecimal(1, 6),
+	})
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		uint32(len(relayers)),
+		3,
+		defaultTrustSetLimitAmount,
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		10,
+	)
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount,
+	})
+
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	currency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	maxHoldingAmount := sdkmath.NewInt(100)
+
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+	_, err := contractClient.RegisterXRPLToken(
+		ctx, owner, issuer, currency, int32(15), maxHoldingAmount, sdkmath.ZeroInt(), nil, nil,
+	)
+	require.NoError(t, err)
+	activateXRPLToken(ctx, t, contractClient, relayers, issuer, currency)
+	registeredToken, err := contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+	require.NoError(t, err)
+
+	// send exactly the max holding amount
+	sendFromXRPLToCoreum(ctx, t, contractClient, relayers, issuer, currency, maxHoldingAmount, coreumRecipient)
+
+	// try to send one more unit
+	exceedingEvidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    integrationtests.GenXRPLTxHash(t),
+		Issuer:    issuer,
+		Currency:  currency,
+		Amount:    sdkmath.NewInt(1),
+		Recipient: coreumRecipient,
+	}
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[0].CoreumAddress, exceedingEvidence)
+	require.NoError(t, err)
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[1].CoreumAddress, exceedingEvidence)
+	require.True(t, coreum.IsMaximumBridgedAmountReachedError(err), err)
+
+	// send half back to XRPL, the completed transfer releases the held amount
+	amountToReturn := sdkmath.NewInt(50)
+	sendFromCoreumToXRPL(
+		ctx,
+		t,
+		contractClient,
+		relayers,
+		coreumRecipient,
+		sdk.NewCoin(registeredToken.CoreumDenom, amountToReturn),
+		xrplRecipientAddress,
+	)
+	recipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxHoldingAmount.Sub(amountToReturn).String(), recipientBalanceRes.Balance.Amount.String())
+
+	// the released amount can be sent again
+	sendFromXRPLToCoreum(ctx, t, contractClient, relayers, issuer, currency, amountToReturn, coreumRecipient)
+	recipientBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: coreumRecipient.String(),
+		Denom:   registeredToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, maxHoldingAmount.String(), recipientBalanceRes.Balance.Amount.String())
+
+	// the limit is reached again
+	exceedingEvidence.TxHash = integrationtests.GenXRPLTxHash(t)
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[0].CoreumAddress, exceedingEvidence)
+	require.NoError(t, err)
+	_, err = contractClient.SendXRPLToCoreumTransferEvidence(ctx, relayers[1].CoreumAddress, exceedingEvidence)
+	require.True(t, coreum.IsMaximumBridgedAmountReachedError(err), err)
+}
+
 func TestUpdateCoreumOriginatedTokenMaxHoldingAmount(t *testing.T) {
 	t.Parallel()
 
@@ -1885,7 +2452,8 @@ func TestUpdateCoreumOriginatedTokenMaxHoldingAmount(t *testing.T) {
 
 	newMaxHoldingAmount := sdkmath.NewInt(900)
 	_, err := contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, &newMaxHoldingAmount, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, &newMaxHoldingAmount, nil, nil,
+		nil,
 	)
 	require.NoError(t, err)
 	registeredCoreumOriginatedToken, err = contractClient.GetCoreumTokenByDenom(ctx, registeredCoreumOriginatedToken.Denom)
@@ -1925,7 +2493,8 @@ func TestUpdateCoreumOriginatedTokenMaxHoldingAmount(t *testing.T) {
 	newMaxHoldingAmount = sdkmath.NewInt(100)
 	// try update max holding amount with the values less than balance
 	_, err = contractClient.UpdateCoreumToken(
-		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, &newMaxHoldingAmount, nil,
+		ctx, owner, registeredCoreumOriginatedToken.Denom, nil, nil, &newMaxHoldingAmount, nil, nil,
+		nil,
 	)
 	require.True(t, coreum.IsInvalidTargetMaxHoldingAmountError(err), err)
 }
@@ -1966,8 +2535,152 @@ func TestRegisterXRPLTokenWithProhibitedIssuer(t *testing.T) {
 	require.NoError(t, err)
 	for _, issuer := range prohibitedXRPLAddresses {
 		_, err = contractClient.RegisterXRPLToken(
-			ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee,
+			ctx, owner, issuer, currency, sendingPrecision, maxHoldingAmount, bridgingFee, nil, nil,
 		)
 		require.True(t, coreum.IsProhibitedAddressError(err), err)
 	}
 }
+
+func TestExportAndImportTokenRegistry(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	relayers := genRelayers(ctx, t, chains, 1)
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	deployBridge := func() (sdk.AccAddress, *coreum.ContractClient, *bridgeclient.BridgeClient) {
+		owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+			ctx,
+			t,
+			chains,
+			relayers,
+			uint32(len(relayers)),
+			10,
+			defaultTrustSetLimitAmount,
+			xrpl.GenPrivKeyTxSigner().Account().String(),
+			10,
+		)
+		chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+			Amount: issueFee.Amount.MulRaw(2).AddRaw(1_000_000),
+		})
+		// recover tickets to be able to register the XRPL tokens
+		recoverTickets(ctx, t, contractClient, owner, relayers, 10)
+
+		return owner, contractClient, bridgeclient.NewBridgeClient(
+			chains.Log,
+			chains.Coreum.ClientContext,
+			contractClient,
+			chains.XRPL.RPCClient(),
+			xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil),
+		)
+	}
+
+	sourceOwner, sourceContractClient, sourceBridgeClient := deployBridge()
+	targetOwner, _, targetBridgeClient := deployBridge()
+
+	// seed the source registry
+	_, err := sourceContractClient.RegisterCoreumToken(
+		ctx, sourceOwner, "denom1", 6, 5, sdkmath.NewInt(10000), sdkmath.NewInt(10), lo.ToPtr(uint32(20)),
+	)
+	require.NoError(t, err)
+	_, err = sourceContractClient.RegisterCoreumToken(
+		ctx, sourceOwner, "denom2", 18, 15, sdkmath.NewInt(20000), sdkmath.ZeroInt(), nil,
+	)
+	require.NoError(t, err)
+	_, err = sourceContractClient.UpdateCoreumToken(
+		ctx, sourceOwner, "denom2", lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil, nil,
+		nil,
+	)
+	require.NoError(t, err)
+	issuer := chains.XRPL.GenAccount(ctx, t, 0).String()
+	for _, sendingPrecision := range []int32{6, 12} {
+		_, err = sourceContractClient.RegisterXRPLToken(
+			ctx,
+			sourceOwner,
+			issuer,
+			xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t)),
+			sendingPrecision,
+			sdkmath.NewInt(30000),
+			sdkmath.NewInt(1),
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+	}
+
+	registry, err := sourceBridgeClient.ExportTokenRegistry(ctx)
+	require.NoError(t, err)
+	require.Len(t, registry.CoreumTokens, 2)
+	// XRP token and registered
+	require.Len(t, registry.XRPLTokens, 3)
+	registryJSON, err := json.Marshal(registry)
+	require.NoError(t, err)
+	var decodedRegistry bridgeclient.TokenRegistry
+	require.NoError(t, json.Unmarshal(registryJSON, &decodedRegistry))
+
+	// the dry run doesn't change the target registry
+	results, err := targetBridgeClient.ImportTokenRegistry(ctx, targetOwner, decodedRegistry, true)
+	require.NoError(t, err)
+	requireTokenImportActions(t, map[bridgeclient.TokenImportAction]int{
+		bridgeclient.TokenImportActionCreated: 4,
+		bridgeclient.TokenImportActionSkipped: 1,
+	}, results)
+	targetRegistry, err := targetBridgeClient.ExportTokenRegistry(ctx)
+	require.NoError(t, err)
+	require.Empty(t, targetRegistry.CoreumTokens)
+	require.Len(t, targetRegistry.XRPLTokens, 1)
+
+	results, err = targetBridgeClient.ImportTokenRegistry(ctx, targetOwner, decodedRegistry, false)
+	require.NoError(t, err)
+	requireTokenImportActions(t, map[bridgeclient.TokenImportAction]int{
+		bridgeclient.TokenImportActionCreated: 4,
+		bridgeclient.TokenImportActionSkipped: 1,
+	}, results)
+
+	targetRegistry, err = targetBridgeClient.ExportTokenRegistry(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, normalizeCoreumTokens(registry.CoreumTokens), normalizeCoreumTokens(targetRegistry.CoreumTokens),
+	)
+	require.ElementsMatch(
+		t, normalizeXRPLTokens(registry.XRPLTokens), normalizeXRPLTokens(targetRegistry.XRPLTokens),
+	)
+
+	// the repeated import doesn't change anything
+	results, err = targetBridgeClient.ImportTokenRegistry(ctx, targetOwner, decodedRegistry, false)
+	require.NoError(t, err)
+	requireTokenImportActions(t, map[bridgeclient.TokenImportAction]int{
+		bridgeclient.TokenImportActionSkipped: 5,
+	}, results)
+}
+
+func requireTokenImportActions(
+	t *testing.T,
+	expected map[bridgeclient.TokenImportAction]int,
+	results []bridgeclient.TokenImportResult,
+) {
+	t.Helper()
+
+	require.Equal(t, expected, lo.CountValuesBy(
+		results,
+		func(result bridgeclient.TokenImportResult) bridgeclient.TokenImportAction {
+			return result.Action
+		},
+	), results)
+}
+
+// normalizeCoreumTokens removes the XRPL currency generated by the contract.
+func normalizeCoreumTokens(tokens []coreum.CoreumToken) []coreum.CoreumToken {
+	return lo.Map(tokens, func(token coreum.CoreumToken, _ int) coreum.CoreumToken {
+		token.XRPLCurrency = ""
+		return token
+	})
+}
+
+// normalizeXRPLTokens removes the Coreum denom generated by the contract.
+func normalizeXRPLTokens(tokens []coreum.XRPLToken) []coreum.XRPLToken {
+	return lo.Map(tokens, func(token coreum.XRPLToken, _ int) coreum.XRPLToken {
+		token.CoreumDenom = ""
+		return token
+	})
+}
@@ -6,6 +6,7 @@ package contract_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -143,6 +144,143 @@ func TestUpdateXRPLBaseFee(t *testing.T) {
 	assertOperationsUpdateAfterXRPLBaseFeeUpdate(ctx, t, contractClient, owner, xrplBaseFee, 20, relayers)
 }
 
+func TestUpdateXRPLBaseFeeWithConcurrentStaleSignatureSubmission(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+
+	relayers := genRelayers(ctx, t, chains, 2)
+	evidenceThreshold := uint32(len(relayers))
+	usedTicketSequenceThreshold := uint32(150)
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplBaseFee := uint32(10)
+
+	owner, contractClient := integrationtests.DeployInstantiateAndMigrateContract(
+		ctx,
+		t,
+		chains,
+		relayers,
+		evidenceThreshold,
+		usedTicketSequenceThreshold,
+		defaultTrustSetLimitAmount,
+		bridgeXRPLAddress,
+		xrplBaseFee,
+	)
+
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, owner, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount,
+	})
+
+	coreumSender := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSender, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 6)),
+	})
+	// recover tickets to be able to create operations from coreum to XRPL
+	recoverTickets(ctx, t, contractClient, owner, relayers, xrpl.MaxTicketsToAllocate)
+
+	registeredCoreumOriginatedToken := issueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		contractClient,
+		chains.Coreum,
+		coreumSender,
+		owner,
+		6,
+		sdkmath.NewIntWithDecimal(1, 11),
+		6,
+		sdkmath.NewIntWithDecimal(1, 11),
+		sdkmath.ZeroInt(),
+	)
+
+	_, err := contractClient.SendToXRPL(
+		ctx,
+		coreumSender,
+		xrplRecipientAddress.String(),
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(10)),
+		nil,
+	)
+	require.NoError(t, err)
+
+	pendingOperations, err := contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	staleOperation := pendingOperations[0]
+	require.Equal(t, uint32(1), staleOperation.Version)
+
+	// the first relayer signs the current version, the signature must be dropped by the base fee update
+	_, err = contractClient.SaveSignature(
+		ctx, relayers[0].CoreumAddress, staleOperation.TicketSequence, staleOperation.Version, xrplTxSignature,
+	)
+	require.NoError(t, err)
+
+	// both competitors read the same operation version before any of them submits the tx
+	var readBarrier, updateBarrier sync.WaitGroup
+	readBarrier.Add(2)
+	updateBarrier.Add(1)
+	newXRPLBaseFee := uint32(15)
+	var staleSubmissionErr error
+	require.NoError(t, parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		spawn("base-fee-update", parallel.Continue, func(ctx context.Context) error {
+			defer updateBarrier.Done()
+			_, err := contractClient.GetPendingOperations(ctx)
+			readBarrier.Done()
+			if err != nil {
+				return err
+			}
+			readBarrier.Wait()
+			_, err = contractClient.UpdateXRPLBaseFee(ctx, owner, newXRPLBaseFee)
+			return err
+		})
+		spawn("stale-signature", parallel.Continue, func(ctx context.Context) error {
+			operations, err := contractClient.GetPendingOperations(ctx)
+			readBarrier.Done()
+			if err != nil {
+				return err
+			}
+			readBarrier.Wait()
+			if len(operations) != 1 {
+				return errors.Errorf("expected one pending operation, got: %d", len(operations))
+			}
+			// the signature is submitted for the read version after the update bumps it
+			updateBarrier.Wait()
+			operation := operations[0]
+			_, staleSubmissionErr = contractClient.SaveSignature(
+				ctx, relayers[1].CoreumAddress, operation.TicketSequence, operation.Version, xrplTxSignature,
+			)
+			return nil
+		})
+		return nil
+	}))
+	require.True(t, coreum.IsOperationVersionMismatchError(staleSubmissionErr), staleSubmissionErr)
+
+	// no signature is stored for the stale version
+	pendingOperations, err = contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	updatedOperation := pendingOperations[0]
+	require.Equal(t, staleOperation.Version+1, updatedOperation.Version)
+	require.Equal(t, newXRPLBaseFee, updatedOperation.XRPLBaseFee)
+	require.Empty(t, updatedOperation.Signatures)
+
+	// the signature for the new version is accepted
+	_, err = contractClient.SaveSignature(
+		ctx, relayers[1].CoreumAddress, updatedOperation.TicketSequence, updatedOperation.Version, xrplTxSignature,
+	)
+	require.NoError(t, err)
+	pendingOperations, err = contractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	require.Equal(t, []coreum.Signature{
+		{
+			RelayerCoreumAddress: relayers[1].CoreumAddress,
+			Signature:            xrplTxSignature,
+		},
+	}, pendingOperations[0].Signatures)
+}
+
 func TestUpdateXRPLBaseFeeForMaxOperationCount(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,8 @@ package integrationtests
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,12 +16,19 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 )
 
+// CurrentContractVersion is the contract version referring the compiled contract.
+const CurrentContractVersion = "current"
+
 // CoreumChainConfig represents coreum chain config.
 type CoreumChainConfig struct {
 	GRPCAddress          string
 	FundingMnemonic      string
 	ContractPath         string
 	PreviousContractPath string
+	// ReleasedContractsDir is the directory with the released contracts bytecode downloaded by the builder.
+	ReleasedContractsDir string
+	// ContractVersions are the contract versions the compatibility is tested with.
+	ContractVersions []string
 }
 
 // CoreumChain is configured coreum chain.
@@ -73,6 +82,16 @@ func (c CoreumChain) Config() CoreumChainConfig {
 	return c.cfg
 }
 
+// ContractPathByVersion returns the path to the released contract bytecode of the version, or the path to the
+// compiled contract bytecode for the CurrentContractVersion.
+func (c CoreumChain) ContractPathByVersion(version string) string {
+	if version == CurrentContractVersion {
+		return c.cfg.ContractPath
+	}
+
+	return filepath.Join(c.cfg.ReleasedContractsDir, fmt.Sprintf("coreumbridge-xrpl-%s.wasm", version))
+}
+
 func getTestContextConfig() client.ContextConfig {
 	cfg := client.DefaultContextConfig()
 	cfg.TimeoutConfig.TxStatusPollInterval = 100 * time.Millisecond
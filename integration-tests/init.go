@@ -6,10 +6,13 @@ package integrationtests
 import (
 	"context"
 	"flag"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
@@ -37,8 +40,11 @@ func init() {
 	flag.StringVar(&coreumCfg.FundingMnemonic, "coreum-funding-mnemonic", "sad hobby filter tray ordinary gap half web cat hard call mystery describe member round trend friend beyond such clap frozen segment fan mistake", "Funding coreum account mnemonic required by tests")
 	flag.StringVar(&coreumCfg.ContractPath, "coreum-contract-path", "../../contract/artifacts/coreumbridge_xrpl.wasm", "Path to smart contract bytecode")
 	flag.StringVar(&coreumCfg.PreviousContractPath, "coreum-previous-contract-path", "../../bin/coreumbridge-xrpl-v1.1.0.wasm", "Path to previous smart contract bytecode")
+	flag.StringVar(&coreumCfg.ReleasedContractsDir, "coreum-released-contracts-dir", "../../bin", "Path to directory with released smart contracts bytecode named coreumbridge-xrpl-<version>.wasm")
+	contractVersions := flag.String("contract-versions", "v1.1.0", fmt.Sprintf("Comma separated released smart contract versions to test the compatibility with, %q refers the compiled contract", CurrentContractVersion))
 	flag.StringVar(&xrplCfg.RPCAddress, "xrpl-rpc-address", "http://localhost:5005", "RPC address of xrpl node")
 	flag.StringVar(&xrplCfg.FundingSeed, "xrpl-funding-seed", "snoPBrXtMeMyMHUVTgbuqAfg1SUTb", "Funding XRPL account seed required by tests")
+	flag.StringVar(&xrplCfg.FaucetURL, "xrpl-faucet-url", "", "XRPL faucet URL used to fund the test accounts, e.g. https://faucet.altnet.rippletest.net/accounts (the funding seed account is used if empty)")
 	// this is the default address used in znet
 	flag.StringVar(&bridgeCfg.ContractAddress, "contract-address", "devcore14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sd4f0ak", "Smart contract address of the bridge (znet)")
 	flag.StringVar(&bridgeCfg.OwnerMnemonic, "owner-mnemonic", "analyst evil lucky job exhaust inform note where grant file already exit vibrant come finger spatial absorb enter aisle orange soldier false attend response", "Smart contract owner of the bridge (znet)")
@@ -47,6 +53,7 @@ func init() {
 	testing.Init()
 	// parse additional flags
 	flag.Parse()
+	coreumCfg.ContractVersions = lo.Compact(strings.Split(*contractVersions, ","))
 
 	logCfg := logger.DefaultZapLoggerConfig()
 	// set correct skip caller since we don't use the err counter wrapper here
@@ -0,0 +1,107 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSendXRPLOriginatedTokenFromXRPLToCoreumThroughAMMPool(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	// the token isn't registered, so the bridge doesn't have the trust line to receive it directly
+	xrplFOOIssuer := chains.XRPL.GenAccount(ctx, t, 100)
+	runnerEnv.EnableXRPLAccountRippling(ctx, t, xrplFOOIssuer)
+	xrplFOOCurrency := integrationtests.GenerateXRPLCurrency(t)
+	fooAmount := func(value string) rippledata.Amount {
+		v, err := rippledata.NewValue(value, false)
+		require.NoError(t, err)
+		return rippledata.Amount{
+			Value:    v,
+			Currency: xrplFOOCurrency,
+			Issuer:   xrplFOOIssuer,
+		}
+	}
+
+	// create the FOO/XRP pool with 1000 FOO and 100 XRP, so the spot price is 10 FOO per 1 XRP
+	liquidityProvider := chains.XRPL.GenAccount(ctx, t, 150)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, liquidityProvider, xrplFOOIssuer, xrplFOOCurrency)
+	runnerEnv.SendXRPLPaymentTx(ctx, t, xrplFOOIssuer, liquidityProvider, fooAmount("1000"), rippledata.Memo{})
+	poolXRPValue, err := rippledata.NewValue("100", true)
+	require.NoError(t, err)
+	ammCreateTx := rippledata.AMMCreate{
+		Amount: rippledata.Amount{
+			Value:    poolXRPValue,
+			Currency: xrpl.XRPTokenCurrency,
+			Issuer:   xrpl.XRPTokenIssuer,
+		},
+		Amount2:    fooAmount("1000"),
+		TradingFee: 0,
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.AMM_CREATE,
+		},
+	}
+	chains.XRPL.AutoFillTx(ctx, t, &ammCreateTx, liquidityProvider)
+	// the AMMCreate fee is the owner reserve increment
+	ammCreateFee, err := rippledata.NewNativeValue(2_000_000)
+	require.NoError(t, err)
+	ammCreateTx.Fee = *ammCreateFee
+	require.NoError(t, chains.XRPL.SignAndSubmitTx(ctx, t, &ammCreateTx, liquidityProvider))
+
+	xrplSender := chains.XRPL.GenAccount(ctx, t, 10)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplSender, xrplFOOIssuer, xrplFOOCurrency)
+	runnerEnv.SendXRPLPaymentTx(ctx, t, xrplFOOIssuer, xrplSender, fooAmount("10"), rippledata.Memo{})
+	coreumRecipient := chains.Coreum.GenAccount()
+
+	ammRouter, err := xrpl.NewAMMRouter(
+		xrpl.AMMRouterConfig{
+			MaxSlippageBPS: 100,
+		},
+		chains.Log,
+		chains.XRPL.RPCClient(),
+	)
+	require.NoError(t, err)
+	runnerEnv.BridgeClient.WithXRPLAMMRouter(ammRouter)
+	sendRes, err := runnerEnv.BridgeClient.SendFromXRPLToCoreumWithAMMRouting(
+		ctx, xrplSender.String(), fooAmount("10"), coreumRecipient,
+	)
+	require.NoError(t, err)
+	// the asset change is reported to the sender
+	require.True(t, sendRes.Routed)
+	require.Equal(t, fooAmount("10").String(), sendRes.SentAmount.String())
+	require.True(t, sendRes.DeliveredAmount.IsNative())
+	require.Equal(t, "0.99", sendRes.DeliveredAmount.Value.String())
+
+	registeredXRPToken, err := runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+	)
+	require.NoError(t, err)
+	// 10 FOO is 1 XRP at the spot price, and the router delivers it with the 1% slippage
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(registeredXRPToken.CoreumDenom, sdkmath.NewInt(990_000)),
+	)
+
+	// the sender has spent not more than the sent FOO amount
+	senderFOOBalance := chains.XRPL.GetAccountBalance(ctx, t, xrplSender, xrplFOOIssuer, xrplFOOCurrency)
+	require.False(t, senderFOOBalance.Value.IsNegative())
+	require.False(t, senderFOOBalance.Value.IsZero())
+}
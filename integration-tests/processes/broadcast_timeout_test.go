@@ -0,0 +1,145 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"context"
+	"crypto/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestXRPLToCoreumEvidenceRequeueOnBroadcastTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	envCfg.RelayersCount = 1
+	envCfg.SigningThreshold = 1
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+
+	relayerCoreumAddress, err := sdk.AccAddressFromBech32(runnerEnv.BootstrappingConfig.Relayers[0].CoreumAddress)
+	require.NoError(t, err)
+	coreumRecipient := chains.Coreum.GenAccount()
+
+	// the slow node is simulated by the contract client with the timeout too short for any broadcast
+	slowNodeContractClientCfg := coreum.DefaultContractClientConfig(runnerEnv.ContractClient.GetContractAddress())
+	slowNodeContractClientCfg.TxBroadcastTimeout = time.Nanosecond
+	contractClient := &slowNodeContractClient{
+		ContractClient: runnerEnv.ContractClient,
+		slowNodeContractClient: coreum.NewContractClient(
+			slowNodeContractClientCfg, chains.Log, chains.Coreum.ClientContext, nil, nil,
+		),
+		slowCalls: 2,
+	}
+
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreumRecipient)
+	require.NoError(t, err)
+	xrpAmount, err := rippledata.NewAmount("1000000")
+	require.NoError(t, err)
+	var txHash rippledata.Hash256
+	_, err = rand.Read(txHash[:])
+	require.NoError(t, err)
+	paymentTx := rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			Destination: runnerEnv.BridgeXRPLAddress,
+			Amount:      *xrpAmount,
+			TxBase: rippledata.TxBase{
+				Account:         chains.XRPL.GenEmptyAccount(t),
+				TransactionType: rippledata.PAYMENT,
+				Memos:           rippledata.Memos{memo},
+				Hash:            txHash,
+			},
+		},
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: xrpAmount,
+		},
+	}
+
+	evidenceAuditLog, err := processes.NewEvidenceAuditLog(processes.EvidenceAuditLogConfig{})
+	require.NoError(t, err)
+	process, err := processes.NewXRPLToCoreumProcess(
+		processes.XRPLToCoreumProcessConfig{
+			BridgeXRPLAddress:          runnerEnv.BridgeXRPLAddress,
+			RelayerCoreumAddress:       relayerCoreumAddress,
+			BroadcastTimeoutRetryDelay: 100 * time.Millisecond,
+		},
+		chains.Log,
+		staticXRPLTxScanner{txs: []rippledata.TransactionWithMetaData{paymentTx}},
+		contractClient,
+		runnerEnv.RunnerComponents[0].MetricsRegistry,
+		evidenceAuditLog,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	// the process is finished once the scanned tx is processed
+	require.NoError(t, process.Start(ctx))
+	// two timed out attempts and the successful one
+	require.Equal(t, int32(3), contractClient.calls.Load())
+
+	registeredXRPToken, err := runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+	)
+	require.NoError(t, err)
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(registeredXRPToken.CoreumDenom, sdkmath.NewIntWithDecimal(1, xrpl.XRPCurrencyDecimals)),
+	)
+}
+
+// slowNodeContractClient sends the first slowCalls transfer evidences with the slow node contract client.
+type slowNodeContractClient struct {
+	*coreum.ContractClient
+	slowNodeContractClient *coreum.ContractClient
+	slowCalls              int32
+	calls                  atomic.Int32
+}
+
+func (c *slowNodeContractClient) SendXRPLToCoreumTransferEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evidence coreum.XRPLToCoreumTransferEvidence,
+) (*sdk.TxResponse, error) {
+	if c.calls.Add(1) <= c.slowCalls {
+		return c.slowNodeContractClient.SendXRPLToCoreumTransferEvidence(ctx, sender, evidence)
+	}
+
+	return c.ContractClient.SendXRPLToCoreumTransferEvidence(ctx, sender, evidence)
+}
+
+// staticXRPLTxScanner sends the predefined txs and stops.
+type staticXRPLTxScanner struct {
+	txs []rippledata.TransactionWithMetaData
+}
+
+func (s staticXRPLTxScanner) ScanTxs(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+	for _, tx := range s.txs {
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case ch <- tx:
+		}
+	}
+
+	return nil
+}
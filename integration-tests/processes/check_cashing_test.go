@@ -0,0 +1,84 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSendXRPTokenFromXRPLToCoreumWithCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	// the check casher scans the bridge account checks with the default poll interval
+	envCfg.AwaitTimeout = 2 * time.Minute
+	envCfg.ModifyRunnerConfig = func(cfg *runner.Config) {
+		cfg.XRPL.EnableCheckCashing = true
+	}
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	registeredXRPToken, err := runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+	)
+	require.NoError(t, err)
+
+	xrplSenderAddress := chains.XRPL.GenAccount(ctx, t, 12)
+	t.Logf("XRPL sender: %s", xrplSenderAddress.String())
+	coreumRecipient := chains.Coreum.GenAccount()
+	t.Logf("Coreum recipient: %s", coreumRecipient.String())
+
+	valueToSendFromXRPLtoCoreum, err := rippledata.NewValue("10.000001", true)
+	require.NoError(t, err)
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreumRecipient)
+	require.NoError(t, err)
+
+	checkCreateTx := rippledata.CheckCreate{
+		Destination: runnerEnv.BridgeXRPLAddress,
+		SendMax: rippledata.Amount{
+			Value:    valueToSendFromXRPLtoCoreum,
+			Currency: xrpl.XRPTokenCurrency,
+			Issuer:   xrpl.XRPTokenIssuer,
+		},
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.CHECK_CREATE,
+			Memos: rippledata.Memos{
+				memo,
+			},
+		},
+	}
+	require.NoError(t, chains.XRPL.AutoFillSignAndSubmitTx(ctx, t, &checkCreateTx, xrplSenderAddress))
+
+	// the amount is minted for the recipient once the bridge account cashes the check
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(
+			registeredXRPToken.CoreumDenom,
+			integrationtests.ConvertStringWithDecimalsToSDKInt(
+				t,
+				valueToSendFromXRPLtoCoreum.String(),
+				xrpl.XRPCurrencyDecimals,
+			)),
+	)
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+
+	// the cashed check is removed from the bridge account objects
+	accountObjects, err := chains.XRPL.RPCClient().AccountObjects(ctx, runnerEnv.BridgeXRPLAddress, "check", nil)
+	require.NoError(t, err)
+	require.Empty(t, accountObjects.AccountObjects)
+}
@@ -0,0 +1,110 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+func TestEmergencyDisableTokenWithInFlightOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+	t.Logf("XRPL recipient address: %s", xrplRecipientAddress)
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, 200)
+
+	tokenDecimals := uint32(6)
+	registeredCoreumOriginatedToken := runnerEnv.IssueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		coreumSenderAddress,
+		tokenDecimals,
+		sdkmath.NewIntWithDecimal(1, 16),
+		int32(6),
+		sdkmath.NewIntWithDecimal(1, 16),
+		sdkmath.ZeroInt(),
+	)
+
+	xrplCurrency, err := rippledata.NewCurrency(registeredCoreumOriginatedToken.XRPLCurrency)
+	require.NoError(t, err)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency)
+
+	amountToSendToXRPL := sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewIntWithDecimal(1, 6))
+	_, err = runnerEnv.ContractClient.SendToXRPL(
+		ctx, coreumSenderAddress, xrplRecipientAddress.String(), amountToSendToXRPL, nil,
+	)
+	require.NoError(t, err)
+
+	// disable the token while the operation is in-flight
+	tokenRef := bridgeclient.TokenRef{Denom: registeredCoreumOriginatedToken.Denom}
+	reason := "compromised issuer"
+	report, err := runnerEnv.BridgeClient.EmergencyDisableToken(
+		ctx, runnerEnv.ContractOwner, tokenRef, reason, envCfg.AwaitTimeout,
+	)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateDisabled, report.State)
+	require.Empty(t, report.RemainingOperationIDs)
+	t.Logf("Pending operations at the token disabling: %v", report.PendingOperationIDs)
+
+	// the reason is recorded in the memo
+	txRes, err := sdktxtypes.NewServiceClient(chains.Coreum.ClientContext).GetTx(
+		ctx, &sdktxtypes.GetTxRequest{Hash: report.TxHash},
+	)
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(txRes.Tx.Body.Memo, "reason: "+reason), txRes.Tx.Body.Memo)
+
+	// the in-flight operation is completed
+	xrplRecipientBalance := runnerEnv.Chains.XRPL.GetAccountBalance(
+		ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency,
+	)
+	require.Equal(t, "1", xrplRecipientBalance.Value.String())
+
+	// the new sends are rejected
+	_, err = runnerEnv.ContractClient.SendToXRPL(
+		ctx, coreumSenderAddress, xrplRecipientAddress.String(), amountToSendToXRPL, nil,
+	)
+	require.True(t, coreum.IsTokenNotEnabledError(err), err)
+
+	_, err = runnerEnv.BridgeClient.EmergencyEnableToken(ctx, runnerEnv.ContractOwner, tokenRef, "issuer is recovered")
+	require.NoError(t, err)
+
+	_, err = runnerEnv.ContractClient.SendToXRPL(
+		ctx, coreumSenderAddress, xrplRecipientAddress.String(), amountToSendToXRPL, nil,
+	)
+	require.NoError(t, err)
+	runnerEnv.AwaitState(ctx, t, func(t *testing.T) error {
+		xrplRecipientBalance = runnerEnv.Chains.XRPL.GetAccountBalance(
+			ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency,
+		)
+		if xrplRecipientBalance.Value.String() != "2" {
+			return errors.Errorf("unexpected XRPL recipient balance: %s", xrplRecipientBalance.Value.String())
+		}
+		return nil
+	})
+}
@@ -48,6 +48,10 @@ type RunnerEnvConfig struct {
 	CustomContractOwner         *sdk.AccAddress
 	// if custom error handler returns false, the runner env fails with the input error
 	CustomErrorHandler func(err error) bool
+	// TracingEndpoint is the OTLP HTTP endpoint the relayers export the traces to, empty disables the tracing.
+	TracingEndpoint string
+	// ModifyRunnerConfig modifies the config of each relayer runner if provided.
+	ModifyRunnerConfig func(cfg *runner.Config)
 }
 
 // DefaultRunnerEnvConfig returns default runner environment config.
@@ -69,6 +73,8 @@ func DefaultRunnerEnvConfig() RunnerEnvConfig {
 		CustomContractAddress:       nil,
 		CustomContractOwner:         nil,
 		CustomErrorHandler:          nil,
+		TracingEndpoint:             "",
+		ModifyRunnerConfig:          nil,
 	}
 }
 
@@ -121,8 +127,10 @@ func NewRunnerEnv(ctx context.Context, t *testing.T, cfg RunnerEnvConfig, chains
 		coreum.DefaultContractClientConfig(sdk.AccAddress(nil)),
 		chains.Log,
 		chains.Coreum.ClientContext,
+		nil,
+		nil,
 	)
-	xrplTxSigner := xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring())
+	xrplTxSigner := xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil)
 	bridgeClient := bridgeclient.NewBridgeClient(
 		chains.Log,
 		chains.Coreum.ClientContext,
@@ -157,7 +165,7 @@ func NewRunnerEnv(ctx context.Context, t *testing.T, cfg RunnerEnvConfig, chains
 
 	if cfg.CustomContractAddress == nil {
 		contractAddress, err := bridgeClient.Bootstrap(
-			ctx, contractOwner, bridgeXRPLAddress.String(), bootstrappingCfg,
+			ctx, contractOwner, bridgeXRPLAddress.String(), bootstrappingCfg, "",
 		)
 		require.NoError(t, err)
 		require.NoError(t, contractClient.SetContractAddress(contractAddress))
@@ -181,6 +189,8 @@ func NewRunnerEnv(ctx context.Context, t *testing.T, cfg RunnerEnvConfig, chains
 			relayerXRPLAddresses[i],
 			contractClient.GetContractAddress(),
 			relayerCoreumAddresses[i],
+			cfg.TracingEndpoint,
+			cfg.ModifyRunnerConfig,
 		)
 		runners = append(runners, rnr)
 		runnerComponents = append(runnerComponents, rnrComponents)
@@ -196,6 +206,8 @@ func NewRunnerEnv(ctx context.Context, t *testing.T, cfg RunnerEnvConfig, chains
 			maliciousXRPLAddress,
 			contractClient.GetContractAddress(),
 			relayerCoreumAddresses[i],
+			cfg.TracingEndpoint,
+			cfg.ModifyRunnerConfig,
 		)
 		runners = append(runners, rnr)
 		runnerComponents = append(runnerComponents, rnrComponents)
@@ -340,6 +352,8 @@ func (r *RunnerEnv) RegisterXRPLOriginatedToken(
 		sendingPrecision,
 		maxHoldingAmount,
 		bridgingFee,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	// await for the trust set
@@ -364,7 +378,7 @@ func (r *RunnerEnv) RegisterCoreumOriginatedToken(
 	bridgingFee sdkmath.Int,
 ) coreum.CoreumToken {
 	token, err := r.BridgeClient.RegisterCoreumToken(
-		ctx, r.ContractOwner, denom, decimals, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx, r.ContractOwner, denom, decimals, sendingPrecision, maxHoldingAmount, bridgingFee, nil,
 	)
 	require.NoError(t, err)
 	return token
@@ -527,6 +541,8 @@ func (r *RunnerEnv) UpdateCoreumToken(
 			sendingPrecision,
 			maxHoldingAmount,
 			bridgingFee,
+			nil,
+			nil,
 		))
 }
 
@@ -552,6 +568,8 @@ func (r *RunnerEnv) UpdateXRPLToken(
 			sendingPrecision,
 			maxHoldingAmount,
 			bridgingFee,
+			nil,
+			nil,
 		))
 }
 
@@ -606,6 +624,8 @@ func createDevRunner(
 	xrplRelayerAcc rippledata.Account,
 	contractAddress sdk.AccAddress,
 	relayerCoreumAddress sdk.AccAddress,
+	tracingEndpoint string,
+	modifyCfg func(cfg *runner.Config),
 ) (runner.Components, *runner.Runner) {
 	t.Helper()
 
@@ -649,6 +669,11 @@ func createDevRunner(
 	// make the collector faster
 	relayerRunnerCfg.Metrics.PeriodicCollector.RepeatDelay = 500 * time.Millisecond
 
+	relayerRunnerCfg.TracingEndpoint = tracingEndpoint
+	if modifyCfg != nil {
+		modifyCfg(&relayerRunnerCfg)
+	}
+
 	// re-init log to use correct `CallerSkip`
 	log, err := logger.NewZapLogger(logger.DefaultZapLoggerConfig())
 	require.NoError(t, err)
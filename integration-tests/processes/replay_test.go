@@ -0,0 +1,107 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestReplayBridgeHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumRecipient, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntFromUint64(1_000_000),
+	})
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+
+	xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 1)
+	runnerEnv.EnableXRPLAccountRippling(ctx, t, xrplIssuerAddress)
+	registeredXRPLCurrency := integrationtests.GenerateXRPLCurrency(t)
+	registeredXRPLToken := runnerEnv.RegisterXRPLOriginatedToken(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		registeredXRPLCurrency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+		sdkmath.ZeroInt(),
+	)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplRecipientAddress, xrplIssuerAddress, registeredXRPLCurrency)
+
+	// the scripted history: two deposits from the XRPL and two withdrawals back
+	totalSentToCoreum := sdkmath.ZeroInt()
+	for _, value := range []string{"10", "20"} {
+		xrplValue, err := rippledata.NewValue(value, false)
+		require.NoError(t, err)
+		runnerEnv.SendFromXRPLToCoreum(ctx, t, xrplIssuerAddress.String(), rippledata.Amount{
+			Value:    xrplValue,
+			Currency: registeredXRPLCurrency,
+			Issuer:   xrplIssuerAddress,
+		}, coreumRecipient)
+		totalSentToCoreum = totalSentToCoreum.Add(
+			integrationtests.ConvertStringWithDecimalsToSDKInt(t, value, xrpl.XRPLIssuedTokenDecimals),
+		)
+		runnerEnv.AwaitCoreumBalance(
+			ctx, t, coreumRecipient, sdk.NewCoin(registeredXRPLToken.CoreumDenom, totalSentToCoreum),
+		)
+	}
+	amountSentToXRPL := totalSentToCoreum.QuoRaw(2)
+	for i := 0; i < 2; i++ {
+		runnerEnv.SendFromCoreumToXRPL(
+			ctx,
+			t,
+			coreumRecipient,
+			xrplRecipientAddress,
+			sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToXRPL),
+			nil,
+		)
+	}
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+
+	transfers, err := runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{})
+	require.NoError(t, err)
+	require.Len(t, transfers, 4)
+
+	dir := t.TempDir()
+	cfg := bridgeclient.DefaultReplayConfig(1, transfers[0].Height, filepath.Join(dir, "report.jsonl"))
+	cfg.CoreumHeightsWindow = 10
+	cfg.CheckpointFilePath = filepath.Join(dir, "checkpoint.json")
+	summary, err := runnerEnv.BridgeClient.Replay(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, bridgeclient.ReplaySummary{
+		MatchedXRPLToCoreum: 2,
+		MatchedCoreumToXRPL: 2,
+		Tokens: []bridgeclient.ReplayTokenTotals{
+			{
+				Issuer:          xrplIssuerAddress.String(),
+				Currency:        registeredXRPLToken.Currency,
+				XRPLDeposits:    totalSentToCoreum,
+				CoreumMints:     totalSentToCoreum,
+				CoreumSends:     totalSentToCoreum,
+				XRPLWithdrawals: totalSentToCoreum,
+			},
+		},
+		Reconciled: true,
+	}, summary)
+	require.FileExists(t, cfg.ReportFilePath)
+}
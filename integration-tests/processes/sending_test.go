@@ -23,6 +23,7 @@ import (
 	"github.com/CoreumFoundation/coreum/v4/pkg/client"
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -98,16 +99,14 @@ func TestSendXRPLOriginatedTokensFromXRPLToCoreumAndBack(t *testing.T) {
 		t,
 		coreumSender,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	runnerEnv.SendFromCoreumToXRPL(
 		ctx,
 		t,
 		coreumSender,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
 
@@ -119,16 +118,14 @@ func TestSendXRPLOriginatedTokensFromXRPLToCoreumAndBack(t *testing.T) {
 		t,
 		coreumSender,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	runnerEnv.SendFromCoreumToXRPL(
 		ctx,
 		t,
 		coreumSender,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
 
@@ -149,6 +146,92 @@ func TestSendXRPLOriginatedTokensFromXRPLToCoreumAndBack(t *testing.T) {
 	}
 }
 
+func TestSendBatchFromCoreumToXRPLWithRejectedLeg(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	coreumSender := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSender, coreumintegration.BalancesOptions{
+		Amount: sdkmath.NewIntFromUint64(1_000_000),
+	})
+	t.Logf("Coreum sender: %s", coreumSender.String())
+	xrplRecipientWithTrustSet := chains.XRPL.GenAccount(ctx, t, 0)
+	t.Logf("XRPL recipient with trust set: %s", xrplRecipientWithTrustSet.String())
+	xrplRecipientWithoutTrustSet := chains.XRPL.GenAccount(ctx, t, 0)
+	t.Logf("XRPL recipient without trust set: %s", xrplRecipientWithoutTrustSet.String())
+
+	xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 1)
+	// enable to be able to send to any address
+	runnerEnv.EnableXRPLAccountRippling(ctx, t, xrplIssuerAddress)
+	registeredXRPLCurrency := integrationtests.GenerateXRPLCurrency(t)
+	registeredXRPLToken := runnerEnv.RegisterXRPLOriginatedToken(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		registeredXRPLCurrency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+		sdkmath.ZeroInt(),
+	)
+
+	valueSentToCoreum, err := rippledata.NewValue("1e10", false)
+	require.NoError(t, err)
+	runnerEnv.SendFromXRPLToCoreum(ctx, t, xrplIssuerAddress.String(), rippledata.Amount{
+		Value:    valueSentToCoreum,
+		Currency: registeredXRPLCurrency,
+		Issuer:   xrplIssuerAddress,
+	}, coreumSender)
+	amountSentToCoreum := integrationtests.ConvertStringWithDecimalsToSDKInt(
+		t, valueSentToCoreum.String(), xrpl.XRPLIssuedTokenDecimals,
+	)
+	runnerEnv.AwaitCoreumBalance(
+		ctx, t, coreumSender, sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToCoreum),
+	)
+
+	// only one recipient sets the trust line, so the leg of the second recipient is rejected
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplRecipientWithTrustSet, xrplIssuerAddress, registeredXRPLCurrency)
+
+	legs := []bridgeclient.SendLeg{
+		{
+			Recipient: xrplRecipientWithTrustSet,
+			Coin:      sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToCoreum.QuoRaw(4)),
+		},
+		{
+			Recipient: xrplRecipientWithoutTrustSet,
+			Coin:      sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToCoreum.QuoRaw(2)),
+		},
+	}
+	report, err := runnerEnv.BridgeClient.SendBatchToXRPL(ctx, coreumSender, legs)
+	require.NoError(t, err)
+	require.Len(t, report.Legs, len(legs))
+	require.False(t, report.IsFinal())
+
+	report, err = runnerEnv.BridgeClient.WaitForBatch(ctx, report, time.Minute)
+	require.NoError(t, err)
+	require.True(t, report.IsFinal())
+	require.Equal(t, bridgeclient.BatchLegStatusAccepted, report.Legs[0].Status)
+	require.Empty(t, report.Legs[0].RefundID)
+	require.Equal(t, bridgeclient.BatchLegStatusRejected, report.Legs[1].Status)
+
+	// the refund of the rejected leg is attributed to it
+	pendingRefunds, err := runnerEnv.BridgeClient.GetPendingRefunds(ctx, coreumSender)
+	require.NoError(t, err)
+	require.Len(t, pendingRefunds, 1)
+	require.Equal(t, pendingRefunds[0].ID, report.Legs[1].RefundID)
+	require.Equal(t, legs[1].Coin, pendingRefunds[0].Coin)
+
+	xrplRecipientBalance := runnerEnv.Chains.XRPL.GetAccountBalance(
+		ctx, t, xrplRecipientWithTrustSet, xrplIssuerAddress, registeredXRPLCurrency,
+	)
+	require.Equal(t, "2500000000", xrplRecipientBalance.Value.String())
+}
+
 func TestSendXRPLOriginatedTokenWithTransferRateAndDeliverAmountFromXRPLToCoreumAndBack(t *testing.T) {
 	t.Parallel()
 
@@ -625,8 +708,7 @@ func TestSendXRPLOriginatedTokenFromXRPLToCoreumWithMaliciousRelayer(t *testing.
 		t,
 		coreumSender,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
 
@@ -680,6 +762,8 @@ func TestSendXRPLOriginatedTokensFromXRPLToCoreumWithDifferentAmountAndPartialAm
 		sendingPrecision,
 		maxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -692,6 +776,8 @@ func TestSendXRPLOriginatedTokensFromXRPLToCoreumWithDifferentAmountAndPartialAm
 		sendingPrecision,
 		maxHoldingAmount,
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -899,6 +985,66 @@ func TestSendXRPLOriginatedTokenFromXRPLToCoreumWithTooLowAmount(t *testing.T) {
 	)
 }
 
+func TestSendXRPLOriginatedTokenFromXRPLToCoreumWithMemoRecipient(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 100)
+	t.Logf("XRPL currency issuer address: %s", xrplIssuerAddress)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	t.Logf("Coreum recipient: %s", coreumRecipient.String())
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, 200)
+
+	registeredXRPLCurrency := integrationtests.GenerateXRPLCurrency(t)
+	registeredXRPLToken := runnerEnv.RegisterXRPLOriginatedToken(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		registeredXRPLCurrency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 16),
+		sdkmath.ZeroInt(),
+	)
+
+	value, err := rippledata.NewValue("10", false)
+	require.NoError(t, err)
+	amount := rippledata.Amount{
+		Value:    value,
+		Currency: registeredXRPLCurrency,
+		Issuer:   xrplIssuerAddress,
+	}
+
+	// the recipient is embedded to the memo data as the plain bech32 address
+	runnerEnv.SendXRPLPaymentTx(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		runnerEnv.BridgeXRPLAddress,
+		amount,
+		rippledata.Memo{
+			Memo: rippledata.MemoItem{
+				MemoData: rippledata.VariableLength(coreumRecipient.String()),
+			},
+		},
+	)
+
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(
+			registeredXRPLToken.CoreumDenom,
+			integrationtests.ConvertStringWithDecimalsToSDKInt(t, "10", xrpl.XRPLIssuedTokenDecimals),
+		),
+	)
+}
+
 func TestSendXRPLOriginatedTokensFromXRPLToCoreumWithAmountGreaterThanMax(t *testing.T) {
 	t.Parallel()
 
@@ -1077,16 +1223,14 @@ func TestSendXRPLOriginatedTokenFromXRPLToCoreumAndBackWithTokenDisabling(t *tes
 		ctx,
 		coreumSender,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	require.NoError(t, err)
 	_, err = runnerEnv.ContractClient.SendToXRPL(
 		ctx,
 		coreumSender,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend),
-		nil,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountToSend), nil,
 	)
 	require.NoError(t, err)
 
@@ -1111,6 +1255,97 @@ func TestSendXRPLOriginatedTokenFromXRPLToCoreumAndBackWithTokenDisabling(t *tes
 	require.Equal(t, "10000000000", xrplRecipientBalance.Value.String())
 }
 
+func TestSendXRPLOriginatedTokenFromXRPLToCoreumWithTokenStateChangeReport(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	t.Logf("Coreum recipient: %s", coreumRecipient.String())
+
+	xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 1)
+	registeredXRPLCurrency := integrationtests.GenerateXRPLCurrency(t)
+	registeredXRPLToken := runnerEnv.RegisterXRPLOriginatedToken(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		registeredXRPLCurrency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+		sdkmath.ZeroInt(),
+	)
+	issuer := xrplIssuerAddress.String()
+	currency := xrpl.ConvertCurrencyToString(registeredXRPLCurrency)
+
+	report, err := runnerEnv.BridgeClient.GetTokenStateChangeReport(ctx, "", issuer, currency, 1000)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateEnabled, report.State)
+	require.Equal(t, registeredXRPLToken.CoreumDenom, report.CoreumDenom)
+	require.True(t, report.CoreumSupply.IsZero())
+	require.Empty(t, report.PendingOperationIDs)
+	require.NoError(t, bridgeclient.ValidateTokenStateTransition(report.State, coreum.TokenStateDisabled))
+
+	runnerEnv.UpdateXRPLToken(
+		ctx, t, runnerEnv.ContractOwner, issuer, currency, lo.ToPtr(coreum.TokenStateDisabled), nil, nil, nil,
+	)
+
+	report, err = runnerEnv.BridgeClient.GetTokenStateChangeReport(ctx, "", issuer, currency, 1000)
+	require.NoError(t, err)
+	require.True(t, coreum.IsInvalidTargetTokenStateError(
+		bridgeclient.ValidateTokenStateTransition(report.State, coreum.TokenStateProcessing),
+	))
+	require.Error(t, bridgeclient.ValidateTokenStateTransition(report.State, coreum.TokenStateDisabled))
+
+	valueToSendFromXRPLtoCoreum, err := rippledata.NewValue("1e10", false)
+	require.NoError(t, err)
+	amountToSendFromXRPLtoCoreum := rippledata.Amount{
+		Value:    valueToSendFromXRPLtoCoreum,
+		Currency: registeredXRPLCurrency,
+		Issuer:   xrplIssuerAddress,
+	}
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreumRecipient)
+	require.NoError(t, err)
+	runnerEnv.SendXRPLPaymentTx(ctx, t, xrplIssuerAddress, runnerEnv.BridgeXRPLAddress, amountToSendFromXRPLtoCoreum, memo)
+
+	// give the relayers time to find the tx and try to relay the evidence with the disabled token
+	select {
+	case <-ctx.Done():
+		require.NoError(t, ctx.Err())
+	case <-time.After(5 * time.Second):
+	}
+	recipientBalance, err := runnerEnv.BridgeClient.GetCoreumBalances(ctx, coreumRecipient)
+	require.NoError(t, err)
+	require.True(t, recipientBalance.AmountOf(registeredXRPLToken.CoreumDenom).IsZero())
+
+	require.NoError(t, bridgeclient.ValidateTokenStateTransition(report.State, coreum.TokenStateEnabled))
+	runnerEnv.UpdateXRPLToken(
+		ctx, t, runnerEnv.ContractOwner, issuer, currency, lo.ToPtr(coreum.TokenStateEnabled), nil, nil, nil,
+	)
+
+	expectedAmount := integrationtests.ConvertStringWithDecimalsToSDKInt(
+		t,
+		valueToSendFromXRPLtoCoreum.String(),
+		xrpl.XRPLIssuedTokenDecimals,
+	)
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, expectedAmount),
+	)
+
+	report, err = runnerEnv.BridgeClient.GetTokenStateChangeReport(ctx, "", issuer, currency, 1000)
+	require.NoError(t, err)
+	require.Equal(t, expectedAmount.String(), report.CoreumSupply.Amount.String())
+	require.Equal(t, 1, report.RecentInboundTransfers)
+	require.Equal(t, expectedAmount.String(), report.RecentInboundVolume.Amount.String())
+}
+
 func TestSendXRPLOriginatedTokenViaCrossCurrencyPayment(t *testing.T) {
 	t.Parallel()
 
@@ -1441,8 +1676,7 @@ func TestSendCoreumOriginatedTokenFromCoreumToXRPLAndBackWithDifferentAmountsAnd
 		t,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL1),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL1), nil,
 	)
 
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -1460,8 +1694,7 @@ func TestSendCoreumOriginatedTokenFromCoreumToXRPLAndBackWithDifferentAmountsAnd
 		t,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL2),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL2), nil,
 	)
 
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -1595,8 +1828,7 @@ func TestSendCoreumOriginatedTokenFromCoreumToXRPLAndBackWithMaliciousRelayer(t
 		t,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL), nil,
 	)
 
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -1667,8 +1899,7 @@ func TestSendCoreumOriginatedTokenFromCoreumToXRPLAndBackWithTokenDisabling(t *t
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress.String(),
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL), nil,
 	)
 	require.NoError(t, err)
 
@@ -1836,8 +2067,7 @@ func TestSendCoreumOriginatedTokenWithBurningRateAndSendingCommissionFromCoreumT
 		t,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL), nil,
 	)
 
 	// contract balance holds the token now
@@ -1931,8 +2161,7 @@ func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
 		t,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL), nil,
 	)
 
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -1957,8 +2186,7 @@ func TestSendFromCoreumToXRPLProhibitedAddresses(t *testing.T) {
 		ctx,
 		coreumSenderAddress,
 		xrplRecipientAddress,
-		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL),
-		nil,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, amountToSendToXRPL), nil,
 	)
 	require.True(t, coreum.IsProhibitedAddressError(err), err)
 }
@@ -4,16 +4,30 @@
 package processes_test
 
 import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/stretchr/testify/require"
+	coltracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
 
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/tracing"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
@@ -78,6 +92,99 @@ func TestTraceXRPLToCoreumTransfer(t *testing.T) {
 	require.Len(t, tracingInfo.EvidenceToTxs, 2)
 }
 
+func TestOTelTraceXRPLToCoreumTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	// the OTLP HTTP receiver, the same protocol is accepted by the Jaeger collector
+	var (
+		mu            sync.Mutex
+		resourceSpans []*tracev1.ResourceSpans
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req coltracev1.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		mu.Lock()
+		resourceSpans = append(resourceSpans, req.GetResourceSpans()...)
+		mu.Unlock()
+
+		resBody, err := proto.Marshal(&coltracev1.ExportTraceServiceResponse{})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, err = w.Write(resBody)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(collector.Close)
+
+	envCfg := DefaultRunnerEnvConfig()
+	envCfg.TracingEndpoint = collector.URL
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	// XRP to send and cover fees
+	xrplSenderAddress := chains.XRPL.GenAccount(ctx, t, 1.1)
+
+	registeredXRPToken, err := runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrpl.XRPTokenIssuer.String(), xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+	)
+	require.NoError(t, err)
+	valueSentToCoreum, err := rippledata.NewValue("1", true)
+	require.NoError(t, err)
+	txHash, err := runnerEnv.BridgeClient.SendFromXRPLToCoreum(ctx, xrplSenderAddress.String(), rippledata.Amount{
+		Value:    valueSentToCoreum,
+		Currency: xrpl.XRPTokenCurrency,
+		Issuer:   xrpl.XRPTokenIssuer,
+	}, coreumRecipient)
+	require.NoError(t, err)
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(
+			registeredXRPToken.CoreumDenom,
+			integrationtests.ConvertStringWithDecimalsToSDKInt(t, valueSentToCoreum.String(), xrpl.XRPCurrencyDecimals),
+		),
+	)
+
+	// the relayer spans of the transfer share the trace started by the scanner
+	expectedChildSpans := []string{
+		tracing.SpanBuildEvidence,
+		tracing.SpanCoreumSaveEvidence,
+		tracing.SpanCoreumBroadcastTx,
+	}
+	serviceName := runner.DefaultConfig().TracingServiceName
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, rs := range resourceSpans {
+			if getOTelAttributeValue(rs.GetResource().GetAttributes(), "service.name") != serviceName {
+				continue
+			}
+			for _, ss := range rs.GetScopeSpans() {
+				for _, rootSpan := range ss.GetSpans() {
+					rootTxHash := getOTelAttributeValue(rootSpan.GetAttributes(), tracing.AttributeXRPLTxHash)
+					if rootSpan.GetName() != tracing.SpanXRPLScanPayment || !strings.EqualFold(rootTxHash, txHash) {
+						continue
+					}
+					if containsOTelChildSpans(resourceSpans, rootSpan, expectedChildSpans) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}, time.Minute, time.Second)
+}
+
 func TestTraceCoreumToXRPLTransfer(t *testing.T) {
 	t.Parallel()
 
@@ -158,3 +265,174 @@ func TestTraceCoreumToXRPLTransfer(t *testing.T) {
 	require.Len(t, tracingInfo.XRPLTxs, 2)
 	require.Len(t, tracingInfo.EvidenceToTxs, 2)
 }
+
+func TestTransferHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	coreumRecipient := chains.Coreum.GenAccount()
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	// register XRPL originated token with the bridging fee
+	xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 1)
+	runnerEnv.EnableXRPLAccountRippling(ctx, t, xrplIssuerAddress)
+	registeredXRPLCurrency := integrationtests.GenerateXRPLCurrency(t)
+	xrplTokenBridgingFee := sdkmath.NewIntWithDecimal(1, 13)
+	registeredXRPLToken := runnerEnv.RegisterXRPLOriginatedToken(
+		ctx,
+		t,
+		xrplIssuerAddress,
+		registeredXRPLCurrency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+		xrplTokenBridgingFee,
+	)
+
+	// issue and register Coreum originated token
+	registeredCoreumOriginatedToken := runnerEnv.IssueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		coreumSenderAddress,
+		uint32(4),
+		sdkmath.NewIntWithDecimal(1, 16),
+		int32(2),
+		sdkmath.NewIntWithDecimal(1, 16),
+		sdkmath.ZeroInt(),
+	)
+	xrplCurrency, err := rippledata.NewCurrency(registeredCoreumOriginatedToken.XRPLCurrency)
+	require.NoError(t, err)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency)
+
+	// send XRPL to Coreum
+	valueSentToCoreum, err := rippledata.NewValue("1.0", false)
+	require.NoError(t, err)
+	xrplTxHash, err := runnerEnv.BridgeClient.SendFromXRPLToCoreum(
+		ctx,
+		xrplIssuerAddress.String(),
+		rippledata.Amount{
+			Value:    valueSentToCoreum,
+			Currency: registeredXRPLCurrency,
+			Issuer:   xrplIssuerAddress,
+		},
+		coreumRecipient,
+	)
+	require.NoError(t, err)
+	amountSentToCoreum := integrationtests.ConvertStringWithDecimalsToSDKInt(
+		t, valueSentToCoreum.String(), xrpl.XRPLIssuedTokenDecimals,
+	)
+	runnerEnv.AwaitCoreumBalance(
+		ctx,
+		t,
+		coreumRecipient,
+		sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToCoreum.Sub(xrplTokenBridgingFee)),
+	)
+
+	// send Coreum to XRPL
+	amountSentToXRPL := sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewInt(111111))
+	runnerEnv.SendFromCoreumToXRPL(ctx, t, coreumSenderAddress, xrplRecipientAddress, amountSentToXRPL, nil)
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+
+	// filter by direction and denom
+	xrplToCoreumTransfers, err := runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+		Direction:  bridgeclient.TransferDirectionXRPLToCoreum,
+		TokenDenom: registeredXRPLToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Len(t, xrplToCoreumTransfers, 1)
+	xrplToCoreumTransfer := xrplToCoreumTransfers[0]
+	require.Equal(t, bridgeclient.TransferStatusCompleted, xrplToCoreumTransfer.Status)
+	require.Equal(t, []string{xrplTxHash}, xrplToCoreumTransfer.XRPLTxHashes)
+	require.Equal(t, coreumRecipient.String(), xrplToCoreumTransfer.Recipient)
+	require.Equal(t, sdk.NewCoin(registeredXRPLToken.CoreumDenom, amountSentToCoreum), xrplToCoreumTransfer.Amount)
+	require.Equal(t, sdk.NewCoin(registeredXRPLToken.CoreumDenom, xrplTokenBridgingFee), xrplToCoreumTransfer.Fee)
+	require.False(t, xrplToCoreumTransfer.Timestamp.IsZero())
+
+	coreumToXRPLTransfers, err := runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+		Direction:        bridgeclient.TransferDirectionCoreumToXRPL,
+		TokenDenom:       registeredCoreumOriginatedToken.Denom,
+		RecipientAddress: xrplRecipientAddress.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, coreumToXRPLTransfers, 1)
+	coreumToXRPLTransfer := coreumToXRPLTransfers[0]
+	require.Equal(t, bridgeclient.TransferStatusCompleted, coreumToXRPLTransfer.Status)
+	require.Len(t, coreumToXRPLTransfer.XRPLTxHashes, 1)
+	require.Equal(t, coreumSenderAddress.String(), coreumToXRPLTransfer.Sender)
+	require.Equal(t, amountSentToXRPL, coreumToXRPLTransfer.Amount)
+	require.True(t, coreumToXRPLTransfer.Fee.IsZero())
+
+	// the denom doesn't match the direction
+	transfers, err := runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+		Direction:  bridgeclient.TransferDirectionCoreumToXRPL,
+		TokenDenom: registeredXRPLToken.CoreumDenom,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+
+	// no filter, the latest transfers first
+	transfers, err = runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []bridgeclient.TransferEvent{coreumToXRPLTransfer, xrplToCoreumTransfer}, transfers)
+
+	// filter by height
+	transfers, err = runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+		StartHeight: xrplToCoreumTransfer.Height,
+		EndHeight:   xrplToCoreumTransfer.Height,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []bridgeclient.TransferEvent{xrplToCoreumTransfer}, transfers)
+	transfers, err = runnerEnv.BridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+		StartHeight: coreumToXRPLTransfer.Height + 1,
+	})
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+}
+
+// containsOTelChildSpans checks that the spans with the names are exported as the children of the root span, and the
+// broadcast span has the Coreum tx hash.
+func containsOTelChildSpans(resourceSpans []*tracev1.ResourceSpans, rootSpan *tracev1.Span, names []string) bool {
+	found := make(map[string]struct{})
+	for _, rs := range resourceSpans {
+		for _, ss := range rs.GetScopeSpans() {
+			for _, span := range ss.GetSpans() {
+				if !bytes.Equal(span.GetTraceId(), rootSpan.GetTraceId()) {
+					continue
+				}
+				if span.GetName() == tracing.SpanCoreumBroadcastTx &&
+					getOTelAttributeValue(span.GetAttributes(), tracing.AttributeCoreumTxHash) == "" {
+					continue
+				}
+				found[span.GetName()] = struct{}{}
+			}
+		}
+	}
+	for _, name := range names {
+		if _, ok := found[name]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func getOTelAttributeValue(attributes []*commonv1.KeyValue, key string) string {
+	for _, attr := range attributes {
+		if attr.GetKey() == key {
+			return attr.GetValue().GetStringValue()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,123 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+)
+
+func TestTicketsAllocationWithTimedOutSignatureAggregation(t *testing.T) {
+	t.Parallel()
+
+	numberOfTicketsToAllocate := uint32(200)
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	runnerEnv := NewRunnerEnv(ctx, t, DefaultRunnerEnvConfig(), chains)
+	chains.XRPL.FundAccountForTicketAllocation(ctx, t, runnerEnv.BridgeXRPLAddress, numberOfTicketsToAllocate)
+
+	// only one of the relayers is online, so the signing threshold isn't reached
+	runnerEnv.RunnersParallelGroup.Spawn("runner-0", parallel.Exit, runnerEnv.Runners[0].Start)
+
+	bridgeXRPLAccountInfo, err := chains.XRPL.RPCClient().AccountInfo(ctx, runnerEnv.BridgeXRPLAddress)
+	require.NoError(t, err)
+	_, err = runnerEnv.ContractClient.RecoverTickets(
+		ctx,
+		runnerEnv.ContractOwner,
+		*bridgeXRPLAccountInfo.AccountData.Sequence,
+		&numberOfTicketsToAllocate,
+	)
+	require.NoError(t, err)
+
+	var pendingOperations []coreum.Operation
+	runnerEnv.AwaitState(ctx, t, func(t *testing.T) error {
+		pendingOperations, err = runnerEnv.ContractClient.GetPendingOperations(ctx)
+		require.NoError(t, err)
+		if len(pendingOperations) != 1 || len(pendingOperations[0].Signatures) != 1 {
+			return errors.Errorf("the ticket allocation operation isn't signed by the online relayer")
+		}
+		return nil
+	})
+
+	// the online relayers abandon the operation once its signature aggregation is timed out
+	now := time.Now()
+	clock := func() time.Time {
+		return now
+	}
+	signatureTrackers := make([]*processes.SignatureAggregationTracker, 0)
+	for i := 0; i < int(runnerEnv.Cfg.SigningThreshold); i++ {
+		signatureTracker, err := processes.NewSignatureAggregationTracker(
+			processes.SignatureAggregationTrackerConfig{
+				Timeout: time.Hour,
+			},
+			chains.Log,
+			clock,
+		)
+		require.NoError(t, err)
+		_, err = signatureTracker.Track(ctx, pendingOperations)
+		require.NoError(t, err)
+		signatureTrackers = append(signatureTrackers, signatureTracker)
+	}
+	now = now.Add(2 * time.Hour)
+
+	for i, signatureTracker := range signatureTrackers {
+		relayerCoreumAddress, err := sdk.AccAddressFromBech32(runnerEnv.BootstrappingConfig.Relayers[i].CoreumAddress)
+		require.NoError(t, err)
+		process, err := processes.NewCoreumToXRPLProcess(
+			processes.CoreumToXRPLProcessConfig{
+				BridgeXRPLAddress:    runnerEnv.BridgeXRPLAddress,
+				RelayerCoreumAddress: relayerCoreumAddress,
+				XRPLTxSignerKeyName:  runner.DefaultConfig().XRPL.MultiSignerKeyName,
+				RepeatRecentScan:     false,
+			},
+			chains.Log,
+			runnerEnv.ContractClient,
+			chains.XRPL.RPCClient(),
+			runnerEnv.RunnerComponents[i].XRPLKeyringTxSigner,
+			runnerEnv.RunnerComponents[i].MetricsRegistry,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			signatureTracker,
+			nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, process.Start(ctx), fmt.Sprintf("relayer %d", i))
+	}
+
+	// the operation is cleared without the tickets allocation
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+	availableTickets, err := runnerEnv.ContractClient.GetAvailableTickets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, availableTickets)
+
+	// the tickets are allocated once the offline relayers are back
+	for i := 1; i < len(runnerEnv.Runners); i++ {
+		runnerEnv.RunnersParallelGroup.Spawn(fmt.Sprintf("runner-%d", i), parallel.Exit, runnerEnv.Runners[i].Start)
+	}
+	_, err = runnerEnv.ContractClient.RecoverTickets(
+		ctx,
+		runnerEnv.ContractOwner,
+		*bridgeXRPLAccountInfo.AccountData.Sequence,
+		&numberOfTicketsToAllocate,
+	)
+	require.NoError(t, err)
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+	availableTickets, err = runnerEnv.ContractClient.GetAvailableTickets(ctx)
+	require.NoError(t, err)
+	require.Len(t, availableTickets, int(numberOfTicketsToAllocate))
+}
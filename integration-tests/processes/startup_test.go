@@ -0,0 +1,54 @@
+//go:build integrationtests
+// +build integrationtests
+
+package processes_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+)
+
+func TestRelayerStartupFailsFastWithUnreachableXRPLRPC(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	envCfg.RelayersCount = 1
+	envCfg.SigningThreshold = 1
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+
+	relayerCoreumAddress, err := sdk.AccAddressFromBech32(runnerEnv.BootstrappingConfig.Relayers[0].CoreumAddress)
+	require.NoError(t, err)
+	relayerXRPLAddress, err := rippledata.NewAccountFromAddress(runnerEnv.BootstrappingConfig.Relayers[0].XRPLAddress)
+	require.NoError(t, err)
+
+	const startupTimeoutSeconds = 3
+	_, relayerRunner := createDevRunner(
+		ctx,
+		t,
+		chains,
+		*relayerXRPLAddress,
+		runnerEnv.ContractClient.GetContractAddress(),
+		relayerCoreumAddress,
+		"",
+		func(cfg *runner.Config) {
+			// nothing listens on the port
+			cfg.XRPL.RPC.URL = "http://127.0.0.1:1"
+			cfg.Processes.StartupTimeoutSeconds = startupTimeoutSeconds
+		},
+	)
+
+	startTime := time.Now()
+	err = relayerRunner.Start(ctx)
+	require.ErrorContains(t, err, "XRPL chain is not ready")
+	// the runner exits right after the startup timeout instead of retrying the XRPL processes
+	require.Less(t, time.Since(startTime), startupTimeoutSeconds*time.Second+5*time.Second)
+}
@@ -4,16 +4,24 @@
 package processes_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum/v4/pkg/client"
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
+	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -46,7 +54,11 @@ func TestRecoverXRPLOriginatedTokenRegistrationAndSendFromXRPLToCoreumAndBack(t
 	// gen account but don't fund it to let the tx to fail since the account won't exist on the XRPL side
 	xrplIssuerAddress := chains.XRPL.GenEmptyAccount(t)
 
-	_, err := runnerEnv.ContractClient.RegisterXRPLToken(
+	// the pre-flight check rejects the issuer which doesn't exist on the XRPL
+	err := runnerEnv.BridgeClient.CheckXRPLIssuerAccount(ctx, xrplIssuerAddress)
+	require.ErrorIs(t, err, bridgeclient.ErrXRPLIssuerAccountNotActivated)
+
+	_, err = runnerEnv.ContractClient.RegisterXRPLToken(
 		ctx,
 		runnerEnv.ContractOwner,
 		xrplIssuerAddress.String(),
@@ -54,6 +66,8 @@ func TestRecoverXRPLOriginatedTokenRegistrationAndSendFromXRPLToCoreumAndBack(t
 		int32(6),
 		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -65,6 +79,7 @@ func TestRecoverXRPLOriginatedTokenRegistrationAndSendFromXRPLToCoreumAndBack(t
 
 	// create the account on the XRPL and send some XRP on top to cover fees
 	runnerEnv.Chains.XRPL.CreateAccount(ctx, t, xrplIssuerAddress, 1)
+	require.NoError(t, runnerEnv.BridgeClient.CheckXRPLIssuerAccount(ctx, xrplIssuerAddress))
 	// recover from owner
 	_, err = runnerEnv.ContractClient.RecoverXRPLTokenRegistration(
 		ctx, runnerEnv.ContractOwner, xrplIssuerAddress.String(), xrpl.ConvertCurrencyToString(registeredXRPLCurrency),
@@ -133,6 +148,65 @@ func TestRecoverXRPLOriginatedTokenRegistrationAndSendFromXRPLToCoreumAndBack(t
 	require.Equal(t, valueToSendFromXRPLtoCoreum.String(), xrplRecipientBalance.Value.String())
 }
 
+func TestRecoverRejectedXRPLOriginatedTokenRegistrationWithBridgeClient(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	runnerEnv.Chains.Coreum.FundAccountWithOptions(ctx, t, runnerEnv.ContractOwner, coreumintegration.BalancesOptions{
+		Amount: runnerEnv.Chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee.Amount,
+	})
+
+	// the issuer isn't funded, so the TrustSet transaction is rejected
+	xrplIssuerAddress := chains.XRPL.GenEmptyAccount(t)
+	currency := xrpl.ConvertCurrencyToString(integrationtests.GenerateXRPLCurrency(t))
+	_, err := runnerEnv.ContractClient.RegisterXRPLToken(
+		ctx,
+		runnerEnv.ContractOwner,
+		xrplIssuerAddress.String(),
+		currency,
+		int32(6),
+		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+		sdkmath.ZeroInt(),
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+	registeredXRPLToken, err := runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrplIssuerAddress.String(), currency,
+	)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateInactive, registeredXRPLToken.State)
+
+	// the inactive token isn't stuck in the processing
+	stuckTokens, err := runnerEnv.BridgeClient.ListStuckTokenRegistrations(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, stuckTokens)
+
+	// recover the registration the same way the recover-token-registration command does
+	runnerEnv.Chains.XRPL.CreateAccount(ctx, t, xrplIssuerAddress, 1)
+	require.NoError(t, runnerEnv.BridgeClient.RecoverStuckXRPLTokenRegistration(
+		ctx, runnerEnv.ContractOwner, xrplIssuerAddress.String(), currency, time.Hour,
+	))
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+	registeredXRPLToken, err = runnerEnv.ContractClient.GetXRPLTokenByIssuerAndCurrency(
+		ctx, xrplIssuerAddress.String(), currency,
+	)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateEnabled, registeredXRPLToken.State)
+
+	// the enabled token registration can't be recovered
+	err = runnerEnv.BridgeClient.RecoverStuckXRPLTokenRegistration(
+		ctx, runnerEnv.ContractOwner, xrplIssuerAddress.String(), currency, time.Hour,
+	)
+	require.True(t, coreum.IsXRPLTokenNotInactiveError(err), err)
+}
 func TestXRPLOriginatedTokenRegistrationForAccountWithDisallowIncomingTrustLine(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +240,8 @@ func TestXRPLOriginatedTokenRegistrationForAccountWithDisallowIncomingTrustLine(
 		int32(6),
 		integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
 		sdkmath.ZeroInt(),
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	runnerEnv.AwaitNoPendingOperations(ctx, t)
@@ -176,3 +252,145 @@ func TestXRPLOriginatedTokenRegistrationForAccountWithDisallowIncomingTrustLine(
 	require.NoError(t, err)
 	require.Equal(t, coreum.TokenStateInactive, registeredXRPLToken.State)
 }
+
+func TestCoreumOriginatedTokenRegistrationWithDecimalsFromDenomMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+
+	issuerAddress := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, issuerAddress, coreumintegration.BalancesOptions{
+		Amount: chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+
+	// the asset FT module stores the bank denom metadata, the same way as it's stored for the IBC denoms
+	tokenDecimals := uint32(9)
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuerAddress.String(),
+		Symbol:        "symbol" + uuid.NewString()[:4],
+		Subunit:       "subunit" + uuid.NewString()[:4],
+		Precision:     tokenDecimals,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chains.Coreum.ClientContext.WithFromAddress(issuerAddress),
+		chains.Coreum.TxFactory().WithSimulateAndExecute(true),
+		issueMsg,
+	)
+	require.NoError(t, err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuerAddress)
+
+	require.NoError(t, runnerEnv.BridgeClient.ValidateCoreumDenomSupply(ctx, denom))
+	decimals, err := runnerEnv.BridgeClient.GetCoreumDenomDecimals(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, tokenDecimals, decimals)
+
+	registeredToken := runnerEnv.RegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		denom,
+		decimals,
+		int32(6),
+		sdkmath.NewIntWithDecimal(1, 10),
+		sdkmath.ZeroInt(),
+	)
+	require.Equal(t, tokenDecimals, registeredToken.Decimals)
+	require.NotEmpty(t, registeredToken.XRPLCurrency)
+
+	storedToken, err := runnerEnv.ContractClient.GetCoreumTokenByDenom(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, tokenDecimals, storedToken.Decimals)
+	require.Equal(t, registeredToken.XRPLCurrency, storedToken.XRPLCurrency)
+
+	// unknown denom has neither supply nor metadata
+	ibcDenomHash := sha256.Sum256([]byte(uuid.NewString()))
+	unknownDenom := "ibc/" + strings.ToUpper(hex.EncodeToString(ibcDenomHash[:]))
+	require.ErrorContains(
+		t, runnerEnv.BridgeClient.ValidateCoreumDenomSupply(ctx, unknownDenom), "doesn't exist or has zero supply",
+	)
+	_, err = runnerEnv.BridgeClient.GetCoreumDenomDecimals(ctx, unknownDenom)
+	require.ErrorContains(t, err, "has neither metadata nor asset FT token")
+}
+
+func TestGetTokenPairs(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	envCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, envCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, uint32(200))
+
+	registeredXRPLTokens := make([]coreum.XRPLToken, 0, 2)
+	for i := 0; i < 2; i++ {
+		xrplIssuerAddress := chains.XRPL.GenAccount(ctx, t, 1)
+		runnerEnv.EnableXRPLAccountRippling(ctx, t, xrplIssuerAddress)
+		registeredXRPLTokens = append(registeredXRPLTokens, runnerEnv.RegisterXRPLOriginatedToken(
+			ctx,
+			t,
+			xrplIssuerAddress,
+			integrationtests.GenerateXRPLCurrency(t),
+			int32(6),
+			integrationtests.ConvertStringWithDecimalsToSDKInt(t, "1", 30),
+			sdkmath.NewInt(10),
+		))
+	}
+
+	coreumTokenIssuer := chains.Coreum.GenAccount()
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumTokenIssuer, coreumintegration.BalancesOptions{
+		Amount: chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee.Amount.MulRaw(2).Add(sdkmath.NewIntWithDecimal(1, 7)),
+	})
+	registeredCoreumTokens := make([]coreum.CoreumToken, 0, 2)
+	for i := 0; i < 2; i++ {
+		registeredCoreumTokens = append(registeredCoreumTokens, runnerEnv.IssueAndRegisterCoreumOriginatedToken(
+			ctx,
+			t,
+			coreumTokenIssuer,
+			6,
+			sdkmath.NewIntWithDecimal(1, 10),
+			int32(5),
+			sdkmath.NewIntWithDecimal(1, 10),
+			sdkmath.ZeroInt(),
+		))
+	}
+
+	tokenPairs, err := runnerEnv.BridgeClient.GetTokenPairs(ctx)
+	require.NoError(t, err)
+	// the XRP token is registered by the contract instantiation
+	tokenPairs = lo.Filter(tokenPairs, func(tokenPair bridgeclient.TokenPair, _ int) bool {
+		return tokenPair.XRPLIssuer != xrpl.XRPTokenIssuer.String()
+	})
+	require.Len(t, tokenPairs, 4)
+
+	contractCfg, err := runnerEnv.ContractClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+	for i, token := range registeredXRPLTokens {
+		require.Equal(t, bridgeclient.TokenPair{
+			Type:             bridgeclient.TokenPairTypeXRPL,
+			XRPLIssuer:       token.Issuer,
+			XRPLCurrency:     token.Currency,
+			CoreumDenom:      token.CoreumDenom,
+			SendingPrecision: token.SendingPrecision,
+			MaxHoldingAmount: token.MaxHoldingAmount,
+			BridgingFee:      token.BridgingFee,
+			State:            coreum.TokenStateEnabled,
+		}, tokenPairs[i])
+	}
+	for i, token := range registeredCoreumTokens {
+		require.Equal(t, bridgeclient.TokenPair{
+			Type:             bridgeclient.TokenPairTypeCoreum,
+			XRPLIssuer:       contractCfg.BridgeXRPLAddress,
+			XRPLCurrency:     token.XRPLCurrency,
+			CoreumDenom:      token.Denom,
+			SendingPrecision: token.SendingPrecision,
+			MaxHoldingAmount: token.MaxHoldingAmount,
+			BridgingFee:      token.BridgingFee,
+			State:            coreum.TokenStateEnabled,
+		}, tokenPairs[len(registeredXRPLTokens)+i])
+	}
+}
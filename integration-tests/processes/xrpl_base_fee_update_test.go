@@ -4,6 +4,7 @@
 package processes_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
 	coreumintegration "github.com/CoreumFoundation/coreum/v4/testutil/integration"
 	integrationtests "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
@@ -118,3 +120,85 @@ func TestUpdateXRPLBaseFee(t *testing.T) {
 	)
 	require.Equal(t, "1", xrplRecipientBalance.Value.String())
 }
+
+func TestUpdateXRPLBaseFeeResignsPendingOperationsWithinLoopTick(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewTestingContext(t)
+
+	runnerEnvCfg := DefaultRunnerEnvConfig()
+	runnerEnv := NewRunnerEnv(ctx, t, runnerEnvCfg, chains)
+	runnerEnv.StartAllRunnerProcesses()
+	runnerEnv.AllocateTickets(ctx, t, 200)
+
+	// update the XRPL base fee to 1, to make the fee not enough and keep the operation pending
+	require.NoError(t, runnerEnv.BridgeClient.UpdateXRPLBaseFee(ctx, runnerEnv.ContractOwner, 1))
+
+	xrplRecipientAddress := chains.XRPL.GenAccount(ctx, t, 0)
+	coreumSenderAddress := chains.Coreum.GenAccount()
+	issueFee := chains.Coreum.QueryAssetFTParams(ctx, t).IssueFee
+	chains.Coreum.FundAccountWithOptions(ctx, t, coreumSenderAddress, coreumintegration.BalancesOptions{
+		Amount: issueFee.Amount.Add(sdkmath.NewIntWithDecimal(1, 6)),
+	})
+	registeredCoreumOriginatedToken := runnerEnv.IssueAndRegisterCoreumOriginatedToken(
+		ctx,
+		t,
+		coreumSenderAddress,
+		6,
+		sdkmath.NewIntWithDecimal(1, 30),
+		6,
+		sdkmath.NewIntWithDecimal(1, 30),
+		sdkmath.ZeroInt(),
+	)
+	xrplCurrency, err := rippledata.NewCurrency(registeredCoreumOriginatedToken.XRPLCurrency)
+	require.NoError(t, err)
+	runnerEnv.SendXRPLMaxTrustSetTx(ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency)
+
+	runnerEnv.SendFromCoreumToXRPL(
+		ctx,
+		t,
+		coreumSenderAddress,
+		xrplRecipientAddress,
+		sdk.NewCoin(registeredCoreumOriginatedToken.Denom, sdkmath.NewIntWithDecimal(1, 6)),
+		nil,
+	)
+	runnerEnv.AwaitState(ctx, t, func(t *testing.T) error {
+		pendingOperations, err := runnerEnv.ContractClient.GetPendingOperations(ctx)
+		require.NoError(t, err)
+		if len(pendingOperations) == 1 && len(pendingOperations[0].Signatures) == int(runnerEnvCfg.SigningThreshold) {
+			return nil
+		}
+		return errors.Errorf("no pending operations or not all signatures are saved")
+	})
+
+	// the version bump removes the signatures of the pending operations
+	require.NoError(t, runnerEnv.BridgeClient.UpdateXRPLBaseFee(ctx, runnerEnv.ContractOwner, xrpl.DefaultXRPLBaseFee))
+	pendingOperations, err := runnerEnv.ContractClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingOperations, 1)
+	require.Equal(t, uint32(2), pendingOperations[0].Version)
+
+	// the relayers re-sign the operation right after the operations_version_bumped event, so the first signature
+	// must be saved within one loop tick (500ms) plus the time required to include the signing tx into a block
+	resignCtx, resignCancel := context.WithTimeout(ctx, 500*time.Millisecond+3*time.Second)
+	defer resignCancel()
+	require.NoError(t, retry.Do(resignCtx, 100*time.Millisecond, func() error {
+		pendingOperations, err := runnerEnv.ContractClient.GetPendingOperations(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pendingOperations) == 0 {
+			return nil
+		}
+		if pendingOperations[0].Version != 2 || len(pendingOperations[0].Signatures) == 0 {
+			return retry.Retryable(errors.Errorf("operation isn't re-signed yet"))
+		}
+		return nil
+	}))
+
+	runnerEnv.AwaitNoPendingOperations(ctx, t)
+	xrplRecipientBalance := runnerEnv.Chains.XRPL.GetAccountBalance(
+		ctx, t, xrplRecipientAddress, runnerEnv.BridgeXRPLAddress, xrplCurrency,
+	)
+	require.Equal(t, "1", xrplRecipientBalance.Value.String())
+}
@@ -67,6 +67,8 @@ func NewEnv(t *testing.T, cfg EnvConfig) *Env {
 		coreum.DefaultContractClientConfig(sdk.MustAccAddressFromBech32(bridgeCfg.ContractAddress)),
 		chains.Log,
 		chains.Coreum.ClientContext,
+		nil,
+		nil,
 	)
 
 	bridgeClient := bridgeclient.NewBridgeClient(
@@ -74,7 +76,7 @@ func NewEnv(t *testing.T, cfg EnvConfig) *Env {
 		chains.Coreum.ClientContext,
 		contractClient,
 		chains.XRPL.RPCClient(),
-		xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring()),
+		xrpl.NewKeyringTxSigner(chains.XRPL.GetSignerKeyring(), nil),
 	)
 
 	// import contract owner mnemonic
@@ -96,6 +98,8 @@ func (env *Env) NewBridgeClient() *bridgeclient.BridgeClient {
 		coreum.DefaultContractClientConfig(sdk.MustAccAddressFromBech32(bridgeCfg.ContractAddress)),
 		env.Chains.Log,
 		env.Chains.Coreum.ClientContext,
+		nil,
+		nil,
 	)
 
 	return bridgeclient.NewBridgeClient(
@@ -103,7 +107,7 @@ func (env *Env) NewBridgeClient() *bridgeclient.BridgeClient {
 		env.Chains.Coreum.ClientContext,
 		contractClient,
 		env.Chains.XRPL.RPCClient(),
-		xrpl.NewKeyringTxSigner(env.Chains.XRPL.GetSignerKeyring()),
+		xrpl.NewKeyringTxSigner(env.Chains.XRPL.GetSignerKeyring(), nil),
 	)
 }
 
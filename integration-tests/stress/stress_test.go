@@ -285,6 +285,8 @@ func enableAndDisableXRPToken(ctx context.Context, t *testing.T, env *Env) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 		},
 		func() error {
@@ -297,6 +299,8 @@ func enableAndDisableXRPToken(ctx context.Context, t *testing.T, env *Env) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 		},
 	),
@@ -23,6 +23,7 @@ import (
 	coreumapp "github.com/CoreumFoundation/coreum/v4/app"
 	coreumconfig "github.com/CoreumFoundation/coreum/v4/pkg/config"
 	coreumkeyring "github.com/CoreumFoundation/coreum/v4/pkg/keyring"
+	integrationxrpl "github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests/xrpl"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -41,6 +42,8 @@ const (
 type XRPLChainConfig struct {
 	RPCAddress  string
 	FundingSeed string
+	// FaucetURL is the optional XRPL faucet URL, if set the accounts are funded by the faucet.
+	FaucetURL string
 }
 
 // XRPLChain is XRPL chain for the testing.
@@ -49,6 +52,8 @@ type XRPLChain struct {
 	signer    *xrpl.KeyringTxSigner
 	rpcClient *xrpl.RPCClient
 	fundMu    *sync.Mutex
+	// faucetClient is nil if the faucet isn't configured
+	faucetClient *integrationxrpl.FaucetClient
 }
 
 // NewXRPLChain returns the new instance of the XRPL chain.
@@ -69,13 +74,19 @@ func NewXRPLChain(cfg XRPLChainConfig, log logger.Logger) (XRPLChain, error) {
 		nil,
 	)
 
-	signer := xrpl.NewKeyringTxSigner(kr)
+	signer := xrpl.NewKeyringTxSigner(kr, nil)
+
+	var faucetClient *integrationxrpl.FaucetClient
+	if cfg.FaucetURL != "" {
+		faucetClient = integrationxrpl.NewFaucetClient(integrationxrpl.DefaultFaucetClientConfig(cfg.FaucetURL), rpcClient)
+	}
 
 	return XRPLChain{
-		cfg:       cfg,
-		signer:    signer,
-		rpcClient: rpcClient,
-		fundMu:    &sync.Mutex{},
+		cfg:          cfg,
+		signer:       signer,
+		rpcClient:    rpcClient,
+		fundMu:       &sync.Mutex{},
+		faucetClient: faucetClient,
 	}, nil
 }
 
@@ -113,7 +124,7 @@ func (c XRPLChain) GenEmptyAccount(t *testing.T) rippledata.Account {
 		hd.Secp256k1,
 	)
 	require.NoError(t, err)
-	acc, err := xrpl.NewKeyringTxSigner(kr).Account(signerKeyName)
+	acc, err := xrpl.NewKeyringTxSigner(kr, nil).Account(signerKeyName)
 	require.NoError(t, err)
 
 	// reimport with the key as signer address
@@ -171,9 +182,21 @@ func (c XRPLChain) FundAccountForSignerListSet(
 }
 
 // FundAccount funds the provided account with the provided amount.
+// If the faucet is configured, the first funding of the account is done by the faucet in case the faucet amount covers
+// the requested one, the rest is funded from the funding account.
 func (c XRPLChain) FundAccount(ctx context.Context, t *testing.T, acc rippledata.Account, amount float64) {
 	t.Helper()
 
+	if c.faucetClient != nil {
+		t.Logf("Funding account by the faucet, account address: %s", acc)
+		res, err := c.faucetClient.FundAccount(ctx, acc)
+		require.NoError(t, err)
+		if !res.Cached && res.Amount >= amount {
+			t.Logf("The account %s is funded by the faucet, amount: %f", acc, res.Amount)
+			return
+		}
+	}
+
 	c.fundMu.Lock()
 	defer c.fundMu.Unlock()
 
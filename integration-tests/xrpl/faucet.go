@@ -0,0 +1,268 @@
+// Package xrpl contains the XRPL helpers used by the integration tests.
+package xrpl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
+	relayerxrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const faucetUserAgent = "coreumbridge-xrpl-integration-tests"
+
+// FaucetRPCClient is the RPC client used to await the funding transactions.
+type FaucetRPCClient interface {
+	Tx(ctx context.Context, hash rippledata.Hash256) (relayerxrpl.TxResult, error)
+}
+
+// FaucetClientConfig is the faucet client config.
+type FaucetClientConfig struct {
+	URL            string
+	RequestTimeout time.Duration
+	// MaxAttempts is the max number of the faucet requests before the error is returned.
+	MaxAttempts int
+	// RetryDelay is the initial delay between the rate-limited requests, it is doubled with each attempt and limited by
+	// the MaxRetryDelay. The Retry-After header of the response takes precedence if present.
+	RetryDelay        time.Duration
+	MaxRetryDelay     time.Duration
+	ValidationTimeout time.Duration
+	ValidationDelay   time.Duration
+}
+
+// DefaultFaucetClientConfig returns the default FaucetClientConfig.
+func DefaultFaucetClientConfig(url string) FaucetClientConfig {
+	return FaucetClientConfig{
+		URL:               url,
+		RequestTimeout:    30 * time.Second,
+		MaxAttempts:       10,
+		RetryDelay:        time.Second,
+		MaxRetryDelay:     30 * time.Second,
+		ValidationTimeout: time.Minute,
+		ValidationDelay:   500 * time.Millisecond,
+	}
+}
+
+// FaucetFundingResult is the result of the account funding.
+type FaucetFundingResult struct {
+	Account rippledata.Account
+	// Amount is the XRP amount sent by the faucet.
+	Amount float64
+	TxHash rippledata.Hash256
+	// Cached is true if the account was already funded by the client and no new funds were sent.
+	Cached bool
+}
+
+type faucetRequest struct {
+	Destination string `json:"destination,omitempty"`
+	UserAgent   string `json:"userAgent"`
+}
+
+type faucetResponse struct {
+	Amount          float64 `json:"amount"`
+	TransactionHash string  `json:"transactionHash"`
+}
+
+type faucetFunding struct {
+	done   chan struct{}
+	result FaucetFundingResult
+	err    error
+}
+
+// FaucetClient is the XRPL testnet faucet client.
+type FaucetClient struct {
+	cfg        FaucetClientConfig
+	rpcClient  FaucetRPCClient
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	funding map[string]*faucetFunding
+}
+
+// NewFaucetClient returns new instance of the FaucetClient.
+func NewFaucetClient(cfg FaucetClientConfig, rpcClient FaucetRPCClient) *FaucetClient {
+	return &FaucetClient{
+		cfg:       cfg,
+		rpcClient: rpcClient,
+		httpClient: &http.Client{
+			Timeout: cfg.RequestTimeout,
+		},
+		funding: make(map[string]*faucetFunding),
+	}
+}
+
+// FundAccount requests the funds for the account from the faucet and waits for the funding transaction to be
+// validated. Each account is funded once, the parallel and subsequent calls for the same account return the result of
+// the first successful funding.
+func (c *FaucetClient) FundAccount(ctx context.Context, acc rippledata.Account) (FaucetFundingResult, error) {
+	key := acc.String()
+
+	c.mu.Lock()
+	funding, ok := c.funding[key]
+	if !ok {
+		funding = &faucetFunding{
+			done: make(chan struct{}),
+		}
+		c.funding[key] = funding
+	}
+	c.mu.Unlock()
+
+	if ok {
+		select {
+		case <-ctx.Done():
+			return FaucetFundingResult{}, errors.WithStack(ctx.Err())
+		case <-funding.done:
+		}
+		if funding.err != nil {
+			return FaucetFundingResult{}, funding.err
+		}
+		result := funding.result
+		result.Cached = true
+		return result, nil
+	}
+
+	funding.result, funding.err = c.fundAccount(ctx, acc)
+	if funding.err != nil {
+		// the failed funding isn't cached, so it can be repeated by the next call
+		c.mu.Lock()
+		delete(c.funding, key)
+		c.mu.Unlock()
+	}
+	close(funding.done)
+
+	return funding.result, funding.err
+}
+
+func (c *FaucetClient) fundAccount(ctx context.Context, acc rippledata.Account) (FaucetFundingResult, error) {
+	res, err := c.requestFunds(ctx, acc)
+	if err != nil {
+		return FaucetFundingResult{}, err
+	}
+	if res.TransactionHash == "" {
+		return FaucetFundingResult{}, errors.Errorf("faucet response doesn't contain the transaction hash")
+	}
+	txHash, err := rippledata.NewHash256(res.TransactionHash)
+	if err != nil {
+		return FaucetFundingResult{}, errors.Wrapf(err, "invalid faucet transaction hash %s", res.TransactionHash)
+	}
+	if err := c.awaitValidation(ctx, *txHash); err != nil {
+		return FaucetFundingResult{}, err
+	}
+
+	return FaucetFundingResult{
+		Account: acc,
+		Amount:  res.Amount,
+		TxHash:  *txHash,
+	}, nil
+}
+
+func (c *FaucetClient) requestFunds(ctx context.Context, acc rippledata.Account) (faucetResponse, error) {
+	reqBody, err := json.Marshal(faucetRequest{
+		Destination: acc.String(),
+		UserAgent:   faucetUserAgent,
+	})
+	if err != nil {
+		return faucetResponse{}, errors.Wrap(err, "failed to marshal faucet request")
+	}
+
+	delay := c.cfg.RetryDelay
+	for attempt := 1; ; attempt++ {
+		res, retryAfter, err := c.doRequest(ctx, reqBody)
+		if err == nil {
+			return res, nil
+		}
+		var retryableErr retry.RetryableError
+		if !errors.As(err, &retryableErr) || attempt >= c.cfg.MaxAttempts {
+			return faucetResponse{}, errors.Wrapf(err, "faucet request failed, attempts:%d", attempt)
+		}
+		if retryAfter == 0 {
+			retryAfter = delay
+		}
+		select {
+		case <-ctx.Done():
+			return faucetResponse{}, errors.WithStack(ctx.Err())
+		case <-time.After(retryAfter):
+		}
+		delay *= 2
+		if delay > c.cfg.MaxRetryDelay {
+			delay = c.cfg.MaxRetryDelay
+		}
+	}
+}
+
+// doRequest executes the faucet request and returns the Retry-After delay of the rate-limited response.
+func (c *FaucetClient) doRequest(ctx context.Context, reqBody []byte) (faucetResponse, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL, bytes.NewReader(reqBody))
+	if err != nil {
+		return faucetResponse{}, 0, errors.Wrap(err, "failed to build faucet request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return faucetResponse{}, 0, retry.Retryable(errors.Wrap(err, "failed to send faucet request"))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return faucetResponse{}, 0, retry.Retryable(errors.Wrap(err, "failed to read faucet response"))
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		return faucetResponse{}, parseRetryAfter(resp.Header.Get("Retry-After")), retry.Retryable(
+			errors.Errorf("faucet is rate-limited, status:%d, body:%s", resp.StatusCode, strings.TrimSpace(string(respBody))),
+		)
+	case resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated:
+		return faucetResponse{}, 0, errors.Errorf(
+			"unexpected faucet response, status:%d, body:%s", resp.StatusCode, strings.TrimSpace(string(respBody)),
+		)
+	}
+
+	var res faucetResponse
+	if err := json.Unmarshal(respBody, &res); err != nil {
+		return faucetResponse{}, 0, errors.Wrapf(err, "failed to decode faucet response, body:%s", string(respBody))
+	}
+
+	return res, 0, nil
+}
+
+func (c *FaucetClient) awaitValidation(ctx context.Context, txHash rippledata.Hash256) error {
+	retryCtx, retryCtxCancel := context.WithTimeout(ctx, c.cfg.ValidationTimeout)
+	defer retryCtxCancel()
+
+	err := retry.Do(retryCtx, c.cfg.ValidationDelay, func() error {
+		txRes, err := c.rpcClient.Tx(retryCtx, txHash)
+		if err != nil {
+			return retry.Retryable(err)
+		}
+		if !txRes.Validated {
+			return retry.Retryable(errors.Errorf("transaction is not validated"))
+		}
+		if !txRes.MetaData.TransactionResult.Success() {
+			return errors.Errorf("faucet transaction %s is failed, result:%s", txHash, txRes.MetaData.TransactionResult)
+		}
+		return nil
+	})
+	return errors.Wrapf(err, "failed to await faucet transaction %s validation", txHash)
+}
+
+// parseRetryAfter parses the Retry-After header value in seconds, the HTTP-date format isn't used by the faucet.
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
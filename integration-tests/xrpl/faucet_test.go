@@ -0,0 +1,213 @@
+package xrpl_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/integration-tests/xrpl"
+	relayerxrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const faucetTxHash = "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7"
+
+func TestFaucetClient_FundAccount_RetryOnRateLimit(t *testing.T) {
+	t.Parallel()
+
+	acc := relayerxrpl.GenPrivKeyTxSigner().Account()
+	var requests atomic.Int32
+	server := newFaucetServer(t, func(w http.ResponseWriter, destination string) {
+		require.Equal(t, acc.String(), destination)
+		switch requests.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			writeFaucetResponse(t, w, 1000)
+		}
+	})
+
+	rpcClient := &fakeFaucetRPCClient{notValidatedResponses: 2}
+	client := xrpl.NewFaucetClient(testFaucetClientConfig(server.URL), rpcClient)
+	res, err := client.FundAccount(context.Background(), acc)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load())
+	require.Equal(t, int32(3), rpcClient.calls.Load())
+	require.Equal(t, acc, res.Account)
+	require.Equal(t, float64(1000), res.Amount)
+	require.Equal(t, faucetTxHash, res.TxHash.String())
+	require.False(t, res.Cached)
+}
+
+func TestFaucetClient_FundAccount_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		statusCode       int
+		rpcErr           error
+		expectedRequests int32
+		expectedErr      string
+	}{
+		{
+			name:             "max_attempts_reached",
+			statusCode:       http.StatusTooManyRequests,
+			expectedRequests: 3,
+			expectedErr:      "faucet is rate-limited",
+		},
+		{
+			name:             "non_retryable_status",
+			statusCode:       http.StatusBadRequest,
+			expectedRequests: 1,
+			expectedErr:      "unexpected faucet response",
+		},
+		{
+			name:             "validation_timeout",
+			statusCode:       http.StatusOK,
+			rpcErr:           errors.New("txnNotFound"),
+			expectedRequests: 1,
+			expectedErr:      "txnNotFound",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := newFaucetServer(t, func(w http.ResponseWriter, _ string) {
+				requests.Add(1)
+				if tt.statusCode != http.StatusOK {
+					w.WriteHeader(tt.statusCode)
+					return
+				}
+				writeFaucetResponse(t, w, 1000)
+			})
+
+			cfg := testFaucetClientConfig(server.URL)
+			cfg.MaxAttempts = 3
+			client := xrpl.NewFaucetClient(cfg, &fakeFaucetRPCClient{err: tt.rpcErr})
+			_, err := client.FundAccount(context.Background(), relayerxrpl.GenPrivKeyTxSigner().Account())
+			require.ErrorContains(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedRequests, requests.Load())
+		})
+	}
+}
+
+func TestFaucetClient_FundAccount_Cache(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	// the first request fails to check that failed funding isn't cached
+	server := newFaucetServer(t, func(w http.ResponseWriter, _ string) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeFaucetResponse(t, w, 1000)
+	})
+
+	client := xrpl.NewFaucetClient(testFaucetClientConfig(server.URL), &fakeFaucetRPCClient{})
+	ctx := context.Background()
+	acc := relayerxrpl.GenPrivKeyTxSigner().Account()
+	_, err := client.FundAccount(ctx, acc)
+	require.Error(t, err)
+
+	const parallelCalls = 10
+	results := make([]xrpl.FaucetFundingResult, parallelCalls)
+	var wg sync.WaitGroup
+	for i := 0; i < parallelCalls; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.FundAccount(ctx, acc)
+			require.NoError(t, err)
+			results[i] = res
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), requests.Load())
+
+	var notCachedResults int
+	for _, res := range results {
+		require.Equal(t, faucetTxHash, res.TxHash.String())
+		if !res.Cached {
+			notCachedResults++
+		}
+	}
+	require.Equal(t, 1, notCachedResults)
+
+	// another account is funded by the new request
+	_, err = client.FundAccount(ctx, relayerxrpl.GenPrivKeyTxSigner().Account())
+	require.NoError(t, err)
+	require.Equal(t, int32(3), requests.Load())
+}
+
+func testFaucetClientConfig(url string) xrpl.FaucetClientConfig {
+	cfg := xrpl.DefaultFaucetClientConfig(url)
+	cfg.RetryDelay = time.Millisecond
+	cfg.MaxRetryDelay = 5 * time.Millisecond
+	cfg.ValidationTimeout = 100 * time.Millisecond
+	cfg.ValidationDelay = time.Millisecond
+
+	return cfg
+}
+
+func newFaucetServer(t *testing.T, handler func(w http.ResponseWriter, destination string)) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var req struct {
+			Destination string `json:"destination"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		handler(w, req.Destination)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func writeFaucetResponse(t *testing.T, w http.ResponseWriter, amount float64) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+		"amount":          amount,
+		"transactionHash": strings.ToLower(faucetTxHash),
+	}))
+}
+
+type fakeFaucetRPCClient struct {
+	notValidatedResponses int32
+	err                   error
+	calls                 atomic.Int32
+}
+
+func (c *fakeFaucetRPCClient) Tx(_ context.Context, hash rippledata.Hash256) (relayerxrpl.TxResult, error) {
+	if hash.String() != faucetTxHash {
+		return relayerxrpl.TxResult{}, errors.Errorf("unexpected tx hash %s", hash)
+	}
+	if c.err != nil {
+		return relayerxrpl.TxResult{}, c.err
+	}
+	if c.calls.Add(1) <= c.notValidatedResponses {
+		return relayerxrpl.TxResult{}, nil
+	}
+
+	return relayerxrpl.TxResult{Validated: true}, nil
+}
@@ -59,6 +59,7 @@ func TestFullHistoryScanAccountTx(t *testing.T) {
 		scannerCfg,
 		chains.Log,
 		rpcClient,
+		nil,
 		metricRegistry,
 	)
 	// add timeout to finish the tests in case of error
@@ -116,6 +117,7 @@ func TestRecentHistoryScanAccountTx(t *testing.T) {
 		scannerCfg,
 		chains.Log,
 		rpcClient,
+		nil,
 		metricRegistry,
 	)
 
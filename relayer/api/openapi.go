@@ -0,0 +1,258 @@
+package api
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	openAPIPath    = "/openapi.json"
+	openAPIVersion = "3.0.3"
+	apiTitle       = "Coreum XRPL bridge API"
+	apiVersion     = "v1"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// OpenAPIDocument is the OpenAPI document of the API.
+type OpenAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents          `json:"components"`
+}
+
+// OpenAPIInfo is the OpenAPI document info.
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIPathItem is the OpenAPI path item.
+type OpenAPIPathItem struct {
+	Get OpenAPIOperation `json:"get"`
+}
+
+// OpenAPIOperation is the OpenAPI operation.
+type OpenAPIOperation struct {
+	Summary    string                     `json:"summary"`
+	Parameters []OpenAPIParameter         `json:"parameters,omitempty"`
+	Responses  map[string]OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter is the OpenAPI operation parameter.
+type OpenAPIParameter struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description"`
+	Required    bool          `json:"required"`
+	Schema      OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIResponse is the OpenAPI operation response.
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIMediaType is the OpenAPI response media type.
+type OpenAPIMediaType struct {
+	Schema OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIComponents is the OpenAPI reusable components.
+type OpenAPIComponents struct {
+	Schemas map[string]OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPISchema is the OpenAPI schema object.
+type OpenAPISchema struct {
+	Ref                  string                   `json:"$ref,omitempty"`
+	Type                 string                   `json:"type,omitempty"`
+	Format               string                   `json:"format,omitempty"`
+	Nullable             bool                     `json:"nullable,omitempty"`
+	Items                *OpenAPISchema           `json:"items,omitempty"`
+	Properties           map[string]OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema           `json:"additionalProperties,omitempty"`
+}
+
+// buildOpenAPIDocument builds the OpenAPI document from the route definitions.
+func buildOpenAPIDocument(routes []route) OpenAPIDocument {
+	gen := schemaGenerator{
+		schemas: make(map[string]OpenAPISchema),
+	}
+	errorResponse := OpenAPIResponse{
+		Content: map[string]OpenAPIMediaType{
+			"application/json": {
+				Schema: gen.schema(reflect.TypeOf(ErrorResponse{})),
+			},
+		},
+	}
+	withDescription := func(res OpenAPIResponse, description string) OpenAPIResponse {
+		res.Description = description
+		return res
+	}
+
+	paths := make(map[string]OpenAPIPathItem, len(routes))
+	for _, r := range routes {
+		operation := OpenAPIOperation{
+			Summary: r.summary,
+			Responses: map[string]OpenAPIResponse{
+				strconv.Itoa(http.StatusOK): {
+					Description: "Successful response.",
+					Content: map[string]OpenAPIMediaType{
+						"application/json": {
+							Schema: gen.schema(r.response),
+						},
+					},
+				},
+				strconv.Itoa(http.StatusTooManyRequests): withDescription(errorResponse, "Rate limit exceeded."),
+				strconv.Itoa(http.StatusInternalServerError): withDescription(
+					errorResponse, "Failed to handle the request.",
+				),
+			},
+		}
+		if r.param != nil {
+			operation.Parameters = []OpenAPIParameter{
+				{
+					Name:        r.param.name,
+					In:          "path",
+					Description: r.param.description,
+					Required:    true,
+					Schema: OpenAPISchema{
+						Type: "string",
+					},
+				},
+			}
+			operation.Responses[strconv.Itoa(http.StatusBadRequest)] = withDescription(
+				errorResponse, "Invalid request parameter.",
+			)
+		}
+		paths[r.path] = OpenAPIPathItem{
+			Get: operation,
+		}
+	}
+
+	return OpenAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: OpenAPIInfo{
+			Title:   apiTitle,
+			Version: apiVersion,
+		},
+		Paths: paths,
+		Components: OpenAPIComponents{
+			Schemas: gen.schemas,
+		},
+	}
+}
+
+// schemaGenerator generates the OpenAPI schemas from the go types based on their JSON encoding, the named structs
+// are put to the components and referenced.
+type schemaGenerator struct {
+	schemas map[string]OpenAPISchema
+}
+
+func (g schemaGenerator) schema(t reflect.Type) OpenAPISchema {
+	if t.Kind() == reflect.Pointer {
+		schema := g.schema(t.Elem())
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+		return schema
+	}
+
+	// the custom encoded types (amounts, addresses, etc.) are encoded as strings, except the slices with the custom
+	// encoding of the nil value
+	if isCustomEncoded(t) && (t.Kind() != reflect.Slice || t.Elem().Kind() == reflect.Uint8) {
+		return OpenAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return OpenAPISchema{Type: "number"}
+	case reflect.String:
+		return OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		items := g.schema(t.Elem())
+		return OpenAPISchema{Type: "array", Items: &items}
+	case reflect.Map:
+		values := g.schema(t.Elem())
+		return OpenAPISchema{Type: "object", AdditionalProperties: &values}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return OpenAPISchema{}
+	}
+}
+
+func (g schemaGenerator) structSchema(t reflect.Type) OpenAPISchema {
+	name := schemaName(t)
+	ref := OpenAPISchema{Ref: "#/components/schemas/" + name}
+	if _, ok := g.schemas[name]; ok {
+		return ref
+	}
+	// the placeholder prevents the infinite recursion of the self referencing types
+	g.schemas[name] = OpenAPISchema{}
+
+	schema := OpenAPISchema{
+		Type:       "object",
+		Properties: make(map[string]OpenAPISchema),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldName, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+		schema.Properties[fieldName] = g.schema(field.Type)
+	}
+	g.schemas[name] = schema
+
+	return ref
+}
+
+func schemaName(t reflect.Type) string {
+	name := strings.NewReplacer("[", "_", "]", "", "/", "_", "*", "").Replace(t.Name())
+	return path.Base(t.PkgPath()) + "." + name
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, false
+}
+
+func isCustomEncoded(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) ||
+		reflect.PointerTo(t).Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) ||
+		reflect.PointerTo(t).Implements(textMarshalerType)
+}
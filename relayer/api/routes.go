@@ -0,0 +1,278 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+const coreumTxHashLength = 64
+
+// ErrorResponse is the response returned on the failed request.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// TokensResponse is the registered tokens response.
+type TokensResponse struct {
+	CoreumTokens []coreum.CoreumToken `json:"coreum_tokens"`
+	XRPLTokens   []coreum.XRPLToken   `json:"xrpl_tokens"`
+}
+
+// BridgeStateResponse is the bridge state response.
+type BridgeStateResponse struct {
+	State coreum.BridgeState `json:"state"`
+}
+
+// PendingOperationsResponse is the pending operations response.
+type PendingOperationsResponse struct {
+	Operations []coreum.Operation `json:"operations"`
+}
+
+// PendingRefundsResponse is the pending refunds response.
+type PendingRefundsResponse struct {
+	Refunds []coreum.PendingRefund `json:"refunds"`
+}
+
+// FeesCollectedResponse is the relayer collected fees response.
+type FeesCollectedResponse struct {
+	Fees sdk.Coins `json:"fees"`
+}
+
+// XRPLToCoreumTransferResponse is the XRPL to Coreum transfer tracing response.
+type XRPLToCoreumTransferResponse struct {
+	XRPLTxHash       string   `json:"xrpl_tx_hash"`
+	CoreumTxHash     string   `json:"coreum_tx_hash,omitempty"`
+	Completed        bool     `json:"completed"`
+	EvidenceTxHashes []string `json:"evidence_tx_hashes"`
+}
+
+// CoreumToXRPLTransferResponse is the Coreum to XRPL transfer tracing response.
+type CoreumToXRPLTransferResponse struct {
+	CoreumTxHash string   `json:"coreum_tx_hash"`
+	XRPLTxHashes []string `json:"xrpl_tx_hashes"`
+	Completed    bool     `json:"completed"`
+}
+
+// route is the API route definition, used for both request handling and OpenAPI document generation.
+type route struct {
+	path     string
+	summary  string
+	param    *routeParam
+	response reflect.Type
+	handle   func(ctx context.Context, param string) (any, error)
+}
+
+// routeParam is the route path param, must be the last element of the path.
+type routeParam struct {
+	name        string
+	description string
+}
+
+func (s *Server) buildRoutes() []route {
+	return []route{
+		{
+			path:     "/v1/tokens",
+			summary:  "Get the Coreum and XRPL tokens registered in the bridge.",
+			response: reflect.TypeOf(TokensResponse{}),
+			handle: func(ctx context.Context, _ string) (any, error) {
+				return s.getTokens(ctx)
+			},
+		},
+		{
+			path:     "/v1/contract-config",
+			summary:  "Get the bridge contract config.",
+			response: reflect.TypeOf(coreum.ContractConfig{}),
+			handle: func(ctx context.Context, _ string) (any, error) {
+				return s.contractClient.GetContractConfig(ctx)
+			},
+		},
+		{
+			path:     "/v1/bridge-state",
+			summary:  "Get the bridge state.",
+			response: reflect.TypeOf(BridgeStateResponse{}),
+			handle: func(ctx context.Context, _ string) (any, error) {
+				return s.getBridgeState(ctx)
+			},
+		},
+		{
+			path:     "/v1/pending-operations",
+			summary:  "Get the pending operations.",
+			response: reflect.TypeOf(PendingOperationsResponse{}),
+			handle: func(ctx context.Context, _ string) (any, error) {
+				return s.getPendingOperations(ctx)
+			},
+		},
+		{
+			path:    "/v1/pending-refunds/{address}",
+			summary: "Get the pending refunds of the address.",
+			param: &routeParam{
+				name:        "address",
+				description: "Coreum address of the refunds owner.",
+			},
+			response: reflect.TypeOf(PendingRefundsResponse{}),
+			handle:   s.getPendingRefunds,
+		},
+		{
+			path:    "/v1/fees-collected/{address}",
+			summary: "Get the fees collected by the relayer.",
+			param: &routeParam{
+				name:        "address",
+				description: "Coreum address of the relayer.",
+			},
+			response: reflect.TypeOf(FeesCollectedResponse{}),
+			handle:   s.getFeesCollected,
+		},
+		{
+			path:    "/v1/transfers/xrpl-to-coreum/{tx_hash}",
+			summary: "Trace the XRPL to Coreum transfer.",
+			param: &routeParam{
+				name:        "tx_hash",
+				description: "Hash of the XRPL transaction sending the funds to the bridge.",
+			},
+			response: reflect.TypeOf(XRPLToCoreumTransferResponse{}),
+			handle:   s.getXRPLToCoreumTransfer,
+		},
+		{
+			path:    "/v1/transfers/coreum-to-xrpl/{tx_hash}",
+			summary: "Trace the Coreum to XRPL transfer.",
+			param: &routeParam{
+				name:        "tx_hash",
+				description: "Hash of the Coreum transaction sending the funds to XRPL.",
+			},
+			response: reflect.TypeOf(CoreumToXRPLTransferResponse{}),
+			handle:   s.getCoreumToXRPLTransfer,
+		},
+	}
+}
+
+func (s *Server) getTokens(ctx context.Context) (TokensResponse, error) {
+	coreumTokens, err := s.contractClient.GetCoreumTokens(ctx)
+	if err != nil {
+		return TokensResponse{}, err
+	}
+	xrplTokens, err := s.contractClient.GetXRPLTokens(ctx)
+	if err != nil {
+		return TokensResponse{}, err
+	}
+
+	return TokensResponse{
+		CoreumTokens: coreumTokens,
+		XRPLTokens:   xrplTokens,
+	}, nil
+}
+
+func (s *Server) getBridgeState(ctx context.Context) (BridgeStateResponse, error) {
+	cfg, err := s.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return BridgeStateResponse{}, err
+	}
+
+	return BridgeStateResponse{
+		State: cfg.BridgeState,
+	}, nil
+}
+
+func (s *Server) getPendingOperations(ctx context.Context) (PendingOperationsResponse, error) {
+	operations, err := s.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return PendingOperationsResponse{}, err
+	}
+
+	return PendingOperationsResponse{
+		Operations: operations,
+	}, nil
+}
+
+func (s *Server) getPendingRefunds(ctx context.Context, addressString string) (any, error) {
+	address, err := sdk.AccAddressFromBech32(addressString)
+	if err != nil {
+		return nil, badRequestError{msg: "invalid address: " + addressString}
+	}
+	refunds, err := s.bridgeClient.GetPendingRefunds(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return PendingRefundsResponse{
+		Refunds: refunds,
+	}, nil
+}
+
+func (s *Server) getFeesCollected(ctx context.Context, addressString string) (any, error) {
+	address, err := sdk.AccAddressFromBech32(addressString)
+	if err != nil {
+		return nil, badRequestError{msg: "invalid address: " + addressString}
+	}
+	fees, err := s.bridgeClient.GetFeesCollected(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return FeesCollectedResponse{
+		Fees: fees,
+	}, nil
+}
+
+func (s *Server) getXRPLToCoreumTransfer(ctx context.Context, xrplTxHash string) (any, error) {
+	if _, err := rippledata.NewHash256(xrplTxHash); err != nil {
+		return nil, badRequestError{msg: "invalid XRPL tx hash: " + xrplTxHash}
+	}
+	tracingInfo, err := s.bridgeClient.GetXRPLToCoreumTracingInfo(ctx, xrplTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res := XRPLToCoreumTransferResponse{
+		XRPLTxHash:       xrplTxHash,
+		EvidenceTxHashes: make([]string, 0, len(tracingInfo.EvidenceToTxs)),
+	}
+	if tracingInfo.CoreumTx != nil {
+		res.CoreumTxHash = tracingInfo.CoreumTx.TxHash
+		res.Completed = true
+	}
+	for _, evidenceToTx := range tracingInfo.EvidenceToTxs {
+		if evidenceToTx.Tx == nil {
+			continue
+		}
+		res.EvidenceTxHashes = append(res.EvidenceTxHashes, evidenceToTx.Tx.TxHash)
+	}
+
+	return res, nil
+}
+
+func (s *Server) getCoreumToXRPLTransfer(ctx context.Context, coreumTxHash string) (any, error) {
+	if err := validateCoreumTxHash(coreumTxHash); err != nil {
+		return nil, badRequestError{msg: "invalid Coreum tx hash: " + coreumTxHash}
+	}
+	tracingInfo, err := s.bridgeClient.GetCoreumToXRPLTracingInfo(ctx, coreumTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return CoreumToXRPLTransferResponse{
+		CoreumTxHash: coreumTxHash,
+		XRPLTxHashes: lo.Map(tracingInfo.XRPLTxs, func(tx rippledata.TransactionWithMetaData, _ int) string {
+			return tx.GetHash().String()
+		}),
+		Completed: len(tracingInfo.XRPLTxs) > 0,
+	}, nil
+}
+
+func validateCoreumTxHash(hash string) error {
+	if len(hash) != coreumTxHashLength {
+		return errors.Errorf("invalid hash length, expected:%d, got:%d", coreumTxHashLength, len(hash))
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return errors.Wrap(err, "invalid hex hash")
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=server_mocks_test.go -package=api_test . ContractClient,BridgeClient
+
+// ContractClient is the contract client used by the API for the frequent queries, expected to be cached.
+type ContractClient interface {
+	GetContractConfig(ctx context.Context) (coreum.ContractConfig, error)
+	GetPendingOperations(ctx context.Context) ([]coreum.Operation, error)
+	GetXRPLTokens(ctx context.Context) ([]coreum.XRPLToken, error)
+	GetCoreumTokens(ctx context.Context) ([]coreum.CoreumToken, error)
+}
+
+// BridgeClient is the bridge client used by the API for the address and transfer specific queries.
+type BridgeClient interface {
+	GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error)
+	GetFeesCollected(ctx context.Context, address sdk.Address) (sdk.Coins, error)
+	GetXRPLToCoreumTracingInfo(ctx context.Context, xrplTxHash string) (bridgeclient.XRPLToCoreumTracingInfo, error)
+	GetCoreumToXRPLTracingInfo(
+		ctx context.Context,
+		coreumTxHash string,
+	) (bridgeclient.CoreumToXRPLTracingInfo, error)
+}
+
+// RateLimitConfig is the per client IP rate limit config.
+type RateLimitConfig struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// ServerConfig is the API server config.
+type ServerConfig struct {
+	ListenAddress string
+	// CORSAllowedOrigins are the origins allowed to call the API from the browser, "*" allows any origin.
+	CORSAllowedOrigins []string
+	RateLimit          RateLimitConfig
+	// RateLimitClientTTL is the time the rate limit of the client is kept after its last request.
+	RateLimitClientTTL time.Duration
+}
+
+// DefaultServerConfig returns the default ServerConfig.
+func DefaultServerConfig() ServerConfig {
+	return ServerConfig{
+		ListenAddress:      "localhost:8080",
+		CORSAllowedOrigins: nil,
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 10,
+			Burst:             20,
+		},
+		RateLimitClientTTL: 10 * time.Minute,
+	}
+}
+
+// Server is the read-only HTTP JSON API exposing the bridge state. It doesn't sign or broadcast any transactions.
+type Server struct {
+	cfg            ServerConfig
+	log            logger.Logger
+	contractClient ContractClient
+	bridgeClient   BridgeClient
+	clock          func() time.Time
+
+	routes []route
+
+	limitersMu     sync.Mutex
+	limiters       map[string]*clientLimiter
+	limitersPruned time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewServer returns a new instance of the Server.
+func NewServer(
+	cfg ServerConfig,
+	log logger.Logger,
+	contractClient ContractClient,
+	bridgeClient BridgeClient,
+	clock func() time.Time,
+) *Server {
+	s := &Server{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		bridgeClient:   bridgeClient,
+		clock:          clock,
+		limiters:       make(map[string]*clientLimiter),
+	}
+	s.routes = s.buildRoutes()
+
+	return s
+}
+
+// Start starts the API server and blocks until the context is canceled.
+func (s *Server) Start(ctx context.Context) error {
+	l, err := net.Listen("tcp", s.cfg.ListenAddress)
+	if err != nil {
+		return errors.Wrap(err, "API server listener failed")
+	}
+	defer l.Close()
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.log.Info(ctx, "Starting API server", zap.String("address", l.Addr().String()))
+
+	err = parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		spawn("server", parallel.Exit, func(ctx context.Context) error {
+			if err := server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return errors.Wrap(err, "API server exited")
+			}
+			return ctx.Err()
+		})
+		spawn("close", parallel.Exit, func(ctx context.Context) error {
+			<-ctx.Done()
+			server.Close()
+			return ctx.Err()
+		})
+		return nil
+	})
+
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// Handler returns the HTTP handler of the API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	for _, r := range s.routes {
+		r := r
+		pattern := r.path
+		if r.param != nil {
+			// the route with the path param handles the whole subtree
+			pattern = strings.TrimSuffix(r.path, "{"+r.param.name+"}")
+		}
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, req *http.Request) {
+			s.handleRoute(w, req, r, pattern)
+		})
+	}
+
+	openAPIDoc := buildOpenAPIDocument(s.routes)
+	mux.HandleFunc(openAPIPath, func(w http.ResponseWriter, req *http.Request) {
+		s.writeJSON(req.Context(), w, http.StatusOK, openAPIDoc)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		s.writeError(req.Context(), w, http.StatusNotFound, "not found")
+	})
+
+	return s.withCORS(s.withRateLimit(s.withReadOnly(mux)))
+}
+
+func (s *Server) handleRoute(w http.ResponseWriter, req *http.Request, r route, pattern string) {
+	ctx := req.Context()
+	var param string
+	if r.param != nil {
+		param = strings.TrimPrefix(req.URL.Path, pattern)
+		if param == "" || strings.Contains(param, "/") {
+			s.writeError(ctx, w, http.StatusNotFound, "not found")
+			return
+		}
+	}
+
+	res, err := r.handle(ctx, param)
+	if err != nil {
+		var badRequestErr badRequestError
+		if errors.As(err, &badRequestErr) {
+			s.writeError(ctx, w, http.StatusBadRequest, badRequestErr.Error())
+			return
+		}
+		s.log.Warn(
+			ctx,
+			"Failed to handle API request",
+			zap.String("path", req.URL.Path),
+			zap.Error(err),
+		)
+		s.writeError(ctx, w, http.StatusInternalServerError, "failed to handle the request")
+		return
+	}
+
+	s.writeJSON(ctx, w, http.StatusOK, res)
+}
+
+// withReadOnly rejects all the methods except GET, the CORS preflight is handled before.
+func (s *Server) withReadOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			s.writeError(req.Context(), w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// withRateLimit limits the requests per client IP. The IP is taken from the connection, so if the API is behind the
+// proxy the limit is shared by all the proxy clients.
+func (s *Server) withRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		clientIP, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			clientIP = req.RemoteAddr
+		}
+		if !s.allowRequest(clientIP) {
+			w.Header().Set("Retry-After", "1")
+			s.writeError(req.Context(), w, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (s *Server) allowRequest(clientIP string) bool {
+	now := s.clock()
+
+	s.limitersMu.Lock()
+	defer s.limitersMu.Unlock()
+
+	// the clients without the recent requests are removed to keep the memory bounded
+	if now.Sub(s.limitersPruned) >= s.cfg.RateLimitClientTTL {
+		for ip, limiter := range s.limiters {
+			if now.Sub(limiter.lastSeen) >= s.cfg.RateLimitClientTTL {
+				delete(s.limiters, ip)
+			}
+		}
+		s.limitersPruned = now
+	}
+
+	limiter, ok := s.limiters[clientIP]
+	if !ok {
+		limiter = &clientLimiter{
+			limiter: rate.NewLimiter(rate.Limit(s.cfg.RateLimit.RequestsPerSecond), s.cfg.RateLimit.Burst),
+		}
+		s.limiters[clientIP] = limiter
+	}
+	limiter.lastSeen = now
+
+	return limiter.limiter.AllowN(now, 1)
+}
+
+// withCORS sets the CORS headers for the allowed origins and responds to the preflight requests.
+func (s *Server) withCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		origin := req.Header.Get("Origin")
+		if origin == "" || !s.isOriginAllowed(origin) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Methods", http.MethodGet)
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		if req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (s *Server) isOriginAllowed(origin string) bool {
+	for _, allowedOrigin := range s.cfg.CORSAllowedOrigins {
+		if allowedOrigin == "*" || allowedOrigin == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) writeError(ctx context.Context, w http.ResponseWriter, status int, msg string) {
+	s.writeJSON(ctx, w, status, ErrorResponse{Error: msg})
+}
+
+func (s *Server) writeJSON(ctx context.Context, w http.ResponseWriter, status int, res any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		s.log.Warn(ctx, "Failed to write API response", zap.Error(err))
+	}
+}
+
+// badRequestError is the error of the invalid request input.
+type badRequestError struct {
+	msg string
+}
+
+func (e badRequestError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/api (interfaces: ContractClient,BridgeClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=server_mocks_test.go -package=api_test . ContractClient,BridgeClient
+//
+
+// Package api_test is a generated GoMock package.
+package api_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	client "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	coreum "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContractClient is a mock of ContractClient interface.
+type MockContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractClientMockRecorder
+}
+
+// MockContractClientMockRecorder is the mock recorder for MockContractClient.
+type MockContractClientMockRecorder struct {
+	mock *MockContractClient
+}
+
+// NewMockContractClient creates a new mock instance.
+func NewMockContractClient(ctrl *gomock.Controller) *MockContractClient {
+	mock := &MockContractClient{ctrl: ctrl}
+	mock.recorder = &MockContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractClient) EXPECT() *MockContractClientMockRecorder {
+	return m.recorder
+}
+
+// GetContractConfig mocks base method.
+func (m *MockContractClient) GetContractConfig(arg0 context.Context) (coreum.ContractConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractConfig", arg0)
+	ret0, _ := ret[0].(coreum.ContractConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractConfig indicates an expected call of GetContractConfig.
+func (mr *MockContractClientMockRecorder) GetContractConfig(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractConfig", reflect.TypeOf((*MockContractClient)(nil).GetContractConfig), arg0)
+}
+
+// GetCoreumTokens mocks base method.
+func (m *MockContractClient) GetCoreumTokens(arg0 context.Context) ([]coreum.CoreumToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumTokens", arg0)
+	ret0, _ := ret[0].([]coreum.CoreumToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumTokens indicates an expected call of GetCoreumTokens.
+func (mr *MockContractClientMockRecorder) GetCoreumTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumTokens", reflect.TypeOf((*MockContractClient)(nil).GetCoreumTokens), arg0)
+}
+
+// GetPendingOperations mocks base method.
+func (m *MockContractClient) GetPendingOperations(arg0 context.Context) ([]coreum.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperations", arg0)
+	ret0, _ := ret[0].([]coreum.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperations indicates an expected call of GetPendingOperations.
+func (mr *MockContractClientMockRecorder) GetPendingOperations(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperations", reflect.TypeOf((*MockContractClient)(nil).GetPendingOperations), arg0)
+}
+
+// GetXRPLTokens mocks base method.
+func (m *MockContractClient) GetXRPLTokens(arg0 context.Context) ([]coreum.XRPLToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLTokens", arg0)
+	ret0, _ := ret[0].([]coreum.XRPLToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLTokens indicates an expected call of GetXRPLTokens.
+func (mr *MockContractClientMockRecorder) GetXRPLTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLTokens", reflect.TypeOf((*MockContractClient)(nil).GetXRPLTokens), arg0)
+}
+
+// MockBridgeClient is a mock of BridgeClient interface.
+type MockBridgeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeClientMockRecorder
+}
+
+// MockBridgeClientMockRecorder is the mock recorder for MockBridgeClient.
+type MockBridgeClientMockRecorder struct {
+	mock *MockBridgeClient
+}
+
+// NewMockBridgeClient creates a new mock instance.
+func NewMockBridgeClient(ctrl *gomock.Controller) *MockBridgeClient {
+	mock := &MockBridgeClient{ctrl: ctrl}
+	mock.recorder = &MockBridgeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeClient) EXPECT() *MockBridgeClientMockRecorder {
+	return m.recorder
+}
+
+// GetCoreumToXRPLTracingInfo mocks base method.
+func (m *MockBridgeClient) GetCoreumToXRPLTracingInfo(arg0 context.Context, arg1 string) (client.CoreumToXRPLTracingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumToXRPLTracingInfo", arg0, arg1)
+	ret0, _ := ret[0].(client.CoreumToXRPLTracingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumToXRPLTracingInfo indicates an expected call of GetCoreumToXRPLTracingInfo.
+func (mr *MockBridgeClientMockRecorder) GetCoreumToXRPLTracingInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumToXRPLTracingInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetCoreumToXRPLTracingInfo), arg0, arg1)
+}
+
+// GetFeesCollected mocks base method.
+func (m *MockBridgeClient) GetFeesCollected(arg0 context.Context, arg1 types.Address) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeesCollected", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeesCollected indicates an expected call of GetFeesCollected.
+func (mr *MockBridgeClientMockRecorder) GetFeesCollected(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeesCollected", reflect.TypeOf((*MockBridgeClient)(nil).GetFeesCollected), arg0, arg1)
+}
+
+// GetPendingRefunds mocks base method.
+func (m *MockBridgeClient) GetPendingRefunds(arg0 context.Context, arg1 types.AccAddress) ([]coreum.PendingRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRefunds", arg0, arg1)
+	ret0, _ := ret[0].([]coreum.PendingRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRefunds indicates an expected call of GetPendingRefunds.
+func (mr *MockBridgeClientMockRecorder) GetPendingRefunds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRefunds", reflect.TypeOf((*MockBridgeClient)(nil).GetPendingRefunds), arg0, arg1)
+}
+
+// GetXRPLToCoreumTracingInfo mocks base method.
+func (m *MockBridgeClient) GetXRPLToCoreumTracingInfo(arg0 context.Context, arg1 string) (client.XRPLToCoreumTracingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLToCoreumTracingInfo", arg0, arg1)
+	ret0, _ := ret[0].(client.XRPLToCoreumTracingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLToCoreumTracingInfo indicates an expected call of GetXRPLToCoreumTracingInfo.
+func (mr *MockBridgeClientMockRecorder) GetXRPLToCoreumTracingInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLToCoreumTracingInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetXRPLToCoreumTracingInfo), arg0, arg1)
+}
@@ -0,0 +1,516 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/api"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+const (
+	testXRPLTxHash   = "D3B2B6A7F4B0F4E2B8A0C9C1E4B5A6F7D8E9F0A1B2C3D4E5F6A7B8C9D0E1F2A3"
+	testCoreumTxHash = "8F3C2A1B0E9D8C7B6A5F4E3D2C1B0A9F8E7D6C5B4A3F2E1D0C9B8A7F6E5D4C3B"
+)
+
+func TestServer_Endpoints(t *testing.T) {
+	t.Parallel()
+
+	address := coreum.GenAccount()
+	contractCfg := coreum.ContractConfig{
+		Relayers: []coreum.Relayer{
+			{
+				CoreumAddress: coreum.GenAccount(),
+				XRPLAddress:   "rPa9ZUj3oFGE3dJ2w9Z4yGMoCGYbmn8B8y",
+				XRPLPubKey:    "02B5C4A1B7F1C2E3D4A5B6C7D8E9F0A1B2C3D4E5F6A7B8C9D0E1F2A3B4C5D6E7F8",
+			},
+		},
+		EvidenceThreshold:           1,
+		UsedTicketSequenceThreshold: 150,
+		TrustSetLimitAmount:         sdkmath.NewInt(1_000_000),
+		BridgeXRPLAddress:           "rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN",
+		BridgeState:                 coreum.BridgeStateActive,
+		XRPLBaseFee:                 10,
+	}
+	coreumTokens := []coreum.CoreumToken{
+		{
+			Denom:            "ucore",
+			Decimals:         6,
+			XRPLCurrency:     "434F524500000000000000000000000000000000",
+			SendingPrecision: 6,
+			MaxHoldingAmount: sdkmath.NewInt(1_000_000_000),
+			State:            coreum.TokenStateEnabled,
+			BridgingFee:      sdkmath.ZeroInt(),
+		},
+	}
+	xrplTokens := []coreum.XRPLToken{
+		{
+			Issuer:           "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			Currency:         "XRP",
+			CoreumDenom:      "drop-core1",
+			SendingPrecision: 6,
+			MaxHoldingAmount: sdkmath.NewInt(10_000_000),
+			State:            coreum.TokenStateEnabled,
+			BridgingFee:      sdkmath.ZeroInt(),
+		},
+	}
+	pendingOperations := []coreum.Operation{
+		{
+			Version:        1,
+			TicketSequence: 5,
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{
+					Number: 10,
+				},
+			},
+			XRPLBaseFee: 10,
+		},
+	}
+	pendingRefunds := []coreum.PendingRefund{
+		{
+			ID:         "refund-1",
+			Coin:       sdk.NewInt64Coin("ucore", 100),
+			XRPLTxHash: testXRPLTxHash,
+		},
+	}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("ucore", 50))
+
+	tests := []struct {
+		name                  string
+		method                string
+		path                  string
+		contractClientBuilder func(ctrl *gomock.Controller) api.ContractClient
+		bridgeClientBuilder   func(ctrl *gomock.Controller) api.BridgeClient
+		wantStatus            int
+		wantResponse          any
+	}{
+		{
+			name: "tokens",
+			path: "/v1/tokens",
+			contractClientBuilder: func(ctrl *gomock.Controller) api.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return(coreumTokens, nil)
+				contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return(xrplTokens, nil)
+				return contractClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.TokensResponse{
+				CoreumTokens: coreumTokens,
+				XRPLTokens:   xrplTokens,
+			},
+		},
+		{
+			name: "contract_config",
+			path: "/v1/contract-config",
+			contractClientBuilder: func(ctrl *gomock.Controller) api.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractCfg, nil)
+				return contractClientMock
+			},
+			wantStatus:   http.StatusOK,
+			wantResponse: contractCfg,
+		},
+		{
+			name: "bridge_state",
+			path: "/v1/bridge-state",
+			contractClientBuilder: func(ctrl *gomock.Controller) api.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractCfg, nil)
+				return contractClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.BridgeStateResponse{
+				State: coreum.BridgeStateActive,
+			},
+		},
+		{
+			name: "pending_operations",
+			path: "/v1/pending-operations",
+			contractClientBuilder: func(ctrl *gomock.Controller) api.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return(pendingOperations, nil)
+				return contractClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.PendingOperationsResponse{
+				Operations: pendingOperations,
+			},
+		},
+		{
+			name: "pending_refunds",
+			path: "/v1/pending-refunds/" + address.String(),
+			bridgeClientBuilder: func(ctrl *gomock.Controller) api.BridgeClient {
+				bridgeClientMock := NewMockBridgeClient(ctrl)
+				bridgeClientMock.EXPECT().GetPendingRefunds(gomock.Any(), address).Return(pendingRefunds, nil)
+				return bridgeClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.PendingRefundsResponse{
+				Refunds: pendingRefunds,
+			},
+		},
+		{
+			name:       "pending_refunds_invalid_address",
+			path:       "/v1/pending-refunds/invalid",
+			wantStatus: http.StatusBadRequest,
+			wantResponse: api.ErrorResponse{
+				Error: "invalid address: invalid",
+			},
+		},
+		{
+			name: "fees_collected",
+			path: "/v1/fees-collected/" + address.String(),
+			bridgeClientBuilder: func(ctrl *gomock.Controller) api.BridgeClient {
+				bridgeClientMock := NewMockBridgeClient(ctrl)
+				bridgeClientMock.EXPECT().GetFeesCollected(gomock.Any(), address).Return(fees, nil)
+				return bridgeClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.FeesCollectedResponse{
+				Fees: fees,
+			},
+		},
+		{
+			name:       "fees_collected_without_address",
+			path:       "/v1/fees-collected/",
+			wantStatus: http.StatusNotFound,
+			wantResponse: api.ErrorResponse{
+				Error: "not found",
+			},
+		},
+		{
+			name: "xrpl_to_coreum_transfer",
+			path: "/v1/transfers/xrpl-to-coreum/" + testXRPLTxHash,
+			bridgeClientBuilder: func(ctrl *gomock.Controller) api.BridgeClient {
+				bridgeClientMock := NewMockBridgeClient(ctrl)
+				bridgeClientMock.EXPECT().GetXRPLToCoreumTracingInfo(gomock.Any(), testXRPLTxHash).
+					Return(bridgeclient.XRPLToCoreumTracingInfo{
+						CoreumTx: &sdk.TxResponse{
+							TxHash: testCoreumTxHash,
+						},
+						EvidenceToTxs: []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{
+							{
+								Tx: &sdk.TxResponse{
+									TxHash: testCoreumTxHash,
+								},
+							},
+						},
+					}, nil)
+				return bridgeClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.XRPLToCoreumTransferResponse{
+				XRPLTxHash:       testXRPLTxHash,
+				CoreumTxHash:     testCoreumTxHash,
+				Completed:        true,
+				EvidenceTxHashes: []string{testCoreumTxHash},
+			},
+		},
+		{
+			name:       "xrpl_to_coreum_transfer_invalid_hash",
+			path:       "/v1/transfers/xrpl-to-coreum/invalid",
+			wantStatus: http.StatusBadRequest,
+			wantResponse: api.ErrorResponse{
+				Error: "invalid XRPL tx hash: invalid",
+			},
+		},
+		{
+			name: "coreum_to_xrpl_transfer",
+			path: "/v1/transfers/coreum-to-xrpl/" + testCoreumTxHash,
+			bridgeClientBuilder: func(ctrl *gomock.Controller) api.BridgeClient {
+				bridgeClientMock := NewMockBridgeClient(ctrl)
+				xrplTxHash, err := rippledata.NewHash256(testXRPLTxHash)
+				require.NoError(t, err)
+				bridgeClientMock.EXPECT().GetCoreumToXRPLTracingInfo(gomock.Any(), testCoreumTxHash).
+					Return(bridgeclient.CoreumToXRPLTracingInfo{
+						XRPLTxs: []rippledata.TransactionWithMetaData{
+							{
+								Transaction: &rippledata.Payment{
+									TxBase: rippledata.TxBase{
+										Hash: *xrplTxHash,
+									},
+								},
+							},
+						},
+					}, nil)
+				return bridgeClientMock
+			},
+			wantStatus: http.StatusOK,
+			wantResponse: api.CoreumToXRPLTransferResponse{
+				CoreumTxHash: testCoreumTxHash,
+				XRPLTxHashes: []string{testXRPLTxHash},
+				Completed:    true,
+			},
+		},
+		{
+			name:       "coreum_to_xrpl_transfer_invalid_hash",
+			path:       "/v1/transfers/coreum-to-xrpl/" + strings.Repeat("Z", 64),
+			wantStatus: http.StatusBadRequest,
+			wantResponse: api.ErrorResponse{
+				Error: "invalid Coreum tx hash: " + strings.Repeat("Z", 64),
+			},
+		},
+		{
+			name: "client_error",
+			path: "/v1/pending-operations",
+			contractClientBuilder: func(ctrl *gomock.Controller) api.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return(nil, errors.New("node error"))
+				return contractClientMock
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantResponse: api.ErrorResponse{
+				Error: "failed to handle the request",
+			},
+		},
+		{
+			name:       "not_found",
+			path:       "/v1/unknown",
+			wantStatus: http.StatusNotFound,
+			wantResponse: api.ErrorResponse{
+				Error: "not found",
+			},
+		},
+		{
+			name:       "method_not_allowed",
+			method:     http.MethodPost,
+			path:       "/v1/tokens",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantResponse: api.ErrorResponse{
+				Error: "method not allowed",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			var contractClient api.ContractClient = NewMockContractClient(ctrl)
+			if tt.contractClientBuilder != nil {
+				contractClient = tt.contractClientBuilder(ctrl)
+			}
+			var bridgeClient api.BridgeClient = NewMockBridgeClient(ctrl)
+			if tt.bridgeClientBuilder != nil {
+				bridgeClient = tt.bridgeClientBuilder(ctrl)
+			}
+			server := api.NewServer(
+				api.DefaultServerConfig(), logger.NewAnyLogMock(ctrl), contractClient, bridgeClient, time.Now,
+			)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			res := serveRequest(server.Handler(), httptest.NewRequest(method, tt.path, nil))
+			require.Equal(t, tt.wantStatus, res.Code)
+			require.Equal(t, "application/json", res.Header().Get("Content-Type"))
+
+			wantBody, err := json.Marshal(tt.wantResponse)
+			require.NoError(t, err)
+			require.JSONEq(t, string(wantBody), res.Body.String())
+		})
+	}
+}
+
+func TestServer_CORS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		allowedOrigins []string
+		method         string
+		origin         string
+		wantStatus     int
+		wantOrigin     string
+	}{
+		{
+			name:           "allowed_origin",
+			allowedOrigins: []string{"https://bridge.example.com"},
+			method:         http.MethodGet,
+			origin:         "https://bridge.example.com",
+			wantStatus:     http.StatusOK,
+			wantOrigin:     "https://bridge.example.com",
+		},
+		{
+			name:           "any_origin",
+			allowedOrigins: []string{"*"},
+			method:         http.MethodGet,
+			origin:         "https://explorer.example.com",
+			wantStatus:     http.StatusOK,
+			wantOrigin:     "https://explorer.example.com",
+		},
+		{
+			name:           "not_allowed_origin",
+			allowedOrigins: []string{"https://bridge.example.com"},
+			method:         http.MethodGet,
+			origin:         "https://explorer.example.com",
+			wantStatus:     http.StatusOK,
+		},
+		{
+			name:           "preflight",
+			allowedOrigins: []string{"https://bridge.example.com"},
+			method:         http.MethodOptions,
+			origin:         "https://bridge.example.com",
+			wantStatus:     http.StatusNoContent,
+			wantOrigin:     "https://bridge.example.com",
+		},
+		{
+			name:           "not_allowed_preflight",
+			allowedOrigins: []string{"https://bridge.example.com"},
+			method:         http.MethodOptions,
+			origin:         "https://explorer.example.com",
+			wantStatus:     http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			contractClientMock := NewMockContractClient(ctrl)
+			contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+				BridgeState: coreum.BridgeStateHalted,
+			}, nil).AnyTimes()
+
+			cfg := api.DefaultServerConfig()
+			cfg.CORSAllowedOrigins = tt.allowedOrigins
+			server := api.NewServer(
+				cfg, logger.NewAnyLogMock(ctrl), contractClientMock, NewMockBridgeClient(ctrl), time.Now,
+			)
+
+			req := httptest.NewRequest(tt.method, "/v1/bridge-state", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.method == http.MethodOptions {
+				req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+			}
+			res := serveRequest(server.Handler(), req)
+			require.Equal(t, tt.wantStatus, res.Code)
+			require.Equal(t, tt.wantOrigin, res.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	contractClientMock := NewMockContractClient(ctrl)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		BridgeState: coreum.BridgeStateActive,
+	}, nil).AnyTimes()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := api.DefaultServerConfig()
+	cfg.RateLimit = api.RateLimitConfig{
+		RequestsPerSecond: 1,
+		Burst:             2,
+	}
+	server := api.NewServer(
+		cfg,
+		logger.NewAnyLogMock(ctrl),
+		contractClientMock,
+		NewMockBridgeClient(ctrl),
+		func() time.Time { return now },
+	)
+	handler := server.Handler()
+
+	requestFrom := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/bridge-state", nil)
+		req.RemoteAddr = remoteAddr
+		return serveRequest(handler, req).Code
+	}
+
+	// the burst is allowed
+	require.Equal(t, http.StatusOK, requestFrom("10.0.0.1:1000"))
+	require.Equal(t, http.StatusOK, requestFrom("10.0.0.1:1001"))
+	// the limit is per IP, not per connection
+	require.Equal(t, http.StatusTooManyRequests, requestFrom("10.0.0.1:1002"))
+	// another client isn't limited
+	require.Equal(t, http.StatusOK, requestFrom("10.0.0.2:1000"))
+
+	// the limit is restored with the time
+	now = now.Add(time.Second)
+	require.Equal(t, http.StatusOK, requestFrom("10.0.0.1:1000"))
+	require.Equal(t, http.StatusTooManyRequests, requestFrom("10.0.0.1:1000"))
+}
+
+func TestServer_OpenAPIDocument(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	server := api.NewServer(
+		api.DefaultServerConfig(),
+		logger.NewAnyLogMock(ctrl),
+		NewMockContractClient(ctrl),
+		NewMockBridgeClient(ctrl),
+		time.Now,
+	)
+
+	res := serveRequest(server.Handler(), httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var doc api.OpenAPIDocument
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+	require.Len(t, doc.Paths, 8)
+
+	for path, pathItem := range doc.Paths {
+		okResponse, ok := pathItem.Get.Responses["200"]
+		require.True(t, ok, "path %s", path)
+		require.NotEmpty(t, okResponse.Content["application/json"].Schema.Ref, "path %s", path)
+		if strings.Contains(path, "{") {
+			require.Len(t, pathItem.Get.Parameters, 1, "path %s", path)
+			require.Equal(t, "path", pathItem.Get.Parameters[0].In)
+			require.Contains(t, pathItem.Get.Responses, "400")
+		} else {
+			require.Empty(t, pathItem.Get.Parameters, "path %s", path)
+		}
+	}
+
+	refundsPath := doc.Paths["/v1/pending-refunds/{address}"]
+	require.Equal(t, "address", refundsPath.Get.Parameters[0].Name)
+	require.Equal(
+		t,
+		"#/components/schemas/api.PendingRefundsResponse",
+		refundsPath.Get.Responses["200"].Content["application/json"].Schema.Ref,
+	)
+
+	contractCfgSchema, ok := doc.Components.Schemas["coreum.ContractConfig"]
+	require.True(t, ok)
+	require.Equal(t, "object", contractCfgSchema.Type)
+	// the custom encoded amounts and addresses are strings
+	require.Equal(t, "string", contractCfgSchema.Properties["trust_set_limit_amount"].Type)
+	require.Equal(t, "string", contractCfgSchema.Properties["bridge_state"].Type)
+	require.True(t, contractCfgSchema.Properties["source_tag"].Nullable)
+	relayersSchema := contractCfgSchema.Properties["relayers"]
+	require.Equal(t, "array", relayersSchema.Type)
+	require.Equal(t, "#/components/schemas/coreum.Relayer", relayersSchema.Items.Ref)
+	require.Equal(t, "string", doc.Components.Schemas["coreum.Relayer"].Properties["coreum_address"].Type)
+
+	// the coins are encoded as the array
+	feesSchema := doc.Components.Schemas["api.FeesCollectedResponse"].Properties["fees"]
+	require.Equal(t, "array", feesSchema.Type)
+	require.Equal(t, "#/components/schemas/types.Coin", feesSchema.Items.Ref)
+}
+
+func serveRequest(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	return res
+}
@@ -0,0 +1,80 @@
+package bridgesdk
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// SendEstimation is the estimation of the Coreum to XRPL transfer.
+type SendEstimation struct {
+	// BridgingFee is the bridging fee of the token, charged in the sent denom.
+	BridgingFee sdkmath.Int
+	// TruncatedAmount is the part of the amount removed by the sending precision truncation, collected as a fee.
+	TruncatedAmount sdkmath.Int
+	// ReceivedAmount is the amount the XRPL recipient receives, represented in the sent denom decimals.
+	ReceivedAmount sdkmath.Int
+}
+
+// XRPLToCoreumDelivery is the completed XRPL to Coreum transfer.
+type XRPLToCoreumDelivery struct {
+	XRPLTxHash   string
+	CoreumTxHash string
+	Height       int64
+	Issuer       string
+	Currency     string
+	// Amount is the amount sent on the XRPL side before the bridging fees.
+	Amount    sdkmath.Int
+	Recipient sdk.AccAddress
+}
+
+// EstimateXRPLOriginatedTokenSend estimates the Coreum to XRPL transfer of the XRPL originated token.
+func EstimateXRPLOriginatedTokenSend(token coreum.XRPLToken, amount sdkmath.Int) (SendEstimation, error) {
+	decimals := uint32(xrpl.XRPLIssuedTokenDecimals)
+	if token.Issuer == xrpl.XRPTokenIssuer.String() &&
+		token.Currency == xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency) {
+		decimals = xrpl.XRPCurrencyDecimals
+	}
+
+	return estimateSend(amount, token.BridgingFee, token.SendingPrecision, decimals)
+}
+
+// EstimateCoreumOriginatedTokenSend estimates the Coreum to XRPL transfer of the Coreum originated token.
+func EstimateCoreumOriginatedTokenSend(token coreum.CoreumToken, amount sdkmath.Int) (SendEstimation, error) {
+	return estimateSend(amount, token.BridgingFee, token.SendingPrecision, token.Decimals)
+}
+
+// estimateSend repeats the contract fees and truncation computation.
+func estimateSend(
+	amount, bridgingFee sdkmath.Int,
+	sendingPrecision int32,
+	decimals uint32,
+) (SendEstimation, error) {
+	if amount.LT(bridgingFee) {
+		return SendEstimation{}, errors.Errorf(
+			"amount %s can't cover the bridging fee %s", amount.String(), bridgingFee.String(),
+		)
+	}
+	amountAfterFees := amount.Sub(bridgingFee)
+
+	exponent := int64(decimals) - int64(sendingPrecision)
+	if exponent < 0 {
+		exponent = -exponent
+	}
+	truncationUnit := sdkmath.NewIntWithDecimal(1, int(exponent))
+	receivedAmount := amountAfterFees.Quo(truncationUnit).Mul(truncationUnit)
+	if receivedAmount.IsZero() {
+		return SendEstimation{}, errors.Errorf(
+			"amount %s is zero after the sending precision truncation", amount.String(),
+		)
+	}
+
+	return SendEstimation{
+		BridgingFee:     bridgingFee,
+		TruncatedAmount: amountAfterFees.Sub(receivedAmount),
+		ReceivedAmount:  receivedAmount,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package bridgesdk_test
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/bridgesdk"
+)
+
+func ExampleNew() {
+	cfg := bridgesdk.DefaultConfig()
+	cfg.CoreumGRPCURL = "https://full-node.mainnet-1.coreum.dev:9090"
+	cfg.ContractAddress = "core1zhs909jp9yktml6qqx9f0ptcq2xnhhj99cja03j3lfcsp2pgm86studdrz"
+
+	// the keyring isn't set, so the SDK can be used for the queries only
+	s, err := bridgesdk.New(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	coreumTokens, xrplTokens, err := s.GetTokens(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(len(coreumTokens), len(xrplTokens))
+}
+
+func ExampleSDK_EstimateSend() {
+	var s *bridgesdk.SDK // initialised with the bridgesdk.New
+
+	estimation, err := s.EstimateSend(context.Background(), sdk.NewCoin("ucore", sdkmath.NewInt(1_000_000)))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(estimation.ReceivedAmount.String())
+}
+
+func ExampleSDK_SubscribeXRPLToCoreumDeliveries() {
+	var s *bridgesdk.SDK // initialised with the bridgesdk.New
+	recipient := sdk.MustAccAddressFromBech32("core1ssh2d2ft6hzrgn9z6k7mmsamy2hfpxl9y5yrgp")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := make(chan bridgesdk.XRPLToCoreumDelivery)
+	go func() {
+		if err := s.SubscribeXRPLToCoreumDeliveries(ctx, []sdk.AccAddress{recipient}, ch); err != nil {
+			fmt.Println(err)
+		}
+	}()
+
+	for delivery := range ch {
+		fmt.Println(delivery.XRPLTxHash, delivery.Amount.String())
+	}
+}
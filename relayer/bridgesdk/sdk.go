@@ -0,0 +1,338 @@
+package bridgesdk
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+//go:generate mockgen -source=sdk.go -destination=sdk_mocks_test.go -package=bridgesdk_test
+
+// ContractClient is the bridge contract client used by the SDK.
+type ContractClient interface {
+	SendToXRPL(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		recipient string,
+		amount sdk.Coin,
+		deliverAmount *sdkmath.Int,
+	) (*sdk.TxResponse, error)
+	ClaimRefund(ctx context.Context, sender sdk.AccAddress, pendingRefundID string) (*sdk.TxResponse, error)
+	GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error)
+	GetCoreumTokens(ctx context.Context) ([]coreum.CoreumToken, error)
+	GetXRPLTokens(ctx context.Context) ([]coreum.XRPLToken, error)
+	GetXRPLToCoreumTransfers(
+		ctx context.Context,
+		filter coreum.TransfersFilter,
+	) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error)
+	GetLatestBlockHeight(ctx context.Context) (int64, error)
+}
+
+// BridgeClient is the bridge client used by the SDK for the cross-chain queries.
+type BridgeClient interface {
+	GetXRPLToCoreumTracingInfo(ctx context.Context, xrplTxHash string) (bridgeclient.XRPLToCoreumTracingInfo, error)
+	GetCoreumToXRPLTracingInfo(ctx context.Context, coreumTxHash string) (bridgeclient.CoreumToXRPLTracingInfo, error)
+}
+
+// Config is the SDK config.
+type Config struct {
+	// CoreumGRPCURL is the Coreum GRPC URL, e.g. https://full-node.mainnet-1.coreum.dev:9090.
+	CoreumGRPCURL string
+	// CoreumChainID is the Coreum chain ID.
+	CoreumChainID string
+	// ContractAddress is the bridge contract address.
+	ContractAddress string
+	// XRPLRPCURL is the XRPL RPC URL, required only for the tracing of the transfers.
+	XRPLRPCURL string
+	// CoreumKeyring is the keyring with the senders keys, required only for the tx signing methods.
+	CoreumKeyring keyring.Keyring
+	// DeliveriesPollInterval is the interval of the deliveries subscription polling.
+	DeliveriesPollInterval time.Duration
+	// Log is the logger, the default zap logger is used if not provided.
+	Log logger.Logger
+}
+
+// DefaultConfig returns the default SDK config.
+func DefaultConfig() Config {
+	return Config{
+		CoreumChainID:          string(runner.DefaultCoreumChainID),
+		DeliveriesPollInterval: 5 * time.Second,
+	}
+}
+
+// SDK is the bridge facade for the third-party integrators.
+type SDK struct {
+	cfg            Config
+	log            logger.Logger
+	contractClient ContractClient
+	bridgeClient   BridgeClient
+}
+
+// New builds the SDK from the config.
+func New(cfg Config) (*SDK, error) {
+	if cfg.CoreumGRPCURL == "" {
+		return nil, errors.New("coreum GRPC URL is required")
+	}
+	if cfg.ContractAddress == "" {
+		return nil, errors.New("contract address is required")
+	}
+
+	log := cfg.Log
+	if log == nil {
+		zapLogger, err := logger.NewZapLogger(logger.DefaultZapLoggerConfig())
+		if err != nil {
+			return nil, err
+		}
+		log = zapLogger
+	}
+
+	runnerCfg := runner.DefaultConfig()
+	runnerCfg.Coreum.GRPC.URL = cfg.CoreumGRPCURL
+	runnerCfg.Coreum.Network.ChainID = cfg.CoreumChainID
+	runnerCfg.Coreum.Contract.ContractAddress = cfg.ContractAddress
+	runnerCfg.XRPL.RPC.URL = cfg.XRPLRPCURL
+
+	components, err := runner.NewComponents(
+		runnerCfg,
+		client.Context{},
+		client.Context{}.WithKeyring(cfg.CoreumKeyring),
+		log,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build bridge components")
+	}
+
+	bridgeClient := bridgeclient.NewBridgeClient(
+		components.Log,
+		components.CoreumClientCtx,
+		components.CoreumContractClient,
+		components.XRPLRPCClient,
+		nil,
+	)
+
+	return NewWithClients(cfg, components.Log, components.CoreumContractClient, bridgeClient), nil
+}
+
+// NewWithClients builds the SDK from the already initialised clients.
+func NewWithClients(
+	cfg Config,
+	log logger.Logger,
+	contractClient ContractClient,
+	bridgeClient BridgeClient,
+) *SDK {
+	if cfg.DeliveriesPollInterval == 0 {
+		cfg.DeliveriesPollInterval = DefaultConfig().DeliveriesPollInterval
+	}
+
+	return &SDK{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		bridgeClient:   bridgeClient,
+	}
+}
+
+// SendToXRPL sends the coins from the Coreum sender to the XRPL recipient and returns the Coreum tx hash.
+func (s *SDK) SendToXRPL(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	recipient string,
+	amount sdk.Coin,
+	deliverAmount *sdkmath.Int,
+) (string, error) {
+	if err := s.assertCanSign(); err != nil {
+		return "", err
+	}
+	recipientAccount, err := xrpl.ParseAccount(recipient, s.cfg.CoreumChainID == string(constant.ChainIDMain))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse recipient")
+	}
+	txRes, err := s.contractClient.SendToXRPL(ctx, sender, recipientAccount.String(), amount, deliverAmount)
+	if err != nil {
+		return "", err
+	}
+
+	return txRes.TxHash, nil
+}
+
+// EstimateSend estimates the amount received on the XRPL side if the amount is sent with the SendToXRPL.
+func (s *SDK) EstimateSend(ctx context.Context, amount sdk.Coin) (SendEstimation, error) {
+	xrplTokens, err := s.contractClient.GetXRPLTokens(ctx)
+	if err != nil {
+		return SendEstimation{}, err
+	}
+	for _, token := range xrplTokens {
+		if token.CoreumDenom == amount.Denom {
+			return EstimateXRPLOriginatedTokenSend(token, amount.Amount)
+		}
+	}
+
+	coreumTokens, err := s.contractClient.GetCoreumTokens(ctx)
+	if err != nil {
+		return SendEstimation{}, err
+	}
+	for _, token := range coreumTokens {
+		if token.Denom == amount.Denom {
+			return EstimateCoreumOriginatedTokenSend(token, amount.Amount)
+		}
+	}
+
+	return SendEstimation{}, errors.Errorf("token %s is not registered in the bridge", amount.Denom)
+}
+
+// GetPendingRefunds returns the pending refunds of the address.
+func (s *SDK) GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error) {
+	return s.contractClient.GetPendingRefunds(ctx, address)
+}
+
+// ClaimRefund claims the pending refund and returns the Coreum tx hash.
+func (s *SDK) ClaimRefund(ctx context.Context, address sdk.AccAddress, refundID string) (string, error) {
+	if err := s.assertCanSign(); err != nil {
+		return "", err
+	}
+	txRes, err := s.contractClient.ClaimRefund(ctx, address, refundID)
+	if err != nil {
+		return "", err
+	}
+
+	return txRes.TxHash, nil
+}
+
+// TraceXRPLToCoreumTransfer returns the tracing info of the XRPL to Coreum transfer.
+func (s *SDK) TraceXRPLToCoreumTransfer(
+	ctx context.Context,
+	xrplTxHash string,
+) (bridgeclient.XRPLToCoreumTracingInfo, error) {
+	return s.bridgeClient.GetXRPLToCoreumTracingInfo(ctx, xrplTxHash)
+}
+
+// TraceCoreumToXRPLTransfer returns the tracing info of the Coreum to XRPL transfer.
+func (s *SDK) TraceCoreumToXRPLTransfer(
+	ctx context.Context,
+	coreumTxHash string,
+) (bridgeclient.CoreumToXRPLTracingInfo, error) {
+	return s.bridgeClient.GetCoreumToXRPLTracingInfo(ctx, coreumTxHash)
+}
+
+// GetTokens returns all tokens registered in the bridge.
+func (s *SDK) GetTokens(ctx context.Context) ([]coreum.CoreumToken, []coreum.XRPLToken, error) {
+	coreumTokens, err := s.contractClient.GetCoreumTokens(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	xrplTokens, err := s.contractClient.GetXRPLTokens(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return coreumTokens, xrplTokens, nil
+}
+
+// SubscribeXRPLToCoreumDeliveries streams the XRPL to Coreum deliveries to the provided addresses into the channel.
+// Only the deliveries completed after the subscription start are streamed. The function blocks until the context is
+// canceled.
+func (s *SDK) SubscribeXRPLToCoreumDeliveries(
+	ctx context.Context,
+	recipients []sdk.AccAddress,
+	ch chan<- XRPLToCoreumDelivery,
+) error {
+	if len(recipients) == 0 {
+		return errors.New("at least one recipient is required")
+	}
+
+	s.log.Info(ctx, "Subscribing on XRPL to Coreum deliveries", zap.Int("recipients", len(recipients)))
+
+	// each recipient has the height the next poll fetches its deliveries from, so each poll fetches only the
+	// deliveries completed after the previous one
+	latestHeight, err := s.contractClient.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+	fromHeights := make(map[string]int64, len(recipients))
+	for _, recipient := range recipients {
+		fromHeights[recipient.String()] = latestHeight + 1
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.DeliveriesPollInterval):
+		}
+
+		if err := s.streamNewDeliveries(ctx, fromHeights, ch); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.log.Error(ctx, "Failed to fetch XRPL to Coreum deliveries", zap.Error(err))
+		}
+	}
+}
+
+// streamNewDeliveries streams the deliveries of each recipient from its height up to the block before the latest one,
+// since the txs of the latest block might not be indexed yet, and moves the recipient height after the streamed
+// range.
+func (s *SDK) streamNewDeliveries(
+	ctx context.Context,
+	fromHeights map[string]int64,
+	ch chan<- XRPLToCoreumDelivery,
+) error {
+	latestHeight, err := s.contractClient.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+	toHeight := latestHeight - 1
+	for recipient, fromHeight := range fromHeights {
+		if fromHeight > toHeight {
+			continue
+		}
+		transfers, err := s.contractClient.GetXRPLToCoreumTransfers(ctx, coreum.TransfersFilter{
+			StartHeight: fromHeight,
+			EndHeight:   toHeight,
+			Recipient:   recipient,
+		})
+		if err != nil {
+			return err
+		}
+		for _, transfer := range transfers {
+			delivery := XRPLToCoreumDelivery{
+				XRPLTxHash:   transfer.Evidence.TxHash,
+				CoreumTxHash: transfer.Tx.TxHash,
+				Height:       transfer.Tx.Height,
+				Issuer:       transfer.Evidence.Issuer,
+				Currency:     transfer.Evidence.Currency,
+				Amount:       transfer.Evidence.Amount,
+				Recipient:    transfer.Evidence.Recipient,
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ch <- delivery:
+			}
+		}
+		fromHeights[recipient] = toHeight + 1
+	}
+
+	return nil
+}
+
+func (s *SDK) assertCanSign() error {
+	if s.cfg.CoreumKeyring == nil {
+		return errors.New("coreum keyring is required to sign the transactions")
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sdk.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sdk.go -destination=sdk_mocks_test.go -package=bridgesdk_test
+//
+
+// Package bridgesdk_test is a generated GoMock package.
+package bridgesdk_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	math "cosmossdk.io/math"
+	client "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	coreum "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContractClient is a mock of ContractClient interface.
+type MockContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractClientMockRecorder
+}
+
+// MockContractClientMockRecorder is the mock recorder for MockContractClient.
+type MockContractClientMockRecorder struct {
+	mock *MockContractClient
+}
+
+// NewMockContractClient creates a new mock instance.
+func NewMockContractClient(ctrl *gomock.Controller) *MockContractClient {
+	mock := &MockContractClient{ctrl: ctrl}
+	mock.recorder = &MockContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractClient) EXPECT() *MockContractClientMockRecorder {
+	return m.recorder
+}
+
+// ClaimRefund mocks base method.
+func (m *MockContractClient) ClaimRefund(ctx context.Context, sender types.AccAddress, pendingRefundID string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRefund", ctx, sender, pendingRefundID)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRefund indicates an expected call of ClaimRefund.
+func (mr *MockContractClientMockRecorder) ClaimRefund(ctx, sender, pendingRefundID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRefund", reflect.TypeOf((*MockContractClient)(nil).ClaimRefund), ctx, sender, pendingRefundID)
+}
+
+// GetCoreumTokens mocks base method.
+func (m *MockContractClient) GetCoreumTokens(ctx context.Context) ([]coreum.CoreumToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumTokens", ctx)
+	ret0, _ := ret[0].([]coreum.CoreumToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumTokens indicates an expected call of GetCoreumTokens.
+func (mr *MockContractClientMockRecorder) GetCoreumTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumTokens", reflect.TypeOf((*MockContractClient)(nil).GetCoreumTokens), ctx)
+}
+
+// GetLatestBlockHeight mocks base method.
+func (m *MockContractClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestBlockHeight", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestBlockHeight indicates an expected call of GetLatestBlockHeight.
+func (mr *MockContractClientMockRecorder) GetLatestBlockHeight(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestBlockHeight", reflect.TypeOf((*MockContractClient)(nil).GetLatestBlockHeight), ctx)
+}
+
+// GetPendingRefunds mocks base method.
+func (m *MockContractClient) GetPendingRefunds(ctx context.Context, address types.AccAddress) ([]coreum.PendingRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRefunds", ctx, address)
+	ret0, _ := ret[0].([]coreum.PendingRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRefunds indicates an expected call of GetPendingRefunds.
+func (mr *MockContractClientMockRecorder) GetPendingRefunds(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRefunds", reflect.TypeOf((*MockContractClient)(nil).GetPendingRefunds), ctx, address)
+}
+
+// GetXRPLToCoreumTransfers mocks base method.
+func (m *MockContractClient) GetXRPLToCoreumTransfers(ctx context.Context, filter coreum.TransfersFilter) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLToCoreumTransfers", ctx, filter)
+	ret0, _ := ret[0].([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLToCoreumTransfers indicates an expected call of GetXRPLToCoreumTransfers.
+func (mr *MockContractClientMockRecorder) GetXRPLToCoreumTransfers(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLToCoreumTransfers", reflect.TypeOf((*MockContractClient)(nil).GetXRPLToCoreumTransfers), ctx, filter)
+}
+
+// GetXRPLTokens mocks base method.
+func (m *MockContractClient) GetXRPLTokens(ctx context.Context) ([]coreum.XRPLToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLTokens", ctx)
+	ret0, _ := ret[0].([]coreum.XRPLToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLTokens indicates an expected call of GetXRPLTokens.
+func (mr *MockContractClientMockRecorder) GetXRPLTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLTokens", reflect.TypeOf((*MockContractClient)(nil).GetXRPLTokens), ctx)
+}
+
+// SendToXRPL mocks base method.
+func (m *MockContractClient) SendToXRPL(ctx context.Context, sender types.AccAddress, recipient string, amount types.Coin, deliverAmount *math.Int) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendToXRPL", ctx, sender, recipient, amount, deliverAmount)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendToXRPL indicates an expected call of SendToXRPL.
+func (mr *MockContractClientMockRecorder) SendToXRPL(ctx, sender, recipient, amount, deliverAmount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendToXRPL", reflect.TypeOf((*MockContractClient)(nil).SendToXRPL), ctx, sender, recipient, amount, deliverAmount)
+}
+
+// MockBridgeClient is a mock of BridgeClient interface.
+type MockBridgeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeClientMockRecorder
+}
+
+// MockBridgeClientMockRecorder is the mock recorder for MockBridgeClient.
+type MockBridgeClientMockRecorder struct {
+	mock *MockBridgeClient
+}
+
+// NewMockBridgeClient creates a new mock instance.
+func NewMockBridgeClient(ctrl *gomock.Controller) *MockBridgeClient {
+	mock := &MockBridgeClient{ctrl: ctrl}
+	mock.recorder = &MockBridgeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeClient) EXPECT() *MockBridgeClientMockRecorder {
+	return m.recorder
+}
+
+// GetCoreumToXRPLTracingInfo mocks base method.
+func (m *MockBridgeClient) GetCoreumToXRPLTracingInfo(ctx context.Context, coreumTxHash string) (client.CoreumToXRPLTracingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumToXRPLTracingInfo", ctx, coreumTxHash)
+	ret0, _ := ret[0].(client.CoreumToXRPLTracingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumToXRPLTracingInfo indicates an expected call of GetCoreumToXRPLTracingInfo.
+func (mr *MockBridgeClientMockRecorder) GetCoreumToXRPLTracingInfo(ctx, coreumTxHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumToXRPLTracingInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetCoreumToXRPLTracingInfo), ctx, coreumTxHash)
+}
+
+// GetXRPLToCoreumTracingInfo mocks base method.
+func (m *MockBridgeClient) GetXRPLToCoreumTracingInfo(ctx context.Context, xrplTxHash string) (client.XRPLToCoreumTracingInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLToCoreumTracingInfo", ctx, xrplTxHash)
+	ret0, _ := ret[0].(client.XRPLToCoreumTracingInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLToCoreumTracingInfo indicates an expected call of GetXRPLToCoreumTracingInfo.
+func (mr *MockBridgeClientMockRecorder) GetXRPLToCoreumTracingInfo(ctx, xrplTxHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLToCoreumTracingInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetXRPLToCoreumTracingInfo), ctx, xrplTxHash)
+}
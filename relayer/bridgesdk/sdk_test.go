@@ -0,0 +1,216 @@
+package bridgesdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/bridgesdk"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSDK_EstimateSend(t *testing.T) {
+	t.Parallel()
+
+	xrpToken := coreum.XRPLToken{
+		Issuer:           xrpl.XRPTokenIssuer.String(),
+		Currency:         xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+		CoreumDenom:      "drop",
+		SendingPrecision: 4,
+		BridgingFee:      sdkmath.NewInt(100),
+	}
+	xrplOriginatedToken := coreum.XRPLToken{
+		Issuer:           xrpl.GenPrivKeyTxSigner().Account().String(),
+		Currency:         "CRN",
+		CoreumDenom:      "xrplcrn",
+		SendingPrecision: 10,
+		BridgingFee:      sdkmath.ZeroInt(),
+	}
+	coreumToken := coreum.CoreumToken{
+		Denom:            "ucore",
+		Decimals:         6,
+		SendingPrecision: 2,
+		BridgingFee:      sdkmath.NewInt(1),
+	}
+
+	tests := []struct {
+		name    string
+		amount  sdk.Coin
+		want    bridgesdk.SendEstimation
+		wantErr bool
+	}{
+		{
+			name:   "xrp",
+			amount: sdk.NewCoin(xrpToken.CoreumDenom, sdkmath.NewInt(1_234_567)),
+			want: bridgesdk.SendEstimation{
+				BridgingFee:     sdkmath.NewInt(100),
+				TruncatedAmount: sdkmath.NewInt(67),
+				ReceivedAmount:  sdkmath.NewInt(1_234_400),
+			},
+		},
+		{
+			name:   "xrpl_originated_token",
+			amount: sdk.NewCoin(xrplOriginatedToken.CoreumDenom, sdkmath.NewIntWithDecimal(1, 15).AddRaw(1)),
+			want: bridgesdk.SendEstimation{
+				BridgingFee:     sdkmath.ZeroInt(),
+				TruncatedAmount: sdkmath.NewInt(1),
+				ReceivedAmount:  sdkmath.NewIntWithDecimal(1, 15),
+			},
+		},
+		{
+			name:   "coreum_originated_token",
+			amount: sdk.NewCoin(coreumToken.Denom, sdkmath.NewInt(1_000_001)),
+			want: bridgesdk.SendEstimation{
+				BridgingFee:     sdkmath.NewInt(1),
+				TruncatedAmount: sdkmath.ZeroInt(),
+				ReceivedAmount:  sdkmath.NewInt(1_000_000),
+			},
+		},
+		{
+			name:    "amount_lower_than_bridging_fee",
+			amount:  sdk.NewCoin(xrpToken.CoreumDenom, sdkmath.NewInt(99)),
+			wantErr: true,
+		},
+		{
+			name:    "zero_after_truncation",
+			amount:  sdk.NewCoin(coreumToken.Denom, sdkmath.NewInt(1_000)),
+			wantErr: true,
+		},
+		{
+			name:    "not_registered_token",
+			amount:  sdk.NewCoin("unknown", sdkmath.NewInt(1_000)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			contractClientMock := NewMockContractClient(ctrl)
+			contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).
+				Return([]coreum.XRPLToken{xrpToken, xrplOriginatedToken}, nil)
+			contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).
+				Return([]coreum.CoreumToken{coreumToken}, nil).AnyTimes()
+
+			s := bridgesdk.NewWithClients(
+				bridgesdk.DefaultConfig(), logger.NewAnyLogMock(ctrl), contractClientMock, NewMockBridgeClient(ctrl),
+			)
+			got, err := s.EstimateSend(context.Background(), tt.amount)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want.BridgingFee.String(), got.BridgingFee.String())
+			require.Equal(t, tt.want.TruncatedAmount.String(), got.TruncatedAmount.String())
+			require.Equal(t, tt.want.ReceivedAmount.String(), got.ReceivedAmount.String())
+		})
+	}
+}
+
+func TestSDK_SignWithoutKeyring(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	s := bridgesdk.NewWithClients(
+		bridgesdk.DefaultConfig(), logger.NewAnyLogMock(ctrl), NewMockContractClient(ctrl), NewMockBridgeClient(ctrl),
+	)
+
+	_, err := s.SendToXRPL(
+		context.Background(),
+		coreum.GenAccount(),
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		sdk.NewCoin("ucore", sdkmath.NewInt(1)),
+		nil,
+	)
+	require.ErrorContains(t, err, "keyring is required")
+
+	_, err = s.ClaimRefund(context.Background(), coreum.GenAccount(), "refund-id")
+	require.ErrorContains(t, err, "keyring is required")
+}
+
+func TestSDK_SubscribeXRPLToCoreumDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	recipient := coreum.GenAccount()
+	newTransfer := coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{
+		Evidence: coreum.XRPLToCoreumTransferEvidence{
+			TxHash:    "new",
+			Issuer:    "issuer",
+			Currency:  "currency",
+			Amount:    sdkmath.NewInt(2),
+			Recipient: recipient,
+		},
+		Tx: &sdk.TxResponse{TxHash: "coreum-new", Height: 12},
+	}
+
+	ctrl := gomock.NewController(t)
+	contractClientMock := NewMockContractClient(ctrl)
+	gomock.InOrder(
+		// the deliveries are streamed from the block after the latest one at the subscription start
+		contractClientMock.EXPECT().GetLatestBlockHeight(gomock.Any()).Return(int64(10), nil),
+		// the latest block isn't fetched since its txs might not be indexed yet
+		contractClientMock.EXPECT().GetLatestBlockHeight(gomock.Any()).Return(int64(11), nil),
+		contractClientMock.EXPECT().GetLatestBlockHeight(gomock.Any()).Return(int64(13), nil),
+		contractClientMock.EXPECT().GetXRPLToCoreumTransfers(gomock.Any(), coreum.TransfersFilter{
+			StartHeight: 11,
+			EndHeight:   12,
+			Recipient:   recipient.String(),
+		}).Return([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{newTransfer}, nil),
+		// the next polls start after the fetched blocks
+		contractClientMock.EXPECT().GetLatestBlockHeight(gomock.Any()).Return(int64(14), nil),
+		contractClientMock.EXPECT().GetXRPLToCoreumTransfers(gomock.Any(), coreum.TransfersFilter{
+			StartHeight: 13,
+			EndHeight:   13,
+			Recipient:   recipient.String(),
+		}).Return(nil, nil),
+		contractClientMock.EXPECT().GetLatestBlockHeight(gomock.Any()).Return(int64(14), nil).AnyTimes(),
+	)
+
+	cfg := bridgesdk.DefaultConfig()
+	cfg.DeliveriesPollInterval = time.Millisecond
+	s := bridgesdk.NewWithClients(cfg, logger.NewAnyLogMock(ctrl), contractClientMock, NewMockBridgeClient(ctrl))
+
+	ch := make(chan bridgesdk.XRPLToCoreumDelivery)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.SubscribeXRPLToCoreumDeliveries(ctx, []sdk.AccAddress{recipient}, ch)
+	}()
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for the delivery")
+	case delivery := <-ch:
+		require.Equal(t, bridgesdk.XRPLToCoreumDelivery{
+			XRPLTxHash:   "new",
+			CoreumTxHash: "coreum-new",
+			Height:       12,
+			Issuer:       "issuer",
+			Currency:     "currency",
+			Amount:       sdkmath.NewInt(2),
+			Recipient:    recipient,
+		}, delivery)
+	}
+
+	// no more deliveries are expected
+	select {
+	case delivery := <-ch:
+		t.Fatalf("unexpected delivery: %+v", delivery)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+}
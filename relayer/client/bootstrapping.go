@@ -0,0 +1,129 @@
+//nolint:tagliatelle // yaml spec
+package client
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// BootstrappingProgressFileName is the name of the bootstrapping progress file stored in the relayer home.
+const BootstrappingProgressFileName = "bootstrapping-progress.yaml"
+
+// BootstrappingProgress records the completed bridge bootstrapping steps, so the failed bootstrapping can be
+// resumed from the first incomplete step.
+type BootstrappingProgress struct {
+	XRPLBridgeAddress      string `yaml:"xrpl_bridge_address"`
+	XRPLAccountFunded      bool   `yaml:"xrpl_account_funded"`
+	ContractCodeID         uint64 `yaml:"contract_code_id,omitempty"`
+	ContractAddress        string `yaml:"contract_address,omitempty"`
+	EnableRipplingTxHash   string `yaml:"enable_rippling_tx_hash,omitempty"`
+	SignerListSetTxHash    string `yaml:"signer_list_set_tx_hash,omitempty"`
+	DisableMasterKeyTxHash string `yaml:"disable_master_key_tx_hash,omitempty"`
+}
+
+// ReadBootstrappingProgress reads the bootstrapping progress file, the empty progress is returned if the file path
+// is empty or the file does not exist.
+func ReadBootstrappingProgress(filePath string) (BootstrappingProgress, error) {
+	if filePath == "" {
+		return BootstrappingProgress{}, nil
+	}
+	if _, err := os.Stat(filePath); errors.Is(err, os.ErrNotExist) {
+		return BootstrappingProgress{}, nil
+	}
+
+	fileBytes, err := readConfigFromFile(filePath)
+	if err != nil {
+		return BootstrappingProgress{}, err
+	}
+
+	var progress BootstrappingProgress
+	if err := yaml.Unmarshal(fileBytes, &progress); err != nil {
+		return BootstrappingProgress{}, errors.Wrapf(err, "failed to unmarshal file to yaml, path:%s", filePath)
+	}
+
+	return progress, nil
+}
+
+// RemoveBootstrappingProgress removes the bootstrapping progress file, so the next bootstrapping starts from scratch.
+func RemoveBootstrappingProgress(filePath string) error {
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrapf(err, "failed to remove bootstrapping progress file, path:%s", filePath)
+	}
+
+	return nil
+}
+
+func saveBootstrappingProgress(filePath string, progress BootstrappingProgress) error {
+	if filePath == "" {
+		return nil
+	}
+
+	return saveConfigToFile(filePath, progress)
+}
+
+// bootstrappingConfigFieldComments are the explanations written above the fields of the generated bootstrapping
+// config template.
+var bootstrappingConfigFieldComments = map[string]string{
+	"owner":          "Coreum address of the contract owner, the owner manages the tokens, relayers and bridge state.",
+	"admin":          "Coreum address of the contract admin, the admin is allowed to migrate the contract.",
+	"relayers":       "Relayers of the bridge, the keys are printed by the \"relayer-keys\" command of each relayer.",
+	"coreum_address": "Coreum address of the relayer used to sign the evidences.",
+	"xrpl_address":   "XRPL address of the relayer, the address is added to the bridge account signer list.",
+	"xrpl_pub_key":   "XRPL public key of the relayer, the key must correspond to the XRPL address.",
+	"evidence_threshold": "Number of the relayers evidences required to confirm an operation, " +
+		"must not exceed the relayers count.",
+	"used_ticket_sequence_threshold": "Number of the used tickets triggering the tickets re-allocation.",
+	"trust_set_limit_amount":         "Limit amount of the trust lines set by the bridge for the registered XRPL tokens.",
+	"contract_bytecode_path":         "Path to the bridge contract wasm bytecode.",
+	"xrpl_base_fee":                  "XRPL base fee in drops used for the XRPL transactions fee calculation.",
+	"max_xrpl_tx_fee":                "Optional max fee in drops of the multi-signing XRPL transactions.",
+	"source_tag":                     "Optional source tag set to all outgoing XRPL Payment and TrustSet transactions.",
+	"max_evidences_per_relayer_per_block": "Optional max number of the evidences each relayer can save in a block, " +
+		"the exceeding evidences are rejected.",
+}
+
+// EncodeBootstrappingConfigTemplate encodes the bootstrapping config to yaml with the explanation comment above
+// every field.
+func EncodeBootstrappingConfigTemplate(cfg BootstrappingConfig) ([]byte, error) {
+	var node yaml.Node
+	if err := node.Encode(cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to encode bootstrapping config to yaml node")
+	}
+	if err := commentYAMLMappingKeys(&node, bootstrappingConfigFieldComments); err != nil {
+		return nil, err
+	}
+
+	out, err := yaml.Marshal(&node)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal bootstrapping config template")
+	}
+
+	return out, nil
+}
+
+func commentYAMLMappingKeys(node *yaml.Node, comments map[string]string) error {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			key := node.Content[i]
+			comment, ok := comments[key.Value]
+			if !ok {
+				return errors.Errorf("no template comment for the field %q", key.Value)
+			}
+			key.HeadComment = comment
+			if err := commentYAMLMappingKeys(node.Content[i+1], comments); err != nil {
+				return err
+			}
+		}
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for i, item := range node.Content {
+			if err := commentYAMLMappingKeys(item, comments); err != nil {
+				return errors.Wrapf(err, "invalid item %d", i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,362 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const (
+	stepDeployContract      = "deploy-contract"
+	stepInstantiateContract = "instantiate-contract"
+	stepEnableRippling      = "enable-rippling"
+	stepSetSignerList       = "set-signer-list"
+	stepDisableMasterKey    = "disable-master-key"
+)
+
+func TestBootstrap_Resume(t *testing.T) {
+	t.Parallel()
+
+	steps := []string{
+		stepDeployContract,
+		stepInstantiateContract,
+		stepEnableRippling,
+		stepSetSignerList,
+		stepDisableMasterKey,
+	}
+	for _, failingStep := range steps {
+		failingStep := failingStep
+		t.Run(failingStep, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			contractClient := &fakeBootstrappingContractClient{}
+			xrplRPCClient := newFakeBootstrappingXRPLRPCClient()
+			bridgeClient := client.NewBridgeClient(
+				newTestLogger(t), newTestBootstrappingCoreumClientCtx(t), contractClient, xrplRPCClient, xrplRPCClient,
+			)
+			cfg := newTestBootstrappingConfig(t)
+			progressFilePath := filepath.Join(t.TempDir(), client.BootstrappingProgressFileName)
+
+			contractClient.failingStep = failingStep
+			xrplRPCClient.failingStep = failingStep
+			_, err := bridgeClient.Bootstrap(ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath)
+			require.ErrorContains(t, err, failingStep)
+
+			contractClient.failingStep = ""
+			xrplRPCClient.failingStep = ""
+			contractAddress, err := bridgeClient.Bootstrap(
+				ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath,
+			)
+			require.NoError(t, err)
+			require.Equal(t, contractClient.contractAddress, contractAddress)
+
+			// each step is executed once
+			require.Equal(t, 1, contractClient.deployCalls)
+			require.Equal(t, 1, contractClient.instantiateCalls)
+			require.Equal(t, map[string]int{
+				stepEnableRippling:   1,
+				stepSetSignerList:    1,
+				stepDisableMasterKey: 1,
+			}, xrplRPCClient.submittedSteps)
+
+			progress, err := client.ReadBootstrappingProgress(progressFilePath)
+			require.NoError(t, err)
+			require.Equal(t, xrplRPCClient.bridgeAccount.String(), progress.XRPLBridgeAddress)
+			require.True(t, progress.XRPLAccountFunded)
+			require.Equal(t, contractClient.codeID, progress.ContractCodeID)
+			require.Equal(t, contractAddress.String(), progress.ContractAddress)
+			require.NotEmpty(t, progress.EnableRipplingTxHash)
+			require.NotEmpty(t, progress.SignerListSetTxHash)
+			require.NotEmpty(t, progress.DisableMasterKeyTxHash)
+
+			// the completed bootstrapping is not repeated
+			_, err = bridgeClient.Bootstrap(ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath)
+			require.NoError(t, err)
+			require.Equal(t, 1, contractClient.deployCalls)
+			require.Equal(t, 1, contractClient.instantiateCalls)
+			require.Len(t, xrplRPCClient.submittedSteps, 3)
+		})
+	}
+}
+
+func TestBootstrap_RecordedStepEffectDoesNotHold(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	contractClient := &fakeBootstrappingContractClient{}
+	xrplRPCClient := newFakeBootstrappingXRPLRPCClient()
+	bridgeClient := client.NewBridgeClient(
+		newTestLogger(t), newTestBootstrappingCoreumClientCtx(t), contractClient, xrplRPCClient, xrplRPCClient,
+	)
+	cfg := newTestBootstrappingConfig(t)
+	progressFilePath := filepath.Join(t.TempDir(), client.BootstrappingProgressFileName)
+
+	xrplRPCClient.failingStep = stepSetSignerList
+	_, err := bridgeClient.Bootstrap(ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath)
+	require.ErrorContains(t, err, stepSetSignerList)
+
+	// the rippling is disabled after the step is completed
+	xrplRPCClient.failingStep = ""
+	xrplRPCClient.flags &^= rippledata.LsDefaultRipple
+	_, err = bridgeClient.Bootstrap(ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath)
+	require.ErrorContains(t, err, "its effect doesn't hold")
+
+	// the restart ignores the progress
+	require.NoError(t, client.RemoveBootstrappingProgress(progressFilePath))
+	_, err = bridgeClient.Bootstrap(ctx, coreum.GenAccount(), bridgeAccountKeyName, cfg, progressFilePath)
+	require.NoError(t, err)
+	require.Equal(t, 2, contractClient.deployCalls)
+	require.Equal(t, 2, xrplRPCClient.submittedSteps[stepEnableRippling])
+	require.Equal(t, 1, xrplRPCClient.submittedSteps[stepSetSignerList])
+}
+
+const bridgeAccountKeyName = "bridge-account"
+
+func newTestBootstrappingConfig(t *testing.T) client.BootstrappingConfig {
+	t.Helper()
+
+	contractByteCodePath := filepath.Join(t.TempDir(), "contract.wasm")
+	require.NoError(t, os.WriteFile(contractByteCodePath, []byte("bytecode"), 0o600))
+
+	cfg := client.DefaultBootstrappingConfig()
+	cfg.Owner = coreum.GenAccount().String()
+	cfg.Admin = coreum.GenAccount().String()
+	relayerXRPLSigner := xrpl.GenPrivKeyTxSigner()
+	cfg.Relayers = []client.RelayerConfig{
+		{
+			CoreumAddress: coreum.GenAccount().String(),
+			XRPLAddress:   relayerXRPLSigner.Account().String(),
+			XRPLPubKey:    relayerXRPLSigner.PubKey().String(),
+		},
+	}
+	cfg.EvidenceThreshold = 1
+	cfg.ContractByteCodePath = contractByteCodePath
+
+	return cfg
+}
+
+// newTestBootstrappingCoreumClientCtx returns the client context connected to the in-memory gRPC server which knows
+// all the Coreum accounts.
+func newTestBootstrappingCoreumClientCtx(t *testing.T) coreumclient.Context {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	authtypes.RegisterQueryServer(server, &fakeAuthQueryServer{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return coreumclient.Context{}.WithGRPCClient(conn)
+}
+
+type fakeAuthQueryServer struct {
+	authtypes.UnimplementedQueryServer
+}
+
+func (*fakeAuthQueryServer) Account(
+	_ context.Context,
+	_ *authtypes.QueryAccountRequest,
+) (*authtypes.QueryAccountResponse, error) {
+	return &authtypes.QueryAccountResponse{}, nil
+}
+
+func newTestLogger(t *testing.T) logger.Logger {
+	t.Helper()
+
+	log, err := logger.NewZapLogger(logger.DefaultZapLoggerConfig())
+	require.NoError(t, err)
+
+	return log
+}
+
+type fakeBootstrappingContractClient struct {
+	client.ContractClient
+
+	failingStep      string
+	codeID           uint64
+	contractAddress  sdk.AccAddress
+	deployCalls      int
+	instantiateCalls int
+}
+
+func (c *fakeBootstrappingContractClient) DeployContract(
+	_ context.Context,
+	_ sdk.AccAddress,
+	_ []byte,
+) (*sdk.TxResponse, uint64, error) {
+	if c.failingStep == stepDeployContract {
+		return nil, 0, errors.New(stepDeployContract)
+	}
+	c.deployCalls++
+	c.codeID = uint64(c.deployCalls)
+
+	return &sdk.TxResponse{}, c.codeID, nil
+}
+
+func (c *fakeBootstrappingContractClient) InstantiateContract(
+	_ context.Context,
+	_ sdk.AccAddress,
+	codeID uint64,
+	_ coreum.InstantiationConfig,
+) (sdk.AccAddress, error) {
+	if c.failingStep == stepInstantiateContract {
+		return nil, errors.New(stepInstantiateContract)
+	}
+	if codeID != c.codeID {
+		return nil, errors.Errorf("unexpected code ID %d", codeID)
+	}
+	c.instantiateCalls++
+	c.contractAddress = coreum.GenAccount()
+
+	return c.contractAddress, nil
+}
+
+func (c *fakeBootstrappingContractClient) IsContractCodeStored(_ context.Context, codeID uint64) (bool, error) {
+	return codeID != 0 && codeID == c.codeID, nil
+}
+
+func (c *fakeBootstrappingContractClient) GetContractCodeID(
+	_ context.Context,
+	contractAddress sdk.AccAddress,
+) (uint64, error) {
+	if !contractAddress.Equals(c.contractAddress) {
+		return 0, errors.New("no such contract")
+	}
+
+	return c.codeID, nil
+}
+
+// fakeBootstrappingXRPLRPCClient is the XRPL RPC client and tx signer which applies the bootstrapping txs to the
+// in-memory bridge account.
+type fakeBootstrappingXRPLRPCClient struct {
+	client.XRPLRPCClient
+
+	failingStep    string
+	bridgeAccount  rippledata.Account
+	flags          rippledata.LedgerEntryFlag
+	signerList     []rippledata.SignerList
+	submittedSteps map[string]int
+	txCount        int
+}
+
+func newFakeBootstrappingXRPLRPCClient() *fakeBootstrappingXRPLRPCClient {
+	return &fakeBootstrappingXRPLRPCClient{
+		bridgeAccount:  xrpl.GenPrivKeyTxSigner().Account(),
+		submittedSteps: make(map[string]int),
+	}
+}
+
+func (c *fakeBootstrappingXRPLRPCClient) Account(_ string) (rippledata.Account, error) {
+	return c.bridgeAccount, nil
+}
+
+func (c *fakeBootstrappingXRPLRPCClient) Sign(tx rippledata.Transaction, _ string) error {
+	c.txCount++
+	tx.GetBase().Hash = rippledata.Hash256{byte(c.txCount)}
+
+	return nil
+}
+
+func (c *fakeBootstrappingXRPLRPCClient) AccountInfo(
+	_ context.Context,
+	_ rippledata.Account,
+) (xrpl.AccountInfoResult, error) {
+	balance, err := rippledata.NewNativeValue(1_000_000_000_000)
+	if err != nil {
+		return xrpl.AccountInfoResult{}, err
+	}
+	flags := c.flags
+
+	return xrpl.AccountInfoResult{
+		AccountData: xrpl.AccountDataWithSigners{
+			AccountRoot: rippledata.AccountRoot{
+				Account: &c.bridgeAccount,
+				Balance: balance,
+				Flags:   &flags,
+			},
+			SignerList: c.signerList,
+		},
+	}, nil
+}
+
+func (c *fakeBootstrappingXRPLRPCClient) AutoFillTx(
+	_ context.Context,
+	_ rippledata.Transaction,
+	_ rippledata.Account,
+	_ uint32,
+) error {
+	return nil
+}
+
+func (c *fakeBootstrappingXRPLRPCClient) SubmitAndAwaitSuccess(_ context.Context, tx rippledata.Transaction) error {
+	var step string
+	switch typedTx := tx.(type) {
+	case *rippledata.AccountSet:
+		switch *typedTx.SetFlag {
+		case uint32(rippledata.TxDefaultRipple):
+			step = stepEnableRippling
+		case uint32(rippledata.TxSetDisableMaster):
+			step = stepDisableMasterKey
+		}
+	case *rippledata.SignerListSet:
+		step = stepSetSignerList
+	}
+	if step == "" {
+		return errors.Errorf("unexpected tx %s", tx.GetType())
+	}
+	if step == c.failingStep {
+		return errors.New(step)
+	}
+
+	switch step {
+	case stepEnableRippling:
+		c.flags |= rippledata.LsDefaultRipple
+	case stepDisableMasterKey:
+		c.flags |= rippledata.LsDisableMaster
+	case stepSetSignerList:
+		signerListSetTx, ok := tx.(*rippledata.SignerListSet)
+		if !ok {
+			return errors.Errorf("unexpected tx %s", tx.GetType())
+		}
+		quorum := signerListSetTx.SignerQuorum
+		c.signerList = []rippledata.SignerList{{
+			SignerQuorum:  &quorum,
+			SignerEntries: signerListSetTx.SignerEntries,
+		}}
+	}
+	c.submittedSteps[step]++
+
+	return nil
+}
@@ -6,8 +6,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -15,11 +20,17 @@ import (
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
 	"github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
@@ -28,18 +39,29 @@ const (
 	minBalanceToCoverFeeAndTrustLines = float64(20)
 )
 
+// ErrXRPLIssuerAccountNotActivated is returned if the XRPL token issuer account doesn't exist or its balance is
+// below the base reserve.
+var ErrXRPLIssuerAccountNotActivated = errors.New("XRPL issuer account is not activated")
+
 // ContractClient is the interface for the contract client.
 //
 //nolint:interfacebloat
 type ContractClient interface {
-	DeployAndInstantiate(
+	InstantiateContract(
 		ctx context.Context,
 		sender sdk.AccAddress,
-		byteCode []byte,
+		codeID uint64,
 		config coreum.InstantiationConfig,
 	) (sdk.AccAddress, error)
+	IsContractCodeStored(ctx context.Context, codeID uint64) (bool, error)
+	GetContractCodeID(ctx context.Context, contractAddress sdk.AccAddress) (uint64, error)
+	GetContractAddress() sdk.AccAddress
+	GetContractVersion(ctx context.Context) (coreum.ContractVersion, error)
+	GetNodeInfo(ctx context.Context) (coreum.NodeInfo, error)
 	GetContractConfig(ctx context.Context) (coreum.ContractConfig, error)
 	GetContractOwnership(ctx context.Context) (coreum.ContractOwnership, error)
+	TransferOwnership(ctx context.Context, sender, newOwner sdk.AccAddress) (*sdk.TxResponse, error)
+	AcceptOwnership(ctx context.Context, sender sdk.AccAddress) (*sdk.TxResponse, error)
 	RecoverTickets(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -54,6 +76,7 @@ type ContractClient interface {
 		sendingPrecision int32,
 		maxHoldingAmount sdkmath.Int,
 		bridgingFee sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
 	) (*sdk.TxResponse, error)
 	RegisterXRPLToken(
 		ctx context.Context,
@@ -62,6 +85,13 @@ type ContractClient interface {
 		sendingPrecision int32,
 		maxHoldingAmount sdkmath.Int,
 		bridgingFee sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		deliveryMode *coreum.XRPLTokenDeliveryMode,
+	) (*sdk.TxResponse, error)
+	RegisterXRPLTokenBatch(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		requests ...coreum.XRPLTokenRegistrationRequest,
 	) (*sdk.TxResponse, error)
 	GetCoreumTokenByDenom(ctx context.Context, denom string) (coreum.CoreumToken, error)
 	GetCoreumTokens(ctx context.Context) ([]coreum.CoreumToken, error)
@@ -74,6 +104,11 @@ type ContractClient interface {
 		amount sdk.Coin,
 		deliverAmount *sdkmath.Int,
 	) (*sdk.TxResponse, error)
+	MultiSendToXRPL(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		requests ...coreum.SendToXRPLRequest,
+	) (*sdk.TxResponse, error)
 	UpdateXRPLToken(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -82,6 +117,8 @@ type ContractClient interface {
 		sendingPrecision *int32,
 		maxHoldingAmount *sdkmath.Int,
 		bridgingFee *sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		treasuryFee *sdkmath.Int,
 	) (*sdk.TxResponse, error)
 	UpdateCoreumToken(
 		ctx context.Context,
@@ -91,6 +128,8 @@ type ContractClient interface {
 		sendingPrecision *int32,
 		maxHoldingAmount *sdkmath.Int,
 		bridgingFee *sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		treasuryFee *sdkmath.Int,
 	) (*sdk.TxResponse, error)
 	GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error)
 	ClaimRefund(
@@ -104,6 +143,8 @@ type ContractClient interface {
 		sender sdk.AccAddress,
 		amounts sdk.Coins,
 	) (*sdk.TxResponse, error)
+	GetTreasuryFeesCollected(ctx context.Context) (sdk.Coins, error)
+	ClaimTreasuryFees(ctx context.Context, sender sdk.AccAddress) (*sdk.TxResponse, error)
 	RotateKeys(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -115,6 +156,16 @@ type ContractClient interface {
 		sender sdk.AccAddress,
 		issuer, currency string,
 	) (*sdk.TxResponse, error)
+	MigrateXRPLToken(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		oldIssuer, oldCurrency, newIssuer, newCurrency string,
+	) (*sdk.TxResponse, error)
+	RemoveMigratedXRPLToken(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		issuer, currency string,
+	) (*sdk.TxResponse, error)
 	HaltBridge(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -140,7 +191,44 @@ type ContractClient interface {
 		operationID uint32,
 	) (*sdk.TxResponse, error)
 	GetPendingOperations(ctx context.Context) ([]coreum.Operation, error)
+	GetSignatureProgress(ctx context.Context, ticketSequence uint32) (coreum.SignatureProgress, error)
+	GetAvailableTickets(ctx context.Context) ([]uint32, error)
+	SaveMultipleSignatures(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		requests ...coreum.SaveSignatureRequest,
+	) (*sdk.TxResponse, error)
 	GetTransactionEvidences(ctx context.Context) ([]coreum.TransactionEvidence, error)
+	SendXRPLTicketsAllocationTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultTicketsAllocationEvidence,
+	) (*sdk.TxResponse, error)
+	SendXRPLTrustSetTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultTrustSetEvidence,
+	) (*sdk.TxResponse, error)
+	SendCoreumToXRPLTransferTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendNFTokenTransferTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultNFTokenTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendCheckCashTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultCheckCashEvidence,
+	) (*sdk.TxResponse, error)
+	SendKeysRotationTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultKeysRotationEvidence,
+	) (*sdk.TxResponse, error)
 	DeployContract(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -159,6 +247,14 @@ type ContractClient interface {
 		ctx context.Context,
 		coreumTxHash string,
 	) (coreum.CoreumToXRPLTracingInfo, error)
+	GetXRPLToCoreumTransfers(
+		ctx context.Context,
+		filter coreum.TransfersFilter,
+	) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error)
+	GetCoreumToXRPLTransfers(
+		ctx context.Context,
+		filter coreum.TransfersFilter,
+	) ([]coreum.CoreumToXRPLTransfer, error)
 }
 
 // XRPLRPCClient is XRPL RPC client interface.
@@ -180,6 +276,19 @@ type XRPLRPCClient interface {
 	) (xrpl.AccountLinesResult, error)
 	GetXRPLBalances(ctx context.Context, acc rippledata.Account) ([]rippledata.Amount, error)
 	Tx(ctx context.Context, hash rippledata.Hash256) (xrpl.TxResult, error)
+	AccountObjects(
+		ctx context.Context,
+		account rippledata.Account,
+		objectType string,
+		marker any,
+	) (xrpl.AccountObjectsResult, error)
+	ServerState(ctx context.Context) (xrpl.ServerStateResult, error)
+	AccountTx(
+		ctx context.Context,
+		account rippledata.Account,
+		minLedger, maxLedger int64,
+		marker map[string]any,
+	) (xrpl.AccountTxResult, error)
 }
 
 // XRPLTxSigner is XRPL transaction signer.
@@ -188,6 +297,28 @@ type XRPLTxSigner interface {
 	Sign(tx rippledata.Transaction, keyName string) error
 }
 
+// XRPLAMMRouter is the router of the payments to the bridge through the XRPL AMM pools.
+type XRPLAMMRouter interface {
+	RoutePayment(ctx context.Context, payment *rippledata.Payment) (bool, error)
+}
+
+// AMMRoutedXRPLToCoreumSend is the result of the XRPL to Coreum sending with the AMM routing.
+type AMMRoutedXRPLToCoreumSend struct {
+	TxHash string
+	// Routed is true if the payment is routed through the token/XRP AMM pool, so the bridge receives XRP and the
+	// recipient receives the bridged XRP instead of the sent token.
+	Routed bool
+	// SentAmount is the max amount of the token spent by the sender.
+	SentAmount rippledata.Amount
+	// DeliveredAmount is the amount delivered to the bridge and sent to the recipient.
+	DeliveredAmount rippledata.Amount
+}
+
+// XRPLSubmissionMonitor is the monitor of the submitted XRPL transactions.
+type XRPLSubmissionMonitor interface {
+	SubmitAndMonitor(ctx context.Context, tx rippledata.Transaction, sign xrpl.SignFunc) (xrpl.TxResult, error)
+}
+
 // RelayerConfig is relayer config used for the bootstrapping and keys rotation.
 type RelayerConfig struct {
 	CoreumAddress string `yaml:"coreum_address"`
@@ -205,7 +336,13 @@ type BootstrappingConfig struct {
 	TrustSetLimitAmount         string          `yaml:"trust_set_limit_amount"`
 	ContractByteCodePath        string          `yaml:"contract_bytecode_path"`
 	XRPLBaseFee                 uint32          `yaml:"xrpl_base_fee"`
-	SkipXRPLBalanceValidation   bool            `yaml:"-"`
+	// MaxXRPLTxFee caps the fee in drops of the multi-signing XRPL transactions if provided.
+	MaxXRPLTxFee *uint64 `yaml:"max_xrpl_tx_fee,omitempty"`
+	// SourceTag is set to all outgoing XRPL Payment and TrustSet transactions if provided.
+	SourceTag *uint32 `yaml:"source_tag,omitempty"`
+	// MaxEvidencesPerRelayerPerBlock limits the number of the evidences each relayer can save in a block if provided.
+	MaxEvidencesPerRelayerPerBlock *uint32 `yaml:"max_evidences_per_relayer_per_block,omitempty"`
+	SkipXRPLBalanceValidation      bool    `yaml:"-"`
 }
 
 // DefaultBootstrappingConfig returns default BootstrappingConfig.
@@ -241,6 +378,13 @@ func DefaultKeysRotationConfig() KeysRotationConfig {
 	}
 }
 
+// CoreumAccountInfo is the on-chain coreum account info.
+type CoreumAccountInfo struct {
+	Address sdk.AccAddress
+	// PubKeySet is false if the account has never signed a transaction.
+	PubKeySet bool
+}
+
 // XRPLToCoreumTracingInfo is XRPL to Coreum tracing info.
 type XRPLToCoreumTracingInfo struct {
 	XRPLTx        rippledata.TransactionWithMetaData
@@ -255,6 +399,111 @@ type CoreumToXRPLTracingInfo struct {
 	EvidenceToTxs [][]coreum.DataToTx[coreum.XRPLTransactionResultEvidence]
 }
 
+// TransferDirection is the direction of the bridge transfer.
+type TransferDirection string
+
+// TransferDirection values.
+const (
+	TransferDirectionXRPLToCoreum TransferDirection = "xrpl-to-coreum"
+	TransferDirectionCoreumToXRPL TransferDirection = "coreum-to-xrpl"
+)
+
+// TransferStatus is the status of the bridge transfer.
+type TransferStatus string
+
+// TransferStatus values.
+const (
+	// TransferStatusPending is the status of the Coreum to XRPL transfer without the confirmed XRPL tx.
+	TransferStatusPending TransferStatus = "pending"
+	// TransferStatusCompleted is the status of the transfer delivered to the recipient.
+	TransferStatusCompleted TransferStatus = "completed"
+	// TransferStatusFailed is the status of the Coreum to XRPL transfer rejected on the XRPL, the sent amount is
+	// available for the refund.
+	TransferStatusFailed TransferStatus = "failed"
+)
+
+// HistoryFilter is the filter of the transfer history, zero values are ignored.
+type HistoryFilter struct {
+	// StartHeight is the inclusive lower bound of the Coreum tx height.
+	StartHeight int64
+	// EndHeight is the inclusive upper bound of the Coreum tx height.
+	EndHeight  int64
+	TokenDenom string
+	Direction  TransferDirection
+	// RecipientAddress is the Coreum address for the XRPL to Coreum transfers and XRPL address for the Coreum to
+	// XRPL transfers.
+	RecipientAddress string
+}
+
+// TransferEvent is the bridge transfer found in the Coreum tx history.
+type TransferEvent struct {
+	Direction    TransferDirection
+	Status       TransferStatus
+	CoreumTxHash string
+	// XRPLTxHashes are the hashes of the XRPL txs of the transfer, empty for the pending transfers.
+	XRPLTxHashes []string
+	Height       int64
+	Timestamp    time.Time
+	// Sender is the Coreum sender of the Coreum to XRPL transfer, empty for the XRPL to Coreum transfers.
+	Sender    string
+	Recipient string
+	// Amount is the transferred amount before the fees, represented in the Coreum denom.
+	Amount sdk.Coin
+	// Fee is the bridging fee of the token at the transfer height.
+	Fee sdk.Coin
+}
+
+// TokenPairType is the origin of the registered token pair.
+type TokenPairType string
+
+// TokenPairType values.
+const (
+	TokenPairTypeXRPL   TokenPairType = "XRPL"
+	TokenPairTypeCoreum TokenPairType = "Coreum"
+)
+
+// TokenPair is the registered token with its XRPL and Coreum representations.
+type TokenPair struct {
+	Type TokenPairType `json:"type"`
+	// XRPLIssuer is the bridge XRPL address for the Coreum originated tokens.
+	XRPLIssuer       string            `json:"xrpl_issuer"`
+	XRPLCurrency     string            `json:"xrpl_currency"`
+	CoreumDenom      string            `json:"coreum_denom"`
+	SendingPrecision int32             `json:"sending_precision"`
+	MaxHoldingAmount sdkmath.Int       `json:"max_holding_amount"`
+	BridgingFee      sdkmath.Int       `json:"bridging_fee"`
+	State            coreum.TokenState `json:"state"`
+}
+
+// TokenStateChangeReport is the token activity summary reported before the token state change.
+type TokenStateChangeReport struct {
+	CoreumDenom  string
+	XRPLIssuer   string
+	XRPLCurrency string
+	State        coreum.TokenState
+	// CoreumSupply is the current supply of the token denom on the Coreum chain.
+	CoreumSupply sdk.Coin
+	// PendingOperationIDs are the IDs of the pending operations referencing the token.
+	PendingOperationIDs []uint32
+	// RecentInboundTransfers is the number of the XRPL to Coreum transfers of the token found in the recent blocks.
+	RecentInboundTransfers int
+	// RecentInboundVolume is the total amount of the recent XRPL to Coreum transfers of the token.
+	RecentInboundVolume sdk.Coin
+}
+
+// StuckTokenRegistration is the XRPL token in the processing state which TrustSet operation is missing or pending
+// for too long.
+type StuckTokenRegistration struct {
+	Issuer      string `json:"issuer"`
+	Currency    string `json:"currency"`
+	CoreumDenom string `json:"coreum_denom"`
+	// OperationID is the ID of the pending TrustSet operation, nil if the operation is missing.
+	OperationID *uint32 `json:"operation_id,omitempty"`
+	// OperationAge is the time since the TrustSet operation is first seen by the relayer, zero if the operation is
+	// missing.
+	OperationAge time.Duration `json:"operation_age,omitempty"`
+}
+
 // BridgeClient is the service responsible for the bridge bootstrapping.
 type BridgeClient struct {
 	log             logger.Logger
@@ -262,6 +511,11 @@ type BridgeClient struct {
 	contractClient  ContractClient
 	xrplRPCClient   XRPLRPCClient
 	xrplTxSigner    XRPLTxSigner
+
+	minBridgeAmounts          processes.MinBridgeAmounts
+	xrplSubmissionMonitor     XRPLSubmissionMonitor
+	xrplAMMRouter             XRPLAMMRouter
+	operationAgeStoreFilePath string
 }
 
 // NewBridgeClient returns a new instance of the BridgeClient.
@@ -281,13 +535,42 @@ func NewBridgeClient(
 	}
 }
 
+// WithMinBridgeAmounts sets the min bridge amounts validated by the client before the sending.
+func (b *BridgeClient) WithMinBridgeAmounts(minBridgeAmounts processes.MinBridgeAmounts) *BridgeClient {
+	b.minBridgeAmounts = minBridgeAmounts
+	return b
+}
+
+// WithXRPLSubmissionMonitor sets the monitor the XRPL transactions are submitted with, the transactions not validated
+// up to their last ledger sequence are re-submitted.
+func (b *BridgeClient) WithXRPLSubmissionMonitor(xrplSubmissionMonitor XRPLSubmissionMonitor) *BridgeClient {
+	b.xrplSubmissionMonitor = xrplSubmissionMonitor
+	return b
+}
+
+// WithXRPLAMMRouter sets the router used by the SendFromXRPLToCoreumWithAMMRouting.
+func (b *BridgeClient) WithXRPLAMMRouter(xrplAMMRouter XRPLAMMRouter) *BridgeClient {
+	b.xrplAMMRouter = xrplAMMRouter
+	return b
+}
+
+// WithOperationAgeStoreFilePath sets the path of the relayer pending operations age store, used to find the stuck
+// token registrations.
+func (b *BridgeClient) WithOperationAgeStoreFilePath(operationAgeStoreFilePath string) *BridgeClient {
+	b.operationAgeStoreFilePath = operationAgeStoreFilePath
+	return b
+}
+
 // Bootstrap creates initial XRPL bridge multi-signing account with the disabled master key,
 // enabled rippling on it, and deploys the bridge contract with the provided settings.
+// The completed steps are recorded to the progress file, if the path is provided, and skipped on the next call
+// after the verification that their effects still hold.
 func (b *BridgeClient) Bootstrap(
 	ctx context.Context,
 	senderAddress sdk.AccAddress,
 	bridgeAccountKeyName string,
 	cfg BootstrappingConfig,
+	progressFilePath string,
 ) (sdk.AccAddress, error) {
 	xrplBridgeAccount, err := b.xrplTxSigner.Account(bridgeAccountKeyName)
 	if err != nil {
@@ -299,8 +582,33 @@ func (b *BridgeClient) Bootstrap(
 		zap.String("keyName", bridgeAccountKeyName),
 		zap.String("xrplAddress", xrplBridgeAccount.String()),
 	)
-	if !cfg.SkipXRPLBalanceValidation {
-		if err = b.validateXRPLBridgeAccountBalance(ctx, xrplBridgeAccount); err != nil {
+
+	progress, err := ReadBootstrappingProgress(progressFilePath)
+	if err != nil {
+		return nil, err
+	}
+	switch progress.XRPLBridgeAddress {
+	case "":
+		progress.XRPLBridgeAddress = xrplBridgeAccount.String()
+	case xrplBridgeAccount.String():
+		b.log.Info(ctx, "Resuming bootstrapping", zap.Any("progress", progress))
+	default:
+		return nil, errors.Errorf(
+			"bootstrapping progress belongs to another XRPL bridge account, progress account:%s, account:%s",
+			progress.XRPLBridgeAddress, xrplBridgeAccount.String(),
+		)
+	}
+
+	if progress.XRPLAccountFunded {
+		b.log.Info(ctx, "Skipping XRPL bridge account balance validation, the account is already funded")
+	} else {
+		if !cfg.SkipXRPLBalanceValidation {
+			if err = b.validateXRPLBridgeAccountBalance(ctx, xrplBridgeAccount); err != nil {
+				return nil, err
+			}
+		}
+		progress.XRPLAccountFunded = true
+		if err := saveBootstrappingProgress(progressFilePath, progress); err != nil {
 			return nil, err
 		}
 	}
@@ -309,11 +617,6 @@ func (b *BridgeClient) Bootstrap(
 	if err != nil {
 		return nil, err
 	}
-	// prepare deployment config
-	contactByteCode, err := os.ReadFile(cfg.ContractByteCodePath)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get contract bytecode by path:%s", cfg.ContractByteCodePath)
-	}
 	owner, err := sdk.AccAddressFromBech32(cfg.Owner)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse owner")
@@ -332,27 +635,40 @@ func (b *BridgeClient) Bootstrap(
 			)
 	}
 	instantiationCfg := coreum.InstantiationConfig{
-		Owner:                       owner,
-		Admin:                       admin,
-		Relayers:                    relayers,
-		EvidenceThreshold:           cfg.EvidenceThreshold,
-		UsedTicketSequenceThreshold: cfg.UsedTicketSequenceThreshold,
-		TrustSetLimitAmount:         trustSetLimitAmount,
-		BridgeXRPLAddress:           xrplBridgeAccount.String(),
-		XRPLBaseFee:                 cfg.XRPLBaseFee,
-	}
-	b.log.Info(ctx, "Deploying contract", zap.Any("settings", instantiationCfg))
-	contractAddress, err := b.contractClient.DeployAndInstantiate(ctx, senderAddress, contactByteCode, instantiationCfg)
+		Owner:                          owner,
+		Admin:                          admin,
+		Relayers:                       relayers,
+		EvidenceThreshold:              cfg.EvidenceThreshold,
+		UsedTicketSequenceThreshold:    cfg.UsedTicketSequenceThreshold,
+		TrustSetLimitAmount:            trustSetLimitAmount,
+		BridgeXRPLAddress:              xrplBridgeAccount.String(),
+		XRPLBaseFee:                    cfg.XRPLBaseFee,
+		MaxXRPLTxFee:                   cfg.MaxXRPLTxFee,
+		SourceTag:                      cfg.SourceTag,
+		MaxEvidencesPerRelayerPerBlock: cfg.MaxEvidencesPerRelayerPerBlock,
+	}
+	if err := instantiationCfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid contract instantiation config")
+	}
+	contractAddress, err := b.deployAndInstantiateBridgeContract(
+		ctx, senderAddress, cfg.ContractByteCodePath, instantiationCfg, &progress, progressFilePath,
+	)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to deploy contract")
+		return nil, err
 	}
-	b.log.Info(ctx, "Contract is deployed successfully", zap.String("address", contractAddress.String()))
 
-	if err := b.setUpXRPLBridgeAccount(ctx, bridgeAccountKeyName, cfg, xrplSignerEntries); err != nil {
+	if err := b.setUpXRPLBridgeAccount(
+		ctx, bridgeAccountKeyName, cfg, xrplSignerEntries, &progress, progressFilePath,
+	); err != nil {
 		return nil, err
 	}
 
-	b.log.Info(ctx, "The XRPL bridge account is ready", zap.String("address", xrplBridgeAccount.String()))
+	b.log.Info(
+		ctx,
+		"The bridge is bootstrapped",
+		zap.String("contractAddress", contractAddress.String()),
+		zap.String("xrplBridgeAddress", xrplBridgeAccount.String()),
+	)
 	return contractAddress, nil
 }
 
@@ -401,6 +717,76 @@ func (b *BridgeClient) GetContractOwnership(ctx context.Context) (coreum.Contrac
 	return b.contractClient.GetContractOwnership(ctx)
 }
 
+// GetCoreumAccountInfo returns the coreum account info, the error is returned if the account doesn't exist on chain.
+func (b *BridgeClient) GetCoreumAccountInfo(ctx context.Context, address sdk.AccAddress) (CoreumAccountInfo, error) {
+	acc, err := client.GetAccountInfo(ctx, b.coreumClientCtx, address)
+	if err != nil {
+		return CoreumAccountInfo{}, errors.Wrapf(err, "failed to get coreum account, address:%s", address.String())
+	}
+
+	return CoreumAccountInfo{
+		Address:   acc.GetAddress(),
+		PubKeySet: acc.GetPubKey() != nil,
+	}, nil
+}
+
+// TransferOwnership starts the contract ownership transfer, the new owner must accept the ownership to complete it.
+func (b *BridgeClient) TransferOwnership(ctx context.Context, sender, newOwner sdk.AccAddress) error {
+	b.log.Info(
+		ctx,
+		"Transferring contract ownership",
+		zap.String("sender", sender.String()),
+		zap.String("newOwner", newOwner.String()),
+	)
+	txRes, err := b.contractClient.TransferOwnership(ctx, sender, newOwner)
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+
+	b.log.Info(
+		ctx,
+		"Contract ownership transfer is started, the new owner must accept it",
+		zap.String("pendingOwner", newOwner.String()),
+		zap.String("txHash", txRes.TxHash),
+	)
+	return nil
+}
+
+// AcceptOwnership accepts the pending contract ownership transfer.
+func (b *BridgeClient) AcceptOwnership(ctx context.Context, sender sdk.AccAddress) error {
+	b.log.Info(ctx, "Accepting contract ownership", zap.String("sender", sender.String()))
+
+	ownership, err := b.contractClient.GetContractOwnership(ctx)
+	if err != nil {
+		return err
+	}
+	if ownership.PendingOwner.Empty() {
+		return errors.New("there is no pending contract ownership transfer")
+	}
+	if !ownership.PendingOwner.Equals(sender) {
+		return errors.Errorf(
+			"only the pending owner can accept the ownership, pending owner:%s, sender:%s",
+			ownership.PendingOwner.String(), sender.String(),
+		)
+	}
+
+	txRes, err := b.contractClient.AcceptOwnership(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+
+	b.log.Info(ctx, "Contract ownership is accepted", zap.String("txHash", txRes.TxHash))
+	return nil
+}
+
 // RecoverTickets recovers tickets allocation.
 func (b *BridgeClient) RecoverTickets(
 	ctx context.Context,
@@ -462,6 +848,7 @@ func (b *BridgeClient) RegisterCoreumToken(
 	sendingPrecision int32,
 	maxHoldingAmount sdkmath.Int,
 	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
 ) (coreum.CoreumToken, error) {
 	b.log.Info(
 		ctx,
@@ -472,6 +859,7 @@ func (b *BridgeClient) RegisterCoreumToken(
 		zap.Int32("sendingPrecision", sendingPrecision),
 		zap.String("maxHoldingAmount", maxHoldingAmount.String()),
 		zap.String("bridgingFee", bridgingFee.String()),
+		zap.Uint32p("maxSendsPerAddressPerDay", maxSendsPerAddressPerDay),
 	)
 	txRes, err := b.contractClient.RegisterCoreumToken(
 		ctx,
@@ -481,6 +869,7 @@ func (b *BridgeClient) RegisterCoreumToken(
 		sendingPrecision,
 		maxHoldingAmount,
 		bridgingFee,
+		maxSendsPerAddressPerDay,
 	)
 	if err != nil {
 		return coreum.CoreumToken{}, err
@@ -504,7 +893,59 @@ func (b *BridgeClient) RegisterCoreumToken(
 	return token, nil
 }
 
-// RegisterXRPLToken registers XRPL token.
+// ValidateCoreumDenomSupply checks that the denom exists on the Coreum chain and has non-zero supply.
+func (b *BridgeClient) ValidateCoreumDenomSupply(ctx context.Context, denom string) error {
+	bankClient := banktypes.NewQueryClient(b.coreumClientCtx)
+	res, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get coreum denom supply, denom:%s", denom)
+	}
+	if !res.Amount.IsPositive() {
+		return errors.Errorf("the denom %s doesn't exist or has zero supply", denom)
+	}
+
+	return nil
+}
+
+// GetCoreumDenomDecimals returns the decimals of the denom taken from the exponent of the bank denom metadata display
+// unit, or from the asset FT token precision if the denom has no metadata.
+func (b *BridgeClient) GetCoreumDenomDecimals(ctx context.Context, denom string) (uint32, error) {
+	bankClient := banktypes.NewQueryClient(b.coreumClientCtx)
+	metadataRes, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: denom,
+	})
+	if err == nil {
+		metadata := metadataRes.Metadata
+		displayUnit, found := lo.Find(metadata.DenomUnits, func(unit *banktypes.DenomUnit) bool {
+			return unit.Denom == metadata.Display
+		})
+		if !found {
+			return 0, errors.Errorf(
+				"the denom %s metadata doesn't contain the display unit %s", denom, metadata.Display,
+			)
+		}
+		return displayUnit.Exponent, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return 0, errors.Wrapf(err, "failed to get coreum denom metadata, denom:%s", denom)
+	}
+
+	assetftClient := assetfttypes.NewQueryClient(b.coreumClientCtx)
+	tokenRes, err := assetftClient.Token(ctx, &assetfttypes.QueryTokenRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(
+			err, "failed to resolve decimals, the denom %s has neither metadata nor asset FT token", denom,
+		)
+	}
+
+	return tokenRes.Token.Precision, nil
+}
+
+// RegisterXRPLToken registers XRPL token. The nil deliveryMode means the AssetFT delivery.
 func (b *BridgeClient) RegisterXRPLToken(
 	ctx context.Context,
 	owner sdk.AccAddress,
@@ -512,6 +953,8 @@ func (b *BridgeClient) RegisterXRPLToken(
 	sendingPrecision int32,
 	maxHoldingAmount sdkmath.Int,
 	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	deliveryMode *coreum.XRPLTokenDeliveryMode,
 ) (coreum.XRPLToken, error) {
 	stringCurrency := xrpl.ConvertCurrencyToString(currency)
 	b.log.Info(
@@ -523,6 +966,8 @@ func (b *BridgeClient) RegisterXRPLToken(
 		zap.Int32("sendingPrecision", sendingPrecision),
 		zap.String("maxHoldingAmount", maxHoldingAmount.String()),
 		zap.String("bridgingFee", bridgingFee.String()),
+		zap.Uint32p("maxSendsPerAddressPerDay", maxSendsPerAddressPerDay),
+		zap.Any("deliveryMode", deliveryMode),
 	)
 	txRes, err := b.contractClient.RegisterXRPLToken(
 		ctx,
@@ -532,6 +977,8 @@ func (b *BridgeClient) RegisterXRPLToken(
 		sendingPrecision,
 		maxHoldingAmount,
 		bridgingFee,
+		maxSendsPerAddressPerDay,
+		deliveryMode,
 	)
 	if err != nil {
 		return coreum.XRPLToken{}, err
@@ -556,6 +1003,70 @@ func (b *BridgeClient) RegisterXRPLToken(
 	return token, nil
 }
 
+// RegisterXRPLTokenBatch registers XRPL tokens with the batches of the batchSize registrations per transaction.
+// The tokens of the failed batch and the following batches aren't registered.
+func (b *BridgeClient) RegisterXRPLTokenBatch(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	requests []coreum.XRPLTokenRegistrationRequest,
+	batchSize int,
+) ([]coreum.XRPLToken, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("at least one XRPL token registration request is required")
+	}
+	if batchSize <= 0 {
+		return nil, errors.Errorf("batch size must be positive, got: %d", batchSize)
+	}
+
+	normalizedRequests := make([]coreum.XRPLTokenRegistrationRequest, 0, len(requests))
+	for _, req := range requests {
+		issuer, err := rippledata.NewAccountFromAddress(req.Issuer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", req.Issuer)
+		}
+		currency, err := rippledata.NewCurrency(req.Currency)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", req.Currency)
+		}
+		req.Issuer = issuer.String()
+		req.Currency = xrpl.ConvertCurrencyToString(currency)
+		normalizedRequests = append(normalizedRequests, req)
+	}
+
+	b.log.Info(
+		ctx,
+		"Registering XRPL tokens",
+		zap.String("owner", owner.String()),
+		zap.Int("count", len(normalizedRequests)),
+		zap.Int("batchSize", batchSize),
+	)
+	tokens := make([]coreum.XRPLToken, 0, len(normalizedRequests))
+	for _, batch := range lo.Chunk(normalizedRequests, batchSize) {
+		txRes, err := b.contractClient.RegisterXRPLTokenBatch(ctx, owner, batch...)
+		if err != nil {
+			return tokens, err
+		}
+		if txRes == nil {
+			continue
+		}
+		for _, req := range batch {
+			token, err := b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, req.Issuer, req.Currency)
+			if err != nil {
+				return tokens, err
+			}
+			tokens = append(tokens, token)
+		}
+		b.log.Info(
+			ctx,
+			"Successfully registered XRPL tokens batch",
+			zap.Int("count", len(batch)),
+			zap.String("txHash", txRes.TxHash),
+		)
+	}
+
+	return tokens, nil
+}
+
 // RecoverXRPLTokenRegistration recovers xrpl token registration.
 func (b *BridgeClient) RecoverXRPLTokenRegistration(
 	ctx context.Context,
@@ -590,57 +1101,259 @@ func (b *BridgeClient) RecoverXRPLTokenRegistration(
 	return nil
 }
 
-// GetAllTokens returns all registered tokens.
-func (b *BridgeClient) GetAllTokens(ctx context.Context) ([]coreum.CoreumToken, []coreum.XRPLToken, error) {
-	coreumTokens, err := b.contractClient.GetCoreumTokens(ctx)
+// ListStuckTokenRegistrations returns the XRPL tokens in the processing state which TrustSet operation is missing
+// or is first seen by the relayer more than olderThan ago. The operation age is taken from the relayer operation age
+// store, so the pending operations not tracked by the relayer aren't reported.
+func (b *BridgeClient) ListStuckTokenRegistrations(
+	ctx context.Context,
+	olderThan time.Duration,
+) ([]StuckTokenRegistration, error) {
+	xrplTokens, err := b.contractClient.GetXRPLTokens(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-
-	xrplTokens, err := b.contractClient.GetXRPLTokens(ctx)
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	operationAgeStore, err := processes.ReadOperationAgeStore(b.operationAgeStoreFilePath)
+	if err != nil {
+		return nil, err
 	}
 
-	return coreumTokens, xrplTokens, nil
+	now := time.Now()
+	stuckTokens := make([]StuckTokenRegistration, 0)
+	for _, token := range xrplTokens {
+		if token.State != coreum.TokenStateProcessing {
+			continue
+		}
+		stuckToken := StuckTokenRegistration{
+			Issuer:      token.Issuer,
+			Currency:    token.Currency,
+			CoreumDenom: token.CoreumDenom,
+		}
+		operation, found := lo.Find(pendingOperations, func(operation coreum.Operation) bool {
+			trustSet := operation.OperationType.TrustSet
+			return trustSet != nil && trustSet.Issuer == token.Issuer && trustSet.Currency == token.Currency
+		})
+		if !found {
+			stuckTokens = append(stuckTokens, stuckToken)
+			continue
+		}
+		firstSeen, ok := operationAgeStore.FirstSeen[operation.GetOperationID()]
+		if !ok || now.Sub(firstSeen) < olderThan {
+			continue
+		}
+		stuckToken.OperationID = lo.ToPtr(operation.GetOperationID())
+		stuckToken.OperationAge = now.Sub(firstSeen).Truncate(time.Second)
+		stuckTokens = append(stuckTokens, stuckToken)
+	}
+
+	return stuckTokens, nil
 }
 
-// GetXRPLTokenByIssuerAndCurrency returns XRPL registered token by issuer and currency.
-func (b *BridgeClient) GetXRPLTokenByIssuerAndCurrency(
+// RecoverStuckXRPLTokenRegistration recovers the registration of the inactive XRPL token, or of the token which
+// TrustSet operation is pending for more than olderThan. The stuck TrustSet operation is cancelled before the
+// recovery. The registration of the token in any other state is refused with the error recognized by the
+// coreum.IsXRPLTokenNotInactiveError.
+func (b *BridgeClient) RecoverStuckXRPLTokenRegistration(
 	ctx context.Context,
+	sender sdk.AccAddress,
 	issuer, currency string,
-) (coreum.XRPLToken, error) {
-	return b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+	olderThan time.Duration,
+) error {
+	token, err := b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+	if err != nil {
+		return err
+	}
+	switch token.State {
+	case coreum.TokenStateInactive:
+	case coreum.TokenStateProcessing:
+		stuckTokens, err := b.ListStuckTokenRegistrations(ctx, olderThan)
+		if err != nil {
+			return err
+		}
+		stuckToken, found := lo.Find(stuckTokens, func(stuckToken StuckTokenRegistration) bool {
+			return stuckToken.Issuer == issuer && stuckToken.Currency == currency
+		})
+		if !found {
+			return errors.Errorf(
+				"XRPLTokenNotInactive: the token TrustSet operation isn't pending for more than %s", olderThan,
+			)
+		}
+		// the contract recovers only the inactive token, and there is no operation to cancel to make it inactive
+		if stuckToken.OperationID == nil {
+			return errors.New("XRPLTokenNotInactive: the token TrustSet operation is missing")
+		}
+		b.log.Info(
+			ctx,
+			"Cancelling stuck TrustSet operation",
+			zap.Uint32("operationID", *stuckToken.OperationID),
+			zap.Duration("operationAge", stuckToken.OperationAge),
+		)
+		if err := b.CancelPendingOperation(ctx, sender, *stuckToken.OperationID); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("XRPLTokenNotInactive: the registration of the %s token can't be recovered", token.State)
+	}
+
+	return b.RecoverXRPLTokenRegistration(ctx, sender, issuer, currency)
 }
 
-// SendFromCoreumToXRPL sends tokens form Coreum to XRPL.
-func (b *BridgeClient) SendFromCoreumToXRPL(
+// MigrateXRPLToken migrates the XRPL token to the new issuer and currency keeping its Coreum denom.
+func (b *BridgeClient) MigrateXRPLToken(
 	ctx context.Context,
 	sender sdk.AccAddress,
-	recipient rippledata.Account,
-	amount sdk.Coin,
-	deliverAmount *sdkmath.Int,
-) (string, error) {
-	logFields := []zap.Field{
+	oldIssuer, oldCurrency, newIssuer, newCurrency string,
+) error {
+	b.log.Info(ctx, "Migrating XRPL token",
 		zap.String("sender", sender.String()),
-		zap.String("amount", amount.String()),
-		zap.String("recipient", recipient.String()),
-	}
-	if deliverAmount != nil {
-		logFields = append(logFields, zap.String("deliverAmount", deliverAmount.String()))
-	}
-	b.log.Info(
-		ctx,
-		"Sending tokens form Coreum to XRPL",
-		logFields...,
+		zap.String("oldIssuer", oldIssuer),
+		zap.String("oldCurrency", oldCurrency),
+		zap.String("newIssuer", newIssuer),
+		zap.String("newCurrency", newCurrency),
 	)
-	txRes, err := b.contractClient.SendToXRPL(ctx, sender, recipient.String(), amount, deliverAmount)
+	txRes, err := b.contractClient.MigrateXRPLToken(ctx, sender, oldIssuer, oldCurrency, newIssuer, newCurrency)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	if txRes == nil {
-		return "", nil
+		return nil
+	}
+	b.log.Info(
+		ctx,
+		"Successfully sent tx to migrate XRPL token, the token is enabled once the TrustSet operation is completed",
+		zap.String("txHash", txRes.TxHash),
+	)
+
+	return nil
+}
+
+// RemoveMigratedXRPLToken removes the disabled old token of the migrated XRPL token.
+func (b *BridgeClient) RemoveMigratedXRPLToken(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	issuer, currency string,
+) error {
+	b.log.Info(ctx, "Removing migrated XRPL token",
+		zap.String("sender", sender.String()),
+		zap.String("issuer", issuer),
+		zap.String("currency", currency),
+	)
+	txRes, err := b.contractClient.RemoveMigratedXRPLToken(ctx, sender, issuer, currency)
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+	b.log.Info(
+		ctx,
+		"Successfully removed migrated XRPL token",
+		zap.String("txHash", txRes.TxHash),
+	)
+
+	return nil
+}
+
+// GetAllTokens returns all registered tokens.
+func (b *BridgeClient) GetAllTokens(ctx context.Context) ([]coreum.CoreumToken, []coreum.XRPLToken, error) {
+	coreumTokens, err := b.contractClient.GetCoreumTokens(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	xrplTokens, err := b.contractClient.GetXRPLTokens(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return coreumTokens, xrplTokens, nil
+}
+
+// GetTokenPairs returns all registered tokens joined to the XRPL/Coreum pairs, the XRPL originated tokens go first.
+func (b *BridgeClient) GetTokenPairs(ctx context.Context) ([]TokenPair, error) {
+	coreumTokens, xrplTokens, err := b.GetAllTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPairs := make([]TokenPair, 0, len(xrplTokens)+len(coreumTokens))
+	for _, token := range xrplTokens {
+		tokenPairs = append(tokenPairs, TokenPair{
+			Type:             TokenPairTypeXRPL,
+			XRPLIssuer:       token.Issuer,
+			XRPLCurrency:     token.Currency,
+			CoreumDenom:      token.CoreumDenom,
+			SendingPrecision: token.SendingPrecision,
+			MaxHoldingAmount: token.MaxHoldingAmount,
+			BridgingFee:      token.BridgingFee,
+			State:            token.State,
+		})
+	}
+	for _, token := range coreumTokens {
+		tokenPairs = append(tokenPairs, TokenPair{
+			Type:             TokenPairTypeCoreum,
+			XRPLIssuer:       contractCfg.BridgeXRPLAddress,
+			XRPLCurrency:     token.XRPLCurrency,
+			CoreumDenom:      token.Denom,
+			SendingPrecision: token.SendingPrecision,
+			MaxHoldingAmount: token.MaxHoldingAmount,
+			BridgingFee:      token.BridgingFee,
+			State:            token.State,
+		})
+	}
+
+	return tokenPairs, nil
+}
+
+// GetXRPLTokenByIssuerAndCurrency returns XRPL registered token by issuer and currency.
+func (b *BridgeClient) GetXRPLTokenByIssuerAndCurrency(
+	ctx context.Context,
+	issuer, currency string,
+) (coreum.XRPLToken, error) {
+	return b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+}
+
+// SendFromCoreumToXRPL sends tokens form Coreum to XRPL.
+func (b *BridgeClient) SendFromCoreumToXRPL(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	recipient rippledata.Account,
+	amount sdk.Coin,
+	deliverAmount *sdkmath.Int,
+) (string, error) {
+	if err := b.minBridgeAmounts.ValidateCoreumToXRPLAmount(amount); err != nil {
+		return "", err
+	}
+
+	logFields := []zap.Field{
+		zap.String("sender", sender.String()),
+		zap.String("amount", amount.String()),
+		zap.String("recipient", recipient.String()),
+	}
+	if deliverAmount != nil {
+		logFields = append(logFields, zap.String("deliverAmount", deliverAmount.String()))
+	}
+	b.log.Info(
+		ctx,
+		"Sending tokens form Coreum to XRPL",
+		logFields...,
+	)
+	txRes, err := b.contractClient.SendToXRPL(ctx, sender, recipient.String(), amount, deliverAmount)
+	if err != nil {
+		return "", err
+	}
+
+	if txRes == nil {
+		return "", nil
 	}
 
 	b.log.Info(
@@ -659,49 +1372,54 @@ func (b *BridgeClient) SendFromXRPLToCoreum(
 	amount rippledata.Amount,
 	recipient sdk.AccAddress,
 ) (string, error) {
-	senderAccount, err := b.xrplTxSigner.Account(senderKeyName)
+	paymentTx, err := b.buildXRPLToCoreumPaymentTx(ctx, senderKeyName, amount, recipient)
 	if err != nil {
 		return "", err
 	}
 
-	b.log.Info(
-		ctx,
-		"Sending tokens form XRPL to Coreum",
-		zap.String("sender", senderAccount.String()),
-		zap.String("amount", amount.String()),
-		zap.String("recipient", recipient.String()),
-	)
+	return b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &paymentTx, senderKeyName)
+}
 
-	cfg, err := b.contractClient.GetContractConfig(ctx)
+// SendFromXRPLToCoreumWithAMMRouting sends tokens form XRPL to Coreum, and if the bridge trust line can't receive the
+// token, routes the payment through the token/XRP AMM pool, so the recipient receives XRP instead of the sent token.
+// The returned result contains the delivered amount, which is different from the sent one if the payment is routed.
+func (b *BridgeClient) SendFromXRPLToCoreumWithAMMRouting(
+	ctx context.Context,
+	senderKeyName string,
+	amount rippledata.Amount,
+	recipient sdk.AccAddress,
+) (AMMRoutedXRPLToCoreumSend, error) {
+	if b.xrplAMMRouter == nil {
+		return AMMRoutedXRPLToCoreumSend{}, errors.New("XRPL AMM router is not set")
+	}
+	paymentTx, err := b.buildXRPLToCoreumPaymentTx(ctx, senderKeyName, amount, recipient)
 	if err != nil {
-		return "", err
+		return AMMRoutedXRPLToCoreumSend{}, err
 	}
-	xrplBridgeAddress, err := rippledata.NewAccountFromAddress(cfg.BridgeXRPLAddress)
+	routed, err := b.xrplAMMRouter.RoutePayment(ctx, &paymentTx)
 	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"failed to convert BridgeXRPLAddress from contract to rippledata.Account, address:%s",
-			cfg.BridgeXRPLAddress,
+		return AMMRoutedXRPLToCoreumSend{}, errors.Wrap(err, "failed to route payment through the XRPL AMM pool")
+	}
+	if routed {
+		b.log.Warn(
+			ctx,
+			"Payment is routed through the XRPL AMM pool, the recipient receives XRP instead of the sent token",
+			zap.String("sendMax", amount.String()),
+			zap.String("amount", paymentTx.Amount.String()),
 		)
 	}
 
-	memo, err := xrpl.EncodeCoreumRecipientToMemo(recipient)
+	txHash, err := b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &paymentTx, senderKeyName)
 	if err != nil {
-		return "", err
-	}
-
-	paymentTx := rippledata.Payment{
-		Destination: *xrplBridgeAddress,
-		Amount:      amount,
-		TxBase: rippledata.TxBase{
-			TransactionType: rippledata.PAYMENT,
-			Memos: rippledata.Memos{
-				memo,
-			},
-		},
+		return AMMRoutedXRPLToCoreumSend{}, err
 	}
 
-	return b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &paymentTx, senderKeyName)
+	return AMMRoutedXRPLToCoreumSend{
+		TxHash:          txHash,
+		Routed:          routed,
+		SentAmount:      amount,
+		DeliveredAmount: paymentTx.Amount,
+	}, nil
 }
 
 // SetXRPLTrustSet sends XRPL TrustSet transaction.
@@ -746,6 +1464,8 @@ func (b *BridgeClient) UpdateCoreumToken(
 	sendingPrecision *int32,
 	maxHoldingAmount *sdkmath.Int,
 	bridgingFee *sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	treasuryFee *sdkmath.Int,
 ) error {
 	fields := []zap.Field{
 		zap.String("sender", sender.String()),
@@ -763,6 +1483,12 @@ func (b *BridgeClient) UpdateCoreumToken(
 	if bridgingFee != nil {
 		fields = append(fields, zap.String("bridgingFee", bridgingFee.String()))
 	}
+	if maxSendsPerAddressPerDay != nil {
+		fields = append(fields, zap.Uint32("maxSendsPerAddressPerDay", *maxSendsPerAddressPerDay))
+	}
+	if treasuryFee != nil {
+		fields = append(fields, zap.String("treasuryFee", treasuryFee.String()))
+	}
 	b.log.Info(
 		ctx,
 		"Updating token",
@@ -770,7 +1496,15 @@ func (b *BridgeClient) UpdateCoreumToken(
 	)
 
 	txRes, err := b.contractClient.UpdateCoreumToken(
-		ctx, sender, denom, state, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx,
+		sender,
+		denom,
+		state,
+		sendingPrecision,
+		maxHoldingAmount,
+		bridgingFee,
+		maxSendsPerAddressPerDay,
+		treasuryFee,
 	)
 	if err != nil {
 		return err
@@ -798,6 +1532,8 @@ func (b *BridgeClient) UpdateXRPLToken(
 	sendingPrecision *int32,
 	maxHoldingAmount *sdkmath.Int,
 	bridgingFee *sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	treasuryFee *sdkmath.Int,
 ) error {
 	fields := []zap.Field{
 		zap.String("sender", sender.String()),
@@ -816,13 +1552,28 @@ func (b *BridgeClient) UpdateXRPLToken(
 	if bridgingFee != nil {
 		fields = append(fields, zap.String("bridgingFee", bridgingFee.String()))
 	}
+	if maxSendsPerAddressPerDay != nil {
+		fields = append(fields, zap.Uint32("maxSendsPerAddressPerDay", *maxSendsPerAddressPerDay))
+	}
+	if treasuryFee != nil {
+		fields = append(fields, zap.String("treasuryFee", treasuryFee.String()))
+	}
 	b.log.Info(
 		ctx,
 		"Updating token",
 		fields...,
 	)
 	txRes, err := b.contractClient.UpdateXRPLToken(
-		ctx, sender, issuer, currency, state, sendingPrecision, maxHoldingAmount, bridgingFee,
+		ctx,
+		sender,
+		issuer,
+		currency,
+		state,
+		sendingPrecision,
+		maxHoldingAmount,
+		bridgingFee,
+		maxSendsPerAddressPerDay,
+		treasuryFee,
 	)
 	if err != nil {
 		return err
@@ -969,6 +1720,86 @@ func (b *BridgeClient) ClaimRelayerFees(
 	return nil
 }
 
+// GetTreasuryFeesCollected returns the treasury fees collected and not claimed yet.
+func (b *BridgeClient) GetTreasuryFeesCollected(ctx context.Context) (sdk.Coins, error) {
+	return b.contractClient.GetTreasuryFeesCollected(ctx)
+}
+
+// ClaimTreasuryFees claims all treasury fees collected, the fees are sent to the treasury address or to the owner if
+// the treasury address is not set.
+func (b *BridgeClient) ClaimTreasuryFees(
+	ctx context.Context,
+	sender sdk.AccAddress,
+) error {
+	b.log.Info(
+		ctx,
+		"Claiming treasury fees",
+		zap.String("sender", sender.String()),
+	)
+
+	txRes, err := b.contractClient.ClaimTreasuryFees(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+
+	b.log.Info(
+		ctx,
+		"Successfully claimed treasury fees",
+		zap.String("txHash", txRes.TxHash),
+	)
+
+	return nil
+}
+
+// SweepRelayerFees claims all fees collected by the relayers, the claim is sent from each relayer in parallel, so the
+// keys of all relayers must be in the keyring. The total claimed amount is returned.
+func (b *BridgeClient) SweepRelayerFees(ctx context.Context, relayerAddresses []sdk.AccAddress) (sdk.Coins, error) {
+	for _, relayerAddress := range relayerAddresses {
+		if _, err := b.coreumClientCtx.Keyring().KeyByAddress(relayerAddress); err != nil {
+			return nil, errors.Wrapf(err, "failed to get relayer key from the keyring, address:%s", relayerAddress)
+		}
+	}
+
+	var (
+		totalClaimedMu sync.Mutex
+		totalClaimed   = sdk.NewCoins()
+	)
+	err := parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		for _, relayerAddress := range relayerAddresses {
+			relayerAddress := relayerAddress
+			spawn(relayerAddress.String(), parallel.Continue, func(ctx context.Context) error {
+				feesCollected, err := b.contractClient.GetFeesCollected(ctx, relayerAddress)
+				if err != nil {
+					return err
+				}
+				if feesCollected.IsZero() {
+					b.log.Info(ctx, "No relayer fees to claim", zap.String("relayer", relayerAddress.String()))
+					return nil
+				}
+				if err := b.ClaimRelayerFees(ctx, relayerAddress, feesCollected); err != nil {
+					return errors.Wrapf(err, "failed to claim relayer fees, address:%s", relayerAddress)
+				}
+
+				totalClaimedMu.Lock()
+				defer totalClaimedMu.Unlock()
+				totalClaimed = totalClaimed.Add(feesCollected...)
+
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return totalClaimed, nil
+}
+
 // GetCoreumBalances returns all coreum account balances.
 func (b *BridgeClient) GetCoreumBalances(ctx context.Context, address sdk.AccAddress) (sdk.Coins, error) {
 	bankClient := banktypes.NewQueryClient(b.coreumClientCtx)
@@ -987,6 +1818,131 @@ func (b *BridgeClient) GetXRPLBalances(ctx context.Context, acc rippledata.Accou
 	return b.xrplRPCClient.GetXRPLBalances(ctx, acc)
 }
 
+// VerifyXRPLBridgeAccount verifies the XRPL bridge account configuration against the contract config and returns
+// the found misconfigurations.
+func (b *BridgeClient) VerifyXRPLBridgeAccount(ctx context.Context) ([]xrpl.BridgeAccountMisconfiguration, error) {
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	xrplBridgeAccount, err := rippledata.NewAccountFromAddress(contractCfg.BridgeXRPLAddress)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"failed to convert bridge XRPL address to rippledata.Account, address:%s",
+			contractCfg.BridgeXRPLAddress,
+		)
+	}
+	b.log.Info(ctx, "Verifying XRPL bridge account", zap.String("address", xrplBridgeAccount.String()))
+
+	return xrpl.VerifyBridgeAccount(ctx, b.xrplRPCClient, *xrplBridgeAccount, xrpl.BridgeAccountExpectedConfig{
+		SignerQuorum: contractCfg.EvidenceThreshold,
+		SignerAddresses: lo.Map(contractCfg.Relayers, func(relayer coreum.Relayer, _ int) string {
+			return relayer.XRPLAddress
+		}),
+	})
+}
+
+// GetXRPLAccountReserves returns the XRPL account reserve breakdown.
+func (b *BridgeClient) GetXRPLAccountReserves(
+	ctx context.Context,
+	account rippledata.Account,
+) (xrpl.AccountReserves, error) {
+	return xrpl.FetchAccountReserves(ctx, b.xrplRPCClient, account)
+}
+
+// XRPLTicketsReserve is the XRPL bridge account reserve required for the tickets allocation.
+type XRPLTicketsReserve struct {
+	NumberOfTickets uint32
+	BalanceDrops    int64
+	// RequiredReserveDrops is the account reserve after the tickets allocation including the signer list set by
+	// the bootstrapping.
+	RequiredReserveDrops int64
+	// ShortfallDrops is the amount missing to cover the required reserve, zero if the balance is sufficient.
+	ShortfallDrops int64
+}
+
+// ComputeXRPLTicketsReserve computes the reserve the account requires after the allocation of the number of tickets,
+// each ticket is an owned object which increases the reserve by the owner reserve. The signer list reserve is added
+// if the account doesn't have it yet.
+func ComputeXRPLTicketsReserve(reserves xrpl.AccountReserves, numberOfTickets uint32) XRPLTicketsReserve {
+	newObjects := int64(numberOfTickets)
+	if reserves.SignerListsCount == 0 {
+		newObjects++
+	}
+	requiredReserveDrops := reserves.BaseReserveDrops +
+		reserves.OwnerReserveDrops*(int64(reserves.OwnerCount)+newObjects)
+
+	return XRPLTicketsReserve{
+		NumberOfTickets:      numberOfTickets,
+		BalanceDrops:         reserves.BalanceDrops,
+		RequiredReserveDrops: requiredReserveDrops,
+		ShortfallDrops:       max(requiredReserveDrops-reserves.BalanceDrops, 0),
+	}
+}
+
+// GetXRPLTicketsReserve returns the reserve the account requires after the allocation of the number of tickets with
+// the current ledger reserves, the account which doesn't exist yet is considered empty.
+func (b *BridgeClient) GetXRPLTicketsReserve(
+	ctx context.Context,
+	account rippledata.Account,
+	numberOfTickets uint32,
+) (XRPLTicketsReserve, error) {
+	serverState, err := b.xrplRPCClient.ServerState(ctx)
+	if err != nil {
+		return XRPLTicketsReserve{}, err
+	}
+	reserves := xrpl.AccountReserves{
+		BaseReserveDrops:  serverState.State.ValidatedLedger.ReserveBase,
+		OwnerReserveDrops: serverState.State.ValidatedLedger.ReserveInc,
+	}
+	accountInfo, err := b.xrplRPCClient.AccountInfo(ctx, account)
+	switch {
+	case err == nil:
+		reserves, err = xrpl.ComputeAccountReservesFromInfo(accountInfo, serverState)
+		if err != nil {
+			return XRPLTicketsReserve{}, errors.Wrapf(
+				err, "failed to compute XRPL account reserves, account:%s", account.String(),
+			)
+		}
+	case xrpl.IsAccountNotFoundError(err):
+	default:
+		return XRPLTicketsReserve{}, errors.Wrapf(err, "failed to get XRPL account info, account:%s", account.String())
+	}
+
+	return ComputeXRPLTicketsReserve(reserves, numberOfTickets), nil
+}
+
+// CheckXRPLIssuerAccount checks that the XRPL token issuer account exists and its balance covers the base reserve.
+// The bridge trust set to the issuer which isn't activated can never succeed, so the check is expected before the
+// token registration.
+func (b *BridgeClient) CheckXRPLIssuerAccount(ctx context.Context, issuer rippledata.Account) error {
+	accountInfo, err := b.xrplRPCClient.AccountInfo(ctx, issuer)
+	if err != nil {
+		if xrpl.IsAccountNotFoundError(err) {
+			return errors.Wrapf(ErrXRPLIssuerAccountNotActivated, "account %s doesn't exist", issuer.String())
+		}
+		return errors.Wrapf(err, "failed to get XRPL issuer account info, issuer:%s", issuer.String())
+	}
+	serverState, err := b.xrplRPCClient.ServerState(ctx)
+	if err != nil {
+		return err
+	}
+	reserves, err := xrpl.ComputeAccountReservesFromInfo(accountInfo, serverState)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compute XRPL issuer account reserves, issuer:%s", issuer.String())
+	}
+	if reserves.BalanceDrops < reserves.BaseReserveDrops {
+		return errors.Wrapf(
+			ErrXRPLIssuerAccountNotActivated,
+			"account %s balance %d drops is below the base reserve %d drops",
+			issuer.String(), reserves.BalanceDrops, reserves.BaseReserveDrops,
+		)
+	}
+
+	return nil
+}
+
 // GetPendingRefunds queries for the pending refunds of an address.
 func (b *BridgeClient) GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error) {
 	b.log.Info(ctx, "Getting pending refunds", zap.String("address", address.String()))
@@ -1057,15 +2013,14 @@ func (b *BridgeClient) buildContractRelayersFromRelayersConfig(
 		}); err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get coreum account by address:%s", relayer.CoreumAddress)
 		}
-		xrplAddress, err := rippledata.NewAccountFromAddress(relayer.XRPLAddress)
+		// the X-address is converted to the classic address the contract expects
+		xrplAddress, err := xrpl.ParseAccount(
+			relayer.XRPLAddress, b.coreumClientCtx.ChainID() == string(constant.ChainIDMain),
+		)
 		if err != nil {
-			return nil, nil, errors.Wrapf(
-				err,
-				"failed to convert XRPL address string to rippledata.Account, address:%s",
-				relayer.XRPLAddress,
-			)
+			return nil, nil, errors.Wrap(err, "failed to parse relayer XRPL address")
 		}
-		xrplAccInfo, err := b.xrplRPCClient.AccountInfo(ctx, *xrplAddress)
+		xrplAccInfo, err := b.xrplRPCClient.AccountInfo(ctx, xrplAddress)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get XRPL account by address:%s", xrplAddress.String())
 		}
@@ -1081,12 +2036,12 @@ func (b *BridgeClient) buildContractRelayersFromRelayersConfig(
 		}
 		contractRelayers = append(contractRelayers, coreum.Relayer{
 			CoreumAddress: relayerCoreumAddress,
-			XRPLAddress:   relayer.XRPLAddress,
+			XRPLAddress:   xrplAddress.String(),
 			XRPLPubKey:    relayer.XRPLPubKey,
 		})
 		xrplSignerEntries = append(xrplSignerEntries, rippledata.SignerEntry{
 			SignerEntry: rippledata.SignerEntryItem{
-				Account:      xrplAddress,
+				Account:      &xrplAddress,
 				SignerWeight: lo.ToPtr(uint16(1)),
 			},
 		})
@@ -1134,18 +2089,335 @@ func (b *BridgeClient) CancelPendingOperation(
 	return nil
 }
 
-// GetPendingOperations returns a list of all pending operations.
-func (b *BridgeClient) GetPendingOperations(ctx context.Context) ([]coreum.Operation, error) {
-	b.log.Info(ctx, "Getting pending operations")
-	return b.contractClient.GetPendingOperations(ctx)
-}
-
-// GetTransactionEvidences returns a list of not confirmed transaction evidences.
-func (b *BridgeClient) GetTransactionEvidences(ctx context.Context) ([]coreum.TransactionEvidence, error) {
-	b.log.Info(ctx, "Getting transaction evidences")
-	return b.contractClient.GetTransactionEvidences(ctx)
-}
-
+// ForceCompleteOperation submits the transaction result evidence for the pending operation identified by the ticket
+// (or account) sequence. It is used to unblock an operation whose XRPL transaction was never observed by the relayers.
+// The sender must be the contract owner, and the evidence is counted as a vote of the sender, so the owner must be
+// one of the relayers.
+func (b *BridgeClient) ForceCompleteOperation(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	ticketSequence uint32,
+	txHash string,
+	result coreum.TransactionResult,
+) error {
+	b.log.Info(
+		ctx,
+		"Force completing pending operation",
+		zap.String("sender", sender.String()),
+		zap.Uint32("ticketSequence", ticketSequence),
+		zap.String("txHash", txHash),
+		zap.String("result", string(result)),
+	)
+	if result != coreum.TransactionResultAccepted && result != coreum.TransactionResultRejected {
+		return errors.Errorf("invalid transaction result: %s", result)
+	}
+
+	ownership, err := b.contractClient.GetContractOwnership(ctx)
+	if err != nil {
+		return err
+	}
+	if !ownership.Owner.Equals(sender) {
+		return errors.Errorf(
+			"only the contract owner can force complete the operation, owner:%s, sender:%s",
+			ownership.Owner.String(), sender.String(),
+		)
+	}
+
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return err
+	}
+	operation, found := lo.Find(pendingOperations, func(operation coreum.Operation) bool {
+		return operation.GetOperationID() == ticketSequence
+	})
+	if !found {
+		return errors.Errorf("pending operation with ticket sequence %d not found", ticketSequence)
+	}
+
+	txResultEvidence := coreum.XRPLTransactionResultEvidence{
+		TxHash:            strings.ToUpper(txHash),
+		TransactionResult: result,
+	}
+	if operation.TicketSequence != 0 {
+		txResultEvidence.TicketSequence = lo.ToPtr(operation.TicketSequence)
+	} else {
+		txResultEvidence.AccountSequence = lo.ToPtr(operation.AccountSequence)
+	}
+
+	var txRes *sdk.TxResponse
+	switch {
+	case operation.OperationType.AllocateTickets != nil:
+		evd := coreum.XRPLTransactionResultTicketsAllocationEvidence{
+			XRPLTransactionResultEvidence: txResultEvidence,
+		}
+		if result == coreum.TransactionResultAccepted {
+			tickets, err := b.getAllocatedTickets(ctx, txHash)
+			if err != nil {
+				return err
+			}
+			evd.Tickets = tickets
+		}
+		txRes, err = b.contractClient.SendXRPLTicketsAllocationTransactionResultEvidence(ctx, sender, evd)
+	case operation.OperationType.TrustSet != nil:
+		txRes, err = b.contractClient.SendXRPLTrustSetTransactionResultEvidence(
+			ctx, sender, coreum.XRPLTransactionResultTrustSetEvidence{
+				XRPLTransactionResultEvidence: txResultEvidence,
+			},
+		)
+	case operation.OperationType.CoreumToXRPLTransfer != nil:
+		txRes, err = b.contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+			ctx, sender, coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+				XRPLTransactionResultEvidence: txResultEvidence,
+			},
+		)
+	case operation.OperationType.RotateKeys != nil:
+		txRes, err = b.contractClient.SendKeysRotationTransactionResultEvidence(
+			ctx, sender, coreum.XRPLTransactionResultKeysRotationEvidence{
+				XRPLTransactionResultEvidence: txResultEvidence,
+			},
+		)
+	case operation.OperationType.NFTokenTransfer != nil:
+		txRes, err = b.contractClient.SendNFTokenTransferTransactionResultEvidence(
+			ctx, sender, coreum.XRPLTransactionResultNFTokenTransferEvidence{
+				XRPLTransactionResultEvidence: txResultEvidence,
+			},
+		)
+	case operation.OperationType.CheckCash != nil:
+		txRes, err = b.contractClient.SendCheckCashTransactionResultEvidence(
+			ctx, sender, coreum.XRPLTransactionResultCheckCashEvidence{
+				XRPLTransactionResultEvidence: txResultEvidence,
+			},
+		)
+	default:
+		return errors.Errorf("unsupported operation type, operation:%+v", operation)
+	}
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+
+	b.log.Info(ctx, "Successfully submitted the operation evidence", zap.String("txHash", txRes.TxHash))
+	return nil
+}
+
+// GetTokenStateChangeReport returns the state change report of the Coreum originated token if the denom is provided,
+// and of the XRPL originated token with the issuer and currency otherwise. The inbound volume is taken from the
+// transfer history of the recentBlocks latest Coreum blocks, and isn't collected if recentBlocks is zero.
+func (b *BridgeClient) GetTokenStateChangeReport(
+	ctx context.Context,
+	denom, issuer, currency string,
+	recentBlocks int64,
+) (TokenStateChangeReport, error) {
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return TokenStateChangeReport{}, err
+	}
+
+	var report TokenStateChangeReport
+	if denom != "" {
+		token, err := b.contractClient.GetCoreumTokenByDenom(ctx, denom)
+		if err != nil {
+			return TokenStateChangeReport{}, err
+		}
+		report = TokenStateChangeReport{
+			CoreumDenom: token.Denom,
+			// the Coreum originated tokens are issued by the bridge account on the XRPL
+			XRPLIssuer:   contractCfg.BridgeXRPLAddress,
+			XRPLCurrency: token.XRPLCurrency,
+			State:        token.State,
+		}
+	} else {
+		token, err := b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, issuer, currency)
+		if err != nil {
+			return TokenStateChangeReport{}, err
+		}
+		report = TokenStateChangeReport{
+			CoreumDenom:  token.CoreumDenom,
+			XRPLIssuer:   token.Issuer,
+			XRPLCurrency: token.Currency,
+			State:        token.State,
+		}
+	}
+
+	bankClient := banktypes.NewQueryClient(b.coreumClientCtx)
+	supplyRes, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: report.CoreumDenom,
+	})
+	if err != nil {
+		return TokenStateChangeReport{}, errors.Wrapf(
+			err, "failed to get coreum denom supply, denom:%s", report.CoreumDenom,
+		)
+	}
+	report.CoreumSupply = supplyRes.Amount
+
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return TokenStateChangeReport{}, err
+	}
+	report.PendingOperationIDs = FilterTokenPendingOperationIDs(
+		pendingOperations, report.XRPLIssuer, report.XRPLCurrency,
+	)
+
+	report.RecentInboundVolume = sdk.NewCoin(report.CoreumDenom, sdkmath.ZeroInt())
+	if recentBlocks <= 0 {
+		return report, nil
+	}
+	latestBlockRes, err := tmservice.NewServiceClient(b.coreumClientCtx).GetLatestBlock(
+		ctx, &tmservice.GetLatestBlockRequest{},
+	)
+	if err != nil {
+		return TokenStateChangeReport{}, errors.Wrap(err, "failed to get latest coreum block")
+	}
+	latestHeight := latestBlockRes.GetSdkBlock().GetHeader().Height
+	inboundTransfers, err := b.GetTransferHistory(ctx, HistoryFilter{
+		StartHeight: lo.Max([]int64{latestHeight - recentBlocks + 1, 1}),
+		EndHeight:   latestHeight,
+		TokenDenom:  report.CoreumDenom,
+		Direction:   TransferDirectionXRPLToCoreum,
+	})
+	if err != nil {
+		return TokenStateChangeReport{}, err
+	}
+	for _, transfer := range inboundTransfers {
+		report.RecentInboundTransfers++
+		report.RecentInboundVolume = report.RecentInboundVolume.AddAmount(transfer.Amount.Amount)
+	}
+
+	return report, nil
+}
+
+// FilterTokenPendingOperationIDs returns the IDs of the pending operations referencing the token with the XRPL issuer
+// and currency.
+func FilterTokenPendingOperationIDs(operations []coreum.Operation, issuer, currency string) []uint32 {
+	operationIDs := make([]uint32, 0)
+	for _, operation := range operations {
+		var operationIssuer, operationCurrency string
+		switch {
+		case operation.OperationType.TrustSet != nil:
+			operationIssuer = operation.OperationType.TrustSet.Issuer
+			operationCurrency = operation.OperationType.TrustSet.Currency
+		case operation.OperationType.CoreumToXRPLTransfer != nil:
+			operationIssuer = operation.OperationType.CoreumToXRPLTransfer.Issuer
+			operationCurrency = operation.OperationType.CoreumToXRPLTransfer.Currency
+		default:
+			continue
+		}
+		if operationIssuer == issuer && operationCurrency == currency {
+			operationIDs = append(operationIDs, operation.GetOperationID())
+		}
+	}
+
+	return operationIDs
+}
+
+// ValidateTokenStateTransition checks the token state transition with the same rules the contract enforces, the
+// returned errors are recognized by the coreum.IsTokenStateIsImmutableError and
+// coreum.IsInvalidTargetTokenStateError.
+func ValidateTokenStateTransition(currentState, targetState coreum.TokenState) error {
+	if currentState == coreum.TokenStateInactive || currentState == coreum.TokenStateProcessing {
+		return errors.Errorf("TokenStateIsImmutable: the token state %s can't be updated", currentState)
+	}
+	if targetState != coreum.TokenStateEnabled && targetState != coreum.TokenStateDisabled {
+		return errors.Errorf("InvalidTargetTokenState: the token state can't be updated to %s", targetState)
+	}
+	if currentState == targetState {
+		return errors.Errorf("the token state is already %s", currentState)
+	}
+
+	return nil
+}
+
+// GetPendingOperations returns a list of all pending operations.
+func (b *BridgeClient) GetPendingOperations(ctx context.Context) ([]coreum.Operation, error) {
+	b.log.Info(ctx, "Getting pending operations")
+	return b.contractClient.GetPendingOperations(ctx)
+}
+
+// GetSignatureProgress returns the relayers signing progress of the pending operation.
+func (b *BridgeClient) GetSignatureProgress(
+	ctx context.Context,
+	ticketSequence uint32,
+) (coreum.SignatureProgress, error) {
+	b.log.Info(ctx, "Getting signature progress", zap.Uint32("ticketSequence", ticketSequence))
+	return b.contractClient.GetSignatureProgress(ctx, ticketSequence)
+}
+
+// GetAvailableTickets returns the tickets available in the contract for the new operations.
+func (b *BridgeClient) GetAvailableTickets(ctx context.Context) ([]uint32, error) {
+	b.log.Info(ctx, "Getting available tickets")
+	return b.contractClient.GetAvailableTickets(ctx)
+}
+
+// GetUnsignedPendingOperations returns the pending operations not yet signed by the relayer together with the XRPL
+// transactions to be signed offline.
+func (b *BridgeClient) GetUnsignedPendingOperations(
+	ctx context.Context,
+	relayerAddress sdk.AccAddress,
+) (processes.UnsignedOperations, error) {
+	b.log.Info(ctx, "Getting unsigned pending operations", zap.String("relayerAddress", relayerAddress.String()))
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return processes.UnsignedOperations{}, err
+	}
+	bridgeXRPLAddress, err := rippledata.NewAccountFromAddress(contractCfg.BridgeXRPLAddress)
+	if err != nil {
+		return processes.UnsignedOperations{}, errors.Wrapf(
+			err,
+			"failed to convert bridge XRPL address to rippledata.Account, address:%s",
+			contractCfg.BridgeXRPLAddress,
+		)
+	}
+	operations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return processes.UnsignedOperations{}, err
+	}
+
+	return processes.BuildUnsignedOperations(
+		*bridgeXRPLAddress,
+		contractCfg.SourceTag,
+		relayerAddress,
+		operations,
+		processes.NewMultiSigningTxFeeConfig(contractCfg),
+	)
+}
+
+// SaveOperationSignatures saves the offline produced operation signatures in the contract.
+func (b *BridgeClient) SaveOperationSignatures(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	signatures processes.OperationSignatures,
+) error {
+	if len(signatures.Signatures) == 0 {
+		return errors.New("no signatures to save")
+	}
+	b.log.Info(
+		ctx,
+		"Saving operation signatures",
+		zap.String("sender", sender.String()),
+		zap.Int("count", len(signatures.Signatures)),
+	)
+	txRes, err := b.contractClient.SaveMultipleSignatures(ctx, sender, signatures.ToSaveSignatureRequests()...)
+	if err != nil {
+		return err
+	}
+
+	if txRes == nil {
+		return nil
+	}
+
+	b.log.Info(ctx, "Successfully saved operation signatures", zap.String("txHash", txRes.TxHash))
+	return nil
+}
+
+// GetTransactionEvidences returns a list of not confirmed transaction evidences.
+func (b *BridgeClient) GetTransactionEvidences(ctx context.Context) ([]coreum.TransactionEvidence, error) {
+	b.log.Info(ctx, "Getting transaction evidences")
+	return b.contractClient.GetTransactionEvidences(ctx)
+}
+
 // GetXRPLToCoreumTracingInfo returns XRPL to Coreum tracing info.
 func (b *BridgeClient) GetXRPLToCoreumTracingInfo(
 	ctx context.Context,
@@ -1171,7 +2443,10 @@ func (b *BridgeClient) GetXRPLToCoreumTracingInfo(
 	if !ok {
 		return XRPLToCoreumTracingInfo{}, errors.Errorf("failed to cast tx to Payment, data:%+v", tx)
 	}
-	coreumRecipient := xrpl.DecodeCoreumRecipientFromMemo(paymentTx.Memos)
+	coreumRecipient, err := xrpl.ParseMemoRecipient(paymentTx)
+	if err != nil {
+		coreumRecipient = xrpl.DecodeCoreumRecipientFromMemo(paymentTx.Memos)
+	}
 	if coreumRecipient == nil {
 		return XRPLToCoreumTracingInfo{}, errors.New("XRPL tx memo does not include expected structure")
 	}
@@ -1236,6 +2511,212 @@ func (b *BridgeClient) GetCoreumToXRPLTracingInfo(
 	return coreumToXRPLTracingInfo, nil
 }
 
+// GetTransferHistory returns the bridge transfers found in the Coreum tx history, the latest transfers first.
+func (b *BridgeClient) GetTransferHistory(ctx context.Context, filter HistoryFilter) ([]TransferEvent, error) {
+	b.log.Info(ctx, "Getting transfer history", zap.Any("filter", filter))
+
+	if filter.Direction != "" &&
+		filter.Direction != TransferDirectionXRPLToCoreum &&
+		filter.Direction != TransferDirectionCoreumToXRPL {
+		return nil, errors.Errorf("invalid transfer direction: %s", filter.Direction)
+	}
+	if filter.EndHeight > 0 && filter.StartHeight > filter.EndHeight {
+		return nil, errors.Errorf(
+			"start height %d is greater than end height %d", filter.StartHeight, filter.EndHeight,
+		)
+	}
+
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	coreumTokens, xrplTokens, err := b.GetAllTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registry := newTransferTokensRegistry(contractCfg.BridgeXRPLAddress, coreumTokens, xrplTokens)
+
+	transfersFilter := coreum.TransfersFilter{
+		StartHeight: filter.StartHeight,
+		EndHeight:   filter.EndHeight,
+		Recipient:   filter.RecipientAddress,
+	}
+	events := make([]TransferEvent, 0)
+	if filter.Direction == "" || filter.Direction == TransferDirectionXRPLToCoreum {
+		xrplToCoreumEvents, err := b.getXRPLToCoreumTransferEvents(ctx, transfersFilter, filter.TokenDenom, registry)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, xrplToCoreumEvents...)
+	}
+	if filter.Direction == "" || filter.Direction == TransferDirectionCoreumToXRPL {
+		coreumToXRPLEvents, err := b.getCoreumToXRPLTransferEvents(ctx, transfersFilter, filter.TokenDenom, registry)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, coreumToXRPLEvents...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Height > events[j].Height
+	})
+
+	return events, nil
+}
+
+func (b *BridgeClient) getXRPLToCoreumTransferEvents(
+	ctx context.Context,
+	filter coreum.TransfersFilter,
+	denom string,
+	registry transferTokensRegistry,
+) ([]TransferEvent, error) {
+	transfers, err := b.contractClient.GetXRPLToCoreumTransfers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]TransferEvent, 0, len(transfers))
+	for _, transfer := range transfers {
+		evidence := transfer.Evidence
+		var (
+			transferDenom string
+			amount        = evidence.Amount
+		)
+		if coreumToken, ok := registry.coreumTokenByXRPLCurrency(evidence.Issuer, evidence.Currency); ok {
+			transferDenom = coreumToken.Denom
+			// the XRPL amount of the Coreum originated token is represented with the XRPL issued token decimals
+			amount, err = coreum.ConvertAmountDecimals(amount, xrpl.XRPLIssuedTokenDecimals, coreumToken.Decimals)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to convert XRPL to Coreum transfer amount, tx:%s", transfer.Tx.TxHash)
+			}
+		} else if xrplToken, ok := registry.xrplTokens[xrplTokenKey(evidence.Issuer, evidence.Currency)]; ok {
+			transferDenom = xrplToken.CoreumDenom
+		} else {
+			return nil, errors.Errorf(
+				"token is not registered, issuer:%s, currency:%s, tx:%s",
+				evidence.Issuer, evidence.Currency, transfer.Tx.TxHash,
+			)
+		}
+		if denom != "" && transferDenom != denom {
+			continue
+		}
+
+		event, err := b.buildTransferEvent(ctx, transfer.Tx, registry, sdk.NewCoin(transferDenom, amount))
+		if err != nil {
+			return nil, err
+		}
+		event.Direction = TransferDirectionXRPLToCoreum
+		event.Status = TransferStatusCompleted
+		event.XRPLTxHashes = []string{evidence.TxHash}
+		event.Recipient = evidence.Recipient.String()
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (b *BridgeClient) getCoreumToXRPLTransferEvents(
+	ctx context.Context,
+	filter coreum.TransfersFilter,
+	denom string,
+	registry transferTokensRegistry,
+) ([]TransferEvent, error) {
+	transfers, err := b.contractClient.GetCoreumToXRPLTransfers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]TransferEvent, 0, len(transfers))
+	for _, transfer := range transfers {
+		if denom != "" && transfer.Request.Amount.Denom != denom {
+			continue
+		}
+
+		event, err := b.buildTransferEvent(ctx, transfer.Tx, registry, transfer.Request.Amount)
+		if err != nil {
+			return nil, err
+		}
+		event.Direction = TransferDirectionCoreumToXRPL
+		event.Status = TransferStatusPending
+		event.XRPLTxHashes = make([]string, 0, len(transfer.ResultEvidences))
+		for _, evidence := range transfer.ResultEvidences {
+			if evidence.TxHash != "" {
+				event.XRPLTxHashes = append(event.XRPLTxHashes, evidence.TxHash)
+			}
+			switch {
+			case evidence.TransactionResult == coreum.TransactionResultAccepted:
+				event.Status = TransferStatusCompleted
+			case event.Status != TransferStatusCompleted:
+				event.Status = TransferStatusFailed
+			}
+		}
+		event.Sender = transfer.Sender.String()
+		event.Recipient = transfer.Request.Recipient
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// buildTransferEvent builds the transfer event with the tx data and the token bridging fee at the tx height.
+func (b *BridgeClient) buildTransferEvent(
+	ctx context.Context,
+	tx *sdk.TxResponse,
+	registry transferTokensRegistry,
+	amount sdk.Coin,
+) (TransferEvent, error) {
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return TransferEvent{}, errors.Wrapf(err, "failed to parse tx timestamp, tx:%s", tx.TxHash)
+	}
+
+	heightCtx := coreum.WithHeightRequestContext(ctx, tx.Height)
+	var bridgingFee sdkmath.Int
+	if coreumToken, ok := registry.coreumTokens[amount.Denom]; ok {
+		token, err := b.contractClient.GetCoreumTokenByDenom(heightCtx, coreumToken.Denom)
+		if err != nil {
+			return TransferEvent{}, err
+		}
+		bridgingFee = token.BridgingFee
+	} else if xrplToken, ok := registry.xrplTokensByDenom[amount.Denom]; ok {
+		token, err := b.contractClient.GetXRPLTokenByIssuerAndCurrency(heightCtx, xrplToken.Issuer, xrplToken.Currency)
+		if err != nil {
+			return TransferEvent{}, err
+		}
+		bridgingFee = token.BridgingFee
+	} else {
+		return TransferEvent{}, errors.Errorf("token is not registered, denom:%s, tx:%s", amount.Denom, tx.TxHash)
+	}
+
+	return TransferEvent{
+		CoreumTxHash: tx.TxHash,
+		Height:       tx.Height,
+		Timestamp:    timestamp,
+		Amount:       amount,
+		Fee:          sdk.NewCoin(amount.Denom, bridgingFee),
+	}, nil
+}
+
+func (b *BridgeClient) getAllocatedTickets(ctx context.Context, txHash string) ([]uint32, error) {
+	hash, err := rippledata.NewHash256(txHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid XRPL tx hash: %s", txHash)
+	}
+	tx, err := b.xrplRPCClient.Tx(ctx, *hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get XRPL tx, hash: %s", txHash)
+	}
+	if !tx.Validated {
+		return nil, errors.Errorf("XRPL tx is not validated, hash: %s", txHash)
+	}
+	tickets := xrpl.ExtractTicketSequencesFromMetaData(tx.MetaData)
+	if len(tickets) == 0 {
+		return nil, errors.Errorf("XRPL tx doesn't contain allocated tickets, hash: %s", txHash)
+	}
+
+	return tickets, nil
+}
+
 func (b *BridgeClient) validateXRPLBridgeAccountBalance(
 	ctx context.Context,
 	xrplBridgeAccount rippledata.Account,
@@ -1266,29 +2747,129 @@ func (b *BridgeClient) validateXRPLBridgeAccountBalance(
 	return nil
 }
 
+func (b *BridgeClient) deployAndInstantiateBridgeContract(
+	ctx context.Context,
+	senderAddress sdk.AccAddress,
+	contractByteCodePath string,
+	instantiationCfg coreum.InstantiationConfig,
+	progress *BootstrappingProgress,
+	progressFilePath string,
+) (sdk.AccAddress, error) {
+	if progress.ContractAddress != "" {
+		contractAddress, err := sdk.AccAddressFromBech32(progress.ContractAddress)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse contract address from the bootstrapping progress")
+		}
+		codeID, err := b.contractClient.GetContractCodeID(ctx, contractAddress)
+		if err != nil {
+			return nil, err
+		}
+		if codeID != progress.ContractCodeID {
+			return nil, errors.Errorf(
+				"contract %s is instantiated from unexpected code ID, expected:%d, got:%d",
+				contractAddress.String(), progress.ContractCodeID, codeID,
+			)
+		}
+		b.log.Info(ctx, "Skipping contract deployment, the contract is already instantiated",
+			zap.String("address", contractAddress.String()))
+		return contractAddress, nil
+	}
+
+	codeID := progress.ContractCodeID
+	if codeID != 0 {
+		stored, err := b.contractClient.IsContractCodeStored(ctx, codeID)
+		if err != nil {
+			return nil, err
+		}
+		if !stored {
+			return nil, errors.Errorf("contract code from the bootstrapping progress is not stored, codeID:%d", codeID)
+		}
+		b.log.Info(ctx, "Skipping contract bytecode deployment, the bytecode is already stored",
+			zap.Uint64("codeID", codeID))
+	} else {
+		contactByteCode, err := os.ReadFile(contractByteCodePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get contract bytecode by path:%s", contractByteCodePath)
+		}
+		b.log.Info(ctx, "Deploying contract bytecode")
+		_, codeID, err = b.contractClient.DeployContract(ctx, senderAddress, contactByteCode)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to deploy contract")
+		}
+		progress.ContractCodeID = codeID
+		if err := saveBootstrappingProgress(progressFilePath, *progress); err != nil {
+			return nil, err
+		}
+	}
+
+	b.log.Info(ctx, "Instantiating contract", zap.Any("settings", instantiationCfg))
+	contractAddress, err := b.contractClient.InstantiateContract(ctx, senderAddress, codeID, instantiationCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to instantiate contract")
+	}
+	b.log.Info(ctx, "Contract is deployed successfully", zap.String("address", contractAddress.String()))
+	progress.ContractAddress = contractAddress.String()
+	if err := saveBootstrappingProgress(progressFilePath, *progress); err != nil {
+		return nil, err
+	}
+
+	return contractAddress, nil
+}
+
 func (b *BridgeClient) setUpXRPLBridgeAccount(
 	ctx context.Context,
 	bridgeAccountKeyName string,
 	cfg BootstrappingConfig,
 	xrplSignerEntries []rippledata.SignerEntry,
+	progress *BootstrappingProgress,
+	progressFilePath string,
 ) error {
 	xrplBridgeAccount, err := b.xrplTxSigner.Account(bridgeAccountKeyName)
 	if err != nil {
 		return err
 	}
 
-	b.log.Info(ctx, "Enabling rippling")
+	// the effects of the steps are checked on-chain to skip the steps applied before the progress is saved
+	accountInfo, err := b.xrplRPCClient.AccountInfo(ctx, xrplBridgeAccount)
+	if err != nil {
+		return err
+	}
+	misconfigurations := xrpl.ValidateBridgeAccount(
+		xrpl.BridgeAccountState{
+			AccountInfo: accountInfo,
+		},
+		xrpl.BridgeAccountExpectedConfig{
+			SignerQuorum: cfg.EvidenceThreshold,
+			SignerAddresses: lo.Map(xrplSignerEntries, func(entry rippledata.SignerEntry, _ int) string {
+				return entry.SignerEntry.Account.String()
+			}),
+		},
+	)
+	hasMisconfiguration := func(reasons ...xrpl.BridgeAccountMisconfigurationReason) bool {
+		return lo.ContainsBy(misconfigurations, func(misconfiguration xrpl.BridgeAccountMisconfiguration) bool {
+			return lo.Contains(reasons, misconfiguration.Reason)
+		})
+	}
+
 	enableRipplingTx := rippledata.AccountSet{
 		SetFlag: lo.ToPtr(uint32(rippledata.TxDefaultRipple)),
 		TxBase: rippledata.TxBase{
 			TransactionType: rippledata.ACCOUNT_SET,
 		},
 	}
-	if _, err := b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &enableRipplingTx, bridgeAccountKeyName); err != nil {
+	if err := b.applyXRPLBootstrappingStep(
+		ctx,
+		"Enabling rippling",
+		!hasMisconfiguration(xrpl.BridgeAccountDefaultRippleDisabled),
+		&enableRipplingTx,
+		bridgeAccountKeyName,
+		&progress.EnableRipplingTxHash,
+		progress,
+		progressFilePath,
+	); err != nil {
 		return err
 	}
 
-	b.log.Info(ctx, "Setting signers rippling")
 	signerListSetTx := rippledata.SignerListSet{
 		SignerQuorum:  cfg.EvidenceThreshold,
 		SignerEntries: xrplSignerEntries,
@@ -1296,11 +2877,23 @@ func (b *BridgeClient) setUpXRPLBridgeAccount(
 			TransactionType: rippledata.SIGNER_LIST_SET,
 		},
 	}
-	if _, err := b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &signerListSetTx, bridgeAccountKeyName); err != nil {
+	if err := b.applyXRPLBootstrappingStep(
+		ctx,
+		"Setting signers",
+		!hasMisconfiguration(
+			xrpl.BridgeAccountSignerListMissing,
+			xrpl.BridgeAccountSignerQuorumMismatch,
+			xrpl.BridgeAccountSignerEntriesMismatch,
+		),
+		&signerListSetTx,
+		bridgeAccountKeyName,
+		&progress.SignerListSetTxHash,
+		progress,
+		progressFilePath,
+	); err != nil {
 		return err
 	}
 
-	b.log.Info(ctx, "Disabling master key")
 	disableMasterKeyTx := rippledata.AccountSet{
 		TxBase: rippledata.TxBase{
 			Account:         xrplBridgeAccount,
@@ -1308,11 +2901,49 @@ func (b *BridgeClient) setUpXRPLBridgeAccount(
 		},
 		SetFlag: lo.ToPtr(uint32(rippledata.TxSetDisableMaster)),
 	}
-	if _, err := b.autoFillSignSubmitAndAwaitXRPLTx(ctx, &disableMasterKeyTx, bridgeAccountKeyName); err != nil {
+	return b.applyXRPLBootstrappingStep(
+		ctx,
+		"Disabling master key",
+		!hasMisconfiguration(xrpl.BridgeAccountMasterKeyEnabled),
+		&disableMasterKeyTx,
+		bridgeAccountKeyName,
+		&progress.DisableMasterKeyTxHash,
+		progress,
+		progressFilePath,
+	)
+}
+
+// applyXRPLBootstrappingStep submits the step tx if its effect isn't applied yet and records the tx hash.
+func (b *BridgeClient) applyXRPLBootstrappingStep(
+	ctx context.Context,
+	step string,
+	applied bool,
+	tx rippledata.Transaction,
+	bridgeAccountKeyName string,
+	recordedTxHash *string,
+	progress *BootstrappingProgress,
+	progressFilePath string,
+) error {
+	if applied {
+		b.log.Info(ctx, "Skipping applied bootstrapping step", zap.String("step", step),
+			zap.String("txHash", *recordedTxHash))
+		return nil
+	}
+	if *recordedTxHash != "" {
+		return errors.Errorf(
+			"bootstrapping step %q is completed with tx %s, but its effect doesn't hold on the XRPL bridge account",
+			step, *recordedTxHash,
+		)
+	}
+
+	b.log.Info(ctx, step)
+	txHash, err := b.autoFillSignSubmitAndAwaitXRPLTx(ctx, tx, bridgeAccountKeyName)
+	if err != nil {
 		return err
 	}
+	*recordedTxHash = txHash
 
-	return nil
+	return saveBootstrappingProgress(progressFilePath, *progress)
 }
 
 // ComputeXRPLBridgeAccountBalance computes the min balance required by the XRPL bridge account.
@@ -1339,6 +2970,9 @@ func ReadBootstrappingConfig(filePath string) (BootstrappingConfig, error) {
 	if err := yaml.Unmarshal(fileBytes, &config); err != nil {
 		return BootstrappingConfig{}, errors.Wrapf(err, "failed to unmarshal file to yaml, path:%s", filePath)
 	}
+	if err := verifyRelayersXRPLKeys(config.Relayers); err != nil {
+		return BootstrappingConfig{}, errors.Wrapf(err, "invalid bootstrapping config, path:%s", filePath)
+	}
 
 	return config, nil
 }
@@ -1359,10 +2993,28 @@ func ReadKeysRotationConfig(filePath string) (KeysRotationConfig, error) {
 	if err := yaml.Unmarshal(fileBytes, &config); err != nil {
 		return KeysRotationConfig{}, errors.Wrapf(err, "failed to unmarshal file to yaml, path:%s", filePath)
 	}
+	if err := verifyRelayersXRPLKeys(config.Relayers); err != nil {
+		return KeysRotationConfig{}, errors.Wrapf(err, "invalid keys rotation config, path:%s", filePath)
+	}
 
 	return config, nil
 }
 
+// verifyRelayersXRPLKeys checks that the XRPL address of each relayer is derived from its XRPL public key. The empty
+// relayer of the config template is skipped.
+func verifyRelayersXRPLKeys(relayers []RelayerConfig) error {
+	for i, relayer := range relayers {
+		if relayer.XRPLAddress == "" && relayer.XRPLPubKey == "" {
+			continue
+		}
+		if err := xrpl.VerifyPublicKeyAddress(relayer.XRPLPubKey, relayer.XRPLAddress); err != nil {
+			return errors.Wrapf(err, "invalid XRPL keys of the relayer %d, coreum address:%s", i, relayer.CoreumAddress)
+		}
+	}
+
+	return nil
+}
+
 func saveConfigToFile(filePath string, srt any) error {
 	dirPath := filepath.Dir(filePath)
 	if err := os.MkdirAll(dirPath, 0o700); err != nil {
@@ -1399,6 +3051,57 @@ func readConfigFromFile(filePath string) ([]byte, error) {
 	return fileBytes, nil
 }
 
+func (b *BridgeClient) buildXRPLToCoreumPaymentTx(
+	ctx context.Context,
+	senderKeyName string,
+	amount rippledata.Amount,
+	recipient sdk.AccAddress,
+) (rippledata.Payment, error) {
+	senderAccount, err := b.xrplTxSigner.Account(senderKeyName)
+	if err != nil {
+		return rippledata.Payment{}, err
+	}
+
+	b.log.Info(
+		ctx,
+		"Sending tokens form XRPL to Coreum",
+		zap.String("sender", senderAccount.String()),
+		zap.String("amount", amount.String()),
+		zap.String("recipient", recipient.String()),
+	)
+
+	cfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return rippledata.Payment{}, err
+	}
+	xrplBridgeAddress, err := rippledata.NewAccountFromAddress(cfg.BridgeXRPLAddress)
+	if err != nil {
+		return rippledata.Payment{}, errors.Wrapf(
+			err,
+			"failed to convert BridgeXRPLAddress from contract to rippledata.Account, address:%s",
+			cfg.BridgeXRPLAddress,
+		)
+	}
+
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(recipient)
+	if err != nil {
+		return rippledata.Payment{}, err
+	}
+
+	paymentTx := rippledata.Payment{
+		Destination: *xrplBridgeAddress,
+		Amount:      amount,
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.PAYMENT,
+			Memos: rippledata.Memos{
+				memo,
+			},
+		},
+	}
+
+	return paymentTx, nil
+}
+
 func (b *BridgeClient) autoFillSignSubmitAndAwaitXRPLTx(
 	ctx context.Context,
 	tx rippledata.Transaction,
@@ -1411,6 +3114,10 @@ func (b *BridgeClient) autoFillSignSubmitAndAwaitXRPLTx(
 	if err := b.xrplRPCClient.AutoFillTx(ctx, tx, sender, xrpl.MaxAllowedXRPLSigners); err != nil {
 		return "", err
 	}
+	// the monitor signs the tx itself since it sets the last ledger sequence
+	if b.xrplSubmissionMonitor != nil {
+		return b.submitAndMonitorXRPLTx(ctx, tx, signerKeyName)
+	}
 	if err := b.xrplTxSigner.Sign(tx, signerKeyName); err != nil {
 		return "", err
 	}
@@ -1423,3 +3130,75 @@ func (b *BridgeClient) autoFillSignSubmitAndAwaitXRPLTx(
 
 	return tx.GetHash().String(), nil
 }
+
+func (b *BridgeClient) submitAndMonitorXRPLTx(
+	ctx context.Context,
+	tx rippledata.Transaction,
+	signerKeyName string,
+) (string, error) {
+	txRes, err := b.xrplSubmissionMonitor.SubmitAndMonitor(
+		ctx,
+		tx,
+		func(_ context.Context, tx rippledata.Transaction) error {
+			return b.xrplTxSigner.Sign(tx, signerKeyName)
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	txHash := txRes.GetHash().String()
+	if !txRes.MetaData.TransactionResult.Success() {
+		return "", errors.Errorf(
+			"XRPL transaction %s is validated with the failed result: %s",
+			txHash, txRes.MetaData.TransactionResult.String(),
+		)
+	}
+	b.log.Info(ctx, "Successfully submitted transaction", zap.String("txHash", txHash))
+
+	return txHash, nil
+}
+
+// transferTokensRegistry is the registered tokens index used to resolve the tokens of the transfers.
+type transferTokensRegistry struct {
+	bridgeXRPLAddress string
+	coreumTokens      map[string]coreum.CoreumToken
+	xrplTokens        map[string]coreum.XRPLToken
+	xrplTokensByDenom map[string]coreum.XRPLToken
+}
+
+func newTransferTokensRegistry(
+	bridgeXRPLAddress string,
+	coreumTokens []coreum.CoreumToken,
+	xrplTokens []coreum.XRPLToken,
+) transferTokensRegistry {
+	return transferTokensRegistry{
+		bridgeXRPLAddress: bridgeXRPLAddress,
+		coreumTokens: lo.SliceToMap(coreumTokens, func(token coreum.CoreumToken) (string, coreum.CoreumToken) {
+			return token.Denom, token
+		}),
+		xrplTokens: lo.SliceToMap(xrplTokens, func(token coreum.XRPLToken) (string, coreum.XRPLToken) {
+			return xrplTokenKey(token.Issuer, token.Currency), token
+		}),
+		xrplTokensByDenom: lo.SliceToMap(xrplTokens, func(token coreum.XRPLToken) (string, coreum.XRPLToken) {
+			return token.CoreumDenom, token
+		}),
+	}
+}
+
+// coreumTokenByXRPLCurrency returns the Coreum originated token issued on the XRPL by the bridge account.
+func (r transferTokensRegistry) coreumTokenByXRPLCurrency(issuer, currency string) (coreum.CoreumToken, bool) {
+	if issuer != r.bridgeXRPLAddress {
+		return coreum.CoreumToken{}, false
+	}
+	for _, token := range r.coreumTokens {
+		if token.XRPLCurrency == currency {
+			return token, true
+		}
+	}
+
+	return coreum.CoreumToken{}, false
+}
+
+func xrplTokenKey(issuer, currency string) string {
+	return issuer + "/" + currency
+}
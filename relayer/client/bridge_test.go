@@ -1,13 +1,26 @@
 package client_test
 
 import (
+	"context"
+	"encoding/json"
+	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
 func TestInitAndReadBootstrappingConfig(t *testing.T) {
@@ -40,6 +53,128 @@ func TestInitAndReadKeysRotationConfig(t *testing.T) {
 	require.Equal(t, defaultCfg, readConfig)
 }
 
+func TestReadConfigs_XRPLKeysVerification(t *testing.T) {
+	t.Parallel()
+
+	// the keys are derived from the "masterpassphrase" seed
+	secp256k1Relayer := client.RelayerConfig{
+		CoreumAddress: "core1secp256k1",
+		XRPLAddress:   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		XRPLPubKey:    "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+	}
+	ed25519Relayer := client.RelayerConfig{
+		CoreumAddress: "core1ed25519",
+		XRPLAddress:   "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+		XRPLPubKey:    "EDAAC3F98BB94F451804EF5993C847DAAA4E6154F455635659D88AA5C80F156303",
+	}
+
+	tests := []struct {
+		name         string
+		relayers     []client.RelayerConfig
+		wantErrorMsg string
+	}{
+		{
+			name:     "valid_keys",
+			relayers: []client.RelayerConfig{secp256k1Relayer, ed25519Relayer},
+		},
+		{
+			name: "swapped_entries",
+			relayers: []client.RelayerConfig{
+				{
+					CoreumAddress: secp256k1Relayer.CoreumAddress,
+					XRPLAddress:   secp256k1Relayer.XRPLAddress,
+					XRPLPubKey:    ed25519Relayer.XRPLPubKey,
+				},
+				{
+					CoreumAddress: ed25519Relayer.CoreumAddress,
+					XRPLAddress:   ed25519Relayer.XRPLAddress,
+					XRPLPubKey:    secp256k1Relayer.XRPLPubKey,
+				},
+			},
+			wantErrorMsg: "invalid XRPL keys of the relayer 0, coreum address:core1secp256k1",
+		},
+		{
+			name: "missing_pub_key",
+			relayers: []client.RelayerConfig{
+				secp256k1Relayer,
+				{
+					CoreumAddress: ed25519Relayer.CoreumAddress,
+					XRPLAddress:   ed25519Relayer.XRPLAddress,
+				},
+			},
+			wantErrorMsg: "invalid XRPL keys of the relayer 1, coreum address:core1ed25519",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bootstrappingCfg := client.DefaultBootstrappingConfig()
+			bootstrappingCfg.Relayers = tt.relayers
+			bootstrappingCfgPath := path.Join(t.TempDir(), "bootstrapping.yaml")
+			writeYAMLFile(t, bootstrappingCfgPath, bootstrappingCfg)
+			_, err := client.ReadBootstrappingConfig(bootstrappingCfgPath)
+			if tt.wantErrorMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			keysRotationCfgPath := path.Join(t.TempDir(), "new-keys.yaml")
+			writeYAMLFile(t, keysRotationCfgPath, client.KeysRotationConfig{
+				Relayers:          tt.relayers,
+				EvidenceThreshold: 1,
+			})
+			_, err = client.ReadKeysRotationConfig(keysRotationCfgPath)
+			if tt.wantErrorMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEncodeBootstrappingConfigTemplate(t *testing.T) {
+	t.Parallel()
+
+	sourceTag := uint32(7)
+	cfg := client.DefaultBootstrappingConfig()
+	cfg.Relayers = make([]client.RelayerConfig, 3)
+	cfg.EvidenceThreshold = 2
+	cfg.SourceTag = &sourceTag
+
+	templateData, err := client.EncodeBootstrappingConfigTemplate(cfg)
+	require.NoError(t, err)
+
+	// every field is explained
+	templateLines := strings.Split(strings.TrimSpace(string(templateData)), "\n")
+	isComment := func(line string) bool {
+		return strings.HasPrefix(strings.TrimLeft(strings.TrimSpace(line), "- "), "#")
+	}
+	for i, line := range templateLines {
+		if isComment(line) {
+			continue
+		}
+		require.True(t, i > 0 && isComment(templateLines[i-1]), "field %q has no comment", line)
+	}
+
+	filePath := path.Join(t.TempDir(), "bootstrapping.yaml")
+	require.NoError(t, os.WriteFile(filePath, templateData, 0o600))
+	readConfig, err := client.ReadBootstrappingConfig(filePath)
+	require.NoError(t, err)
+	require.Equal(t, cfg, readConfig)
+}
+
+func writeYAMLFile(t *testing.T, filePath string, v any) {
+	t.Helper()
+
+	fileData, err := yaml.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filePath, fileData, 0o600))
+}
+
 // the func returns the default config snapshot.
 func getDefaultBootstrappingConfigString() string {
 	return `owner: ""
@@ -65,3 +200,561 @@ func getDefaultKeysRotationConfigString() string {
 evidence_threshold: 0
 `
 }
+
+func TestValidateTokenStateTransition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		currentState coreum.TokenState
+		targetState  coreum.TokenState
+		checkErr     func(t *testing.T, err error)
+	}{
+		{
+			name:         "enabled_to_disabled",
+			currentState: coreum.TokenStateEnabled,
+			targetState:  coreum.TokenStateDisabled,
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "disabled_to_enabled",
+			currentState: coreum.TokenStateDisabled,
+			targetState:  coreum.TokenStateEnabled,
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "inactive_to_enabled",
+			currentState: coreum.TokenStateInactive,
+			targetState:  coreum.TokenStateEnabled,
+			checkErr: func(t *testing.T, err error) {
+				require.True(t, coreum.IsTokenStateIsImmutableError(err), err)
+			},
+		},
+		{
+			name:         "processing_to_disabled",
+			currentState: coreum.TokenStateProcessing,
+			targetState:  coreum.TokenStateDisabled,
+			checkErr: func(t *testing.T, err error) {
+				require.True(t, coreum.IsTokenStateIsImmutableError(err), err)
+			},
+		},
+		{
+			name:         "enabled_to_processing",
+			currentState: coreum.TokenStateEnabled,
+			targetState:  coreum.TokenStateProcessing,
+			checkErr: func(t *testing.T, err error) {
+				require.True(t, coreum.IsInvalidTargetTokenStateError(err), err)
+			},
+		},
+		{
+			name:         "disabled_to_disabled",
+			currentState: coreum.TokenStateDisabled,
+			targetState:  coreum.TokenStateDisabled,
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "the token state is already disabled")
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.checkErr(t, client.ValidateTokenStateTransition(tt.currentState, tt.targetState))
+		})
+	}
+}
+
+func TestFilterTokenPendingOperationIDs(t *testing.T) {
+	t.Parallel()
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	currency := "TKN"
+	operations := []coreum.Operation{
+		{
+			TicketSequence: 1,
+			OperationType: coreum.OperationType{
+				TrustSet: &coreum.OperationTypeTrustSet{Issuer: issuer, Currency: currency},
+			},
+		},
+		{
+			TicketSequence: 2,
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{Issuer: issuer, Currency: currency},
+			},
+		},
+		{
+			TicketSequence: 3,
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{Issuer: issuer, Currency: "ABC"},
+			},
+		},
+		{
+			AccountSequence: 4,
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{Number: 10},
+			},
+		},
+	}
+	require.Equal(t, []uint32{1, 2}, client.FilterTokenPendingOperationIDs(operations, issuer, currency))
+	require.Empty(t, client.FilterTokenPendingOperationIDs(operations, issuer, "XYZ"))
+}
+
+func TestSendFromCoreumToXRPL_MinBridgeAmount(t *testing.T) {
+	t.Parallel()
+
+	minBridgeAmounts, err := processes.NewMinBridgeAmounts(processes.MinBridgeAmountsConfig{
+		Tokens: []processes.MinBridgeAmountConfig{
+			{Denom: "ucore", Amount: "1000"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		amount      sdk.Coin
+		expectedErr string
+	}{
+		{
+			name:   "amount_equal_to_min",
+			amount: sdk.NewCoin("ucore", sdkmath.NewInt(1000)),
+		},
+		{
+			name:        "amount_below_min",
+			amount:      sdk.NewCoin("ucore", sdkmath.NewInt(999)),
+			expectedErr: "amount 999ucore is below the min bridge amount 1000ucore",
+		},
+		{
+			name:   "amount_of_denom_without_min",
+			amount: sdk.NewCoin("utkn", sdkmath.NewInt(1)),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := &fakeSendToXRPLContractClient{}
+			bridgeClient := client.NewBridgeClient(
+				newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil,
+			).WithMinBridgeAmounts(minBridgeAmounts)
+
+			_, err := bridgeClient.SendFromCoreumToXRPL(
+				context.Background(), coreum.GenAccount(), xrpl.GenPrivKeyTxSigner().Account(), tt.amount, nil,
+			)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				require.Empty(t, contractClient.sentAmounts)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Coin{tt.amount}, contractClient.sentAmounts)
+		})
+	}
+}
+
+type fakeSendToXRPLContractClient struct {
+	client.ContractClient
+
+	sentAmounts []sdk.Coin
+}
+
+func (c *fakeSendToXRPLContractClient) SendToXRPL(
+	_ context.Context,
+	_ sdk.AccAddress,
+	_ string,
+	amount sdk.Coin,
+	_ *sdkmath.Int,
+) (*sdk.TxResponse, error) {
+	c.sentAmounts = append(c.sentAmounts, amount)
+	return &sdk.TxResponse{}, nil
+}
+
+func TestGetTokenPairs(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplToken := coreum.XRPLToken{
+		Issuer:           xrpl.GenPrivKeyTxSigner().Account().String(),
+		Currency:         "CRN",
+		CoreumDenom:      "xrpl-crn",
+		SendingPrecision: 12,
+		MaxHoldingAmount: sdkmath.NewInt(3000),
+		State:            coreum.TokenStateDisabled,
+		BridgingFee:      sdkmath.NewInt(1),
+	}
+	coreumToken := coreum.CoreumToken{
+		Denom:            "ucore",
+		Decimals:         6,
+		XRPLCurrency:     "636F726575670000000000000000000000000000",
+		SendingPrecision: 6,
+		MaxHoldingAmount: sdkmath.NewInt(1000),
+		State:            coreum.TokenStateEnabled,
+		BridgingFee:      sdkmath.NewInt(10),
+	}
+	contractClient := &fakeTokenPairsContractClient{
+		contractCfg:  coreum.ContractConfig{BridgeXRPLAddress: bridgeXRPLAddress},
+		coreumTokens: []coreum.CoreumToken{coreumToken},
+		xrplTokens:   []coreum.XRPLToken{xrplToken},
+	}
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+	tokenPairs, err := bridgeClient.GetTokenPairs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []client.TokenPair{
+		{
+			Type:             client.TokenPairTypeXRPL,
+			XRPLIssuer:       xrplToken.Issuer,
+			XRPLCurrency:     xrplToken.Currency,
+			CoreumDenom:      xrplToken.CoreumDenom,
+			SendingPrecision: xrplToken.SendingPrecision,
+			MaxHoldingAmount: xrplToken.MaxHoldingAmount,
+			BridgingFee:      xrplToken.BridgingFee,
+			State:            xrplToken.State,
+		},
+		{
+			Type:             client.TokenPairTypeCoreum,
+			XRPLIssuer:       bridgeXRPLAddress,
+			XRPLCurrency:     coreumToken.XRPLCurrency,
+			CoreumDenom:      coreumToken.Denom,
+			SendingPrecision: coreumToken.SendingPrecision,
+			MaxHoldingAmount: coreumToken.MaxHoldingAmount,
+			BridgingFee:      coreumToken.BridgingFee,
+			State:            coreumToken.State,
+		},
+	}, tokenPairs)
+}
+
+type fakeTokenPairsContractClient struct {
+	client.ContractClient
+
+	contractCfg  coreum.ContractConfig
+	coreumTokens []coreum.CoreumToken
+	xrplTokens   []coreum.XRPLToken
+}
+
+func (c *fakeTokenPairsContractClient) GetContractConfig(_ context.Context) (coreum.ContractConfig, error) {
+	return c.contractCfg, nil
+}
+
+func (c *fakeTokenPairsContractClient) GetCoreumTokens(_ context.Context) ([]coreum.CoreumToken, error) {
+	return c.coreumTokens, nil
+}
+
+func (c *fakeTokenPairsContractClient) GetXRPLTokens(_ context.Context) ([]coreum.XRPLToken, error) {
+	return c.xrplTokens, nil
+}
+
+func TestListStuckTokenRegistrationsAndRecover(t *testing.T) {
+	t.Parallel()
+
+	genToken := func(currency string, state coreum.TokenState) coreum.XRPLToken {
+		return coreum.XRPLToken{
+			Issuer:      xrpl.GenPrivKeyTxSigner().Account().String(),
+			Currency:    currency,
+			CoreumDenom: "xrpl-" + strings.ToLower(currency),
+			State:       state,
+		}
+	}
+	genTrustSetOperation := func(token coreum.XRPLToken, ticketSequence uint32) coreum.Operation {
+		return coreum.Operation{
+			TicketSequence: ticketSequence,
+			OperationType: coreum.OperationType{
+				TrustSet: &coreum.OperationTypeTrustSet{
+					Issuer:   token.Issuer,
+					Currency: token.Currency,
+				},
+			},
+		}
+	}
+	oldToken := genToken("OLD", coreum.TokenStateProcessing)
+	recentToken := genToken("NEW", coreum.TokenStateProcessing)
+	untrackedToken := genToken("UNT", coreum.TokenStateProcessing)
+	missingOperationToken := genToken("MIS", coreum.TokenStateProcessing)
+	inactiveToken := genToken("INA", coreum.TokenStateInactive)
+	enabledToken := genToken("ENA", coreum.TokenStateEnabled)
+
+	storeFilePath := path.Join(t.TempDir(), processes.OperationAgeStoreFileName)
+	storeBytes, err := yaml.Marshal(processes.OperationAgeStore{
+		FirstSeen: map[uint32]time.Time{
+			1: time.Now().Add(-2 * time.Hour),
+			2: time.Now().Add(-time.Minute),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(storeFilePath, storeBytes, 0o600))
+
+	contractClient := &fakeTokenRecoveryContractClient{
+		xrplTokens: []coreum.XRPLToken{
+			oldToken, recentToken, untrackedToken, missingOperationToken, inactiveToken, enabledToken,
+		},
+		pendingOperations: []coreum.Operation{
+			genTrustSetOperation(oldToken, 1),
+			genTrustSetOperation(recentToken, 2),
+			genTrustSetOperation(untrackedToken, 3),
+		},
+	}
+	bridgeClient := client.NewBridgeClient(
+		newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil,
+	).WithOperationAgeStoreFilePath(storeFilePath)
+
+	ctx := context.Background()
+	stuckTokens, err := bridgeClient.ListStuckTokenRegistrations(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, stuckTokens, 2)
+	require.Equal(t, oldToken.Currency, stuckTokens[0].Currency)
+	require.Equal(t, uint32(1), *stuckTokens[0].OperationID)
+	require.GreaterOrEqual(t, stuckTokens[0].OperationAge, 2*time.Hour)
+	require.Equal(t, client.StuckTokenRegistration{
+		Issuer:      missingOperationToken.Issuer,
+		Currency:    missingOperationToken.Currency,
+		CoreumDenom: missingOperationToken.CoreumDenom,
+	}, stuckTokens[1])
+
+	sender := coreum.GenAccount()
+	for _, token := range []coreum.XRPLToken{recentToken, untrackedToken, missingOperationToken, enabledToken} {
+		err := bridgeClient.RecoverStuckXRPLTokenRegistration(ctx, sender, token.Issuer, token.Currency, time.Hour)
+		require.True(t, coreum.IsXRPLTokenNotInactiveError(err), err)
+	}
+	require.Empty(t, contractClient.cancelledOperationIDs)
+	require.Empty(t, contractClient.recoveredCurrencies)
+
+	for _, token := range []coreum.XRPLToken{oldToken, inactiveToken} {
+		require.NoError(
+			t, bridgeClient.RecoverStuckXRPLTokenRegistration(ctx, sender, token.Issuer, token.Currency, time.Hour),
+		)
+	}
+	require.Equal(t, []uint32{1}, contractClient.cancelledOperationIDs)
+	require.Equal(t, []string{oldToken.Currency, inactiveToken.Currency}, contractClient.recoveredCurrencies)
+}
+
+type fakeTokenRecoveryContractClient struct {
+	client.ContractClient
+
+	xrplTokens            []coreum.XRPLToken
+	pendingOperations     []coreum.Operation
+	cancelledOperationIDs []uint32
+	recoveredCurrencies   []string
+}
+
+func (c *fakeTokenRecoveryContractClient) GetXRPLTokens(_ context.Context) ([]coreum.XRPLToken, error) {
+	return c.xrplTokens, nil
+}
+
+func (c *fakeTokenRecoveryContractClient) GetXRPLTokenByIssuerAndCurrency(
+	_ context.Context,
+	issuer, currency string,
+) (coreum.XRPLToken, error) {
+	for _, token := range c.xrplTokens {
+		if token.Issuer == issuer && token.Currency == currency {
+			return token, nil
+		}
+	}
+	return coreum.XRPLToken{}, errors.New("token not found")
+}
+
+func (c *fakeTokenRecoveryContractClient) GetPendingOperations(_ context.Context) ([]coreum.Operation, error) {
+	return c.pendingOperations, nil
+}
+
+func (c *fakeTokenRecoveryContractClient) CancelPendingOperation(
+	_ context.Context,
+	_ sdk.AccAddress,
+	operationID uint32,
+) (*sdk.TxResponse, error) {
+	c.cancelledOperationIDs = append(c.cancelledOperationIDs, operationID)
+	return &sdk.TxResponse{}, nil
+}
+
+func (c *fakeTokenRecoveryContractClient) RecoverXRPLTokenRegistration(
+	_ context.Context,
+	_ sdk.AccAddress,
+	_, currency string,
+) (*sdk.TxResponse, error) {
+	c.recoveredCurrencies = append(c.recoveredCurrencies, currency)
+	return &sdk.TxResponse{}, nil
+}
+
+func TestCheckXRPLIssuerAccount(t *testing.T) {
+	t.Parallel()
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	serverState := xrpl.ServerStateResult{
+		State: xrpl.ServerState{
+			ValidatedLedger: xrpl.ServerStateValidatedLedger{
+				ReserveBase: 10_000_000,
+				ReserveInc:  2_000_000,
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		accountErr error
+		balance    string
+		wantErr    error
+	}{
+		{
+			name:    "activated_account",
+			balance: "10000000",
+		},
+		{
+			name:       "not_existing_account",
+			accountErr: &xrpl.RPCError{Name: "actNotFound"},
+			wantErr:    client.ErrXRPLIssuerAccountNotActivated,
+		},
+		{
+			name:    "balance_below_base_reserve",
+			balance: "9999999",
+			wantErr: client.ErrXRPLIssuerAccountNotActivated,
+		},
+		{
+			name:       "rpc_error",
+			accountErr: errors.New("xrpl is unreachable"),
+			wantErr:    errors.New("xrpl is unreachable"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var accountInfo xrpl.AccountInfoResult
+			if tt.balance != "" {
+				require.NoError(t, json.Unmarshal([]byte(`{
+					"account_data": {
+						"Account": "`+issuer.String()+`",
+						"Balance": "`+tt.balance+`",
+						"Flags": 0,
+						"LedgerEntryType": "AccountRoot",
+						"OwnerCount": 0,
+						"Sequence": 1
+					}
+				}`), &accountInfo))
+			}
+			xrplRPCClient := &fakeIssuerCheckXRPLRPCClient{
+				accountInfo: accountInfo,
+				accountErr:  tt.accountErr,
+				serverState: serverState,
+			}
+			bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, nil, xrplRPCClient, nil)
+
+			err := bridgeClient.CheckXRPLIssuerAccount(context.Background(), issuer)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr.Error())
+			if errors.Is(tt.wantErr, client.ErrXRPLIssuerAccountNotActivated) {
+				require.ErrorIs(t, err, client.ErrXRPLIssuerAccountNotActivated)
+			}
+		})
+	}
+}
+
+func TestGetXRPLTicketsReserve(t *testing.T) {
+	t.Parallel()
+
+	account := xrpl.GenPrivKeyTxSigner().Account()
+	serverState := xrpl.ServerStateResult{
+		State: xrpl.ServerState{
+			ValidatedLedger: xrpl.ServerStateValidatedLedger{
+				ReserveBase: 10_000_000,
+				ReserveInc:  2_000_000,
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		accountErr      error
+		accountData     string
+		numberOfTickets uint32
+		want            client.XRPLTicketsReserve
+	}{
+		{
+			name:            "sufficient_balance",
+			accountData:     `"Balance": "40000000", "OwnerCount": 0`,
+			numberOfTickets: 10,
+			// base reserve, signer list and 10 tickets
+			want: client.XRPLTicketsReserve{
+				NumberOfTickets:      10,
+				BalanceDrops:         40_000_000,
+				RequiredReserveDrops: 32_000_000,
+			},
+		},
+		{
+			name:            "insufficient_balance_with_signer_list",
+			accountData:     `"Balance": "20000000", "OwnerCount": 1, "signer_lists": [{"SignerQuorum": 1}]`,
+			numberOfTickets: 10,
+			// base reserve, existing signer list and 10 tickets
+			want: client.XRPLTicketsReserve{
+				NumberOfTickets:      10,
+				BalanceDrops:         20_000_000,
+				RequiredReserveDrops: 32_000_000,
+				ShortfallDrops:       12_000_000,
+			},
+		},
+		{
+			name:            "not_existing_account",
+			accountErr:      &xrpl.RPCError{Name: "actNotFound"},
+			numberOfTickets: 250,
+			want: client.XRPLTicketsReserve{
+				NumberOfTickets:      250,
+				RequiredReserveDrops: 512_000_000,
+				ShortfallDrops:       512_000_000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var accountInfo xrpl.AccountInfoResult
+			if tt.accountData != "" {
+				require.NoError(t, json.Unmarshal([]byte(`{
+					"account_data": {
+						"Account": "`+account.String()+`",
+						"Flags": 0,
+						"LedgerEntryType": "AccountRoot",
+						"Sequence": 1,
+						`+tt.accountData+`
+					}
+				}`), &accountInfo))
+			}
+			xrplRPCClient := &fakeIssuerCheckXRPLRPCClient{
+				accountInfo: accountInfo,
+				accountErr:  tt.accountErr,
+				serverState: serverState,
+			}
+			bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, nil, xrplRPCClient, nil)
+
+			reserve, err := bridgeClient.GetXRPLTicketsReserve(context.Background(), account, tt.numberOfTickets)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, reserve)
+		})
+	}
+}
+
+type fakeIssuerCheckXRPLRPCClient struct {
+	client.XRPLRPCClient
+
+	accountInfo xrpl.AccountInfoResult
+	accountErr  error
+	serverState xrpl.ServerStateResult
+}
+
+func (c *fakeIssuerCheckXRPLRPCClient) AccountInfo(
+	_ context.Context,
+	_ rippledata.Account,
+) (xrpl.AccountInfoResult, error) {
+	return c.accountInfo, c.accountErr
+}
+
+func (c *fakeIssuerCheckXRPLRPCClient) ServerState(_ context.Context) (xrpl.ServerStateResult, error) {
+	return c.serverState, nil
+}
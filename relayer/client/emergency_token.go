@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// emergencyTokenPollInterval is the interval of the token pending operations check.
+const emergencyTokenPollInterval = 5 * time.Second
+
+// emergencyTokenReasonPrefix is the prefix of the reason recorded in the memo of the token state update tx.
+const emergencyTokenReasonPrefix = "reason: "
+
+// TokenRef is the reference to the bridged token, the Coreum originated token is referenced by the denom and the
+// XRPL originated token by the issuer and currency.
+type TokenRef struct {
+	Denom    string
+	Issuer   string
+	Currency string
+}
+
+// Validate checks that the token is referenced either by the denom or by the issuer and currency.
+func (r TokenRef) Validate() error {
+	switch {
+	case r.Denom != "" && (r.Issuer != "" || r.Currency != ""):
+		return errors.New("token must be referenced either by the denom or by the issuer and currency")
+	case r.Denom == "" && (r.Issuer == "" || r.Currency == ""):
+		return errors.New("token must be referenced by the denom or by both issuer and currency")
+	default:
+		return nil
+	}
+}
+
+// EmergencyTokenReport is the result of the emergency token state update.
+type EmergencyTokenReport struct {
+	CoreumDenom  string
+	XRPLIssuer   string
+	XRPLCurrency string
+	State        coreum.TokenState
+	TxHash       string
+	// PendingOperationIDs are the IDs of the token pending operations at the time of the state update.
+	PendingOperationIDs []uint32
+	// RemainingOperationIDs are the IDs of the token pending operations still not completed or cancelled.
+	RemainingOperationIDs []uint32
+}
+
+// EmergencyDisableToken disables the token and waits until all its pending operations are completed or cancelled,
+// the new operations of the token are rejected by the contract right after the update. The reason is recorded in
+// the memo of the update tx. If the timeout is reached, the report with the remaining operations is returned
+// together with the error.
+func (b *BridgeClient) EmergencyDisableToken(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	tokenRef TokenRef,
+	reason string,
+	waitTimeout time.Duration,
+) (EmergencyTokenReport, error) {
+	report, err := b.updateTokenStateWithReason(ctx, owner, tokenRef, coreum.TokenStateDisabled, reason)
+	if err != nil {
+		return report, err
+	}
+	if len(report.PendingOperationIDs) == 0 {
+		return report, nil
+	}
+	b.log.Info(
+		ctx,
+		"Waiting for the token pending operations",
+		zap.Uint32s("operationIDs", report.PendingOperationIDs),
+		zap.Duration("timeout", waitTimeout),
+	)
+
+	waitCtx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+	for {
+		select {
+		case <-waitCtx.Done():
+			return report, errors.Wrapf(
+				waitCtx.Err(),
+				"token pending operations aren't completed in %s, remaining operations:%v",
+				waitTimeout, report.RemainingOperationIDs,
+			)
+		case <-time.After(emergencyTokenPollInterval):
+		}
+		pendingOperations, err := b.contractClient.GetPendingOperations(waitCtx)
+		if err != nil {
+			return report, err
+		}
+		report.RemainingOperationIDs = FilterTokenPendingOperationIDs(
+			pendingOperations, report.XRPLIssuer, report.XRPLCurrency,
+		)
+		if len(report.RemainingOperationIDs) == 0 {
+			b.log.Info(ctx, "All token pending operations are completed")
+			return report, nil
+		}
+	}
+}
+
+// EmergencyEnableToken enables the token disabled by the EmergencyDisableToken, the reason is recorded in the memo
+// of the update tx.
+func (b *BridgeClient) EmergencyEnableToken(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	tokenRef TokenRef,
+	reason string,
+) (EmergencyTokenReport, error) {
+	return b.updateTokenStateWithReason(ctx, owner, tokenRef, coreum.TokenStateEnabled, reason)
+}
+
+func (b *BridgeClient) updateTokenStateWithReason(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	tokenRef TokenRef,
+	state coreum.TokenState,
+	reason string,
+) (EmergencyTokenReport, error) {
+	if err := tokenRef.Validate(); err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	if reason == "" {
+		return EmergencyTokenReport{}, errors.New("reason must not be empty")
+	}
+	note := emergencyTokenReasonPrefix + reason
+	if len(note) > coreum.MaxTxMemoNoteLength {
+		return EmergencyTokenReport{}, errors.Errorf(
+			"reason is too long, max length:%d", coreum.MaxTxMemoNoteLength-len(emergencyTokenReasonPrefix),
+		)
+	}
+
+	report, err := b.getEmergencyTokenReport(ctx, tokenRef)
+	if err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	if err := ValidateTokenStateTransition(report.State, state); err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	report.PendingOperationIDs = FilterTokenPendingOperationIDs(
+		pendingOperations, report.XRPLIssuer, report.XRPLCurrency,
+	)
+	report.RemainingOperationIDs = report.PendingOperationIDs
+
+	b.log.Info(
+		ctx,
+		"Updating token state",
+		zap.String("owner", owner.String()),
+		zap.String("denom", report.CoreumDenom),
+		zap.String("issuer", report.XRPLIssuer),
+		zap.String("currency", report.XRPLCurrency),
+		zap.String("state", string(state)),
+		zap.String("reason", reason),
+	)
+	ctx = coreum.WithTxMemoNote(ctx, note)
+	var txRes *sdk.TxResponse
+	if tokenRef.Denom != "" {
+		txRes, err = b.contractClient.UpdateCoreumToken(ctx, owner, report.CoreumDenom, &state, nil, nil, nil, nil, nil)
+	} else {
+		txRes, err = b.contractClient.UpdateXRPLToken(
+			ctx, owner, report.XRPLIssuer, report.XRPLCurrency, &state, nil, nil, nil, nil, nil,
+		)
+	}
+	if err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	report.State = state
+	if txRes != nil {
+		report.TxHash = txRes.TxHash
+		b.log.Info(ctx, "Successfully sent tx to update token state", zap.String("txHash", txRes.TxHash))
+	}
+
+	return report, nil
+}
+
+func (b *BridgeClient) getEmergencyTokenReport(ctx context.Context, tokenRef TokenRef) (EmergencyTokenReport, error) {
+	if tokenRef.Denom != "" {
+		contractCfg, err := b.contractClient.GetContractConfig(ctx)
+		if err != nil {
+			return EmergencyTokenReport{}, err
+		}
+		token, err := b.contractClient.GetCoreumTokenByDenom(ctx, tokenRef.Denom)
+		if err != nil {
+			return EmergencyTokenReport{}, err
+		}
+		return EmergencyTokenReport{
+			CoreumDenom: token.Denom,
+			// the Coreum originated tokens are issued by the bridge account on the XRPL
+			XRPLIssuer:   contractCfg.BridgeXRPLAddress,
+			XRPLCurrency: token.XRPLCurrency,
+			State:        token.State,
+		}, nil
+	}
+
+	token, err := b.contractClient.GetXRPLTokenByIssuerAndCurrency(ctx, tokenRef.Issuer, tokenRef.Currency)
+	if err != nil {
+		return EmergencyTokenReport{}, err
+	}
+	return EmergencyTokenReport{
+		CoreumDenom:  token.CoreumDenom,
+		XRPLIssuer:   token.Issuer,
+		XRPLCurrency: token.Currency,
+		State:        token.State,
+	}, nil
+}
@@ -0,0 +1,230 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestEmergencyDisableToken(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	coreumToken := coreum.CoreumToken{
+		Denom:        "ucore",
+		XRPLCurrency: "636F726575670000000000000000000000000000",
+		State:        coreum.TokenStateEnabled,
+	}
+	xrplToken := coreum.XRPLToken{
+		Issuer:      xrpl.GenPrivKeyTxSigner().Account().String(),
+		Currency:    "CRN",
+		CoreumDenom: "xrpl-crn",
+		State:       coreum.TokenStateEnabled,
+	}
+	recipient := xrpl.GenPrivKeyTxSigner().Account()
+	owner := coreum.GenAccount()
+
+	tests := []struct {
+		name                  string
+		tokenRef              client.TokenRef
+		reason                string
+		pendingOperations     []coreum.Operation
+		expectedReport        client.EmergencyTokenReport
+		expectedUpdatedTokens []string
+		expectedErr           error
+		expectedErrMsg        string
+	}{
+		{
+			name:     "coreum_token_without_pending_operations",
+			tokenRef: client.TokenRef{Denom: coreumToken.Denom},
+			reason:   "incident",
+			pendingOperations: []coreum.Operation{
+				newCoreumToXRPLTransferOperation(1, xrplToken.Issuer, xrplToken.Currency, recipient),
+			},
+			expectedReport: client.EmergencyTokenReport{
+				CoreumDenom:           coreumToken.Denom,
+				XRPLIssuer:            bridgeXRPLAddress,
+				XRPLCurrency:          coreumToken.XRPLCurrency,
+				State:                 coreum.TokenStateDisabled,
+				TxHash:                "C0FFEE",
+				PendingOperationIDs:   []uint32{},
+				RemainingOperationIDs: []uint32{},
+			},
+			expectedUpdatedTokens: []string{coreumToken.Denom},
+		},
+		{
+			name:     "xrpl_token_with_remaining_pending_operations",
+			tokenRef: client.TokenRef{Issuer: xrplToken.Issuer, Currency: xrplToken.Currency},
+			reason:   "incident",
+			pendingOperations: []coreum.Operation{
+				newCoreumToXRPLTransferOperation(1, xrplToken.Issuer, xrplToken.Currency, recipient),
+				newCoreumToXRPLTransferOperation(2, bridgeXRPLAddress, coreumToken.XRPLCurrency, recipient),
+			},
+			expectedReport: client.EmergencyTokenReport{
+				CoreumDenom:           xrplToken.CoreumDenom,
+				XRPLIssuer:            xrplToken.Issuer,
+				XRPLCurrency:          xrplToken.Currency,
+				State:                 coreum.TokenStateDisabled,
+				TxHash:                "C0FFEE",
+				PendingOperationIDs:   []uint32{1},
+				RemainingOperationIDs: []uint32{1},
+			},
+			expectedUpdatedTokens: []string{xrplToken.Issuer + "/" + xrplToken.Currency},
+			expectedErr:           context.DeadlineExceeded,
+		},
+		{
+			name:           "empty_reason",
+			tokenRef:       client.TokenRef{Denom: coreumToken.Denom},
+			expectedErrMsg: "reason must not be empty",
+		},
+		{
+			name:           "ambiguous_token_ref",
+			tokenRef:       client.TokenRef{Denom: coreumToken.Denom, Issuer: xrplToken.Issuer},
+			reason:         "incident",
+			expectedErrMsg: "token must be referenced either by the denom or by the issuer and currency",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := &fakeEmergencyTokenContractClient{
+				fakeSendBatchContractClient: fakeSendBatchContractClient{
+					fakeTokenPairsContractClient: fakeTokenPairsContractClient{
+						contractCfg:  coreum.ContractConfig{BridgeXRPLAddress: bridgeXRPLAddress},
+						coreumTokens: []coreum.CoreumToken{coreumToken},
+						xrplTokens:   []coreum.XRPLToken{xrplToken},
+					},
+					txRes:             &sdk.TxResponse{TxHash: "C0FFEE"},
+					currentOperations: tt.pendingOperations,
+				},
+			}
+			bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+			report, err := bridgeClient.EmergencyDisableToken(
+				context.Background(), owner, tt.tokenRef, tt.reason, 10*time.Millisecond,
+			)
+			switch {
+			case tt.expectedErr != nil:
+				require.ErrorIs(t, err, tt.expectedErr)
+			case tt.expectedErrMsg != "":
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+				require.Empty(t, contractClient.updatedTokens)
+				return
+			default:
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedReport, report)
+			require.Equal(t, tt.expectedUpdatedTokens, contractClient.updatedTokens)
+			require.Equal(t, []string{"reason: " + tt.reason}, contractClient.memoNotes)
+		})
+	}
+}
+
+func TestEmergencyEnableToken(t *testing.T) {
+	t.Parallel()
+
+	coreumToken := coreum.CoreumToken{
+		Denom:        "ucore",
+		XRPLCurrency: "636F726575670000000000000000000000000000",
+		State:        coreum.TokenStateDisabled,
+	}
+	contractClient := &fakeEmergencyTokenContractClient{
+		fakeSendBatchContractClient: fakeSendBatchContractClient{
+			fakeTokenPairsContractClient: fakeTokenPairsContractClient{
+				coreumTokens: []coreum.CoreumToken{coreumToken},
+			},
+			txRes: &sdk.TxResponse{TxHash: "C0FFEE"},
+		},
+	}
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+	report, err := bridgeClient.EmergencyEnableToken(
+		context.Background(), coreum.GenAccount(), client.TokenRef{Denom: coreumToken.Denom}, "resolved",
+	)
+	require.NoError(t, err)
+	require.Equal(t, coreum.TokenStateEnabled, report.State)
+	require.Equal(t, []string{coreumToken.Denom}, contractClient.updatedTokens)
+	require.Equal(t, []string{"reason: resolved"}, contractClient.memoNotes)
+
+	// the token is already enabled
+	contractClient.coreumTokens[0].State = coreum.TokenStateEnabled
+	_, err = bridgeClient.EmergencyEnableToken(
+		context.Background(), coreum.GenAccount(), client.TokenRef{Denom: coreumToken.Denom}, "resolved",
+	)
+	require.ErrorContains(t, err, "the token state is already enabled")
+}
+
+type fakeEmergencyTokenContractClient struct {
+	fakeSendBatchContractClient
+
+	updatedTokens []string
+	memoNotes     []string
+}
+
+func (c *fakeEmergencyTokenContractClient) GetCoreumTokenByDenom(
+	_ context.Context,
+	denom string,
+) (coreum.CoreumToken, error) {
+	for _, token := range c.coreumTokens {
+		if token.Denom == denom {
+			return token, nil
+		}
+	}
+
+	return coreum.CoreumToken{}, errors.Errorf("token not found, denom:%s", denom)
+}
+
+func (c *fakeEmergencyTokenContractClient) GetXRPLTokenByIssuerAndCurrency(
+	_ context.Context,
+	issuer, currency string,
+) (coreum.XRPLToken, error) {
+	for _, token := range c.xrplTokens {
+		if token.Issuer == issuer && token.Currency == currency {
+			return token, nil
+		}
+	}
+
+	return coreum.XRPLToken{}, errors.Errorf("token not found, issuer:%s, currency:%s", issuer, currency)
+}
+
+func (c *fakeEmergencyTokenContractClient) UpdateCoreumToken(
+	ctx context.Context,
+	_ sdk.AccAddress,
+	denom string,
+	_ *coreum.TokenState,
+	_ *int32,
+	_, _ *sdkmath.Int,
+	_ *uint32,
+	_ *sdkmath.Int,
+) (*sdk.TxResponse, error) {
+	c.updatedTokens = append(c.updatedTokens, denom)
+	c.memoNotes = append(c.memoNotes, coreum.GetTxMemoNote(ctx))
+	return c.txRes, nil
+}
+
+func (c *fakeEmergencyTokenContractClient) UpdateXRPLToken(
+	ctx context.Context,
+	_ sdk.AccAddress,
+	issuer, currency string,
+	_ *coreum.TokenState,
+	_ *int32,
+	_, _ *sdkmath.Int,
+	_ *uint32,
+	_ *sdkmath.Int,
+) (*sdk.TxResponse, error) {
+	c.updatedTokens = append(c.updatedTokens, issuer+"/"+currency)
+	c.memoNotes = append(c.memoNotes, coreum.GetTxMemoNote(ctx))
+	return c.txRes, nil
+}
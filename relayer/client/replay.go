@@ -0,0 +1,707 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// DefaultReplayCoreumHeightsWindow is the default number of the Coreum heights searched for the contract txs at once.
+const DefaultReplayCoreumHeightsWindow = int64(1000)
+
+// ReplayConfig is the bridge history replay config.
+type ReplayConfig struct {
+	// FromHeight and ToHeight are the inclusive Coreum heights range.
+	FromHeight int64
+	ToHeight   int64
+	// XRPLFromLedger and XRPLToLedger are the inclusive ledgers range of the XRPL bridge account history, -1 means the
+	// earliest and the latest validated ledger accordingly.
+	XRPLFromLedger int64
+	XRPLToLedger   int64
+	// CoreumHeightsWindow is the number of the Coreum heights searched for the contract txs at once.
+	CoreumHeightsWindow int64
+	// ReportFilePath is the file the report records are written to as JSON lines.
+	ReportFilePath string
+	// CheckpointFilePath is the file the replay progress is saved to after each processed Coreum heights window and
+	// XRPL history page. The replay is resumed from it if the file exists, the empty path disables the checkpoints.
+	CheckpointFilePath string
+}
+
+// DefaultReplayConfig returns the default ReplayConfig.
+func DefaultReplayConfig(fromHeight, toHeight int64, reportFilePath string) ReplayConfig {
+	return ReplayConfig{
+		FromHeight:          fromHeight,
+		ToHeight:            toHeight,
+		XRPLFromLedger:      -1,
+		XRPLToLedger:        -1,
+		CoreumHeightsWindow: DefaultReplayCoreumHeightsWindow,
+		ReportFilePath:      reportFilePath,
+	}
+}
+
+// ReplayRecordType is the type of the replay report record.
+type ReplayRecordType string
+
+// ReplayRecordType values.
+const (
+	ReplayRecordTypeMatched                 ReplayRecordType = "matched"
+	ReplayRecordTypeUnmatchedXRPLDeposit    ReplayRecordType = "unmatched_xrpl_deposit"
+	ReplayRecordTypeUnmatchedCoreumMint     ReplayRecordType = "unmatched_coreum_mint"
+	ReplayRecordTypeUnmatchedCoreumSend     ReplayRecordType = "unmatched_coreum_send"
+	ReplayRecordTypeUnmatchedXRPLWithdrawal ReplayRecordType = "unmatched_xrpl_withdrawal"
+	ReplayRecordTypeSummary                 ReplayRecordType = "summary"
+)
+
+// ReplayXRPLTx is the XRPL side of the replayed transfer. The amount is in the contract representation.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type ReplayXRPLTx struct {
+	TxHash      string      `json:"tx_hash"`
+	LedgerIndex uint32      `json:"ledger_index"`
+	OperationID uint32      `json:"operation_id,omitempty"`
+	Issuer      string      `json:"issuer"`
+	Currency    string      `json:"currency"`
+	Amount      sdkmath.Int `json:"amount"`
+	Sender      string      `json:"sender"`
+	Recipient   string      `json:"recipient"`
+}
+
+// ReplayCoreumTx is the Coreum side of the replayed transfer. The amount is in the contract representation of the
+// XRPL token, and the coin is the amount received or sent on the Coreum.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type ReplayCoreumTx struct {
+	TxHash       string      `json:"tx_hash"`
+	Height       int64       `json:"height"`
+	OperationIDs []uint32    `json:"operation_ids,omitempty"`
+	Issuer       string      `json:"issuer"`
+	Currency     string      `json:"currency"`
+	Amount       sdkmath.Int `json:"amount"`
+	Coin         *sdk.Coin   `json:"coin,omitempty"`
+	Sender       string      `json:"sender,omitempty"`
+	Recipient    string      `json:"recipient"`
+}
+
+// ReplayRecord is the replay report record.
+type ReplayRecord struct {
+	Type      ReplayRecordType  `json:"type"`
+	Direction TransferDirection `json:"direction,omitempty"`
+	XRPL      *ReplayXRPLTx     `json:"xrpl,omitempty"`
+	Coreum    *ReplayCoreumTx   `json:"coreum,omitempty"`
+	Summary   *ReplaySummary    `json:"summary,omitempty"`
+}
+
+// ReplayTokenTotals is the total amounts of the token transfers found by the replay, the amounts are in the contract
+// representation.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type ReplayTokenTotals struct {
+	Issuer          string      `json:"issuer"`
+	Currency        string      `json:"currency"`
+	XRPLDeposits    sdkmath.Int `json:"xrpl_deposits"`
+	CoreumMints     sdkmath.Int `json:"coreum_mints"`
+	CoreumSends     sdkmath.Int `json:"coreum_sends"`
+	XRPLWithdrawals sdkmath.Int `json:"xrpl_withdrawals"`
+}
+
+// ReplaySummary is the result of the bridge history replay.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type ReplaySummary struct {
+	MatchedXRPLToCoreum      int                 `json:"matched_xrpl_to_coreum"`
+	MatchedCoreumToXRPL      int                 `json:"matched_coreum_to_xrpl"`
+	UnmatchedXRPLDeposits    int                 `json:"unmatched_xrpl_deposits"`
+	UnmatchedCoreumMints     int                 `json:"unmatched_coreum_mints"`
+	UnmatchedCoreumSends     int                 `json:"unmatched_coreum_sends"`
+	UnmatchedXRPLWithdrawals int                 `json:"unmatched_xrpl_withdrawals"`
+	Tokens                   []ReplayTokenTotals `json:"tokens"`
+	// Reconciled is true if each transfer found on one chain is matched with the transfer on the other chain.
+	Reconciled bool `json:"reconciled"`
+}
+
+// replayCheckpoint is the replay progress. Only the transfers not matched yet are kept, so the size of the
+// checkpoint is bound by the number of the transfers in flight rather than by the replayed history.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type replayCheckpoint struct {
+	FromHeight       int64          `json:"from_height"`
+	ToHeight         int64          `json:"to_height"`
+	XRPLFromLedger   int64          `json:"xrpl_from_ledger"`
+	XRPLToLedger     int64          `json:"xrpl_to_ledger"`
+	NextCoreumHeight int64          `json:"next_coreum_height"`
+	CoreumDone       bool           `json:"coreum_done"`
+	CoreumTime       time.Time      `json:"coreum_time"`
+	XRPLMarker       map[string]any `json:"xrpl_marker,omitempty"`
+	XRPLDone         bool           `json:"xrpl_done"`
+	XRPLTime         time.Time      `json:"xrpl_time"`
+	// ReportOffset is the size of the report written up to the checkpoint, the records written after it are
+	// discarded on the resume.
+	ReportOffset int64 `json:"report_offset"`
+	Completed    bool  `json:"completed"`
+
+	PendingXRPLDeposits    map[string]ReplayXRPLTx      `json:"pending_xrpl_deposits"`
+	PendingCoreumMints     map[string]ReplayCoreumTx    `json:"pending_coreum_mints"`
+	PendingCoreumSends     map[uint32]ReplayCoreumTx    `json:"pending_coreum_sends"`
+	PendingXRPLWithdrawals map[uint32]ReplayXRPLTx      `json:"pending_xrpl_withdrawals"`
+	Tokens                 map[string]ReplayTokenTotals `json:"tokens"`
+	Summary                ReplaySummary                `json:"summary"`
+}
+
+// Replay walks the Coreum contract txs in the heights range and the XRPL bridge account history, reconstructs the
+// transfers in both directions and cross-matches them, the XRPL to Coreum transfers by the XRPL tx hash and the
+// Coreum to XRPL transfers by the operation ID. The matched pairs are written to the report as soon as they are found,
+// the unmatched transfers and the summary are written at the end.
+func (b *BridgeClient) Replay(ctx context.Context, cfg ReplayConfig) (ReplaySummary, error) {
+	if err := validateReplayConfig(cfg); err != nil {
+		return ReplaySummary{}, err
+	}
+	b.log.Info(ctx, "Replaying bridge history", zap.Any("cfg", cfg))
+
+	checkpoint, err := readReplayCheckpoint(cfg)
+	if err != nil {
+		return ReplaySummary{}, err
+	}
+	if checkpoint.Completed {
+		b.log.Info(ctx, "Bridge history is already replayed", zap.String("checkpoint", cfg.CheckpointFilePath))
+		return checkpoint.Summary, nil
+	}
+
+	contractCfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return ReplaySummary{}, err
+	}
+	bridgeXRPLAddress, err := rippledata.NewAccountFromAddress(contractCfg.BridgeXRPLAddress)
+	if err != nil {
+		return ReplaySummary{}, errors.Wrapf(
+			err, "failed to convert bridge XRPL address to rippledata.Account, address:%s", contractCfg.BridgeXRPLAddress,
+		)
+	}
+	coreumTokens, xrplTokens, err := b.GetAllTokens(ctx)
+	if err != nil {
+		return ReplaySummary{}, err
+	}
+	registry := newTransferTokensRegistry(contractCfg.BridgeXRPLAddress, coreumTokens, xrplTokens)
+
+	reportFile, err := openReplayReportFile(cfg.ReportFilePath, checkpoint.ReportOffset)
+	if err != nil {
+		return ReplaySummary{}, err
+	}
+	defer reportFile.Close()
+
+	r := &replayer{
+		bridgeClient:      b,
+		cfg:               cfg,
+		bridgeXRPLAddress: *bridgeXRPLAddress,
+		registry:          registry,
+		checkpoint:        checkpoint,
+		report:            json.NewEncoder(reportFile),
+	}
+	for !checkpoint.CoreumDone || !checkpoint.XRPLDone {
+		// the chains are walked in the time order, so the counterparts of the transfers are found close to each other
+		if !checkpoint.CoreumDone && (checkpoint.XRPLDone || !checkpoint.CoreumTime.After(checkpoint.XRPLTime)) {
+			err = r.replayCoreumHeightsWindow(ctx)
+		} else {
+			err = r.replayXRPLHistoryPage(ctx)
+		}
+		if err != nil {
+			return ReplaySummary{}, err
+		}
+		if err := saveReplayCheckpoint(cfg.CheckpointFilePath, reportFile, checkpoint); err != nil {
+			return ReplaySummary{}, err
+		}
+	}
+
+	if err := r.writeUnmatchedAndSummary(); err != nil {
+		return ReplaySummary{}, err
+	}
+	checkpoint.Completed = true
+	if err := saveReplayCheckpoint(cfg.CheckpointFilePath, reportFile, checkpoint); err != nil {
+		return ReplaySummary{}, err
+	}
+	b.log.Info(ctx, "Bridge history is replayed", zap.Any("summary", checkpoint.Summary))
+
+	return checkpoint.Summary, nil
+}
+
+type replayer struct {
+	bridgeClient      *BridgeClient
+	cfg               ReplayConfig
+	bridgeXRPLAddress rippledata.Account
+	registry          transferTokensRegistry
+	checkpoint        *replayCheckpoint
+	report            *json.Encoder
+}
+
+func (r *replayer) replayCoreumHeightsWindow(ctx context.Context) error {
+	filter := coreum.TransfersFilter{
+		StartHeight: r.checkpoint.NextCoreumHeight,
+		EndHeight:   min(r.checkpoint.NextCoreumHeight+r.cfg.CoreumHeightsWindow-1, r.cfg.ToHeight),
+	}
+	r.bridgeClient.log.Debug(
+		ctx,
+		"Replaying Coreum heights window",
+		zap.Int64("startHeight", filter.StartHeight),
+		zap.Int64("endHeight", filter.EndHeight),
+	)
+
+	mints, err := r.bridgeClient.contractClient.GetXRPLToCoreumTransfers(ctx, filter)
+	if err != nil {
+		return err
+	}
+	for _, mint := range mints {
+		if err := r.replayCoreumMint(mint); err != nil {
+			return err
+		}
+	}
+	sends, err := r.bridgeClient.contractClient.GetCoreumToXRPLTransfers(ctx, filter)
+	if err != nil {
+		return err
+	}
+	for _, send := range sends {
+		if err := r.replayCoreumSend(send); err != nil {
+			return err
+		}
+	}
+
+	r.checkpoint.NextCoreumHeight = filter.EndHeight + 1
+	r.checkpoint.CoreumDone = r.checkpoint.NextCoreumHeight > r.cfg.ToHeight
+
+	return nil
+}
+
+func (r *replayer) replayCoreumMint(mint coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]) error {
+	if err := r.observeCoreumTxTime(mint.Tx); err != nil {
+		return err
+	}
+	evidence := mint.Evidence
+	coreumTx := ReplayCoreumTx{
+		TxHash:    mint.Tx.TxHash,
+		Height:    mint.Tx.Height,
+		Issuer:    evidence.Issuer,
+		Currency:  evidence.Currency,
+		Amount:    evidence.Amount,
+		Recipient: evidence.Recipient.String(),
+	}
+	r.addTokenTotals(coreumTx.Issuer, coreumTx.Currency, func(totals *ReplayTokenTotals) {
+		totals.CoreumMints = totals.CoreumMints.Add(coreumTx.Amount)
+	})
+
+	xrplTxHash := strings.ToUpper(evidence.TxHash)
+	deposit, ok := r.checkpoint.PendingXRPLDeposits[xrplTxHash]
+	if !ok {
+		r.checkpoint.PendingCoreumMints[xrplTxHash] = coreumTx
+		return nil
+	}
+	delete(r.checkpoint.PendingXRPLDeposits, xrplTxHash)
+
+	return r.writeMatched(TransferDirectionXRPLToCoreum, deposit, coreumTx)
+}
+
+func (r *replayer) replayCoreumSend(send coreum.CoreumToXRPLTransfer) error {
+	if err := r.observeCoreumTxTime(send.Tx); err != nil {
+		return err
+	}
+	issuer, currency, amount, err := r.convertCoreumSendAmount(send.Request.Amount)
+	if err != nil {
+		return errors.Wrapf(err, "failed to replay Coreum to XRPL transfer, tx:%s", send.Tx.TxHash)
+	}
+	coreumTx := ReplayCoreumTx{
+		TxHash:       send.Tx.TxHash,
+		Height:       send.Tx.Height,
+		OperationIDs: send.OperationIDs,
+		Issuer:       issuer,
+		Currency:     currency,
+		Amount:       amount,
+		Coin:         lo.ToPtr(send.Request.Amount),
+		Sender:       send.Sender.String(),
+		Recipient:    send.Request.Recipient,
+	}
+	r.addTokenTotals(issuer, currency, func(totals *ReplayTokenTotals) {
+		totals.CoreumSends = totals.CoreumSends.Add(amount)
+	})
+
+	for _, operationID := range send.OperationIDs {
+		withdrawal, ok := r.checkpoint.PendingXRPLWithdrawals[operationID]
+		if !ok {
+			r.checkpoint.PendingCoreumSends[operationID] = coreumTx
+			continue
+		}
+		delete(r.checkpoint.PendingXRPLWithdrawals, operationID)
+		if err := r.writeMatched(TransferDirectionCoreumToXRPL, withdrawal, coreumTx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertCoreumSendAmount returns the XRPL token of the sent coin and its amount in the contract representation.
+func (r *replayer) convertCoreumSendAmount(coin sdk.Coin) (string, string, sdkmath.Int, error) {
+	if xrplToken, ok := r.registry.xrplTokensByDenom[coin.Denom]; ok {
+		return xrplToken.Issuer, xrplToken.Currency, coin.Amount, nil
+	}
+	coreumToken, ok := r.registry.coreumTokens[coin.Denom]
+	if !ok {
+		return "", "", sdkmath.Int{}, errors.Errorf("token is not registered, denom:%s", coin.Denom)
+	}
+	amount, err := coreum.ConvertAmountDecimals(coin.Amount, coreumToken.Decimals, xrpl.XRPLIssuedTokenDecimals)
+	if err != nil {
+		return "", "", sdkmath.Int{}, err
+	}
+
+	return r.registry.bridgeXRPLAddress, coreumToken.XRPLCurrency, amount, nil
+}
+
+func (r *replayer) observeCoreumTxTime(tx *sdk.TxResponse) error {
+	txTime, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse Coreum tx timestamp, tx:%s", tx.TxHash)
+	}
+	if txTime.After(r.checkpoint.CoreumTime) {
+		r.checkpoint.CoreumTime = txTime
+	}
+
+	return nil
+}
+
+func (r *replayer) replayXRPLHistoryPage(ctx context.Context) error {
+	r.bridgeClient.log.Debug(ctx, "Replaying XRPL history page", zap.Any("marker", r.checkpoint.XRPLMarker))
+	page, err := r.bridgeClient.xrplRPCClient.AccountTx(
+		ctx, r.bridgeXRPLAddress, r.cfg.XRPLFromLedger, r.cfg.XRPLToLedger, r.checkpoint.XRPLMarker,
+	)
+	if err != nil {
+		return err
+	}
+	for _, tx := range page.Transactions {
+		if tx == nil {
+			continue
+		}
+		if txTime := tx.Date.Time(); txTime.After(r.checkpoint.XRPLTime) {
+			r.checkpoint.XRPLTime = txTime
+		}
+		if err := r.replayXRPLTx(ctx, *tx); err != nil {
+			return err
+		}
+	}
+	r.checkpoint.XRPLMarker = page.Marker
+	r.checkpoint.XRPLDone = len(page.Marker) == 0
+
+	return nil
+}
+
+func (r *replayer) replayXRPLTx(ctx context.Context, tx rippledata.TransactionWithMetaData) error {
+	paymentTx, ok := tx.Transaction.(*rippledata.Payment)
+	if !ok || !tx.MetaData.TransactionResult.Success() {
+		return nil
+	}
+	txHash := strings.ToUpper(tx.GetHash().String())
+
+	if paymentTx.Account == r.bridgeXRPLAddress {
+		return r.replayXRPLWithdrawal(tx, paymentTx, txHash)
+	}
+	if paymentTx.Destination != r.bridgeXRPLAddress {
+		return nil
+	}
+
+	evidence, err := processes.ConvertXRPLPaymentToTransferEvidence(tx)
+	if err != nil {
+		// the payments which can't be converted to the evidence aren't bridged by the relayers
+		r.bridgeClient.log.Debug(ctx, "Skipping XRPL payment", zap.String("txHash", txHash), zap.Error(err))
+		return nil
+	}
+	xrplTx := ReplayXRPLTx{
+		TxHash:      txHash,
+		LedgerIndex: tx.LedgerSequence,
+		Issuer:      evidence.Issuer,
+		Currency:    evidence.Currency,
+		Amount:      evidence.Amount,
+		Sender:      paymentTx.Account.String(),
+		Recipient:   evidence.Recipient.String(),
+	}
+	r.addTokenTotals(xrplTx.Issuer, xrplTx.Currency, func(totals *ReplayTokenTotals) {
+		totals.XRPLDeposits = totals.XRPLDeposits.Add(xrplTx.Amount)
+	})
+
+	mint, ok := r.checkpoint.PendingCoreumMints[txHash]
+	if !ok {
+		r.checkpoint.PendingXRPLDeposits[txHash] = xrplTx
+		return nil
+	}
+	delete(r.checkpoint.PendingCoreumMints, txHash)
+
+	return r.writeMatched(TransferDirectionXRPLToCoreum, xrplTx, mint)
+}
+
+func (r *replayer) replayXRPLWithdrawal(
+	tx rippledata.TransactionWithMetaData,
+	paymentTx *rippledata.Payment,
+	txHash string,
+) error {
+	// the operation ID is the ticket sequence or the account sequence if the ticket isn't used
+	operationID := paymentTx.Sequence
+	if paymentTx.TicketSequence != nil && *paymentTx.TicketSequence != 0 {
+		operationID = *paymentTx.TicketSequence
+	}
+	deliveredAmount := paymentTx.Amount
+	if tx.MetaData.DeliveredAmount != nil {
+		deliveredAmount = *tx.MetaData.DeliveredAmount
+	}
+	amount, err := processes.ConvertXRPLAmountToCoreumAmount(deliveredAmount)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert XRPL withdrawal amount, tx:%s", txHash)
+	}
+	xrplTx := ReplayXRPLTx{
+		TxHash:      txHash,
+		LedgerIndex: tx.LedgerSequence,
+		OperationID: operationID,
+		Issuer:      deliveredAmount.Issuer.String(),
+		Currency:    xrpl.ConvertCurrencyToString(deliveredAmount.Currency),
+		Amount:      amount,
+		Sender:      paymentTx.Account.String(),
+		Recipient:   paymentTx.Destination.String(),
+	}
+	r.addTokenTotals(xrplTx.Issuer, xrplTx.Currency, func(totals *ReplayTokenTotals) {
+		totals.XRPLWithdrawals = totals.XRPLWithdrawals.Add(xrplTx.Amount)
+	})
+
+	send, ok := r.checkpoint.PendingCoreumSends[operationID]
+	if !ok {
+		r.checkpoint.PendingXRPLWithdrawals[operationID] = xrplTx
+		return nil
+	}
+	delete(r.checkpoint.PendingCoreumSends, operationID)
+
+	return r.writeMatched(TransferDirectionCoreumToXRPL, xrplTx, send)
+}
+
+func (r *replayer) addTokenTotals(issuer, currency string, update func(totals *ReplayTokenTotals)) {
+	key := xrplTokenKey(issuer, currency)
+	totals, ok := r.checkpoint.Tokens[key]
+	if !ok {
+		totals = ReplayTokenTotals{
+			Issuer:          issuer,
+			Currency:        currency,
+			XRPLDeposits:    sdkmath.ZeroInt(),
+			CoreumMints:     sdkmath.ZeroInt(),
+			CoreumSends:     sdkmath.ZeroInt(),
+			XRPLWithdrawals: sdkmath.ZeroInt(),
+		}
+	}
+	update(&totals)
+	r.checkpoint.Tokens[key] = totals
+}
+
+func (r *replayer) writeMatched(direction TransferDirection, xrplTx ReplayXRPLTx, coreumTx ReplayCoreumTx) error {
+	if direction == TransferDirectionXRPLToCoreum {
+		r.checkpoint.Summary.MatchedXRPLToCoreum++
+	} else {
+		r.checkpoint.Summary.MatchedCoreumToXRPL++
+	}
+
+	return r.writeRecord(ReplayRecord{
+		Type:      ReplayRecordTypeMatched,
+		Direction: direction,
+		XRPL:      &xrplTx,
+		Coreum:    &coreumTx,
+	})
+}
+
+func (r *replayer) writeUnmatchedAndSummary() error {
+	summary := &r.checkpoint.Summary
+	for _, deposit := range sortedMapValues(r.checkpoint.PendingXRPLDeposits) {
+		summary.UnmatchedXRPLDeposits++
+		if err := r.writeRecord(ReplayRecord{
+			Type:      ReplayRecordTypeUnmatchedXRPLDeposit,
+			Direction: TransferDirectionXRPLToCoreum,
+			XRPL:      lo.ToPtr(deposit),
+		}); err != nil {
+			return err
+		}
+	}
+	for _, mint := range sortedMapValues(r.checkpoint.PendingCoreumMints) {
+		summary.UnmatchedCoreumMints++
+		if err := r.writeRecord(ReplayRecord{
+			Type:      ReplayRecordTypeUnmatchedCoreumMint,
+			Direction: TransferDirectionXRPLToCoreum,
+			Coreum:    lo.ToPtr(mint),
+		}); err != nil {
+			return err
+		}
+	}
+	for _, send := range sortedMapValues(r.checkpoint.PendingCoreumSends) {
+		summary.UnmatchedCoreumSends++
+		if err := r.writeRecord(ReplayRecord{
+			Type:      ReplayRecordTypeUnmatchedCoreumSend,
+			Direction: TransferDirectionCoreumToXRPL,
+			Coreum:    lo.ToPtr(send),
+		}); err != nil {
+			return err
+		}
+	}
+	for _, withdrawal := range sortedMapValues(r.checkpoint.PendingXRPLWithdrawals) {
+		summary.UnmatchedXRPLWithdrawals++
+		if err := r.writeRecord(ReplayRecord{
+			Type:      ReplayRecordTypeUnmatchedXRPLWithdrawal,
+			Direction: TransferDirectionCoreumToXRPL,
+			XRPL:      lo.ToPtr(withdrawal),
+		}); err != nil {
+			return err
+		}
+	}
+
+	summary.Tokens = sortedMapValues(r.checkpoint.Tokens)
+	summary.Reconciled = summary.UnmatchedXRPLDeposits == 0 &&
+		summary.UnmatchedCoreumMints == 0 &&
+		summary.UnmatchedCoreumSends == 0 &&
+		summary.UnmatchedXRPLWithdrawals == 0
+
+	return r.writeRecord(ReplayRecord{
+		Type:    ReplayRecordTypeSummary,
+		Summary: summary,
+	})
+}
+
+func (r *replayer) writeRecord(record ReplayRecord) error {
+	return errors.Wrap(r.report.Encode(record), "failed to write replay report record")
+}
+
+// sortedMapValues returns the map values sorted by the keys to keep the report deterministic.
+func sortedMapValues[K string | uint32, V any](values map[K]V) []V {
+	keys := lo.Keys(values)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return lo.Map(keys, func(key K, _ int) V {
+		return values[key]
+	})
+}
+
+func validateReplayConfig(cfg ReplayConfig) error {
+	if cfg.FromHeight <= 0 {
+		return errors.Errorf("from height must be positive, height:%d", cfg.FromHeight)
+	}
+	if cfg.FromHeight > cfg.ToHeight {
+		return errors.Errorf("from height %d is greater than to height %d", cfg.FromHeight, cfg.ToHeight)
+	}
+	if cfg.XRPLFromLedger >= 0 && cfg.XRPLToLedger >= 0 && cfg.XRPLFromLedger > cfg.XRPLToLedger {
+		return errors.Errorf(
+			"XRPL from ledger %d is greater than XRPL to ledger %d", cfg.XRPLFromLedger, cfg.XRPLToLedger,
+		)
+	}
+	if cfg.CoreumHeightsWindow <= 0 {
+		return errors.Errorf("Coreum heights window must be positive, window:%d", cfg.CoreumHeightsWindow)
+	}
+	if cfg.ReportFilePath == "" {
+		return errors.New("report file path must not be empty")
+	}
+
+	return nil
+}
+
+// readReplayCheckpoint reads the replay checkpoint, the initial checkpoint is returned if the file path is empty or
+// the file does not exist.
+func readReplayCheckpoint(cfg ReplayConfig) (*replayCheckpoint, error) {
+	checkpoint := &replayCheckpoint{
+		FromHeight:             cfg.FromHeight,
+		ToHeight:               cfg.ToHeight,
+		XRPLFromLedger:         cfg.XRPLFromLedger,
+		XRPLToLedger:           cfg.XRPLToLedger,
+		NextCoreumHeight:       cfg.FromHeight,
+		PendingXRPLDeposits:    make(map[string]ReplayXRPLTx),
+		PendingCoreumMints:     make(map[string]ReplayCoreumTx),
+		PendingCoreumSends:     make(map[uint32]ReplayCoreumTx),
+		PendingXRPLWithdrawals: make(map[uint32]ReplayXRPLTx),
+		Tokens:                 make(map[string]ReplayTokenTotals),
+	}
+	if cfg.CheckpointFilePath == "" {
+		return checkpoint, nil
+	}
+	fileBytes, err := os.ReadFile(cfg.CheckpointFilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return checkpoint, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read replay checkpoint file, path:%s", cfg.CheckpointFilePath)
+	}
+	if err := json.Unmarshal(fileBytes, checkpoint); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal replay checkpoint file, path:%s", cfg.CheckpointFilePath)
+	}
+	if checkpoint.FromHeight != cfg.FromHeight ||
+		checkpoint.ToHeight != cfg.ToHeight ||
+		checkpoint.XRPLFromLedger != cfg.XRPLFromLedger ||
+		checkpoint.XRPLToLedger != cfg.XRPLToLedger {
+		return nil, errors.Errorf(
+			"replay checkpoint is saved for another range, heights:%d-%d, XRPL ledgers:%d-%d, path:%s",
+			checkpoint.FromHeight,
+			checkpoint.ToHeight,
+			checkpoint.XRPLFromLedger,
+			checkpoint.XRPLToLedger,
+			cfg.CheckpointFilePath,
+		)
+	}
+
+	return checkpoint, nil
+}
+
+// saveReplayCheckpoint saves the checkpoint with the current size of the report.
+func saveReplayCheckpoint(filePath string, reportFile *os.File, checkpoint *replayCheckpoint) error {
+	if filePath == "" {
+		return nil
+	}
+	reportOffset, err := reportFile.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get replay report offset, path:%s", reportFile.Name())
+	}
+	if err := reportFile.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to sync replay report file, path:%s", reportFile.Name())
+	}
+	checkpoint.ReportOffset = reportOffset
+	checkpointBytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal replay checkpoint")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, checkpointBytes), "failed to save replay checkpoint, path:%s", filePath,
+	)
+}
+
+// openReplayReportFile opens the report file truncated to the offset saved in the checkpoint, so the records written
+// after the last checkpoint aren't duplicated on the resume.
+func openReplayReportFile(filePath string, offset int64) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create replay report dir, path:%s", filePath)
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open replay report file, path:%s", filePath)
+	}
+	if err := file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "failed to truncate replay report file, path:%s", filePath)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "failed to seek replay report file, path:%s", filePath)
+	}
+
+	return file, nil
+}
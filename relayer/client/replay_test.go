@@ -0,0 +1,330 @@
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestBridgeClient_Replay(t *testing.T) {
+	t.Parallel()
+
+	history := newReplayHistory(t)
+	reportFilePath := filepath.Join(t.TempDir(), "report.jsonl")
+	bridgeClient := client.NewBridgeClient(
+		newTestLogger(t), coreumclient.Context{}, history.contractClient, history.xrplRPCClient, nil,
+	)
+	cfg := client.DefaultReplayConfig(1, 30, reportFilePath)
+	cfg.CoreumHeightsWindow = 5
+	summary, err := bridgeClient.Replay(context.Background(), cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, client.ReplaySummary{
+		MatchedXRPLToCoreum:   2,
+		MatchedCoreumToXRPL:   1,
+		UnmatchedXRPLDeposits: 1,
+		UnmatchedCoreumSends:  1,
+		Tokens: []client.ReplayTokenTotals{
+			{
+				Issuer:          history.issuer.String(),
+				Currency:        "RCP",
+				XRPLDeposits:    sdkmath.NewIntWithDecimal(6, xrpl.XRPLIssuedTokenDecimals),
+				CoreumMints:     sdkmath.NewIntWithDecimal(3, xrpl.XRPLIssuedTokenDecimals),
+				CoreumSends:     sdkmath.NewIntWithDecimal(2, xrpl.XRPLIssuedTokenDecimals),
+				XRPLWithdrawals: sdkmath.NewIntWithDecimal(1, xrpl.XRPLIssuedTokenDecimals),
+			},
+		},
+	}, summary)
+
+	records := readReplayReport(t, reportFilePath)
+	require.Equal(t, []client.ReplayRecordType{
+		client.ReplayRecordTypeMatched,
+		client.ReplayRecordTypeMatched,
+		client.ReplayRecordTypeMatched,
+		client.ReplayRecordTypeUnmatchedXRPLDeposit,
+		client.ReplayRecordTypeUnmatchedCoreumSend,
+		client.ReplayRecordTypeSummary,
+	}, lo.Map(records, func(record client.ReplayRecord, _ int) client.ReplayRecordType {
+		return record.Type
+	}))
+	for _, record := range records[:3] {
+		switch record.Direction {
+		case client.TransferDirectionXRPLToCoreum:
+			require.Equal(t, record.XRPL.Amount.String(), record.Coreum.Amount.String())
+		case client.TransferDirectionCoreumToXRPL:
+			require.Equal(t, []uint32{record.XRPL.OperationID}, record.Coreum.OperationIDs)
+		default:
+			t.Fatalf("unexpected direction: %s", record.Direction)
+		}
+	}
+	require.Equal(t, summary, *records[len(records)-1].Summary)
+}
+
+func TestBridgeClient_Replay_ResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// the reference replay without the interruption
+	history := newReplayHistory(t)
+	referenceReportFilePath := filepath.Join(dir, "reference.jsonl")
+	cfg := client.DefaultReplayConfig(1, 30, referenceReportFilePath)
+	cfg.CoreumHeightsWindow = 5
+	wantSummary, err := client.NewBridgeClient(
+		newTestLogger(t), coreumclient.Context{}, history.contractClient, history.xrplRPCClient, nil,
+	).Replay(ctx, cfg)
+	require.NoError(t, err)
+
+	cfg.ReportFilePath = filepath.Join(dir, "report.jsonl")
+	cfg.CheckpointFilePath = filepath.Join(dir, "checkpoint.json")
+	history.xrplRPCClient.failOnPage = 2
+	bridgeClient := client.NewBridgeClient(
+		newTestLogger(t), coreumclient.Context{}, history.contractClient, history.xrplRPCClient, nil,
+	)
+	_, err = bridgeClient.Replay(ctx, cfg)
+	require.ErrorContains(t, err, "xrpl is unreachable")
+	require.FileExists(t, cfg.CheckpointFilePath)
+
+	// the checkpoint of another range isn't used
+	otherCfg := cfg
+	otherCfg.ToHeight = 40
+	_, err = bridgeClient.Replay(ctx, otherCfg)
+	require.ErrorContains(t, err, "replay checkpoint is saved for another range")
+
+	history.xrplRPCClient.failOnPage = 0
+	summary, err := bridgeClient.Replay(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, wantSummary, summary)
+	require.Equal(t, readReplayReport(t, referenceReportFilePath), readReplayReport(t, cfg.ReportFilePath))
+
+	// the completed replay isn't repeated
+	history.xrplRPCClient.failOnPage = 1
+	summary, err = bridgeClient.Replay(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, wantSummary, summary)
+}
+
+type replayHistory struct {
+	issuer         rippledata.Account
+	contractClient *fakeReplayContractClient
+	xrplRPCClient  *fakeReplayXRPLRPCClient
+}
+
+// newReplayHistory builds the bridge history with the matched deposits and withdrawals, the deposit without the mint
+// and the send without the withdrawal.
+func newReplayHistory(t *testing.T) replayHistory {
+	t.Helper()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	xrplAccount := xrpl.GenPrivKeyTxSigner().Account()
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	coreumRecipient := coreum.GenAccount()
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreumRecipient)
+	require.NoError(t, err)
+	// the XRPL txs are dated with the ledger index seconds and the Coreum txs with the height seconds
+	startTime := rippledata.RippleTime{}.Time()
+
+	newXRPLTx := func(
+		hashByte byte, ledger uint32, payment *rippledata.Payment, amount string,
+	) *rippledata.TransactionWithMetaData {
+		deliveredAmount, err := rippledata.NewAmount(amount + "/RCP/" + issuer.String())
+		require.NoError(t, err)
+		payment.TransactionType = rippledata.PAYMENT
+		payment.Hash = rippledata.Hash256{hashByte}
+		payment.Amount = *deliveredAmount
+		return &rippledata.TransactionWithMetaData{
+			Transaction:    payment,
+			MetaData:       rippledata.MetaData{DeliveredAmount: deliveredAmount},
+			Date:           rippledata.RippleTime{T: ledger},
+			LedgerSequence: ledger,
+		}
+	}
+	newDeposit := func(hashByte byte, ledger uint32, amount string) *rippledata.TransactionWithMetaData {
+		return newXRPLTx(hashByte, ledger, &rippledata.Payment{
+			TxBase:      rippledata.TxBase{Account: xrplAccount, Memos: rippledata.Memos{memo}},
+			Destination: bridgeXRPLAddress,
+		}, amount)
+	}
+	newMint := func(hashByte byte, height int64, amount int64) coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence] {
+		return coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{
+			Evidence: coreum.XRPLToCoreumTransferEvidence{
+				TxHash:    rippledata.Hash256{hashByte}.String(),
+				Issuer:    issuer.String(),
+				Currency:  "RCP",
+				Amount:    sdkmath.NewIntWithDecimal(amount, xrpl.XRPLIssuedTokenDecimals),
+				Recipient: coreumRecipient,
+			},
+			Tx: newReplayCoreumTx(startTime, height),
+		}
+	}
+	newSend := func(height int64, operationID uint32) coreum.CoreumToXRPLTransfer {
+		return coreum.CoreumToXRPLTransfer{
+			Sender: coreumRecipient,
+			Request: coreum.SendToXRPLRequest{
+				Recipient: xrplAccount.String(),
+				Amount:    sdk.NewCoin("drcp", sdkmath.NewIntWithDecimal(1, xrpl.XRPLIssuedTokenDecimals)),
+			},
+			OperationIDs: []uint32{operationID},
+			Tx:           newReplayCoreumTx(startTime, height),
+		}
+	}
+
+	withdrawal := newXRPLTx(5, 22, &rippledata.Payment{
+		TxBase:         rippledata.TxBase{Account: bridgeXRPLAddress},
+		Destination:    xrplAccount,
+		TicketSequence: lo.ToPtr(uint32(7)),
+	}, "1")
+	failedWithdrawal := newXRPLTx(6, 23, &rippledata.Payment{
+		TxBase:         rippledata.TxBase{Account: bridgeXRPLAddress},
+		Destination:    xrplAccount,
+		TicketSequence: lo.ToPtr(uint32(8)),
+	}, "2")
+	// tecPATH_PARTIAL
+	failedWithdrawal.MetaData.TransactionResult = rippledata.TransactionResult(101)
+
+	return replayHistory{
+		issuer: issuer,
+		contractClient: &fakeReplayContractClient{
+			contractCfg: coreum.ContractConfig{BridgeXRPLAddress: bridgeXRPLAddress.String()},
+			xrplTokens: []coreum.XRPLToken{
+				{
+					Issuer:      issuer.String(),
+					Currency:    "RCP",
+					CoreumDenom: "drcp",
+				},
+			},
+			mints: []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{
+				newMint(1, 3, 1),
+				newMint(2, 12, 2),
+			},
+			sends: []coreum.CoreumToXRPLTransfer{
+				newSend(20, 7),
+				newSend(21, 8),
+			},
+		},
+		xrplRPCClient: &fakeReplayXRPLRPCClient{
+			pages: [][]*rippledata.TransactionWithMetaData{
+				{newDeposit(1, 2, "1")},
+				{newDeposit(2, 11, "2")},
+				{withdrawal, failedWithdrawal},
+				{newDeposit(3, 25, "3")},
+			},
+		},
+	}
+}
+
+func newReplayCoreumTx(startTime time.Time, height int64) *sdk.TxResponse {
+	return &sdk.TxResponse{
+		TxHash:    "coreum-tx-" + strconv.FormatInt(height, 10),
+		Height:    height,
+		Timestamp: startTime.Add(time.Duration(height) * time.Second).Format(time.RFC3339),
+	}
+}
+
+func readReplayReport(t *testing.T, filePath string) []client.ReplayRecord {
+	t.Helper()
+
+	file, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	records := make([]client.ReplayRecord, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record client.ReplayRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
+
+type fakeReplayContractClient struct {
+	client.ContractClient
+
+	contractCfg coreum.ContractConfig
+	xrplTokens  []coreum.XRPLToken
+	mints       []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]
+	sends       []coreum.CoreumToXRPLTransfer
+}
+
+func (c *fakeReplayContractClient) GetContractConfig(_ context.Context) (coreum.ContractConfig, error) {
+	return c.contractCfg, nil
+}
+
+func (c *fakeReplayContractClient) GetCoreumTokens(_ context.Context) ([]coreum.CoreumToken, error) {
+	return nil, nil
+}
+
+func (c *fakeReplayContractClient) GetXRPLTokens(_ context.Context) ([]coreum.XRPLToken, error) {
+	return c.xrplTokens, nil
+}
+
+func (c *fakeReplayContractClient) GetXRPLToCoreumTransfers(
+	_ context.Context,
+	filter coreum.TransfersFilter,
+) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error) {
+	return lo.Filter(c.mints, func(mint coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], _ int) bool {
+		return mint.Tx.Height >= filter.StartHeight && mint.Tx.Height <= filter.EndHeight
+	}), nil
+}
+
+func (c *fakeReplayContractClient) GetCoreumToXRPLTransfers(
+	_ context.Context,
+	filter coreum.TransfersFilter,
+) ([]coreum.CoreumToXRPLTransfer, error) {
+	return lo.Filter(c.sends, func(send coreum.CoreumToXRPLTransfer, _ int) bool {
+		return send.Tx.Height >= filter.StartHeight && send.Tx.Height <= filter.EndHeight
+	}), nil
+}
+
+type fakeReplayXRPLRPCClient struct {
+	client.XRPLRPCClient
+
+	pages [][]*rippledata.TransactionWithMetaData
+	// failOnPage is the number of the page, starting from 1, which request fails.
+	failOnPage int
+}
+
+func (c *fakeReplayXRPLRPCClient) AccountTx(
+	_ context.Context,
+	_ rippledata.Account,
+	_, _ int64,
+	marker map[string]any,
+) (xrpl.AccountTxResult, error) {
+	pageIndex := 0
+	if marker != nil {
+		// the marker is restored from the checkpoint as the JSON number
+		pageIndex = int(marker["page"].(float64))
+	}
+	if pageIndex+1 == c.failOnPage {
+		return xrpl.AccountTxResult{}, errors.New("xrpl is unreachable")
+	}
+	result := xrpl.AccountTxResult{
+		Transactions: c.pages[pageIndex],
+	}
+	if pageIndex+1 < len(c.pages) {
+		result.Marker = map[string]any{"page": float64(pageIndex + 1)}
+	}
+
+	return result, nil
+}
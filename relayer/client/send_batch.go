@@ -0,0 +1,295 @@
+//nolint:tagliatelle // yaml spec
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// batchPollInterval is the interval of the batch legs status check.
+const batchPollInterval = 5 * time.Second
+
+// BatchLegStatus is the status of the batch leg.
+type BatchLegStatus string
+
+// BatchLegStatus values.
+const (
+	BatchLegStatusPending  BatchLegStatus = "pending"
+	BatchLegStatusAccepted BatchLegStatus = "accepted"
+	BatchLegStatusRejected BatchLegStatus = "rejected"
+)
+
+// SendLeg is a single transfer of the batch sent from Coreum to XRPL.
+type SendLeg struct {
+	Recipient     rippledata.Account
+	Coin          sdk.Coin
+	DeliverAmount *sdkmath.Int
+}
+
+// SendBatchLegConfig is the batch leg in the send batch file.
+type SendBatchLegConfig struct {
+	// Recipient is the classic XRPL address or X-address without the destination tag.
+	Recipient string `yaml:"recipient"`
+	// Coin is the Coreum coin to send, e.g. "1000000ucore".
+	Coin string `yaml:"coin"`
+	// DeliverAmount is the optional amount to deliver to the recipient.
+	DeliverAmount string `yaml:"deliver_amount,omitempty"`
+}
+
+// SendBatchConfig is the send batch file content.
+type SendBatchConfig struct {
+	Legs []SendBatchLegConfig `yaml:"legs"`
+}
+
+// ReadSendBatchConfig reads the send batch yaml file.
+func ReadSendBatchConfig(filePath string) (SendBatchConfig, error) {
+	fileBytes, err := readConfigFromFile(filePath)
+	if err != nil {
+		return SendBatchConfig{}, err
+	}
+
+	var config SendBatchConfig
+	if err := yaml.Unmarshal(fileBytes, &config); err != nil {
+		return SendBatchConfig{}, errors.Wrapf(err, "failed to unmarshal file to yaml, path:%s", filePath)
+	}
+
+	return config, nil
+}
+
+// BatchLegReport is the status of the batch leg.
+type BatchLegReport struct {
+	Leg SendLeg
+	// OperationID is the ID of the pending operation created for the leg.
+	OperationID uint32
+	Status      BatchLegStatus
+	// RefundID is the ID of the pending refund of the rejected leg.
+	RefundID string
+}
+
+// BatchReport is the report of the batch sent from Coreum to XRPL.
+type BatchReport struct {
+	Sender    sdk.AccAddress
+	TxHash    string
+	Height    int64
+	Timestamp time.Time
+	Legs      []BatchLegReport
+}
+
+// IsFinal returns true if all legs are accepted or rejected.
+func (r BatchReport) IsFinal() bool {
+	return lo.EveryBy(r.Legs, func(leg BatchLegReport) bool {
+		return leg.Status != BatchLegStatusPending
+	})
+}
+
+// SendBatchToXRPL sends the legs from Coreum to XRPL in one transaction and maps each leg to the operation created for
+// it. The operations are found as the ones created at the transaction height for the leg recipient and token, the
+// legs with the same recipient and token are mapped in the order of the operation IDs.
+func (b *BridgeClient) SendBatchToXRPL(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	legs []SendLeg,
+) (BatchReport, error) {
+	if len(legs) == 0 {
+		return BatchReport{}, errors.New("batch must contain at least one leg")
+	}
+	// the token pairs are used to map the leg coins to the XRPL tokens of the operations
+	tokenPairs, err := b.GetTokenPairs(ctx)
+	if err != nil {
+		return BatchReport{}, err
+	}
+	legTokenPairs := make([]TokenPair, 0, len(legs))
+	requests := make([]coreum.SendToXRPLRequest, 0, len(legs))
+	for i, leg := range legs {
+		if err := b.minBridgeAmounts.ValidateCoreumToXRPLAmount(leg.Coin); err != nil {
+			return BatchReport{}, errors.Wrapf(err, "invalid leg %d", i)
+		}
+		tokenPair, ok := lo.Find(tokenPairs, func(pair TokenPair) bool {
+			return pair.CoreumDenom == leg.Coin.Denom
+		})
+		if !ok {
+			return BatchReport{}, errors.Errorf("invalid leg %d, token is not registered, denom:%s", i, leg.Coin.Denom)
+		}
+		legTokenPairs = append(legTokenPairs, tokenPair)
+		requests = append(requests, coreum.SendToXRPLRequest{
+			Recipient:     leg.Recipient.String(),
+			Amount:        leg.Coin,
+			DeliverAmount: leg.DeliverAmount,
+		})
+	}
+
+	b.log.Info(
+		ctx,
+		"Sending batch from Coreum to XRPL",
+		zap.String("sender", sender.String()),
+		zap.Int("legs", len(legs)),
+	)
+	txRes, err := b.contractClient.MultiSendToXRPL(ctx, sender, requests...)
+	if err != nil {
+		return BatchReport{}, err
+	}
+	if txRes == nil {
+		return BatchReport{}, errors.New("batch tx response is empty")
+	}
+	timestamp, err := time.Parse(time.RFC3339, txRes.Timestamp)
+	if err != nil {
+		return BatchReport{}, errors.Wrapf(err, "failed to parse batch tx timestamp, txHash:%s", txRes.TxHash)
+	}
+
+	operations, err := b.getOperationsCreatedAtHeight(ctx, txRes.Height)
+	if err != nil {
+		return BatchReport{}, err
+	}
+	report := BatchReport{
+		Sender:    sender,
+		TxHash:    txRes.TxHash,
+		Height:    txRes.Height,
+		Timestamp: timestamp,
+		Legs:      make([]BatchLegReport, 0, len(legs)),
+	}
+	for i, leg := range legs {
+		operationID, err := popLegOperation(leg, legTokenPairs[i], &operations)
+		if err != nil {
+			return BatchReport{}, errors.Wrapf(err, "failed to find operation of leg %d, txHash:%s", i, txRes.TxHash)
+		}
+		report.Legs = append(report.Legs, BatchLegReport{
+			Leg:         leg,
+			OperationID: operationID,
+			Status:      BatchLegStatusPending,
+		})
+	}
+
+	b.log.Info(
+		ctx,
+		"Successfully sent batch from Coreum to XRPL",
+		zap.String("txHash", txRes.TxHash),
+		zap.Any("operationIDs", lo.Map(report.Legs, func(leg BatchLegReport, _ int) uint32 {
+			return leg.OperationID
+		})),
+	)
+
+	return report, nil
+}
+
+// WaitForBatch waits for the operations of the batch legs to be completed and returns the report with the final
+// status of each leg. The leg is rejected if the refund of its operation is pending for the sender, so the refund
+// claimed before the check makes the leg look accepted. If the timeout is reached, the report with the current
+// statuses is returned together with the error.
+func (b *BridgeClient) WaitForBatch(
+	ctx context.Context,
+	report BatchReport,
+	timeout time.Duration,
+) (BatchReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		var err error
+		report, err = b.updateBatchReport(ctx, report)
+		if err != nil {
+			return report, err
+		}
+		if report.IsFinal() {
+			return report, nil
+		}
+		select {
+		case <-ctx.Done():
+			return report, errors.Wrapf(ctx.Err(), "batch isn't completed in %s, txHash:%s", timeout, report.TxHash)
+		case <-time.After(batchPollInterval):
+		}
+	}
+}
+
+func (b *BridgeClient) updateBatchReport(ctx context.Context, report BatchReport) (BatchReport, error) {
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return report, err
+	}
+	pendingOperationIDs := lo.SliceToMap(pendingOperations, func(operation coreum.Operation) (uint32, struct{}) {
+		return operation.GetOperationID(), struct{}{}
+	})
+	pendingRefunds, err := b.contractClient.GetPendingRefunds(ctx, report.Sender)
+	if err != nil {
+		return report, err
+	}
+	pendingRefundIDs := lo.SliceToMap(pendingRefunds, func(refund coreum.PendingRefund) (string, struct{}) {
+		return refund.ID, struct{}{}
+	})
+
+	legs := make([]BatchLegReport, 0, len(report.Legs))
+	for _, leg := range report.Legs {
+		if leg.Status == BatchLegStatusPending {
+			if _, ok := pendingOperationIDs[leg.OperationID]; !ok {
+				// the refund ID is the ID of the operation built by the contract from the creation time and the
+				// operation ID
+				refundID := fmt.Sprintf("%d-%d", report.Timestamp.Unix(), leg.OperationID)
+				if _, ok := pendingRefundIDs[refundID]; ok {
+					leg.Status = BatchLegStatusRejected
+					leg.RefundID = refundID
+				} else {
+					leg.Status = BatchLegStatusAccepted
+				}
+			}
+		}
+		legs = append(legs, leg)
+	}
+	report.Legs = legs
+
+	return report, nil
+}
+
+func (b *BridgeClient) getOperationsCreatedAtHeight(ctx context.Context, height int64) ([]coreum.Operation, error) {
+	operationsBefore, err := b.contractClient.GetPendingOperations(coreum.WithHeightRequestContext(ctx, height-1))
+	if err != nil {
+		return nil, err
+	}
+	operationsAfter, err := b.contractClient.GetPendingOperations(coreum.WithHeightRequestContext(ctx, height))
+	if err != nil {
+		return nil, err
+	}
+	operationIDsBefore := lo.SliceToMap(operationsBefore, func(operation coreum.Operation) (uint32, struct{}) {
+		return operation.GetOperationID(), struct{}{}
+	})
+
+	createdOperations := lo.Filter(operationsAfter, func(operation coreum.Operation, _ int) bool {
+		_, ok := operationIDsBefore[operation.GetOperationID()]
+		return !ok && operation.OperationType.CoreumToXRPLTransfer != nil
+	})
+	sort.Slice(createdOperations, func(i, j int) bool {
+		return createdOperations[i].GetOperationID() < createdOperations[j].GetOperationID()
+	})
+
+	return createdOperations, nil
+}
+
+// popLegOperation returns the ID of the first operation sending the leg token to the leg recipient and removes the
+// operation from the list.
+func popLegOperation(leg SendLeg, tokenPair TokenPair, operations *[]coreum.Operation) (uint32, error) {
+	for i, operation := range *operations {
+		transfer := operation.OperationType.CoreumToXRPLTransfer
+		if transfer.Recipient != leg.Recipient.String() ||
+			transfer.Issuer != tokenPair.XRPLIssuer ||
+			transfer.Currency != tokenPair.XRPLCurrency {
+			continue
+		}
+		*operations = append((*operations)[:i], (*operations)[i+1:]...)
+		return operation.GetOperationID(), nil
+	}
+
+	return 0, errors.Errorf(
+		"operation not found, recipient:%s, issuer:%s, currency:%s",
+		leg.Recipient.String(), tokenPair.XRPLIssuer, tokenPair.XRPLCurrency,
+	)
+}
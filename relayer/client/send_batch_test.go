@@ -0,0 +1,233 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSendBatchToXRPL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplToken := coreum.XRPLToken{
+		Issuer:      xrpl.GenPrivKeyTxSigner().Account().String(),
+		Currency:    "CRN",
+		CoreumDenom: "xrpl-crn",
+	}
+	coreumToken := coreum.CoreumToken{
+		Denom:        "ucore",
+		XRPLCurrency: "636F726575670000000000000000000000000000",
+	}
+	sender := coreum.GenAccount()
+	recipient1 := xrpl.GenPrivKeyTxSigner().Account()
+	recipient2 := xrpl.GenPrivKeyTxSigner().Account()
+
+	legs := []client.SendLeg{
+		{
+			Recipient: recipient1,
+			Coin:      sdk.NewInt64Coin(coreumToken.Denom, 100),
+		},
+		{
+			Recipient:     recipient2,
+			Coin:          sdk.NewInt64Coin(xrplToken.CoreumDenom, 200),
+			DeliverAmount: lo.ToPtr(sdkmath.NewInt(150)),
+		},
+		{
+			Recipient: recipient1,
+			Coin:      sdk.NewInt64Coin(coreumToken.Denom, 300),
+		},
+	}
+
+	txTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	existingOperation := newCoreumToXRPLTransferOperation(5, bridgeXRPLAddress, coreumToken.XRPLCurrency, recipient1)
+	contractClient := &fakeSendBatchContractClient{
+		fakeTokenPairsContractClient: fakeTokenPairsContractClient{
+			contractCfg:  coreum.ContractConfig{BridgeXRPLAddress: bridgeXRPLAddress},
+			coreumTokens: []coreum.CoreumToken{coreumToken},
+			xrplTokens:   []coreum.XRPLToken{xrplToken},
+		},
+		txRes: &sdk.TxResponse{
+			TxHash:    "C0FFEE",
+			Height:    100,
+			Timestamp: txTime.Format(time.RFC3339),
+		},
+		operationsByHeight: map[int64][]coreum.Operation{
+			99: {existingOperation},
+			100: {
+				existingOperation,
+				newCoreumToXRPLTransferOperation(12, bridgeXRPLAddress, coreumToken.XRPLCurrency, recipient1),
+				newCoreumToXRPLTransferOperation(11, xrplToken.Issuer, xrplToken.Currency, recipient2),
+				newCoreumToXRPLTransferOperation(10, bridgeXRPLAddress, coreumToken.XRPLCurrency, recipient1),
+			},
+		},
+	}
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+	report, err := bridgeClient.SendBatchToXRPL(ctx, sender, legs)
+	require.NoError(t, err)
+	require.Len(t, contractClient.sentRequests, len(legs))
+	require.Equal(t, client.BatchReport{
+		Sender:    sender,
+		TxHash:    "C0FFEE",
+		Height:    100,
+		Timestamp: txTime,
+		Legs: []client.BatchLegReport{
+			// the legs with the same recipient and token are mapped in the order of the operation IDs
+			{Leg: legs[0], OperationID: 10, Status: client.BatchLegStatusPending},
+			{Leg: legs[1], OperationID: 11, Status: client.BatchLegStatusPending},
+			{Leg: legs[2], OperationID: 12, Status: client.BatchLegStatusPending},
+		},
+	}, report)
+	require.False(t, report.IsFinal())
+
+	// the leg 10 is accepted, the leg 11 is rejected and the leg 12 is still pending
+	contractClient.currentOperations = []coreum.Operation{
+		existingOperation,
+		newCoreumToXRPLTransferOperation(12, bridgeXRPLAddress, coreumToken.XRPLCurrency, recipient1),
+	}
+	contractClient.pendingRefunds = []coreum.PendingRefund{
+		{
+			ID:   strconv.FormatInt(txTime.Unix(), 10) + "-11",
+			Coin: legs[1].Coin,
+		},
+	}
+	pendingReport, err := bridgeClient.WaitForBatch(ctx, report, time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, pendingReport.IsFinal())
+	require.Equal(t, []client.BatchLegStatus{
+		client.BatchLegStatusAccepted,
+		client.BatchLegStatusRejected,
+		client.BatchLegStatusPending,
+	}, batchLegStatuses(pendingReport))
+	require.Equal(t, strconv.FormatInt(txTime.Unix(), 10)+"-11", pendingReport.Legs[1].RefundID)
+
+	contractClient.currentOperations = []coreum.Operation{existingOperation}
+	finalReport, err := bridgeClient.WaitForBatch(ctx, pendingReport, time.Minute)
+	require.NoError(t, err)
+	require.True(t, finalReport.IsFinal())
+	require.Equal(t, []client.BatchLegStatus{
+		client.BatchLegStatusAccepted,
+		client.BatchLegStatusRejected,
+		client.BatchLegStatusAccepted,
+	}, batchLegStatuses(finalReport))
+}
+
+func TestSendBatchToXRPL_InvalidLegs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		legs        []client.SendLeg
+		expectedErr string
+	}{
+		{
+			name:        "empty_batch",
+			expectedErr: "batch must contain at least one leg",
+		},
+		{
+			name: "unregistered_token",
+			legs: []client.SendLeg{
+				{
+					Recipient: xrpl.GenPrivKeyTxSigner().Account(),
+					Coin:      sdk.NewInt64Coin("utkn", 1),
+				},
+			},
+			expectedErr: "invalid leg 0, token is not registered, denom:utkn",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := &fakeSendBatchContractClient{}
+			bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+			_, err := bridgeClient.SendBatchToXRPL(context.Background(), coreum.GenAccount(), tt.legs)
+			require.ErrorContains(t, err, tt.expectedErr)
+			require.Empty(t, contractClient.sentRequests)
+		})
+	}
+}
+
+type fakeSendBatchContractClient struct {
+	fakeTokenPairsContractClient
+
+	txRes              *sdk.TxResponse
+	sentRequests       []coreum.SendToXRPLRequest
+	operationsByHeight map[int64][]coreum.Operation
+	currentOperations  []coreum.Operation
+	pendingRefunds     []coreum.PendingRefund
+}
+
+func (c *fakeSendBatchContractClient) MultiSendToXRPL(
+	_ context.Context,
+	_ sdk.AccAddress,
+	requests ...coreum.SendToXRPLRequest,
+) (*sdk.TxResponse, error) {
+	c.sentRequests = append(c.sentRequests, requests...)
+	return c.txRes, nil
+}
+
+func (c *fakeSendBatchContractClient) GetPendingOperations(ctx context.Context) ([]coreum.Operation, error) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok || len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 0 {
+		return c.currentOperations, nil
+	}
+	height, err := strconv.ParseInt(md.Get(grpctypes.GRPCBlockHeightHeader)[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.operationsByHeight[height], nil
+}
+
+func (c *fakeSendBatchContractClient) GetPendingRefunds(
+	_ context.Context,
+	_ sdk.AccAddress,
+) ([]coreum.PendingRefund, error) {
+	return c.pendingRefunds, nil
+}
+
+func newCoreumToXRPLTransferOperation(
+	ticketSequence uint32,
+	issuer, currency string,
+	recipient fmt.Stringer,
+) coreum.Operation {
+	return coreum.Operation{
+		TicketSequence: ticketSequence,
+		OperationType: coreum.OperationType{
+			CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{
+				Issuer:    issuer,
+				Currency:  currency,
+				Amount:    sdkmath.NewInt(1),
+				Recipient: recipient.String(),
+			},
+		},
+	}
+}
+
+func batchLegStatuses(report client.BatchReport) []client.BatchLegStatus {
+	statuses := make([]client.BatchLegStatus, 0, len(report.Legs))
+	for _, leg := range report.Legs {
+		statuses = append(statuses, leg.Status)
+	}
+
+	return statuses
+}
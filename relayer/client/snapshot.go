@@ -0,0 +1,312 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// BridgeSnapshotVersion is the version of the bridge snapshot format.
+const BridgeSnapshotVersion = 1
+
+// BridgeSnapshot is the bridge contract state snapshot used to migrate the bridge to another contract.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type BridgeSnapshot struct {
+	Version                 int                          `json:"version"`
+	ContractAddress         string                       `json:"contract_address"`
+	Config                  coreum.ContractConfig        `json:"config"`
+	Ownership               coreum.ContractOwnership     `json:"ownership"`
+	Tokens                  TokenRegistry                `json:"tokens"`
+	PendingOperations       []coreum.Operation           `json:"pending_operations"`
+	AvailableTickets        []uint32                     `json:"available_tickets"`
+	TransactionEvidences    []coreum.TransactionEvidence `json:"transaction_evidences"`
+	FeesCollected           []RelayerFeesCollected       `json:"fees_collected"`
+	PendingRefunds          []AddressPendingRefunds      `json:"pending_refunds"`
+	ProhibitedXRPLAddresses []string                     `json:"prohibited_xrpl_addresses"`
+}
+
+// RelayerFeesCollected is the fees collected by the relayer.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type RelayerFeesCollected struct {
+	RelayerAddress sdk.AccAddress `json:"relayer_address"`
+	Fees           sdk.Coins      `json:"fees"`
+}
+
+// AddressPendingRefunds is the pending refunds of the address.
+type AddressPendingRefunds struct {
+	Address sdk.AccAddress         `json:"address"`
+	Refunds []coreum.PendingRefund `json:"refunds"`
+}
+
+// SnapshotImportResult is the bridge snapshot import result.
+type SnapshotImportResult struct {
+	// Conflicts are the differences between the snapshot and the target contract which can't be resolved by the
+	// owner calls, and the snapshot state which can't be replayed.
+	Conflicts []string `json:"conflicts"`
+	// Applied is true if the snapshot is imported, the snapshot with the conflicts is imported only if forced.
+	Applied bool                `json:"applied"`
+	Tokens  []TokenImportResult `json:"tokens"`
+	// Actions are the owner calls other than the tokens import.
+	Actions []string `json:"actions"`
+}
+
+// ExportSnapshot returns the bridge contract state snapshot. The contract doesn't list the pending refunds of all
+// addresses, so only the refunds of the provided addresses are included.
+func (b *BridgeClient) ExportSnapshot(
+	ctx context.Context,
+	refundAddresses []sdk.AccAddress,
+) (BridgeSnapshot, error) {
+	cfg, err := b.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	ownership, err := b.contractClient.GetContractOwnership(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	tokens, err := b.ExportTokenRegistry(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	pendingOperations, err := b.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	availableTickets, err := b.contractClient.GetAvailableTickets(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	transactionEvidences, err := b.contractClient.GetTransactionEvidences(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+	prohibitedXRPLAddresses, err := b.contractClient.GetProhibitedXRPLAddresses(ctx)
+	if err != nil {
+		return BridgeSnapshot{}, err
+	}
+
+	feesCollected := make([]RelayerFeesCollected, 0)
+	for _, relayer := range cfg.Relayers {
+		fees, err := b.contractClient.GetFeesCollected(ctx, relayer.CoreumAddress)
+		if err != nil {
+			return BridgeSnapshot{}, err
+		}
+		if fees.IsZero() {
+			continue
+		}
+		feesCollected = append(feesCollected, RelayerFeesCollected{
+			RelayerAddress: relayer.CoreumAddress,
+			Fees:           fees,
+		})
+	}
+
+	pendingRefunds := make([]AddressPendingRefunds, 0)
+	for _, address := range lo.UniqBy(refundAddresses, sdk.AccAddress.String) {
+		refunds, err := b.contractClient.GetPendingRefunds(ctx, address)
+		if err != nil {
+			return BridgeSnapshot{}, err
+		}
+		if len(refunds) == 0 {
+			continue
+		}
+		pendingRefunds = append(pendingRefunds, AddressPendingRefunds{
+			Address: address,
+			Refunds: refunds,
+		})
+	}
+
+	return BridgeSnapshot{
+		Version:                 BridgeSnapshotVersion,
+		ContractAddress:         b.contractClient.GetContractAddress().String(),
+		Config:                  cfg,
+		Ownership:               ownership,
+		Tokens:                  tokens,
+		PendingOperations:       pendingOperations,
+		AvailableTickets:        availableTickets,
+		TransactionEvidences:    transactionEvidences,
+		FeesCollected:           feesCollected,
+		PendingRefunds:          pendingRefunds,
+		ProhibitedXRPLAddresses: prohibitedXRPLAddresses,
+	}, nil
+}
+
+// ImportSnapshot replays the snapshot to the freshly deployed bridge contract with the owner calls. The tokens, the
+// XRPL base fee and the prohibited XRPL addresses are replayed. The conflicts are detected before any call, and the
+// snapshot with the conflicts is imported only if forced.
+func (b *BridgeClient) ImportSnapshot(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	snapshot BridgeSnapshot,
+	force bool,
+) (SnapshotImportResult, error) {
+	if snapshot.Version != BridgeSnapshotVersion {
+		return SnapshotImportResult{}, errors.Errorf(
+			"unsupported snapshot version %d, expected: %d", snapshot.Version, BridgeSnapshotVersion,
+		)
+	}
+
+	target, err := b.ExportSnapshot(ctx, nil)
+	if err != nil {
+		return SnapshotImportResult{}, err
+	}
+	conflicts := findSnapshotConflicts(snapshot, target)
+
+	// the dry run reports the tokens which can't be imported
+	tokenResults, err := b.ImportTokenRegistry(ctx, owner, snapshot.Tokens, true)
+	if err != nil {
+		return SnapshotImportResult{}, err
+	}
+	for _, tokenResult := range tokenResults {
+		if tokenResult.Action == TokenImportActionFailed {
+			conflicts = append(
+				conflicts, fmt.Sprintf("token %s can't be imported: %s", tokenResult.Token, tokenResult.Error),
+			)
+		}
+	}
+
+	result := SnapshotImportResult{
+		Conflicts: conflicts,
+		Tokens:    tokenResults,
+		Actions:   make([]string, 0),
+	}
+	if len(conflicts) != 0 && !force {
+		b.log.Warn(ctx, "Snapshot import conflicts are found", zap.Strings("conflicts", conflicts))
+		return result, nil
+	}
+
+	b.log.Info(
+		ctx,
+		"Importing bridge snapshot",
+		zap.String("sourceContractAddress", snapshot.ContractAddress),
+		zap.Int("conflicts", len(conflicts)),
+		zap.Bool("force", force),
+	)
+	result.Applied = true
+	if result.Tokens, err = b.ImportTokenRegistry(ctx, owner, snapshot.Tokens, false); err != nil {
+		return result, err
+	}
+	if snapshot.Config.XRPLBaseFee != target.Config.XRPLBaseFee {
+		if err := b.UpdateXRPLBaseFee(ctx, owner, snapshot.Config.XRPLBaseFee); err != nil {
+			return result, err
+		}
+		result.Actions = append(result.Actions, fmt.Sprintf("xrpl_base_fee:%d", snapshot.Config.XRPLBaseFee))
+	}
+	if !equalStringSets(snapshot.ProhibitedXRPLAddresses, target.ProhibitedXRPLAddresses) {
+		if err := b.UpdateProhibitedXRPLAddresses(ctx, owner, snapshot.ProhibitedXRPLAddresses); err != nil {
+			return result, err
+		}
+		result.Actions = append(
+			result.Actions, fmt.Sprintf("prohibited_xrpl_addresses:%d", len(snapshot.ProhibitedXRPLAddresses)),
+		)
+	}
+
+	return result, nil
+}
+
+// findSnapshotConflicts returns the conflicts of the snapshot and the target contract state which aren't replayed.
+func findSnapshotConflicts(snapshot, target BridgeSnapshot) []string {
+	conflicts := make([]string, 0)
+	if snapshot.ContractAddress == target.ContractAddress {
+		conflicts = append(
+			conflicts, fmt.Sprintf("the snapshot is taken from the target contract %s", target.ContractAddress),
+		)
+	}
+
+	// the instantiation parameters can't be changed by the owner calls
+	if !equalRelayers(snapshot.Config.Relayers, target.Config.Relayers) {
+		conflicts = append(conflicts, "the relayers differ")
+	}
+	if snapshot.Config.EvidenceThreshold != target.Config.EvidenceThreshold {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the evidence threshold differs, snapshot:%d, target:%d",
+			snapshot.Config.EvidenceThreshold, target.Config.EvidenceThreshold,
+		))
+	}
+	if snapshot.Config.UsedTicketSequenceThreshold != target.Config.UsedTicketSequenceThreshold {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the used ticket sequence threshold differs, snapshot:%d, target:%d",
+			snapshot.Config.UsedTicketSequenceThreshold, target.Config.UsedTicketSequenceThreshold,
+		))
+	}
+	if !snapshot.Config.TrustSetLimitAmount.Equal(target.Config.TrustSetLimitAmount) {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the trust set limit amount differs, snapshot:%s, target:%s",
+			snapshot.Config.TrustSetLimitAmount.String(), target.Config.TrustSetLimitAmount.String(),
+		))
+	}
+	if snapshot.Config.BridgeXRPLAddress != target.Config.BridgeXRPLAddress {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the bridge XRPL address differs, snapshot:%s, target:%s",
+			snapshot.Config.BridgeXRPLAddress, target.Config.BridgeXRPLAddress,
+		))
+	}
+
+	// the target contract must be fresh
+	if len(target.PendingOperations) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the target contract has %d pending operations", len(target.PendingOperations),
+		))
+	}
+	if len(target.TransactionEvidences) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the target contract has %d transaction evidences", len(target.TransactionEvidences),
+		))
+	}
+
+	// the contract state created by the relayers and users can't be replayed by the owner
+	if len(snapshot.PendingOperations) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"%d pending operations can't be replayed", len(snapshot.PendingOperations),
+		))
+	}
+	if len(snapshot.TransactionEvidences) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"%d transaction evidences can't be replayed", len(snapshot.TransactionEvidences),
+		))
+	}
+	if len(snapshot.AvailableTickets) != 0 && len(target.AvailableTickets) == 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"%d available tickets can't be replayed, recover the tickets in the target contract",
+			len(snapshot.AvailableTickets),
+		))
+	}
+	if len(snapshot.FeesCollected) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the fees collected by %d relayers can't be replayed, claim them from the source contract",
+			len(snapshot.FeesCollected),
+		))
+	}
+	if len(snapshot.PendingRefunds) != 0 {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"the pending refunds of %d addresses can't be replayed, claim them from the source contract",
+			len(snapshot.PendingRefunds),
+		))
+	}
+
+	return conflicts
+}
+
+func equalRelayers(a, b []coreum.Relayer) bool {
+	relayerKey := func(relayer coreum.Relayer) string {
+		return fmt.Sprintf("%s/%s/%s", relayer.CoreumAddress.String(), relayer.XRPLAddress, relayer.XRPLPubKey)
+	}
+
+	return equalStringSets(lo.Map(a, func(relayer coreum.Relayer, _ int) string {
+		return relayerKey(relayer)
+	}), lo.Map(b, func(relayer coreum.Relayer, _ int) string {
+		return relayerKey(relayer)
+	}))
+}
+
+func equalStringSets(a, b []string) bool {
+	a, b = lo.Uniq(a), lo.Uniq(b)
+	return len(a) == len(b) && lo.Every(a, b)
+}
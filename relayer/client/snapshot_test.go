@@ -0,0 +1,286 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestBridgeSnapshot_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	relayerAddress := coreum.GenAccount()
+	refundAddress := coreum.GenAccount()
+	contractClient := newFakeSnapshotContractClient()
+	contractClient.cfg.Relayers = []coreum.Relayer{
+		{
+			CoreumAddress: relayerAddress,
+			XRPLAddress:   xrpl.GenPrivKeyTxSigner().Account().String(),
+			XRPLPubKey:    "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+		},
+	}
+	contractClient.coreumTokens["ucore"] = coreum.CoreumToken{
+		Denom:                    "ucore",
+		Decimals:                 6,
+		XRPLCurrency:             "3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C3C",
+		SendingPrecision:         6,
+		MaxHoldingAmount:         sdkmath.NewInt(1000),
+		State:                    coreum.TokenStateEnabled,
+		BridgingFee:              sdkmath.NewInt(10),
+		MaxSendsPerAddressPerDay: lo.ToPtr(uint32(10)),
+	}
+	contractClient.pendingOperations = []coreum.Operation{
+		{
+			Version:        1,
+			TicketSequence: 5,
+			Signatures: []coreum.Signature{
+				{
+					RelayerCoreumAddress: relayerAddress,
+					Signature:            "signature",
+				},
+			},
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{
+					Issuer:    xrpl.XRPTokenIssuer.String(),
+					Currency:  xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+					Amount:    sdkmath.NewInt(100),
+					MaxAmount: lo.ToPtr(sdkmath.NewInt(200)),
+					Recipient: xrpl.GenPrivKeyTxSigner().Account().String(),
+				},
+			},
+			XRPLBaseFee: 10,
+		},
+	}
+	contractClient.availableTickets = []uint32{6, 7, 8}
+	contractClient.transactionEvidences = []coreum.TransactionEvidence{
+		{
+			Hash:             "hash",
+			RelayerAddresses: []sdk.AccAddress{relayerAddress},
+		},
+	}
+	contractClient.feesCollected[relayerAddress.String()] = sdk.NewCoins(sdk.NewInt64Coin("ucore", 5))
+	contractClient.pendingRefunds[refundAddress.String()] = []coreum.PendingRefund{
+		{
+			ID:         "refund-id",
+			Coin:       sdk.NewInt64Coin("ucore", 100),
+			XRPLTxHash: "xrpl-tx-hash",
+		},
+	}
+	contractClient.ownership = coreum.ContractOwnership{
+		Owner:        coreum.GenAccount(),
+		PendingOwner: coreum.GenAccount(),
+	}
+	contractClient.prohibitedXRPLAddresses = []string{xrpl.GenPrivKeyTxSigner().Account().String()}
+
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+	snapshot, err := bridgeClient.ExportSnapshot(ctx, []sdk.AccAddress{refundAddress, coreum.GenAccount()})
+	require.NoError(t, err)
+	require.Equal(t, client.BridgeSnapshotVersion, snapshot.Version)
+	require.Len(t, snapshot.FeesCollected, 1)
+	// the addresses without the refunds are skipped
+	require.Len(t, snapshot.PendingRefunds, 1)
+
+	snapshotJSON, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	var decodedSnapshot client.BridgeSnapshot
+	require.NoError(t, json.Unmarshal(snapshotJSON, &decodedSnapshot))
+	require.Equal(t, snapshot, decodedSnapshot)
+}
+
+func TestImportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	owner := coreum.GenAccount()
+	relayer := coreum.Relayer{
+		CoreumAddress: coreum.GenAccount(),
+		XRPLAddress:   xrpl.GenPrivKeyTxSigner().Account().String(),
+		XRPLPubKey:    "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+	}
+	prohibitedXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+
+	snapshot := client.BridgeSnapshot{
+		Version:         client.BridgeSnapshotVersion,
+		ContractAddress: coreum.GenAccount().String(),
+		Config: coreum.ContractConfig{
+			Relayers:                    []coreum.Relayer{relayer},
+			EvidenceThreshold:           1,
+			UsedTicketSequenceThreshold: 150,
+			TrustSetLimitAmount:         sdkmath.NewInt(1000),
+			BridgeXRPLAddress:           "bridge",
+			XRPLBaseFee:                 20,
+		},
+		Tokens: client.TokenRegistry{
+			CoreumTokens: []coreum.CoreumToken{
+				{
+					Denom:            "ucore",
+					Decimals:         6,
+					SendingPrecision: 6,
+					MaxHoldingAmount: sdkmath.NewInt(1000),
+					State:            coreum.TokenStateEnabled,
+					BridgingFee:      sdkmath.NewInt(10),
+				},
+			},
+		},
+		AvailableTickets: []uint32{1, 2},
+		FeesCollected: []client.RelayerFeesCollected{
+			{
+				RelayerAddress: relayer.CoreumAddress,
+				Fees:           sdk.NewCoins(sdk.NewInt64Coin("ucore", 5)),
+			},
+		},
+		ProhibitedXRPLAddresses: []string{prohibitedXRPLAddress},
+	}
+
+	contractClient := newFakeSnapshotContractClient()
+	contractClient.cfg = snapshot.Config
+	contractClient.cfg.XRPLBaseFee = 10
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+	// the not replayed state is reported as conflicts, and nothing is changed
+	result, err := bridgeClient.ImportSnapshot(ctx, owner, snapshot, false)
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Equal(t, []string{
+		"2 available tickets can't be replayed, recover the tickets in the target contract",
+		"the fees collected by 1 relayers can't be replayed, claim them from the source contract",
+	}, result.Conflicts)
+	require.Zero(t, contractClient.txCount)
+	require.Zero(t, contractClient.ownerTxCount)
+
+	// the forced import replays the owner state
+	result, err = bridgeClient.ImportSnapshot(ctx, owner, snapshot, true)
+	require.NoError(t, err)
+	require.True(t, result.Applied)
+	require.Len(t, result.Conflicts, 2)
+	require.Equal(t, []client.TokenImportResult{
+		{
+			Token:   "ucore",
+			Action:  client.TokenImportActionCreated,
+			Changes: []string{},
+		},
+	}, result.Tokens)
+	require.Equal(t, []string{"xrpl_base_fee:20", "prohibited_xrpl_addresses:1"}, result.Actions)
+	require.Equal(t, 1, contractClient.txCount)
+	require.Equal(t, 2, contractClient.ownerTxCount)
+	require.Equal(t, uint32(20), contractClient.cfg.XRPLBaseFee)
+	require.Equal(t, []string{prohibitedXRPLAddress}, contractClient.prohibitedXRPLAddresses)
+
+	// the instantiation config mismatch and the snapshot of the same contract are conflicts
+	snapshot.AvailableTickets = nil
+	snapshot.FeesCollected = nil
+	snapshot.ContractAddress = contractClient.GetContractAddress().String()
+	snapshot.Config.EvidenceThreshold = 2
+	result, err = bridgeClient.ImportSnapshot(ctx, owner, snapshot, false)
+	require.NoError(t, err)
+	require.False(t, result.Applied)
+	require.Equal(t, []string{
+		"the snapshot is taken from the target contract " + snapshot.ContractAddress,
+		"the evidence threshold differs, snapshot:2, target:1",
+	}, result.Conflicts)
+
+	// the unsupported version is rejected even if forced
+	snapshot.Version = client.BridgeSnapshotVersion + 1
+	_, err = bridgeClient.ImportSnapshot(ctx, owner, snapshot, true)
+	require.ErrorContains(t, err, "unsupported snapshot version")
+}
+
+type fakeSnapshotContractClient struct {
+	*fakeTokenRegistryContractClient
+
+	contractAddress         sdk.AccAddress
+	cfg                     coreum.ContractConfig
+	ownership               coreum.ContractOwnership
+	pendingOperations       []coreum.Operation
+	availableTickets        []uint32
+	transactionEvidences    []coreum.TransactionEvidence
+	feesCollected           map[string]sdk.Coins
+	pendingRefunds          map[string][]coreum.PendingRefund
+	prohibitedXRPLAddresses []string
+	ownerTxCount            int
+}
+
+func newFakeSnapshotContractClient() *fakeSnapshotContractClient {
+	return &fakeSnapshotContractClient{
+		fakeTokenRegistryContractClient: &fakeTokenRegistryContractClient{
+			coreumTokens: make(map[string]coreum.CoreumToken),
+			xrplTokens:   make(map[string]coreum.XRPLToken),
+		},
+		contractAddress: coreum.GenAccount(),
+		cfg: coreum.ContractConfig{
+			TrustSetLimitAmount: sdkmath.NewInt(1000),
+		},
+		feesCollected:  make(map[string]sdk.Coins),
+		pendingRefunds: make(map[string][]coreum.PendingRefund),
+	}
+}
+
+func (c *fakeSnapshotContractClient) GetContractAddress() sdk.AccAddress {
+	return c.contractAddress
+}
+
+func (c *fakeSnapshotContractClient) GetContractConfig(context.Context) (coreum.ContractConfig, error) {
+	return c.cfg, nil
+}
+
+func (c *fakeSnapshotContractClient) GetContractOwnership(context.Context) (coreum.ContractOwnership, error) {
+	return c.ownership, nil
+}
+
+func (c *fakeSnapshotContractClient) GetPendingOperations(context.Context) ([]coreum.Operation, error) {
+	return c.pendingOperations, nil
+}
+
+func (c *fakeSnapshotContractClient) GetAvailableTickets(context.Context) ([]uint32, error) {
+	return c.availableTickets, nil
+}
+
+func (c *fakeSnapshotContractClient) GetTransactionEvidences(context.Context) ([]coreum.TransactionEvidence, error) {
+	return c.transactionEvidences, nil
+}
+
+func (c *fakeSnapshotContractClient) GetFeesCollected(_ context.Context, address sdk.Address) (sdk.Coins, error) {
+	return c.feesCollected[address.String()], nil
+}
+
+func (c *fakeSnapshotContractClient) GetPendingRefunds(
+	_ context.Context,
+	address sdk.AccAddress,
+) ([]coreum.PendingRefund, error) {
+	return c.pendingRefunds[address.String()], nil
+}
+
+func (c *fakeSnapshotContractClient) GetProhibitedXRPLAddresses(context.Context) ([]string, error) {
+	return c.prohibitedXRPLAddresses, nil
+}
+
+func (c *fakeSnapshotContractClient) UpdateXRPLBaseFee(
+	_ context.Context,
+	_ sdk.AccAddress,
+	xrplBaseFee uint32,
+) (*sdk.TxResponse, error) {
+	c.ownerTxCount++
+	c.cfg.XRPLBaseFee = xrplBaseFee
+	return &sdk.TxResponse{}, nil
+}
+
+func (c *fakeSnapshotContractClient) UpdateProhibitedXRPLAddresses(
+	_ context.Context,
+	_ sdk.AccAddress,
+	prohibitedXRPLAddresses []string,
+) (*sdk.TxResponse, error) {
+	c.ownerTxCount++
+	c.prohibitedXRPLAddresses = prohibitedXRPLAddresses
+	return &sdk.TxResponse{}, nil
+}
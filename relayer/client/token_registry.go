@@ -0,0 +1,397 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// TokenImportAction is the action taken for the token by the token registry import.
+type TokenImportAction string
+
+// TokenImportAction values.
+const (
+	TokenImportActionCreated TokenImportAction = "created"
+	TokenImportActionUpdated TokenImportAction = "updated"
+	TokenImportActionSkipped TokenImportAction = "skipped"
+	TokenImportActionFailed  TokenImportAction = "failed"
+)
+
+// TokenRegistry is the full list of the tokens registered in the bridge contract.
+//
+//nolint:tagliatelle // we use the same style as the contract
+type TokenRegistry struct {
+	CoreumTokens []coreum.CoreumToken `json:"coreum_tokens"`
+	XRPLTokens   []coreum.XRPLToken   `json:"xrpl_tokens"`
+}
+
+// TokenImportResult is the token registry import result of a single token.
+type TokenImportResult struct {
+	Token   string            `json:"token"`
+	Action  TokenImportAction `json:"action"`
+	Changes []string          `json:"changes,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// tokenParams are the token parameters the owner can update after the registration.
+type tokenParams struct {
+	State                    *coreum.TokenState
+	SendingPrecision         *int32
+	MaxHoldingAmount         *sdkmath.Int
+	BridgingFee              *sdkmath.Int
+	MaxSendsPerAddressPerDay *uint32
+	TreasuryFee              *sdkmath.Int
+}
+
+func (p tokenParams) changes() []string {
+	changes := make([]string, 0)
+	if p.State != nil {
+		changes = append(changes, fmt.Sprintf("state:%s", *p.State))
+	}
+	if p.SendingPrecision != nil {
+		changes = append(changes, fmt.Sprintf("sending_precision:%d", *p.SendingPrecision))
+	}
+	if p.MaxHoldingAmount != nil {
+		changes = append(changes, fmt.Sprintf("max_holding_amount:%s", p.MaxHoldingAmount.String()))
+	}
+	if p.BridgingFee != nil {
+		changes = append(changes, fmt.Sprintf("bridging_fee:%s", p.BridgingFee.String()))
+	}
+	if p.MaxSendsPerAddressPerDay != nil {
+		changes = append(changes, fmt.Sprintf("max_sends_per_address_per_day:%d", *p.MaxSendsPerAddressPerDay))
+	}
+	if p.TreasuryFee != nil {
+		changes = append(changes, fmt.Sprintf("treasury_fee:%s", p.TreasuryFee.String()))
+	}
+
+	return changes
+}
+
+// ExportTokenRegistry returns all tokens registered in the bridge contract.
+func (b *BridgeClient) ExportTokenRegistry(ctx context.Context) (TokenRegistry, error) {
+	coreumTokens, xrplTokens, err := b.GetAllTokens(ctx)
+	if err != nil {
+		return TokenRegistry{}, err
+	}
+
+	return TokenRegistry{
+		CoreumTokens: coreumTokens,
+		XRPLTokens:   xrplTokens,
+	}, nil
+}
+
+// ImportTokenRegistry registers the registry tokens missing in the bridge contract and updates the parameters of the
+// registered ones to match the registry. The XRP token is registered at the contract instantiation, so it's skipped.
+// The import is idempotent, the failed token doesn't stop the import of the rest, and with the dryRun nothing is
+// changed, but the planned actions are returned.
+func (b *BridgeClient) ImportTokenRegistry(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	registry TokenRegistry,
+	dryRun bool,
+) ([]TokenImportResult, error) {
+	current, err := b.ExportTokenRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentCoreumTokens := lo.SliceToMap(
+		current.CoreumTokens,
+		func(token coreum.CoreumToken) (string, coreum.CoreumToken) {
+			return token.Denom, token
+		},
+	)
+	currentXRPLTokens := lo.SliceToMap(current.XRPLTokens, func(token coreum.XRPLToken) (string, coreum.XRPLToken) {
+		return buildXRPLTokenKey(token.Issuer, token.Currency), token
+	})
+
+	b.log.Info(
+		ctx,
+		"Importing token registry",
+		zap.String("owner", owner.String()),
+		zap.Int("coreumTokens", len(registry.CoreumTokens)),
+		zap.Int("xrplTokens", len(registry.XRPLTokens)),
+		zap.Bool("dryRun", dryRun),
+	)
+
+	results := make([]TokenImportResult, 0, len(registry.CoreumTokens)+len(registry.XRPLTokens))
+	for _, token := range registry.CoreumTokens {
+		result := b.importCoreumToken(ctx, owner, token, currentCoreumTokens, dryRun)
+		b.logTokenImportResult(ctx, result)
+		results = append(results, result)
+	}
+	for _, token := range registry.XRPLTokens {
+		result := b.importXRPLToken(ctx, owner, token, currentXRPLTokens, dryRun)
+		b.logTokenImportResult(ctx, result)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (b *BridgeClient) importCoreumToken(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	token coreum.CoreumToken,
+	currentTokens map[string]coreum.CoreumToken,
+	dryRun bool,
+) TokenImportResult {
+	result := TokenImportResult{
+		Token: token.Denom,
+	}
+
+	currentToken, found := currentTokens[token.Denom]
+	if !found {
+		result.Action = TokenImportActionCreated
+		// the Coreum token is enabled on registration
+		var update tokenParams
+		if token.State == coreum.TokenStateDisabled {
+			update.State = &token.State
+		}
+		result.Changes = update.changes()
+		if dryRun {
+			return result
+		}
+		if _, err := b.RegisterCoreumToken(
+			ctx,
+			owner,
+			token.Denom,
+			token.Decimals,
+			token.SendingPrecision,
+			token.MaxHoldingAmount,
+			token.BridgingFee,
+			token.MaxSendsPerAddressPerDay,
+		); err != nil {
+			return failTokenImportResult(result, err)
+		}
+		if update.State == nil {
+			return result
+		}
+		if err := b.UpdateCoreumToken(ctx, owner, token.Denom, update.State, nil, nil, nil, nil, nil); err != nil {
+			return failTokenImportResult(result, err)
+		}
+
+		return result
+	}
+
+	if currentToken.Decimals != token.Decimals {
+		return failTokenImportResult(result, errors.Errorf(
+			"the registered token decimals %d can't be updated to %d", currentToken.Decimals, token.Decimals,
+		))
+	}
+	update := buildTokenParamsUpdate(
+		currentToken.State,
+		token.State,
+		tokenParams{
+			SendingPrecision:         &currentToken.SendingPrecision,
+			MaxHoldingAmount:         &currentToken.MaxHoldingAmount,
+			BridgingFee:              &currentToken.BridgingFee,
+			MaxSendsPerAddressPerDay: currentToken.MaxSendsPerAddressPerDay,
+			TreasuryFee:              currentToken.TreasuryFee,
+		},
+		tokenParams{
+			SendingPrecision:         &token.SendingPrecision,
+			MaxHoldingAmount:         &token.MaxHoldingAmount,
+			BridgingFee:              &token.BridgingFee,
+			MaxSendsPerAddressPerDay: token.MaxSendsPerAddressPerDay,
+			TreasuryFee:              token.TreasuryFee,
+		},
+	)
+	result.Changes = update.changes()
+	if len(result.Changes) == 0 {
+		result.Action = TokenImportActionSkipped
+		return result
+	}
+	result.Action = TokenImportActionUpdated
+	if dryRun {
+		return result
+	}
+	if err := b.UpdateCoreumToken(
+		ctx,
+		owner,
+		token.Denom,
+		update.State,
+		update.SendingPrecision,
+		update.MaxHoldingAmount,
+		update.BridgingFee,
+		update.MaxSendsPerAddressPerDay,
+		update.TreasuryFee,
+	); err != nil {
+		return failTokenImportResult(result, err)
+	}
+
+	return result
+}
+
+func (b *BridgeClient) importXRPLToken(
+	ctx context.Context,
+	owner sdk.AccAddress,
+	token coreum.XRPLToken,
+	currentTokens map[string]coreum.XRPLToken,
+	dryRun bool,
+) TokenImportResult {
+	result := TokenImportResult{
+		Token: fmt.Sprintf("%s/%s", token.Currency, token.Issuer),
+	}
+	if token.Issuer == xrpl.XRPTokenIssuer.String() &&
+		token.Currency == xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency) {
+		result.Action = TokenImportActionSkipped
+		return result
+	}
+
+	issuer, err := rippledata.NewAccountFromAddress(token.Issuer)
+	if err != nil {
+		return failTokenImportResult(
+			result, errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", token.Issuer),
+		)
+	}
+	currency, err := rippledata.NewCurrency(token.Currency)
+	if err != nil {
+		return failTokenImportResult(
+			result, errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", token.Currency),
+		)
+	}
+
+	currentToken, found := currentTokens[buildXRPLTokenKey(issuer.String(), xrpl.ConvertCurrencyToString(currency))]
+	if !found {
+		// the state of the registered XRPL token is set by the trust set operation, so it's synced by the next import
+		result.Action = TokenImportActionCreated
+		if dryRun {
+			return result
+		}
+		if _, err := b.RegisterXRPLToken(
+			ctx,
+			owner,
+			*issuer,
+			currency,
+			token.SendingPrecision,
+			token.MaxHoldingAmount,
+			token.BridgingFee,
+			token.MaxSendsPerAddressPerDay,
+			nil,
+		); err != nil {
+			return failTokenImportResult(result, err)
+		}
+
+		return result
+	}
+
+	update := buildTokenParamsUpdate(
+		currentToken.State,
+		token.State,
+		tokenParams{
+			SendingPrecision:         &currentToken.SendingPrecision,
+			MaxHoldingAmount:         &currentToken.MaxHoldingAmount,
+			BridgingFee:              &currentToken.BridgingFee,
+			MaxSendsPerAddressPerDay: currentToken.MaxSendsPerAddressPerDay,
+			TreasuryFee:              currentToken.TreasuryFee,
+		},
+		tokenParams{
+			SendingPrecision:         &token.SendingPrecision,
+			MaxHoldingAmount:         &token.MaxHoldingAmount,
+			BridgingFee:              &token.BridgingFee,
+			MaxSendsPerAddressPerDay: token.MaxSendsPerAddressPerDay,
+			TreasuryFee:              token.TreasuryFee,
+		},
+	)
+	result.Changes = update.changes()
+	if len(result.Changes) == 0 {
+		result.Action = TokenImportActionSkipped
+		return result
+	}
+	result.Action = TokenImportActionUpdated
+	if dryRun {
+		return result
+	}
+	if err := b.UpdateXRPLToken(
+		ctx,
+		owner,
+		currentToken.Issuer,
+		currentToken.Currency,
+		update.State,
+		update.SendingPrecision,
+		update.MaxHoldingAmount,
+		update.BridgingFee,
+		update.MaxSendsPerAddressPerDay,
+		update.TreasuryFee,
+	); err != nil {
+		return failTokenImportResult(result, err)
+	}
+
+	return result
+}
+
+func (b *BridgeClient) logTokenImportResult(ctx context.Context, result TokenImportResult) {
+	fields := []zap.Field{
+		zap.String("token", result.Token),
+		zap.String("action", string(result.Action)),
+		zap.Strings("changes", result.Changes),
+	}
+	if result.Action == TokenImportActionFailed {
+		b.log.Warn(ctx, "Failed to import token", append(fields, zap.String("error", result.Error))...)
+		return
+	}
+	b.log.Info(ctx, "Token import result", fields...)
+}
+
+// buildTokenParamsUpdate returns the target parameters different from the current. The state is updated only if both
+// states are changeable by the owner, and the max sends per address per day is updated only if set, since the contract
+// can't unset it. The treasury fee missing in the target is removed by setting it to zero.
+func buildTokenParamsUpdate(
+	currentState, targetState coreum.TokenState,
+	current, target tokenParams,
+) tokenParams {
+	var update tokenParams
+	if currentState != targetState &&
+		isOwnerChangeableTokenState(currentState) &&
+		isOwnerChangeableTokenState(targetState) {
+		update.State = &targetState
+	}
+	if target.SendingPrecision != nil && *target.SendingPrecision != *current.SendingPrecision {
+		update.SendingPrecision = target.SendingPrecision
+	}
+	if target.MaxHoldingAmount != nil && !target.MaxHoldingAmount.Equal(*current.MaxHoldingAmount) {
+		update.MaxHoldingAmount = target.MaxHoldingAmount
+	}
+	if target.BridgingFee != nil && !target.BridgingFee.Equal(*current.BridgingFee) {
+		update.BridgingFee = target.BridgingFee
+	}
+	if target.MaxSendsPerAddressPerDay != nil &&
+		(current.MaxSendsPerAddressPerDay == nil ||
+			*target.MaxSendsPerAddressPerDay != *current.MaxSendsPerAddressPerDay) {
+		update.MaxSendsPerAddressPerDay = target.MaxSendsPerAddressPerDay
+	}
+	switch {
+	case target.TreasuryFee != nil:
+		if current.TreasuryFee == nil || !target.TreasuryFee.Equal(*current.TreasuryFee) {
+			update.TreasuryFee = target.TreasuryFee
+		}
+	case current.TreasuryFee != nil:
+		zeroTreasuryFee := sdkmath.ZeroInt()
+		update.TreasuryFee = &zeroTreasuryFee
+	}
+
+	return update
+}
+
+func isOwnerChangeableTokenState(state coreum.TokenState) bool {
+	return state == coreum.TokenStateEnabled || state == coreum.TokenStateDisabled
+}
+
+func failTokenImportResult(result TokenImportResult, err error) TokenImportResult {
+	result.Action = TokenImportActionFailed
+	result.Error = err.Error()
+	return result
+}
+
+func buildXRPLTokenKey(issuer, currency string) string {
+	return fmt.Sprintf("%s/%s", issuer, currency)
+}
@@ -0,0 +1,286 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestImportTokenRegistry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	owner := coreum.GenAccount()
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrpToken := coreum.XRPLToken{
+		Issuer:           xrpl.XRPTokenIssuer.String(),
+		Currency:         xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+		CoreumDenom:      "drop-source",
+		SendingPrecision: 6,
+		MaxHoldingAmount: sdkmath.NewInt(100),
+		State:            coreum.TokenStateEnabled,
+		BridgingFee:      sdkmath.ZeroInt(),
+	}
+
+	registry := client.TokenRegistry{
+		CoreumTokens: []coreum.CoreumToken{
+			{
+				Denom:            "ucore",
+				Decimals:         6,
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(1000),
+				State:            coreum.TokenStateDisabled,
+				BridgingFee:      sdkmath.NewInt(10),
+			},
+			{
+				Denom:                    "uother",
+				Decimals:                 6,
+				SendingPrecision:         5,
+				MaxHoldingAmount:         sdkmath.NewInt(2000),
+				State:                    coreum.TokenStateEnabled,
+				BridgingFee:              sdkmath.ZeroInt(),
+				MaxSendsPerAddressPerDay: lo.ToPtr(uint32(10)),
+				TreasuryFee:              lo.ToPtr(sdkmath.NewInt(3)),
+			},
+			{
+				Denom:            "udecimals",
+				Decimals:         18,
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(1000),
+				State:            coreum.TokenStateEnabled,
+				BridgingFee:      sdkmath.ZeroInt(),
+			},
+		},
+		XRPLTokens: []coreum.XRPLToken{
+			xrpToken,
+			{
+				Issuer:           issuer,
+				Currency:         "CRN",
+				SendingPrecision: 12,
+				MaxHoldingAmount: sdkmath.NewInt(3000),
+				State:            coreum.TokenStateEnabled,
+				BridgingFee:      sdkmath.NewInt(1),
+			},
+		},
+	}
+
+	contractClient := &fakeTokenRegistryContractClient{
+		coreumTokens: map[string]coreum.CoreumToken{
+			"uother": {
+				Denom:            "uother",
+				Decimals:         6,
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(1000),
+				State:            coreum.TokenStateDisabled,
+				BridgingFee:      sdkmath.ZeroInt(),
+			},
+			"udecimals": {
+				Denom:            "udecimals",
+				Decimals:         6,
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(1000),
+				State:            coreum.TokenStateEnabled,
+				BridgingFee:      sdkmath.ZeroInt(),
+			},
+		},
+		xrplTokens: map[string]coreum.XRPLToken{
+			fmt.Sprintf("%s/%s", xrpToken.Issuer, xrpToken.Currency): xrpToken,
+		},
+	}
+	bridgeClient := client.NewBridgeClient(newTestLogger(t), coreumclient.Context{}, contractClient, nil, nil)
+
+	// nothing is changed with the dry run
+	results, err := bridgeClient.ImportTokenRegistry(ctx, owner, registry, true)
+	require.NoError(t, err)
+	expectedResults := []client.TokenImportResult{
+		{
+			Token:   "ucore",
+			Action:  client.TokenImportActionCreated,
+			Changes: []string{"state:disabled"},
+		},
+		{
+			Token:  "uother",
+			Action: client.TokenImportActionUpdated,
+			Changes: []string{
+				"state:enabled",
+				"sending_precision:5",
+				"max_holding_amount:2000",
+				"max_sends_per_address_per_day:10",
+				"treasury_fee:3",
+			},
+		},
+		{
+			Token:  "udecimals",
+			Action: client.TokenImportActionFailed,
+			Error:  "the registered token decimals 6 can't be updated to 18",
+		},
+		{
+			Token:  fmt.Sprintf("%s/%s", xrpToken.Currency, xrpToken.Issuer),
+			Action: client.TokenImportActionSkipped,
+		},
+		{
+			Token:  fmt.Sprintf("CRN/%s", issuer),
+			Action: client.TokenImportActionCreated,
+		},
+	}
+	require.Equal(t, expectedResults, results)
+	require.Zero(t, contractClient.txCount)
+
+	results, err = bridgeClient.ImportTokenRegistry(ctx, owner, registry, false)
+	require.NoError(t, err)
+	require.Equal(t, expectedResults, results)
+	// ucore registration and state update, uother update and CRN registration
+	require.Equal(t, 4, contractClient.txCount)
+
+	exportedRegistry, err := bridgeClient.ExportTokenRegistry(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, registry.CoreumTokens[:2], lo.Filter(
+		exportedRegistry.CoreumTokens,
+		func(token coreum.CoreumToken, _ int) bool {
+			return token.Denom != "udecimals"
+		},
+	))
+
+	// the repeated import is idempotent
+	results, err = bridgeClient.ImportTokenRegistry(ctx, owner, registry, false)
+	require.NoError(t, err)
+	require.Equal(t, []client.TokenImportAction{
+		client.TokenImportActionSkipped,
+		client.TokenImportActionSkipped,
+		client.TokenImportActionFailed,
+		client.TokenImportActionSkipped,
+		client.TokenImportActionSkipped,
+	}, lo.Map(results, func(result client.TokenImportResult, _ int) client.TokenImportAction {
+		return result.Action
+	}))
+	require.Equal(t, 4, contractClient.txCount)
+}
+
+type fakeTokenRegistryContractClient struct {
+	client.ContractClient
+
+	coreumTokens map[string]coreum.CoreumToken
+	xrplTokens   map[string]coreum.XRPLToken
+	txCount      int
+}
+
+func (c *fakeTokenRegistryContractClient) GetCoreumTokens(context.Context) ([]coreum.CoreumToken, error) {
+	return lo.Values(c.coreumTokens), nil
+}
+
+func (c *fakeTokenRegistryContractClient) GetXRPLTokens(context.Context) ([]coreum.XRPLToken, error) {
+	return lo.Values(c.xrplTokens), nil
+}
+
+func (c *fakeTokenRegistryContractClient) GetCoreumTokenByDenom(
+	_ context.Context,
+	denom string,
+) (coreum.CoreumToken, error) {
+	return c.coreumTokens[denom], nil
+}
+
+func (c *fakeTokenRegistryContractClient) GetXRPLTokenByIssuerAndCurrency(
+	_ context.Context,
+	issuer, currency string,
+) (coreum.XRPLToken, error) {
+	return c.xrplTokens[fmt.Sprintf("%s/%s", issuer, currency)], nil
+}
+
+func (c *fakeTokenRegistryContractClient) RegisterCoreumToken(
+	_ context.Context,
+	_ sdk.AccAddress,
+	denom string,
+	decimals uint32,
+	sendingPrecision int32,
+	maxHoldingAmount sdkmath.Int,
+	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+) (*sdk.TxResponse, error) {
+	c.txCount++
+	c.coreumTokens[denom] = coreum.CoreumToken{
+		Denom:                    denom,
+		Decimals:                 decimals,
+		SendingPrecision:         sendingPrecision,
+		MaxHoldingAmount:         maxHoldingAmount,
+		State:                    coreum.TokenStateEnabled,
+		BridgingFee:              bridgingFee,
+		MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
+	}
+	return &sdk.TxResponse{}, nil
+}
+
+func (c *fakeTokenRegistryContractClient) RegisterXRPLToken(
+	_ context.Context,
+	_ sdk.AccAddress,
+	issuer, currency string,
+	sendingPrecision int32,
+	maxHoldingAmount sdkmath.Int,
+	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	deliveryMode *coreum.XRPLTokenDeliveryMode,
+) (*sdk.TxResponse, error) {
+	c.txCount++
+	c.xrplTokens[fmt.Sprintf("%s/%s", issuer, currency)] = coreum.XRPLToken{
+		Issuer:                   issuer,
+		Currency:                 currency,
+		SendingPrecision:         sendingPrecision,
+		MaxHoldingAmount:         maxHoldingAmount,
+		State:                    coreum.TokenStateProcessing,
+		BridgingFee:              bridgingFee,
+		MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
+		DeliveryMode:             deliveryMode,
+	}
+	return &sdk.TxResponse{}, nil
+}
+
+func (c *fakeTokenRegistryContractClient) UpdateCoreumToken(
+	_ context.Context,
+	_ sdk.AccAddress,
+	denom string,
+	state *coreum.TokenState,
+	sendingPrecision *int32,
+	maxHoldingAmount *sdkmath.Int,
+	bridgingFee *sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	treasuryFee *sdkmath.Int,
+) (*sdk.TxResponse, error) {
+	token, ok := c.coreumTokens[denom]
+	if !ok {
+		return nil, errors.Errorf("token %s not found", denom)
+	}
+	c.txCount++
+	if state != nil {
+		token.State = *state
+	}
+	if sendingPrecision != nil {
+		token.SendingPrecision = *sendingPrecision
+	}
+	if maxHoldingAmount != nil {
+		token.MaxHoldingAmount = *maxHoldingAmount
+	}
+	if bridgingFee != nil {
+		token.BridgingFee = *bridgingFee
+	}
+	if maxSendsPerAddressPerDay != nil {
+		token.MaxSendsPerAddressPerDay = maxSendsPerAddressPerDay
+	}
+	if treasuryFee != nil {
+		token.TreasuryFee = treasuryFee
+		if treasuryFee.IsZero() {
+			token.TreasuryFee = nil
+		}
+	}
+	c.coreumTokens[denom] = token
+	return &sdk.TxResponse{}, nil
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"golang.org/x/mod/semver"
+)
+
+// ContractName is the cw2 contract name of the bridge contract.
+const ContractName = "coreumbridge-xrpl"
+
+// SupportedContractVersions are the cw2 versions of the bridge contract the relayer is compatible with, ordered from
+// the oldest to the newest.
+var SupportedContractVersions = []string{
+	"0.1.0",
+	"1.0.0",
+	"1.1.0",
+}
+
+// ContractCompatibility is the compatibility verdict of the deployed contract version.
+type ContractCompatibility string
+
+// ContractCompatibility values.
+const (
+	ContractCompatibilitySupported ContractCompatibility = "supported"
+	ContractCompatibilityTooOld    ContractCompatibility = "too_old"
+	ContractCompatibilityUnknown   ContractCompatibility = "unknown"
+)
+
+// ContractVersionInfo is the deployed contract version info.
+type ContractVersionInfo struct {
+	Address       string                `json:"address,omitempty"`
+	Name          string                `json:"name,omitempty"`
+	Version       string                `json:"version,omitempty"`
+	CodeID        uint64                `json:"code_id,omitempty"`
+	Compatibility ContractCompatibility `json:"compatibility"`
+	Error         string                `json:"error,omitempty"`
+}
+
+// CoreumVersionInfo is the Coreum chain version info.
+type CoreumVersionInfo struct {
+	ChainID     string `json:"chain_id,omitempty"`
+	NodeVersion string `json:"node_version,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// XRPLVersionInfo is the XRPL server version info.
+type XRPLVersionInfo struct {
+	BuildVersion string `json:"build_version,omitempty"`
+	NetworkID    uint32 `json:"network_id"`
+	Error        string `json:"error,omitempty"`
+}
+
+// VersionInfo is the version info of the bridge components the relayer is connected to. The section with the
+// unreachable component has the Error set instead of the version.
+type VersionInfo struct {
+	Contract ContractVersionInfo `json:"contract"`
+	Coreum   CoreumVersionInfo   `json:"coreum"`
+	XRPL     XRPLVersionInfo     `json:"xrpl"`
+}
+
+// GetVersionInfo returns the version info of the contract, Coreum chain and XRPL server. The failed queries don't
+// fail the call, the corresponding section errors are set instead.
+func (b *BridgeClient) GetVersionInfo(ctx context.Context) VersionInfo {
+	return VersionInfo{
+		Contract: b.getContractVersionInfo(ctx),
+		Coreum:   b.getCoreumVersionInfo(ctx),
+		XRPL:     b.getXRPLVersionInfo(ctx),
+	}
+}
+
+// GetContractCompatibility returns the compatibility verdict of the contract name and cw2 version based on the
+// SupportedContractVersions.
+func GetContractCompatibility(name, version string) ContractCompatibility {
+	if name != ContractName {
+		return ContractCompatibilityUnknown
+	}
+	if lo.Contains(SupportedContractVersions, version) {
+		return ContractCompatibilitySupported
+	}
+	semverVersion := "v" + version
+	if !semver.IsValid(semverVersion) {
+		return ContractCompatibilityUnknown
+	}
+	if semver.Compare(semverVersion, "v"+SupportedContractVersions[0]) < 0 {
+		return ContractCompatibilityTooOld
+	}
+
+	return ContractCompatibilityUnknown
+}
+
+func (b *BridgeClient) getContractVersionInfo(ctx context.Context) ContractVersionInfo {
+	info := ContractVersionInfo{
+		Compatibility: ContractCompatibilityUnknown,
+	}
+	contractAddress := b.contractClient.GetContractAddress()
+	if contractAddress.Empty() {
+		info.Error = "contract address is not configured"
+		return info
+	}
+	info.Address = contractAddress.String()
+
+	codeID, err := b.contractClient.GetContractCodeID(ctx, contractAddress)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.CodeID = codeID
+
+	version, err := b.contractClient.GetContractVersion(ctx)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Name = version.Contract
+	info.Version = version.Version
+	info.Compatibility = GetContractCompatibility(version.Contract, version.Version)
+
+	return info
+}
+
+func (b *BridgeClient) getCoreumVersionInfo(ctx context.Context) CoreumVersionInfo {
+	nodeInfo, err := b.contractClient.GetNodeInfo(ctx)
+	if err != nil {
+		return CoreumVersionInfo{
+			Error: err.Error(),
+		}
+	}
+
+	return CoreumVersionInfo{
+		ChainID:     nodeInfo.ChainID,
+		NodeVersion: nodeInfo.Version,
+	}
+}
+
+func (b *BridgeClient) getXRPLVersionInfo(ctx context.Context) XRPLVersionInfo {
+	serverState, err := b.xrplRPCClient.ServerState(ctx)
+	if err != nil {
+		return XRPLVersionInfo{
+			Error: err.Error(),
+		}
+	}
+
+	return XRPLVersionInfo{
+		BuildVersion: serverState.State.BuildVersion,
+		NetworkID:    serverState.State.NetworkID,
+	}
+}
@@ -0,0 +1,219 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	coreumclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestGetContractCompatibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cName   string
+		version string
+		want    client.ContractCompatibility
+	}{
+		{
+			name:    "supported",
+			cName:   client.ContractName,
+			version: client.SupportedContractVersions[len(client.SupportedContractVersions)-1],
+			want:    client.ContractCompatibilitySupported,
+		},
+		{
+			name:    "too_old",
+			cName:   client.ContractName,
+			version: "0.0.1",
+			want:    client.ContractCompatibilityTooOld,
+		},
+		{
+			name:    "unknown_newer_version",
+			cName:   client.ContractName,
+			version: "99.0.0",
+			want:    client.ContractCompatibilityUnknown,
+		},
+		{
+			name:    "unknown_not_released_version",
+			cName:   client.ContractName,
+			version: "1.0.5",
+			want:    client.ContractCompatibilityUnknown,
+		},
+		{
+			name:    "unknown_invalid_version",
+			cName:   client.ContractName,
+			version: "latest",
+			want:    client.ContractCompatibilityUnknown,
+		},
+		{
+			name:    "unknown_contract_name",
+			cName:   "cw20-base",
+			version: client.SupportedContractVersions[0],
+			want:    client.ContractCompatibilityUnknown,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, client.GetContractCompatibility(tt.cName, tt.version))
+		})
+	}
+}
+
+func TestGetVersionInfo(t *testing.T) {
+	t.Parallel()
+
+	contractAddress := coreum.GenAccount()
+	contractVersion := coreum.ContractVersion{
+		Contract: client.ContractName,
+		Version:  client.SupportedContractVersions[0],
+	}
+	nodeInfo := coreum.NodeInfo{
+		ChainID: "coreum-devnet-1",
+		Version: "v4.0.0",
+	}
+	serverState := xrpl.ServerState{
+		BuildVersion: "2.0.0",
+		NetworkID:    1,
+	}
+
+	tests := []struct {
+		name           string
+		contractClient *fakeVersionContractClient
+		xrplRPCClient  *fakeVersionXRPLRPCClient
+		want           client.VersionInfo
+	}{
+		{
+			name: "all_reachable",
+			contractClient: &fakeVersionContractClient{
+				contractAddress: contractAddress,
+				codeID:          7,
+				contractVersion: contractVersion,
+				nodeInfo:        nodeInfo,
+			},
+			xrplRPCClient: &fakeVersionXRPLRPCClient{
+				serverState: serverState,
+			},
+			want: client.VersionInfo{
+				Contract: client.ContractVersionInfo{
+					Address:       contractAddress.String(),
+					Name:          contractVersion.Contract,
+					Version:       contractVersion.Version,
+					CodeID:        7,
+					Compatibility: client.ContractCompatibilitySupported,
+				},
+				Coreum: client.CoreumVersionInfo{
+					ChainID:     nodeInfo.ChainID,
+					NodeVersion: nodeInfo.Version,
+				},
+				XRPL: client.XRPLVersionInfo{
+					BuildVersion: serverState.BuildVersion,
+					NetworkID:    serverState.NetworkID,
+				},
+			},
+		},
+		{
+			name: "contract_address_not_configured",
+			contractClient: &fakeVersionContractClient{
+				nodeInfo: nodeInfo,
+			},
+			xrplRPCClient: &fakeVersionXRPLRPCClient{
+				serverState: serverState,
+			},
+			want: client.VersionInfo{
+				Contract: client.ContractVersionInfo{
+					Compatibility: client.ContractCompatibilityUnknown,
+					Error:         "contract address is not configured",
+				},
+				Coreum: client.CoreumVersionInfo{
+					ChainID:     nodeInfo.ChainID,
+					NodeVersion: nodeInfo.Version,
+				},
+				XRPL: client.XRPLVersionInfo{
+					BuildVersion: serverState.BuildVersion,
+					NetworkID:    serverState.NetworkID,
+				},
+			},
+		},
+		{
+			name: "all_unreachable",
+			contractClient: &fakeVersionContractClient{
+				contractAddress: contractAddress,
+				err:             errors.New("coreum is unreachable"),
+			},
+			xrplRPCClient: &fakeVersionXRPLRPCClient{
+				err: errors.New("xrpl is unreachable"),
+			},
+			want: client.VersionInfo{
+				Contract: client.ContractVersionInfo{
+					Address:       contractAddress.String(),
+					Compatibility: client.ContractCompatibilityUnknown,
+					Error:         "coreum is unreachable",
+				},
+				Coreum: client.CoreumVersionInfo{
+					Error: "coreum is unreachable",
+				},
+				XRPL: client.XRPLVersionInfo{
+					Error: "xrpl is unreachable",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bridgeClient := client.NewBridgeClient(
+				newTestLogger(t), coreumclient.Context{}, tt.contractClient, tt.xrplRPCClient, nil,
+			)
+			require.Equal(t, tt.want, bridgeClient.GetVersionInfo(context.Background()))
+		})
+	}
+}
+
+type fakeVersionContractClient struct {
+	client.ContractClient
+
+	contractAddress sdk.AccAddress
+	codeID          uint64
+	contractVersion coreum.ContractVersion
+	nodeInfo        coreum.NodeInfo
+	err             error
+}
+
+func (c *fakeVersionContractClient) GetContractAddress() sdk.AccAddress {
+	return c.contractAddress
+}
+
+func (c *fakeVersionContractClient) GetContractCodeID(_ context.Context, _ sdk.AccAddress) (uint64, error) {
+	return c.codeID, c.err
+}
+
+func (c *fakeVersionContractClient) GetContractVersion(_ context.Context) (coreum.ContractVersion, error) {
+	return c.contractVersion, c.err
+}
+
+func (c *fakeVersionContractClient) GetNodeInfo(_ context.Context) (coreum.NodeInfo, error) {
+	return c.nodeInfo, c.err
+}
+
+type fakeVersionXRPLRPCClient struct {
+	client.XRPLRPCClient
+
+	serverState xrpl.ServerState
+	err         error
+}
+
+func (c *fakeVersionXRPLRPCClient) ServerState(_ context.Context) (xrpl.ServerStateResult, error) {
+	return xrpl.ServerStateResult{State: c.serverState}, c.err
+}
@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	coreumchainconfig "github.com/CoreumFoundation/coreum/v4/pkg/config"
+	coreumchainconstant "github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const (
+	defaultBootstrapTemplateRelayersCount = 3
+	defaultBootstrapTemplateXRPLNetwork   = "testnet"
+	bootstrapScriptRelayerBinary          = "coreumbridge-xrpl-relayer"
+)
+
+// xrplNetworkRPCURLs are the public XRPL RPC URLs used by the generated bootstrapping script.
+var xrplNetworkRPCURLs = map[string]string{
+	"mainnet": "https://s1.ripple.com:51234/",
+	"testnet": "https://s.altnet.rippletest.net:51234/",
+	"devnet":  "https://s.devnet.rippletest.net:51234/",
+}
+
+type bootstrapTemplateParams struct {
+	RelayersCount               int
+	XRPLNetwork                 string
+	CoreumChainID               string
+	TrustSetLimitAmount         string
+	UsedTicketSequenceThreshold uint32
+	XRPLBaseFee                 uint32
+}
+
+// GenerateBootstrapConfigCmd interactively generates the bootstrapping config template and the script deploying
+// the bridge with it.
+func GenerateBootstrapConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-bootstrap-config [config-path]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Interactively generates the bootstrapping config and the bridge deployment script.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Interactively generates the bootstrapping config and the bridge deployment script.
+Every field of the generated config is explained by the comment above it. The owner, admin, relayers and
+contract bytecode path must be filled in before the script is executed. The script is written next to the
+config with the ".sh" extension if the --%s flag is not provided.
+Example:
+$ generate-bootstrap-config bootstrapping.yaml --%s deploy-bridge.sh
+`, FlagScriptOutput, FlagScriptOutput)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log, err := GetCLILogger()
+			if err != nil {
+				return err
+			}
+
+			configPath := args[0]
+			scriptPath, err := cmd.Flags().GetString(FlagScriptOutput)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get %s", FlagScriptOutput)
+			}
+			if scriptPath == "" {
+				scriptPath = strings.TrimSuffix(configPath, filepath.Ext(configPath)) + ".sh"
+			}
+
+			params, err := promptBootstrapTemplateParams(bufio.NewScanner(cmd.InOrStdin()), cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+
+			cfg := buildBootstrapTemplateConfig(params)
+			cfgData, err := bridgeclient.EncodeBootstrappingConfigTemplate(cfg)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(configPath, cfgData, 0o600); err != nil {
+				return errors.Wrapf(err, "failed to write file, path:%s", configPath)
+			}
+
+			absConfigPath, err := filepath.Abs(configPath)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get absolute path, path:%s", configPath)
+			}
+			//nolint:gosec // the script must be executable
+			if err := os.WriteFile(scriptPath, []byte(buildBootstrapScript(params, absConfigPath)), 0o700); err != nil {
+				return errors.Wrapf(err, "failed to write file, path:%s", scriptPath)
+			}
+
+			log.Info(
+				ctx,
+				"Bootstrapping config and script are generated, fill in the config before running the script.",
+				zap.String("config", configPath),
+				zap.String("script", scriptPath),
+			)
+
+			return nil
+		},
+	}
+	cmd.PersistentFlags().String(FlagScriptOutput, "", "Bridge deployment script output file path")
+
+	return cmd
+}
+
+func promptBootstrapTemplateParams(input *bufio.Scanner, output io.Writer) (bootstrapTemplateParams, error) {
+	defaultCfg := bridgeclient.DefaultBootstrappingConfig()
+	networks := lo.Keys(xrplNetworkRPCURLs)
+	sort.Strings(networks)
+
+	relayersCount, err := promptBootstrapTemplateUint(
+		input, output, "Number of relayers", defaultBootstrapTemplateRelayersCount,
+	)
+	if err != nil {
+		return bootstrapTemplateParams{}, err
+	}
+	if relayersCount == 0 || relayersCount > xrpl.MaxAllowedXRPLSigners {
+		return bootstrapTemplateParams{}, errors.Errorf(
+			"number of relayers must be between 1 and %d, got:%d", xrpl.MaxAllowedXRPLSigners, relayersCount,
+		)
+	}
+
+	xrplNetwork := promptBootstrapTemplateValue(
+		input, output, fmt.Sprintf("XRPL network (%s)", strings.Join(networks, "/")),
+		defaultBootstrapTemplateXRPLNetwork,
+	)
+	if _, ok := xrplNetworkRPCURLs[xrplNetwork]; !ok {
+		return bootstrapTemplateParams{}, errors.Errorf(
+			"invalid XRPL network %q, expected one of: %s", xrplNetwork, strings.Join(networks, ", "),
+		)
+	}
+
+	coreumChainID := promptBootstrapTemplateValue(
+		input, output, "Coreum chain ID", string(runner.DefaultCoreumChainID),
+	)
+	if _, err := coreumchainconfig.NetworkConfigByChainID(coreumchainconstant.ChainID(coreumChainID)); err != nil {
+		return bootstrapTemplateParams{}, errors.Wrapf(err, "invalid Coreum chain ID %q", coreumChainID)
+	}
+
+	trustSetLimitAmount := promptBootstrapTemplateValue(
+		input, output, "Initial trust set limit amount", defaultCfg.TrustSetLimitAmount,
+	)
+	trustSetLimit, ok := sdkmath.NewIntFromString(trustSetLimitAmount)
+	if !ok || !trustSetLimit.IsPositive() {
+		return bootstrapTemplateParams{}, errors.Errorf(
+			"trust set limit amount must be a positive integer, got:%s", trustSetLimitAmount,
+		)
+	}
+
+	usedTicketSequenceThreshold, err := promptBootstrapTemplateUint(
+		input, output, "Used ticket sequence threshold", defaultCfg.UsedTicketSequenceThreshold,
+	)
+	if err != nil {
+		return bootstrapTemplateParams{}, err
+	}
+	if usedTicketSequenceThreshold == 0 {
+		return bootstrapTemplateParams{}, errors.New("used ticket sequence threshold must be positive")
+	}
+
+	xrplBaseFee, err := promptBootstrapTemplateUint(input, output, "XRPL base fee", defaultCfg.XRPLBaseFee)
+	if err != nil {
+		return bootstrapTemplateParams{}, err
+	}
+	if xrplBaseFee == 0 {
+		return bootstrapTemplateParams{}, errors.New("XRPL base fee must be positive")
+	}
+
+	return bootstrapTemplateParams{
+		RelayersCount:               int(relayersCount),
+		XRPLNetwork:                 xrplNetwork,
+		CoreumChainID:               coreumChainID,
+		TrustSetLimitAmount:         trustSetLimit.String(),
+		UsedTicketSequenceThreshold: usedTicketSequenceThreshold,
+		XRPLBaseFee:                 xrplBaseFee,
+	}, nil
+}
+
+// promptBootstrapTemplateValue asks for the value and returns the default one if the answer is empty or the input
+// is closed.
+func promptBootstrapTemplateValue(input *bufio.Scanner, output io.Writer, question, defaultValue string) string {
+	fmt.Fprintf(output, "%s [%s]: ", question, defaultValue)
+	if !input.Scan() {
+		fmt.Fprintln(output)
+		return defaultValue
+	}
+	answer := strings.TrimSpace(input.Text())
+	if answer == "" {
+		return defaultValue
+	}
+
+	return answer
+}
+
+func promptBootstrapTemplateUint(
+	input *bufio.Scanner,
+	output io.Writer,
+	question string,
+	defaultValue uint32,
+) (uint32, error) {
+	answer := promptBootstrapTemplateValue(input, output, question, strconv.FormatUint(uint64(defaultValue), 10))
+	value, err := strconv.ParseUint(answer, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s %q", strings.ToLower(question), answer)
+	}
+
+	return uint32(value), nil
+}
+
+func buildBootstrapTemplateConfig(params bootstrapTemplateParams) bridgeclient.BootstrappingConfig {
+	cfg := bridgeclient.DefaultBootstrappingConfig()
+	cfg.Relayers = make([]bridgeclient.RelayerConfig, params.RelayersCount)
+	// the two-thirds majority of the relayers
+	cfg.EvidenceThreshold = uint32(params.RelayersCount*2/3 + 1)
+	if cfg.EvidenceThreshold > uint32(params.RelayersCount) {
+		cfg.EvidenceThreshold = uint32(params.RelayersCount)
+	}
+	cfg.TrustSetLimitAmount = params.TrustSetLimitAmount
+	cfg.UsedTicketSequenceThreshold = params.UsedTicketSequenceThreshold
+	cfg.XRPLBaseFee = params.XRPLBaseFee
+
+	return cfg
+}
+
+func buildBootstrapScript(params bootstrapTemplateParams, configPath string) string {
+	return fmt.Sprintf(`#!/usr/bin/env bash
+# Sets up the XRPL %[1]s bridge account and deploys the bridge contract to the %[2]s chain.
+# Fill in the owner, admin, relayers and contract_bytecode_path in the %[3]s before running the script.
+set -euo pipefail
+
+RELAYER_BINARY="${RELAYER_BINARY:-%[4]s}"
+RELAYER_HOME="${RELAYER_HOME:-%[5]s}"
+KEY_NAME="${KEY_NAME:-bridge-account}"
+COREUM_GRPC_URL="${COREUM_GRPC_URL:?COREUM_GRPC_URL must be set}"
+
+if [ ! -f "${RELAYER_HOME}/%[6]s" ]; then
+  "${RELAYER_BINARY}" init --%[7]s "${RELAYER_HOME}" \
+    --%[8]s "%[2]s" \
+    --%[9]s "${COREUM_GRPC_URL}" \
+    --%[10]s "%[11]s"
+fi
+
+"${RELAYER_BINARY}" bootstrap-bridge "%[3]s" --%[12]s "${KEY_NAME}" --%[7]s "${RELAYER_HOME}"
+`,
+		params.XRPLNetwork,
+		params.CoreumChainID,
+		configPath,
+		bootstrapScriptRelayerBinary,
+		manifestDefaultHomeDir,
+		runner.ConfigFileName,
+		FlagHome,
+		FlagCoreumChainID,
+		FlagCoreumGRPCURL,
+		FlagXRPLRPCURL,
+		xrplNetworkRPCURLs[params.XRPLNetwork],
+		FlagKeyName,
+	)
+}
@@ -3,10 +3,15 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -17,14 +22,19 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
 
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/api"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/buildinfo"
 	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli/cosmos/keys"
 	overridekeyring "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli/cosmos/override/crypto/keyring"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
 //go:generate mockgen -destination=cli_mocks_test.go -package=cli_test . BridgeClient,Runner
@@ -35,7 +45,7 @@ func init() {
 		panic(err)
 	}
 
-	DefaultHomeDir = filepath.Join(userHomeDir, ".coreumbridge-xrpl-relayer")
+	DefaultHomeDir = filepath.Join(userHomeDir, defaultHomeDirName)
 }
 
 // DefaultHomeDir is default home for the relayer.
@@ -44,6 +54,10 @@ var DefaultHomeDir string
 const (
 	sampleAmount = "100ucore"
 
+	defaultHomeDirName = ".coreumbridge-xrpl-relayer"
+	// manifestDefaultHomeDir is the machine independent representation of the DefaultHomeDir in the manifest.
+	manifestDefaultHomeDir = "$HOME/" + defaultHomeDirName
+
 	// TxCLIUse is cobra Use tx group name.
 	TxCLIUse = "tx"
 	// QueryCLIUse is cobra Use query group name.
@@ -88,6 +102,16 @@ const (
 	FlagRefundID = "refund-id"
 	// FlagMaxHoldingAmount is max holding amount flag.
 	FlagMaxHoldingAmount = "max-holding-amount"
+	// FlagMaxSendsPerAddressPerDay is max sends per address per day flag.
+	FlagMaxSendsPerAddressPerDay = "max-sends-per-address-per-day"
+	// FlagTreasuryFee is treasury fee flag.
+	FlagTreasuryFee = "treasury-fee"
+	// FlagDeliveryMode is XRPL token delivery mode flag.
+	FlagDeliveryMode = "delivery-mode"
+	// FlagIBCChannelID is IBC channel ID flag.
+	FlagIBCChannelID = "ibc-channel-id"
+	// FlagIBCTransferTimeout is IBC transfer timeout flag.
+	FlagIBCTransferTimeout = "ibc-transfer-timeout"
 	// FlagDeliverAmount is deliver amount flag.
 	FlagDeliverAmount = "deliver-amount"
 	// FlagTicketsToAllocate is tickets to allocate flag.
@@ -100,6 +124,121 @@ const (
 	FlagProhibitedXRPLAddress = "prohibited-xrpl-address"
 	// FlagFromOwner from owner flag.
 	FlagFromOwner = "from-owner"
+	// FlagTicketSequence is ticket sequence flag.
+	FlagTicketSequence = "ticket-sequence"
+	// FlagTxHash is tx hash flag.
+	FlagTxHash = "tx-hash"
+	// FlagResult is transaction result flag.
+	FlagResult = "result"
+	// FlagAdminOverride is admin override flag.
+	FlagAdminOverride = "admin-override"
+	// FlagInput is input file flag.
+	FlagInput = "input"
+	// FlagOutput is output file or output format flag.
+	FlagOutput = "output"
+	// FlagState is state flag.
+	FlagState = "state"
+	// FlagStartHeight is start height flag.
+	FlagStartHeight = "start-height"
+	// FlagEndHeight is end height flag.
+	FlagEndHeight = "end-height"
+	// FlagDenom is denom flag.
+	FlagDenom = "denom"
+	// FlagDirection is transfer direction flag.
+	FlagDirection = "direction"
+	// FlagRecipient is recipient flag.
+	FlagRecipient = "recipient"
+	// FlagRestart is restart flag.
+	FlagRestart = "restart"
+	// FlagNewOwner is new contract owner flag.
+	FlagNewOwner = "new-owner"
+	// FlagBatchSize is batch size flag.
+	FlagBatchSize = "batch-size"
+	// FlagDecimals is token decimals flag.
+	FlagDecimals = "decimals"
+	// FlagRecentBlocks is the number of the latest blocks flag.
+	FlagRecentBlocks = "recent-blocks"
+	// FlagTarget is the keyring target flag.
+	FlagTarget = "target"
+	// FlagScriptOutput is the generated script output file flag.
+	FlagScriptOutput = "script-output"
+	// FlagDemo is demo mode flag.
+	FlagDemo = "demo"
+	// FlagCoreumFaucetURL is Coreum faucet URL flag.
+	FlagCoreumFaucetURL = "coreum-faucet-url"
+	// FlagXRPLFaucetURL is XRPL faucet URL flag.
+	FlagXRPLFaucetURL = "xrpl-faucet-url"
+	// FlagFull is full version info flag.
+	FlagFull = "full"
+	// FlagWaitTimeout is the timeout of waiting for the result flag.
+	FlagWaitTimeout = "wait-timeout"
+	// FlagAddress is the sender or recipient address flag.
+	FlagAddress = "address"
+	// FlagSince is the min time flag.
+	FlagSince = "since"
+	// FlagFormat is the export format flag.
+	FlagFormat = "format"
+	// FlagRelayersConfig is the relayers config file path flag.
+	FlagRelayersConfig = "relayers-config"
+	// FlagQuorum is the signer list quorum flag.
+	FlagQuorum = "quorum"
+	// FlagSequence is the XRPL account sequence flag.
+	FlagSequence = "sequence"
+	// FlagPubKey is the hex encoded public key flag.
+	FlagPubKey = "pubkey"
+	// FlagRefundAddress is the address which pending refunds are exported flag.
+	FlagRefundAddress = "refund-address"
+	// FlagForce is the flag to proceed despite the detected conflicts.
+	FlagForce = "force"
+	// FlagFromHeight is the inclusive start Coreum height flag.
+	FlagFromHeight = "from-height"
+	// FlagToHeight is the inclusive end Coreum height flag.
+	FlagToHeight = "to-height"
+	// FlagXRPLFromLedger is the inclusive start XRPL ledger flag.
+	FlagXRPLFromLedger = "xrpl-from-ledger"
+	// FlagXRPLToLedger is the inclusive end XRPL ledger flag.
+	FlagXRPLToLedger = "xrpl-to-ledger"
+	// FlagCheckpointFile is the progress checkpoint file path flag.
+	FlagCheckpointFile = "checkpoint-file"
+	// FlagSkipIssuerCheck is the flag to skip the XRPL issuer account activation check.
+	FlagSkipIssuerCheck = "skip-issuer-check"
+	// FlagCoreumRPCURL is the Coreum RPC URL flag.
+	FlagCoreumRPCURL = "coreum-rpc-url"
+	// FlagXRPLRecentScanWindow is the XRPL scanner recent scan window flag.
+	FlagXRPLRecentScanWindow = "xrpl-recent-scan-window"
+	// FlagOverwrite is the flag to overwrite the existing config.
+	FlagOverwrite = "overwrite"
+	// FlagWindow is the time window flag.
+	FlagWindow = "window"
+	// FlagReason is the reason of the owner action flag.
+	FlagReason = "reason"
+	// FlagAcceptNewBridge is the flag to accept the bridge different from the one recorded in the relayer home.
+	FlagAcceptNewBridge = "accept-new-bridge"
+	// FlagLogFormat is the relayer log format flag overriding the config.
+	FlagLogFormat = "log-format"
+	// FlagIssuer is the XRPL token issuer flag.
+	FlagIssuer = "issuer"
+	// FlagCurrency is the XRPL token currency flag.
+	FlagCurrency = "currency"
+	// FlagOlderThan is the age of the pending operation after which it's considered stuck.
+	FlagOlderThan = "older-than"
+	// FlagOperationID is the pending operation ID flag.
+	FlagOperationID = "operation-id"
+	// FlagAMMRouting is the flag to route the payment through the token/XRP AMM pool if the bridge can't receive the
+	// token.
+	FlagAMMRouting = "amm-routing"
+)
+
+// Relayer log formats.
+const (
+	LogFormatJSON    = "json"
+	LogFormatConsole = "console"
+)
+
+// Transfer history export formats.
+const (
+	TransferHistoryFormatCSV  = "csv"
+	TransferHistoryFormatJSON = "json"
 )
 
 // BridgeClient is bridge client used to interact with the chains and contract.
@@ -110,14 +249,20 @@ type BridgeClient interface {
 		senderAddress sdk.AccAddress,
 		bridgeAccountKeyName string,
 		cfg bridgeclient.BootstrappingConfig,
+		progressFilePath string,
 	) (sdk.AccAddress, error)
 	GetContractConfig(ctx context.Context) (coreum.ContractConfig, error)
 	GetContractOwnership(ctx context.Context) (coreum.ContractOwnership, error)
+	GetCoreumAccountInfo(ctx context.Context, address sdk.AccAddress) (bridgeclient.CoreumAccountInfo, error)
+	TransferOwnership(ctx context.Context, sender, newOwner sdk.AccAddress) error
+	AcceptOwnership(ctx context.Context, sender sdk.AccAddress) error
 	RecoverTickets(
 		ctx context.Context,
 		ownerAddress sdk.AccAddress,
 		ticketsToAllocate *uint32,
 	) error
+	ValidateCoreumDenomSupply(ctx context.Context, denom string) error
+	GetCoreumDenomDecimals(ctx context.Context, denom string) (uint32, error)
 	RegisterCoreumToken(
 		ctx context.Context,
 		ownerAddress sdk.AccAddress,
@@ -126,6 +271,7 @@ type BridgeClient interface {
 		sendingPrecision int32,
 		maxHoldingAmount sdkmath.Int,
 		bridgingFee sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
 	) (coreum.CoreumToken, error)
 	RegisterXRPLToken(
 		ctx context.Context,
@@ -134,8 +280,34 @@ type BridgeClient interface {
 		sendingPrecision int32,
 		maxHoldingAmount sdkmath.Int,
 		bridgingFee sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		deliveryMode *coreum.XRPLTokenDeliveryMode,
 	) (coreum.XRPLToken, error)
+	CheckXRPLIssuerAccount(ctx context.Context, issuer rippledata.Account) error
+	RegisterXRPLTokenBatch(
+		ctx context.Context,
+		ownerAddress sdk.AccAddress,
+		requests []coreum.XRPLTokenRegistrationRequest,
+		batchSize int,
+	) ([]coreum.XRPLToken, error)
 	GetAllTokens(ctx context.Context) ([]coreum.CoreumToken, []coreum.XRPLToken, error)
+	GetTokenPairs(ctx context.Context) ([]bridgeclient.TokenPair, error)
+	GetVersionInfo(ctx context.Context) bridgeclient.VersionInfo
+	ExportTokenRegistry(ctx context.Context) (bridgeclient.TokenRegistry, error)
+	ImportTokenRegistry(
+		ctx context.Context,
+		owner sdk.AccAddress,
+		registry bridgeclient.TokenRegistry,
+		dryRun bool,
+	) ([]bridgeclient.TokenImportResult, error)
+	ExportSnapshot(ctx context.Context, refundAddresses []sdk.AccAddress) (bridgeclient.BridgeSnapshot, error)
+	Replay(ctx context.Context, cfg bridgeclient.ReplayConfig) (bridgeclient.ReplaySummary, error)
+	ImportSnapshot(
+		ctx context.Context,
+		owner sdk.AccAddress,
+		snapshot bridgeclient.BridgeSnapshot,
+		force bool,
+	) (bridgeclient.SnapshotImportResult, error)
 	SendFromCoreumToXRPL(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -143,12 +315,28 @@ type BridgeClient interface {
 		amount sdk.Coin,
 		deliverAmount *sdkmath.Int,
 	) (string, error)
+	SendBatchToXRPL(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		legs []bridgeclient.SendLeg,
+	) (bridgeclient.BatchReport, error)
+	WaitForBatch(
+		ctx context.Context,
+		report bridgeclient.BatchReport,
+		timeout time.Duration,
+	) (bridgeclient.BatchReport, error)
 	SendFromXRPLToCoreum(
 		ctx context.Context,
 		senderKeyName string,
 		amount rippledata.Amount,
 		recipient sdk.AccAddress,
 	) (string, error)
+	SendFromXRPLToCoreumWithAMMRouting(
+		ctx context.Context,
+		senderKeyName string,
+		amount rippledata.Amount,
+		recipient sdk.AccAddress,
+	) (bridgeclient.AMMRoutedXRPLToCoreumSend, error)
 	SetXRPLTrustSet(
 		ctx context.Context,
 		senderKeyName string,
@@ -162,6 +350,8 @@ type BridgeClient interface {
 		sendingPrecision *int32,
 		maxHoldingAmount *sdkmath.Int,
 		bridgingFee *sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		treasuryFee *sdkmath.Int,
 	) error
 	UpdateXRPLToken(
 		ctx context.Context,
@@ -171,7 +361,22 @@ type BridgeClient interface {
 		sendingPrecision *int32,
 		maxHoldingAmount *sdkmath.Int,
 		bridgingFee *sdkmath.Int,
+		maxSendsPerAddressPerDay *uint32,
+		treasuryFee *sdkmath.Int,
 	) error
+	EmergencyDisableToken(
+		ctx context.Context,
+		owner sdk.AccAddress,
+		tokenRef bridgeclient.TokenRef,
+		reason string,
+		waitTimeout time.Duration,
+	) (bridgeclient.EmergencyTokenReport, error)
+	EmergencyEnableToken(
+		ctx context.Context,
+		owner sdk.AccAddress,
+		tokenRef bridgeclient.TokenRef,
+		reason string,
+	) (bridgeclient.EmergencyTokenReport, error)
 	RotateKeys(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -184,19 +389,57 @@ type BridgeClient interface {
 	) error
 	GetCoreumBalances(ctx context.Context, address sdk.AccAddress) (sdk.Coins, error)
 	GetXRPLBalances(ctx context.Context, acc rippledata.Account) ([]rippledata.Amount, error)
+	VerifyXRPLBridgeAccount(ctx context.Context) ([]xrpl.BridgeAccountMisconfiguration, error)
+	GetXRPLAccountReserves(ctx context.Context, account rippledata.Account) (xrpl.AccountReserves, error)
+	GetXRPLTicketsReserve(
+		ctx context.Context,
+		account rippledata.Account,
+		numberOfTickets uint32,
+	) (bridgeclient.XRPLTicketsReserve, error)
+	GetUnsignedPendingOperations(
+		ctx context.Context,
+		relayerAddress sdk.AccAddress,
+	) (processes.UnsignedOperations, error)
+	SaveOperationSignatures(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		signatures processes.OperationSignatures,
+	) error
 	GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error)
 	ClaimRefund(ctx context.Context, address sdk.AccAddress, pendingRefundID string) error
 	GetFeesCollected(ctx context.Context, address sdk.Address) (sdk.Coins, error)
+	SweepRelayerFees(ctx context.Context, relayerAddresses []sdk.AccAddress) (sdk.Coins, error)
 	ClaimRelayerFees(
 		ctx context.Context,
 		sender sdk.AccAddress,
 		amounts sdk.Coins,
 	) error
+	ClaimTreasuryFees(ctx context.Context, sender sdk.AccAddress) error
 	RecoverXRPLTokenRegistration(
 		ctx context.Context,
 		sender sdk.AccAddress,
 		issuer, currency string,
 	) error
+	ListStuckTokenRegistrations(
+		ctx context.Context,
+		olderThan time.Duration,
+	) ([]bridgeclient.StuckTokenRegistration, error)
+	RecoverStuckXRPLTokenRegistration(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		issuer, currency string,
+		olderThan time.Duration,
+	) error
+	MigrateXRPLToken(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		oldIssuer, oldCurrency, newIssuer, newCurrency string,
+	) error
+	RemoveMigratedXRPLToken(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		issuer, currency string,
+	) error
 	HaltBridge(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -212,7 +455,21 @@ type BridgeClient interface {
 		sender sdk.AccAddress,
 		operationID uint32,
 	) error
+	ForceCompleteOperation(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		ticketSequence uint32,
+		txHash string,
+		result coreum.TransactionResult,
+	) error
 	GetPendingOperations(ctx context.Context) ([]coreum.Operation, error)
+	GetSignatureProgress(ctx context.Context, ticketSequence uint32) (coreum.SignatureProgress, error)
+	GetAvailableTickets(ctx context.Context) ([]uint32, error)
+	GetTokenStateChangeReport(
+		ctx context.Context,
+		denom, issuer, currency string,
+		recentBlocks int64,
+	) (bridgeclient.TokenStateChangeReport, error)
 	GetTransactionEvidences(ctx context.Context) ([]coreum.TransactionEvidence, error)
 	DeployContract(
 		ctx context.Context,
@@ -224,6 +481,7 @@ type BridgeClient interface {
 		ctx context.Context,
 		coreumTxHash string,
 	) (bridgeclient.CoreumToXRPLTracingInfo, error)
+	GetTransferHistory(ctx context.Context, filter bridgeclient.HistoryFilter) ([]bridgeclient.TransferEvent, error)
 }
 
 // BridgeClientProvider is function which returns the BridgeClient from the input cmd.
@@ -243,11 +501,23 @@ func NewRunnerFromHome(cmd *cobra.Command) (*runner.Runner, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the flag is defined by the start cmd only
+	if cmd.Flags().Lookup(FlagLogFormat) != nil {
+		logFormat, err := cmd.Flags().GetString(FlagLogFormat)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read flag %s", FlagLogFormat)
+		}
+		if logFormat != "" {
+			if logFormat != LogFormatJSON && logFormat != LogFormatConsole {
+				return nil, errors.Errorf(
+					"invalid --%s %q, expected %s or %s", FlagLogFormat, logFormat, LogFormatJSON, LogFormatConsole,
+				)
+			}
+			cfg.LoggingConfig.Format = logFormat
+		}
+	}
 
-	logCfg := logger.DefaultZapLoggerConfig()
-	logCfg.Level = cfg.LoggingConfig.Level
-	logCfg.Format = cfg.LoggingConfig.Format
-	zapLogger, err := logger.NewZapLogger(logCfg)
+	zapLogger, err := newConfigLogger(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -257,30 +527,125 @@ func NewRunnerFromHome(cmd *cobra.Command) (*runner.Runner, error) {
 		return nil, err
 	}
 
+	operationAgeStoreFilePath, err := getOperationAgeStoreFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.CoreumToXRPLProcess.OperationAgeStoreFilePath = operationAgeStoreFilePath
+	signatureAggregationStoreFilePath, err := getSignatureAggregationStoreFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.CoreumToXRPLProcess.SignatureAggregationStoreFilePath = signatureAggregationStoreFilePath
+	xrplTxResultStoreFilePath, err := getXRPLTxResultStoreFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.CoreumToXRPLProcess.TxResultStoreFilePath = xrplTxResultStoreFilePath
+	blockedDeliveriesStoreFilePath, err := getBlockedDeliveriesStoreFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.XRPLToCoreumProcess.BlockedDeliveriesStoreFilePath = blockedDeliveriesStoreFilePath
+	evidenceDeadLetterLogFilePath, err := getEvidenceDeadLetterLogFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.XRPLToCoreumProcess.EvidenceDeadLetterLogFilePath = evidenceDeadLetterLogFilePath
+	transferLatencyStoreFilePath, err := getTransferLatencyStoreFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.TransferLatency.StoreFilePath = transferLatencyStoreFilePath
+	transferHistoryFilePath, err := getTransferHistoryFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.TransferHistory.FilePath = transferHistoryFilePath
+	ticketUsageFilePath, err := getTicketUsageFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Processes.TicketUsageFilePath = ticketUsageFilePath
+	transferWebhookDeadLetterLogFilePath, err := getTransferWebhookDeadLetterLogFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Notifications.DeadLetterLogFilePath = transferWebhookDeadLetterLogFilePath
+	xrplScannerCheckpointFilePath, err := getXRPLScannerCheckpointFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.XRPL.Scanner.CheckpointFilePath = xrplScannerCheckpointFilePath
+	bridgeIdentityFilePath, err := getBridgeIdentityFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg.BridgeIdentityFilePath = bridgeIdentityFilePath
+	// the flag is defined by the start cmd only
+	if cmd.Flags().Lookup(FlagAcceptNewBridge) != nil {
+		if cfg.AcceptNewBridge, err = cmd.Flags().GetBool(FlagAcceptNewBridge); err != nil {
+			return nil, errors.Wrapf(err, "failed to read flag %s", FlagAcceptNewBridge)
+		}
+	}
+
 	rnr, err := runner.NewRunner(cmd.Context(), components, cfg)
 	if err != nil {
+		if errors.Is(err, runner.ErrBridgeChanged) {
+			return nil, errors.Wrapf(
+				err,
+				"the local state of the recorded bridge must not be used, start with the --%s flag to remove it",
+				FlagAcceptNewBridge,
+			)
+		}
 		return nil, err
 	}
 
 	return rnr, nil
 }
 
+func newConfigLogger(cfg runner.Config) (*logger.ZapLogger, error) {
+	logCfg := logger.DefaultZapLoggerConfig()
+	logCfg.Level = cfg.LoggingConfig.Level
+	logCfg.Format = cfg.LoggingConfig.Format
+	logCfg.Sampling = logger.SamplingConfig{
+		Initial:    cfg.LoggingConfig.Sampling.Initial,
+		Thereafter: cfg.LoggingConfig.Sampling.Thereafter,
+	}
+
+	return logger.NewZapLogger(logCfg)
+}
+
 // NewComponents creates components based on CLI input.
 func NewComponents(cmd *cobra.Command, log logger.Logger) (runner.Components, error) {
 	cfg, err := GetHomeRunnerConfig(cmd)
 	if err != nil {
 		return runner.Components{}, err
 	}
+	cfg, err = applyFeeFlags(cmd, cfg)
+	if err != nil {
+		return runner.Components{}, err
+	}
+	keyUsageAuditLogFilePath, err := getKeyUsageAuditLogFilePath(cmd)
+	if err != nil {
+		return runner.Components{}, err
+	}
+	cfg.KeyUsageAudit.FilePath = keyUsageAuditLogFilePath
+	operationAgeStoreFilePath, err := getOperationAgeStoreFilePath(cmd)
+	if err != nil {
+		return runner.Components{}, err
+	}
+	cfg.Processes.CoreumToXRPLProcess.OperationAgeStoreFilePath = operationAgeStoreFilePath
 
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
 		return runner.Components{}, errors.Wrap(err, "failed to get client context")
 	}
-	xrplClientCtx, err := withKeyring(clientCtx, cmd.Flags(), XRPLKeyringSuffix, log)
+	xrplClientCtx, err := withKeyring(clientCtx, cmd.Flags(), XRPLKeyringSuffix, cfg.XRPL.KeyringBackend, log)
 	if err != nil {
 		return runner.Components{}, errors.Wrap(err, "failed to configure xrpl keyring")
 	}
-	coreumClientCtx, err := withKeyring(clientCtx, cmd.Flags(), CoreumKeyringSuffix, log)
+	coreumClientCtx, err := withKeyring(clientCtx, cmd.Flags(), CoreumKeyringSuffix, cfg.Coreum.KeyringBackend, log)
 	if err != nil {
 		return runner.Components{}, errors.Wrap(err, "failed to configure coreum keyring")
 	}
@@ -298,8 +663,33 @@ func InitCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initializes the relayer home with the default config.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Initializes the relayer home with the default config.
+With the --%s flag the relayer home is initialized for the %s or %s network: the config is generated with the
+network endpoints, the relayer keys are created and funded by the faucets. The existing config and keys are kept, so
+the command can be re-run. The endpoints can be overridden with the flags, e.g. for the znet.
+Each flag can also be set with the %s prefixed environment variable, e.g. %s for the --%s flag. The flag
+takes precedence over the environment variable, and the environment variable over the default. The endpoint URLs and
+the contract address are validated before the config is written. The existing config is kept in the demo mode and
+the init fails otherwise, unless the --%s flag is set.
+Example:
+$ init --%s --%s %s --%s devcore1...
+$ init --%s --%s localhost:9090 --%s http://localhost:8090/api/faucet/v1/fund \
+  --%s http://localhost:5005 --%s "" --%s devcore1...
+$ %s=localhost:9090 %s=http://localhost:5005 init --%s
+`,
+			FlagDemo, constant.ChainIDDev, constant.ChainIDTest,
+			InitEnvPrefix, InitEnvVarName(FlagCoreumGRPCURL), FlagCoreumGRPCURL, FlagOverwrite,
+			FlagDemo, FlagCoreumChainID, constant.ChainIDDev, FlagCoreumContractAddress,
+			FlagDemo, FlagCoreumGRPCURL, FlagCoreumFaucetURL, FlagXRPLRPCURL, FlagXRPLFaucetURL,
+			FlagCoreumContractAddress,
+			InitEnvVarName(FlagCoreumGRPCURL), InitEnvVarName(FlagXRPLRPCURL), FlagOverwrite,
+		)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
+			if err := applyInitEnvVars(cmd); err != nil {
+				return err
+			}
 			home, err := getRelayerHome(cmd)
 			if err != nil {
 				return err
@@ -310,23 +700,51 @@ func InitCmd() *cobra.Command {
 			}
 			log.Info(ctx, "Generating settings", zap.String("home", home))
 
+			overwrite, err := cmd.Flags().GetBool(FlagOverwrite)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagOverwrite)
+			}
+			demo, err := cmd.Flags().GetBool(FlagDemo)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagDemo)
+			}
 			chainID, err := cmd.Flags().GetString(FlagCoreumChainID)
 			if err != nil {
 				return errors.Wrapf(err, "failed to read %s", FlagCoreumChainID)
 			}
+			// the demo relayer is initialized for the devnet by default
+			if demo && !cmd.Flags().Changed(FlagCoreumChainID) {
+				chainID = string(constant.ChainIDDev)
+			}
 			coreumGRPCURL, err := cmd.Flags().GetString(FlagCoreumGRPCURL)
 			if err != nil {
 				return errors.Wrapf(err, "failed to read %s", FlagCoreumGRPCURL)
 			}
+			coreumRPCURL, err := cmd.Flags().GetString(FlagCoreumRPCURL)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagCoreumRPCURL)
+			}
 			coreumContractAddress, err := cmd.Flags().GetString(FlagCoreumContractAddress)
 			if err != nil {
 				return errors.Wrapf(err, "failed to read %s", FlagCoreumContractAddress)
 			}
+			coreumKeyName, err := cmd.Flags().GetString(FlagCoreumKeyName)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagCoreumKeyName)
+			}
 
 			xrplRPCURL, err := cmd.Flags().GetString(FlagXRPLRPCURL)
 			if err != nil {
 				return errors.Wrapf(err, "failed to read %s", FlagXRPLRPCURL)
 			}
+			xrplKeyName, err := cmd.Flags().GetString(FlagXRPLKeyName)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagXRPLKeyName)
+			}
+			xrplRecentScanWindow, err := cmd.Flags().GetInt64(FlagXRPLRecentScanWindow)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", FlagXRPLRecentScanWindow)
+			}
 
 			metricsEnabled, err := cmd.Flags().GetBool(FlagMetricsEnabled)
 			if err != nil {
@@ -338,31 +756,99 @@ func InitCmd() *cobra.Command {
 				return errors.Wrapf(err, "failed to read %s", FlagMetricsListenAddr)
 			}
 
+			var demoNetworkCfg demoNetworkConfig
+			if demo {
+				if coreumContractAddress == "" {
+					return errors.Errorf("the %s is required in the demo mode", FlagCoreumContractAddress)
+				}
+				demoNetworkCfg, err = getDemoNetworkConfig(cmd, chainID)
+				if err != nil {
+					return err
+				}
+				coreumGRPCURL = demoNetworkCfg.CoreumGRPCURL
+				xrplRPCURL = demoNetworkCfg.XRPLRPCURL
+			}
+
 			cfg := runner.DefaultConfig()
 			cfg.Coreum.Network.ChainID = chainID
 			cfg.Coreum.GRPC.URL = coreumGRPCURL
+			cfg.Coreum.RPC.URL = coreumRPCURL
 			cfg.Coreum.Contract.ContractAddress = coreumContractAddress
+			cfg.Coreum.RelayerKeyName = coreumKeyName
 
 			cfg.XRPL.RPC.URL = xrplRPCURL
+			cfg.XRPL.MultiSignerKeyName = xrplKeyName
+			cfg.XRPL.Scanner.RecentScanWindow = xrplRecentScanWindow
 
 			cfg.Metrics.Enabled = metricsEnabled
 			cfg.Metrics.Server.ListenAddress = metricsListenAddr
 
-			if err = runner.InitConfig(home, cfg); err != nil {
+			if err := validateInitConfig(cfg); err != nil {
 				return err
 			}
-			log.Info(ctx, "Settings are generated successfully")
-			return nil
+
+			configPath := runner.BuildFilePath(home)
+			if _, err := os.Stat(configPath); err == nil {
+				switch {
+				case overwrite:
+					log.Info(ctx, "Overwriting existing config", zap.String("path", configPath))
+					if err := os.Remove(configPath); err != nil {
+						return errors.Wrapf(err, "failed to remove existing config %s", configPath)
+					}
+				case !demo:
+					return errors.Errorf("config %s already exists, use --%s to overwrite it", configPath, FlagOverwrite)
+				}
+			} else if !os.IsNotExist(err) {
+				return errors.Wrapf(err, "failed to check config file %s", configPath)
+			}
+
+			if !demo {
+				if err = runner.InitConfig(home, cfg); err != nil {
+					return err
+				}
+				log.Info(ctx, "Settings are generated successfully")
+				return writeInitSummary(cmd.OutOrStdout(), configPath, cfg)
+			}
+
+			if err := initDemoConfig(ctx, log, home, cfg); err != nil {
+				return err
+			}
+			if err := initDemoKeysAndFunds(cmd, log, demoNetworkCfg); err != nil {
+				return err
+			}
+			return writeInitSummary(cmd.OutOrStdout(), configPath, cfg)
 		},
 	}
 
 	addCoreumChainIDFlag(cmd)
 	cmd.PersistentFlags().String(FlagXRPLRPCURL, "", "XRPL RPC address.")
 	cmd.PersistentFlags().String(FlagCoreumGRPCURL, "", "Coreum GRPC address.")
+	cmd.PersistentFlags().String(FlagCoreumRPCURL, "", "Coreum RPC address.")
 	cmd.PersistentFlags().String(FlagCoreumContractAddress, "", "Address of the bridge smart contract.")
-	cmd.PersistentFlags().Bool(FlagMetricsEnabled, false, "Start metric server in relayer.")
-	cmd.PersistentFlags().String(FlagMetricsListenAddr, "localhost:9090", "Address metrics server listens on.")
+	defaultCfg := runner.DefaultConfig()
+	cmd.PersistentFlags().String(FlagCoreumKeyName, defaultCfg.Coreum.RelayerKeyName, "Coreum relayer key name.")
+	cmd.PersistentFlags().String(FlagXRPLKeyName, defaultCfg.XRPL.MultiSignerKeyName, "XRPL multi-signer key name.")
+	cmd.PersistentFlags().Int64(
+		FlagXRPLRecentScanWindow,
+		defaultCfg.XRPL.Scanner.RecentScanWindow,
+		"Number of the latest XRPL ledgers the recent history scanner starts from.",
+	)
+	cmd.PersistentFlags().Bool(FlagMetricsEnabled, defaultCfg.Metrics.Enabled, "Start metric server in relayer.")
+	cmd.PersistentFlags().String(
+		FlagMetricsListenAddr, defaultCfg.Metrics.Server.ListenAddress, "Address metrics server listens on.",
+	)
+	cmd.PersistentFlags().Bool(FlagOverwrite, false, "Overwrite the existing config.")
+	cmd.PersistentFlags().Bool(
+		FlagDemo, false, "Initialize the ready to use relayer with the funded keys for the devnet or testnet.",
+	)
+	cmd.PersistentFlags().String(
+		FlagCoreumFaucetURL, "", "Coreum faucet URL used in the demo mode, the known network faucet if not set.",
+	)
+	cmd.PersistentFlags().String(
+		FlagXRPLFaucetURL, "", "XRPL faucet URL used in the demo mode, the known network faucet if not set.",
+	)
 
+	AddKeyringFlags(cmd)
 	AddHomeFlag(cmd)
 
 	return cmd
@@ -373,6 +859,14 @@ func StartCmd(pp RunnerProvider) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "Start relayer.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Start relayer.
+The contract address, Coreum chain ID and bridge XRPL address are recorded in the relayer home on the first start, and
+the relayer refuses to start if they are changed, since the local state of the recorded bridge can't be used for the
+new one. Start with the --%s flag to remove the local state (the scanner checkpoint, the pending operations
+stores, the transfers history, etc.) and record the new bridge, the keyrings and the config are kept.
+`, FlagAcceptNewBridge,
+		)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			// scan helps to wait for any input infinitely and just then call the relayer. That handles
@@ -386,16 +880,140 @@ func StartCmd(pp RunnerProvider) *cobra.Command {
 			input := bufio.NewScanner(os.Stdin)
 			input.Scan()
 
-			runner, err := pp(cmd)
+			home, err := getRelayerHome(cmd)
+			if err != nil {
+				return err
+			}
+			homeLock, err := runner.LockHome(home)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := homeLock.Unlock(); err != nil {
+					log.Warn(ctx, "Failed to unlock relayer home", zap.Error(err))
+				}
+			}()
+
+			relayerRunner, err := pp(cmd)
 			if err != nil {
 				return err
 			}
 
-			return runner.Start(ctx)
+			return relayerRunner.Start(ctx)
 		},
 	}
 	AddHomeFlag(cmd)
 	AddKeyringFlags(cmd)
+	cmd.Flags().Bool(
+		FlagAcceptNewBridge,
+		false,
+		"Remove the local state of the bridge recorded in the relayer home and start for the new bridge",
+	)
+	cmd.Flags().String(
+		FlagLogFormat,
+		"",
+		fmt.Sprintf("Log format overriding the config: %s or %s", LogFormatJSON, LogFormatConsole),
+	)
+
+	return cmd
+}
+
+// ServeAPICmd returns the cmd which starts the read-only bridge API.
+func ServeAPICmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-api",
+		Short: "Start the read-only bridge HTTP API.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Start the read-only HTTP JSON API exposing the bridge state.
+The API doesn't sign any transactions and runs independently of the relayer processes, the API config is taken from
+the "api" section of the relayer config. The OpenAPI document is served at the /openapi.json path.
+Example:
+$ serve-api --%s %s
+`, FlagHome, DefaultHomeDir),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cfg, err := GetHomeRunnerConfig(cmd)
+			if err != nil {
+				return err
+			}
+			log, err := newConfigLogger(cfg)
+			if err != nil {
+				return err
+			}
+			components, err := NewComponents(cmd, log)
+			if err != nil {
+				return err
+			}
+			bridgeClient, err := bcp(components)
+			if err != nil {
+				return err
+			}
+
+			cachingContractClient := coreum.NewCachingContractClient(
+				runner.NewCachingContractClientConfig(cfg),
+				components.CoreumContractClient,
+				components.MetricsRegistry,
+				time.Now,
+			)
+			serverCfg := api.DefaultServerConfig()
+			serverCfg.ListenAddress = cfg.API.ListenAddress
+			serverCfg.CORSAllowedOrigins = cfg.API.CORSAllowedOrigins
+			serverCfg.RateLimit = api.RateLimitConfig{
+				RequestsPerSecond: cfg.API.RateLimit.RequestsPerSecond,
+				Burst:             cfg.API.RateLimit.Burst,
+			}
+
+			return api.NewServer(serverCfg, log, cachingContractClient, bridgeClient, time.Now).Start(ctx)
+		},
+	}
+	AddHomeFlag(cmd)
+	AddKeyringFlags(cmd)
+
+	return cmd
+}
+
+// ConfigCmd returns the relayer config cmd.
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Relayer config commands.",
+	}
+	cmd.AddCommand(ConfigValidateCmd())
+
+	return cmd
+}
+
+// ConfigValidateCmd returns the cmd which validates the relayer config.
+func ConfigValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Load and validate the relayer config and print the effective config.",
+		Long: strings.TrimSpace(
+			`Load and validate the relayer config without starting the relayer.
+The config of the older version is migrated, and the unknown fields are rejected. The effective config with the
+default values of the unset fields is printed.
+Example:
+$ config validate
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := GetHomeRunnerConfig(cmd)
+			if err != nil {
+				return err
+			}
+			if err := runner.ValidateConfig(cfg); err != nil {
+				return err
+			}
+			cfgBytes, err := yaml.Marshal(cfg)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal effective config")
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), string(cfgBytes))
+			return errors.Wrap(err, "failed to write effective config")
+		},
+	}
+	AddHomeFlag(cmd)
 
 	return cmd
 }
@@ -450,38 +1068,52 @@ func RelayerKeysCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "relayer-keys",
 		Short: "Print the Coreum and XRPL relayer keys info.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the Coreum and XRPL relayer keys info.
+The keyrings might use different backends, in that case the keys info of one keyring can be printed with the %s flag.
+Example:
+$ relayer-keys --%s %s
+`, FlagTarget, FlagTarget, XRPLKeyringSuffix,
+		)),
 		RunE: runBridgeCmd(nil,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				xrplAddress, err := components.XRPLKeyringTxSigner.Account(components.RunnerConfig.XRPL.MultiSignerKeyName)
+				target, err := cmd.Flags().GetString(FlagTarget)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to get %s", FlagTarget)
 				}
-
-				xrplPubKey, err := components.XRPLKeyringTxSigner.PubKey(components.RunnerConfig.XRPL.MultiSignerKeyName)
-				if err != nil {
-					return err
+				if target != "" && target != CoreumKeyringSuffix && target != XRPLKeyringSuffix {
+					return errors.Errorf(
+						"invalid target %s, expected %s or %s", target, CoreumKeyringSuffix, XRPLKeyringSuffix,
+					)
 				}
 
-				// Coreum
-				coreumKeyRecord, err := components.CoreumClientCtx.Keyring().Key(components.RunnerConfig.Coreum.RelayerKeyName)
-				if err != nil {
-					return errors.Wrapf(err, "failed to get coreum key, keyName:%s", components.RunnerConfig.Coreum.RelayerKeyName)
+				fields := make([]zap.Field, 0)
+				if target == "" || target == CoreumKeyringSuffix {
+					coreumAddress, err := getRelayerCoreumAddress(components)
+					if err != nil {
+						return err
+					}
+					fields = append(fields, zap.String("coreumAddress", coreumAddress.String()))
 				}
-				coreumAddress, err := coreumKeyRecord.GetAddress()
-				if err != nil {
-					return errors.Wrapf(err, "failed to get coreum address from key, keyName:%s",
-						components.RunnerConfig.Coreum.RelayerKeyName)
+				if target == "" || target == XRPLKeyringSuffix {
+					xrplAddress, err := components.XRPLKeyringTxSigner.Account(components.RunnerConfig.XRPL.MultiSignerKeyName)
+					if err != nil {
+						return err
+					}
+					xrplPubKey, err := components.XRPLKeyringTxSigner.PubKey(components.RunnerConfig.XRPL.MultiSignerKeyName)
+					if err != nil {
+						return err
+					}
+					fields = append(
+						fields,
+						zap.String("xrplAddress", xrplAddress.String()),
+						zap.String("xrplPubKey", xrplPubKey.String()),
+					)
 				}
 
-				components.Log.Info(
-					ctx,
-					"Keys info",
-					zap.String("coreumAddress", coreumAddress.String()),
-					zap.String("xrplAddress", xrplAddress.String()),
-					zap.String("xrplPubKey", xrplPubKey.String()),
-				)
+				components.Log.Info(ctx, "Keys info", fields...)
 
 				return nil
 			}),
@@ -489,46 +1121,204 @@ func RelayerKeysCmd() *cobra.Command {
 	AddKeyringFlags(cmd)
 	AddKeyNameFlag(cmd)
 	AddHomeFlag(cmd)
+	cmd.Flags().String(
+		FlagTarget, "", fmt.Sprintf("Keyring to use (%s|%s), both if empty", CoreumKeyringSuffix, XRPLKeyringSuffix),
+	)
 
 	return cmd
 }
 
-// BootstrapBridgeCmd safely creates XRPL bridge account with all required settings and deploys the bridge contract.
-func BootstrapBridgeCmd(bcp BridgeClientProvider) *cobra.Command {
+// SignPendingCmd writes the pending operations not yet signed by the relayer to the file for the offline signing.
+func SignPendingCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bootstrap-bridge [config-path]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Sets up the XRPL bridge account with all required settings and deploys the bridge contract.",
+		Use:   "sign-pending",
+		Short: "Write the pending operations not yet signed by the relayer to the file for the offline signing.",
 		Long: strings.TrimSpace(fmt.Sprintf(
-			`Sets up the XRPL bridge account with all required settings and deploys the bridge contract.
+			`Write the pending operations not yet signed by the relayer to the file for the offline signing.
+The file is signed on the machine with the XRPL relayer key with the sign-offline command, and the result is
+broadcast with the broadcast-signatures command.
 Example:
-$ bootstrap-bridge bootstrapping.yaml --%s bridge-account
-`, FlagKeyName)),
+$ sign-pending --%s unsigned.json
+`, FlagOutput,
+		)),
+		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				xrplKeyName, err := cmd.Flags().GetString(FlagXRPLKeyName)
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
 				if err != nil {
-					return errors.Wrapf(err, "failed to get %s", FlagXRPLKeyName)
+					return errors.WithStack(err)
 				}
-				xrplKeyringTxSigner := components.XRPLKeyringTxSigner
-				xrplBridgeAddress, err := xrplKeyringTxSigner.Account(xrplKeyName)
+				relayerAddress, err := getRelayerCoreumAddress(components)
 				if err != nil {
 					return err
 				}
 
-				components.Log.Info(ctx, "XRPL bridge address", zap.String("address", xrplBridgeAddress.String()))
-
-				coreumKeyName, err := cmd.Flags().GetString(FlagCoreumKeyName)
+				unsignedOperations, err := bridgeClient.GetUnsignedPendingOperations(ctx, relayerAddress)
 				if err != nil {
-					return errors.Wrapf(err, "failed to get %s", FlagCoreumKeyName)
+					return err
 				}
-				coreumKRRecord, err := components.CoreumClientCtx.Keyring().Key(coreumKeyName)
-				if err != nil {
-					return errors.Wrapf(err, "failed to get key by name:%s", coreumKeyName)
+				if err := writeJSONFile(outputPath, unsignedOperations); err != nil {
+					return err
 				}
-				coreumAddress, err := coreumKRRecord.GetAddress()
+
+				components.Log.Info(
+					ctx,
+					"Unsigned pending operations are written to the file.",
+					zap.String("path", outputPath),
+					zap.Int("count", len(unsignedOperations.Operations)),
+				)
+
+				return nil
+			}),
+	}
+	cmd.PersistentFlags().String(FlagOutput, "unsigned.json", "Unsigned operations output file path")
+	AddKeyringFlags(cmd)
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// SignOfflineCmd signs the operations from the sign-pending command output with the XRPL relayer key.
+func SignOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-offline",
+		Short: "Sign the operations from the sign-pending command output with the XRPL relayer key.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Sign the operations from the sign-pending command output with the XRPL relayer key.
+The command doesn't require the network access.
+Example:
+$ sign-offline --%s unsigned.json --%s signed.json
+`, FlagInput, FlagOutput,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(nil,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				inputPath, err := cmd.Flags().GetString(FlagInput)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+
+				var unsignedOperations processes.UnsignedOperations
+				if err := readJSONFile(inputPath, &unsignedOperations); err != nil {
+					return err
+				}
+				signatures, err := processes.SignUnsignedOperations(
+					unsignedOperations,
+					components.XRPLKeyringTxSigner,
+					components.RunnerConfig.XRPL.MultiSignerKeyName,
+				)
+				if err != nil {
+					return err
+				}
+				if err := writeJSONFile(outputPath, signatures); err != nil {
+					return err
+				}
+
+				components.Log.Info(
+					ctx,
+					"Operation signatures are written to the file.",
+					zap.String("path", outputPath),
+					zap.Int("count", len(signatures.Signatures)),
+				)
+
+				return nil
+			}),
+	}
+	cmd.PersistentFlags().String(FlagInput, "unsigned.json", "Unsigned operations input file path")
+	cmd.PersistentFlags().String(FlagOutput, "signed.json", "Operation signatures output file path")
+	AddKeyringFlags(cmd)
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// BroadcastSignaturesCmd saves the operation signatures from the sign-offline command output in the contract.
+func BroadcastSignaturesCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast-signatures",
+		Short: "Save the operation signatures from the sign-offline command output in the contract.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Save the operation signatures from the sign-offline command output in the contract.
+Example:
+$ broadcast-signatures --%s signed.json
+`, FlagInput,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				inputPath, err := cmd.Flags().GetString(FlagInput)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				relayerAddress, err := getRelayerCoreumAddress(components)
+				if err != nil {
+					return err
+				}
+
+				var signatures processes.OperationSignatures
+				if err := readJSONFile(inputPath, &signatures); err != nil {
+					return err
+				}
+
+				return bridgeClient.SaveOperationSignatures(ctx, relayerAddress, signatures)
+			}),
+	}
+	cmd.PersistentFlags().String(FlagInput, "signed.json", "Operation signatures input file path")
+	AddKeyringFlags(cmd)
+	AddHomeFlag(cmd)
+	AddFeeFlags(cmd)
+
+	return cmd
+}
+
+// BootstrapBridgeCmd safely creates XRPL bridge account with all required settings and deploys the bridge contract.
+func BootstrapBridgeCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bootstrap-bridge [config-path]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sets up the XRPL bridge account with all required settings and deploys the bridge contract.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Sets up the XRPL bridge account with all required settings and deploys the bridge contract.
+The completed steps are recorded to the %s file in the home, and the failed bootstrapping is resumed from the
+first incomplete step. Use the --%s flag to ignore the recorded progress.
+Example:
+$ bootstrap-bridge bootstrapping.yaml --%s bridge-account
+`, bridgeclient.BootstrappingProgressFileName, FlagRestart, FlagKeyName)),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				xrplKeyName, err := cmd.Flags().GetString(FlagXRPLKeyName)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagXRPLKeyName)
+				}
+				xrplKeyringTxSigner := components.XRPLKeyringTxSigner
+				xrplBridgeAddress, err := xrplKeyringTxSigner.Account(xrplKeyName)
+				if err != nil {
+					return err
+				}
+
+				components.Log.Info(ctx, "XRPL bridge address", zap.String("address", xrplBridgeAddress.String()))
+
+				coreumKeyName, err := cmd.Flags().GetString(FlagCoreumKeyName)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagCoreumKeyName)
+				}
+				coreumKRRecord, err := components.CoreumClientCtx.Keyring().Key(coreumKeyName)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get key by name:%s", coreumKeyName)
+				}
+				coreumAddress, err := coreumKRRecord.GetAddress()
 				if err != nil {
 					return errors.Wrapf(err, "failed to address for key name:%s", coreumKeyName)
 				}
@@ -561,45 +1351,582 @@ $ bootstrap-bridge bootstrapping.yaml --%s bridge-account
 				if err != nil {
 					return err
 				}
+				numberOfTickets, err := cmd.Flags().GetUint32(FlagTicketsToAllocate)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagTicketsToAllocate)
+				}
+				if err := validateXRPLTicketsReserve(
+					ctx, components, bridgeClient, xrplBridgeAddress, numberOfTickets,
+				); err != nil {
+					return err
+				}
+
 				components.Log.Info(ctx, "Bootstrapping XRPL bridge", zap.Any("config", cfg))
 				components.Log.Info(ctx, "Press any key to continue.")
 				input := bufio.NewScanner(os.Stdin)
 				input.Scan()
 
-				_, err = bridgeClient.Bootstrap(ctx, coreumAddress, xrplKeyName, cfg)
-				return err
+				home, err := getRelayerHome(cmd)
+				if err != nil {
+					return err
+				}
+				progressFilePath := filepath.Join(home, bridgeclient.BootstrappingProgressFileName)
+				restart, err := cmd.Flags().GetBool(FlagRestart)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagRestart)
+				}
+				if restart {
+					components.Log.Info(ctx, "Removing bootstrapping progress", zap.String("path", progressFilePath))
+					if err := bridgeclient.RemoveBootstrappingProgress(progressFilePath); err != nil {
+						return err
+					}
+				}
+
+				contractAddress, err := bridgeClient.Bootstrap(ctx, coreumAddress, xrplKeyName, cfg, progressFilePath)
+				if err != nil {
+					return err
+				}
+				components.Log.Info(
+					ctx,
+					"Bridge is bootstrapped",
+					zap.String("contractAddress", contractAddress.String()),
+					zap.String("xrplBridgeAddress", xrplBridgeAddress.String()),
+				)
+
+				return nil
 			}),
 	}
 	AddKeyringFlags(cmd)
 	AddHomeFlag(cmd)
+	AddFeeFlags(cmd)
 
 	cmd.PersistentFlags().Bool(FlagInitOnly, false, "Init default config")
 	cmd.PersistentFlags().Int(FlagRelayersCount, 0, "Relayers count")
 	cmd.PersistentFlags().String(FlagCoreumKeyName, "", "Key name from the Coreum keyring")
 	cmd.PersistentFlags().String(FlagXRPLKeyName, "", "Key name from the XRPL keyring")
+	cmd.PersistentFlags().Bool(FlagRestart, false, "Ignore the bootstrapping progress and start from scratch")
+	cmd.PersistentFlags().Uint32(
+		FlagTicketsToAllocate,
+		xrpl.MaxTicketsToAllocate,
+		"Number of the tickets to be allocated after the bootstrapping, validated against the XRPL account reserve",
+	)
 
 	return cmd
 }
 
+func validateXRPLTicketsReserve(
+	ctx context.Context,
+	components runner.Components,
+	bridgeClient BridgeClient,
+	xrplBridgeAddress rippledata.Account,
+	numberOfTickets uint32,
+) error {
+	reserve, err := bridgeClient.GetXRPLTicketsReserve(ctx, xrplBridgeAddress, numberOfTickets)
+	if err != nil {
+		return err
+	}
+	components.Log.Info(
+		ctx,
+		"XRPL bridge account reserve for the tickets allocation",
+		zap.Uint32("numberOfTickets", reserve.NumberOfTickets),
+		zap.Float64("balance", xrpl.DropsToXRP(reserve.BalanceDrops)),
+		zap.Float64("requiredReserve", xrpl.DropsToXRP(reserve.RequiredReserveDrops)),
+	)
+	if reserve.ShortfallDrops == 0 {
+		return nil
+	}
+	shortfall := strconv.FormatFloat(xrpl.DropsToXRP(reserve.ShortfallDrops), 'f', -1, 64)
+	components.Log.Info(
+		ctx,
+		fmt.Sprintf(
+			"Fund the XRPL bridge account %s with at least %s XRP to allocate %d tickets, "+
+				"or decrease the --%s.",
+			xrplBridgeAddress.String(), shortfall, numberOfTickets, FlagTicketsToAllocate,
+		),
+	)
+
+	return errors.Errorf(
+		"insufficient XRPL bridge account balance to cover the reserve of %d tickets, required:%s XRP, missing:%s XRP",
+		numberOfTickets,
+		strconv.FormatFloat(xrpl.DropsToXRP(reserve.RequiredReserveDrops), 'f', -1, 64),
+		shortfall,
+	)
+}
+
 // VersionCmd returns a CLI command to interactively print the application binary version information.
-func VersionCmd() *cobra.Command {
-	return &cobra.Command{
+func VersionCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the application binary version information",
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the application binary version information.
+With the --%s flag the contract, Coreum chain and XRPL server versions are queried as well, and the contract
+compatibility verdict is printed. The section of the unreachable endpoint is marked unknown.
+Example:
+$ version --%s --%s %s
+`, FlagFull, FlagFull, FlagOutput, outputFormatJSON,
+		)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			full, err := cmd.Flags().GetBool(FlagFull)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get %s", FlagFull)
+			}
+			if !full {
+				log, err := GetCLILogger()
+				if err != nil {
+					return err
+				}
+				log.Info(
+					cmd.Context(),
+					"Version Info",
+					zap.String("Git Tag", buildinfo.VersionTag),
+					zap.String("Git Commit", buildinfo.GitCommit),
+				)
+				return nil
+			}
+
+			outputFormat, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get %s", FlagOutput)
+			}
+			if outputFormat != outputFormatText && outputFormat != outputFormatJSON {
+				return errors.Errorf("invalid output format %q", outputFormat)
+			}
+
+			return runBridgeCmd(bcp,
+				func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+					info := fullVersionInfo{
+						Relayer: relayerVersionInfo{
+							Version:   buildinfo.VersionTag,
+							GitCommit: buildinfo.GitCommit,
+						},
+						VersionInfo: bridgeClient.GetVersionInfo(cmd.Context()),
+					}
+					if outputFormat == outputFormatJSON {
+						infoBytes, err := json.MarshalIndent(info, "", "  ")
+						if err != nil {
+							return errors.Wrap(err, "failed to marshal version info")
+						}
+						_, err = fmt.Fprintln(cmd.OutOrStdout(), string(infoBytes))
+						return errors.Wrap(err, "failed to write version info")
+					}
+
+					return writeVersionInfo(cmd.OutOrStdout(), info)
+				})(cmd, args)
+		},
+	}
+	cmd.Flags().Bool(FlagFull, false, "Query and print the contract, Coreum chain and XRPL server versions")
+	cmd.Flags().String(
+		FlagOutput, outputFormatText, fmt.Sprintf("Output format (%s/%s)", outputFormatText, outputFormatJSON),
+	)
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+type relayerVersionInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+}
+
+type fullVersionInfo struct {
+	Relayer relayerVersionInfo `json:"relayer"`
+	bridgeclient.VersionInfo
+}
+
+func writeVersionInfo(out io.Writer, info fullVersionInfo) error {
+	rows := [][2]string{
+		{"Relayer version", info.Relayer.Version},
+		{"Relayer git commit", info.Relayer.GitCommit},
+	}
+	if info.Contract.Error != "" {
+		rows = append(rows, [2]string{"Contract", unknownVersionInfo(info.Contract.Error)})
+	} else {
+		rows = append(rows,
+			[2]string{"Contract address", info.Contract.Address},
+			[2]string{"Contract name", info.Contract.Name},
+			[2]string{"Contract version", info.Contract.Version},
+			[2]string{"Contract code ID", strconv.FormatUint(info.Contract.CodeID, 10)},
+		)
+	}
+	rows = append(rows, [2]string{"Contract compatibility", string(info.Contract.Compatibility)})
+	if info.Coreum.Error != "" {
+		rows = append(rows, [2]string{"Coreum", unknownVersionInfo(info.Coreum.Error)})
+	} else {
+		rows = append(rows,
+			[2]string{"Coreum chain ID", info.Coreum.ChainID},
+			[2]string{"Coreum node version", info.Coreum.NodeVersion},
+		)
+	}
+	if info.XRPL.Error != "" {
+		rows = append(rows, [2]string{"XRPL", unknownVersionInfo(info.XRPL.Error)})
+	} else {
+		rows = append(rows,
+			[2]string{"XRPL build version", info.XRPL.BuildVersion},
+			[2]string{"XRPL network ID", strconv.FormatUint(uint64(info.XRPL.NetworkID), 10)},
+		)
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		if _, err := fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1]); err != nil {
+			return errors.Wrap(err, "failed to write version info")
+		}
+	}
+
+	return errors.Wrap(tw.Flush(), "failed to write version info")
+}
+
+func unknownVersionInfo(reason string) string {
+	return fmt.Sprintf("unknown (%s)", reason)
+}
+
+// CompletionCmd returns a CLI command to generate the shell completion script.
+func CompletionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate the shell completion script",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the shell completion script.
+Example:
+$ source <(%s completion bash)
+`, os.Args[0]),
+		),
+		DisableFlagsInUseLine: true,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCmd := cmd.Root()
+			out := cmd.OutOrStdout()
+			switch args[0] {
+			case "bash":
+				return errors.Wrap(rootCmd.GenBashCompletionV2(out, true), "failed to generate bash completion")
+			case "zsh":
+				return errors.Wrap(rootCmd.GenZshCompletion(out), "failed to generate zsh completion")
+			case "fish":
+				return errors.Wrap(rootCmd.GenFishCompletion(out, true), "failed to generate fish completion")
+			default:
+				return errors.Wrap(
+					rootCmd.GenPowerShellCompletionWithDesc(out), "failed to generate powershell completion",
+				)
+			}
+		},
+	}
+}
+
+// CommandManifest is the machine-readable description of the CLI command.
+type CommandManifest struct {
+	Path   string         `json:"path"`
+	Use    string         `json:"use"`
+	Short  string         `json:"short"`
+	Hidden bool           `json:"hidden"`
+	Flags  []FlagManifest `json:"flags"`
+}
+
+// FlagManifest is the machine-readable description of the CLI command flag.
+type FlagManifest struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand,omitempty"`
+	Type      string `json:"type"`
+	Default   string `json:"default"`
+	Usage     string `json:"usage"`
+	Inherited bool   `json:"inherited"`
+}
+
+// HistoryCmd returns the command to read the local history of the transfers the relayer has provided the evidences for.
+func HistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Read the local history of the transfers the relayer has provided the evidences for.",
+	}
+	cmd.AddCommand(HistoryListCmd())
+	cmd.AddCommand(HistoryExportCmd())
+
+	return cmd
+}
+
+// HistoryListCmd prints the transfers history records matching the filter.
+func HistoryListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Print the transfers history records.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the history records of the transfers the relayer has provided the evidences for.
+The records older than the processes.transfer_history.retention_days from the relayer config are pruned on the start.
+Example:
+$ history list --%s %s --%s ucore --%s 2024-01-01
+`, FlagAddress, constant.AddressSampleTest, FlagDenom, FlagSince,
+		)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			records, err := readTransferHistoryFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
 			log, err := GetCLILogger()
 			if err != nil {
 				return err
 			}
-			log.Info(
-				cmd.Context(),
-				"Version Info",
-				zap.String("Git Tag", buildinfo.VersionTag),
-				zap.String("Git Commit", buildinfo.GitCommit),
-			)
+			log.Info(ctx, "Got transfer history", zap.Any("transfers", records))
+
 			return nil
 		},
 	}
+	addTransferHistoryFilterFlags(cmd)
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// HistoryExportCmd writes the transfers history records matching the filter to the stdout.
+func HistoryExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the transfers history records.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Export the history records of the transfers the relayer has provided the evidences for to the stdout.
+Example:
+$ history export --%s %s --%s 2024-01-01T00:00:00Z > history.csv
+`, FlagFormat, TransferHistoryFormatCSV, FlagSince,
+		)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagFormat)
+			}
+			if format != TransferHistoryFormatCSV && format != TransferHistoryFormatJSON {
+				return errors.Errorf(
+					"invalid --%s %q, expected %s or %s",
+					FlagFormat, format, TransferHistoryFormatCSV, TransferHistoryFormatJSON,
+				)
+			}
+
+			records, err := readTransferHistoryFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			if format == TransferHistoryFormatCSV {
+				return processes.WriteTransferHistoryCSV(cmd.OutOrStdout(), records)
+			}
+			recordsBytes, err := json.MarshalIndent(records, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal transfer history")
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(recordsBytes))
+			return errors.Wrap(err, "failed to write transfer history")
+		},
+	}
+	addTransferHistoryFilterFlags(cmd)
+	cmd.Flags().String(
+		FlagFormat,
+		TransferHistoryFormatCSV,
+		fmt.Sprintf("Export format: %s or %s", TransferHistoryFormatCSV, TransferHistoryFormatJSON),
+	)
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+func addTransferHistoryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagAddress, "", "Sender or recipient address, Coreum or XRPL")
+	cmd.Flags().String(FlagDenom, "", "Coreum denom or XRPL currency of the transferred token")
+	cmd.Flags().String(FlagSince, "", "Min record time, RFC3339 time or date, e.g. 2024-01-01")
+}
+
+func getSinceFlag(cmd *cobra.Command) (time.Time, error) {
+	sinceValue, err := cmd.Flags().GetString(FlagSince)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to read flag %s", FlagSince)
+	}
+	var since time.Time
+	if sinceValue != "" {
+		if since, err = time.Parse(time.RFC3339, sinceValue); err != nil {
+			if since, err = time.Parse(time.DateOnly, sinceValue); err != nil {
+				return time.Time{}, errors.Errorf("invalid --%s %q, expected RFC3339 time or date", FlagSince, sinceValue)
+			}
+		}
+	}
+
+	return since, nil
+}
+
+func readTransferHistoryFromCmd(cmd *cobra.Command) ([]processes.TransferHistoryRecord, error) {
+	address, err := cmd.Flags().GetString(FlagAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read flag %s", FlagAddress)
+	}
+	denom, err := cmd.Flags().GetString(FlagDenom)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read flag %s", FlagDenom)
+	}
+	since, err := getSinceFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	transferHistoryFilePath, err := getTransferHistoryFilePath(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return processes.ReadTransferHistory(transferHistoryFilePath, processes.TransferHistoryFilter{
+		Address: address,
+		Denom:   denom,
+		Since:   since,
+	})
+}
+
+// AuditCmd returns the relayer keys usage audit log commands.
+func AuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Verify and export the audit log of the XRPL signatures and Coreum txs produced by the relayer keys.",
+	}
+	cmd.AddCommand(AuditVerifyCmd())
+	cmd.AddCommand(AuditExportCmd())
+
+	return cmd
+}
+
+// AuditVerifyCmd verifies the hash chain of the key usage audit log.
+func AuditVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the key usage audit log.",
+		Long: strings.TrimSpace(
+			`Verify the hash chain of the key usage audit log, the modified, removed or reordered entries are reported.
+The number of entries and the hash of the last entry are printed, the removal of the trailing entries is detected by
+comparing them with the previous verification result.
+Example:
+$ audit verify
+`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyUsageAuditLogFilePath, err := getKeyUsageAuditLogFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			verification, err := processes.VerifyKeyUsageAuditLog(keyUsageAuditLogFilePath)
+			if err != nil {
+				return err
+			}
+			verificationBytes, err := json.MarshalIndent(verification, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal key usage audit log verification")
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(verificationBytes))
+			return errors.Wrap(err, "failed to write key usage audit log verification")
+		},
+	}
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// AuditExportCmd writes the key usage audit log entries to the stdout.
+func AuditExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the key usage audit log entries.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Export the key usage audit log entries to the stdout in the JSON Lines format.
+Example:
+$ audit export --%s 2024-01-01T00:00:00Z > key-usage.jsonl
+`, FlagSince,
+		)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			since, err := getSinceFlag(cmd)
+			if err != nil {
+				return err
+			}
+			keyUsageAuditLogFilePath, err := getKeyUsageAuditLogFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			entries, err := processes.ReadKeyUsageAuditLog(keyUsageAuditLogFilePath, since)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				entryBytes, err := json.Marshal(entry)
+				if err != nil {
+					return errors.Wrap(err, "failed to marshal key usage audit entry")
+				}
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(entryBytes)); err != nil {
+					return errors.Wrap(err, "failed to write key usage audit entry")
+				}
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagSince, "", "Min entry time, RFC3339 time or date, e.g. 2024-01-01")
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// ManifestCmd returns a hidden CLI command to print the JSON description of all commands and their flags.
+func ManifestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:    "__manifest",
+		Short:  "Print the JSON description of all commands and their flags",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			manifest := buildCommandManifest(cmd.Root(), make([]CommandManifest, 0))
+			manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal commands manifest")
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(manifestBytes))
+			return errors.Wrap(err, "failed to write commands manifest")
+		},
+	}
+}
+
+func buildCommandManifest(cmd *cobra.Command, manifest []CommandManifest) []CommandManifest {
+	cmdFlags := make([]FlagManifest, 0)
+	addFlags := func(flagSet *pflag.FlagSet, inherited bool) {
+		flagSet.VisitAll(func(flag *pflag.Flag) {
+			cmdFlags = append(cmdFlags, FlagManifest{
+				Name:      flag.Name,
+				Shorthand: flag.Shorthand,
+				Type:      flag.Value.Type(),
+				Default:   resolveManifestFlagDefault(flag.DefValue),
+				Usage:     flag.Usage,
+				Inherited: inherited,
+			})
+		})
+	}
+	addFlags(cmd.LocalFlags(), false)
+	addFlags(cmd.InheritedFlags(), true)
+
+	manifest = append(manifest, CommandManifest{
+		Path:   cmd.CommandPath(),
+		Use:    cmd.Use,
+		Short:  cmd.Short,
+		Hidden: cmd.Hidden,
+		Flags:  cmdFlags,
+	})
+	for _, subCmd := range cmd.Commands() {
+		manifest = buildCommandManifest(subCmd, manifest)
+	}
+
+	return manifest
+}
+
+// resolveManifestFlagDefault replaces the defaults depending on the machine the CLI is executed on.
+func resolveManifestFlagDefault(value string) string {
+	return strings.ReplaceAll(value, DefaultHomeDir, manifestDefaultHomeDir)
 }
 
 // GetCLILogger returns the console logger initialised with the default logger config but with set `yaml` format.
@@ -651,24 +1978,190 @@ func AddKeyringFlags(cmd *cobra.Command) {
 		"", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 }
 
+// AddFeeFlags adds the Coreum tx fee flags to the command, the values from the runner config are used if the flags
+// are not set.
+func AddFeeFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		flags.FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1ucore)",
+	)
+	cmd.PersistentFlags().Float64(
+		flags.FlagGasAdjustment, 0, "Adjustment factor to be multiplied against the estimate returned by the tx simulation",
+	)
+	cmd.PersistentFlags().String(flags.FlagFeeGranter, "", "Fee granter grants fees for the transaction")
+}
+
 // AddKeyNameFlag adds key-name flag to the command.
 func AddKeyNameFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(FlagKeyName, "", "Key name from the keyring")
 }
 
+func getRelayerCoreumAddress(components runner.Components) (sdk.AccAddress, error) {
+	keyName := components.RunnerConfig.Coreum.RelayerKeyName
+	coreumKeyRecord, err := components.CoreumClientCtx.Keyring().Key(keyName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get coreum key, keyName:%s", keyName)
+	}
+	coreumAddress, err := coreumKeyRecord.GetAddress()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get coreum address from key, keyName:%s", keyName)
+	}
+
+	return coreumAddress, nil
+}
+
+// parseXRPLAccount parses the classic XRPL address or X-address without the destination tag of the network the relayer
+// is configured for.
+func parseXRPLAccount(components runner.Components, address string) (rippledata.Account, error) {
+	return xrpl.ParseAccount(address, components.RunnerConfig.Coreum.Network.ChainID == string(constant.ChainIDMain))
+}
+
+func writeJSONFile(path string, data any) error {
+	fileBytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode JSON, path:%s", path)
+	}
+	if err := os.WriteFile(path, fileBytes, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write file, path:%s", path)
+	}
+
+	return nil
+}
+
+func readJSONFile(path string, data any) error {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file, path:%s", path)
+	}
+	if err := json.Unmarshal(fileBytes, data); err != nil {
+		return errors.Wrapf(err, "failed to decode JSON, path:%s", path)
+	}
+
+	return nil
+}
+
 func getRelayerHome(cmd *cobra.Command) (string, error) {
 	return cmd.Flags().GetString(FlagHome)
 }
 
+func getOperationAgeStoreFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.OperationAgeStoreFileName), nil
+}
+
+func getSignatureAggregationStoreFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.SignatureAggregationStoreFileName), nil
+}
+
+func getXRPLTxResultStoreFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.XRPLTxResultStoreFileName), nil
+}
+
+func getBlockedDeliveriesStoreFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.BlockedDeliveriesStoreFileName), nil
+}
+
+func getEvidenceDeadLetterLogFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.EvidenceDeadLetterLogFileName), nil
+}
+
+func getTransferWebhookDeadLetterLogFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.TransferWebhookDeadLetterLogFileName), nil
+}
+
+func getTransferLatencyStoreFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.TransferLatencyStoreFileName), nil
+}
+
+func getTransferHistoryFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.TransferHistoryFileName), nil
+}
+
+func getTicketUsageFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.TicketUsageFileName), nil
+}
+
+func getBridgeIdentityFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, runner.BridgeIdentityFileName), nil
+}
+
+func getKeyUsageAuditLogFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, processes.KeyUsageAuditLogFileName), nil
+}
+
+func getXRPLScannerCheckpointFilePath(cmd *cobra.Command) (string, error) {
+	home, err := getRelayerHome(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, xrpl.ScannerCheckpointFileName), nil
+}
+
 func addCoreumChainIDFlag(cmd *cobra.Command) *string {
 	return cmd.PersistentFlags().String(FlagCoreumChainID, string(runner.DefaultCoreumChainID), "Default coreum chain ID")
 }
 
 // withKeyring adds suffix-specific keyring witch decoded private key caching to the context.
+// The keyring backend is taken from the flag if the keyringBackend is empty.
 func withKeyring(
 	clientCtx client.Context,
 	flagSet *pflag.FlagSet,
 	suffix string,
+	keyringBackend string,
 	log logger.Logger,
 ) (client.Context, error) {
 	if flagSet.Lookup(flags.FlagKeyringDir) == nil || flagSet.Lookup(flags.FlagKeyringBackend) == nil {
@@ -684,11 +2177,15 @@ func withKeyring(
 	keyringDir += "-" + suffix
 	clientCtx = clientCtx.WithKeyringDir(keyringDir)
 
-	keyringBackend, err := flagSet.GetString(flags.FlagKeyringBackend)
-	if err != nil {
-		return client.Context{}, errors.WithStack(err)
+	if keyringBackend == "" {
+		keyringBackend, err = flagSet.GetString(flags.FlagKeyringBackend)
+		if err != nil {
+			return client.Context{}, errors.WithStack(err)
+		}
 	}
-	kr, err := client.NewKeyringFromBackend(clientCtx, keyringBackend)
+	// the SDK replaces the keyring with the in-memory one if the dry-run flag is set, but the commands supporting
+	// the dry run still need the keys to resolve the sender
+	kr, err := client.NewKeyringFromBackend(clientCtx.WithSimulation(false), keyringBackend)
 	if err != nil {
 		return client.Context{}, errors.WithStack(err)
 	}
@@ -696,6 +2193,42 @@ func withKeyring(
 	return clientCtx.WithKeyring(newCacheKeyring(suffix, kr, clientCtx.Codec, log)), nil
 }
 
+// applyFeeFlags overrides the Coreum contract fee config with the fee flags set in the command.
+func applyFeeFlags(cmd *cobra.Command, cfg runner.Config) (runner.Config, error) {
+	if cmd.Flags().Lookup(flags.FlagGasPrices) == nil {
+		return cfg, nil
+	}
+	gasPrices, err := getFlagStringIfPresent(cmd, flags.FlagGasPrices)
+	if err != nil {
+		return runner.Config{}, errors.Wrapf(err, "failed to get %s", flags.FlagGasPrices)
+	}
+	if gasPrices != nil {
+		if _, err := sdk.ParseDecCoins(*gasPrices); err != nil {
+			return runner.Config{}, errors.Wrapf(err, "invalid %s %q", flags.FlagGasPrices, *gasPrices)
+		}
+		cfg.Coreum.Contract.GasPrices = *gasPrices
+	}
+	if cmd.Flags().Lookup(flags.FlagGasAdjustment).Changed {
+		gasAdjustment, err := cmd.Flags().GetFloat64(flags.FlagGasAdjustment)
+		if err != nil {
+			return runner.Config{}, errors.Wrapf(err, "failed to get %s", flags.FlagGasAdjustment)
+		}
+		if gasAdjustment <= 0 {
+			return runner.Config{}, errors.Errorf("%s must be positive, got: %f", flags.FlagGasAdjustment, gasAdjustment)
+		}
+		cfg.Coreum.Contract.GasAdjustment = gasAdjustment
+	}
+	feeGranter, err := getFlagStringIfPresent(cmd, flags.FlagFeeGranter)
+	if err != nil {
+		return runner.Config{}, errors.Wrapf(err, "failed to get %s", flags.FlagFeeGranter)
+	}
+	if feeGranter != nil {
+		cfg.Coreum.Contract.FeeGranter = *feeGranter
+	}
+
+	return cfg, nil
+}
+
 func getFlagSDKIntIfPresent(cmd *cobra.Command, flag string) (*sdkmath.Int, error) {
 	stringVal, err := getFlagStringIfPresent(cmd, flag)
 	if err != nil {
@@ -12,10 +12,13 @@ package cli_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	client "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	coreum "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	processes "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 	types "github.com/cosmos/cosmos-sdk/types"
 	data "github.com/rubblelabs/ripple/data"
 	gomock "go.uber.org/mock/gomock"
@@ -44,19 +47,33 @@ func (m *MockBridgeClient) EXPECT() *MockBridgeClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptOwnership mocks base method.
+func (m *MockBridgeClient) AcceptOwnership(arg0 context.Context, arg1 types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOwnership", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOwnership indicates an expected call of AcceptOwnership.
+func (mr *MockBridgeClientMockRecorder) AcceptOwnership(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOwnership", reflect.TypeOf((*MockBridgeClient)(nil).AcceptOwnership), arg0, arg1)
+}
+
 // Bootstrap mocks base method.
-func (m *MockBridgeClient) Bootstrap(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 client.BootstrappingConfig) (types.AccAddress, error) {
+func (m *MockBridgeClient) Bootstrap(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 client.BootstrappingConfig, arg4 string) (types.AccAddress, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Bootstrap", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Bootstrap", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(types.AccAddress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Bootstrap indicates an expected call of Bootstrap.
-func (mr *MockBridgeClientMockRecorder) Bootstrap(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockBridgeClientMockRecorder) Bootstrap(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrap", reflect.TypeOf((*MockBridgeClient)(nil).Bootstrap), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrap", reflect.TypeOf((*MockBridgeClient)(nil).Bootstrap), arg0, arg1, arg2, arg3, arg4)
 }
 
 // CancelPendingOperation mocks base method.
@@ -73,6 +90,20 @@ func (mr *MockBridgeClientMockRecorder) CancelPendingOperation(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingOperation", reflect.TypeOf((*MockBridgeClient)(nil).CancelPendingOperation), arg0, arg1, arg2)
 }
 
+// CheckXRPLIssuerAccount mocks base method.
+func (m *MockBridgeClient) CheckXRPLIssuerAccount(arg0 context.Context, arg1 data.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckXRPLIssuerAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckXRPLIssuerAccount indicates an expected call of CheckXRPLIssuerAccount.
+func (mr *MockBridgeClientMockRecorder) CheckXRPLIssuerAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckXRPLIssuerAccount", reflect.TypeOf((*MockBridgeClient)(nil).CheckXRPLIssuerAccount), arg0, arg1)
+}
+
 // ClaimRefund mocks base method.
 func (m *MockBridgeClient) ClaimRefund(arg0 context.Context, arg1 types.AccAddress, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -101,6 +132,20 @@ func (mr *MockBridgeClientMockRecorder) ClaimRelayerFees(arg0, arg1, arg2 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRelayerFees", reflect.TypeOf((*MockBridgeClient)(nil).ClaimRelayerFees), arg0, arg1, arg2)
 }
 
+// ClaimTreasuryFees mocks base method.
+func (m *MockBridgeClient) ClaimTreasuryFees(arg0 context.Context, arg1 types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTreasuryFees", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimTreasuryFees indicates an expected call of ClaimTreasuryFees.
+func (mr *MockBridgeClientMockRecorder) ClaimTreasuryFees(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTreasuryFees", reflect.TypeOf((*MockBridgeClient)(nil).ClaimTreasuryFees), arg0, arg1)
+}
+
 // DeployContract mocks base method.
 func (m *MockBridgeClient) DeployContract(arg0 context.Context, arg1 types.AccAddress, arg2 string) (*types.TxResponse, uint64, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +162,80 @@ func (mr *MockBridgeClientMockRecorder) DeployContract(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployContract", reflect.TypeOf((*MockBridgeClient)(nil).DeployContract), arg0, arg1, arg2)
 }
 
+// EmergencyDisableToken mocks base method.
+func (m *MockBridgeClient) EmergencyDisableToken(arg0 context.Context, arg1 types.AccAddress, arg2 client.TokenRef, arg3 string, arg4 time.Duration) (client.EmergencyTokenReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmergencyDisableToken", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(client.EmergencyTokenReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmergencyDisableToken indicates an expected call of EmergencyDisableToken.
+func (mr *MockBridgeClientMockRecorder) EmergencyDisableToken(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmergencyDisableToken", reflect.TypeOf((*MockBridgeClient)(nil).EmergencyDisableToken), arg0, arg1, arg2, arg3, arg4)
+}
+
+// EmergencyEnableToken mocks base method.
+func (m *MockBridgeClient) EmergencyEnableToken(arg0 context.Context, arg1 types.AccAddress, arg2 client.TokenRef, arg3 string) (client.EmergencyTokenReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmergencyEnableToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(client.EmergencyTokenReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmergencyEnableToken indicates an expected call of EmergencyEnableToken.
+func (mr *MockBridgeClientMockRecorder) EmergencyEnableToken(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmergencyEnableToken", reflect.TypeOf((*MockBridgeClient)(nil).EmergencyEnableToken), arg0, arg1, arg2, arg3)
+}
+
+// ExportSnapshot mocks base method.
+func (m *MockBridgeClient) ExportSnapshot(arg0 context.Context, arg1 []types.AccAddress) (client.BridgeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(client.BridgeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSnapshot indicates an expected call of ExportSnapshot.
+func (mr *MockBridgeClientMockRecorder) ExportSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSnapshot", reflect.TypeOf((*MockBridgeClient)(nil).ExportSnapshot), arg0, arg1)
+}
+
+// ExportTokenRegistry mocks base method.
+func (m *MockBridgeClient) ExportTokenRegistry(arg0 context.Context) (client.TokenRegistry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTokenRegistry", arg0)
+	ret0, _ := ret[0].(client.TokenRegistry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTokenRegistry indicates an expected call of ExportTokenRegistry.
+func (mr *MockBridgeClientMockRecorder) ExportTokenRegistry(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTokenRegistry", reflect.TypeOf((*MockBridgeClient)(nil).ExportTokenRegistry), arg0)
+}
+
+// ForceCompleteOperation mocks base method.
+func (m *MockBridgeClient) ForceCompleteOperation(arg0 context.Context, arg1 types.AccAddress, arg2 uint32, arg3 string, arg4 coreum.TransactionResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceCompleteOperation", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceCompleteOperation indicates an expected call of ForceCompleteOperation.
+func (mr *MockBridgeClientMockRecorder) ForceCompleteOperation(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceCompleteOperation", reflect.TypeOf((*MockBridgeClient)(nil).ForceCompleteOperation), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetAllTokens mocks base method.
 func (m *MockBridgeClient) GetAllTokens(arg0 context.Context) ([]coreum.CoreumToken, []coreum.XRPLToken, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +252,21 @@ func (mr *MockBridgeClientMockRecorder) GetAllTokens(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTokens", reflect.TypeOf((*MockBridgeClient)(nil).GetAllTokens), arg0)
 }
 
+// GetAvailableTickets mocks base method.
+func (m *MockBridgeClient) GetAvailableTickets(arg0 context.Context) ([]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableTickets", arg0)
+	ret0, _ := ret[0].([]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableTickets indicates an expected call of GetAvailableTickets.
+func (mr *MockBridgeClientMockRecorder) GetAvailableTickets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableTickets", reflect.TypeOf((*MockBridgeClient)(nil).GetAvailableTickets), arg0)
+}
+
 // GetContractConfig mocks base method.
 func (m *MockBridgeClient) GetContractConfig(arg0 context.Context) (coreum.ContractConfig, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +297,21 @@ func (mr *MockBridgeClientMockRecorder) GetContractOwnership(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractOwnership", reflect.TypeOf((*MockBridgeClient)(nil).GetContractOwnership), arg0)
 }
 
+// GetCoreumAccountInfo mocks base method.
+func (m *MockBridgeClient) GetCoreumAccountInfo(arg0 context.Context, arg1 types.AccAddress) (client.CoreumAccountInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumAccountInfo", arg0, arg1)
+	ret0, _ := ret[0].(client.CoreumAccountInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumAccountInfo indicates an expected call of GetCoreumAccountInfo.
+func (mr *MockBridgeClientMockRecorder) GetCoreumAccountInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumAccountInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetCoreumAccountInfo), arg0, arg1)
+}
+
 // GetCoreumBalances mocks base method.
 func (m *MockBridgeClient) GetCoreumBalances(arg0 context.Context, arg1 types.AccAddress) (types.Coins, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +327,21 @@ func (mr *MockBridgeClientMockRecorder) GetCoreumBalances(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumBalances", reflect.TypeOf((*MockBridgeClient)(nil).GetCoreumBalances), arg0, arg1)
 }
 
+// GetCoreumDenomDecimals mocks base method.
+func (m *MockBridgeClient) GetCoreumDenomDecimals(arg0 context.Context, arg1 string) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumDenomDecimals", arg0, arg1)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumDenomDecimals indicates an expected call of GetCoreumDenomDecimals.
+func (mr *MockBridgeClientMockRecorder) GetCoreumDenomDecimals(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumDenomDecimals", reflect.TypeOf((*MockBridgeClient)(nil).GetCoreumDenomDecimals), arg0, arg1)
+}
+
 // GetCoreumToXRPLTracingInfo mocks base method.
 func (m *MockBridgeClient) GetCoreumToXRPLTracingInfo(arg0 context.Context, arg1 string) (client.CoreumToXRPLTracingInfo, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +417,51 @@ func (mr *MockBridgeClientMockRecorder) GetProhibitedXRPLAddresses(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProhibitedXRPLAddresses", reflect.TypeOf((*MockBridgeClient)(nil).GetProhibitedXRPLAddresses), arg0)
 }
 
+// GetSignatureProgress mocks base method.
+func (m *MockBridgeClient) GetSignatureProgress(arg0 context.Context, arg1 uint32) (coreum.SignatureProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignatureProgress", arg0, arg1)
+	ret0, _ := ret[0].(coreum.SignatureProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignatureProgress indicates an expected call of GetSignatureProgress.
+func (mr *MockBridgeClientMockRecorder) GetSignatureProgress(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignatureProgress", reflect.TypeOf((*MockBridgeClient)(nil).GetSignatureProgress), arg0, arg1)
+}
+
+// GetTokenPairs mocks base method.
+func (m *MockBridgeClient) GetTokenPairs(arg0 context.Context) ([]client.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenPairs", arg0)
+	ret0, _ := ret[0].([]client.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenPairs indicates an expected call of GetTokenPairs.
+func (mr *MockBridgeClientMockRecorder) GetTokenPairs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenPairs", reflect.TypeOf((*MockBridgeClient)(nil).GetTokenPairs), arg0)
+}
+
+// GetTokenStateChangeReport mocks base method.
+func (m *MockBridgeClient) GetTokenStateChangeReport(arg0 context.Context, arg1, arg2, arg3 string, arg4 int64) (client.TokenStateChangeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenStateChangeReport", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(client.TokenStateChangeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenStateChangeReport indicates an expected call of GetTokenStateChangeReport.
+func (mr *MockBridgeClientMockRecorder) GetTokenStateChangeReport(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenStateChangeReport", reflect.TypeOf((*MockBridgeClient)(nil).GetTokenStateChangeReport), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetTransactionEvidences mocks base method.
 func (m *MockBridgeClient) GetTransactionEvidences(arg0 context.Context) ([]coreum.TransactionEvidence, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +477,65 @@ func (mr *MockBridgeClientMockRecorder) GetTransactionEvidences(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionEvidences", reflect.TypeOf((*MockBridgeClient)(nil).GetTransactionEvidences), arg0)
 }
 
+// GetTransferHistory mocks base method.
+func (m *MockBridgeClient) GetTransferHistory(arg0 context.Context, arg1 client.HistoryFilter) ([]client.TransferEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferHistory", arg0, arg1)
+	ret0, _ := ret[0].([]client.TransferEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferHistory indicates an expected call of GetTransferHistory.
+func (mr *MockBridgeClientMockRecorder) GetTransferHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferHistory", reflect.TypeOf((*MockBridgeClient)(nil).GetTransferHistory), arg0, arg1)
+}
+
+// GetUnsignedPendingOperations mocks base method.
+func (m *MockBridgeClient) GetUnsignedPendingOperations(arg0 context.Context, arg1 types.AccAddress) (processes.UnsignedOperations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsignedPendingOperations", arg0, arg1)
+	ret0, _ := ret[0].(processes.UnsignedOperations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsignedPendingOperations indicates an expected call of GetUnsignedPendingOperations.
+func (mr *MockBridgeClientMockRecorder) GetUnsignedPendingOperations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsignedPendingOperations", reflect.TypeOf((*MockBridgeClient)(nil).GetUnsignedPendingOperations), arg0, arg1)
+}
+
+// GetVersionInfo mocks base method.
+func (m *MockBridgeClient) GetVersionInfo(arg0 context.Context) client.VersionInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionInfo", arg0)
+	ret0, _ := ret[0].(client.VersionInfo)
+	return ret0
+}
+
+// GetVersionInfo indicates an expected call of GetVersionInfo.
+func (mr *MockBridgeClientMockRecorder) GetVersionInfo(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionInfo", reflect.TypeOf((*MockBridgeClient)(nil).GetVersionInfo), arg0)
+}
+
+// GetXRPLAccountReserves mocks base method.
+func (m *MockBridgeClient) GetXRPLAccountReserves(arg0 context.Context, arg1 data.Account) (xrpl.AccountReserves, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLAccountReserves", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.AccountReserves)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLAccountReserves indicates an expected call of GetXRPLAccountReserves.
+func (mr *MockBridgeClientMockRecorder) GetXRPLAccountReserves(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLAccountReserves", reflect.TypeOf((*MockBridgeClient)(nil).GetXRPLAccountReserves), arg0, arg1)
+}
+
 // GetXRPLBalances mocks base method.
 func (m *MockBridgeClient) GetXRPLBalances(arg0 context.Context, arg1 data.Account) ([]data.Amount, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +551,21 @@ func (mr *MockBridgeClientMockRecorder) GetXRPLBalances(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLBalances", reflect.TypeOf((*MockBridgeClient)(nil).GetXRPLBalances), arg0, arg1)
 }
 
+// GetXRPLTicketsReserve mocks base method.
+func (m *MockBridgeClient) GetXRPLTicketsReserve(arg0 context.Context, arg1 data.Account, arg2 uint32) (client.XRPLTicketsReserve, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLTicketsReserve", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.XRPLTicketsReserve)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLTicketsReserve indicates an expected call of GetXRPLTicketsReserve.
+func (mr *MockBridgeClientMockRecorder) GetXRPLTicketsReserve(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLTicketsReserve", reflect.TypeOf((*MockBridgeClient)(nil).GetXRPLTicketsReserve), arg0, arg1, arg2)
+}
+
 // GetXRPLToCoreumTracingInfo mocks base method.
 func (m *MockBridgeClient) GetXRPLToCoreumTracingInfo(arg0 context.Context, arg1 string) (client.XRPLToCoreumTracingInfo, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +595,79 @@ func (mr *MockBridgeClientMockRecorder) HaltBridge(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HaltBridge", reflect.TypeOf((*MockBridgeClient)(nil).HaltBridge), arg0, arg1)
 }
 
+// ImportSnapshot mocks base method.
+func (m *MockBridgeClient) ImportSnapshot(arg0 context.Context, arg1 types.AccAddress, arg2 client.BridgeSnapshot, arg3 bool) (client.SnapshotImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(client.SnapshotImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSnapshot indicates an expected call of ImportSnapshot.
+func (mr *MockBridgeClientMockRecorder) ImportSnapshot(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSnapshot", reflect.TypeOf((*MockBridgeClient)(nil).ImportSnapshot), arg0, arg1, arg2, arg3)
+}
+
+// ImportTokenRegistry mocks base method.
+func (m *MockBridgeClient) ImportTokenRegistry(arg0 context.Context, arg1 types.AccAddress, arg2 client.TokenRegistry, arg3 bool) ([]client.TokenImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTokenRegistry", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]client.TokenImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTokenRegistry indicates an expected call of ImportTokenRegistry.
+func (mr *MockBridgeClientMockRecorder) ImportTokenRegistry(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTokenRegistry", reflect.TypeOf((*MockBridgeClient)(nil).ImportTokenRegistry), arg0, arg1, arg2, arg3)
+}
+
+// ListStuckTokenRegistrations mocks base method.
+func (m *MockBridgeClient) ListStuckTokenRegistrations(arg0 context.Context, arg1 time.Duration) ([]client.StuckTokenRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStuckTokenRegistrations", arg0, arg1)
+	ret0, _ := ret[0].([]client.StuckTokenRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStuckTokenRegistrations indicates an expected call of ListStuckTokenRegistrations.
+func (mr *MockBridgeClientMockRecorder) ListStuckTokenRegistrations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStuckTokenRegistrations", reflect.TypeOf((*MockBridgeClient)(nil).ListStuckTokenRegistrations), arg0, arg1)
+}
+
+// MigrateXRPLToken mocks base method.
+func (m *MockBridgeClient) MigrateXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateXRPLToken", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateXRPLToken indicates an expected call of MigrateXRPLToken.
+func (mr *MockBridgeClientMockRecorder) MigrateXRPLToken(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).MigrateXRPLToken), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// RecoverStuckXRPLTokenRegistration mocks base method.
+func (m *MockBridgeClient) RecoverStuckXRPLTokenRegistration(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 string, arg4 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverStuckXRPLTokenRegistration", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecoverStuckXRPLTokenRegistration indicates an expected call of RecoverStuckXRPLTokenRegistration.
+func (mr *MockBridgeClientMockRecorder) RecoverStuckXRPLTokenRegistration(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverStuckXRPLTokenRegistration", reflect.TypeOf((*MockBridgeClient)(nil).RecoverStuckXRPLTokenRegistration), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RecoverTickets mocks base method.
 func (m *MockBridgeClient) RecoverTickets(arg0 context.Context, arg1 types.AccAddress, arg2 *uint32) error {
 	m.ctrl.T.Helper()
@@ -341,33 +697,77 @@ func (mr *MockBridgeClientMockRecorder) RecoverXRPLTokenRegistration(arg0, arg1,
 }
 
 // RegisterCoreumToken mocks base method.
-func (m *MockBridgeClient) RegisterCoreumToken(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 uint32, arg4 int32, arg5, arg6 math.Int) (coreum.CoreumToken, error) {
+func (m *MockBridgeClient) RegisterCoreumToken(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 uint32, arg4 int32, arg5, arg6 math.Int, arg7 *uint32) (coreum.CoreumToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterCoreumToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "RegisterCoreumToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(coreum.CoreumToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterCoreumToken indicates an expected call of RegisterCoreumToken.
-func (mr *MockBridgeClientMockRecorder) RegisterCoreumToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+func (mr *MockBridgeClientMockRecorder) RegisterCoreumToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCoreumToken", reflect.TypeOf((*MockBridgeClient)(nil).RegisterCoreumToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCoreumToken", reflect.TypeOf((*MockBridgeClient)(nil).RegisterCoreumToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
 // RegisterXRPLToken mocks base method.
-func (m *MockBridgeClient) RegisterXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2 data.Account, arg3 data.Currency, arg4 int32, arg5, arg6 math.Int) (coreum.XRPLToken, error) {
+func (m *MockBridgeClient) RegisterXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2 data.Account, arg3 data.Currency, arg4 int32, arg5, arg6 math.Int, arg7 *uint32, arg8 *coreum.XRPLTokenDeliveryMode) (coreum.XRPLToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterXRPLToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "RegisterXRPLToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	ret0, _ := ret[0].(coreum.XRPLToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RegisterXRPLToken indicates an expected call of RegisterXRPLToken.
-func (mr *MockBridgeClientMockRecorder) RegisterXRPLToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+func (mr *MockBridgeClientMockRecorder) RegisterXRPLToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).RegisterXRPLToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// RegisterXRPLTokenBatch mocks base method.
+func (m *MockBridgeClient) RegisterXRPLTokenBatch(arg0 context.Context, arg1 types.AccAddress, arg2 []coreum.XRPLTokenRegistrationRequest, arg3 int) ([]coreum.XRPLToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterXRPLTokenBatch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]coreum.XRPLToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterXRPLTokenBatch indicates an expected call of RegisterXRPLTokenBatch.
+func (mr *MockBridgeClientMockRecorder) RegisterXRPLTokenBatch(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterXRPLTokenBatch", reflect.TypeOf((*MockBridgeClient)(nil).RegisterXRPLTokenBatch), arg0, arg1, arg2, arg3)
+}
+
+// RemoveMigratedXRPLToken mocks base method.
+func (m *MockBridgeClient) RemoveMigratedXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMigratedXRPLToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMigratedXRPLToken indicates an expected call of RemoveMigratedXRPLToken.
+func (mr *MockBridgeClientMockRecorder) RemoveMigratedXRPLToken(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMigratedXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).RemoveMigratedXRPLToken), arg0, arg1, arg2, arg3)
+}
+
+// Replay mocks base method.
+func (m *MockBridgeClient) Replay(arg0 context.Context, arg1 client.ReplayConfig) (client.ReplaySummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", arg0, arg1)
+	ret0, _ := ret[0].(client.ReplaySummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockBridgeClientMockRecorder) Replay(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).RegisterXRPLToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockBridgeClient)(nil).Replay), arg0, arg1)
 }
 
 // ResumeBridge mocks base method.
@@ -398,6 +798,35 @@ func (mr *MockBridgeClientMockRecorder) RotateKeys(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKeys", reflect.TypeOf((*MockBridgeClient)(nil).RotateKeys), arg0, arg1, arg2)
 }
 
+// SaveOperationSignatures mocks base method.
+func (m *MockBridgeClient) SaveOperationSignatures(arg0 context.Context, arg1 types.AccAddress, arg2 processes.OperationSignatures) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOperationSignatures", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOperationSignatures indicates an expected call of SaveOperationSignatures.
+func (mr *MockBridgeClientMockRecorder) SaveOperationSignatures(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOperationSignatures", reflect.TypeOf((*MockBridgeClient)(nil).SaveOperationSignatures), arg0, arg1, arg2)
+}
+
+// SendBatchToXRPL mocks base method.
+func (m *MockBridgeClient) SendBatchToXRPL(arg0 context.Context, arg1 types.AccAddress, arg2 []client.SendLeg) (client.BatchReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBatchToXRPL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.BatchReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendBatchToXRPL indicates an expected call of SendBatchToXRPL.
+func (mr *MockBridgeClientMockRecorder) SendBatchToXRPL(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBatchToXRPL", reflect.TypeOf((*MockBridgeClient)(nil).SendBatchToXRPL), arg0, arg1, arg2)
+}
+
 // SendFromCoreumToXRPL mocks base method.
 func (m *MockBridgeClient) SendFromCoreumToXRPL(arg0 context.Context, arg1 types.AccAddress, arg2 data.Account, arg3 types.Coin, arg4 *math.Int) (string, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +857,21 @@ func (mr *MockBridgeClientMockRecorder) SendFromXRPLToCoreum(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFromXRPLToCoreum", reflect.TypeOf((*MockBridgeClient)(nil).SendFromXRPLToCoreum), arg0, arg1, arg2, arg3)
 }
 
+// SendFromXRPLToCoreumWithAMMRouting mocks base method.
+func (m *MockBridgeClient) SendFromXRPLToCoreumWithAMMRouting(arg0 context.Context, arg1 string, arg2 data.Amount, arg3 types.AccAddress) (client.AMMRoutedXRPLToCoreumSend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFromXRPLToCoreumWithAMMRouting", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(client.AMMRoutedXRPLToCoreumSend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFromXRPLToCoreumWithAMMRouting indicates an expected call of SendFromXRPLToCoreumWithAMMRouting.
+func (mr *MockBridgeClientMockRecorder) SendFromXRPLToCoreumWithAMMRouting(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFromXRPLToCoreumWithAMMRouting", reflect.TypeOf((*MockBridgeClient)(nil).SendFromXRPLToCoreumWithAMMRouting), arg0, arg1, arg2, arg3)
+}
+
 // SetXRPLTrustSet mocks base method.
 func (m *MockBridgeClient) SetXRPLTrustSet(arg0 context.Context, arg1 string, arg2 data.Amount) error {
 	m.ctrl.T.Helper()
@@ -442,18 +886,47 @@ func (mr *MockBridgeClientMockRecorder) SetXRPLTrustSet(arg0, arg1, arg2 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetXRPLTrustSet", reflect.TypeOf((*MockBridgeClient)(nil).SetXRPLTrustSet), arg0, arg1, arg2)
 }
 
+// SweepRelayerFees mocks base method.
+func (m *MockBridgeClient) SweepRelayerFees(arg0 context.Context, arg1 []types.AccAddress) (types.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepRelayerFees", arg0, arg1)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepRelayerFees indicates an expected call of SweepRelayerFees.
+func (mr *MockBridgeClientMockRecorder) SweepRelayerFees(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepRelayerFees", reflect.TypeOf((*MockBridgeClient)(nil).SweepRelayerFees), arg0, arg1)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockBridgeClient) TransferOwnership(arg0 context.Context, arg1, arg2 types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockBridgeClientMockRecorder) TransferOwnership(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockBridgeClient)(nil).TransferOwnership), arg0, arg1, arg2)
+}
+
 // UpdateCoreumToken mocks base method.
-func (m *MockBridgeClient) UpdateCoreumToken(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 *coreum.TokenState, arg4 *int32, arg5, arg6 *math.Int) error {
+func (m *MockBridgeClient) UpdateCoreumToken(arg0 context.Context, arg1 types.AccAddress, arg2 string, arg3 *coreum.TokenState, arg4 *int32, arg5, arg6 *math.Int, arg7 *uint32, arg8 *math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCoreumToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "UpdateCoreumToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateCoreumToken indicates an expected call of UpdateCoreumToken.
-func (mr *MockBridgeClientMockRecorder) UpdateCoreumToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+func (mr *MockBridgeClientMockRecorder) UpdateCoreumToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCoreumToken", reflect.TypeOf((*MockBridgeClient)(nil).UpdateCoreumToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCoreumToken", reflect.TypeOf((*MockBridgeClient)(nil).UpdateCoreumToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 }
 
 // UpdateProhibitedXRPLAddresses mocks base method.
@@ -485,17 +958,61 @@ func (mr *MockBridgeClientMockRecorder) UpdateXRPLBaseFee(arg0, arg1, arg2 any)
 }
 
 // UpdateXRPLToken mocks base method.
-func (m *MockBridgeClient) UpdateXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 string, arg4 *coreum.TokenState, arg5 *int32, arg6, arg7 *math.Int) error {
+func (m *MockBridgeClient) UpdateXRPLToken(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 string, arg4 *coreum.TokenState, arg5 *int32, arg6, arg7 *math.Int, arg8 *uint32, arg9 *math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateXRPLToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret := m.ctrl.Call(m, "UpdateXRPLToken", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateXRPLToken indicates an expected call of UpdateXRPLToken.
-func (mr *MockBridgeClientMockRecorder) UpdateXRPLToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 any) *gomock.Call {
+func (mr *MockBridgeClientMockRecorder) UpdateXRPLToken(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).UpdateXRPLToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
+
+// ValidateCoreumDenomSupply mocks base method.
+func (m *MockBridgeClient) ValidateCoreumDenomSupply(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCoreumDenomSupply", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCoreumDenomSupply indicates an expected call of ValidateCoreumDenomSupply.
+func (mr *MockBridgeClientMockRecorder) ValidateCoreumDenomSupply(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCoreumDenomSupply", reflect.TypeOf((*MockBridgeClient)(nil).ValidateCoreumDenomSupply), arg0, arg1)
+}
+
+// VerifyXRPLBridgeAccount mocks base method.
+func (m *MockBridgeClient) VerifyXRPLBridgeAccount(arg0 context.Context) ([]xrpl.BridgeAccountMisconfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyXRPLBridgeAccount", arg0)
+	ret0, _ := ret[0].([]xrpl.BridgeAccountMisconfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyXRPLBridgeAccount indicates an expected call of VerifyXRPLBridgeAccount.
+func (mr *MockBridgeClientMockRecorder) VerifyXRPLBridgeAccount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyXRPLBridgeAccount", reflect.TypeOf((*MockBridgeClient)(nil).VerifyXRPLBridgeAccount), arg0)
+}
+
+// WaitForBatch mocks base method.
+func (m *MockBridgeClient) WaitForBatch(arg0 context.Context, arg1 client.BatchReport, arg2 time.Duration) (client.BatchReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForBatch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.BatchReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForBatch indicates an expected call of WaitForBatch.
+func (mr *MockBridgeClientMockRecorder) WaitForBatch(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateXRPLToken", reflect.TypeOf((*MockBridgeClient)(nil).UpdateXRPLToken), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForBatch", reflect.TypeOf((*MockBridgeClient)(nil).WaitForBatch), arg0, arg1, arg2)
 }
 
 // MockRunner is a mock of Runner interface.
@@ -5,18 +5,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	krflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
 
 	coreumapp "github.com/CoreumFoundation/coreum/v4/app"
 	"github.com/CoreumFoundation/coreum/v4/pkg/config"
@@ -24,6 +33,10 @@ import (
 	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli"
 	overridecryptokeyring "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli/cosmos/override/crypto/keyring"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/metrics"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -32,6 +45,214 @@ func TestInitCmd(t *testing.T) {
 	initConfig(t)
 }
 
+func TestInitCmd_EnvVars(t *testing.T) {
+	configPath := path.Join(t.TempDir(), "config-path")
+	contractAddress := sdk.MustBech32ifyAddressBytes(
+		constant.AddressPrefixMain, sdk.AccAddress(bytes.Repeat([]byte{1}, 20)),
+	)
+	t.Setenv(cli.InitEnvVarName(cli.FlagHome), configPath)
+	t.Setenv(cli.InitEnvVarName(cli.FlagCoreumGRPCURL), "env-grpc:9090")
+	t.Setenv(cli.InitEnvVarName(cli.FlagCoreumRPCURL), "http://env-rpc:26657")
+	t.Setenv(cli.InitEnvVarName(cli.FlagCoreumContractAddress), contractAddress)
+	t.Setenv(cli.InitEnvVarName(cli.FlagXRPLRPCURL), "http://env-xrpl:5005")
+	t.Setenv(cli.InitEnvVarName(cli.FlagXRPLKeyName), "env-xrpl-key")
+	t.Setenv(cli.InitEnvVarName(cli.FlagXRPLRecentScanWindow), "500")
+	t.Setenv(cli.InitEnvVarName(cli.FlagMetricsEnabled), "true")
+
+	// the flags take precedence over the env vars
+	out := executeCmd(t, cli.InitCmd(),
+		flagWithPrefix(cli.FlagCoreumGRPCURL), "flag-grpc:9090",
+		flagWithPrefix(cli.FlagXRPLKeyName), "flag-xrpl-key",
+	)
+	require.Contains(t, out, path.Join(configPath, runner.ConfigFileName))
+	require.Contains(t, out, "coreum.grpc.url: flag-grpc:9090")
+	require.Contains(t, out, "xrpl.scanner.recent_scan_window: 500")
+	require.NotContains(t, out, "coreum.relayer_key_name")
+
+	cfg, err := cli.GetHomeRunnerConfig(newHomeCmd(t, flagWithPrefix(cli.FlagHome), configPath))
+	require.NoError(t, err)
+	defaultCfg := runner.DefaultConfig()
+	require.Equal(t, "flag-grpc:9090", cfg.Coreum.GRPC.URL)
+	require.Equal(t, "http://env-rpc:26657", cfg.Coreum.RPC.URL)
+	require.Equal(t, contractAddress, cfg.Coreum.Contract.ContractAddress)
+	require.Equal(t, defaultCfg.Coreum.RelayerKeyName, cfg.Coreum.RelayerKeyName)
+	require.Equal(t, "http://env-xrpl:5005", cfg.XRPL.RPC.URL)
+	require.Equal(t, "flag-xrpl-key", cfg.XRPL.MultiSignerKeyName)
+	require.Equal(t, int64(500), cfg.XRPL.Scanner.RecentScanWindow)
+	require.True(t, cfg.Metrics.Enabled)
+	require.Equal(t, defaultCfg.Metrics.Server.ListenAddress, cfg.Metrics.Server.ListenAddress)
+
+	// the invalid env var value is rejected
+	t.Setenv(cli.InitEnvVarName(cli.FlagMetricsEnabled), "not-bool")
+	_, err = executeCmdWithOutputOptionAndError(
+		cli.InitCmd(), "text", flagWithPrefix(cli.FlagHome), path.Join(t.TempDir(), "config-path"),
+	)
+	require.ErrorContains(t, err, cli.InitEnvVarName(cli.FlagMetricsEnabled))
+}
+
+func TestInitCmd_Overwrite(t *testing.T) {
+	args := initConfig(t)
+	configFilePath := path.Join(args[1], runner.ConfigFileName)
+
+	_, err := executeCmdWithOutputOptionAndError(
+		cli.InitCmd(), "text", append(args, flagWithPrefix(cli.FlagXRPLRPCURL), "http://localhost:5005")...,
+	)
+	require.ErrorContains(t, err, "already exists")
+	cfg, err := cli.GetHomeRunnerConfig(newHomeCmd(t, args...))
+	require.NoError(t, err)
+	require.Empty(t, cfg.XRPL.RPC.URL)
+
+	out := executeCmd(t, cli.InitCmd(), append(
+		args, flagWithPrefix(cli.FlagXRPLRPCURL), "http://localhost:5005", flagWithPrefix(cli.FlagOverwrite),
+	)...)
+	require.Contains(t, out, configFilePath)
+	cfg, err = cli.GetHomeRunnerConfig(newHomeCmd(t, args...))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:5005", cfg.XRPL.RPC.URL)
+}
+
+func TestInitCmd_InvalidValues(t *testing.T) {
+	devContractAddress := sdk.MustBech32ifyAddressBytes(
+		constant.AddressPrefixDev, sdk.AccAddress(bytes.Repeat([]byte{1}, 20)),
+	)
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "grpc_url_without_port",
+			args:          []string{flagWithPrefix(cli.FlagCoreumGRPCURL), "localhost"},
+			expectedError: "invalid coreum GRPC URL",
+		},
+		{
+			name:          "grpc_url_with_invalid_scheme",
+			args:          []string{flagWithPrefix(cli.FlagCoreumGRPCURL), "ftp://localhost:9090"},
+			expectedError: "invalid coreum GRPC URL",
+		},
+		{
+			name:          "rpc_url_without_scheme",
+			args:          []string{flagWithPrefix(cli.FlagCoreumRPCURL), "localhost:26657"},
+			expectedError: "invalid coreum RPC URL",
+		},
+		{
+			name:          "xrpl_rpc_url_with_invalid_scheme",
+			args:          []string{flagWithPrefix(cli.FlagXRPLRPCURL), "ws://localhost:6006"},
+			expectedError: "invalid XRPL RPC URL",
+		},
+		{
+			name:          "invalid_contract_address",
+			args:          []string{flagWithPrefix(cli.FlagCoreumContractAddress), "core1invalid"},
+			expectedError: "invalid contract address",
+		},
+		{
+			name:          "contract_address_of_other_network",
+			args:          []string{flagWithPrefix(cli.FlagCoreumContractAddress), devContractAddress},
+			expectedError: "invalid contract address",
+		},
+		{
+			name:          "empty_key_name",
+			args:          []string{flagWithPrefix(cli.FlagCoreumKeyName), ""},
+			expectedError: "coreum relayer key name must not be empty",
+		},
+		{
+			name:          "non_positive_recent_scan_window",
+			args:          []string{flagWithPrefix(cli.FlagXRPLRecentScanWindow), "0"},
+			expectedError: "recent scan window must be positive",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := path.Join(t.TempDir(), "config-path")
+			_, err := executeCmdWithOutputOptionAndError(
+				cli.InitCmd(), "text", append(tt.args, flagWithPrefix(cli.FlagHome), configPath)...,
+			)
+			require.ErrorContains(t, err, tt.expectedError)
+			require.NoFileExists(t, path.Join(configPath, runner.ConfigFileName))
+		})
+	}
+}
+
+func TestInitCmd_Demo(t *testing.T) {
+	var (
+		mu                  sync.Mutex
+		coreumFundedAddress []string
+		xrplFundedAddress   []string
+	)
+	faucetServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch r.URL.Path {
+		case "/coreum":
+			coreumFundedAddress = append(coreumFundedAddress, req["address"])
+			_, err := w.Write([]byte(`{"txHash":"hash"}`))
+			require.NoError(t, err)
+		case "/xrpl":
+			xrplFundedAddress = append(xrplFundedAddress, req["destination"])
+			_, err := w.Write([]byte(`{"amount":1000,"transactionHash":"hash"}`))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(faucetServer.Close)
+
+	configPath := path.Join(t.TempDir(), "config-path")
+	keyringDir := t.TempDir()
+	homeArgs := append([]string{flagWithPrefix(cli.FlagHome), configPath}, testKeyringFlags(keyringDir)...)
+	contractAddress := sdk.MustBech32ifyAddressBytes(
+		constant.AddressPrefixDev, sdk.AccAddress(bytes.Repeat([]byte{1}, 20)),
+	)
+	// the unavailable gRPC endpoint is used to check that the runner fails on the contract config request only
+	demoArgs := append([]string{
+		flagWithPrefix(cli.FlagDemo),
+		flagWithPrefix(cli.FlagCoreumChainID), string(constant.ChainIDDev),
+		flagWithPrefix(cli.FlagCoreumContractAddress), contractAddress,
+		flagWithPrefix(cli.FlagCoreumGRPCURL), "localhost:1",
+		flagWithPrefix(cli.FlagXRPLRPCURL), "http://localhost:1",
+		flagWithPrefix(cli.FlagCoreumFaucetURL), faucetServer.URL + "/coreum",
+		flagWithPrefix(cli.FlagXRPLFaucetURL), faucetServer.URL + "/xrpl",
+	}, homeArgs...)
+
+	executeCmd(t, cli.InitCmd(), demoArgs...)
+	require.FileExists(t, path.Join(configPath, runner.ConfigFileName))
+	require.Len(t, coreumFundedAddress, 1)
+	require.Len(t, xrplFundedAddress, 1)
+	require.True(t, strings.HasPrefix(coreumFundedAddress[0], constant.AddressPrefixDev))
+	require.True(t, strings.HasPrefix(xrplFundedAddress[0], "r"))
+
+	// the re-run keeps the config and keys and funds the same accounts
+	executeCmd(t, cli.InitCmd(), demoArgs...)
+	require.Equal(t, []string{coreumFundedAddress[0], coreumFundedAddress[0]}, coreumFundedAddress)
+	require.Equal(t, []string{xrplFundedAddress[0], xrplFundedAddress[0]}, xrplFundedAddress)
+
+	cfg, err := cli.GetHomeRunnerConfig(newHomeCmd(t, homeArgs...))
+	require.NoError(t, err)
+	require.Equal(t, string(constant.ChainIDDev), cfg.Coreum.Network.ChainID)
+	require.Equal(t, contractAddress, cfg.Coreum.Contract.ContractAddress)
+	require.Equal(t, "localhost:1", cfg.Coreum.GRPC.URL)
+	require.Equal(t, "http://localhost:1", cfg.XRPL.RPC.URL)
+
+	// the config and keys are loaded, and the runner requests the contract config from the chain
+	_, err = cli.NewRunnerFromHome(newHomeCmd(t, homeArgs...))
+	require.ErrorContains(t, err, "failed to get contract config for the runner initialization")
+
+	// the mainnet is refused
+	mainnetArgs := append([]string{
+		flagWithPrefix(cli.FlagDemo),
+		flagWithPrefix(cli.FlagCoreumChainID), string(constant.ChainIDMain),
+		flagWithPrefix(cli.FlagCoreumContractAddress), contractAddress,
+		flagWithPrefix(cli.FlagHome), path.Join(t.TempDir(), "config-path"),
+	}, testKeyringFlags(t.TempDir())...)
+	_, err = executeCmdWithOutputOptionAndError(cli.InitCmd(), "text", mainnetArgs...)
+	require.ErrorContains(t, err, "the demo mode can't be used with the mainnet chain ID")
+	require.Len(t, coreumFundedAddress, 2)
+}
+
 func TestStartCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -44,6 +265,23 @@ func TestStartCmd(t *testing.T) {
 	executeCmd(t, cmd, initConfig(t)...) // to disable telemetry server
 }
 
+func TestConfigValidateCmd(t *testing.T) {
+	args := initConfig(t)
+	out := executeCmd(t, cli.ConfigValidateCmd(), args...)
+
+	expectedCfgBytes, err := yaml.Marshal(runner.DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, string(expectedCfgBytes), out)
+
+	// add unknown field
+	configFilePath := runner.BuildFilePath(args[1])
+	cfgBytes, err := os.ReadFile(configFilePath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(configFilePath, append(cfgBytes, []byte("unknown_field: 1\n")...), 0o600))
+	_, err = executeCmdWithOutputOptionAndError(cli.ConfigValidateCmd(), "text", args...)
+	require.ErrorContains(t, err, "field unknown_field not found")
+}
+
 func TestKeyringCmds(t *testing.T) {
 	cmd, err := cli.KeyringCmd(cli.CoreumKeyringSuffix, constant.CoinType, overridecryptokeyring.CoreumAddressFormatter)
 	require.NoError(t, err)
@@ -68,6 +306,194 @@ func TestRelayerKeyInfoCmd(t *testing.T) {
 		sdk.GetConfig().GetFullBIP44Path())
 
 	executeCmd(t, cli.RelayerKeysCmd(), args...)
+	executeCmd(t, cli.RelayerKeysCmd(), append(args, flagWithPrefix(cli.FlagTarget), cli.CoreumKeyringSuffix)...)
+	executeCmd(t, cli.RelayerKeysCmd(), append(args, flagWithPrefix(cli.FlagTarget), cli.XRPLKeyringSuffix)...)
+	_, err := executeCmdWithOutputOptionAndError(
+		cli.RelayerKeysCmd(), "text", append(args, flagWithPrefix(cli.FlagTarget), "invalid")...,
+	)
+	require.ErrorContains(t, err, "invalid target")
+}
+
+func TestNewComponents_SplitKeyringBackends(t *testing.T) {
+	keyringDir := t.TempDir()
+	runnerCfg := runner.DefaultConfig()
+	// the XRPL keyring uses the memory backend, and the Coreum keyring falls back to the test backend from the flag
+	runnerCfg.XRPL.KeyringBackend = keyring.BackendMemory
+	homePath := path.Join(t.TempDir(), "config-path")
+	require.NoError(t, runner.InitConfig(homePath, runnerCfg))
+	args := append([]string{flagWithPrefix(cli.FlagHome), homePath}, testKeyringFlags(keyringDir)...)
+
+	coreumAddress := addKeyToTestKeyring(
+		t, keyringDir, runnerCfg.Coreum.RelayerKeyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path(),
+	)
+	// the key in the test backend of the XRPL keyring dir must not be used
+	addKeyToTestKeyring(t, keyringDir, runnerCfg.XRPL.MultiSignerKeyName, cli.XRPLKeyringSuffix, xrpl.XRPLHDPath)
+
+	components := newTestComponents(t, args...)
+	require.Equal(t, keyring.BackendTest, components.CoreumSDKClientCtx.Keyring.Backend())
+	require.Equal(t, keyring.BackendMemory, components.XRPLSDKClietCtx.Keyring.Backend())
+
+	coreumKeyRecord, err := components.CoreumClientCtx.Keyring().Key(runnerCfg.Coreum.RelayerKeyName)
+	require.NoError(t, err)
+	gotCoreumAddress, err := coreumKeyRecord.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, coreumAddress, gotCoreumAddress)
+
+	_, err = components.XRPLKeyringTxSigner.Account(runnerCfg.XRPL.MultiSignerKeyName)
+	require.Error(t, err)
+	xrplKeyRecord, _, err := components.XRPLSDKClietCtx.Keyring.NewMnemonic(
+		runnerCfg.XRPL.MultiSignerKeyName, keyring.English, xrpl.XRPLHDPath, "", hd.Secp256k1,
+	)
+	require.NoError(t, err)
+	xrplPubKey, err := xrplKeyRecord.GetPubKey()
+	require.NoError(t, err)
+	gotXRPLPubKey, err := components.XRPLKeyringTxSigner.PubKey(runnerCfg.XRPL.MultiSignerKeyName)
+	require.NoError(t, err)
+	require.Equal(t, xrplPubKey.Bytes(), gotXRPLPubKey.Bytes())
+	_, err = components.CoreumSDKClientCtx.Keyring.Key(runnerCfg.XRPL.MultiSignerKeyName)
+	require.Error(t, err)
+}
+
+func TestNewComponents_LegacyKeyringConfig(t *testing.T) {
+	keyringDir := t.TempDir()
+	args := initConfig(t)
+	// the config without the keyring backends uses the flag backend for both keyrings
+	configFilePath := path.Join(args[1], runner.ConfigFileName)
+	cfgBytes, err := os.ReadFile(configFilePath)
+	require.NoError(t, err)
+	legacyCfgBytes := bytes.ReplaceAll(cfgBytes, []byte("    keyring_backend: \"\"\n"), nil)
+	require.NotEqual(t, cfgBytes, legacyCfgBytes)
+	require.NoError(t, os.WriteFile(configFilePath, legacyCfgBytes, 0o600))
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	runnerDefaultCfg := runner.DefaultConfig()
+	addKeyToTestKeyring(t, keyringDir, runnerDefaultCfg.XRPL.MultiSignerKeyName, cli.XRPLKeyringSuffix, xrpl.XRPLHDPath)
+	addKeyToTestKeyring(t, keyringDir, runnerDefaultCfg.Coreum.RelayerKeyName, cli.CoreumKeyringSuffix,
+		sdk.GetConfig().GetFullBIP44Path())
+
+	components := newTestComponents(t, args...)
+	require.Equal(t, keyring.BackendTest, components.CoreumSDKClientCtx.Keyring.Backend())
+	require.Equal(t, keyring.BackendTest, components.XRPLSDKClietCtx.Keyring.Backend())
+	_, err = components.XRPLKeyringTxSigner.Account(runnerDefaultCfg.XRPL.MultiSignerKeyName)
+	require.NoError(t, err)
+
+	executeCmd(t, cli.RelayerKeysCmd(), args...)
+}
+
+func TestNewComponents_FeeFlags(t *testing.T) {
+	keyringDir := t.TempDir()
+	cfgFeeGranter := sdk.MustBech32ifyAddressBytes(constant.AddressPrefixMain, bytes.Repeat([]byte{1}, 20))
+	flagFeeGranter := sdk.MustBech32ifyAddressBytes(constant.AddressPrefixMain, bytes.Repeat([]byte{2}, 20))
+	runnerCfg := runner.DefaultConfig()
+	runnerCfg.Coreum.Contract.GasPrices = "0.0625ucore"
+	runnerCfg.Coreum.Contract.FeeGranter = cfgFeeGranter
+	homePath := path.Join(t.TempDir(), "config-path")
+	require.NoError(t, runner.InitConfig(homePath, runnerCfg))
+	args := append([]string{flagWithPrefix(cli.FlagHome), homePath}, testKeyringFlags(keyringDir)...)
+
+	// the runner config values are used if the flags are not set
+	components := newTestComponents(t, args...)
+	require.Equal(t, runnerCfg.Coreum.Contract, components.RunnerConfig.Coreum.Contract)
+
+	components = newTestComponents(t, append(
+		args,
+		flagWithPrefix(krflags.FlagGasPrices), "0.1ibc/ABC,0.5ucore",
+		flagWithPrefix(krflags.FlagGasAdjustment), "2.5",
+		flagWithPrefix(krflags.FlagFeeGranter), flagFeeGranter,
+	)...)
+	expectedContractCfg := runnerCfg.Coreum.Contract
+	expectedContractCfg.GasPrices = "0.1ibc/ABC,0.5ucore"
+	expectedContractCfg.GasAdjustment = 2.5
+	expectedContractCfg.FeeGranter = flagFeeGranter
+	require.Equal(t, expectedContractCfg, components.RunnerConfig.Coreum.Contract)
+
+	cmd := &cobra.Command{
+		Use: "components",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := cli.NewComponents(cmd, logger.NewZapLoggerFromLogger(zap.NewNop()))
+			return err
+		},
+	}
+	cli.AddHomeFlag(cmd)
+	cli.AddKeyringFlags(cmd)
+	cli.AddFeeFlags(cmd)
+	_, err := executeCmdWithOutputOptionAndError(
+		cmd, "text", append(args, flagWithPrefix(krflags.FlagGasPrices), "ucore")...,
+	)
+	require.ErrorContains(t, err, "invalid gas-prices")
+}
+
+func newTestComponents(t *testing.T, args ...string) runner.Components {
+	t.Helper()
+
+	var components runner.Components
+	cmd := &cobra.Command{
+		Use: "components",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			components, err = cli.NewComponents(cmd, logger.NewZapLoggerFromLogger(zap.NewNop()))
+			return err
+		},
+	}
+	cli.AddHomeFlag(cmd)
+	cli.AddKeyringFlags(cmd)
+	cli.AddFeeFlags(cmd)
+	executeCmd(t, cmd, args...)
+
+	return components
+}
+
+func TestOfflineSigningCmds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	args := append(initConfig(t), testKeyringFlags(keyringDir)...)
+	runnerDefaultCfg := runner.DefaultConfig()
+
+	addKeyToTestKeyring(t, keyringDir, runnerDefaultCfg.XRPL.MultiSignerKeyName, cli.XRPLKeyringSuffix, xrpl.XRPLHDPath)
+	relayerAddress := addKeyToTestKeyring(t, keyringDir, runnerDefaultCfg.Coreum.RelayerKeyName,
+		cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	unsignedOperations, err := processes.BuildUnsignedOperations(bridgeXRPLAddress, nil, relayerAddress,
+		[]coreum.Operation{{
+			Version:         1,
+			AccountSequence: 5,
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{
+					Number: 3,
+				},
+			},
+			XRPLBaseFee: xrpl.DefaultXRPLBaseFee,
+		}},
+		processes.MultiSigningTxFeeConfig{},
+	)
+	require.NoError(t, err)
+
+	unsignedPath := path.Join(t.TempDir(), "unsigned.json")
+	signedPath := path.Join(t.TempDir(), "signed.json")
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetUnsignedPendingOperations(gomock.Any(), relayerAddress).Return(unsignedOperations, nil)
+	executeCmd(t, cli.SignPendingCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(args, flagWithPrefix(cli.FlagOutput), unsignedPath)...)
+	require.FileExists(t, unsignedPath)
+
+	executeCmd(t, cli.SignOfflineCmd(),
+		append(args, flagWithPrefix(cli.FlagInput), unsignedPath, flagWithPrefix(cli.FlagOutput), signedPath)...)
+	signedBytes, err := os.ReadFile(signedPath)
+	require.NoError(t, err)
+	var signatures processes.OperationSignatures
+	require.NoError(t, json.Unmarshal(signedBytes, &signatures))
+	require.Len(t, signatures.Signatures, 1)
+	require.Equal(t, uint32(5), signatures.Signatures[0].OperationID)
+	require.Equal(t, uint32(1), signatures.Signatures[0].OperationVersion)
+	require.NotEmpty(t, signatures.Signatures[0].Signature)
+
+	bridgeClientMock.EXPECT().SaveOperationSignatures(gomock.Any(), relayerAddress, signatures).Return(nil)
+	executeCmd(t, cli.BroadcastSignaturesCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(args, flagWithPrefix(cli.FlagInput), signedPath)...)
 }
 
 func TestBootstrapCmd(t *testing.T) {
@@ -96,8 +522,18 @@ func TestBootstrapCmd(t *testing.T) {
 	executeCmd(t, cli.BootstrapBridgeCmd(mockBridgeClientProvider(nil)), args...)
 
 	// use generated file
+	progressFilePath := filepath.Join(homeArgs[1], bridgeclient.BootstrappingProgressFileName)
 	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().Bootstrap(gomock.Any(), gomock.Any(), xrplKeyName, bridgeclient.DefaultBootstrappingConfig())
+	bridgeClientMock.EXPECT().GetXRPLTicketsReserve(gomock.Any(), gomock.Any(), xrpl.MaxTicketsToAllocate).Return(
+		bridgeclient.XRPLTicketsReserve{
+			NumberOfTickets:      xrpl.MaxTicketsToAllocate,
+			BalanceDrops:         60_000_000,
+			RequiredReserveDrops: 51_200_000,
+		}, nil,
+	).Times(2)
+	bridgeClientMock.EXPECT().Bootstrap(
+		gomock.Any(), gomock.Any(), xrplKeyName, bridgeclient.DefaultBootstrappingConfig(), progressFilePath,
+	).Return(coreum.GenAccount(), nil).Times(2)
 	args = append([]string{
 		bootstrapConfigPath,
 		flagWithPrefix(cli.FlagXRPLKeyName), xrplKeyName,
@@ -105,6 +541,289 @@ func TestBootstrapCmd(t *testing.T) {
 	}, homeArgs...)
 	args = append(args, testKeyringFlags(keyringDir)...)
 	executeCmd(t, cli.BootstrapBridgeCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+
+	// restart removes the progress file
+	require.NoError(t, os.WriteFile(progressFilePath, []byte("xrpl_account_funded: true"), 0o600))
+	args = append(args, flagWithPrefix(cli.FlagRestart))
+	executeCmd(t, cli.BootstrapBridgeCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+	require.NoFileExists(t, progressFilePath)
+
+	// the bootstrapping isn't started if the balance doesn't cover the tickets reserve
+	bridgeClientMock.EXPECT().GetXRPLTicketsReserve(gomock.Any(), gomock.Any(), uint32(10)).Return(
+		bridgeclient.XRPLTicketsReserve{
+			NumberOfTickets:      10,
+			BalanceDrops:         1_000_000,
+			RequiredReserveDrops: 3_200_000,
+			ShortfallDrops:       2_200_000,
+		}, nil,
+	)
+	args = append(args, flagWithPrefix(cli.FlagTicketsToAllocate), "10")
+	_, err := executeCmdWithOutputOptionAndError(
+		cli.BootstrapBridgeCmd(mockBridgeClientProvider(bridgeClientMock)), "text", args...,
+	)
+	require.ErrorContains(t, err, "required:3.2 XRP, missing:2.2 XRP")
+}
+
+func TestGenerateBootstrapConfigCmd(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "bootstrapping.yaml")
+
+	cmd := cli.GenerateBootstrapConfigCmd()
+	cmd.SetIn(strings.NewReader(
+		fmt.Sprintf("4\ndevnet\n%s\n1000000\n200\n20\n", constant.ChainIDDev),
+	))
+	executeCmd(t, cmd, configPath)
+
+	cfg, err := bridgeclient.ReadBootstrappingConfig(configPath)
+	require.NoError(t, err)
+	require.Len(t, cfg.Relayers, 4)
+	require.Equal(t, uint32(3), cfg.EvidenceThreshold)
+	require.Equal(t, "1000000", cfg.TrustSetLimitAmount)
+	require.Equal(t, uint32(200), cfg.UsedTicketSequenceThreshold)
+	require.Equal(t, uint32(20), cfg.XRPLBaseFee)
+
+	script, err := os.ReadFile(filepath.Join(dir, "bootstrapping.sh"))
+	require.NoError(t, err)
+	require.Contains(t, string(script), string(constant.ChainIDDev))
+	require.Contains(t, string(script), "https://s.devnet.rippletest.net:51234/")
+	require.Contains(t, string(script), fmt.Sprintf("bootstrap-bridge %q", configPath))
+
+	// the defaults are used for the empty answers
+	scriptPath := filepath.Join(dir, "deploy.sh")
+	cmd = cli.GenerateBootstrapConfigCmd()
+	cmd.SetIn(strings.NewReader(""))
+	executeCmd(t, cmd, configPath, flagWithPrefix(cli.FlagScriptOutput), scriptPath)
+
+	cfg, err = bridgeclient.ReadBootstrappingConfig(configPath)
+	require.NoError(t, err)
+	defaultCfg := bridgeclient.DefaultBootstrappingConfig()
+	require.Len(t, cfg.Relayers, 3)
+	require.Equal(t, uint32(3), cfg.EvidenceThreshold)
+	require.Equal(t, defaultCfg.TrustSetLimitAmount, cfg.TrustSetLimitAmount)
+	require.Equal(t, defaultCfg.UsedTicketSequenceThreshold, cfg.UsedTicketSequenceThreshold)
+	require.Equal(t, defaultCfg.XRPLBaseFee, cfg.XRPLBaseFee)
+	script, err = os.ReadFile(scriptPath)
+	require.NoError(t, err)
+	require.Contains(t, string(script), string(runner.DefaultCoreumChainID))
+
+	// invalid network
+	cmd = cli.GenerateBootstrapConfigCmd()
+	cmd.SetIn(strings.NewReader("4\nlocalnet\n"))
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", configPath)
+	require.ErrorContains(t, err, "invalid XRPL network")
+}
+
+func TestCompletionCmd(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish", "powershell"} {
+		out := executeCmd(t, newTestRootCmd(t), "completion", shell)
+		require.NotEmpty(t, out)
+		require.Contains(t, out, "test-relayer")
+		// the script is the same on each generation
+		require.Equal(t, out, executeCmd(t, newTestRootCmd(t), "completion", shell))
+	}
+
+	_, err := executeCmdWithOutputOptionAndError(newTestRootCmd(t), "text", "completion", "cmd")
+	require.ErrorContains(t, err, "invalid argument")
+}
+
+func TestVersionCmd_Full(t *testing.T) {
+	versionInfo := bridgeclient.VersionInfo{
+		Contract: bridgeclient.ContractVersionInfo{
+			Address:       coreum.GenAccount().String(),
+			Name:          bridgeclient.ContractName,
+			Version:       "0.0.1",
+			CodeID:        3,
+			Compatibility: bridgeclient.ContractCompatibilityTooOld,
+		},
+		Coreum: bridgeclient.CoreumVersionInfo{
+			ChainID:     string(constant.ChainIDDev),
+			NodeVersion: "v4.0.0",
+		},
+		XRPL: bridgeclient.XRPLVersionInfo{
+			Error: "xrpl is unreachable",
+		},
+	}
+	ctrl := gomock.NewController(t)
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetVersionInfo(gomock.Any()).Return(versionInfo).Times(2)
+
+	// text output
+	cmd := cli.VersionCmd(mockBridgeClientProvider(bridgeClientMock))
+	out := executeCmd(t, cmd, append(initConfig(t), flagWithPrefix(cli.FlagFull))...)
+	require.Regexp(t, "Contract version:\\s+0.0.1\n", out)
+	require.Regexp(t, "Contract compatibility:\\s+too_old\n", out)
+	require.Regexp(t, "Coreum chain ID:\\s+"+string(constant.ChainIDDev)+"\n", out)
+	require.Regexp(t, "XRPL:\\s+unknown \\(xrpl is unreachable\\)\n", out)
+
+	// json output
+	cmd = cli.VersionCmd(mockBridgeClientProvider(bridgeClientMock))
+	out = executeCmd(
+		t, cmd, append(initConfig(t), flagWithPrefix(cli.FlagFull), flagWithPrefix(cli.FlagOutput), "json")...,
+	)
+	var gotVersionInfo bridgeclient.VersionInfo
+	require.NoError(t, json.Unmarshal([]byte(out), &gotVersionInfo))
+	require.Equal(t, versionInfo, gotVersionInfo)
+
+	// invalid output format
+	cmd = cli.VersionCmd(mockBridgeClientProvider(bridgeClientMock))
+	_, err := executeCmdWithOutputOptionAndError(
+		cmd, "text", append(initConfig(t), flagWithPrefix(cli.FlagFull), flagWithPrefix(cli.FlagOutput), "yaml")...,
+	)
+	require.ErrorContains(t, err, `invalid output format "yaml"`)
+}
+
+func TestHistoryCmds(t *testing.T) {
+	args := initConfig(t)
+	history, err := processes.NewTransferHistory(
+		processes.DefaultTransferHistoryConfig(path.Join(args[1], processes.TransferHistoryFileName)),
+		time.Now,
+	)
+	require.NoError(t, err)
+	recipient := coreum.GenAccount()
+	for _, record := range []processes.TransferHistoryRecord{
+		{
+			Direction:    processes.TransferHistoryDirectionXRPLToCoreum,
+			SourceTxHash: "A1",
+			Recipient:    recipient.String(),
+			Currency:     "USD",
+			Amount:       "100",
+			RecordedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Status:       processes.TransferHistoryStatusCompleted,
+		},
+		{
+			Direction:    processes.TransferHistoryDirectionXRPLToCoreum,
+			SourceTxHash: "A2",
+			Recipient:    recipient.String(),
+			Currency:     "EUR",
+			Amount:       "200",
+			RecordedAt:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			Status:       processes.TransferHistoryStatusConfirmed,
+		},
+	} {
+		require.NoError(t, history.Append(context.Background(), record))
+	}
+
+	executeCmd(t, cli.HistoryListCmd(), append(args, flagWithPrefix(cli.FlagAddress), recipient.String())...)
+	_, err = executeCmdWithOutputOptionAndError(
+		cli.HistoryListCmd(), "text", append(args, flagWithPrefix(cli.FlagSince), "yesterday")...,
+	)
+	require.ErrorContains(t, err, "expected RFC3339 time or date")
+
+	out := executeCmd(t, cli.HistoryExportCmd(), append(args, flagWithPrefix(cli.FlagSince), "2024-01-15")...)
+	require.Equal(t, "direction,source_tx_hash,destination_tx_hash,operation_id,sender,recipient,issuer,currency,"+
+		"denom,amount,fee,started_at,completed_at,recorded_at,status\n"+
+		fmt.Sprintf("xrpl-to-coreum,A2,,,,%s,,EUR,,200,,,,2024-02-01T00:00:00Z,confirmed\n", recipient.String()),
+		out,
+	)
+
+	out = executeCmd(t, cli.HistoryExportCmd(), append(
+		args, flagWithPrefix(cli.FlagDenom), "USD", flagWithPrefix(cli.FlagFormat), cli.TransferHistoryFormatJSON,
+	)...)
+	records := make([]processes.TransferHistoryRecord, 0)
+	require.NoError(t, json.Unmarshal([]byte(out), &records))
+	require.Len(t, records, 1)
+	require.Equal(t, "A1", records[0].SourceTxHash)
+
+	_, err = executeCmdWithOutputOptionAndError(
+		cli.HistoryExportCmd(), "text", append(args, flagWithPrefix(cli.FlagFormat), "xml")...,
+	)
+	require.ErrorContains(t, err, `invalid --format "xml"`)
+}
+
+func TestAuditCmds(t *testing.T) {
+	args := initConfig(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	auditLog, err := processes.NewKeyUsageAuditLog(
+		path.Join(args[1], processes.KeyUsageAuditLogFileName),
+		logger.NewZapLoggerFromLogger(zap.NewNop()),
+		metrics.NewRegistry(),
+		func() time.Time {
+			now = now.Add(24 * time.Hour)
+			return now
+		},
+	)
+	require.NoError(t, err)
+	auditLog.LogXRPLSign(1, []byte{1})
+	auditLog.LogCoreumBroadcast("A1", []byte{2})
+
+	out := executeCmd(t, cli.AuditVerifyCmd(), args...)
+	var verification processes.KeyUsageAuditVerification
+	require.NoError(t, json.Unmarshal([]byte(out), &verification))
+	require.Equal(t, uint64(2), verification.Entries)
+
+	out = executeCmd(t, cli.AuditExportCmd(), append(args, flagWithPrefix(cli.FlagSince), "2024-01-03")...)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 1)
+	var entry processes.KeyUsageAuditEntry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "A1", entry.TxHash)
+	require.Equal(t, verification.LastHash, entry.Hash)
+}
+
+func TestManifestCmd(t *testing.T) {
+	rootCmd := newTestRootCmd(t)
+	out := executeCmd(t, rootCmd, "__manifest")
+	manifest := make([]cli.CommandManifest, 0)
+	require.NoError(t, json.Unmarshal([]byte(out), &manifest))
+
+	manifestCmdPaths := make(map[string]cli.CommandManifest)
+	for _, cmdManifest := range manifest {
+		manifestCmdPaths[cmdManifest.Path] = cmdManifest
+	}
+	require.Len(t, manifestCmdPaths, len(manifest))
+
+	// every registered command is included
+	cmdPaths := make([]string, 0)
+	var collectCmdPaths func(cmd *cobra.Command)
+	collectCmdPaths = func(cmd *cobra.Command) {
+		cmdPaths = append(cmdPaths, cmd.CommandPath())
+		for _, subCmd := range cmd.Commands() {
+			collectCmdPaths(subCmd)
+		}
+	}
+	collectCmdPaths(rootCmd)
+	require.ElementsMatch(t, cmdPaths, lo.Keys(manifestCmdPaths))
+	require.Contains(t, manifestCmdPaths, "test-relayer coreum tx send-from-coreum-to-xrpl")
+	require.Contains(t, manifestCmdPaths, "test-relayer xrpl q balances")
+	require.True(t, manifestCmdPaths["test-relayer __manifest"].Hidden)
+
+	// the home dir default is machine independent
+	homeFlag, found := lo.Find(
+		manifestCmdPaths["test-relayer coreum q contract-config"].Flags,
+		func(flag cli.FlagManifest) bool {
+			return flag.Name == cli.FlagHome
+		},
+	)
+	require.True(t, found)
+	require.Equal(t, "$HOME/.coreumbridge-xrpl-relayer", homeFlag.Default)
+	require.True(t, homeFlag.Inherited)
+	require.NotContains(t, out, cli.DefaultHomeDir)
+
+	// the manifest is the same on each generation
+	require.Equal(t, out, executeCmd(t, newTestRootCmd(t), "__manifest"))
+}
+
+func newTestRootCmd(t *testing.T) *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use: "test-relayer",
+	}
+	rootCmd.AddCommand(cli.InitCmd())
+	rootCmd.AddCommand(cli.ConfigCmd())
+	rootCmd.AddCommand(cli.VersionCmd(mockBridgeClientProvider(nil)))
+	rootCmd.AddCommand(cli.CompletionCmd())
+	rootCmd.AddCommand(cli.ManifestCmd())
+	rootCmd.AddCommand(cli.HistoryCmd())
+	rootCmd.AddCommand(cli.AuditCmd())
+
+	coreumCmd, err := cli.CoreumCmd(mockBridgeClientProvider(nil))
+	require.NoError(t, err)
+	rootCmd.AddCommand(coreumCmd)
+
+	xrplCmd, err := cli.XRPLCmd(mockBridgeClientProvider(nil))
+	require.NoError(t, err)
+	rootCmd.AddCommand(xrplCmd)
+
+	return rootCmd
 }
 
 func executeTxCmd(t *testing.T, cmd *cobra.Command, args ...string) {
@@ -126,6 +845,15 @@ func executeCmd(t *testing.T, cmd *cobra.Command, args ...string) string {
 func executeCmdWithOutputOption(t *testing.T, cmd *cobra.Command, outOpt string, args ...string) string {
 	t.Helper()
 
+	out, err := executeCmdWithOutputOptionAndError(cmd, outOpt, args...)
+	require.NoError(t, err)
+
+	t.Logf("Command %s is executed successfully", cmd.Name())
+
+	return out
+}
+
+func executeCmdWithOutputOptionAndError(cmd *cobra.Command, outOpt string, args ...string) (string, error) {
 	cmd.SetArgs(args)
 
 	buf := new(bytes.Buffer)
@@ -133,6 +861,12 @@ func executeCmdWithOutputOption(t *testing.T, cmd *cobra.Command, outOpt string,
 	cmd.SetOut(buf)
 	cmd.SetArgs(args)
 
+	err := cmd.ExecuteContext(newClientContext(outOpt))
+
+	return buf.String(), err
+}
+
+func newClientContext(outOpt string) context.Context {
 	encodingConfig := config.NewEncodingConfig(coreumapp.ModuleBasics)
 	clientCtx := client.Context{}.
 		WithCodec(encodingConfig.Codec).
@@ -141,15 +875,8 @@ func executeCmdWithOutputOption(t *testing.T, cmd *cobra.Command, outOpt string,
 		WithLegacyAmino(encodingConfig.Amino).
 		WithInput(os.Stdin).
 		WithOutputFormat(outOpt)
-	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
-
-	if err := cmd.ExecuteContext(ctx); err != nil {
-		require.NoError(t, err)
-	}
-
-	t.Logf("Command %s is executed successfully", cmd.Name())
 
-	return buf.String()
+	return context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
 }
 
 func addKeyToTestKeyring(t *testing.T, keyringDir, keyName, suffix, hdPath string) sdk.AccAddress {
@@ -199,6 +926,17 @@ func mockBridgeClientProvider(bridgeClientMock *MockBridgeClient) cli.BridgeClie
 	}
 }
 
+// newHomeCmd returns the command with the parsed home and keyring flags.
+func newHomeCmd(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	cli.AddHomeFlag(cmd)
+	cli.AddKeyringFlags(cmd)
+	require.NoError(t, cmd.ParseFlags(args))
+	cmd.SetContext(newClientContext("text"))
+
+	return cmd
+}
+
 func initConfig(t *testing.T) []string {
 	configPath := path.Join(t.TempDir(), "config-path")
 	configFilePath := path.Join(configPath, runner.ConfigFileName)
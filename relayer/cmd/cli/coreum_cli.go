@@ -2,16 +2,24 @@ package cli
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
@@ -21,6 +29,8 @@ import (
 	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	overridecryptokeyring "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli/cosmos/override/crypto/keyring"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/metrics"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -40,18 +50,32 @@ func CoreumCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 	coreumTxCmd.AddCommand(RegisterCoreumTokenCmd(bcp))
 	coreumTxCmd.AddCommand(UpdateCoreumTokenCmd(bcp))
 	coreumTxCmd.AddCommand(RegisterXRPLTokenCmd(bcp))
+	coreumTxCmd.AddCommand(RegisterXRPLTokensFromFileCmd(bcp))
+	coreumTxCmd.AddCommand(ImportTokensCmd(bcp))
 	coreumTxCmd.AddCommand(RecoverXRPLTokenRegistrationCmd(bcp))
+	coreumTxCmd.AddCommand(RecoverTokenRegistrationCmd(bcp))
 	coreumTxCmd.AddCommand(UpdateXRPLTokenCmd(bcp))
+	coreumTxCmd.AddCommand(MigrateXRPLTokenCmd(bcp))
+	coreumTxCmd.AddCommand(RemoveMigratedXRPLTokenCmd(bcp))
+	coreumTxCmd.AddCommand(DisableTokenCmd(bcp))
+	coreumTxCmd.AddCommand(EnableTokenCmd(bcp))
+	coreumTxCmd.AddCommand(EmergencyDisableTokenCmd(bcp))
+	coreumTxCmd.AddCommand(EmergencyEnableTokenCmd(bcp))
 	coreumTxCmd.AddCommand(RotateKeysCmd(bcp))
 	coreumTxCmd.AddCommand(UpdateXRPLBaseFeeCmd(bcp))
 	coreumTxCmd.AddCommand(SendFromCoreumToXRPLCmd(bcp))
+	coreumTxCmd.AddCommand(SendBatchCmd(bcp))
 	coreumTxCmd.AddCommand(ClaimRefundCmd(bcp))
 	coreumTxCmd.AddCommand(ClaimRelayerFeesCmd(bcp))
+	coreumTxCmd.AddCommand(ClaimTreasuryFeesCmd(bcp))
 	coreumTxCmd.AddCommand(HaltBridgeCmd(bcp))
 	coreumTxCmd.AddCommand(ResumeBridgeCmd(bcp))
 	coreumTxCmd.AddCommand(CancelPendingOperationCmd(bcp))
+	coreumTxCmd.AddCommand(ForceCompleteOperationCmd(bcp))
 	coreumTxCmd.AddCommand(UpdateProhibitedXRPLAddressesCmd(bcp))
 	coreumTxCmd.AddCommand(DeployContractCmd(bcp))
+	coreumTxCmd.AddCommand(TransferOwnershipCmd(bcp))
+	coreumTxCmd.AddCommand(AcceptOwnershipCmd(bcp))
 
 	AddCoreumTxFlags(coreumTxCmd)
 
@@ -66,13 +90,20 @@ func CoreumCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 	coreumQueryCmd.AddCommand(ContractConfigCmd(bcp))
 	coreumQueryCmd.AddCommand(ContractOwnershipCmd(bcp))
 	coreumQueryCmd.AddCommand(RegisteredTokensCmd(bcp))
+	coreumQueryCmd.AddCommand(ExportTokensCmd(bcp))
 	coreumQueryCmd.AddCommand(CoreumBalancesCmd(bcp))
 	coreumQueryCmd.AddCommand(PendingRefundsCmd(bcp))
 	coreumQueryCmd.AddCommand(RelayerFeesCmd(bcp))
 	coreumQueryCmd.AddCommand(PendingOperationsCmd(bcp))
+	coreumQueryCmd.AddCommand(PreviewOperationCmd(bcp))
+	coreumQueryCmd.AddCommand(SignatureProgressCmd(bcp))
+	coreumQueryCmd.AddCommand(StuckTokenRegistrationsCmd(bcp))
+	coreumQueryCmd.AddCommand(TicketUsageCmd(bcp))
 	coreumQueryCmd.AddCommand(ProhibitedXRPLAddressesCmd(bcp))
 	coreumQueryCmd.AddCommand(TransactionEvidencesCmd(bcp))
 	coreumQueryCmd.AddCommand(TraceCoreumToXRPLTransfer(bcp))
+	coreumQueryCmd.AddCommand(TransferHistoryCmd(bcp))
+	coreumQueryCmd.AddCommand(RateLimitsCmd())
 
 	AddHomeFlag(coreumQueryCmd)
 
@@ -84,6 +115,13 @@ func CoreumCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 
 	coreumCmd.AddCommand(coreumTxCmd)
 	coreumCmd.AddCommand(coreumQueryCmd)
+	coreumCmd.AddCommand(SweepAllFeesCmd(bcp))
+	coreumCmd.AddCommand(ExportSnapshotCmd(bcp))
+	coreumCmd.AddCommand(ImportSnapshotCmd(bcp))
+	coreumCmd.AddCommand(ReplayCmd(bcp))
+	coreumCmd.AddCommand(GenerateStateDiagramCmd(bcp))
+	coreumCmd.AddCommand(BlockedDeliveriesCmd())
+	coreumCmd.AddCommand(RetryBlockedDeliveryCmd())
 	coreumCmd.AddCommand(keyringCoreumCmd)
 
 	return coreumCmd, nil
@@ -172,6 +210,306 @@ $ claim-relayer-fees --key-name address --%s %s
 	return cmd
 }
 
+// SweepAllFeesCmd claims the fees collected by all relayers.
+func SweepAllFeesCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-all-fees [relayer-address...]",
+		Short: "Claim the fees collected by all relayers.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Claims the fees collected by the relayers, one claim transaction is sent from each relayer in parallel.
+The keys of all relayers must be in the keyring. If the relayer addresses aren't provided, they are taken from the
+contract config.
+Example:
+$ sweep-all-fees %s --%s
+`, constant.AddressSampleTest, flags.FlagSkipConfirmation,
+		)),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				relayerAddresses := make([]sdk.AccAddress, 0, len(args))
+				for _, arg := range args {
+					relayerAddress, err := sdk.AccAddressFromBech32(arg)
+					if err != nil {
+						return errors.Wrapf(err, "invalid relayer address: %s", arg)
+					}
+					relayerAddresses = append(relayerAddresses, relayerAddress)
+				}
+				if len(relayerAddresses) == 0 {
+					contractCfg, err := bridgeClient.GetContractConfig(ctx)
+					if err != nil {
+						return err
+					}
+					for _, relayer := range contractCfg.Relayers {
+						relayerAddresses = append(relayerAddresses, relayer.CoreumAddress)
+					}
+				}
+
+				feesToClaim := sdk.NewCoins()
+				for _, relayerAddress := range relayerAddresses {
+					feesCollected, err := bridgeClient.GetFeesCollected(ctx, relayerAddress)
+					if err != nil {
+						return err
+					}
+					components.Log.Info(
+						ctx,
+						"Got relayer fees",
+						zap.String("relayer", relayerAddress.String()),
+						zap.String("fees", feesCollected.String()),
+					)
+					feesToClaim = feesToClaim.Add(feesCollected...)
+				}
+				if feesToClaim.IsZero() {
+					components.Log.Info(ctx, "No relayer fees to claim")
+					return nil
+				}
+
+				skipConfirmation, err := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", flags.FlagSkipConfirmation)
+				}
+				if !skipConfirmation {
+					components.Log.Info(
+						ctx,
+						"Type \"yes\" to claim the relayer fees.",
+						zap.String("fees", feesToClaim.String()),
+					)
+					input := bufio.NewScanner(cmd.InOrStdin())
+					input.Scan()
+					if strings.TrimSpace(input.Text()) != "yes" {
+						return errors.New("the relayer fees sweep is not confirmed")
+					}
+				}
+
+				totalClaimed, err := bridgeClient.SweepRelayerFees(ctx, relayerAddresses)
+				if err != nil {
+					return err
+				}
+				for _, coin := range totalClaimed {
+					components.Log.Info(
+						ctx,
+						"Claimed relayer fees",
+						zap.String("denom", coin.Denom),
+						zap.String("amount", coin.Amount.String()),
+					)
+				}
+
+				return nil
+			}),
+	}
+	AddKeyringFlags(cmd)
+	AddHomeFlag(cmd)
+	AddFeeFlags(cmd)
+	cmd.Flags().Bool(flags.FlagSkipConfirmation, false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// ExportSnapshotCmd writes the bridge contract state to the file.
+func ExportSnapshotCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-snapshot",
+		Short: "Write the bridge contract state to the file.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Write the bridge contract state to the file.
+The snapshot includes the config, tokens, pending operations, tickets, evidences, relayer fees and prohibited XRPL
+addresses. The contract doesn't list the pending refunds of all addresses, so only the refunds of the addresses
+provided with the --%s flag are included.
+Example:
+$ export-snapshot --%s snapshot.json --%s %s
+`, FlagRefundAddress, FlagOutput, FlagRefundAddress, constant.AddressSampleTest,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagOutput)
+				}
+				refundAddressesString, err := cmd.Flags().GetStringSlice(FlagRefundAddress)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagRefundAddress)
+				}
+				refundAddresses := make([]sdk.AccAddress, 0, len(refundAddressesString))
+				for _, addressString := range refundAddressesString {
+					address, err := sdk.AccAddressFromBech32(addressString)
+					if err != nil {
+						return errors.Wrapf(err, "invalid refund address: %s", addressString)
+					}
+					refundAddresses = append(refundAddresses, address)
+				}
+
+				snapshot, err := bridgeClient.ExportSnapshot(ctx, refundAddresses)
+				if err != nil {
+					return err
+				}
+				if err := writeJSONFile(outputPath, snapshot); err != nil {
+					return err
+				}
+
+				components.Log.Info(
+					ctx,
+					"Bridge snapshot is written to the file.",
+					zap.String("path", outputPath),
+					zap.Int("coreumTokens", len(snapshot.Tokens.CoreumTokens)),
+					zap.Int("xrplTokens", len(snapshot.Tokens.XRPLTokens)),
+					zap.Int("pendingOperations", len(snapshot.PendingOperations)),
+					zap.Int("availableTickets", len(snapshot.AvailableTickets)),
+					zap.Int("transactionEvidences", len(snapshot.TransactionEvidences)),
+				)
+
+				return nil
+			}),
+	}
+	AddHomeFlag(cmd)
+	cmd.Flags().String(FlagOutput, "snapshot.json", "Bridge snapshot output file path")
+	cmd.Flags().StringSlice(FlagRefundAddress, nil, "Address which pending refunds are included into the snapshot")
+
+	return cmd
+}
+
+// ReplayCmd reconciles the bridge transfers found in the Coreum and XRPL history.
+func ReplayCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Reconcile the bridge transfers found in the Coreum and XRPL history.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Reconcile the bridge transfers found in the Coreum and XRPL history.
+The contract txs in the Coreum heights range and the XRPL bridge account txs are walked in the time order, the XRPL to
+Coreum transfers are matched by the XRPL tx hash and the Coreum to XRPL transfers by the operation ID. The matched
+pairs, unmatched transfers and the totals per token are written to the report file as JSON lines. The nodes must keep
+the history of the range, so the archive nodes are expected for the old ranges.
+The progress is saved to the checkpoint file, if provided, and the interrupted replay is resumed from it.
+Example:
+$ replay --%s 1000 --%s 2000 --%s report.jsonl --%s checkpoint.json
+`, FlagFromHeight, FlagToHeight, FlagOutput, FlagCheckpointFile,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagFromHeight)
+				}
+				toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagToHeight)
+				}
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagOutput)
+				}
+				cfg := bridgeclient.DefaultReplayConfig(fromHeight, toHeight, outputPath)
+				if cfg.XRPLFromLedger, err = cmd.Flags().GetInt64(FlagXRPLFromLedger); err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagXRPLFromLedger)
+				}
+				if cfg.XRPLToLedger, err = cmd.Flags().GetInt64(FlagXRPLToLedger); err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagXRPLToLedger)
+				}
+				if cfg.CheckpointFilePath, err = cmd.Flags().GetString(FlagCheckpointFile); err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagCheckpointFile)
+				}
+
+				summary, err := bridgeClient.Replay(ctx, cfg)
+				if err != nil {
+					return err
+				}
+
+				components.Log.Info(
+					ctx,
+					"Bridge history is replayed.",
+					zap.String("path", outputPath),
+					zap.Any("summary", summary),
+				)
+				if !summary.Reconciled {
+					return errors.Errorf("bridge history isn't reconciled, see the report: %s", outputPath)
+				}
+
+				return nil
+			}),
+	}
+	AddHomeFlag(cmd)
+	cmd.Flags().Int64(FlagFromHeight, 0, "Inclusive start Coreum height")
+	cmd.Flags().Int64(FlagToHeight, 0, "Inclusive end Coreum height")
+	cmd.Flags().Int64(FlagXRPLFromLedger, -1, "Inclusive start XRPL ledger, -1 is the earliest ledger")
+	cmd.Flags().Int64(FlagXRPLToLedger, -1, "Inclusive end XRPL ledger, -1 is the latest validated ledger")
+	cmd.Flags().String(FlagOutput, "replay-report.jsonl", "Reconciliation report output file path")
+	cmd.Flags().String(FlagCheckpointFile, "", "Replay progress checkpoint file path")
+
+	return cmd
+}
+
+// ImportSnapshotCmd replays the bridge snapshot to the freshly deployed contract.
+func ImportSnapshotCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-snapshot",
+		Short: "Replay the export-snapshot command output to the freshly deployed bridge contract.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Replay the export-snapshot command output to the freshly deployed bridge contract.
+The tokens, XRPL base fee and prohibited XRPL addresses are replayed with the owner transactions. The contract
+config set on the instantiation, the pending operations, evidences, tickets, relayer fees and refunds can't be
+replayed, so such differences are reported as conflicts, and nothing is imported unless the --%s flag is set.
+Example:
+$ import-snapshot --%s snapshot.json --%s owner
+`, FlagForce, FlagInput, FlagKeyName,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+				inputPath, err := cmd.Flags().GetString(FlagInput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagInput)
+				}
+				force, err := cmd.Flags().GetBool(FlagForce)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagForce)
+				}
+
+				var snapshot bridgeclient.BridgeSnapshot
+				if err := readJSONFile(inputPath, &snapshot); err != nil {
+					return err
+				}
+
+				result, err := bridgeClient.ImportSnapshot(ctx, sender, snapshot, force)
+				if err != nil {
+					return err
+				}
+				for _, conflict := range result.Conflicts {
+					components.Log.Warn(ctx, "Snapshot conflict", zap.String("conflict", conflict))
+				}
+				if !result.Applied {
+					return errors.Errorf(
+						"found %d snapshot conflicts, use the --%s flag to import anyway", len(result.Conflicts), FlagForce,
+					)
+				}
+				components.Log.Info(
+					ctx,
+					"Bridge snapshot is imported.",
+					zap.Int("tokens", len(result.Tokens)),
+					zap.Strings("actions", result.Actions),
+				)
+
+				return nil
+			}),
+	}
+	AddCoreumTxFlags(cmd)
+	cmd.PreRunE = CoreumTxPreRun(bcp)
+	cmd.Flags().String(FlagInput, "snapshot.json", "Bridge snapshot input file path")
+	cmd.Flags().Bool(FlagForce, false, "Import the snapshot despite the conflicts")
+
+	return cmd
+}
+
 // HaltBridgeCmd halts the bridge and stops its operation.
 func HaltBridgeCmd(bcp BridgeClientProvider) *cobra.Command {
 	return &cobra.Command{
@@ -187,29 +525,674 @@ $ halt-bridge --%s owner
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				return bridgeClient.HaltBridge(
+					ctx,
+					sender,
+				)
+			}),
+	}
+}
+
+// TransferOwnershipCmd starts the contract ownership transfer.
+func TransferOwnershipCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership",
+		Short: "Start the contract ownership transfer, the new owner must accept it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Start the contract ownership transfer, the new owner must accept it.
+The new owner address must be typed again to confirm the transfer.
+Example:
+$ transfer-ownership --%s %s --%s owner
+`, FlagNewOwner, constant.AddressSampleTest, FlagKeyName)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				newOwnerString, err := cmd.Flags().GetString(FlagNewOwner)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagNewOwner)
+				}
+				if newOwnerString == "" {
+					return errors.Errorf("the --%s flag is required", FlagNewOwner)
+				}
+				newOwner, err := sdk.AccAddressFromBech32(newOwnerString)
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert new owner address to sdk.AccAddress")
+				}
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				ownership, err := bridgeClient.GetContractOwnership(ctx)
+				if err != nil {
+					return err
+				}
+				components.Log.Info(
+					ctx,
+					"Contract ownership",
+					zap.String("owner", ownership.Owner.String()),
+					zap.String("pendingOwner", ownership.PendingOwner.String()),
+					zap.String("newOwner", newOwner.String()),
+				)
+
+				accountInfo, err := bridgeClient.GetCoreumAccountInfo(ctx, newOwner)
+				if err != nil {
+					return errors.Wrap(err, "the new owner account must exist on chain")
+				}
+				if !accountInfo.PubKeySet {
+					components.Log.Warn(
+						ctx,
+						"!!! The new owner account has never signed a transaction, make sure you control its keys, "+
+							"otherwise the ownership can't be accepted !!!",
+						zap.String("newOwner", newOwner.String()),
+					)
+				}
+
+				components.Log.Info(ctx, "Type the new owner address again to confirm the transfer.")
+				input := bufio.NewScanner(cmd.InOrStdin())
+				input.Scan()
+				if strings.TrimSpace(input.Text()) != newOwner.String() {
+					return errors.New("the typed address doesn't match the new owner, the transfer is not confirmed")
+				}
+
+				return bridgeClient.TransferOwnership(ctx, sender, newOwner)
+			}),
+	}
+	cmd.Flags().String(FlagNewOwner, "", "New contract owner address")
+
+	return cmd
+}
+
+// AcceptOwnershipCmd accepts the pending contract ownership transfer.
+func AcceptOwnershipCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-ownership",
+		Short: "Accept the pending contract ownership transfer.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept the pending contract ownership transfer, the sender must be the pending owner.
+Example:
+$ accept-ownership --%s new-owner
+`, FlagKeyName)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				return bridgeClient.AcceptOwnership(cmd.Context(), sender)
+			}),
+	}
+}
+
+// ResumeBridgeCmd resumes the bridge and restarts its operation.
+func ResumeBridgeCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume-bridge",
+		Short: "Resume the bridge.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume the bridge.
+Example:
+$ resume-bridge --%s owner
+`, FlagKeyName)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+				return bridgeClient.ResumeBridge(
+					ctx,
+					sender,
+				)
+			}),
+	}
+}
+
+// CancelPendingOperationCmd cancels pending operation.
+func CancelPendingOperationCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-pending-operation [operation-id]",
+		Short: "Cancel pending operation.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel pending operation.
+Example:
+$ cancel-pending-operation 123 --%s owner
+`, FlagKeyName)),
+		Args: cobra.ExactArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				operationID, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return errors.Wrapf(err, "invalid operation ID: %s", args[0])
+				}
+
+				return bridgeClient.CancelPendingOperation(
+					ctx,
+					sender,
+					uint32(operationID),
+				)
+			}),
+	}
+}
+
+// ForceCompleteOperationCmd force completes the stale pending operation by submitting the manual evidence.
+func ForceCompleteOperationCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-complete-operation",
+		Short: "Force complete the stale pending operation by submitting the manual transaction result evidence.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Force complete the stale pending operation by submitting the manual transaction result evidence.
+The command is used to unblock the operation which XRPL transaction is lost, and must be executed by the contract owner.
+The evidence is submitted as a vote of the owner, so the owner must be one of the relayers.
+Manually completed operation might desync the bridge state with the XRPL, use it only as a last resort.
+Example:
+$ force-complete-operation --%s 123 --%s 3B2D4F... --%s rejected --%s --%s owner
+`, FlagTicketSequence, FlagTxHash, FlagResult, FlagAdminOverride, FlagKeyName)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				adminOverride, err := cmd.Flags().GetBool(FlagAdminOverride)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagAdminOverride)
+				}
+				if !adminOverride {
+					return errors.Errorf("the command requires the --%s flag", FlagAdminOverride)
+				}
+
+				ticketSequence, err := cmd.Flags().GetUint32(FlagTicketSequence)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagTicketSequence)
+				}
+				if ticketSequence == 0 {
+					return errors.Errorf("the --%s flag is required", FlagTicketSequence)
+				}
+
+				txHash, err := cmd.Flags().GetString(FlagTxHash)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagTxHash)
+				}
+				if txHash == "" {
+					return errors.Errorf("the --%s flag is required", FlagTxHash)
+				}
+
+				resultString, err := cmd.Flags().GetString(FlagResult)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagResult)
+				}
+				result := coreum.TransactionResult(resultString)
+				if result != coreum.TransactionResultAccepted && result != coreum.TransactionResultRejected {
+					return errors.Errorf(
+						"invalid --%s value: %s, expected %s or %s",
+						FlagResult, resultString, coreum.TransactionResultAccepted, coreum.TransactionResultRejected,
+					)
+				}
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				components.Log.Warn(
+					ctx,
+					"Manually completing the operation might desync the bridge state with the XRPL",
+					zap.Uint32("ticketSequence", ticketSequence),
+					zap.String("txHash", txHash),
+					zap.String("result", resultString),
+				)
+				skipConfirmation, err := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", flags.FlagSkipConfirmation)
+				}
+				if !skipConfirmation {
+					components.Log.Info(ctx, "Type \"yes\" to continue.")
+					input := bufio.NewScanner(cmd.InOrStdin())
+					input.Scan()
+					if strings.TrimSpace(input.Text()) != "yes" {
+						return errors.New("the operation force completion is not confirmed")
+					}
+				}
+
+				return bridgeClient.ForceCompleteOperation(ctx, sender, ticketSequence, txHash, result)
+			}),
+	}
+	cmd.Flags().Uint32(FlagTicketSequence, 0, "Ticket or account sequence of the pending operation")
+	cmd.Flags().String(FlagTxHash, "", "XRPL transaction hash of the operation")
+	cmd.Flags().String(
+		FlagResult,
+		"",
+		fmt.Sprintf("Transaction result: %s or %s", coreum.TransactionResultAccepted, coreum.TransactionResultRejected),
+	)
+	cmd.Flags().Bool(FlagAdminOverride, false, "Confirms the admin override of the operation processing")
+	cmd.Flags().Bool(flags.FlagSkipConfirmation, false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// ClaimRefundCmd claims pending refund.
+func ClaimRefundCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-refund",
+		Short: "Claim pending refund, either all pending refunds or with a refund id.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Claim pending refunds.
+Example:
+$ claim-refund --%s claimer --%s 1705664693-2
+`, FlagKeyName, FlagRefundID,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				address, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				refundID, err := cmd.Flags().GetString(FlagRefundID)
+				if err != nil {
+					return err
+				}
+
+				if refundID != "" {
+					return bridgeClient.ClaimRefund(ctx, address, refundID)
+				}
+
+				refunds, err := bridgeClient.GetPendingRefunds(ctx, address)
+				if err != nil {
+					return err
+				}
+
+				for _, refund := range refunds {
+					err := bridgeClient.ClaimRefund(ctx, address, refund.ID)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			}),
+	}
+
+	cmd.PersistentFlags().String(FlagRefundID, "", "pending refund id")
+
+	return cmd
+}
+
+// RegisterCoreumTokenCmd registers the Coreum originated token in the bridge contract.
+func RegisterCoreumTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-coreum-token [denom] [sendingPrecision] [maxHoldingAmount] [bridgingFee]",
+		Short: "Register Coreum token in the bridge contract.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register Coreum token in the bridge contract.
+The denom must exist on the chain and have non-zero supply. If the --%s flag isn't provided, the decimals are
+taken from the denom bank metadata or the asset FT token precision.
+Example:
+$ register-coreum-token ucore 2 500000000000000 4000 --%s 6 --%s 100 --%s owner
+`, FlagDecimals, FlagDecimals, FlagMaxSendsPerAddressPerDay, FlagKeyName)),
+		Args: cobra.ExactArgs(4),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				denom := args[0]
+				sendingPrecision, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid sendingPrecision: %s", args[1])
+				}
+
+				maxHoldingAmount, ok := sdkmath.NewIntFromString(args[2])
+				if !ok {
+					return errors.Errorf("invalid maxHoldingAmount: %s", args[2])
+				}
+
+				bridgingFee, ok := sdkmath.NewIntFromString(args[3])
+				if !ok {
+					return errors.Errorf("invalid bridgingFee: %s", args[3])
+				}
+
+				maxSendsPerAddressPerDay, err := getFlagUint32IfPresent(cmd, FlagMaxSendsPerAddressPerDay)
+				if err != nil {
+					return err
+				}
+
+				if err := bridgeClient.ValidateCoreumDenomSupply(ctx, denom); err != nil {
+					return err
+				}
+
+				decimals, err := getFlagUint32IfPresent(cmd, FlagDecimals)
+				if err != nil {
+					return err
+				}
+				if decimals == nil {
+					resolvedDecimals, err := bridgeClient.GetCoreumDenomDecimals(ctx, denom)
+					if err != nil {
+						return err
+					}
+					components.Log.Info(
+						ctx,
+						"Decimals are resolved from the denom metadata",
+						zap.String("denom", denom),
+						zap.Uint32("decimals", resolvedDecimals),
+					)
+					decimals = &resolvedDecimals
+				}
+
+				token, err := bridgeClient.RegisterCoreumToken(
+					ctx,
+					sender,
+					denom,
+					*decimals,
+					int32(sendingPrecision),
+					maxHoldingAmount,
+					bridgingFee,
+					maxSendsPerAddressPerDay,
+				)
+				if err != nil {
+					return err
+				}
+				// the token is empty if the tx is generated only
+				if token.Denom == "" {
+					return nil
+				}
+				components.Log.Info(
+					ctx,
+					"Coreum token is registered",
+					zap.String("denom", token.Denom),
+					zap.Uint32("decimals", token.Decimals),
+					zap.String("xrplCurrency", token.XRPLCurrency),
+				)
+
+				return nil
+			}),
+	}
+
+	addMaxSendsPerAddressPerDayFlag(cmd)
+	cmd.PersistentFlags().Uint32(FlagDecimals, 0, "Token decimals, resolved from the denom metadata if not provided")
+
+	return cmd
+}
+
+// ClaimTreasuryFeesCmd claims the treasury fees.
+func ClaimTreasuryFeesCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-treasury-fees",
+		Short: "Claim all treasury fees collected by the bridge.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim all treasury fees collected by the bridge.
+The fees are sent to the treasury address or to the owner if the treasury address is not set.
+Example:
+$ claim-treasury-fees --%s owner
+`, FlagKeyName)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				return bridgeClient.ClaimTreasuryFees(ctx, sender)
+			}),
+	}
+}
+
+// UpdateCoreumTokenCmd updates the Coreum originated token in the bridge contract.
+func UpdateCoreumTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-coreum-token [denom]",
+		Short: "Update Coreum token in the bridge contract.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update Coreum token in the bridge contract.
+Example:
+$ update-coreum-token ucore --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s 100 --%s 1000 --%s owner
+`, FlagTokenState, FlagSendingPrecision, FlagMaxHoldingAmount, FlagBridgingFee, FlagMaxSendsPerAddressPerDay,
+				FlagTreasuryFee, FlagKeyName)),
+		Args: cobra.ExactArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+				denom := args[0]
+
+				state, sendingPrecision, maxHoldingAmount, bridgingFee, maxSendsPerAddressPerDay, err := readUpdateTokenFlags(
+					cmd,
+				)
+				if err != nil {
+					return err
+				}
+
+				treasuryFee, err := getFlagSDKIntIfPresent(cmd, FlagTreasuryFee)
+				if err != nil {
+					return err
+				}
+
+				tokenState, err := convertStateStringTokenState(state)
+				if err != nil {
+					return err
+				}
+
+				return bridgeClient.UpdateCoreumToken(
+					ctx,
+					sender,
+					denom,
+					tokenState,
+					sendingPrecision,
+					maxHoldingAmount,
+					bridgingFee,
+					maxSendsPerAddressPerDay,
+					treasuryFee,
+				)
+			}),
+	}
+
+	addUpdateTokenFlags(cmd)
+
+	return cmd
+}
+
+// RegisterXRPLTokenCmd registers the XRPL originated token in the bridge contract.
+func RegisterXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-xrpl-token [issuer] [currency] [sendingPrecision] [maxHoldingAmount] [bridgeFee]",
+		Short: "Register XRPL token in the bridge contract.",
+		//nolint:lll // example
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register XRPL token in the bridge contract.
+Example:
+$ register-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 2 500000000000000 4000 --%s 100 --%s owner
+$ register-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 2 500000000000000 4000 --%s ibc --%s channel-0 --%s 10m --%s owner
+`, FlagMaxSendsPerAddressPerDay, FlagKeyName,
+				FlagDeliveryMode, FlagIBCChannelID, FlagIBCTransferTimeout, FlagKeyName)),
+		Args: cobra.ExactArgs(5),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				issuer, err := rippledata.NewAccountFromAddress(args[0])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[0])
+				}
+
+				currency, err := rippledata.NewCurrency(args[1])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", args[1])
+				}
+
+				sendingPrecision, err := strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid sendingPrecision: %s", args[2])
+				}
+
+				maxHoldingAmount, ok := sdkmath.NewIntFromString(args[3])
+				if !ok {
+					return errors.Wrapf(err, "invalid maxHoldingAmount: %s", args[3])
+				}
+
+				bridgingFee, ok := sdkmath.NewIntFromString(args[4])
+				if !ok {
+					return errors.Wrapf(err, "invalid bridgeFee: %s", args[4])
+				}
+
+				maxSendsPerAddressPerDay, err := getFlagUint32IfPresent(cmd, FlagMaxSendsPerAddressPerDay)
+				if err != nil {
+					return err
+				}
+
+				deliveryMode, err := readDeliveryModeFlags(cmd)
+				if err != nil {
+					return err
+				}
+
+				if err := checkXRPLIssuerAccounts(cmd, bridgeClient, *issuer); err != nil {
+					return err
+				}
+
+				_, err = bridgeClient.RegisterXRPLToken(
+					ctx,
+					sender,
+					*issuer,
+					currency,
+					int32(sendingPrecision),
+					maxHoldingAmount,
+					bridgingFee,
+					maxSendsPerAddressPerDay,
+					deliveryMode,
+				)
+				return err
+			}),
+	}
+
+	addMaxSendsPerAddressPerDayFlag(cmd)
+	addDeliveryModeFlags(cmd)
+	addSkipIssuerCheckFlag(cmd)
+
+	return cmd
+}
+
+// RegisterXRPLTokensFromFileCmd registers XRPL tokens from the JSON file in the bridge contract.
+func RegisterXRPLTokensFromFileCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-xrpl-tokens-from-file [file]",
+		Short: "Register XRPL tokens from the JSON file in the bridge contract.",
+		//nolint:lll // example
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register XRPL tokens from the JSON file in the bridge contract.
+The tokens are registered in batches, one transaction per batch, each registration requires the issuance fee.
+The file contains the list of the tokens:
+[
+  {
+    "issuer": "rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D",
+    "currency": "434F524500000000000000000000000000000000",
+    "sending_precision": 2,
+    "max_holding_amount": "500000000000000",
+    "bridging_fee": "4000",
+    "max_sends_per_address_per_day": 100
+  }
+]
+Example:
+$ register-xrpl-tokens-from-file tokens.json --%s 10 --%s owner
+`, FlagBatchSize, FlagKeyName)),
+		Args: cobra.ExactArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagBatchSize)
+				}
+
+				var requests []coreum.XRPLTokenRegistrationRequest
+				if err := readJSONFile(args[0], &requests); err != nil {
+					return err
+				}
+				issuers := make([]rippledata.Account, 0, len(requests))
+				for _, request := range requests {
+					issuer, err := rippledata.NewAccountFromAddress(request.Issuer)
+					if err != nil {
+						return errors.Wrapf(
+							err, "failed to convert issuer string to rippledata.Account: %s", request.Issuer,
+						)
+					}
+					issuers = append(issuers, *issuer)
+				}
+				if err := checkXRPLIssuerAccounts(cmd, bridgeClient, issuers...); err != nil {
+					return err
+				}
+
+				tokens, err := bridgeClient.RegisterXRPLTokenBatch(ctx, sender, requests, batchSize)
 				if err != nil {
 					return err
 				}
+				components.Log.Info(ctx, "XRPL tokens are registered", zap.Int("count", len(tokens)))
 
-				return bridgeClient.HaltBridge(
-					ctx,
-					sender,
-				)
+				return nil
 			}),
 	}
+	cmd.Flags().Int(FlagBatchSize, 10, "Max number of the tokens registered in one transaction")
+	addSkipIssuerCheckFlag(cmd)
+
+	return cmd
 }
 
-// ResumeBridgeCmd resumes the bridge and restarts its operation.
-func ResumeBridgeCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
-		Use:   "resume-bridge",
-		Short: "Resume the bridge.",
+// ImportTokensCmd registers the tokens missing in the bridge contract and updates the registered ones from the
+// export-tokens command output.
+func ImportTokensCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-tokens",
+		Short: "Import the token registry from the export-tokens command output to the bridge contract.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Resume the bridge.
+			fmt.Sprintf(`Import the token registry from the export-tokens command output to the bridge contract.
+The tokens missing in the contract are registered, and the parameters of the registered tokens are updated to match
+the file. The XRP token is skipped. The repeated import of the same file doesn't change the contract, apart from the
+XRPL tokens state which is synced once the token trust set is processed.
 Example:
-$ resume-bridge --%s owner
-`, FlagKeyName)),
+$ import-tokens --%s tokens.json --%s --%s owner
+`, FlagInput, flags.FlagDryRun, FlagKeyName)),
 		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
@@ -219,25 +1202,64 @@ $ resume-bridge --%s owner
 				if err != nil {
 					return err
 				}
-				return bridgeClient.ResumeBridge(
-					ctx,
-					sender,
+				inputPath, err := cmd.Flags().GetString(FlagInput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagInput)
+				}
+				dryRun, err := cmd.Flags().GetBool(flags.FlagDryRun)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", flags.FlagDryRun)
+				}
+
+				var registry bridgeclient.TokenRegistry
+				if err := readJSONFile(inputPath, &registry); err != nil {
+					return err
+				}
+
+				results, err := bridgeClient.ImportTokenRegistry(ctx, sender, registry, dryRun)
+				if err != nil {
+					return err
+				}
+				actionCounts := lo.CountValuesBy(
+					results,
+					func(result bridgeclient.TokenImportResult) bridgeclient.TokenImportAction {
+						return result.Action
+					},
 				)
+				summaryFields := []zap.Field{zap.Bool("dryRun", dryRun)}
+				for _, action := range []bridgeclient.TokenImportAction{
+					bridgeclient.TokenImportActionCreated,
+					bridgeclient.TokenImportActionUpdated,
+					bridgeclient.TokenImportActionSkipped,
+					bridgeclient.TokenImportActionFailed,
+				} {
+					summaryFields = append(summaryFields, zap.Int(string(action), actionCounts[action]))
+				}
+				components.Log.Info(ctx, "Token registry import summary", summaryFields...)
+				if failed := actionCounts[bridgeclient.TokenImportActionFailed]; failed > 0 {
+					return errors.Errorf("failed to import %d tokens", failed)
+				}
+
+				return nil
 			}),
 	}
+	cmd.Flags().String(FlagInput, "tokens.json", "Token registry input file path")
+	cmd.Flags().Bool(flags.FlagDryRun, false, "Report the import actions without sending the transactions")
+
+	return cmd
 }
 
-// CancelPendingOperationCmd cancels pending operation.
-func CancelPendingOperationCmd(bcp BridgeClientProvider) *cobra.Command {
+// RecoverXRPLTokenRegistrationCmd recovers xrpl token registration.
+func RecoverXRPLTokenRegistrationCmd(bcp BridgeClientProvider) *cobra.Command {
 	return &cobra.Command{
-		Use:   "cancel-pending-operation [operation-id]",
-		Short: "Cancel pending operation.",
-		Long: strings.TrimSpace(
-			fmt.Sprintf(`Cancel pending operation.
+		Use:   "recover-xrpl-token-registration [issuer] [currency]",
+		Short: "Recover XRPL token registration.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Recover XRPL token registration.
 Example:
-$ cancel-pending-operation 123 --%s owner
+$ recover-xrpl-token-registration [issuer] [currency] --%s owner
 `, FlagKeyName)),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.ExactArgs(2),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
@@ -247,81 +1269,103 @@ $ cancel-pending-operation 123 --%s owner
 					return err
 				}
 
-				operationID, err := strconv.ParseUint(args[0], 10, 32)
+				issuer, err := rippledata.NewAccountFromAddress(args[0])
 				if err != nil {
-					return errors.Wrapf(err, "invalid operation ID: %s", args[0])
+					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[0])
 				}
 
-				return bridgeClient.CancelPendingOperation(
-					ctx,
-					sender,
-					uint32(operationID),
-				)
+				currency, err := rippledata.NewCurrency(args[1])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", args[1])
+				}
+
+				return bridgeClient.RecoverXRPLTokenRegistration(ctx, sender, issuer.String(), currency.String())
 			}),
 	}
 }
 
-// ClaimRefundCmd claims pending refund.
-func ClaimRefundCmd(bcp BridgeClientProvider) *cobra.Command {
+// RecoverTokenRegistrationCmd recovers the registration of the inactive or stuck XRPL token.
+func RecoverTokenRegistrationCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "claim-refund",
-		Short: "Claim pending refund, either all pending refunds or with a refund id.",
+		Use:   "recover-token-registration",
+		Short: "Recover the registration of the inactive or stuck XRPL token.",
 		Long: strings.TrimSpace(fmt.Sprintf(
-			`Claim pending refunds.
+			`Recover the registration of the inactive or stuck XRPL token.
+The token is stuck if it's in the processing state and its TrustSet operation is first seen by the relayer more than
+--%s ago. The stuck TrustSet operation is cancelled before the recovery. The command is refused for the token in any
+other state, including the token which TrustSet operation is still in-flight.
 Example:
-$ claim-refund --%s claimer --%s 1705664693-2
-`, FlagKeyName, FlagRefundID,
-		)),
+$ recover-token-registration --%s rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D --%s 434F524500000000000000000000000000000000 --%s 2h --%s owner
+`, FlagOlderThan, FlagIssuer, FlagCurrency, FlagOlderThan, FlagKeyName)),
 		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				address, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
 				if err != nil {
 					return err
 				}
 
-				refundID, err := cmd.Flags().GetString(FlagRefundID)
+				issuerString, err := cmd.Flags().GetString(FlagIssuer)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to read flag %s", FlagIssuer)
 				}
-
-				if refundID != "" {
-					return bridgeClient.ClaimRefund(ctx, address, refundID)
+				if issuerString == "" {
+					return errors.Errorf("the --%s flag is required", FlagIssuer)
+				}
+				issuer, err := rippledata.NewAccountFromAddress(issuerString)
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", issuerString)
 				}
 
-				refunds, err := bridgeClient.GetPendingRefunds(ctx, address)
+				currencyString, err := cmd.Flags().GetString(FlagCurrency)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to read flag %s", FlagCurrency)
+				}
+				if currencyString == "" {
+					return errors.Errorf("the --%s flag is required", FlagCurrency)
+				}
+				currency, err := rippledata.NewCurrency(currencyString)
+				if err != nil {
+					return errors.Wrapf(
+						err, "failed to convert currency string to rippledata.Currency: %s", currencyString,
+					)
 				}
 
-				for _, refund := range refunds {
-					err := bridgeClient.ClaimRefund(ctx, address, refund.ID)
-					if err != nil {
-						return err
-					}
+				olderThan, err := cmd.Flags().GetDuration(FlagOlderThan)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagOlderThan)
 				}
-				return nil
+
+				return bridgeClient.RecoverStuckXRPLTokenRegistration(
+					ctx, sender, issuer.String(), xrpl.ConvertCurrencyToString(currency), olderThan,
+				)
 			}),
 	}
-
-	cmd.PersistentFlags().String(FlagRefundID, "", "pending refund id")
-
+	cmd.Flags().String(FlagIssuer, "", "XRPL token issuer")
+	cmd.Flags().String(FlagCurrency, "", "XRPL token currency")
+	cmd.Flags().Duration(
+		FlagOlderThan,
+		processes.DefaultOperationAgeTrackerConfig("").MaxAge,
+		"Age of the TrustSet operation after which the token is considered stuck",
+	)
 	return cmd
 }
 
-// RegisterCoreumTokenCmd registers the Coreum originated token in the bridge contract.
-func RegisterCoreumTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+// MigrateXRPLTokenCmd migrates the XRPL originated token to the new issuer and currency.
+func MigrateXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
 	return &cobra.Command{
-		Use:   "register-coreum-token [denom] [decimals] [sendingPrecision] [maxHoldingAmount] [bridgingFee]",
-		Short: "Register Coreum token in the bridge contract.",
-		Long: strings.TrimSpace(
-			fmt.Sprintf(`Register Coreum token in the bridge contract.
+		Use:   "migrate-xrpl-token [old-issuer] [old-currency] [new-issuer] [new-currency]",
+		Short: "Migrate the XRPL originated token to the new issuer and currency.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Migrate the XRPL originated token to the new issuer and currency, e.g. after the issuer account rotation.
+The token keeps its Coreum denom, so the bridged balances aren't affected. The old issuer and currency can't be bridged
+anymore, and the token is enabled once the TrustSet operation for the new issuer and currency is completed.
 Example:
-$ register-coreum-token ucore 6 2 500000000000000 4000 --%s owner
+$ migrate-xrpl-token [old-issuer] [old-currency] [new-issuer] [new-currency] --%s owner
 `, FlagKeyName)),
-		Args: cobra.ExactArgs(5),
+		Args: cobra.ExactArgs(4),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
@@ -331,52 +1375,88 @@ $ register-coreum-token ucore 6 2 500000000000000 4000 --%s owner
 					return err
 				}
 
-				denom := args[0]
-				decimals, err := strconv.ParseUint(args[1], 10, 64)
+				oldIssuer, err := rippledata.NewAccountFromAddress(args[0])
 				if err != nil {
-					return errors.Wrapf(err, "invalid decimals: %s", args[1])
+					return errors.Wrapf(err, "failed to convert old issuer string to rippledata.Account: %s", args[0])
 				}
-
-				sendingPrecision, err := strconv.ParseInt(args[2], 10, 64)
+				oldCurrency, err := rippledata.NewCurrency(args[1])
 				if err != nil {
-					return errors.Wrapf(err, "invalid sendingPrecision: %s", args[2])
+					return errors.Wrapf(err, "failed to convert old currency string to rippledata.Currency: %s", args[1])
+				}
+				newIssuer, err := rippledata.NewAccountFromAddress(args[2])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert new issuer string to rippledata.Account: %s", args[2])
+				}
+				newCurrency, err := rippledata.NewCurrency(args[3])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert new currency string to rippledata.Currency: %s", args[3])
 				}
 
-				maxHoldingAmount, ok := sdkmath.NewIntFromString(args[3])
-				if !ok {
-					return errors.Wrapf(err, "invalid maxHoldingAmount: %s", args[3])
+				return bridgeClient.MigrateXRPLToken(
+					ctx,
+					sender,
+					oldIssuer.String(),
+					xrpl.ConvertCurrencyToString(oldCurrency),
+					newIssuer.String(),
+					xrpl.ConvertCurrencyToString(newCurrency),
+				)
+			}),
+	}
+}
+
+// RemoveMigratedXRPLTokenCmd removes the disabled old token of the migrated XRPL originated token.
+func RemoveMigratedXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-migrated-xrpl-token [issuer] [currency]",
+		Short: "Remove the disabled old token of the migrated XRPL originated token.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Remove the disabled old token of the migrated XRPL originated token once the balance of its trust line has
+been handled. The old issuer and currency can be registered again after the removal.
+Example:
+$ remove-migrated-xrpl-token [issuer] [currency] --%s owner
+`, FlagKeyName)),
+		Args: cobra.ExactArgs(2),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
 				}
 
-				bridgingFee, ok := sdkmath.NewIntFromString(args[4])
-				if !ok {
-					return errors.Wrapf(err, "invalid bridgingFee: %s", args[4])
+				issuer, err := rippledata.NewAccountFromAddress(args[0])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[0])
+				}
+				currency, err := rippledata.NewCurrency(args[1])
+				if err != nil {
+					return errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", args[1])
 				}
 
-				_, err = bridgeClient.RegisterCoreumToken(
+				return bridgeClient.RemoveMigratedXRPLToken(
 					ctx,
 					sender,
-					denom,
-					uint32(decimals),
-					int32(sendingPrecision),
-					maxHoldingAmount,
-					bridgingFee,
+					issuer.String(),
+					xrpl.ConvertCurrencyToString(currency),
 				)
-				return err
 			}),
 	}
 }
 
-// UpdateCoreumTokenCmd updates the Coreum originated token in the bridge contract.
-func UpdateCoreumTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+// UpdateXRPLTokenCmd updates the XRPL originated token in the bridge contract.
+func UpdateXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-coreum-token [denom]",
-		Short: "Update Coreum token in the bridge contract.",
+		Use:   "update-xrpl-token [issuer] [currency]",
+		Short: "Update XRPL token in the bridge contract.",
+		//nolint:lll // long example
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update Coreum token in the bridge contract.
+			fmt.Sprintf(`Update XRPL token in the bridge contract.
 Example:
-$ update-coreum-token ucore --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s owner
-`, FlagTokenState, FlagSendingPrecision, FlagMaxHoldingAmount, FlagBridgingFee, FlagKeyName)),
-		Args: cobra.ExactArgs(1),
+$ update-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s 100 --%s 1000 --%s owner
+`, FlagTokenState, FlagSendingPrecision, FlagMaxHoldingAmount, FlagBridgingFee, FlagMaxSendsPerAddressPerDay,
+				FlagTreasuryFee, FlagKeyName)),
+		Args: cobra.ExactArgs(2),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
@@ -385,9 +1465,17 @@ $ update-coreum-token ucore --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s own
 				if err != nil {
 					return err
 				}
-				denom := args[0]
+				issuer := args[0]
+				currency := args[1]
+
+				state, sendingPrecision, maxHoldingAmount, bridgingFee, maxSendsPerAddressPerDay, err := readUpdateTokenFlags(
+					cmd,
+				)
+				if err != nil {
+					return err
+				}
 
-				state, sendingPrecision, maxHoldingAmount, bridgingFee, err := readUpdateTokenFlags(cmd)
+				treasuryFee, err := getFlagSDKIntIfPresent(cmd, FlagTreasuryFee)
 				if err != nil {
 					return err
 				}
@@ -397,14 +1485,16 @@ $ update-coreum-token ucore --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s own
 					return err
 				}
 
-				return bridgeClient.UpdateCoreumToken(
+				return bridgeClient.UpdateXRPLToken(
 					ctx,
 					sender,
-					denom,
+					issuer, currency,
 					tokenState,
 					sendingPrecision,
 					maxHoldingAmount,
 					bridgingFee,
+					maxSendsPerAddressPerDay,
+					treasuryFee,
 				)
 			}),
 	}
@@ -414,18 +1504,33 @@ $ update-coreum-token ucore --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s own
 	return cmd
 }
 
-// RegisterXRPLTokenCmd registers the XRPL originated token in the bridge contract.
-func RegisterXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
-		Use:   "register-xrpl-token [issuer] [currency] [sendingPrecision] [maxHoldingAmount] [bridgeFee]",
-		Short: "Register XRPL token in the bridge contract.",
-		//nolint:lll // example
+// DisableTokenCmd disables the registered token after the token activity review.
+func DisableTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	return updateTokenStateCmd(bcp, "disable", coreum.TokenStateDisabled)
+}
+
+// EnableTokenCmd enables the registered token after the token activity review.
+func EnableTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	return updateTokenStateCmd(bcp, "enable", coreum.TokenStateEnabled)
+}
+
+func updateTokenStateCmd(bcp BridgeClientProvider, action string, targetState coreum.TokenState) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s-token [denom | issuer currency]", action),
+		Short: fmt.Sprintf("Set the registered token state to %s.", targetState),
+		//nolint:lll // long example
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Register XRPL token in the bridge contract.
+			fmt.Sprintf(`Set the registered token state to %[1]s.
+The Coreum originated token is set by the denom and the XRPL originated token by the issuer and currency.
+Before the state update the command reports the token Coreum supply, the pending operations referencing the token
+and the XRPL to Coreum transfers found in the recent Coreum blocks, and requires the confirmation.
+The disabled token deposits sent on the XRPL are not bridged until the token is enabled again, while the pending
+operations are still processed.
 Example:
-$ register-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 2 500000000000000 4000 --%s owner
-`, FlagKeyName)),
-		Args: cobra.ExactArgs(5),
+$ %[2]s-token ucore --%[3]s owner
+$ %[2]s-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 --%[4]s 10000 --%[3]s owner
+`, targetState, action, FlagKeyName, FlagRecentBlocks)),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
@@ -434,131 +1539,192 @@ $ register-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F52450000000000000000
 				if err != nil {
 					return err
 				}
-
-				issuer, err := rippledata.NewAccountFromAddress(args[0])
+				recentBlocks, err := cmd.Flags().GetInt64(FlagRecentBlocks)
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[0])
+					return errors.Wrapf(err, "failed to get %s", FlagRecentBlocks)
 				}
 
-				currency, err := rippledata.NewCurrency(args[1])
-				if err != nil {
-					return errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", args[1])
+				var denom, issuer, currency string
+				if len(args) == 1 {
+					denom = args[0]
+				} else {
+					issuer = args[0]
+					currency = args[1]
 				}
 
-				sendingPrecision, err := strconv.ParseInt(args[2], 10, 64)
+				report, err := bridgeClient.GetTokenStateChangeReport(ctx, denom, issuer, currency, recentBlocks)
 				if err != nil {
-					return errors.Wrapf(err, "invalid sendingPrecision: %s", args[2])
+					return err
 				}
-
-				maxHoldingAmount, ok := sdkmath.NewIntFromString(args[3])
-				if !ok {
-					return errors.Wrapf(err, "invalid maxHoldingAmount: %s", args[3])
+				if err := bridgeclient.ValidateTokenStateTransition(report.State, targetState); err != nil {
+					return err
 				}
+				components.Log.Info(
+					ctx,
+					"Token state change report",
+					zap.String("denom", report.CoreumDenom),
+					zap.String("issuer", report.XRPLIssuer),
+					zap.String("currency", report.XRPLCurrency),
+					zap.String("currentState", string(report.State)),
+					zap.String("targetState", string(targetState)),
+					zap.String("coreumSupply", report.CoreumSupply.String()),
+					zap.Uint32s("pendingOperationIDs", report.PendingOperationIDs),
+					zap.Int64("recentBlocks", recentBlocks),
+					zap.Int("recentInboundTransfers", report.RecentInboundTransfers),
+					zap.String("recentInboundVolume", report.RecentInboundVolume.String()),
+				)
 
-				bridgingFee, ok := sdkmath.NewIntFromString(args[4])
-				if !ok {
-					return errors.Wrapf(err, "invalid bridgeFee: %s", args[4])
+				skipConfirmation, err := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", flags.FlagSkipConfirmation)
+				}
+				if !skipConfirmation {
+					components.Log.Info(ctx, fmt.Sprintf("Type \"yes\" to %s the token.", action))
+					input := bufio.NewScanner(cmd.InOrStdin())
+					input.Scan()
+					if strings.TrimSpace(input.Text()) != "yes" {
+						return errors.Errorf("the token state update to %s is not confirmed", targetState)
+					}
 				}
 
-				_, err = bridgeClient.RegisterXRPLToken(
-					ctx,
-					sender,
-					*issuer,
-					currency,
-					int32(sendingPrecision),
-					maxHoldingAmount,
-					bridgingFee,
+				if denom != "" {
+					return bridgeClient.UpdateCoreumToken(
+						ctx, sender, denom, lo.ToPtr(targetState), nil, nil, nil, nil, nil,
+					)
+				}
+				return bridgeClient.UpdateXRPLToken(
+					ctx, sender, issuer, currency, lo.ToPtr(targetState), nil, nil, nil, nil, nil,
 				)
-				return err
 			}),
 	}
+	cmd.Flags().Int64(
+		FlagRecentBlocks, 10000, "Number of the latest Coreum blocks used to report the recent inbound transfers",
+	)
+	cmd.Flags().Bool(flags.FlagSkipConfirmation, false, "Skip the confirmation prompt")
+
+	return cmd
 }
 
-// RecoverXRPLTokenRegistrationCmd recovers xrpl token registration.
-func RecoverXRPLTokenRegistrationCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
-		Use:   "recover-xrpl-token-registration [issuer] [currency]",
-		Short: "Recover XRPL token registration.",
-		Long: strings.TrimSpace(fmt.Sprintf(
-			`Recover XRPL token registration.
+// EmergencyDisableTokenCmd disables the token without the review and waits for its pending operations.
+func EmergencyDisableTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-disable-token [denom | issuer currency]",
+		Short: "Disable the registered token immediately and wait for its pending operations.",
+		//nolint:lll // long example
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the registered token immediately and wait for its pending operations.
+The Coreum originated token is set by the denom and the XRPL originated token by the issuer and currency.
+The new sends of the token are rejected right after the update, while the pending operations referencing the token
+are still processed. The command waits until they are completed or cancelled and reports the remaining ones if
+the wait timeout is reached. The reason is recorded in the tx memo.
 Example:
-$ recover-xrpl-token-registration [issuer] [currency] --%s owner
-`, FlagKeyName)),
-		Args: cobra.ExactArgs(2),
+$ emergency-disable-token ucore --%[1]s "compromised issuer" --%[2]s 10m --%[3]s owner
+$ emergency-disable-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 --%[1]s "compromised issuer" --%[3]s owner
+`, FlagReason, FlagWaitTimeout, FlagKeyName)),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				owner, err := readFromAddressFromCmdSDKClientCtx(cmd)
 				if err != nil {
 					return err
 				}
-
-				issuer, err := rippledata.NewAccountFromAddress(args[0])
+				reason, err := cmd.Flags().GetString(FlagReason)
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[0])
+					return errors.Wrapf(err, "failed to get %s", FlagReason)
+				}
+				if reason == "" {
+					return errors.Errorf("the --%s flag is required", FlagReason)
+				}
+				waitTimeout, err := cmd.Flags().GetDuration(FlagWaitTimeout)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagWaitTimeout)
 				}
 
-				currency, err := rippledata.NewCurrency(args[1])
-				if err != nil {
-					return errors.Wrapf(err, "failed to convert currency string to rippledata.Currency: %s", args[1])
+				report, err := bridgeClient.EmergencyDisableToken(ctx, owner, tokenRefFromArgs(args), reason, waitTimeout)
+				if report.TxHash != "" {
+					components.Log.Info(
+						ctx,
+						"Token is disabled",
+						zap.String("denom", report.CoreumDenom),
+						zap.String("issuer", report.XRPLIssuer),
+						zap.String("currency", report.XRPLCurrency),
+						zap.String("txHash", report.TxHash),
+						zap.Uint32s("pendingOperationIDs", report.PendingOperationIDs),
+						zap.Uint32s("remainingOperationIDs", report.RemainingOperationIDs),
+					)
 				}
 
-				return bridgeClient.RecoverXRPLTokenRegistration(ctx, sender, issuer.String(), currency.String())
+				return err
 			}),
 	}
+	cmd.Flags().String(FlagReason, "", "Reason of the token disabling recorded in the tx memo")
+	cmd.Flags().Duration(FlagWaitTimeout, 10*time.Minute, "Timeout of waiting for the token pending operations")
+
+	return cmd
 }
 
-// UpdateXRPLTokenCmd updates the XRPL originated token in the bridge contract.
-func UpdateXRPLTokenCmd(bcp BridgeClientProvider) *cobra.Command {
+// EmergencyEnableTokenCmd enables the token disabled by the emergency-disable-token.
+func EmergencyEnableTokenCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-xrpl-token [issuer] [currency]",
-		Short: "Update XRPL token in the bridge contract.",
+		Use:   "emergency-enable-token [denom | issuer currency]",
+		Short: "Enable the token disabled in emergency.",
 		//nolint:lll // long example
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update XRPL token in the bridge contract.
+			fmt.Sprintf(`Enable the token disabled in emergency.
+The Coreum originated token is set by the denom and the XRPL originated token by the issuer and currency.
+The reason is recorded in the tx memo.
 Example:
-$ update-xrpl-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 --%s enabled --%s 2 --%s 10000000 --%s 4000 --%s owner
-`, FlagTokenState, FlagSendingPrecision, FlagMaxHoldingAmount, FlagBridgingFee, FlagKeyName)),
-		Args: cobra.ExactArgs(2),
+$ emergency-enable-token ucore --%[1]s "issuer is recovered" --%[2]s owner
+$ emergency-enable-token rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D 434F524500000000000000000000000000000000 --%[1]s "issuer is recovered" --%[2]s owner
+`, FlagReason, FlagKeyName)),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				owner, err := readFromAddressFromCmdSDKClientCtx(cmd)
 				if err != nil {
 					return err
 				}
-				issuer := args[0]
-				currency := args[1]
-
-				state, sendingPrecision, maxHoldingAmount, bridgingFee, err := readUpdateTokenFlags(cmd)
+				reason, err := cmd.Flags().GetString(FlagReason)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to get %s", FlagReason)
+				}
+				if reason == "" {
+					return errors.Errorf("the --%s flag is required", FlagReason)
 				}
 
-				tokenState, err := convertStateStringTokenState(state)
+				report, err := bridgeClient.EmergencyEnableToken(ctx, owner, tokenRefFromArgs(args), reason)
 				if err != nil {
 					return err
 				}
-
-				return bridgeClient.UpdateXRPLToken(
+				components.Log.Info(
 					ctx,
-					sender,
-					issuer, currency,
-					tokenState,
-					sendingPrecision,
-					maxHoldingAmount,
-					bridgingFee,
+					"Token is enabled",
+					zap.String("denom", report.CoreumDenom),
+					zap.String("issuer", report.XRPLIssuer),
+					zap.String("currency", report.XRPLCurrency),
+					zap.String("txHash", report.TxHash),
 				)
+
+				return nil
 			}),
 	}
-
-	addUpdateTokenFlags(cmd)
+	cmd.Flags().String(FlagReason, "", "Reason of the token enabling recorded in the tx memo")
 
 	return cmd
 }
 
+func tokenRefFromArgs(args []string) bridgeclient.TokenRef {
+	if len(args) == 1 {
+		return bridgeclient.TokenRef{Denom: args[0]}
+	}
+
+	return bridgeclient.TokenRef{Issuer: args[0], Currency: args[1]}
+}
+
 // RotateKeysCmd starts the keys rotation.
 func RotateKeysCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
@@ -650,6 +1816,7 @@ func SendFromCoreumToXRPLCmd(bcp BridgeClientProvider) *cobra.Command {
 		Short: "Send tokens from the Coreum to XRPL.",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Send tokens from the Coreum to XRPL.
+The recipient is the classic XRPL address or X-address without the destination tag.
 Example:
 $ send-from-coreum-to-xrpl 1000000ucore rrrrrrrrrrrrrrrrrrrrrhoLvTp --%s sender --%s 100000
 `, FlagKeyName, FlagDeliverAmount)),
@@ -672,12 +1839,12 @@ $ send-from-coreum-to-xrpl 1000000ucore rrrrrrrrrrrrrrrrrrrrrhoLvTp --%s sender
 				if err != nil {
 					return err
 				}
-				recipient, err := rippledata.NewAccountFromAddress(args[1])
+				recipient, err := parseXRPLAccount(components, args[1])
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert recipient string to rippledata.Account: %s", args[1])
+					return errors.Wrap(err, "failed to parse recipient")
 				}
 
-				_, err = bridgeClient.SendFromCoreumToXRPL(ctx, sender, *recipient, amount, deliverAmount)
+				_, err = bridgeClient.SendFromCoreumToXRPL(ctx, sender, recipient, amount, deliverAmount)
 				return err
 			}),
 	}
@@ -687,6 +1854,97 @@ $ send-from-coreum-to-xrpl 1000000ucore rrrrrrrrrrrrrrrrrrrrrhoLvTp --%s sender
 	return cmd
 }
 
+// SendBatchCmd sends tokens from the Coreum to multiple XRPL recipients in one transaction.
+func SendBatchCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch [file]",
+		Short: "Send tokens from the Coreum to multiple XRPL recipients in one transaction.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send tokens from the Coreum to multiple XRPL recipients in one transaction.
+Each leg is mapped to the pending operation created for it. If the --%s is set, the command waits for the
+operations to be completed and prints the status of each leg with the refund ID of the rejected ones.
+The file contains the list of the legs:
+legs:
+  - recipient: rrrrrrrrrrrrrrrrrrrrrhoLvTp
+    coin: 1000000ucore
+  - recipient: rrrrrrrrrrrrrrrrrrrrBZbvji
+    coin: 1000000drop
+    deliver_amount: "900000"
+Example:
+$ send-batch batch.yaml --%s 10m --%s sender
+`, FlagWaitTimeout, FlagWaitTimeout, FlagKeyName)),
+		Args: cobra.ExactArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				waitTimeout, err := cmd.Flags().GetDuration(FlagWaitTimeout)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagWaitTimeout)
+				}
+
+				sender, err := readFromAddressFromCmdSDKClientCtx(cmd)
+				if err != nil {
+					return err
+				}
+
+				cfg, err := bridgeclient.ReadSendBatchConfig(args[0])
+				if err != nil {
+					return err
+				}
+				legs := make([]bridgeclient.SendLeg, 0, len(cfg.Legs))
+				for i, legCfg := range cfg.Legs {
+					leg, err := parseSendLeg(components, legCfg)
+					if err != nil {
+						return errors.Wrapf(err, "invalid leg %d", i)
+					}
+					legs = append(legs, leg)
+				}
+
+				report, err := bridgeClient.SendBatchToXRPL(ctx, sender, legs)
+				if err != nil {
+					return err
+				}
+				components.Log.Info(ctx, "Batch is sent", zap.Any("report", report))
+				if waitTimeout == 0 {
+					return nil
+				}
+
+				report, err = bridgeClient.WaitForBatch(ctx, report, waitTimeout)
+				components.Log.Info(ctx, "Batch status", zap.Any("report", report))
+				return err
+			}),
+	}
+
+	cmd.Flags().Duration(FlagWaitTimeout, 0, "Timeout of waiting for the batch operations, zero disables waiting")
+
+	return cmd
+}
+
+func parseSendLeg(components runner.Components, cfg bridgeclient.SendBatchLegConfig) (bridgeclient.SendLeg, error) {
+	recipient, err := parseXRPLAccount(components, cfg.Recipient)
+	if err != nil {
+		return bridgeclient.SendLeg{}, errors.Wrap(err, "failed to parse recipient")
+	}
+	coin, err := sdk.ParseCoinNormalized(cfg.Coin)
+	if err != nil {
+		return bridgeclient.SendLeg{}, errors.Wrapf(err, "failed to parse coin, coin:%s", cfg.Coin)
+	}
+	leg := bridgeclient.SendLeg{
+		Recipient: recipient,
+		Coin:      coin,
+	}
+	if cfg.DeliverAmount != "" {
+		deliverAmount, ok := sdkmath.NewIntFromString(cfg.DeliverAmount)
+		if !ok {
+			return bridgeclient.SendLeg{}, errors.Errorf("invalid deliver amount, amount:%s", cfg.DeliverAmount)
+		}
+		leg.DeliverAmount = &deliverAmount
+	}
+
+	return leg, nil
+}
+
 // UpdateProhibitedXRPLAddressesCmd updates/replace the list of the prohibited XRPL addresses.
 func UpdateProhibitedXRPLAddressesCmd(bcp BridgeClientProvider) *cobra.Command {
 	cmd := &cobra.Command{
@@ -803,35 +2061,122 @@ func ContractOwnershipCmd(bcp BridgeClientProvider) *cobra.Command {
 	}
 }
 
-// RegisteredTokensCmd prints all registered tokens.
+// RegisteredTokensCmd prints all registered token pairs.
 func RegisteredTokensCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "registered-tokens",
-		Short: "Print all registered tokens.",
+		Short: "Print all registered token pairs.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print all registered token pairs.
+The XRPL issuer of the Coreum originated tokens is the bridge XRPL address.
+Example:
+$ registered-tokens --%s %s --%s %s
+`, FlagState, coreum.TokenStateEnabled, FlagOutput, outputFormatJSON,
+		)),
+		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				coreumTokens, xrplTokens, err := bridgeClient.GetAllTokens(ctx)
+				state, err := cmd.Flags().GetString(FlagState)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to get %s", FlagState)
+				}
+				if state != "" && !lo.Contains([]coreum.TokenState{
+					coreum.TokenStateEnabled,
+					coreum.TokenStateDisabled,
+					coreum.TokenStateProcessing,
+					coreum.TokenStateInactive,
+				}, coreum.TokenState(state)) {
+					return errors.Errorf("invalid token state %q", state)
+				}
+				outputFormat, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagOutput)
+				}
+				if outputFormat != outputFormatText && outputFormat != outputFormatJSON {
+					return errors.Errorf("invalid output format %q", outputFormat)
 				}
 
-				components.Log.Info(ctx, "Coreum tokens", zap.Int("total", len(coreumTokens)))
+				tokenPairs, err := bridgeClient.GetTokenPairs(ctx)
+				if err != nil {
+					return err
+				}
+				if state != "" {
+					tokenPairs = lo.Filter(tokenPairs, func(tokenPair bridgeclient.TokenPair, _ int) bool {
+						return tokenPair.State == coreum.TokenState(state)
+					})
+				}
 
-				for _, token := range coreumTokens {
-					components.Log.Info(ctx, token.Denom, zap.Any("token", token))
+				if outputFormat == outputFormatJSON {
+					tokenPairsBytes, err := json.MarshalIndent(tokenPairs, "", "  ")
+					if err != nil {
+						return errors.Wrap(err, "failed to marshal token pairs")
+					}
+					_, err = fmt.Fprintln(cmd.OutOrStdout(), string(tokenPairsBytes))
+					return errors.Wrap(err, "failed to write token pairs")
 				}
 
-				components.Log.Info(ctx, "XRPL tokens", zap.Int("total", len(xrplTokens)))
+				return writeTokenPairsTable(cmd.OutOrStdout(), tokenPairs)
+			}),
+	}
+	cmd.Flags().String(
+		FlagState,
+		"",
+		fmt.Sprintf("Token state filter (%s/%s/%s/%s)",
+			coreum.TokenStateEnabled, coreum.TokenStateDisabled, coreum.TokenStateProcessing, coreum.TokenStateInactive),
+	)
+	cmd.Flags().String(
+		FlagOutput, outputFormatText, fmt.Sprintf("Output format (%s/%s)", outputFormatText, outputFormatJSON),
+	)
+
+	return cmd
+}
+
+// ExportTokensCmd writes all registered tokens to the file.
+func ExportTokensCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-tokens",
+		Short: "Write all registered tokens to the file.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Write all registered tokens to the file.
+The file is used to replicate the token registry to another bridge contract with the import-tokens command.
+Example:
+$ export-tokens --%s tokens.json
+`, FlagOutput,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagOutput)
+				}
 
-				for _, token := range xrplTokens {
-					components.Log.Info(ctx, fmt.Sprintf("%s/%s", token.Currency, token.Issuer), zap.Any("token", token))
+				registry, err := bridgeClient.ExportTokenRegistry(ctx)
+				if err != nil {
+					return err
+				}
+				if err := writeJSONFile(outputPath, registry); err != nil {
+					return err
 				}
 
+				components.Log.Info(
+					ctx,
+					"Token registry is written to the file.",
+					zap.String("path", outputPath),
+					zap.Int("coreumTokens", len(registry.CoreumTokens)),
+					zap.Int("xrplTokens", len(registry.XRPLTokens)),
+				)
+
 				return nil
 			}),
 	}
+	cmd.Flags().String(FlagOutput, "tokens.json", "Token registry output file path")
+
+	return cmd
 }
 
 // CoreumBalancesCmd prints coreum balances.
@@ -901,40 +2246,255 @@ func RelayerFeesCmd(bcp BridgeClientProvider) *cobra.Command {
 		Long: strings.TrimSpace(fmt.Sprintf(
 			`Print pending refunds.
 Example:
-$ relayer-fees %s 
-`, constant.AddressSampleTest,
+$ relayer-fees %s 
+`, constant.AddressSampleTest,
+		)),
+		Args: cobra.ExactArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				address, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+
+				relayerFees, err := bridgeClient.GetFeesCollected(ctx, address)
+				if err != nil {
+					return err
+				}
+
+				components.Log.Info(ctx, "Got relayer fees", zap.String("fees", relayerFees.String()))
+				return nil
+			}),
+	}
+}
+
+// PendingOperationsCmd prints pending operations.
+func PendingOperationsCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-operations",
+		Short: "Print pending operations.",
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				pendingOperations, err := bridgeClient.GetPendingOperations(ctx)
+				if err != nil {
+					return err
+				}
+				contractCfg, err := bridgeClient.GetContractConfig(ctx)
+				if err != nil {
+					return err
+				}
+
+				operationAgeStoreFilePath, err := getOperationAgeStoreFilePath(cmd)
+				if err != nil {
+					return err
+				}
+				operationAgeStore, err := processes.ReadOperationAgeStore(operationAgeStoreFilePath)
+				if err != nil {
+					return err
+				}
+
+				// the fee is computed the same way as by the relayer process
+				feeCfg := processes.NewMultiSigningTxFeeConfig(contractCfg)
+				pendingOperationsInfo := make([]pendingOperationInfo, 0, len(pendingOperations))
+				for _, operation := range pendingOperations {
+					fee, err := processes.GetMultiSigningTxFee(operation, feeCfg)
+					if err != nil {
+						return err
+					}
+					info := pendingOperationInfo{
+						Operation: operation,
+						XRPLTxFee: fee.String(),
+					}
+					if firstSeen, ok := operationAgeStore.FirstSeen[operation.GetOperationID()]; ok {
+						info.Age = time.Since(firstSeen).Truncate(time.Second).String()
+					}
+					pendingOperationsInfo = append(pendingOperationsInfo, info)
+				}
+
+				log, err := GetCLILogger()
+				if err != nil {
+					return err
+				}
+				log.Info(ctx, "Got pending operations", zap.Any("operations", pendingOperationsInfo))
+
+				return nil
+			}),
+	}
+}
+
+// PreviewOperationCmd prints the XRPL transaction the relayers sign for the pending operation.
+func PreviewOperationCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview-operation",
+		Short: "Print the XRPL transaction the relayers sign for the pending operation.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the XRPL transaction the relayers sign for the pending operation without signing it.
+The transaction is built the same way as by the relayer process, and printed as the field breakdown and the canonical
+signing-ready JSON along with the number of the required signatures and the relayers which have already signed it.
+Example:
+$ preview-operation --%s 123
+`, FlagOperationID,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				operationID, err := cmd.Flags().GetUint32(FlagOperationID)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagOperationID)
+				}
+				if operationID == 0 {
+					return errors.Errorf("the --%s flag is required", FlagOperationID)
+				}
+
+				pendingOperations, err := bridgeClient.GetPendingOperations(ctx)
+				if err != nil {
+					return err
+				}
+				operation, found := lo.Find(pendingOperations, func(operation coreum.Operation) bool {
+					return operation.GetOperationID() == operationID
+				})
+				if !found {
+					return errors.Errorf("pending operation %d not found", operationID)
+				}
+				contractCfg, err := bridgeClient.GetContractConfig(ctx)
+				if err != nil {
+					return err
+				}
+
+				preview, err := processes.BuildOperationPreview(contractCfg, operation)
+				if err != nil {
+					return err
+				}
+
+				return writeOperationPreview(cmd.OutOrStdout(), preview)
+			}),
+	}
+	cmd.Flags().Uint32(FlagOperationID, 0, "Ticket or account sequence of the pending operation")
+
+	return cmd
+}
+
+// SignatureProgressCmd prints the relayers signing progress of the pending operation.
+func SignatureProgressCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signature-progress",
+		Short: "Print the relayers signing progress of the pending operation.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the relayers which have and haven't signed the pending operation, and the percentage of the evidence
+threshold reached by the signatures.
+Example:
+$ signature-progress --%s 123
+`, FlagTicketSequence,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ticketSequence, err := cmd.Flags().GetUint32(FlagTicketSequence)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagTicketSequence)
+				}
+				if ticketSequence == 0 {
+					return errors.Errorf("the --%s flag is required", FlagTicketSequence)
+				}
+
+				progress, err := bridgeClient.GetSignatureProgress(cmd.Context(), ticketSequence)
+				if err != nil {
+					return err
+				}
+
+				return writeSignatureProgress(cmd.OutOrStdout(), progress)
+			}),
+	}
+	cmd.Flags().Uint32(FlagTicketSequence, 0, "Ticket or account sequence of the pending operation")
+
+	return cmd
+}
+
+// StuckTokenRegistrationsCmd prints the XRPL tokens which registration is stuck.
+func StuckTokenRegistrationsCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stuck-token-registrations",
+		Short: "Print the XRPL tokens which registration is stuck.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the XRPL tokens in the processing state which TrustSet operation is missing or is first seen by the
+relayer more than --%s ago. The operation age is read from the relayer home, so the operations not tracked by the
+relayer aren't reported. The stuck registrations can be recovered with the recover-token-registration command.
+Example:
+$ stuck-token-registrations --%s 2h
+`, FlagOlderThan, FlagOlderThan,
 		)),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				address, err := sdk.AccAddressFromBech32(args[0])
+				olderThan, err := cmd.Flags().GetDuration(FlagOlderThan)
 				if err != nil {
-					return err
+					return errors.Wrapf(err, "failed to read flag %s", FlagOlderThan)
 				}
 
-				relayerFees, err := bridgeClient.GetFeesCollected(ctx, address)
+				stuckTokens, err := bridgeClient.ListStuckTokenRegistrations(ctx, olderThan)
 				if err != nil {
 					return err
 				}
 
-				components.Log.Info(ctx, "Got relayer fees", zap.String("fees", relayerFees.String()))
+				components.Log.Info(ctx, "Got stuck token registrations", zap.Any("tokens", stuckTokens))
 				return nil
 			}),
 	}
+	cmd.Flags().Duration(
+		FlagOlderThan,
+		processes.DefaultOperationAgeTrackerConfig("").MaxAge,
+		"Age of the TrustSet operation after which the token is considered stuck",
+	)
+
+	return cmd
 }
 
-// PendingOperationsCmd prints pending operations.
-func PendingOperationsCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
-		Use:   "pending-operations",
-		Short: "Print pending operations.",
+// TicketUsageCmd prints the tickets used by the completed operations and the projected exhaustion of the available
+// tickets.
+func TicketUsageCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ticket-usage",
+		Short: "Print the tickets consumption by operation type.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Print the tickets used by the operations completed within the window, by operation type and result, the burn
+rate in tickets per day and the projected exhaustion time of the tickets available in the contract.
+The usage is read from the local log of the relayer, so only the operations observed by the relayer are counted. The
+tickets of the invalid operations are returned to the contract, so they aren't included in the burn rate.
+Example:
+$ ticket-usage --%s 72h
+`, FlagWindow,
+		)),
+		Args: cobra.NoArgs,
 		RunE: runBridgeCmd(bcp,
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				pendingOperations, err := bridgeClient.GetPendingOperations(ctx)
+				window, err := cmd.Flags().GetDuration(FlagWindow)
+				if err != nil {
+					return errors.Wrapf(err, "failed to read flag %s", FlagWindow)
+				}
+				ticketUsageFilePath, err := getTicketUsageFilePath(cmd)
+				if err != nil {
+					return err
+				}
+				now := time.Now().UTC()
+				records, err := processes.ReadTicketUsage(ticketUsageFilePath, now.Add(-window))
+				if err != nil {
+					return err
+				}
+				availableTickets, err := bridgeClient.GetAvailableTickets(ctx)
+				if err != nil {
+					return err
+				}
+				summary, err := processes.SummarizeTicketUsage(records, window, now, len(availableTickets))
 				if err != nil {
 					return err
 				}
@@ -943,11 +2503,14 @@ func PendingOperationsCmd(bcp BridgeClientProvider) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				log.Info(ctx, "Got pending operations", zap.Any("operations", pendingOperations))
+				log.Info(ctx, "Got ticket usage", zap.Any("usage", summary))
 
 				return nil
 			}),
 	}
+	cmd.Flags().Duration(FlagWindow, 7*24*time.Hour, "Time window of the completed operations")
+
+	return cmd
 }
 
 // ProhibitedXRPLAddressesCmd gets the prohibited xrpl addresses from the contract.
@@ -1039,6 +2602,153 @@ func TraceCoreumToXRPLTransfer(bcp BridgeClientProvider) *cobra.Command {
 	}
 }
 
+// TransferHistoryCmd prints the bridge transfers found in the Coreum tx history.
+func TransferHistoryCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-history",
+		Short: "Print the bridge transfers history.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Print the bridge transfers found in the Coreum tx history.
+Example:
+$ transfer-history --%s 1000 --%s 2000 --%s %s --%s ucore
+`,
+				FlagStartHeight, FlagEndHeight, FlagDirection, bridgeclient.TransferDirectionCoreumToXRPL, FlagDenom,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagStartHeight)
+				}
+				endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagEndHeight)
+				}
+				denom, err := cmd.Flags().GetString(FlagDenom)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagDenom)
+				}
+				direction, err := cmd.Flags().GetString(FlagDirection)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagDirection)
+				}
+				recipient, err := cmd.Flags().GetString(FlagRecipient)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get %s", FlagRecipient)
+				}
+
+				transfers, err := bridgeClient.GetTransferHistory(ctx, bridgeclient.HistoryFilter{
+					StartHeight:      startHeight,
+					EndHeight:        endHeight,
+					TokenDenom:       denom,
+					Direction:        bridgeclient.TransferDirection(direction),
+					RecipientAddress: recipient,
+				})
+				if err != nil {
+					return err
+				}
+
+				log, err := GetCLILogger()
+				if err != nil {
+					return err
+				}
+				log.Info(ctx, "Got transfer history", zap.Any("transfers", transfers))
+
+				return nil
+			}),
+	}
+	cmd.Flags().Int64(FlagStartHeight, 0, "Inclusive start Coreum height")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Inclusive end Coreum height")
+	cmd.Flags().String(FlagDenom, "", "Coreum denom of the transferred token")
+	cmd.Flags().String(
+		FlagDirection,
+		"",
+		fmt.Sprintf(
+			"Transfer direction: %s or %s",
+			bridgeclient.TransferDirectionXRPLToCoreum, bridgeclient.TransferDirectionCoreumToXRPL,
+		),
+	)
+	cmd.Flags().String(FlagRecipient, "", "Recipient address, Coreum for XRPL to Coreum and XRPL for Coreum to XRPL")
+
+	return cmd
+}
+
+// RateLimitsCmd prints the Coreum to XRPL transfer rate limits of the relayer and their current consumption.
+func RateLimitsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Print the Coreum to XRPL transfer rate limits of the relayer.",
+		Long: strings.TrimSpace(
+			`Print the Coreum to XRPL transfer rate limits configured in the relayer config and their current consumption.
+The consumption is read from the metrics server of the running relayer, so the metrics must be enabled.
+Example:
+$ rate-limits
+`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cfg, err := GetHomeRunnerConfig(cmd)
+			if err != nil {
+				return err
+			}
+			log, err := GetCLILogger()
+			if err != nil {
+				return err
+			}
+
+			rateLimitsCfg := cfg.Processes.CoreumToXRPLProcess.RateLimits
+			if len(rateLimitsCfg) == 0 {
+				log.Info(ctx, "No rate limits configured")
+				return nil
+			}
+			if !cfg.Metrics.Enabled {
+				log.Info(
+					ctx,
+					"Metrics are disabled, the rate limits consumption is unavailable",
+					zap.Any("rateLimits", rateLimitsCfg),
+				)
+				return nil
+			}
+
+			rateLimitsState, err := getRateLimitsStateFromMetrics(ctx, cfg.Metrics.Server.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			rateLimits := make([]rateLimitInfo, 0, len(rateLimitsCfg))
+			for _, rateLimitCfg := range rateLimitsCfg {
+				maxAmount, err := strconv.ParseFloat(rateLimitCfg.MaxAmountPerHour, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid max amount per hour: %s", rateLimitCfg.MaxAmountPerHour)
+				}
+				state := rateLimitsState[fmt.Sprintf("%s/%s", rateLimitCfg.Currency, rateLimitCfg.Issuer)]
+				remaining := maxAmount - state.consumption
+				if remaining < 0 {
+					remaining = 0
+				}
+				rateLimits = append(rateLimits, rateLimitInfo{
+					Issuer:                rateLimitCfg.Issuer,
+					Currency:              rateLimitCfg.Currency,
+					MaxAmountPerHour:      rateLimitCfg.MaxAmountPerHour,
+					Consumption:           state.consumption,
+					Remaining:             remaining,
+					RateLimitedOperations: state.rateLimitedOperations,
+				})
+			}
+			log.Info(ctx, "Got rate limits", zap.Any("rateLimits", rateLimits))
+
+			return nil
+		},
+	}
+}
+
 // CoreumTxPreRun is Coreum transaction CMD pre-run function.
 func CoreumTxPreRun(bcp BridgeClientProvider) func(cmd *cobra.Command, args []string) error {
 	return runBridgeCmd(bcp,
@@ -1068,6 +2778,7 @@ func AddCoreumTxFlags(cmd *cobra.Command) {
 	AddKeyringFlags(cmd)
 	AddKeyNameFlag(cmd)
 	AddHomeFlag(cmd)
+	AddFeeFlags(cmd)
 	cmd.PersistentFlags().Bool(flags.FlagGenerateOnly, false, "Generate unsigned transaction")
 	cmd.PersistentFlags().Bool(
 		FlagFromOwner, false, "Sets message sender to owner address specified in contract config",
@@ -1144,32 +2855,144 @@ func addUpdateTokenFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(
 		FlagBridgingFee,
 		"", "Token bridging fee")
+	cmd.PersistentFlags().Uint32(
+		FlagMaxSendsPerAddressPerDay,
+		0, "Token max sends to XRPL per address per day (0 removes the limit)")
+	cmd.PersistentFlags().String(
+		FlagTreasuryFee,
+		"", "Part of the token bridging fee kept for the treasury (0 removes the fee)")
+}
+
+func addMaxSendsPerAddressPerDayFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Uint32(
+		FlagMaxSendsPerAddressPerDay,
+		0, "Token max sends to XRPL per address per day (unlimited if not set)")
+}
+
+func addSkipIssuerCheckFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(
+		FlagSkipIssuerCheck,
+		false,
+		"Skip the check that the XRPL issuer account exists and its balance covers the base reserve",
+	)
+}
+
+// checkXRPLIssuerAccounts checks that the XRPL issuer accounts are activated unless the check is skipped with the flag.
+func checkXRPLIssuerAccounts(cmd *cobra.Command, bridgeClient BridgeClient, issuers ...rippledata.Account) error {
+	skipIssuerCheck, err := cmd.Flags().GetBool(FlagSkipIssuerCheck)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", FlagSkipIssuerCheck)
+	}
+	if skipIssuerCheck {
+		return nil
+	}
+	for _, issuer := range lo.Uniq(issuers) {
+		if err := bridgeClient.CheckXRPLIssuerAccount(cmd.Context(), issuer); err != nil {
+			return errors.Wrapf(err, "XRPL issuer account check failed, use --%s to skip it", FlagSkipIssuerCheck)
+		}
+	}
+
+	return nil
+}
+
+func addDeliveryModeFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(
+		FlagDeliveryMode,
+		"", fmt.Sprintf(
+			"Token delivery mode (%s or %s, %s if not set)",
+			coreum.XRPLTokenDeliveryModeTypeAssetFT,
+			coreum.XRPLTokenDeliveryModeTypeIBC,
+			coreum.XRPLTokenDeliveryModeTypeAssetFT,
+		))
+	cmd.PersistentFlags().String(
+		FlagIBCChannelID,
+		"", "IBC channel ID the tokens are transferred with in the ibc delivery mode")
+	cmd.PersistentFlags().Duration(
+		FlagIBCTransferTimeout,
+		0, "Timeout of the IBC transfer in the ibc delivery mode, the transfer is refunded once timed out")
 }
 
-func readUpdateTokenFlags(cmd *cobra.Command) (*string, *int32, *sdkmath.Int, *sdkmath.Int, error) {
+func readDeliveryModeFlags(cmd *cobra.Command) (*coreum.XRPLTokenDeliveryMode, error) {
+	modeType, err := getFlagStringIfPresent(cmd, FlagDeliveryMode)
+	if err != nil {
+		return nil, err
+	}
+	ibcChannelID, err := cmd.Flags().GetString(FlagIBCChannelID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	ibcTransferTimeout, err := cmd.Flags().GetDuration(FlagIBCTransferTimeout)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if modeType == nil {
+		if ibcChannelID != "" || ibcTransferTimeout != 0 {
+			return nil, errors.Errorf(
+				"the --%s and --%s flags require --%s %s",
+				FlagIBCChannelID, FlagIBCTransferTimeout, FlagDeliveryMode, coreum.XRPLTokenDeliveryModeTypeIBC,
+			)
+		}
+		return nil, nil //nolint:nilnil // nil delivery mode means the default one
+	}
+
+	switch coreum.XRPLTokenDeliveryModeType(*modeType) {
+	case coreum.XRPLTokenDeliveryModeTypeAssetFT:
+		if ibcChannelID != "" || ibcTransferTimeout != 0 {
+			return nil, errors.Errorf(
+				"the --%s and --%s flags are used only with --%s %s",
+				FlagIBCChannelID, FlagIBCTransferTimeout, FlagDeliveryMode, coreum.XRPLTokenDeliveryModeTypeIBC,
+			)
+		}
+		return &coreum.XRPLTokenDeliveryMode{
+			Type: coreum.XRPLTokenDeliveryModeTypeAssetFT,
+		}, nil
+	case coreum.XRPLTokenDeliveryModeTypeIBC:
+		if ibcChannelID == "" || ibcTransferTimeout < time.Second {
+			return nil, errors.Errorf(
+				"the --%s and --%s flags are required with --%s %s",
+				FlagIBCChannelID, FlagIBCTransferTimeout, FlagDeliveryMode, coreum.XRPLTokenDeliveryModeTypeIBC,
+			)
+		}
+		return &coreum.XRPLTokenDeliveryMode{
+			Type:                      coreum.XRPLTokenDeliveryModeTypeIBC,
+			IBCChannelID:              ibcChannelID,
+			IBCTransferTimeoutSeconds: uint64(ibcTransferTimeout.Seconds()),
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid delivery mode: %s", *modeType)
+	}
+}
+
+func readUpdateTokenFlags(cmd *cobra.Command) (*string, *int32, *sdkmath.Int, *sdkmath.Int, *uint32, error) {
 	var (
 		state *string
 		err   error
 	)
 	if state, err = getFlagStringIfPresent(cmd, FlagTokenState); err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
 	}
 	var sendingPrecision *int32
 	if sendingPrecision, err = getFlagInt32IfPresent(cmd, FlagSendingPrecision); err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
 	}
 
 	maxHoldingAmount, err := getFlagSDKIntIfPresent(cmd, FlagMaxHoldingAmount)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
 	}
 
 	bridgingFee, err := getFlagSDKIntIfPresent(cmd, FlagBridgingFee)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, err
+	}
+
+	maxSendsPerAddressPerDay, err := getFlagUint32IfPresent(cmd, FlagMaxSendsPerAddressPerDay)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
 	}
 
-	return state, sendingPrecision, maxHoldingAmount, bridgingFee, nil
+	return state, sendingPrecision, maxHoldingAmount, bridgingFee, maxSendsPerAddressPerDay, nil
 }
 
 func convertStateStringTokenState(state *string) (*coreum.TokenState, error) {
@@ -1184,3 +3007,279 @@ func convertStateStringTokenState(state *string) (*coreum.TokenState, error) {
 		return nil, errors.Errorf("invalid token state: %s", *state)
 	}
 }
+
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+)
+
+func writeTokenPairsTable(out io.Writer, tokenPairs []bridgeclient.TokenPair) error {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	rows := make([]string, 0, len(tokenPairs)+1)
+	rows = append(rows, strings.Join([]string{
+		"TYPE",
+		"XRPL ISSUER",
+		"XRPL CURRENCY",
+		"COREUM DENOM",
+		"SENDING PRECISION",
+		"MAX HOLDING AMOUNT",
+		"BRIDGING FEE",
+		"STATE",
+	}, "\t"))
+	for _, tokenPair := range tokenPairs {
+		rows = append(rows, strings.Join([]string{
+			string(tokenPair.Type),
+			tokenPair.XRPLIssuer,
+			tokenPair.XRPLCurrency,
+			tokenPair.CoreumDenom,
+			strconv.Itoa(int(tokenPair.SendingPrecision)),
+			tokenPair.MaxHoldingAmount.String(),
+			tokenPair.BridgingFee.String(),
+			string(tokenPair.State),
+		}, "\t"))
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(tw, row); err != nil {
+			return errors.Wrap(err, "failed to write token pairs table")
+		}
+	}
+
+	return errors.Wrap(tw.Flush(), "failed to write token pairs table")
+}
+
+func writeOperationPreview(out io.Writer, preview processes.OperationPreview) error {
+	var txFields map[string]json.RawMessage
+	if err := json.Unmarshal(preview.TxJSON, &txFields); err != nil {
+		return errors.Wrap(err, "failed to unmarshal XRPL transaction JSON")
+	}
+	signedCount := lo.CountBy(preview.Signers, func(signer processes.OperationPreviewSigner) bool {
+		return signer.Signed
+	})
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	rows := []string{
+		fmt.Sprintf("OPERATION ID\t%d", preview.OperationID),
+		fmt.Sprintf("OPERATION VERSION\t%d", preview.OperationVersion),
+		fmt.Sprintf("OPERATION TYPE\t%s", preview.OperationType),
+		fmt.Sprintf("SIGNATURES\t%d/%d", signedCount, preview.RequiredSignatures),
+		"",
+		"FIELD\tVALUE",
+	}
+	fields := lo.Keys(txFields)
+	sort.Strings(fields)
+	for _, field := range fields {
+		rows = append(rows, fmt.Sprintf("%s\t%s", field, strings.Trim(string(txFields[field]), `"`)))
+	}
+	rows = append(rows, "", "RELAYER COREUM ADDRESS\tRELAYER XRPL ADDRESS\tSIGNED")
+	for _, signer := range preview.Signers {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%t", signer.CoreumAddress, signer.XRPLAddress, signer.Signed))
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(tw, row); err != nil {
+			return errors.Wrap(err, "failed to write operation preview")
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return errors.Wrap(err, "failed to write operation preview")
+	}
+
+	txJSON, err := json.MarshalIndent(preview.TxJSON, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal XRPL transaction JSON")
+	}
+	_, err = fmt.Fprintf(out, "\nSigning-ready XRPL transaction:\n%s\nTx blob: %s\n", string(txJSON), preview.TxBlob)
+
+	return errors.Wrap(err, "failed to write operation preview")
+}
+
+func writeSignatureProgress(out io.Writer, progress coreum.SignatureProgress) error {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	rows := []string{
+		fmt.Sprintf("OPERATION ID\t%d", progress.Operation.GetOperationID()),
+		fmt.Sprintf("OPERATION VERSION\t%d", progress.Operation.Version),
+		fmt.Sprintf("OPERATION TYPE\t%s", progress.Operation.GetOperationTypeName()),
+		fmt.Sprintf(
+			"SIGNATURES\t%d/%d (%.0f%%)",
+			len(progress.SignedRelayers), progress.EvidenceThreshold, progress.ThresholdPercentage,
+		),
+		"",
+		"RELAYER COREUM ADDRESS\tRELAYER XRPL ADDRESS\tSIGNED",
+	}
+	for _, relayer := range progress.SignedRelayers {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%t", relayer.CoreumAddress.String(), relayer.XRPLAddress, true))
+	}
+	for _, relayer := range progress.UnsignedRelayers {
+		rows = append(rows, fmt.Sprintf("%s\t%s\t%t", relayer.CoreumAddress.String(), relayer.XRPLAddress, false))
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(tw, row); err != nil {
+			return errors.Wrap(err, "failed to write signature progress")
+		}
+	}
+
+	return errors.Wrap(tw.Flush(), "failed to write signature progress")
+}
+
+type pendingOperationInfo struct {
+	coreum.Operation
+	// XRPLTxFee is the fee in drops of the operation XRPL transaction.
+	XRPLTxFee string `json:"xrpl_tx_fee"`
+	// Age is the time since the operation is first seen by the relayer, it's set only if the relayer home contains
+	// the operation age store.
+	Age string `json:"age,omitempty"`
+}
+
+type rateLimitInfo struct {
+	Issuer                string  `json:"issuer"`
+	Currency              string  `json:"currency"`
+	MaxAmountPerHour      string  `json:"max_amount_per_hour"`
+	Consumption           float64 `json:"consumption"`
+	Remaining             float64 `json:"remaining"`
+	RateLimitedOperations float64 `json:"rate_limited_operations"`
+}
+
+type rateLimitState struct {
+	consumption           float64
+	rateLimitedOperations float64
+}
+
+// getRateLimitsStateFromMetrics returns the rate limits state exported by the relayer metrics server, the state is
+// keyed by the currency/issuer label.
+func getRateLimitsStateFromMetrics(ctx context.Context, listenAddress string) (map[string]rateLimitState, error) {
+	metricsURL := fmt.Sprintf("http://%s/metrics", listenAddress)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metricsURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build metrics request")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get relayer metrics from %s, is the relayer running?", metricsURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected relayer metrics response status: %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	metricFamilies, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse relayer metrics")
+	}
+
+	states := make(map[string]rateLimitState)
+	for _, metricName := range []string{
+		metrics.CoreumToXRPLTransferRateLimitConsumptionMetricName,
+		metrics.CoreumToXRPLTransferRateLimitedOperationsMetricName,
+	} {
+		metricFamily, ok := metricFamilies[metricName]
+		if !ok {
+			continue
+		}
+		for _, metric := range metricFamily.GetMetric() {
+			var label string
+			for _, labelPair := range metric.GetLabel() {
+				if labelPair.GetName() == metrics.XRPLCurrencyIssuerLabel {
+					label = labelPair.GetValue()
+				}
+			}
+			state := states[label]
+			switch metricName {
+			case metrics.CoreumToXRPLTransferRateLimitConsumptionMetricName:
+				state.consumption = metric.GetGauge().GetValue()
+			default:
+				state.rateLimitedOperations = metric.GetCounter().GetValue()
+			}
+			states[label] = state
+		}
+	}
+
+	return states, nil
+}
+
+// BlockedDeliveriesCmd prints the XRPL to Coreum deliveries blocked by the asset FT rules.
+func BlockedDeliveriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-deliveries",
+		Short: "Print the XRPL to Coreum deliveries blocked by the asset FT rules.",
+		Long: strings.TrimSpace(
+			`Print the XRPL to Coreum deliveries blocked by the asset FT rules, e.g. freezing or whitelisting.
+The deliveries are re-attempted by the relayer with the blocked_delivery_retry_delay from the relayer config.
+Example:
+$ blocked-deliveries
+`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			blockedDeliveriesStoreFilePath, err := getBlockedDeliveriesStoreFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			blockedDeliveries, err := processes.ReadBlockedDeliveries(blockedDeliveriesStoreFilePath)
+			if err != nil {
+				return err
+			}
+
+			log, err := GetCLILogger()
+			if err != nil {
+				return err
+			}
+			log.Info(ctx, "Got blocked deliveries", zap.Any("deliveries", blockedDeliveries))
+
+			return nil
+		},
+	}
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+// RetryBlockedDeliveryCmd requests the retry of the blocked XRPL to Coreum delivery.
+func RetryBlockedDeliveryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-blocked",
+		Short: "Request the retry of the XRPL to Coreum delivery blocked by the asset FT rules.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Request the retry of the XRPL to Coreum delivery blocked by the asset FT rules.
+The delivery is re-attempted by the running relayer within a minute, or with the relayer start.
+Example:
+$ retry-blocked --%s %s
+`, FlagTxHash, "A4E1AC7A3A8AB9E6B4CA8C5EA9C2C6B09D2F0B2A0D1F0C1A84E4AA5E0F0B7C11",
+		)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			txHash, err := cmd.Flags().GetString(FlagTxHash)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagTxHash)
+			}
+			if txHash == "" {
+				return errors.Errorf("the --%s flag is required", FlagTxHash)
+			}
+
+			blockedDeliveriesStoreFilePath, err := getBlockedDeliveriesStoreFilePath(cmd)
+			if err != nil {
+				return err
+			}
+			if err := processes.RequestBlockedDeliveryRetry(
+				blockedDeliveriesStoreFilePath, strings.ToUpper(txHash),
+			); err != nil {
+				return err
+			}
+
+			log, err := GetCLILogger()
+			if err != nil {
+				return err
+			}
+			log.Info(ctx, "Blocked delivery retry is requested", zap.String("txHash", txHash))
+
+			return nil
+		},
+	}
+	AddHomeFlag(cmd)
+	cmd.Flags().String(FlagTxHash, "", "XRPL tx hash of the blocked delivery")
+
+	return cmd
+}
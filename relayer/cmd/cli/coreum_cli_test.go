@@ -2,24 +2,37 @@ package cli_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http/httptest"
+	"os"
 	"path"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
 	bridgeclient "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/client"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/cmd/cli"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/metrics"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
@@ -159,43 +172,105 @@ func TestRecoverTicketsCmd(t *testing.T) {
 }
 
 func TestRegisterCoreumTokenCmd(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
 	keyringDir := t.TempDir()
 	keyName := "owner"
 	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
 
 	denom := "denom"
-	decimals := 10
+	decimals := uint32(10)
 	sendingPrecision := 12
 	maxHoldingAmount := 10000
-	args := append(initConfig(t),
-		denom,
-		strconv.Itoa(decimals),
-		strconv.Itoa(sendingPrecision),
-		strconv.Itoa(maxHoldingAmount),
-		"1",
-		flagWithPrefix(cli.FlagKeyName), keyName,
-	)
-	args = append(args, testKeyringFlags(keyringDir)...)
 
-	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().RegisterCoreumToken(
-		gomock.Any(),
-		gomock.Any(),
-		denom,
-		uint32(decimals),
-		int32(sendingPrecision),
-		sdkmath.NewInt(int64(maxHoldingAmount)),
-		sdkmath.NewInt(1),
-	)
-	executeCoreumTxCmd(
-		t,
-		mockBridgeClientProvider(bridgeClientMock),
-		cli.RegisterCoreumTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
-		args...,
-	)
+	tests := []struct {
+		name          string
+		decimalsFlag  *uint32
+		mockFn        func(bridgeClientMock *MockBridgeClient)
+		expectedError string
+	}{
+		{
+			name:         "decimals_from_flag",
+			decimalsFlag: lo.ToPtr(decimals),
+			mockFn: func(bridgeClientMock *MockBridgeClient) {
+				bridgeClientMock.EXPECT().ValidateCoreumDenomSupply(gomock.Any(), denom).Return(nil)
+			},
+		},
+		{
+			name: "decimals_from_metadata",
+			mockFn: func(bridgeClientMock *MockBridgeClient) {
+				bridgeClientMock.EXPECT().ValidateCoreumDenomSupply(gomock.Any(), denom).Return(nil)
+				bridgeClientMock.EXPECT().GetCoreumDenomDecimals(gomock.Any(), denom).Return(decimals, nil)
+			},
+		},
+		{
+			name:         "zero_supply",
+			decimalsFlag: lo.ToPtr(decimals),
+			mockFn: func(bridgeClientMock *MockBridgeClient) {
+				bridgeClientMock.EXPECT().ValidateCoreumDenomSupply(gomock.Any(), denom).
+					Return(errors.New("zero supply"))
+			},
+			expectedError: "zero supply",
+		},
+		{
+			name: "no_metadata",
+			mockFn: func(bridgeClientMock *MockBridgeClient) {
+				bridgeClientMock.EXPECT().ValidateCoreumDenomSupply(gomock.Any(), denom).Return(nil)
+				bridgeClientMock.EXPECT().GetCoreumDenomDecimals(gomock.Any(), denom).
+					Return(uint32(0), errors.New("no metadata"))
+			},
+			expectedError: "no metadata",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			args := append(initConfig(t),
+				denom,
+				strconv.Itoa(sendingPrecision),
+				strconv.Itoa(maxHoldingAmount),
+				"1",
+				flagWithPrefix(cli.FlagKeyName), keyName,
+			)
+			if tt.decimalsFlag != nil {
+				args = append(args, flagWithPrefix(cli.FlagDecimals), strconv.Itoa(int(*tt.decimalsFlag)))
+			}
+			args = append(args, testKeyringFlags(keyringDir)...)
+
+			bridgeClientMock := NewMockBridgeClient(ctrl)
+			tt.mockFn(bridgeClientMock)
+			if tt.expectedError != "" {
+				cmd := cli.RegisterCoreumTokenCmd(mockBridgeClientProvider(bridgeClientMock))
+				cli.AddCoreumTxFlags(cmd)
+				cmd.PreRunE = cli.CoreumTxPreRun(mockBridgeClientProvider(bridgeClientMock))
+				_, err := executeCmdWithOutputOptionAndError(cmd, "text", args...)
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+
+			bridgeClientMock.EXPECT().RegisterCoreumToken(
+				gomock.Any(),
+				gomock.Any(),
+				denom,
+				decimals,
+				int32(sendingPrecision),
+				sdkmath.NewInt(int64(maxHoldingAmount)),
+				sdkmath.NewInt(1),
+				nil,
+			).Return(coreum.CoreumToken{
+				Denom:        denom,
+				Decimals:     decimals,
+				XRPLCurrency: "436F72650000000000000000000000000000000",
+			}, nil)
+			executeCoreumTxCmd(
+				t,
+				mockBridgeClientProvider(bridgeClientMock),
+				cli.RegisterCoreumTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
+				args...,
+			)
+		})
+	}
 }
 
 func TestUpdateCoreumTokenCmd(t *testing.T) {
@@ -227,6 +302,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					nil,
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -248,6 +325,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -269,6 +348,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -290,6 +371,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -311,6 +394,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					nil,
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -335,6 +420,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -356,6 +443,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 						return v.String() == "77"
 					}),
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -380,6 +469,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 						return v.String() == "77"
 					}),
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -401,6 +492,54 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					mock.MatchedBy(func(v *sdkmath.Int) bool {
 						return v.String() == "9999"
 					}),
+					nil,
+					nil,
+				)
+			},
+		},
+		{
+			name: "max_sends_per_address_per_day_update",
+			args: []string{
+				denom,
+				flagWithPrefix(cli.FlagMaxSendsPerAddressPerDay), "100",
+				flagWithPrefix(cli.FlagKeyName), keyName,
+			},
+			mock: func(m *MockBridgeClient) {
+				m.EXPECT().UpdateCoreumToken(
+					gomock.Any(),
+					gomock.Any(),
+					denom,
+					nil,
+					nil,
+					nil,
+					nil,
+					mock.MatchedBy(func(v *uint32) bool {
+						return *v == 100
+					}),
+					nil,
+				)
+			},
+		},
+		{
+			name: "treasury_fee_update",
+			args: []string{
+				denom,
+				flagWithPrefix(cli.FlagTreasuryFee), "500",
+				flagWithPrefix(cli.FlagKeyName), keyName,
+			},
+			mock: func(m *MockBridgeClient) {
+				m.EXPECT().UpdateCoreumToken(
+					gomock.Any(),
+					gomock.Any(),
+					denom,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					mock.MatchedBy(func(v *sdkmath.Int) bool {
+						return v.String() == "500"
+					}),
 				)
 			},
 		},
@@ -425,6 +564,8 @@ func TestUpdateCoreumTokenCmd(t *testing.T) {
 					mock.MatchedBy(func(v *sdkmath.Int) bool {
 						return v.String() == "9999"
 					}),
+					nil,
+					nil,
 				)
 			},
 		},
@@ -464,11 +605,13 @@ func TestRegisterXRPLTokenCmd(t *testing.T) {
 		strconv.Itoa(sendingPrecision),
 		strconv.Itoa(maxHoldingAmount),
 		"1",
+		flagWithPrefix(cli.FlagMaxSendsPerAddressPerDay), "100",
 		flagWithPrefix(cli.FlagKeyName), keyName,
 	)
 	args = append(args, testKeyringFlags(keyringDir)...)
 
 	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().CheckXRPLIssuerAccount(gomock.Any(), issuer).Return(nil)
 	bridgeClientMock.EXPECT().RegisterXRPLToken(
 		gomock.Any(),
 		gomock.Any(),
@@ -477,6 +620,77 @@ func TestRegisterXRPLTokenCmd(t *testing.T) {
 		int32(sendingPrecision),
 		sdkmath.NewInt(int64(maxHoldingAmount)),
 		sdkmath.NewInt(1),
+		lo.ToPtr(uint32(100)),
+		nil,
+	).Times(2)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RegisterXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+
+	// the registration isn't broadcast if the issuer isn't activated
+	bridgeClientMock.EXPECT().CheckXRPLIssuerAccount(gomock.Any(), issuer).
+		Return(errors.Wrap(bridgeclient.ErrXRPLIssuerAccountNotActivated, "account doesn't exist"))
+	cmd := cli.RegisterXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddCoreumTxFlags(cmd)
+	cmd.PreRunE = cli.CoreumTxPreRun(mockBridgeClientProvider(bridgeClientMock))
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorIs(t, err, bridgeclient.ErrXRPLIssuerAccountNotActivated)
+	require.ErrorContains(t, err, cli.FlagSkipIssuerCheck)
+
+	// the check is skipped with the flag
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RegisterXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(args, flagWithPrefix(cli.FlagSkipIssuerCheck))...,
+	)
+}
+
+func TestRegisterXRPLTokenCmd_IBCDeliveryMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	currency, err := rippledata.NewCurrency("CRN")
+	require.NoError(t, err)
+	ibcChannelID := "channel-1"
+	ibcTransferTimeout := "10m"
+	args := append(initConfig(t),
+		issuer.String(),
+		currency.String(),
+		"12",
+		"10000",
+		"1",
+		flagWithPrefix(cli.FlagDeliveryMode), string(coreum.XRPLTokenDeliveryModeTypeIBC),
+		flagWithPrefix(cli.FlagIBCChannelID), ibcChannelID,
+		flagWithPrefix(cli.FlagIBCTransferTimeout), ibcTransferTimeout,
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().CheckXRPLIssuerAccount(gomock.Any(), issuer).Return(nil)
+	bridgeClientMock.EXPECT().RegisterXRPLToken(
+		gomock.Any(),
+		gomock.Any(),
+		issuer,
+		currency,
+		int32(12),
+		sdkmath.NewInt(10000),
+		sdkmath.NewInt(1),
+		nil,
+		&coreum.XRPLTokenDeliveryMode{
+			Type:                      coreum.XRPLTokenDeliveryModeTypeIBC,
+			IBCChannelID:              ibcChannelID,
+			IBCTransferTimeoutSeconds: 600,
+		},
 	)
 	executeCoreumTxCmd(
 		t,
@@ -484,6 +698,145 @@ func TestRegisterXRPLTokenCmd(t *testing.T) {
 		cli.RegisterXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
 		args...,
 	)
+
+	// the IBC delivery mode without the channel
+	args = append(initConfig(t),
+		issuer.String(),
+		currency.String(),
+		"12",
+		"10000",
+		"1",
+		flagWithPrefix(cli.FlagDeliveryMode), string(coreum.XRPLTokenDeliveryModeTypeIBC),
+		flagWithPrefix(cli.FlagIBCTransferTimeout), ibcTransferTimeout,
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	cmd := cli.RegisterXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddCoreumTxFlags(cmd)
+	cmd.PreRunE = cli.CoreumTxPreRun(mockBridgeClientProvider(bridgeClientMock))
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorContains(t, err, "flags are required")
+}
+
+func TestRegisterXRPLTokensFromFileCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	requests := []coreum.XRPLTokenRegistrationRequest{
+		{
+			Issuer:                   xrpl.GenPrivKeyTxSigner().Account().String(),
+			Currency:                 "CRN",
+			SendingPrecision:         12,
+			MaxHoldingAmount:         sdkmath.NewInt(10000),
+			BridgingFee:              sdkmath.NewInt(1),
+			MaxSendsPerAddressPerDay: lo.ToPtr(uint32(100)),
+		},
+		{
+			Issuer:           xrpl.GenPrivKeyTxSigner().Account().String(),
+			Currency:         "434F524500000000000000000000000000000000",
+			SendingPrecision: 6,
+			MaxHoldingAmount: sdkmath.NewInt(20000),
+			BridgingFee:      sdkmath.ZeroInt(),
+		},
+	}
+	requestsJSON, err := json.Marshal(requests)
+	require.NoError(t, err)
+	filePath := path.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(filePath, requestsJSON, 0o600))
+
+	args := append(initConfig(t),
+		filePath,
+		flagWithPrefix(cli.FlagBatchSize), "5",
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	for _, request := range requests {
+		issuer, err := rippledata.NewAccountFromAddress(request.Issuer)
+		require.NoError(t, err)
+		bridgeClientMock.EXPECT().CheckXRPLIssuerAccount(gomock.Any(), *issuer).Return(nil)
+	}
+	bridgeClientMock.EXPECT().RegisterXRPLTokenBatch(gomock.Any(), gomock.Any(), requests, 5).
+		Return([]coreum.XRPLToken{{}, {}}, nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RegisterXRPLTokensFromFileCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestImportTokensCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	registry := bridgeclient.TokenRegistry{
+		CoreumTokens: []coreum.CoreumToken{
+			{
+				Denom:            "ucore",
+				Decimals:         6,
+				XRPLCurrency:     "434F524500000000000000000000000000000000",
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(10000),
+				State:            coreum.TokenStateEnabled,
+				BridgingFee:      sdkmath.ZeroInt(),
+			},
+		},
+		XRPLTokens: []coreum.XRPLToken{
+			{
+				Issuer:           xrpl.GenPrivKeyTxSigner().Account().String(),
+				Currency:         "CRN",
+				SendingPrecision: 12,
+				MaxHoldingAmount: sdkmath.NewInt(10000),
+				State:            coreum.TokenStateDisabled,
+				BridgingFee:      sdkmath.NewInt(1),
+			},
+		},
+	}
+	registryJSON, err := json.Marshal(registry)
+	require.NoError(t, err)
+	filePath := path.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(filePath, registryJSON, 0o600))
+
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagInput), filePath,
+		flagWithPrefix(flags.FlagDryRun),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().ImportTokenRegistry(gomock.Any(), gomock.Any(), registry, true).
+		Return([]bridgeclient.TokenImportResult{
+			{Token: "ucore", Action: bridgeclient.TokenImportActionSkipped},
+			{Token: "CRN", Action: bridgeclient.TokenImportActionCreated},
+		}, nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.ImportTokensCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+
+	// the failed token import fails the command
+	bridgeClientMock.EXPECT().ImportTokenRegistry(gomock.Any(), gomock.Any(), registry, true).
+		Return([]bridgeclient.TokenImportResult{
+			{Token: "ucore", Action: bridgeclient.TokenImportActionFailed, Error: "failed"},
+		}, nil)
+	cmd := cli.ImportTokensCmd(mockBridgeClientProvider(bridgeClientMock))
+	cmd.PreRunE = cli.CoreumTxPreRun(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddCoreumTxFlags(cmd)
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorContains(t, err, "failed to import 1 tokens")
 }
 
 func TestRecoverXRPLTokenRegistrationCmd(t *testing.T) {
@@ -519,42 +872,152 @@ func TestRecoverXRPLTokenRegistrationCmd(t *testing.T) {
 	)
 }
 
-func TestUpdateXRPLTokenCmd(t *testing.T) {
+func TestRecoverTokenRegistrationCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	keyringDir := t.TempDir()
 	keyName := "owner"
-	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
-	issuer := "rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D"
-	currency := "434F524500000000000000000000000000000000"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
 
-	tests := []struct {
-		name string
-		args []string
-		mock func(m *MockBridgeClient)
-	}{
-		{
-			name: "no_additional_flags",
-			args: []string{
-				issuer,
-				currency,
-				flagWithPrefix(cli.FlagKeyName), keyName,
-			},
-			mock: func(m *MockBridgeClient) {
-				m.EXPECT().UpdateXRPLToken(
-					gomock.Any(),
-					gomock.Any(),
-					issuer,
-					currency,
-					nil,
-					nil,
-					nil,
-					nil,
-				)
-			},
-		},
-		{
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	currency, err := rippledata.NewCurrency("CRN")
+	require.NoError(t, err)
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagIssuer), issuer.String(),
+		flagWithPrefix(cli.FlagCurrency), currency.String(),
+		flagWithPrefix(cli.FlagOlderThan), "2h",
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().RecoverStuckXRPLTokenRegistration(
+		gomock.Any(),
+		owner,
+		issuer.String(),
+		currency.String(),
+		2*time.Hour,
+	).Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RecoverTokenRegistrationCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestMigrateXRPLTokenCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	oldIssuer := xrpl.GenPrivKeyTxSigner().Account()
+	oldCurrency, err := rippledata.NewCurrency("CRN")
+	require.NoError(t, err)
+	newIssuer := xrpl.GenPrivKeyTxSigner().Account()
+	newCurrency, err := rippledata.NewCurrency("434F524500000000000000000000000000000000")
+	require.NoError(t, err)
+	args := append(initConfig(t),
+		oldIssuer.String(),
+		oldCurrency.String(),
+		newIssuer.String(),
+		"434F524500000000000000000000000000000000",
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().MigrateXRPLToken(
+		gomock.Any(),
+		owner,
+		oldIssuer.String(),
+		xrpl.ConvertCurrencyToString(oldCurrency),
+		newIssuer.String(),
+		xrpl.ConvertCurrencyToString(newCurrency),
+	).Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.MigrateXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestRemoveMigratedXRPLTokenCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	currency, err := rippledata.NewCurrency("CRN")
+	require.NoError(t, err)
+	args := append(initConfig(t),
+		issuer.String(),
+		currency.String(),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().RemoveMigratedXRPLToken(
+		gomock.Any(),
+		owner,
+		issuer.String(),
+		xrpl.ConvertCurrencyToString(currency),
+	).Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RemoveMigratedXRPLTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestUpdateXRPLTokenCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	issuer := "rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D"
+	currency := "434F524500000000000000000000000000000000"
+
+	tests := []struct {
+		name string
+		args []string
+		mock func(m *MockBridgeClient)
+	}{
+		{
+			name: "no_additional_flags",
+			args: []string{
+				issuer,
+				currency,
+				flagWithPrefix(cli.FlagKeyName), keyName,
+			},
+			mock: func(m *MockBridgeClient) {
+				m.EXPECT().UpdateXRPLToken(
+					gomock.Any(),
+					gomock.Any(),
+					issuer,
+					currency,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+				)
+			},
+		},
+		{
 			name: "negative_sending_precision",
 			args: []string{
 				issuer,
@@ -574,6 +1037,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -597,6 +1062,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -620,6 +1087,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -643,6 +1112,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					nil,
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -669,6 +1140,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					}),
 					nil,
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -692,6 +1165,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 						return v.String() == "66"
 					}),
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -718,6 +1193,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 						return v.String() == "66"
 					}),
 					nil,
+					nil,
+					nil,
 				)
 			},
 		},
@@ -741,6 +1218,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					mock.MatchedBy(func(v *sdkmath.Int) bool {
 						return v.String() == "9999"
 					}),
+					nil,
+					nil,
 				)
 			},
 		},
@@ -767,6 +1246,8 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 					mock.MatchedBy(func(v *sdkmath.Int) bool {
 						return v.String() == "9999"
 					}),
+					nil,
+					nil,
 				)
 			},
 		},
@@ -788,78 +1269,313 @@ func TestUpdateXRPLTokenCmd(t *testing.T) {
 	}
 }
 
-func TestRotateKeysCmd(t *testing.T) {
+func TestDisableTokenCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	configPath := path.Join(t.TempDir(), "new-keys.yaml")
-
 	keyringDir := t.TempDir()
 	keyName := "owner"
-	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	issuer := "rcoreNywaoz2ZCQ8Lg2EbSLnGuRBmun6D"
+	currency := "434F524500000000000000000000000000000000"
 
-	homeArgs := initConfig(t)
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetTokenStateChangeReport(gomock.Any(), "", issuer, currency, int64(100)).Return(
+		bridgeclient.TokenStateChangeReport{
+			CoreumDenom:            "ucore",
+			XRPLIssuer:             issuer,
+			XRPLCurrency:           currency,
+			State:                  coreum.TokenStateEnabled,
+			CoreumSupply:           sdk.NewCoin("ucore", sdkmath.NewInt(1000)),
+			PendingOperationIDs:    []uint32{5},
+			RecentInboundTransfers: 1,
+			RecentInboundVolume:    sdk.NewCoin("ucore", sdkmath.NewInt(10)),
+		}, nil,
+	)
+	bridgeClientMock.EXPECT().UpdateXRPLToken(
+		gomock.Any(),
+		owner,
+		issuer,
+		currency,
+		lo.ToPtr(coreum.TokenStateDisabled),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
 
-	// call rotate-keys with init only
-	args := append([]string{
-		configPath,
-		flagWithPrefix(cli.FlagInitOnly),
+	args := append(initConfig(t),
+		issuer,
+		currency,
+		flagWithPrefix(cli.FlagRecentBlocks), "100",
 		flagWithPrefix(cli.FlagKeyName), keyName,
-	}, homeArgs...)
-	args = append(args, testKeyringFlags(keyringDir)...)
-	executeCoreumTxCmd(
-		t,
-		mockBridgeClientProvider(nil),
-		cli.RotateKeysCmd(mockBridgeClientProvider(nil)),
-		args...,
 	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	cmd := cli.DisableTokenCmd(mockBridgeClientProvider(bridgeClientMock))
+	cmd.SetIn(strings.NewReader("yes\n"))
+	executeCoreumTxCmd(t, mockBridgeClientProvider(bridgeClientMock), cmd, args...)
+}
+
+func TestEnableTokenCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	denom := "ucore"
 
-	// use generated file
 	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().RotateKeys(gomock.Any(), gomock.Any(), bridgeclient.DefaultKeysRotationConfig())
-	args = append([]string{
-		configPath,
+	bridgeClientMock.EXPECT().GetTokenStateChangeReport(gomock.Any(), denom, "", "", int64(10000)).Return(
+		bridgeclient.TokenStateChangeReport{
+			CoreumDenom:         denom,
+			State:               coreum.TokenStateDisabled,
+			CoreumSupply:        sdk.NewCoin(denom, sdkmath.NewInt(1000)),
+			RecentInboundVolume: sdk.NewCoin(denom, sdkmath.ZeroInt()),
+		}, nil,
+	)
+	bridgeClientMock.EXPECT().UpdateCoreumToken(
+		gomock.Any(),
+		owner,
+		denom,
+		lo.ToPtr(coreum.TokenStateEnabled),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	args := append(initConfig(t),
+		denom,
+		flagWithPrefix(flags.FlagSkipConfirmation),
 		flagWithPrefix(cli.FlagKeyName), keyName,
-	}, homeArgs...)
+	)
 	args = append(args, testKeyringFlags(keyringDir)...)
 	executeCoreumTxCmd(
 		t,
 		mockBridgeClientProvider(bridgeClientMock),
-		cli.RotateKeysCmd(mockBridgeClientProvider(bridgeClientMock)),
+		cli.EnableTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
 		args...,
 	)
 }
 
-func TestUpdateXRPLBaseFeeCmd(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestDisableTokenCmd_NotUpdated(t *testing.T) {
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	denom := "ucore"
+
+	tests := []struct {
+		name         string
+		currentState coreum.TokenState
+		input        string
+		checkErr     func(t *testing.T, err error)
+	}{
+		{
+			name:         "immutable_token_state",
+			currentState: coreum.TokenStateProcessing,
+			input:        "yes\n",
+			checkErr: func(t *testing.T, err error) {
+				require.True(t, coreum.IsTokenStateIsImmutableError(err), err)
+			},
+		},
+		{
+			name:         "already_disabled",
+			currentState: coreum.TokenStateDisabled,
+			input:        "yes\n",
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "the token state is already disabled")
+			},
+		},
+		{
+			name:         "not_confirmed",
+			currentState: coreum.TokenStateEnabled,
+			input:        "no\n",
+			checkErr: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "not confirmed")
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bridgeClientMock := NewMockBridgeClient(ctrl)
+			bridgeClientMock.EXPECT().GetTokenStateChangeReport(gomock.Any(), denom, "", "", gomock.Any()).Return(
+				bridgeclient.TokenStateChangeReport{
+					CoreumDenom:         denom,
+					State:               tt.currentState,
+					CoreumSupply:        sdk.NewCoin(denom, sdkmath.NewInt(1000)),
+					RecentInboundVolume: sdk.NewCoin(denom, sdkmath.ZeroInt()),
+				}, nil,
+			)
+
+			args := append(initConfig(t), denom, flagWithPrefix(cli.FlagKeyName), keyName)
+			args = append(args, testKeyringFlags(keyringDir)...)
+			bcp := mockBridgeClientProvider(bridgeClientMock)
+			cmd := cli.DisableTokenCmd(bcp)
+			cli.AddCoreumTxFlags(cmd)
+			cmd.PreRunE = cli.CoreumTxPreRun(bcp)
+			cmd.SetIn(strings.NewReader(tt.input))
+			_, err := executeCmdWithOutputOptionAndError(cmd, "text", args...)
+			tt.checkErr(t, err)
+		})
+	}
+}
 
+func TestEmergencyDisableTokenCmd(t *testing.T) {
 	keyringDir := t.TempDir()
 	keyName := "owner"
 	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	currency := "CRN"
+	reason := "compromised issuer"
+
+	ctrl := gomock.NewController(t)
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().EmergencyDisableToken(
+		gomock.Any(),
+		gomock.Any(),
+		bridgeclient.TokenRef{Issuer: issuer, Currency: currency},
+		reason,
+		time.Minute,
+	).Return(bridgeclient.EmergencyTokenReport{
+		XRPLIssuer:            issuer,
+		XRPLCurrency:          currency,
+		State:                 coreum.TokenStateDisabled,
+		TxHash:                "C0FFEE",
+		PendingOperationIDs:   []uint32{1, 2},
+		RemainingOperationIDs: []uint32{2},
+	}, context.DeadlineExceeded)
 
-	// call rotate-keys with init only
 	args := append(initConfig(t),
-		"17",
+		issuer, currency,
+		flagWithPrefix(cli.FlagReason), reason,
+		flagWithPrefix(cli.FlagWaitTimeout), "1m",
 		flagWithPrefix(cli.FlagKeyName), keyName,
 	)
 	args = append(args, testKeyringFlags(keyringDir)...)
+	bcp := mockBridgeClientProvider(bridgeClientMock)
+	cmd := cli.EmergencyDisableTokenCmd(bcp)
+	cli.AddCoreumTxFlags(cmd)
+	cmd.PreRunE = cli.CoreumTxPreRun(bcp)
+	_, err := executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the reason is mandatory
+	args = append(initConfig(t), issuer, currency, flagWithPrefix(cli.FlagKeyName), keyName)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	cmd = cli.EmergencyDisableTokenCmd(bcp)
+	cli.AddCoreumTxFlags(cmd)
+	cmd.PreRunE = cli.CoreumTxPreRun(bcp)
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorContains(t, err, "the --reason flag is required")
+}
+
+func TestEmergencyEnableTokenCmd(t *testing.T) {
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	denom := "ucore"
+	reason := "issuer is recovered"
+
+	ctrl := gomock.NewController(t)
 	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().UpdateXRPLBaseFee(gomock.Any(), gomock.Any(), uint32(17))
+	bridgeClientMock.EXPECT().EmergencyEnableToken(
+		gomock.Any(), gomock.Any(), bridgeclient.TokenRef{Denom: denom}, reason,
+	).Return(bridgeclient.EmergencyTokenReport{
+		CoreumDenom: denom,
+		State:       coreum.TokenStateEnabled,
+	}, nil)
+
+	args := append(initConfig(t),
+		denom,
+		flagWithPrefix(cli.FlagReason), reason,
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
 	executeCoreumTxCmd(
 		t,
 		mockBridgeClientProvider(bridgeClientMock),
-		cli.UpdateXRPLBaseFeeCmd(mockBridgeClientProvider(bridgeClientMock)),
+		cli.EmergencyEnableTokenCmd(mockBridgeClientProvider(bridgeClientMock)),
 		args...,
 	)
 }
 
-func TestSendFromCoreumToXRPLCmd(t *testing.T) {
+func TestRotateKeysCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	configPath := path.Join(t.TempDir(), "new-keys.yaml")
+
 	keyringDir := t.TempDir()
-	keyName := "sender"
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	homeArgs := initConfig(t)
+
+	// call rotate-keys with init only
+	args := append([]string{
+		configPath,
+		flagWithPrefix(cli.FlagInitOnly),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, homeArgs...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(nil),
+		cli.RotateKeysCmd(mockBridgeClientProvider(nil)),
+		args...,
+	)
+
+	// use generated file
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().RotateKeys(gomock.Any(), gomock.Any(), bridgeclient.DefaultKeysRotationConfig())
+	args = append([]string{
+		configPath,
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, homeArgs...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.RotateKeysCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestUpdateXRPLBaseFeeCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	// call rotate-keys with init only
+	args := append(initConfig(t),
+		"17",
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().UpdateXRPLBaseFee(gomock.Any(), gomock.Any(), uint32(17))
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.UpdateXRPLBaseFeeCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestSendFromCoreumToXRPLCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "sender"
 	addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
 
 	homeArgs := initConfig(t)
@@ -914,6 +1630,107 @@ func TestSendFromCoreumToXRPLCmd(t *testing.T) {
 		cli.SendFromCoreumToXRPLCmd(mockBridgeClientProvider(bridgeClientMock)),
 		args...,
 	)
+
+	// the X-address without the tag is converted to the classic address
+	args = append([]string{
+		amount.String(),
+		xrpl.EncodeXAddress(recipient, nil, false),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, homeArgs...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	bridgeClientMock = NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().SendFromCoreumToXRPL(
+		gomock.Any(),
+		gomock.Any(),
+		recipient,
+		amount,
+		nil,
+	)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.SendFromCoreumToXRPLCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+
+	// the X-address with the tag and the testnet X-address on the mainnet are rejected
+	for xAddress, expectedErr := range map[string]string{
+		xrpl.EncodeXAddress(recipient, lo.ToPtr(uint32(1)), false): "destination tags are not supported",
+		xrpl.EncodeXAddress(recipient, nil, true):                  "belongs to the XRPL test network",
+	} {
+		args = append([]string{
+			amount.String(),
+			xAddress,
+			flagWithPrefix(cli.FlagKeyName), keyName,
+		}, homeArgs...)
+		args = append(args, testKeyringFlags(keyringDir)...)
+
+		bridgeClientMock = NewMockBridgeClient(ctrl)
+		cmd := cli.SendFromCoreumToXRPLCmd(mockBridgeClientProvider(bridgeClientMock))
+		cli.AddCoreumTxFlags(cmd)
+		cmd.PreRunE = cli.CoreumTxPreRun(mockBridgeClientProvider(bridgeClientMock))
+		_, err := executeCmdWithOutputOptionAndError(cmd, "text", args...)
+		require.ErrorContains(t, err, expectedErr)
+	}
+}
+
+func TestSendBatchCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "sender"
+	senderAddress := addKeyToTestKeyring(
+		t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path(),
+	)
+
+	recipient1 := xrpl.GenPrivKeyTxSigner().Account()
+	recipient2 := xrpl.GenPrivKeyTxSigner().Account()
+	legs := []bridgeclient.SendLeg{
+		{
+			Recipient: recipient1,
+			Coin:      sdk.NewInt64Coin("ucore", 1000),
+		},
+		{
+			Recipient:     recipient2,
+			Coin:          sdk.NewInt64Coin("drop", 2000),
+			DeliverAmount: lo.ToPtr(sdkmath.NewInt(1900)),
+		},
+	}
+	filePath := path.Join(t.TempDir(), "batch.yaml")
+	require.NoError(t, os.WriteFile(filePath, []byte(fmt.Sprintf(`legs:
+  - recipient: %s
+    coin: 1000ucore
+  - recipient: %s
+    coin: 2000drop
+    deliver_amount: "1900"
+`, recipient1.String(), xrpl.EncodeXAddress(recipient2, nil, false))), 0o600))
+
+	args := append(initConfig(t),
+		filePath,
+		flagWithPrefix(cli.FlagWaitTimeout), "1m",
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	report := bridgeclient.BatchReport{
+		Sender: senderAddress,
+		TxHash: "C0FFEE",
+		Legs: []bridgeclient.BatchLegReport{
+			{Leg: legs[0], OperationID: 1, Status: bridgeclient.BatchLegStatusPending},
+			{Leg: legs[1], OperationID: 2, Status: bridgeclient.BatchLegStatusPending},
+		},
+	}
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().SendBatchToXRPL(gomock.Any(), senderAddress, legs).Return(report, nil)
+	bridgeClientMock.EXPECT().WaitForBatch(gomock.Any(), report, time.Minute).Return(report, nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.SendBatchCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
 }
 
 func TestClaimPendingRefundCmd_WithRefundID(t *testing.T) {
@@ -1030,6 +1847,52 @@ func TestClaimRelayerFees(t *testing.T) {
 	)
 }
 
+func TestSweepAllFeesCmd_WithRelayersFromContractConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	relayer1 := coreum.GenAccount()
+	relayer2 := coreum.GenAccount()
+	bridgeClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		Relayers: []coreum.Relayer{
+			{CoreumAddress: relayer1},
+			{CoreumAddress: relayer2},
+		},
+	}, nil)
+	relayer1Fees := sdk.NewCoins(sdk.NewCoin("ucore", sdkmath.NewInt(100)))
+	relayer2Fees := sdk.NewCoins(sdk.NewCoin("ucore", sdkmath.NewInt(50)), sdk.NewCoin("mycoin", sdkmath.NewInt(10)))
+	bridgeClientMock.EXPECT().GetFeesCollected(gomock.Any(), relayer1).Return(relayer1Fees, nil)
+	bridgeClientMock.EXPECT().GetFeesCollected(gomock.Any(), relayer2).Return(relayer2Fees, nil)
+	bridgeClientMock.EXPECT().
+		SweepRelayerFees(gomock.Any(), []sdk.AccAddress{relayer1, relayer2}).
+		Return(relayer1Fees.Add(relayer2Fees...), nil)
+
+	args := append(initConfig(t), flagWithPrefix(flags.FlagSkipConfirmation))
+	args = append(args, testKeyringFlags(t.TempDir())...)
+	executeCmd(t, cli.SweepAllFeesCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+}
+
+func TestSweepAllFeesCmd_NotConfirmed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	relayer := coreum.GenAccount()
+	bridgeClientMock.EXPECT().
+		GetFeesCollected(gomock.Any(), relayer).
+		Return(sdk.NewCoins(sdk.NewCoin("ucore", sdkmath.NewInt(100))), nil)
+
+	args := append([]string{relayer.String()}, initConfig(t)...)
+	args = append(args, testKeyringFlags(t.TempDir())...)
+	cmd := cli.SweepAllFeesCmd(mockBridgeClientProvider(bridgeClientMock))
+	cmd.SetIn(strings.NewReader("no\n"))
+	_, err := executeCmdWithOutputOptionAndError(cmd, "text", args...)
+	require.ErrorContains(t, err, "not confirmed")
+}
+
 func TestHaltBridgeCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1051,6 +1914,27 @@ func TestHaltBridgeCmd(t *testing.T) {
 	)
 }
 
+func TestClaimTreasuryFeesCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	args := append(initConfig(t), flagWithPrefix(cli.FlagKeyName), keyName)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	bridgeClientMock.EXPECT().ClaimTreasuryFees(gomock.Any(), owner).Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.ClaimTreasuryFeesCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
 func TestResumeBridgeCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1097,6 +1981,93 @@ func TestCancelPendingOperationCmd(t *testing.T) {
 	)
 }
 
+func TestForceCompleteOperationCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	ticketSequence := uint32(7)
+	txHash := "A4E1AC7A3A8AB9E6B4CA8C5EA9C2C6B09D2F0B2A0D1F0C1A84E4AA5E0F0B7C11"
+	args := append([]string{
+		flagWithPrefix(cli.FlagTicketSequence), strconv.Itoa(int(ticketSequence)),
+		flagWithPrefix(cli.FlagTxHash), txHash,
+		flagWithPrefix(cli.FlagResult), string(coreum.TransactionResultRejected),
+		flagWithPrefix(cli.FlagAdminOverride),
+		flagWithPrefix(flags.FlagSkipConfirmation),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, initConfig(t)...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	bridgeClientMock.EXPECT().
+		ForceCompleteOperation(gomock.Any(), owner, ticketSequence, txHash, coreum.TransactionResultRejected).
+		Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.ForceCompleteOperationCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
+func TestTransferOwnershipCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+	newOwner := coreum.GenAccount()
+
+	args := append([]string{
+		flagWithPrefix(cli.FlagNewOwner), newOwner.String(),
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, initConfig(t)...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	bridgeClientMock.EXPECT().GetContractOwnership(gomock.Any()).Return(coreum.ContractOwnership{
+		Owner: owner,
+	}, nil)
+	bridgeClientMock.EXPECT().GetCoreumAccountInfo(gomock.Any(), newOwner).Return(bridgeclient.CoreumAccountInfo{
+		Address:   newOwner,
+		PubKeySet: true,
+	}, nil)
+	bridgeClientMock.EXPECT().TransferOwnership(gomock.Any(), owner, newOwner).Return(nil)
+
+	cmd := cli.TransferOwnershipCmd(mockBridgeClientProvider(bridgeClientMock))
+	cmd.SetIn(strings.NewReader(newOwner.String() + "\n"))
+	executeCoreumTxCmd(t, mockBridgeClientProvider(bridgeClientMock), cmd, args...)
+}
+
+func TestAcceptOwnershipCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	keyringDir := t.TempDir()
+	keyName := "new-owner"
+	newOwner := addKeyToTestKeyring(
+		t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path(),
+	)
+
+	args := append([]string{
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	}, initConfig(t)...)
+	args = append(args, testKeyringFlags(keyringDir)...)
+	bridgeClientMock.EXPECT().AcceptOwnership(gomock.Any(), newOwner).Return(nil)
+	executeCoreumTxCmd(
+		t,
+		mockBridgeClientProvider(bridgeClientMock),
+		cli.AcceptOwnershipCmd(mockBridgeClientProvider(bridgeClientMock)),
+		args...,
+	)
+}
+
 func TestUpdateProhibitedXRPLAddressesCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1158,10 +2129,296 @@ func TestPendingOperationsCmd(t *testing.T) {
 	defer ctrl.Finish()
 
 	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{}, nil)
+	bridgeClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{
+		{
+			Version:        1,
+			TicketSequence: 1,
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{
+					Number: 3,
+				},
+			},
+			XRPLBaseFee: xrpl.DefaultXRPLBaseFee,
+		},
+	}, nil)
+	bridgeClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		EvidenceThreshold: 2,
+		Relayers:          make([]coreum.Relayer, 3),
+	}, nil)
 	executeQueryCmd(t, cli.PendingOperationsCmd(mockBridgeClientProvider(bridgeClientMock)), initConfig(t)...)
 }
 
+func TestPreviewOperationCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relayers := make([]coreum.Relayer, 0, 3)
+	for i := 0; i < 3; i++ {
+		relayers = append(relayers, coreum.Relayer{
+			CoreumAddress: coreum.GenAccount(),
+			XRPLAddress:   xrpl.GenPrivKeyTxSigner().Account().String(),
+		})
+	}
+	contractCfg := coreum.ContractConfig{
+		EvidenceThreshold: 2,
+		Relayers:          relayers,
+		BridgeXRPLAddress: xrpl.GenPrivKeyTxSigner().Account().String(),
+	}
+	operation := coreum.Operation{
+		Version:        1,
+		TicketSequence: 7,
+		Signatures: []coreum.Signature{{
+			RelayerCoreumAddress: relayers[1].CoreumAddress,
+			Signature:            "signature",
+		}},
+		OperationType: coreum.OperationType{
+			AllocateTickets: &coreum.OperationTypeAllocateTickets{
+				Number: 3,
+			},
+		},
+		XRPLBaseFee: xrpl.DefaultXRPLBaseFee,
+	}
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{operation}, nil)
+	bridgeClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractCfg, nil)
+	cmd := cli.PreviewOperationCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	out := executeCmd(t, cmd, append(initConfig(t), flagWithPrefix(cli.FlagOperationID), "7")...)
+
+	preview, err := processes.BuildOperationPreview(contractCfg, operation)
+	require.NoError(t, err)
+	require.Contains(t, out, "allocate_tickets")
+	require.Contains(t, out, "TicketCreate")
+	require.Regexp(t, `SIGNATURES\s+1/2`, out)
+	require.Regexp(t, relayers[1].CoreumAddress.String()+`\s+`+relayers[1].XRPLAddress+`\s+true`, out)
+	require.Contains(t, out, preview.TxBlob)
+
+	// the operation isn't pending
+	bridgeClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{operation}, nil)
+	cmd = cli.PreviewOperationCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	_, err = executeCmdWithOutputOptionAndError(
+		cmd, "text", append(initConfig(t), flagWithPrefix(cli.FlagOperationID), "8")...,
+	)
+	require.ErrorContains(t, err, "pending operation 8 not found")
+}
+
+func TestSignatureProgressCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	relayers := make([]coreum.Relayer, 0, 3)
+	for i := 0; i < 3; i++ {
+		relayers = append(relayers, coreum.Relayer{
+			CoreumAddress: coreum.GenAccount(),
+			XRPLAddress:   xrpl.GenPrivKeyTxSigner().Account().String(),
+		})
+	}
+	operation := coreum.Operation{
+		Version:        1,
+		TicketSequence: 7,
+		Signatures: []coreum.Signature{{
+			RelayerCoreumAddress: relayers[1].CoreumAddress,
+			Signature:            "signature",
+		}},
+		OperationType: coreum.OperationType{
+			AllocateTickets: &coreum.OperationTypeAllocateTickets{
+				Number: 3,
+			},
+		},
+	}
+	progress := coreum.NewSignatureProgress(coreum.ContractConfig{
+		EvidenceThreshold: 2,
+		Relayers:          relayers,
+	}, operation)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetSignatureProgress(gomock.Any(), uint32(7)).Return(progress, nil)
+	cmd := cli.SignatureProgressCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	out := executeCmd(t, cmd, append(initConfig(t), flagWithPrefix(cli.FlagTicketSequence), "7")...)
+
+	require.Contains(t, out, "allocate_tickets")
+	require.Regexp(t, `SIGNATURES\s+1/2 \(50%\)`, out)
+	require.Regexp(t, relayers[1].CoreumAddress.String()+`\s+`+relayers[1].XRPLAddress+`\s+true`, out)
+	require.Regexp(t, relayers[0].CoreumAddress.String()+`\s+`+relayers[0].XRPLAddress+`\s+false`, out)
+	require.Regexp(t, relayers[2].CoreumAddress.String()+`\s+`+relayers[2].XRPLAddress+`\s+false`, out)
+
+	// the ticket sequence is required
+	cmd = cli.SignatureProgressCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	_, err := executeCmdWithOutputOptionAndError(cmd, "text", initConfig(t)...)
+	require.ErrorContains(t, err, "the --ticket-sequence flag is required")
+}
+
+func TestStuckTokenRegistrationsCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().ListStuckTokenRegistrations(gomock.Any(), 30*time.Minute).Return(
+		[]bridgeclient.StuckTokenRegistration{
+			{
+				Issuer:       xrpl.GenPrivKeyTxSigner().Account().String(),
+				Currency:     "CRN",
+				CoreumDenom:  "crn-devcore1",
+				OperationID:  lo.ToPtr(uint32(5)),
+				OperationAge: time.Hour,
+			},
+		}, nil,
+	)
+	executeQueryCmd(
+		t,
+		cli.StuckTokenRegistrationsCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(initConfig(t), flagWithPrefix(cli.FlagOlderThan), "30m")...,
+	)
+}
+
+func TestTicketUsageCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	args := initConfig(t)
+	tracker, err := processes.NewTicketUsageTracker(
+		path.Join(args[1], processes.TicketUsageFileName), metrics.NewRegistry(), time.Now,
+	)
+	require.NoError(t, err)
+	require.NoError(t, tracker.Record(context.Background(), processes.TicketUsageRecord{
+		TicketSequence: 5,
+		OperationType:  coreum.OperationTypeEnumCoreumToXRPLTransfer,
+		Result:         coreum.TransactionResultAccepted,
+		TxHash:         "HASH",
+	}))
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetAvailableTickets(gomock.Any()).Return([]uint32{6, 7, 8}, nil).Times(2)
+	cmd := cli.TicketUsageCmd(mockBridgeClientProvider(bridgeClientMock))
+	executeQueryCmd(t, cmd, append(args, flagWithPrefix(cli.FlagWindow), "24h")...)
+
+	cmd = cli.TicketUsageCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	_, err = executeCmdWithOutputOptionAndError(cmd, "text", append(args, flagWithPrefix(cli.FlagWindow), "0s")...)
+	require.ErrorContains(t, err, "window must be positive")
+}
+
+func TestGenerateStateDiagramCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	xrplTokenIssuer := xrpl.GenPrivKeyTxSigner().Account().String()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		Relayers:          make([]coreum.Relayer, 3),
+		EvidenceThreshold: 2,
+		BridgeXRPLAddress: bridgeXRPLAddress,
+		BridgeState:       coreum.BridgeStateActive,
+	}, nil)
+	bridgeClientMock.EXPECT().GetAllTokens(gomock.Any()).Return(
+		[]coreum.CoreumToken{
+			{Denom: "ucore", XRPLCurrency: "ucore", State: coreum.TokenStateEnabled},
+			// the denom with the quote checks the escaping
+			{Denom: `my"denom`, XRPLCurrency: "5B2B", State: coreum.TokenStateDisabled},
+		},
+		[]coreum.XRPLToken{
+			{Issuer: xrplTokenIssuer, Currency: "USD", CoreumDenom: "usd-denom", State: coreum.TokenStateProcessing},
+			{Issuer: xrplTokenIssuer, Currency: "EUR", CoreumDenom: "eur-denom", State: coreum.TokenStateInactive},
+		},
+		nil,
+	)
+	bridgeClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{
+		{
+			TicketSequence: 12,
+			Signatures:     make([]coreum.Signature, 1),
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{
+					Issuer:   bridgeXRPLAddress,
+					Currency: "ucore",
+				},
+			},
+		},
+		{
+			TicketSequence: 11,
+			OperationType: coreum.OperationType{
+				TrustSet: &coreum.OperationTypeTrustSet{
+					Issuer:   xrplTokenIssuer,
+					Currency: "USD",
+				},
+			},
+		},
+		{
+			AccountSequence: 3,
+			Signatures:      make([]coreum.Signature, 2),
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{
+					Number: 10,
+				},
+			},
+		},
+	}, nil)
+
+	outputPath := path.Join(t.TempDir(), "bridge-state.dot")
+	args := append(initConfig(t), flagWithPrefix(cli.FlagOutput), outputPath)
+	executeCmd(t, cli.GenerateStateDiagramCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+
+	diagramBytes, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	diagram := string(diagramBytes)
+	requireValidDOTDigraph(t, diagram)
+
+	expectedStatements := []string{
+		fmt.Sprintf(`"bridge" [shape=box, fillcolor=green, label="Bridge\n%s\nstate: active\nrelayers: 3, threshold: 2"];`,
+			bridgeXRPLAddress),
+		`"coreum:ucore" [shape=ellipse, fillcolor=green, label="ucore\nxrpl currency: ucore\nstate: enabled"];`,
+		`"coreum:my\"denom" [shape=ellipse, fillcolor=red, label="my\"denom\nxrpl currency: 5B2B\nstate: disabled"];`,
+		fmt.Sprintf(`"xrpl:%s/USD" [shape=ellipse, fillcolor=yellow,`, xrplTokenIssuer),
+		fmt.Sprintf(`"xrpl:%s/EUR" [shape=ellipse, fillcolor=red,`, xrplTokenIssuer),
+		`"bridge" -> "xrpl" [label="#3 allocate_tickets\nsignatures: 2/2"];`,
+		fmt.Sprintf(`"bridge" -> "xrpl:%s/USD" [label="#11 trust_set\nsignatures: 0/2"];`, xrplTokenIssuer),
+		`"bridge" -> "coreum:ucore" [label="#12 coreum_to_xrpl_transfer\nsignatures: 1/2"];`,
+	}
+	for _, statement := range expectedStatements {
+		require.Contains(t, diagram, statement)
+	}
+	// the edges are ordered by the operation ID
+	require.Less(t, strings.Index(diagram, "#3 "), strings.Index(diagram, "#11 "))
+	require.Less(t, strings.Index(diagram, "#11 "), strings.Index(diagram, "#12 "))
+}
+
+// requireValidDOTDigraph checks the DOT syntax of the diagram produced by the generate-state-diagram command.
+func requireValidDOTDigraph(t *testing.T, diagram string) {
+	t.Helper()
+
+	lines := strings.Split(strings.TrimSuffix(diagram, "\n"), "\n")
+	require.GreaterOrEqual(t, len(lines), 2)
+	require.Equal(t, "digraph bridge {", lines[0])
+	require.Equal(t, "}", lines[len(lines)-1])
+	for _, line := range lines[1 : len(lines)-1] {
+		require.True(t, strings.HasSuffix(line, ";"), "statement must end with the semicolon: %s", line)
+		// the quoted IDs and labels must be closed, and the brackets are allowed only outside of them
+		inQuotes := false
+		brackets := 0
+		for i := 0; i < len(line); i++ {
+			switch {
+			case inQuotes && line[i] == '\\':
+				i++
+			case line[i] == '"':
+				inQuotes = !inQuotes
+			case !inQuotes && line[i] == '[':
+				brackets++
+			case !inQuotes && line[i] == ']':
+				brackets--
+			case !inQuotes && (line[i] == '{' || line[i] == '}'):
+				require.Failf(t, "unexpected brace in statement", line)
+			}
+		}
+		require.False(t, inQuotes, "not closed quote in statement: %s", line)
+		require.Zero(t, brackets, "not closed bracket in statement: %s", line)
+	}
+}
+
 func TestRelayerFeesCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1201,12 +2458,294 @@ func TestCoreumBalancesCmd(t *testing.T) {
 }
 
 func TestRegisteredTokensCmd(t *testing.T) {
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account().String()
+	tokenPairs := []bridgeclient.TokenPair{
+		{
+			Type:             bridgeclient.TokenPairTypeXRPL,
+			XRPLIssuer:       xrpl.GenPrivKeyTxSigner().Account().String(),
+			XRPLCurrency:     "AAA",
+			CoreumDenom:      "xrpl-aaa",
+			SendingPrecision: 6,
+			MaxHoldingAmount: sdkmath.NewInt(1_000_000),
+			BridgingFee:      sdkmath.NewInt(10),
+			State:            coreum.TokenStateEnabled,
+		},
+		{
+			Type:             bridgeclient.TokenPairTypeXRPL,
+			XRPLIssuer:       xrpl.GenPrivKeyTxSigner().Account().String(),
+			XRPLCurrency:     "BBB",
+			CoreumDenom:      "xrpl-bbb",
+			SendingPrecision: 2,
+			MaxHoldingAmount: sdkmath.NewInt(2_000_000),
+			BridgingFee:      sdkmath.ZeroInt(),
+			State:            coreum.TokenStateDisabled,
+		},
+		{
+			Type:             bridgeclient.TokenPairTypeCoreum,
+			XRPLIssuer:       bridgeXRPLAddress,
+			XRPLCurrency:     "636F726575670000000000000000000000000000",
+			CoreumDenom:      "ucore",
+			SendingPrecision: 6,
+			MaxHoldingAmount: sdkmath.NewInt(3_000_000),
+			BridgingFee:      sdkmath.NewInt(1),
+			State:            coreum.TokenStateEnabled,
+		},
+		{
+			Type:             bridgeclient.TokenPairTypeCoreum,
+			XRPLIssuer:       bridgeXRPLAddress,
+			XRPLCurrency:     "6466740000000000000000000000000000000000",
+			CoreumDenom:      "udft",
+			SendingPrecision: 4,
+			MaxHoldingAmount: sdkmath.NewInt(4_000_000),
+			BridgingFee:      sdkmath.ZeroInt(),
+			State:            coreum.TokenStateEnabled,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		args           []string
+		wantTokenPairs []bridgeclient.TokenPair
+	}{
+		{
+			name:           "all",
+			wantTokenPairs: tokenPairs,
+		},
+		{
+			name:           "enabled",
+			args:           []string{flagWithPrefix(cli.FlagState), string(coreum.TokenStateEnabled)},
+			wantTokenPairs: []bridgeclient.TokenPair{tokenPairs[0], tokenPairs[2], tokenPairs[3]},
+		},
+		{
+			name:           "inactive",
+			args:           []string{flagWithPrefix(cli.FlagState), string(coreum.TokenStateInactive)},
+			wantTokenPairs: []bridgeclient.TokenPair{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bridgeClientMock := NewMockBridgeClient(ctrl)
+			bridgeClientMock.EXPECT().GetTokenPairs(gomock.Any()).Return(tokenPairs, nil).Times(2)
+
+			// table output
+			cmd := cli.RegisteredTokensCmd(mockBridgeClientProvider(bridgeClientMock))
+			cli.AddHomeFlag(cmd)
+			out := executeCmd(t, cmd, append(initConfig(t), tt.args...)...)
+			rows := strings.Split(strings.TrimSpace(out), "\n")
+			require.Len(t, rows, len(tt.wantTokenPairs)+1)
+			require.Equal(t, []string{
+				"TYPE", "XRPL", "ISSUER", "XRPL", "CURRENCY", "COREUM", "DENOM", "SENDING", "PRECISION", "MAX",
+				"HOLDING", "AMOUNT", "BRIDGING", "FEE", "STATE",
+			}, strings.Fields(rows[0]))
+			for i, tokenPair := range tt.wantTokenPairs {
+				require.Equal(t, []string{
+					string(tokenPair.Type),
+					tokenPair.XRPLIssuer,
+					tokenPair.XRPLCurrency,
+					tokenPair.CoreumDenom,
+					strconv.Itoa(int(tokenPair.SendingPrecision)),
+					tokenPair.MaxHoldingAmount.String(),
+					tokenPair.BridgingFee.String(),
+					string(tokenPair.State),
+				}, strings.Fields(rows[i+1]))
+			}
+
+			// json output
+			cmd = cli.RegisteredTokensCmd(mockBridgeClientProvider(bridgeClientMock))
+			cli.AddHomeFlag(cmd)
+			out = executeCmd(t, cmd, append(initConfig(t), append(tt.args, flagWithPrefix(cli.FlagOutput), "json")...)...)
+			var gotTokenPairs []bridgeclient.TokenPair
+			require.NoError(t, json.Unmarshal([]byte(out), &gotTokenPairs))
+			require.Equal(t, tt.wantTokenPairs, gotTokenPairs)
+		})
+	}
+}
+
+func TestRegisteredTokensCmd_InvalidFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	cmd := cli.RegisteredTokensCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	_, err := executeCmdWithOutputOptionAndError(
+		cmd, "text", append(initConfig(t), flagWithPrefix(cli.FlagState), "unknown")...,
+	)
+	require.ErrorContains(t, err, `invalid token state "unknown"`)
+
+	cmd = cli.RegisteredTokensCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	_, err = executeCmdWithOutputOptionAndError(
+		cmd, "text", append(initConfig(t), flagWithPrefix(cli.FlagOutput), "yaml")...,
+	)
+	require.ErrorContains(t, err, `invalid output format "yaml"`)
+}
+
+func TestExportTokensCmd(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	registry := bridgeclient.TokenRegistry{
+		CoreumTokens: []coreum.CoreumToken{
+			{
+				Denom:            "ucore",
+				Decimals:         6,
+				SendingPrecision: 6,
+				MaxHoldingAmount: sdkmath.NewInt(10000),
+				State:            coreum.TokenStateEnabled,
+				BridgingFee:      sdkmath.ZeroInt(),
+			},
+		},
+		XRPLTokens: []coreum.XRPLToken{},
+	}
+	filePath := path.Join(t.TempDir(), "tokens.json")
+
 	bridgeClientMock := NewMockBridgeClient(ctrl)
-	bridgeClientMock.EXPECT().GetAllTokens(gomock.Any()).Return([]coreum.CoreumToken{}, []coreum.XRPLToken{}, nil)
-	executeQueryCmd(t, cli.RegisteredTokensCmd(mockBridgeClientProvider(bridgeClientMock)), initConfig(t)...)
+	bridgeClientMock.EXPECT().ExportTokenRegistry(gomock.Any()).Return(registry, nil)
+	executeQueryCmd(
+		t,
+		cli.ExportTokensCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(initConfig(t), flagWithPrefix(cli.FlagOutput), filePath)...,
+	)
+
+	registryJSON, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	var exportedRegistry bridgeclient.TokenRegistry
+	require.NoError(t, json.Unmarshal(registryJSON, &exportedRegistry))
+	require.Equal(t, registry, exportedRegistry)
+}
+
+func TestExportSnapshotCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	refundAddress := coreum.GenAccount()
+	snapshot := bridgeclient.BridgeSnapshot{
+		Version:         bridgeclient.BridgeSnapshotVersion,
+		ContractAddress: coreum.GenAccount().String(),
+		Config: coreum.ContractConfig{
+			TrustSetLimitAmount: sdkmath.NewInt(1000),
+		},
+		Ownership: coreum.ContractOwnership{
+			Owner:        coreum.GenAccount(),
+			PendingOwner: coreum.GenAccount(),
+		},
+		AvailableTickets: []uint32{1, 2},
+		PendingRefunds: []bridgeclient.AddressPendingRefunds{
+			{
+				Address: refundAddress,
+				Refunds: []coreum.PendingRefund{
+					{
+						ID:   "refund-id",
+						Coin: sdk.NewInt64Coin("ucore", 10),
+					},
+				},
+			},
+		},
+	}
+	filePath := path.Join(t.TempDir(), "snapshot.json")
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagOutput), filePath,
+		flagWithPrefix(cli.FlagRefundAddress), sdk.MustBech32ifyAddressBytes(constant.AddressPrefixMain, refundAddress),
+	)
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().ExportSnapshot(gomock.Any(), []sdk.AccAddress{refundAddress}).Return(snapshot, nil)
+	executeCmd(t, cli.ExportSnapshotCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+
+	snapshotJSON, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	var exportedSnapshot bridgeclient.BridgeSnapshot
+	require.NoError(t, json.Unmarshal(snapshotJSON, &exportedSnapshot))
+	require.Equal(t, snapshot, exportedSnapshot)
+}
+
+func TestReplayCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	reportFilePath := path.Join(dir, "report.jsonl")
+	checkpointFilePath := path.Join(dir, "checkpoint.json")
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagFromHeight), "10",
+		flagWithPrefix(cli.FlagToHeight), "20",
+		flagWithPrefix(cli.FlagXRPLToLedger), "300",
+		flagWithPrefix(cli.FlagOutput), reportFilePath,
+		flagWithPrefix(cli.FlagCheckpointFile), checkpointFilePath,
+	)
+
+	wantCfg := bridgeclient.DefaultReplayConfig(10, 20, reportFilePath)
+	wantCfg.XRPLToLedger = 300
+	wantCfg.CheckpointFilePath = checkpointFilePath
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().Replay(gomock.Any(), wantCfg).Return(bridgeclient.ReplaySummary{
+		MatchedXRPLToCoreum: 1,
+		Reconciled:          true,
+	}, nil)
+	executeCmd(t, cli.ReplayCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+
+	// the not reconciled history fails the command
+	bridgeClientMock.EXPECT().Replay(gomock.Any(), wantCfg).Return(bridgeclient.ReplaySummary{
+		UnmatchedXRPLDeposits: 1,
+	}, nil)
+	_, err := executeCmdWithOutputOptionAndError(
+		cli.ReplayCmd(mockBridgeClientProvider(bridgeClientMock)), "text", args...,
+	)
+	require.ErrorContains(t, err, "bridge history isn't reconciled")
+}
+
+func TestImportSnapshotCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyringDir := t.TempDir()
+	keyName := "owner"
+	owner := addKeyToTestKeyring(t, keyringDir, keyName, cli.CoreumKeyringSuffix, sdk.GetConfig().GetFullBIP44Path())
+
+	snapshot := bridgeclient.BridgeSnapshot{
+		Version:         bridgeclient.BridgeSnapshotVersion,
+		ContractAddress: coreum.GenAccount().String(),
+		Config: coreum.ContractConfig{
+			TrustSetLimitAmount: sdkmath.NewInt(1000),
+		},
+		AvailableTickets: []uint32{1, 2},
+	}
+	snapshotJSON, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	filePath := path.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(filePath, snapshotJSON, 0o600))
+	// the empty ownership addresses are decoded to the empty slices
+	require.NoError(t, json.Unmarshal(snapshotJSON, &snapshot))
+
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagInput), filePath,
+		flagWithPrefix(cli.FlagKeyName), keyName,
+	)
+	args = append(args, testKeyringFlags(keyringDir)...)
+
+	// the conflicts fail the command
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().ImportSnapshot(gomock.Any(), owner, snapshot, false).
+		Return(bridgeclient.SnapshotImportResult{
+			Conflicts: []string{"2 available tickets can't be replayed"},
+		}, nil)
+	_, err = executeCmdWithOutputOptionAndError(
+		cli.ImportSnapshotCmd(mockBridgeClientProvider(bridgeClientMock)), "text", args...,
+	)
+	require.ErrorContains(t, err, "found 1 snapshot conflicts")
+
+	bridgeClientMock.EXPECT().ImportSnapshot(gomock.Any(), owner, snapshot, true).
+		Return(bridgeclient.SnapshotImportResult{
+			Conflicts: []string{"2 available tickets can't be replayed"},
+			Applied:   true,
+		}, nil)
+	executeCmd(
+		t,
+		cli.ImportSnapshotCmd(mockBridgeClientProvider(bridgeClientMock)),
+		append(args, flagWithPrefix(cli.FlagForce))...,
+	)
 }
 
 func TestContractConfigCmd(t *testing.T) {
@@ -1271,8 +2810,96 @@ func TestTraceCoreumToXRPLTransfer(t *testing.T) {
 	executeQueryCmd(t, cli.TraceCoreumToXRPLTransfer(mockBridgeClientProvider(bridgeClientMock)), args...)
 }
 
+func TestTransferHistoryCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	recipient := xrpl.GenPrivKeyTxSigner().Account().String()
+	args := append(initConfig(t),
+		flagWithPrefix(cli.FlagStartHeight), "10",
+		flagWithPrefix(cli.FlagEndHeight), "20",
+		flagWithPrefix(cli.FlagDenom), "ucore",
+		flagWithPrefix(cli.FlagDirection), string(bridgeclient.TransferDirectionCoreumToXRPL),
+		flagWithPrefix(cli.FlagRecipient), recipient,
+	)
+
+	bridgeClientMock.EXPECT().GetTransferHistory(gomock.Any(), bridgeclient.HistoryFilter{
+		StartHeight:      10,
+		EndHeight:        20,
+		TokenDenom:       "ucore",
+		Direction:        bridgeclient.TransferDirectionCoreumToXRPL,
+		RecipientAddress: recipient,
+	}).Return([]bridgeclient.TransferEvent{}, nil)
+	executeQueryCmd(t, cli.TransferHistoryCmd(mockBridgeClientProvider(bridgeClientMock)), args...)
+}
+
 func executeCoreumTxCmd(t *testing.T, bcp cli.BridgeClientProvider, cmd *cobra.Command, args ...string) {
 	cli.AddCoreumTxFlags(cmd)
 	cmd.PreRunE = cli.CoreumTxPreRun(bcp)
 	executeCmd(t, cmd, args...)
 }
+
+func TestRateLimitsCmd(t *testing.T) {
+	metricsRegistry := metrics.NewRegistry()
+	promRegistry := prometheus.NewRegistry()
+	require.NoError(t, metricsRegistry.Register(promRegistry))
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	metricsRegistry.SetCoreumToXRPLTransferRateLimitConsumption("XRP", "rrrrrrrrrrrrrrrrrrrrrhoLvTp", 500, 1000)
+	metricsRegistry.SetCoreumToXRPLTransferRateLimitConsumption("TKN", issuer, 0, 10)
+	metricsRegistry.IncrementCoreumToXRPLTransferRateLimitedOperationsCounter("XRP", "rrrrrrrrrrrrrrrrrrrrrhoLvTp")
+	server := httptest.NewServer(promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{}))
+	defer server.Close()
+
+	cfg := runner.DefaultConfig()
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.Server.ListenAddress = strings.TrimPrefix(server.URL, "http://")
+	cfg.Processes.CoreumToXRPLProcess.RateLimits = []runner.TransferRateLimitConfig{
+		{
+			Issuer:           "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+			Currency:         "XRP",
+			MaxAmountPerHour: "1000",
+		},
+		{
+			Issuer:           issuer,
+			Currency:         "TKN",
+			MaxAmountPerHour: "10",
+		},
+	}
+	homePath := path.Join(t.TempDir(), "config-path")
+	require.NoError(t, runner.InitConfig(homePath, cfg))
+
+	executeQueryCmd(t, cli.RateLimitsCmd(), flagWithPrefix(cli.FlagHome), homePath)
+}
+
+func TestBlockedDeliveriesCmds(t *testing.T) {
+	args := initConfig(t)
+	storeFilePath := path.Join(args[1], processes.BlockedDeliveriesStoreFileName)
+	txHash := "A4E1AC7A3A8AB9E6B4CA8C5EA9C2C6B09D2F0B2A0D1F0C1A84E4AA5E0F0B7C11"
+	require.NoError(t, os.WriteFile(storeFilePath, []byte(fmt.Sprintf(`deliveries:
+    - tx_hash: %s
+      issuer: %s
+      currency: TKN
+      amount: "100"
+      recipient: %s
+      denom: ucore
+      reason: token is globally frozen
+      attempts: 1
+`, txHash, xrpl.GenPrivKeyTxSigner().Account().String(), coreum.GenAccount().String())), 0o600))
+
+	executeCmd(t, cli.BlockedDeliveriesCmd(), args...)
+
+	_, err := executeCmdWithOutputOptionAndError(
+		cli.RetryBlockedDeliveryCmd(), "text", append(args, flagWithPrefix(cli.FlagTxHash), "unknown")...,
+	)
+	require.ErrorContains(t, err, "blocked delivery not found")
+
+	executeCmd(
+		t, cli.RetryBlockedDeliveryCmd(), append(args, flagWithPrefix(cli.FlagTxHash), strings.ToLower(txHash))...,
+	)
+	blockedDeliveries, err := processes.ReadBlockedDeliveries(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, blockedDeliveries, 1)
+	require.True(t, blockedDeliveries[0].RetryRequested)
+}
@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	toolshttp "github.com/CoreumFoundation/coreum-tools/pkg/http"
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// demoNetworkConfig is the config of the network the demo relayer is initialized for.
+type demoNetworkConfig struct {
+	CoreumGRPCURL   string
+	CoreumFaucetURL string
+	XRPLRPCURL      string
+	XRPLFaucetURL   string
+}
+
+// demoNetworkConfigs are the known public networks the demo relayer can be initialized for, the znet endpoints are set
+// with the flags.
+var demoNetworkConfigs = map[constant.ChainID]demoNetworkConfig{
+	constant.ChainIDDev: {
+		CoreumGRPCURL:   "full-node.devnet-1.coreum.dev:9090",
+		CoreumFaucetURL: "https://api.devnet-1.coreum.dev/api/faucet/v1/fund",
+		XRPLRPCURL:      "https://s.altnet.rippletest.net:51234/",
+		XRPLFaucetURL:   "https://faucet.altnet.rippletest.net/accounts",
+	},
+	constant.ChainIDTest: {
+		CoreumGRPCURL:   "full-node.testnet-1.coreum.dev:9090",
+		CoreumFaucetURL: "https://api.testnet-1.coreum.dev/api/faucet/v1/fund",
+		XRPLRPCURL:      "https://s.altnet.rippletest.net:51234/",
+		XRPLFaucetURL:   "https://faucet.altnet.rippletest.net/accounts",
+	},
+}
+
+type coreumFaucetRequest struct {
+	Address string `json:"address"`
+}
+
+type coreumFaucetResponse struct {
+	TxHash string `json:"txHash"`
+}
+
+type xrplFaucetRequest struct {
+	Destination string `json:"destination"`
+}
+
+type xrplFaucetResponse struct {
+	Amount          float64 `json:"amount"`
+	TransactionHash string  `json:"transactionHash"`
+}
+
+// getDemoNetworkConfig returns the demo network config of the chain ID with the endpoints overridden by the set flags.
+func getDemoNetworkConfig(cmd *cobra.Command, chainID string) (demoNetworkConfig, error) {
+	if constant.ChainID(chainID) == constant.ChainIDMain {
+		return demoNetworkConfig{}, errors.Errorf("the demo mode can't be used with the mainnet chain ID %s", chainID)
+	}
+	networkCfg, ok := demoNetworkConfigs[constant.ChainID(chainID)]
+	if !ok {
+		return demoNetworkConfig{}, errors.Errorf(
+			"the demo mode can't be used with the chain ID %s, expected %s or %s",
+			chainID, constant.ChainIDDev, constant.ChainIDTest,
+		)
+	}
+
+	for flagName, value := range map[string]*string{
+		FlagCoreumGRPCURL:   &networkCfg.CoreumGRPCURL,
+		FlagCoreumFaucetURL: &networkCfg.CoreumFaucetURL,
+		FlagXRPLRPCURL:      &networkCfg.XRPLRPCURL,
+		FlagXRPLFaucetURL:   &networkCfg.XRPLFaucetURL,
+	} {
+		if !cmd.Flags().Changed(flagName) {
+			continue
+		}
+		flagValue, err := cmd.Flags().GetString(flagName)
+		if err != nil {
+			return demoNetworkConfig{}, errors.Wrapf(err, "failed to read %s", flagName)
+		}
+		*value = flagValue
+	}
+
+	return networkCfg, nil
+}
+
+// initDemoConfig writes the config if it doesn't exist yet.
+func initDemoConfig(ctx context.Context, log logger.Logger, home string, cfg runner.Config) error {
+	configFilePath := filepath.Join(home, runner.ConfigFileName)
+	if _, err := os.Stat(configFilePath); err == nil {
+		log.Info(ctx, "Config already exists, skipping generation", zap.String("path", configFilePath))
+		return nil
+	} else if !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to check config file %s", configFilePath)
+	}
+
+	return runner.InitConfig(home, cfg)
+}
+
+// initDemoKeysAndFunds creates the relayer keys missing in the keyrings and requests the funds for them from the
+// faucets. The funds are requested on each run, so the failed funding is fixed by the re-run.
+func initDemoKeysAndFunds(cmd *cobra.Command, log logger.Logger, networkCfg demoNetworkConfig) error {
+	ctx := cmd.Context()
+	components, err := NewComponents(cmd, log)
+	if err != nil {
+		return err
+	}
+
+	coreumKeyRecord, err := getOrCreateDemoKey(
+		ctx,
+		log,
+		components.CoreumSDKClientCtx.Keyring,
+		components.RunnerConfig.Coreum.RelayerKeyName,
+		hd.CreateHDPath(constant.CoinType, 0, 0).String(),
+	)
+	if err != nil {
+		return err
+	}
+	coreumAddress, err := coreumKeyRecord.GetAddress()
+	if err != nil {
+		return errors.Wrap(err, "failed to get coreum relayer address")
+	}
+
+	xrplKeyName := components.RunnerConfig.XRPL.MultiSignerKeyName
+	if _, err := getOrCreateDemoKey(
+		ctx, log, components.XRPLSDKClietCtx.Keyring, xrplKeyName, xrpl.XRPLHDPath,
+	); err != nil {
+		return err
+	}
+	xrplAddress, err := components.XRPLKeyringTxSigner.Account(xrplKeyName)
+	if err != nil {
+		return err
+	}
+
+	httpClient := toolshttp.NewRetryableClient(toolshttp.DefaultClientConfig())
+	if networkCfg.CoreumFaucetURL == "" {
+		log.Warn(ctx, "Coreum faucet URL is empty, skipping Coreum relayer account funding")
+	} else {
+		var res coreumFaucetResponse
+		if err := httpClient.DoJSON(
+			ctx,
+			http.MethodPost,
+			networkCfg.CoreumFaucetURL,
+			coreumFaucetRequest{Address: coreumAddress.String()},
+			func(resBytes []byte) error {
+				return errors.WithStack(json.Unmarshal(resBytes, &res))
+			},
+		); err != nil {
+			return errors.Wrapf(err, "failed to fund coreum relayer account %s", coreumAddress.String())
+		}
+		log.Info(ctx, "Coreum relayer account is funded", zap.String("txHash", res.TxHash))
+	}
+	if networkCfg.XRPLFaucetURL == "" {
+		log.Warn(ctx, "XRPL faucet URL is empty, skipping XRPL relayer account funding")
+	} else {
+		var res xrplFaucetResponse
+		if err := httpClient.DoJSON(
+			ctx,
+			http.MethodPost,
+			networkCfg.XRPLFaucetURL,
+			xrplFaucetRequest{Destination: xrplAddress.String()},
+			func(resBytes []byte) error {
+				return errors.WithStack(json.Unmarshal(resBytes, &res))
+			},
+		); err != nil {
+			return errors.Wrapf(err, "failed to fund XRPL relayer account %s", xrplAddress.String())
+		}
+		log.Info(
+			ctx,
+			"XRPL relayer account is funded",
+			zap.Float64("amount", res.Amount),
+			zap.String("txHash", res.TransactionHash),
+		)
+	}
+
+	log.Info(
+		ctx,
+		"Demo relayer is initialized",
+		zap.String("coreumAddress", coreumAddress.String()),
+		zap.String("xrplAddress", xrplAddress.String()),
+		zap.String("contractAddress", components.RunnerConfig.Coreum.Contract.ContractAddress),
+	)
+
+	return nil
+}
+
+func getOrCreateDemoKey(
+	ctx context.Context,
+	log logger.Logger,
+	kr keyring.Keyring,
+	keyName, hdPath string,
+) (*keyring.Record, error) {
+	keyRecord, err := kr.Key(keyName)
+	if err == nil {
+		log.Info(ctx, "Key already exists, skipping creation", zap.String("keyName", keyName))
+		return keyRecord, nil
+	}
+	if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, errors.Wrapf(err, "failed to get key %s", keyName)
+	}
+
+	keyRecord, _, err = kr.NewMnemonic(keyName, keyring.English, hdPath, "", hd.Secp256k1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create key %s", keyName)
+	}
+	log.Info(ctx, "Key is created", zap.String("keyName", keyName))
+
+	return keyRecord, nil
+}
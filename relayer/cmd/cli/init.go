@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	coreumchainconfig "github.com/CoreumFoundation/coreum/v4/pkg/config"
+	"github.com/CoreumFoundation/coreum/v4/pkg/config/constant"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+)
+
+// InitEnvPrefix is the prefix of the environment variables the values of the init command flags are read from.
+const InitEnvPrefix = "RELAYER_"
+
+// initConfigValue is the config value set by the init command.
+type initConfigValue struct {
+	Name         string
+	Value        string
+	DefaultValue string
+}
+
+// InitEnvVarName returns the name of the environment variable of the init command flag, e.g. RELAYER_COREUM_GRPC_URL
+// for the coreum-grpc-url flag.
+func InitEnvVarName(flagName string) string {
+	return InitEnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// applyInitEnvVars sets the values of the flags which are not set explicitly from the environment variables, so the
+// flag takes precedence over the environment variable, and the environment variable over the flag default.
+func applyInitEnvVars(cmd *cobra.Command) error {
+	var err error
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if err != nil || f.Changed {
+			return
+		}
+		envVarName := InitEnvVarName(f.Name)
+		value, ok := os.LookupEnv(envVarName)
+		if !ok {
+			return
+		}
+		if setErr := cmd.Flags().Set(f.Name, value); setErr != nil {
+			err = errors.Wrapf(setErr, "invalid value of the %s environment variable", envVarName)
+		}
+	})
+
+	return err
+}
+
+// validateInitConfig validates the config values set by the init command, so the invalid config is rejected before
+// it's written to the relayer home.
+func validateInitConfig(cfg runner.Config) error {
+	if cfg.Coreum.Network.ChainID == "" {
+		return errors.New("coreum chain ID must not be empty")
+	}
+	if err := validateGRPCURL(cfg.Coreum.GRPC.URL); err != nil {
+		return errors.Wrapf(err, "invalid coreum GRPC URL")
+	}
+	if err := validateHTTPURL(cfg.Coreum.RPC.URL, "http", "https", "tcp", "ws", "wss"); err != nil {
+		return errors.Wrapf(err, "invalid coreum RPC URL")
+	}
+	if err := validateHTTPURL(cfg.XRPL.RPC.URL, "http", "https"); err != nil {
+		return errors.Wrapf(err, "invalid XRPL RPC URL")
+	}
+	if err := validateContractAddress(cfg.Coreum.Network.ChainID, cfg.Coreum.Contract.ContractAddress); err != nil {
+		return errors.Wrapf(err, "invalid contract address")
+	}
+	if cfg.Coreum.RelayerKeyName == "" {
+		return errors.New("coreum relayer key name must not be empty")
+	}
+	if cfg.XRPL.MultiSignerKeyName == "" {
+		return errors.New("XRPL multi-signer key name must not be empty")
+	}
+	if cfg.XRPL.Scanner.RecentScanWindow <= 0 {
+		return errors.Errorf(
+			"XRPL scanner recent scan window must be positive, got %d", cfg.XRPL.Scanner.RecentScanWindow,
+		)
+	}
+	if cfg.Metrics.Enabled {
+		if _, _, err := net.SplitHostPort(cfg.Metrics.Server.ListenAddress); err != nil {
+			return errors.Wrapf(err, "invalid metrics listen address %q", cfg.Metrics.Server.ListenAddress)
+		}
+	}
+
+	return nil
+}
+
+// validateGRPCURL validates the GRPC URL, which is either host:port or the URL with the http or https scheme, the
+// empty URL is valid since it's optional in the config.
+func validateGRPCURL(grpcURL string) error {
+	if grpcURL == "" {
+		return nil
+	}
+	if strings.Contains(grpcURL, "://") {
+		return validateHTTPURL(grpcURL, "http", "https")
+	}
+	host, port, err := net.SplitHostPort(grpcURL)
+	if err != nil {
+		return errors.Wrapf(err, "expected host:port, got %q", grpcURL)
+	}
+	if host == "" {
+		return errors.Errorf("empty host in %q", grpcURL)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return errors.Errorf("invalid port in %q", grpcURL)
+	}
+
+	return nil
+}
+
+// validateHTTPURL validates the absolute URL with one of the schemes, the empty URL is valid since it's optional in the
+// config.
+func validateHTTPURL(rawURL string, schemes ...string) error {
+	if rawURL == "" {
+		return nil
+	}
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %q", rawURL)
+	}
+	scheme := strings.ToLower(parsedURL.Scheme)
+	for _, s := range schemes {
+		if scheme == s {
+			if parsedURL.Host == "" {
+				return errors.Errorf("empty host in %q", rawURL)
+			}
+			return nil
+		}
+	}
+
+	return errors.Errorf("unsupported scheme of %q, expected one of: %s", rawURL, strings.Join(schemes, ", "))
+}
+
+// validateContractAddress validates the bech32 contract address, the address prefix is checked if the chain ID is
+// known.
+func validateContractAddress(chainID, contractAddress string) error {
+	if contractAddress == "" {
+		return nil
+	}
+	prefix, addressBytes, err := bech32.DecodeAndConvert(contractAddress)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode bech32 address %q", contractAddress)
+	}
+	if err := sdk.VerifyAddressFormat(addressBytes); err != nil {
+		return errors.Wrapf(err, "invalid address %q", contractAddress)
+	}
+	networkConfig, err := coreumchainconfig.NetworkConfigByChainID(constant.ChainID(chainID))
+	if err != nil {
+		return nil //nolint:nilerr // the address prefix of the custom chain is unknown
+	}
+	if expectedPrefix := networkConfig.Provider.GetAddressPrefix(); prefix != expectedPrefix {
+		return errors.Errorf(
+			"address %q has prefix %s, expected %s for the chain ID %s", contractAddress, prefix, expectedPrefix, chainID,
+		)
+	}
+
+	return nil
+}
+
+// getInitConfigValues returns the config values set by the init command along with their defaults.
+func getInitConfigValues(cfg runner.Config) []initConfigValue {
+	defaultCfg := runner.DefaultConfig()
+	return []initConfigValue{
+		{"coreum.network.chain_id", cfg.Coreum.Network.ChainID, defaultCfg.Coreum.Network.ChainID},
+		{"coreum.grpc.url", cfg.Coreum.GRPC.URL, defaultCfg.Coreum.GRPC.URL},
+		{"coreum.rpc.url", cfg.Coreum.RPC.URL, defaultCfg.Coreum.RPC.URL},
+		{
+			"coreum.contract.contract_address",
+			cfg.Coreum.Contract.ContractAddress,
+			defaultCfg.Coreum.Contract.ContractAddress,
+		},
+		{"coreum.relayer_key_name", cfg.Coreum.RelayerKeyName, defaultCfg.Coreum.RelayerKeyName},
+		{"xrpl.rpc.url", cfg.XRPL.RPC.URL, defaultCfg.XRPL.RPC.URL},
+		{"xrpl.multi_signer_key_name", cfg.XRPL.MultiSignerKeyName, defaultCfg.XRPL.MultiSignerKeyName},
+		{
+			"xrpl.scanner.recent_scan_window",
+			strconv.FormatInt(cfg.XRPL.Scanner.RecentScanWindow, 10),
+			strconv.FormatInt(defaultCfg.XRPL.Scanner.RecentScanWindow, 10),
+		},
+		{"metrics.enabled", strconv.FormatBool(cfg.Metrics.Enabled), strconv.FormatBool(defaultCfg.Metrics.Enabled)},
+		{
+			"metrics.server.listen_address",
+			cfg.Metrics.Server.ListenAddress,
+			defaultCfg.Metrics.Server.ListenAddress,
+		},
+	}
+}
+
+// writeInitSummary writes the path of the generated config and the config values which differ from the defaults.
+func writeInitSummary(w io.Writer, configPath string, cfg runner.Config) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Config path: %s\n", configPath)
+	nonDefaultValues := make([]initConfigValue, 0)
+	for _, value := range getInitConfigValues(cfg) {
+		if value.Value != value.DefaultValue {
+			nonDefaultValues = append(nonDefaultValues, value)
+		}
+	}
+	if len(nonDefaultValues) == 0 {
+		sb.WriteString("All values are default.\n")
+	} else {
+		sb.WriteString("Non-default values:\n")
+		for _, value := range nonDefaultValues {
+			fmt.Fprintf(&sb, "  %s: %s (default: %q)\n", value.Name, value.Value, value.DefaultValue)
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+
+	return errors.WithStack(err)
+}
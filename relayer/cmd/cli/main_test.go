@@ -1,6 +1,7 @@
 package cli_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
@@ -9,4 +10,5 @@ import (
 
 func TestMain(m *testing.M) {
 	coreum.SetSDKConfig(string(runner.DefaultCoreumChainID))
+	os.Exit(m.Run())
 }
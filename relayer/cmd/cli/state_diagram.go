@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+)
+
+const (
+	stateDiagramBridgeNodeID = "bridge"
+	stateDiagramXRPLNodeID   = "xrpl"
+)
+
+// GenerateStateDiagramCmd writes the Graphviz DOT diagram of the current bridge state.
+func GenerateStateDiagramCmd(bcp BridgeClientProvider) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-state-diagram",
+		Short: "Write the Graphviz DOT diagram of the current bridge state.",
+		Long: strings.TrimSpace(fmt.Sprintf(
+			`Write the Graphviz DOT diagram of the current bridge state.
+The registered tokens are the nodes colored by the token state: green - enabled, yellow - processing,
+red - inactive or disabled. The pending operations are the edges labeled with the relayers signatures progress.
+The diagram is rendered with the graphviz, e.g. "dot -Tpng bridge-state.dot -o bridge-state.png".
+Example:
+$ generate-state-diagram --%s bridge-state.dot
+`, FlagOutput,
+		)),
+		Args: cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				outputPath, err := cmd.Flags().GetString(FlagOutput)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				contractCfg, err := bridgeClient.GetContractConfig(ctx)
+				if err != nil {
+					return err
+				}
+				coreumTokens, xrplTokens, err := bridgeClient.GetAllTokens(ctx)
+				if err != nil {
+					return err
+				}
+				pendingOperations, err := bridgeClient.GetPendingOperations(ctx)
+				if err != nil {
+					return err
+				}
+
+				diagram := buildStateDiagram(contractCfg, coreumTokens, xrplTokens, pendingOperations)
+				if err := os.WriteFile(outputPath, []byte(diagram), 0o600); err != nil {
+					return errors.Wrapf(err, "failed to write file, path:%s", outputPath)
+				}
+
+				components.Log.Info(
+					ctx,
+					"Bridge state diagram is written to the file.",
+					zap.String("path", outputPath),
+					zap.Int("tokens", len(coreumTokens)+len(xrplTokens)),
+					zap.Int("pendingOperations", len(pendingOperations)),
+				)
+
+				return nil
+			}),
+	}
+	cmd.PersistentFlags().String(FlagOutput, "bridge-state.dot", "State diagram output file path")
+	AddHomeFlag(cmd)
+
+	return cmd
+}
+
+func buildStateDiagram(
+	contractCfg coreum.ContractConfig,
+	coreumTokens []coreum.CoreumToken,
+	xrplTokens []coreum.XRPLToken,
+	pendingOperations []coreum.Operation,
+) string {
+	var sb strings.Builder
+	sb.WriteString("digraph bridge {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [style=filled, fontname=\"Helvetica\"];\n")
+	sb.WriteString("  edge [fontname=\"Helvetica\"];\n")
+
+	bridgeColor := "green"
+	if contractCfg.BridgeState != coreum.BridgeStateActive {
+		bridgeColor = "red"
+	}
+	writeStateDiagramNode(&sb, stateDiagramBridgeNodeID, "box", bridgeColor,
+		"Bridge",
+		contractCfg.BridgeXRPLAddress,
+		fmt.Sprintf("state: %s", contractCfg.BridgeState),
+		fmt.Sprintf("relayers: %d, threshold: %d", len(contractCfg.Relayers), contractCfg.EvidenceThreshold),
+	)
+	writeStateDiagramNode(&sb, stateDiagramXRPLNodeID, "box", "lightgrey", "XRPL")
+
+	for _, token := range coreumTokens {
+		writeStateDiagramNode(&sb, coreumTokenNodeID(token.Denom), "ellipse", tokenStateColor(token.State),
+			token.Denom,
+			fmt.Sprintf("xrpl currency: %s", token.XRPLCurrency),
+			fmt.Sprintf("state: %s", token.State),
+		)
+	}
+	for _, token := range xrplTokens {
+		writeStateDiagramNode(&sb, xrplTokenNodeID(token.Issuer, token.Currency), "ellipse", tokenStateColor(token.State),
+			fmt.Sprintf("%s/%s", token.Currency, token.Issuer),
+			fmt.Sprintf("coreum denom: %s", token.CoreumDenom),
+			fmt.Sprintf("state: %s", token.State),
+		)
+	}
+
+	// the operations are sorted to produce the same diagram for the same state
+	operations := make([]coreum.Operation, len(pendingOperations))
+	copy(operations, pendingOperations)
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].GetOperationID() < operations[j].GetOperationID()
+	})
+	coreumDenomsByXRPLCurrency := make(map[string]string, len(coreumTokens))
+	for _, token := range coreumTokens {
+		coreumDenomsByXRPLCurrency[token.XRPLCurrency] = token.Denom
+	}
+	for _, operation := range operations {
+		targetNodeID := stateDiagramXRPLNodeID
+		switch {
+		case operation.OperationType.TrustSet != nil:
+			targetNodeID = xrplTokenNodeID(
+				operation.OperationType.TrustSet.Issuer, operation.OperationType.TrustSet.Currency,
+			)
+		case operation.OperationType.CoreumToXRPLTransfer != nil:
+			transfer := operation.OperationType.CoreumToXRPLTransfer
+			targetNodeID = xrplTokenNodeID(transfer.Issuer, transfer.Currency)
+			// the coreum originated tokens are issued by the bridge account
+			if denom, ok := coreumDenomsByXRPLCurrency[transfer.Currency]; ok &&
+				transfer.Issuer == contractCfg.BridgeXRPLAddress {
+				targetNodeID = coreumTokenNodeID(denom)
+			}
+		}
+		fmt.Fprintf(&sb, "  %s -> %s [label=%s];\n",
+			quoteDOTID(stateDiagramBridgeNodeID),
+			quoteDOTID(targetNodeID),
+			buildDOTLabel(
+				fmt.Sprintf("#%d %s", operation.GetOperationID(), operation.GetOperationTypeName()),
+				fmt.Sprintf("signatures: %d/%d", len(operation.Signatures), contractCfg.EvidenceThreshold),
+			),
+		)
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func writeStateDiagramNode(sb *strings.Builder, id, shape, color string, labelLines ...string) {
+	fmt.Fprintf(sb, "  %s [shape=%s, fillcolor=%s, label=%s];\n",
+		quoteDOTID(id), shape, color, buildDOTLabel(labelLines...))
+}
+
+func tokenStateColor(state coreum.TokenState) string {
+	switch state {
+	case coreum.TokenStateEnabled:
+		return "green"
+	case coreum.TokenStateProcessing:
+		return "yellow"
+	case coreum.TokenStateInactive, coreum.TokenStateDisabled:
+		return "red"
+	default:
+		return "lightgrey"
+	}
+}
+
+func coreumTokenNodeID(denom string) string {
+	return fmt.Sprintf("coreum:%s", denom)
+}
+
+func xrplTokenNodeID(issuer, currency string) string {
+	return fmt.Sprintf("xrpl:%s/%s", issuer, currency)
+}
+
+// buildDOTLabel returns the quoted label with the lines separated by the DOT line break.
+func buildDOTLabel(lines ...string) string {
+	escapedLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		escapedLines = append(escapedLines, escapeDOTString(line))
+	}
+
+	return `"` + strings.Join(escapedLines, `\n`) + `"`
+}
+
+func quoteDOTID(id string) string {
+	return `"` + escapeDOTString(id) + `"`
+}
+
+func escapeDOTString(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -41,6 +46,8 @@ func XRPLCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 	}
 	xrplQueryCmd.AddCommand(XRPLBalancesCmd(bcp))
 	xrplQueryCmd.AddCommand(TraceXRPLToCoreumTransfer(bcp))
+	xrplQueryCmd.AddCommand(VerifyXRPLBridgeAccountCmd(bcp))
+	xrplQueryCmd.AddCommand(XRPLReservesCmd(bcp))
 	AddHomeFlag(xrplQueryCmd)
 
 	keyringXRPLCmd, err := KeyringCmd(XRPLKeyringSuffix, xrpl.CoinType,
@@ -52,6 +59,8 @@ func XRPLCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 	xrplCmd.AddCommand(xrplTxCmd)
 	xrplCmd.AddCommand(xrplQueryCmd)
 	xrplCmd.AddCommand(keyringXRPLCmd)
+	xrplCmd.AddCommand(GenerateXRPLSignerListCmd())
+	xrplCmd.AddCommand(VerifyXRPLKeypairCmd())
 
 	return xrplCmd, nil
 }
@@ -60,14 +69,17 @@ func XRPLCmd(bcp BridgeClientProvider) (*cobra.Command, error) {
 
 // SendFromXRPLToCoreumCmd sends tokens from the XRPL to Coreum.
 func SendFromXRPLToCoreumCmd(bcp BridgeClientProvider) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "send-from-xrpl-to-coreum [amount] [issuer] [currency] [recipient]",
 		Short: "Send tokens from the XRPL to Coreum.",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Send tokens from the XRPL to Coreum.
+With the --%s flag, if the bridge can't receive the token, the payment is routed through the token/XRP AMM pool,
+so the recipient receives XRP instead of the sent token. The sent and delivered amounts are printed.
 Example:
 $ send-from-xrpl-to-coreum 1000000 %s %s %s --%s sender
 `,
+				FlagAMMRouting,
 				xrpl.XRPTokenIssuer.String(),
 				xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
 				constant.AddressSampleTest,
@@ -79,9 +91,9 @@ $ send-from-xrpl-to-coreum 1000000 %s %s %s --%s sender
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				issuer, err := rippledata.NewAccountFromAddress(args[1])
+				issuer, err := parseXRPLAccount(components, args[1])
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[2])
+					return errors.Wrap(err, "failed to parse issuer")
 				}
 
 				currency, err := rippledata.NewCurrency(args[2])
@@ -110,20 +122,42 @@ $ send-from-xrpl-to-coreum 1000000 %s %s %s --%s sender
 					return errors.Wrapf(err, "failed to get flag %s", FlagKeyName)
 				}
 
-				_, err = bridgeClient.SendFromXRPLToCoreum(
-					ctx,
-					keyName,
-					rippledata.Amount{
-						Value:    value,
-						Currency: currency,
-						Issuer:   *issuer,
-					},
-					recipient,
+				amount := rippledata.Amount{
+					Value:    value,
+					Currency: currency,
+					Issuer:   issuer,
+				}
+
+				ammRouting, err := cmd.Flags().GetBool(FlagAMMRouting)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get flag %s", FlagAMMRouting)
+				}
+				if !ammRouting {
+					_, err = bridgeClient.SendFromXRPLToCoreum(ctx, keyName, amount, recipient)
+					return err
+				}
+
+				res, err := bridgeClient.SendFromXRPLToCoreumWithAMMRouting(ctx, keyName, amount, recipient)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(
+					cmd.OutOrStdout(),
+					"Tx hash: %s\nRouted through AMM pool: %t\nSent amount: %s\nDelivered amount: %s\n",
+					res.TxHash, res.Routed, res.SentAmount.String(), res.DeliveredAmount.String(),
 				)
 
-				return err
+				return errors.WithStack(err)
 			}),
 	}
+	cmd.Flags().Bool(
+		FlagAMMRouting,
+		false,
+		"Route the payment through the token/XRP AMM pool if the bridge can't receive the token, "+
+			"the recipient receives XRP instead of the sent token",
+	)
+
+	return cmd
 }
 
 // SetXRPLTrustSetCmd sends the XRPL TrustSet transaction.
@@ -142,9 +176,9 @@ $ set-trust-set 1e80 %s %s --%s sender
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				issuer, err := rippledata.NewAccountFromAddress(args[1])
+				issuer, err := parseXRPLAccount(components, args[1])
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert issuer string to rippledata.Account: %s", args[2])
+					return errors.Wrap(err, "failed to parse issuer")
 				}
 
 				currency, err := rippledata.NewCurrency(args[2])
@@ -174,7 +208,7 @@ $ set-trust-set 1e80 %s %s --%s sender
 					rippledata.Amount{
 						Value:    value,
 						Currency: currency,
-						Issuer:   *issuer,
+						Issuer:   issuer,
 					},
 				)
 			}),
@@ -193,11 +227,11 @@ func XRPLBalancesCmd(bcp BridgeClientProvider) *cobra.Command {
 			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
 				ctx := cmd.Context()
 
-				acc, err := rippledata.NewAccountFromAddress(args[0])
+				acc, err := parseXRPLAccount(components, args[0])
 				if err != nil {
-					return errors.Wrapf(err, "failed to convert address to rippledata.Address, address:%s", args[0])
+					return errors.Wrap(err, "failed to parse address")
 				}
-				balances, err := bridgeClient.GetXRPLBalances(ctx, *acc)
+				balances, err := bridgeClient.GetXRPLBalances(ctx, acc)
 				if err != nil {
 					return err
 				}
@@ -258,3 +292,242 @@ func TraceXRPLToCoreumTransfer(bcp BridgeClientProvider) *cobra.Command {
 			}),
 	}
 }
+
+// VerifyXRPLBridgeAccountCmd verifies the XRPL bridge account configuration.
+func VerifyXRPLBridgeAccountCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-bridge-account",
+		Short: "Verify the XRPL bridge account master key, flags, signer list and reserve.",
+		Args:  cobra.NoArgs,
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				misconfigurations, err := bridgeClient.VerifyXRPLBridgeAccount(ctx)
+				if err != nil {
+					return err
+				}
+				if len(misconfigurations) == 0 {
+					components.Log.Info(ctx, "XRPL bridge account is configured correctly.")
+					return nil
+				}
+
+				for _, misconfiguration := range misconfigurations {
+					components.Log.Error(
+						ctx,
+						"XRPL bridge account is misconfigured.",
+						zap.String("reason", string(misconfiguration.Reason)),
+						zap.String("details", misconfiguration.Details),
+					)
+				}
+
+				return errors.Errorf("found %d XRPL bridge account misconfigurations", len(misconfigurations))
+			}),
+	}
+}
+
+// XRPLReservesCmd prints the XRPL account reserve breakdown.
+func XRPLReservesCmd(bcp BridgeClientProvider) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reserves [address]",
+		Short: "Print the XRPL account reserve breakdown, the bridge account is used if the address isn't provided.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Print the XRPL account reserve breakdown, the bridge account is used if the address isn't provided.
+The required reserve is the base reserve plus the owner reserve for each object owned by the account. The spendable
+amount is the balance above the required reserve, the payments and trust sets of the account fail with
+tecINSUFFICIENT_RESERVE once it's exhausted.
+Example:
+$ reserves %s
+`, xrpl.XRPTokenIssuer.String()),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: runBridgeCmd(bcp,
+			func(cmd *cobra.Command, args []string, components runner.Components, bridgeClient BridgeClient) error {
+				ctx := cmd.Context()
+
+				var address string
+				if len(args) == 1 {
+					address = args[0]
+				} else {
+					contractCfg, err := bridgeClient.GetContractConfig(ctx)
+					if err != nil {
+						return err
+					}
+					address = contractCfg.BridgeXRPLAddress
+				}
+				acc, err := rippledata.NewAccountFromAddress(address)
+				if err != nil {
+					return errors.Wrapf(err, "failed to parse address: %s", address)
+				}
+				reserves, err := bridgeClient.GetXRPLAccountReserves(ctx, *acc)
+				if err != nil {
+					return err
+				}
+
+				return writeXRPLReserves(cmd.OutOrStdout(), acc.String(), reserves)
+			}),
+	}
+}
+
+func writeXRPLReserves(out io.Writer, address string, reserves xrpl.AccountReserves) error {
+	formatXRP := func(drops int64) string {
+		return strconv.FormatFloat(xrpl.DropsToXRP(drops), 'f', -1, 64) + " XRP"
+	}
+	formatObjects := func(count uint32) string {
+		return fmt.Sprintf("%d (%s)", count, formatXRP(reserves.OwnerReserveDrops*int64(count)))
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, row := range [][2]string{
+		{"address", address},
+		{"balance", formatXRP(reserves.BalanceDrops)},
+		{"base reserve", formatXRP(reserves.BaseReserveDrops)},
+		{"owner reserve per object", formatXRP(reserves.OwnerReserveDrops)},
+		{"tickets", formatObjects(reserves.TicketsCount)},
+		{"trust lines", formatObjects(reserves.TrustLinesCount)},
+		{"signer lists", formatObjects(reserves.SignerListsCount)},
+		{"other objects", formatObjects(reserves.OtherObjectsCount)},
+		{"required reserve", formatXRP(reserves.RequiredReserveDrops)},
+		{"spendable", formatXRP(reserves.SpendableDrops)},
+	} {
+		if _, err := fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1]); err != nil {
+			return errors.Wrap(err, "failed to write XRPL reserves")
+		}
+	}
+
+	return errors.Wrap(tw.Flush(), "failed to flush XRPL reserves")
+}
+
+// ********** Offline **********
+
+// GenerateXRPLSignerListCmd generates the XRPL SignerListSet transaction for the bridge account.
+func GenerateXRPLSignerListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-signer-list",
+		Short: "Generate the XRPL SignerListSet transaction blob setting the relayers as the bridge account signers.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the XRPL SignerListSet transaction blob setting the relayers as the bridge account signers.
+The relayers config is the JSON file with the relayers XRPL addresses and public keys:
+{"relayers": [{"xrpl_address": "%s", "xrpl_pub_key": "02..."}]}
+Each relayer gets the signer weight 1. The transaction must be signed by the bridge account, so the bridge account
+address and its current sequence should be provided to get the transaction ready for the signing and submission.
+Example:
+$ generate-signer-list --%s relayers.json --%s 3 --%s %s --%s 10
+`,
+				xrpl.XRPTokenIssuer.String(),
+				FlagRelayersConfig,
+				FlagQuorum,
+				FlagAddress,
+				xrpl.XRPTokenIssuer.String(),
+				FlagSequence,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			relayersConfigPath, err := cmd.Flags().GetString(FlagRelayersConfig)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagRelayersConfig)
+			}
+			if relayersConfigPath == "" {
+				return errors.Errorf("the --%s flag is required", FlagRelayersConfig)
+			}
+			quorum, err := cmd.Flags().GetUint32(FlagQuorum)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagQuorum)
+			}
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagAddress)
+			}
+			sequence, err := cmd.Flags().GetUint32(FlagSequence)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagSequence)
+			}
+
+			var account rippledata.Account
+			if address != "" {
+				parsedAccount, err := rippledata.NewAccountFromAddress(address)
+				if err != nil {
+					return errors.Wrapf(err, "failed to parse bridge account address: %s", address)
+				}
+				account = *parsedAccount
+			}
+
+			relayersConfigBytes, err := os.ReadFile(relayersConfigPath)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read relayers config, path:%s", relayersConfigPath)
+			}
+			var relayersConfig xrpl.SignerListConfig
+			if err := json.Unmarshal(relayersConfigBytes, &relayersConfig); err != nil {
+				return errors.Wrapf(err, "failed to unmarshal relayers config, path:%s", relayersConfigPath)
+			}
+
+			tx, err := xrpl.BuildSignerListSetTx(account, sequence, quorum, relayersConfig.Relayers)
+			if err != nil {
+				return err
+			}
+			txBlob, err := xrpl.EncodeSignerListSetTx(tx)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(
+				cmd.OutOrStdout(),
+				"tx_blob: %s\nreserve: %s XRP\n",
+				txBlob,
+				strconv.FormatFloat(xrpl.GetSignerListReserve(len(relayersConfig.Relayers)), 'f', -1, 64),
+			)
+			return errors.Wrap(err, "failed to write signer list transaction")
+		},
+	}
+	cmd.Flags().String(FlagRelayersConfig, "", "Path to the JSON file with the relayers XRPL addresses and public keys")
+	cmd.Flags().Uint32(FlagQuorum, 0, "Signer list quorum, the number of the relayer signatures required")
+	cmd.Flags().String(FlagAddress, "", "Bridge account address")
+	cmd.Flags().Uint32(FlagSequence, 0, "Bridge account sequence")
+
+	return cmd
+}
+
+// VerifyXRPLKeypairCmd verifies that the XRPL address is derived from the public key.
+func VerifyXRPLKeypairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-keypair",
+		Short: "Verify that the XRPL address is derived from the secp256k1 or ed25519 public key.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify that the XRPL address is derived from the secp256k1 or ed25519 public key.
+Example:
+$ verify-keypair --%s %s --%s %s
+`,
+				FlagPubKey,
+				"0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+				FlagAddress,
+				"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubKey, err := cmd.Flags().GetString(FlagPubKey)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagPubKey)
+			}
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read flag %s", FlagAddress)
+			}
+			if pubKey == "" || address == "" {
+				return errors.Errorf("the --%s and --%s flags are required", FlagPubKey, FlagAddress)
+			}
+
+			if err := xrpl.VerifyPublicKeyAddress(pubKey, address); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "The XRPL address %s matches the public key.\n", address)
+			return errors.Wrap(err, "failed to write keypair verification result")
+		},
+	}
+	cmd.Flags().String(FlagPubKey, "", "Hex encoded XRPL public key")
+	cmd.Flags().String(FlagAddress, "", "XRPL address")
+
+	return cmd
+}
@@ -1,6 +1,12 @@
 package cli_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -86,3 +92,137 @@ func TestTraceXRPLToCoreumTransfer(t *testing.T) {
 
 	executeQueryCmd(t, cli.TraceXRPLToCoreumTransfer(mockBridgeClientProvider(bridgeClientMock)), args...)
 }
+
+func TestVerifyXRPLBridgeAccountCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+
+	// valid
+	bridgeClientMock.EXPECT().VerifyXRPLBridgeAccount(gomock.Any()).
+		Return([]xrpl.BridgeAccountMisconfiguration{}, nil)
+	executeQueryCmd(t, cli.VerifyXRPLBridgeAccountCmd(mockBridgeClientProvider(bridgeClientMock)), initConfig(t)...)
+
+	// misconfigured
+	bridgeClientMock.EXPECT().VerifyXRPLBridgeAccount(gomock.Any()).
+		Return([]xrpl.BridgeAccountMisconfiguration{
+			{
+				Reason:  xrpl.BridgeAccountMasterKeyEnabled,
+				Details: "lsfDisableMaster flag is not set",
+			},
+		}, nil)
+	cmd := cli.VerifyXRPLBridgeAccountCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	cmd.SetArgs(initConfig(t))
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	require.ErrorContains(t, cmd.ExecuteContext(context.Background()), "XRPL bridge account misconfigurations")
+}
+
+func TestXRPLReservesCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bridgeAccount := xrpl.GenPrivKeyTxSigner().Account()
+	reserves := xrpl.AccountReserves{
+		BalanceDrops:         40_500_000,
+		BaseReserveDrops:     10_000_000,
+		OwnerReserveDrops:    2_000_000,
+		OwnerCount:           14,
+		TicketsCount:         10,
+		TrustLinesCount:      3,
+		SignerListsCount:     1,
+		RequiredReserveDrops: 38_000_000,
+		SpendableDrops:       2_500_000,
+	}
+
+	bridgeClientMock := NewMockBridgeClient(ctrl)
+	bridgeClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		BridgeXRPLAddress: bridgeAccount.String(),
+	}, nil)
+	bridgeClientMock.EXPECT().GetXRPLAccountReserves(gomock.Any(), bridgeAccount).Return(reserves, nil)
+	cmd := cli.XRPLReservesCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	out := executeCmd(t, cmd, initConfig(t)...)
+	require.Contains(t, out, "address:                   "+bridgeAccount.String())
+	require.Contains(t, out, "owner reserve per object:  2 XRP")
+	require.Contains(t, out, "tickets:                   10 (20 XRP)")
+	require.Contains(t, out, "trust lines:               3 (6 XRP)")
+	require.Contains(t, out, "required reserve:          38 XRP")
+	require.Contains(t, out, "spendable:                 2.5 XRP")
+
+	// the provided address is used instead of the bridge account
+	account := xrpl.GenPrivKeyTxSigner().Account()
+	bridgeClientMock.EXPECT().GetXRPLAccountReserves(gomock.Any(), account).Return(xrpl.AccountReserves{}, nil)
+	cmd = cli.XRPLReservesCmd(mockBridgeClientProvider(bridgeClientMock))
+	cli.AddHomeFlag(cmd)
+	out = executeCmd(t, cmd, append(initConfig(t), account.String())...)
+	require.Contains(t, out, account.String())
+}
+
+func TestGenerateXRPLSignerListCmd(t *testing.T) {
+	relayersConfigPath := filepath.Join(t.TempDir(), "relayers.json")
+	relayersConfigBytes, err := json.Marshal(xrpl.SignerListConfig{
+		Relayers: []xrpl.SignerListRelayer{
+			{
+				XRPLAddress: "r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+				XRPLPubKey:  "02" + strings.Repeat("11", 32),
+			},
+			{
+				XRPLAddress: "ravjKydV4McJxGSDbDfmiS2rT5Gm4zFWwQ",
+				XRPLPubKey:  "03" + strings.Repeat("22", 32),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(relayersConfigPath, relayersConfigBytes, 0o600))
+
+	out := executeCmd(t, cli.GenerateXRPLSignerListCmd(),
+		flagWithPrefix(cli.FlagRelayersConfig), relayersConfigPath,
+		flagWithPrefix(cli.FlagQuorum), "2",
+		flagWithPrefix(cli.FlagAddress), "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		flagWithPrefix(cli.FlagSequence), "3",
+	)
+	require.Equal(t, "tx_blob: 12000C2400000003202300000002"+
+		"68400000000000000A"+
+		"8114B5F762798A53D543A014CAF8B297CFF8F2F937E8"+
+		"F4"+
+		"EB13000181144102020202020202020202020202020202020202E1"+
+		"EB13000181145001010101010101010101010101010101010101E1"+
+		"F1\n"+
+		"reserve: 6 XRP\n",
+		out,
+	)
+
+	_, err = executeCmdWithOutputOptionAndError(cli.GenerateXRPLSignerListCmd(), "text",
+		flagWithPrefix(cli.FlagRelayersConfig), relayersConfigPath,
+		flagWithPrefix(cli.FlagQuorum), "3",
+	)
+	require.ErrorContains(t, err, "invalid quorum")
+
+	_, err = executeCmdWithOutputOptionAndError(cli.GenerateXRPLSignerListCmd(), "text",
+		flagWithPrefix(cli.FlagQuorum), "1",
+	)
+	require.ErrorContains(t, err, "the --relayers-config flag is required")
+}
+
+func TestVerifyXRPLKeypairCmd(t *testing.T) {
+	pubKey := "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
+	out := executeCmd(t, cli.VerifyXRPLKeypairCmd(),
+		flagWithPrefix(cli.FlagPubKey), pubKey,
+		flagWithPrefix(cli.FlagAddress), "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+	)
+	require.Equal(t, "The XRPL address rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh matches the public key.\n", out)
+
+	_, err := executeCmdWithOutputOptionAndError(cli.VerifyXRPLKeypairCmd(), "text",
+		flagWithPrefix(cli.FlagPubKey), pubKey,
+		flagWithPrefix(cli.FlagAddress), "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf",
+	)
+	require.ErrorContains(t, err, "doesn't match the public key")
+
+	_, err = executeCmdWithOutputOptionAndError(cli.VerifyXRPLKeypairCmd(), "text",
+		flagWithPrefix(cli.FlagPubKey), pubKey,
+	)
+	require.ErrorContains(t, err, "the --pubkey and --address flags are required")
+}
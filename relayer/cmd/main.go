@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/pkg/errors"
@@ -16,8 +19,13 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
 )
 
+const appName = "coreumbridge-xrpl-relayer"
+
 func main() {
-	run.Tool("coreumbridge-xrpl-relayer", func(ctx context.Context) error {
+	run.Tool(appName, func(ctx context.Context) error {
+		// the first signal cancels the context and starts the graceful shutdown
+		go exitOnRepeatedTermination()
+
 		rootCmd, err := RootCmd(ctx)
 		if err != nil {
 			return err
@@ -30,6 +38,17 @@ func main() {
 	})
 }
 
+// exitOnRepeatedTermination exits immediately if the termination signal is received again during the graceful
+// shutdown started by the first one.
+func exitOnRepeatedTermination() {
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+	<-sigs
+	fmt.Fprintln(os.Stderr, "Termination signal is received again, exiting without waiting for the graceful shutdown")
+	os.Exit(1)
+}
+
 // RootCmd returns the root cmd.
 //
 //nolint:contextcheck // the context is passed in the command
@@ -43,15 +62,26 @@ func RootCmd(ctx context.Context) (*cobra.Command, error) {
 		WithInput(os.Stdin)
 	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
 	cmd := &cobra.Command{
+		Use:   appName,
 		Short: "Coreumbridge XRPL relayer.",
 	}
 	cmd.SetContext(ctx)
 
 	cmd.AddCommand(cli.InitCmd())
 	cmd.AddCommand(cli.StartCmd(processorProvider))
+	cmd.AddCommand(cli.ServeAPICmd(bridgeClientProvider))
+	cmd.AddCommand(cli.ConfigCmd())
 	cmd.AddCommand(cli.RelayerKeysCmd())
 	cmd.AddCommand(cli.BootstrapBridgeCmd(bridgeClientProvider))
-	cmd.AddCommand(cli.VersionCmd())
+	cmd.AddCommand(cli.GenerateBootstrapConfigCmd())
+	cmd.AddCommand(cli.SignPendingCmd(bridgeClientProvider))
+	cmd.AddCommand(cli.SignOfflineCmd())
+	cmd.AddCommand(cli.BroadcastSignaturesCmd(bridgeClientProvider))
+	cmd.AddCommand(cli.VersionCmd(bridgeClientProvider))
+	cmd.AddCommand(cli.HistoryCmd())
+	cmd.AddCommand(cli.AuditCmd())
+	cmd.AddCommand(cli.CompletionCmd())
+	cmd.AddCommand(cli.ManifestCmd())
 
 	coreumCmd, err := cli.CoreumCmd(bridgeClientProvider)
 	if err != nil {
@@ -75,7 +105,10 @@ func bridgeClientProvider(components runner.Components) (cli.BridgeClient, error
 		components.CoreumContractClient,
 		components.XRPLRPCClient,
 		components.XRPLKeyringTxSigner,
-	), nil
+	).WithMinBridgeAmounts(components.MinBridgeAmounts).
+		WithXRPLSubmissionMonitor(components.XRPLSubmissionMonitor).
+		WithXRPLAMMRouter(components.XRPLAMMRouter).
+		WithOperationAgeStoreFilePath(components.RunnerConfig.Processes.CoreumToXRPLProcess.OperationAgeStoreFilePath), nil
 }
 
 func processorProvider(cmd *cobra.Command) (cli.Runner, error) {
@@ -0,0 +1,521 @@
+package coreum
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//go:generate mockgen -destination=caching_client_mocks_test.go -package=coreum_test . CacheableContractClient,CachingContractClientMetricRegistry
+
+// CacheableContractClient is the contract client wrapped by the CachingContractClient.
+type CacheableContractClient interface {
+	IsInitialized() bool
+	GetContractConfig(ctx context.Context) (ContractConfig, error)
+	GetAvailableTickets(ctx context.Context) ([]uint32, error)
+	GetPendingOperations(ctx context.Context) ([]Operation, error)
+	GetXRPLTokens(ctx context.Context) ([]XRPLToken, error)
+	GetCoreumTokens(ctx context.Context) ([]CoreumToken, error)
+	SendXRPLToCoreumTransferEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLToCoreumTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendXRPLNFTokenTransferEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLNFTokenTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendXRPLCheckCashEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLCheckCashEvidence,
+	) (*sdk.TxResponse, error)
+	SendXRPLTicketsAllocationTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultTicketsAllocationEvidence,
+	) (*sdk.TxResponse, error)
+	SendXRPLTrustSetTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultTrustSetEvidence,
+	) (*sdk.TxResponse, error)
+	SendCoreumToXRPLTransferTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultCoreumToXRPLTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendNFTokenTransferTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultNFTokenTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendCheckCashTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultCheckCashEvidence,
+	) (*sdk.TxResponse, error)
+	SendKeysRotationTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd XRPLTransactionResultKeysRotationEvidence,
+	) (*sdk.TxResponse, error)
+	SaveSignature(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		operationID uint32,
+		operationVersion uint32,
+		signature string,
+	) (*sdk.TxResponse, error)
+}
+
+// CachingContractClientMetricRegistry is the CachingContractClient metric registry.
+type CachingContractClientMetricRegistry interface {
+	IncrementCoreumContractCacheRequestCounter(query string, hit bool)
+}
+
+// the cached query names used in the metrics
+const (
+	contractConfigQuery    = "contract_config"
+	availableTicketsQuery  = "available_tickets"
+	pendingOperationsQuery = "pending_operations"
+	xrplTokensQuery        = "xrpl_tokens"
+	coreumTokensQuery      = "coreum_tokens"
+)
+
+// CachingContractClientConfig is the CachingContractClient config, the zero TTL disables the caching of the query.
+type CachingContractClientConfig struct {
+	ContractConfigTTL    time.Duration
+	AvailableTicketsTTL  time.Duration
+	PendingOperationsTTL time.Duration
+	// TokensTTL is the TTL of the XRPL and Coreum token lists.
+	TokensTTL time.Duration
+}
+
+// DefaultCachingContractClientConfig returns the default CachingContractClientConfig.
+func DefaultCachingContractClientConfig() CachingContractClientConfig {
+	return CachingContractClientConfig{
+		ContractConfigTTL:    time.Minute,
+		AvailableTicketsTTL:  5 * time.Second,
+		PendingOperationsTTL: time.Second,
+		TokensTTL:            5 * time.Minute,
+	}
+}
+
+// CachingContractClient caches the contract queries executed by the relayer on each processing iteration.
+// The cached tickets and pending operations are dropped on each write executed through the client, since the write
+// might change them. The contract config and tokens rarely change, so they are dropped only on the writes and pending
+// operations which change them, or if they are expired.
+type CachingContractClient struct {
+	cfg            CachingContractClientConfig
+	contractClient CacheableContractClient
+	metricRegistry CachingContractClientMetricRegistry
+	clock          func() time.Time
+
+	mu                sync.Mutex
+	contractConfig    cachedValue[ContractConfig]
+	availableTickets  cachedValue[[]uint32]
+	pendingOperations cachedValue[[]Operation]
+	xrplTokens        cachedValue[[]XRPLToken]
+	coreumTokens      cachedValue[[]CoreumToken]
+	// observedOperationIDs are the IDs of the pending operations changing the contract config and tokens which are
+	// already observed by the client
+	observedOperationIDs map[uint32]struct{}
+}
+
+type cachedValue[T any] struct {
+	value     T
+	expiresAt time.Time
+	// generation is incremented on each invalidation to not cache the value queried before it
+	generation uint64
+}
+
+// NewCachingContractClient returns a new instance of the CachingContractClient.
+func NewCachingContractClient(
+	cfg CachingContractClientConfig,
+	contractClient CacheableContractClient,
+	metricRegistry CachingContractClientMetricRegistry,
+	clock func() time.Time,
+) *CachingContractClient {
+	return &CachingContractClient{
+		cfg:                  cfg,
+		contractClient:       contractClient,
+		metricRegistry:       metricRegistry,
+		clock:                clock,
+		observedOperationIDs: make(map[uint32]struct{}),
+	}
+}
+
+// IsInitialized returns true if the wrapped client is initialized.
+func (c *CachingContractClient) IsInitialized() bool {
+	return c.contractClient.IsInitialized()
+}
+
+// GetContractConfig returns the cached contract config or queries it.
+func (c *CachingContractClient) GetContractConfig(ctx context.Context) (ContractConfig, error) {
+	return getCachedValue(
+		c, contractConfigQuery, &c.contractConfig, c.cfg.ContractConfigTTL, func() (ContractConfig, error) {
+			return c.contractClient.GetContractConfig(ctx)
+		},
+	)
+}
+
+// GetAvailableTickets returns the cached available tickets or queries them.
+func (c *CachingContractClient) GetAvailableTickets(ctx context.Context) ([]uint32, error) {
+	tickets, err := getCachedValue(
+		c, availableTicketsQuery, &c.availableTickets, c.cfg.AvailableTicketsTTL, func() ([]uint32, error) {
+			return c.contractClient.GetAvailableTickets(ctx)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(tickets), nil
+}
+
+// GetPendingOperations returns the cached pending operations or queries them.
+func (c *CachingContractClient) GetPendingOperations(ctx context.Context) ([]Operation, error) {
+	operations, err := getCachedValue(
+		c, pendingOperationsQuery, &c.pendingOperations, c.cfg.PendingOperationsTTL, func() ([]Operation, error) {
+			return c.contractClient.GetPendingOperations(ctx)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.observeOperations(operations)
+
+	return slices.Clone(operations), nil
+}
+
+// GetPendingOperationsByType returns the pending operations of the provided type filtered from the cached pending
+// operations.
+func (c *CachingContractClient) GetPendingOperationsByType(
+	ctx context.Context,
+	opType OperationTypeEnum,
+) ([]Operation, error) {
+	if err := validateOperationType(opType); err != nil {
+		return nil, err
+	}
+	operations, err := c.GetPendingOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterOperationsByType(operations, opType), nil
+}
+
+// GetXRPLTokens returns the cached XRPL tokens or queries them.
+func (c *CachingContractClient) GetXRPLTokens(ctx context.Context) ([]XRPLToken, error) {
+	tokens, err := getCachedValue(c, xrplTokensQuery, &c.xrplTokens, c.cfg.TokensTTL, func() ([]XRPLToken, error) {
+		return c.contractClient.GetXRPLTokens(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(tokens), nil
+}
+
+// GetCoreumTokens returns the cached Coreum tokens or queries them.
+func (c *CachingContractClient) GetCoreumTokens(ctx context.Context) ([]CoreumToken, error) {
+	tokens, err := getCachedValue(c, coreumTokensQuery, &c.coreumTokens, c.cfg.TokensTTL, func() ([]CoreumToken, error) {
+		return c.contractClient.GetCoreumTokens(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(tokens), nil
+}
+
+// IsXRPLTokenRegistered returns true if the XRPL token with the issuer and currency is registered in the contract,
+// either as the XRPL token, or as the XRPL representation of the Coreum token. The token missing in the cached
+// values is looked up again after the force refresh, so the stale cache never reports the registered token as
+// unknown.
+func (c *CachingContractClient) IsXRPLTokenRegistered(ctx context.Context, issuer, currency string) (bool, error) {
+	registered, err := c.isXRPLTokenRegistered(ctx, issuer, currency)
+	if err != nil || registered {
+		return registered, err
+	}
+	if err := c.ForceRefresh(ctx); err != nil {
+		return false, err
+	}
+
+	return c.isXRPLTokenRegistered(ctx, issuer, currency)
+}
+
+// ForceRefresh drops the cached contract config and tokens and queries them again.
+func (c *CachingContractClient) ForceRefresh(ctx context.Context) error {
+	c.InvalidateContractConfig()
+	c.InvalidateTokens()
+	if _, err := c.GetContractConfig(ctx); err != nil {
+		return err
+	}
+	if _, err := c.GetXRPLTokens(ctx); err != nil {
+		return err
+	}
+	if _, err := c.GetCoreumTokens(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InvalidateContractConfig drops the cached contract config.
+func (c *CachingContractClient) InvalidateContractConfig() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	invalidateCachedValue(&c.contractConfig)
+}
+
+// InvalidatePendingOperations drops the cached pending operations.
+func (c *CachingContractClient) InvalidatePendingOperations() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	invalidateCachedValue(&c.pendingOperations)
+}
+
+// InvalidateTokens drops the cached XRPL and Coreum tokens.
+func (c *CachingContractClient) InvalidateTokens() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	invalidateCachedValue(&c.xrplTokens)
+	invalidateCachedValue(&c.coreumTokens)
+}
+
+// SendXRPLToCoreumTransferEvidence sends an Evidence of an accepted XRPL to coreum transfer transaction.
+func (c *CachingContractClient) SendXRPLToCoreumTransferEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLToCoreumTransferEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendXRPLToCoreumTransferEvidence(ctx, sender, evd)
+}
+
+// SendXRPLNFTokenTransferEvidence sends an Evidence of the XRPL NFToken sell offer created for the bridge account.
+func (c *CachingContractClient) SendXRPLNFTokenTransferEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLNFTokenTransferEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendXRPLNFTokenTransferEvidence(ctx, sender, evd)
+}
+
+// SendXRPLCheckCashEvidence sends an Evidence of the XRPL Check created for the bridge account.
+func (c *CachingContractClient) SendXRPLCheckCashEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLCheckCashEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendXRPLCheckCashEvidence(ctx, sender, evd)
+}
+
+// SendXRPLTicketsAllocationTransactionResultEvidence sends an Evidence of an accepted
+// or rejected ticket allocation transaction.
+func (c *CachingContractClient) SendXRPLTicketsAllocationTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultTicketsAllocationEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendXRPLTicketsAllocationTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SendXRPLTrustSetTransactionResultEvidence sends an Evidence of an accepted or rejected trust set transaction.
+func (c *CachingContractClient) SendXRPLTrustSetTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultTrustSetEvidence,
+) (*sdk.TxResponse, error) {
+	// the trust set result changes the token state
+	defer c.InvalidateTokens()
+	defer c.invalidate()
+	return c.contractClient.SendXRPLTrustSetTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SendCoreumToXRPLTransferTransactionResultEvidence sends an Evidence of an accepted or
+// rejected coreum to XRPL transfer transaction.
+func (c *CachingContractClient) SendCoreumToXRPLTransferTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultCoreumToXRPLTransferEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SendNFTokenTransferTransactionResultEvidence sends an Evidence of an accepted or
+// rejected NFToken sell offer acceptance transaction.
+func (c *CachingContractClient) SendNFTokenTransferTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultNFTokenTransferEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendNFTokenTransferTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SendCheckCashTransactionResultEvidence sends an Evidence of an accepted or rejected Check cashing transaction.
+func (c *CachingContractClient) SendCheckCashTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultCheckCashEvidence,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SendCheckCashTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SendKeysRotationTransactionResultEvidence sends an Evidence of an accepted or
+// rejected keys rotation transaction.
+func (c *CachingContractClient) SendKeysRotationTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultKeysRotationEvidence,
+) (*sdk.TxResponse, error) {
+	// the keys rotation result changes the relayers and bridge state
+	defer c.InvalidateContractConfig()
+	defer c.invalidate()
+	return c.contractClient.SendKeysRotationTransactionResultEvidence(ctx, sender, evd)
+}
+
+// SaveSignature saves a signature for an operation.
+func (c *CachingContractClient) SaveSignature(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	operationID uint32,
+	operationVersion uint32,
+	signature string,
+) (*sdk.TxResponse, error) {
+	defer c.invalidate()
+	return c.contractClient.SaveSignature(ctx, sender, operationID, operationVersion, signature)
+}
+
+// invalidate drops the cached tickets and pending operations, it's called even if the write is failed, since the
+// failed broadcast might still be included in the block.
+func (c *CachingContractClient) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	invalidateCachedValue(&c.availableTickets)
+	invalidateCachedValue(&c.pendingOperations)
+}
+
+// observeOperations drops the cached tokens if a new trust set operation is observed, since it's created by the XRPL
+// token registration, and drops the cached contract config if a new keys rotation operation is observed.
+func (c *CachingContractClient) observeOperations(operations []Operation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	observedOperationIDs := make(map[uint32]struct{})
+	for _, operation := range operations {
+		if operation.OperationType.TrustSet == nil && operation.OperationType.RotateKeys == nil {
+			continue
+		}
+		operationID := operation.GetOperationID()
+		observedOperationIDs[operationID] = struct{}{}
+		if _, ok := c.observedOperationIDs[operationID]; ok {
+			continue
+		}
+		if operation.OperationType.TrustSet != nil {
+			invalidateCachedValue(&c.xrplTokens)
+		} else {
+			invalidateCachedValue(&c.contractConfig)
+		}
+	}
+	// the completed operations are dropped to keep the set small
+	c.observedOperationIDs = observedOperationIDs
+}
+
+func (c *CachingContractClient) isXRPLTokenRegistered(ctx context.Context, issuer, currency string) (bool, error) {
+	xrplTokens, err := c.GetXRPLTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, token := range xrplTokens {
+		if token.Issuer == issuer && token.Currency == currency {
+			return true, nil
+		}
+	}
+
+	contractConfig, err := c.GetContractConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	// the Coreum tokens are issued on XRPL by the bridge account
+	if contractConfig.BridgeXRPLAddress != issuer {
+		return false, nil
+	}
+	coreumTokens, err := c.GetCoreumTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, token := range coreumTokens {
+		if token.XRPLCurrency == currency {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func invalidateCachedValue[T any](cached *cachedValue[T]) {
+	*cached = cachedValue[T]{
+		generation: cached.generation + 1,
+	}
+}
+
+func getCachedValue[T any](
+	c *CachingContractClient,
+	queryName string,
+	cached *cachedValue[T],
+	ttl time.Duration,
+	query func() (T, error),
+) (T, error) {
+	if ttl <= 0 {
+		return query()
+	}
+
+	c.mu.Lock()
+	now := c.clock()
+	if now.Before(cached.expiresAt) {
+		value := cached.value
+		c.mu.Unlock()
+		c.metricRegistry.IncrementCoreumContractCacheRequestCounter(queryName, true)
+		return value, nil
+	}
+	generation := cached.generation
+	c.mu.Unlock()
+	c.metricRegistry.IncrementCoreumContractCacheRequestCounter(queryName, false)
+
+	// the query is executed without the lock to not block the writes and other queries
+	value, err := query()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != cached.generation {
+		return value, nil
+	}
+	*cached = cachedValue[T]{
+		value:      value,
+		expiresAt:  now.Add(ttl),
+		generation: generation,
+	}
+
+	return value, nil
+}
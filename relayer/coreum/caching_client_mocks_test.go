@@ -0,0 +1,316 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum (interfaces: CacheableContractClient,CachingContractClientMetricRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -destination=caching_client_mocks_test.go -package=coreum_test . CacheableContractClient,CachingContractClientMetricRegistry
+//
+
+// Package coreum_test is a generated GoMock package.
+package coreum_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	coreum "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCacheableContractClient is a mock of CacheableContractClient interface.
+type MockCacheableContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheableContractClientMockRecorder
+}
+
+// MockCacheableContractClientMockRecorder is the mock recorder for MockCacheableContractClient.
+type MockCacheableContractClientMockRecorder struct {
+	mock *MockCacheableContractClient
+}
+
+// NewMockCacheableContractClient creates a new mock instance.
+func NewMockCacheableContractClient(ctrl *gomock.Controller) *MockCacheableContractClient {
+	mock := &MockCacheableContractClient{ctrl: ctrl}
+	mock.recorder = &MockCacheableContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCacheableContractClient) EXPECT() *MockCacheableContractClientMockRecorder {
+	return m.recorder
+}
+
+// GetAvailableTickets mocks base method.
+func (m *MockCacheableContractClient) GetAvailableTickets(arg0 context.Context) ([]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableTickets", arg0)
+	ret0, _ := ret[0].([]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableTickets indicates an expected call of GetAvailableTickets.
+func (mr *MockCacheableContractClientMockRecorder) GetAvailableTickets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableTickets", reflect.TypeOf((*MockCacheableContractClient)(nil).GetAvailableTickets), arg0)
+}
+
+// GetContractConfig mocks base method.
+func (m *MockCacheableContractClient) GetContractConfig(arg0 context.Context) (coreum.ContractConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractConfig", arg0)
+	ret0, _ := ret[0].(coreum.ContractConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractConfig indicates an expected call of GetContractConfig.
+func (mr *MockCacheableContractClientMockRecorder) GetContractConfig(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractConfig", reflect.TypeOf((*MockCacheableContractClient)(nil).GetContractConfig), arg0)
+}
+
+// GetCoreumTokens mocks base method.
+func (m *MockCacheableContractClient) GetCoreumTokens(arg0 context.Context) ([]coreum.CoreumToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumTokens", arg0)
+	ret0, _ := ret[0].([]coreum.CoreumToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumTokens indicates an expected call of GetCoreumTokens.
+func (mr *MockCacheableContractClientMockRecorder) GetCoreumTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumTokens", reflect.TypeOf((*MockCacheableContractClient)(nil).GetCoreumTokens), arg0)
+}
+
+// GetPendingOperations mocks base method.
+func (m *MockCacheableContractClient) GetPendingOperations(arg0 context.Context) ([]coreum.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperations", arg0)
+	ret0, _ := ret[0].([]coreum.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperations indicates an expected call of GetPendingOperations.
+func (mr *MockCacheableContractClientMockRecorder) GetPendingOperations(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperations", reflect.TypeOf((*MockCacheableContractClient)(nil).GetPendingOperations), arg0)
+}
+
+// GetXRPLTokens mocks base method.
+func (m *MockCacheableContractClient) GetXRPLTokens(arg0 context.Context) ([]coreum.XRPLToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLTokens", arg0)
+	ret0, _ := ret[0].([]coreum.XRPLToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLTokens indicates an expected call of GetXRPLTokens.
+func (mr *MockCacheableContractClientMockRecorder) GetXRPLTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLTokens", reflect.TypeOf((*MockCacheableContractClient)(nil).GetXRPLTokens), arg0)
+}
+
+// IsInitialized mocks base method.
+func (m *MockCacheableContractClient) IsInitialized() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInitialized")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsInitialized indicates an expected call of IsInitialized.
+func (mr *MockCacheableContractClientMockRecorder) IsInitialized() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInitialized", reflect.TypeOf((*MockCacheableContractClient)(nil).IsInitialized))
+}
+
+// SaveSignature mocks base method.
+func (m *MockCacheableContractClient) SaveSignature(arg0 context.Context, arg1 types.AccAddress, arg2, arg3 uint32, arg4 string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSignature", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSignature indicates an expected call of SaveSignature.
+func (mr *MockCacheableContractClientMockRecorder) SaveSignature(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSignature", reflect.TypeOf((*MockCacheableContractClient)(nil).SaveSignature), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SendCheckCashTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendCheckCashTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultCheckCashEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCheckCashTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCheckCashTransactionResultEvidence indicates an expected call of SendCheckCashTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendCheckCashTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCheckCashTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendCheckCashTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendCoreumToXRPLTransferTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendCoreumToXRPLTransferTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoreumToXRPLTransferTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCoreumToXRPLTransferTransactionResultEvidence indicates an expected call of SendCoreumToXRPLTransferTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendCoreumToXRPLTransferTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoreumToXRPLTransferTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendCoreumToXRPLTransferTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendKeysRotationTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendKeysRotationTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultKeysRotationEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendKeysRotationTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendKeysRotationTransactionResultEvidence indicates an expected call of SendKeysRotationTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendKeysRotationTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendKeysRotationTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendKeysRotationTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendNFTokenTransferTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendNFTokenTransferTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultNFTokenTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNFTokenTransferTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendNFTokenTransferTransactionResultEvidence indicates an expected call of SendNFTokenTransferTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendNFTokenTransferTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNFTokenTransferTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendNFTokenTransferTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLCheckCashEvidence mocks base method.
+func (m *MockCacheableContractClient) SendXRPLCheckCashEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLCheckCashEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLCheckCashEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLCheckCashEvidence indicates an expected call of SendXRPLCheckCashEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendXRPLCheckCashEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLCheckCashEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendXRPLCheckCashEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLNFTokenTransferEvidence mocks base method.
+func (m *MockCacheableContractClient) SendXRPLNFTokenTransferEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLNFTokenTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLNFTokenTransferEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLNFTokenTransferEvidence indicates an expected call of SendXRPLNFTokenTransferEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendXRPLNFTokenTransferEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLNFTokenTransferEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendXRPLNFTokenTransferEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLTicketsAllocationTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendXRPLTicketsAllocationTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultTicketsAllocationEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLTicketsAllocationTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLTicketsAllocationTransactionResultEvidence indicates an expected call of SendXRPLTicketsAllocationTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendXRPLTicketsAllocationTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLTicketsAllocationTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendXRPLTicketsAllocationTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLToCoreumTransferEvidence mocks base method.
+func (m *MockCacheableContractClient) SendXRPLToCoreumTransferEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLToCoreumTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLToCoreumTransferEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLToCoreumTransferEvidence indicates an expected call of SendXRPLToCoreumTransferEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendXRPLToCoreumTransferEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLToCoreumTransferEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendXRPLToCoreumTransferEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLTrustSetTransactionResultEvidence mocks base method.
+func (m *MockCacheableContractClient) SendXRPLTrustSetTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultTrustSetEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLTrustSetTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLTrustSetTransactionResultEvidence indicates an expected call of SendXRPLTrustSetTransactionResultEvidence.
+func (mr *MockCacheableContractClientMockRecorder) SendXRPLTrustSetTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLTrustSetTransactionResultEvidence", reflect.TypeOf((*MockCacheableContractClient)(nil).SendXRPLTrustSetTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// MockCachingContractClientMetricRegistry is a mock of CachingContractClientMetricRegistry interface.
+type MockCachingContractClientMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockCachingContractClientMetricRegistryMockRecorder
+}
+
+// MockCachingContractClientMetricRegistryMockRecorder is the mock recorder for MockCachingContractClientMetricRegistry.
+type MockCachingContractClientMetricRegistryMockRecorder struct {
+	mock *MockCachingContractClientMetricRegistry
+}
+
+// NewMockCachingContractClientMetricRegistry creates a new mock instance.
+func NewMockCachingContractClientMetricRegistry(ctrl *gomock.Controller) *MockCachingContractClientMetricRegistry {
+	mock := &MockCachingContractClientMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockCachingContractClientMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCachingContractClientMetricRegistry) EXPECT() *MockCachingContractClientMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementCoreumContractCacheRequestCounter mocks base method.
+func (m *MockCachingContractClientMetricRegistry) IncrementCoreumContractCacheRequestCounter(arg0 string, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCoreumContractCacheRequestCounter", arg0, arg1)
+}
+
+// IncrementCoreumContractCacheRequestCounter indicates an expected call of IncrementCoreumContractCacheRequestCounter.
+func (mr *MockCachingContractClientMetricRegistryMockRecorder) IncrementCoreumContractCacheRequestCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCoreumContractCacheRequestCounter", reflect.TypeOf((*MockCachingContractClientMetricRegistry)(nil).IncrementCoreumContractCacheRequestCounter), arg0, arg1)
+}
@@ -0,0 +1,395 @@
+package coreum_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+func TestCachingContractClient_TTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	contractClientMock := NewMockCacheableContractClient(ctrl)
+	cfg := coreum.CachingContractClientConfig{
+		ContractConfigTTL:    5 * time.Second,
+		AvailableTicketsTTL:  5 * time.Second,
+		PendingOperationsTTL: time.Second,
+	}
+	metricRegistryMock := NewMockCachingContractClientMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter(gomock.Any(), gomock.Any()).AnyTimes()
+	cachingClient := coreum.NewCachingContractClient(cfg, contractClientMock, metricRegistryMock, clock)
+
+	contractConfig := coreum.ContractConfig{EvidenceThreshold: 2}
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	contractClientMock.EXPECT().GetAvailableTickets(gomock.Any()).Return([]uint32{1, 2}, nil)
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{{TicketSequence: 1}}, nil)
+
+	// the values are queried once and then returned from the cache
+	for i := 0; i < 3; i++ {
+		gotContractConfig, err := cachingClient.GetContractConfig(ctx)
+		require.NoError(t, err)
+		require.Equal(t, contractConfig, gotContractConfig)
+		tickets, err := cachingClient.GetAvailableTickets(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uint32{1, 2}, tickets)
+		operations, err := cachingClient.GetPendingOperations(ctx)
+		require.NoError(t, err)
+		require.Len(t, operations, 1)
+	}
+
+	// the pending operations are expired
+	now = now.Add(cfg.PendingOperationsTTL)
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{}, nil)
+	operations, err := cachingClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	require.Empty(t, operations)
+	_, err = cachingClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+
+	// the contract config and tickets are expired
+	now = now.Add(cfg.ContractConfigTTL)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	contractClientMock.EXPECT().GetAvailableTickets(gomock.Any()).Return([]uint32{2}, nil)
+	_, err = cachingClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+	tickets, err := cachingClient.GetAvailableTickets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{2}, tickets)
+
+	// the errors are not cached
+	now = now.Add(cfg.ContractConfigTTL)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{}, errors.New("timeout"))
+	_, err = cachingClient.GetContractConfig(ctx)
+	require.ErrorContains(t, err, "timeout")
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	_, err = cachingClient.GetContractConfig(ctx)
+	require.NoError(t, err)
+}
+
+func TestCachingContractClient_WriteInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	relayerAddress := coreum.GenAccount()
+
+	tests := []struct {
+		name  string
+		write func(t *testing.T, clientMock *MockCacheableContractClient, client *coreum.CachingContractClient)
+		// the pending operations are re-queried after each write
+		expectedContractConfigQueries int
+		expectedXRPLTokensQueries     int
+	}{
+		{
+			name: "save_signature",
+			write: func(t *testing.T, clientMock *MockCacheableContractClient, client *coreum.CachingContractClient) {
+				clientMock.EXPECT().SaveSignature(gomock.Any(), relayerAddress, uint32(1), uint32(1), "sig")
+				_, err := client.SaveSignature(ctx, relayerAddress, 1, 1, "sig")
+				require.NoError(t, err)
+			},
+			expectedContractConfigQueries: 1,
+			expectedXRPLTokensQueries:     1,
+		},
+		{
+			name: "keys_rotation_evidence",
+			write: func(t *testing.T, clientMock *MockCacheableContractClient, client *coreum.CachingContractClient) {
+				clientMock.EXPECT().SendKeysRotationTransactionResultEvidence(
+					gomock.Any(), relayerAddress, gomock.Any(),
+				)
+				_, err := client.SendKeysRotationTransactionResultEvidence(
+					ctx, relayerAddress, coreum.XRPLTransactionResultKeysRotationEvidence{},
+				)
+				require.NoError(t, err)
+			},
+			expectedContractConfigQueries: 2,
+			expectedXRPLTokensQueries:     1,
+		},
+		{
+			name: "failed_trust_set_evidence",
+			write: func(t *testing.T, clientMock *MockCacheableContractClient, client *coreum.CachingContractClient) {
+				clientMock.EXPECT().SendXRPLTrustSetTransactionResultEvidence(
+					gomock.Any(), relayerAddress, gomock.Any(),
+				).Return(nil, errors.New("broadcast timeout"))
+				_, err := client.SendXRPLTrustSetTransactionResultEvidence(
+					ctx, relayerAddress, coreum.XRPLTransactionResultTrustSetEvidence{},
+				)
+				require.ErrorContains(t, err, "broadcast timeout")
+			},
+			expectedContractConfigQueries: 1,
+			expectedXRPLTokensQueries:     2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			contractClientMock := NewMockCacheableContractClient(ctrl)
+			metricRegistryMock := NewMockCachingContractClientMetricRegistry(ctrl)
+			metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter(gomock.Any(), gomock.Any()).AnyTimes()
+			cachingClient := coreum.NewCachingContractClient(
+				coreum.DefaultCachingContractClientConfig(), contractClientMock, metricRegistryMock, time.Now,
+			)
+
+			// the first query is cached, and the write drops the cache of the values it changes
+			contractClientMock.EXPECT().GetContractConfig(gomock.Any()).
+				Return(coreum.ContractConfig{}, nil).Times(tt.expectedContractConfigQueries)
+			contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).
+				Return([]coreum.XRPLToken{}, nil).Times(tt.expectedXRPLTokensQueries)
+			contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{}, nil).Times(2)
+			queryAll := func() {
+				for i := 0; i < 2; i++ {
+					_, err := cachingClient.GetContractConfig(ctx)
+					require.NoError(t, err)
+					_, err = cachingClient.GetXRPLTokens(ctx)
+					require.NoError(t, err)
+					_, err = cachingClient.GetPendingOperations(ctx)
+					require.NoError(t, err)
+				}
+			}
+
+			queryAll()
+			tt.write(t, contractClientMock, cachingClient)
+			queryAll()
+		})
+	}
+}
+
+func TestCachingContractClient_OperationsInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	contractClientMock := NewMockCacheableContractClient(ctrl)
+	metricRegistryMock := NewMockCachingContractClientMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter(gomock.Any(), gomock.Any()).AnyTimes()
+	cachingClient := coreum.NewCachingContractClient(coreum.CachingContractClientConfig{
+		ContractConfigTTL: time.Hour,
+		TokensTTL:         time.Hour,
+	}, contractClientMock, metricRegistryMock, time.Now)
+
+	trustSetOperation := coreum.Operation{
+		TicketSequence: 1,
+		OperationType: coreum.OperationType{
+			TrustSet: &coreum.OperationTypeTrustSet{Issuer: "issuer", Currency: "TKN"},
+		},
+	}
+	rotateKeysOperation := coreum.Operation{
+		TicketSequence: 2,
+		OperationType: coreum.OperationType{
+			RotateKeys: &coreum.OperationTypeRotateKeys{},
+		},
+	}
+	transferOperation := coreum.Operation{
+		TicketSequence: 3,
+		OperationType: coreum.OperationType{
+			CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{Issuer: "issuer", Currency: "TKN"},
+		},
+	}
+
+	queryStaticData := func() {
+		_, err := cachingClient.GetContractConfig(ctx)
+		require.NoError(t, err)
+		_, err = cachingClient.GetXRPLTokens(ctx)
+		require.NoError(t, err)
+		_, err = cachingClient.GetCoreumTokens(ctx)
+		require.NoError(t, err)
+	}
+
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{}, nil)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{}, nil)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{}, nil)
+	queryStaticData()
+
+	// the transfer operation doesn't change the static data
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{transferOperation}, nil)
+	_, err := cachingClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	queryStaticData()
+
+	// the new trust set operation drops the XRPL tokens
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).
+		Return([]coreum.Operation{transferOperation, trustSetOperation}, nil)
+	_, err = cachingClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{}, nil)
+	queryStaticData()
+
+	// the already observed trust set operation doesn't drop the tokens, and the new keys rotation operation drops the
+	// contract config
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).
+		Return([]coreum.Operation{trustSetOperation, rotateKeysOperation}, nil)
+	_, err = cachingClient.GetPendingOperations(ctx)
+	require.NoError(t, err)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{}, nil)
+	queryStaticData()
+
+	// the force refresh drops all static data
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{}, nil)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{}, nil)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{}, nil)
+	require.NoError(t, cachingClient.ForceRefresh(ctx))
+	queryStaticData()
+}
+
+func TestCachingContractClient_IsXRPLTokenRegistered(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	contractClientMock := NewMockCacheableContractClient(ctrl)
+	metricRegistryMock := NewMockCachingContractClientMetricRegistry(ctrl)
+	cachingClient := coreum.NewCachingContractClient(
+		coreum.DefaultCachingContractClientConfig(), contractClientMock, metricRegistryMock, clock,
+	)
+
+	bridgeXRPLAddress := "bridge"
+	contractConfig := coreum.ContractConfig{BridgeXRPLAddress: bridgeXRPLAddress}
+	xrplToken := coreum.XRPLToken{Issuer: "issuer", Currency: "TKN"}
+	coreumToken := coreum.CoreumToken{Denom: "ucore", XRPLCurrency: "CRE"}
+	newXRPLToken := coreum.XRPLToken{Issuer: "issuer", Currency: "NEW"}
+
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{xrplToken}, nil)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{coreumToken}, nil)
+	// the XRPL tokens are queried and then hit, and the contract config and Coreum tokens are queried
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter("xrpl_tokens", false)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter("xrpl_tokens", true)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter("contract_config", false)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter("coreum_tokens", false)
+
+	// the registered tokens are found in the cache
+	registered, err := cachingClient.IsXRPLTokenRegistered(ctx, xrplToken.Issuer, xrplToken.Currency)
+	require.NoError(t, err)
+	require.True(t, registered)
+	registered, err = cachingClient.IsXRPLTokenRegistered(ctx, bridgeXRPLAddress, coreumToken.XRPLCurrency)
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	// the token registered after the caching is found after the force refresh
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter(gomock.Any(), gomock.Any()).AnyTimes()
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{xrplToken, newXRPLToken}, nil)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{coreumToken}, nil)
+	registered, err = cachingClient.IsXRPLTokenRegistered(ctx, newXRPLToken.Issuer, newXRPLToken.Currency)
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	// the unknown token isn't registered even after the force refresh
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{xrplToken, newXRPLToken}, nil)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{coreumToken}, nil)
+	registered, err = cachingClient.IsXRPLTokenRegistered(ctx, bridgeXRPLAddress, "UNK")
+	require.NoError(t, err)
+	require.False(t, registered)
+
+	// the tokens are expired
+	now = now.Add(coreum.DefaultCachingContractClientConfig().TokensTTL)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{xrplToken}, nil)
+	registered, err = cachingClient.IsXRPLTokenRegistered(ctx, xrplToken.Issuer, xrplToken.Currency)
+	require.NoError(t, err)
+	require.True(t, registered)
+}
+
+func TestCachingContractClient_DisabledCaching(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	contractClientMock := NewMockCacheableContractClient(ctrl)
+	cachingClient := coreum.NewCachingContractClient(
+		coreum.CachingContractClientConfig{}, contractClientMock, NewMockCachingContractClientMetricRegistry(ctrl), time.Now,
+	)
+
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := cachingClient.GetPendingOperations(ctx)
+		require.NoError(t, err)
+	}
+}
+
+func TestCachingContractClient_GetPendingOperationsByType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	contractClientMock := NewMockCacheableContractClient(ctrl)
+	metricRegistryMock := NewMockCachingContractClientMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementCoreumContractCacheRequestCounter(gomock.Any(), gomock.Any()).AnyTimes()
+	cachingClient := coreum.NewCachingContractClient(coreum.CachingContractClientConfig{
+		PendingOperationsTTL: time.Minute,
+	}, contractClientMock, metricRegistryMock, time.Now)
+
+	operations := []coreum.Operation{
+		{
+			AccountSequence: 1,
+			OperationType: coreum.OperationType{
+				AllocateTickets: &coreum.OperationTypeAllocateTickets{Number: 10},
+			},
+		},
+		{
+			TicketSequence: 2,
+			OperationType: coreum.OperationType{
+				TrustSet: &coreum.OperationTypeTrustSet{Issuer: "issuer", Currency: "TKN"},
+			},
+		},
+		{
+			TicketSequence: 3,
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{Issuer: "issuer", Currency: "TKN"},
+			},
+		},
+		{
+			TicketSequence: 4,
+			OperationType: coreum.OperationType{
+				CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{Issuer: "issuer", Currency: "ABC"},
+			},
+		},
+	}
+	// all types are filtered from the single cached query
+	contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return(operations, nil)
+
+	expectedOperationIDs := map[coreum.OperationTypeEnum][]uint32{
+		coreum.OperationTypeEnumAllocateTickets:      {1},
+		coreum.OperationTypeEnumTrustSet:             {2},
+		coreum.OperationTypeEnumCoreumToXRPLTransfer: {3, 4},
+		coreum.OperationTypeEnumRotateKeys:           {},
+		coreum.OperationTypeEnumNFTokenTransfer:      {},
+	}
+	for opType, expectedIDs := range expectedOperationIDs {
+		typedOperations, err := cachingClient.GetPendingOperationsByType(ctx, opType)
+		require.NoError(t, err)
+		operationIDs := make([]uint32, 0, len(typedOperations))
+		for _, operation := range typedOperations {
+			require.Equal(t, opType, operation.GetOperationType())
+			operationIDs = append(operationIDs, operation.GetOperationID())
+		}
+		require.Equal(t, expectedIDs, operationIDs, opType)
+	}
+
+	_, err := cachingClient.GetPendingOperationsByType(ctx, coreum.OperationTypeEnumUnknown)
+	require.ErrorContains(t, err, "invalid operation type")
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -14,3 +15,42 @@ const (
 // MaxContractAmount is max coins amount you can use for the wasm coin type.
 // The value is ((2^128)-1) = 340282366920938463463374607431768211455.
 var MaxContractAmount = sdkmath.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(2), big.NewInt(128), nil)).SubRaw(1)
+
+// ErrAmountExceedsContractMax is error which indicates that the amount is greater than the MaxContractAmount, so it
+// can't be represented as the contract Uint128.
+var ErrAmountExceedsContractMax = errors.New("amount exceeds the contract max amount")
+
+// ValidateContractAmount returns the ErrAmountExceedsContractMax if the amount is greater than the MaxContractAmount.
+func ValidateContractAmount(amount sdkmath.Int) error {
+	if amount.IsNil() || amount.LTE(MaxContractAmount) {
+		return nil
+	}
+
+	return errors.Wrapf(ErrAmountExceedsContractMax, "amount:%s, max:%s", amount.String(), MaxContractAmount.String())
+}
+
+// ConvertAmountDecimals converts the amount from one decimals to another the same way the contract does. The
+// conversion is done with the big.Int, so the scaled amount which doesn't fit the contract Uint128 results in the
+// ErrAmountExceedsContractMax instead of the silent overflow.
+func ConvertAmountDecimals(amount sdkmath.Int, fromDecimals, toDecimals uint32) (sdkmath.Int, error) {
+	if err := ValidateContractAmount(amount); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	converted := amount.BigInt()
+	switch {
+	case fromDecimals < toDecimals:
+		converted.Mul(converted, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(toDecimals-fromDecimals)), nil))
+	case fromDecimals > toDecimals:
+		converted.Quo(converted, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(fromDecimals-toDecimals)), nil))
+	}
+	// the check is done before the sdkmath.Int conversion since the scaled amount might exceed its max bit length
+	if converted.Cmp(MaxContractAmount.BigInt()) > 0 {
+		return sdkmath.Int{}, errors.Wrapf(
+			ErrAmountExceedsContractMax,
+			"amount %s converted from %d to %d decimals is %s", amount.String(), fromDecimals, toDecimals, converted,
+		)
+	}
+
+	return sdkmath.NewIntFromBigInt(converted), nil
+}
@@ -0,0 +1,98 @@
+package coreum_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestValidateContractAmount(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, coreum.ValidateContractAmount(sdkmath.ZeroInt()))
+	require.NoError(t, coreum.ValidateContractAmount(coreum.MaxContractAmount))
+	require.ErrorIs(t, coreum.ValidateContractAmount(coreum.MaxContractAmount.AddRaw(1)), coreum.ErrAmountExceedsContractMax)
+}
+
+func TestConvertAmountDecimals(t *testing.T) {
+	t.Parallel()
+
+	// the max XRPL amount which can be scaled to the 20 decimals without the overflow
+	maxScalableTo20Decimals := coreum.MaxContractAmount.Quo(sdkmath.NewIntWithDecimal(1, 20-xrpl.XRPLIssuedTokenDecimals))
+
+	tests := []struct {
+		name         string
+		amount       sdkmath.Int
+		fromDecimals uint32
+		toDecimals   uint32
+		want         sdkmath.Int
+		wantErr      error
+	}{
+		{
+			name:         "same_decimals_max",
+			amount:       coreum.MaxContractAmount,
+			fromDecimals: 20,
+			toDecimals:   20,
+			want:         coreum.MaxContractAmount,
+		},
+		{
+			name:         "same_decimals_above_max",
+			amount:       coreum.MaxContractAmount.AddRaw(1),
+			fromDecimals: 20,
+			toDecimals:   20,
+			wantErr:      coreum.ErrAmountExceedsContractMax,
+		},
+		{
+			name:         "scale_down_max",
+			amount:       coreum.MaxContractAmount,
+			fromDecimals: 20,
+			toDecimals:   xrpl.XRPLIssuedTokenDecimals,
+			want:         maxScalableTo20Decimals,
+		},
+		{
+			name:         "scale_up_to_20_decimals_boundary",
+			amount:       maxScalableTo20Decimals,
+			fromDecimals: xrpl.XRPLIssuedTokenDecimals,
+			toDecimals:   20,
+			want:         maxScalableTo20Decimals.Mul(sdkmath.NewIntWithDecimal(1, 20-xrpl.XRPLIssuedTokenDecimals)),
+		},
+		{
+			name:         "scale_up_to_20_decimals_one_above_boundary",
+			amount:       maxScalableTo20Decimals.AddRaw(1),
+			fromDecimals: xrpl.XRPLIssuedTokenDecimals,
+			toDecimals:   20,
+			wantErr:      coreum.ErrAmountExceedsContractMax,
+		},
+		{
+			name:         "scale_up_to_20_decimals_max",
+			amount:       coreum.MaxContractAmount,
+			fromDecimals: xrpl.XRPLIssuedTokenDecimals,
+			toDecimals:   20,
+			wantErr:      coreum.ErrAmountExceedsContractMax,
+		},
+		{
+			name:         "scale_up_from_6_to_20_decimals_overflow",
+			amount:       coreum.MaxContractAmount.Quo(sdkmath.NewIntWithDecimal(1, 13)),
+			fromDecimals: 6,
+			toDecimals:   20,
+			wantErr:      coreum.ErrAmountExceedsContractMax,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := coreum.ConvertAmountDecimals(tt.amount, tt.fromDecimals, tt.toDecimals)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want.String(), got.String())
+		})
+	}
+}
@@ -3,8 +3,10 @@ package coreum
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,13 +14,21 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 
@@ -28,20 +38,36 @@ import (
 	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/buildinfo"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/tracing"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
 const (
 	contractLabel = "coreumbridge-xrpl"
+	// contractVersionStorageKey is the storage key of the cw2 contract version.
+	contractVersionStorageKey = "contract_info"
 	// RelayerCoreumMemoPrefix is memo prefix for the relayer transaction.
 	RelayerCoreumMemoPrefix = "Coreum XRPL bridge relayer version:"
-
-	eventAttributeAction           = "action"
-	eventAttributeHash             = "hash"
-	eventAttributeThresholdReached = "threshold_reached"
-	eventAttributeOperationID      = "operation_id"
-	eventValueSaveAction           = "save_evidence"
+	// RelayerCoreumMemoNoteSeparator separates the relayer version and the note in the memo of the relayer transaction.
+	RelayerCoreumMemoNoteSeparator = "|"
+	// MaxTxMemoNoteLength is the max length of the memo note, so the memo fits the default max memo length of the chain.
+	MaxTxMemoNoteLength = 160
+
+	eventAttributeAction            = "action"
+	eventAttributeHash              = "hash"
+	eventAttributeThresholdReached  = "threshold_reached"
+	eventAttributeOperationID       = "operation_id"
+	eventAttributeOperationUniqueID = "operation_unique_id"
+	eventAttributeRecipient         = "recipient"
+	eventAttributeSender            = "sender"
+	eventAttributeCoin              = "coin"
+	eventValueSaveAction            = "save_evidence"
+	eventValueSendToXRPLAction      = "send_to_xrpl"
 )
 
+// ErrBroadcastTimeout is returned if the tx isn't broadcast and included in a block within the broadcast timeout.
+var ErrBroadcastTimeout = errors.New("tx broadcast timeout")
+
 // ExecMethod is contract exec method.
 type ExecMethod string
 
@@ -57,6 +83,8 @@ const (
 	ExecRecoveryXRPLTokenRegistration ExecMethod = "recover_xrpl_token_registration"
 	ExecClaimRelayersFees             ExecMethod = "claim_relayer_fees"
 	ExecUpdateXRPLToken               ExecMethod = "update_xrpl_token"
+	ExecMigrateXRPLToken              ExecMethod = "migrate_xrpl_token"
+	ExecRemoveMigratedXRPLToken       ExecMethod = "remove_migrated_xrpl_token"
 	ExecUpdateCoreumToken             ExecMethod = "update_coreum_token"
 	ExecClaimRefund                   ExecMethod = "claim_refund"
 	ExecRotateKeys                    ExecMethod = "rotate_keys"
@@ -65,6 +93,7 @@ const (
 	ExecUpdateXRPLBaseFee             ExecMethod = "update_xrpl_base_fee"
 	ExecUpdateProhibitedXRPLAddresses ExecMethod = "update_prohibited_xrpl_addresses"
 	ExecCancelPendingOperation        ExecMethod = "cancel_pending_operation"
+	ExecClaimTreasuryFees             ExecMethod = "claim_treasury_fees"
 )
 
 // TransactionResult is transaction result.
@@ -88,6 +117,29 @@ const (
 	TokenStateInactive   TokenState = "inactive"
 )
 
+// XRPLTokenDeliveryModeType is the XRPL token delivery mode type.
+type XRPLTokenDeliveryModeType string
+
+// XRPLTokenDeliveryModeType values.
+const (
+	XRPLTokenDeliveryModeTypeAssetFT XRPLTokenDeliveryModeType = "assetft"
+	XRPLTokenDeliveryModeTypeIBC     XRPLTokenDeliveryModeType = "ibc"
+)
+
+// OperationTypeEnum is the operation type name as it's defined in the contract.
+type OperationTypeEnum string
+
+// OperationTypeEnum values.
+const (
+	OperationTypeEnumAllocateTickets      OperationTypeEnum = "allocate_tickets"
+	OperationTypeEnumTrustSet             OperationTypeEnum = "trust_set"
+	OperationTypeEnumCoreumToXRPLTransfer OperationTypeEnum = "coreum_to_xrpl_transfer"
+	OperationTypeEnumRotateKeys           OperationTypeEnum = "rotate_keys"
+	OperationTypeEnumNFTokenTransfer      OperationTypeEnum = "nftoken_transfer"
+	OperationTypeEnumCheckCash            OperationTypeEnum = "check_cash"
+	OperationTypeEnumUnknown              OperationTypeEnum = "unknown"
+)
+
 // BridgeState is bridge state.
 type BridgeState string
 
@@ -102,16 +154,20 @@ type QueryMethod string
 
 // QueryMethods.
 const (
-	QueryMethodConfig                  QueryMethod = "config"
-	QueryMethodOwnership               QueryMethod = "ownership"
-	QueryMethodXRPLTokens              QueryMethod = "xrpl_tokens"
-	QueryMethodFeesCollected           QueryMethod = "fees_collected"
-	QueryMethodCoreumTokens            QueryMethod = "coreum_tokens"
-	QueryMethodPendingOperations       QueryMethod = "pending_operations"
-	QueryMethodAvailableTickets        QueryMethod = "available_tickets"
-	QueryMethodPendingRefunds          QueryMethod = "pending_refunds"
-	QueryMethodTransactionEvidences    QueryMethod = "transaction_evidences"
-	QueryMethodProhibitedXRPLAddresses QueryMethod = "prohibited_xrpl_addresses"
+	QueryMethodConfig                   QueryMethod = "config"
+	QueryMethodOwnership                QueryMethod = "ownership"
+	QueryMethodXRPLTokens               QueryMethod = "xrpl_tokens"
+	QueryMethodMigratedXRPLTokens       QueryMethod = "migrated_xrpl_tokens"
+	QueryMethodFeesCollected            QueryMethod = "fees_collected"
+	QueryMethodTreasuryFeesCollected    QueryMethod = "treasury_fees_collected"
+	QueryMethodCoreumTokens             QueryMethod = "coreum_tokens"
+	QueryMethodPendingOperations        QueryMethod = "pending_operations"
+	QueryMethodAvailableTickets         QueryMethod = "available_tickets"
+	QueryMethodPendingRefunds           QueryMethod = "pending_refunds"
+	QueryMethodTransactionEvidences     QueryMethod = "transaction_evidences"
+	QueryMethodProhibitedXRPLAddresses  QueryMethod = "prohibited_xrpl_addresses"
+	QueryMethodRelayerEvidenceRateLimit QueryMethod = "relayer_evidence_rate_limit"
+	QueryMethodPendingIBCTransfers      QueryMethod = "pending_ibc_transfers"
 )
 
 // Relayer is the relayer information in the contract config.
@@ -121,16 +177,16 @@ type Relayer struct {
 	XRPLPubKey    string         `json:"xrpl_pub_key"`
 }
 
-// InstantiationConfig holds attributes used for the contract instantiation.
-type InstantiationConfig struct {
-	Owner                       sdk.AccAddress
-	Admin                       sdk.AccAddress
-	Relayers                    []Relayer
-	EvidenceThreshold           uint32
-	UsedTicketSequenceThreshold uint32
-	TrustSetLimitAmount         sdkmath.Int
-	BridgeXRPLAddress           string
-	XRPLBaseFee                 uint32
+// ContractVersion is the contract name and version set by the contract according to the cw2 specification.
+type ContractVersion struct {
+	Contract string `json:"contract"`
+	Version  string `json:"version"`
+}
+
+// NodeInfo is the Coreum node info.
+type NodeInfo struct {
+	ChainID string
+	Version string
 }
 
 // ContractConfig is contract config.
@@ -142,6 +198,20 @@ type ContractConfig struct {
 	BridgeXRPLAddress           string      `json:"bridge_xrpl_address"`
 	BridgeState                 BridgeState `json:"bridge_state"`
 	XRPLBaseFee                 uint32      `json:"xrpl_base_fee"`
+	// MaxXRPLTxFee is nil if the multi-signing transactions fee isn't limited.
+	MaxXRPLTxFee *uint64 `json:"max_xrpl_tx_fee,omitempty"`
+	SourceTag    *uint32 `json:"source_tag,omitempty"`
+	// MaxEvidencesPerRelayerPerBlock is nil if the evidences aren't limited.
+	MaxEvidencesPerRelayerPerBlock *uint32 `json:"max_evidences_per_relayer_per_block,omitempty"`
+	// TreasuryAddress is nil if the treasury fees are claimed to the owner.
+	TreasuryAddress sdk.AccAddress `json:"treasury_address,omitempty"`
+}
+
+// RelayerEvidenceRateLimit is the state of the relayer evidences limit in the block the query is executed at.
+type RelayerEvidenceRateLimit struct {
+	MaxEvidencesPerRelayerPerBlock *uint32 `json:"max_evidences_per_relayer_per_block"`
+	BlockHeight                    uint64  `json:"block_height"`
+	EvidencesCount                 uint32  `json:"evidences_count"`
 }
 
 // ContractOwnership is owner contract config.
@@ -159,6 +229,69 @@ type XRPLToken struct {
 	MaxHoldingAmount sdkmath.Int `json:"max_holding_amount"`
 	State            TokenState  `json:"state"`
 	BridgingFee      sdkmath.Int `json:"bridging_fee"`
+	// MaxSendsPerAddressPerDay is the limit of the transfers to XRPL an address can do per day, nil if not limited.
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
+	// TreasuryFee is the part of the bridging fee kept for the treasury, capped by it, nil if not charged.
+	TreasuryFee *sdkmath.Int `json:"treasury_fee,omitempty"`
+	// DeliveryMode is the delivery mode of the bridged tokens, nil means the AssetFT delivery.
+	DeliveryMode *XRPLTokenDeliveryMode `json:"delivery_mode,omitempty"`
+}
+
+// XRPLTokenDeliveryMode is the way the tokens bridged from XRPL are delivered to the recipient. In the IBC mode the
+// tokens are transferred over the IBC channel to the recipient on the IBC-connected chain provided in the XRPL memo.
+type XRPLTokenDeliveryMode struct {
+	Type                      XRPLTokenDeliveryModeType
+	IBCChannelID              string
+	IBCTransferTimeoutSeconds uint64
+}
+
+type xrplTokenIBCDeliveryMode struct {
+	IBCChannelID              string `json:"ibc_channel_id"`
+	IBCTransferTimeoutSeconds uint64 `json:"ibc_transfer_timeout_seconds"`
+}
+
+// MarshalJSON encodes the delivery mode as the contract enum, which is the plain string for the AssetFT mode and the
+// object for the IBC mode.
+func (m XRPLTokenDeliveryMode) MarshalJSON() ([]byte, error) {
+	switch m.Type {
+	case XRPLTokenDeliveryModeTypeAssetFT:
+		return json.Marshal(m.Type)
+	case XRPLTokenDeliveryModeTypeIBC:
+		return json.Marshal(map[XRPLTokenDeliveryModeType]xrplTokenIBCDeliveryMode{
+			XRPLTokenDeliveryModeTypeIBC: {
+				IBCChannelID:              m.IBCChannelID,
+				IBCTransferTimeoutSeconds: m.IBCTransferTimeoutSeconds,
+			},
+		})
+	default:
+		return nil, errors.Errorf("unknown XRPL token delivery mode type:%s", m.Type)
+	}
+}
+
+// UnmarshalJSON decodes the delivery mode from the contract enum.
+func (m *XRPLTokenDeliveryMode) UnmarshalJSON(data []byte) error {
+	var modeType XRPLTokenDeliveryModeType
+	if err := json.Unmarshal(data, &modeType); err == nil {
+		*m = XRPLTokenDeliveryMode{
+			Type: modeType,
+		}
+		return nil
+	}
+	var modes map[XRPLTokenDeliveryModeType]xrplTokenIBCDeliveryMode
+	if err := json.Unmarshal(data, &modes); err != nil {
+		return errors.Wrapf(err, "failed to decode XRPL token delivery mode, data:%s", string(data))
+	}
+	ibcMode, ok := modes[XRPLTokenDeliveryModeTypeIBC]
+	if !ok || len(modes) != 1 {
+		return errors.Errorf("unknown XRPL token delivery mode, data:%s", string(data))
+	}
+	*m = XRPLTokenDeliveryMode{
+		Type:                      XRPLTokenDeliveryModeTypeIBC,
+		IBCChannelID:              ibcMode.IBCChannelID,
+		IBCTransferTimeoutSeconds: ibcMode.IBCTransferTimeoutSeconds,
+	}
+
+	return nil
 }
 
 // CoreumToken is coreum token registered on the contract.
@@ -172,6 +305,10 @@ type CoreumToken struct {
 	MaxHoldingAmount sdkmath.Int `json:"max_holding_amount"`
 	State            TokenState  `json:"state"`
 	BridgingFee      sdkmath.Int `json:"bridging_fee"`
+	// MaxSendsPerAddressPerDay is the limit of the transfers to XRPL an address can do per day, nil if not limited.
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
+	// TreasuryFee is the part of the bridging fee kept for the treasury, capped by it, nil if not charged.
+	TreasuryFee *sdkmath.Int `json:"treasury_fee,omitempty"`
 }
 
 // XRPLToCoreumTransferEvidence is evidence with values represented sending from XRPL to coreum.
@@ -181,6 +318,36 @@ type XRPLToCoreumTransferEvidence struct {
 	Currency  string         `json:"currency"`
 	Amount    sdkmath.Int    `json:"amount"`
 	Recipient sdk.AccAddress `json:"recipient"`
+	// DestinationChainRecipient is the recipient on the IBC-connected chain of the token with the IBC delivery mode,
+	// empty means the tokens are minted to the Recipient.
+	DestinationChainRecipient string `json:"destination_chain_recipient,omitempty"`
+}
+
+// XRPLNFTokenTransferEvidence is evidence of the XRPL NFToken sell offer created for the bridge account.
+type XRPLNFTokenTransferEvidence struct {
+	TxHash      string         `json:"tx_hash"`
+	NFTokenID   string         `json:"nftoken_id"`
+	SellOfferID string         `json:"sell_offer_id"`
+	Sender      string         `json:"sender"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+}
+
+// XRPLCheckCashEvidence is evidence of the XRPL Check created for the bridge account.
+type XRPLCheckCashEvidence struct {
+	TxHash    string         `json:"tx_hash"`
+	CheckID   string         `json:"check_id"`
+	Issuer    string         `json:"issuer"`
+	Currency  string         `json:"currency"`
+	Amount    sdkmath.Int    `json:"amount"`
+	Sender    string         `json:"sender"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// IBCTransferResultEvidence is evidence of the acknowledgement or timeout of the IBC transfer sent by the XRPL to
+// Coreum transfer of the token with the IBC delivery mode.
+type IBCTransferResultEvidence struct {
+	TxHash  string `json:"tx_hash"`
+	Success bool   `json:"success"`
 }
 
 // XRPLTransactionResultEvidence is type which contains common transaction result data.
@@ -213,6 +380,16 @@ type XRPLTransactionResultKeysRotationEvidence struct {
 	XRPLTransactionResultEvidence
 }
 
+// XRPLTransactionResultNFTokenTransferEvidence is evidence of the NFToken sell offer acceptance by the bridge account.
+type XRPLTransactionResultNFTokenTransferEvidence struct {
+	XRPLTransactionResultEvidence
+}
+
+// XRPLTransactionResultCheckCashEvidence is evidence of the Check cashing by the bridge account.
+type XRPLTransactionResultCheckCashEvidence struct {
+	XRPLTransactionResultEvidence
+}
+
 // Signature is a pair of the relayer provided the signature and signature string.
 type Signature struct {
 	RelayerCoreumAddress sdk.AccAddress `json:"relayer_coreum_address"`
@@ -246,12 +423,31 @@ type OperationTypeRotateKeys struct {
 	NewEvidenceThreshold int       `json:"new_evidence_threshold"`
 }
 
+// OperationTypeNFTokenTransfer is XRPL NFToken sell offer acceptance operation type.
+type OperationTypeNFTokenTransfer struct {
+	NFTokenID   string `json:"nftoken_id"`
+	SellOfferID string `json:"sell_offer_id"`
+	Sender      string `json:"sender"`
+	Recipient   string `json:"recipient"`
+}
+
+// OperationTypeCheckCash is XRPL Check cashing operation type.
+type OperationTypeCheckCash struct {
+	CheckID   string      `json:"check_id"`
+	Issuer    string      `json:"issuer"`
+	Currency  string      `json:"currency"`
+	Amount    sdkmath.Int `json:"amount"`
+	Recipient string      `json:"recipient"`
+}
+
 // OperationType is operation type.
 type OperationType struct {
 	AllocateTickets      *OperationTypeAllocateTickets      `json:"allocate_tickets,omitempty"`
 	TrustSet             *OperationTypeTrustSet             `json:"trust_set,omitempty"`
 	CoreumToXRPLTransfer *OperationTypeCoreumToXRPLTransfer `json:"coreum_to_xrpl_transfer,omitempty"`
 	RotateKeys           *OperationTypeRotateKeys           `json:"rotate_keys,omitempty"`
+	NFTokenTransfer      *OperationTypeNFTokenTransfer      `json:"nftoken_transfer,omitempty"`
+	CheckCash            *OperationTypeCheckCash            `json:"check_cash,omitempty"`
 }
 
 // Operation is contract operation which should be signed and executed.
@@ -273,6 +469,38 @@ func (o Operation) GetOperationID() uint32 {
 	return o.AccountSequence
 }
 
+// GetOperationType returns the operation type.
+func (o Operation) GetOperationType() OperationTypeEnum {
+	switch {
+	case o.OperationType.AllocateTickets != nil:
+		return OperationTypeEnumAllocateTickets
+	case o.OperationType.TrustSet != nil:
+		return OperationTypeEnumTrustSet
+	case o.OperationType.CoreumToXRPLTransfer != nil:
+		return OperationTypeEnumCoreumToXRPLTransfer
+	case o.OperationType.RotateKeys != nil:
+		return OperationTypeEnumRotateKeys
+	case o.OperationType.NFTokenTransfer != nil:
+		return OperationTypeEnumNFTokenTransfer
+	case o.OperationType.CheckCash != nil:
+		return OperationTypeEnumCheckCash
+	default:
+		return OperationTypeEnumUnknown
+	}
+}
+
+// GetOperationTypeName returns the operation type name as it's defined in the contract.
+func (o Operation) GetOperationTypeName() string {
+	return string(o.GetOperationType())
+}
+
+// FilterOperationsByType returns the operations of the provided type.
+func FilterOperationsByType(operations []Operation, opType OperationTypeEnum) []Operation {
+	return lo.Filter(operations, func(operation Operation, _ int) bool {
+		return operation.GetOperationType() == opType
+	})
+}
+
 // SendToXRPLRequest defines single request to send from coreum to XRPL.
 type SendToXRPLRequest struct {
 	Recipient     string       `json:"recipient"`
@@ -280,6 +508,14 @@ type SendToXRPLRequest struct {
 	Amount        sdk.Coin     `json:"-"`
 }
 
+// SendToXRPLOperationID is the identifier of the pending operation created by the send to XRPL request.
+type SendToXRPLOperationID struct {
+	// OperationID is the ticket sequence of the operation.
+	OperationID uint32
+	// OperationUniqueID is the ID which isn't reused by the later operations with the same ticket sequence.
+	OperationUniqueID string
+}
+
 // SaveSignatureRequest defines single request to save relayer signature.
 type SaveSignatureRequest struct {
 	OperationID      uint32
@@ -287,6 +523,61 @@ type SaveSignatureRequest struct {
 	Signature        string
 }
 
+// XRPLTokenRegistrationRequest defines single request to register XRPL token.
+type XRPLTokenRegistrationRequest struct {
+	Issuer           string      `json:"issuer"`
+	Currency         string      `json:"currency"`
+	SendingPrecision int32       `json:"sending_precision"`
+	MaxHoldingAmount sdkmath.Int `json:"max_holding_amount"`
+	BridgingFee      sdkmath.Int `json:"bridging_fee"`
+	// the field is omitted when not set to stay compatible with the contracts deployed before its introduction
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
+	// the field is omitted when not set to stay compatible with the contracts deployed before its introduction
+	DeliveryMode *XRPLTokenDeliveryMode `json:"delivery_mode,omitempty"`
+}
+
+// SignatureProgress is the relayers signing progress of the pending operation.
+type SignatureProgress struct {
+	Operation         Operation `json:"operation"`
+	SignedRelayers    []Relayer `json:"signed_relayers"`
+	UnsignedRelayers  []Relayer `json:"unsigned_relayers"`
+	EvidenceThreshold uint32    `json:"evidence_threshold"`
+	// ThresholdPercentage is the percentage of the evidence threshold reached by the signatures, capped at 100.
+	ThresholdPercentage float64 `json:"threshold_percentage"`
+}
+
+// NewSignatureProgress returns the signing progress of the operation by the contract relayers. The signatures of the
+// relayers which aren't in the contract config anymore aren't counted.
+func NewSignatureProgress(contractConfig ContractConfig, operation Operation) SignatureProgress {
+	signedRelayers := make([]Relayer, 0)
+	unsignedRelayers := make([]Relayer, 0)
+	for _, relayer := range contractConfig.Relayers {
+		signed := lo.ContainsBy(operation.Signatures, func(signature Signature) bool {
+			return signature.RelayerCoreumAddress.Equals(relayer.CoreumAddress)
+		})
+		if signed {
+			signedRelayers = append(signedRelayers, relayer)
+		} else {
+			unsignedRelayers = append(unsignedRelayers, relayer)
+		}
+	}
+	thresholdPercentage := float64(100)
+	if contractConfig.EvidenceThreshold != 0 {
+		thresholdPercentage = min(
+			thresholdPercentage,
+			float64(len(signedRelayers))*100/float64(contractConfig.EvidenceThreshold),
+		)
+	}
+
+	return SignatureProgress{
+		Operation:           operation,
+		SignedRelayers:      signedRelayers,
+		UnsignedRelayers:    unsignedRelayers,
+		EvidenceThreshold:   contractConfig.EvidenceThreshold,
+		ThresholdPercentage: thresholdPercentage,
+	}
+}
+
 // PendingRefund holds the pending refund information.
 type PendingRefund struct {
 	ID         string   `json:"id"`
@@ -294,6 +585,15 @@ type PendingRefund struct {
 	XRPLTxHash string   `json:"xrpl_tx_hash"`
 }
 
+// PendingIBCTransfer is the IBC transfer of the XRPL to Coreum transfer waiting for its result evidence.
+type PendingIBCTransfer struct {
+	TxHash                    string         `json:"tx_hash"`
+	Recipient                 sdk.AccAddress `json:"recipient"`
+	IBCChannelID              string         `json:"ibc_channel_id"`
+	DestinationChainRecipient string         `json:"destination_chain_recipient"`
+	Coin                      sdk.Coin       `json:"coin"`
+}
+
 // TransactionEvidence is the transaction evidence.
 type TransactionEvidence struct {
 	Hash             string           `json:"hash"`
@@ -306,6 +606,28 @@ type DataToTx[T any] struct {
 	Tx       *sdk.TxResponse
 }
 
+// TransfersFilter is the filter of the contract transfer txs, zero values are ignored.
+type TransfersFilter struct {
+	// StartHeight is the inclusive lower bound of the tx height.
+	StartHeight int64
+	// EndHeight is the inclusive upper bound of the tx height.
+	EndHeight int64
+	Recipient string
+}
+
+// CoreumToXRPLTransfer is the Coreum to XRPL transfer executed in the contract.
+//
+//nolint:revive //kept for the better naming convention.
+type CoreumToXRPLTransfer struct {
+	Sender  sdk.AccAddress
+	Request SendToXRPLRequest
+	// OperationIDs are the IDs of the operations created for the transfer.
+	OperationIDs []uint32
+	// ResultEvidences are the XRPL transaction result evidences which reached the threshold.
+	ResultEvidences []XRPLTransactionResultEvidence
+	Tx              *sdk.TxResponse
+}
+
 // XRPLToCoreumTracingInfo is XRPL to Coreum tracing info.
 type XRPLToCoreumTracingInfo struct {
 	CoreumTx      *sdk.TxResponse
@@ -342,6 +664,14 @@ type instantiateRequest struct {
 	TrustSetLimitAmount         sdkmath.Int    `json:"trust_set_limit_amount"`
 	BridgeXRPLAddress           string         `json:"bridge_xrpl_address"`
 	XRPLBaseFee                 uint32         `json:"xrpl_base_fee"`
+	// the field is omitted if empty to keep the request compatible with the contracts without the max fee
+	MaxXRPLTxFee *uint64 `json:"max_xrpl_tx_fee,omitempty"`
+	// the field is omitted if empty to keep the request compatible with the contracts without the source tag
+	SourceTag *uint32 `json:"source_tag,omitempty"`
+	// the field is omitted if empty to keep the request compatible with the contracts without the evidences limit
+	MaxEvidencesPerRelayerPerBlock *uint32 `json:"max_evidences_per_relayer_per_block,omitempty"`
+	// the field is omitted if empty to keep the request compatible with the contracts without the treasury
+	TreasuryAddress sdk.AccAddress `json:"treasury_address,omitempty"`
 }
 
 type transferOwnershipRequest struct {
@@ -356,14 +686,8 @@ type registerCoreumTokenRequest struct {
 	SendingPrecision int32       `json:"sending_precision"`
 	MaxHoldingAmount sdkmath.Int `json:"max_holding_amount"`
 	BridgingFee      sdkmath.Int `json:"bridging_fee"`
-}
-
-type registerXRPLTokenRequest struct {
-	Issuer           string      `json:"issuer"`
-	Currency         string      `json:"currency"`
-	SendingPrecision int32       `json:"sending_precision"`
-	MaxHoldingAmount sdkmath.Int `json:"max_holding_amount"`
-	BridgingFee      sdkmath.Int `json:"bridging_fee"`
+	// the field is omitted when not set to stay compatible with the contracts deployed before its introduction
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
 }
 
 type recoverTicketsRequest struct {
@@ -398,6 +722,22 @@ type updateXRPLTokenRequest struct {
 	SendingPrecision *int32       `json:"sending_precision,omitempty"`
 	MaxHoldingAmount *sdkmath.Int `json:"max_holding_amount,omitempty"`
 	BridgingFee      *sdkmath.Int `json:"bridging_fee,omitempty"`
+	// zero removes the limit
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
+	// zero removes the treasury fee
+	TreasuryFee *sdkmath.Int `json:"treasury_fee,omitempty"`
+}
+
+type migrateXRPLTokenRequest struct {
+	OldIssuer   string `json:"old_issuer"`
+	OldCurrency string `json:"old_currency"`
+	NewIssuer   string `json:"new_issuer"`
+	NewCurrency string `json:"new_currency"`
+}
+
+type removeMigratedXRPLTokenRequest struct {
+	Issuer   string `json:"issuer"`
+	Currency string `json:"currency"`
 }
 
 type updateCoreumTokenRequest struct {
@@ -406,6 +746,10 @@ type updateCoreumTokenRequest struct {
 	SendingPrecision *int32       `json:"sending_precision,omitempty"`
 	MaxHoldingAmount *sdkmath.Int `json:"max_holding_amount,omitempty"`
 	BridgingFee      *sdkmath.Int `json:"bridging_fee,omitempty"`
+	// zero removes the limit
+	MaxSendsPerAddressPerDay *uint32 `json:"max_sends_per_address_per_day,omitempty"`
+	// zero removes the treasury fee
+	TreasuryFee *sdkmath.Int `json:"treasury_fee,omitempty"`
 }
 
 type claimRefundRequest struct {
@@ -440,6 +784,9 @@ type xrplTransactionResultEvidence struct {
 type evidence struct {
 	XRPLToCoreumTransfer  *XRPLToCoreumTransferEvidence  `json:"xrpl_to_coreum_transfer,omitempty"`
 	XRPLTransactionResult *xrplTransactionResultEvidence `json:"xrpl_transaction_result,omitempty"`
+	XRPLNFTokenTransfer   *XRPLNFTokenTransferEvidence   `json:"xrpl_nftoken_transfer,omitempty"`
+	XRPLCheckCash         *XRPLCheckCashEvidence         `json:"xrpl_check_cash,omitempty"`
+	IBCTransferResult     *IBCTransferResultEvidence     `json:"ibc_transfer_result,omitempty"`
 }
 
 type xrplTokensResponse struct {
@@ -476,6 +823,11 @@ type pendingRefundsResponse struct {
 	PendingRefunds []PendingRefund `json:"pending_refunds"`
 }
 
+type pendingIBCTransfersResponse struct {
+	LastKey             string               `json:"last_key"`
+	PendingIBCTransfers []PendingIBCTransfer `json:"pending_ibc_transfers"`
+}
+
 type transactionEvidencesResponse struct {
 	LastKey              string                `json:"last_key"`
 	TransactionEvidences []TransactionEvidence `json:"transaction_evidences"`
@@ -511,6 +863,16 @@ type ContractClientConfig struct {
 	OutOfGasRetryDelay    time.Duration
 	OutOfGasRetryAttempts uint32
 	TxsQueryPageLimit     uint32
+	// TxBroadcastTimeout limits the time of each tx broadcast including the inclusion awaiting, zero disables it.
+	TxBroadcastTimeout time.Duration
+	// ShutdownTimeout is the time the started tx broadcast is allowed to complete after the context cancellation, so
+	// the relayer shutdown doesn't interrupt the tx awaiting. Zero cancels the broadcast together with the context.
+	ShutdownTimeout time.Duration
+	// GasPrices are the fixed gas prices of the txs, the chain min gas price adjusted by the GasPriceAdjustment is
+	// used if empty.
+	GasPrices sdk.DecCoins
+	// FeeGranter is the account which pays the fees of the txs, the sender pays the fees if empty.
+	FeeGranter sdk.AccAddress
 }
 
 // DefaultContractClientConfig returns default ContractClient config.
@@ -523,33 +885,65 @@ func DefaultContractClientConfig(contractAddress sdk.AccAddress) ContractClientC
 		OutOfGasRetryDelay:    500 * time.Millisecond,
 		OutOfGasRetryAttempts: 5,
 		TxsQueryPageLimit:     1000,
+		TxBroadcastTimeout:    time.Minute,
 	}
 }
 
+//go:generate mockgen -destination=contract_mocks_test.go -package=coreum_test . ContractClientMetricRegistry
+
+// ContractClientMetricRegistry is the ContractClient metric registry.
+type ContractClientMetricRegistry interface {
+	ObserveCoreumContractTxGasUsed(operationType string, gasUsed float64)
+}
+
+// KeyUsageAuditLogger records the txs broadcast with the relayer keys.
+type KeyUsageAuditLogger interface {
+	LogCoreumBroadcast(txHash string, payloadDigest []byte)
+}
+
+type txMemoNoteKey struct{}
+
 // ContractClient is the bridge contract client.
 type ContractClient struct {
 	cfg                ContractClientConfig
 	log                logger.Logger
+	metricRegistry     ContractClientMetricRegistry
+	auditLogger        KeyUsageAuditLogger
 	clientCtx          client.Context
 	wasmClient         wasmtypes.QueryClient
 	assetftClient      assetfttypes.QueryClient
 	cometServiceClient sdktxtypes.ServiceClient
+	// broadcastTxFn is replaced in the unit tests to capture the broadcast messages without the chain.
+	broadcastTxFn func(ctx context.Context, clientCtx client.Context, txf client.Factory, msgs ...sdk.Msg) (
+		*sdk.TxResponse, error,
+	)
 
 	execMu sync.Mutex
 }
 
-// NewContractClient returns a new instance of the ContractClient.
-func NewContractClient(cfg ContractClientConfig, log logger.Logger, clientCtx client.Context) *ContractClient {
+// NewContractClient returns a new instance of the ContractClient. The metricRegistry is optional, the gas used by
+// the broadcast txs isn't recorded if it's nil. If the auditLogger is provided, each broadcast tx is recorded with it.
+func NewContractClient(
+	cfg ContractClientConfig,
+	log logger.Logger,
+	clientCtx client.Context,
+	metricRegistry ContractClientMetricRegistry,
+	auditLogger KeyUsageAuditLogger,
+) *ContractClient {
 	return &ContractClient{
-		cfg: cfg,
-		log: log,
+		cfg:            cfg,
+		log:            log,
+		metricRegistry: metricRegistry,
+		auditLogger:    auditLogger,
 		clientCtx: clientCtx.
 			WithBroadcastMode(flags.BroadcastSync).
 			WithAwaitTx(true).WithGasPriceAdjustment(cfg.GasPriceAdjustment).
-			WithGasAdjustment(cfg.GasAdjustment),
+			WithGasAdjustment(cfg.GasAdjustment).
+			WithFeeGranterAddress(cfg.FeeGranter),
 		wasmClient:         wasmtypes.NewQueryClient(clientCtx),
 		assetftClient:      assetfttypes.NewQueryClient(clientCtx),
 		cometServiceClient: sdktxtypes.NewServiceClient(clientCtx),
+		broadcastTxFn:      broadcastTxWithGasPrices,
 
 		execMu: sync.Mutex{},
 	}
@@ -567,14 +961,28 @@ func (c *ContractClient) DeployAndInstantiate(
 		return nil, err
 	}
 
+	return c.InstantiateContract(ctx, sender, codeID, config)
+}
+
+// InstantiateContract instantiates the contract from the deployed bytecode.
+func (c *ContractClient) InstantiateContract(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	codeID uint64,
+	config InstantiationConfig,
+) (sdk.AccAddress, error) {
 	reqPayload, err := json.Marshal(instantiateRequest{
-		Owner:                       config.Owner,
-		Relayers:                    config.Relayers,
-		EvidenceThreshold:           config.EvidenceThreshold,
-		UsedTicketSequenceThreshold: config.UsedTicketSequenceThreshold,
-		TrustSetLimitAmount:         config.TrustSetLimitAmount,
-		BridgeXRPLAddress:           config.BridgeXRPLAddress,
-		XRPLBaseFee:                 config.XRPLBaseFee,
+		Owner:                          config.Owner,
+		Relayers:                       config.Relayers,
+		EvidenceThreshold:              config.EvidenceThreshold,
+		UsedTicketSequenceThreshold:    config.UsedTicketSequenceThreshold,
+		TrustSetLimitAmount:            config.TrustSetLimitAmount,
+		BridgeXRPLAddress:              config.BridgeXRPLAddress,
+		XRPLBaseFee:                    config.XRPLBaseFee,
+		MaxXRPLTxFee:                   config.MaxXRPLTxFee,
+		SourceTag:                      config.SourceTag,
+		MaxEvidencesPerRelayerPerBlock: config.MaxEvidencesPerRelayerPerBlock,
+		TreasuryAddress:                config.TreasuryAddress,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to marshal instantiate payload")
@@ -596,7 +1004,7 @@ func (c *ContractClient) DeployAndInstantiate(
 	}
 
 	c.log.Info(ctx, "Instantiating contract.", zap.Any("msg", msg))
-	res, err := client.BroadcastTx(ctx, c.clientCtx.WithFromAddress(sender), c.getTxFactory(), msg)
+	res, err := c.broadcastTx(ctx, c.clientCtx.WithFromAddress(sender), msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to deploy bytecode")
 	}
@@ -629,7 +1037,7 @@ func (c *ContractClient) DeployContract(
 	}
 	c.log.Info(ctx, "Deploying contract bytecode.")
 
-	txRes, err := client.BroadcastTx(ctx, c.clientCtx.WithFromAddress(sender), c.getTxFactory(), msgStoreCode)
+	txRes, err := c.broadcastTx(ctx, c.clientCtx.WithFromAddress(sender), msgStoreCode)
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed to deploy wasm bytecode")
 	}
@@ -646,6 +1054,56 @@ func (c *ContractClient) DeployContract(
 	return txRes, codeID, nil
 }
 
+// IsContractCodeStored returns true if the contract bytecode with the code ID is stored.
+func (c *ContractClient) IsContractCodeStored(ctx context.Context, codeID uint64) (bool, error) {
+	if _, err := c.wasmClient.Code(ctx, &wasmtypes.QueryCodeRequest{
+		CodeId: codeID,
+	}); err != nil {
+		if isError(err, "no such code") {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get contract code, codeID:%d", codeID)
+	}
+
+	return true, nil
+}
+
+// GetContractCodeID returns the code ID the contract is instantiated from.
+func (c *ContractClient) GetContractCodeID(ctx context.Context, contractAddress sdk.AccAddress) (uint64, error) {
+	res, err := c.wasmClient.ContractInfo(ctx, &wasmtypes.QueryContractInfoRequest{
+		Address: contractAddress.String(),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get contract info, address:%s", contractAddress.String())
+	}
+
+	return res.CodeID, nil
+}
+
+// GetContractVersion returns the cw2 version of the contract.
+func (c *ContractClient) GetContractVersion(ctx context.Context) (ContractVersion, error) {
+	res, err := c.wasmClient.RawContractState(ctx, &wasmtypes.QueryRawContractStateRequest{
+		Address:   c.cfg.ContractAddress.String(),
+		QueryData: []byte(contractVersionStorageKey),
+	})
+	if err != nil {
+		return ContractVersion{}, errors.Wrapf(
+			err, "failed to get contract version, address:%s", c.cfg.ContractAddress.String(),
+		)
+	}
+	if len(res.Data) == 0 {
+		return ContractVersion{}, errors.Errorf(
+			"contract version is not set, address:%s", c.cfg.ContractAddress.String(),
+		)
+	}
+	var version ContractVersion
+	if err := json.Unmarshal(res.Data, &version); err != nil {
+		return ContractVersion{}, errors.Wrapf(err, "failed to unmarshal contract version: %s", string(res.Data))
+	}
+
+	return version, nil
+}
+
 // MigrateContract calls the executes the contract migration.
 func (c *ContractClient) MigrateContract(
 	ctx context.Context,
@@ -659,7 +1117,7 @@ func (c *ContractClient) MigrateContract(
 		Msg:      []byte("{}"),
 	}
 
-	txRes, err := client.BroadcastTx(ctx, c.clientCtx.WithFromAddress(sender), c.getTxFactory(), msgMigrate)
+	txRes, err := c.broadcastTx(ctx, c.clientCtx.WithFromAddress(sender), msgMigrate)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to migrate contract, codeID:%d", codeID)
 	}
@@ -733,15 +1191,17 @@ func (c *ContractClient) RegisterCoreumToken(
 	sendingPrecision int32,
 	maxHoldingAmount sdkmath.Int,
 	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
 ) (*sdk.TxResponse, error) {
 	txRes, err := c.execute(ctx, sender, execRequest{
 		Body: map[ExecMethod]registerCoreumTokenRequest{
 			ExecMethodRegisterCoreumToken: {
-				Denom:            denom,
-				Decimals:         decimals,
-				SendingPrecision: sendingPrecision,
-				MaxHoldingAmount: maxHoldingAmount,
-				BridgingFee:      bridgingFee,
+				Denom:                    denom,
+				Decimals:                 decimals,
+				SendingPrecision:         sendingPrecision,
+				MaxHoldingAmount:         maxHoldingAmount,
+				BridgingFee:              bridgingFee,
+				MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
 			},
 		},
 	})
@@ -760,6 +1220,8 @@ func (c *ContractClient) RegisterXRPLToken(
 	sendingPrecision int32,
 	maxHoldingAmount sdkmath.Int,
 	bridgingFee sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	deliveryMode *XRPLTokenDeliveryMode,
 ) (*sdk.TxResponse, error) {
 	fee, err := c.queryAssetFTIssueFee(ctx)
 	if err != nil {
@@ -767,13 +1229,15 @@ func (c *ContractClient) RegisterXRPLToken(
 	}
 
 	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]registerXRPLTokenRequest{
+		Body: map[ExecMethod]XRPLTokenRegistrationRequest{
 			ExecMethodRegisterXRPLToken: {
-				Issuer:           issuer,
-				Currency:         currency,
-				SendingPrecision: sendingPrecision,
-				MaxHoldingAmount: maxHoldingAmount,
-				BridgingFee:      bridgingFee,
+				Issuer:                   issuer,
+				Currency:                 currency,
+				SendingPrecision:         sendingPrecision,
+				MaxHoldingAmount:         maxHoldingAmount,
+				BridgingFee:              bridgingFee,
+				MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
+				DeliveryMode:             deliveryMode,
 			},
 		},
 		Funds: sdk.NewCoins(fee),
@@ -785,6 +1249,38 @@ func (c *ContractClient) RegisterXRPLToken(
 	return txRes, nil
 }
 
+// RegisterXRPLTokenBatch executes `register_xrpl_token` methods for all requests in one transaction, so either all or
+// none of the tokens are registered. Each registration is funded with the asset FT issue fee.
+func (c *ContractClient) RegisterXRPLTokenBatch(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	requests ...XRPLTokenRegistrationRequest,
+) (*sdk.TxResponse, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("at least one XRPL token registration request is required")
+	}
+	fee, err := c.queryAssetFTIssueFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	execRequests := make([]execRequest, 0, len(requests))
+	for _, req := range requests {
+		execRequests = append(execRequests, execRequest{
+			Body: map[ExecMethod]XRPLTokenRegistrationRequest{
+				ExecMethodRegisterXRPLToken: req,
+			},
+			Funds: sdk.NewCoins(fee),
+		})
+	}
+	txRes, err := c.execute(ctx, sender, execRequests...)
+	if err != nil {
+		return nil, err
+	}
+
+	return txRes, nil
+}
+
 // SendXRPLToCoreumTransferEvidence sends an Evidence of an accepted XRPL to coreum transfer transaction.
 func (c *ContractClient) SendXRPLToCoreumTransferEvidence(
 	ctx context.Context,
@@ -796,16 +1292,50 @@ func (c *ContractClient) SendXRPLToCoreumTransferEvidence(
 			XRPLToCoreumTransfer: &evd,
 		},
 	}
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]SaveEvidenceRequest{
-			ExecMethodSaveEvidence: req,
+	return c.saveEvidence(ctx, sender, req)
+}
+
+// SendXRPLNFTokenTransferEvidence sends an Evidence of the XRPL NFToken sell offer created for the bridge account. The
+// evidence isn't supported by the current contract yet, so it's sent only if the NFToken bridging is enabled.
+func (c *ContractClient) SendXRPLNFTokenTransferEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLNFTokenTransferEvidence,
+) (*sdk.TxResponse, error) {
+	req := SaveEvidenceRequest{
+		Evidence: evidence{
+			XRPLNFTokenTransfer: &evd,
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
+	return c.saveEvidence(ctx, sender, req)
+}
 
-	return txRes, nil
+// SendXRPLCheckCashEvidence sends an Evidence of the XRPL Check created for the bridge account.
+func (c *ContractClient) SendXRPLCheckCashEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLCheckCashEvidence,
+) (*sdk.TxResponse, error) {
+	req := SaveEvidenceRequest{
+		Evidence: evidence{
+			XRPLCheckCash: &evd,
+		},
+	}
+	return c.saveEvidence(ctx, sender, req)
+}
+
+// SendIBCTransferResultEvidence sends an Evidence of the acknowledged or timed out IBC transfer.
+func (c *ContractClient) SendIBCTransferResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd IBCTransferResultEvidence,
+) (*sdk.TxResponse, error) {
+	req := SaveEvidenceRequest{
+		Evidence: evidence{
+			IBCTransferResult: &evd,
+		},
+	}
+	return c.saveEvidence(ctx, sender, req)
 }
 
 // SendXRPLTicketsAllocationTransactionResultEvidence sends an Evidence of an accepted
@@ -827,16 +1357,7 @@ func (c *ContractClient) SendXRPLTicketsAllocationTransactionResultEvidence(
 			},
 		},
 	}
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]SaveEvidenceRequest{
-			ExecMethodSaveEvidence: req,
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return txRes, nil
+	return c.saveEvidence(ctx, sender, req)
 }
 
 // SendXRPLTrustSetTransactionResultEvidence sends an Evidence of an accepted or rejected trust set transaction.
@@ -852,16 +1373,7 @@ func (c *ContractClient) SendXRPLTrustSetTransactionResultEvidence(
 			},
 		},
 	}
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]SaveEvidenceRequest{
-			ExecMethodSaveEvidence: req,
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return txRes, nil
+	return c.saveEvidence(ctx, sender, req)
 }
 
 // SendCoreumToXRPLTransferTransactionResultEvidence sends an Evidence of an accepted or
@@ -878,16 +1390,7 @@ func (c *ContractClient) SendCoreumToXRPLTransferTransactionResultEvidence(
 			},
 		},
 	}
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]SaveEvidenceRequest{
-			ExecMethodSaveEvidence: req,
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	return txRes, nil
+	return c.saveEvidence(ctx, sender, req)
 }
 
 // SendKeysRotationTransactionResultEvidence sends an Evidence of an accepted or
@@ -904,16 +1407,40 @@ func (c *ContractClient) SendKeysRotationTransactionResultEvidence(
 			},
 		},
 	}
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]SaveEvidenceRequest{
-			ExecMethodSaveEvidence: req,
+	return c.saveEvidence(ctx, sender, req)
+}
+
+// SendNFTokenTransferTransactionResultEvidence sends an Evidence of an accepted or
+// rejected NFToken sell offer acceptance transaction.
+func (c *ContractClient) SendNFTokenTransferTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultNFTokenTransferEvidence,
+) (*sdk.TxResponse, error) {
+	req := SaveEvidenceRequest{
+		Evidence: evidence{
+			XRPLTransactionResult: &xrplTransactionResultEvidence{
+				XRPLTransactionResultEvidence: evd.XRPLTransactionResultEvidence,
+			},
 		},
-	})
-	if err != nil {
-		return nil, err
 	}
+	return c.saveEvidence(ctx, sender, req)
+}
 
-	return txRes, nil
+// SendCheckCashTransactionResultEvidence sends an Evidence of an accepted or rejected Check cashing transaction.
+func (c *ContractClient) SendCheckCashTransactionResultEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evd XRPLTransactionResultCheckCashEvidence,
+) (*sdk.TxResponse, error) {
+	req := SaveEvidenceRequest{
+		Evidence: evidence{
+			XRPLTransactionResult: &xrplTransactionResultEvidence{
+				XRPLTransactionResultEvidence: evd.XRPLTransactionResultEvidence,
+			},
+		},
+	}
+	return c.saveEvidence(ctx, sender, req)
 }
 
 // RecoverTickets executes `recover_tickets` method.
@@ -998,14 +1525,23 @@ func (c *ContractClient) SendToXRPL(
 	})
 }
 
-// MultiSendToXRPL executes `send_to_xrpl` method for each request.
+// MultiSendToXRPL executes `send_to_xrpl` method for each request. The requests with the amount or deliver amount
+// greater than the MaxContractAmount are rejected with the ErrAmountExceedsContractMax before the tx is broadcast.
 func (c *ContractClient) MultiSendToXRPL(
 	ctx context.Context,
 	sender sdk.AccAddress,
 	requests ...SendToXRPLRequest,
 ) (*sdk.TxResponse, error) {
 	execRequests := make([]execRequest, 0, len(requests))
-	for _, req := range requests {
+	for i, req := range requests {
+		if err := ValidateContractAmount(req.Amount.Amount); err != nil {
+			return nil, errors.Wrapf(err, "invalid amount of the send to XRPL request %d", i)
+		}
+		if req.DeliverAmount != nil {
+			if err := ValidateContractAmount(*req.DeliverAmount); err != nil {
+				return nil, errors.Wrapf(err, "invalid deliver amount of the send to XRPL request %d", i)
+			}
+		}
 		execRequests = append(execRequests, execRequest{
 			Body: map[ExecMethod]SendToXRPLRequest{
 				ExecSendToXRPL: req,
@@ -1021,18 +1557,59 @@ func (c *ContractClient) MultiSendToXRPL(
 	return txRes, nil
 }
 
-// RecoverXRPLTokenRegistration executes `recover_xrpl_token_registration` method.
-func (c *ContractClient) RecoverXRPLTokenRegistration(
+// MultiSendToXRPLWithOperationIDs executes `send_to_xrpl` method for each request and returns the identifiers of the
+// created operations decoded from the tx events, the identifier index is the same as the index of its request.
+func (c *ContractClient) MultiSendToXRPLWithOperationIDs(
 	ctx context.Context,
 	sender sdk.AccAddress,
-	issuer, currency string,
-) (*sdk.TxResponse, error) {
-	txRes, err := c.execute(ctx, sender, execRequest{
-		Body: map[ExecMethod]recoverXRPLTokenRegistrationRequest{
-			ExecRecoveryXRPLTokenRegistration: {
-				Issuer:   issuer,
-				Currency: currency,
-			},
+	requests ...SendToXRPLRequest,
+) (*sdk.TxResponse, []SendToXRPLOperationID, error) {
+	txRes, err := c.MultiSendToXRPL(ctx, sender, requests...)
+	if err != nil {
+		return nil, nil, err
+	}
+	operationIDs, err := decodeSendToXRPLOperationIDs(txRes, c.cfg.ContractAddress, len(requests))
+	if err != nil {
+		return txRes, nil, err
+	}
+
+	return txRes, operationIDs, nil
+}
+
+// saveEvidence executes `save_evidence` method.
+func (c *ContractClient) saveEvidence(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	req SaveEvidenceRequest,
+) (txRes *sdk.TxResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, tracing.SpanCoreumSaveEvidence)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+	txRes, err = c.execute(ctx, sender, execRequest{
+		Body: map[ExecMethod]SaveEvidenceRequest{
+			ExecMethodSaveEvidence: req,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txRes, nil
+}
+
+// RecoverXRPLTokenRegistration executes `recover_xrpl_token_registration` method.
+func (c *ContractClient) RecoverXRPLTokenRegistration(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	issuer, currency string,
+) (*sdk.TxResponse, error) {
+	txRes, err := c.execute(ctx, sender, execRequest{
+		Body: map[ExecMethod]recoverXRPLTokenRegistrationRequest{
+			ExecRecoveryXRPLTokenRegistration: {
+				Issuer:   issuer,
+				Currency: currency,
+			},
 		},
 	})
 	if err != nil {
@@ -1062,6 +1639,23 @@ func (c *ContractClient) ClaimRelayerFees(
 	return txRes, nil
 }
 
+// ClaimTreasuryFees executes `claim_treasury_fees` method.
+func (c *ContractClient) ClaimTreasuryFees(
+	ctx context.Context,
+	sender sdk.AccAddress,
+) (*sdk.TxResponse, error) {
+	txRes, err := c.execute(ctx, sender, execRequest{
+		Body: map[ExecMethod]struct{}{
+			ExecClaimTreasuryFees: {},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txRes, nil
+}
+
 // UpdateXRPLToken executes `update_xrpl_token` method.
 func (c *ContractClient) UpdateXRPLToken(
 	ctx context.Context,
@@ -1071,16 +1665,64 @@ func (c *ContractClient) UpdateXRPLToken(
 	sendingPrecision *int32,
 	maxHoldingAmount *sdkmath.Int,
 	bridgingFee *sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	treasuryFee *sdkmath.Int,
 ) (*sdk.TxResponse, error) {
 	txRes, err := c.execute(ctx, sender, execRequest{
 		Body: map[ExecMethod]updateXRPLTokenRequest{
 			ExecUpdateXRPLToken: {
-				Issuer:           issuer,
-				Currency:         currency,
-				State:            state,
-				SendingPrecision: sendingPrecision,
-				MaxHoldingAmount: maxHoldingAmount,
-				BridgingFee:      bridgingFee,
+				Issuer:                   issuer,
+				Currency:                 currency,
+				State:                    state,
+				SendingPrecision:         sendingPrecision,
+				MaxHoldingAmount:         maxHoldingAmount,
+				BridgingFee:              bridgingFee,
+				MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
+				TreasuryFee:              treasuryFee,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txRes, nil
+}
+
+// MigrateXRPLToken executes `migrate_xrpl_token` method.
+func (c *ContractClient) MigrateXRPLToken(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	oldIssuer, oldCurrency, newIssuer, newCurrency string,
+) (*sdk.TxResponse, error) {
+	txRes, err := c.execute(ctx, sender, execRequest{
+		Body: map[ExecMethod]migrateXRPLTokenRequest{
+			ExecMigrateXRPLToken: {
+				OldIssuer:   oldIssuer,
+				OldCurrency: oldCurrency,
+				NewIssuer:   newIssuer,
+				NewCurrency: newCurrency,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txRes, nil
+}
+
+// RemoveMigratedXRPLToken executes `remove_migrated_xrpl_token` method.
+func (c *ContractClient) RemoveMigratedXRPLToken(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	issuer, currency string,
+) (*sdk.TxResponse, error) {
+	txRes, err := c.execute(ctx, sender, execRequest{
+		Body: map[ExecMethod]removeMigratedXRPLTokenRequest{
+			ExecRemoveMigratedXRPLToken: {
+				Issuer:   issuer,
+				Currency: currency,
 			},
 		},
 	})
@@ -1100,15 +1742,19 @@ func (c *ContractClient) UpdateCoreumToken(
 	sendingPrecision *int32,
 	maxHoldingAmount *sdkmath.Int,
 	bridgingFee *sdkmath.Int,
+	maxSendsPerAddressPerDay *uint32,
+	treasuryFee *sdkmath.Int,
 ) (*sdk.TxResponse, error) {
 	txRes, err := c.execute(ctx, sender, execRequest{
 		Body: map[ExecMethod]updateCoreumTokenRequest{
 			ExecUpdateCoreumToken: {
-				Denom:            denom,
-				State:            state,
-				SendingPrecision: sendingPrecision,
-				MaxHoldingAmount: maxHoldingAmount,
-				BridgingFee:      bridgingFee,
+				Denom:                    denom,
+				State:                    state,
+				SendingPrecision:         sendingPrecision,
+				MaxHoldingAmount:         maxHoldingAmount,
+				BridgingFee:              bridgingFee,
+				MaxSendsPerAddressPerDay: maxSendsPerAddressPerDay,
+				TreasuryFee:              treasuryFee,
 			},
 		},
 	})
@@ -1139,6 +1785,75 @@ func (c *ContractClient) ClaimRefund(
 	return txRes, nil
 }
 
+// ClaimRefundAll executes `claim_refund` method for each pending refund of the claimant in a single transaction and
+// returns the claimed refunds.
+func (c *ContractClient) ClaimRefundAll(
+	ctx context.Context,
+	claimant sdk.AccAddress,
+) ([]PendingRefund, error) {
+	pendingRefunds, err := c.GetPendingRefunds(ctx, claimant)
+	if err != nil {
+		return nil, err
+	}
+	if len(pendingRefunds) == 0 {
+		return pendingRefunds, nil
+	}
+
+	execRequests := make([]execRequest, 0, len(pendingRefunds))
+	for _, pendingRefund := range pendingRefunds {
+		execRequests = append(execRequests, execRequest{
+			Body: map[ExecMethod]claimRefundRequest{
+				ExecClaimRefund: {
+					PendingRefundID: pendingRefund.ID,
+				},
+			},
+		})
+	}
+	if _, err := c.execute(ctx, claimant, execRequests...); err != nil {
+		return nil, err
+	}
+
+	return pendingRefunds, nil
+}
+
+// ClaimRefundsOnBehalf executes `claim_refund` method for each pending refund of the claimant in a single authz
+// transaction signed by the grantee. The claimant must have granted the grantee the contract execution. If the
+// feeGranter is set, the fee is paid from its fee allowance to the grantee.
+func (c *ContractClient) ClaimRefundsOnBehalf(
+	ctx context.Context,
+	grantee, claimant, feeGranter sdk.AccAddress,
+	pendingRefundIDs []string,
+) (*sdk.TxResponse, error) {
+	if c.cfg.ContractAddress == nil {
+		return nil, errors.New("failed to execute with empty contract address")
+	}
+	c.execMu.Lock()
+	defer c.execMu.Unlock()
+
+	execRequests := make([]execRequest, 0, len(pendingRefundIDs))
+	for _, pendingRefundID := range pendingRefundIDs {
+		execRequests = append(execRequests, execRequest{
+			Body: map[ExecMethod]claimRefundRequest{
+				ExecClaimRefund: {
+					PendingRefundID: pendingRefundID,
+				},
+			},
+		})
+	}
+	msgs, err := c.buildExecuteContractMsgs(ctx, claimant, execRequests...)
+	if err != nil {
+		return nil, err
+	}
+	msgExec := authz.NewMsgExec(grantee, msgs)
+
+	txRes, err := c.broadcastTx(ctx, c.clientCtx.WithFromAddress(grantee).WithFeeGranterAddress(feeGranter), &msgExec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim refunds on behalf, claimant:%s", claimant.String())
+	}
+
+	return txRes, nil
+}
+
 // RotateKeys executes `rotate_keys` method.
 func (c *ContractClient) RotateKeys(
 	ctx context.Context,
@@ -1146,6 +1861,15 @@ func (c *ContractClient) RotateKeys(
 	newRelayers []Relayer,
 	newEvidenceThreshold uint32,
 ) (*sdk.TxResponse, error) {
+	// the contract doesn't verify the keys, and the relayer with the mismatching keys can't sign the operations
+	for i, relayer := range newRelayers {
+		if err := xrpl.VerifyPublicKeyAddress(relayer.XRPLPubKey, relayer.XRPLAddress); err != nil {
+			return nil, errors.Wrapf(
+				err, "invalid XRPL keys of the relayer %d, coreum address:%s", i, relayer.CoreumAddress.String(),
+			)
+		}
+	}
+
 	txRes, err := c.execute(ctx, sender, execRequest{
 		Body: map[ExecMethod]rotateKeysRequest{
 			ExecRotateKeys: {
@@ -1323,6 +2047,26 @@ func (c *ContractClient) GetXRPLTokens(ctx context.Context) ([]XRPLToken, error)
 	return tokens, nil
 }
 
+// GetMigratedXRPLTokens returns the disabled old tokens of the migrated XRPL tokens, which are kept until their
+// balances have been handled.
+func (c *ContractClient) GetMigratedXRPLTokens(ctx context.Context) ([]XRPLToken, error) {
+	tokens := make([]XRPLToken, 0)
+	lastKey := ""
+	for {
+		response, err := c.getPaginatedMigratedXRPLTokens(ctx, lastKey, &c.cfg.PageLimit)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Tokens) == 0 {
+			break
+		}
+		tokens = append(tokens, response.Tokens...)
+		lastKey = response.LastKey
+	}
+
+	return tokens, nil
+}
+
 // GetCoreumTokenByDenom returns a coreum registered token or nil by the provided denom.
 func (c *ContractClient) GetCoreumTokenByDenom(ctx context.Context, denom string) (CoreumToken, error) {
 	tokens, err := c.GetCoreumTokens(ctx)
@@ -1357,6 +2101,14 @@ func (c *ContractClient) GetCoreumTokens(ctx context.Context) ([]CoreumToken, er
 	return tokens, nil
 }
 
+// GetContractTxs returns the contract txs in the height range, the zero height means that the range isn't bounded
+// on that side.
+func (c *ContractClient) GetContractTxs(ctx context.Context, startHeight, endHeight int64) ([]*sdk.TxResponse, error) {
+	return c.getContractTransactionsByWasmEventAttributes(
+		ctx, map[string]string{}, buildTxHeightConditions(startHeight, endHeight)...,
+	)
+}
+
 // GetPendingOperations returns a list of all pending operations.
 func (c *ContractClient) GetPendingOperations(ctx context.Context) ([]Operation, error) {
 	operations := make([]Operation, 0)
@@ -1376,6 +2128,45 @@ func (c *ContractClient) GetPendingOperations(ctx context.Context) ([]Operation,
 	return operations, nil
 }
 
+// GetPendingOperationsByType returns the pending operations of the provided type.
+// The contract query doesn't support the type filter, so all pending operations are fetched page by page and filtered
+// on the client side, hence the complexity is linear in the number of all pending operations.
+func (c *ContractClient) GetPendingOperationsByType(
+	ctx context.Context,
+	opType OperationTypeEnum,
+) ([]Operation, error) {
+	if err := validateOperationType(opType); err != nil {
+		return nil, err
+	}
+	operations, err := c.GetPendingOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterOperationsByType(operations, opType), nil
+}
+
+// GetSignatureProgress returns the relayers signing progress of the pending operation with the provided ticket or
+// account sequence.
+func (c *ContractClient) GetSignatureProgress(ctx context.Context, ticketSequence uint32) (SignatureProgress, error) {
+	operations, err := c.GetPendingOperations(ctx)
+	if err != nil {
+		return SignatureProgress{}, err
+	}
+	operation, found := lo.Find(operations, func(operation Operation) bool {
+		return operation.GetOperationID() == ticketSequence
+	})
+	if !found {
+		return SignatureProgress{}, errors.Errorf("pending operation with ticket sequence %d not found", ticketSequence)
+	}
+	contractConfig, err := c.GetContractConfig(ctx)
+	if err != nil {
+		return SignatureProgress{}, err
+	}
+
+	return NewSignatureProgress(contractConfig, operation), nil
+}
+
 // GetAvailableTickets returns a list of registered not used tickets.
 func (c *ContractClient) GetAvailableTickets(ctx context.Context) ([]uint32, error) {
 	var res availableTicketsResponse
@@ -1406,6 +2197,19 @@ func (c *ContractClient) GetFeesCollected(ctx context.Context, address sdk.Addre
 	return sdk.NewCoins(res.FeesCollected...), nil
 }
 
+// GetTreasuryFeesCollected returns the treasury fees collected and not claimed yet.
+func (c *ContractClient) GetTreasuryFeesCollected(ctx context.Context) (sdk.Coins, error) {
+	var res feesCollectedResponse
+	err := c.query(ctx, map[QueryMethod]interface{}{
+		QueryMethodTreasuryFeesCollected: struct{}{},
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(res.FeesCollected...), nil
+}
+
 // GetPendingRefunds returns the list of pending refunds for and address.
 func (c *ContractClient) GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]PendingRefund, error) {
 	pendingRefunds := make([]PendingRefund, 0)
@@ -1425,6 +2229,25 @@ func (c *ContractClient) GetPendingRefunds(ctx context.Context, address sdk.AccA
 	return pendingRefunds, nil
 }
 
+// GetPendingIBCTransfers returns the list of IBC transfers waiting for the result evidences.
+func (c *ContractClient) GetPendingIBCTransfers(ctx context.Context) ([]PendingIBCTransfer, error) {
+	pendingIBCTransfers := make([]PendingIBCTransfer, 0)
+	lastKey := ""
+	for {
+		res, err := c.getPaginatedPendingIBCTransfers(ctx, lastKey, &c.cfg.PageLimit)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.PendingIBCTransfers) == 0 {
+			break
+		}
+		pendingIBCTransfers = append(pendingIBCTransfers, res.PendingIBCTransfers...)
+		lastKey = res.LastKey
+	}
+
+	return pendingIBCTransfers, nil
+}
+
 // GetTransactionEvidences returns a list of transaction evidences.
 func (c *ContractClient) GetTransactionEvidences(ctx context.Context) ([]TransactionEvidence, error) {
 	transactionEvidences := make([]TransactionEvidence, 0)
@@ -1457,6 +2280,124 @@ func (c *ContractClient) GetProhibitedXRPLAddresses(ctx context.Context) ([]stri
 	return response.ProhibitedXRPLAddresses, nil
 }
 
+// GetRelayerEvidenceRateLimit returns the state of the relayer evidences limit in the latest block.
+func (c *ContractClient) GetRelayerEvidenceRateLimit(
+	ctx context.Context,
+	relayerAddress sdk.AccAddress,
+) (RelayerEvidenceRateLimit, error) {
+	var response RelayerEvidenceRateLimit
+	err := c.query(ctx, map[QueryMethod]interface{}{
+		QueryMethodRelayerEvidenceRateLimit: struct {
+			RelayerAddress sdk.AccAddress `json:"relayer_address"`
+		}{
+			RelayerAddress: relayerAddress,
+		},
+	}, &response)
+	if err != nil {
+		return RelayerEvidenceRateLimit{}, err
+	}
+
+	return response, nil
+}
+
+// GetLatestBlockHeight returns the height of the latest Coreum block.
+func (c *ContractClient) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	res, err := tmservice.NewServiceClient(c.clientCtx).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get latest coreum block")
+	}
+
+	return res.GetSdkBlock().GetHeader().Height, nil
+}
+
+// GetNodeInfo returns the chain ID and the application version of the Coreum node.
+func (c *ContractClient) GetNodeInfo(ctx context.Context) (NodeInfo, error) {
+	res, err := tmservice.NewServiceClient(c.clientCtx).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return NodeInfo{}, errors.Wrap(err, "failed to get coreum node info")
+	}
+
+	return NodeInfo{
+		ChainID: res.GetDefaultNodeInfo().GetNetwork(),
+		Version: res.GetApplicationVersion().GetVersion(),
+	}, nil
+}
+
+// GetContractExecutionGranters returns the addresses which have granted the grantee the execution of the contracts
+// via authz.
+func (c *ContractClient) GetContractExecutionGranters(
+	ctx context.Context,
+	grantee sdk.AccAddress,
+) ([]sdk.AccAddress, error) {
+	authzClient := authz.NewQueryClient(c.clientCtx)
+	executeContractMsgTypeURL := sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})
+	granters := make([]sdk.AccAddress, 0)
+	var nextKey []byte
+	for {
+		res, err := authzClient.GranteeGrants(ctx, &authz.QueryGranteeGrantsRequest{
+			Grantee: grantee.String(),
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: uint64(c.cfg.PageLimit),
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get authz grants, grantee:%s", grantee.String())
+		}
+		for _, grant := range res.Grants {
+			var authorization authz.Authorization
+			if err := c.clientCtx.InterfaceRegistry().UnpackAny(grant.Authorization, &authorization); err != nil {
+				return nil, errors.Wrapf(err, "failed to unpack authz authorization, granter:%s", grant.Granter)
+			}
+			if authorization.MsgTypeURL() != executeContractMsgTypeURL {
+				continue
+			}
+			granter, err := sdk.AccAddressFromBech32(grant.Granter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse granter address:%s", grant.Granter)
+			}
+			granters = append(granters, granter)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return granters, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// GetFeeAllowanceGranters returns the addresses which have granted the grantee the fee allowance.
+func (c *ContractClient) GetFeeAllowanceGranters(
+	ctx context.Context,
+	grantee sdk.AccAddress,
+) ([]sdk.AccAddress, error) {
+	feegrantClient := feegrant.NewQueryClient(c.clientCtx)
+	granters := make([]sdk.AccAddress, 0)
+	var nextKey []byte
+	for {
+		res, err := feegrantClient.Allowances(ctx, &feegrant.QueryAllowancesRequest{
+			Grantee: grantee.String(),
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: uint64(c.cfg.PageLimit),
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get fee allowances, grantee:%s", grantee.String())
+		}
+		for _, allowance := range res.Allowances {
+			granter, err := sdk.AccAddressFromBech32(allowance.Granter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse granter address:%s", allowance.Granter)
+			}
+			granters = append(granters, granter)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return granters, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
 // GetXRPLToCoreumTracingInfo returns XRPL to Coreum tracing info.
 func (c *ContractClient) GetXRPLToCoreumTracingInfo(
 	ctx context.Context,
@@ -1484,19 +2425,133 @@ func (c *ContractClient) GetXRPLToCoreumTracingInfo(
 			if payload.SaveEvidence == nil || payload.SaveEvidence.Evidence.XRPLToCoreumTransfer == nil {
 				continue
 			}
-			xrplToCoreumTracingInfo.EvidenceToTxs = append(
-				xrplToCoreumTracingInfo.EvidenceToTxs,
-				DataToTx[XRPLToCoreumTransferEvidence]{
-					Evidence: *payload.SaveEvidence.Evidence.XRPLToCoreumTransfer,
-					Tx:       tx,
-				})
-			if isEventValueEqual(tx.Logs[i].Events, wasmtypes.WasmModuleEventType, eventAttributeThresholdReached, "true") {
-				xrplToCoreumTracingInfo.CoreumTx = tx
+			xrplToCoreumTracingInfo.EvidenceToTxs = append(
+				xrplToCoreumTracingInfo.EvidenceToTxs,
+				DataToTx[XRPLToCoreumTransferEvidence]{
+					Evidence: *payload.SaveEvidence.Evidence.XRPLToCoreumTransfer,
+					Tx:       tx,
+				})
+			if isEventValueEqual(tx.Logs[i].Events, wasmtypes.WasmModuleEventType, eventAttributeThresholdReached, "true") {
+				xrplToCoreumTracingInfo.CoreumTx = tx
+			}
+		}
+	}
+
+	return xrplToCoreumTracingInfo, nil
+}
+
+// GetXRPLToCoreumTransfers returns the XRPL to Coreum transfers which reached the evidence threshold in the
+// filtered txs, each item is mapped to the tx which reached the threshold.
+func (c *ContractClient) GetXRPLToCoreumTransfers(
+	ctx context.Context,
+	filter TransfersFilter,
+) ([]DataToTx[XRPLToCoreumTransferEvidence], error) {
+	attributes := map[string]string{
+		eventAttributeAction:           eventValueSaveAction,
+		eventAttributeThresholdReached: "true",
+	}
+	if filter.Recipient != "" {
+		attributes[eventAttributeRecipient] = filter.Recipient
+	}
+	txs, err := c.getContractTransactionsByWasmEventAttributes(ctx, attributes, buildTxHeightConditions(filter.StartHeight, filter.EndHeight)...)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]DataToTx[XRPLToCoreumTransferEvidence], 0)
+	for _, tx := range txs {
+		executePayloads, err := c.decodeExecutePayload(tx)
+		if err != nil {
+			return nil, err
+		}
+		for i, payload := range executePayloads {
+			if payload.SaveEvidence == nil || payload.SaveEvidence.Evidence.XRPLToCoreumTransfer == nil {
+				continue
+			}
+			evidence := *payload.SaveEvidence.Evidence.XRPLToCoreumTransfer
+			if filter.Recipient != "" && evidence.Recipient.String() != filter.Recipient {
+				continue
+			}
+			if !isEventValueEqual(tx.Logs[i].Events, wasmtypes.WasmModuleEventType, eventAttributeThresholdReached, "true") {
+				continue
+			}
+			transfers = append(transfers, DataToTx[XRPLToCoreumTransferEvidence]{
+				Evidence: evidence,
+				Tx:       tx,
+			})
+		}
+	}
+
+	return transfers, nil
+}
+
+// GetCoreumToXRPLTransfers returns the Coreum to XRPL transfers executed in the filtered txs together with the
+// confirmed results of the operations created for them.
+func (c *ContractClient) GetCoreumToXRPLTransfers(
+	ctx context.Context,
+	filter TransfersFilter,
+) ([]CoreumToXRPLTransfer, error) {
+	attributes := map[string]string{
+		eventAttributeAction: eventValueSendToXRPLAction,
+	}
+	if filter.Recipient != "" {
+		attributes[eventAttributeRecipient] = filter.Recipient
+	}
+	txs, err := c.getContractTransactionsByWasmEventAttributes(ctx, attributes, buildTxHeightConditions(filter.StartHeight, filter.EndHeight)...)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]CoreumToXRPLTransfer, 0)
+	for _, tx := range txs {
+		executePayloads, err := c.decodeExecutePayload(tx)
+		if err != nil {
+			return nil, err
+		}
+		for i, payload := range executePayloads {
+			if payload.SendToXRPL == nil {
+				continue
+			}
+			request := *payload.SendToXRPL
+			if filter.Recipient != "" && request.Recipient != filter.Recipient {
+				continue
+			}
+			events := tx.Logs[i].Events
+			coinValue, _ := getEventValue(events, wasmtypes.WasmModuleEventType, eventAttributeCoin)
+			request.Amount, err = sdk.ParseCoinNormalized(coinValue)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse send to XRPL coin, tx:%s", tx.TxHash)
+			}
+			senderValue, _ := getEventValue(events, wasmtypes.WasmModuleEventType, eventAttributeSender)
+			sender, err := sdk.AccAddressFromBech32(senderValue)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse send to XRPL sender, tx:%s", tx.TxHash)
+			}
+
+			operationIDs, err := c.getSendToXRPLOperationIDs(ctx, request, tx.Height)
+			if err != nil {
+				return nil, err
 			}
+			resultEvidences := make([]XRPLTransactionResultEvidence, 0)
+			for _, operationID := range operationIDs {
+				operationResultEvidences, err := c.getConfirmedTxResultEvidencesForOperation(ctx, operationID)
+				if err != nil {
+					return nil, err
+				}
+				resultEvidences = append(resultEvidences, operationResultEvidences...)
+			}
+
+			transfers = append(transfers, CoreumToXRPLTransfer{
+				Sender:          sender,
+				Request:         request,
+				OperationIDs:    operationIDs,
+				ResultEvidences: resultEvidences,
+				Tx:              tx,
+			})
 		}
 	}
 
-	return xrplToCoreumTracingInfo, nil
+	return transfers, nil
 }
 
 // GetCoreumToXRPLTracingInfo returns Coreum to XRPL tracing info.
@@ -1591,6 +2646,47 @@ func (c *ContractClient) getXRPLTxsFromSaveTxResultEvidenceForOperation(
 	}), nil
 }
 
+func (c *ContractClient) getConfirmedTxResultEvidencesForOperation(
+	ctx context.Context,
+	operationID uint32,
+) ([]XRPLTransactionResultEvidence, error) {
+	operationIDValue := strconv.FormatUint(uint64(operationID), 10)
+	txs, err := c.getContractTransactionsByWasmEventAttributes(ctx,
+		map[string]string{
+			eventAttributeAction:           eventValueSaveAction,
+			eventAttributeOperationID:      operationIDValue,
+			eventAttributeThresholdReached: "true",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	evidences := make([]XRPLTransactionResultEvidence, 0)
+	for _, tx := range txs {
+		executePayloads, err := c.decodeExecutePayload(tx)
+		if err != nil {
+			return nil, err
+		}
+		for i, payload := range executePayloads {
+			if payload.SaveEvidence == nil || payload.SaveEvidence.Evidence.XRPLTransactionResult == nil {
+				continue
+			}
+			events := tx.Logs[i].Events
+			if !isEventValueEqual(events, wasmtypes.WasmModuleEventType, eventAttributeOperationID, operationIDValue) ||
+				!isEventValueEqual(events, wasmtypes.WasmModuleEventType, eventAttributeThresholdReached, "true") {
+				continue
+			}
+			evidences = append(
+				evidences,
+				payload.SaveEvidence.Evidence.XRPLTransactionResult.XRPLTransactionResultEvidence,
+			)
+		}
+	}
+
+	return evidences, nil
+}
+
 func (c *ContractClient) getPaginatedXRPLTokens(
 	ctx context.Context,
 	startAfterKey string,
@@ -1610,6 +2706,25 @@ func (c *ContractClient) getPaginatedXRPLTokens(
 	return response, nil
 }
 
+func (c *ContractClient) getPaginatedMigratedXRPLTokens(
+	ctx context.Context,
+	startAfterKey string,
+	limit *uint32,
+) (xrplTokensResponse, error) {
+	var response xrplTokensResponse
+	err := c.query(ctx, map[QueryMethod]pagingStringKeyRequest{
+		QueryMethodMigratedXRPLTokens: {
+			StartAfterKey: startAfterKey,
+			Limit:         limit,
+		},
+	}, &response)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
 func (c *ContractClient) getPaginatedCoreumTokens(
 	ctx context.Context,
 	startAfterKey string,
@@ -1668,6 +2783,24 @@ func (c *ContractClient) getPaginatedPendingRefunds(
 	return res, nil
 }
 
+func (c *ContractClient) getPaginatedPendingIBCTransfers(
+	ctx context.Context,
+	startAfterKey string,
+	limit *uint32,
+) (pendingIBCTransfersResponse, error) {
+	var res pendingIBCTransfersResponse
+	err := c.query(ctx, map[QueryMethod]pagingStringKeyRequest{
+		QueryMethodPendingIBCTransfers: {
+			StartAfterKey: startAfterKey,
+			Limit:         limit,
+		},
+	}, &res)
+	if err != nil {
+		return pendingIBCTransfersResponse{}, err
+	}
+	return res, nil
+}
+
 func (c *ContractClient) getPaginatedTransactionEvidences(
 	ctx context.Context,
 	startAfterKey string,
@@ -1706,25 +2839,18 @@ func (c *ContractClient) execute(
 	c.execMu.Lock()
 	defer c.execMu.Unlock()
 
-	msgs := make([]sdk.Msg, 0, len(requests))
-	for _, req := range requests {
-		payload, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to marshal payload, request:%+v", req.Body)
-		}
-		c.log.Debug(ctx, "Executing contract", zap.String("payload", string(payload)))
-		msg := &wasmtypes.MsgExecuteContract{
-			Sender:   sender.String(),
-			Contract: c.cfg.ContractAddress.String(),
-			Msg:      payload,
-			Funds:    req.Funds,
-		}
-		msgs = append(msgs, msg)
+	msgs, err := c.buildExecuteContractMsgs(ctx, sender, requests...)
+	if err != nil {
+		return nil, err
 	}
 
 	clientCtx := c.clientCtx.WithFromAddress(sender)
 	if clientCtx.GenerateOnly() {
-		unsignedTx, err := client.GenerateUnsignedTx(ctx, clientCtx, c.getTxFactory(), msgs...)
+		txf, err := withFixedGasPrices(ctx, clientCtx, c.getTxFactory(ctx), msgs...)
+		if err != nil {
+			return nil, err
+		}
+		unsignedTx, err := client.GenerateUnsignedTx(ctx, clientCtx, txf, msgs...)
 		if err != nil {
 			return nil, err
 		}
@@ -1739,9 +2865,9 @@ func (c *ContractClient) execute(
 
 	var res *sdk.TxResponse
 	outOfGasRetryAttempt := uint32(1)
-	err := retry.Do(ctx, c.cfg.OutOfGasRetryDelay, func() error {
+	err = retry.Do(ctx, c.cfg.OutOfGasRetryDelay, func() error {
 		var err error
-		res, err = client.BroadcastTx(ctx, clientCtx.WithFromAddress(sender), c.getTxFactory(), msgs...)
+		res, err = c.broadcastTx(ctx, clientCtx.WithFromAddress(sender), msgs...)
 		if err == nil {
 			return nil
 		}
@@ -1764,6 +2890,178 @@ func (c *ContractClient) execute(
 	return res, nil
 }
 
+func (c *ContractClient) buildExecuteContractMsgs(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	requests ...execRequest,
+) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(requests))
+	for _, req := range requests {
+		payload, err := json.Marshal(req.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal payload, request:%+v", req.Body)
+		}
+		c.log.Debug(ctx, "Executing contract", zap.String("payload", string(payload)))
+		msg := &wasmtypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: c.cfg.ContractAddress.String(),
+			Msg:      payload,
+			Funds:    req.Funds,
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// broadcastTx broadcasts the tx with the broadcast timeout and returns the ErrBroadcastTimeout if the timeout is
+// reached before the tx is included in a block.
+func (c *ContractClient) broadcastTx(
+	ctx context.Context,
+	clientCtx client.Context,
+	msgs ...sdk.Msg,
+) (res *sdk.TxResponse, err error) {
+	// the new txs aren't broadcast once the shutdown is started
+	if err := ctx.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// the span is ended when the broadcast tx is included to the block or the broadcast is failed
+	ctx, span := tracing.Tracer().Start(ctx, tracing.SpanCoreumBroadcastTx)
+	defer func() {
+		if res != nil {
+			span.SetAttributes(
+				attribute.String(tracing.AttributeCoreumTxHash, res.TxHash),
+				attribute.Int64(tracing.AttributeCoreumTxHeight, res.Height),
+			)
+			c.logKeyUsage(res, msgs)
+		}
+		tracing.EndSpan(span, err)
+	}()
+	ctx, ctxCancel := withShutdownGrace(ctx, c.cfg.ShutdownTimeout)
+	defer ctxCancel()
+	// the account sequence, broadcast and confirmation of the tx are requested from the same node
+	ctx = WithStickyGRPCEndpoint(ctx)
+
+	if c.cfg.TxBroadcastTimeout == 0 {
+		res, err = c.broadcastTxFn(ctx, clientCtx, c.getTxFactory(ctx), msgs...)
+		if err == nil {
+			c.trackTxGas(ctx, res, msgs)
+		}
+		return res, err
+	}
+
+	broadcastCtx, broadcastCtxCancel := context.WithTimeout(ctx, c.cfg.TxBroadcastTimeout)
+	defer broadcastCtxCancel()
+	res, err = c.broadcastTxFn(broadcastCtx, clientCtx, c.getTxFactory(ctx), msgs...)
+	if err != nil && ctx.Err() == nil && errors.Is(broadcastCtx.Err(), context.DeadlineExceeded) {
+		return nil, errors.Wrapf(
+			ErrBroadcastTimeout, "timeout:%s, error:%s", c.cfg.TxBroadcastTimeout.String(), err.Error(),
+		)
+	}
+	if err == nil {
+		c.trackTxGas(ctx, res, msgs)
+	}
+
+	return res, err
+}
+
+// logKeyUsage records the broadcast tx in the key usage audit log, the payload digest is the hash of the tx messages.
+func (c *ContractClient) logKeyUsage(res *sdk.TxResponse, msgs []sdk.Msg) {
+	if c.auditLogger == nil {
+		return
+	}
+	hasher := sha256.New()
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			// the message without the value is hashed by its type only
+			hasher.Write([]byte(sdk.MsgTypeURL(msg)))
+			continue
+		}
+		hasher.Write([]byte(msgAny.TypeUrl))
+		hasher.Write(msgAny.Value)
+	}
+	c.auditLogger.LogCoreumBroadcast(res.TxHash, hasher.Sum(nil))
+}
+
+// trackTxGas logs the gas of the broadcast tx and records the gas used per operation type to find the expensive
+// operations.
+func (c *ContractClient) trackTxGas(ctx context.Context, res *sdk.TxResponse, msgs []sdk.Msg) {
+	if res == nil {
+		return
+	}
+	operationType := getTxOperationType(msgs)
+	// the gas wanted is the simulated gas multiplied by the gas adjustment
+	simulatedGas := res.GasWanted
+	if c.cfg.GasAdjustment > 0 {
+		simulatedGas = int64(float64(res.GasWanted) / c.cfg.GasAdjustment)
+	}
+	c.log.Debug(
+		ctx,
+		"Coreum tx gas",
+		zap.String("operationType", operationType),
+		zap.Int("messageCount", len(msgs)),
+		zap.Int64("simulatedGas", simulatedGas),
+		zap.Int64("gasWanted", res.GasWanted),
+		zap.Int64("gasUsed", res.GasUsed),
+		zap.String("txHash", res.TxHash),
+	)
+	if c.metricRegistry != nil {
+		c.metricRegistry.ObserveCoreumContractTxGasUsed(operationType, float64(res.GasUsed))
+	}
+}
+
+// getTxOperationType returns the operation type of the tx messages, which is the execute method for the contract
+// execution messages and the message type URL for the rest. The different types of the multi-message tx are joined.
+func getTxOperationType(msgs []sdk.Msg) string {
+	operationTypes := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		operationTypes = append(operationTypes, getMsgOperationType(msg))
+	}
+	operationTypes = lo.Uniq(operationTypes)
+	slices.Sort(operationTypes)
+
+	return strings.Join(operationTypes, ",")
+}
+
+func getMsgOperationType(msg sdk.Msg) string {
+	executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+	if !ok {
+		return sdk.MsgTypeURL(msg)
+	}
+	var payload map[ExecMethod]json.RawMessage
+	if err := json.Unmarshal(executeMsg.Msg, &payload); err != nil || len(payload) != 1 {
+		return sdk.MsgTypeURL(msg)
+	}
+	for method := range payload {
+		return string(method)
+	}
+
+	return sdk.MsgTypeURL(msg)
+}
+
+// withShutdownGrace returns the context which is canceled after the grace period once the parent context is canceled.
+func withShutdownGrace(parentCtx context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	if grace == 0 {
+		return context.WithCancel(parentCtx)
+	}
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parentCtx))
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-parentCtx.Done():
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(grace):
+			cancel()
+		}
+	}()
+
+	return ctx, cancel
+}
+
 func (c *ContractClient) query(ctx context.Context, request, response any) error {
 	if c.cfg.ContractAddress == nil {
 		return errors.New("failed to execute with empty contract address")
@@ -1797,18 +3095,80 @@ func (c *ContractClient) query(ctx context.Context, request, response any) error
 	return nil
 }
 
-func (c *ContractClient) getTxFactory() client.Factory {
-	return client.Factory{}.
+func (c *ContractClient) getTxFactory(ctx context.Context) client.Factory {
+	txf := client.Factory{}.
 		WithKeybase(c.clientCtx.Keyring()).
 		WithChainID(c.clientCtx.ChainID()).
 		WithTxConfig(c.clientCtx.TxConfig()).
-		WithMemo(fmt.Sprintf("%s %s", RelayerCoreumMemoPrefix, buildinfo.VersionTag)).
+		WithMemo(getTxMemo(ctx)).
+		WithGasAdjustment(c.cfg.GasAdjustment).
 		WithSimulateAndExecute(true)
+	if !c.cfg.GasPrices.IsZero() {
+		txf = txf.WithGasPrices(c.cfg.GasPrices.String())
+	}
+
+	return txf
+}
+
+// WithTxMemoNote returns the context with the note appended to the memo of the txs executed with it, e.g. the reason
+// of the owner action.
+func WithTxMemoNote(ctx context.Context, note string) context.Context {
+	return context.WithValue(ctx, txMemoNoteKey{}, note)
+}
+
+// GetTxMemoNote returns the memo note set by the WithTxMemoNote.
+func GetTxMemoNote(ctx context.Context) string {
+	note, _ := ctx.Value(txMemoNoteKey{}).(string)
+	return note
+}
+
+func getTxMemo(ctx context.Context) string {
+	memo := fmt.Sprintf("%s %s", RelayerCoreumMemoPrefix, buildinfo.VersionTag)
+	if note := GetTxMemoNote(ctx); note != "" {
+		memo = fmt.Sprintf("%s %s %s", memo, RelayerCoreumMemoNoteSeparator, note)
+	}
+
+	return memo
+}
+
+// broadcastTxWithGasPrices broadcasts the tx with the fixed gas prices if they are set in the factory.
+func broadcastTxWithGasPrices(
+	ctx context.Context,
+	clientCtx client.Context,
+	txf client.Factory,
+	msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	txf, err := withFixedGasPrices(ctx, clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.BroadcastTx(ctx, clientCtx, txf, msgs...)
+}
+
+// withFixedGasPrices estimates the tx gas and disables the simulation of the factory with the gas prices, since the
+// simulation on the tx generation replaces the gas prices with the chain min gas price.
+func withFixedGasPrices(
+	ctx context.Context,
+	clientCtx client.Context,
+	txf client.Factory,
+	msgs ...sdk.Msg,
+) (client.Factory, error) {
+	if txf.GasPrices().IsZero() || !txf.SimulateAndExecute() {
+		return txf, nil
+	}
+	_, gas, err := client.CalculateGas(ctx, clientCtx, txf, msgs...)
+	if err != nil {
+		return client.Factory{}, errors.Wrap(err, "failed to estimate tx gas")
+	}
+
+	return txf.WithSimulateAndExecute(false).WithGas(gas), nil
 }
 
 func (c *ContractClient) getContractTransactionsByWasmEventAttributes(
 	ctx context.Context,
 	attributes map[string]string,
+	conditions ...string,
 ) ([]*sdk.TxResponse, error) {
 	page := uint64(0)
 	txResponses := make([]*sdk.TxResponse, 0)
@@ -1829,6 +3189,8 @@ func (c *ContractClient) getContractTransactionsByWasmEventAttributes(
 		))
 	}
 
+	events = append(events, conditions...)
+
 	attributes[wasmtypes.AttributeKeyContractAddr] = wasmtypes.WasmModuleEventType
 	for {
 		txEventsPage, err := c.cometServiceClient.GetTxsEvent(ctx, &sdktxtypes.GetTxsEventRequest{
@@ -1873,10 +3235,120 @@ func (c *ContractClient) decodeExecutePayload(txAny *sdk.TxResponse) ([]ExecuteP
 	return executePayloads, nil
 }
 
+// IsEvidenceThresholdReached returns true if the evidence saved by the tx has reached the threshold.
+func IsEvidenceThresholdReached(txRes *sdk.TxResponse) bool {
+	if txRes == nil {
+		return false
+	}
+	for _, ev := range txRes.Events {
+		if ev.Type != wasmtypes.WasmModuleEventType {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == eventAttributeThresholdReached && attr.Value == "true" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GetReceivedCoins returns the coins received by the address in the tx.
+func GetReceivedCoins(txRes *sdk.TxResponse, address sdk.AccAddress) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	if txRes == nil {
+		return coins, nil
+	}
+	for _, ev := range txRes.Events {
+		if ev.Type != banktypes.EventTypeCoinReceived {
+			continue
+		}
+		var receiver, amount string
+		for _, attr := range ev.Attributes {
+			switch attr.Key {
+			case banktypes.AttributeKeyReceiver:
+				receiver = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		if receiver != address.String() || amount == "" {
+			continue
+		}
+		receivedCoins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse received coins, tx:%s", txRes.TxHash)
+		}
+		coins = coins.Add(receivedCoins...)
+	}
+
+	return coins, nil
+}
+
+// decodeSendToXRPLOperationIDs decodes the identifiers of the operations created by the contract in the send to XRPL
+// tx. The tx messages are executed in order and each of them creates one operation, so the order of the operation
+// created events is the order of the requests. The events of other contracts are ignored.
+func decodeSendToXRPLOperationIDs(
+	txRes *sdk.TxResponse,
+	contractAddress sdk.AccAddress,
+	requestsCount int,
+) ([]SendToXRPLOperationID, error) {
+	operationIDs := make([]SendToXRPLOperationID, 0, requestsCount)
+	for _, ev := range txRes.Events {
+		if ev.Type != OperationCreatedEventType {
+			continue
+		}
+		attributes := lo.SliceToMap(ev.Attributes, func(attr abci.EventAttribute) (string, string) {
+			return attr.Key, attr.Value
+		})
+		if attributes[wasmtypes.AttributeKeyContractAddr] != contractAddress.String() {
+			continue
+		}
+		operationID, err := strconv.ParseUint(attributes[eventAttributeOperationID], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"failed to parse operation ID, operationID:%s, tx:%s",
+				attributes[eventAttributeOperationID],
+				txRes.TxHash,
+			)
+		}
+		operationUniqueID, ok := attributes[eventAttributeOperationUniqueID]
+		if !ok || operationUniqueID == "" {
+			return nil, errors.Errorf(
+				"operation created event without unique ID, operationID:%d, tx:%s", operationID, txRes.TxHash,
+			)
+		}
+		operationIDs = append(operationIDs, SendToXRPLOperationID{
+			OperationID:       uint32(operationID),
+			OperationUniqueID: operationUniqueID,
+		})
+	}
+	if len(operationIDs) != requestsCount {
+		return nil, errors.Errorf(
+			"unexpected number of created operations, expected:%d, got:%d, tx:%s",
+			requestsCount,
+			len(operationIDs),
+			txRes.TxHash,
+		)
+	}
+
+	return operationIDs, nil
+}
+
 func isEventValueEqual(
 	events sdk.StringEvents,
 	etype, key, value string,
 ) bool {
+	eventValue, ok := getEventValue(events, etype, key)
+	return ok && eventValue == value
+}
+
+func getEventValue(
+	events sdk.StringEvents,
+	etype, key string,
+) (string, bool) {
 	for _, ev := range events {
 		if ev.Type != etype {
 			continue
@@ -1886,10 +3358,22 @@ func isEventValueEqual(
 				continue
 			}
 
-			return attr.Value == value
+			return attr.Value, true
 		}
 	}
-	return false
+	return "", false
+}
+
+func buildTxHeightConditions(startHeight, endHeight int64) []string {
+	conditions := make([]string, 0)
+	if startHeight > 0 {
+		conditions = append(conditions, fmt.Sprintf("tx.height>=%d", startHeight))
+	}
+	if endHeight > 0 {
+		conditions = append(conditions, fmt.Sprintf("tx.height<=%d", endHeight))
+	}
+
+	return conditions
 }
 
 func (c *ContractClient) getSendToXRPLOperationIDs(
@@ -1898,13 +3382,13 @@ func (c *ContractClient) getSendToXRPLOperationIDs(
 	txHeight int64,
 ) ([]uint32, error) {
 	beforeCtx := WithHeightRequestContext(ctx, txHeight-1)
-	operationsBefore, err := c.GetPendingOperations(beforeCtx)
+	operationsBefore, err := c.GetPendingOperationsByType(beforeCtx, OperationTypeEnumCoreumToXRPLTransfer)
 	if err != nil {
 		return nil, err
 	}
 
 	afterCtx := WithHeightRequestContext(ctx, txHeight)
-	operationsAfter, err := c.GetPendingOperations(afterCtx)
+	operationsAfter, err := c.GetPendingOperationsByType(afterCtx, OperationTypeEnumCoreumToXRPLTransfer)
 	if err != nil {
 		return nil, err
 	}
@@ -1916,9 +3400,6 @@ func (c *ContractClient) getSendToXRPLOperationIDs(
 	operationIDs := make([]uint32, 0)
 	for _, operation := range operationsAfter {
 		if _, ok := operationsBeforeMap[operation.GetOperationID()]; !ok {
-			if operation.OperationType.CoreumToXRPLTransfer == nil {
-				continue
-			}
 			if operation.OperationType.CoreumToXRPLTransfer.Recipient != sendReq.Recipient {
 				continue
 			}
@@ -1929,6 +3410,20 @@ func (c *ContractClient) getSendToXRPLOperationIDs(
 	return operationIDs, nil
 }
 
+func validateOperationType(opType OperationTypeEnum) error {
+	switch opType {
+	case OperationTypeEnumAllocateTickets,
+		OperationTypeEnumTrustSet,
+		OperationTypeEnumCoreumToXRPLTransfer,
+		OperationTypeEnumRotateKeys,
+		OperationTypeEnumNFTokenTransfer,
+		OperationTypeEnumCheckCash:
+		return nil
+	default:
+		return errors.Errorf("invalid operation type: %s", opType)
+	}
+}
+
 // ******************** Context ********************
 
 // WithHeightRequestContext adds the height to the context for queries.
@@ -1942,6 +3437,11 @@ func WithHeightRequestContext(ctx context.Context, height int64) context.Context
 
 // ******************** Contract error ********************
 
+// IsBroadcastTimeoutError returns true if error is ErrBroadcastTimeout.
+func IsBroadcastTimeoutError(err error) bool {
+	return errors.Is(err, ErrBroadcastTimeout)
+}
+
 // IsNotOwnerError returns true if error is `not owner`.
 func IsNotOwnerError(err error) bool {
 	return isError(err, "Caller is not the contract's current owner")
@@ -1972,6 +3472,17 @@ func IsTokenNotRegisteredError(err error) bool {
 	return isError(err, "TokenNotRegistered")
 }
 
+// IsAmountOverflowError returns true if error is the `OverflowError` of the amount multiplication, which the contract
+// returns if the amount converted to the token decimals doesn't fit the Uint128.
+func IsAmountOverflowError(err error) bool {
+	return isError(err, "Cannot Mul with")
+}
+
+// IsRateLimitExceededError returns true if error is `RateLimitExceeded`.
+func IsRateLimitExceededError(err error) bool {
+	return isError(err, "RateLimitExceeded")
+}
+
 // IsEvidenceAlreadyProvidedError returns true if error is `EvidenceAlreadyProvided`.
 func IsEvidenceAlreadyProvidedError(err error) bool {
 	return isError(err, "EvidenceAlreadyProvided")
@@ -2022,6 +3533,11 @@ func IsInvalidXRPLAddressError(err error) bool {
 	return isError(err, "InvalidXRPLAddress")
 }
 
+// IsInvalidXRPLCurrencyError returns true if error is `InvalidXRPLCurrency`.
+func IsInvalidXRPLCurrencyError(err error) bool {
+	return isError(err, "InvalidXRPLCurrency")
+}
+
 // IsLastTicketReservedError returns true if error is `LastTicketReserved`.
 func IsLastTicketReservedError(err error) bool {
 	return isError(err, "LastTicketReserved")
@@ -2092,6 +3608,36 @@ func IsInvalidOperationResultError(err error) bool {
 	return isError(err, "InvalidOperationResult")
 }
 
+// IsDailyTransferLimitExceededError returns true if error is `DailyTransferLimitExceeded`.
+func IsDailyTransferLimitExceededError(err error) bool {
+	return isError(err, "DailyTransferLimitExceeded")
+}
+
+// IsXRPTokenNotMigratableError returns true if error is `XRPTokenNotMigratable`.
+func IsXRPTokenNotMigratableError(err error) bool {
+	return isError(err, "XRPTokenNotMigratable")
+}
+
+// IsXRPLTokenHasPendingOperationsError returns true if error is `XRPLTokenHasPendingOperations`.
+func IsXRPLTokenHasPendingOperationsError(err error) bool {
+	return isError(err, "XRPLTokenHasPendingOperations")
+}
+
+// IsDisabledXRPLTokenNotMigratableError returns true if error is `DisabledXRPLTokenNotMigratable`.
+func IsDisabledXRPLTokenNotMigratableError(err error) bool {
+	return isError(err, "DisabledXRPLTokenNotMigratable")
+}
+
+// IsMigratedXRPLTokenNotFoundError returns true if error is `MigratedXRPLTokenNotFound`.
+func IsMigratedXRPLTokenNotFoundError(err error) bool {
+	return isError(err, "MigratedXRPLTokenNotFound")
+}
+
+// IsNoTreasuryFeesToClaimError returns true if error is `NoTreasuryFeesToClaim`.
+func IsNoTreasuryFeesToClaimError(err error) bool {
+	return isError(err, "NoTreasuryFeesToClaim")
+}
+
 // IsInvalidTransactionResultEvidenceError returns true if error is `InvalidTransactionResultEvidence`.
 func IsInvalidTransactionResultEvidenceError(err error) bool {
 	return isError(err, "InvalidTransactionResultEvidence")
@@ -2113,8 +3659,18 @@ func IsInvalidTicketAllocationEvidenceError(err error) bool {
 	return isError(err, "InvalidTicketAllocationEvidence")
 }
 
+// IsPendingIBCTransferNotFoundError returns true if error is `PendingIBCTransferNotFound`.
+func IsPendingIBCTransferNotFoundError(err error) bool {
+	return isError(err, "PendingIBCTransferNotFound")
+}
+
 // ******************** Asset FT errors ********************
 
+// IsCheckCashTokenNotSupportedError returns true if error is `CheckCashTokenNotSupported`.
+func IsCheckCashTokenNotSupportedError(err error) bool {
+	return isError(err, "CheckCashTokenNotSupported")
+}
+
 // IsAssetFTStateError returns true if the error is caused by enabled asset FT features.
 func IsAssetFTStateError(err error) bool {
 	return IsAssetFTFreezingError(err) ||
@@ -0,0 +1,627 @@
+package coreum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=contract_events_mocks_test.go -package=coreum_test . ContractTxsProvider,ContractEventsMetricRegistry
+
+// EventSource is the source of the contract events.
+type EventSource string
+
+// EventSource values.
+const (
+	EventSourcePoll      EventSource = "poll"
+	EventSourceWebSocket EventSource = "websocket"
+)
+
+const (
+	webSocketEndpoint = "/websocket"
+
+	subscribeRequestID = rpctypes.JSONRPCIntID(1)
+	statusRequestID    = rpctypes.JSONRPCIntID(2)
+)
+
+// TokenStateChangedEventType is the type of the event emitted by the contract on the token state transition.
+const TokenStateChangedEventType = wasmtypes.CustomContractEventPrefix + "token_state_changed"
+
+// OperationCreatedEventType is the type of the event emitted by the contract on the pending operation creation, with
+// the operation_id, operation_unique_id, operation_type and xrpl_base_fee attributes.
+const OperationCreatedEventType = wasmtypes.CustomContractEventPrefix + "operation_created"
+
+// OperationsVersionBumpedEventType is the type of the event emitted by the contract on the XRPL base fee update and on
+// the keys rotation confirmation, which increments the versions of all pending operations and drops their signatures.
+const OperationsVersionBumpedEventType = wasmtypes.CustomContractEventPrefix + "operations_version_bumped"
+
+// Operations version bumped event attributes.
+const (
+	eventAttributeXRPLBaseFee     = "xrpl_base_fee"
+	eventAttributeOperationsCount = "operations_count"
+)
+
+// XRPL to Coreum transfer save evidence event attributes.
+const (
+	eventAttributeIssuer   = "issuer"
+	eventAttributeCurrency = "currency"
+	eventAttributeAmount   = "amount"
+)
+
+// Token state changed event attributes.
+const (
+	eventAttributeTokenDenom = "token_denom"
+	eventAttributeOldState   = "old_state"
+	eventAttributeNewState   = "new_state"
+	eventAttributeActor      = "actor"
+	eventAttributeTimestamp  = "timestamp"
+)
+
+// TokenStateChange is the token state transition audited by the contract.
+type TokenStateChange struct {
+	TokenDenom string
+	OldState   TokenState
+	NewState   TokenState
+	Actor      sdk.AccAddress
+	Timestamp  time.Time
+}
+
+// XRPLToCoreumTransfer is the XRPL to Coreum transfer which evidence has reached the threshold in the contract tx.
+type XRPLToCoreumTransfer struct {
+	XRPLTxHash string
+	Issuer     string
+	Currency   string
+	// Amount is the XRPL amount of the evidence before the bridging fees.
+	Amount    sdkmath.Int
+	Recipient sdk.AccAddress
+	// ReceivedCoins are the coins received by the recipient, so the amount after the bridging fees and truncation.
+	ReceivedCoins sdk.Coins
+}
+
+// OperationsVersionBump is the version increment of the pending operations caused by the XRPL base fee update or by the
+// keys rotation, which changes the expected signers count and hence the tx fee. The signatures of the previous
+// versions are dropped by the contract, and all pending operations get the XRPLBaseFee.
+type OperationsVersionBump struct {
+	XRPLBaseFee uint32
+	// OperationsCount is the number of the bumped pending operations.
+	OperationsCount uint32
+}
+
+// ContractTx is the contract transaction observed by the ContractEventsSubscriber.
+type ContractTx struct {
+	Hash                  string
+	Height                int64
+	TokenStateChanges     []TokenStateChange
+	XRPLToCoreumTransfers []XRPLToCoreumTransfer
+	// OperationsVersionBump is set if the tx has bumped the pending operations version.
+	OperationsVersionBump *OperationsVersionBump
+}
+
+// ContractTxsProvider provides the contract txs to fill the gaps in the events subscription.
+type ContractTxsProvider interface {
+	GetContractTxs(ctx context.Context, startHeight, endHeight int64) ([]*sdk.TxResponse, error)
+}
+
+// ContractEventsMetricRegistry is the contract events subscriber metric registry.
+type ContractEventsMetricRegistry interface {
+	IncrementCoreumContractEventsReconnectCounter()
+	SetCoreumContractEventsGapFillRange(startHeight, endHeight float64)
+}
+
+// ContractEventsSubscriberConfig is the ContractEventsSubscriber config.
+type ContractEventsSubscriberConfig struct {
+	RPCURL          string
+	ContractAddress sdk.AccAddress
+	ReconnectDelay  time.Duration
+	// ReadTimeout is the max time without any message or ping from the node after which the connection is
+	// considered as dropped.
+	ReadTimeout time.Duration
+}
+
+// DefaultContractEventsSubscriberConfig returns the default ContractEventsSubscriberConfig.
+func DefaultContractEventsSubscriberConfig(rpcURL string, contractAddress sdk.AccAddress) ContractEventsSubscriberConfig {
+	return ContractEventsSubscriberConfig{
+		RPCURL:          rpcURL,
+		ContractAddress: contractAddress,
+		ReconnectDelay:  5 * time.Second,
+		ReadTimeout:     time.Minute,
+	}
+}
+
+// ContractEventsSubscriber subscribes to the contract txs with the Tendermint RPC websocket.
+type ContractEventsSubscriber struct {
+	cfg            ContractEventsSubscriberConfig
+	log            logger.Logger
+	txsProvider    ContractTxsProvider
+	metricRegistry ContractEventsMetricRegistry
+}
+
+// NewContractEventsSubscriber returns a new instance of the ContractEventsSubscriber.
+func NewContractEventsSubscriber(
+	cfg ContractEventsSubscriberConfig,
+	log logger.Logger,
+	txsProvider ContractTxsProvider,
+	metricRegistry ContractEventsMetricRegistry,
+) *ContractEventsSubscriber {
+	return &ContractEventsSubscriber{
+		cfg:            cfg,
+		log:            log,
+		txsProvider:    txsProvider,
+		metricRegistry: metricRegistry,
+	}
+}
+
+// Subscribe subscribes to the contract txs and sends them to the channel. The dropped connection is re-established,
+// and the txs executed while it was down are fetched with the tx search starting from the last seen height. Each tx
+// is sent to the channel once.
+func (s *ContractEventsSubscriber) Subscribe(ctx context.Context, ch chan<- ContractTx) error {
+	wsURL, err := buildWebSocketURL(s.cfg.RPCURL)
+	if err != nil {
+		return err
+	}
+	s.log.Info(ctx, "Subscribing to the contract events", zap.String("url", wsURL))
+
+	state := &contractEventsState{
+		seenTxs: make(map[string]int64),
+	}
+	for {
+		err := s.subscribe(ctx, wsURL, state, ch)
+		if ctx.Err() != nil {
+			return errors.WithStack(ctx.Err())
+		}
+		s.log.Warn(
+			ctx,
+			"Contract events subscription is dropped, reconnecting",
+			zap.Error(err),
+			zap.Int64("lastSeenHeight", state.lastSeenHeight),
+			zap.Duration("reconnectDelay", s.cfg.ReconnectDelay),
+		)
+		s.metricRegistry.IncrementCoreumContractEventsReconnectCounter()
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(s.cfg.ReconnectDelay):
+		}
+	}
+}
+
+type contractEventsState struct {
+	lastSeenHeight int64
+	// seenTxs is the tx hash to height map of the recently sent txs used for the de-duplication.
+	seenTxs map[string]int64
+}
+
+type resultEvent struct {
+	Query string `json:"query"`
+	// Data contains the tx events in the emitted order, unlike the flattened Events.
+	Data struct {
+		Value struct {
+			TxResult struct {
+				Result struct {
+					Events []abci.Event `json:"events"`
+				} `json:"result"`
+			} `json:"TxResult"` //nolint:tagliatelle // the Tendermint RPC format
+		} `json:"value"`
+	} `json:"data"`
+	Events map[string][]string `json:"events"`
+}
+
+type resultStatus struct {
+	SyncInfo struct {
+		LatestBlockHeight string `json:"latest_block_height"`
+	} `json:"sync_info"`
+}
+
+func (s *ContractEventsSubscriber) subscribe(
+	ctx context.Context,
+	wsURL string,
+	state *contractEventsState,
+	ch chan<- ContractTx,
+) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to dial websocket, url:%s", wsURL)
+	}
+	defer conn.Close()
+
+	// the blocking read is interrupted by closing the connection
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-stopCh:
+		}
+	}()
+
+	conn.SetPingHandler(func(appData string) error {
+		if err := conn.SetReadDeadline(time.Now().Add(s.cfg.ReadTimeout)); err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(
+			conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(s.cfg.ReadTimeout)),
+		)
+	})
+
+	query := fmt.Sprintf(
+		"tm.event='Tx' AND %s.%s='%s'",
+		wasmtypes.WasmModuleEventType, wasmtypes.AttributeKeyContractAddr, s.cfg.ContractAddress.String(),
+	)
+	if err := writeRPCRequest(conn, subscribeRequestID, "subscribe", map[string]interface{}{"query": query}); err != nil {
+		return err
+	}
+	// the status is requested after the subscription, so the gap fill covers all heights before the first event
+	if err := writeRPCRequest(conn, statusRequestID, "status", map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	// the seen txs starting from the gap start height are kept until the gap is filled
+	gapStartHeight := state.lastSeenHeight
+	retainFromHeight := gapStartHeight
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(s.cfg.ReadTimeout)); err != nil {
+			return errors.WithStack(err)
+		}
+		var res rpctypes.RPCResponse
+		if err := conn.ReadJSON(&res); err != nil {
+			return errors.Wrap(err, "failed to read websocket message")
+		}
+		if res.Error != nil {
+			return errors.Errorf("received RPC error, id:%v, error:%s", res.ID, res.Error.Error())
+		}
+
+		if res.ID == statusRequestID {
+			if err := s.fillGap(ctx, res.Result, gapStartHeight, state, ch); err != nil {
+				return err
+			}
+			retainFromHeight = math.MaxInt64
+			continue
+		}
+
+		var event resultEvent
+		if err := json.Unmarshal(res.Result, &event); err != nil {
+			return errors.Wrapf(err, "failed to decode websocket event, event:%s", string(res.Result))
+		}
+		// the subscription confirmation has an empty result
+		if event.Query == "" {
+			continue
+		}
+		contractTx, err := decodeContractTxFromEvents(event.Events, event.Data.Value.TxResult.Result.Events)
+		if err != nil {
+			return err
+		}
+		if err := s.sendTx(ctx, contractTx, retainFromHeight, state, ch); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *ContractEventsSubscriber) fillGap(
+	ctx context.Context,
+	statusResult json.RawMessage,
+	gapStartHeight int64,
+	state *contractEventsState,
+	ch chan<- ContractTx,
+) error {
+	var status resultStatus
+	if err := json.Unmarshal(statusResult, &status); err != nil {
+		return errors.Wrapf(err, "failed to decode status, status:%s", string(statusResult))
+	}
+	latestHeight, err := strconv.ParseInt(status.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse latest block height, height:%s", status.SyncInfo.LatestBlockHeight)
+	}
+
+	// nothing is missed on the first subscription
+	if gapStartHeight == 0 {
+		if latestHeight > state.lastSeenHeight {
+			state.lastSeenHeight = latestHeight
+		}
+		return nil
+	}
+
+	s.log.Info(
+		ctx,
+		"Filling the contract events gap",
+		zap.Int64("startHeight", gapStartHeight),
+		zap.Int64("endHeight", latestHeight),
+	)
+	s.metricRegistry.SetCoreumContractEventsGapFillRange(float64(gapStartHeight), float64(latestHeight))
+	txs, err := s.txsProvider.GetContractTxs(ctx, gapStartHeight, latestHeight)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get contract txs, startHeight:%d, endHeight:%d", gapStartHeight, latestHeight)
+	}
+	// the txs are returned in descending order
+	for i := len(txs) - 1; i >= 0; i-- {
+		tokenStateChanges, err := decodeTokenStateChanges(abciEventsToMap(txs[i].Events))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode token state changes, tx:%s", txs[i].TxHash)
+		}
+		xrplToCoreumTransfers, err := decodeXRPLToCoreumTransfers(txs[i].Events)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode XRPL to Coreum transfers, tx:%s", txs[i].TxHash)
+		}
+		operationsVersionBump, err := decodeOperationsVersionBump(abciEventsToMap(txs[i].Events))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode operations version bump, tx:%s", txs[i].TxHash)
+		}
+		if err := s.sendTx(ctx, ContractTx{
+			Hash:                  txs[i].TxHash,
+			Height:                txs[i].Height,
+			TokenStateChanges:     tokenStateChanges,
+			XRPLToCoreumTransfers: xrplToCoreumTransfers,
+			OperationsVersionBump: operationsVersionBump,
+		}, gapStartHeight, state, ch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ContractEventsSubscriber) sendTx(
+	ctx context.Context,
+	contractTx ContractTx,
+	retainFromHeight int64,
+	state *contractEventsState,
+	ch chan<- ContractTx,
+) error {
+	if _, ok := state.seenTxs[contractTx.Hash]; ok {
+		s.log.Debug(ctx, "Skipping already seen contract tx", zap.String("txHash", contractTx.Hash))
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return errors.WithStack(ctx.Err())
+	case ch <- contractTx:
+	}
+
+	state.seenTxs[contractTx.Hash] = contractTx.Height
+	if contractTx.Height > state.lastSeenHeight {
+		state.lastSeenHeight = contractTx.Height
+	}
+	pruneBelowHeight := lo.Min([]int64{state.lastSeenHeight, retainFromHeight})
+	for hash, height := range state.seenTxs {
+		if height < pruneBelowHeight {
+			delete(state.seenTxs, hash)
+		}
+	}
+
+	return nil
+}
+
+func decodeContractTxFromEvents(events map[string][]string, abciEvents []abci.Event) (ContractTx, error) {
+	hashes := events["tx.hash"]
+	heights := events["tx.height"]
+	if len(hashes) != 1 || len(heights) != 1 {
+		return ContractTx{}, errors.Errorf("unexpected tx event, events:%v", events)
+	}
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return ContractTx{}, errors.Wrapf(err, "failed to parse tx height, height:%s", heights[0])
+	}
+	tokenStateChanges, err := decodeTokenStateChanges(events)
+	if err != nil {
+		return ContractTx{}, errors.Wrapf(err, "failed to decode token state changes, tx:%s", hashes[0])
+	}
+	xrplToCoreumTransfers, err := decodeXRPLToCoreumTransfers(abciEvents)
+	if err != nil {
+		return ContractTx{}, errors.Wrapf(err, "failed to decode XRPL to Coreum transfers, tx:%s", hashes[0])
+	}
+	operationsVersionBump, err := decodeOperationsVersionBump(events)
+	if err != nil {
+		return ContractTx{}, errors.Wrapf(err, "failed to decode operations version bump, tx:%s", hashes[0])
+	}
+
+	return ContractTx{
+		Hash:                  hashes[0],
+		Height:                height,
+		TokenStateChanges:     tokenStateChanges,
+		XRPLToCoreumTransfers: xrplToCoreumTransfers,
+		OperationsVersionBump: operationsVersionBump,
+	}, nil
+}
+
+// decodeOperationsVersionBump decodes the operations version bump from the events flattened to the "type.key" to
+// values map, nil is returned if the tx hasn't bumped the version.
+func decodeOperationsVersionBump(events map[string][]string) (*OperationsVersionBump, error) {
+	attributeValues := func(key string) []string {
+		return events[OperationsVersionBumpedEventType+"."+key]
+	}
+	xrplBaseFees := attributeValues(eventAttributeXRPLBaseFee)
+	if len(xrplBaseFees) == 0 {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	operationsCounts := attributeValues(eventAttributeOperationsCount)
+	if len(operationsCounts) != len(xrplBaseFees) {
+		return nil, errors.Errorf("inconsistent operations version bumped event attributes, events:%v", events)
+	}
+
+	// the last fee is the effective one if the tx has updated it several times, and the last update bumps all pending
+	// operations
+	xrplBaseFee, err := strconv.ParseUint(xrplBaseFees[len(xrplBaseFees)-1], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse XRPL base fee, fee:%s", xrplBaseFees[len(xrplBaseFees)-1])
+	}
+	operationsCount, err := strconv.ParseUint(operationsCounts[len(operationsCounts)-1], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to parse operations count, count:%s", operationsCounts[len(operationsCounts)-1],
+		)
+	}
+
+	return &OperationsVersionBump{
+		XRPLBaseFee:     uint32(xrplBaseFee),
+		OperationsCount: uint32(operationsCount),
+	}, nil
+}
+
+// decodeTokenStateChanges decodes the token state changes from the events flattened to the "type.key" to values map,
+// the values of the same key are ordered by the events order.
+func decodeTokenStateChanges(events map[string][]string) ([]TokenStateChange, error) {
+	attributeValues := func(key string) []string {
+		return events[TokenStateChangedEventType+"."+key]
+	}
+	denoms := attributeValues(eventAttributeTokenDenom)
+	oldStates := attributeValues(eventAttributeOldState)
+	newStates := attributeValues(eventAttributeNewState)
+	actors := attributeValues(eventAttributeActor)
+	timestamps := attributeValues(eventAttributeTimestamp)
+	for _, values := range [][]string{oldStates, newStates, actors, timestamps} {
+		if len(values) != len(denoms) {
+			return nil, errors.Errorf("inconsistent token state changed event attributes, events:%v", events)
+		}
+	}
+	if len(denoms) == 0 {
+		return nil, nil
+	}
+
+	tokenStateChanges := make([]TokenStateChange, 0, len(denoms))
+	for i := range denoms {
+		actor, err := sdk.AccAddressFromBech32(actors[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse token state change actor, actor:%s", actors[i])
+		}
+		timestamp, err := strconv.ParseInt(timestamps[i], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to parse token state change timestamp, timestamp:%s", timestamps[i],
+			)
+		}
+		tokenStateChanges = append(tokenStateChanges, TokenStateChange{
+			TokenDenom: denoms[i],
+			OldState:   TokenState(oldStates[i]),
+			NewState:   TokenState(newStates[i]),
+			Actor:      actor,
+			Timestamp:  time.Unix(timestamp, 0).UTC(),
+		})
+	}
+
+	return tokenStateChanges, nil
+}
+
+// decodeXRPLToCoreumTransfers decodes the XRPL to Coreum transfers which evidences have reached the threshold. The
+// coins received by the recipient are taken from the bank events following the save evidence event, since the
+// contract messages are dispatched after its event is emitted.
+func decodeXRPLToCoreumTransfers(events []abci.Event) ([]XRPLToCoreumTransfer, error) {
+	var (
+		transfers []XRPLToCoreumTransfer
+		current   *XRPLToCoreumTransfer
+	)
+	for _, ev := range events {
+		attributes := lo.SliceToMap(ev.Attributes, func(attr abci.EventAttribute) (string, string) {
+			return attr.Key, attr.Value
+		})
+		switch ev.Type {
+		case wasmtypes.WasmModuleEventType:
+			if attributes[eventAttributeAction] != eventValueSaveAction {
+				continue
+			}
+			current = nil
+			xrplTxHash, ok := attributes[eventAttributeHash]
+			// the hash attribute is set for the XRPL to Coreum transfer evidence only
+			if !ok || attributes[eventAttributeThresholdReached] != "true" {
+				continue
+			}
+			amount, ok := sdkmath.NewIntFromString(attributes[eventAttributeAmount])
+			if !ok {
+				return nil, errors.Errorf("failed to parse transfer amount, amount:%s", attributes[eventAttributeAmount])
+			}
+			recipient, err := sdk.AccAddressFromBech32(attributes[eventAttributeRecipient])
+			if err != nil {
+				return nil, errors.Wrapf(
+					err, "failed to parse transfer recipient, recipient:%s", attributes[eventAttributeRecipient],
+				)
+			}
+			transfers = append(transfers, XRPLToCoreumTransfer{
+				XRPLTxHash:    xrplTxHash,
+				Issuer:        attributes[eventAttributeIssuer],
+				Currency:      attributes[eventAttributeCurrency],
+				Amount:        amount,
+				Recipient:     recipient,
+				ReceivedCoins: sdk.NewCoins(),
+			})
+			current = &transfers[len(transfers)-1]
+		case banktypes.EventTypeCoinReceived:
+			if current == nil || attributes[banktypes.AttributeKeyReceiver] != current.Recipient.String() {
+				continue
+			}
+			receivedCoins, err := sdk.ParseCoinsNormalized(attributes[sdk.AttributeKeyAmount])
+			if err != nil {
+				return nil, errors.Wrapf(
+					err, "failed to parse received coins, amount:%s", attributes[sdk.AttributeKeyAmount],
+				)
+			}
+			current.ReceivedCoins = current.ReceivedCoins.Add(receivedCoins...)
+		}
+	}
+
+	return transfers, nil
+}
+
+// abciEventsToMap flattens the events to the same "type.key" to values map the websocket subscription returns.
+func abciEventsToMap(events []abci.Event) map[string][]string {
+	eventsMap := make(map[string][]string)
+	for _, ev := range events {
+		for _, attr := range ev.Attributes {
+			key := ev.Type + "." + attr.Key
+			eventsMap[key] = append(eventsMap[key], attr.Value)
+		}
+	}
+
+	return eventsMap
+}
+
+func writeRPCRequest(
+	conn *websocket.Conn,
+	id rpctypes.JSONRPCIntID,
+	method string,
+	params map[string]interface{},
+) error {
+	req, err := rpctypes.MapToRequest(id, method, params)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build RPC request, method:%s", method)
+	}
+	if err := conn.WriteJSON(req); err != nil {
+		return errors.Wrapf(err, "failed to write RPC request, method:%s", method)
+	}
+
+	return nil
+}
+
+func buildWebSocketURL(rpcURL string) (string, error) {
+	parsedURL, err := url.Parse(rpcURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse RPC URL, url:%s", rpcURL)
+	}
+	switch parsedURL.Scheme {
+	case "http", "ws":
+		parsedURL.Scheme = "ws"
+	case "https", "wss":
+		parsedURL.Scheme = "wss"
+	default:
+		return "", errors.Errorf("unsupported RPC URL scheme, url:%s", rpcURL)
+	}
+	parsedURL.Path = webSocketEndpoint
+
+	return parsedURL.String(), nil
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum (interfaces: ContractTxsProvider,ContractEventsMetricRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -destination=contract_events_mocks_test.go -package=coreum_test . ContractTxsProvider,ContractEventsMetricRegistry
+//
+
+// Package coreum_test is a generated GoMock package.
+package coreum_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContractTxsProvider is a mock of ContractTxsProvider interface.
+type MockContractTxsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractTxsProviderMockRecorder
+}
+
+// MockContractTxsProviderMockRecorder is the mock recorder for MockContractTxsProvider.
+type MockContractTxsProviderMockRecorder struct {
+	mock *MockContractTxsProvider
+}
+
+// NewMockContractTxsProvider creates a new mock instance.
+func NewMockContractTxsProvider(ctrl *gomock.Controller) *MockContractTxsProvider {
+	mock := &MockContractTxsProvider{ctrl: ctrl}
+	mock.recorder = &MockContractTxsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractTxsProvider) EXPECT() *MockContractTxsProviderMockRecorder {
+	return m.recorder
+}
+
+// GetContractTxs mocks base method.
+func (m *MockContractTxsProvider) GetContractTxs(arg0 context.Context, arg1, arg2 int64) ([]*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractTxs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractTxs indicates an expected call of GetContractTxs.
+func (mr *MockContractTxsProviderMockRecorder) GetContractTxs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractTxs", reflect.TypeOf((*MockContractTxsProvider)(nil).GetContractTxs), arg0, arg1, arg2)
+}
+
+// MockContractEventsMetricRegistry is a mock of ContractEventsMetricRegistry interface.
+type MockContractEventsMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractEventsMetricRegistryMockRecorder
+}
+
+// MockContractEventsMetricRegistryMockRecorder is the mock recorder for MockContractEventsMetricRegistry.
+type MockContractEventsMetricRegistryMockRecorder struct {
+	mock *MockContractEventsMetricRegistry
+}
+
+// NewMockContractEventsMetricRegistry creates a new mock instance.
+func NewMockContractEventsMetricRegistry(ctrl *gomock.Controller) *MockContractEventsMetricRegistry {
+	mock := &MockContractEventsMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockContractEventsMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractEventsMetricRegistry) EXPECT() *MockContractEventsMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementCoreumContractEventsReconnectCounter mocks base method.
+func (m *MockContractEventsMetricRegistry) IncrementCoreumContractEventsReconnectCounter() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCoreumContractEventsReconnectCounter")
+}
+
+// IncrementCoreumContractEventsReconnectCounter indicates an expected call of IncrementCoreumContractEventsReconnectCounter.
+func (mr *MockContractEventsMetricRegistryMockRecorder) IncrementCoreumContractEventsReconnectCounter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCoreumContractEventsReconnectCounter", reflect.TypeOf((*MockContractEventsMetricRegistry)(nil).IncrementCoreumContractEventsReconnectCounter))
+}
+
+// SetCoreumContractEventsGapFillRange mocks base method.
+func (m *MockContractEventsMetricRegistry) SetCoreumContractEventsGapFillRange(arg0, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCoreumContractEventsGapFillRange", arg0, arg1)
+}
+
+// SetCoreumContractEventsGapFillRange indicates an expected call of SetCoreumContractEventsGapFillRange.
+func (mr *MockContractEventsMetricRegistryMockRecorder) SetCoreumContractEventsGapFillRange(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoreumContractEventsGapFillRange", reflect.TypeOf((*MockContractEventsMetricRegistry)(nil).SetCoreumContractEventsGapFillRange), arg0, arg1)
+}
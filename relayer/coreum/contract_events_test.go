@@ -0,0 +1,586 @@
+package coreum_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestContractEventsSubscriber_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	contractAddress := coreum.GenAccount()
+	server := newMockWSServer(t, contractAddress)
+
+	ctrl := gomock.NewController(t)
+	txsProviderMock := NewMockContractTxsProvider(ctrl)
+	metricRegistryMock := NewMockContractEventsMetricRegistry(ctrl)
+
+	cfg := coreum.DefaultContractEventsSubscriberConfig(server.URL(), contractAddress)
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	subscriber := coreum.NewContractEventsSubscriber(
+		cfg, logger.NewAnyLogMock(ctrl), txsProviderMock, metricRegistryMock,
+	)
+
+	ch := make(chan coreum.ContractTx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- subscriber.Subscribe(ctx, ch)
+	}()
+
+	// the first subscription doesn't fill the gap
+	conn := server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 10)
+	conn.SendTxEvent(t, "tx1", 11)
+	requireContractTx(t, ch, "tx1", 11)
+	conn.SendTxEvent(t, "tx2", 12)
+	requireContractTx(t, ch, "tx2", 12)
+	// the duplicated event is skipped
+	conn.SendTxEvent(t, "tx2", 12)
+
+	// the dropped connection is re-established and the gap is filled from the last seen height
+	metricRegistryMock.EXPECT().IncrementCoreumContractEventsReconnectCounter()
+	metricRegistryMock.EXPECT().SetCoreumContractEventsGapFillRange(float64(12), float64(15))
+	txsProviderMock.EXPECT().GetContractTxs(gomock.Any(), int64(12), int64(15)).Return([]*sdk.TxResponse{
+		{TxHash: "tx4", Height: 15},
+		{TxHash: "tx3", Height: 13},
+		{TxHash: "tx2", Height: 12},
+	}, nil)
+	conn.Close(t)
+
+	conn = server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	// the tx received by the websocket before the gap fill
+	conn.SendTxEvent(t, "tx4", 15)
+	requireContractTx(t, ch, "tx4", 15)
+	conn.RespondStatus(t, 15)
+	// the txs seen by both paths are sent once
+	requireContractTx(t, ch, "tx3", 13)
+	conn.SendTxEvent(t, "tx5", 16)
+	requireContractTx(t, ch, "tx5", 16)
+
+	cancel()
+	select {
+	case err := <-errCh:
+		require.True(t, errors.Is(err, context.Canceled), err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscriber isn't stopped")
+	}
+}
+
+func TestContractEventsSubscriber_RPCError(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	contractAddress := coreum.GenAccount()
+	server := newMockWSServer(t, contractAddress)
+
+	ctrl := gomock.NewController(t)
+	metricRegistryMock := NewMockContractEventsMetricRegistry(ctrl)
+
+	cfg := coreum.DefaultContractEventsSubscriberConfig(server.URL(), contractAddress)
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	subscriber := coreum.NewContractEventsSubscriber(
+		cfg, logger.NewAnyLogMock(ctrl), NewMockContractTxsProvider(ctrl), metricRegistryMock,
+	)
+
+	ch := make(chan coreum.ContractTx)
+	go func() {
+		_ = subscriber.Subscribe(ctx, ch)
+	}()
+
+	// the rejected subscription is repeated
+	reconnectedCh := make(chan struct{})
+	metricRegistryMock.EXPECT().IncrementCoreumContractEventsReconnectCounter().Do(func() {
+		close(reconnectedCh)
+	})
+	conn := server.AwaitConnection(t)
+	conn.RespondError(t, "subscription is rejected")
+	select {
+	case <-reconnectedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscriber isn't reconnected")
+	}
+
+	conn = server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 1)
+	conn.SendTxEvent(t, "tx1", 2)
+	requireContractTx(t, ch, "tx1", 2)
+}
+
+func TestContractEventsSubscriber_TokenStateChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	contractAddress := coreum.GenAccount()
+	server := newMockWSServer(t, contractAddress)
+
+	ctrl := gomock.NewController(t)
+	txsProviderMock := NewMockContractTxsProvider(ctrl)
+	metricRegistryMock := NewMockContractEventsMetricRegistry(ctrl)
+
+	cfg := coreum.DefaultContractEventsSubscriberConfig(server.URL(), contractAddress)
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	subscriber := coreum.NewContractEventsSubscriber(
+		cfg, logger.NewAnyLogMock(ctrl), txsProviderMock, metricRegistryMock,
+	)
+
+	ch := make(chan coreum.ContractTx)
+	go func() {
+		_ = subscriber.Subscribe(ctx, ch)
+	}()
+
+	actor := coreum.GenAccount()
+	timestamp := time.Unix(1700000000, 0).UTC()
+	disabled := coreum.TokenStateChange{
+		TokenDenom: "denom1",
+		OldState:   coreum.TokenStateEnabled,
+		NewState:   coreum.TokenStateDisabled,
+		Actor:      actor,
+		Timestamp:  timestamp,
+	}
+	enabled := coreum.TokenStateChange{
+		TokenDenom: "denom2",
+		OldState:   coreum.TokenStateDisabled,
+		NewState:   coreum.TokenStateEnabled,
+		Actor:      actor,
+		Timestamp:  timestamp,
+	}
+
+	conn := server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 10)
+	events := map[string][]string{
+		"tx.hash":   {"tx1"},
+		"tx.height": {"11"},
+	}
+	for _, stateChange := range []coreum.TokenStateChange{disabled, enabled} {
+		for key, value := range tokenStateChangeAttributes(stateChange) {
+			eventKey := coreum.TokenStateChangedEventType + "." + key
+			events[eventKey] = append(events[eventKey], value)
+		}
+	}
+	conn.SendEvents(t, events)
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:              "tx1",
+		Height:            11,
+		TokenStateChanges: []coreum.TokenStateChange{disabled, enabled},
+	})
+
+	// the state changes of the gap fill txs are decoded from the tx events
+	metricRegistryMock.EXPECT().IncrementCoreumContractEventsReconnectCounter()
+	metricRegistryMock.EXPECT().SetCoreumContractEventsGapFillRange(float64(11), float64(12))
+	attributes := make([]abci.EventAttribute, 0)
+	for key, value := range tokenStateChangeAttributes(enabled) {
+		attributes = append(attributes, abci.EventAttribute{Key: key, Value: value})
+	}
+	txsProviderMock.EXPECT().GetContractTxs(gomock.Any(), int64(11), int64(12)).Return([]*sdk.TxResponse{
+		{
+			TxHash: "tx2",
+			Height: 12,
+			Events: []abci.Event{{
+				Type:       coreum.TokenStateChangedEventType,
+				Attributes: attributes,
+			}},
+		},
+	}, nil)
+	conn.Close(t)
+
+	conn = server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 12)
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:              "tx2",
+		Height:            12,
+		TokenStateChanges: []coreum.TokenStateChange{enabled},
+	})
+}
+
+func TestContractEventsSubscriber_OperationsVersionBump(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	contractAddress := coreum.GenAccount()
+	server := newMockWSServer(t, contractAddress)
+
+	ctrl := gomock.NewController(t)
+	txsProviderMock := NewMockContractTxsProvider(ctrl)
+	metricRegistryMock := NewMockContractEventsMetricRegistry(ctrl)
+
+	cfg := coreum.DefaultContractEventsSubscriberConfig(server.URL(), contractAddress)
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	subscriber := coreum.NewContractEventsSubscriber(
+		cfg, logger.NewAnyLogMock(ctrl), txsProviderMock, metricRegistryMock,
+	)
+
+	ch := make(chan coreum.ContractTx)
+	go func() {
+		_ = subscriber.Subscribe(ctx, ch)
+	}()
+
+	conn := server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 10)
+	conn.SendEvents(t, map[string][]string{
+		"tx.hash":   {"tx1"},
+		"tx.height": {"11"},
+		coreum.OperationsVersionBumpedEventType + ".xrpl_base_fee":    {"20"},
+		coreum.OperationsVersionBumpedEventType + ".operations_count": {"2"},
+	})
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:   "tx1",
+		Height: 11,
+		OperationsVersionBump: &coreum.OperationsVersionBump{
+			XRPLBaseFee:     20,
+			OperationsCount: 2,
+		},
+	})
+
+	// the version bump without the pending operations is decoded from the gap fill tx events
+	metricRegistryMock.EXPECT().IncrementCoreumContractEventsReconnectCounter()
+	metricRegistryMock.EXPECT().SetCoreumContractEventsGapFillRange(float64(11), float64(12))
+	txsProviderMock.EXPECT().GetContractTxs(gomock.Any(), int64(11), int64(12)).Return([]*sdk.TxResponse{
+		{
+			TxHash: "tx2",
+			Height: 12,
+			Events: []abci.Event{{
+				Type: coreum.OperationsVersionBumpedEventType,
+				Attributes: []abci.EventAttribute{
+					{Key: "xrpl_base_fee", Value: "30"},
+					{Key: "operations_count", Value: "0"},
+				},
+			}},
+		},
+	}, nil)
+	conn.Close(t)
+
+	conn = server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 12)
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:   "tx2",
+		Height: 12,
+		OperationsVersionBump: &coreum.OperationsVersionBump{
+			XRPLBaseFee:     30,
+			OperationsCount: 0,
+		},
+	})
+}
+
+func TestContractEventsSubscriber_XRPLToCoreumTransfers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	contractAddress := coreum.GenAccount()
+	server := newMockWSServer(t, contractAddress)
+
+	ctrl := gomock.NewController(t)
+	txsProviderMock := NewMockContractTxsProvider(ctrl)
+	metricRegistryMock := NewMockContractEventsMetricRegistry(ctrl)
+
+	cfg := coreum.DefaultContractEventsSubscriberConfig(server.URL(), contractAddress)
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	subscriber := coreum.NewContractEventsSubscriber(
+		cfg, logger.NewAnyLogMock(ctrl), txsProviderMock, metricRegistryMock,
+	)
+
+	ch := make(chan coreum.ContractTx)
+	go func() {
+		_ = subscriber.Subscribe(ctx, ch)
+	}()
+
+	recipient := coreum.GenAccount()
+	transfer := coreum.XRPLToCoreumTransfer{
+		XRPLTxHash:    "xrpl-tx-hash",
+		Issuer:        "issuer",
+		Currency:      "currency",
+		Amount:        sdkmath.NewInt(1000),
+		Recipient:     recipient,
+		ReceivedCoins: sdk.NewCoins(sdk.NewInt64Coin("denom", 990)),
+	}
+	thresholdReachedEvents := []abci.Event{
+		saveEvidenceEvent(transfer, true),
+		// the fees minted to the contract aren't received by the recipient
+		coinReceivedEvent(contractAddress, "10denom"),
+		coinReceivedEvent(recipient, "990denom"),
+	}
+
+	conn := server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 10)
+	conn.SendTxResultEvents(t, "tx1", 11, thresholdReachedEvents)
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:                  "tx1",
+		Height:                11,
+		XRPLToCoreumTransfers: []coreum.XRPLToCoreumTransfer{transfer},
+	})
+	// the evidence which hasn't reached the threshold isn't a transfer
+	conn.SendTxResultEvents(t, "tx2", 12, []abci.Event{saveEvidenceEvent(transfer, false)})
+	requireContractTx(t, ch, "tx2", 12)
+
+	// the transfers of the gap fill txs are decoded from the tx events
+	metricRegistryMock.EXPECT().IncrementCoreumContractEventsReconnectCounter()
+	metricRegistryMock.EXPECT().SetCoreumContractEventsGapFillRange(float64(12), float64(13))
+	txsProviderMock.EXPECT().GetContractTxs(gomock.Any(), int64(12), int64(13)).Return([]*sdk.TxResponse{
+		{
+			TxHash: "tx3",
+			Height: 13,
+			Events: thresholdReachedEvents,
+		},
+	}, nil)
+	conn.Close(t)
+
+	conn = server.AwaitConnection(t)
+	conn.RespondSubscribe(t)
+	conn.RespondStatus(t, 13)
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{
+		Hash:                  "tx3",
+		Height:                13,
+		XRPLToCoreumTransfers: []coreum.XRPLToCoreumTransfer{transfer},
+	})
+}
+
+func saveEvidenceEvent(transfer coreum.XRPLToCoreumTransfer, thresholdReached bool) abci.Event {
+	return abci.Event{
+		Type: "wasm",
+		Attributes: []abci.EventAttribute{
+			{Key: "action", Value: "save_evidence"},
+			{Key: "hash", Value: transfer.XRPLTxHash},
+			{Key: "issuer", Value: transfer.Issuer},
+			{Key: "currency", Value: transfer.Currency},
+			{Key: "amount", Value: transfer.Amount.String()},
+			{Key: "recipient", Value: transfer.Recipient.String()},
+			{Key: "threshold_reached", Value: strconv.FormatBool(thresholdReached)},
+		},
+	}
+}
+
+func coinReceivedEvent(receiver sdk.AccAddress, amount string) abci.Event {
+	return abci.Event{
+		Type: "coin_received",
+		Attributes: []abci.EventAttribute{
+			{Key: "receiver", Value: receiver.String()},
+			{Key: "amount", Value: amount},
+		},
+	}
+}
+
+func tokenStateChangeAttributes(stateChange coreum.TokenStateChange) map[string]string {
+	return map[string]string{
+		"token_denom": stateChange.TokenDenom,
+		"old_state":   string(stateChange.OldState),
+		"new_state":   string(stateChange.NewState),
+		"actor":       stateChange.Actor.String(),
+		"timestamp":   strconv.FormatInt(stateChange.Timestamp.Unix(), 10),
+	}
+}
+
+func requireContractTx(t *testing.T, ch <-chan coreum.ContractTx, hash string, height int64) {
+	t.Helper()
+
+	requireContractTxWithStateChanges(t, ch, coreum.ContractTx{Hash: hash, Height: height})
+}
+
+func requireContractTxWithStateChanges(t *testing.T, ch <-chan coreum.ContractTx, expectedTx coreum.ContractTx) {
+	t.Helper()
+
+	select {
+	case contractTx := <-ch:
+		require.Equal(t, expectedTx, contractTx)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("contract tx %s isn't received", expectedTx.Hash)
+	}
+}
+
+// mockWSServer emulates the Tendermint RPC websocket endpoint, each accepted connection is passed to the test after
+// the subscribe and status requests are received.
+type mockWSServer struct {
+	server      *httptest.Server
+	connections chan *mockWSConn
+}
+
+func newMockWSServer(t *testing.T, contractAddress sdk.AccAddress) *mockWSServer {
+	t.Helper()
+
+	s := &mockWSServer{
+		connections: make(chan *mockWSConn),
+	}
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/websocket" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var subscribeReq, statusReq rpctypes.RPCRequest
+		if err := conn.ReadJSON(&subscribeReq); err != nil {
+			return
+		}
+		if err := conn.ReadJSON(&statusReq); err != nil {
+			return
+		}
+		subscribeID, subscribeIDOK := subscribeReq.ID.(rpctypes.JSONRPCIntID)
+		statusID, statusIDOK := statusReq.ID.(rpctypes.JSONRPCIntID)
+		wsConn := &mockWSConn{
+			conn:        conn,
+			subscribeID: subscribeID,
+			statusID:    statusID,
+		}
+		if !subscribeIDOK || !statusIDOK ||
+			subscribeReq.Method != "subscribe" || statusReq.Method != "status" ||
+			!strings.Contains(string(subscribeReq.Params), contractAddress.String()) {
+			wsConn.failure = errors.Errorf("unexpected requests, subscribe:%+v, status:%+v", subscribeReq, statusReq)
+		}
+		s.connections <- wsConn
+
+		// the connection is kept until it's closed by any side
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+func (s *mockWSServer) URL() string {
+	return s.server.URL
+}
+
+func (s *mockWSServer) AwaitConnection(t *testing.T) *mockWSConn {
+	t.Helper()
+
+	select {
+	case conn := <-s.connections:
+		require.NoError(t, conn.failure)
+		return conn
+	case <-time.After(5 * time.Second):
+		t.Fatal("websocket connection isn't established")
+		return nil
+	}
+}
+
+type mockWSConn struct {
+	conn        *websocket.Conn
+	subscribeID rpctypes.JSONRPCIntID
+	statusID    rpctypes.JSONRPCIntID
+	failure     error
+}
+
+func (c *mockWSConn) RespondSubscribe(t *testing.T) {
+	t.Helper()
+
+	c.write(t, c.subscribeID, map[string]any{})
+}
+
+func (c *mockWSConn) RespondStatus(t *testing.T, latestHeight int64) {
+	t.Helper()
+
+	c.write(t, c.statusID, map[string]any{
+		"sync_info": map[string]any{
+			"latest_block_height": strconv.FormatInt(latestHeight, 10),
+		},
+	})
+}
+
+func (c *mockWSConn) SendTxEvent(t *testing.T, hash string, height int64) {
+	t.Helper()
+
+	c.SendEvents(t, map[string][]string{
+		"tx.hash":   {hash},
+		"tx.height": {strconv.FormatInt(height, 10)},
+	})
+}
+
+func (c *mockWSConn) SendEvents(t *testing.T, events map[string][]string) {
+	t.Helper()
+
+	c.write(t, c.subscribeID, map[string]any{
+		"query":  "tm.event='Tx'",
+		"events": events,
+	})
+}
+
+func (c *mockWSConn) SendTxResultEvents(t *testing.T, hash string, height int64, events []abci.Event) {
+	t.Helper()
+
+	c.write(t, c.subscribeID, map[string]any{
+		"query": "tm.event='Tx'",
+		"data": map[string]any{
+			"type": "tendermint/event/Tx",
+			"value": map[string]any{
+				"TxResult": map[string]any{
+					"height": strconv.FormatInt(height, 10),
+					"result": map[string]any{
+						"events": events,
+					},
+				},
+			},
+		},
+		"events": map[string][]string{
+			"tx.hash":   {hash},
+			"tx.height": {strconv.FormatInt(height, 10)},
+		},
+	})
+}
+
+func (c *mockWSConn) RespondError(t *testing.T, message string) {
+	t.Helper()
+
+	require.NoError(t, c.conn.WriteJSON(rpctypes.RPCInternalError(c.subscribeID, errors.New(message))))
+}
+
+func (c *mockWSConn) Close(t *testing.T) {
+	t.Helper()
+
+	require.NoError(t, c.conn.Close())
+}
+
+func (c *mockWSConn) write(t *testing.T, id rpctypes.JSONRPCIntID, result any) {
+	t.Helper()
+
+	resultBytes, err := json.Marshal(result)
+	require.NoError(t, err)
+	require.NoError(t, c.conn.WriteJSON(rpctypes.RPCResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Result:  resultBytes,
+	}))
+}
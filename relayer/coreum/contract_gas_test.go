@@ -0,0 +1,198 @@
+package coreum_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestContractClient_TxGasTracking(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		broadcastErr           error
+		metricRegistryBuilder  func(ctrl *gomock.Controller) coreum.ContractClientMetricRegistry
+		execute                func(ctx context.Context, c *coreum.ContractClient) error
+		wantErr                bool
+		wantBroadcastMsgsCount int
+	}{
+		{
+			name: "single_message",
+			metricRegistryBuilder: func(ctrl *gomock.Controller) coreum.ContractClientMetricRegistry {
+				metricRegistryMock := NewMockContractClientMetricRegistry(ctrl)
+				metricRegistryMock.EXPECT().ObserveCoreumContractTxGasUsed("update_ownership", float64(95_000))
+				return metricRegistryMock
+			},
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+				return err
+			},
+			wantBroadcastMsgsCount: 1,
+		},
+		{
+			name: "multiple_messages",
+			metricRegistryBuilder: func(ctrl *gomock.Controller) coreum.ContractClientMetricRegistry {
+				metricRegistryMock := NewMockContractClientMetricRegistry(ctrl)
+				metricRegistryMock.EXPECT().ObserveCoreumContractTxGasUsed("save_signature", float64(95_000))
+				return metricRegistryMock
+			},
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SaveMultipleSignatures(ctx, testRelayerAddress, coreum.SaveSignatureRequest{
+					OperationID:      10,
+					OperationVersion: 1,
+					Signature:        "3045022100",
+				}, coreum.SaveSignatureRequest{
+					OperationID:      11,
+					OperationVersion: 1,
+					Signature:        "3045022101",
+				})
+				return err
+			},
+			wantBroadcastMsgsCount: 2,
+		},
+		{
+			name:         "failed_broadcast",
+			broadcastErr: errors.New("broadcast failed"),
+			metricRegistryBuilder: func(ctrl *gomock.Controller) coreum.ContractClientMetricRegistry {
+				return NewMockContractClientMetricRegistry(ctrl)
+			},
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+				return err
+			},
+			wantErr:                true,
+			wantBroadcastMsgsCount: 1,
+		},
+		{
+			name: "without_metric_registry",
+			metricRegistryBuilder: func(_ *gomock.Controller) coreum.ContractClientMetricRegistry {
+				return nil
+			},
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+				return err
+			},
+			wantBroadcastMsgsCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			broadcastMsgsCount := 0
+			contractClient := coreum.NewContractClientWithoutChain(
+				coreum.DefaultContractClientConfig(testContractAddress),
+				logger.NewZapLoggerFromLogger(zap.NewNop()),
+				newFakeWasmQueryClient(t),
+				fakeAssetFTQueryClient{},
+				func(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+					broadcastMsgsCount = len(msgs)
+					if tt.broadcastErr != nil {
+						return nil, tt.broadcastErr
+					}
+					return &sdk.TxResponse{
+						TxHash:    "tx-hash",
+						GasWanted: 140_000,
+						GasUsed:   95_000,
+					}, nil
+				},
+				tt.metricRegistryBuilder(ctrl),
+			)
+
+			err := tt.execute(context.Background(), contractClient)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantBroadcastMsgsCount, broadcastMsgsCount)
+		})
+	}
+}
+
+func TestContractClient_TxFees(t *testing.T) {
+	t.Parallel()
+
+	feeGranter := sdk.AccAddress(bytes.Repeat([]byte{5}, 20))
+	tests := []struct {
+		name              string
+		modifyCfg         func(cfg coreum.ContractClientConfig) coreum.ContractClientConfig
+		wantGasPrices     sdk.DecCoins
+		wantGasAdjustment float64
+		wantFeeGranter    sdk.AccAddress
+	}{
+		{
+			name: "default_config",
+			modifyCfg: func(cfg coreum.ContractClientConfig) coreum.ContractClientConfig {
+				return cfg
+			},
+			wantGasAdjustment: 1.4,
+		},
+		{
+			name: "custom_fees",
+			modifyCfg: func(cfg coreum.ContractClientConfig) coreum.ContractClientConfig {
+				cfg.GasPrices = sdk.NewDecCoins(
+					sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.0625")),
+					sdk.NewDecCoinFromDec("ibc/ABC", sdk.MustNewDecFromStr("0.1")),
+				)
+				cfg.GasAdjustment = 2
+				cfg.FeeGranter = feeGranter
+				return cfg
+			},
+			wantGasPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.0625")),
+				sdk.NewDecCoinFromDec("ibc/ABC", sdk.MustNewDecFromStr("0.1")),
+			),
+			wantGasAdjustment: 2,
+			wantFeeGranter:    feeGranter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := coreum.NewContractClientWithoutChain(
+				tt.modifyCfg(coreum.DefaultContractClientConfig(testContractAddress)),
+				logger.NewZapLoggerFromLogger(zap.NewNop()),
+				newFakeWasmQueryClient(t),
+				fakeAssetFTQueryClient{},
+				nil,
+				nil,
+			)
+			var (
+				broadcastClientCtx client.Context
+				broadcastTxf       client.Factory
+			)
+			contractClient.SetBroadcastTxFn(func(
+				_ context.Context, clientCtx client.Context, txf client.Factory, _ ...sdk.Msg,
+			) (*sdk.TxResponse, error) {
+				broadcastClientCtx = clientCtx
+				broadcastTxf = txf
+				return &sdk.TxResponse{}, nil
+			})
+
+			_, err := contractClient.TransferOwnership(context.Background(), testOwnerAddress, testRecipientAddress)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantGasPrices, broadcastTxf.GasPrices())
+			require.InDelta(t, tt.wantGasAdjustment, broadcastTxf.GasAdjustment(), 1e-9)
+			// the gas is estimated by the broadcaster
+			require.True(t, broadcastTxf.SimulateAndExecute())
+			require.Equal(t, tt.wantFeeGranter, broadcastClientCtx.FeeGranterAddress())
+			require.Equal(t, testOwnerAddress, broadcastClientCtx.FromAddress())
+		})
+	}
+}
@@ -0,0 +1,1102 @@
+package coreum_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+var updateGoldenFiles = flag.Bool("update", false, "update the contract messages golden files")
+
+const (
+	contractTestDataDir = "testdata/contract"
+
+	testXRPLIssuer    = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	testXRPLRecipient = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+	testXRPLTxHash    = "8F8C6B2D0D0B8CC6E8B42F7C29D3A8F4A0F5B9B8C2A1D6E3F4A5B6C7D8E9F0A1"
+	testXRPLPubKey    = "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
+	testIBCRecipient  = "osmo1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+)
+
+// the addresses are fixed to keep the golden files deterministic.
+var (
+	testOwnerAddress     = sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	testRelayerAddress   = sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	testRecipientAddress = sdk.AccAddress(bytes.Repeat([]byte{3}, 20))
+	testContractAddress  = sdk.AccAddress(bytes.Repeat([]byte{4}, 20))
+
+	testTrustSetLimitAmount = sdkmath.NewIntFromBigInt(
+		sdkmath.NewUintFromString("340282366920938463463374607431768211455").BigInt(),
+	)
+)
+
+type executeMsgGolden struct {
+	Msg   json.RawMessage `json:"msg"`
+	Funds sdk.Coins       `json:"funds"`
+}
+
+func TestContractClient_ExecuteMessages(t *testing.T) {
+	t.Parallel()
+
+	// the XRPL address is derived from the public key to pass the keys verification
+	testRelayer := coreum.Relayer{
+		CoreumAddress: testRelayerAddress,
+		XRPLAddress:   testXRPLIssuer,
+		XRPLPubKey:    testXRPLPubKey,
+	}
+	testResultEvidence := coreum.XRPLTransactionResultEvidence{
+		TxHash:            testXRPLTxHash,
+		TicketSequence:    lo.ToPtr(uint32(10)),
+		TransactionResult: coreum.TransactionResultAccepted,
+	}
+
+	tests := []struct {
+		name    string
+		execute func(ctx context.Context, c *coreum.ContractClient) error
+	}{
+		{
+			name: "transfer_ownership",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+				return err
+			},
+		},
+		{
+			name: "accept_ownership",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.AcceptOwnership(ctx, testOwnerAddress)
+				return err
+			},
+		},
+		{
+			name: "register_coreum_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RegisterCoreumToken(
+					ctx, testOwnerAddress, "ucore", 6, 6, sdkmath.NewInt(100000000000000), sdkmath.NewInt(10), nil,
+				)
+				return err
+			},
+		},
+		{
+			name: "register_coreum_token_with_max_sends_per_day",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RegisterCoreumToken(
+					ctx, testOwnerAddress, "ucore", 6, 6, sdkmath.NewInt(100000000000000), sdkmath.ZeroInt(),
+					lo.ToPtr(uint32(5)),
+				)
+				return err
+			},
+		},
+		{
+			name: "register_xrpl_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RegisterXRPLToken(
+					ctx, testOwnerAddress, testXRPLIssuer, "USD", 15,
+					sdkmath.NewIntWithDecimal(1, 21), sdkmath.NewInt(1000), nil, nil,
+				)
+				return err
+			},
+		},
+		{
+			name: "register_xrpl_token_with_ibc_delivery_mode",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RegisterXRPLToken(
+					ctx, testOwnerAddress, testXRPLIssuer, "USD", 15,
+					sdkmath.NewIntWithDecimal(1, 21), sdkmath.NewInt(1000), nil, &coreum.XRPLTokenDeliveryMode{
+						Type:                      coreum.XRPLTokenDeliveryModeTypeIBC,
+						IBCChannelID:              "channel-0",
+						IBCTransferTimeoutSeconds: 600,
+					},
+				)
+				return err
+			},
+		},
+		{
+			name: "register_xrpl_token_batch",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RegisterXRPLTokenBatch(ctx, testOwnerAddress, coreum.XRPLTokenRegistrationRequest{
+					Issuer:           testXRPLIssuer,
+					Currency:         "USD",
+					SendingPrecision: 15,
+					MaxHoldingAmount: sdkmath.NewIntWithDecimal(1, 21),
+					BridgingFee:      sdkmath.ZeroInt(),
+				}, coreum.XRPLTokenRegistrationRequest{
+					Issuer:                   testXRPLIssuer,
+					Currency:                 "EUR",
+					SendingPrecision:         -2,
+					MaxHoldingAmount:         sdkmath.NewIntWithDecimal(1, 21),
+					BridgingFee:              sdkmath.NewInt(1000),
+					MaxSendsPerAddressPerDay: lo.ToPtr(uint32(5)),
+					DeliveryMode: &coreum.XRPLTokenDeliveryMode{
+						Type: coreum.XRPLTokenDeliveryModeTypeAssetFT,
+					},
+				})
+				return err
+			},
+		},
+		{
+			name: "save_evidence_xrpl_to_coreum_transfer",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLToCoreumTransferEvidence(ctx, testRelayerAddress, coreum.XRPLToCoreumTransferEvidence{
+					TxHash:    testXRPLTxHash,
+					Issuer:    testXRPLIssuer,
+					Currency:  "USD",
+					Amount:    sdkmath.NewIntWithDecimal(1, 20),
+					Recipient: testRecipientAddress,
+				})
+				return err
+			},
+		},
+		{
+			name: "save_evidence_xrpl_to_coreum_transfer_with_destination_chain_recipient",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLToCoreumTransferEvidence(ctx, testRelayerAddress, coreum.XRPLToCoreumTransferEvidence{
+					TxHash:                    testXRPLTxHash,
+					Issuer:                    testXRPLIssuer,
+					Currency:                  "USD",
+					Amount:                    sdkmath.NewIntWithDecimal(1, 20),
+					Recipient:                 testRecipientAddress,
+					DestinationChainRecipient: testIBCRecipient,
+				})
+				return err
+			},
+		},
+		{
+			name: "save_evidence_ibc_transfer_result",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendIBCTransferResultEvidence(ctx, testRelayerAddress, coreum.IBCTransferResultEvidence{
+					TxHash:  testXRPLTxHash,
+					Success: false,
+				})
+				return err
+			},
+		},
+		{
+			name: "save_evidence_xrpl_nftoken_transfer",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLNFTokenTransferEvidence(ctx, testRelayerAddress, coreum.XRPLNFTokenTransferEvidence{
+					TxHash:      testXRPLTxHash,
+					NFTokenID:   "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002",
+					SellOfferID: "F660CA62E16B8067ED9B5CA5C6D6A96A5D3F2E9EBB3C1F8BE6A5D1D9D5A0E1C7",
+					Sender:      testXRPLIssuer,
+					Recipient:   testRecipientAddress,
+				})
+				return err
+			},
+		},
+		{
+			name: "save_evidence_tickets_allocation_accepted",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLTicketsAllocationTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultTicketsAllocationEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TxHash:            testXRPLTxHash,
+							AccountSequence:   lo.ToPtr(uint32(1)),
+							TransactionResult: coreum.TransactionResultAccepted,
+						},
+						Tickets: []uint32{3, 4, 5},
+					},
+				)
+				return err
+			},
+		},
+		{
+			name: "save_evidence_tickets_allocation_rejected",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLTicketsAllocationTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultTicketsAllocationEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TxHash:            testXRPLTxHash,
+							AccountSequence:   lo.ToPtr(uint32(1)),
+							TransactionResult: coreum.TransactionResultRejected,
+						},
+					},
+				)
+				return err
+			},
+		},
+		{
+			name: "save_evidence_trust_set",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendXRPLTrustSetTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultTrustSetEvidence{XRPLTransactionResultEvidence: testResultEvidence},
+				)
+				return err
+			},
+		},
+		{
+			name: "save_evidence_coreum_to_xrpl_transfer",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendCoreumToXRPLTransferTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+						XRPLTransactionResultEvidence: testResultEvidence,
+					},
+				)
+				return err
+			},
+		},
+		{
+			name: "save_evidence_keys_rotation",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendKeysRotationTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultKeysRotationEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TxHash:            testXRPLTxHash,
+							TicketSequence:    lo.ToPtr(uint32(10)),
+							TransactionResult: coreum.TransactionResultInvalid,
+						},
+					},
+				)
+				return err
+			},
+		},
+		{
+			name: "save_evidence_nftoken_transfer",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendNFTokenTransferTransactionResultEvidence(
+					ctx,
+					testRelayerAddress,
+					coreum.XRPLTransactionResultNFTokenTransferEvidence{XRPLTransactionResultEvidence: testResultEvidence},
+				)
+				return err
+			},
+		},
+		{
+			name: "recover_tickets",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RecoverTickets(ctx, testOwnerAddress, 1, nil)
+				return err
+			},
+		},
+		{
+			name: "recover_tickets_with_number_of_tickets",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RecoverTickets(ctx, testOwnerAddress, 1, lo.ToPtr(uint32(250)))
+				return err
+			},
+		},
+		{
+			name: "save_signature",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SaveSignature(ctx, testRelayerAddress, 10, 1, "3045022100")
+				return err
+			},
+		},
+		{
+			name: "save_multiple_signatures",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SaveMultipleSignatures(ctx, testRelayerAddress, coreum.SaveSignatureRequest{
+					OperationID:      10,
+					OperationVersion: 1,
+					Signature:        "3045022100",
+				}, coreum.SaveSignatureRequest{
+					OperationID:      11,
+					OperationVersion: 2,
+					Signature:        "3045022101",
+				})
+				return err
+			},
+		},
+		{
+			name: "send_to_xrpl",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendToXRPL(ctx, testRecipientAddress, testXRPLRecipient, sdk.NewInt64Coin("ucore", 100), nil)
+				return err
+			},
+		},
+		{
+			name: "send_to_xrpl_with_deliver_amount",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.SendToXRPL(
+					ctx,
+					testRecipientAddress,
+					testXRPLRecipient,
+					sdk.NewInt64Coin("ucore", 100),
+					lo.ToPtr(sdkmath.NewInt(90)),
+				)
+				return err
+			},
+		},
+		{
+			name: "multi_send_to_xrpl",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.MultiSendToXRPL(ctx, testRecipientAddress, coreum.SendToXRPLRequest{
+					Recipient: testXRPLRecipient,
+					Amount:    sdk.NewInt64Coin("ucore", 100),
+				}, coreum.SendToXRPLRequest{
+					Recipient:     testXRPLIssuer,
+					DeliverAmount: lo.ToPtr(sdkmath.NewInt(90)),
+					Amount:        sdk.NewInt64Coin("ucore", 200),
+				})
+				return err
+			},
+		},
+		{
+			name: "recover_xrpl_token_registration",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RecoverXRPLTokenRegistration(ctx, testOwnerAddress, testXRPLIssuer, "USD")
+				return err
+			},
+		},
+		{
+			name: "claim_relayer_fees",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.ClaimRelayerFees(ctx, testRelayerAddress, sdk.NewCoins(
+					sdk.NewInt64Coin("ucore", 100), sdk.NewInt64Coin("drop", 50),
+				))
+				return err
+			},
+		},
+		{
+			name: "update_xrpl_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.UpdateXRPLToken(ctx, testOwnerAddress, testXRPLIssuer, "USD", nil, nil, nil, nil, nil, nil)
+				return err
+			},
+		},
+		{
+			name: "update_xrpl_token_all_fields",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.UpdateXRPLToken(
+					ctx,
+					testOwnerAddress,
+					testXRPLIssuer,
+					"USD",
+					lo.ToPtr(coreum.TokenStateDisabled),
+					lo.ToPtr(int32(-2)),
+					lo.ToPtr(sdkmath.NewIntWithDecimal(1, 21)),
+					lo.ToPtr(sdkmath.NewInt(1000)),
+					lo.ToPtr(uint32(0)),
+					lo.ToPtr(sdkmath.NewInt(500)),
+				)
+				return err
+			},
+		},
+		{
+			name: "migrate_xrpl_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.MigrateXRPLToken(ctx, testOwnerAddress, testXRPLIssuer, "USD", testXRPLRecipient, "EUR")
+				return err
+			},
+		},
+		{
+			name: "remove_migrated_xrpl_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RemoveMigratedXRPLToken(ctx, testOwnerAddress, testXRPLIssuer, "USD")
+				return err
+			},
+		},
+		{
+			name: "update_coreum_token",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.UpdateCoreumToken(
+					ctx,
+					testOwnerAddress,
+					"ucore",
+					lo.ToPtr(coreum.TokenStateEnabled),
+					lo.ToPtr(int32(6)),
+					lo.ToPtr(sdkmath.NewInt(100000000000000)),
+					lo.ToPtr(sdkmath.ZeroInt()),
+					lo.ToPtr(uint32(5)),
+					lo.ToPtr(sdkmath.ZeroInt()),
+				)
+				return err
+			},
+		},
+		{
+			name: "claim_refund",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.ClaimRefund(ctx, testRecipientAddress, "1700000000-10")
+				return err
+			},
+		},
+		{
+			name: "claim_refund_all",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.ClaimRefundAll(ctx, testRecipientAddress)
+				return err
+			},
+		},
+		{
+			name: "claim_treasury_fees",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.ClaimTreasuryFees(ctx, testOwnerAddress)
+				return err
+			},
+		},
+		{
+			name: "rotate_keys",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.RotateKeys(ctx, testOwnerAddress, []coreum.Relayer{testRelayer}, 1)
+				return err
+			},
+		},
+		{
+			name: "halt_bridge",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.HaltBridge(ctx, testOwnerAddress)
+				return err
+			},
+		},
+		{
+			name: "resume_bridge",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.ResumeBridge(ctx, testOwnerAddress)
+				return err
+			},
+		},
+		{
+			name: "update_xrpl_base_fee",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.UpdateXRPLBaseFee(ctx, testOwnerAddress, 20)
+				return err
+			},
+		},
+		{
+			name: "cancel_pending_operation",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.CancelPendingOperation(ctx, testOwnerAddress, 10)
+				return err
+			},
+		},
+		{
+			name: "update_prohibited_xrpl_addresses",
+			execute: func(ctx context.Context, c *coreum.ContractClient) error {
+				_, err := c.UpdateProhibitedXRPLAddresses(
+					ctx, testOwnerAddress, []string{testXRPLIssuer, testXRPLRecipient},
+				)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msgs := make([]executeMsgGolden, 0)
+			contractClient := newContractClientWithoutChain(t, newFakeWasmQueryClient(t), func(
+				txMsgs ...sdk.Msg,
+			) (*sdk.TxResponse, error) {
+				for _, txMsg := range txMsgs {
+					executeMsg, ok := txMsg.(*wasmtypes.MsgExecuteContract)
+					if !ok {
+						return nil, errors.Errorf("unexpected message type %T", txMsg)
+					}
+					require.Equal(t, testContractAddress.String(), executeMsg.Contract)
+					msgs = append(msgs, executeMsgGolden{
+						Msg:   json.RawMessage(executeMsg.Msg),
+						Funds: executeMsg.Funds,
+					})
+				}
+				return &sdk.TxResponse{}, nil
+			})
+
+			require.NoError(t, tt.execute(context.Background(), contractClient))
+			requireGoldenFile(t, filepath.Join(contractTestDataDir, "execute", tt.name+".json"), msgs)
+		})
+	}
+}
+
+func TestContractClient_RotateKeys_MismatchingXRPLKeys(t *testing.T) {
+	t.Parallel()
+
+	contractClient := newContractClientWithoutChain(t, newFakeWasmQueryClient(t), func(
+		...sdk.Msg,
+	) (*sdk.TxResponse, error) {
+		return nil, errors.New("unexpected tx broadcast")
+	})
+	_, err := contractClient.RotateKeys(context.Background(), testOwnerAddress, []coreum.Relayer{
+		{
+			CoreumAddress: testRelayerAddress,
+			XRPLAddress:   testXRPLIssuer,
+			XRPLPubKey:    testXRPLPubKey,
+		},
+		{
+			CoreumAddress: testRecipientAddress,
+			XRPLAddress:   testXRPLRecipient,
+			XRPLPubKey:    testXRPLPubKey,
+		},
+	}, 1)
+	require.ErrorContains(
+		t, err, fmt.Sprintf("invalid XRPL keys of the relayer 1, coreum address:%s", testRecipientAddress.String()),
+	)
+}
+
+func TestContractClient_MultiSendToXRPL_AmountExceedsContractMax(t *testing.T) {
+	t.Parallel()
+
+	contractClient := newContractClientWithoutChain(t, newFakeWasmQueryClient(t), func(
+		...sdk.Msg,
+	) (*sdk.TxResponse, error) {
+		return nil, errors.New("unexpected tx broadcast")
+	})
+	ctx := context.Background()
+
+	maxAmount := coreum.MaxContractAmount
+	aboveMaxAmount := coreum.MaxContractAmount.AddRaw(1)
+	_, err := contractClient.SendToXRPL(
+		ctx, testRecipientAddress, testXRPLRecipient, sdk.NewCoin("ucore", aboveMaxAmount), nil,
+	)
+	require.ErrorIs(t, err, coreum.ErrAmountExceedsContractMax)
+
+	_, err = contractClient.MultiSendToXRPL(ctx, testRecipientAddress, coreum.SendToXRPLRequest{
+		Recipient: testXRPLRecipient,
+		Amount:    sdk.NewInt64Coin("ucore", 100),
+	}, coreum.SendToXRPLRequest{
+		Recipient:     testXRPLRecipient,
+		Amount:        sdk.NewCoin("ucore", maxAmount),
+		DeliverAmount: &aboveMaxAmount,
+	})
+	require.ErrorIs(t, err, coreum.ErrAmountExceedsContractMax)
+}
+
+func TestContractClient_MultiSendToXRPLWithOperationIDs(t *testing.T) {
+	t.Parallel()
+
+	otherContractAddress := sdk.AccAddress(bytes.Repeat([]byte{5}, 20))
+	operationCreatedEvent := func(contractAddress sdk.AccAddress, operationID, operationUniqueID string) abci.Event {
+		attributes := []abci.EventAttribute{
+			{Key: wasmtypes.AttributeKeyContractAddr, Value: contractAddress.String()},
+			{Key: "operation_id", Value: operationID},
+		}
+		if operationUniqueID != "" {
+			attributes = append(attributes, abci.EventAttribute{Key: "operation_unique_id", Value: operationUniqueID})
+		}
+		attributes = append(attributes,
+			abci.EventAttribute{Key: "operation_type", Value: "coreum_to_xrpl_transfer"},
+			abci.EventAttribute{Key: "xrpl_base_fee", Value: "10"},
+		)
+		return abci.Event{Type: coreum.OperationCreatedEventType, Attributes: attributes}
+	}
+	sendToXRPLEvents := func(contractAddress sdk.AccAddress, operationID, operationUniqueID string) []abci.Event {
+		return []abci.Event{
+			{
+				Type: banktypes.EventTypeCoinReceived,
+				Attributes: []abci.EventAttribute{
+					{Key: banktypes.AttributeKeyReceiver, Value: contractAddress.String()},
+					{Key: sdk.AttributeKeyAmount, Value: "100ucore"},
+				},
+			},
+			operationCreatedEvent(contractAddress, operationID, operationUniqueID),
+			{
+				Type: wasmtypes.WasmModuleEventType,
+				Attributes: []abci.EventAttribute{
+					{Key: wasmtypes.AttributeKeyContractAddr, Value: contractAddress.String()},
+					{Key: "action", Value: "send_to_xrpl"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		requestsCount    int
+		events           []abci.Event
+		wantOperationIDs []coreum.SendToXRPLOperationID
+		wantErr          string
+	}{
+		{
+			name:          "single_request",
+			requestsCount: 1,
+			events:        sendToXRPLEvents(testContractAddress, "7", "1700000000-7"),
+			wantOperationIDs: []coreum.SendToXRPLOperationID{
+				{OperationID: 7, OperationUniqueID: "1700000000-7"},
+			},
+		},
+		{
+			name:          "multiple_requests_with_unrelated_contract_events",
+			requestsCount: 3,
+			events: lo.Flatten([][]abci.Event{
+				sendToXRPLEvents(testContractAddress, "12", "1700000000-12"),
+				// the operation created by another contract executed in the same tx
+				sendToXRPLEvents(otherContractAddress, "12", "1600000000-12"),
+				{
+					{
+						Type: coreum.TokenStateChangedEventType,
+						Attributes: []abci.EventAttribute{
+							{Key: wasmtypes.AttributeKeyContractAddr, Value: testContractAddress.String()},
+							{Key: "token_denom", Value: "ucore"},
+						},
+					},
+				},
+				sendToXRPLEvents(testContractAddress, "3", "1700000000-3"),
+				sendToXRPLEvents(testContractAddress, "5", "1700000000-5"),
+			}),
+			wantOperationIDs: []coreum.SendToXRPLOperationID{
+				{OperationID: 12, OperationUniqueID: "1700000000-12"},
+				{OperationID: 3, OperationUniqueID: "1700000000-3"},
+				{OperationID: 5, OperationUniqueID: "1700000000-5"},
+			},
+		},
+		{
+			name:          "operations_count_mismatch",
+			requestsCount: 2,
+			events: lo.Flatten([][]abci.Event{
+				sendToXRPLEvents(testContractAddress, "12", "1700000000-12"),
+				sendToXRPLEvents(otherContractAddress, "13", "1700000000-13"),
+			}),
+			wantErr: "unexpected number of created operations, expected:2, got:1",
+		},
+		{
+			name:          "operation_without_unique_id",
+			requestsCount: 1,
+			events:        sendToXRPLEvents(testContractAddress, "12", ""),
+			wantErr:       "operation created event without unique ID",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := newContractClientWithoutChain(t, newFakeWasmQueryClient(t), func(
+				msgs ...sdk.Msg,
+			) (*sdk.TxResponse, error) {
+				require.Len(t, msgs, tt.requestsCount)
+				return &sdk.TxResponse{
+					TxHash: "tx-hash",
+					Events: tt.events,
+				}, nil
+			})
+			requests := make([]coreum.SendToXRPLRequest, 0, tt.requestsCount)
+			for i := 0; i < tt.requestsCount; i++ {
+				requests = append(requests, coreum.SendToXRPLRequest{
+					Recipient: testXRPLRecipient,
+					Amount:    sdk.NewInt64Coin("ucore", 100),
+				})
+			}
+
+			txRes, operationIDs, err := contractClient.MultiSendToXRPLWithOperationIDs(
+				context.Background(), testRecipientAddress, requests...,
+			)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				// the tx is executed even if the operations can't be decoded
+				require.NotNil(t, txRes)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantOperationIDs, operationIDs)
+		})
+	}
+}
+
+func TestContractClient_QueryMessages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    func(ctx context.Context, c *coreum.ContractClient) (any, error)
+		expected any
+	}{
+		{
+			name: "config",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetContractConfig(ctx)
+			},
+			expected: coreum.ContractConfig{
+				Relayers: []coreum.Relayer{
+					{
+						CoreumAddress: testRelayerAddress,
+						XRPLAddress:   testXRPLIssuer,
+						XRPLPubKey:    testXRPLPubKey,
+					},
+				},
+				EvidenceThreshold:           1,
+				UsedTicketSequenceThreshold: 150,
+				TrustSetLimitAmount:         testTrustSetLimitAmount,
+				BridgeXRPLAddress:           testXRPLRecipient,
+				BridgeState:                 coreum.BridgeStateActive,
+				XRPLBaseFee:                 10,
+			},
+		},
+		{
+			name: "ownership",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetContractOwnership(ctx)
+			},
+			expected: coreum.ContractOwnership{
+				Owner:        testOwnerAddress,
+				PendingOwner: sdk.AccAddress{},
+			},
+		},
+		{
+			name: "xrpl_tokens",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetXRPLTokens(ctx)
+			},
+			expected: []coreum.XRPLToken{
+				{
+					Issuer:           "rrrrrrrrrrrrrrrrrrrrrhoLvTp",
+					Currency:         "XRP",
+					CoreumDenom:      "drop-" + testContractAddress.String(),
+					SendingPrecision: 6,
+					MaxHoldingAmount: sdkmath.NewIntWithDecimal(1, 16),
+					State:            coreum.TokenStateEnabled,
+					BridgingFee:      sdkmath.ZeroInt(),
+				},
+				{
+					Issuer:                   testXRPLIssuer,
+					Currency:                 "USD",
+					CoreumDenom:              "xrpl5a8d3a8e81-" + testContractAddress.String(),
+					SendingPrecision:         15,
+					MaxHoldingAmount:         sdkmath.NewIntWithDecimal(1, 21),
+					State:                    coreum.TokenStateDisabled,
+					BridgingFee:              sdkmath.NewInt(1000),
+					MaxSendsPerAddressPerDay: lo.ToPtr(uint32(5)),
+					TreasuryFee:              lo.ToPtr(sdkmath.NewInt(500)),
+					DeliveryMode: &coreum.XRPLTokenDeliveryMode{
+						Type:                      coreum.XRPLTokenDeliveryModeTypeIBC,
+						IBCChannelID:              "channel-0",
+						IBCTransferTimeoutSeconds: 600,
+					},
+				},
+			},
+		},
+		{
+			name: "migrated_xrpl_tokens",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetMigratedXRPLTokens(ctx)
+			},
+			expected: []coreum.XRPLToken{
+				{
+					Issuer:           testXRPLIssuer,
+					Currency:         "USD",
+					CoreumDenom:      "xrpl5a8d3a8e81-" + testContractAddress.String(),
+					SendingPrecision: 15,
+					MaxHoldingAmount: sdkmath.NewIntWithDecimal(1, 21),
+					State:            coreum.TokenStateDisabled,
+					BridgingFee:      sdkmath.NewInt(1000),
+				},
+			},
+		},
+		{
+			name: "coreum_tokens",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetCoreumTokens(ctx)
+			},
+			expected: []coreum.CoreumToken{
+				{
+					Denom:            "ucore",
+					Decimals:         6,
+					XRPLCurrency:     "636F72650000000000000000000000000000000000",
+					SendingPrecision: 6,
+					MaxHoldingAmount: sdkmath.NewInt(100000000000000),
+					State:            coreum.TokenStateEnabled,
+					BridgingFee:      sdkmath.NewInt(10),
+				},
+			},
+		},
+		{
+			name: "pending_operations",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetPendingOperations(ctx)
+			},
+			expected: []coreum.Operation{
+				{
+					Version:        1,
+					TicketSequence: 10,
+					Signatures: []coreum.Signature{
+						{
+							RelayerCoreumAddress: testRelayerAddress,
+							Signature:            "3045022100",
+						},
+					},
+					OperationType: coreum.OperationType{
+						TrustSet: &coreum.OperationTypeTrustSet{
+							Issuer:              testXRPLIssuer,
+							Currency:            "USD",
+							TrustSetLimitAmount: testTrustSetLimitAmount,
+						},
+					},
+					XRPLBaseFee: 10,
+				},
+				{
+					Version:        2,
+					TicketSequence: 11,
+					Signatures:     []coreum.Signature{},
+					OperationType: coreum.OperationType{
+						CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{
+							Issuer:    testXRPLIssuer,
+							Currency:  "USD",
+							Amount:    sdkmath.NewIntWithDecimal(1, 15),
+							MaxAmount: lo.ToPtr(sdkmath.NewIntWithDecimal(11, 14)),
+							Recipient: testXRPLRecipient,
+						},
+					},
+					XRPLBaseFee: 10,
+				},
+				{
+					Version:         1,
+					AccountSequence: 1,
+					Signatures:      []coreum.Signature{},
+					OperationType: coreum.OperationType{
+						AllocateTickets: &coreum.OperationTypeAllocateTickets{
+							Number: 250,
+						},
+					},
+					XRPLBaseFee: 10,
+				},
+			},
+		},
+		{
+			name: "signature_progress",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetSignatureProgress(ctx, 10)
+			},
+			expected: coreum.SignatureProgress{
+				Operation: coreum.Operation{
+					Version:        1,
+					TicketSequence: 10,
+					Signatures: []coreum.Signature{
+						{
+							RelayerCoreumAddress: testRelayerAddress,
+							Signature:            "3045022100",
+						},
+					},
+					OperationType: coreum.OperationType{
+						TrustSet: &coreum.OperationTypeTrustSet{
+							Issuer:              testXRPLIssuer,
+							Currency:            "USD",
+							TrustSetLimitAmount: testTrustSetLimitAmount,
+						},
+					},
+					XRPLBaseFee: 10,
+				},
+				SignedRelayers: []coreum.Relayer{
+					{
+						CoreumAddress: testRelayerAddress,
+						XRPLAddress:   testXRPLIssuer,
+						XRPLPubKey:    testXRPLPubKey,
+					},
+				},
+				UnsignedRelayers:    []coreum.Relayer{},
+				EvidenceThreshold:   1,
+				ThresholdPercentage: 100,
+			},
+		},
+		{
+			name: "available_tickets",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetAvailableTickets(ctx)
+			},
+			expected: []uint32{3, 4, 5},
+		},
+		{
+			name: "fees_collected",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetFeesCollected(ctx, testRelayerAddress)
+			},
+			expected: sdk.NewCoins(sdk.NewInt64Coin("ucore", 100)),
+		},
+		{
+			name: "treasury_fees_collected",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetTreasuryFeesCollected(ctx)
+			},
+			expected: sdk.NewCoins(sdk.NewInt64Coin("ucore", 30)),
+		},
+		{
+			name: "pending_refunds",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetPendingRefunds(ctx, testRecipientAddress)
+			},
+			expected: []coreum.PendingRefund{
+				{
+					ID:         "1700000000-10",
+					Coin:       sdk.NewInt64Coin("ucore", 100),
+					XRPLTxHash: testXRPLTxHash,
+				},
+				{
+					ID:   "1700000000-11",
+					Coin: sdk.NewInt64Coin("ucore", 200),
+				},
+			},
+		},
+		{
+			name: "pending_ibc_transfers",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetPendingIBCTransfers(ctx)
+			},
+			expected: []coreum.PendingIBCTransfer{
+				{
+					TxHash:                    testXRPLTxHash,
+					Recipient:                 testRecipientAddress,
+					IBCChannelID:              "channel-0",
+					DestinationChainRecipient: testIBCRecipient,
+					Coin:                      sdk.NewInt64Coin("ucore", 100),
+				},
+			},
+		},
+		{
+			name: "transaction_evidences",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetTransactionEvidences(ctx)
+			},
+			expected: []coreum.TransactionEvidence{
+				{
+					Hash:             "5b8f1c3e0f6f2a1c",
+					RelayerAddresses: []sdk.AccAddress{testRelayerAddress},
+				},
+			},
+		},
+		{
+			name: "prohibited_xrpl_addresses",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetProhibitedXRPLAddresses(ctx)
+			},
+			expected: []string{"rrrrrrrrrrrrrrrrrrrrrhoLvTp", testXRPLRecipient},
+		},
+		{
+			name: "relayer_evidence_rate_limit",
+			query: func(ctx context.Context, c *coreum.ContractClient) (any, error) {
+				return c.GetRelayerEvidenceRateLimit(ctx, testRelayerAddress)
+			},
+			expected: coreum.RelayerEvidenceRateLimit{
+				MaxEvidencesPerRelayerPerBlock: lo.ToPtr(uint32(2)),
+				BlockHeight:                    100,
+				EvidencesCount:                 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wasmClient := newFakeWasmQueryClient(t)
+			contractClient := newContractClientWithoutChain(t, wasmClient, func(...sdk.Msg) (*sdk.TxResponse, error) {
+				return nil, errors.New("unexpected tx broadcast")
+			})
+
+			res, err := tt.query(context.Background(), contractClient)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+			requireGoldenFile(t, filepath.Join(contractTestDataDir, "query", tt.name+".json"), wasmClient.requests)
+		})
+	}
+}
+
+func newContractClientWithoutChain(
+	t *testing.T,
+	wasmClient wasmtypes.QueryClient,
+	broadcastTx func(msgs ...sdk.Msg) (*sdk.TxResponse, error),
+) *coreum.ContractClient {
+	t.Helper()
+
+	return coreum.NewContractClientWithoutChain(
+		coreum.DefaultContractClientConfig(testContractAddress),
+		logger.NewZapLoggerFromLogger(zap.NewNop()),
+		wasmClient,
+		fakeAssetFTQueryClient{},
+		broadcastTx,
+		nil,
+	)
+}
+
+// requireGoldenFile compares the value marshaled to the indented JSON with the golden file content, and rewrites
+// the golden file if the update flag is set.
+func requireGoldenFile(t *testing.T, path string, value any) {
+	t.Helper()
+
+	actual, err := json.MarshalIndent(value, "", "  ")
+	require.NoError(t, err)
+	actual = append(actual, '\n')
+	if *updateGoldenFiles {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, actual, 0o600))
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "golden file is missing, run the test with the -update flag to create it")
+	require.Equal(t, string(expected), string(actual))
+}
+
+// fakeWasmQueryClient responds to the first smart query of each method with the contract response from the test
+// data and to the next ones with the empty response, which completes the paginated queries.
+type fakeWasmQueryClient struct {
+	wasmtypes.QueryClient
+
+	t         *testing.T
+	requests  []json.RawMessage
+	responded map[string]struct{}
+}
+
+func newFakeWasmQueryClient(t *testing.T) *fakeWasmQueryClient {
+	return &fakeWasmQueryClient{
+		t:         t,
+		requests:  make([]json.RawMessage, 0),
+		responded: make(map[string]struct{}),
+	}
+}
+
+func (c *fakeWasmQueryClient) SmartContractState(
+	_ context.Context,
+	req *wasmtypes.QuerySmartContractStateRequest,
+	_ ...grpc.CallOption,
+) (*wasmtypes.QuerySmartContractStateResponse, error) {
+	require.Equal(c.t, testContractAddress.String(), req.Address)
+	c.requests = append(c.requests, json.RawMessage(req.QueryData))
+
+	var query map[string]json.RawMessage
+	require.NoError(c.t, json.Unmarshal(req.QueryData, &query))
+	require.Len(c.t, query, 1)
+	method := lo.Keys(query)[0]
+	if _, ok := c.responded[method]; ok {
+		return &wasmtypes.QuerySmartContractStateResponse{Data: []byte("{}")}, nil
+	}
+	c.responded[method] = struct{}{}
+
+	data, err := os.ReadFile(filepath.Join(contractTestDataDir, "responses", method+".json"))
+	require.NoError(c.t, err)
+
+	return &wasmtypes.QuerySmartContractStateResponse{Data: data}, nil
+}
+
+type fakeAssetFTQueryClient struct {
+	assetfttypes.QueryClient
+}
+
+func (fakeAssetFTQueryClient) Params(
+	context.Context,
+	*assetfttypes.QueryParamsRequest,
+	...grpc.CallOption,
+) (*assetfttypes.QueryParamsResponse, error) {
+	return &assetfttypes.QueryParamsResponse{
+		Params: assetfttypes.Params{
+			IssueFee: sdk.NewInt64Coin("ucore", 10000000),
+		},
+	}, nil
+}
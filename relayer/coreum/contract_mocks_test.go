@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum (interfaces: ContractClientMetricRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -destination=contract_mocks_test.go -package=coreum_test . ContractClientMetricRegistry
+//
+
+// Package coreum_test is a generated GoMock package.
+package coreum_test
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContractClientMetricRegistry is a mock of ContractClientMetricRegistry interface.
+type MockContractClientMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractClientMetricRegistryMockRecorder
+}
+
+// MockContractClientMetricRegistryMockRecorder is the mock recorder for MockContractClientMetricRegistry.
+type MockContractClientMetricRegistryMockRecorder struct {
+	mock *MockContractClientMetricRegistry
+}
+
+// NewMockContractClientMetricRegistry creates a new mock instance.
+func NewMockContractClientMetricRegistry(ctrl *gomock.Controller) *MockContractClientMetricRegistry {
+	mock := &MockContractClientMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockContractClientMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractClientMetricRegistry) EXPECT() *MockContractClientMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// ObserveCoreumContractTxGasUsed mocks base method.
+func (m *MockContractClientMetricRegistry) ObserveCoreumContractTxGasUsed(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveCoreumContractTxGasUsed", arg0, arg1)
+}
+
+// ObserveCoreumContractTxGasUsed indicates an expected call of ObserveCoreumContractTxGasUsed.
+func (mr *MockContractClientMetricRegistryMockRecorder) ObserveCoreumContractTxGasUsed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveCoreumContractTxGasUsed", reflect.TypeOf((*MockContractClientMetricRegistry)(nil).ObserveCoreumContractTxGasUsed), arg0, arg1)
+}
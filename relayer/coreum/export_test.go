@@ -0,0 +1,54 @@
+package coreum
+
+import (
+	"context"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/CoreumFoundation/coreum/v4/pkg/client"
+	assetfttypes "github.com/CoreumFoundation/coreum/v4/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// NewContractClientWithoutChain returns the ContractClient which uses the provided query clients and passes the
+// messages of the txs to the broadcastTx instead of signing and broadcasting them.
+func NewContractClientWithoutChain(
+	cfg ContractClientConfig,
+	log logger.Logger,
+	wasmClient wasmtypes.QueryClient,
+	assetftClient assetfttypes.QueryClient,
+	broadcastTx func(msgs ...sdk.Msg) (*sdk.TxResponse, error),
+	metricRegistry ContractClientMetricRegistry,
+) *ContractClient {
+	c := NewContractClient(cfg, log, client.Context{}, metricRegistry, nil)
+	c.wasmClient = wasmClient
+	c.assetftClient = assetftClient
+	c.broadcastTxFn = func(
+		_ context.Context, _ client.Context, _ client.Factory, msgs ...sdk.Msg,
+	) (*sdk.TxResponse, error) {
+		return broadcastTx(msgs...)
+	}
+
+	return c
+}
+
+// SetCometServiceClient replaces the tx service client used for the tx search queries.
+func (c *ContractClient) SetCometServiceClient(cometServiceClient sdktxtypes.ServiceClient) {
+	c.cometServiceClient = cometServiceClient
+}
+
+// SetBroadcastTxFn replaces the func which signs and broadcasts the txs.
+func (c *ContractClient) SetBroadcastTxFn(
+	broadcastTxFn func(
+		ctx context.Context, clientCtx client.Context, txf client.Factory, msgs ...sdk.Msg,
+	) (*sdk.TxResponse, error),
+) {
+	c.broadcastTxFn = broadcastTxFn
+}
+
+// SetKeyUsageAuditLogger replaces the key usage audit logger.
+func (c *ContractClient) SetKeyUsageAuditLogger(auditLogger KeyUsageAuditLogger) {
+	c.auditLogger = auditLogger
+}
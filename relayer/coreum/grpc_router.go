@@ -0,0 +1,518 @@
+package coreum
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=grpc_router_mocks_test.go -package=coreum_test . GRPCRouterMetricRegistry
+
+const (
+	grpcRouterBalancerName   = "coreum_grpc_router"
+	grpcRouterResolverScheme = "coreum-grpc-router"
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(grpcRouterBalancerName, grpcRouterPickerBuilder{}, base.Config{}))
+}
+
+// GRPCRouterMetricRegistry is GRPC router metric registry.
+type GRPCRouterMetricRegistry interface {
+	SetCoreumGRPCEndpointSelected(url string, selected bool)
+	IncrementCoreumGRPCFailoverCounter()
+}
+
+// GRPCRouterConfig is GRPCRouter config.
+type GRPCRouterConfig struct {
+	URLs          []string
+	ProbeInterval time.Duration
+	ProbeTimeout  time.Duration
+	// MaxBlockAge is the max age of the endpoint latest block for the endpoint to be healthy.
+	MaxBlockAge time.Duration
+	// ErrorThreshold is the number of the consecutive connectivity errors after which the endpoint is unhealthy.
+	ErrorThreshold int
+}
+
+// DefaultGRPCRouterConfig returns default GRPCRouterConfig.
+func DefaultGRPCRouterConfig(urls []string) GRPCRouterConfig {
+	return GRPCRouterConfig{
+		URLs:           urls,
+		ProbeInterval:  15 * time.Second,
+		ProbeTimeout:   5 * time.Second,
+		MaxBlockAge:    time.Minute,
+		ErrorThreshold: 3,
+	}
+}
+
+// GRPCRouter routes the Coreum GRPC requests of its connection to the healthiest endpoint. The selected endpoint is
+// used till it becomes unhealthy, then the requests fail over to the ready healthy endpoint with the highest latest
+// block. The endpoint is unhealthy if its latest block is older than the max block age or its consecutive
+// connectivity errors reach the error threshold.
+type GRPCRouter struct {
+	cfg            GRPCRouterConfig
+	log            logger.Logger
+	metricRegistry GRPCRouterMetricRegistry
+	clock          func() time.Time
+	conn           *grpc.ClientConn
+
+	mu        sync.Mutex
+	endpoints []*grpcEndpoint
+	selected  *grpcEndpoint
+}
+
+type grpcEndpoint struct {
+	url     string
+	address string
+	height  int64
+	// fresh is false if the latest probed block is too old or the probe is failed
+	fresh             bool
+	consecutiveErrors int
+}
+
+func (e *grpcEndpoint) healthy(errorThreshold int) bool {
+	return e.fresh && e.consecutiveErrors < errorThreshold
+}
+
+// NewGRPCRouter returns a new instance of the GRPCRouter with the connection to all endpoints.
+func NewGRPCRouter(
+	cfg GRPCRouterConfig,
+	log logger.Logger,
+	metricRegistry GRPCRouterMetricRegistry,
+	clock func() time.Time,
+	dialOpts ...grpc.DialOption,
+) (*GRPCRouter, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, errors.New("at least one Coreum GRPC URL is required")
+	}
+	if cfg.ProbeInterval <= 0 {
+		return nil, errors.Errorf("probe interval must be positive, got: %s", cfg.ProbeInterval)
+	}
+	if cfg.ProbeTimeout <= 0 {
+		return nil, errors.Errorf("probe timeout must be positive, got: %s", cfg.ProbeTimeout)
+	}
+	if cfg.MaxBlockAge <= 0 {
+		return nil, errors.Errorf("max block age must be positive, got: %s", cfg.MaxBlockAge)
+	}
+	if cfg.ErrorThreshold <= 0 {
+		return nil, errors.Errorf("error threshold must be positive, got: %d", cfg.ErrorThreshold)
+	}
+
+	r := &GRPCRouter{
+		cfg:            cfg,
+		log:            log,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		endpoints:      make([]*grpcEndpoint, 0, len(cfg.URLs)),
+	}
+
+	var secure *bool
+	addresses := make([]resolver.Address, 0, len(cfg.URLs))
+	for _, grpcURL := range cfg.URLs {
+		if grpcURL == "" {
+			return nil, errors.New("Coreum GRPC URL must not be empty")
+		}
+		host, isSecure, err := ParseGRPCURL(grpcURL)
+		if err != nil {
+			return nil, err
+		}
+		if secure != nil && *secure != isSecure {
+			return nil, errors.New("Coreum GRPC URLs must be either all https or all insecure")
+		}
+		secure = &isSecure
+		for _, endpoint := range r.endpoints {
+			if endpoint.address == host {
+				return nil, errors.Errorf("duplicated Coreum GRPC URL: %s", grpcURL)
+			}
+		}
+		r.endpoints = append(r.endpoints, &grpcEndpoint{
+			url:     grpcURL,
+			address: host,
+			// the endpoint is healthy till the probe proves the opposite
+			fresh: true,
+		})
+		serverName, _, err := net.SplitHostPort(host)
+		if err != nil {
+			serverName = host
+		}
+		addresses = append(addresses, resolver.Address{
+			Addr:       host,
+			ServerName: serverName,
+			Attributes: attributes.New(grpcRouterAttributeKey{}, r),
+		})
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if *secure {
+		transportCredentials = credentials.NewTLS(&tls.Config{})
+	}
+
+	endpointsResolver := manual.NewBuilderWithScheme(grpcRouterResolverScheme)
+	endpointsResolver.InitialState(resolver.State{Addresses: addresses})
+	conn, err := grpc.Dial(
+		fmt.Sprintf("%s:///endpoints", grpcRouterResolverScheme),
+		append([]grpc.DialOption{
+			grpc.WithResolvers(endpointsResolver),
+			grpc.WithDefaultServiceConfig(
+				fmt.Sprintf(`{"loadBalancingConfig":[{"%s":{}}]}`, grpcRouterBalancerName),
+			),
+			grpc.WithTransportCredentials(transportCredentials),
+		}, dialOpts...)...,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial Coreum GRPC endpoints")
+	}
+	r.conn = conn
+
+	return r, nil
+}
+
+// Conn returns the connection routing the requests to the selected endpoint.
+func (r *GRPCRouter) Conn() *grpc.ClientConn {
+	return r.conn
+}
+
+// Start probes all endpoints with the latest block request every probe interval.
+func (r *GRPCRouter) Start(ctx context.Context) error {
+	for {
+		if err := r.ProbeEndpoints(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(r.cfg.ProbeInterval):
+		}
+	}
+}
+
+// ProbeEndpoints requests the latest block of all endpoints in parallel and updates their freshness.
+func (r *GRPCRouter) ProbeEndpoints(ctx context.Context) error {
+	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		for _, endpoint := range r.endpoints {
+			address := endpoint.address
+			spawn(address, parallel.Continue, func(ctx context.Context) error {
+				r.probeEndpoint(ctx, address)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// SelectedURL returns the URL of the currently selected endpoint, or empty string if no endpoint has been selected
+// yet.
+func (r *GRPCRouter) SelectedURL() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.selected == nil {
+		return ""
+	}
+
+	return r.selected.url
+}
+
+func (r *GRPCRouter) probeEndpoint(ctx context.Context, address string) {
+	probeCtx, probeCtxCancel := context.WithTimeout(withPinnedGRPCEndpoint(ctx, address), r.cfg.ProbeTimeout)
+	defer probeCtxCancel()
+
+	res, err := tmservice.NewServiceClient(r.conn).GetLatestBlock(probeCtx, &tmservice.GetLatestBlockRequest{})
+	// the probe is interrupted by the shutdown
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	endpoint := r.findEndpoint(address)
+	if err != nil {
+		if endpoint.fresh {
+			r.log.Warn(ctx, "Coreum GRPC endpoint probe is failed", zap.String("url", endpoint.url), zap.Error(err))
+		}
+		endpoint.fresh = false
+		return
+	}
+
+	height, blockTime := latestBlockHeightAndTime(res)
+	blockAge := r.clock().Sub(blockTime)
+	fresh := blockAge <= r.cfg.MaxBlockAge
+	if !fresh && endpoint.fresh {
+		r.log.Warn(
+			ctx,
+			"Coreum GRPC endpoint latest block is too old",
+			zap.String("url", endpoint.url),
+			zap.Int64("height", height),
+			zap.Duration("blockAge", blockAge),
+		)
+	}
+	endpoint.height = height
+	endpoint.fresh = fresh
+	// the endpoint has responded, so it's reachable again
+	endpoint.consecutiveErrors = 0
+}
+
+// selectAddress returns the address of the selected endpoint if it's ready and healthy, otherwise fails over to the
+// ready healthy endpoint with the highest latest block. The unhealthy endpoints are used only if there are no ready
+// healthy endpoints.
+func (r *GRPCRouter) selectAddress(ctx context.Context, readyAddresses map[string]balancer.SubConn) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, selectedReady := readyAddresses[r.selectedAddress()]
+	if selectedReady && r.selected.healthy(r.cfg.ErrorThreshold) {
+		return r.selected.address, true
+	}
+
+	var candidate *grpcEndpoint
+	for _, endpoint := range r.endpoints {
+		if _, ok := readyAddresses[endpoint.address]; !ok {
+			continue
+		}
+		if candidate == nil {
+			candidate = endpoint
+			continue
+		}
+		endpointHealthy := endpoint.healthy(r.cfg.ErrorThreshold)
+		candidateHealthy := candidate.healthy(r.cfg.ErrorThreshold)
+		if endpointHealthy != candidateHealthy {
+			if endpointHealthy {
+				candidate = endpoint
+			}
+			continue
+		}
+		if endpoint.height > candidate.height {
+			candidate = endpoint
+		}
+	}
+	if candidate == nil {
+		return "", false
+	}
+	// switching between the unhealthy endpoints doesn't help
+	if selectedReady && !candidate.healthy(r.cfg.ErrorThreshold) {
+		return r.selected.address, true
+	}
+
+	r.setSelected(ctx, candidate)
+
+	return candidate.address, true
+}
+
+func (r *GRPCRouter) setSelected(ctx context.Context, endpoint *grpcEndpoint) {
+	previous := r.selected
+	r.selected = endpoint
+	if previous != nil {
+		r.log.Warn(
+			ctx,
+			"Coreum GRPC endpoint is failed over",
+			zap.String("fromURL", previous.url),
+			zap.String("toURL", endpoint.url),
+		)
+		r.metricRegistry.IncrementCoreumGRPCFailoverCounter()
+	}
+	for _, e := range r.endpoints {
+		r.metricRegistry.SetCoreumGRPCEndpointSelected(e.url, e == endpoint)
+	}
+}
+
+func (r *GRPCRouter) selectedAddress() string {
+	if r.selected == nil {
+		return ""
+	}
+
+	return r.selected.address
+}
+
+// observeResult counts the consecutive connectivity errors of the endpoint, any other result resets them.
+func (r *GRPCRouter) observeResult(ctx context.Context, address string, err error) {
+	// the request is cancelled by the caller, so the error doesn't depend on the endpoint
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	endpoint := r.findEndpoint(address)
+	if endpoint == nil {
+		return
+	}
+	if !isGRPCConnectivityError(err) {
+		endpoint.consecutiveErrors = 0
+		return
+	}
+	endpoint.consecutiveErrors++
+	if endpoint.consecutiveErrors == r.cfg.ErrorThreshold {
+		r.log.Warn(
+			ctx,
+			"Coreum GRPC endpoint has reached the error threshold",
+			zap.String("url", endpoint.url),
+			zap.Int("errorThreshold", r.cfg.ErrorThreshold),
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *GRPCRouter) findEndpoint(address string) *grpcEndpoint {
+	for _, endpoint := range r.endpoints {
+		if endpoint.address == address {
+			return endpoint
+		}
+	}
+
+	return nil
+}
+
+type (
+	grpcRouterAttributeKey struct{}
+	pinnedGRPCEndpointKey  struct{}
+	stickyGRPCEndpointKey  struct{}
+)
+
+type stickyGRPCEndpoint struct {
+	mu      sync.Mutex
+	address string
+}
+
+// WithStickyGRPCEndpoint returns the context which routes all GRPCRouter requests to the endpoint selected for the
+// first of them while it's ready, so the broadcast and confirmation of a tx are executed on the same node.
+func WithStickyGRPCEndpoint(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(stickyGRPCEndpointKey{}).(*stickyGRPCEndpoint); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, stickyGRPCEndpointKey{}, &stickyGRPCEndpoint{})
+}
+
+func withPinnedGRPCEndpoint(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, pinnedGRPCEndpointKey{}, address)
+}
+
+type grpcRouterPickerBuilder struct{}
+
+func (grpcRouterPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	var router *GRPCRouter
+	readyAddresses := make(map[string]balancer.SubConn, len(info.ReadySCs))
+	for subConn, subConnInfo := range info.ReadySCs {
+		router, _ = subConnInfo.Address.Attributes.Value(grpcRouterAttributeKey{}).(*GRPCRouter)
+		readyAddresses[subConnInfo.Address.Addr] = subConn
+	}
+	if router == nil {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	return &grpcRouterPicker{
+		router:         router,
+		readyAddresses: readyAddresses,
+	}
+}
+
+type grpcRouterPicker struct {
+	router         *GRPCRouter
+	readyAddresses map[string]balancer.SubConn
+}
+
+func (p *grpcRouterPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	// the probe requests are executed on the pinned endpoint and don't affect its error counter
+	if address, ok := info.Ctx.Value(pinnedGRPCEndpointKey{}).(string); ok {
+		subConn, ok := p.readyAddresses[address]
+		if !ok {
+			return balancer.PickResult{}, balancer.ErrNoSubConnAvailable
+		}
+		return balancer.PickResult{SubConn: subConn}, nil
+	}
+
+	address, ok := p.pickAddress(info.Ctx)
+	if !ok {
+		return balancer.PickResult{}, balancer.ErrNoSubConnAvailable
+	}
+
+	return balancer.PickResult{
+		SubConn: p.readyAddresses[address],
+		Done: func(doneInfo balancer.DoneInfo) {
+			p.router.observeResult(info.Ctx, address, doneInfo.Err)
+		},
+	}, nil
+}
+
+func (p *grpcRouterPicker) pickAddress(ctx context.Context) (string, bool) {
+	sticky, ok := ctx.Value(stickyGRPCEndpointKey{}).(*stickyGRPCEndpoint)
+	if !ok {
+		return p.router.selectAddress(ctx, p.readyAddresses)
+	}
+
+	sticky.mu.Lock()
+	defer sticky.mu.Unlock()
+	if _, ok := p.readyAddresses[sticky.address]; ok {
+		return sticky.address, true
+	}
+	address, ok := p.router.selectAddress(ctx, p.readyAddresses)
+	if ok {
+		sticky.address = address
+	}
+
+	return address, ok
+}
+
+// ParseGRPCURL returns the host of the GRPC URL and whether the connection to it must be secured with TLS. The URL
+// without the protocol is treated as the insecure host:port.
+func ParseGRPCURL(grpcURL string) (string, bool, error) {
+	parsedURL, err := url.Parse(grpcURL)
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to parse grpc URL")
+	}
+
+	host := parsedURL.Host
+	// https - tls grpc
+	if parsedURL.Scheme == "https" {
+		return host, true, nil
+	}
+	// handling of host:port URL without the protocol
+	if host == "" {
+		host = fmt.Sprintf("%s:%s", parsedURL.Scheme, parsedURL.Opaque)
+	}
+
+	return host, false, nil
+}
+
+func latestBlockHeightAndTime(res *tmservice.GetLatestBlockResponse) (int64, time.Time) {
+	if res.SdkBlock != nil {
+		return res.SdkBlock.Header.Height, res.SdkBlock.Header.Time
+	}
+	if res.Block != nil {
+		return res.Block.Header.Height, res.Block.Header.Time
+	}
+
+	return 0, time.Time{}
+}
+
+func isGRPCConnectivityError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
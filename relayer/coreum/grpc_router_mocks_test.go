@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum (interfaces: GRPCRouterMetricRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -destination=grpc_router_mocks_test.go -package=coreum_test . GRPCRouterMetricRegistry
+//
+
+// Package coreum_test is a generated GoMock package.
+package coreum_test
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGRPCRouterMetricRegistry is a mock of GRPCRouterMetricRegistry interface.
+type MockGRPCRouterMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockGRPCRouterMetricRegistryMockRecorder
+}
+
+// MockGRPCRouterMetricRegistryMockRecorder is the mock recorder for MockGRPCRouterMetricRegistry.
+type MockGRPCRouterMetricRegistryMockRecorder struct {
+	mock *MockGRPCRouterMetricRegistry
+}
+
+// NewMockGRPCRouterMetricRegistry creates a new mock instance.
+func NewMockGRPCRouterMetricRegistry(ctrl *gomock.Controller) *MockGRPCRouterMetricRegistry {
+	mock := &MockGRPCRouterMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockGRPCRouterMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGRPCRouterMetricRegistry) EXPECT() *MockGRPCRouterMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementCoreumGRPCFailoverCounter mocks base method.
+func (m *MockGRPCRouterMetricRegistry) IncrementCoreumGRPCFailoverCounter() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCoreumGRPCFailoverCounter")
+}
+
+// IncrementCoreumGRPCFailoverCounter indicates an expected call of IncrementCoreumGRPCFailoverCounter.
+func (mr *MockGRPCRouterMetricRegistryMockRecorder) IncrementCoreumGRPCFailoverCounter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCoreumGRPCFailoverCounter", reflect.TypeOf((*MockGRPCRouterMetricRegistry)(nil).IncrementCoreumGRPCFailoverCounter))
+}
+
+// SetCoreumGRPCEndpointSelected mocks base method.
+func (m *MockGRPCRouterMetricRegistry) SetCoreumGRPCEndpointSelected(arg0 string, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCoreumGRPCEndpointSelected", arg0, arg1)
+}
+
+// SetCoreumGRPCEndpointSelected indicates an expected call of SetCoreumGRPCEndpointSelected.
+func (mr *MockGRPCRouterMetricRegistryMockRecorder) SetCoreumGRPCEndpointSelected(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoreumGRPCEndpointSelected", reflect.TypeOf((*MockGRPCRouterMetricRegistry)(nil).SetCoreumGRPCEndpointSelected), arg0, arg1)
+}
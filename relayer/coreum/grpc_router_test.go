@@ -0,0 +1,275 @@
+package coreum_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+const (
+	firstNodeURL  = "http://node-1:9090"
+	secondNodeURL = "http://node-2:9090"
+)
+
+func TestGRPCRouter_FailoverOnOutage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	nodes := newFakeCoreumNodes(t)
+	metricRegistryMock := NewMockGRPCRouterMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetCoreumGRPCEndpointSelected(gomock.Any(), gomock.Any()).AnyTimes()
+	metricRegistryMock.EXPECT().IncrementCoreumGRPCFailoverCounter().Times(1)
+
+	router := newTestGRPCRouter(ctx, t, ctrl, nodes, metricRegistryMock)
+	txClient := sdktxtypes.NewServiceClient(router.Conn())
+
+	_, err := txClient.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+	require.NoError(t, err)
+	require.Equal(t, firstNodeURL, router.SelectedURL())
+	require.Equal(t, 1, nodes[firstNodeURL].getTxCalls())
+
+	nodes[firstNodeURL].stop()
+
+	require.Eventually(t, func() bool {
+		_, err := txClient.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, secondNodeURL, router.SelectedURL())
+	require.Equal(t, 1, nodes[firstNodeURL].getTxCalls())
+	require.Equal(t, 1, nodes[secondNodeURL].getTxCalls())
+}
+
+func TestGRPCRouter_FailoverOnErrorThreshold(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	nodes := newFakeCoreumNodes(t)
+	metricRegistryMock := NewMockGRPCRouterMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetCoreumGRPCEndpointSelected(gomock.Any(), gomock.Any()).AnyTimes()
+	metricRegistryMock.EXPECT().IncrementCoreumGRPCFailoverCounter().Times(1)
+
+	router := newTestGRPCRouter(ctx, t, ctrl, nodes, metricRegistryMock)
+	txClient := sdktxtypes.NewServiceClient(router.Conn())
+
+	nodes[firstNodeURL].setGetTxErr(status.Error(codes.Unavailable, "node is overloaded"))
+	// the default error threshold is 3
+	for i := 0; i < 3; i++ {
+		_, err := txClient.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	require.Equal(t, firstNodeURL, router.SelectedURL())
+
+	_, err := txClient.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+	require.NoError(t, err)
+	require.Equal(t, secondNodeURL, router.SelectedURL())
+	require.Equal(t, 3, nodes[firstNodeURL].getTxCalls())
+	require.Equal(t, 1, nodes[secondNodeURL].getTxCalls())
+}
+
+func TestGRPCRouter_StickyBroadcastConfirmation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	nodes := newFakeCoreumNodes(t)
+	metricRegistryMock := NewMockGRPCRouterMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetCoreumGRPCEndpointSelected(gomock.Any(), gomock.Any()).AnyTimes()
+	metricRegistryMock.EXPECT().IncrementCoreumGRPCFailoverCounter().Times(1)
+
+	router := newTestGRPCRouter(ctx, t, ctrl, nodes, metricRegistryMock)
+	txClient := sdktxtypes.NewServiceClient(router.Conn())
+
+	stickyCtx := coreum.WithStickyGRPCEndpoint(ctx)
+	_, err := txClient.BroadcastTx(stickyCtx, &sdktxtypes.BroadcastTxRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, nodes[firstNodeURL].broadcastTxCalls())
+
+	// the first node falls behind after the broadcast
+	nodes[firstNodeURL].setBlockTime(time.Now().Add(-time.Hour))
+	require.NoError(t, router.ProbeEndpoints(ctx))
+
+	// the other requests fail over to the second node
+	_, err = txClient.GetTx(ctx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+	require.NoError(t, err)
+	require.Equal(t, secondNodeURL, router.SelectedURL())
+	require.Equal(t, 1, nodes[secondNodeURL].getTxCalls())
+
+	// the tx is confirmed on the node it's broadcast to
+	_, err = txClient.GetTx(stickyCtx, &sdktxtypes.GetTxRequest{Hash: "hash"})
+	require.NoError(t, err)
+	require.Equal(t, 1, nodes[firstNodeURL].getTxCalls())
+	require.Equal(t, 1, nodes[secondNodeURL].getTxCalls())
+}
+
+func newTestGRPCRouter(
+	ctx context.Context,
+	t *testing.T,
+	ctrl *gomock.Controller,
+	nodes map[string]*fakeCoreumNode,
+	metricRegistry coreum.GRPCRouterMetricRegistry,
+) *coreum.GRPCRouter {
+	t.Helper()
+
+	listeners := make(map[string]*bufconn.Listener, len(nodes))
+	for grpcURL, node := range nodes {
+		host, _, err := coreum.ParseGRPCURL(grpcURL)
+		require.NoError(t, err)
+		listeners[host] = node.listener
+	}
+
+	router, err := coreum.NewGRPCRouter(
+		coreum.DefaultGRPCRouterConfig([]string{firstNodeURL, secondNodeURL}),
+		logger.NewAnyLogMock(ctrl),
+		metricRegistry,
+		time.Now,
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return listeners[address].DialContext(ctx)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = router.Conn().Close()
+	})
+	// the probe waits for the connections to all endpoints, so the first endpoint is selected by the config order
+	require.NoError(t, router.ProbeEndpoints(ctx))
+
+	return router
+}
+
+func newFakeCoreumNodes(t *testing.T) map[string]*fakeCoreumNode {
+	t.Helper()
+
+	return map[string]*fakeCoreumNode{
+		firstNodeURL:  newFakeCoreumNode(t),
+		secondNodeURL: newFakeCoreumNode(t),
+	}
+}
+
+type fakeCoreumNode struct {
+	listener *bufconn.Listener
+	server   *grpc.Server
+
+	mu            sync.Mutex
+	blockTime     time.Time
+	getTxErr      error
+	getTxCount    int
+	broadcastTxes int
+}
+
+func newFakeCoreumNode(t *testing.T) *fakeCoreumNode {
+	t.Helper()
+
+	node := &fakeCoreumNode{
+		listener:  bufconn.Listen(1024 * 1024),
+		server:    grpc.NewServer(),
+		blockTime: time.Now(),
+	}
+	tmservice.RegisterServiceServer(node.server, &fakeTendermintServiceServer{node: node})
+	sdktxtypes.RegisterServiceServer(node.server, &fakeTxServiceServer{node: node})
+	go func() {
+		_ = node.server.Serve(node.listener)
+	}()
+	t.Cleanup(node.stop)
+
+	return node
+}
+
+func (n *fakeCoreumNode) stop() {
+	n.server.Stop()
+}
+
+func (n *fakeCoreumNode) setBlockTime(blockTime time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.blockTime = blockTime
+}
+
+func (n *fakeCoreumNode) setGetTxErr(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.getTxErr = err
+}
+
+func (n *fakeCoreumNode) getTxCalls() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.getTxCount
+}
+
+func (n *fakeCoreumNode) broadcastTxCalls() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.broadcastTxes
+}
+
+type fakeTendermintServiceServer struct {
+	tmservice.UnimplementedServiceServer
+	node *fakeCoreumNode
+}
+
+func (s *fakeTendermintServiceServer) GetLatestBlock(
+	_ context.Context,
+	_ *tmservice.GetLatestBlockRequest,
+) (*tmservice.GetLatestBlockResponse, error) {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+
+	return &tmservice.GetLatestBlockResponse{
+		SdkBlock: &tmservice.Block{
+			Header: tmservice.Header{
+				Height: 100,
+				Time:   s.node.blockTime,
+			},
+		},
+	}, nil
+}
+
+type fakeTxServiceServer struct {
+	sdktxtypes.UnimplementedServiceServer
+	node *fakeCoreumNode
+}
+
+func (s *fakeTxServiceServer) BroadcastTx(
+	_ context.Context,
+	_ *sdktxtypes.BroadcastTxRequest,
+) (*sdktxtypes.BroadcastTxResponse, error) {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+	s.node.broadcastTxes++
+
+	return &sdktxtypes.BroadcastTxResponse{TxResponse: &sdk.TxResponse{TxHash: "hash"}}, nil
+}
+
+func (s *fakeTxServiceServer) GetTx(
+	_ context.Context,
+	_ *sdktxtypes.GetTxRequest,
+) (*sdktxtypes.GetTxResponse, error) {
+	s.node.mu.Lock()
+	defer s.node.mu.Unlock()
+	s.node.getTxCount++
+	if s.node.getTxErr != nil {
+		return nil, s.node.getTxErr
+	}
+
+	return &sdktxtypes.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: "hash"}}, nil
+}
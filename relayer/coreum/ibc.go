@@ -0,0 +1,229 @@
+package coreum
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/pkg/errors"
+)
+
+// IBCPacketStatus is the status of the IBC packet sent from Coreum.
+type IBCPacketStatus string
+
+// IBCPacketStatus values.
+const (
+	IBCPacketStatusPending      IBCPacketStatus = "pending"
+	IBCPacketStatusAcknowledged IBCPacketStatus = "acknowledged"
+	// IBCPacketStatusFailed is the status of the packet acknowledged with the error, the transferred tokens are
+	// returned to the sender.
+	IBCPacketStatusFailed IBCPacketStatus = "failed"
+	// IBCPacketStatusTimedOut is the status of the packet not received before its timeout, the transferred tokens are
+	// returned to the sender.
+	IBCPacketStatusTimedOut IBCPacketStatus = "timed_out"
+)
+
+// IBCPacket identifies the IBC packet sent from Coreum.
+type IBCPacket struct {
+	SourcePort    string
+	SourceChannel string
+	Sequence      uint64
+}
+
+// GetIBCSendPackets returns the IBC packets sent by the tx.
+func GetIBCSendPackets(txRes *sdk.TxResponse) ([]IBCPacket, error) {
+	if txRes == nil {
+		return nil, nil
+	}
+	packets := make([]IBCPacket, 0)
+	for _, ev := range txRes.Events {
+		if ev.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := decodeIBCPacket(ev)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode IBC packet, txHash:%s", txRes.TxHash)
+		}
+		packets = append(packets, packet)
+	}
+
+	return packets, nil
+}
+
+// GetIBCTransferPacket returns the IBC packet sent by the evidence of the XRPL to Coreum transfer which reached the
+// threshold, or nil if such evidence tx isn't indexed yet. The wasm event of the evidence is followed by the events of
+// the messages it dispatches, so the first packet sent after the event belongs to the transfer.
+func (c *ContractClient) GetIBCTransferPacket(ctx context.Context, xrplTxHash string) (*IBCPacket, error) {
+	res, err := c.cometServiceClient.GetTxsEvent(ctx, &sdktxtypes.GetTxsEventRequest{
+		Events: []string{
+			fmt.Sprintf(
+				"%s.%s='%s'",
+				wasmtypes.WasmModuleEventType, wasmtypes.AttributeKeyContractAddr, c.cfg.ContractAddress.String(),
+			),
+			fmt.Sprintf("%s.%s='%s'", wasmtypes.WasmModuleEventType, eventAttributeHash, xrplTxHash),
+			fmt.Sprintf("%s.%s='%t'", wasmtypes.WasmModuleEventType, eventAttributeThresholdReached, true),
+		},
+		OrderBy: sdktxtypes.OrderBy_ORDER_BY_DESC,
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get IBC transfer evidence txs by events, xrplTxHash:%s", xrplTxHash)
+	}
+	if len(res.TxResponses) == 0 {
+		return nil, nil //nolint:nilnil // nil means the tx isn't found
+	}
+
+	return getIBCTransferPacket(res.TxResponses[0], xrplTxHash)
+}
+
+// GetIBCPacketStatus returns the status of the IBC packet sent from Coreum. The status is found by the tx which
+// acknowledged the packet or timed it out, so the packet is pending until such tx is indexed.
+func (c *ContractClient) GetIBCPacketStatus(ctx context.Context, packet IBCPacket) (IBCPacketStatus, error) {
+	ackTx, err := c.getIBCPacketTx(ctx, channeltypes.EventTypeAcknowledgePacket, packet)
+	if err != nil {
+		return "", err
+	}
+	if ackTx != nil {
+		return getIBCPacketAcknowledgementStatus(ackTx.Events, packet)
+	}
+
+	timeoutTx, err := c.getIBCPacketTx(ctx, channeltypes.EventTypeTimeoutPacket, packet)
+	if err != nil {
+		return "", err
+	}
+	if timeoutTx != nil {
+		return IBCPacketStatusTimedOut, nil
+	}
+
+	return IBCPacketStatusPending, nil
+}
+
+func (c *ContractClient) getIBCPacketTx(
+	ctx context.Context,
+	eventType string,
+	packet IBCPacket,
+) (*sdk.TxResponse, error) {
+	res, err := c.cometServiceClient.GetTxsEvent(ctx, &sdktxtypes.GetTxsEventRequest{
+		Events: []string{
+			fmt.Sprintf("%s.%s='%s'", eventType, channeltypes.AttributeKeySrcPort, packet.SourcePort),
+			fmt.Sprintf("%s.%s='%s'", eventType, channeltypes.AttributeKeySrcChannel, packet.SourceChannel),
+			fmt.Sprintf("%s.%s='%d'", eventType, channeltypes.AttributeKeySequence, packet.Sequence),
+		},
+		OrderBy: sdktxtypes.OrderBy_ORDER_BY_DESC,
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get IBC packet txs by events, event:%s, packet:%+v", eventType, packet)
+	}
+	if len(res.TxResponses) == 0 {
+		return nil, nil //nolint:nilnil // nil means the tx isn't found
+	}
+
+	return res.TxResponses[0], nil
+}
+
+func getIBCTransferPacket(txRes *sdk.TxResponse, xrplTxHash string) (*IBCPacket, error) {
+	evidenceFound := false
+	for _, ev := range txRes.Events {
+		if !evidenceFound {
+			evidenceFound = ev.Type == wasmtypes.WasmModuleEventType &&
+				hasEventAttribute(ev, eventAttributeHash, xrplTxHash) &&
+				hasEventAttribute(ev, eventAttributeThresholdReached, strconv.FormatBool(true))
+			continue
+		}
+		if ev.Type == wasmtypes.WasmModuleEventType {
+			break
+		}
+		if ev.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		packet, err := decodeIBCPacket(ev)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode IBC packet, txHash:%s", txRes.TxHash)
+		}
+		return &packet, nil
+	}
+
+	return nil, errors.Errorf(
+		"IBC packet of the transfer isn't found in the evidence tx, txHash:%s, xrplTxHash:%s", txRes.TxHash, xrplTxHash,
+	)
+}
+
+func hasEventAttribute(ev abci.Event, key, value string) bool {
+	for _, attr := range ev.Attributes {
+		if attr.Key == key && attr.Value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getIBCPacketAcknowledgementStatus returns the status of the acknowledged packet. The tx might acknowledge multiple
+// packets, and the transfer module emits the result of each acknowledgement right after the acknowledge packet event,
+// so the first result event after the packet acknowledgement is used.
+func getIBCPacketAcknowledgementStatus(events []abci.Event, packet IBCPacket) (IBCPacketStatus, error) {
+	packetFound := false
+	for _, ev := range events {
+		if !packetFound {
+			if ev.Type != channeltypes.EventTypeAcknowledgePacket {
+				continue
+			}
+			ackPacket, err := decodeIBCPacket(ev)
+			if err != nil {
+				return "", err
+			}
+			packetFound = ackPacket == packet
+			continue
+		}
+		if ev.Type == channeltypes.EventTypeAcknowledgePacket {
+			break
+		}
+		if ev.Type != transfertypes.EventTypePacket {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			switch attr.Key {
+			case transfertypes.AttributeKeyAckSuccess:
+				return IBCPacketStatusAcknowledged, nil
+			case transfertypes.AttributeKeyAckError:
+				return IBCPacketStatusFailed, nil
+			}
+		}
+	}
+
+	return "", errors.Errorf("acknowledgement result of the IBC packet isn't found, packet:%+v", packet)
+}
+
+func decodeIBCPacket(ev abci.Event) (IBCPacket, error) {
+	var (
+		packet      IBCPacket
+		sequenceSet bool
+	)
+	for _, attr := range ev.Attributes {
+		switch attr.Key {
+		case channeltypes.AttributeKeySrcPort:
+			packet.SourcePort = attr.Value
+		case channeltypes.AttributeKeySrcChannel:
+			packet.SourceChannel = attr.Value
+		case channeltypes.AttributeKeySequence:
+			sequence, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return IBCPacket{}, errors.Wrapf(err, "failed to parse IBC packet sequence:%s", attr.Value)
+			}
+			packet.Sequence = sequence
+			sequenceSet = true
+		}
+	}
+	if packet.SourcePort == "" || packet.SourceChannel == "" || !sequenceSet {
+		return IBCPacket{}, errors.Errorf("IBC packet event doesn't contain the packet attributes, event:%s", ev.Type)
+	}
+
+	return packet, nil
+}
@@ -0,0 +1,278 @@
+package coreum_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktxtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+func TestGetIBCSendPackets(t *testing.T) {
+	t.Parallel()
+
+	packets, err := coreum.GetIBCSendPackets(nil)
+	require.NoError(t, err)
+	require.Empty(t, packets)
+
+	packets, err = coreum.GetIBCSendPackets(&sdk.TxResponse{
+		Events: []abci.Event{
+			newABCIEvent("wasm", "_contract_address", testContractAddress.String()),
+			newIBCPacketEvent("send_packet", "channel-0", "7"),
+			newABCIEvent("transfer", "amount", "100ucore"),
+			newIBCPacketEvent("send_packet", "channel-1", "8"),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []coreum.IBCPacket{
+		{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 7},
+		{SourcePort: "transfer", SourceChannel: "channel-1", Sequence: 8},
+	}, packets)
+
+	_, err = coreum.GetIBCSendPackets(&sdk.TxResponse{
+		Events: []abci.Event{
+			newIBCPacketEvent("send_packet", "channel-0", "invalid"),
+		},
+	})
+	require.ErrorContains(t, err, "failed to parse IBC packet sequence")
+}
+
+func TestContractClient_GetIBCPacketStatus(t *testing.T) {
+	t.Parallel()
+
+	packet := coreum.IBCPacket{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Sequence:      7,
+	}
+	// the acknowledgement tx of the relayer usually contains the acknowledgements of multiple packets
+	otherPacketAck := []abci.Event{
+		newIBCPacketEvent("acknowledge_packet", "channel-0", "6"),
+		newABCIEvent("fungible_token_packet", "success", "\u0001"),
+	}
+
+	tests := []struct {
+		name           string
+		txsByEventType map[string][]abci.Event
+		expectedStatus coreum.IBCPacketStatus
+	}{
+		{
+			name:           "pending",
+			expectedStatus: coreum.IBCPacketStatusPending,
+		},
+		{
+			name: "acknowledged",
+			txsByEventType: map[string][]abci.Event{
+				"acknowledge_packet": append([]abci.Event{
+					newIBCPacketEvent("acknowledge_packet", "channel-0", "7"),
+					newABCIEvent("fungible_token_packet", "receiver", testIBCRecipient),
+					newABCIEvent("fungible_token_packet", "success", "\u0001"),
+				}, otherPacketAck...),
+			},
+			expectedStatus: coreum.IBCPacketStatusAcknowledged,
+		},
+		{
+			name: "failed",
+			txsByEventType: map[string][]abci.Event{
+				"acknowledge_packet": append(otherPacketAck, []abci.Event{
+					newIBCPacketEvent("acknowledge_packet", "channel-0", "7"),
+					newABCIEvent("fungible_token_packet", "error", "invalid receiver address"),
+				}...),
+			},
+			expectedStatus: coreum.IBCPacketStatusFailed,
+		},
+		{
+			name: "timed_out",
+			txsByEventType: map[string][]abci.Event{
+				"timeout_packet": {
+					newIBCPacketEvent("timeout_packet", "channel-0", "7"),
+				},
+			},
+			expectedStatus: coreum.IBCPacketStatusTimedOut,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			contractClient := newContractClientWithoutChain(
+				t, newFakeWasmQueryClient(t), func(...sdk.Msg) (*sdk.TxResponse, error) {
+					return nil, errors.New("unexpected tx broadcast")
+				},
+			)
+			contractClient.SetCometServiceClient(&fakeTxServiceClient{
+				t:              t,
+				txsByEventType: tt.txsByEventType,
+			})
+
+			status, err := contractClient.GetIBCPacketStatus(context.Background(), packet)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatus, status)
+		})
+	}
+}
+
+func TestContractClient_GetIBCTransferPacket(t *testing.T) {
+	t.Parallel()
+
+	xrplTxHash := "8F3A4B7C1E2D"
+	contractClient := newContractClientWithoutChain(
+		t, newFakeWasmQueryClient(t), func(...sdk.Msg) (*sdk.TxResponse, error) {
+			return nil, errors.New("unexpected tx broadcast")
+		},
+	)
+	txServiceClient := &fakeEvidenceTxServiceClient{
+		t:          t,
+		xrplTxHash: xrplTxHash,
+	}
+	contractClient.SetCometServiceClient(txServiceClient)
+
+	// the evidence tx isn't indexed yet
+	packet, err := contractClient.GetIBCTransferPacket(context.Background(), xrplTxHash)
+	require.NoError(t, err)
+	require.Nil(t, packet)
+
+	// the tx contains the evidences of multiple transfers
+	txServiceClient.events = []abci.Event{
+		newSaveEvidenceEvent("1A2B3C"),
+		newIBCPacketEvent("send_packet", "channel-0", "6"),
+		newSaveEvidenceEvent(xrplTxHash),
+		newABCIEvent("transfer", "amount", "100ucore"),
+		newIBCPacketEvent("send_packet", "channel-0", "7"),
+	}
+	packet, err = contractClient.GetIBCTransferPacket(context.Background(), xrplTxHash)
+	require.NoError(t, err)
+	require.Equal(t, &coreum.IBCPacket{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Sequence:      7,
+	}, packet)
+
+	// the evidence of the token with the AssetFT delivery mode doesn't send packets
+	txServiceClient.events = []abci.Event{
+		newSaveEvidenceEvent(xrplTxHash),
+		newSaveEvidenceEvent("1A2B3C"),
+		newIBCPacketEvent("send_packet", "channel-0", "6"),
+	}
+	_, err = contractClient.GetIBCTransferPacket(context.Background(), xrplTxHash)
+	require.ErrorContains(t, err, "IBC packet of the transfer isn't found")
+}
+
+// fakeEvidenceTxServiceClient returns the tx with the events if they are set.
+type fakeEvidenceTxServiceClient struct {
+	sdktxtypes.ServiceClient
+
+	t          *testing.T
+	xrplTxHash string
+	events     []abci.Event
+}
+
+func (c *fakeEvidenceTxServiceClient) GetTxsEvent(
+	_ context.Context,
+	req *sdktxtypes.GetTxsEventRequest,
+	_ ...grpc.CallOption,
+) (*sdktxtypes.GetTxsEventResponse, error) {
+	require.Equal(c.t, []string{
+		"%[1]s._contract_address='" + testContractAddress.String() + "'",
+		"%[1]s.hash='" + c.xrplTxHash + "'",
+		"%[1]s.threshold_reached='true'",
+	}, replaceEventType(req.Events))
+
+	if c.events == nil {
+		return &sdktxtypes.GetTxsEventResponse{}, nil
+	}
+
+	return &sdktxtypes.GetTxsEventResponse{
+		TxResponses: []*sdk.TxResponse{
+			{
+				Events: c.events,
+			},
+		},
+	}, nil
+}
+
+// fakeTxServiceClient returns the tx with the events of the searched event type.
+type fakeTxServiceClient struct {
+	sdktxtypes.ServiceClient
+
+	t              *testing.T
+	txsByEventType map[string][]abci.Event
+}
+
+func (c *fakeTxServiceClient) GetTxsEvent(
+	_ context.Context,
+	req *sdktxtypes.GetTxsEventRequest,
+	_ ...grpc.CallOption,
+) (*sdktxtypes.GetTxsEventResponse, error) {
+	require.Equal(c.t, []string{
+		"%[1]s.packet_src_port='transfer'",
+		"%[1]s.packet_src_channel='channel-0'",
+		"%[1]s.packet_sequence='7'",
+	}, replaceEventType(req.Events))
+
+	eventType, _, _ := strings.Cut(req.Events[0], ".")
+	events, ok := c.txsByEventType[eventType]
+	if !ok {
+		return &sdktxtypes.GetTxsEventResponse{}, nil
+	}
+
+	return &sdktxtypes.GetTxsEventResponse{
+		TxResponses: []*sdk.TxResponse{
+			{
+				Events: events,
+			},
+		},
+	}, nil
+}
+
+func replaceEventType(events []string) []string {
+	replaced := make([]string, 0, len(events))
+	for _, ev := range events {
+		_, attribute, _ := strings.Cut(ev, ".")
+		replaced = append(replaced, "%[1]s."+attribute)
+	}
+
+	return replaced
+}
+
+func newIBCPacketEvent(eventType, channel, sequence string) abci.Event {
+	return abci.Event{
+		Type: eventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "packet_sequence", Value: sequence},
+			{Key: "packet_src_port", Value: "transfer"},
+			{Key: "packet_src_channel", Value: channel},
+			{Key: "packet_dst_port", Value: "transfer"},
+			{Key: "packet_dst_channel", Value: "channel-42"},
+		},
+	}
+}
+
+func newSaveEvidenceEvent(xrplTxHash string) abci.Event {
+	return abci.Event{
+		Type: "wasm",
+		Attributes: []abci.EventAttribute{
+			{Key: "_contract_address", Value: testContractAddress.String()},
+			{Key: "action", Value: "save_evidence"},
+			{Key: "hash", Value: xrplTxHash},
+			{Key: "threshold_reached", Value: "true"},
+		},
+	}
+}
+
+func newABCIEvent(eventType, key, value string) abci.Event {
+	return abci.Event{
+		Type: eventType,
+		Attributes: []abci.EventAttribute{
+			{Key: key, Value: value},
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package coreum
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// The instantiation limits, enforced by the contract.
+const (
+	// MaxRelayers is the max number of the contract relayers.
+	MaxRelayers = 32
+	// MinUsedTicketSequenceThreshold is the min number of the used tickets to trigger the tickets allocation.
+	MinUsedTicketSequenceThreshold = uint32(2)
+	// MaxUsedTicketSequenceThreshold is the max number of the used tickets to trigger the tickets allocation, equal to
+	// the max number of the tickets the XRPL account can hold.
+	MaxUsedTicketSequenceThreshold = uint32(250)
+	// MinXRPLBaseFee is the min XRPL base fee in drops.
+	MinXRPLBaseFee = uint32(10)
+)
+
+// XRPDefaultMaxHoldingAmount is the max holding amount of the XRP token registered by the contract on instantiation.
+var XRPDefaultMaxHoldingAmount = sdkmath.NewIntWithDecimal(1, 16)
+
+// InstantiationConfig holds attributes used for the contract instantiation.
+type InstantiationConfig struct {
+	Owner                       sdk.AccAddress
+	Admin                       sdk.AccAddress
+	Relayers                    []Relayer
+	EvidenceThreshold           uint32
+	UsedTicketSequenceThreshold uint32
+	TrustSetLimitAmount         sdkmath.Int
+	BridgeXRPLAddress           string
+	XRPLBaseFee                 uint32
+	// MaxXRPLTxFee caps the fee of the multi-signing transactions, nil means no limit. The fee is a part of the signed
+	// transaction, so it's taken from the contract config to keep it the same for all relayers.
+	MaxXRPLTxFee *uint64
+	SourceTag    *uint32
+	// MaxEvidencesPerRelayerPerBlock limits the number of the evidences each relayer can save in a block, nil means
+	// no limit.
+	MaxEvidencesPerRelayerPerBlock *uint32
+	// TreasuryAddress receives the claimed treasury fees, nil means the owner receives them.
+	TreasuryAddress sdk.AccAddress
+}
+
+// Validate checks the cross-field constraints of the instantiation config, so the invalid config is rejected before
+// the contract deployment.
+func (c InstantiationConfig) Validate() error {
+	if len(c.Relayers) > MaxRelayers {
+		return errors.Errorf("too many relayers, max:%d, got:%d", MaxRelayers, len(c.Relayers))
+	}
+	if c.EvidenceThreshold == 0 || int(c.EvidenceThreshold) > len(c.Relayers) {
+		return errors.Errorf(
+			"evidence threshold must be between 1 and the relayers count %d, got:%d",
+			len(c.Relayers), c.EvidenceThreshold,
+		)
+	}
+
+	coreumAddresses := make(map[string]struct{}, len(c.Relayers))
+	xrplAddresses := make(map[string]struct{}, len(c.Relayers))
+	xrplPubKeys := make(map[string]struct{}, len(c.Relayers))
+	for i, relayer := range c.Relayers {
+		if relayer.CoreumAddress.Empty() {
+			return errors.Errorf("relayer %d Coreum address is empty", i)
+		}
+		if relayer.XRPLAddress == "" {
+			return errors.Errorf("relayer %d XRPL address is empty", i)
+		}
+		if relayer.XRPLPubKey == "" {
+			return errors.Errorf("relayer %d XRPL public key is empty", i)
+		}
+		if _, ok := coreumAddresses[relayer.CoreumAddress.String()]; ok {
+			return errors.Errorf("duplicated relayer Coreum address:%s", relayer.CoreumAddress.String())
+		}
+		coreumAddresses[relayer.CoreumAddress.String()] = struct{}{}
+		if _, ok := xrplAddresses[relayer.XRPLAddress]; ok {
+			return errors.Errorf("duplicated relayer XRPL address:%s", relayer.XRPLAddress)
+		}
+		xrplAddresses[relayer.XRPLAddress] = struct{}{}
+		if _, ok := xrplPubKeys[relayer.XRPLPubKey]; ok {
+			return errors.Errorf("duplicated relayer XRPL public key:%s", relayer.XRPLPubKey)
+		}
+		xrplPubKeys[relayer.XRPLPubKey] = struct{}{}
+	}
+
+	if c.UsedTicketSequenceThreshold < MinUsedTicketSequenceThreshold ||
+		c.UsedTicketSequenceThreshold > MaxUsedTicketSequenceThreshold {
+		return errors.Errorf(
+			"used ticket sequence threshold must be between %d and %d, got:%d",
+			MinUsedTicketSequenceThreshold, MaxUsedTicketSequenceThreshold, c.UsedTicketSequenceThreshold,
+		)
+	}
+
+	if c.TrustSetLimitAmount.IsNil() || !c.TrustSetLimitAmount.IsPositive() {
+		return errors.New("trust set limit amount must be positive")
+	}
+	if c.TrustSetLimitAmount.LT(XRPDefaultMaxHoldingAmount) {
+		return errors.Errorf(
+			"trust set limit amount must be greater than or equal to the XRP max holding amount %s, got:%s",
+			XRPDefaultMaxHoldingAmount.String(), c.TrustSetLimitAmount.String(),
+		)
+	}
+
+	if c.XRPLBaseFee < MinXRPLBaseFee {
+		return errors.Errorf("XRPL base fee must be at least %d drops, got:%d", MinXRPLBaseFee, c.XRPLBaseFee)
+	}
+
+	// the cap can't be lower than the fee of the transaction signed by the threshold number of relayers
+	thresholdTxFee := uint64(c.XRPLBaseFee) * uint64(1+c.EvidenceThreshold)
+	if c.MaxXRPLTxFee != nil && *c.MaxXRPLTxFee < thresholdTxFee {
+		return errors.Errorf(
+			"max XRPL tx fee must be greater than or equal to the XRPL base fee multiplied by the evidence threshold "+
+				"plus one %d, got:%d",
+			thresholdTxFee, *c.MaxXRPLTxFee,
+		)
+	}
+
+	if c.MaxEvidencesPerRelayerPerBlock != nil && *c.MaxEvidencesPerRelayerPerBlock == 0 {
+		return errors.New("max evidences per relayer per block must be positive if set")
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package coreum_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+func TestInstantiationConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		modify    func(cfg *coreum.InstantiationConfig)
+		wantError string
+	}{
+		{
+			name:   "valid",
+			modify: func(_ *coreum.InstantiationConfig) {},
+		},
+		{
+			name: "valid_min_limits",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.EvidenceThreshold = 1
+				cfg.UsedTicketSequenceThreshold = coreum.MinUsedTicketSequenceThreshold
+				cfg.TrustSetLimitAmount = coreum.XRPDefaultMaxHoldingAmount
+				cfg.XRPLBaseFee = coreum.MinXRPLBaseFee
+				cfg.MaxXRPLTxFee = lo.ToPtr(uint64(2 * coreum.MinXRPLBaseFee))
+				cfg.MaxEvidencesPerRelayerPerBlock = lo.ToPtr(uint32(1))
+			},
+		},
+		{
+			name: "valid_max_limits",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers = genTestInstantiationRelayers(coreum.MaxRelayers)
+				cfg.EvidenceThreshold = coreum.MaxRelayers
+				cfg.UsedTicketSequenceThreshold = coreum.MaxUsedTicketSequenceThreshold
+				cfg.TrustSetLimitAmount = coreum.MaxContractAmount
+			},
+		},
+		{
+			name: "too_many_relayers",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers = genTestInstantiationRelayers(coreum.MaxRelayers + 1)
+			},
+			wantError: "too many relayers, max:32, got:33",
+		},
+		{
+			name: "zero_evidence_threshold",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.EvidenceThreshold = 0
+			},
+			wantError: "evidence threshold must be between 1 and the relayers count 3, got:0",
+		},
+		{
+			name: "evidence_threshold_above_relayers_count",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.EvidenceThreshold = 4
+			},
+			wantError: "evidence threshold must be between 1 and the relayers count 3, got:4",
+		},
+		{
+			name: "no_relayers",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers = nil
+			},
+			wantError: "evidence threshold must be between 1 and the relayers count 0, got:2",
+		},
+		{
+			name: "empty_relayer_coreum_address",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[1].CoreumAddress = nil
+			},
+			wantError: "relayer 1 Coreum address is empty",
+		},
+		{
+			name: "empty_relayer_xrpl_address",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[1].XRPLAddress = ""
+			},
+			wantError: "relayer 1 XRPL address is empty",
+		},
+		{
+			name: "empty_relayer_xrpl_pub_key",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[2].XRPLPubKey = ""
+			},
+			wantError: "relayer 2 XRPL public key is empty",
+		},
+		{
+			name: "duplicated_relayer_coreum_address",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[2].CoreumAddress = cfg.Relayers[0].CoreumAddress
+			},
+			wantError: fmt.Sprintf(
+				"duplicated relayer Coreum address:%s", genTestInstantiationRelayers(1)[0].CoreumAddress.String(),
+			),
+		},
+		{
+			name: "duplicated_relayer_xrpl_address",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[2].XRPLAddress = cfg.Relayers[0].XRPLAddress
+			},
+			wantError: "duplicated relayer XRPL address:xrpl-address-0",
+		},
+		{
+			name: "duplicated_relayer_xrpl_pub_key",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.Relayers[2].XRPLPubKey = cfg.Relayers[0].XRPLPubKey
+			},
+			wantError: "duplicated relayer XRPL public key:xrpl-pub-key-0",
+		},
+		{
+			name: "used_ticket_sequence_threshold_below_min",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.UsedTicketSequenceThreshold = 1
+			},
+			wantError: "used ticket sequence threshold must be between 2 and 250, got:1",
+		},
+		{
+			name: "used_ticket_sequence_threshold_above_max",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.UsedTicketSequenceThreshold = 251
+			},
+			wantError: "used ticket sequence threshold must be between 2 and 250, got:251",
+		},
+		{
+			name: "nil_trust_set_limit_amount",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.TrustSetLimitAmount = sdkmath.Int{}
+			},
+			wantError: "trust set limit amount must be positive",
+		},
+		{
+			name: "zero_trust_set_limit_amount",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.TrustSetLimitAmount = sdkmath.ZeroInt()
+			},
+			wantError: "trust set limit amount must be positive",
+		},
+		{
+			name: "trust_set_limit_amount_below_xrp_max_holding_amount",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.TrustSetLimitAmount = coreum.XRPDefaultMaxHoldingAmount.SubRaw(1)
+			},
+			wantError: "trust set limit amount must be greater than or equal to the XRP max holding amount " +
+				"10000000000000000, got:9999999999999999",
+		},
+		{
+			name: "xrpl_base_fee_below_min",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.XRPLBaseFee = 9
+			},
+			wantError: "XRPL base fee must be at least 10 drops, got:9",
+		},
+		{
+			name: "max_xrpl_tx_fee_below_threshold_tx_fee",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.MaxXRPLTxFee = lo.ToPtr(uint64(29))
+			},
+			wantError: "max XRPL tx fee must be greater than or equal to the XRPL base fee multiplied by the evidence " +
+				"threshold plus one 30, got:29",
+		},
+		{
+			name: "zero_max_evidences_per_relayer_per_block",
+			modify: func(cfg *coreum.InstantiationConfig) {
+				cfg.MaxEvidencesPerRelayerPerBlock = lo.ToPtr(uint32(0))
+			},
+			wantError: "max evidences per relayer per block must be positive if set",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := coreum.InstantiationConfig{
+				Owner:                       testOwnerAddress,
+				Admin:                       testOwnerAddress,
+				Relayers:                    genTestInstantiationRelayers(3),
+				EvidenceThreshold:           2,
+				UsedTicketSequenceThreshold: 150,
+				TrustSetLimitAmount:         testTrustSetLimitAmount,
+				BridgeXRPLAddress:           testXRPLIssuer,
+				XRPLBaseFee:                 10,
+			}
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.wantError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantError)
+		})
+	}
+}
+
+func genTestInstantiationRelayers(count int) []coreum.Relayer {
+	relayers := make([]coreum.Relayer, 0, count)
+	for i := 0; i < count; i++ {
+		relayers = append(relayers, coreum.Relayer{
+			CoreumAddress: sdk.AccAddress(bytes.Repeat([]byte{byte(i + 10)}, 20)),
+			XRPLAddress:   fmt.Sprintf("xrpl-address-%d", i),
+			XRPLPubKey:    fmt.Sprintf("xrpl-pub-key-%d", i),
+		})
+	}
+
+	return relayers
+}
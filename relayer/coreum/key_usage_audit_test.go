@@ -0,0 +1,61 @@
+package coreum_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestContractClient_KeyUsageAudit(t *testing.T) {
+	t.Parallel()
+
+	var broadcastErr error
+	contractClient := coreum.NewContractClientWithoutChain(
+		coreum.DefaultContractClientConfig(testContractAddress),
+		logger.NewZapLoggerFromLogger(zap.NewNop()),
+		newFakeWasmQueryClient(t),
+		fakeAssetFTQueryClient{},
+		func(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+			if broadcastErr != nil {
+				return nil, broadcastErr
+			}
+			return &sdk.TxResponse{TxHash: "tx-hash"}, nil
+		},
+		nil,
+	)
+	auditLogger := &fakeKeyUsageAuditLogger{}
+	contractClient.SetKeyUsageAuditLogger(auditLogger)
+
+	ctx := context.Background()
+	_, err := contractClient.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+	require.NoError(t, err)
+	_, err = contractClient.TransferOwnership(ctx, testOwnerAddress, testOwnerAddress)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-hash", "tx-hash"}, auditLogger.txHashes)
+	require.Len(t, auditLogger.payloadDigests[0], 32)
+	// the digest depends on the tx messages
+	require.NotEqual(t, auditLogger.payloadDigests[0], auditLogger.payloadDigests[1])
+
+	// the tx which isn't broadcast isn't recorded
+	broadcastErr = errors.New("broadcast failed")
+	_, err = contractClient.TransferOwnership(ctx, testOwnerAddress, testRecipientAddress)
+	require.Error(t, err)
+	require.Len(t, auditLogger.txHashes, 2)
+}
+
+type fakeKeyUsageAuditLogger struct {
+	txHashes       []string
+	payloadDigests [][]byte
+}
+
+func (l *fakeKeyUsageAuditLogger) LogCoreumBroadcast(txHash string, payloadDigest []byte) {
+	l.txHashes = append(l.txHashes, txHash)
+	l.payloadDigests = append(l.payloadDigests, payloadDigest)
+}
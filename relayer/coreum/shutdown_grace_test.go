@@ -0,0 +1,47 @@
+package coreum
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithShutdownGrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without_grace", func(t *testing.T) {
+		t.Parallel()
+
+		parentCtx, parentCancel := context.WithCancel(context.Background())
+		ctx, cancel := withShutdownGrace(parentCtx, 0)
+		defer cancel()
+		parentCancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("canceled_after_grace", func(t *testing.T) {
+		t.Parallel()
+
+		parentCtx, parentCancel := context.WithCancel(context.Background())
+		ctx, cancel := withShutdownGrace(parentCtx, 50*time.Millisecond)
+		defer cancel()
+		parentCancel()
+		// the context is alive during the grace period
+		require.NoError(t, ctx.Err())
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("context isn't canceled after the grace period")
+		}
+	})
+
+	t.Run("canceled_explicitly", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := withShutdownGrace(context.Background(), time.Hour)
+		cancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
@@ -0,0 +1,26 @@
+package coreum
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/buildinfo"
+)
+
+func TestGetTxMemo(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	require.Equal(t, RelayerCoreumMemoPrefix+" "+buildinfo.VersionTag, getTxMemo(ctx))
+
+	ctx = WithTxMemoNote(ctx, "reason: incident")
+	memo := getTxMemo(ctx)
+	require.Equal(t, RelayerCoreumMemoPrefix+" "+buildinfo.VersionTag+" | reason: incident", memo)
+
+	// the version is still parsed from the memo with the note
+	version, _, _ := strings.Cut(strings.TrimPrefix(memo, RelayerCoreumMemoPrefix), RelayerCoreumMemoNoteSeparator)
+	require.Equal(t, buildinfo.VersionTag, strings.TrimSpace(version))
+}
@@ -0,0 +1,26 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// WriteFileAtomically writes the data to the file creating its dir if it doesn't exist. The data is written to the
+// temporary file first, which then replaces the file with the rename, so the previous version of the file is kept if
+// the write is interrupted.
+func WriteFileAtomically(filePath string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create dir, path:%s", filePath)
+	}
+	tmpFilePath := filePath + ".tmp"
+	if err := os.WriteFile(tmpFilePath, data, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write file, path:%s", tmpFilePath)
+	}
+	if err := os.Rename(tmpFilePath, filePath); err != nil {
+		return errors.Wrapf(err, "failed to replace file, path:%s", filePath)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package fsutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+)
+
+func TestWriteFileAtomically(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "dir", "store.yaml")
+	require.NoError(t, fsutil.WriteFileAtomically(filePath, []byte("v1")))
+	require.NoError(t, fsutil.WriteFileAtomically(filePath, []byte("v2")))
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Equal(t, "v2", string(data))
+	fileInfo, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), fileInfo.Mode().Perm())
+	// the temporary file is replaced
+	_, err = os.Stat(filePath + ".tmp")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
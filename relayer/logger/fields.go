@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"go.uber.org/zap"
+)
+
+// The field names of the XRPL observation and evidence submission logs. The values are logged as fields instead of
+// being formatted into the message, so the logs can be filtered by them and the repeated messages can be sampled.
+const (
+	DirectionFieldName   = "direction"
+	TxHashFieldName      = "tx_hash"
+	OperationIDFieldName = "operation_id"
+	TokenFieldName       = "token"
+	AmountFieldName      = "amount"
+)
+
+// Direction is the bridging direction logged with the DirectionFieldName.
+type Direction string
+
+// Direction values.
+const (
+	DirectionXRPLToCoreum Direction = "xrpl_to_coreum"
+	DirectionCoreumToXRPL Direction = "coreum_to_xrpl"
+)
+
+// DirectionField returns the bridging direction field.
+func DirectionField(direction Direction) zap.Field {
+	return zap.String(DirectionFieldName, string(direction))
+}
+
+// TxHashField returns the tx hash field.
+func TxHashField(txHash string) zap.Field {
+	return zap.String(TxHashFieldName, txHash)
+}
+
+// OperationIDField returns the operation ID field.
+func OperationIDField(operationID uint32) zap.Field {
+	return zap.Uint32(OperationIDFieldName, operationID)
+}
+
+// TokenField returns the token field, the token is the XRPL issuer and currency or the Coreum denom.
+func TokenField(token string) zap.Field {
+	return zap.String(TokenFieldName, token)
+}
+
+// XRPLTokenField returns the token field of the XRPL token.
+func XRPLTokenField(issuer, currency string) zap.Field {
+	return TokenField(issuer + "/" + currency)
+}
+
+// AmountField returns the amount field.
+func AmountField(amount string) zap.Field {
+	return zap.String(AmountFieldName, amount)
+}
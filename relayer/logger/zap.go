@@ -3,6 +3,7 @@ package logger
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -19,10 +20,22 @@ const (
 	tracingProcessFieldName    = "process"
 )
 
+// SamplingConfig is the config of the debug and info logs sampling. The logs with the same message and level are
+// counted each second, the first Initial logs are written and then only every Thereafter-th log. The warn and error
+// logs are never sampled.
+type SamplingConfig struct {
+	// Initial is the number of the same logs written each second before the sampling is started, 0 disables the
+	// sampling.
+	Initial int
+	// Thereafter is the sampling rate of the logs after the Initial, 0 drops all of them.
+	Thereafter int
+}
+
 // ZapLoggerConfig is ZapLogger config.
 type ZapLoggerConfig struct {
-	Level  string
-	Format string
+	Level    string
+	Format   string
+	Sampling SamplingConfig
 	// CallerSkip increases the number of callers skipped by caller annotation
 	// (as enabled by the AddCaller option). When building wrappers around the
 	// Logger and SugaredLogger, supplying this Option prevents zap from always
@@ -35,6 +48,10 @@ func DefaultZapLoggerConfig() ZapLoggerConfig {
 	return ZapLoggerConfig{
 		Level:  "info",
 		Format: "console",
+		Sampling: SamplingConfig{
+			Initial:    100,
+			Thereafter: 100,
+		},
 		//
 		CallerSkip: 2,
 	}
@@ -58,6 +75,9 @@ func NewZapLogger(cfg ZapLoggerConfig) (*ZapLogger, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Sampling.Initial < 0 || cfg.Sampling.Thereafter < 0 {
+		return nil, errors.Errorf("sampling values must not be negative, sampling:%+v", cfg.Sampling)
+	}
 
 	encoderConfig := zap.NewProductionEncoderConfig()
 	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -75,6 +95,9 @@ func NewZapLogger(cfg ZapLoggerConfig) (*ZapLogger, error) {
 		zap.AddCaller(),
 		zap.AddCallerSkip(cfg.CallerSkip),
 		zap.AddStacktrace(zapcore.ErrorLevel),
+		zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return newSamplingCore(core, cfg.Sampling)
+		}),
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to build zap logger from the config, config:%+v", zapCfg)
@@ -127,6 +150,59 @@ func addContextFields(ctx context.Context, fields ...zap.Field) []zap.Field {
 	return fields
 }
 
+// newSamplingCore wraps the core to sample the debug and info logs, the logs of the higher levels are written by the
+// original core.
+func newSamplingCore(core zapcore.Core, cfg SamplingConfig) zapcore.Core {
+	if cfg.Initial == 0 {
+		return core
+	}
+
+	return zapcore.NewTee(
+		zapcore.NewSamplerWithOptions(
+			&levelFilterCore{
+				Core: core,
+				filter: func(level zapcore.Level) bool {
+					return level < zapcore.WarnLevel
+				},
+			},
+			time.Second,
+			cfg.Initial,
+			cfg.Thereafter,
+		),
+		&levelFilterCore{
+			Core: core,
+			filter: func(level zapcore.Level) bool {
+				return level >= zapcore.WarnLevel
+			},
+		},
+	)
+}
+
+// levelFilterCore is zapcore.Core which writes the logs of the levels passed by the filter only.
+type levelFilterCore struct {
+	zapcore.Core
+	filter func(level zapcore.Level) bool
+}
+
+func (c *levelFilterCore) Enabled(level zapcore.Level) bool {
+	return c.filter(level) && c.Core.Enabled(level)
+}
+
+func (c *levelFilterCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelFilterCore{
+		Core:   c.Core.With(fields),
+		filter: c.filter,
+	}
+}
+
+func (c *levelFilterCore) Check(entry zapcore.Entry, checkedEntry *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.filter(entry.Level) {
+		return checkedEntry
+	}
+
+	return c.Core.Check(entry, checkedEntry)
+}
+
 // stringToLoggerLevel converts the string level to zapcore.Level.
 func stringToLoggerLevel(level string) (zapcore.Level, error) {
 	switch strings.ToLower(level) {
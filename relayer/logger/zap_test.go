@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestZapLogger_StructuredFields(t *testing.T) {
+	t.Parallel()
+
+	core, observedLogs := observer.New(zapcore.DebugLevel)
+	log := NewZapLoggerFromLogger(zap.New(core))
+
+	log.Info(
+		context.Background(),
+		"Successfully sent XRPL to Coreum transfer evidence",
+		DirectionField(DirectionXRPLToCoreum),
+		TxHashField("A1"),
+		OperationIDField(7),
+		XRPLTokenField("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "USD"),
+		AmountField("100"),
+	)
+
+	entries := observedLogs.All()
+	require.Len(t, entries, 1)
+	require.Equal(t, map[string]any{
+		DirectionFieldName:   string(DirectionXRPLToCoreum),
+		TxHashFieldName:      "A1",
+		OperationIDFieldName: uint32(7),
+		TokenFieldName:       "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh/USD",
+		AmountFieldName:      "100",
+	}, entries[0].ContextMap())
+}
+
+func TestZapLogger_Sampling(t *testing.T) {
+	t.Parallel()
+
+	core, observedLogs := observer.New(zapcore.DebugLevel)
+	log := NewZapLoggerFromLogger(zap.New(newSamplingCore(core, SamplingConfig{
+		Initial:    2,
+		Thereafter: 5,
+	})))
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		log.Debug(ctx, "debug", TxHashField("A1"))
+		log.Info(ctx, "info", TxHashField("A1"))
+		log.Warn(ctx, "warn")
+		log.Error(ctx, "error")
+	}
+
+	// the first 2 logs and then every 5th of the 8 left are written
+	require.Equal(t, 3, observedLogs.FilterMessage("debug").Len())
+	require.Equal(t, 3, observedLogs.FilterMessage("info").Len())
+	// the warn and error logs are never sampled
+	require.Equal(t, 10, observedLogs.FilterMessage("warn").Len())
+	require.Equal(t, 10, observedLogs.FilterMessage("error").Len())
+}
+
+func TestZapLogger_SamplingDisabled(t *testing.T) {
+	t.Parallel()
+
+	core, observedLogs := observer.New(zapcore.DebugLevel)
+	log := NewZapLoggerFromLogger(zap.New(newSamplingCore(core, SamplingConfig{})))
+
+	for i := 0; i < 10; i++ {
+		log.Info(context.Background(), "info")
+	}
+	require.Equal(t, 10, observedLogs.Len())
+}
+
+func TestNewZapLogger_InvalidSampling(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultZapLoggerConfig()
+	cfg.Sampling.Initial = -1
+	_, err := NewZapLogger(cfg)
+	require.ErrorContains(t, err, "sampling values must not be negative")
+}
@@ -41,6 +41,12 @@ type XRPLRPCClient interface {
 	ServerState(ctx context.Context) (xrpl.ServerStateResult, error)
 	GetXRPLBalances(ctx context.Context, acc rippledata.Account) ([]rippledata.Amount, error)
 	AccountInfo(ctx context.Context, acc rippledata.Account) (xrpl.AccountInfoResult, error)
+	AccountObjects(
+		ctx context.Context,
+		account rippledata.Account,
+		objectType string,
+		marker any,
+	) (xrpl.AccountObjectsResult, error)
 }
 
 // ContractClient is the interface for the contract client.
@@ -62,6 +68,8 @@ type PeriodicCollectorConfig struct {
 	CoreumQueryPageLimit     uint64
 	// what time frame to take to track the relayer activity
 	RelayerActivityCheckFrame time.Duration
+	// the XRPL account spendable balance lower than the buffer is logged as a warning
+	XRPLSpendableWarningBufferDrops int64
 }
 
 // DefaultPeriodicCollectorConfig returns default PeriodicCollectorConfig.
@@ -72,6 +80,8 @@ func DefaultPeriodicCollectorConfig() PeriodicCollectorConfig {
 		CoreumQueryPageLimit:     1000,
 		// we take the activity for the last 2 days
 		RelayerActivityCheckFrame: 24 * time.Hour,
+		// 5 XRP
+		XRPLSpendableWarningBufferDrops: 5_000_000,
 	}
 }
 
@@ -92,6 +102,8 @@ type PeriodicCollector struct {
 	relayersBalancesCachedKeys     map[string]struct{}
 	relayerActivityCachedKeys      map[string]struct{}
 	relayerVersionCachedKeys       map[string]struct{}
+	bridgeXRPLAccountCachedKeys    map[string]struct{}
+	relayerXRPLSpendableCachedKeys map[string]struct{}
 	cacheMu                        sync.Mutex
 }
 
@@ -125,6 +137,8 @@ func NewPeriodicCollector(
 		relayersBalancesCachedKeys:     make(map[string]struct{}),
 		relayerActivityCachedKeys:      make(map[string]struct{}),
 		relayerVersionCachedKeys:       make(map[string]struct{}),
+		bridgeXRPLAccountCachedKeys:    make(map[string]struct{}),
+		relayerXRPLSpendableCachedKeys: make(map[string]struct{}),
 		cacheMu:                        sync.Mutex{},
 	}
 }
@@ -144,6 +158,10 @@ func (c *PeriodicCollector) Start(ctx context.Context) error {
 		fmt.Sprintf("%s/%s", relayerActivityMetricName, relayerVersionMetricName): c.collectRelayerActivityAndVersion,
 		xrplTokensCoreumSupplyMetricName:                                          c.collectXRPLTokensCoreumSupply,
 		xrplBridgeAccountReservesMetricName:                                       c.collectXRPLBridgeAccountReserves,
+		bridgeXRPLAccountMisconfiguredMetricName:                                  c.collectBridgeXRPLAccountMisconfiguration,
+		fmt.Sprintf(
+			"%s/%s", bridgeXRPLAccountSpendableDropsMetricName, relayerXRPLAccountSpendableDropsMetricName,
+		): c.collectXRPLAccountsSpendable,
 	}
 	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
 		for name, collector := range periodicCollectors {
@@ -462,7 +480,11 @@ func (c *PeriodicCollector) collectRelayerActivityAndVersion(ctx context.Context
 			if !strings.HasPrefix(tx.Body.Memo, coreum.RelayerCoreumMemoPrefix) {
 				continue
 			}
-			version := strings.TrimSpace(strings.TrimPrefix(tx.Body.Memo, coreum.RelayerCoreumMemoPrefix))
+			// the memo might contain the note after the version
+			version, _, _ := strings.Cut(
+				strings.TrimPrefix(tx.Body.Memo, coreum.RelayerCoreumMemoPrefix), coreum.RelayerCoreumMemoNoteSeparator,
+			)
+			version = strings.TrimSpace(version)
 			if version == "" {
 				continue
 			}
@@ -561,6 +583,138 @@ func (c *PeriodicCollector) collectXRPLBridgeAccountReserves(ctx context.Context
 	return nil
 }
 
+func (c *PeriodicCollector) collectXRPLAccountsSpendable(ctx context.Context) error {
+	contractCfg, err := c.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get contract config")
+	}
+	serverState, err := c.xrplRPCClient.ServerState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get XRPL server state")
+	}
+
+	bridgeReserves, found, err := c.getXRPLAccountReserves(ctx, contractCfg.BridgeXRPLAddress, serverState)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.Errorf("XRPL bridge account %s is not found", contractCfg.BridgeXRPLAddress)
+	}
+	c.registry.BridgeXRPLAccountSpendableDropsGauge.Set(float64(bridgeReserves.SpendableDrops))
+	c.warnOnLowXRPLSpendable(ctx, "bridge", contractCfg.BridgeXRPLAddress, bridgeReserves)
+
+	currentValues := make(map[string]gaugeVecValue)
+	// get sequentially to prevent rate limit
+	for _, relayer := range contractCfg.Relayers {
+		reserves, found, err := c.getXRPLAccountReserves(ctx, relayer.XRPLAddress, serverState)
+		if err != nil {
+			return err
+		}
+		// the relayer account isn't required to be activated since it only signs the bridge account txs
+		if !found {
+			continue
+		}
+		currentValues[relayer.XRPLAddress] = gaugeVecValue{
+			keys:  []string{relayer.XRPLAddress},
+			value: float64(reserves.SpendableDrops),
+		}
+		c.warnOnLowXRPLSpendable(ctx, "relayer", relayer.XRPLAddress, reserves)
+	}
+	c.updateGaugeVecAndCachedValues(
+		currentValues, c.relayerXRPLSpendableCachedKeys, c.registry.RelayerXRPLAccountSpendableDropsGaugeVec,
+	)
+
+	return nil
+}
+
+func (c *PeriodicCollector) getXRPLAccountReserves(
+	ctx context.Context,
+	address string,
+	serverState xrpl.ServerStateResult,
+) (xrpl.AccountReserves, bool, error) {
+	account, err := rippledata.NewAccountFromAddress(address)
+	if err != nil {
+		return xrpl.AccountReserves{}, false, errors.Wrapf(
+			err, "failed to convert XRPL address to rippledata.Account, address:%s", address,
+		)
+	}
+	accountInfo, err := c.xrplRPCClient.AccountInfo(ctx, *account)
+	if err != nil {
+		if xrpl.IsAccountNotFoundError(err) {
+			return xrpl.AccountReserves{}, false, nil
+		}
+		return xrpl.AccountReserves{}, false, errors.Wrapf(err, "failed to get XRPL account info, address:%s", address)
+	}
+	reserves, err := xrpl.ComputeAccountReservesFromInfo(accountInfo, serverState)
+	if err != nil {
+		return xrpl.AccountReserves{}, false, errors.Wrapf(err, "failed to compute XRPL reserves, address:%s", address)
+	}
+
+	return reserves, true, nil
+}
+
+func (c *PeriodicCollector) warnOnLowXRPLSpendable(
+	ctx context.Context,
+	accountType string,
+	address string,
+	reserves xrpl.AccountReserves,
+) {
+	if reserves.SpendableDrops >= c.cfg.XRPLSpendableWarningBufferDrops {
+		return
+	}
+	c.log.Warn(
+		ctx,
+		"XRPL account spendable balance is lower than the buffer, the txs might fail with tecINSUFFICIENT_RESERVE",
+		zap.String("accountType", accountType),
+		zap.String("address", address),
+		zap.Int64("spendableDrops", reserves.SpendableDrops),
+		zap.Int64("bufferDrops", c.cfg.XRPLSpendableWarningBufferDrops),
+		zap.Int64("requiredReserveDrops", reserves.RequiredReserveDrops),
+		zap.Uint32("ownerCount", reserves.OwnerCount),
+	)
+}
+
+func (c *PeriodicCollector) collectBridgeXRPLAccountMisconfiguration(ctx context.Context) error {
+	contractCfg, err := c.contractClient.GetContractConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get contract config")
+	}
+	xrplBridgeAccount, err := rippledata.NewAccountFromAddress(contractCfg.BridgeXRPLAddress)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"failed to convert bridge XRPL address to rippledata.Account, address:%s",
+			contractCfg.BridgeXRPLAddress,
+		)
+	}
+	misconfigurations, err := xrpl.VerifyBridgeAccount(
+		ctx, c.xrplRPCClient, *xrplBridgeAccount, buildBridgeXRPLAccountExpectedConfig(contractCfg),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify XRPL bridge account")
+	}
+
+	currentValues := make(map[string]gaugeVecValue)
+	for _, misconfiguration := range misconfigurations {
+		c.log.Error(
+			ctx,
+			"XRPL bridge account is misconfigured",
+			zap.String("reason", string(misconfiguration.Reason)),
+			zap.String("details", misconfiguration.Details),
+		)
+		reason := string(misconfiguration.Reason)
+		currentValues[reason] = gaugeVecValue{
+			keys:  []string{reason},
+			value: 1,
+		}
+	}
+	c.updateGaugeVecAndCachedValues(
+		currentValues, c.bridgeXRPLAccountCachedKeys, c.registry.BridgeXRPLAccountMisconfiguredGaugeVec,
+	)
+
+	return nil
+}
+
 func (c *PeriodicCollector) updateGaugeVecAndCachedValues(
 	currentValues map[string]gaugeVecValue,
 	cachedKeys map[string]struct{},
@@ -661,6 +815,15 @@ func (c *PeriodicCollector) getRelayerCallsWithinTimeFrame(
 	return txResponses, nil
 }
 
+func buildBridgeXRPLAccountExpectedConfig(contractCfg coreum.ContractConfig) xrpl.BridgeAccountExpectedConfig {
+	return xrpl.BridgeAccountExpectedConfig{
+		SignerQuorum: contractCfg.EvidenceThreshold,
+		SignerAddresses: lo.Map(contractCfg.Relayers, func(relayer coreum.Relayer, _ int) string {
+			return relayer.XRPLAddress
+		}),
+	}
+}
+
 func truncateAmountWithDecimals(decimals uint32, amount sdkmath.Int) float64 {
 	tenPowerDec := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
 	balanceRat := big.NewRat(0, 1).SetFrac(amount.BigInt(), tenPowerDec)
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// XRPLChainName is the name of the XRPL chain in the readiness report.
+const XRPLChainName = "xrpl"
+
+// LastProcessedBlockTimeFunc returns the time the chain block was processed last time, the zero time means that no
+// block is processed yet.
+type LastProcessedBlockTimeFunc func() time.Time
+
+// StaleChain is the chain which blocks aren't processed for longer than the staleness threshold.
+type StaleChain struct {
+	Chain string `json:"chain"`
+	// LastProcessedAt is nil if no block is processed since the start.
+	LastProcessedAt *time.Time `json:"last_processed_at,omitempty"`
+	StaleForSeconds float64    `json:"stale_for_seconds"`
+}
+
+// ReadinessReport is the result of the readiness check.
+type ReadinessReport struct {
+	Ready       bool         `json:"ready"`
+	StaleChains []StaleChain `json:"stale_chains,omitempty"`
+}
+
+// ReadinessProbe checks that the chains blocks are processed within the staleness threshold.
+type ReadinessProbe struct {
+	stalenessThreshold time.Duration
+	clock              func() time.Time
+	startedAt          time.Time
+
+	mu     sync.RWMutex
+	chains map[string]LastProcessedBlockTimeFunc
+}
+
+// NewReadinessProbe returns a new instance of the ReadinessProbe. The zero staleness threshold disables the check.
+func NewReadinessProbe(stalenessThreshold time.Duration, clock func() time.Time) *ReadinessProbe {
+	return &ReadinessProbe{
+		stalenessThreshold: stalenessThreshold,
+		clock:              clock,
+		startedAt:          clock(),
+		chains:             make(map[string]LastProcessedBlockTimeFunc),
+	}
+}
+
+// RegisterChain registers the chain last processed block time provider.
+func (p *ReadinessProbe) RegisterChain(chain string, lastProcessedBlockTime LastProcessedBlockTimeFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.chains[chain] = lastProcessedBlockTime
+}
+
+// Check returns the readiness report. Until the first block is processed the staleness is counted from the probe
+// creation, so the relayer isn't reported as stale right after the start.
+func (p *ReadinessProbe) Check() ReadinessReport {
+	if p.stalenessThreshold == 0 {
+		return ReadinessReport{Ready: true}
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	now := p.clock()
+	staleChains := make([]StaleChain, 0)
+	for chain, lastProcessedBlockTime := range p.chains {
+		lastProcessedAt := lastProcessedBlockTime()
+		since := p.startedAt
+		if !lastProcessedAt.IsZero() {
+			since = lastProcessedAt
+		}
+		staleFor := now.Sub(since)
+		if staleFor <= p.stalenessThreshold {
+			continue
+		}
+		staleChain := StaleChain{
+			Chain:           chain,
+			StaleForSeconds: staleFor.Seconds(),
+		}
+		if !lastProcessedAt.IsZero() {
+			staleChain.LastProcessedAt = &lastProcessedAt
+		}
+		staleChains = append(staleChains, staleChain)
+	}
+	sort.Slice(staleChains, func(i, j int) bool {
+		return staleChains[i].Chain < staleChains[j].Chain
+	})
+
+	return ReadinessReport{
+		Ready:       len(staleChains) == 0,
+		StaleChains: staleChains,
+	}
+}
+
+// ServeHTTP writes the readiness report, the status is 503 if any chain is stale.
+func (p *ReadinessProbe) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	report := p.Check()
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errcheck // the client might be disconnected, there is nothing to do with the error
+	json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,86 @@
+package metrics_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/metrics"
+)
+
+func TestReadinessProbe_Check(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := startedAt
+	clock := func() time.Time { return now }
+	var lastProcessedAt time.Time
+
+	probe := metrics.NewReadinessProbe(time.Minute, clock)
+	probe.RegisterChain(metrics.XRPLChainName, func() time.Time { return lastProcessedAt })
+
+	// no ledger is processed, but the threshold since the start isn't reached
+	now = startedAt.Add(time.Minute)
+	require.Equal(t, metrics.ReadinessReport{Ready: true, StaleChains: []metrics.StaleChain{}}, probe.Check())
+
+	// no ledger is processed since the start
+	now = startedAt.Add(90 * time.Second)
+	require.Equal(t, metrics.ReadinessReport{
+		StaleChains: []metrics.StaleChain{
+			{
+				Chain:           metrics.XRPLChainName,
+				StaleForSeconds: 90,
+			},
+		},
+	}, probe.Check())
+
+	// the ledger is processed recently
+	lastProcessedAt = startedAt.Add(80 * time.Second)
+	require.True(t, probe.Check().Ready)
+
+	// the scanner hangs
+	now = lastProcessedAt.Add(2 * time.Minute)
+	require.Equal(t, metrics.ReadinessReport{
+		StaleChains: []metrics.StaleChain{
+			{
+				Chain:           metrics.XRPLChainName,
+				LastProcessedAt: &lastProcessedAt,
+				StaleForSeconds: 120,
+			},
+		},
+	}, probe.Check())
+
+	// the zero threshold disables the check
+	disabledProbe := metrics.NewReadinessProbe(0, clock)
+	disabledProbe.RegisterChain(metrics.XRPLChainName, func() time.Time { return time.Time{} })
+	now = now.Add(time.Hour)
+	require.True(t, disabledProbe.Check().Ready)
+}
+
+func TestReadinessProbe_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	probe := metrics.NewReadinessProbe(time.Minute, clock)
+	probe.RegisterChain(metrics.XRPLChainName, func() time.Time { return time.Time{} })
+
+	rec := httptest.NewRecorder()
+	probe.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	now = now.Add(2 * time.Minute)
+	rec = httptest.NewRecorder()
+	probe.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var report metrics.ReadinessReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.False(t, report.Ready)
+	require.Len(t, report.StaleChains, 1)
+	require.Equal(t, metrics.XRPLChainName, report.StaleChains[0].Chain)
+}
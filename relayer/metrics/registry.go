@@ -25,6 +25,39 @@ const (
 	xrplBridgeAccountReservesMetricName               = "xrpl_bridge_account_reserves"
 	relayerVersionMetricName                          = "relayer_version"
 	xrplRPCDecodingErrorCounterMetricName             = "xrpl_rpc_decoding_errors_total"
+	xrplMemoLimitExceededTxsCounterMetricName         = "xrpl_memo_limit_exceeded_txs_total"
+	bridgeDuplicateSignatureCounterMetricName         = "bridge_duplicate_signature_total"
+	bridgeXRPLAccountMisconfiguredMetricName          = "bridge_xrpl_account_misconfigured"
+	coreumContractEventsReconnectsMetricName          = "coreum_contract_events_reconnects_total"
+	coreumContractEventsGapFillStartHeightMetricName  = "coreum_contract_events_gap_fill_start_height"
+	coreumContractEventsGapFillEndHeightMetricName    = "coreum_contract_events_gap_fill_end_height"
+	// CoreumToXRPLTransferRateLimitConsumptionMetricName is Coreum to XRPL transfer rate limit window consumption
+	// metric name.
+	CoreumToXRPLTransferRateLimitConsumptionMetricName = "coreum_to_xrpl_transfer_rate_limit_consumption"
+	// CoreumToXRPLTransferRateLimitMaxAmountMetricName is Coreum to XRPL transfer rate limit max amount metric name.
+	CoreumToXRPLTransferRateLimitMaxAmountMetricName = "coreum_to_xrpl_transfer_rate_limit_max_amount"
+	// CoreumToXRPLTransferRateLimitedOperationsMetricName is Coreum to XRPL transfer rate limited operations metric
+	// name.
+	CoreumToXRPLTransferRateLimitedOperationsMetricName = "coreum_to_xrpl_transfer_rate_limited_operations_total"
+	oldestPendingOperationAgeMetricName                 = "bridge_oldest_pending_operation_age_seconds"
+	pendingOperationMaxAgeMetricName                    = "bridge_pending_operation_max_age_seconds"
+	blockedDeliveriesMetricName                         = "xrpl_to_coreum_blocked_deliveries"
+	xrplToCoreumBelowMinAmountTransfersMetricName       = "xrpl_to_coreum_below_min_amount_transfers_total"
+	xrplHistoryGapMetricName                            = "bridge_xrpl_history_gap"
+	xrplToCoreumLatencyMetricName                       = "bridge_xrpl_to_coreum_latency_seconds"
+	coreumToXRPLLatencyMetricName                       = "bridge_coreum_to_xrpl_latency_seconds"
+	xrplRPCEndpointLatencyMetricName                    = "xrpl_rpc_endpoint_latency_seconds"
+	coreumGRPCEndpointSelectedMetricName                = "coreum_grpc_endpoint_selected"
+	coreumGRPCFailoversMetricName                       = "coreum_grpc_failovers_total"
+	coreumContractCacheRequestsMetricName               = "coreum_contract_cache_requests_total"
+	coreumToXRPLAmountPrecisionMismatchesMetricName     = "coreum_to_xrpl_amount_precision_mismatches_total"
+	unclaimedRefundsMetricName                          = "unclaimed_refunds"
+	relayedRefundClaimsMetricName                       = "relayed_refund_claims_total"
+	coreumContractTxGasUsedMetricName                   = "coreum_contract_tx_gas_used"
+	bridgeXRPLAccountSpendableDropsMetricName           = "bridge_xrpl_account_spendable_drops"
+	relayerXRPLAccountSpendableDropsMetricName          = "relayer_xrpl_account_spendable_drops"
+	bridgeTicketsConsumedMetricName                     = "bridge_tickets_consumed_total"
+	keyUsageAuditLogFailuresMetricName                  = "key_usage_audit_log_failures_total"
 
 	// XRPLCurrencyIssuerLabel is XRPL currency issuer label.
 	XRPLCurrencyIssuerLabel = "xrpl_currency_issuer"
@@ -42,8 +75,35 @@ const (
 	ActionLabel = "action"
 	// VersionLabel is version label.
 	VersionLabel = "version"
+	// ReasonLabel is reason label.
+	ReasonLabel = "reason"
+	// OperationTypeLabel is operation type label.
+	OperationTypeLabel = "operation_type"
+	// URLLabel is URL label.
+	URLLabel = "url"
+	// QueryLabel is query label.
+	QueryLabel = "query"
+	// CacheResultLabel is cache result label.
+	CacheResultLabel = "cache_result"
+	// CoreumAddressLabel is Coreum address label.
+	CoreumAddressLabel = "coreum_address"
+	// RelayerXRPLAddressLabel is relayer XRPL address label.
+	RelayerXRPLAddressLabel = "relayer_xrpl_address"
+	// TypeLabel is type label.
+	TypeLabel = "type"
+	// ResultLabel is result label.
+	ResultLabel = "result"
+
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
 )
 
+// latencyBuckets are the transfer latency histogram buckets from 1 second to about 1 hour.
+var latencyBuckets = prometheus.ExponentialBuckets(1, 2, 13)
+
+// gasBuckets are the Coreum tx gas histogram buckets from 50k to about 100m gas.
+var gasBuckets = prometheus.ExponentialBuckets(50_000, 2, 12)
+
 // Registry contains metrics.
 type Registry struct {
 	RelayerErrorCounter                          prometheus.Counter
@@ -64,7 +124,48 @@ type Registry struct {
 	RelayerVersion                               *prometheus.GaugeVec
 	XRPLTokensCoreumSupplyGaugeVec               *prometheus.GaugeVec
 	XRPLBridgeAccountReservesGauge               prometheus.Gauge
+	BridgeXRPLAccountSpendableDropsGauge         prometheus.Gauge
+	RelayerXRPLAccountSpendableDropsGaugeVec     *prometheus.GaugeVec
 	XRPLRPCDecodingErrorCounter                  prometheus.Counter
+	XRPLMemoLimitExceededTxsCounter              prometheus.Counter
+	BridgeDuplicateSignatureCounter              prometheus.Counter
+	BridgeXRPLAccountMisconfiguredGaugeVec       *prometheus.GaugeVec
+	CoreumContractEventsReconnectCounter         prometheus.Counter
+	CoreumContractEventsGapFillStartHeightGauge  prometheus.Gauge
+	CoreumContractEventsGapFillEndHeightGauge    prometheus.Gauge
+	// the rate limit metrics are labeled with the XRPLCurrencyIssuerLabel
+	CoreumToXRPLTransferRateLimitConsumptionGaugeVec    *prometheus.GaugeVec
+	CoreumToXRPLTransferRateLimitMaxAmountGaugeVec      *prometheus.GaugeVec
+	CoreumToXRPLTransferRateLimitedOperationsCounterVec *prometheus.CounterVec
+	// the operations age is computed from the time the relayer has first seen the operation
+	OldestPendingOperationAgeGauge prometheus.Gauge
+	PendingOperationMaxAgeGaugeVec *prometheus.GaugeVec
+	BlockedDeliveriesGaugeVec      *prometheus.GaugeVec
+	// the counter is labeled with the XRPLCurrencyIssuerLabel
+	XRPLToCoreumBelowMinAmountTransfersCounterVec *prometheus.CounterVec
+	XRPLHistoryGapGauge                           prometheus.Gauge
+	// the latency is measured between the transfer start and completion times on the chains
+	XRPLToCoreumLatencyHistogram prometheus.Histogram
+	CoreumToXRPLLatencyHistogram prometheus.Histogram
+	// the gauge is labeled with the URLLabel
+	XRPLRPCEndpointLatencyGaugeVec *prometheus.GaugeVec
+	// the gauge is labeled with the URLLabel, it's 1 for the selected endpoint and 0 for the others
+	CoreumGRPCEndpointSelectedGaugeVec *prometheus.GaugeVec
+	CoreumGRPCFailoverCounter          prometheus.Counter
+	// the counter is labeled with the QueryLabel and CacheResultLabel, so the hit ratio is computed per query
+	CoreumContractCacheRequestsCounterVec *prometheus.CounterVec
+	// the counter is labeled with the XRPLCurrencyIssuerLabel
+	CoreumToXRPLAmountPrecisionMismatchesCounterVec *prometheus.CounterVec
+	// the refund metrics are labeled with the CoreumAddressLabel of the refund owner
+	UnclaimedRefundsGaugeVec      *prometheus.GaugeVec
+	RelayedRefundClaimsCounterVec *prometheus.CounterVec
+	// the histogram is labeled with the OperationTypeLabel, so the max gas per operation is taken from the buckets
+	CoreumContractTxGasUsedHistogramVec *prometheus.HistogramVec
+	// the counter is labeled with the TypeLabel of the operation and the ResultLabel, the tickets of the invalid
+	// operations are returned to the contract, so they are counted separately from the consumed ones
+	BridgeTicketsConsumedCounterVec *prometheus.CounterVec
+	// the counter is labeled with the ActionLabel of the key usage which isn't written to the audit log
+	KeyUsageAuditLogFailuresCounterVec *prometheus.CounterVec
 }
 
 // NewRegistry returns new metric registry.
@@ -182,10 +283,195 @@ func NewRegistry() *Registry {
 			Name: xrplBridgeAccountReservesMetricName,
 			Help: "XRPL bridge account reserves",
 		}),
+		BridgeXRPLAccountSpendableDropsGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: bridgeXRPLAccountSpendableDropsMetricName,
+			Help: "XRPL bridge account balance above the required reserve in drops",
+		}),
+		RelayerXRPLAccountSpendableDropsGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: relayerXRPLAccountSpendableDropsMetricName,
+			Help: "Relayer XRPL account balance above the required reserve in drops",
+		},
+			[]string{
+				RelayerXRPLAddressLabel,
+			},
+		),
 		XRPLRPCDecodingErrorCounter: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: xrplRPCDecodingErrorCounterMetricName,
 			Help: "XRPL RPC decoding error counter",
 		}),
+		XRPLMemoLimitExceededTxsCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: xrplMemoLimitExceededTxsCounterMetricName,
+			Help: "Incoming XRPL txs rejected because of the memos exceeding the size or count limits",
+		}),
+		BridgeDuplicateSignatureCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: bridgeDuplicateSignatureCounterMetricName,
+			Help: "Operation signatures rejected by the contract because the relayer has already provided them",
+		}),
+		BridgeXRPLAccountMisconfiguredGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: bridgeXRPLAccountMisconfiguredMetricName,
+			Help: "XRPL bridge account misconfiguration",
+		},
+			[]string{
+				ReasonLabel,
+			},
+		),
+		CoreumContractEventsReconnectCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: coreumContractEventsReconnectsMetricName,
+			Help: "Coreum contract events websocket reconnect counter",
+		}),
+		CoreumContractEventsGapFillStartHeightGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: coreumContractEventsGapFillStartHeightMetricName,
+			Help: "Start height of the last Coreum contract events gap fill",
+		}),
+		CoreumContractEventsGapFillEndHeightGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: coreumContractEventsGapFillEndHeightMetricName,
+			Help: "End height of the last Coreum contract events gap fill",
+		}),
+		CoreumToXRPLTransferRateLimitConsumptionGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: CoreumToXRPLTransferRateLimitConsumptionMetricName,
+			Help: "Coreum to XRPL transfers amount signed by the relayer in the current rate limit window",
+		},
+			[]string{
+				XRPLCurrencyIssuerLabel,
+			},
+		),
+		CoreumToXRPLTransferRateLimitMaxAmountGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: CoreumToXRPLTransferRateLimitMaxAmountMetricName,
+			Help: "Coreum to XRPL transfers max amount per rate limit window",
+		},
+			[]string{
+				XRPLCurrencyIssuerLabel,
+			},
+		),
+		CoreumToXRPLTransferRateLimitedOperationsCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: CoreumToXRPLTransferRateLimitedOperationsMetricName,
+			Help: "Coreum to XRPL transfer operations not signed because of the rate limit",
+		},
+			[]string{
+				XRPLCurrencyIssuerLabel,
+			},
+		),
+		OldestPendingOperationAgeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: oldestPendingOperationAgeMetricName,
+			Help: "Age of the oldest pending operation",
+		}),
+		PendingOperationMaxAgeGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: pendingOperationMaxAgeMetricName,
+			Help: "Max age of the pending operations by operation type",
+		},
+			[]string{
+				OperationTypeLabel,
+			},
+		),
+		BlockedDeliveriesGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: blockedDeliveriesMetricName,
+			Help: "XRPL to Coreum deliveries blocked by the asset FT rules by denom",
+		},
+			[]string{
+				CoreumDenomLabel,
+			},
+		),
+		XRPLToCoreumBelowMinAmountTransfersCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: xrplToCoreumBelowMinAmountTransfersMetricName,
+			Help: "XRPL to Coreum transfers skipped because of the amount below the min bridge amount",
+		},
+			[]string{
+				XRPLCurrencyIssuerLabel,
+			},
+		),
+		XRPLHistoryGapGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: xrplHistoryGapMetricName,
+			Help: "Number of the not scanned XRPL ledgers missing on the node, kept until the gap is backfilled",
+		}),
+		XRPLToCoreumLatencyHistogram: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    xrplToCoreumLatencyMetricName,
+			Help:    "Time from the XRPL payment ledger close to the Coreum block with the evidence threshold reached",
+			Buckets: latencyBuckets,
+		}),
+		CoreumToXRPLLatencyHistogram: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    coreumToXRPLLatencyMetricName,
+			Help:    "Time from the Coreum send to XRPL tx block to the XRPL payment ledger close",
+			Buckets: latencyBuckets,
+		}),
+		XRPLRPCEndpointLatencyGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: xrplRPCEndpointLatencyMetricName,
+			Help: "P95 latency of the XRPL RPC endpoint requests in the sliding window",
+		},
+			[]string{
+				URLLabel,
+			},
+		),
+		CoreumGRPCEndpointSelectedGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: coreumGRPCEndpointSelectedMetricName,
+			Help: "Whether the Coreum GRPC endpoint is selected for the requests",
+		},
+			[]string{
+				URLLabel,
+			},
+		),
+		CoreumGRPCFailoverCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: coreumGRPCFailoversMetricName,
+			Help: "Number of the Coreum GRPC endpoint failovers",
+		}),
+		CoreumContractCacheRequestsCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: coreumContractCacheRequestsMetricName,
+			Help: "Coreum contract cached query requests",
+		},
+			[]string{
+				QueryLabel,
+				CacheResultLabel,
+			},
+		),
+		CoreumToXRPLAmountPrecisionMismatchesCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: coreumToXRPLAmountPrecisionMismatchesMetricName,
+			Help: "Coreum to XRPL operations not signed because the amount is not representable in the XRPL precision",
+		},
+			[]string{
+				XRPLCurrencyIssuerLabel,
+			},
+		),
+		UnclaimedRefundsGaugeVec: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: unclaimedRefundsMetricName,
+			Help: "Pending refunds not claimed for longer than the threshold and not claimable by the relayer",
+		},
+			[]string{
+				CoreumAddressLabel,
+			},
+		),
+		RelayedRefundClaimsCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: relayedRefundClaimsMetricName,
+			Help: "Pending refunds claimed by the relayer on behalf of the refund owner",
+		},
+			[]string{
+				CoreumAddressLabel,
+			},
+		),
+		CoreumContractTxGasUsedHistogramVec: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    coreumContractTxGasUsedMetricName,
+			Help:    "Gas used by the Coreum txs broadcast by the relayer by operation type",
+			Buckets: gasBuckets,
+		},
+			[]string{
+				OperationTypeLabel,
+			},
+		),
+		BridgeTicketsConsumedCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: bridgeTicketsConsumedMetricName,
+			Help: "Bridge XRPL account tickets used by the completed operations by operation type and result",
+		},
+			[]string{
+				TypeLabel,
+				ResultLabel,
+			},
+		),
+		KeyUsageAuditLogFailuresCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: keyUsageAuditLogFailuresMetricName,
+			Help: "Relayer keys usages which are failed to be written to the audit log by action",
+		},
+			[]string{
+				ActionLabel,
+			},
+		),
 	}
 }
 
@@ -210,7 +496,35 @@ func (m *Registry) Register(registry prometheus.Registerer) error {
 		m.RelayerVersion,
 		m.XRPLTokensCoreumSupplyGaugeVec,
 		m.XRPLBridgeAccountReservesGauge,
+		m.BridgeXRPLAccountSpendableDropsGauge,
+		m.RelayerXRPLAccountSpendableDropsGaugeVec,
 		m.XRPLRPCDecodingErrorCounter,
+		m.XRPLMemoLimitExceededTxsCounter,
+		m.BridgeDuplicateSignatureCounter,
+		m.BridgeXRPLAccountMisconfiguredGaugeVec,
+		m.CoreumContractEventsReconnectCounter,
+		m.CoreumContractEventsGapFillStartHeightGauge,
+		m.CoreumContractEventsGapFillEndHeightGauge,
+		m.CoreumToXRPLTransferRateLimitConsumptionGaugeVec,
+		m.CoreumToXRPLTransferRateLimitMaxAmountGaugeVec,
+		m.CoreumToXRPLTransferRateLimitedOperationsCounterVec,
+		m.OldestPendingOperationAgeGauge,
+		m.PendingOperationMaxAgeGaugeVec,
+		m.BlockedDeliveriesGaugeVec,
+		m.XRPLToCoreumBelowMinAmountTransfersCounterVec,
+		m.XRPLHistoryGapGauge,
+		m.XRPLToCoreumLatencyHistogram,
+		m.CoreumToXRPLLatencyHistogram,
+		m.XRPLRPCEndpointLatencyGaugeVec,
+		m.CoreumGRPCEndpointSelectedGaugeVec,
+		m.CoreumGRPCFailoverCounter,
+		m.CoreumContractCacheRequestsCounterVec,
+		m.CoreumToXRPLAmountPrecisionMismatchesCounterVec,
+		m.UnclaimedRefundsGaugeVec,
+		m.RelayedRefundClaimsCounterVec,
+		m.CoreumContractTxGasUsedHistogramVec,
+		m.BridgeTicketsConsumedCounterVec,
+		m.KeyUsageAuditLogFailuresCounterVec,
 	}
 
 	for _, c := range collectors {
@@ -243,7 +557,146 @@ func (m *Registry) SetMaliciousBehaviourKey(key string) {
 	m.MaliciousBehaviourGaugeVec.WithLabelValues(key).Set(1)
 }
 
+// SetXRPLHistoryGap sets XRPLHistoryGapGauge value.
+func (m *Registry) SetXRPLHistoryGap(gap float64) {
+	m.XRPLHistoryGapGauge.Set(gap)
+}
+
 // IncrementXRPLRPCDecodingErrorCounter increments XRPLRPCDecodingErrorCounter.
 func (m *Registry) IncrementXRPLRPCDecodingErrorCounter() {
 	m.XRPLRPCDecodingErrorCounter.Inc()
 }
+
+// IncrementXRPLMemoLimitExceededTxsCounter increments XRPLMemoLimitExceededTxsCounter.
+func (m *Registry) IncrementXRPLMemoLimitExceededTxsCounter() {
+	m.XRPLMemoLimitExceededTxsCounter.Inc()
+}
+
+// IncrementBridgeDuplicateSignatureCounter increments BridgeDuplicateSignatureCounter.
+func (m *Registry) IncrementBridgeDuplicateSignatureCounter() {
+	m.BridgeDuplicateSignatureCounter.Inc()
+}
+
+// IncrementCoreumContractEventsReconnectCounter increments CoreumContractEventsReconnectCounter.
+func (m *Registry) IncrementCoreumContractEventsReconnectCounter() {
+	m.CoreumContractEventsReconnectCounter.Inc()
+}
+
+// SetCoreumContractEventsGapFillRange sets the start and end heights of the last contract events gap fill.
+func (m *Registry) SetCoreumContractEventsGapFillRange(startHeight, endHeight float64) {
+	m.CoreumContractEventsGapFillStartHeightGauge.Set(startHeight)
+	m.CoreumContractEventsGapFillEndHeightGauge.Set(endHeight)
+}
+
+// SetCoreumToXRPLTransferRateLimitConsumption sets the rate limit window consumption and max amount of the token.
+func (m *Registry) SetCoreumToXRPLTransferRateLimitConsumption(
+	currency, issuer string,
+	consumption, maxAmount float64,
+) {
+	label := buildCurrencyIssuerLabel(currency, issuer)
+	m.CoreumToXRPLTransferRateLimitConsumptionGaugeVec.WithLabelValues(label).Set(consumption)
+	m.CoreumToXRPLTransferRateLimitMaxAmountGaugeVec.WithLabelValues(label).Set(maxAmount)
+}
+
+// IncrementCoreumToXRPLTransferRateLimitedOperationsCounter increments the rate limited operations counter of the
+// token.
+func (m *Registry) IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(currency, issuer string) {
+	m.CoreumToXRPLTransferRateLimitedOperationsCounterVec.WithLabelValues(
+		buildCurrencyIssuerLabel(currency, issuer),
+	).Inc()
+}
+
+// SetOldestPendingOperationAge sets OldestPendingOperationAgeGauge value.
+func (m *Registry) SetOldestPendingOperationAge(seconds float64) {
+	m.OldestPendingOperationAgeGauge.Set(seconds)
+}
+
+// SetPendingOperationMaxAge sets the max age of the pending operations of the operation type.
+func (m *Registry) SetPendingOperationMaxAge(operationType string, seconds float64) {
+	m.PendingOperationMaxAgeGaugeVec.WithLabelValues(operationType).Set(seconds)
+}
+
+// ObserveXRPLToCoreumLatency observes the XRPL to Coreum transfer latency.
+func (m *Registry) ObserveXRPLToCoreumLatency(seconds float64) {
+	m.XRPLToCoreumLatencyHistogram.Observe(seconds)
+}
+
+// ObserveCoreumToXRPLLatency observes the Coreum to XRPL transfer latency.
+func (m *Registry) ObserveCoreumToXRPLLatency(seconds float64) {
+	m.CoreumToXRPLLatencyHistogram.Observe(seconds)
+}
+
+// SetBlockedDeliveriesCount sets the number of the blocked XRPL to Coreum deliveries of the denom.
+func (m *Registry) SetBlockedDeliveriesCount(denom string, count float64) {
+	m.BlockedDeliveriesGaugeVec.WithLabelValues(denom).Set(count)
+}
+
+// IncrementXRPLToCoreumBelowMinAmountTransfersCounter increments the skipped below min amount transfers counter of
+// the token.
+func (m *Registry) IncrementXRPLToCoreumBelowMinAmountTransfersCounter(currency, issuer string) {
+	m.XRPLToCoreumBelowMinAmountTransfersCounterVec.WithLabelValues(
+		buildCurrencyIssuerLabel(currency, issuer),
+	).Inc()
+}
+
+// IncrementCoreumToXRPLAmountPrecisionMismatchesCounter increments the counter of the operations with the amount not
+// representable in the XRPL precision.
+func (m *Registry) IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(currency, issuer string) {
+	m.CoreumToXRPLAmountPrecisionMismatchesCounterVec.WithLabelValues(
+		buildCurrencyIssuerLabel(currency, issuer),
+	).Inc()
+}
+
+// SetUnclaimedRefunds sets the number of the unclaimed refunds of the address.
+func (m *Registry) SetUnclaimedRefunds(address string, count int) {
+	m.UnclaimedRefundsGaugeVec.WithLabelValues(address).Set(float64(count))
+}
+
+// AddRelayedRefundClaims adds the number of the refunds claimed by the relayer on behalf of the address.
+func (m *Registry) AddRelayedRefundClaims(address string, count int) {
+	m.RelayedRefundClaimsCounterVec.WithLabelValues(address).Add(float64(count))
+}
+
+// SetXRPLRPCEndpointLatency sets the XRPL RPC endpoint P95 latency.
+func (m *Registry) SetXRPLRPCEndpointLatency(url string, seconds float64) {
+	m.XRPLRPCEndpointLatencyGaugeVec.WithLabelValues(url).Set(seconds)
+}
+
+// SetCoreumGRPCEndpointSelected sets whether the Coreum GRPC endpoint is selected.
+func (m *Registry) SetCoreumGRPCEndpointSelected(url string, selected bool) {
+	value := 0.0
+	if selected {
+		value = 1
+	}
+	m.CoreumGRPCEndpointSelectedGaugeVec.WithLabelValues(url).Set(value)
+}
+
+// IncrementCoreumGRPCFailoverCounter increments CoreumGRPCFailoverCounter.
+func (m *Registry) IncrementCoreumGRPCFailoverCounter() {
+	m.CoreumGRPCFailoverCounter.Inc()
+}
+
+// IncrementCoreumContractCacheRequestCounter increments the cached query requests counter with the hit or miss result.
+func (m *Registry) IncrementCoreumContractCacheRequestCounter(query string, hit bool) {
+	result := cacheResultMiss
+	if hit {
+		result = cacheResultHit
+	}
+	m.CoreumContractCacheRequestsCounterVec.WithLabelValues(query, result).Inc()
+}
+
+// ObserveCoreumContractTxGasUsed records the gas used by the Coreum tx of the operation type.
+func (m *Registry) ObserveCoreumContractTxGasUsed(operationType string, gasUsed float64) {
+	m.CoreumContractTxGasUsedHistogramVec.WithLabelValues(operationType).Observe(gasUsed)
+}
+
+// IncrementTicketsConsumedCounter increments the counter of the tickets used by the completed operations.
+func (m *Registry) IncrementTicketsConsumedCounter(operationType, result string) {
+	m.BridgeTicketsConsumedCounterVec.WithLabelValues(operationType, result).Inc()
+}
+
+// IncrementKeyUsageAuditLogFailuresCounter increments the counter of the key usages failed to be written to the
+// audit log.
+func (m *Registry) IncrementKeyUsageAuditLogFailuresCounter(action string) {
+	m.KeyUsageAuditLogFailuresCounterVec.WithLabelValues(action).Inc()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,26 +16,32 @@ import (
 // ServerConfig is metric server config.
 type ServerConfig struct {
 	ListenAddress string
+	// BlockProcessingStalenessThreshold is the time after which the chain without the processed blocks is reported
+	// as not ready by the /readyz endpoint, the zero threshold disables the check.
+	BlockProcessingStalenessThreshold time.Duration
 }
 
 // DefaultServerConfig return default ServerConfig.
 func DefaultServerConfig() ServerConfig {
 	return ServerConfig{
-		ListenAddress: "localhost:9090",
+		ListenAddress:                     "localhost:9090",
+		BlockProcessingStalenessThreshold: 5 * time.Minute,
 	}
 }
 
 // Server is metric server.
 type Server struct {
-	cfg      ServerConfig
-	registry *Registry
+	cfg            ServerConfig
+	registry       *Registry
+	readinessProbe *ReadinessProbe
 }
 
 // NewServer returns new instance of the Server.
-func NewServer(cfg ServerConfig, registry *Registry) *Server {
+func NewServer(cfg ServerConfig, registry *Registry, readinessProbe *ReadinessProbe) *Server {
 	return &Server{
-		cfg:      cfg,
-		registry: registry,
+		cfg:            cfg,
+		registry:       registry,
+		readinessProbe: readinessProbe,
 	}
 }
 
@@ -55,6 +62,7 @@ func (s *Server) Start(ctx context.Context) error {
 	mux.Handle("/metrics", promhttp.InstrumentMetricHandler(
 		registry, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 	))
+	mux.Handle("/readyz", s.readinessProbe)
 
 	server := &http.Server{Handler: mux}
 
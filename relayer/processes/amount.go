@@ -11,13 +11,17 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
+// xrplIssuedTokenMaxSignificantDigits is the max number of the significant digits of the XRPL issued token amount
+// mantissa.
+const xrplIssuedTokenMaxSignificantDigits = 16
+
 var (
 	// ErrSDKMathIntOutOfBounds is error which indicates that during the conversion we have reached the max possible value
 	// for the sdkmath.Int.
 	ErrSDKMathIntOutOfBounds = errors.New("sdkmath.Int, out of bounds")
-	// ErrContractUint128OutOfBounds is error which indicates that during the conversion we have reached the max possible
-	// value for the contract Uint128.
-	ErrContractUint128OutOfBounds = errors.New("contract Uint128, out of bounds")
+	// ErrXRPLAmountPrecisionMismatch is error which indicates that the Coreum amount can't be represented as the XRPL
+	// amount without the precision loss.
+	ErrXRPLAmountPrecisionMismatch = errors.New("XRPL amount precision mismatch")
 )
 
 // ConvertXRPLAmountToCoreumAmount converts the XRPL native token amount from XRPL to coreum amount
@@ -80,9 +84,9 @@ func convertXRPLAmountToCoreumAmountWithDecimals(xrplAmount rippledata.Amount, d
 	}
 
 	sdkMathAmount := sdkmath.NewIntFromBigInt(binIntAmount)
-	if sdkMathAmount.GT(coreum.MaxContractAmount) {
+	if err := coreum.ValidateContractAmount(sdkMathAmount); err != nil {
 		return sdkmath.Int{}, errors.Wrapf(
-			ErrContractUint128OutOfBounds, "failed to XRPL amount to Coreum, XRPL amount:%s", xrplAmount.String(),
+			err, "failed to convert XRPL amount to Coreum, XRPL amount:%s", xrplAmount.String(),
 		)
 	}
 
@@ -102,18 +106,19 @@ func convertCoreumAmountToXRPLAmountWithDecimals(
 		}
 		offset++
 	}
+	if len(coreumAmountString)-int(offset) > xrplIssuedTokenMaxSignificantDigits {
+		return rippledata.Amount{}, errors.Wrapf(
+			ErrXRPLAmountPrecisionMismatch,
+			"maximum significant digits should not exceed %d, input number: %s",
+			xrplIssuedTokenMaxSignificantDigits, coreumAmountString,
+		)
+	}
 	intValue := coreumAmount.Quo(sdkmath.NewIntWithDecimal(1, int(offset)))
 	if !intValue.IsInt64() {
 		return rippledata.Amount{}, errors.Errorf(
 			"failed to convert coreum XRPL currency amount to int64, out of bound, value:%s", intValue.String(),
 		)
 	}
-
-	if len(coreumAmountString)-int(offset) > 16 {
-		return rippledata.Amount{}, errors.Errorf(
-			"maximum significant digits should not exceed 16, input number: %s", coreumAmountString,
-		)
-	}
 	// include decimals to offset
 	offset -= int64(decimals)
 	xrplValue, err := rippledata.NewNonNativeValue(intValue.Int64(), offset)
@@ -142,11 +147,25 @@ func convertCoreumAmountToXRPLAmountWithDecimals(
 		)
 	}
 
-	return rippledata.Amount{
+	xrplAmount := rippledata.Amount{
 		Value:    xrplValue,
 		Currency: currency,
 		Issuer:   *issuer,
-	}, nil
+	}
+	// the amount signed for the XRPL must be exactly the amount recorded by the contract
+	roundTripAmount, err := convertXRPLAmountToCoreumAmountWithDecimals(xrplAmount, decimals)
+	if err != nil {
+		return rippledata.Amount{}, err
+	}
+	if !roundTripAmount.Equal(coreumAmount) {
+		return rippledata.Amount{}, errors.Wrapf(
+			ErrXRPLAmountPrecisionMismatch,
+			"coreum amount %s is converted to XRPL value %s, which is %s in coreum amount",
+			coreumAmountString, xrplValue.String(), roundTripAmount.String(),
+		)
+	}
+
+	return xrplAmount, nil
 }
 
 func isXRPToken(issuer, currency string) bool {
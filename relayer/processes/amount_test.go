@@ -10,6 +10,7 @@ import (
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
@@ -77,10 +78,20 @@ func TestConvertXRPLAmountToCoreumAmount(t *testing.T) {
 			xrplAmount: amountStringToXRPLAmount(t, fmt.Sprintf("34e22/%s/%s", fooCurrency, fooIssuer)),
 			want:       stringToSDKInt(t, "340000000000000000000000000000000000000"),
 		},
+		{
+			name:       "max_significant_digits_below_contract_max_XRPL_FOO_to_coreum_FOO",
+			xrplAmount: amountStringToXRPLAmount(t, fmt.Sprintf("3402823669209384e8/%s/%s", fooCurrency, fooIssuer)),
+			want:       stringToSDKInt(t, "340282366920938400000000000000000000000"),
+		},
+		{
+			name:       "max_significant_digits_above_contract_max_XRPL_FOO_to_coreum_FOO",
+			xrplAmount: amountStringToXRPLAmount(t, fmt.Sprintf("3402823669209385e8/%s/%s", fooCurrency, fooIssuer)),
+			wantErr:    coreum.ErrAmountExceedsContractMax,
+		},
 		{
 			name:       "invalid_foo_amount_contract_out_of_bound",
 			xrplAmount: amountStringToXRPLAmount(t, fmt.Sprintf("34e23/%s/%s", fooCurrency, fooIssuer)),
-			wantErr:    processes.ErrContractUint128OutOfBounds,
+			wantErr:    coreum.ErrAmountExceedsContractMax,
 		},
 		{
 			name:       "invalid_foo_amount_sdkmath_out_of_bound",
@@ -182,6 +193,27 @@ func TestConvertCoreumAmountToXRPLAmount(t *testing.T) {
 			currency:     fooCurrency,
 			want:         amountStringToXRPLAmount(t, fmt.Sprintf("1.000000000000001/%s/%s", fooCurrency, fooIssuer)),
 		},
+		{
+			name:         "fifteen_significant_digits_high_value_FOO_to_XRPL_FOO",
+			coreumAmount: stringToSDKInt(t, "123456789012345000000000000000000"),
+			issuer:       fooIssuer,
+			currency:     fooCurrency,
+			want:         amountStringToXRPLAmount(t, fmt.Sprintf("123456789012345000/%s/%s", fooCurrency, fooIssuer)),
+		},
+		{
+			name:         "sixteen_significant_digits_high_value_FOO_to_XRPL_FOO",
+			coreumAmount: stringToSDKInt(t, "1234567890123456000000000000000000"),
+			issuer:       fooIssuer,
+			currency:     fooCurrency,
+			want:         amountStringToXRPLAmount(t, fmt.Sprintf("1234567890123456000/%s/%s", fooCurrency, fooIssuer)),
+		},
+		{
+			name:         "seventeen_significant_digits_FOO_to_XRPL_FOO",
+			coreumAmount: stringToSDKInt(t, "12345678901234567"),
+			issuer:       fooIssuer,
+			currency:     fooCurrency,
+			wantErr:      true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -190,14 +222,75 @@ func TestConvertCoreumAmountToXRPLAmount(t *testing.T) {
 			got, err := processes.ConvertCoreumAmountToXRPLAmount(tt.coreumAmount, tt.issuer, tt.currency)
 			if tt.wantErr {
 				require.Error(t, err)
-			} else {
-				require.NoError(t, err)
+				return
 			}
+			require.NoError(t, err)
 			require.Equal(t, tt.want.String(), got.String())
 		})
 	}
 }
 
+func TestConvertCoreumAmountToXRPLAmount_PrecisionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	const currency = "FOO"
+
+	tests := []struct {
+		name         string
+		coreumAmount sdkmath.Int
+		wantErr      error
+	}{
+		{
+			name:         "fifteen_significant_digits",
+			coreumAmount: stringToSDKInt(t, "999999999999999"),
+		},
+		{
+			name:         "sixteen_significant_digits",
+			coreumAmount: stringToSDKInt(t, "9999999999999999"),
+		},
+		{
+			name:         "sixteen_significant_digits_with_max_exponent",
+			coreumAmount: stringToSDKInt(t, "9999999999999999000000000000000000000"),
+		},
+		{
+			// 1.23456789012345678901234 of the token with 20 decimals recorded by the contract in 15 XRPL decimals
+			name:         "twenty_decimals_token_truncated_by_contract",
+			coreumAmount: stringToSDKInt(t, "1234567890123456"),
+		},
+		{
+			// 1e17 of the token with 20 decimals and sending precision 15
+			name:         "twenty_decimals_token_high_value",
+			coreumAmount: stringToSDKInt(t, "100000000000000000000000000000000"),
+		},
+		{
+			name:         "seventeen_significant_digits",
+			coreumAmount: stringToSDKInt(t, "12345678901234567"),
+			wantErr:      processes.ErrXRPLAmountPrecisionMismatch,
+		},
+		{
+			name:         "seventeen_significant_digits_with_exponent",
+			coreumAmount: stringToSDKInt(t, "12345678901234567000000000"),
+			wantErr:      processes.ErrXRPLAmountPrecisionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			xrplAmount, err := processes.ConvertCoreumAmountToXRPLAmount(tt.coreumAmount, issuer, currency)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			coreumAmount, err := processes.ConvertXRPLAmountToCoreumAmount(xrplAmount)
+			require.NoError(t, err)
+			require.Equal(t, tt.coreumAmount.String(), coreumAmount.String())
+		})
+	}
+}
+
 func FuzzAmountConversionCoreumToXRPLAndBack(f *testing.F) {
 	issuerString := xrpl.GenPrivKeyTxSigner().Account().String()
 	f.Add(uint64(1000000000000000001), int8(3))
@@ -0,0 +1,358 @@
+//nolint:tagliatelle // yaml spec
+package processes
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// BlockedDeliveriesStoreFileName is the name of the blocked deliveries store file stored in the relayer home.
+const BlockedDeliveriesStoreFileName = "blocked-deliveries.yaml"
+
+// blockedDeliveriesCheckInterval is the max interval of the blocked deliveries check, the retry delay is usually
+// longer, but the retry requested by the operator is expected to be executed soon.
+const blockedDeliveriesCheckInterval = time.Minute
+
+// BlockedDelivery is the XRPL to Coreum transfer evidence which delivery is blocked by the asset FT rules of the
+// token, e.g. freezing or whitelisting.
+type BlockedDelivery struct {
+	TxHash    string `yaml:"tx_hash" json:"tx_hash"`
+	Issuer    string `yaml:"issuer" json:"issuer"`
+	Currency  string `yaml:"currency" json:"currency"`
+	Amount    string `yaml:"amount" json:"amount"`
+	Recipient string `yaml:"recipient" json:"recipient"`
+	Denom     string `yaml:"denom" json:"denom"`
+	// Reason is the error of the last delivery attempt.
+	Reason        string    `yaml:"reason" json:"reason"`
+	BlockedAt     time.Time `yaml:"blocked_at" json:"blocked_at"`
+	LastAttemptAt time.Time `yaml:"last_attempt_at" json:"last_attempt_at"`
+	Attempts      uint32    `yaml:"attempts" json:"attempts"`
+	// RetryRequested indicates that the operator requested the retry before the next scheduled attempt.
+	RetryRequested bool `yaml:"retry_requested" json:"retry_requested"`
+}
+
+// Evidence returns the evidence of the blocked delivery.
+func (d BlockedDelivery) Evidence() (coreum.XRPLToCoreumTransferEvidence, error) {
+	amount, ok := sdkmath.NewIntFromString(d.Amount)
+	if !ok {
+		return coreum.XRPLToCoreumTransferEvidence{}, errors.Errorf("invalid blocked delivery amount: %s", d.Amount)
+	}
+	recipient, err := sdk.AccAddressFromBech32(d.Recipient)
+	if err != nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, errors.Wrapf(
+			err, "invalid blocked delivery recipient: %s", d.Recipient,
+		)
+	}
+
+	return coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    d.TxHash,
+		Issuer:    d.Issuer,
+		Currency:  d.Currency,
+		Amount:    amount,
+		Recipient: recipient,
+	}, nil
+}
+
+type blockedDeliveriesStore struct {
+	Deliveries []BlockedDelivery `yaml:"deliveries"`
+}
+
+// ReadBlockedDeliveries reads the blocked deliveries from the store file, the empty list is returned if the file path
+// is empty or the file does not exist.
+func ReadBlockedDeliveries(filePath string) ([]BlockedDelivery, error) {
+	if filePath == "" {
+		return make([]BlockedDelivery, 0), nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return make([]BlockedDelivery, 0), nil
+		}
+		return nil, errors.Wrapf(err, "failed to read blocked deliveries store file, path:%s", filePath)
+	}
+	var store blockedDeliveriesStore
+	if err := yaml.Unmarshal(fileBytes, &store); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal blocked deliveries store file, path:%s", filePath)
+	}
+	if store.Deliveries == nil {
+		return make([]BlockedDelivery, 0), nil
+	}
+
+	return store.Deliveries, nil
+}
+
+// RequestBlockedDeliveryRetry marks the blocked delivery to be retried by the relayer with the next check.
+func RequestBlockedDeliveryRetry(filePath, txHash string) error {
+	deliveries, err := ReadBlockedDeliveries(filePath)
+	if err != nil {
+		return err
+	}
+	for i := range deliveries {
+		if deliveries[i].TxHash == txHash {
+			deliveries[i].RetryRequested = true
+			return saveBlockedDeliveries(filePath, deliveries)
+		}
+	}
+
+	return errors.Errorf("blocked delivery not found, txHash:%s", txHash)
+}
+
+func saveBlockedDeliveries(filePath string, deliveries []BlockedDelivery) error {
+	if filePath == "" {
+		return nil
+	}
+	storeBytes, err := yaml.Marshal(blockedDeliveriesStore{Deliveries: deliveries})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal blocked deliveries store")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, storeBytes), "failed to save blocked deliveries store, path:%s", filePath,
+	)
+}
+
+// BlockedDeliveryQueueConfig is the BlockedDeliveryQueue config.
+type BlockedDeliveryQueueConfig struct {
+	BridgeXRPLAddress    rippledata.Account
+	RelayerCoreumAddress sdk.AccAddress
+	// RetryDelay is the delay between the delivery attempts.
+	RetryDelay time.Duration
+	// StoreFilePath is the path of the blocked deliveries file, which is the source of truth, since the CLI updates
+	// it as well.
+	StoreFilePath string
+}
+
+// DefaultBlockedDeliveryQueueConfig returns the default BlockedDeliveryQueueConfig.
+func DefaultBlockedDeliveryQueueConfig(
+	bridgeXRPLAddress rippledata.Account,
+	relayerCoreumAddress sdk.AccAddress,
+	storeFilePath string,
+) BlockedDeliveryQueueConfig {
+	return BlockedDeliveryQueueConfig{
+		BridgeXRPLAddress:    bridgeXRPLAddress,
+		RelayerCoreumAddress: relayerCoreumAddress,
+		RetryDelay:           time.Hour,
+		StoreFilePath:        storeFilePath,
+	}
+}
+
+// BlockedDeliveryQueue keeps the XRPL to Coreum transfer evidences which delivery is blocked by the asset FT rules and
+// re-attempts them with the retry delay, instead of the resubmission of each of them with every scan.
+type BlockedDeliveryQueue struct {
+	cfg            BlockedDeliveryQueueConfig
+	log            logger.Logger
+	contractClient BlockedDeliveryContractClient
+	metricRegistry BlockedDeliveryMetricRegistry
+	clock          func() time.Time
+
+	mu             sync.Mutex
+	reportedDenoms map[string]struct{}
+}
+
+// NewBlockedDeliveryQueue returns a new instance of the BlockedDeliveryQueue.
+func NewBlockedDeliveryQueue(
+	cfg BlockedDeliveryQueueConfig,
+	log logger.Logger,
+	contractClient BlockedDeliveryContractClient,
+	metricRegistry BlockedDeliveryMetricRegistry,
+	clock func() time.Time,
+) (*BlockedDeliveryQueue, error) {
+	if cfg.RetryDelay <= 0 {
+		return nil, errors.Errorf("blocked delivery retry delay must be positive, got: %s", cfg.RetryDelay)
+	}
+	if cfg.RelayerCoreumAddress.Empty() {
+		return nil, errors.New("relayer address is nil or empty")
+	}
+
+	return &BlockedDeliveryQueue{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		reportedDenoms: make(map[string]struct{}),
+	}, nil
+}
+
+// Start starts the retries of the blocked deliveries.
+func (q *BlockedDeliveryQueue) Start(ctx context.Context) error {
+	checkInterval := q.cfg.RetryDelay
+	if checkInterval > blockedDeliveriesCheckInterval {
+		checkInterval = blockedDeliveriesCheckInterval
+	}
+	for {
+		if err := q.RetryDue(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			q.log.Error(ctx, "Failed to retry blocked deliveries", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(checkInterval):
+		}
+	}
+}
+
+// Park adds the evidence to the queue. If the evidence is already parked, its failure reason is updated.
+func (q *BlockedDeliveryQueue) Park(
+	ctx context.Context,
+	evidence coreum.XRPLToCoreumTransferEvidence,
+	reason string,
+) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	deliveries, err := ReadBlockedDeliveries(q.cfg.StoreFilePath)
+	if err != nil {
+		return err
+	}
+	now := q.clock()
+	for i := range deliveries {
+		if deliveries[i].TxHash == evidence.TxHash {
+			deliveries[i].Reason = reason
+			deliveries[i].LastAttemptAt = now
+			deliveries[i].Attempts++
+			return q.save(deliveries)
+		}
+	}
+
+	delivery := BlockedDelivery{
+		TxHash:        evidence.TxHash,
+		Issuer:        evidence.Issuer,
+		Currency:      evidence.Currency,
+		Amount:        evidence.Amount.String(),
+		Recipient:     evidence.Recipient.String(),
+		Denom:         q.getDenom(ctx, evidence.Issuer, evidence.Currency),
+		Reason:        reason,
+		BlockedAt:     now,
+		LastAttemptAt: now,
+		Attempts:      1,
+	}
+	q.log.Warn(ctx, "XRPL to Coreum delivery is blocked, the evidence is parked", zap.Any("delivery", delivery))
+
+	return q.save(append(deliveries, delivery))
+}
+
+// RetryDue re-attempts the deliveries which retry delay is passed or the retry is requested by the operator.
+func (q *BlockedDeliveryQueue) RetryDue(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	deliveries, err := ReadBlockedDeliveries(q.cfg.StoreFilePath)
+	if err != nil {
+		return err
+	}
+	now := q.clock()
+	remainingDeliveries := make([]BlockedDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		if !delivery.RetryRequested && now.Before(delivery.LastAttemptAt.Add(q.cfg.RetryDelay)) {
+			remainingDeliveries = append(remainingDeliveries, delivery)
+			continue
+		}
+		delivered, err := q.retry(ctx, &delivery, now)
+		if err != nil {
+			return err
+		}
+		if !delivered {
+			remainingDeliveries = append(remainingDeliveries, delivery)
+		}
+	}
+
+	return q.save(remainingDeliveries)
+}
+
+func (q *BlockedDeliveryQueue) retry(ctx context.Context, delivery *BlockedDelivery, now time.Time) (bool, error) {
+	evidence, err := delivery.Evidence()
+	if err != nil {
+		return false, err
+	}
+	delivery.RetryRequested = false
+	delivery.LastAttemptAt = now
+	delivery.Attempts++
+
+	_, err = q.contractClient.SendXRPLToCoreumTransferEvidence(ctx, q.cfg.RelayerCoreumAddress, evidence)
+	switch {
+	case err == nil:
+		q.log.Info(ctx, "Blocked XRPL to Coreum delivery is sent", zap.Any("delivery", delivery))
+		return true, nil
+	case IsExpectedEvidenceSubmissionError(err):
+		// the evidence is confirmed by other relayers or can't be delivered at all
+		q.log.Info(
+			ctx,
+			"Blocked XRPL to Coreum delivery is removed with the expected evidence submission error",
+			zap.Any("delivery", delivery),
+			zap.String("errText", err.Error()),
+		)
+		return true, nil
+	case coreum.IsAssetFTStateError(err):
+		delivery.Reason = err.Error()
+		q.log.Info(ctx, "XRPL to Coreum delivery is still blocked", zap.Any("delivery", delivery))
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (q *BlockedDeliveryQueue) save(deliveries []BlockedDelivery) error {
+	if err := saveBlockedDeliveries(q.cfg.StoreFilePath, deliveries); err != nil {
+		return err
+	}
+
+	countByDenom := make(map[string]int)
+	for _, delivery := range deliveries {
+		countByDenom[delivery.Denom]++
+	}
+	// the denoms without the blocked deliveries are reset to zero to not keep the outdated count
+	for denom := range q.reportedDenoms {
+		if _, ok := countByDenom[denom]; !ok {
+			q.metricRegistry.SetBlockedDeliveriesCount(denom, 0)
+		}
+	}
+	for denom, count := range countByDenom {
+		q.reportedDenoms[denom] = struct{}{}
+		q.metricRegistry.SetBlockedDeliveriesCount(denom, float64(count))
+	}
+
+	return nil
+}
+
+// getDenom returns the Coreum denom of the token, or the currency and issuer if the token can't be found.
+func (q *BlockedDeliveryQueue) getDenom(ctx context.Context, issuer, currency string) string {
+	if issuer == q.cfg.BridgeXRPLAddress.String() {
+		coreumTokens, err := q.contractClient.GetCoreumTokens(ctx)
+		if err == nil {
+			for _, token := range coreumTokens {
+				if token.XRPLCurrency == currency {
+					return token.Denom
+				}
+			}
+		}
+	} else {
+		xrplTokens, err := q.contractClient.GetXRPLTokens(ctx)
+		if err == nil {
+			for _, token := range xrplTokens {
+				if token.Issuer == issuer && token.Currency == currency {
+					return token.CoreumDenom
+				}
+			}
+		}
+	}
+
+	return fmt.Sprintf("%s/%s", currency, issuer)
+}
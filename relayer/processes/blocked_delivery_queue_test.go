@@ -0,0 +1,143 @@
+package processes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestBlockedDeliveryQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.BlockedDeliveriesStoreFileName)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	relayerAddress := coreum.GenAccount()
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	denom := "ucore"
+	evidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    "A4E1AC7A3A8AB9E6B4CA8C5EA9C2C6B09D2F0B2A0D1F0C1A84E4AA5E0F0B7C11",
+		Issuer:    issuer,
+		Currency:  "TKN",
+		Amount:    sdkmath.NewInt(100),
+		Recipient: coreum.GenAccount(),
+	}
+	freezingErr := errors.New("token is globally frozen")
+
+	contractClientMock := NewMockBlockedDeliveryContractClient(ctrl)
+	contractClientMock.EXPECT().GetXRPLTokens(gomock.Any()).Return([]coreum.XRPLToken{
+		{
+			Issuer:      issuer,
+			Currency:    evidence.Currency,
+			CoreumDenom: denom,
+		},
+	}, nil)
+
+	blockedDeliveriesCount := make(map[string]float64)
+	metricRegistryMock := NewMockBlockedDeliveryMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetBlockedDeliveriesCount(gomock.Any(), gomock.Any()).
+		Do(func(denom string, count float64) {
+			blockedDeliveriesCount[denom] = count
+		}).AnyTimes()
+
+	cfg := processes.DefaultBlockedDeliveryQueueConfig(bridgeXRPLAddress, relayerAddress, storeFilePath)
+	queue, err := processes.NewBlockedDeliveryQueue(
+		cfg,
+		logger.NewAnyLogMock(ctrl),
+		contractClientMock,
+		metricRegistryMock,
+		clock,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, queue.Park(ctx, evidence, freezingErr.Error()))
+	require.Equal(t, 1.0, blockedDeliveriesCount[denom])
+	blockedDeliveries, err := processes.ReadBlockedDeliveries(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, blockedDeliveries, 1)
+	require.Equal(t, denom, blockedDeliveries[0].Denom)
+	require.Equal(t, freezingErr.Error(), blockedDeliveries[0].Reason)
+	parkedEvidence, err := blockedDeliveries[0].Evidence()
+	require.NoError(t, err)
+	require.Equal(t, evidence, parkedEvidence)
+
+	// the retry is suppressed until the retry delay is passed
+	now = now.Add(cfg.RetryDelay - time.Second)
+	require.NoError(t, queue.RetryDue(ctx))
+
+	// the scheduled retry, the delivery is still blocked
+	now = now.Add(time.Second)
+	contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(gomock.Any(), relayerAddress, evidence).
+		Return(nil, freezingErr)
+	require.NoError(t, queue.RetryDue(ctx))
+	blockedDeliveries, err = processes.ReadBlockedDeliveries(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, blockedDeliveries, 1)
+	require.Equal(t, uint32(2), blockedDeliveries[0].Attempts)
+	require.Equal(t, now, blockedDeliveries[0].LastAttemptAt.UTC())
+
+	// the retry is suppressed again
+	now = now.Add(time.Minute)
+	require.NoError(t, queue.RetryDue(ctx))
+
+	// the manual retry is executed before the retry delay is passed
+	require.ErrorContains(t, processes.RequestBlockedDeliveryRetry(storeFilePath, "unknown"), "not found")
+	require.NoError(t, processes.RequestBlockedDeliveryRetry(storeFilePath, evidence.TxHash))
+	contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(gomock.Any(), relayerAddress, evidence).
+		Return(nil, nil)
+	require.NoError(t, queue.RetryDue(ctx))
+	blockedDeliveries, err = processes.ReadBlockedDeliveries(storeFilePath)
+	require.NoError(t, err)
+	require.Empty(t, blockedDeliveries)
+	require.Equal(t, 0.0, blockedDeliveriesCount[denom])
+}
+
+func TestBlockedDeliveryQueue_UnknownToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.BlockedDeliveriesStoreFileName)
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	evidence := coreum.XRPLToCoreumTransferEvidence{
+		TxHash:    "B4E1AC7A3A8AB9E6B4CA8C5EA9C2C6B09D2F0B2A0D1F0C1A84E4AA5E0F0B7C11",
+		Issuer:    bridgeXRPLAddress.String(),
+		Currency:  "TKN",
+		Amount:    sdkmath.NewInt(100),
+		Recipient: coreum.GenAccount(),
+	}
+
+	contractClientMock := NewMockBlockedDeliveryContractClient(ctrl)
+	contractClientMock.EXPECT().GetCoreumTokens(gomock.Any()).Return([]coreum.CoreumToken{}, nil)
+	metricRegistryMock := NewMockBlockedDeliveryMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetBlockedDeliveriesCount("TKN/"+bridgeXRPLAddress.String(), 1.0)
+
+	queue, err := processes.NewBlockedDeliveryQueue(
+		processes.DefaultBlockedDeliveryQueueConfig(bridgeXRPLAddress, coreum.GenAccount(), storeFilePath),
+		logger.NewAnyLogMock(ctrl),
+		contractClientMock,
+		metricRegistryMock,
+		time.Now,
+	)
+	require.NoError(t, err)
+	require.NoError(t, queue.Park(ctx, evidence, "whitelisted limit exceeded"))
+}
@@ -0,0 +1,216 @@
+package processes
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// CheckCasherConfig is the CheckCasher config.
+type CheckCasherConfig struct {
+	RelayerCoreumAddress sdk.AccAddress
+	// PollInterval is the interval between the bridge account checks scans.
+	PollInterval time.Duration
+}
+
+// DefaultCheckCasherConfig returns the default CheckCasherConfig.
+func DefaultCheckCasherConfig(relayerCoreumAddress sdk.AccAddress) CheckCasherConfig {
+	return CheckCasherConfig{
+		RelayerCoreumAddress: relayerCoreumAddress,
+		PollInterval:         30 * time.Second,
+	}
+}
+
+// CheckCasher periodically scans the XRPL Checks created for the bridge account and sends the evidences of them to
+// the contract. Once the evidence threshold is reached the contract creates the check cashing operation, the CheckCash
+// transaction of which is signed and submitted by the CoreumToXRPLProcess, and the contract mints the cashed amount for
+// the recipient once the accepted transaction result evidence is sent.
+type CheckCasher struct {
+	cfg            CheckCasherConfig
+	log            logger.Logger
+	checkScanner   XRPLCheckScanner
+	contractClient CheckCasherContractClient
+	auditLogger    EvidenceAuditLogger
+
+	mu sync.Mutex
+	// sentCheckIDs are the IDs of the checks the evidence is sent for
+	sentCheckIDs map[rippledata.Hash256]struct{}
+}
+
+// NewCheckCasher returns a new instance of the CheckCasher.
+func NewCheckCasher(
+	cfg CheckCasherConfig,
+	log logger.Logger,
+	checkScanner XRPLCheckScanner,
+	contractClient CheckCasherContractClient,
+	auditLogger EvidenceAuditLogger,
+) (*CheckCasher, error) {
+	if cfg.RelayerCoreumAddress.Empty() {
+		return nil, errors.New("relayer address is nil or empty")
+	}
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("check casher poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+
+	return &CheckCasher{
+		cfg:            cfg,
+		log:            log,
+		checkScanner:   checkScanner,
+		contractClient: contractClient,
+		auditLogger:    auditLogger,
+		sentCheckIDs:   make(map[rippledata.Hash256]struct{}),
+	}, nil
+}
+
+// Start starts the periodic cashing of the bridge account checks.
+func (c *CheckCasher) Start(ctx context.Context) error {
+	for {
+		if err := c.CashDue(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			c.log.Error(ctx, "Failed to cash XRPL checks", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(c.cfg.PollInterval):
+		}
+	}
+}
+
+// CashDue sends the evidences of the open bridge account checks which haven't been sent yet.
+func (c *CheckCasher) CashDue(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	checks, err := c.checkScanner.ScanChecks(ctx)
+	if err != nil {
+		return err
+	}
+	openCheckIDs := make(map[rippledata.Hash256]struct{}, len(checks))
+	for _, check := range checks {
+		openCheckIDs[check.ID] = struct{}{}
+		if _, ok := c.sentCheckIDs[check.ID]; ok {
+			continue
+		}
+		sent, err := c.sendCheckCashEvidence(ctx, check)
+		if err != nil {
+			return err
+		}
+		if sent {
+			c.sentCheckIDs[check.ID] = struct{}{}
+		}
+	}
+	// the checks which aren't open anymore are cashed or canceled
+	for checkID := range c.sentCheckIDs {
+		if _, ok := openCheckIDs[checkID]; !ok {
+			delete(c.sentCheckIDs, checkID)
+		}
+	}
+
+	return nil
+}
+
+func (c *CheckCasher) sendCheckCashEvidence(ctx context.Context, check xrpl.Check) (bool, error) {
+	coreumAmount, err := ConvertXRPLAmountToCoreumAmount(check.SendMax)
+	if err != nil {
+		c.log.Warn(
+			ctx,
+			"Skipping XRPL check with invalid amount",
+			zap.String("checkID", check.ID.String()),
+			zap.Any("sendMax", check.SendMax),
+			zap.Error(err),
+		)
+		return true, nil
+	}
+	if coreumAmount.IsZero() {
+		c.log.Debug(ctx, "Skipping XRPL check with zero amount", zap.String("checkID", check.ID.String()))
+		return true, nil
+	}
+
+	evidence := coreum.XRPLCheckCashEvidence{
+		TxHash:    strings.ToUpper(check.TxHash.String()),
+		CheckID:   strings.ToUpper(check.ID.String()),
+		Issuer:    check.SendMax.Issuer.String(),
+		Currency:  xrpl.ConvertCurrencyToString(check.SendMax.Currency),
+		Amount:    coreumAmount,
+		Sender:    check.Sender.String(),
+		Recipient: check.Recipient,
+	}
+	txRes, err := c.contractClient.SendXRPLCheckCashEvidence(ctx, c.cfg.RelayerCoreumAddress, evidence)
+	c.logEvidenceAudit(ctx, evidence, txRes, err)
+	switch {
+	case err == nil:
+		c.log.Info(
+			ctx,
+			"Successfully sent XRPL check cash evidence",
+			zap.String("checkID", evidence.CheckID),
+			zap.String("txHash", evidence.TxHash),
+			zap.String("recipient", evidence.Recipient.String()),
+		)
+		return true, nil
+	case IsExpectedEvidenceSubmissionError(err), coreum.IsCheckCashTokenNotSupportedError(err):
+		c.log.Debug(
+			ctx,
+			"Received expected check cash evidence error",
+			zap.String("checkID", evidence.CheckID),
+			zap.String("reason", err.Error()),
+		)
+		return true, nil
+	case errors.Is(err, context.Canceled):
+		return false, err
+	default:
+		// the evidence is resent on the next scan
+		c.log.Warn(
+			ctx,
+			"Failed to send XRPL check cash evidence",
+			zap.String("checkID", evidence.CheckID),
+			zap.Error(err),
+		)
+		return false, nil
+	}
+}
+
+func (c *CheckCasher) logEvidenceAudit(
+	ctx context.Context,
+	evidence coreum.XRPLCheckCashEvidence,
+	txRes *sdk.TxResponse,
+	err error,
+) {
+	record := EvidenceAuditRecord{
+		RelayerCoreumAddress: c.cfg.RelayerCoreumAddress.String(),
+		EvidenceType:         EvidenceAuditTypeXRPLCheckCash,
+		TxHash:               evidence.TxHash,
+		Issuer:               evidence.Issuer,
+		Currency:             evidence.Currency,
+		Amount:               evidence.Amount.String(),
+		Recipient:            evidence.Recipient.String(),
+	}
+	switch {
+	case err == nil:
+		record.Outcome = EvidenceAuditOutcomeSuccess
+	case coreum.IsEvidenceAlreadyProvidedError(err):
+		record.Outcome = EvidenceAuditOutcomeAlreadyProvided
+	default:
+		record.Outcome = EvidenceAuditOutcomeError
+		record.Error = err.Error()
+	}
+	if txRes != nil {
+		record.CoreumTxHash = txRes.TxHash
+	}
+	// the audit log failure doesn't affect the evidence processing
+	if logErr := c.auditLogger.LogEvidence(ctx, record); logErr != nil {
+		c.log.Warn(ctx, "Failed to write evidence audit record", zap.Error(logErr), zap.Any("record", record))
+	}
+}
@@ -0,0 +1,103 @@
+package processes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestCheckCasher_CashDue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	relayerAddress := coreum.GenAccount()
+	sender := xrpl.GenPrivKeyTxSigner().Account()
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	xrpSendMax, err := rippledata.NewAmount("1000000")
+	require.NoError(t, err)
+	tokenSendMax, err := rippledata.NewAmount("1.5/USD/" + issuer.String())
+	require.NoError(t, err)
+	xrpCheck := xrpl.Check{
+		ID:        genHash256(t, "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0"),
+		TxHash:    genHash256(t, "D2D6EB33C17D4D6B7B1AF2A4D0E2A7C4B5F3A4E1C2D8E9F0A1B2C3D4E5F6A7B8"),
+		Sender:    sender,
+		SendMax:   *xrpSendMax,
+		Recipient: coreum.GenAccount(),
+	}
+	tokenCheck := xrpl.Check{
+		ID:        genHash256(t, "A1B2C3D4E5F6A7B8D2D6EB33C17D4D6B7B1AF2A4D0E2A7C4B5F3A4E1C2D8E9F0"),
+		TxHash:    genHash256(t, "87C97F67E9977FB049647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF"),
+		Sender:    sender,
+		SendMax:   *tokenSendMax,
+		Recipient: coreum.GenAccount(),
+	}
+
+	checkScannerMock := NewMockXRPLCheckScanner(ctrl)
+	contractClientMock := NewMockCheckCasherContractClient(ctrl)
+	auditLoggerMock := NewMockEvidenceAuditLogger(ctrl)
+	auditLoggerMock.EXPECT().LogEvidence(gomock.Any(), gomock.Any()).AnyTimes()
+
+	checkCasher, err := processes.NewCheckCasher(
+		processes.DefaultCheckCasherConfig(relayerAddress),
+		logger.NewAnyLogMock(ctrl),
+		checkScannerMock,
+		contractClientMock,
+		auditLoggerMock,
+	)
+	require.NoError(t, err)
+
+	// the token check evidence fails and is resent on the next scan
+	checkScannerMock.EXPECT().ScanChecks(gomock.Any()).Return([]xrpl.Check{xrpCheck, tokenCheck}, nil)
+	contractClientMock.EXPECT().SendXRPLCheckCashEvidence(gomock.Any(), relayerAddress, coreum.XRPLCheckCashEvidence{
+		TxHash:    strings.ToUpper(xrpCheck.TxHash.String()),
+		CheckID:   strings.ToUpper(xrpCheck.ID.String()),
+		Issuer:    xrpl.XRPTokenIssuer.String(),
+		Currency:  xrpl.XRPTokenCurrency.String(),
+		Amount:    sdkmath.NewInt(1_000_000),
+		Sender:    sender.String(),
+		Recipient: xrpCheck.Recipient,
+	}).Return(&sdk.TxResponse{}, nil)
+	tokenCheckEvidence := coreum.XRPLCheckCashEvidence{
+		TxHash:    strings.ToUpper(tokenCheck.TxHash.String()),
+		CheckID:   strings.ToUpper(tokenCheck.ID.String()),
+		Issuer:    issuer.String(),
+		Currency:  "USD",
+		Amount:    sdkmath.NewIntWithDecimal(15, xrpl.XRPLIssuedTokenDecimals-1),
+		Sender:    sender.String(),
+		Recipient: tokenCheck.Recipient,
+	}
+	contractClientMock.EXPECT().SendXRPLCheckCashEvidence(gomock.Any(), relayerAddress, tokenCheckEvidence).
+		Return(nil, errors.New("node is unavailable"))
+	require.NoError(t, checkCasher.CashDue(ctx))
+
+	checkScannerMock.EXPECT().ScanChecks(gomock.Any()).Return([]xrpl.Check{xrpCheck, tokenCheck}, nil)
+	contractClientMock.EXPECT().SendXRPLCheckCashEvidence(gomock.Any(), relayerAddress, tokenCheckEvidence).
+		Return(&sdk.TxResponse{}, nil)
+	require.NoError(t, checkCasher.CashDue(ctx))
+
+	// the evidences aren't resent while the checks are open
+	checkScannerMock.EXPECT().ScanChecks(gomock.Any()).Return([]xrpl.Check{xrpCheck, tokenCheck}, nil)
+	require.NoError(t, checkCasher.CashDue(ctx))
+}
+
+func genHash256(t *testing.T, hash string) rippledata.Hash256 {
+	t.Helper()
+
+	hash256, err := rippledata.NewHash256(hash)
+	require.NoError(t, err)
+	return *hash256
+}
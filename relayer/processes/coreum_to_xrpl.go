@@ -12,11 +12,19 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
+// duplicateSignatureWarningInterval is the min interval between the warnings about the duplicate signatures.
+const duplicateSignatureWarningInterval = 10 * time.Minute
+
+// signatureVerificationFailedLogMessage is the message of the log written when the relayer signature fails the
+// verification before the submission.
+const signatureVerificationFailedLogMessage = "SIGNATURE_VERIFICATION_FAILED"
+
 // MultiSignableTransaction is XRPL multi-singable transaction type.
 type MultiSignableTransaction interface {
 	rippledata.MultiSignable
@@ -29,15 +37,21 @@ type BridgeSigners struct {
 	XRPLWeightsQuorum   uint32
 	XRPLPubKeys         map[rippledata.Account]rippledata.PublicKey
 	CoreumToXRPLAccount map[string]rippledata.Account
+	TxFeeConfig         MultiSigningTxFeeConfig
 }
 
 // CoreumToXRPLProcessConfig is the CoreumToXRPLProcess config.
 type CoreumToXRPLProcessConfig struct {
-	BridgeXRPLAddress    rippledata.Account
+	BridgeXRPLAddress rippledata.Account
+	// SourceTag must be taken from the contract config since it is a part of the signed transaction
+	SourceTag            *uint32
 	RelayerCoreumAddress sdk.AccAddress
 	XRPLTxSignerKeyName  string
 	RepeatRecentScan     bool
 	RepeatDelay          time.Duration
+	// VerifyBeforeSubmit enables the verification of the relayer signature with its XRPL public key registered in
+	// the contract before the signature is saved to the contract.
+	VerifyBeforeSubmit bool
 }
 
 // ProcessConfig is the CoreumToXRPLProcess config.
@@ -57,6 +71,7 @@ func DefaultProcessConfig(
 			RelayerCoreumAddress: relayerAddress,
 			RepeatRecentScan:     true,
 			RepeatDelay:          10 * time.Second,
+			VerifyBeforeSubmit:   true,
 		},
 		RetryDelay: 10 * time.Second,
 	}
@@ -64,15 +79,32 @@ func DefaultProcessConfig(
 
 // CoreumToXRPLProcess is process which observes pending XRPL operations, signs them and executes them.
 type CoreumToXRPLProcess struct {
-	cfg            CoreumToXRPLProcessConfig
-	log            logger.Logger
-	contractClient ContractClient
-	xrplRPCClient  XRPLRPCClient
-	xrplSigner     XRPLTxSigner
-	metricRegistry MetricRegistry
+	cfg                      CoreumToXRPLProcessConfig
+	log                      logger.Logger
+	contractClient           ContractClient
+	xrplRPCClient            XRPLRPCClient
+	xrplSigner               XRPLTxSigner
+	metricRegistry           MetricRegistry
+	contractEventsSubscriber ContractEventsSubscriber
+	transferRateLimiter      CoreumToXRPLTransferRateLimiter
+	operationAgeTracker      CoreumToXRPLOperationAgeTracker
+	tokenRegistry            CoreumToXRPLTokenRegistry
+	txResultTracker          CoreumToXRPLTxResultTracker
+	signatureTracker         CoreumToXRPLSignatureAggregationTracker
+	operationsCache          CoreumToXRPLOperationsCache
+
+	duplicateSignatureWarnedAt time.Time
 }
 
-// NewCoreumToXRPLProcess returns a new instance of the CoreumToXRPLProcess.
+// NewCoreumToXRPLProcess returns a new instance of the CoreumToXRPLProcess. The pending operations are polled with the
+// repeat delay, and if the contractEventsSubscriber is provided, they are additionally processed on each new contract
+// tx. If the transferRateLimiter is provided, the Coreum to XRPL transfers are signed only within its limits. If the
+// operationAgeTracker is provided, it receives the pending operations on each processing. If the tokenRegistry is
+// provided, the Coreum to XRPL transfers of the tokens unknown to it aren't signed. If the txResultTracker is provided,
+// it tracks the results of the submitted txs. If the signatureTracker is provided, the operations which signature
+// aggregation is timed out without reaching the quorum are abandoned with the invalid transaction result evidence. If
+// the operationsCache is provided, it's flushed once the contract tx bumping the pending operations version is
+// received, so the operations are re-signed right away instead of being skipped as signed by the stale cache.
 func NewCoreumToXRPLProcess(
 	cfg CoreumToXRPLProcessConfig,
 	log logger.Logger,
@@ -80,6 +112,13 @@ func NewCoreumToXRPLProcess(
 	xrplRPCClient XRPLRPCClient,
 	xrplSigner XRPLTxSigner,
 	metricRegistry MetricRegistry,
+	contractEventsSubscriber ContractEventsSubscriber,
+	transferRateLimiter CoreumToXRPLTransferRateLimiter,
+	operationAgeTracker CoreumToXRPLOperationAgeTracker,
+	tokenRegistry CoreumToXRPLTokenRegistry,
+	txResultTracker CoreumToXRPLTxResultTracker,
+	signatureTracker CoreumToXRPLSignatureAggregationTracker,
+	operationsCache CoreumToXRPLOperationsCache,
 ) (*CoreumToXRPLProcess, error) {
 	if cfg.RelayerCoreumAddress.Empty() {
 		return nil, errors.Errorf("failed to init process, relayer address is nil or empty")
@@ -92,18 +131,104 @@ func NewCoreumToXRPLProcess(
 	}
 
 	return &CoreumToXRPLProcess{
-		cfg:            cfg,
-		log:            log,
-		contractClient: contractClient,
-		xrplRPCClient:  xrplRPCClient,
-		xrplSigner:     xrplSigner,
-		metricRegistry: metricRegistry,
+		cfg:                      cfg,
+		log:                      log,
+		contractClient:           contractClient,
+		xrplRPCClient:            xrplRPCClient,
+		xrplSigner:               xrplSigner,
+		metricRegistry:           metricRegistry,
+		contractEventsSubscriber: contractEventsSubscriber,
+		transferRateLimiter:      transferRateLimiter,
+		operationAgeTracker:      operationAgeTracker,
+		tokenRegistry:            tokenRegistry,
+		txResultTracker:          txResultTracker,
+		signatureTracker:         signatureTracker,
+		operationsCache:          operationsCache,
 	}, nil
 }
 
 // Start starts the process.
 func (p *CoreumToXRPLProcess) Start(ctx context.Context) error {
 	p.log.Info(ctx, "Starting Coreum to XRPL process")
+	if p.contractEventsSubscriber == nil {
+		return p.processPendingOperationsWithRepeat(ctx, nil)
+	}
+
+	contractTxCh := make(chan coreum.ContractTx)
+	// the trigger channel coalesces the txs received during the processing, so the subscriber is never blocked
+	triggerCh := make(chan coreum.ContractTx, 1)
+	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		spawn("contract-events-subscriber", parallel.Fail, func(ctx context.Context) error {
+			return p.contractEventsSubscriber.Subscribe(ctx, contractTxCh)
+		})
+		spawn("contract-events-trigger", parallel.Fail, func(ctx context.Context) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return errors.WithStack(ctx.Err())
+				case contractTx := <-contractTxCh:
+					// the state changes are logged before the coalescing, so none of them is skipped
+					p.logTokenStateChanges(ctx, contractTx)
+					p.flushOperationsCacheOnVersionBump(ctx, contractTx)
+					select {
+					case triggerCh <- contractTx:
+					default:
+					}
+				}
+			}
+		})
+		spawn("pending-operations-processor", parallel.Exit, func(ctx context.Context) error {
+			return p.processPendingOperationsWithRepeat(ctx, triggerCh)
+		})
+
+		return nil
+	}, parallel.WithGroupLogger(p.log))
+}
+
+func (p *CoreumToXRPLProcess) logTokenStateChanges(ctx context.Context, contractTx coreum.ContractTx) {
+	for _, stateChange := range contractTx.TokenStateChanges {
+		p.log.Info(
+			ctx,
+			"Token state is changed",
+			zap.String("tokenDenom", stateChange.TokenDenom),
+			zap.String("oldState", string(stateChange.OldState)),
+			zap.String("newState", string(stateChange.NewState)),
+			zap.String("actor", stateChange.Actor.String()),
+			zap.Time("timestamp", stateChange.Timestamp),
+			zap.String("txHash", contractTx.Hash),
+			zap.Int64("height", contractTx.Height),
+		)
+	}
+}
+
+// flushOperationsCacheOnVersionBump drops the cached pending operations if the tx has bumped their version, the
+// following processing triggered by the tx fetches the operations without the dropped signatures and re-signs them.
+func (p *CoreumToXRPLProcess) flushOperationsCacheOnVersionBump(ctx context.Context, contractTx coreum.ContractTx) {
+	if contractTx.OperationsVersionBump == nil {
+		return
+	}
+	p.log.Info(
+		ctx,
+		"Pending operations version is bumped, re-signing the operations",
+		zap.Uint32("xrplBaseFee", contractTx.OperationsVersionBump.XRPLBaseFee),
+		zap.Uint32("operationsCount", contractTx.OperationsVersionBump.OperationsCount),
+		zap.String("txHash", contractTx.Hash),
+		zap.Int64("height", contractTx.Height),
+	)
+	if p.operationsCache == nil {
+		return
+	}
+	p.operationsCache.InvalidatePendingOperations()
+	// the contract config holds the updated XRPL base fee, relayers and evidence threshold
+	p.operationsCache.InvalidateContractConfig()
+}
+
+// processPendingOperationsWithRepeat processes the pending operations with the repeat delay, the received contract tx
+// triggers the processing without waiting for the delay.
+func (p *CoreumToXRPLProcess) processPendingOperationsWithRepeat(
+	ctx context.Context,
+	contractTxCh <-chan coreum.ContractTx,
+) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -121,16 +246,34 @@ func (p *CoreumToXRPLProcess) Start(ctx context.Context) error {
 			case <-ctx.Done():
 				return errors.WithStack(ctx.Err())
 			case <-time.After(p.cfg.RepeatDelay):
+			case contractTx := <-contractTxCh:
+				p.log.Debug(
+					ctx,
+					"Received contract tx, processing pending operations",
+					zap.String("txHash", contractTx.Hash),
+					zap.Int64("height", contractTx.Height),
+				)
 			}
 		}
 	}
 }
 
 func (p *CoreumToXRPLProcess) processPendingOperations(ctx context.Context) error {
+	if p.transferRateLimiter != nil {
+		// the window consumption is reported even if there are no new transfers
+		p.transferRateLimiter.ReportConsumption()
+	}
 	operations, err := p.contractClient.GetPendingOperations(ctx)
 	if err != nil {
 		return err
 	}
+	if p.operationAgeTracker != nil {
+		// the tracking failure doesn't block the operations processing
+		if err := p.operationAgeTracker.Track(ctx, operations); err != nil {
+			p.log.Warn(ctx, "Failed to track pending operations age", zap.Error(err))
+		}
+	}
+	timedOutOperationIDs := p.trackSignatureAggregation(ctx, operations)
 	if len(operations) == 0 {
 		p.log.Debug(ctx, "No pending operations to process")
 		return nil
@@ -143,12 +286,35 @@ func (p *CoreumToXRPLProcess) processPendingOperations(ctx context.Context) erro
 	}
 
 	for _, operation := range operations {
-		if err := p.signOrSubmitOperation(ctx, operation, bridgeSigners); err != nil {
+		process := p.signOrSubmitOperation
+		if _, ok := timedOutOperationIDs[operation.GetOperationID()]; ok {
+			process = p.abandonOrSubmitOperation
+		}
+		if err := process(ctx, operation, bridgeSigners); err != nil {
+			// the operation stays pending in the contract, so it is re-queued by the next processing
+			if coreum.IsBroadcastTimeoutError(err) {
+				p.log.Warn(
+					ctx,
+					"Coreum tx broadcast is timed out, the operation is re-queued",
+					append(operationLogFields(operation), zap.String("error", err.Error()))...,
+				)
+				continue
+			}
+			if errors.Is(err, ErrXRPLAmountPrecisionMismatch) {
+				currency, issuer := getOperationCurrencyIssuer(operation)
+				p.metricRegistry.IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(currency, issuer)
+				p.log.Error(
+					ctx,
+					"Operation amount can't be represented in the XRPL precision, the operation isn't signed, "+
+						"the contract and relayer precision must be investigated",
+					append(operationLogFields(operation), zap.Error(err), zap.Any("operation", operation))...,
+				)
+				continue
+			}
 			p.log.Error(
 				ctx,
 				"Failed to process pending operation, skipping processing",
-				zap.Error(err),
-				zap.Any("operation", operation),
+				append(operationLogFields(operation), zap.Error(err), zap.Any("operation", operation))...,
 			)
 			continue
 		}
@@ -157,6 +323,27 @@ func (p *CoreumToXRPLProcess) processPendingOperations(ctx context.Context) erro
 	return nil
 }
 
+// trackSignatureAggregation returns the IDs of the operations which signature aggregation is timed out.
+func (p *CoreumToXRPLProcess) trackSignatureAggregation(
+	ctx context.Context,
+	operations []coreum.Operation,
+) map[uint32]struct{} {
+	timedOutOperationIDs := make(map[uint32]struct{})
+	if p.signatureTracker == nil {
+		return timedOutOperationIDs
+	}
+	timedOutOperations, err := p.signatureTracker.Track(ctx, operations)
+	if err != nil {
+		// the tracking failure doesn't block the operations processing
+		p.log.Warn(ctx, "Failed to track pending operations signature aggregation", zap.Error(err))
+	}
+	for _, operation := range timedOutOperations {
+		timedOutOperationIDs[operation.GetOperationID()] = struct{}{}
+	}
+
+	return timedOutOperationIDs
+}
+
 func (p *CoreumToXRPLProcess) getBridgeSigners(ctx context.Context) (BridgeSigners, error) {
 	xrplWeights, xrplWeightsQuorum, err := p.getBridgeXRPLSignerAccountsWithWeights(ctx)
 	if err != nil {
@@ -197,6 +384,7 @@ func (p *CoreumToXRPLProcess) getBridgeSigners(ctx context.Context) (BridgeSigne
 		XRPLWeightsQuorum:   xrplWeightsQuorum,
 		XRPLPubKeys:         xrplPubKeys,
 		CoreumToXRPLAccount: coreumToXRPLAccount,
+		TxFeeConfig:         NewMultiSigningTxFeeConfig(contractConfig),
 	}, nil
 }
 
@@ -231,13 +419,13 @@ func (p *CoreumToXRPLProcess) signOrSubmitOperation(
 		return err
 	}
 	if !valid {
-		p.log.Warn(ctx, "Operation is invalid", zap.Any("operation", operation))
+		p.log.Warn(ctx, "Operation is invalid", append(operationLogFields(operation), zap.Any("operation", operation))...)
 		return nil
 	}
 	p.log.Debug(
 		ctx,
 		"Pre-validation of the operation passed, operation is valid",
-		zap.Any("operation", operation),
+		operationLogFields(operation)...,
 	)
 
 	tx, quorumIsReached, err := p.buildSubmittableTransaction(ctx, operation, bridgeSigners)
@@ -245,19 +433,46 @@ func (p *CoreumToXRPLProcess) signOrSubmitOperation(
 		return err
 	}
 	if !quorumIsReached {
-		return p.registerTxSignature(ctx, operation)
+		if p.isSignedByRelayer(operation) {
+			p.log.Debug(
+				ctx,
+				"Operation is already signed by the relayer",
+				operationLogFields(operation)...,
+			)
+			return nil
+		}
+		registered, err := p.isTransferTokenRegistered(ctx, operation)
+		if err != nil {
+			return err
+		}
+		if !registered {
+			return nil
+		}
+		if !p.isAllowedByTransferRateLimiter(ctx, operation) {
+			return nil
+		}
+		return p.registerTxSignature(ctx, operation, bridgeSigners)
 	}
 
 	txRes, err := p.xrplRPCClient.Submit(ctx, tx)
 	if err != nil {
 		return errors.Wrapf(err, "failed to submit transaction:%+v", tx)
 	}
+	if p.txResultTracker != nil {
+		// the tracking failure doesn't affect the submission, the result is observed by the XRPL to Coreum process
+		if err := p.txResultTracker.Track(ctx, operation, tx, txRes); err != nil {
+			p.log.Warn(ctx, "Failed to track submitted XRPL transaction", zap.Error(err))
+		}
+	}
 	if txRes.EngineResult.Success() {
 		p.log.Info(
 			ctx,
 			"XRPL multi-sign transaction has been successfully submitted",
-			zap.String("txHash", strings.ToUpper(tx.GetHash().String())),
-			zap.Any("tx", tx),
+			append(
+				operationLogFields(operation),
+				logger.TxHashField(strings.ToUpper(tx.GetHash().String())),
+				zap.Any("tx", tx),
+			)...,
 		)
 		return nil
 	}
@@ -265,10 +480,13 @@ func (p *CoreumToXRPLProcess) signOrSubmitOperation(
 	if strings.HasPrefix(txRes.EngineResult.String(), xrpl.TecTxResultPrefix) {
 		p.log.Debug(
 			ctx,
-			fmt.Sprintf(
-				"The transaction has been sent, but will be reverted, code:%s, description:%s",
-				txRes.EngineResult.String(), txRes.EngineResult.Human(),
-			),
+			"The transaction has been sent, but will be reverted",
+			append(
+				operationLogFields(operation),
+				logger.TxHashField(strings.ToUpper(tx.GetHash().String())),
+				zap.String("code", txRes.EngineResult.String()),
+				zap.String("description", txRes.EngineResult.Human()),
+			)...,
 		)
 		return nil
 	}
@@ -278,12 +496,14 @@ func (p *CoreumToXRPLProcess) signOrSubmitOperation(
 		p.log.Debug(
 			ctx,
 			"Transaction has been already submitted",
+			append(operationLogFields(operation), logger.TxHashField(strings.ToUpper(tx.GetHash().String())))...,
 		)
 		return nil
 	case rippledata.TelINSUF_FEE_P:
 		p.log.Warn(
 			ctx,
 			"The Fee from the transaction is not high enough to meet the server's current transaction cost requirement.",
+			append(operationLogFields(operation), logger.TxHashField(strings.ToUpper(tx.GetHash().String())))...,
 		)
 		return nil
 	default:
@@ -292,6 +512,114 @@ func (p *CoreumToXRPLProcess) signOrSubmitOperation(
 	}
 }
 
+// abandonOrSubmitOperation sends the invalid transaction result evidence for the operation which signature aggregation
+// is timed out, so the contract removes the operation and returns its ticket. The operation with the reached quorum
+// might be already submitted to the XRPL, so it's processed as usual.
+func (p *CoreumToXRPLProcess) abandonOrSubmitOperation(
+	ctx context.Context,
+	operation coreum.Operation,
+	bridgeSigners BridgeSigners,
+) error {
+	_, quorumIsReached, err := p.buildSubmittableTransaction(ctx, operation, bridgeSigners)
+	if err != nil {
+		return err
+	}
+	if quorumIsReached {
+		return p.signOrSubmitOperation(ctx, operation, bridgeSigners)
+	}
+
+	p.log.Info(
+		ctx,
+		"Sending invalid tx evidence for the operation with timed out signature aggregation",
+		append(operationLogFields(operation), zap.Any("operation", operation))...,
+	)
+	err = p.sendInvalidTransactionResultEvidence(ctx, operation)
+	if err == nil {
+		return nil
+	}
+	// the operation might be completed by the other relayers in the meantime
+	if IsExpectedEvidenceSubmissionError(err) || coreum.IsPendingOperationNotFoundError(err) {
+		p.log.Debug(
+			ctx,
+			"Received expected evidence submission error",
+			append(operationLogFields(operation), zap.String("errText", err.Error()))...,
+		)
+		return nil
+	}
+
+	return errors.Wrapf(err, "failed to send invalid tx evidence, operationID:%d", operation.GetOperationID())
+}
+
+func (p *CoreumToXRPLProcess) sendInvalidTransactionResultEvidence(
+	ctx context.Context,
+	operation coreum.Operation,
+) error {
+	evidence := coreum.XRPLTransactionResultEvidence{
+		TransactionResult: coreum.TransactionResultInvalid,
+	}
+	if operation.TicketSequence != 0 {
+		evidence.TicketSequence = lo.ToPtr(operation.TicketSequence)
+	} else {
+		evidence.AccountSequence = lo.ToPtr(operation.AccountSequence)
+	}
+
+	var err error
+	switch {
+	case isAllocateTicketsOperation(operation):
+		_, err = p.contractClient.SendXRPLTicketsAllocationTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultTicketsAllocationEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	case isTrustSetOperation(operation):
+		_, err = p.contractClient.SendXRPLTrustSetTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultTrustSetEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	case isCoreumToXRPLTransferOperation(operation):
+		_, err = p.contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	case isRotateKeysOperation(operation):
+		_, err = p.contractClient.SendKeysRotationTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultKeysRotationEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	case isNFTokenTransferOperation(operation):
+		_, err = p.contractClient.SendNFTokenTransferTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultNFTokenTransferEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	case isCheckCashOperation(operation):
+		_, err = p.contractClient.SendCheckCashTransactionResultEvidence(
+			ctx,
+			p.cfg.RelayerCoreumAddress,
+			coreum.XRPLTransactionResultCheckCashEvidence{
+				XRPLTransactionResultEvidence: evidence,
+			},
+		)
+	default:
+		return errors.Errorf("unknown operation type, operation:%+v", operation)
+	}
+
+	return err
+}
+
 func (p *CoreumToXRPLProcess) buildSubmittableTransaction(
 	ctx context.Context,
 	operation coreum.Operation,
@@ -338,7 +666,7 @@ func (p *CoreumToXRPLProcess) buildSubmittableTransaction(
 				SigningPubKey: &xrplPubKey,
 			},
 		}
-		tx, err := p.buildXRPLTxFromOperation(operation)
+		tx, err := p.buildXRPLTxFromOperation(operation, bridgeSigners.TxFeeConfig)
 		if err != nil {
 			return nil, false, err
 		}
@@ -381,7 +709,7 @@ func (p *CoreumToXRPLProcess) buildSubmittableTransaction(
 		return nil, false, nil
 	}
 	// build tx one more time to be sure that it is not affected
-	tx, err := p.buildXRPLTxFromOperation(operation)
+	tx, err := p.buildXRPLTxFromOperation(operation, bridgeSigners.TxFeeConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -398,10 +726,8 @@ func (p *CoreumToXRPLProcess) buildSubmittableTransaction(
 func (p *CoreumToXRPLProcess) preValidateOperation(ctx context.Context, operation coreum.Operation) (bool, error) {
 	// no need to check if the current relayer has already provided the signature
 	// this check prevents the state when relayer votes and then changes its vote because of different current state
-	for _, signature := range operation.Signatures {
-		if signature.RelayerCoreumAddress.String() == p.cfg.RelayerCoreumAddress.String() {
-			return true, nil
-		}
+	if p.isSignedByRelayer(operation) {
+		return true, nil
 	}
 
 	// currently we validate only the allocate tickets operation with not zero sequence
@@ -446,6 +772,45 @@ func (p *CoreumToXRPLProcess) preValidateOperation(ctx context.Context, operatio
 	return false, nil
 }
 
+// isTransferTokenRegistered returns false if the Coreum to XRPL transfer operation isn't signed by the relayer yet,
+// and its token is unknown to the token registry.
+func (p *CoreumToXRPLProcess) isTransferTokenRegistered(ctx context.Context, operation coreum.Operation) (bool, error) {
+	if p.tokenRegistry == nil || !isCoreumToXRPLTransferOperation(operation) || p.isSignedByRelayer(operation) {
+		return true, nil
+	}
+	transfer := operation.OperationType.CoreumToXRPLTransfer
+	registered, err := p.tokenRegistry.IsXRPLTokenRegistered(ctx, transfer.Issuer, transfer.Currency)
+	if err != nil {
+		return false, err
+	}
+	if !registered {
+		p.log.Warn(
+			ctx,
+			"Skipping signing of the Coreum to XRPL transfer of the unknown token",
+			operationLogFields(operation)...,
+		)
+	}
+
+	return registered, nil
+}
+
+// isAllowedByTransferRateLimiter returns false if the Coreum to XRPL transfer operation isn't signed by the relayer
+// yet, and signing it exceeds the transfer rate limit.
+func (p *CoreumToXRPLProcess) isAllowedByTransferRateLimiter(ctx context.Context, operation coreum.Operation) bool {
+	if p.transferRateLimiter == nil || !isCoreumToXRPLTransferOperation(operation) {
+		return true
+	}
+	// the signed operation is already counted by the limiter
+	if p.isSignedByRelayer(operation) {
+		return true
+	}
+	transfer := operation.OperationType.CoreumToXRPLTransfer
+
+	return p.transferRateLimiter.Allow(
+		ctx, operation.GetOperationID(), transfer.Issuer, transfer.Currency, transfer.Amount,
+	)
+}
+
 func (p *CoreumToXRPLProcess) registerInvalidSignatureMetric(operationID uint32, signature coreum.Signature) {
 	p.metricRegistry.SetMaliciousBehaviourKey(
 		fmt.Sprintf(
@@ -455,8 +820,12 @@ func (p *CoreumToXRPLProcess) registerInvalidSignatureMetric(operationID uint32,
 	)
 }
 
-func (p *CoreumToXRPLProcess) registerTxSignature(ctx context.Context, operation coreum.Operation) error {
-	tx, err := p.buildXRPLTxFromOperation(operation)
+func (p *CoreumToXRPLProcess) registerTxSignature(
+	ctx context.Context,
+	operation coreum.Operation,
+	bridgeSigners BridgeSigners,
+) error {
+	tx, err := p.buildXRPLTxFromOperation(operation, bridgeSigners.TxFeeConfig)
 	if err != nil {
 		return err
 	}
@@ -464,6 +833,26 @@ func (p *CoreumToXRPLProcess) registerTxSignature(ctx context.Context, operation
 	if err != nil {
 		return errors.Wrapf(err, "failed to sign transaction, keyName:%s", p.cfg.XRPLTxSignerKeyName)
 	}
+	if p.cfg.VerifyBeforeSubmit {
+		// the malformed signature which can't be verified is reported the same way as the invalid one
+		isValid, err := p.verifyTxSignature(operation, bridgeSigners, signer)
+		if err != nil || !isValid {
+			// the bad signature is never saved, since it can't be used for the tx and blocks the relayer vote
+			p.log.Error(
+				ctx,
+				signatureVerificationFailedLogMessage,
+				append(
+					operationLogFields(operation),
+					zap.Error(err),
+					zap.String("signature", signer.Signer.TxnSignature.String()),
+					zap.String("signerXRPLAddress", signer.Signer.Account.String()),
+					zap.String("relayerCoreumAddress", p.cfg.RelayerCoreumAddress.String()),
+					zap.Any("operation", operation),
+				)...,
+			)
+			return nil
+		}
+	}
 	_, err = p.contractClient.SaveSignature(
 		ctx,
 		p.cfg.RelayerCoreumAddress,
@@ -475,19 +864,28 @@ func (p *CoreumToXRPLProcess) registerTxSignature(ctx context.Context, operation
 		p.log.Info(
 			ctx,
 			"Signature registered for the operation",
-			zap.String("signature", signer.Signer.TxnSignature.String()),
-			zap.Any("operation", operation),
+			append(
+				operationLogFields(operation),
+				zap.String("signature", signer.Signer.TxnSignature.String()),
+				zap.Any("operation", operation),
+			)...,
 		)
 		return nil
 	}
-	if coreum.IsSignatureAlreadyProvidedError(err) ||
-		coreum.IsPendingOperationNotFoundError(err) ||
+	// the operation isn't signed by the relayer in the processed state, so the signature is provided by another
+	// instance of the relayer using the same key in the meantime
+	if coreum.IsSignatureAlreadyProvidedError(err) {
+		p.metricRegistry.IncrementBridgeDuplicateSignatureCounter()
+		p.warnDuplicateSignature(ctx, operation)
+		return nil
+	}
+	if coreum.IsPendingOperationNotFoundError(err) ||
 		coreum.IsOperationVersionMismatchError(err) ||
 		coreum.IsBridgeHaltedError(err) {
 		p.log.Debug(
 			ctx,
 			"Received expected evidence error on saving signature",
-			zap.String("errText", err.Error()),
+			append(operationLogFields(operation), zap.String("errText", err.Error()))...,
 		)
 
 		return nil
@@ -496,18 +894,99 @@ func (p *CoreumToXRPLProcess) registerTxSignature(ctx context.Context, operation
 	return errors.Wrap(err, "failed to register transaction signature")
 }
 
-func (p *CoreumToXRPLProcess) buildXRPLTxFromOperation(operation coreum.Operation) (MultiSignableTransaction, error) {
+// verifyTxSignature returns true if the signature is valid for the XRPL account and public key of the relayer
+// registered in the contract, so the signature produced with the misconfigured key is reported as invalid.
+func (p *CoreumToXRPLProcess) verifyTxSignature(
+	operation coreum.Operation,
+	bridgeSigners BridgeSigners,
+	signer rippledata.Signer,
+) (bool, error) {
+	xrplAcc, ok := bridgeSigners.CoreumToXRPLAccount[p.cfg.RelayerCoreumAddress.String()]
+	if !ok {
+		return false, nil
+	}
+	xrplPubKey, ok := bridgeSigners.XRPLPubKeys[xrplAcc]
+	if !ok {
+		return false, nil
+	}
+	// the tx is built again since the signing modifies it
+	tx, err := p.buildXRPLTxFromOperation(operation, bridgeSigners.TxFeeConfig)
+	if err != nil {
+		return false, err
+	}
+
+	return xrpl.VerifySignature(tx, rippledata.Signer{
+		Signer: rippledata.SignerItem{
+			Account:       xrplAcc,
+			TxnSignature:  signer.Signer.TxnSignature,
+			SigningPubKey: &xrplPubKey,
+		},
+	})
+}
+
+// warnDuplicateSignature warns about the duplicate signature not more often than once per the warning interval.
+func (p *CoreumToXRPLProcess) warnDuplicateSignature(ctx context.Context, operation coreum.Operation) {
+	now := time.Now()
+	if now.Sub(p.duplicateSignatureWarnedAt) < duplicateSignatureWarningInterval {
+		return
+	}
+	p.duplicateSignatureWarnedAt = now
+	p.log.Warn(
+		ctx,
+		"Operation signature is already provided by the relayer, another relayer instance might be running with "+
+			"the same key",
+		append(
+			operationLogFields(operation),
+			zap.String("relayerCoreumAddress", p.cfg.RelayerCoreumAddress.String()),
+		)...,
+	)
+}
+
+// operationLogFields returns the structured log fields of the operation, the transfer operations are logged with the
+// token and amount.
+func operationLogFields(operation coreum.Operation) []zap.Field {
+	fields := []zap.Field{
+		logger.DirectionField(logger.DirectionCoreumToXRPL),
+		logger.OperationIDField(operation.GetOperationID()),
+	}
+	if isCoreumToXRPLTransferOperation(operation) {
+		transfer := operation.OperationType.CoreumToXRPLTransfer
+		fields = append(
+			fields,
+			logger.XRPLTokenField(transfer.Issuer, transfer.Currency),
+			logger.AmountField(transfer.Amount.String()),
+		)
+	}
+
+	return fields
+}
+
+func (p *CoreumToXRPLProcess) isSignedByRelayer(operation coreum.Operation) bool {
+	for _, signature := range operation.Signatures {
+		if signature.RelayerCoreumAddress.String() == p.cfg.RelayerCoreumAddress.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *CoreumToXRPLProcess) buildXRPLTxFromOperation(
+	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
+) (MultiSignableTransaction, error) {
+	return BuildXRPLTxFromOperation(p.cfg.BridgeXRPLAddress, p.cfg.SourceTag, operation, feeCfg)
+}
+
+// getOperationCurrencyIssuer returns the currency and issuer of the token the operation amount is in.
+func getOperationCurrencyIssuer(operation coreum.Operation) (string, string) {
 	switch {
-	case isAllocateTicketsOperation(operation):
-		return BuildTicketCreateTxForMultiSigning(p.cfg.BridgeXRPLAddress, operation)
-	case isTrustSetOperation(operation):
-		return BuildTrustSetTxForMultiSigning(p.cfg.BridgeXRPLAddress, operation)
-	case isCoreumToXRPLTransferOperation(operation):
-		return BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(p.cfg.BridgeXRPLAddress, operation)
-	case isRotateKeysOperation(operation):
-		return BuildSignerListSetTxForMultiSigning(p.cfg.BridgeXRPLAddress, operation)
+	case operation.OperationType.CoreumToXRPLTransfer != nil:
+		return operation.OperationType.CoreumToXRPLTransfer.Currency, operation.OperationType.CoreumToXRPLTransfer.Issuer
+	case operation.OperationType.TrustSet != nil:
+		return operation.OperationType.TrustSet.Currency, operation.OperationType.TrustSet.Issuer
 	default:
-		return nil, errors.Errorf("failed to process operation, unable to determine operation type, operation:%+v", operation)
+		return "", ""
 	}
 }
 
@@ -535,3 +1014,16 @@ func isRotateKeysOperation(operation coreum.Operation) bool {
 		len(operation.OperationType.RotateKeys.NewRelayers) != 0 &&
 		operation.OperationType.RotateKeys.NewEvidenceThreshold > 0
 }
+
+func isNFTokenTransferOperation(operation coreum.Operation) bool {
+	return operation.OperationType.NFTokenTransfer != nil &&
+		operation.OperationType.NFTokenTransfer.NFTokenID != "" &&
+		operation.OperationType.NFTokenTransfer.SellOfferID != ""
+}
+
+func isCheckCashOperation(operation coreum.Operation) bool {
+	return operation.OperationType.CheckCash != nil &&
+		operation.OperationType.CheckCash.CheckID != "" &&
+		operation.OperationType.CheckCash.Currency != "" &&
+		!operation.OperationType.CheckCash.Amount.IsZero()
+}
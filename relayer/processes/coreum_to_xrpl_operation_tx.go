@@ -9,10 +9,62 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
+// MultiSigningTxFeeConfig is the config of the multi-signing transactions fee. The fee is a part of the signed
+// transaction, so the config must be the same for all relayers.
+type MultiSigningTxFeeConfig struct {
+	// SignersCount is the number of signatures provided in the submitted transaction, zero means the max allowed
+	// signers count.
+	SignersCount uint32
+	// MaxFee is the max fee in drops, zero means no limit.
+	MaxFee uint64
+}
+
+// NewMultiSigningTxFeeConfig returns the MultiSigningTxFeeConfig with the signers count expected from the contract
+// evidence threshold and relayers, and the max fee set in the contract config.
+func NewMultiSigningTxFeeConfig(contractConfig coreum.ContractConfig) MultiSigningTxFeeConfig {
+	return MultiSigningTxFeeConfig{
+		SignersCount: xrpl.GetMultiSigningTxSignersCount(contractConfig.EvidenceThreshold, len(contractConfig.Relayers)),
+		MaxFee:       lo.FromPtr(contractConfig.MaxXRPLTxFee),
+	}
+}
+
+// GetMultiSigningTxFee returns the multi-signing transaction fee for the operation.
+func GetMultiSigningTxFee(operation coreum.Operation, feeCfg MultiSigningTxFeeConfig) (rippledata.Value, error) {
+	return xrpl.GetMultiSigningTxFee(operation.XRPLBaseFee, feeCfg.SignersCount, feeCfg.MaxFee)
+}
+
+// BuildXRPLTxFromOperation builds the XRPL transaction for the multi-signing from the contract operation.
+func BuildXRPLTxFromOperation(
+	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
+	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
+) (MultiSignableTransaction, error) {
+	switch {
+	case isAllocateTicketsOperation(operation):
+		return BuildTicketCreateTxForMultiSigning(bridgeXRPLAddress, operation, feeCfg)
+	case isTrustSetOperation(operation):
+		return BuildTrustSetTxForMultiSigning(bridgeXRPLAddress, sourceTag, operation, feeCfg)
+	case isCoreumToXRPLTransferOperation(operation):
+		return BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
+			bridgeXRPLAddress, sourceTag, operation, feeCfg,
+		)
+	case isRotateKeysOperation(operation):
+		return BuildSignerListSetTxForMultiSigning(bridgeXRPLAddress, operation, feeCfg)
+	case isNFTokenTransferOperation(operation):
+		return BuildNFTokenAcceptOfferTxForMultiSigning(bridgeXRPLAddress, sourceTag, operation, feeCfg)
+	case isCheckCashOperation(operation):
+		return BuildCheckCashTxForMultiSigning(bridgeXRPLAddress, sourceTag, operation, feeCfg)
+	default:
+		return nil, errors.Errorf("failed to process operation, unable to determine operation type, operation:%+v", operation)
+	}
+}
+
 // BuildTicketCreateTxForMultiSigning builds TicketCreate transaction operation from the contract operation.
 func BuildTicketCreateTxForMultiSigning(
 	bridgeXRPLAddress rippledata.Account,
 	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
 ) (*rippledata.TicketCreate, error) {
 	tx := rippledata.TicketCreate{
 		TxBase: rippledata.TxBase{
@@ -29,7 +81,7 @@ func BuildTicketCreateTxForMultiSigning(
 	// important for the multi-signing
 	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
 
-	fee, err := xrpl.GetMultiSigningTxFee(operation.XRPLBaseFee)
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +93,9 @@ func BuildTicketCreateTxForMultiSigning(
 // BuildTrustSetTxForMultiSigning builds TrustSet transaction operation from the contract operation.
 func BuildTrustSetTxForMultiSigning(
 	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
 	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
 ) (*rippledata.TrustSet, error) {
 	trustSetType := operation.OperationType.TrustSet
 	value, err := ConvertCoreumAmountToXRPLAmount(
@@ -57,6 +111,7 @@ func BuildTrustSetTxForMultiSigning(
 			Account:         bridgeXRPLAddress,
 			TransactionType: rippledata.TRUST_SET,
 			Flags:           lo.ToPtr(rippledata.TxSetNoRipple),
+			SourceTag:       sourceTag,
 		},
 		LimitAmount: value,
 	}
@@ -64,7 +119,7 @@ func BuildTrustSetTxForMultiSigning(
 	// important for the multi-signing
 	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
 
-	fee, err := xrpl.GetMultiSigningTxFee(operation.XRPLBaseFee)
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +132,9 @@ func BuildTrustSetTxForMultiSigning(
 // XRPL originated token from the contract operation.
 func BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
 	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
 	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
 ) (*rippledata.Payment, error) {
 	coreumToXRPLTransferOperationType := operation.OperationType.CoreumToXRPLTransfer
 	amount, err := ConvertCoreumAmountToXRPLAmount(
@@ -102,7 +159,7 @@ func BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
 		maxAmount = &convertedMaxAmount
 	}
 
-	tx, err := buildPaymentTx(bridgeXRPLAddress, operation, amount, maxAmount)
+	tx, err := buildPaymentTx(bridgeXRPLAddress, sourceTag, operation, amount, maxAmount, feeCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +171,7 @@ func BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
 func BuildSignerListSetTxForMultiSigning(
 	bridgeXRPLAddress rippledata.Account,
 	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
 ) (*rippledata.SignerListSet, error) {
 	rotateKeysOperationType := operation.OperationType.RotateKeys
 
@@ -149,7 +207,92 @@ func BuildSignerListSetTxForMultiSigning(
 	// important for the multi-signing
 	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
 
-	fee, err := xrpl.GetMultiSigningTxFee(operation.XRPLBaseFee)
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxBase.Fee = fee
+
+	return &tx, nil
+}
+
+// BuildNFTokenAcceptOfferTxForMultiSigning builds NFTokenAcceptOffer transaction accepting the NFToken sell offer
+// from the contract operation.
+func BuildNFTokenAcceptOfferTxForMultiSigning(
+	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
+	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
+) (*rippledata.NFTAcceptOffer, error) {
+	nftokenTransferOperationType := operation.OperationType.NFTokenTransfer
+	sellOfferID, err := rippledata.NewHash256(nftokenTransferOperationType.SellOfferID)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"failed to convert NFToken sell offer ID to rippledata.Hash256, sellOfferID:%s",
+			nftokenTransferOperationType.SellOfferID,
+		)
+	}
+	tx := rippledata.NFTAcceptOffer{
+		TxBase: rippledata.TxBase{
+			Account:         bridgeXRPLAddress,
+			TransactionType: rippledata.NFTOKEN_ACCEPT_OFFER,
+			SourceTag:       sourceTag,
+		},
+		NFTokenSellOffer: sellOfferID,
+	}
+	tx.TicketSequence = &operation.TicketSequence
+	// important for the multi-signing
+	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
+
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxBase.Fee = fee
+
+	return &tx, nil
+}
+
+// BuildCheckCashTxForMultiSigning builds CheckCash transaction cashing the XRPL Check created for the bridge account
+// from the contract operation.
+func BuildCheckCashTxForMultiSigning(
+	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
+	operation coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
+) (*rippledata.CheckCash, error) {
+	checkCashOperationType := operation.OperationType.CheckCash
+	checkID, err := rippledata.NewHash256(checkCashOperationType.CheckID)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"failed to convert check ID to rippledata.Hash256, checkID:%s",
+			checkCashOperationType.CheckID,
+		)
+	}
+	amount, err := ConvertCoreumAmountToXRPLAmount(
+		checkCashOperationType.Amount,
+		checkCashOperationType.Issuer,
+		checkCashOperationType.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tx := rippledata.CheckCash{
+		TxBase: rippledata.TxBase{
+			Account:         bridgeXRPLAddress,
+			TransactionType: rippledata.CHECK_CASH,
+			SourceTag:       sourceTag,
+		},
+		CheckID: *checkID,
+		Amount:  &amount,
+	}
+	tx.TicketSequence = &operation.TicketSequence
+	// important for the multi-signing
+	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
+
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -160,9 +303,11 @@ func BuildSignerListSetTxForMultiSigning(
 
 func buildPaymentTx(
 	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
 	operation coreum.Operation,
 	amount rippledata.Amount,
 	maxAmount *rippledata.Amount,
+	feeCfg MultiSigningTxFeeConfig,
 ) (rippledata.Payment, error) {
 	recipient, err := rippledata.NewAccountFromAddress(operation.OperationType.CoreumToXRPLTransfer.Recipient)
 	if err != nil {
@@ -177,6 +322,7 @@ func buildPaymentTx(
 		TxBase: rippledata.TxBase{
 			Account:         bridgeXRPLAddress,
 			TransactionType: rippledata.PAYMENT,
+			SourceTag:       sourceTag,
 		},
 		Amount:  amount,
 		SendMax: maxAmount,
@@ -185,7 +331,7 @@ func buildPaymentTx(
 	// important for the multi-signing
 	tx.TxBase.SigningPubKey = &rippledata.PublicKey{}
 
-	fee, err := xrpl.GetMultiSigningTxFee(operation.XRPLBaseFee)
+	fee, err := GetMultiSigningTxFee(operation, feeCfg)
 	if err != nil {
 		return rippledata.Payment{}, err
 	}
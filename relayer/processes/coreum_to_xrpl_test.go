@@ -3,8 +3,10 @@ package processes_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
@@ -40,6 +42,67 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 		trustSetOperationWithSignatures,
 		trustSetOperationValidSigners := buildTrustSetTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
 
+	// the operation signed by the relayer only, so the quorum isn't reached
+	trustSetOperationSignedByRelayer := trustSetOperation
+	trustSetOperationSignedByRelayer.Signatures = trustSetOperationWithSignatures.Signatures[:1]
+	require.Equal(
+		t, contractRelayers[0].CoreumAddress, trustSetOperationSignedByRelayer.Signatures[0].RelayerCoreumAddress,
+	)
+
+	// the signature of the relayer with the modified last byte
+	tamperedTrustSetSignature := append(
+		rippledata.VariableLength{}, *trustSetOperationValidSigners[0].Signer.TxnSignature...,
+	)
+	tamperedTrustSetSignature[len(tamperedTrustSetSignature)-1] ^= 0xFF
+	tamperedTrustSetSigner := trustSetOperationValidSigners[0]
+	tamperedTrustSetSigner.Signer.TxnSignature = &tamperedTrustSetSignature
+
+	// the signature produced with the key of another relayer, e.g. because of the key misconfiguration
+	misconfiguredKeyTrustSetSigner := trustSetOperationValidSigners[1]
+
+	trustSetTxSignerBuilder := func(signer rippledata.Signer) func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+		return func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+			xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
+			tx, err := processes.BuildTrustSetTxForMultiSigning(
+				bridgeXRPLAddress, nil, trustSetOperation, processes.MultiSigningTxFeeConfig{},
+			)
+			require.NoError(t, err)
+			xrplTxSignerMock.EXPECT().MultiSign(tx, xrplTxSignerKeyName).Return(signer, nil)
+
+			return xrplTxSignerMock
+		}
+	}
+	trustSetContractClientBuilder := func(
+		savedSignature *rippledata.Signer,
+	) func(ctrl *gomock.Controller) processes.ContractClient {
+		return func(ctrl *gomock.Controller) processes.ContractClient {
+			contractClientMock := NewMockContractClient(ctrl)
+			contractClientMock.EXPECT().IsInitialized().Return(true)
+			contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{trustSetOperation}, nil)
+			contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+				Relayers: contractRelayers,
+			}, nil)
+			if savedSignature != nil {
+				contractClientMock.EXPECT().SaveSignature(
+					gomock.Any(),
+					contractRelayers[0].CoreumAddress,
+					trustSetOperation.TicketSequence,
+					trustSetOperation.Version,
+					savedSignature.Signer.TxnSignature.String(),
+				)
+			}
+			return contractClientMock
+		}
+	}
+	trustSetXRPLRPCClientBuilder := func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+		xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+		xrplRPCClientMock.
+			EXPECT().
+			AccountInfo(gomock.Any(), bridgeXRPLAddress).
+			Return(bridgeXRPLSignerAccountWithSigners, nil)
+		return xrplRPCClientMock
+	}
+
 	// ********** CoreumToXRPLTransfer **********
 
 	coreumToXRPLTokenTransferOperation,
@@ -48,6 +111,16 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
 	)
 
+	coreumToXRPLTokenTransferOperationWithPrecisionMismatch := coreumToXRPLTokenTransferOperation
+	precisionMismatchTransfer := *coreumToXRPLTokenTransferOperation.
+		OperationType.CoreumToXRPLTransfer
+	// 17 significant digits can't be represented as the XRPL amount
+	precisionMismatchTransfer.Amount = sdkmath.NewInt(12345678901234567)
+	precisionMismatchTransfer.MaxAmount = lo.ToPtr(sdkmath.NewInt(12345678901234567))
+	coreumToXRPLTokenTransferOperationWithPrecisionMismatch.OperationType = coreum.OperationType{
+		CoreumToXRPLTransfer: &precisionMismatchTransfer,
+	}
+
 	// ********** RoteKeys **********
 
 	rotateKeysOperation,
@@ -57,10 +130,18 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 	)
 
 	tests := []struct {
-		name                  string
-		contractClientBuilder func(ctrl *gomock.Controller) processes.ContractClient
-		xrplRPCClientBuilder  func(ctrl *gomock.Controller) processes.XRPLRPCClient
-		xrplTxSignerBuilder   func(ctrl *gomock.Controller) processes.XRPLTxSigner
+		name                       string
+		contractClientBuilder      func(ctrl *gomock.Controller) processes.ContractClient
+		xrplRPCClientBuilder       func(ctrl *gomock.Controller) processes.XRPLRPCClient
+		xrplTxSignerBuilder        func(ctrl *gomock.Controller) processes.XRPLTxSigner
+		transferRateLimiterBuilder func(ctrl *gomock.Controller) processes.CoreumToXRPLTransferRateLimiter
+		operationAgeTrackerBuilder func(ctrl *gomock.Controller) processes.CoreumToXRPLOperationAgeTracker
+		tokenRegistryBuilder       func(ctrl *gomock.Controller) processes.CoreumToXRPLTokenRegistry
+		metricRegistryBuilder      func(ctrl *gomock.Controller) processes.MetricRegistry
+		txResultTrackerBuilder     func(ctrl *gomock.Controller) processes.CoreumToXRPLTxResultTracker
+		signatureTrackerBuilder    func(ctrl *gomock.Controller) processes.CoreumToXRPLSignatureAggregationTracker
+		verifyBeforeSubmit         bool
+		wantErrorLog               bool
 	}{
 		{
 			name: "no_pending_operations",
@@ -74,6 +155,25 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 				return NewMockXRPLTxSigner(ctrl)
 			},
 		},
+		{
+			name: "track_pending_operations_age_with_tracking_error",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{}, nil)
+				return contractClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			operationAgeTrackerBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLOperationAgeTracker {
+				operationAgeTrackerMock := NewMockCoreumToXRPLOperationAgeTracker(ctrl)
+				// the tracking error doesn't stop the processing
+				operationAgeTrackerMock.EXPECT().Track(gomock.Any(), []coreum.Operation{}).
+					Return(errors.New("store is unavailable"))
+				return operationAgeTrackerMock
+			},
+		},
 		{
 			name: "register_signature_for_create_ticket_tx",
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
@@ -106,7 +206,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 			},
 			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
 				xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
-				tx, err := processes.BuildTicketCreateTxForMultiSigning(bridgeXRPLAddress, allocateTicketsOperation)
+				tx, err := processes.BuildTicketCreateTxForMultiSigning(bridgeXRPLAddress, allocateTicketsOperation, processes.MultiSigningTxFeeConfig{})
 				require.NoError(t, err)
 				xrplTxSignerMock.EXPECT().MultiSign(tx, xrplTxSignerKeyName).Return(allocateTicketOperationValidSigners[0], nil)
 
@@ -134,7 +234,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 					AccountInfo(gomock.Any(), bridgeXRPLAddress).
 					Return(bridgeXRPLSignerAccountWithSigners, nil)
 				expectedTx, err := processes.BuildTicketCreateTxForMultiSigning(
-					bridgeXRPLAddress, allocateTicketOperationWithSignatures,
+					bridgeXRPLAddress, allocateTicketOperationWithSignatures, processes.MultiSigningTxFeeConfig{},
 				)
 				require.NoError(t, err)
 				require.NoError(t, rippledata.SetSigners(expectedTx, allocateTicketOperationValidSigners...))
@@ -155,6 +255,13 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
 				return NewMockXRPLTxSigner(ctrl)
 			},
+			txResultTrackerBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLTxResultTracker {
+				txResultTrackerMock := NewMockCoreumToXRPLTxResultTracker(ctrl)
+				txResultTrackerMock.EXPECT().Track(
+					gomock.Any(), allocateTicketOperationWithSignatures, gomock.Any(), xrpl.SubmitResult{},
+				)
+				return txResultTrackerMock
+			},
 		},
 		{
 			name: "register_invalid_create_ticket_tx",
@@ -221,13 +328,42 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 			},
 			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
 				xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
-				tx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAddress, trustSetOperation)
+				tx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAddress, nil, trustSetOperation, processes.MultiSigningTxFeeConfig{})
 				require.NoError(t, err)
 				xrplTxSignerMock.EXPECT().MultiSign(tx, xrplTxSignerKeyName).Return(trustSetOperationValidSigners[0], nil)
 
 				return xrplTxSignerMock
 			},
 		},
+		{
+			name:                  "register_verified_signature_for_trust_set_tx",
+			contractClientBuilder: trustSetContractClientBuilder(&trustSetOperationValidSigners[0]),
+			xrplRPCClientBuilder:  trustSetXRPLRPCClientBuilder,
+			xrplTxSignerBuilder:   trustSetTxSignerBuilder(trustSetOperationValidSigners[0]),
+			verifyBeforeSubmit:    true,
+		},
+		{
+			name:                  "skip_tampered_signature_for_trust_set_tx",
+			contractClientBuilder: trustSetContractClientBuilder(nil),
+			xrplRPCClientBuilder:  trustSetXRPLRPCClientBuilder,
+			xrplTxSignerBuilder:   trustSetTxSignerBuilder(tamperedTrustSetSigner),
+			verifyBeforeSubmit:    true,
+			wantErrorLog:          true,
+		},
+		{
+			name:                  "skip_signature_with_misconfigured_key_for_trust_set_tx",
+			contractClientBuilder: trustSetContractClientBuilder(nil),
+			xrplRPCClientBuilder:  trustSetXRPLRPCClientBuilder,
+			xrplTxSignerBuilder:   trustSetTxSignerBuilder(misconfiguredKeyTrustSetSigner),
+			verifyBeforeSubmit:    true,
+			wantErrorLog:          true,
+		},
+		{
+			name:                  "register_tampered_signature_for_trust_set_tx_with_disabled_verification",
+			contractClientBuilder: trustSetContractClientBuilder(&tamperedTrustSetSigner),
+			xrplRPCClientBuilder:  trustSetXRPLRPCClientBuilder,
+			xrplTxSignerBuilder:   trustSetTxSignerBuilder(tamperedTrustSetSigner),
+		},
 		{
 			name: "submit_trust_set_tx_with_filtered_signature",
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
@@ -248,7 +384,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 					EXPECT().
 					AccountInfo(gomock.Any(), bridgeXRPLAddress).
 					Return(bridgeXRPLSignerAccountWithSigners, nil)
-				expectedTx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAddress, trustSetOperationWithSignatures)
+				expectedTx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAddress, nil, trustSetOperationWithSignatures, processes.MultiSigningTxFeeConfig{})
 				require.NoError(t, err)
 				require.NoError(t, rippledata.SetSigners(expectedTx, trustSetOperationValidSigners...))
 				xrplRPCClientMock.
@@ -269,6 +405,143 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 				return NewMockXRPLTxSigner(ctrl)
 			},
 		},
+		{
+			name: "skip_trust_set_tx_signing_already_signed_by_relayer",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().
+					GetPendingOperations(gomock.Any()).
+					Return([]coreum.Operation{trustSetOperationSignedByRelayer}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+		},
+		{
+			name: "register_duplicate_signature_for_trust_set_tx",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{trustSetOperation}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				// the signature is provided by another instance of the relayer after the operations are fetched
+				contractClientMock.EXPECT().SaveSignature(
+					gomock.Any(),
+					contractRelayers[0].CoreumAddress,
+					trustSetOperation.TicketSequence,
+					trustSetOperation.Version,
+					trustSetOperationValidSigners[0].Signer.TxnSignature.String(),
+				).Return(nil, errors.New("SignatureAlreadyProvided: signature is already provided"))
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
+				xrplTxSignerMock.EXPECT().MultiSign(gomock.Any(), xrplTxSignerKeyName).
+					Return(trustSetOperationValidSigners[0], nil)
+				return xrplTxSignerMock
+			},
+			metricRegistryBuilder: func(ctrl *gomock.Controller) processes.MetricRegistry {
+				metricRegistryMock := NewMockMetricRegistry(ctrl)
+				metricRegistryMock.EXPECT().IncrementBridgeDuplicateSignatureCounter()
+				return metricRegistryMock
+			},
+		},
+		{
+			name: "abandon_trust_set_tx_with_timed_out_signature_aggregation",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{trustSetOperation}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				contractClientMock.EXPECT().SendXRPLTrustSetTransactionResultEvidence(
+					gomock.Any(),
+					contractRelayers[0].CoreumAddress,
+					coreum.XRPLTransactionResultTrustSetEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TicketSequence:    lo.ToPtr(trustSetOperation.TicketSequence),
+							TransactionResult: coreum.TransactionResultInvalid,
+						},
+					},
+				)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			signatureTrackerBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLSignatureAggregationTracker {
+				signatureTrackerMock := NewMockCoreumToXRPLSignatureAggregationTracker(ctrl)
+				signatureTrackerMock.EXPECT().Track(gomock.Any(), []coreum.Operation{trustSetOperation}).
+					Return([]coreum.Operation{trustSetOperation}, nil)
+				return signatureTrackerMock
+			},
+		},
+		{
+			name: "submit_trust_set_tx_with_timed_out_signature_aggregation_and_reached_quorum",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.
+					EXPECT().
+					GetPendingOperations(gomock.Any()).
+					Return([]coreum.Operation{trustSetOperationWithSignatures}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				// the operation with the reached quorum might be already submitted, so it isn't abandoned
+				xrplRPCClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(xrpl.SubmitResult{}, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			signatureTrackerBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLSignatureAggregationTracker {
+				signatureTrackerMock := NewMockCoreumToXRPLSignatureAggregationTracker(ctrl)
+				signatureTrackerMock.EXPECT().Track(gomock.Any(), gomock.Any()).
+					Return([]coreum.Operation{trustSetOperationWithSignatures}, nil)
+				return signatureTrackerMock
+			},
+		},
 		{
 			name: "register_signature_for_coreum_to_XRPL_token_transfer_payment_tx",
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
@@ -301,7 +574,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
 				xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
 				tx, err := processes.BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
-					bridgeXRPLAddress, coreumToXRPLTokenTransferOperation,
+					bridgeXRPLAddress, nil, coreumToXRPLTokenTransferOperation, processes.MultiSigningTxFeeConfig{},
 				)
 				require.NoError(t, err)
 				xrplTxSignerMock.
@@ -311,6 +584,122 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 
 				return xrplTxSignerMock
 			},
+			tokenRegistryBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLTokenRegistry {
+				tokenRegistryMock := NewMockCoreumToXRPLTokenRegistry(ctrl)
+				transfer := coreumToXRPLTokenTransferOperation.OperationType.CoreumToXRPLTransfer
+				tokenRegistryMock.EXPECT().
+					IsXRPLTokenRegistered(gomock.Any(), transfer.Issuer, transfer.Currency).
+					Return(true, nil)
+				return tokenRegistryMock
+			},
+		},
+		{
+			name: "skip_coreum_to_XRPL_token_transfer_payment_tx_signing_of_unknown_token",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.
+					EXPECT().
+					GetPendingOperations(gomock.Any()).
+					Return([]coreum.Operation{coreumToXRPLTokenTransferOperation}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			tokenRegistryBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLTokenRegistry {
+				tokenRegistryMock := NewMockCoreumToXRPLTokenRegistry(ctrl)
+				transfer := coreumToXRPLTokenTransferOperation.OperationType.CoreumToXRPLTransfer
+				tokenRegistryMock.EXPECT().
+					IsXRPLTokenRegistered(gomock.Any(), transfer.Issuer, transfer.Currency).
+					Return(false, nil)
+				return tokenRegistryMock
+			},
+		},
+		{
+			name: "skip_coreum_to_XRPL_token_transfer_payment_tx_signing_with_amount_precision_mismatch",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.
+					EXPECT().
+					GetPendingOperations(gomock.Any()).
+					Return([]coreum.Operation{coreumToXRPLTokenTransferOperationWithPrecisionMismatch}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			metricRegistryBuilder: func(ctrl *gomock.Controller) processes.MetricRegistry {
+				metricRegistryMock := NewMockMetricRegistry(ctrl)
+				metricRegistryMock.EXPECT().IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(
+					precisionMismatchTransfer.Currency,
+					precisionMismatchTransfer.Issuer,
+				)
+				return metricRegistryMock
+			},
+			wantErrorLog: true,
+		},
+		{
+			name: "skip_coreum_to_XRPL_token_transfer_payment_tx_signing_limited_by_rate_limiter",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.
+					EXPECT().
+					GetPendingOperations(gomock.Any()).
+					Return([]coreum.Operation{coreumToXRPLTokenTransferOperation}, nil)
+				contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+					Relayers: contractRelayers,
+				}, nil)
+				return contractClientMock
+			},
+			xrplRPCClientBuilder: func(ctrl *gomock.Controller) processes.XRPLRPCClient {
+				xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+				xrplRPCClientMock.
+					EXPECT().
+					AccountInfo(gomock.Any(), bridgeXRPLAddress).
+					Return(bridgeXRPLSignerAccountWithSigners, nil)
+				return xrplRPCClientMock
+			},
+			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
+				return NewMockXRPLTxSigner(ctrl)
+			},
+			transferRateLimiterBuilder: func(ctrl *gomock.Controller) processes.CoreumToXRPLTransferRateLimiter {
+				transferRateLimiterMock := NewMockCoreumToXRPLTransferRateLimiter(ctrl)
+				transferRateLimiterMock.EXPECT().ReportConsumption()
+				transfer := coreumToXRPLTokenTransferOperation.OperationType.CoreumToXRPLTransfer
+				transferRateLimiterMock.EXPECT().Allow(
+					gomock.Any(),
+					coreumToXRPLTokenTransferOperation.GetOperationID(),
+					transfer.Issuer,
+					transfer.Currency,
+					transfer.Amount,
+				).Return(false)
+				return transferRateLimiterMock
+			},
 		},
 		{
 			name: "submit_coreum_to_XRPL_token_transfer_payment_tx_with_filtered_signature",
@@ -333,7 +722,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 					AccountInfo(gomock.Any(), bridgeXRPLAddress).
 					Return(bridgeXRPLSignerAccountWithSigners, nil)
 				expectedTx, err := processes.BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
-					bridgeXRPLAddress, coreumToXRPLTokenTransferOperationWithSignatures,
+					bridgeXRPLAddress, nil, coreumToXRPLTokenTransferOperationWithSignatures, processes.MultiSigningTxFeeConfig{},
 				)
 				require.NoError(t, err)
 				require.NoError(t, rippledata.SetSigners(expectedTx, coreumToXRPLTokenTransferOperationValidSigners...))
@@ -385,7 +774,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 			xrplTxSignerBuilder: func(ctrl *gomock.Controller) processes.XRPLTxSigner {
 				xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
 				tx, err := processes.BuildSignerListSetTxForMultiSigning(
-					bridgeXRPLAddress, rotateKeysOperation,
+					bridgeXRPLAddress, rotateKeysOperation, processes.MultiSigningTxFeeConfig{},
 				)
 				require.NoError(t, err)
 				xrplTxSignerMock.
@@ -417,7 +806,7 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 					AccountInfo(gomock.Any(), bridgeXRPLAddress).
 					Return(bridgeXRPLSignerAccountWithSigners, nil)
 				expectedTx, err := processes.BuildSignerListSetTxForMultiSigning(
-					bridgeXRPLAddress, rotateKeysOperationWithSignatures,
+					bridgeXRPLAddress, rotateKeysOperationWithSignatures, processes.MultiSigningTxFeeConfig{},
 				)
 				require.NoError(t, err)
 				require.NoError(t, rippledata.SetSigners(expectedTx, rotateKeysOperationValidSigners...))
@@ -446,6 +835,9 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			logMock := logger.NewAnyLogMock(ctrl)
+			if tt.wantErrorLog {
+				logMock.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any())
+			}
 			var contractClient processes.ContractClient
 			if tt.contractClientBuilder != nil {
 				contractClient = tt.contractClientBuilder(ctrl)
@@ -461,18 +853,55 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 				xrplTxSigner = tt.xrplTxSignerBuilder(ctrl)
 			}
 
-			metricRegistryMock := NewMockMetricRegistry(ctrl)
+			var transferRateLimiter processes.CoreumToXRPLTransferRateLimiter
+			if tt.transferRateLimiterBuilder != nil {
+				transferRateLimiter = tt.transferRateLimiterBuilder(ctrl)
+			}
+
+			var operationAgeTracker processes.CoreumToXRPLOperationAgeTracker
+			if tt.operationAgeTrackerBuilder != nil {
+				operationAgeTracker = tt.operationAgeTrackerBuilder(ctrl)
+			}
+
+			var tokenRegistry processes.CoreumToXRPLTokenRegistry
+			if tt.tokenRegistryBuilder != nil {
+				tokenRegistry = tt.tokenRegistryBuilder(ctrl)
+			}
+
+			var metricRegistry processes.MetricRegistry = NewMockMetricRegistry(ctrl)
+			if tt.metricRegistryBuilder != nil {
+				metricRegistry = tt.metricRegistryBuilder(ctrl)
+			}
+
+			var txResultTracker processes.CoreumToXRPLTxResultTracker
+			if tt.txResultTrackerBuilder != nil {
+				txResultTracker = tt.txResultTrackerBuilder(ctrl)
+			}
+
+			var signatureTracker processes.CoreumToXRPLSignatureAggregationTracker
+			if tt.signatureTrackerBuilder != nil {
+				signatureTracker = tt.signatureTrackerBuilder(ctrl)
+			}
+
 			o, err := processes.NewCoreumToXRPLProcess(
 				processes.CoreumToXRPLProcessConfig{
 					BridgeXRPLAddress:    bridgeXRPLAddress,
 					RelayerCoreumAddress: contractRelayers[0].CoreumAddress,
 					XRPLTxSignerKeyName:  xrplTxSignerKeyName,
+					VerifyBeforeSubmit:   tt.verifyBeforeSubmit,
 				},
 				logMock,
 				contractClient,
 				xrplRPCClient,
 				xrplTxSigner,
-				metricRegistryMock,
+				metricRegistry,
+				nil,
+				transferRateLimiter,
+				operationAgeTracker,
+				tokenRegistry,
+				txResultTracker,
+				signatureTracker,
+				nil,
 			)
 			require.NoError(t, err)
 			require.NoError(t, o.Start(ctx))
@@ -480,6 +909,381 @@ func TestCoreumToXRPLProcess_Start(t *testing.T) {
 	}
 }
 
+func TestCoreumToXRPLProcess_StartWithContractEventsSubscriber(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	contractClientMock := NewMockContractClient(ctrl)
+	contractClientMock.EXPECT().IsInitialized().Return(true)
+	contractEventsSubscriberMock := NewMockContractEventsSubscriber(ctrl)
+	contractEventsSubscriberMock.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, ch chan<- coreum.ContractTx) error {
+			ch <- coreum.ContractTx{
+				Hash:   "tx1",
+				Height: 1,
+				TokenStateChanges: []coreum.TokenStateChange{{
+					TokenDenom: "denom1",
+					OldState:   coreum.TokenStateEnabled,
+					NewState:   coreum.TokenStateDisabled,
+					Actor:      coreum.GenAccount(),
+					Timestamp:  time.Now(),
+				}},
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	)
+	// the first processing is done on start and the second one is triggered by the contract tx without waiting
+	// for the repeat delay
+	gomock.InOrder(
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return(nil, nil),
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).DoAndReturn(
+			func(context.Context) ([]coreum.Operation, error) {
+				cancel()
+				return nil, nil
+			},
+		),
+	)
+
+	// the token state change is logged once, the expectation is added before the generic ones to be matched first
+	logMock := logger.NewMockLogger(ctrl)
+	logMock.EXPECT().Info(gomock.Any(), "Token state is changed", gomock.Any())
+	logMock.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logMock.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logMock.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	p, err := processes.NewCoreumToXRPLProcess(
+		processes.CoreumToXRPLProcessConfig{
+			BridgeXRPLAddress:    xrpl.GenPrivKeyTxSigner().Account(),
+			RelayerCoreumAddress: coreum.GenAccount(),
+			RepeatRecentScan:     true,
+			RepeatDelay:          time.Hour,
+		},
+		logMock,
+		contractClientMock,
+		NewMockXRPLRPCClient(ctrl),
+		NewMockXRPLTxSigner(ctrl),
+		NewMockMetricRegistry(ctrl),
+		contractEventsSubscriberMock,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, p.Start(ctx), context.Canceled)
+}
+
+func TestCoreumToXRPLProcess_DuplicateSignatureWarning(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	contractRelayers, xrplTxSigners, bridgeXRPLSignerAccountWithSigners := genContractRelayers(3)
+	trustSetOperation, _, trustSetOperationValidSigners := buildTrustSetTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	transferOperation, _, _ := buildCoreumToXRPLTokenTransferTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+
+	ctrl := gomock.NewController(t)
+	contractClientMock := NewMockContractClient(ctrl)
+	contractClientMock.EXPECT().IsInitialized().Return(true)
+	contractClientMock.EXPECT().
+		GetPendingOperations(gomock.Any()).
+		Return([]coreum.Operation{trustSetOperation, transferOperation}, nil)
+	contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(coreum.ContractConfig{
+		Relayers: contractRelayers,
+	}, nil)
+	contractClientMock.EXPECT().SaveSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("SignatureAlreadyProvided: signature is already provided")).
+		Times(2)
+	xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+	xrplRPCClientMock.EXPECT().AccountInfo(gomock.Any(), bridgeXRPLAddress).Return(bridgeXRPLSignerAccountWithSigners, nil)
+	xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
+	xrplTxSignerMock.EXPECT().MultiSign(gomock.Any(), gomock.Any()).Return(trustSetOperationValidSigners[0], nil).Times(2)
+	metricRegistryMock := NewMockMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementBridgeDuplicateSignatureCounter().Times(2)
+
+	// both duplicate signatures are counted, but the warning is written once
+	logMock := logger.NewMockLogger(ctrl)
+	logMock.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any())
+	logMock.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logMock.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	p, err := processes.NewCoreumToXRPLProcess(
+		processes.CoreumToXRPLProcessConfig{
+			BridgeXRPLAddress:    bridgeXRPLAddress,
+			RelayerCoreumAddress: contractRelayers[0].CoreumAddress,
+		},
+		logMock,
+		contractClientMock,
+		xrplRPCClientMock,
+		xrplTxSignerMock,
+		metricRegistryMock,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background()))
+}
+
+func TestCoreumToXRPLProcess_FlushOperationsCacheOnVersionBump(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ctrl := gomock.NewController(t)
+	contractClientMock := NewMockContractClient(ctrl)
+	contractClientMock.EXPECT().IsInitialized().Return(true)
+	operationsCacheMock := NewMockCoreumToXRPLOperationsCache(ctrl)
+
+	// the tx is sent once the start processing is done to keep the order of the calls
+	started := make(chan struct{})
+	contractEventsSubscriberMock := NewMockContractEventsSubscriber(ctrl)
+	contractEventsSubscriberMock.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, ch chan<- coreum.ContractTx) error {
+			<-started
+			ch <- coreum.ContractTx{
+				Hash:   "tx1",
+				Height: 1,
+				OperationsVersionBump: &coreum.OperationsVersionBump{
+					XRPLBaseFee:     20,
+					OperationsCount: 1,
+				},
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	)
+	// the cache is flushed before the processing triggered by the tx, so the re-fetched operations are re-signed
+	gomock.InOrder(
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).DoAndReturn(
+			func(context.Context) ([]coreum.Operation, error) {
+				close(started)
+				return nil, nil
+			},
+		),
+		operationsCacheMock.EXPECT().InvalidatePendingOperations(),
+		operationsCacheMock.EXPECT().InvalidateContractConfig(),
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).DoAndReturn(
+			func(context.Context) ([]coreum.Operation, error) {
+				cancel()
+				return nil, nil
+			},
+		),
+	)
+
+	logMock := logger.NewMockLogger(ctrl)
+	logMock.EXPECT().Info(gomock.Any(), "Pending operations version is bumped, re-signing the operations", gomock.Any())
+	logMock.EXPECT().Debug(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logMock.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logMock.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	p, err := processes.NewCoreumToXRPLProcess(
+		processes.CoreumToXRPLProcessConfig{
+			BridgeXRPLAddress:    xrpl.GenPrivKeyTxSigner().Account(),
+			RelayerCoreumAddress: coreum.GenAccount(),
+			RepeatRecentScan:     true,
+			RepeatDelay:          time.Hour,
+		},
+		logMock,
+		contractClientMock,
+		NewMockXRPLRPCClient(ctrl),
+		NewMockXRPLTxSigner(ctrl),
+		NewMockMetricRegistry(ctrl),
+		contractEventsSubscriberMock,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		operationsCacheMock,
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, p.Start(ctx), context.Canceled)
+}
+
+func TestBuildTxForMultiSigning_SourceTag(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	contractRelayers, xrplTxSigners, _ := genContractRelayers(3)
+	trustSetOperation, _, _ := buildTrustSetTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+	transferOperation, _, _ := buildCoreumToXRPLTokenTransferTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	nftokenTransferOperation := coreum.Operation{
+		TicketSequence: 1,
+		OperationType: coreum.OperationType{
+			NFTokenTransfer: &coreum.OperationTypeNFTokenTransfer{
+				NFTokenID:   "000800006203F49C21D5D6E022CB16DE3538F248662FC73C0000000000000001",
+				SellOfferID: "D2D6EB33C17D4D6B7B1AF2A4D0E2A7C4B5F3A4E1C2D8E9F0A1B2C3D4E5F6A7B8",
+				Sender:      xrpl.GenPrivKeyTxSigner().Account().String(),
+				Recipient:   coreum.GenAccount().String(),
+			},
+		},
+	}
+
+	checkCashOperation := coreum.Operation{
+		TicketSequence: 2,
+		OperationType: coreum.OperationType{
+			CheckCash: &coreum.OperationTypeCheckCash{
+				CheckID:   "49647F0D748DC3FE26BDACBC57F251AADEFFF391403EC9BF87C97F67E9977FB0",
+				Issuer:    xrpl.XRPTokenIssuer.String(),
+				Currency:  xrpl.XRPTokenCurrency.String(),
+				Amount:    sdkmath.NewInt(1_000_000),
+				Recipient: coreum.GenAccount().String(),
+			},
+		},
+	}
+
+	txBuilders := map[string]func(sourceTag *uint32) (processes.MultiSignableTransaction, error){
+		"trust_set": func(sourceTag *uint32) (processes.MultiSignableTransaction, error) {
+			return processes.BuildTrustSetTxForMultiSigning(
+				bridgeXRPLAddress, sourceTag, trustSetOperation, processes.MultiSigningTxFeeConfig{},
+			)
+		},
+		"payment": func(sourceTag *uint32) (processes.MultiSignableTransaction, error) {
+			return processes.BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(
+				bridgeXRPLAddress, sourceTag, transferOperation, processes.MultiSigningTxFeeConfig{},
+			)
+		},
+		"nftoken_accept_offer": func(sourceTag *uint32) (processes.MultiSignableTransaction, error) {
+			return processes.BuildXRPLTxFromOperation(
+				bridgeXRPLAddress, sourceTag, nftokenTransferOperation, processes.MultiSigningTxFeeConfig{},
+			)
+		},
+		"check_cash": func(sourceTag *uint32) (processes.MultiSignableTransaction, error) {
+			return processes.BuildXRPLTxFromOperation(
+				bridgeXRPLAddress, sourceTag, checkCashOperation, processes.MultiSigningTxFeeConfig{},
+			)
+		},
+	}
+
+	for name, txBuilder := range txBuilders {
+		txBuilder := txBuilder
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tx, err := txBuilder(nil)
+			require.NoError(t, err)
+			require.Nil(t, tx.GetBase().SourceTag)
+
+			sourceTag := lo.ToPtr(uint32(1234))
+			// each relayer builds and signs the tx independently
+			signers := make([]rippledata.Signer, 0, len(xrplTxSigners))
+			for _, xrplTxSigner := range xrplTxSigners {
+				tx, err := txBuilder(sourceTag)
+				require.NoError(t, err)
+				signer, err := xrplTxSigner.MultiSign(tx)
+				require.NoError(t, err)
+				signers = append(signers, signer)
+			}
+
+			tx, err = txBuilder(sourceTag)
+			require.NoError(t, err)
+			require.Equal(t, sourceTag, tx.GetBase().SourceTag)
+			require.NoError(t, rippledata.SetSigners(tx, signers...))
+			isValid, _, err := rippledata.CheckMultiSignature(tx)
+			require.NoError(t, err)
+			require.True(t, isValid)
+		})
+	}
+}
+
+func TestBuildXRPLTxFromOperation_Fee(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		relayersCount     int
+		evidenceThreshold uint32
+		xrplBaseFee       uint32
+		maxFee            *uint64
+		expectedFee       int64
+	}{
+		{
+			name:              "2_signers",
+			relayersCount:     3,
+			evidenceThreshold: 2,
+			xrplBaseFee:       10,
+			expectedFee:       30,
+		},
+		{
+			name:              "16_signers",
+			relayersCount:     20,
+			evidenceThreshold: 16,
+			xrplBaseFee:       10,
+			expectedFee:       170,
+		},
+		{
+			// the same config as in the max operation count base fee update test
+			name:              "32_signers",
+			relayersCount:     int(xrpl.MaxAllowedXRPLSigners),
+			evidenceThreshold: xrpl.MaxAllowedXRPLSigners,
+			xrplBaseFee:       35,
+			expectedFee:       1155,
+		},
+		{
+			name:              "32_signers_capped_by_max_fee",
+			relayersCount:     int(xrpl.MaxAllowedXRPLSigners),
+			evidenceThreshold: xrpl.MaxAllowedXRPLSigners,
+			xrplBaseFee:       35,
+			maxFee:            lo.ToPtr(uint64(1000)),
+			expectedFee:       1000,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+			contractRelayers, xrplTxSigners, _ := genContractRelayers(tt.relayersCount)
+			operation, _, _ := buildCoreumToXRPLTokenTransferTestData(
+				t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+			)
+			operation.XRPLBaseFee = tt.xrplBaseFee
+			feeCfg := processes.NewMultiSigningTxFeeConfig(coreum.ContractConfig{
+				EvidenceThreshold: tt.evidenceThreshold,
+				Relayers:          contractRelayers,
+				MaxXRPLTxFee:      tt.maxFee,
+			})
+
+			signers := make([]rippledata.Signer, 0, tt.evidenceThreshold)
+			for _, xrplTxSigner := range xrplTxSigners[:tt.evidenceThreshold] {
+				tx, err := processes.BuildXRPLTxFromOperation(bridgeXRPLAddress, nil, operation, feeCfg)
+				require.NoError(t, err)
+				signer, err := xrplTxSigner.MultiSign(tx)
+				require.NoError(t, err)
+				signers = append(signers, signer)
+			}
+
+			tx, err := processes.BuildXRPLTxFromOperation(bridgeXRPLAddress, nil, operation, feeCfg)
+			require.NoError(t, err)
+			expectedFee, err := rippledata.NewNativeValue(tt.expectedFee)
+			require.NoError(t, err)
+			require.Equal(t, expectedFee.String(), tx.GetBase().Fee.String())
+			require.NoError(t, rippledata.SetSigners(tx, signers...))
+			isValid, _, err := rippledata.CheckMultiSignature(tx)
+			require.NoError(t, err)
+			require.True(t, isValid)
+		})
+	}
+}
+
 func genContractRelayers(relayersCount int) ([]coreum.Relayer, []*xrpl.PrivKeyTxSigner, xrpl.AccountInfoResult) {
 	contractRelayers := make([]coreum.Relayer, 0)
 	xrplTxSigners := make([]*xrpl.PrivKeyTxSigner, 0)
@@ -716,7 +1520,7 @@ func multiSignAllocateTicketsOperation(
 	bridgeXRPLAddress rippledata.Account,
 	operation coreum.Operation,
 ) rippledata.Signer {
-	tx, err := processes.BuildTicketCreateTxForMultiSigning(bridgeXRPLAddress, operation)
+	tx, err := processes.BuildTicketCreateTxForMultiSigning(bridgeXRPLAddress, operation, processes.MultiSigningTxFeeConfig{})
 	require.NoError(t, err)
 	signer, err := relayerXRPLSigner.MultiSign(tx)
 	require.NoError(t, err)
@@ -730,7 +1534,7 @@ func multiSignTrustSetOperation(
 	bridgeXRPLAcc rippledata.Account,
 	operation coreum.Operation,
 ) rippledata.Signer {
-	tx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAcc, operation)
+	tx, err := processes.BuildTrustSetTxForMultiSigning(bridgeXRPLAcc, nil, operation, processes.MultiSigningTxFeeConfig{})
 	require.NoError(t, err)
 	signer, err := relayerXRPLSigner.MultiSign(tx)
 	require.NoError(t, err)
@@ -744,7 +1548,7 @@ func multiSignCoreumToXRPLXRPLOriginatedTokeTransferOperation(
 	bridgeXRPLAcc rippledata.Account,
 	operation coreum.Operation,
 ) rippledata.Signer {
-	tx, err := processes.BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(bridgeXRPLAcc, operation)
+	tx, err := processes.BuildCoreumToXRPLXRPLOriginatedTokenTransferPaymentTxForMultiSigning(bridgeXRPLAcc, nil, operation, processes.MultiSigningTxFeeConfig{})
 	require.NoError(t, err)
 	signer, err := relayerXRPLSigner.MultiSign(tx)
 	require.NoError(t, err)
@@ -758,7 +1562,7 @@ func multiRotateKeysTransferOperation(
 	bridgeXRPLAcc rippledata.Account,
 	operation coreum.Operation,
 ) rippledata.Signer {
-	tx, err := processes.BuildSignerListSetTxForMultiSigning(bridgeXRPLAcc, operation)
+	tx, err := processes.BuildSignerListSetTxForMultiSigning(bridgeXRPLAcc, operation, processes.MultiSigningTxFeeConfig{})
 	require.NoError(t, err)
 	signer, err := relayerXRPLSigner.MultiSign(tx)
 	require.NoError(t, err)
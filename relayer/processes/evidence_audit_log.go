@@ -0,0 +1,181 @@
+//nolint:tagliatelle // json lines spec
+package processes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EvidenceAuditOutcome is the outcome of the evidence submission.
+type EvidenceAuditOutcome string
+
+// EvidenceAuditOutcome values.
+const (
+	EvidenceAuditOutcomeSuccess         EvidenceAuditOutcome = "success"
+	EvidenceAuditOutcomeAlreadyProvided EvidenceAuditOutcome = "already-provided"
+	EvidenceAuditOutcomeError           EvidenceAuditOutcome = "error"
+)
+
+// Evidence types written to the audit log.
+const (
+	EvidenceAuditTypeXRPLToCoreumTransfer       = "xrpl_to_coreum_transfer"
+	EvidenceAuditTypeTicketsAllocationResult    = "tickets_allocation_result"
+	EvidenceAuditTypeTrustSetResult             = "trust_set_result"
+	EvidenceAuditTypeCoreumToXRPLTransferResult = "coreum_to_xrpl_transfer_result"
+	EvidenceAuditTypeKeysRotationResult         = "keys_rotation_result"
+	EvidenceAuditTypeXRPLNFTokenTransfer        = "xrpl_nftoken_transfer"
+	EvidenceAuditTypeNFTokenTransferResult      = "nftoken_transfer_result"
+	EvidenceAuditTypeXRPLCheckCash              = "xrpl_check_cash"
+	EvidenceAuditTypeCheckCashResult            = "check_cash_result"
+	EvidenceAuditTypeIBCTransferResult          = "ibc_transfer_result"
+)
+
+const (
+	defaultEvidenceAuditLogMaxSizeBytes = 100 * 1024 * 1024
+	defaultEvidenceAuditLogMaxBackups   = 5
+)
+
+// EvidenceAuditRecord is the audit log record of the evidence submission.
+type EvidenceAuditRecord struct {
+	Timestamp            time.Time            `json:"timestamp"`
+	RelayerCoreumAddress string               `json:"relayer_coreum_address"`
+	EvidenceType         string               `json:"evidence_type"`
+	TxHash               string               `json:"tx_hash"`
+	Issuer               string               `json:"issuer,omitempty"`
+	Currency             string               `json:"currency,omitempty"`
+	Amount               string               `json:"amount,omitempty"`
+	Recipient            string               `json:"recipient,omitempty"`
+	Outcome              EvidenceAuditOutcome `json:"outcome"`
+	Error                string               `json:"error,omitempty"`
+	CoreumTxHash         string               `json:"coreum_tx_hash,omitempty"`
+}
+
+// EvidenceAuditLogConfig is the EvidenceAuditLog config.
+type EvidenceAuditLogConfig struct {
+	// Path is the audit log file path, the empty path disables the audit log.
+	Path string
+	// MaxSizeBytes is the file size after which the file is rotated.
+	MaxSizeBytes int64
+	// MaxBackups is the number of the rotated files to retain.
+	MaxBackups int
+}
+
+// DefaultEvidenceAuditLogConfig returns the default EvidenceAuditLogConfig.
+func DefaultEvidenceAuditLogConfig(path string) EvidenceAuditLogConfig {
+	return EvidenceAuditLogConfig{
+		Path:         path,
+		MaxSizeBytes: defaultEvidenceAuditLogMaxSizeBytes,
+		MaxBackups:   defaultEvidenceAuditLogMaxBackups,
+	}
+}
+
+// EvidenceAuditLog writes the evidence submissions to the JSON Lines file. The file is rotated once it reaches the max
+// size, the rotated files are named with the ".1", ".2", etc. suffix, where ".1" is the latest one.
+type EvidenceAuditLog struct {
+	cfg    EvidenceAuditLogConfig
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewEvidenceAuditLog returns a new instance of the EvidenceAuditLog.
+func NewEvidenceAuditLog(cfg EvidenceAuditLogConfig) (*EvidenceAuditLog, error) {
+	if cfg.Path != "" {
+		if cfg.MaxSizeBytes <= 0 {
+			return nil, errors.Errorf("audit log max size must be positive, got: %d", cfg.MaxSizeBytes)
+		}
+		if cfg.MaxBackups < 0 {
+			return nil, errors.Errorf("audit log max backups must not be negative, got: %d", cfg.MaxBackups)
+		}
+	}
+
+	return &EvidenceAuditLog{
+		cfg: cfg,
+	}, nil
+}
+
+// LogEvidence appends the record to the audit log file.
+func (l *EvidenceAuditLog) LogEvidence(_ context.Context, record EvidenceAuditRecord) error {
+	if l.cfg.Path == "" {
+		return nil
+	}
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal evidence audit record")
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return errors.Errorf("audit log is closed, path:%s", l.cfg.Path)
+	}
+	if err := l.rotateIfRequired(int64(len(recordBytes))); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.cfg.Path), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create audit log dir, path:%s", l.cfg.Path)
+	}
+	file, err := os.OpenFile(l.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open audit log file, path:%s", l.cfg.Path)
+	}
+	if _, err := file.Write(recordBytes); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write audit log file, path:%s", l.cfg.Path)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close audit log file, path:%s", l.cfg.Path)
+}
+
+// Close waits for the record being written and rejects the next records. Each record is written to the file before
+// the LogEvidence returns, so nothing is lost on the shutdown after the Close.
+func (l *EvidenceAuditLog) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.closed = true
+}
+
+func (l *EvidenceAuditLog) rotateIfRequired(recordSize int64) error {
+	fileInfo, err := os.Stat(l.cfg.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get audit log file info, path:%s", l.cfg.Path)
+	}
+	// the empty file is never rotated, so the record bigger than the max size is still written
+	if fileInfo.Size() == 0 || fileInfo.Size()+recordSize <= l.cfg.MaxSizeBytes {
+		return nil
+	}
+
+	if l.cfg.MaxBackups == 0 {
+		return errors.Wrapf(os.Remove(l.cfg.Path), "failed to remove audit log file, path:%s", l.cfg.Path)
+	}
+	// the oldest backup is replaced by the next one
+	for i := l.cfg.MaxBackups - 1; i > 0; i-- {
+		if err := os.Rename(l.backupPath(i), l.backupPath(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Wrapf(err, "failed to rotate audit log backup file, path:%s", l.backupPath(i))
+		}
+	}
+
+	return errors.Wrapf(
+		os.Rename(l.cfg.Path, l.backupPath(1)), "failed to rotate audit log file, path:%s", l.cfg.Path,
+	)
+}
+
+func (l *EvidenceAuditLog) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", l.cfg.Path, index)
+}
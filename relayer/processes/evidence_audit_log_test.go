@@ -0,0 +1,162 @@
+package processes_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestEvidenceAuditLog_LogEvidence(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	auditLogPath := filepath.Join(t.TempDir(), "audit", "evidences.jsonl")
+	auditLog, err := processes.NewEvidenceAuditLog(processes.DefaultEvidenceAuditLogConfig(auditLogPath))
+	require.NoError(t, err)
+
+	records := []processes.EvidenceAuditRecord{
+		{
+			Timestamp:            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			RelayerCoreumAddress: "core1relayer",
+			EvidenceType:         processes.EvidenceAuditTypeXRPLToCoreumTransfer,
+			TxHash:               "A1",
+			Issuer:               "rIssuer",
+			Currency:             "USD",
+			Amount:               "100",
+			Recipient:            "core1recipient",
+			Outcome:              processes.EvidenceAuditOutcomeSuccess,
+			CoreumTxHash:         "B1",
+		},
+		{
+			Timestamp:            time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC),
+			RelayerCoreumAddress: "core1relayer",
+			EvidenceType:         processes.EvidenceAuditTypeTrustSetResult,
+			TxHash:               "A2",
+			Outcome:              processes.EvidenceAuditOutcomeError,
+			Error:                "failed",
+		},
+	}
+	for _, record := range records {
+		require.NoError(t, auditLog.LogEvidence(ctx, record))
+	}
+
+	require.Equal(t, records, readEvidenceAuditRecords(t, auditLogPath))
+
+	fileBytes, err := os.ReadFile(auditLogPath)
+	require.NoError(t, err)
+	require.Contains(
+		t,
+		string(fileBytes),
+		`{"timestamp":"2024-01-01T00:00:01Z","relayer_coreum_address":"core1relayer",`+
+			`"evidence_type":"trust_set_result","tx_hash":"A2","outcome":"error","error":"failed"}`+"\n",
+	)
+
+	// the timestamp is set if not provided
+	require.NoError(t, auditLog.LogEvidence(ctx, processes.EvidenceAuditRecord{
+		Outcome: processes.EvidenceAuditOutcomeAlreadyProvided,
+	}))
+	writtenRecords := readEvidenceAuditRecords(t, auditLogPath)
+	require.Len(t, writtenRecords, 3)
+	require.False(t, writtenRecords[2].Timestamp.IsZero())
+}
+
+func TestEvidenceAuditLog_Rotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	auditLogPath := filepath.Join(t.TempDir(), "evidences.jsonl")
+
+	record := processes.EvidenceAuditRecord{
+		Timestamp: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		TxHash:    "A1",
+		Outcome:   processes.EvidenceAuditOutcomeSuccess,
+	}
+	recordBytes, err := json.Marshal(record)
+	require.NoError(t, err)
+	recordSize := int64(len(recordBytes) + 1)
+
+	// two records fit the file
+	auditLog, err := processes.NewEvidenceAuditLog(processes.EvidenceAuditLogConfig{
+		Path:         auditLogPath,
+		MaxSizeBytes: 2 * recordSize,
+		MaxBackups:   2,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, auditLog.LogEvidence(ctx, record))
+	}
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath), 2)
+	require.NoFileExists(t, auditLogPath+".1")
+
+	// the third record triggers the rotation
+	require.NoError(t, auditLog.LogEvidence(ctx, record))
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath), 1)
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath+".1"), 2)
+
+	// fill the file and rotate two more times, only two backups are retained
+	for i := 0; i < 4; i++ {
+		require.NoError(t, auditLog.LogEvidence(ctx, record))
+	}
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath), 1)
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath+".1"), 2)
+	require.Len(t, readEvidenceAuditRecords(t, auditLogPath+".2"), 2)
+	require.NoFileExists(t, auditLogPath+".3")
+}
+
+func TestEvidenceAuditLog_Disabled(t *testing.T) {
+	t.Parallel()
+
+	auditLog, err := processes.NewEvidenceAuditLog(processes.EvidenceAuditLogConfig{})
+	require.NoError(t, err)
+	require.NoError(t, auditLog.LogEvidence(context.Background(), processes.EvidenceAuditRecord{}))
+
+	_, err = processes.NewEvidenceAuditLog(processes.EvidenceAuditLogConfig{
+		Path: filepath.Join(t.TempDir(), "evidences.jsonl"),
+	})
+	require.ErrorContains(t, err, "audit log max size must be positive")
+}
+
+func TestEvidenceAuditLog_Close(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	auditLogPath := filepath.Join(t.TempDir(), "evidences.jsonl")
+	auditLog, err := processes.NewEvidenceAuditLog(processes.DefaultEvidenceAuditLogConfig(auditLogPath))
+	require.NoError(t, err)
+
+	require.NoError(t, auditLog.LogEvidence(ctx, processes.EvidenceAuditRecord{TxHash: "A1"}))
+	auditLog.Close()
+	require.ErrorContains(t, auditLog.LogEvidence(ctx, processes.EvidenceAuditRecord{TxHash: "A2"}), "audit log is closed")
+
+	records := readEvidenceAuditRecords(t, auditLogPath)
+	require.Len(t, records, 1)
+	require.Equal(t, "A1", records[0].TxHash)
+}
+
+func readEvidenceAuditRecords(t *testing.T, path string) []processes.EvidenceAuditRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	records := make([]processes.EvidenceAuditRecord, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record processes.EvidenceAuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
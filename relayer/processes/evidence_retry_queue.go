@@ -0,0 +1,312 @@
+//nolint:tagliatelle // json lines spec
+package processes
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// EvidenceDeadLetterLogFileName is the name of the dead-letter log file of the evidences which retries are exhausted,
+// stored in the relayer home.
+const EvidenceDeadLetterLogFileName = "evidence-dead-letter.log"
+
+// EvidenceDeadLetterRecord is the dead-letter log record of the XRPL tx which evidence submission is failed after all
+// retries.
+type EvidenceDeadLetterRecord struct {
+	Timestamp time.Time                          `json:"timestamp"`
+	TxHash    string                             `json:"tx_hash"`
+	Attempts  uint32                             `json:"attempts"`
+	Error     string                             `json:"error"`
+	Tx        rippledata.TransactionWithMetaData `json:"tx"`
+}
+
+// EvidenceRetryQueueConfig is the EvidenceRetryQueue config.
+type EvidenceRetryQueueConfig struct {
+	// InitialDelay is the delay before the first retry, the delay is doubled with each next retry.
+	InitialDelay time.Duration
+	// MaxDelay is the max delay between the retries, the jitter is added on top of it.
+	MaxDelay time.Duration
+	// MaxJitterRatio is the max part of the delay added to it randomly, to not retry the txs failed together at the
+	// same time.
+	MaxJitterRatio float64
+	// MaxRetries is the number of the retries after which the tx is written to the dead-letter log.
+	MaxRetries uint32
+	// DeadLetterLogFilePath is the path of the dead-letter log, the empty path disables the log.
+	DeadLetterLogFilePath string
+}
+
+// DefaultEvidenceRetryQueueConfig returns the default EvidenceRetryQueueConfig.
+func DefaultEvidenceRetryQueueConfig(deadLetterLogFilePath string) EvidenceRetryQueueConfig {
+	return EvidenceRetryQueueConfig{
+		InitialDelay:          time.Second,
+		MaxDelay:              time.Minute,
+		MaxJitterRatio:        0.2,
+		MaxRetries:            10,
+		DeadLetterLogFilePath: deadLetterLogFilePath,
+	}
+}
+
+type evidenceRetryItem struct {
+	tx            rippledata.TransactionWithMetaData
+	txHash        string
+	attempts      uint32
+	nextAttemptAt time.Time
+	scheduled     bool
+}
+
+// EvidenceRetryQueue keeps the XRPL txs which evidence submission is failed with the unexpected error and returns them
+// back to the processing with the exponential back-off.
+type EvidenceRetryQueue struct {
+	cfg    EvidenceRetryQueueConfig
+	log    logger.Logger
+	clock  func() time.Time
+	jitter func(n int64) int64
+
+	mu sync.Mutex
+	// items are all txs which are being retried, including the ones returned to the processing
+	items map[string]*evidenceRetryItem
+	// scheduled are the txs waiting for the retry, ordered by the next attempt time
+	scheduled []*evidenceRetryItem
+	notifyCh  chan struct{}
+}
+
+// NewEvidenceRetryQueue returns a new instance of the EvidenceRetryQueue. The jitter returns the random value in the
+// [0, n) range, if it's nil the math/rand is used.
+func NewEvidenceRetryQueue(
+	cfg EvidenceRetryQueueConfig,
+	log logger.Logger,
+	clock func() time.Time,
+	jitter func(n int64) int64,
+) (*EvidenceRetryQueue, error) {
+	if cfg.InitialDelay <= 0 {
+		return nil, errors.Errorf("evidence retry initial delay must be positive, got: %s", cfg.InitialDelay)
+	}
+	if cfg.MaxDelay < cfg.InitialDelay {
+		return nil, errors.Errorf(
+			"evidence retry max delay must not be less than initial delay, got: %s < %s",
+			cfg.MaxDelay, cfg.InitialDelay,
+		)
+	}
+	if cfg.MaxJitterRatio < 0 || cfg.MaxJitterRatio > 1 {
+		return nil, errors.Errorf("evidence retry max jitter ratio must be in [0, 1], got: %f", cfg.MaxJitterRatio)
+	}
+	if jitter == nil {
+		jitter = rand.Int63n //nolint:gosec // the jitter doesn't require the secure random
+	}
+
+	return &EvidenceRetryQueue{
+		cfg:      cfg,
+		log:      log,
+		clock:    clock,
+		jitter:   jitter,
+		items:    make(map[string]*evidenceRetryItem),
+		notifyCh: make(chan struct{}, 1),
+	}, nil
+}
+
+// Start sends the txs to the retryCh once their retry delay is passed.
+func (q *EvidenceRetryQueue) Start(ctx context.Context, retryCh chan<- rippledata.TransactionWithMetaData) error {
+	for {
+		for _, tx := range q.PopDue() {
+			select {
+			case <-ctx.Done():
+				return errors.WithStack(ctx.Err())
+			case retryCh <- tx:
+			}
+		}
+		timer := time.NewTimer(q.nextAttemptDelay())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.WithStack(ctx.Err())
+		case <-q.notifyCh:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Push schedules the retry of the tx which processing is failed. Once the tx is failed more than the max retries
+// times, it's removed from the queue and written to the dead-letter log.
+func (q *EvidenceRetryQueue) Push(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+	processingErr error,
+) error {
+	txHash := strings.ToUpper(tx.GetHash().String())
+
+	q.mu.Lock()
+	item, ok := q.items[txHash]
+	if !ok {
+		item = &evidenceRetryItem{
+			tx:     tx,
+			txHash: txHash,
+		}
+		q.items[txHash] = item
+	}
+	if item.scheduled {
+		// the tx is received from the scanner again while it's waiting for the retry
+		q.mu.Unlock()
+		return nil
+	}
+	if item.attempts >= q.cfg.MaxRetries {
+		delete(q.items, txHash)
+		q.mu.Unlock()
+		q.log.Error(
+			ctx,
+			"Evidence retries are exhausted, the XRPL tx is written to the dead-letter log",
+			zap.String("txHash", txHash),
+			zap.Uint32("attempts", item.attempts),
+			zap.Error(processingErr),
+		)
+		return q.writeDeadLetter(EvidenceDeadLetterRecord{
+			Timestamp: q.clock().UTC(),
+			TxHash:    txHash,
+			Attempts:  item.attempts,
+			Error:     processingErr.Error(),
+			Tx:        tx,
+		})
+	}
+	delay := q.backOffDelay(item.attempts)
+	item.attempts++
+	item.nextAttemptAt = q.clock().Add(delay)
+	item.scheduled = true
+	q.schedule(item)
+	q.mu.Unlock()
+
+	q.log.Warn(
+		ctx,
+		"Failed to submit the XRPL tx evidence, the tx is scheduled for the retry",
+		zap.String("txHash", txHash),
+		zap.Uint32("attempt", item.attempts),
+		zap.String("delay", delay.String()),
+		zap.String("error", processingErr.Error()),
+	)
+	select {
+	case q.notifyCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Complete removes the tx from the queue once its retry is processed successfully.
+func (q *EvidenceRetryQueue) Complete(tx rippledata.TransactionWithMetaData) {
+	txHash := strings.ToUpper(tx.GetHash().String())
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item, ok := q.items[txHash]; ok && !item.scheduled {
+		delete(q.items, txHash)
+	}
+}
+
+// PopDue returns the txs which retry delay is passed, in the order of their next attempt time.
+func (q *EvidenceRetryQueue) PopDue() []rippledata.TransactionWithMetaData {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.clock()
+	dueCount := sort.Search(len(q.scheduled), func(i int) bool {
+		return q.scheduled[i].nextAttemptAt.After(now)
+	})
+	txs := make([]rippledata.TransactionWithMetaData, 0, dueCount)
+	for _, item := range q.scheduled[:dueCount] {
+		item.scheduled = false
+		txs = append(txs, item.tx)
+	}
+	q.scheduled = q.scheduled[dueCount:]
+
+	return txs
+}
+
+// Len returns the number of the txs being retried.
+func (q *EvidenceRetryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.items)
+}
+
+func (q *EvidenceRetryQueue) schedule(item *evidenceRetryItem) {
+	// the items with the same time keep the push order
+	index := sort.Search(len(q.scheduled), func(i int) bool {
+		return q.scheduled[i].nextAttemptAt.After(item.nextAttemptAt)
+	})
+	q.scheduled = append(q.scheduled, nil)
+	copy(q.scheduled[index+1:], q.scheduled[index:])
+	q.scheduled[index] = item
+}
+
+func (q *EvidenceRetryQueue) nextAttemptDelay() time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.scheduled) == 0 {
+		return q.cfg.MaxDelay
+	}
+	delay := q.scheduled[0].nextAttemptAt.Sub(q.clock())
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
+
+// backOffDelay returns the delay of the retry with the provided number of the previous retries.
+func (q *EvidenceRetryQueue) backOffDelay(retries uint32) time.Duration {
+	delay := q.cfg.MaxDelay
+	// the shift is limited to not overflow the duration
+	if retries < 32 && q.cfg.InitialDelay<<retries < q.cfg.MaxDelay {
+		delay = q.cfg.InitialDelay << retries
+	}
+	maxJitter := int64(float64(delay) * q.cfg.MaxJitterRatio)
+	if maxJitter > 0 {
+		delay += time.Duration(q.jitter(maxJitter))
+	}
+
+	return delay
+}
+
+func (q *EvidenceRetryQueue) writeDeadLetter(record EvidenceDeadLetterRecord) error {
+	if q.cfg.DeadLetterLogFilePath == "" {
+		return nil
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal evidence dead-letter record")
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	filePath := q.cfg.DeadLetterLogFilePath
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create evidence dead-letter log dir, path:%s", filePath)
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open evidence dead-letter log file, path:%s", filePath)
+	}
+	if _, err := file.Write(recordBytes); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write evidence dead-letter log file, path:%s", filePath)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close evidence dead-letter log file, path:%s", filePath)
+}
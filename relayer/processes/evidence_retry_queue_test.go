@@ -0,0 +1,212 @@
+package processes_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestEvidenceRetryQueue_OrderingAndBackOff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+	cfg := processes.DefaultEvidenceRetryQueueConfig("")
+	cfg.MaxRetries = 100
+	// the max jitter is added to have the deterministic delays
+	queue, err := processes.NewEvidenceRetryQueue(cfg, logger.NewAnyLogMock(ctrl), clock, func(n int64) int64 {
+		return n - 1
+	})
+	require.NoError(t, err)
+
+	txA := genEvidenceRetryTx(t, 1)
+	txB := genEvidenceRetryTx(t, 2)
+	txC := genEvidenceRetryTx(t, 3)
+	submissionErr := errors.New("submission error")
+
+	require.NoError(t, queue.Push(ctx, txA, submissionErr))
+	now = now.Add(100 * time.Millisecond)
+	require.NoError(t, queue.Push(ctx, txB, submissionErr))
+	require.NoError(t, queue.Push(ctx, txC, submissionErr))
+	// the tx waiting for the retry isn't re-scheduled
+	require.NoError(t, queue.Push(ctx, txA, submissionErr))
+	require.Equal(t, 3, queue.Len())
+
+	require.Empty(t, queue.PopDue())
+	// the initial delay with the max jitter
+	now = now.Add(time.Second + 100*time.Millisecond - time.Nanosecond)
+	requireTxHashes(t, []rippledata.TransactionWithMetaData{txA}, queue.PopDue())
+	now = now.Add(100 * time.Millisecond)
+	// the txs with the same attempt time are returned in the push order
+	requireTxHashes(t, []rippledata.TransactionWithMetaData{txB, txC}, queue.PopDue())
+	require.Empty(t, queue.PopDue())
+
+	// the delay is doubled with each retry
+	expectedDelays := []time.Duration{
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		16 * time.Second,
+		32 * time.Second,
+		time.Minute,
+		time.Minute,
+	}
+	for _, expectedDelay := range expectedDelays {
+		require.NoError(t, queue.Push(ctx, txA, submissionErr))
+		expectedDelay += expectedDelay/5 - time.Nanosecond
+		now = now.Add(expectedDelay - time.Nanosecond)
+		require.Empty(t, queue.PopDue(), expectedDelay.String())
+		now = now.Add(time.Nanosecond)
+		requireTxHashes(t, []rippledata.TransactionWithMetaData{txA}, queue.PopDue())
+	}
+
+	// the retried txs are removed once they are processed
+	queue.Complete(txA)
+	queue.Complete(txB)
+	require.Equal(t, 1, queue.Len())
+	// the tx which is scheduled again isn't removed
+	require.NoError(t, queue.Push(ctx, txC, submissionErr))
+	queue.Complete(txC)
+	require.Equal(t, 1, queue.Len())
+}
+
+func TestEvidenceRetryQueue_MaxRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	deadLetterLogFilePath := filepath.Join(t.TempDir(), processes.EvidenceDeadLetterLogFileName)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+	cfg := processes.DefaultEvidenceRetryQueueConfig(deadLetterLogFilePath)
+	cfg.MaxRetries = 3
+
+	logMock := logger.NewMockLogger(ctrl)
+	logMock.EXPECT().Error(gomock.Any(), "Evidence retries are exhausted, the XRPL tx is written to the dead-letter log",
+		gomock.Any()).Times(1)
+	logMock.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	queue, err := processes.NewEvidenceRetryQueue(cfg, logMock, clock, nil)
+	require.NoError(t, err)
+
+	tx := genEvidenceRetryTx(t, 1)
+	for i := 0; i < int(cfg.MaxRetries); i++ {
+		require.NoError(t, queue.Push(ctx, tx, errors.New("submission error")))
+		now = now.Add(cfg.MaxDelay * 2)
+		requireTxHashes(t, []rippledata.TransactionWithMetaData{tx}, queue.PopDue())
+	}
+	_, err = os.Stat(deadLetterLogFilePath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, queue.Push(ctx, tx, errors.New("last submission error")))
+	require.Zero(t, queue.Len())
+	now = now.Add(cfg.MaxDelay * 2)
+	require.Empty(t, queue.PopDue())
+
+	deadLetterFile, err := os.Open(deadLetterLogFilePath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, deadLetterFile.Close())
+	})
+	records := make([]processes.EvidenceDeadLetterRecord, 0)
+	scanner := bufio.NewScanner(deadLetterFile)
+	for scanner.Scan() {
+		var record processes.EvidenceDeadLetterRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 1)
+	require.Equal(t, strings.ToUpper(tx.GetHash().String()), records[0].TxHash)
+	require.Equal(t, cfg.MaxRetries, records[0].Attempts)
+	require.Equal(t, "last submission error", records[0].Error)
+	require.Equal(t, tx.GetHash().String(), records[0].Tx.GetHash().String())
+}
+
+func TestEvidenceRetryQueue_Start(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctrl := gomock.NewController(t)
+
+	cfg := processes.DefaultEvidenceRetryQueueConfig("")
+	cfg.InitialDelay = 10 * time.Millisecond
+	queue, err := processes.NewEvidenceRetryQueue(cfg, logger.NewAnyLogMock(ctrl), time.Now, nil)
+	require.NoError(t, err)
+
+	retryCh := make(chan rippledata.TransactionWithMetaData)
+	startErrCh := make(chan error, 1)
+	go func() {
+		startErrCh <- queue.Start(ctx, retryCh)
+	}()
+
+	tx := genEvidenceRetryTx(t, 1)
+	// the tx is pushed after the start to check that the queue doesn't wait for the max delay
+	require.NoError(t, queue.Push(ctx, tx, errors.New("submission error")))
+	select {
+	case retriedTx := <-retryCh:
+		requireTxHashes(t, []rippledata.TransactionWithMetaData{tx}, []rippledata.TransactionWithMetaData{retriedTx})
+	case <-time.After(5 * time.Second):
+		t.Fatal("tx isn't retried")
+	}
+
+	cancel()
+	require.ErrorIs(t, <-startErrCh, context.Canceled)
+}
+
+func genEvidenceRetryTx(t *testing.T, index byte) rippledata.TransactionWithMetaData {
+	t.Helper()
+
+	var hash rippledata.Hash256
+	hash[0] = index
+	amount, err := rippledata.NewAmount("100")
+	require.NoError(t, err)
+	return rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			TxBase: rippledata.TxBase{
+				TransactionType: rippledata.PAYMENT,
+				Account:         xrpl.GenPrivKeyTxSigner().Account(),
+				Hash:            hash,
+			},
+			Destination: xrpl.GenPrivKeyTxSigner().Account(),
+			Amount:      *amount,
+		},
+	}
+}
+
+func requireTxHashes(t *testing.T, expected, actual []rippledata.TransactionWithMetaData) {
+	t.Helper()
+
+	expectedHashes := make([]string, 0, len(expected))
+	for _, tx := range expected {
+		expectedHashes = append(expectedHashes, tx.GetHash().String())
+	}
+	actualHashes := make([]string, 0, len(actual))
+	for _, tx := range actual {
+		actualHashes = append(actualHashes, tx.GetHash().String())
+	}
+	require.Equal(t, expectedHashes, actualHashes)
+}
@@ -0,0 +1,186 @@
+package processes
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// IBCTransferTrackerConfig is the IBCTransferTracker config.
+type IBCTransferTrackerConfig struct {
+	RelayerCoreumAddress sdk.AccAddress
+	// PollInterval is the interval between the checks of the pending IBC transfers.
+	PollInterval time.Duration
+}
+
+// DefaultIBCTransferTrackerConfig returns the default IBCTransferTrackerConfig.
+func DefaultIBCTransferTrackerConfig(relayerCoreumAddress sdk.AccAddress) IBCTransferTrackerConfig {
+	return IBCTransferTrackerConfig{
+		RelayerCoreumAddress: relayerCoreumAddress,
+		PollInterval:         30 * time.Second,
+	}
+}
+
+// IBCTransferTracker periodically checks the IBC transfers sent by the contract for the tokens with the IBC delivery
+// mode and sends the evidences of their results. The contract stores the tokens of the failed or timed out transfers
+// as the pending refunds of the Coreum recipients. The tracking runs apart from the XRPL txs processing, so the IBC
+// acknowledgements never delay the XRPL scan.
+type IBCTransferTracker struct {
+	cfg            IBCTransferTrackerConfig
+	log            logger.Logger
+	contractClient IBCTransferTrackerContractClient
+	auditLogger    EvidenceAuditLogger
+}
+
+// NewIBCTransferTracker returns a new instance of the IBCTransferTracker.
+func NewIBCTransferTracker(
+	cfg IBCTransferTrackerConfig,
+	log logger.Logger,
+	contractClient IBCTransferTrackerContractClient,
+	auditLogger EvidenceAuditLogger,
+) (*IBCTransferTracker, error) {
+	if cfg.RelayerCoreumAddress.Empty() {
+		return nil, errors.New("relayer address is nil or empty")
+	}
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("IBC transfer tracker poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+
+	return &IBCTransferTracker{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		auditLogger:    auditLogger,
+	}, nil
+}
+
+// Start starts the periodic tracking of the pending IBC transfers.
+func (t *IBCTransferTracker) Start(ctx context.Context) error {
+	for {
+		if err := t.TrackPending(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			t.log.Error(ctx, "Failed to track pending IBC transfers", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(t.cfg.PollInterval):
+		}
+	}
+}
+
+// TrackPending sends the result evidences of the pending IBC transfers which are acknowledged or timed out.
+func (t *IBCTransferTracker) TrackPending(ctx context.Context) error {
+	pendingTransfers, err := t.contractClient.GetPendingIBCTransfers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pendingTransfer := range pendingTransfers {
+		if err := t.trackTransfer(ctx, pendingTransfer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *IBCTransferTracker) trackTransfer(ctx context.Context, pendingTransfer coreum.PendingIBCTransfer) error {
+	packet, err := t.contractClient.GetIBCTransferPacket(ctx, pendingTransfer.TxHash)
+	if err != nil {
+		return err
+	}
+	if packet == nil {
+		t.log.Debug(ctx, "IBC transfer evidence tx isn't indexed yet", zap.String("txHash", pendingTransfer.TxHash))
+		return nil
+	}
+	status, err := t.contractClient.GetIBCPacketStatus(ctx, *packet)
+	if err != nil {
+		return err
+	}
+	if status == coreum.IBCPacketStatusPending {
+		t.log.Debug(
+			ctx,
+			"IBC transfer is pending",
+			zap.String("txHash", pendingTransfer.TxHash),
+			zap.Any("packet", packet),
+		)
+		return nil
+	}
+
+	evidence := coreum.IBCTransferResultEvidence{
+		TxHash:  pendingTransfer.TxHash,
+		Success: status == coreum.IBCPacketStatusAcknowledged,
+	}
+	txRes, err := t.contractClient.SendIBCTransferResultEvidence(ctx, t.cfg.RelayerCoreumAddress, evidence)
+	t.logEvidenceAudit(ctx, pendingTransfer, txRes, err)
+	switch {
+	case err == nil:
+		t.log.Info(
+			ctx,
+			"Successfully sent IBC transfer result evidence",
+			zap.String("txHash", pendingTransfer.TxHash),
+			zap.String("status", string(status)),
+			zap.String("destinationChainRecipient", pendingTransfer.DestinationChainRecipient),
+		)
+		return nil
+	case IsExpectedEvidenceSubmissionError(err) || coreum.IsPendingIBCTransferNotFoundError(err):
+		// the result is confirmed by other relayers
+		t.log.Debug(
+			ctx,
+			"Received expected IBC transfer result evidence error",
+			zap.String("txHash", pendingTransfer.TxHash),
+			zap.String("reason", err.Error()),
+		)
+		return nil
+	case errors.Is(err, context.Canceled):
+		return err
+	default:
+		// the evidence is resent on the next check
+		t.log.Warn(
+			ctx,
+			"Failed to send IBC transfer result evidence",
+			zap.String("txHash", pendingTransfer.TxHash),
+			zap.Error(err),
+		)
+		return nil
+	}
+}
+
+func (t *IBCTransferTracker) logEvidenceAudit(
+	ctx context.Context,
+	pendingTransfer coreum.PendingIBCTransfer,
+	txRes *sdk.TxResponse,
+	err error,
+) {
+	record := EvidenceAuditRecord{
+		RelayerCoreumAddress: t.cfg.RelayerCoreumAddress.String(),
+		EvidenceType:         EvidenceAuditTypeIBCTransferResult,
+		TxHash:               pendingTransfer.TxHash,
+		Amount:               pendingTransfer.Coin.Amount.String(),
+		Recipient:            pendingTransfer.Recipient.String(),
+	}
+	switch {
+	case err == nil:
+		record.Outcome = EvidenceAuditOutcomeSuccess
+	case coreum.IsEvidenceAlreadyProvidedError(err):
+		record.Outcome = EvidenceAuditOutcomeAlreadyProvided
+	default:
+		record.Outcome = EvidenceAuditOutcomeError
+		record.Error = err.Error()
+	}
+	if txRes != nil {
+		record.CoreumTxHash = txRes.TxHash
+	}
+	// the audit log failure doesn't affect the evidence processing
+	if logErr := t.auditLogger.LogEvidence(ctx, record); logErr != nil {
+		t.log.Warn(ctx, "Failed to write evidence audit record", zap.Error(logErr), zap.Any("record", record))
+	}
+}
@@ -0,0 +1,98 @@
+package processes_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestIBCTransferTracker_TrackPending(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	relayerAddress := coreum.GenAccount()
+	newPendingTransfer := func(txHash string) coreum.PendingIBCTransfer {
+		return coreum.PendingIBCTransfer{
+			TxHash:                    txHash,
+			Recipient:                 coreum.GenAccount(),
+			IBCChannelID:              "channel-0",
+			DestinationChainRecipient: "osmo1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			Coin:                      sdk.NewCoin("ucore", sdkmath.NewInt(100)),
+		}
+	}
+	notIndexedTransfer := newPendingTransfer("0A")
+	pendingTransfer := newPendingTransfer("0B")
+	acknowledgedTransfer := newPendingTransfer("0C")
+	timedOutTransfer := newPendingTransfer("0D")
+	confirmedTransfer := newPendingTransfer("0E")
+	newPacket := func(sequence uint64) *coreum.IBCPacket {
+		return &coreum.IBCPacket{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Sequence:      sequence,
+		}
+	}
+
+	contractClientMock := NewMockIBCTransferTrackerContractClient(ctrl)
+	auditLoggerMock := NewMockEvidenceAuditLogger(ctrl)
+	auditLoggerMock.EXPECT().LogEvidence(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ibcTransferTracker, err := processes.NewIBCTransferTracker(
+		processes.DefaultIBCTransferTrackerConfig(relayerAddress),
+		logger.NewAnyLogMock(ctrl),
+		contractClientMock,
+		auditLoggerMock,
+	)
+	require.NoError(t, err)
+
+	contractClientMock.EXPECT().GetPendingIBCTransfers(gomock.Any()).Return([]coreum.PendingIBCTransfer{
+		notIndexedTransfer, pendingTransfer, acknowledgedTransfer, timedOutTransfer, confirmedTransfer,
+	}, nil)
+	contractClientMock.EXPECT().GetIBCTransferPacket(gomock.Any(), notIndexedTransfer.TxHash).Return(nil, nil)
+	for i, transfer := range []coreum.PendingIBCTransfer{
+		pendingTransfer, acknowledgedTransfer, timedOutTransfer, confirmedTransfer,
+	} {
+		contractClientMock.EXPECT().GetIBCTransferPacket(gomock.Any(), transfer.TxHash).Return(newPacket(uint64(i)), nil)
+	}
+	contractClientMock.EXPECT().GetIBCPacketStatus(gomock.Any(), *newPacket(0)).
+		Return(coreum.IBCPacketStatusPending, nil)
+	contractClientMock.EXPECT().GetIBCPacketStatus(gomock.Any(), *newPacket(1)).
+		Return(coreum.IBCPacketStatusAcknowledged, nil)
+	contractClientMock.EXPECT().GetIBCPacketStatus(gomock.Any(), *newPacket(2)).
+		Return(coreum.IBCPacketStatusTimedOut, nil)
+	contractClientMock.EXPECT().GetIBCPacketStatus(gomock.Any(), *newPacket(3)).
+		Return(coreum.IBCPacketStatusFailed, nil)
+	contractClientMock.EXPECT().SendIBCTransferResultEvidence(gomock.Any(), relayerAddress,
+		coreum.IBCTransferResultEvidence{
+			TxHash:  acknowledgedTransfer.TxHash,
+			Success: true,
+		}).Return(&sdk.TxResponse{}, nil)
+	// the failed evidence is resent on the next check
+	contractClientMock.EXPECT().SendIBCTransferResultEvidence(gomock.Any(), relayerAddress,
+		coreum.IBCTransferResultEvidence{
+			TxHash:  timedOutTransfer.TxHash,
+			Success: false,
+		}).Return(nil, errors.New("node is unavailable"))
+	// the result is confirmed by other relayers
+	contractClientMock.EXPECT().SendIBCTransferResultEvidence(gomock.Any(), relayerAddress,
+		coreum.IBCTransferResultEvidence{
+			TxHash:  confirmedTransfer.TxHash,
+			Success: false,
+		}).Return(nil, errors.New("PendingIBCTransferNotFound: There is no pending IBC transfer"))
+	require.NoError(t, ibcTransferTracker.TrackPending(ctx))
+
+	// the pending transfers query failure is returned
+	contractClientMock.EXPECT().GetPendingIBCTransfers(gomock.Any()).Return(nil, errors.New("node is unavailable"))
+	require.ErrorContains(t, ibcTransferTracker.TrackPending(ctx), "node is unavailable")
+}
@@ -0,0 +1,306 @@
+//nolint:tagliatelle // json lines spec
+package processes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// KeyUsageAuditLogFileName is the name of the signing keys usage audit log stored in the relayer home.
+const KeyUsageAuditLogFileName = "key-usage-audit.jsonl"
+
+// KeyUsageAction is the action performed with the relayer key.
+type KeyUsageAction string
+
+// KeyUsageAction values.
+const (
+	KeyUsageActionXRPLSign        KeyUsageAction = "xrpl_sign"
+	KeyUsageActionCoreumBroadcast KeyUsageAction = "coreum_broadcast"
+)
+
+// keyUsageAuditGenesisHash is the previous hash of the first entry of the log.
+var keyUsageAuditGenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// KeyUsageAuditEntry is the entry of the key usage audit log. The hash of the entry is computed from the entry
+// fields and the hash of the previous entry, so any modification, removal or reordering of the entries breaks the
+// chain.
+type KeyUsageAuditEntry struct {
+	Sequence  uint64         `json:"sequence"`
+	Timestamp time.Time      `json:"timestamp"`
+	Action    KeyUsageAction `json:"action"`
+	// OperationID is the ID of the operation signed on the XRPL, it's the ticket or account sequence of the tx.
+	OperationID uint32 `json:"operation_id,omitempty"`
+	// TxHash is the hash of the tx broadcast to Coreum.
+	TxHash        string `json:"tx_hash,omitempty"`
+	PayloadDigest string `json:"payload_digest"`
+	PrevHash      string `json:"prev_hash"`
+	Hash          string `json:"hash"`
+}
+
+func (e KeyUsageAuditEntry) computeHash() (string, error) {
+	e.Hash = ""
+	entryBytes, err := json.Marshal(e)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal key usage audit entry")
+	}
+	hash := sha256.Sum256(entryBytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// KeyUsageAuditLog is the append-only JSON Lines log of the XRPL signatures and Coreum txs produced by the relayer
+// keys. The log failures don't interrupt the signing and broadcasting, they are logged and counted in the metrics
+// instead.
+type KeyUsageAuditLog struct {
+	path           string
+	log            logger.Logger
+	metricRegistry KeyUsageAuditMetricRegistry
+	clock          func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewKeyUsageAuditLog returns a new instance of the KeyUsageAuditLog.
+func NewKeyUsageAuditLog(
+	path string,
+	log logger.Logger,
+	metricRegistry KeyUsageAuditMetricRegistry,
+	clock func() time.Time,
+) (*KeyUsageAuditLog, error) {
+	if path == "" {
+		return nil, errors.New("key usage audit log path must not be empty")
+	}
+
+	return &KeyUsageAuditLog{
+		path:           path,
+		log:            log,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+	}, nil
+}
+
+// LogXRPLSign appends the XRPL tx signature entry to the log.
+func (l *KeyUsageAuditLog) LogXRPLSign(operationID uint32, payloadDigest []byte) {
+	l.append(KeyUsageAuditEntry{
+		Action:        KeyUsageActionXRPLSign,
+		OperationID:   operationID,
+		PayloadDigest: hex.EncodeToString(payloadDigest),
+	})
+}
+
+// LogCoreumBroadcast appends the Coreum tx broadcast entry to the log.
+func (l *KeyUsageAuditLog) LogCoreumBroadcast(txHash string, payloadDigest []byte) {
+	l.append(KeyUsageAuditEntry{
+		Action:        KeyUsageActionCoreumBroadcast,
+		TxHash:        txHash,
+		PayloadDigest: hex.EncodeToString(payloadDigest),
+	})
+}
+
+func (l *KeyUsageAuditLog) append(entry KeyUsageAuditEntry) {
+	if err := l.appendEntry(entry); err != nil {
+		l.log.Error(
+			context.Background(),
+			"Failed to write key usage audit log entry",
+			zap.String("action", string(entry.Action)),
+			zap.Uint32("operationID", entry.OperationID),
+			zap.String("txHash", entry.TxHash),
+			zap.Error(err),
+		)
+		l.metricRegistry.IncrementKeyUsageAuditLogFailuresCounter(string(entry.Action))
+	}
+}
+
+func (l *KeyUsageAuditLog) appendEntry(entry KeyUsageAuditEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create key usage audit log dir, path:%s", l.path)
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open key usage audit log file, path:%s", l.path)
+	}
+	// the last entry is read on each append, so the entries written by the other relayer process, e.g. the CLI
+	// command, are chained as well
+	lastEntry, err := readLastKeyUsageAuditEntry(file)
+	if err != nil {
+		file.Close() //nolint:errcheck // the read error is returned
+		return errors.Wrapf(err, "failed to read last key usage audit log entry, path:%s", l.path)
+	}
+	entry.PrevHash = keyUsageAuditGenesisHash
+	if lastEntry != nil {
+		entry.Sequence = lastEntry.Sequence + 1
+		entry.PrevHash = lastEntry.Hash
+	} else {
+		entry.Sequence = 1
+	}
+	entry.Timestamp = l.clock().UTC()
+	if entry.Hash, err = entry.computeHash(); err != nil {
+		file.Close() //nolint:errcheck // the hash error is returned
+		return err
+	}
+
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		file.Close() //nolint:errcheck // the marshal error is returned
+		return errors.Wrap(err, "failed to marshal key usage audit entry")
+	}
+	if _, err := file.Write(append(entryBytes, '\n')); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write key usage audit log file, path:%s", l.path)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close key usage audit log file, path:%s", l.path)
+}
+
+// readLastKeyUsageAuditEntry reads the last entry of the log by reading the file backwards, nil is returned for the
+// empty file.
+func readLastKeyUsageAuditEntry(file *os.File) (*KeyUsageAuditEntry, error) {
+	const chunkSize = 4096
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var (
+		tail   []byte
+		offset = fileInfo.Size()
+	)
+	for offset > 0 {
+		readSize := min(int64(chunkSize), offset)
+		offset -= readSize
+		chunk := make([]byte, readSize)
+		if _, err := file.ReadAt(chunk, offset); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		tail = append(chunk, tail...)
+		trimmedTail := bytes.TrimRight(tail, "\n")
+		if lineStart := bytes.LastIndexByte(trimmedTail, '\n'); lineStart >= 0 {
+			tail = trimmedTail[lineStart+1:]
+			break
+		}
+	}
+	tail = bytes.TrimSpace(tail)
+	if len(tail) == 0 {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	var entry KeyUsageAuditEntry
+	if err := json.Unmarshal(tail, &entry); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal key usage audit entry")
+	}
+
+	return &entry, nil
+}
+
+// KeyUsageAuditVerification is the result of the key usage audit log verification.
+type KeyUsageAuditVerification struct {
+	Entries uint64 `json:"entries"`
+	// LastHash is the hash of the last entry, it can be compared with the previously exported hash to detect the
+	// removal of the trailing entries.
+	LastHash string `json:"last_hash"`
+}
+
+// VerifyKeyUsageAuditLog verifies the hash chain of the key usage audit log and returns the error pointing to the
+// first entry which breaks the chain.
+func VerifyKeyUsageAuditLog(filePath string) (KeyUsageAuditVerification, error) {
+	verification := KeyUsageAuditVerification{
+		LastHash: keyUsageAuditGenesisHash,
+	}
+	err := readKeyUsageAuditLog(filePath, func(lineNumber int, entry KeyUsageAuditEntry) error {
+		if entry.Sequence != verification.Entries+1 {
+			return errors.Errorf(
+				"unexpected key usage audit entry sequence, line:%d, expected:%d, got:%d",
+				lineNumber, verification.Entries+1, entry.Sequence,
+			)
+		}
+		if entry.PrevHash != verification.LastHash {
+			return errors.Errorf(
+				"key usage audit entry isn't chained to the previous entry, line:%d, expected prev hash:%s, got:%s",
+				lineNumber, verification.LastHash, entry.PrevHash,
+			)
+		}
+		hash, err := entry.computeHash()
+		if err != nil {
+			return err
+		}
+		if entry.Hash != hash {
+			return errors.Errorf(
+				"key usage audit entry hash mismatch, the entry is modified, line:%d, expected hash:%s, got:%s",
+				lineNumber, hash, entry.Hash,
+			)
+		}
+		verification.Entries++
+		verification.LastHash = entry.Hash
+
+		return nil
+	})
+	if err != nil {
+		return KeyUsageAuditVerification{}, err
+	}
+
+	return verification, nil
+}
+
+// ReadKeyUsageAuditLog reads the key usage audit log entries written since the time, the zero time returns all
+// entries.
+func ReadKeyUsageAuditLog(filePath string, since time.Time) ([]KeyUsageAuditEntry, error) {
+	entries := make([]KeyUsageAuditEntry, 0)
+	err := readKeyUsageAuditLog(filePath, func(_ int, entry KeyUsageAuditEntry) error {
+		if since.IsZero() || !entry.Timestamp.Before(since) {
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func readKeyUsageAuditLog(filePath string, handler func(lineNumber int, entry KeyUsageAuditEntry) error) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open key usage audit log file, path:%s", filePath)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) != 0 {
+			var entry KeyUsageAuditEntry
+			if err := json.Unmarshal(line, &entry); err != nil {
+				return errors.Wrapf(
+					err, "failed to unmarshal key usage audit entry, path:%s, line:%d", filePath, lineNumber,
+				)
+			}
+			if err := handler(lineNumber, entry); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read key usage audit log file, path:%s", filePath)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package processes_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestKeyUsageAuditLog(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), processes.KeyUsageAuditLogFileName)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	auditLog := newTestKeyUsageAuditLog(t, filePath, func() time.Time {
+		now = now.Add(time.Hour)
+		return now
+	})
+
+	auditLog.LogXRPLSign(10, []byte{1})
+	auditLog.LogCoreumBroadcast("A1", []byte{2})
+	auditLog.LogXRPLSign(11, []byte{3})
+
+	verification, err := processes.VerifyKeyUsageAuditLog(filePath)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), verification.Entries)
+
+	entries, err := processes.ReadKeyUsageAuditLog(filePath, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, verification.LastHash, entries[2].Hash)
+	require.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	require.Equal(t, processes.KeyUsageActionXRPLSign, entries[0].Action)
+	require.Equal(t, uint32(10), entries[0].OperationID)
+	require.Equal(t, "01", entries[0].PayloadDigest)
+	require.Equal(t, processes.KeyUsageActionCoreumBroadcast, entries[1].Action)
+	require.Equal(t, "A1", entries[1].TxHash)
+
+	// the entries are chained to the existing log after the restart
+	auditLog = newTestKeyUsageAuditLog(t, filePath, func() time.Time {
+		return now.Add(time.Hour)
+	})
+	auditLog.LogCoreumBroadcast("A2", []byte{4})
+	verification, err = processes.VerifyKeyUsageAuditLog(filePath)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), verification.Entries)
+
+	entries, err = processes.ReadKeyUsageAuditLog(filePath, time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint32(11), entries[0].OperationID)
+	require.Equal(t, "A2", entries[1].TxHash)
+}
+
+func TestVerifyKeyUsageAuditLog_TamperedLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		tamper         func(t *testing.T, lines []string) []string
+		expectedErrMsg string
+	}{
+		{
+			name: "modified_middle_entry",
+			tamper: func(t *testing.T, lines []string) []string {
+				var entry processes.KeyUsageAuditEntry
+				require.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+				entry.TxHash = "B2"
+				lines[2] = marshalKeyUsageAuditEntry(t, entry)
+				return lines
+			},
+			expectedErrMsg: "key usage audit entry hash mismatch, the entry is modified, line:3",
+		},
+		{
+			name: "rehashed_middle_entry",
+			tamper: func(t *testing.T, lines []string) []string {
+				var entry processes.KeyUsageAuditEntry
+				require.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+				entry.Hash = strings.Repeat("1", len(entry.Hash))
+				lines[2] = marshalKeyUsageAuditEntry(t, entry)
+				return lines
+			},
+			expectedErrMsg: "key usage audit entry hash mismatch, the entry is modified, line:3",
+		},
+		{
+			name: "removed_middle_entry",
+			tamper: func(t *testing.T, lines []string) []string {
+				return append(lines[:2], lines[3:]...)
+			},
+			expectedErrMsg: "unexpected key usage audit entry sequence, line:3, expected:3, got:4",
+		},
+		{
+			name: "removed_head_entry",
+			tamper: func(t *testing.T, lines []string) []string {
+				return lines[1:]
+			},
+			expectedErrMsg: "unexpected key usage audit entry sequence, line:1, expected:1, got:2",
+		},
+		{
+			name: "reordered_entries",
+			tamper: func(t *testing.T, lines []string) []string {
+				lines[1], lines[2] = lines[2], lines[1]
+				return lines
+			},
+			expectedErrMsg: "unexpected key usage audit entry sequence, line:2, expected:2, got:3",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filePath := filepath.Join(t.TempDir(), processes.KeyUsageAuditLogFileName)
+			auditLog := newTestKeyUsageAuditLog(t, filePath, time.Now)
+			for i := 0; i < 5; i++ {
+				auditLog.LogCoreumBroadcast(fmt.Sprintf("A%d", i), []byte{byte(i)})
+			}
+			_, err := processes.VerifyKeyUsageAuditLog(filePath)
+			require.NoError(t, err)
+
+			fileBytes, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(fileBytes)), "\n")
+			lines = tt.tamper(t, lines)
+			require.NoError(t, os.WriteFile(filePath, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+
+			_, err = processes.VerifyKeyUsageAuditLog(filePath)
+			require.ErrorContains(t, err, tt.expectedErrMsg)
+		})
+	}
+}
+
+func TestKeyUsageAuditLog_ConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), processes.KeyUsageAuditLogFileName)
+	auditLog := newTestKeyUsageAuditLog(t, filePath, time.Now)
+
+	const writers, writes = 10, 20
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < writes; j++ {
+				if j%2 == 0 {
+					auditLog.LogXRPLSign(uint32(i*writes+j), []byte{byte(j)})
+					continue
+				}
+				auditLog.LogCoreumBroadcast(fmt.Sprintf("A%d-%d", i, j), []byte{byte(j)})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	verification, err := processes.VerifyKeyUsageAuditLog(filePath)
+	require.NoError(t, err)
+	require.Equal(t, uint64(writers*writes), verification.Entries)
+}
+
+func TestKeyUsageAuditLog_WriteFailure(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	// the dir path is used as the file path to fail the writes
+	filePath := t.TempDir()
+
+	metricRegistryMock := NewMockKeyUsageAuditMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementKeyUsageAuditLogFailuresCounter(string(processes.KeyUsageActionXRPLSign))
+	logMock := logger.NewAnyLogMock(ctrl)
+	logMock.EXPECT().Error(gomock.Any(), "Failed to write key usage audit log entry", gomock.Any())
+
+	auditLog, err := processes.NewKeyUsageAuditLog(filePath, logMock, metricRegistryMock, time.Now)
+	require.NoError(t, err)
+	// the failure isn't propagated to the signer
+	auditLog.LogXRPLSign(1, []byte{1})
+}
+
+func newTestKeyUsageAuditLog(
+	t *testing.T,
+	filePath string,
+	clock func() time.Time,
+) *processes.KeyUsageAuditLog {
+	ctrl := gomock.NewController(t)
+	auditLog, err := processes.NewKeyUsageAuditLog(
+		filePath, logger.NewAnyLogMock(ctrl), NewMockKeyUsageAuditMetricRegistry(ctrl), clock,
+	)
+	require.NoError(t, err)
+
+	return auditLog
+}
+
+func marshalKeyUsageAuditEntry(t *testing.T, entry processes.KeyUsageAuditEntry) string {
+	entryBytes, err := json.Marshal(entry)
+	require.NoError(t, err)
+
+	return string(entryBytes)
+}
@@ -0,0 +1,153 @@
+package processes
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// MinBridgeAmountConfig is the min bridge amount of the token. The token is defined either by the Coreum denom, then
+// the amount is in the denom units and applied to the Coreum to XRPL transfers, or by the XRPL issuer and currency,
+// then the amount is the XRPL value, e.g. "0.5", applied to the XRPL to Coreum transfers.
+type MinBridgeAmountConfig struct {
+	Denom    string
+	Issuer   string
+	Currency string
+	Amount   string
+}
+
+// MinBridgeAmountsConfig is the MinBridgeAmounts config.
+type MinBridgeAmountsConfig struct {
+	// DefaultCoreumAmount is the min amount in the denom units of the Coreum to XRPL transfers of the denoms without
+	// the specific config, empty means no min amount.
+	DefaultCoreumAmount string
+	// DefaultXRPLAmount is the min XRPL value of the XRPL to Coreum transfers of the tokens without the specific
+	// config, empty means no min amount.
+	DefaultXRPLAmount string
+	Tokens            []MinBridgeAmountConfig
+}
+
+// MinBridgeAmounts holds the min amounts of the transfers, the transfers below the min amounts are dust which
+// consumes the tickets and relayers gas while delivering negligible value. The zero value has no min amounts.
+type MinBridgeAmounts struct {
+	defaultCoreumAmount sdkmath.Int
+	defaultXRPLAmount   sdkmath.LegacyDec
+	coreumAmounts       map[string]sdkmath.Int
+	xrplAmounts         map[string]sdkmath.Int
+}
+
+// NewMinBridgeAmounts returns a new instance of the MinBridgeAmounts.
+func NewMinBridgeAmounts(cfg MinBridgeAmountsConfig) (MinBridgeAmounts, error) {
+	minAmounts := MinBridgeAmounts{
+		coreumAmounts: make(map[string]sdkmath.Int),
+		xrplAmounts:   make(map[string]sdkmath.Int),
+	}
+	if cfg.DefaultCoreumAmount != "" {
+		amount, err := parseMinCoreumAmount(cfg.DefaultCoreumAmount)
+		if err != nil {
+			return MinBridgeAmounts{}, err
+		}
+		minAmounts.defaultCoreumAmount = amount
+	}
+	if cfg.DefaultXRPLAmount != "" {
+		amount, err := sdkmath.LegacyNewDecFromStr(cfg.DefaultXRPLAmount)
+		if err != nil {
+			return MinBridgeAmounts{}, errors.Wrapf(err, "invalid default XRPL min bridge amount: %s", cfg.DefaultXRPLAmount)
+		}
+		if amount.IsNegative() {
+			return MinBridgeAmounts{}, errors.Errorf(
+				"default XRPL min bridge amount must not be negative, got: %s", cfg.DefaultXRPLAmount,
+			)
+		}
+		minAmounts.defaultXRPLAmount = amount
+	}
+
+	for _, tokenCfg := range cfg.Tokens {
+		switch {
+		case tokenCfg.Denom != "" && tokenCfg.Issuer == "" && tokenCfg.Currency == "":
+			if _, ok := minAmounts.coreumAmounts[tokenCfg.Denom]; ok {
+				return MinBridgeAmounts{}, errors.Errorf("duplicated min bridge amount, denom:%s", tokenCfg.Denom)
+			}
+			amount, err := parseMinCoreumAmount(tokenCfg.Amount)
+			if err != nil {
+				return MinBridgeAmounts{}, errors.Wrapf(err, "invalid min bridge amount, denom:%s", tokenCfg.Denom)
+			}
+			minAmounts.coreumAmounts[tokenCfg.Denom] = amount
+		case tokenCfg.Denom == "" && tokenCfg.Issuer != "" && tokenCfg.Currency != "":
+			key := transferRateLimitKey(tokenCfg.Issuer, tokenCfg.Currency)
+			if _, ok := minAmounts.xrplAmounts[key]; ok {
+				return MinBridgeAmounts{}, errors.Errorf(
+					"duplicated min bridge amount, issuer:%s, currency:%s", tokenCfg.Issuer, tokenCfg.Currency,
+				)
+			}
+			amount, err := parseMinXRPLAmount(tokenCfg.Issuer, tokenCfg.Currency, tokenCfg.Amount)
+			if err != nil {
+				return MinBridgeAmounts{}, err
+			}
+			minAmounts.xrplAmounts[key] = amount
+		default:
+			return MinBridgeAmounts{}, errors.Errorf(
+				"min bridge amount must be set either for denom or for issuer and currency, got: %+v", tokenCfg,
+			)
+		}
+	}
+
+	return minAmounts, nil
+}
+
+// ValidateCoreumToXRPLAmount returns an error if the amount is below the min bridge amount of its denom.
+func (m MinBridgeAmounts) ValidateCoreumToXRPLAmount(amount sdk.Coin) error {
+	minAmount, ok := m.coreumAmounts[amount.Denom]
+	if !ok {
+		minAmount = m.defaultCoreumAmount
+	}
+	if minAmount.IsNil() || amount.Amount.GTE(minAmount) {
+		return nil
+	}
+
+	return errors.Errorf(
+		"amount %s is below the min bridge amount %s%s", amount.String(), minAmount.String(), amount.Denom,
+	)
+}
+
+// GetXRPLToCoreumMinAmount returns the min amount of the XRPL to Coreum transfer converted to the evidence amount
+// representation, the zero amount means no min amount.
+func (m MinBridgeAmounts) GetXRPLToCoreumMinAmount(issuer, currency string) sdkmath.Int {
+	if minAmount, ok := m.xrplAmounts[transferRateLimitKey(issuer, currency)]; ok {
+		return minAmount
+	}
+	if m.defaultXRPLAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+
+	// the amount is rounded up to not allow the amounts below the configured value
+	return m.defaultXRPLAmount.MulInt(sdkmath.NewIntWithDecimal(1, xrplTokenDecimals(issuer, currency))).Ceil().TruncateInt()
+}
+
+func parseMinCoreumAmount(amount string) (sdkmath.Int, error) {
+	minAmount, ok := sdkmath.NewIntFromString(amount)
+	if !ok || minAmount.IsNegative() {
+		return sdkmath.Int{}, errors.Errorf("min bridge amount must be not negative integer, got: %s", amount)
+	}
+
+	return minAmount, nil
+}
+
+func parseMinXRPLAmount(issuer, currency, amount string) (sdkmath.Int, error) {
+	minAmountDec, err := sdkmath.LegacyNewDecFromStr(amount)
+	if err != nil {
+		return sdkmath.Int{}, errors.Wrapf(
+			err, "invalid min bridge amount, issuer:%s, currency:%s, amount:%s", issuer, currency, amount,
+		)
+	}
+	if minAmountDec.IsNegative() {
+		return sdkmath.Int{}, errors.Errorf("min bridge amount must not be negative, got: %s", amount)
+	}
+	decimals := xrplTokenDecimals(issuer, currency)
+	minAmountDec = minAmountDec.MulInt(sdkmath.NewIntWithDecimal(1, decimals))
+	if !minAmountDec.IsInteger() {
+		return sdkmath.Int{}, errors.Errorf("min bridge amount %s has more than %d decimals", amount, decimals)
+	}
+
+	return minAmountDec.TruncateInt(), nil
+}
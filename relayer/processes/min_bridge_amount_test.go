@@ -0,0 +1,180 @@
+package processes_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestNewMinBridgeAmounts_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tokenIssuer := xrpl.GenPrivKeyTxSigner().Account().String()
+
+	tests := []struct {
+		name        string
+		cfg         processes.MinBridgeAmountsConfig
+		expectedErr string
+	}{
+		{
+			name: "denom_and_issuer",
+			cfg: processes.MinBridgeAmountsConfig{
+				Tokens: []processes.MinBridgeAmountConfig{
+					{Denom: "ucore", Issuer: tokenIssuer, Currency: "TKN", Amount: "1"},
+				},
+			},
+			expectedErr: "must be set either for denom or for issuer and currency",
+		},
+		{
+			name: "issuer_without_currency",
+			cfg: processes.MinBridgeAmountsConfig{
+				Tokens: []processes.MinBridgeAmountConfig{
+					{Issuer: tokenIssuer, Amount: "1"},
+				},
+			},
+			expectedErr: "must be set either for denom or for issuer and currency",
+		},
+		{
+			name: "duplicated_denom",
+			cfg: processes.MinBridgeAmountsConfig{
+				Tokens: []processes.MinBridgeAmountConfig{
+					{Denom: "ucore", Amount: "1"},
+					{Denom: "ucore", Amount: "2"},
+				},
+			},
+			expectedErr: "duplicated min bridge amount",
+		},
+		{
+			name: "not_integer_denom_amount",
+			cfg: processes.MinBridgeAmountsConfig{
+				Tokens: []processes.MinBridgeAmountConfig{
+					{Denom: "ucore", Amount: "1.5"},
+				},
+			},
+			expectedErr: "must be not negative integer",
+		},
+		{
+			name: "negative_default_coreum_amount",
+			cfg: processes.MinBridgeAmountsConfig{
+				DefaultCoreumAmount: "-1",
+			},
+			expectedErr: "must be not negative integer",
+		},
+		{
+			name: "xrp_amount_with_too_many_decimals",
+			cfg: processes.MinBridgeAmountsConfig{
+				Tokens: []processes.MinBridgeAmountConfig{
+					{
+						Issuer:   xrpl.XRPTokenIssuer.String(),
+						Currency: xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency),
+						Amount:   "1.0000001",
+					},
+				},
+			},
+			expectedErr: "has more than 6 decimals",
+		},
+		{
+			name: "invalid_default_xrpl_amount",
+			cfg: processes.MinBridgeAmountsConfig{
+				DefaultXRPLAmount: "one",
+			},
+			expectedErr: "invalid default XRPL min bridge amount",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := processes.NewMinBridgeAmounts(tt.cfg)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestMinBridgeAmounts_ValidateCoreumToXRPLAmount(t *testing.T) {
+	t.Parallel()
+
+	minBridgeAmounts, err := processes.NewMinBridgeAmounts(processes.MinBridgeAmountsConfig{
+		DefaultCoreumAmount: "100",
+		Tokens: []processes.MinBridgeAmountConfig{
+			{Denom: "ucore", Amount: "1000000"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		amount      sdk.Coin
+		expectedErr string
+	}{
+		{
+			name:   "token_amount_equal_to_min",
+			amount: sdk.NewCoin("ucore", sdkmath.NewInt(1_000_000)),
+		},
+		{
+			name:        "token_amount_below_min",
+			amount:      sdk.NewCoin("ucore", sdkmath.NewInt(999_999)),
+			expectedErr: "amount 999999ucore is below the min bridge amount 1000000ucore",
+		},
+		{
+			name:   "default_amount_equal_to_min",
+			amount: sdk.NewCoin("utkn", sdkmath.NewInt(100)),
+		},
+		{
+			name:        "default_amount_below_min",
+			amount:      sdk.NewCoin("utkn", sdkmath.NewInt(99)),
+			expectedErr: "amount 99utkn is below the min bridge amount 100utkn",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := minBridgeAmounts.ValidateCoreumToXRPLAmount(tt.amount)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the zero value has no min amounts
+	require.NoError(t, processes.MinBridgeAmounts{}.ValidateCoreumToXRPLAmount(sdk.NewCoin("ucore", sdkmath.OneInt())))
+}
+
+func TestMinBridgeAmounts_GetXRPLToCoreumMinAmount(t *testing.T) {
+	t.Parallel()
+
+	xrpIssuer := xrpl.XRPTokenIssuer.String()
+	xrpCurrency := xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency)
+	tokenIssuer := xrpl.GenPrivKeyTxSigner().Account().String()
+
+	minBridgeAmounts, err := processes.NewMinBridgeAmounts(processes.MinBridgeAmountsConfig{
+		DefaultXRPLAmount: "0.5",
+		Tokens: []processes.MinBridgeAmountConfig{
+			{Issuer: xrpIssuer, Currency: xrpCurrency, Amount: "1.5"},
+			{Issuer: tokenIssuer, Currency: "TKN", Amount: "10"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, sdkmath.NewInt(1_500_000).String(), minBridgeAmounts.GetXRPLToCoreumMinAmount(
+		xrpIssuer, xrpCurrency,
+	).String())
+	require.Equal(t, sdkmath.NewIntWithDecimal(10, xrpl.XRPLIssuedTokenDecimals).String(),
+		minBridgeAmounts.GetXRPLToCoreumMinAmount(tokenIssuer, "TKN").String())
+	// default
+	require.Equal(t, sdkmath.NewIntWithDecimal(5, xrpl.XRPLIssuedTokenDecimals-1).String(),
+		minBridgeAmounts.GetXRPLToCoreumMinAmount(tokenIssuer, "ABC").String())
+
+	// the zero value has no min amounts
+	require.True(t, processes.MinBridgeAmounts{}.GetXRPLToCoreumMinAmount(tokenIssuer, "TKN").IsZero())
+}
@@ -2,7 +2,9 @@ package processes
 
 import (
 	"context"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	rippledata "github.com/rubblelabs/ripple/data"
@@ -11,7 +13,7 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
-//go:generate mockgen -destination=model_mocks_test.go -package=processes_test . ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry
+//go:generate mockgen -destination=model_mocks_test.go -package=processes_test . ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry,ContractEventsSubscriber,CoreumToXRPLTransferRateLimiter,TransferRateLimiterMetricRegistry,CoreumToXRPLOperationAgeTracker,CoreumToXRPLTokenRegistry,OperationAgeMetricRegistry,EvidenceAuditLogger,XRPLToCoreumBlockedDeliveryQueue,XRPLToCoreumEvidenceRetryQueue,BlockedDeliveryContractClient,BlockedDeliveryMetricRegistry,XRPLTransferLatencyObserver,TransferLatencyMetricRegistry,RefundRelayerContractClient,RefundRelayerMetricRegistry,CheckCasherContractClient,IBCTransferTrackerContractClient,XRPLCheckScanner,XRPLTxResultRPCClient,CoreumToXRPLTxResultTracker,CoreumToXRPLSignatureAggregationTracker,TransferHistoryRecorder,TicketUsageRecorder,TicketUsageMetricRegistry,CoreumToXRPLOperationsCache,KeyUsageAuditMetricRegistry
 
 // ContractClient is the interface for the contract client.
 type ContractClient interface {
@@ -41,6 +43,21 @@ type ContractClient interface {
 		sender sdk.AccAddress,
 		evd coreum.XRPLTransactionResultKeysRotationEvidence,
 	) (*sdk.TxResponse, error)
+	SendXRPLNFTokenTransferEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evidence coreum.XRPLNFTokenTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendNFTokenTransferTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultNFTokenTransferEvidence,
+	) (*sdk.TxResponse, error)
+	SendCheckCashTransactionResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evd coreum.XRPLTransactionResultCheckCashEvidence,
+	) (*sdk.TxResponse, error)
 	SaveSignature(
 		ctx context.Context,
 		sender sdk.AccAddress,
@@ -52,6 +69,131 @@ type ContractClient interface {
 	GetContractConfig(ctx context.Context) (coreum.ContractConfig, error)
 }
 
+// ContractEventsSubscriber is the contract txs subscriber.
+type ContractEventsSubscriber interface {
+	Subscribe(ctx context.Context, ch chan<- coreum.ContractTx) error
+}
+
+// CoreumToXRPLTransferRateLimiter limits the Coreum to XRPL transfers signed by the relayer.
+type CoreumToXRPLTransferRateLimiter interface {
+	Allow(ctx context.Context, operationID uint32, issuer, currency string, amount sdkmath.Int) bool
+	ReportConsumption()
+}
+
+// CoreumToXRPLTokenRegistry checks the tokens of the Coreum to XRPL transfers before they are signed.
+type CoreumToXRPLTokenRegistry interface {
+	IsXRPLTokenRegistered(ctx context.Context, issuer, currency string) (bool, error)
+}
+
+// CoreumToXRPLOperationAgeTracker tracks the age of the pending operations observed by the relayer.
+type CoreumToXRPLOperationAgeTracker interface {
+	Track(ctx context.Context, operations []coreum.Operation) error
+}
+
+// CoreumToXRPLSignatureAggregationTracker tracks the signature aggregation of the pending operations and returns the
+// operations which signature aggregation is timed out.
+type CoreumToXRPLSignatureAggregationTracker interface {
+	Track(ctx context.Context, operations []coreum.Operation) ([]coreum.Operation, error)
+}
+
+// CoreumToXRPLOperationsCache is the local cache of the contract state flushed on the pending operations version bump.
+type CoreumToXRPLOperationsCache interface {
+	InvalidatePendingOperations()
+	InvalidateContractConfig()
+}
+
+// XRPLTransferLatencyObserver records the XRPL side timestamps of the bridge transfers.
+type XRPLTransferLatencyObserver interface {
+	ObserveXRPLToCoreumStart(ctx context.Context, txHash string, ledgerCloseTime time.Time) error
+	ObserveCoreumToXRPLCompletion(ctx context.Context, operationID uint32, validationTime time.Time) error
+}
+
+// TransferLatencyContractClient is the contract client used by the transfer latency tracker.
+type TransferLatencyContractClient interface {
+	GetLatestBlockHeight(ctx context.Context) (int64, error)
+	GetXRPLToCoreumTransfers(
+		ctx context.Context,
+		filter coreum.TransfersFilter,
+	) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error)
+	GetCoreumToXRPLTransfers(ctx context.Context, filter coreum.TransfersFilter) ([]coreum.CoreumToXRPLTransfer, error)
+}
+
+// EvidenceAuditLogger writes the audit trail of the evidence submissions.
+type EvidenceAuditLogger interface {
+	LogEvidence(ctx context.Context, record EvidenceAuditRecord) error
+}
+
+// TransferHistoryRecorder records the transfers the relayer has provided the evidences for.
+type TransferHistoryRecorder interface {
+	Append(ctx context.Context, record TransferHistoryRecord) error
+}
+
+// TicketUsageRecorder records the tickets used by the completed operations.
+type TicketUsageRecorder interface {
+	Record(ctx context.Context, record TicketUsageRecord) error
+}
+
+// XRPLToCoreumBlockedDeliveryQueue keeps the evidences which delivery is blocked by the asset FT rules.
+type XRPLToCoreumBlockedDeliveryQueue interface {
+	Park(ctx context.Context, evidence coreum.XRPLToCoreumTransferEvidence, reason string) error
+}
+
+// XRPLToCoreumEvidenceRetryQueue returns the XRPL txs which evidence submission is failed back to the processing.
+type XRPLToCoreumEvidenceRetryQueue interface {
+	Start(ctx context.Context, retryCh chan<- rippledata.TransactionWithMetaData) error
+	Push(ctx context.Context, tx rippledata.TransactionWithMetaData, processingErr error) error
+	Complete(tx rippledata.TransactionWithMetaData)
+}
+
+// BlockedDeliveryContractClient is the contract client used by the blocked delivery queue.
+type BlockedDeliveryContractClient interface {
+	SendXRPLToCoreumTransferEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evidence coreum.XRPLToCoreumTransferEvidence,
+	) (*sdk.TxResponse, error)
+	GetXRPLTokens(ctx context.Context) ([]coreum.XRPLToken, error)
+	GetCoreumTokens(ctx context.Context) ([]coreum.CoreumToken, error)
+}
+
+// RefundRelayerContractClient is the contract client used by the refund relayer.
+type RefundRelayerContractClient interface {
+	GetPendingRefunds(ctx context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error)
+	GetContractExecutionGranters(ctx context.Context, grantee sdk.AccAddress) ([]sdk.AccAddress, error)
+	GetFeeAllowanceGranters(ctx context.Context, grantee sdk.AccAddress) ([]sdk.AccAddress, error)
+	ClaimRefundsOnBehalf(
+		ctx context.Context,
+		grantee, claimant, feeGranter sdk.AccAddress,
+		pendingRefundIDs []string,
+	) (*sdk.TxResponse, error)
+}
+
+// CheckCasherContractClient is the contract client used by the check casher.
+type CheckCasherContractClient interface {
+	SendXRPLCheckCashEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evidence coreum.XRPLCheckCashEvidence,
+	) (*sdk.TxResponse, error)
+}
+
+// IBCTransferTrackerContractClient is the contract client used by the IBC transfer tracker.
+type IBCTransferTrackerContractClient interface {
+	GetPendingIBCTransfers(ctx context.Context) ([]coreum.PendingIBCTransfer, error)
+	GetIBCTransferPacket(ctx context.Context, xrplTxHash string) (*coreum.IBCPacket, error)
+	GetIBCPacketStatus(ctx context.Context, packet coreum.IBCPacket) (coreum.IBCPacketStatus, error)
+	SendIBCTransferResultEvidence(
+		ctx context.Context,
+		sender sdk.AccAddress,
+		evidence coreum.IBCTransferResultEvidence,
+	) (*sdk.TxResponse, error)
+}
+
+// XRPLCheckScanner is XRPL bridge account checks scanner.
+type XRPLCheckScanner interface {
+	ScanChecks(ctx context.Context) ([]xrpl.Check, error)
+}
+
 // XRPLAccountTxScanner is XRPL account tx scanner.
 type XRPLAccountTxScanner interface {
 	ScanTxs(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error
@@ -63,6 +205,16 @@ type XRPLRPCClient interface {
 	Submit(ctx context.Context, tx rippledata.Transaction) (xrpl.SubmitResult, error)
 }
 
+// XRPLTxResultRPCClient is the XRPL RPC client used by the XRPLTxResultTracker.
+type XRPLTxResultRPCClient interface {
+	Tx(ctx context.Context, hash rippledata.Hash256) (xrpl.TxResult, error)
+}
+
+// CoreumToXRPLTxResultTracker tracks the results of the XRPL txs submitted by the relayer.
+type CoreumToXRPLTxResultTracker interface {
+	Track(ctx context.Context, operation coreum.Operation, tx rippledata.Transaction, submitRes xrpl.SubmitResult) error
+}
+
 // XRPLTxSigner is XRPL transaction signer.
 type XRPLTxSigner interface {
 	MultiSign(tx rippledata.MultiSignable, keyName string) (rippledata.Signer, error)
@@ -71,6 +223,49 @@ type XRPLTxSigner interface {
 // MetricRegistry is metric registry.
 type MetricRegistry interface {
 	SetMaliciousBehaviourKey(key string)
+	IncrementXRPLToCoreumBelowMinAmountTransfersCounter(currency, issuer string)
+	IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(currency, issuer string)
+	IncrementXRPLMemoLimitExceededTxsCounter()
+	IncrementBridgeDuplicateSignatureCounter()
+}
+
+// TransferRateLimiterMetricRegistry is the transfer rate limiter metric registry.
+type TransferRateLimiterMetricRegistry interface {
+	SetCoreumToXRPLTransferRateLimitConsumption(currency, issuer string, consumption, maxAmount float64)
+	IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(currency, issuer string)
+}
+
+// OperationAgeMetricRegistry is the operation age tracker metric registry.
+type OperationAgeMetricRegistry interface {
+	SetOldestPendingOperationAge(seconds float64)
+	SetPendingOperationMaxAge(operationType string, seconds float64)
+}
+
+// TransferLatencyMetricRegistry is the transfer latency tracker metric registry.
+type TransferLatencyMetricRegistry interface {
+	ObserveXRPLToCoreumLatency(seconds float64)
+	ObserveCoreumToXRPLLatency(seconds float64)
+}
+
+// BlockedDeliveryMetricRegistry is the blocked delivery queue metric registry.
+type BlockedDeliveryMetricRegistry interface {
+	SetBlockedDeliveriesCount(denom string, count float64)
+}
+
+// RefundRelayerMetricRegistry is the refund relayer metric registry.
+type RefundRelayerMetricRegistry interface {
+	SetUnclaimedRefunds(address string, count int)
+	AddRelayedRefundClaims(address string, count int)
+}
+
+// TicketUsageMetricRegistry is the ticket usage tracker metric registry.
+type TicketUsageMetricRegistry interface {
+	IncrementTicketsConsumedCounter(operationType, result string)
+}
+
+// KeyUsageAuditMetricRegistry is the key usage audit log metric registry.
+type KeyUsageAuditMetricRegistry interface {
+	IncrementKeyUsageAuditLogFailuresCounter(action string)
 }
 
 // IsExpectedEvidenceSubmissionError returns true is error is a part of expected business logic e.g:
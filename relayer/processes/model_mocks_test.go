@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes (interfaces: ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry)
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes (interfaces: ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry,ContractEventsSubscriber,CoreumToXRPLTransferRateLimiter,TransferRateLimiterMetricRegistry,CoreumToXRPLOperationAgeTracker,CoreumToXRPLTokenRegistry,OperationAgeMetricRegistry,EvidenceAuditLogger,XRPLToCoreumBlockedDeliveryQueue,XRPLToCoreumEvidenceRetryQueue,BlockedDeliveryContractClient,BlockedDeliveryMetricRegistry,XRPLTransferLatencyObserver,TransferLatencyMetricRegistry,RefundRelayerContractClient,RefundRelayerMetricRegistry,CheckCasherContractClient,IBCTransferTrackerContractClient,XRPLCheckScanner,XRPLTxResultRPCClient,CoreumToXRPLTxResultTracker,CoreumToXRPLSignatureAggregationTracker,TransferHistoryRecorder,TicketUsageRecorder,TicketUsageMetricRegistry,CoreumToXRPLOperationsCache,KeyUsageAuditMetricRegistry)
 //
 // Generated by this command:
 //
-//	mockgen -destination=model_mocks_test.go -package=processes_test . ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry
+//	mockgen -destination=model_mocks_test.go -package=processes_test . ContractClient,XRPLAccountTxScanner,XRPLRPCClient,XRPLTxSigner,MetricRegistry,ContractEventsSubscriber,CoreumToXRPLTransferRateLimiter,TransferRateLimiterMetricRegistry,CoreumToXRPLOperationAgeTracker,CoreumToXRPLTokenRegistry,OperationAgeMetricRegistry,EvidenceAuditLogger,XRPLToCoreumBlockedDeliveryQueue,XRPLToCoreumEvidenceRetryQueue,BlockedDeliveryContractClient,BlockedDeliveryMetricRegistry,XRPLTransferLatencyObserver,TransferLatencyMetricRegistry,RefundRelayerContractClient,RefundRelayerMetricRegistry,CheckCasherContractClient,IBCTransferTrackerContractClient,XRPLCheckScanner,XRPLTxResultRPCClient,CoreumToXRPLTxResultTracker,CoreumToXRPLSignatureAggregationTracker,TransferHistoryRecorder,TicketUsageRecorder,TicketUsageMetricRegistry,CoreumToXRPLOperationsCache,KeyUsageAuditMetricRegistry
 //
 
 // Package processes_test is a generated GoMock package.
@@ -12,8 +12,11 @@ package processes_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	coreum "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	processes "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
 	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 	types "github.com/cosmos/cosmos-sdk/types"
 	data "github.com/rubblelabs/ripple/data"
@@ -102,6 +105,21 @@ func (mr *MockContractClientMockRecorder) SaveSignature(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSignature", reflect.TypeOf((*MockContractClient)(nil).SaveSignature), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SendCheckCashTransactionResultEvidence mocks base method.
+func (m *MockContractClient) SendCheckCashTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultCheckCashEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCheckCashTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCheckCashTransactionResultEvidence indicates an expected call of SendCheckCashTransactionResultEvidence.
+func (mr *MockContractClientMockRecorder) SendCheckCashTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCheckCashTransactionResultEvidence", reflect.TypeOf((*MockContractClient)(nil).SendCheckCashTransactionResultEvidence), arg0, arg1, arg2)
+}
+
 // SendCoreumToXRPLTransferTransactionResultEvidence mocks base method.
 func (m *MockContractClient) SendCoreumToXRPLTransferTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultCoreumToXRPLTransferEvidence) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +150,36 @@ func (mr *MockContractClientMockRecorder) SendKeysRotationTransactionResultEvide
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendKeysRotationTransactionResultEvidence", reflect.TypeOf((*MockContractClient)(nil).SendKeysRotationTransactionResultEvidence), arg0, arg1, arg2)
 }
 
+// SendNFTokenTransferTransactionResultEvidence mocks base method.
+func (m *MockContractClient) SendNFTokenTransferTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultNFTokenTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNFTokenTransferTransactionResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendNFTokenTransferTransactionResultEvidence indicates an expected call of SendNFTokenTransferTransactionResultEvidence.
+func (mr *MockContractClientMockRecorder) SendNFTokenTransferTransactionResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNFTokenTransferTransactionResultEvidence", reflect.TypeOf((*MockContractClient)(nil).SendNFTokenTransferTransactionResultEvidence), arg0, arg1, arg2)
+}
+
+// SendXRPLNFTokenTransferEvidence mocks base method.
+func (m *MockContractClient) SendXRPLNFTokenTransferEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLNFTokenTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLNFTokenTransferEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLNFTokenTransferEvidence indicates an expected call of SendXRPLNFTokenTransferEvidence.
+func (mr *MockContractClientMockRecorder) SendXRPLNFTokenTransferEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLNFTokenTransferEvidence", reflect.TypeOf((*MockContractClient)(nil).SendXRPLNFTokenTransferEvidence), arg0, arg1, arg2)
+}
+
 // SendXRPLTicketsAllocationTransactionResultEvidence mocks base method.
 func (m *MockContractClient) SendXRPLTicketsAllocationTransactionResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLTransactionResultTicketsAllocationEvidence) (*types.TxResponse, error) {
 	m.ctrl.T.Helper()
@@ -328,6 +376,54 @@ func (m *MockMetricRegistry) EXPECT() *MockMetricRegistryMockRecorder {
 	return m.recorder
 }
 
+// IncrementBridgeDuplicateSignatureCounter mocks base method.
+func (m *MockMetricRegistry) IncrementBridgeDuplicateSignatureCounter() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementBridgeDuplicateSignatureCounter")
+}
+
+// IncrementBridgeDuplicateSignatureCounter indicates an expected call of IncrementBridgeDuplicateSignatureCounter.
+func (mr *MockMetricRegistryMockRecorder) IncrementBridgeDuplicateSignatureCounter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementBridgeDuplicateSignatureCounter", reflect.TypeOf((*MockMetricRegistry)(nil).IncrementBridgeDuplicateSignatureCounter))
+}
+
+// IncrementCoreumToXRPLAmountPrecisionMismatchesCounter mocks base method.
+func (m *MockMetricRegistry) IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCoreumToXRPLAmountPrecisionMismatchesCounter", arg0, arg1)
+}
+
+// IncrementCoreumToXRPLAmountPrecisionMismatchesCounter indicates an expected call of IncrementCoreumToXRPLAmountPrecisionMismatchesCounter.
+func (mr *MockMetricRegistryMockRecorder) IncrementCoreumToXRPLAmountPrecisionMismatchesCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCoreumToXRPLAmountPrecisionMismatchesCounter", reflect.TypeOf((*MockMetricRegistry)(nil).IncrementCoreumToXRPLAmountPrecisionMismatchesCounter), arg0, arg1)
+}
+
+// IncrementXRPLMemoLimitExceededTxsCounter mocks base method.
+func (m *MockMetricRegistry) IncrementXRPLMemoLimitExceededTxsCounter() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementXRPLMemoLimitExceededTxsCounter")
+}
+
+// IncrementXRPLMemoLimitExceededTxsCounter indicates an expected call of IncrementXRPLMemoLimitExceededTxsCounter.
+func (mr *MockMetricRegistryMockRecorder) IncrementXRPLMemoLimitExceededTxsCounter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementXRPLMemoLimitExceededTxsCounter", reflect.TypeOf((*MockMetricRegistry)(nil).IncrementXRPLMemoLimitExceededTxsCounter))
+}
+
+// IncrementXRPLToCoreumBelowMinAmountTransfersCounter mocks base method.
+func (m *MockMetricRegistry) IncrementXRPLToCoreumBelowMinAmountTransfersCounter(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementXRPLToCoreumBelowMinAmountTransfersCounter", arg0, arg1)
+}
+
+// IncrementXRPLToCoreumBelowMinAmountTransfersCounter indicates an expected call of IncrementXRPLToCoreumBelowMinAmountTransfersCounter.
+func (mr *MockMetricRegistryMockRecorder) IncrementXRPLToCoreumBelowMinAmountTransfersCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementXRPLToCoreumBelowMinAmountTransfersCounter", reflect.TypeOf((*MockMetricRegistry)(nil).IncrementXRPLToCoreumBelowMinAmountTransfersCounter), arg0, arg1)
+}
+
 // SetMaliciousBehaviourKey mocks base method.
 func (m *MockMetricRegistry) SetMaliciousBehaviourKey(arg0 string) {
 	m.ctrl.T.Helper()
@@ -339,3 +435,1189 @@ func (mr *MockMetricRegistryMockRecorder) SetMaliciousBehaviourKey(arg0 any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaliciousBehaviourKey", reflect.TypeOf((*MockMetricRegistry)(nil).SetMaliciousBehaviourKey), arg0)
 }
+
+// MockContractEventsSubscriber is a mock of ContractEventsSubscriber interface.
+type MockContractEventsSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractEventsSubscriberMockRecorder
+}
+
+// MockContractEventsSubscriberMockRecorder is the mock recorder for MockContractEventsSubscriber.
+type MockContractEventsSubscriberMockRecorder struct {
+	mock *MockContractEventsSubscriber
+}
+
+// NewMockContractEventsSubscriber creates a new mock instance.
+func NewMockContractEventsSubscriber(ctrl *gomock.Controller) *MockContractEventsSubscriber {
+	mock := &MockContractEventsSubscriber{ctrl: ctrl}
+	mock.recorder = &MockContractEventsSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractEventsSubscriber) EXPECT() *MockContractEventsSubscriberMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockContractEventsSubscriber) Subscribe(arg0 context.Context, arg1 chan<- coreum.ContractTx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockContractEventsSubscriberMockRecorder) Subscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockContractEventsSubscriber)(nil).Subscribe), arg0, arg1)
+}
+
+// MockCoreumToXRPLTransferRateLimiter is a mock of CoreumToXRPLTransferRateLimiter interface.
+type MockCoreumToXRPLTransferRateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLTransferRateLimiterMockRecorder
+}
+
+// MockCoreumToXRPLTransferRateLimiterMockRecorder is the mock recorder for MockCoreumToXRPLTransferRateLimiter.
+type MockCoreumToXRPLTransferRateLimiterMockRecorder struct {
+	mock *MockCoreumToXRPLTransferRateLimiter
+}
+
+// NewMockCoreumToXRPLTransferRateLimiter creates a new mock instance.
+func NewMockCoreumToXRPLTransferRateLimiter(ctrl *gomock.Controller) *MockCoreumToXRPLTransferRateLimiter {
+	mock := &MockCoreumToXRPLTransferRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLTransferRateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLTransferRateLimiter) EXPECT() *MockCoreumToXRPLTransferRateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockCoreumToXRPLTransferRateLimiter) Allow(arg0 context.Context, arg1 uint32, arg2, arg3 string, arg4 math.Int) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockCoreumToXRPLTransferRateLimiterMockRecorder) Allow(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockCoreumToXRPLTransferRateLimiter)(nil).Allow), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ReportConsumption mocks base method.
+func (m *MockCoreumToXRPLTransferRateLimiter) ReportConsumption() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportConsumption")
+}
+
+// ReportConsumption indicates an expected call of ReportConsumption.
+func (mr *MockCoreumToXRPLTransferRateLimiterMockRecorder) ReportConsumption() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportConsumption", reflect.TypeOf((*MockCoreumToXRPLTransferRateLimiter)(nil).ReportConsumption))
+}
+
+// MockTransferRateLimiterMetricRegistry is a mock of TransferRateLimiterMetricRegistry interface.
+type MockTransferRateLimiterMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferRateLimiterMetricRegistryMockRecorder
+}
+
+// MockTransferRateLimiterMetricRegistryMockRecorder is the mock recorder for MockTransferRateLimiterMetricRegistry.
+type MockTransferRateLimiterMetricRegistryMockRecorder struct {
+	mock *MockTransferRateLimiterMetricRegistry
+}
+
+// NewMockTransferRateLimiterMetricRegistry creates a new mock instance.
+func NewMockTransferRateLimiterMetricRegistry(ctrl *gomock.Controller) *MockTransferRateLimiterMetricRegistry {
+	mock := &MockTransferRateLimiterMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockTransferRateLimiterMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferRateLimiterMetricRegistry) EXPECT() *MockTransferRateLimiterMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementCoreumToXRPLTransferRateLimitedOperationsCounter mocks base method.
+func (m *MockTransferRateLimiterMetricRegistry) IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementCoreumToXRPLTransferRateLimitedOperationsCounter", arg0, arg1)
+}
+
+// IncrementCoreumToXRPLTransferRateLimitedOperationsCounter indicates an expected call of IncrementCoreumToXRPLTransferRateLimitedOperationsCounter.
+func (mr *MockTransferRateLimiterMetricRegistryMockRecorder) IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCoreumToXRPLTransferRateLimitedOperationsCounter", reflect.TypeOf((*MockTransferRateLimiterMetricRegistry)(nil).IncrementCoreumToXRPLTransferRateLimitedOperationsCounter), arg0, arg1)
+}
+
+// SetCoreumToXRPLTransferRateLimitConsumption mocks base method.
+func (m *MockTransferRateLimiterMetricRegistry) SetCoreumToXRPLTransferRateLimitConsumption(arg0, arg1 string, arg2, arg3 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCoreumToXRPLTransferRateLimitConsumption", arg0, arg1, arg2, arg3)
+}
+
+// SetCoreumToXRPLTransferRateLimitConsumption indicates an expected call of SetCoreumToXRPLTransferRateLimitConsumption.
+func (mr *MockTransferRateLimiterMetricRegistryMockRecorder) SetCoreumToXRPLTransferRateLimitConsumption(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoreumToXRPLTransferRateLimitConsumption", reflect.TypeOf((*MockTransferRateLimiterMetricRegistry)(nil).SetCoreumToXRPLTransferRateLimitConsumption), arg0, arg1, arg2, arg3)
+}
+
+// MockCoreumToXRPLOperationAgeTracker is a mock of CoreumToXRPLOperationAgeTracker interface.
+type MockCoreumToXRPLOperationAgeTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLOperationAgeTrackerMockRecorder
+}
+
+// MockCoreumToXRPLOperationAgeTrackerMockRecorder is the mock recorder for MockCoreumToXRPLOperationAgeTracker.
+type MockCoreumToXRPLOperationAgeTrackerMockRecorder struct {
+	mock *MockCoreumToXRPLOperationAgeTracker
+}
+
+// NewMockCoreumToXRPLOperationAgeTracker creates a new mock instance.
+func NewMockCoreumToXRPLOperationAgeTracker(ctrl *gomock.Controller) *MockCoreumToXRPLOperationAgeTracker {
+	mock := &MockCoreumToXRPLOperationAgeTracker{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLOperationAgeTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLOperationAgeTracker) EXPECT() *MockCoreumToXRPLOperationAgeTrackerMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method.
+func (m *MockCoreumToXRPLOperationAgeTracker) Track(arg0 context.Context, arg1 []coreum.Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockCoreumToXRPLOperationAgeTrackerMockRecorder) Track(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockCoreumToXRPLOperationAgeTracker)(nil).Track), arg0, arg1)
+}
+
+// MockCoreumToXRPLTokenRegistry is a mock of CoreumToXRPLTokenRegistry interface.
+type MockCoreumToXRPLTokenRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLTokenRegistryMockRecorder
+}
+
+// MockCoreumToXRPLTokenRegistryMockRecorder is the mock recorder for MockCoreumToXRPLTokenRegistry.
+type MockCoreumToXRPLTokenRegistryMockRecorder struct {
+	mock *MockCoreumToXRPLTokenRegistry
+}
+
+// NewMockCoreumToXRPLTokenRegistry creates a new mock instance.
+func NewMockCoreumToXRPLTokenRegistry(ctrl *gomock.Controller) *MockCoreumToXRPLTokenRegistry {
+	mock := &MockCoreumToXRPLTokenRegistry{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLTokenRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLTokenRegistry) EXPECT() *MockCoreumToXRPLTokenRegistryMockRecorder {
+	return m.recorder
+}
+
+// IsXRPLTokenRegistered mocks base method.
+func (m *MockCoreumToXRPLTokenRegistry) IsXRPLTokenRegistered(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsXRPLTokenRegistered", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsXRPLTokenRegistered indicates an expected call of IsXRPLTokenRegistered.
+func (mr *MockCoreumToXRPLTokenRegistryMockRecorder) IsXRPLTokenRegistered(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsXRPLTokenRegistered", reflect.TypeOf((*MockCoreumToXRPLTokenRegistry)(nil).IsXRPLTokenRegistered), arg0, arg1, arg2)
+}
+
+// MockOperationAgeMetricRegistry is a mock of OperationAgeMetricRegistry interface.
+type MockOperationAgeMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationAgeMetricRegistryMockRecorder
+}
+
+// MockOperationAgeMetricRegistryMockRecorder is the mock recorder for MockOperationAgeMetricRegistry.
+type MockOperationAgeMetricRegistryMockRecorder struct {
+	mock *MockOperationAgeMetricRegistry
+}
+
+// NewMockOperationAgeMetricRegistry creates a new mock instance.
+func NewMockOperationAgeMetricRegistry(ctrl *gomock.Controller) *MockOperationAgeMetricRegistry {
+	mock := &MockOperationAgeMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockOperationAgeMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationAgeMetricRegistry) EXPECT() *MockOperationAgeMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// SetOldestPendingOperationAge mocks base method.
+func (m *MockOperationAgeMetricRegistry) SetOldestPendingOperationAge(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetOldestPendingOperationAge", arg0)
+}
+
+// SetOldestPendingOperationAge indicates an expected call of SetOldestPendingOperationAge.
+func (mr *MockOperationAgeMetricRegistryMockRecorder) SetOldestPendingOperationAge(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOldestPendingOperationAge", reflect.TypeOf((*MockOperationAgeMetricRegistry)(nil).SetOldestPendingOperationAge), arg0)
+}
+
+// SetPendingOperationMaxAge mocks base method.
+func (m *MockOperationAgeMetricRegistry) SetPendingOperationMaxAge(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPendingOperationMaxAge", arg0, arg1)
+}
+
+// SetPendingOperationMaxAge indicates an expected call of SetPendingOperationMaxAge.
+func (mr *MockOperationAgeMetricRegistryMockRecorder) SetPendingOperationMaxAge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingOperationMaxAge", reflect.TypeOf((*MockOperationAgeMetricRegistry)(nil).SetPendingOperationMaxAge), arg0, arg1)
+}
+
+// MockEvidenceAuditLogger is a mock of EvidenceAuditLogger interface.
+type MockEvidenceAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvidenceAuditLoggerMockRecorder
+}
+
+// MockEvidenceAuditLoggerMockRecorder is the mock recorder for MockEvidenceAuditLogger.
+type MockEvidenceAuditLoggerMockRecorder struct {
+	mock *MockEvidenceAuditLogger
+}
+
+// NewMockEvidenceAuditLogger creates a new mock instance.
+func NewMockEvidenceAuditLogger(ctrl *gomock.Controller) *MockEvidenceAuditLogger {
+	mock := &MockEvidenceAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockEvidenceAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvidenceAuditLogger) EXPECT() *MockEvidenceAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// LogEvidence mocks base method.
+func (m *MockEvidenceAuditLogger) LogEvidence(arg0 context.Context, arg1 processes.EvidenceAuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvidence", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogEvidence indicates an expected call of LogEvidence.
+func (mr *MockEvidenceAuditLoggerMockRecorder) LogEvidence(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvidence", reflect.TypeOf((*MockEvidenceAuditLogger)(nil).LogEvidence), arg0, arg1)
+}
+
+// MockXRPLToCoreumBlockedDeliveryQueue is a mock of XRPLToCoreumBlockedDeliveryQueue interface.
+type MockXRPLToCoreumBlockedDeliveryQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockXRPLToCoreumBlockedDeliveryQueueMockRecorder
+}
+
+// MockXRPLToCoreumBlockedDeliveryQueueMockRecorder is the mock recorder for MockXRPLToCoreumBlockedDeliveryQueue.
+type MockXRPLToCoreumBlockedDeliveryQueueMockRecorder struct {
+	mock *MockXRPLToCoreumBlockedDeliveryQueue
+}
+
+// NewMockXRPLToCoreumBlockedDeliveryQueue creates a new mock instance.
+func NewMockXRPLToCoreumBlockedDeliveryQueue(ctrl *gomock.Controller) *MockXRPLToCoreumBlockedDeliveryQueue {
+	mock := &MockXRPLToCoreumBlockedDeliveryQueue{ctrl: ctrl}
+	mock.recorder = &MockXRPLToCoreumBlockedDeliveryQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockXRPLToCoreumBlockedDeliveryQueue) EXPECT() *MockXRPLToCoreumBlockedDeliveryQueueMockRecorder {
+	return m.recorder
+}
+
+// Park mocks base method.
+func (m *MockXRPLToCoreumBlockedDeliveryQueue) Park(arg0 context.Context, arg1 coreum.XRPLToCoreumTransferEvidence, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Park", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Park indicates an expected call of Park.
+func (mr *MockXRPLToCoreumBlockedDeliveryQueueMockRecorder) Park(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Park", reflect.TypeOf((*MockXRPLToCoreumBlockedDeliveryQueue)(nil).Park), arg0, arg1, arg2)
+}
+
+// MockXRPLToCoreumEvidenceRetryQueue is a mock of XRPLToCoreumEvidenceRetryQueue interface.
+type MockXRPLToCoreumEvidenceRetryQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockXRPLToCoreumEvidenceRetryQueueMockRecorder
+}
+
+// MockXRPLToCoreumEvidenceRetryQueueMockRecorder is the mock recorder for MockXRPLToCoreumEvidenceRetryQueue.
+type MockXRPLToCoreumEvidenceRetryQueueMockRecorder struct {
+	mock *MockXRPLToCoreumEvidenceRetryQueue
+}
+
+// NewMockXRPLToCoreumEvidenceRetryQueue creates a new mock instance.
+func NewMockXRPLToCoreumEvidenceRetryQueue(ctrl *gomock.Controller) *MockXRPLToCoreumEvidenceRetryQueue {
+	mock := &MockXRPLToCoreumEvidenceRetryQueue{ctrl: ctrl}
+	mock.recorder = &MockXRPLToCoreumEvidenceRetryQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockXRPLToCoreumEvidenceRetryQueue) EXPECT() *MockXRPLToCoreumEvidenceRetryQueueMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockXRPLToCoreumEvidenceRetryQueue) Complete(arg0 data.TransactionWithMetaData) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Complete", arg0)
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockXRPLToCoreumEvidenceRetryQueueMockRecorder) Complete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockXRPLToCoreumEvidenceRetryQueue)(nil).Complete), arg0)
+}
+
+// Push mocks base method.
+func (m *MockXRPLToCoreumEvidenceRetryQueue) Push(arg0 context.Context, arg1 data.TransactionWithMetaData, arg2 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockXRPLToCoreumEvidenceRetryQueueMockRecorder) Push(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockXRPLToCoreumEvidenceRetryQueue)(nil).Push), arg0, arg1, arg2)
+}
+
+// Start mocks base method.
+func (m *MockXRPLToCoreumEvidenceRetryQueue) Start(arg0 context.Context, arg1 chan<- data.TransactionWithMetaData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockXRPLToCoreumEvidenceRetryQueueMockRecorder) Start(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockXRPLToCoreumEvidenceRetryQueue)(nil).Start), arg0, arg1)
+}
+
+// MockBlockedDeliveryContractClient is a mock of BlockedDeliveryContractClient interface.
+type MockBlockedDeliveryContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockedDeliveryContractClientMockRecorder
+}
+
+// MockBlockedDeliveryContractClientMockRecorder is the mock recorder for MockBlockedDeliveryContractClient.
+type MockBlockedDeliveryContractClientMockRecorder struct {
+	mock *MockBlockedDeliveryContractClient
+}
+
+// NewMockBlockedDeliveryContractClient creates a new mock instance.
+func NewMockBlockedDeliveryContractClient(ctrl *gomock.Controller) *MockBlockedDeliveryContractClient {
+	mock := &MockBlockedDeliveryContractClient{ctrl: ctrl}
+	mock.recorder = &MockBlockedDeliveryContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockedDeliveryContractClient) EXPECT() *MockBlockedDeliveryContractClientMockRecorder {
+	return m.recorder
+}
+
+// GetCoreumTokens mocks base method.
+func (m *MockBlockedDeliveryContractClient) GetCoreumTokens(arg0 context.Context) ([]coreum.CoreumToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreumTokens", arg0)
+	ret0, _ := ret[0].([]coreum.CoreumToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreumTokens indicates an expected call of GetCoreumTokens.
+func (mr *MockBlockedDeliveryContractClientMockRecorder) GetCoreumTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreumTokens", reflect.TypeOf((*MockBlockedDeliveryContractClient)(nil).GetCoreumTokens), arg0)
+}
+
+// GetXRPLTokens mocks base method.
+func (m *MockBlockedDeliveryContractClient) GetXRPLTokens(arg0 context.Context) ([]coreum.XRPLToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetXRPLTokens", arg0)
+	ret0, _ := ret[0].([]coreum.XRPLToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetXRPLTokens indicates an expected call of GetXRPLTokens.
+func (mr *MockBlockedDeliveryContractClientMockRecorder) GetXRPLTokens(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXRPLTokens", reflect.TypeOf((*MockBlockedDeliveryContractClient)(nil).GetXRPLTokens), arg0)
+}
+
+// SendXRPLToCoreumTransferEvidence mocks base method.
+func (m *MockBlockedDeliveryContractClient) SendXRPLToCoreumTransferEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLToCoreumTransferEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLToCoreumTransferEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLToCoreumTransferEvidence indicates an expected call of SendXRPLToCoreumTransferEvidence.
+func (mr *MockBlockedDeliveryContractClientMockRecorder) SendXRPLToCoreumTransferEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLToCoreumTransferEvidence", reflect.TypeOf((*MockBlockedDeliveryContractClient)(nil).SendXRPLToCoreumTransferEvidence), arg0, arg1, arg2)
+}
+
+// MockBlockedDeliveryMetricRegistry is a mock of BlockedDeliveryMetricRegistry interface.
+type MockBlockedDeliveryMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockedDeliveryMetricRegistryMockRecorder
+}
+
+// MockBlockedDeliveryMetricRegistryMockRecorder is the mock recorder for MockBlockedDeliveryMetricRegistry.
+type MockBlockedDeliveryMetricRegistryMockRecorder struct {
+	mock *MockBlockedDeliveryMetricRegistry
+}
+
+// NewMockBlockedDeliveryMetricRegistry creates a new mock instance.
+func NewMockBlockedDeliveryMetricRegistry(ctrl *gomock.Controller) *MockBlockedDeliveryMetricRegistry {
+	mock := &MockBlockedDeliveryMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockBlockedDeliveryMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockedDeliveryMetricRegistry) EXPECT() *MockBlockedDeliveryMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// SetBlockedDeliveriesCount mocks base method.
+func (m *MockBlockedDeliveryMetricRegistry) SetBlockedDeliveriesCount(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBlockedDeliveriesCount", arg0, arg1)
+}
+
+// SetBlockedDeliveriesCount indicates an expected call of SetBlockedDeliveriesCount.
+func (mr *MockBlockedDeliveryMetricRegistryMockRecorder) SetBlockedDeliveriesCount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockedDeliveriesCount", reflect.TypeOf((*MockBlockedDeliveryMetricRegistry)(nil).SetBlockedDeliveriesCount), arg0, arg1)
+}
+
+// MockXRPLTransferLatencyObserver is a mock of XRPLTransferLatencyObserver interface.
+type MockXRPLTransferLatencyObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockXRPLTransferLatencyObserverMockRecorder
+}
+
+// MockXRPLTransferLatencyObserverMockRecorder is the mock recorder for MockXRPLTransferLatencyObserver.
+type MockXRPLTransferLatencyObserverMockRecorder struct {
+	mock *MockXRPLTransferLatencyObserver
+}
+
+// NewMockXRPLTransferLatencyObserver creates a new mock instance.
+func NewMockXRPLTransferLatencyObserver(ctrl *gomock.Controller) *MockXRPLTransferLatencyObserver {
+	mock := &MockXRPLTransferLatencyObserver{ctrl: ctrl}
+	mock.recorder = &MockXRPLTransferLatencyObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockXRPLTransferLatencyObserver) EXPECT() *MockXRPLTransferLatencyObserverMockRecorder {
+	return m.recorder
+}
+
+// ObserveCoreumToXRPLCompletion mocks base method.
+func (m *MockXRPLTransferLatencyObserver) ObserveCoreumToXRPLCompletion(arg0 context.Context, arg1 uint32, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObserveCoreumToXRPLCompletion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ObserveCoreumToXRPLCompletion indicates an expected call of ObserveCoreumToXRPLCompletion.
+func (mr *MockXRPLTransferLatencyObserverMockRecorder) ObserveCoreumToXRPLCompletion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveCoreumToXRPLCompletion", reflect.TypeOf((*MockXRPLTransferLatencyObserver)(nil).ObserveCoreumToXRPLCompletion), arg0, arg1, arg2)
+}
+
+// ObserveXRPLToCoreumStart mocks base method.
+func (m *MockXRPLTransferLatencyObserver) ObserveXRPLToCoreumStart(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObserveXRPLToCoreumStart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ObserveXRPLToCoreumStart indicates an expected call of ObserveXRPLToCoreumStart.
+func (mr *MockXRPLTransferLatencyObserverMockRecorder) ObserveXRPLToCoreumStart(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveXRPLToCoreumStart", reflect.TypeOf((*MockXRPLTransferLatencyObserver)(nil).ObserveXRPLToCoreumStart), arg0, arg1, arg2)
+}
+
+// MockTransferLatencyMetricRegistry is a mock of TransferLatencyMetricRegistry interface.
+type MockTransferLatencyMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLatencyMetricRegistryMockRecorder
+}
+
+// MockTransferLatencyMetricRegistryMockRecorder is the mock recorder for MockTransferLatencyMetricRegistry.
+type MockTransferLatencyMetricRegistryMockRecorder struct {
+	mock *MockTransferLatencyMetricRegistry
+}
+
+// NewMockTransferLatencyMetricRegistry creates a new mock instance.
+func NewMockTransferLatencyMetricRegistry(ctrl *gomock.Controller) *MockTransferLatencyMetricRegistry {
+	mock := &MockTransferLatencyMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockTransferLatencyMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLatencyMetricRegistry) EXPECT() *MockTransferLatencyMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// ObserveCoreumToXRPLLatency mocks base method.
+func (m *MockTransferLatencyMetricRegistry) ObserveCoreumToXRPLLatency(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveCoreumToXRPLLatency", arg0)
+}
+
+// ObserveCoreumToXRPLLatency indicates an expected call of ObserveCoreumToXRPLLatency.
+func (mr *MockTransferLatencyMetricRegistryMockRecorder) ObserveCoreumToXRPLLatency(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveCoreumToXRPLLatency", reflect.TypeOf((*MockTransferLatencyMetricRegistry)(nil).ObserveCoreumToXRPLLatency), arg0)
+}
+
+// ObserveXRPLToCoreumLatency mocks base method.
+func (m *MockTransferLatencyMetricRegistry) ObserveXRPLToCoreumLatency(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveXRPLToCoreumLatency", arg0)
+}
+
+// ObserveXRPLToCoreumLatency indicates an expected call of ObserveXRPLToCoreumLatency.
+func (mr *MockTransferLatencyMetricRegistryMockRecorder) ObserveXRPLToCoreumLatency(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveXRPLToCoreumLatency", reflect.TypeOf((*MockTransferLatencyMetricRegistry)(nil).ObserveXRPLToCoreumLatency), arg0)
+}
+
+// MockRefundRelayerContractClient is a mock of RefundRelayerContractClient interface.
+type MockRefundRelayerContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundRelayerContractClientMockRecorder
+}
+
+// MockRefundRelayerContractClientMockRecorder is the mock recorder for MockRefundRelayerContractClient.
+type MockRefundRelayerContractClientMockRecorder struct {
+	mock *MockRefundRelayerContractClient
+}
+
+// NewMockRefundRelayerContractClient creates a new mock instance.
+func NewMockRefundRelayerContractClient(ctrl *gomock.Controller) *MockRefundRelayerContractClient {
+	mock := &MockRefundRelayerContractClient{ctrl: ctrl}
+	mock.recorder = &MockRefundRelayerContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundRelayerContractClient) EXPECT() *MockRefundRelayerContractClientMockRecorder {
+	return m.recorder
+}
+
+// ClaimRefundsOnBehalf mocks base method.
+func (m *MockRefundRelayerContractClient) ClaimRefundsOnBehalf(arg0 context.Context, arg1, arg2, arg3 types.AccAddress, arg4 []string) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRefundsOnBehalf", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRefundsOnBehalf indicates an expected call of ClaimRefundsOnBehalf.
+func (mr *MockRefundRelayerContractClientMockRecorder) ClaimRefundsOnBehalf(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRefundsOnBehalf", reflect.TypeOf((*MockRefundRelayerContractClient)(nil).ClaimRefundsOnBehalf), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetContractExecutionGranters mocks base method.
+func (m *MockRefundRelayerContractClient) GetContractExecutionGranters(arg0 context.Context, arg1 types.AccAddress) ([]types.AccAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractExecutionGranters", arg0, arg1)
+	ret0, _ := ret[0].([]types.AccAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContractExecutionGranters indicates an expected call of GetContractExecutionGranters.
+func (mr *MockRefundRelayerContractClientMockRecorder) GetContractExecutionGranters(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractExecutionGranters", reflect.TypeOf((*MockRefundRelayerContractClient)(nil).GetContractExecutionGranters), arg0, arg1)
+}
+
+// GetFeeAllowanceGranters mocks base method.
+func (m *MockRefundRelayerContractClient) GetFeeAllowanceGranters(arg0 context.Context, arg1 types.AccAddress) ([]types.AccAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeAllowanceGranters", arg0, arg1)
+	ret0, _ := ret[0].([]types.AccAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeAllowanceGranters indicates an expected call of GetFeeAllowanceGranters.
+func (mr *MockRefundRelayerContractClientMockRecorder) GetFeeAllowanceGranters(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeAllowanceGranters", reflect.TypeOf((*MockRefundRelayerContractClient)(nil).GetFeeAllowanceGranters), arg0, arg1)
+}
+
+// GetPendingRefunds mocks base method.
+func (m *MockRefundRelayerContractClient) GetPendingRefunds(arg0 context.Context, arg1 types.AccAddress) ([]coreum.PendingRefund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRefunds", arg0, arg1)
+	ret0, _ := ret[0].([]coreum.PendingRefund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRefunds indicates an expected call of GetPendingRefunds.
+func (mr *MockRefundRelayerContractClientMockRecorder) GetPendingRefunds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRefunds", reflect.TypeOf((*MockRefundRelayerContractClient)(nil).GetPendingRefunds), arg0, arg1)
+}
+
+// MockRefundRelayerMetricRegistry is a mock of RefundRelayerMetricRegistry interface.
+type MockRefundRelayerMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundRelayerMetricRegistryMockRecorder
+}
+
+// MockRefundRelayerMetricRegistryMockRecorder is the mock recorder for MockRefundRelayerMetricRegistry.
+type MockRefundRelayerMetricRegistryMockRecorder struct {
+	mock *MockRefundRelayerMetricRegistry
+}
+
+// NewMockRefundRelayerMetricRegistry creates a new mock instance.
+func NewMockRefundRelayerMetricRegistry(ctrl *gomock.Controller) *MockRefundRelayerMetricRegistry {
+	mock := &MockRefundRelayerMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockRefundRelayerMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundRelayerMetricRegistry) EXPECT() *MockRefundRelayerMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// AddRelayedRefundClaims mocks base method.
+func (m *MockRefundRelayerMetricRegistry) AddRelayedRefundClaims(arg0 string, arg1 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddRelayedRefundClaims", arg0, arg1)
+}
+
+// AddRelayedRefundClaims indicates an expected call of AddRelayedRefundClaims.
+func (mr *MockRefundRelayerMetricRegistryMockRecorder) AddRelayedRefundClaims(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelayedRefundClaims", reflect.TypeOf((*MockRefundRelayerMetricRegistry)(nil).AddRelayedRefundClaims), arg0, arg1)
+}
+
+// SetUnclaimedRefunds mocks base method.
+func (m *MockRefundRelayerMetricRegistry) SetUnclaimedRefunds(arg0 string, arg1 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUnclaimedRefunds", arg0, arg1)
+}
+
+// SetUnclaimedRefunds indicates an expected call of SetUnclaimedRefunds.
+func (mr *MockRefundRelayerMetricRegistryMockRecorder) SetUnclaimedRefunds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnclaimedRefunds", reflect.TypeOf((*MockRefundRelayerMetricRegistry)(nil).SetUnclaimedRefunds), arg0, arg1)
+}
+
+// MockCheckCasherContractClient is a mock of CheckCasherContractClient interface.
+type MockCheckCasherContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckCasherContractClientMockRecorder
+}
+
+// MockCheckCasherContractClientMockRecorder is the mock recorder for MockCheckCasherContractClient.
+type MockCheckCasherContractClientMockRecorder struct {
+	mock *MockCheckCasherContractClient
+}
+
+// NewMockCheckCasherContractClient creates a new mock instance.
+func NewMockCheckCasherContractClient(ctrl *gomock.Controller) *MockCheckCasherContractClient {
+	mock := &MockCheckCasherContractClient{ctrl: ctrl}
+	mock.recorder = &MockCheckCasherContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckCasherContractClient) EXPECT() *MockCheckCasherContractClientMockRecorder {
+	return m.recorder
+}
+
+// SendXRPLCheckCashEvidence mocks base method.
+func (m *MockCheckCasherContractClient) SendXRPLCheckCashEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.XRPLCheckCashEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendXRPLCheckCashEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendXRPLCheckCashEvidence indicates an expected call of SendXRPLCheckCashEvidence.
+func (mr *MockCheckCasherContractClientMockRecorder) SendXRPLCheckCashEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendXRPLCheckCashEvidence", reflect.TypeOf((*MockCheckCasherContractClient)(nil).SendXRPLCheckCashEvidence), arg0, arg1, arg2)
+}
+
+// MockIBCTransferTrackerContractClient is a mock of IBCTransferTrackerContractClient interface.
+type MockIBCTransferTrackerContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBCTransferTrackerContractClientMockRecorder
+}
+
+// MockIBCTransferTrackerContractClientMockRecorder is the mock recorder for MockIBCTransferTrackerContractClient.
+type MockIBCTransferTrackerContractClientMockRecorder struct {
+	mock *MockIBCTransferTrackerContractClient
+}
+
+// NewMockIBCTransferTrackerContractClient creates a new mock instance.
+func NewMockIBCTransferTrackerContractClient(ctrl *gomock.Controller) *MockIBCTransferTrackerContractClient {
+	mock := &MockIBCTransferTrackerContractClient{ctrl: ctrl}
+	mock.recorder = &MockIBCTransferTrackerContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBCTransferTrackerContractClient) EXPECT() *MockIBCTransferTrackerContractClientMockRecorder {
+	return m.recorder
+}
+
+// GetIBCPacketStatus mocks base method.
+func (m *MockIBCTransferTrackerContractClient) GetIBCPacketStatus(arg0 context.Context, arg1 coreum.IBCPacket) (coreum.IBCPacketStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIBCPacketStatus", arg0, arg1)
+	ret0, _ := ret[0].(coreum.IBCPacketStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIBCPacketStatus indicates an expected call of GetIBCPacketStatus.
+func (mr *MockIBCTransferTrackerContractClientMockRecorder) GetIBCPacketStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIBCPacketStatus", reflect.TypeOf((*MockIBCTransferTrackerContractClient)(nil).GetIBCPacketStatus), arg0, arg1)
+}
+
+// GetIBCTransferPacket mocks base method.
+func (m *MockIBCTransferTrackerContractClient) GetIBCTransferPacket(arg0 context.Context, arg1 string) (*coreum.IBCPacket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIBCTransferPacket", arg0, arg1)
+	ret0, _ := ret[0].(*coreum.IBCPacket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIBCTransferPacket indicates an expected call of GetIBCTransferPacket.
+func (mr *MockIBCTransferTrackerContractClientMockRecorder) GetIBCTransferPacket(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIBCTransferPacket", reflect.TypeOf((*MockIBCTransferTrackerContractClient)(nil).GetIBCTransferPacket), arg0, arg1)
+}
+
+// GetPendingIBCTransfers mocks base method.
+func (m *MockIBCTransferTrackerContractClient) GetPendingIBCTransfers(arg0 context.Context) ([]coreum.PendingIBCTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingIBCTransfers", arg0)
+	ret0, _ := ret[0].([]coreum.PendingIBCTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingIBCTransfers indicates an expected call of GetPendingIBCTransfers.
+func (mr *MockIBCTransferTrackerContractClientMockRecorder) GetPendingIBCTransfers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingIBCTransfers", reflect.TypeOf((*MockIBCTransferTrackerContractClient)(nil).GetPendingIBCTransfers), arg0)
+}
+
+// SendIBCTransferResultEvidence mocks base method.
+func (m *MockIBCTransferTrackerContractClient) SendIBCTransferResultEvidence(arg0 context.Context, arg1 types.AccAddress, arg2 coreum.IBCTransferResultEvidence) (*types.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendIBCTransferResultEvidence", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendIBCTransferResultEvidence indicates an expected call of SendIBCTransferResultEvidence.
+func (mr *MockIBCTransferTrackerContractClientMockRecorder) SendIBCTransferResultEvidence(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendIBCTransferResultEvidence", reflect.TypeOf((*MockIBCTransferTrackerContractClient)(nil).SendIBCTransferResultEvidence), arg0, arg1, arg2)
+}
+
+// MockXRPLCheckScanner is a mock of XRPLCheckScanner interface.
+type MockXRPLCheckScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockXRPLCheckScannerMockRecorder
+}
+
+// MockXRPLCheckScannerMockRecorder is the mock recorder for MockXRPLCheckScanner.
+type MockXRPLCheckScannerMockRecorder struct {
+	mock *MockXRPLCheckScanner
+}
+
+// NewMockXRPLCheckScanner creates a new mock instance.
+func NewMockXRPLCheckScanner(ctrl *gomock.Controller) *MockXRPLCheckScanner {
+	mock := &MockXRPLCheckScanner{ctrl: ctrl}
+	mock.recorder = &MockXRPLCheckScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockXRPLCheckScanner) EXPECT() *MockXRPLCheckScannerMockRecorder {
+	return m.recorder
+}
+
+// ScanChecks mocks base method.
+func (m *MockXRPLCheckScanner) ScanChecks(arg0 context.Context) ([]xrpl.Check, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanChecks", arg0)
+	ret0, _ := ret[0].([]xrpl.Check)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanChecks indicates an expected call of ScanChecks.
+func (mr *MockXRPLCheckScannerMockRecorder) ScanChecks(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanChecks", reflect.TypeOf((*MockXRPLCheckScanner)(nil).ScanChecks), arg0)
+}
+
+// MockXRPLTxResultRPCClient is a mock of XRPLTxResultRPCClient interface.
+type MockXRPLTxResultRPCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockXRPLTxResultRPCClientMockRecorder
+}
+
+// MockXRPLTxResultRPCClientMockRecorder is the mock recorder for MockXRPLTxResultRPCClient.
+type MockXRPLTxResultRPCClientMockRecorder struct {
+	mock *MockXRPLTxResultRPCClient
+}
+
+// NewMockXRPLTxResultRPCClient creates a new mock instance.
+func NewMockXRPLTxResultRPCClient(ctrl *gomock.Controller) *MockXRPLTxResultRPCClient {
+	mock := &MockXRPLTxResultRPCClient{ctrl: ctrl}
+	mock.recorder = &MockXRPLTxResultRPCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockXRPLTxResultRPCClient) EXPECT() *MockXRPLTxResultRPCClientMockRecorder {
+	return m.recorder
+}
+
+// Tx mocks base method.
+func (m *MockXRPLTxResultRPCClient) Tx(arg0 context.Context, arg1 data.Hash256) (xrpl.TxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tx", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.TxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockXRPLTxResultRPCClientMockRecorder) Tx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockXRPLTxResultRPCClient)(nil).Tx), arg0, arg1)
+}
+
+// MockCoreumToXRPLTxResultTracker is a mock of CoreumToXRPLTxResultTracker interface.
+type MockCoreumToXRPLTxResultTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLTxResultTrackerMockRecorder
+}
+
+// MockCoreumToXRPLTxResultTrackerMockRecorder is the mock recorder for MockCoreumToXRPLTxResultTracker.
+type MockCoreumToXRPLTxResultTrackerMockRecorder struct {
+	mock *MockCoreumToXRPLTxResultTracker
+}
+
+// NewMockCoreumToXRPLTxResultTracker creates a new mock instance.
+func NewMockCoreumToXRPLTxResultTracker(ctrl *gomock.Controller) *MockCoreumToXRPLTxResultTracker {
+	mock := &MockCoreumToXRPLTxResultTracker{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLTxResultTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLTxResultTracker) EXPECT() *MockCoreumToXRPLTxResultTrackerMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method.
+func (m *MockCoreumToXRPLTxResultTracker) Track(arg0 context.Context, arg1 coreum.Operation, arg2 data.Transaction, arg3 xrpl.SubmitResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockCoreumToXRPLTxResultTrackerMockRecorder) Track(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockCoreumToXRPLTxResultTracker)(nil).Track), arg0, arg1, arg2, arg3)
+}
+
+// MockCoreumToXRPLSignatureAggregationTracker is a mock of CoreumToXRPLSignatureAggregationTracker interface.
+type MockCoreumToXRPLSignatureAggregationTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLSignatureAggregationTrackerMockRecorder
+}
+
+// MockCoreumToXRPLSignatureAggregationTrackerMockRecorder is the mock recorder for MockCoreumToXRPLSignatureAggregationTracker.
+type MockCoreumToXRPLSignatureAggregationTrackerMockRecorder struct {
+	mock *MockCoreumToXRPLSignatureAggregationTracker
+}
+
+// NewMockCoreumToXRPLSignatureAggregationTracker creates a new mock instance.
+func NewMockCoreumToXRPLSignatureAggregationTracker(ctrl *gomock.Controller) *MockCoreumToXRPLSignatureAggregationTracker {
+	mock := &MockCoreumToXRPLSignatureAggregationTracker{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLSignatureAggregationTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLSignatureAggregationTracker) EXPECT() *MockCoreumToXRPLSignatureAggregationTrackerMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method.
+func (m *MockCoreumToXRPLSignatureAggregationTracker) Track(arg0 context.Context, arg1 []coreum.Operation) ([]coreum.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", arg0, arg1)
+	ret0, _ := ret[0].([]coreum.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockCoreumToXRPLSignatureAggregationTrackerMockRecorder) Track(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockCoreumToXRPLSignatureAggregationTracker)(nil).Track), arg0, arg1)
+}
+
+// MockTransferHistoryRecorder is a mock of TransferHistoryRecorder interface.
+type MockTransferHistoryRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferHistoryRecorderMockRecorder
+}
+
+// MockTransferHistoryRecorderMockRecorder is the mock recorder for MockTransferHistoryRecorder.
+type MockTransferHistoryRecorderMockRecorder struct {
+	mock *MockTransferHistoryRecorder
+}
+
+// NewMockTransferHistoryRecorder creates a new mock instance.
+func NewMockTransferHistoryRecorder(ctrl *gomock.Controller) *MockTransferHistoryRecorder {
+	mock := &MockTransferHistoryRecorder{ctrl: ctrl}
+	mock.recorder = &MockTransferHistoryRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferHistoryRecorder) EXPECT() *MockTransferHistoryRecorderMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockTransferHistoryRecorder) Append(arg0 context.Context, arg1 processes.TransferHistoryRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockTransferHistoryRecorderMockRecorder) Append(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockTransferHistoryRecorder)(nil).Append), arg0, arg1)
+}
+
+// MockTicketUsageRecorder is a mock of TicketUsageRecorder interface.
+type MockTicketUsageRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketUsageRecorderMockRecorder
+}
+
+// MockTicketUsageRecorderMockRecorder is the mock recorder for MockTicketUsageRecorder.
+type MockTicketUsageRecorderMockRecorder struct {
+	mock *MockTicketUsageRecorder
+}
+
+// NewMockTicketUsageRecorder creates a new mock instance.
+func NewMockTicketUsageRecorder(ctrl *gomock.Controller) *MockTicketUsageRecorder {
+	mock := &MockTicketUsageRecorder{ctrl: ctrl}
+	mock.recorder = &MockTicketUsageRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketUsageRecorder) EXPECT() *MockTicketUsageRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockTicketUsageRecorder) Record(arg0 context.Context, arg1 processes.TicketUsageRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockTicketUsageRecorderMockRecorder) Record(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockTicketUsageRecorder)(nil).Record), arg0, arg1)
+}
+
+// MockTicketUsageMetricRegistry is a mock of TicketUsageMetricRegistry interface.
+type MockTicketUsageMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketUsageMetricRegistryMockRecorder
+}
+
+// MockTicketUsageMetricRegistryMockRecorder is the mock recorder for MockTicketUsageMetricRegistry.
+type MockTicketUsageMetricRegistryMockRecorder struct {
+	mock *MockTicketUsageMetricRegistry
+}
+
+// NewMockTicketUsageMetricRegistry creates a new mock instance.
+func NewMockTicketUsageMetricRegistry(ctrl *gomock.Controller) *MockTicketUsageMetricRegistry {
+	mock := &MockTicketUsageMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockTicketUsageMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketUsageMetricRegistry) EXPECT() *MockTicketUsageMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementTicketsConsumedCounter mocks base method.
+func (m *MockTicketUsageMetricRegistry) IncrementTicketsConsumedCounter(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementTicketsConsumedCounter", arg0, arg1)
+}
+
+// IncrementTicketsConsumedCounter indicates an expected call of IncrementTicketsConsumedCounter.
+func (mr *MockTicketUsageMetricRegistryMockRecorder) IncrementTicketsConsumedCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTicketsConsumedCounter", reflect.TypeOf((*MockTicketUsageMetricRegistry)(nil).IncrementTicketsConsumedCounter), arg0, arg1)
+}
+
+// MockCoreumToXRPLOperationsCache is a mock of CoreumToXRPLOperationsCache interface.
+type MockCoreumToXRPLOperationsCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoreumToXRPLOperationsCacheMockRecorder
+}
+
+// MockCoreumToXRPLOperationsCacheMockRecorder is the mock recorder for MockCoreumToXRPLOperationsCache.
+type MockCoreumToXRPLOperationsCacheMockRecorder struct {
+	mock *MockCoreumToXRPLOperationsCache
+}
+
+// NewMockCoreumToXRPLOperationsCache creates a new mock instance.
+func NewMockCoreumToXRPLOperationsCache(ctrl *gomock.Controller) *MockCoreumToXRPLOperationsCache {
+	mock := &MockCoreumToXRPLOperationsCache{ctrl: ctrl}
+	mock.recorder = &MockCoreumToXRPLOperationsCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoreumToXRPLOperationsCache) EXPECT() *MockCoreumToXRPLOperationsCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateContractConfig mocks base method.
+func (m *MockCoreumToXRPLOperationsCache) InvalidateContractConfig() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateContractConfig")
+}
+
+// InvalidateContractConfig indicates an expected call of InvalidateContractConfig.
+func (mr *MockCoreumToXRPLOperationsCacheMockRecorder) InvalidateContractConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateContractConfig", reflect.TypeOf((*MockCoreumToXRPLOperationsCache)(nil).InvalidateContractConfig))
+}
+
+// InvalidatePendingOperations mocks base method.
+func (m *MockCoreumToXRPLOperationsCache) InvalidatePendingOperations() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidatePendingOperations")
+}
+
+// InvalidatePendingOperations indicates an expected call of InvalidatePendingOperations.
+func (mr *MockCoreumToXRPLOperationsCacheMockRecorder) InvalidatePendingOperations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePendingOperations", reflect.TypeOf((*MockCoreumToXRPLOperationsCache)(nil).InvalidatePendingOperations))
+}
+
+// MockKeyUsageAuditMetricRegistry is a mock of KeyUsageAuditMetricRegistry interface.
+type MockKeyUsageAuditMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyUsageAuditMetricRegistryMockRecorder
+}
+
+// MockKeyUsageAuditMetricRegistryMockRecorder is the mock recorder for MockKeyUsageAuditMetricRegistry.
+type MockKeyUsageAuditMetricRegistryMockRecorder struct {
+	mock *MockKeyUsageAuditMetricRegistry
+}
+
+// NewMockKeyUsageAuditMetricRegistry creates a new mock instance.
+func NewMockKeyUsageAuditMetricRegistry(ctrl *gomock.Controller) *MockKeyUsageAuditMetricRegistry {
+	mock := &MockKeyUsageAuditMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockKeyUsageAuditMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyUsageAuditMetricRegistry) EXPECT() *MockKeyUsageAuditMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// IncrementKeyUsageAuditLogFailuresCounter mocks base method.
+func (m *MockKeyUsageAuditMetricRegistry) IncrementKeyUsageAuditLogFailuresCounter(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncrementKeyUsageAuditLogFailuresCounter", arg0)
+}
+
+// IncrementKeyUsageAuditLogFailuresCounter indicates an expected call of IncrementKeyUsageAuditLogFailuresCounter.
+func (mr *MockKeyUsageAuditMetricRegistryMockRecorder) IncrementKeyUsageAuditLogFailuresCounter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementKeyUsageAuditLogFailuresCounter", reflect.TypeOf((*MockKeyUsageAuditMetricRegistry)(nil).IncrementKeyUsageAuditLogFailuresCounter), arg0)
+}
@@ -0,0 +1,157 @@
+package processes
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// UnsignedOperation is the pending operation with the XRPL transaction to be signed offline.
+type UnsignedOperation struct {
+	OperationID      uint32 `json:"operation_id"`
+	OperationVersion uint32 `json:"operation_version"`
+	// TxBlob is hex encoded XRPL transaction prepared for the multi-signing.
+	TxBlob string `json:"tx_blob"`
+}
+
+// UnsignedOperations is the set of the pending operations to be signed offline.
+type UnsignedOperations struct {
+	BridgeXRPLAddress string              `json:"bridge_xrpl_address"`
+	Operations        []UnsignedOperation `json:"operations"`
+}
+
+// OperationSignature is the offline produced signature of the pending operation.
+type OperationSignature struct {
+	OperationID      uint32 `json:"operation_id"`
+	OperationVersion uint32 `json:"operation_version"`
+	// XRPLAddress is the XRPL address of the signer.
+	XRPLAddress string `json:"xrpl_address"`
+	Signature   string `json:"signature"`
+}
+
+// OperationSignatures is the set of the offline produced signatures.
+type OperationSignatures struct {
+	Signatures []OperationSignature `json:"signatures"`
+}
+
+// BuildUnsignedOperations builds the unsigned XRPL transactions for the pending operations not yet signed by the
+// relayer.
+func BuildUnsignedOperations(
+	bridgeXRPLAddress rippledata.Account,
+	sourceTag *uint32,
+	relayerCoreumAddress sdk.AccAddress,
+	operations []coreum.Operation,
+	feeCfg MultiSigningTxFeeConfig,
+) (UnsignedOperations, error) {
+	unsignedOperations := make([]UnsignedOperation, 0, len(operations))
+	for _, operation := range operations {
+		signedByRelayer := lo.ContainsBy(operation.Signatures, func(signature coreum.Signature) bool {
+			return signature.RelayerCoreumAddress.Equals(relayerCoreumAddress)
+		})
+		if signedByRelayer {
+			continue
+		}
+
+		tx, err := BuildXRPLTxFromOperation(bridgeXRPLAddress, sourceTag, operation, feeCfg)
+		if err != nil {
+			return UnsignedOperations{}, err
+		}
+		txBlob, err := EncodeUnsignedTx(tx)
+		if err != nil {
+			return UnsignedOperations{}, err
+		}
+		unsignedOperations = append(unsignedOperations, UnsignedOperation{
+			OperationID:      operation.GetOperationID(),
+			OperationVersion: operation.Version,
+			TxBlob:           txBlob,
+		})
+	}
+
+	return UnsignedOperations{
+		BridgeXRPLAddress: bridgeXRPLAddress.String(),
+		Operations:        unsignedOperations,
+	}, nil
+}
+
+// SignUnsignedOperations multi-signs the unsigned operations with the provided key.
+func SignUnsignedOperations(
+	unsignedOperations UnsignedOperations,
+	signer XRPLTxSigner,
+	keyName string,
+) (OperationSignatures, error) {
+	signatures := make([]OperationSignature, 0, len(unsignedOperations.Operations))
+	for _, operation := range unsignedOperations.Operations {
+		tx, err := DecodeUnsignedTx(operation.TxBlob)
+		if err != nil {
+			return OperationSignatures{}, errors.Wrapf(err, "failed to decode operation %d", operation.OperationID)
+		}
+		if tx.GetBase().Account.String() != unsignedOperations.BridgeXRPLAddress {
+			return OperationSignatures{}, errors.Errorf(
+				"operation %d tx account %s doesn't match the bridge XRPL address %s",
+				operation.OperationID, tx.GetBase().Account.String(), unsignedOperations.BridgeXRPLAddress,
+			)
+		}
+		txSigner, err := signer.MultiSign(tx, keyName)
+		if err != nil {
+			return OperationSignatures{}, errors.Wrapf(
+				err, "failed to sign operation %d, keyName:%s", operation.OperationID, keyName,
+			)
+		}
+		signatures = append(signatures, OperationSignature{
+			OperationID:      operation.OperationID,
+			OperationVersion: operation.OperationVersion,
+			XRPLAddress:      txSigner.Signer.Account.String(),
+			Signature:        txSigner.Signer.TxnSignature.String(),
+		})
+	}
+
+	return OperationSignatures{
+		Signatures: signatures,
+	}, nil
+}
+
+// ToSaveSignatureRequests converts the signatures to the contract save signature requests.
+func (s OperationSignatures) ToSaveSignatureRequests() []coreum.SaveSignatureRequest {
+	return lo.Map(s.Signatures, func(signature OperationSignature, _ int) coreum.SaveSignatureRequest {
+		return coreum.SaveSignatureRequest{
+			OperationID:      signature.OperationID,
+			OperationVersion: signature.OperationVersion,
+			Signature:        signature.Signature,
+		}
+	})
+}
+
+// EncodeUnsignedTx encodes the transaction prepared for the multi-signing to the hex string.
+func EncodeUnsignedTx(tx MultiSignableTransaction) (string, error) {
+	_, raw, err := rippledata.Raw(tx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode XRPL transaction")
+	}
+
+	return strings.ToUpper(hex.EncodeToString(raw)), nil
+}
+
+// DecodeUnsignedTx decodes the transaction encoded with the EncodeUnsignedTx.
+func DecodeUnsignedTx(txBlob string) (MultiSignableTransaction, error) {
+	raw, err := hex.DecodeString(txBlob)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode XRPL transaction hex")
+	}
+	tx, err := rippledata.ReadTransaction(bytes.NewReader(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode XRPL transaction")
+	}
+	multiSignableTx, ok := tx.(MultiSignableTransaction)
+	if !ok {
+		return nil, errors.Errorf("XRPL transaction %s is not multi-signable", tx.GetType())
+	}
+
+	return multiSignableTx, nil
+}
@@ -0,0 +1,137 @@
+package processes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	xrplTxSignerKeyName := "xrpl-tx-signer"
+	contractRelayers, xrplTxSigners, _ := genContractRelayers(3)
+
+	allocateTicketsOperation, _, _, _ := buildAllocateTicketsTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	trustSetOperation, _, _ := buildTrustSetTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+	transferOperation, _, _ := buildCoreumToXRPLTokenTransferTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	rotateKeysOperation, _, _ := buildRotateKeysTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+	// the operation already signed by the relayer must be skipped
+	signedTransferOperation := transferOperation
+	signedTransferOperation.TicketSequence = 2
+	signedTransferOperation.Signatures = []coreum.Signature{{
+		RelayerCoreumAddress: contractRelayers[0].CoreumAddress,
+		Signature:            "signature",
+	}}
+	operations := []coreum.Operation{
+		allocateTicketsOperation, trustSetOperation, transferOperation, rotateKeysOperation, signedTransferOperation,
+	}
+
+	unsignedOperations, err := processes.BuildUnsignedOperations(
+		bridgeXRPLAddress, nil, contractRelayers[0].CoreumAddress, operations, processes.MultiSigningTxFeeConfig{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bridgeXRPLAddress.String(), unsignedOperations.BridgeXRPLAddress)
+	require.Len(t, unsignedOperations.Operations, 4)
+
+	// the unsigned operations are transferred to the offline machine as JSON
+	unsignedOperationsJSON, err := json.Marshal(unsignedOperations)
+	require.NoError(t, err)
+	var decodedUnsignedOperations processes.UnsignedOperations
+	require.NoError(t, json.Unmarshal(unsignedOperationsJSON, &decodedUnsignedOperations))
+	require.Equal(t, unsignedOperations, decodedUnsignedOperations)
+
+	// each relayer signs the operations offline
+	relayersSignatures := make([]processes.OperationSignatures, 0, len(xrplTxSigners))
+	for _, xrplTxSigner := range xrplTxSigners {
+		ctrl := gomock.NewController(t)
+		xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
+		xrplTxSignerMock.EXPECT().MultiSign(gomock.Any(), xrplTxSignerKeyName).DoAndReturn(
+			func(tx rippledata.MultiSignable, keyName string) (rippledata.Signer, error) {
+				return xrplTxSigner.MultiSign(tx)
+			},
+		).Times(len(decodedUnsignedOperations.Operations))
+
+		signatures, err := processes.SignUnsignedOperations(
+			decodedUnsignedOperations, xrplTxSignerMock, xrplTxSignerKeyName,
+		)
+		require.NoError(t, err)
+		require.Len(t, signatures.Signatures, len(decodedUnsignedOperations.Operations))
+
+		// the signatures are transferred back as JSON
+		signaturesJSON, err := json.Marshal(signatures)
+		require.NoError(t, err)
+		var decodedSignatures processes.OperationSignatures
+		require.NoError(t, json.Unmarshal(signaturesJSON, &decodedSignatures))
+		require.Equal(t, signatures, decodedSignatures)
+
+		relayersSignatures = append(relayersSignatures, decodedSignatures)
+	}
+
+	// the offline signatures must be equal to the signatures produced by the online relayer and valid
+	for i, operation := range operations[:4] {
+		txSigners := make([]rippledata.Signer, 0, len(xrplTxSigners))
+		for j, xrplTxSigner := range xrplTxSigners {
+			tx, err := processes.BuildXRPLTxFromOperation(
+				bridgeXRPLAddress, nil, operation, processes.MultiSigningTxFeeConfig{},
+			)
+			require.NoError(t, err)
+			onlineSigner, err := xrplTxSigner.MultiSign(tx)
+			require.NoError(t, err)
+
+			offlineSignature := relayersSignatures[j].Signatures[i]
+			require.Equal(t, operation.GetOperationID(), offlineSignature.OperationID)
+			require.Equal(t, operation.Version, offlineSignature.OperationVersion)
+			require.Equal(t, xrplTxSigner.Account().String(), offlineSignature.XRPLAddress)
+			require.Equal(t, onlineSigner.Signer.TxnSignature.String(), offlineSignature.Signature)
+			txSigners = append(txSigners, onlineSigner)
+		}
+
+		tx, err := processes.BuildXRPLTxFromOperation(
+			bridgeXRPLAddress, nil, operation, processes.MultiSigningTxFeeConfig{},
+		)
+		require.NoError(t, err)
+		require.NoError(t, rippledata.SetSigners(tx, txSigners...))
+		isValid, _, err := rippledata.CheckMultiSignature(tx)
+		require.NoError(t, err)
+		require.True(t, isValid)
+	}
+
+	saveSignatureRequests := relayersSignatures[0].ToSaveSignatureRequests()
+	require.Len(t, saveSignatureRequests, 4)
+	require.Equal(t, coreum.SaveSignatureRequest{
+		OperationID:      relayersSignatures[0].Signatures[0].OperationID,
+		OperationVersion: relayersSignatures[0].Signatures[0].OperationVersion,
+		Signature:        relayersSignatures[0].Signatures[0].Signature,
+	}, saveSignatureRequests[0])
+}
+
+func TestSignUnsignedOperations_BridgeAddressMismatch(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	contractRelayers, xrplTxSigners, _ := genContractRelayers(3)
+	trustSetOperation, _, _ := buildTrustSetTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+
+	unsignedOperations, err := processes.BuildUnsignedOperations(
+		bridgeXRPLAddress, nil, contractRelayers[0].CoreumAddress, []coreum.Operation{trustSetOperation},
+		processes.MultiSigningTxFeeConfig{},
+	)
+	require.NoError(t, err)
+	unsignedOperations.BridgeXRPLAddress = xrpl.GenPrivKeyTxSigner().Account().String()
+
+	_, err = processes.SignUnsignedOperations(unsignedOperations, NewMockXRPLTxSigner(gomock.NewController(t)), "key")
+	require.ErrorContains(t, err, "doesn't match the bridge XRPL address")
+}
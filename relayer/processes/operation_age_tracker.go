@@ -0,0 +1,193 @@
+//nolint:tagliatelle // yaml spec
+package processes
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// OperationAgeStoreFileName is the name of the pending operations age store file stored in the relayer home.
+const OperationAgeStoreFileName = "pending-operations-age.yaml"
+
+// OperationAgeStore is the stored first-seen time of the pending operations.
+type OperationAgeStore struct {
+	FirstSeen map[uint32]time.Time `yaml:"first_seen"`
+}
+
+// ReadOperationAgeStore reads the operation age store file, the empty store is returned if the file path is empty or
+// the file does not exist.
+func ReadOperationAgeStore(filePath string) (OperationAgeStore, error) {
+	store := OperationAgeStore{
+		FirstSeen: make(map[uint32]time.Time),
+	}
+	if filePath == "" {
+		return store, nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return store, nil
+		}
+		return OperationAgeStore{}, errors.Wrapf(err, "failed to read operation age store file, path:%s", filePath)
+	}
+	if err := yaml.Unmarshal(fileBytes, &store); err != nil {
+		return OperationAgeStore{}, errors.Wrapf(err, "failed to unmarshal operation age store file, path:%s", filePath)
+	}
+	if store.FirstSeen == nil {
+		store.FirstSeen = make(map[uint32]time.Time)
+	}
+
+	return store, nil
+}
+
+func saveOperationAgeStore(filePath string, store OperationAgeStore) error {
+	if filePath == "" {
+		return nil
+	}
+	storeBytes, err := yaml.Marshal(store)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal operation age store")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, storeBytes), "failed to save operation age store, path:%s", filePath,
+	)
+}
+
+// OperationAgeTrackerConfig is the OperationAgeTracker config.
+type OperationAgeTrackerConfig struct {
+	// MaxAge is the pending operation age after which the operation is reported as stuck.
+	MaxAge time.Duration
+	// StoreFilePath is the path of the file the operations first-seen times are kept in, so the age isn't reset by
+	// the restart. The empty path keeps them in memory only.
+	StoreFilePath string
+}
+
+// DefaultOperationAgeTrackerConfig returns the default OperationAgeTrackerConfig.
+func DefaultOperationAgeTrackerConfig(storeFilePath string) OperationAgeTrackerConfig {
+	return OperationAgeTrackerConfig{
+		MaxAge:        time.Hour,
+		StoreFilePath: storeFilePath,
+	}
+}
+
+// OperationAgeTracker tracks the time the relayer has first seen each pending operation, reports the operations
+// age and warns about the operations pending longer than the max age. The first-seen time is persisted, so the age
+// isn't reset with the relayer restart.
+type OperationAgeTracker struct {
+	cfg            OperationAgeTrackerConfig
+	log            logger.Logger
+	metricRegistry OperationAgeMetricRegistry
+	clock          func() time.Time
+
+	mu                     sync.Mutex
+	store                  OperationAgeStore
+	reportedOperationTypes map[string]struct{}
+}
+
+// NewOperationAgeTracker returns a new instance of the OperationAgeTracker with the state loaded from the store.
+func NewOperationAgeTracker(
+	cfg OperationAgeTrackerConfig,
+	log logger.Logger,
+	metricRegistry OperationAgeMetricRegistry,
+	clock func() time.Time,
+) (*OperationAgeTracker, error) {
+	if cfg.MaxAge <= 0 {
+		return nil, errors.Errorf("max pending operation age must be positive, got: %s", cfg.MaxAge)
+	}
+	store, err := ReadOperationAgeStore(cfg.StoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OperationAgeTracker{
+		cfg:                    cfg,
+		log:                    log,
+		metricRegistry:         metricRegistry,
+		clock:                  clock,
+		store:                  store,
+		reportedOperationTypes: make(map[string]struct{}),
+	}, nil
+}
+
+// Track records the first-seen time of the new pending operations and removes the completed operations, which
+// are not in the pending operations list anymore.
+func (t *OperationAgeTracker) Track(ctx context.Context, operations []coreum.Operation) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock()
+	changed := false
+	pendingOperationIDs := make(map[uint32]struct{}, len(operations))
+	for _, operation := range operations {
+		operationID := operation.GetOperationID()
+		pendingOperationIDs[operationID] = struct{}{}
+		if _, ok := t.store.FirstSeen[operationID]; !ok {
+			t.store.FirstSeen[operationID] = now
+			changed = true
+		}
+	}
+	for operationID := range t.store.FirstSeen {
+		if _, ok := pendingOperationIDs[operationID]; !ok {
+			delete(t.store.FirstSeen, operationID)
+			changed = true
+		}
+	}
+
+	t.report(ctx, operations, now)
+
+	if !changed {
+		return nil
+	}
+
+	return saveOperationAgeStore(t.cfg.StoreFilePath, t.store)
+}
+
+func (t *OperationAgeTracker) report(ctx context.Context, operations []coreum.Operation, now time.Time) {
+	var oldestAge time.Duration
+	maxAgeByType := make(map[string]time.Duration)
+	for _, operation := range operations {
+		age := now.Sub(t.store.FirstSeen[operation.GetOperationID()])
+		if age > oldestAge {
+			oldestAge = age
+		}
+		operationType := operation.GetOperationTypeName()
+		if maxAge, ok := maxAgeByType[operationType]; !ok || age > maxAge {
+			maxAgeByType[operationType] = age
+		}
+		if age > t.cfg.MaxAge {
+			t.log.Warn(
+				ctx,
+				"Pending operation exceeds max age",
+				zap.Uint32("operationID", operation.GetOperationID()),
+				zap.String("operationType", operationType),
+				zap.String("age", age.String()),
+				zap.String("maxAge", t.cfg.MaxAge.String()),
+				zap.Int("signatures", len(operation.Signatures)),
+				zap.Any("operation", operation),
+			)
+		}
+	}
+
+	t.metricRegistry.SetOldestPendingOperationAge(oldestAge.Seconds())
+	// the types without the pending operations are reset to zero to not keep the outdated age
+	for operationType := range t.reportedOperationTypes {
+		if _, ok := maxAgeByType[operationType]; !ok {
+			t.metricRegistry.SetPendingOperationMaxAge(operationType, 0)
+		}
+	}
+	for operationType, age := range maxAgeByType {
+		t.reportedOperationTypes[operationType] = struct{}{}
+		t.metricRegistry.SetPendingOperationMaxAge(operationType, age.Seconds())
+	}
+}
@@ -0,0 +1,107 @@
+package processes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestOperationAgeTracker_Track(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.OperationAgeStoreFileName)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	var oldestAge float64
+	maxAgeByType := make(map[string]float64)
+	metricRegistryMock := NewMockOperationAgeMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetOldestPendingOperationAge(gomock.Any()).Do(func(seconds float64) {
+		oldestAge = seconds
+	}).AnyTimes()
+	metricRegistryMock.EXPECT().SetPendingOperationMaxAge(gomock.Any(), gomock.Any()).
+		Do(func(operationType string, seconds float64) {
+			maxAgeByType[operationType] = seconds
+		}).AnyTimes()
+
+	logMock := logger.NewAnyLogMock(ctrl)
+	cfg := processes.OperationAgeTrackerConfig{
+		MaxAge:        time.Hour,
+		StoreFilePath: storeFilePath,
+	}
+	tracker, err := processes.NewOperationAgeTracker(cfg, logMock, metricRegistryMock, clock)
+	require.NoError(t, err)
+
+	trustSetOperation := coreum.Operation{
+		TicketSequence: 1,
+		OperationType: coreum.OperationType{
+			TrustSet: &coreum.OperationTypeTrustSet{},
+		},
+	}
+	transferOperation := coreum.Operation{
+		TicketSequence: 2,
+		OperationType: coreum.OperationType{
+			CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{},
+		},
+	}
+
+	require.NoError(t, tracker.Track(ctx, []coreum.Operation{trustSetOperation}))
+	require.Equal(t, 0.0, oldestAge)
+	require.Equal(t, 0.0, maxAgeByType["trust_set"])
+
+	now = now.Add(10 * time.Minute)
+	require.NoError(t, tracker.Track(ctx, []coreum.Operation{trustSetOperation, transferOperation}))
+	require.Equal(t, (10 * time.Minute).Seconds(), oldestAge)
+	require.Equal(t, (10 * time.Minute).Seconds(), maxAgeByType["trust_set"])
+	require.Equal(t, 0.0, maxAgeByType["coreum_to_xrpl_transfer"])
+
+	// the tracker created after the restart keeps the first-seen time
+	now = now.Add(time.Hour)
+	restartedTracker, err := processes.NewOperationAgeTracker(cfg, logMock, metricRegistryMock, clock)
+	require.NoError(t, err)
+	require.NoError(t, restartedTracker.Track(ctx, []coreum.Operation{trustSetOperation, transferOperation}))
+	require.Equal(t, (70 * time.Minute).Seconds(), oldestAge)
+	require.Equal(t, (70 * time.Minute).Seconds(), maxAgeByType["trust_set"])
+	require.Equal(t, time.Hour.Seconds(), maxAgeByType["coreum_to_xrpl_transfer"])
+
+	// the completed trust set operation is removed
+	now = now.Add(time.Minute)
+	require.NoError(t, restartedTracker.Track(ctx, []coreum.Operation{transferOperation}))
+	require.Equal(t, (61 * time.Minute).Seconds(), oldestAge)
+	require.Equal(t, 0.0, maxAgeByType["trust_set"])
+	require.Equal(t, (61 * time.Minute).Seconds(), maxAgeByType["coreum_to_xrpl_transfer"])
+
+	store, err := processes.ReadOperationAgeStore(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, store.FirstSeen, 1)
+	require.Equal(t, now.Add(-61*time.Minute), store.FirstSeen[transferOperation.GetOperationID()].UTC())
+
+	// all operations are completed
+	require.NoError(t, restartedTracker.Track(ctx, []coreum.Operation{}))
+	require.Equal(t, 0.0, oldestAge)
+	require.Equal(t, 0.0, maxAgeByType["coreum_to_xrpl_transfer"])
+	store, err = processes.ReadOperationAgeStore(storeFilePath)
+	require.NoError(t, err)
+	require.Empty(t, store.FirstSeen)
+}
+
+func TestReadOperationAgeStore_NotExistingFile(t *testing.T) {
+	t.Parallel()
+
+	store, err := processes.ReadOperationAgeStore(filepath.Join(t.TempDir(), processes.OperationAgeStoreFileName))
+	require.NoError(t, err)
+	require.Empty(t, store.FirstSeen)
+}
@@ -0,0 +1,114 @@
+package processes
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// OperationPreview is the XRPL transaction the relayers sign for the pending operation.
+type OperationPreview struct {
+	OperationID      uint32 `json:"operation_id"`
+	OperationVersion uint32 `json:"operation_version"`
+	OperationType    string `json:"operation_type"`
+	// TxJSON is the canonical JSON of the XRPL transaction prepared for the multi-signing.
+	TxJSON json.RawMessage `json:"tx_json"`
+	// TxBlob is hex encoded XRPL transaction prepared for the multi-signing.
+	TxBlob string `json:"tx_blob"`
+	// RequiredSignatures is the number of the relayer signatures the transaction is submitted with.
+	RequiredSignatures uint32                   `json:"required_signatures"`
+	Signers            []OperationPreviewSigner `json:"signers"`
+}
+
+// OperationPreviewSigner is the relayer signing state of the operation.
+type OperationPreviewSigner struct {
+	CoreumAddress string `json:"coreum_address"`
+	XRPLAddress   string `json:"xrpl_address"`
+	Signed        bool   `json:"signed"`
+}
+
+// BuildXRPLTxFromContractOperation builds the XRPL transaction for the multi-signing from the contract operation with
+// the bridge account, source tag and fee taken from the contract config, so the transaction is the same as the one
+// built by the relayers for signing.
+func BuildXRPLTxFromContractOperation(
+	contractConfig coreum.ContractConfig,
+	operation coreum.Operation,
+) (MultiSignableTransaction, error) {
+	bridgeXRPLAddress, err := rippledata.NewAccountFromAddress(contractConfig.BridgeXRPLAddress)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "failed to convert bridge XRPL address to Account type, address:%s", contractConfig.BridgeXRPLAddress,
+		)
+	}
+
+	return BuildXRPLTxFromOperation(
+		*bridgeXRPLAddress,
+		contractConfig.SourceTag,
+		operation,
+		NewMultiSigningTxFeeConfig(contractConfig),
+	)
+}
+
+// BuildOperationPreview builds the XRPL transaction of the pending operation without signing it and returns it with
+// the relayers signing state.
+func BuildOperationPreview(
+	contractConfig coreum.ContractConfig,
+	operation coreum.Operation,
+) (OperationPreview, error) {
+	tx, err := BuildXRPLTxFromContractOperation(contractConfig, operation)
+	if err != nil {
+		return OperationPreview{}, err
+	}
+	txJSON, err := marshalSigningTxJSON(tx)
+	if err != nil {
+		return OperationPreview{}, err
+	}
+	txBlob, err := EncodeUnsignedTx(tx)
+	if err != nil {
+		return OperationPreview{}, err
+	}
+
+	signers := lo.Map(contractConfig.Relayers, func(relayer coreum.Relayer, _ int) OperationPreviewSigner {
+		return OperationPreviewSigner{
+			CoreumAddress: relayer.CoreumAddress.String(),
+			XRPLAddress:   relayer.XRPLAddress,
+			Signed: lo.ContainsBy(operation.Signatures, func(signature coreum.Signature) bool {
+				return signature.RelayerCoreumAddress.Equals(relayer.CoreumAddress)
+			}),
+		}
+	})
+
+	return OperationPreview{
+		OperationID:        operation.GetOperationID(),
+		OperationVersion:   operation.Version,
+		OperationType:      operation.GetOperationTypeName(),
+		TxJSON:             txJSON,
+		TxBlob:             txBlob,
+		RequiredSignatures: contractConfig.EvidenceThreshold,
+		Signers:            signers,
+	}, nil
+}
+
+// marshalSigningTxJSON marshals the transaction to JSON with the sorted fields and without the hash, since the hash
+// isn't a part of the signed data.
+func marshalSigningTxJSON(tx MultiSignableTransaction) (json.RawMessage, error) {
+	txJSON, err := json.Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal XRPL transaction")
+	}
+	var txFields map[string]json.RawMessage
+	if err := json.Unmarshal(txJSON, &txFields); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal XRPL transaction JSON")
+	}
+	delete(txFields, "hash")
+	txJSON, err = json.Marshal(txFields)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal XRPL transaction fields")
+	}
+
+	return txJSON, nil
+}
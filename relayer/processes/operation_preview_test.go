@@ -0,0 +1,157 @@
+package processes_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestBuildOperationPreview(t *testing.T) {
+	t.Parallel()
+
+	bridgeXRPLAddress := xrpl.GenPrivKeyTxSigner().Account()
+	xrplTxSignerKeyName := "xrpl-tx-signer"
+	contractRelayers, xrplTxSigners, bridgeXRPLSignerAccountWithSigners := genContractRelayers(3)
+	contractConfig := coreum.ContractConfig{
+		Relayers:          contractRelayers,
+		EvidenceThreshold: 2,
+		BridgeXRPLAddress: bridgeXRPLAddress.String(),
+		SourceTag:         lo.ToPtr(uint32(17)),
+	}
+
+	allocateTicketsOperation, _, _, _ := buildAllocateTicketsTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	trustSetOperation, _, _ := buildTrustSetTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+	transferOperation, _, _ := buildCoreumToXRPLTokenTransferTestData(
+		t, xrplTxSigners, bridgeXRPLAddress, contractRelayers,
+	)
+	rotateKeysOperation, _, _ := buildRotateKeysTestData(t, xrplTxSigners, bridgeXRPLAddress, contractRelayers)
+
+	tests := []struct {
+		name              string
+		operation         coreum.Operation
+		wantOperationType string
+		wantTxType        string
+	}{
+		{
+			name:              "allocate_tickets",
+			operation:         allocateTicketsOperation,
+			wantOperationType: string(coreum.OperationTypeEnumAllocateTickets),
+			wantTxType:        rippledata.TICKET_CREATE.String(),
+		},
+		{
+			name:              "trust_set",
+			operation:         trustSetOperation,
+			wantOperationType: string(coreum.OperationTypeEnumTrustSet),
+			wantTxType:        rippledata.TRUST_SET.String(),
+		},
+		{
+			name:              "coreum_to_xrpl_transfer",
+			operation:         transferOperation,
+			wantOperationType: string(coreum.OperationTypeEnumCoreumToXRPLTransfer),
+			wantTxType:        rippledata.PAYMENT.String(),
+		},
+		{
+			name:              "rotate_keys",
+			operation:         rotateKeysOperation,
+			wantOperationType: string(coreum.OperationTypeEnumRotateKeys),
+			wantTxType:        rippledata.SIGNER_LIST_SET.String(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			ctrl := gomock.NewController(t)
+
+			// the operation signed by the second relayer
+			operation := tt.operation
+			operation.Signatures = []coreum.Signature{{
+				RelayerCoreumAddress: contractRelayers[1].CoreumAddress,
+				Signature:            "signature",
+			}}
+			preview, err := processes.BuildOperationPreview(contractConfig, operation)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.operation.GetOperationID(), preview.OperationID)
+			require.Equal(t, tt.operation.Version, preview.OperationVersion)
+			require.Equal(t, tt.wantOperationType, preview.OperationType)
+			require.Equal(t, uint32(2), preview.RequiredSignatures)
+			require.Equal(t, []bool{false, true, false}, lo.Map(
+				preview.Signers, func(signer processes.OperationPreviewSigner, _ int) bool {
+					return signer.Signed
+				},
+			))
+			var txJSON map[string]any
+			require.NoError(t, json.Unmarshal(preview.TxJSON, &txJSON))
+			require.Equal(t, tt.wantTxType, txJSON["TransactionType"])
+			require.Equal(t, bridgeXRPLAddress.String(), txJSON["Account"])
+			require.NotContains(t, txJSON, "hash")
+
+			// the relayer process signs the same transaction
+			var signedTxBlob string
+			contractClientMock := NewMockContractClient(ctrl)
+			contractClientMock.EXPECT().IsInitialized().Return(true)
+			contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{tt.operation}, nil)
+			contractClientMock.EXPECT().GetContractConfig(gomock.Any()).Return(contractConfig, nil)
+			contractClientMock.EXPECT().SaveSignature(
+				gomock.Any(), contractRelayers[0].CoreumAddress, gomock.Any(), gomock.Any(), gomock.Any(),
+			)
+			xrplRPCClientMock := NewMockXRPLRPCClient(ctrl)
+			xrplRPCClientMock.EXPECT().
+				AccountInfo(gomock.Any(), bridgeXRPLAddress).
+				Return(bridgeXRPLSignerAccountWithSigners, nil).
+				AnyTimes()
+			xrplTxSignerMock := NewMockXRPLTxSigner(ctrl)
+			xrplTxSignerMock.EXPECT().MultiSign(gomock.Any(), xrplTxSignerKeyName).DoAndReturn(
+				func(tx rippledata.MultiSignable, _ string) (rippledata.Signer, error) {
+					multiSignableTx, ok := tx.(processes.MultiSignableTransaction)
+					require.True(t, ok)
+					// the tx is encoded before the signing since the signer sets the signature to it
+					var err error
+					signedTxBlob, err = processes.EncodeUnsignedTx(multiSignableTx)
+					require.NoError(t, err)
+					return xrplTxSigners[0].MultiSign(tx)
+				},
+			)
+
+			process, err := processes.NewCoreumToXRPLProcess(
+				processes.CoreumToXRPLProcessConfig{
+					BridgeXRPLAddress:    bridgeXRPLAddress,
+					SourceTag:            contractConfig.SourceTag,
+					RelayerCoreumAddress: contractRelayers[0].CoreumAddress,
+					XRPLTxSignerKeyName:  xrplTxSignerKeyName,
+				},
+				logger.NewAnyLogMock(ctrl),
+				contractClientMock,
+				xrplRPCClientMock,
+				xrplTxSignerMock,
+				NewMockMetricRegistry(ctrl),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			require.NoError(t, process.Start(ctx))
+			require.Equal(t, signedTxBlob, preview.TxBlob)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package processes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// RefundRelayerConfig is the RefundRelayer config.
+type RefundRelayerConfig struct {
+	RelayerCoreumAddress sdk.AccAddress
+	// Addresses are the refund owners the relayer claims the refunds for.
+	Addresses []sdk.AccAddress
+	// AllGranters indicates that the refunds are claimed for all addresses which have granted the relayer the
+	// contract execution via authz, additionally to the Addresses.
+	AllGranters bool
+	// PollInterval is the interval between the pending refunds checks.
+	PollInterval time.Duration
+	// UnclaimedAgeThreshold is the age of the pending refund, which can't be claimed by the relayer, after which
+	// the refund is reported as unclaimed.
+	UnclaimedAgeThreshold time.Duration
+}
+
+// DefaultRefundRelayerConfig returns the default RefundRelayerConfig.
+func DefaultRefundRelayerConfig(relayerCoreumAddress sdk.AccAddress) RefundRelayerConfig {
+	return RefundRelayerConfig{
+		RelayerCoreumAddress:  relayerCoreumAddress,
+		Addresses:             make([]sdk.AccAddress, 0),
+		PollInterval:          time.Minute,
+		UnclaimedAgeThreshold: 24 * time.Hour,
+	}
+}
+
+// RefundRelayer claims the pending refunds on behalf of the refund owners which have granted the relayer the contract
+// execution via authz. The fee is paid from the fee allowance of the owner if it is granted to the relayer.
+// The refunds which can't be claimed by the relayer are reported with the metric and log once they are older than
+// the threshold.
+type RefundRelayer struct {
+	cfg            RefundRelayerConfig
+	log            logger.Logger
+	contractClient RefundRelayerContractClient
+	metricRegistry RefundRelayerMetricRegistry
+	clock          func() time.Time
+
+	mu sync.Mutex
+	// firstSeen is the time the pending refund is seen by the relayer the first time, since the contract doesn't
+	// store the refund creation time. The refunds are grouped by the owner address.
+	firstSeen map[string]map[string]time.Time
+}
+
+// NewRefundRelayer returns a new instance of the RefundRelayer.
+func NewRefundRelayer(
+	cfg RefundRelayerConfig,
+	log logger.Logger,
+	contractClient RefundRelayerContractClient,
+	metricRegistry RefundRelayerMetricRegistry,
+	clock func() time.Time,
+) (*RefundRelayer, error) {
+	if cfg.RelayerCoreumAddress.Empty() {
+		return nil, errors.New("relayer address is nil or empty")
+	}
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("refund relayer poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+	if cfg.UnclaimedAgeThreshold < 0 {
+		return nil, errors.Errorf(
+			"refund relayer unclaimed age threshold must not be negative, got: %s", cfg.UnclaimedAgeThreshold,
+		)
+	}
+
+	return &RefundRelayer{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		firstSeen:      make(map[string]map[string]time.Time),
+	}, nil
+}
+
+// Start starts the periodic relaying of the refund claims.
+func (r *RefundRelayer) Start(ctx context.Context) error {
+	for {
+		if err := r.RelayDue(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			r.log.Error(ctx, "Failed to relay refund claims", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// RelayDue claims the pending refunds of the authz granters and reports the unclaimed refunds of the rest addresses.
+func (r *RefundRelayer) RelayDue(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	granters, err := r.contractClient.GetContractExecutionGranters(ctx, r.cfg.RelayerCoreumAddress)
+	if err != nil {
+		return err
+	}
+	feeGranters, err := r.contractClient.GetFeeAllowanceGranters(ctx, r.cfg.RelayerCoreumAddress)
+	if err != nil {
+		return err
+	}
+	granterSet := addressesToSet(granters)
+	feeGranterSet := addressesToSet(feeGranters)
+
+	addresses := r.cfg.Addresses
+	if r.cfg.AllGranters {
+		addresses = append(append(make([]sdk.AccAddress, 0, len(addresses)+len(granters)), addresses...), granters...)
+	}
+	addresses = lo.UniqBy(addresses, func(address sdk.AccAddress) string {
+		return address.String()
+	})
+
+	now := r.clock()
+	for _, address := range addresses {
+		pendingRefunds, err := r.contractClient.GetPendingRefunds(ctx, address)
+		if err != nil {
+			return err
+		}
+		r.trackPendingRefunds(address, pendingRefunds, now)
+		if len(pendingRefunds) == 0 {
+			r.metricRegistry.SetUnclaimedRefunds(address.String(), 0)
+			continue
+		}
+		if _, ok := granterSet[address.String()]; ok {
+			var feeGranter sdk.AccAddress
+			if _, ok := feeGranterSet[address.String()]; ok {
+				feeGranter = address
+			}
+			claimed, err := r.claim(ctx, address, feeGranter, pendingRefunds)
+			if err != nil {
+				return err
+			}
+			if claimed {
+				continue
+			}
+		}
+		r.reportUnclaimed(ctx, address, pendingRefunds, now)
+	}
+
+	return nil
+}
+
+func (r *RefundRelayer) claim(
+	ctx context.Context,
+	address, feeGranter sdk.AccAddress,
+	pendingRefunds []coreum.PendingRefund,
+) (bool, error) {
+	pendingRefundIDs := lo.Map(pendingRefunds, func(pendingRefund coreum.PendingRefund, _ int) string {
+		return pendingRefund.ID
+	})
+	txRes, err := r.contractClient.ClaimRefundsOnBehalf(
+		ctx, r.cfg.RelayerCoreumAddress, address, feeGranter, pendingRefundIDs,
+	)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false, err
+		}
+		r.log.Warn(
+			ctx,
+			"Failed to claim refunds on behalf of the owner",
+			zap.String("address", address.String()),
+			zap.Strings("pendingRefundIDs", pendingRefundIDs),
+			zap.Error(err),
+		)
+		return false, nil
+	}
+
+	r.log.Info(
+		ctx,
+		"Claimed refunds on behalf of the owner",
+		zap.String("address", address.String()),
+		zap.Strings("pendingRefundIDs", pendingRefundIDs),
+		zap.Bool("feeGranted", !feeGranter.Empty()),
+		zap.String("txHash", txRes.TxHash),
+	)
+	r.metricRegistry.AddRelayedRefundClaims(address.String(), len(pendingRefundIDs))
+	r.metricRegistry.SetUnclaimedRefunds(address.String(), 0)
+	delete(r.firstSeen, address.String())
+
+	return true, nil
+}
+
+func (r *RefundRelayer) reportUnclaimed(
+	ctx context.Context,
+	address sdk.AccAddress,
+	pendingRefunds []coreum.PendingRefund,
+	now time.Time,
+) {
+	firstSeen := r.firstSeen[address.String()]
+	unclaimedRefundIDs := make([]string, 0)
+	for _, pendingRefund := range pendingRefunds {
+		if now.Sub(firstSeen[pendingRefund.ID]) >= r.cfg.UnclaimedAgeThreshold {
+			unclaimedRefundIDs = append(unclaimedRefundIDs, pendingRefund.ID)
+		}
+	}
+	r.metricRegistry.SetUnclaimedRefunds(address.String(), len(unclaimedRefundIDs))
+	if len(unclaimedRefundIDs) == 0 {
+		return
+	}
+	r.log.Warn(
+		ctx,
+		"Found unclaimed refunds the relayer isn't authorized to claim",
+		zap.String("address", address.String()),
+		zap.Strings("pendingRefundIDs", unclaimedRefundIDs),
+		zap.Duration("threshold", r.cfg.UnclaimedAgeThreshold),
+	)
+}
+
+// trackPendingRefunds saves the first seen time of the new pending refunds and removes the refunds which are not
+// pending anymore.
+func (r *RefundRelayer) trackPendingRefunds(
+	address sdk.AccAddress,
+	pendingRefunds []coreum.PendingRefund,
+	now time.Time,
+) {
+	prevFirstSeen := r.firstSeen[address.String()]
+	firstSeen := make(map[string]time.Time, len(pendingRefunds))
+	for _, pendingRefund := range pendingRefunds {
+		seenAt, ok := prevFirstSeen[pendingRefund.ID]
+		if !ok {
+			seenAt = now
+		}
+		firstSeen[pendingRefund.ID] = seenAt
+	}
+	r.firstSeen[address.String()] = firstSeen
+}
+
+func addressesToSet(addresses []sdk.AccAddress) map[string]struct{} {
+	return lo.SliceToMap(addresses, func(address sdk.AccAddress) (string, struct{}) {
+		return address.String(), struct{}{}
+	})
+}
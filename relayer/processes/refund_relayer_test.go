@@ -0,0 +1,140 @@
+package processes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestRefundRelayer_ClaimsRefundsOfGranters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	relayerAddress := coreum.GenAccount()
+	grantedAddress := coreum.GenAccount()
+	feeGrantedAddress := coreum.GenAccount()
+	pendingRefunds := []coreum.PendingRefund{
+		{ID: "refund-1"},
+		{ID: "refund-2"},
+	}
+
+	contractClientMock := NewMockRefundRelayerContractClient(ctrl)
+	contractClientMock.EXPECT().GetContractExecutionGranters(gomock.Any(), relayerAddress).
+		Return([]sdk.AccAddress{grantedAddress, feeGrantedAddress}, nil)
+	contractClientMock.EXPECT().GetFeeAllowanceGranters(gomock.Any(), relayerAddress).
+		Return([]sdk.AccAddress{feeGrantedAddress}, nil)
+	contractClientMock.EXPECT().GetPendingRefunds(gomock.Any(), grantedAddress).Return(pendingRefunds, nil)
+	contractClientMock.EXPECT().GetPendingRefunds(gomock.Any(), feeGrantedAddress).Return(pendingRefunds[:1], nil)
+	// the fee is paid by the relayer if the fee allowance isn't granted
+	contractClientMock.EXPECT().ClaimRefundsOnBehalf(
+		gomock.Any(), relayerAddress, grantedAddress, nil, []string{"refund-1", "refund-2"},
+	).Return(&sdk.TxResponse{}, nil)
+	contractClientMock.EXPECT().ClaimRefundsOnBehalf(
+		gomock.Any(), relayerAddress, feeGrantedAddress, feeGrantedAddress, []string{"refund-1"},
+	).Return(&sdk.TxResponse{}, nil)
+
+	metricRegistryMock := NewMockRefundRelayerMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().AddRelayedRefundClaims(grantedAddress.String(), 2)
+	metricRegistryMock.EXPECT().AddRelayedRefundClaims(feeGrantedAddress.String(), 1)
+	metricRegistryMock.EXPECT().SetUnclaimedRefunds(grantedAddress.String(), 0)
+	metricRegistryMock.EXPECT().SetUnclaimedRefunds(feeGrantedAddress.String(), 0)
+
+	cfg := processes.DefaultRefundRelayerConfig(relayerAddress)
+	cfg.AllGranters = true
+	// the granted address is configured explicitly as well to check that it isn't claimed twice
+	cfg.Addresses = []sdk.AccAddress{grantedAddress}
+	refundRelayer, err := processes.NewRefundRelayer(
+		cfg, logger.NewAnyLogMock(ctrl), contractClientMock, metricRegistryMock, time.Now,
+	)
+	require.NoError(t, err)
+	require.NoError(t, refundRelayer.RelayDue(ctx))
+}
+
+func TestRefundRelayer_ReportsUnclaimedRefundsWithoutGrants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	relayerAddress := coreum.GenAccount()
+	notGrantedAddress := coreum.GenAccount()
+	failedClaimAddress := coreum.GenAccount()
+
+	pendingRefunds := map[string][]coreum.PendingRefund{
+		notGrantedAddress.String(): {
+			{ID: "refund-1"},
+		},
+		failedClaimAddress.String(): {
+			{ID: "refund-2"},
+		},
+	}
+	contractClientMock := NewMockRefundRelayerContractClient(ctrl)
+	contractClientMock.EXPECT().GetContractExecutionGranters(gomock.Any(), relayerAddress).
+		Return([]sdk.AccAddress{failedClaimAddress}, nil).AnyTimes()
+	contractClientMock.EXPECT().GetFeeAllowanceGranters(gomock.Any(), relayerAddress).
+		Return(nil, nil).AnyTimes()
+	contractClientMock.EXPECT().GetPendingRefunds(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, address sdk.AccAddress) ([]coreum.PendingRefund, error) {
+			return pendingRefunds[address.String()], nil
+		}).AnyTimes()
+	contractClientMock.EXPECT().ClaimRefundsOnBehalf(
+		gomock.Any(), relayerAddress, failedClaimAddress, nil, []string{"refund-2"},
+	).Return(nil, errors.New("authorization expired")).AnyTimes()
+
+	unclaimedRefunds := make(map[string]int)
+	metricRegistryMock := NewMockRefundRelayerMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetUnclaimedRefunds(gomock.Any(), gomock.Any()).
+		Do(func(address string, count int) {
+			unclaimedRefunds[address] = count
+		}).AnyTimes()
+
+	cfg := processes.DefaultRefundRelayerConfig(relayerAddress)
+	cfg.Addresses = []sdk.AccAddress{notGrantedAddress, failedClaimAddress}
+	cfg.UnclaimedAgeThreshold = time.Hour
+	refundRelayer, err := processes.NewRefundRelayer(
+		cfg, logger.NewAnyLogMock(ctrl), contractClientMock, metricRegistryMock, clock,
+	)
+	require.NoError(t, err)
+
+	// the refunds are younger than the threshold
+	require.NoError(t, refundRelayer.RelayDue(ctx))
+	require.Equal(t, map[string]int{
+		notGrantedAddress.String():  0,
+		failedClaimAddress.String(): 0,
+	}, unclaimedRefunds)
+
+	now = now.Add(time.Hour)
+	pendingRefunds[notGrantedAddress.String()] = append(
+		pendingRefunds[notGrantedAddress.String()], coreum.PendingRefund{ID: "refund-3"},
+	)
+	require.NoError(t, refundRelayer.RelayDue(ctx))
+	require.Equal(t, map[string]int{
+		notGrantedAddress.String():  1,
+		failedClaimAddress.String(): 1,
+	}, unclaimedRefunds)
+
+	// the refunds are claimed by the owner
+	pendingRefunds[notGrantedAddress.String()] = nil
+	pendingRefunds[failedClaimAddress.String()] = nil
+	require.NoError(t, refundRelayer.RelayDue(ctx))
+	require.Equal(t, map[string]int{
+		notGrantedAddress.String():  0,
+		failedClaimAddress.String(): 0,
+	}, unclaimedRefunds)
+}
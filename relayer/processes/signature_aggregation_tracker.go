@@ -0,0 +1,175 @@
+//nolint:tagliatelle // yaml spec
+package processes
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// SignatureAggregationStoreFileName is the name of the pending operations signature aggregation store file stored in
+// the relayer home.
+const SignatureAggregationStoreFileName = "pending-operations-signatures.yaml"
+
+// SignatureAggregationRecord is the stored signature aggregation state of the pending operation.
+type SignatureAggregationRecord struct {
+	Version    uint32    `yaml:"version"`
+	Signatures int       `yaml:"signatures"`
+	UpdatedAt  time.Time `yaml:"updated_at"`
+}
+
+// SignatureAggregationStore is the stored signature aggregation state of the pending operations.
+type SignatureAggregationStore struct {
+	Operations map[uint32]SignatureAggregationRecord `yaml:"operations"`
+}
+
+// ReadSignatureAggregationStore reads the signature aggregation store file, the empty store is returned if the file
+// path is empty or the file does not exist.
+func ReadSignatureAggregationStore(filePath string) (SignatureAggregationStore, error) {
+	store := SignatureAggregationStore{
+		Operations: make(map[uint32]SignatureAggregationRecord),
+	}
+	if filePath == "" {
+		return store, nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return store, nil
+		}
+		return SignatureAggregationStore{}, errors.Wrapf(
+			err, "failed to read signature aggregation store file, path:%s", filePath,
+		)
+	}
+	if err := yaml.Unmarshal(fileBytes, &store); err != nil {
+		return SignatureAggregationStore{}, errors.Wrapf(
+			err, "failed to unmarshal signature aggregation store file, path:%s", filePath,
+		)
+	}
+	if store.Operations == nil {
+		store.Operations = make(map[uint32]SignatureAggregationRecord)
+	}
+
+	return store, nil
+}
+
+func saveSignatureAggregationStore(filePath string, store SignatureAggregationStore) error {
+	if filePath == "" {
+		return nil
+	}
+	storeBytes, err := yaml.Marshal(store)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal signature aggregation store")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, storeBytes), "failed to save signature aggregation store, path:%s", filePath,
+	)
+}
+
+// SignatureAggregationTrackerConfig is the SignatureAggregationTracker config.
+type SignatureAggregationTrackerConfig struct {
+	// Timeout is the time without a new signature after which the pending operation is timed out.
+	Timeout time.Duration
+	// StoreFilePath is the path of the file the signature change times are kept in across restarts, the empty path
+	// keeps them in memory only.
+	StoreFilePath string
+}
+
+// SignatureAggregationTracker tracks the time the signatures of each pending operation were last changed, and
+// returns the operations which haven't received a new signature within the timeout. The state is persisted, so the
+// timeout isn't reset with the relayer restart.
+type SignatureAggregationTracker struct {
+	cfg   SignatureAggregationTrackerConfig
+	log   logger.Logger
+	clock func() time.Time
+
+	mu    sync.Mutex
+	store SignatureAggregationStore
+}
+
+// NewSignatureAggregationTracker returns a new instance of the SignatureAggregationTracker with the state loaded from
+// the store.
+func NewSignatureAggregationTracker(
+	cfg SignatureAggregationTrackerConfig,
+	log logger.Logger,
+	clock func() time.Time,
+) (*SignatureAggregationTracker, error) {
+	if cfg.Timeout <= 0 {
+		return nil, errors.Errorf("signature aggregation timeout must be positive, got: %s", cfg.Timeout)
+	}
+	store, err := ReadSignatureAggregationStore(cfg.StoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureAggregationTracker{
+		cfg:   cfg,
+		log:   log,
+		clock: clock,
+		store: store,
+	}, nil
+}
+
+// Track records the signature changes of the pending operations, removes the completed operations and returns the
+// operations without a new signature within the timeout. The operation version change is treated as a signature
+// change since the contract resets the signatures of the updated operation.
+func (t *SignatureAggregationTracker) Track(
+	ctx context.Context,
+	operations []coreum.Operation,
+) ([]coreum.Operation, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock()
+	changed := false
+	timedOutOperations := make([]coreum.Operation, 0)
+	pendingOperationIDs := make(map[uint32]struct{}, len(operations))
+	for _, operation := range operations {
+		operationID := operation.GetOperationID()
+		pendingOperationIDs[operationID] = struct{}{}
+		record, ok := t.store.Operations[operationID]
+		if !ok || record.Version != operation.Version || record.Signatures != len(operation.Signatures) {
+			t.store.Operations[operationID] = SignatureAggregationRecord{
+				Version:    operation.Version,
+				Signatures: len(operation.Signatures),
+				UpdatedAt:  now,
+			}
+			changed = true
+			continue
+		}
+		if idle := now.Sub(record.UpdatedAt); idle > t.cfg.Timeout {
+			t.log.Warn(
+				ctx,
+				"Pending operation signature aggregation is timed out",
+				zap.Uint32("operationID", operationID),
+				zap.String("operationType", operation.GetOperationTypeName()),
+				zap.Int("signatures", len(operation.Signatures)),
+				zap.String("idle", idle.String()),
+				zap.String("timeout", t.cfg.Timeout.String()),
+			)
+			timedOutOperations = append(timedOutOperations, operation)
+		}
+	}
+	for operationID := range t.store.Operations {
+		if _, ok := pendingOperationIDs[operationID]; !ok {
+			delete(t.store.Operations, operationID)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return timedOutOperations, nil
+	}
+
+	return timedOutOperations, saveSignatureAggregationStore(t.cfg.StoreFilePath, t.store)
+}
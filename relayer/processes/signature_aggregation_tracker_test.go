@@ -0,0 +1,98 @@
+package processes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestSignatureAggregationTracker_Track(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.SignatureAggregationStoreFileName)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	logMock := logger.NewAnyLogMock(ctrl)
+	cfg := processes.SignatureAggregationTrackerConfig{
+		Timeout:       time.Hour,
+		StoreFilePath: storeFilePath,
+	}
+	tracker, err := processes.NewSignatureAggregationTracker(cfg, logMock, clock)
+	require.NoError(t, err)
+
+	allocateTicketsOperation := coreum.Operation{
+		AccountSequence: 1,
+		OperationType: coreum.OperationType{
+			AllocateTickets: &coreum.OperationTypeAllocateTickets{
+				Number: 10,
+			},
+		},
+	}
+	trustSetOperation := coreum.Operation{
+		TicketSequence: 2,
+		OperationType: coreum.OperationType{
+			TrustSet: &coreum.OperationTypeTrustSet{},
+		},
+	}
+
+	timedOutOperations, err := tracker.Track(ctx, []coreum.Operation{allocateTicketsOperation, trustSetOperation})
+	require.NoError(t, err)
+	require.Empty(t, timedOutOperations)
+
+	// the new signature resets the timeout of the trust set operation
+	now = now.Add(50 * time.Minute)
+	trustSetOperation.Signatures = []coreum.Signature{{
+		RelayerCoreumAddress: coreum.GenAccount(),
+		Signature:            "signature",
+	}}
+	timedOutOperations, err = tracker.Track(ctx, []coreum.Operation{allocateTicketsOperation, trustSetOperation})
+	require.NoError(t, err)
+	require.Empty(t, timedOutOperations)
+
+	// the tracker created after the restart keeps the signature aggregation state
+	now = now.Add(20 * time.Minute)
+	restartedTracker, err := processes.NewSignatureAggregationTracker(cfg, logMock, clock)
+	require.NoError(t, err)
+	timedOutOperations, err = restartedTracker.Track(
+		ctx, []coreum.Operation{allocateTicketsOperation, trustSetOperation},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []coreum.Operation{allocateTicketsOperation}, timedOutOperations)
+
+	// the version change resets the timeout since the contract resets the signatures
+	allocateTicketsOperation.Version = 1
+	timedOutOperations, err = restartedTracker.Track(
+		ctx, []coreum.Operation{allocateTicketsOperation, trustSetOperation},
+	)
+	require.NoError(t, err)
+	require.Empty(t, timedOutOperations)
+
+	// the completed allocate tickets operation is removed
+	now = now.Add(time.Hour)
+	timedOutOperations, err = restartedTracker.Track(ctx, []coreum.Operation{trustSetOperation})
+	require.NoError(t, err)
+	require.Equal(t, []coreum.Operation{trustSetOperation}, timedOutOperations)
+	store, err := processes.ReadSignatureAggregationStore(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, store.Operations, 1)
+	require.Contains(t, store.Operations, trustSetOperation.GetOperationID())
+
+	_, err = processes.NewSignatureAggregationTracker(
+		processes.SignatureAggregationTrackerConfig{}, logMock, clock,
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,266 @@
+//nolint:tagliatelle // json lines spec
+package processes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+)
+
+// TicketUsageFileName is the name of the consumed tickets log stored in the relayer home.
+const TicketUsageFileName = "ticket-usage.jsonl"
+
+// TicketUsageRecord is the record of the ticket used by the completed operation.
+type TicketUsageRecord struct {
+	TicketSequence uint32                   `json:"ticket_sequence"`
+	OperationType  coreum.OperationTypeEnum `json:"operation_type"`
+	// Result is the accepted or rejected result of the validated XRPL tx, which has consumed the ticket, or the
+	// invalid result of the operation which tx has never been validated, such ticket is returned to the contract.
+	Result coreum.TransactionResult `json:"result"`
+	// TxHash is the hash of the validated XRPL tx, empty for the invalid result.
+	TxHash string `json:"tx_hash,omitempty"`
+	// CompletedAt is the XRPL close time of the validated tx or the relayer time of the invalid result evidence.
+	CompletedAt time.Time `json:"completed_at"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+// Consumed returns true if the ticket of the record is consumed on the XRPL.
+func (r TicketUsageRecord) Consumed() bool {
+	return r.Result != coreum.TransactionResultInvalid
+}
+
+func (r TicketUsageRecord) key() string {
+	return fmt.Sprintf("%d/%s/%s", r.TicketSequence, r.Result, r.TxHash)
+}
+
+// TicketUsageTracker keeps the log of the tickets used by the completed operations in the JSON Lines file and counts
+// them in the metrics, so the ticket consumption is broken down by the operation type. The same operation result
+// might be observed several times, e.g. by the recent and full XRPL history scans, so the duplicates are skipped.
+type TicketUsageTracker struct {
+	path           string
+	metricRegistry TicketUsageMetricRegistry
+	clock          func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// NewTicketUsageTracker returns a new instance of the TicketUsageTracker with the records loaded from the log. The
+// empty path disables the log, but the usage is still counted in the metrics.
+func NewTicketUsageTracker(
+	path string,
+	metricRegistry TicketUsageMetricRegistry,
+	clock func() time.Time,
+) (*TicketUsageTracker, error) {
+	records, err := ReadTicketUsage(path, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		seen[record.key()] = struct{}{}
+	}
+
+	return &TicketUsageTracker{
+		path:           path,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		seen:           seen,
+	}, nil
+}
+
+// Record appends the ticket usage to the log and increments the consumed tickets counter, the already recorded
+// usage is skipped.
+func (t *TicketUsageTracker) Record(_ context.Context, record TicketUsageRecord) error {
+	if record.TicketSequence == 0 {
+		return errors.New("ticket sequence must not be zero")
+	}
+	if record.RecordedAt.IsZero() {
+		record.RecordedAt = t.clock().UTC()
+	}
+	if record.CompletedAt.IsZero() {
+		record.CompletedAt = record.RecordedAt
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.seen[record.key()]; ok {
+		return nil
+	}
+	if err := t.appendRecord(record); err != nil {
+		return err
+	}
+	t.seen[record.key()] = struct{}{}
+	t.metricRegistry.IncrementTicketsConsumedCounter(string(record.OperationType), string(record.Result))
+
+	return nil
+}
+
+func (t *TicketUsageTracker) appendRecord(record TicketUsageRecord) error {
+	if t.path == "" {
+		return nil
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal ticket usage record")
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create ticket usage dir, path:%s", t.path)
+	}
+	file, err := os.OpenFile(t.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open ticket usage file, path:%s", t.path)
+	}
+	if _, err := file.Write(recordBytes); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write ticket usage file, path:%s", t.path)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close ticket usage file, path:%s", t.path)
+}
+
+// ReadTicketUsage reads the ticket usage records completed since the time, the empty list is returned if the file path
+// is empty or the file does not exist.
+func ReadTicketUsage(filePath string, since time.Time) ([]TicketUsageRecord, error) {
+	records := make([]TicketUsageRecord, 0)
+	if filePath == "" {
+		return records, nil
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return records, nil
+		}
+		return nil, errors.Wrapf(err, "failed to open ticket usage file, path:%s", filePath)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) != 0 {
+			var record TicketUsageRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				return nil, errors.Wrapf(
+					err, "failed to unmarshal ticket usage record, path:%s, line:%d", filePath, lineNumber,
+				)
+			}
+			if since.IsZero() || !record.CompletedAt.Before(since) {
+				records = append(records, record)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read ticket usage file, path:%s", filePath)
+		}
+	}
+}
+
+// TicketUsageByType is the ticket usage of the operation type.
+type TicketUsageByType struct {
+	OperationType coreum.OperationTypeEnum `json:"operation_type"`
+	Accepted      int                      `json:"accepted"`
+	Rejected      int                      `json:"rejected"`
+	Invalid       int                      `json:"invalid"`
+}
+
+// Consumed returns the number of the tickets consumed by the operations of the type.
+func (u TicketUsageByType) Consumed() int {
+	return u.Accepted + u.Rejected
+}
+
+// TicketUsageSummary is the summary of the ticket usage within the time window.
+type TicketUsageSummary struct {
+	Since  time.Time           `json:"since"`
+	Until  time.Time           `json:"until"`
+	ByType []TicketUsageByType `json:"by_type"`
+	// Consumed is the number of the tickets consumed by the accepted and rejected operations.
+	Consumed int `json:"consumed"`
+	// Invalid is the number of the invalid operations, their tickets are returned to the contract.
+	Invalid int `json:"invalid"`
+	// BurnRatePerDay is the number of the consumed tickets per day within the window.
+	BurnRatePerDay   float64 `json:"burn_rate_per_day"`
+	AvailableTickets int     `json:"available_tickets"`
+	// ProjectedExhaustion is the time the available tickets are consumed at the current burn rate, nil if no tickets
+	// are consumed within the window.
+	ProjectedExhaustion *time.Time `json:"projected_exhaustion,omitempty"`
+}
+
+// SummarizeTicketUsage summarizes the ticket usage records completed within the window before the now time and
+// projects the exhaustion time of the available tickets at the window burn rate.
+func SummarizeTicketUsage(
+	records []TicketUsageRecord,
+	window time.Duration,
+	now time.Time,
+	availableTickets int,
+) (TicketUsageSummary, error) {
+	if window <= 0 {
+		return TicketUsageSummary{}, errors.Errorf("ticket usage window must be positive, got: %s", window)
+	}
+	summary := TicketUsageSummary{
+		Since:            now.Add(-window),
+		Until:            now,
+		ByType:           make([]TicketUsageByType, 0),
+		AvailableTickets: availableTickets,
+	}
+	byType := make(map[coreum.OperationTypeEnum]*TicketUsageByType)
+	for _, record := range records {
+		if record.CompletedAt.Before(summary.Since) || record.CompletedAt.After(now) {
+			continue
+		}
+		usage, ok := byType[record.OperationType]
+		if !ok {
+			usage = &TicketUsageByType{OperationType: record.OperationType}
+			byType[record.OperationType] = usage
+		}
+		switch record.Result {
+		case coreum.TransactionResultAccepted:
+			usage.Accepted++
+			summary.Consumed++
+		case coreum.TransactionResultRejected:
+			usage.Rejected++
+			summary.Consumed++
+		case coreum.TransactionResultInvalid:
+			usage.Invalid++
+			summary.Invalid++
+		default:
+			return TicketUsageSummary{}, errors.Errorf(
+				"unexpected ticket usage result %q of ticket %d", record.Result, record.TicketSequence,
+			)
+		}
+	}
+	for _, usage := range byType {
+		summary.ByType = append(summary.ByType, *usage)
+	}
+	sort.Slice(summary.ByType, func(i, j int) bool {
+		return summary.ByType[i].OperationType < summary.ByType[j].OperationType
+	})
+
+	windowDays := window.Hours() / 24
+	summary.BurnRatePerDay = float64(summary.Consumed) / windowDays
+	if summary.BurnRatePerDay > 0 {
+		daysLeft := float64(availableTickets) / summary.BurnRatePerDay
+		exhaustion := now.Add(time.Duration(math.Round(daysLeft * 24 * float64(time.Hour))))
+		summary.ProjectedExhaustion = &exhaustion
+	}
+
+	return summary, nil
+}
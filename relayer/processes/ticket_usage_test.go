@@ -0,0 +1,159 @@
+package processes_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestTicketUsageTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	filePath := filepath.Join(t.TempDir(), processes.TicketUsageFileName)
+
+	now := time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	counters := make(map[[2]string]int)
+	metricRegistryMock := NewMockTicketUsageMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().IncrementTicketsConsumedCounter(gomock.Any(), gomock.Any()).Do(
+		func(operationType, result string) {
+			counters[[2]string{operationType, result}]++
+		},
+	).AnyTimes()
+
+	tracker, err := processes.NewTicketUsageTracker(filePath, metricRegistryMock, clock)
+	require.NoError(t, err)
+
+	completions := []processes.TicketUsageRecord{
+		newTicketUsageRecord(1, coreum.OperationTypeEnumCoreumToXRPLTransfer, coreum.TransactionResultAccepted, now),
+		newTicketUsageRecord(2, coreum.OperationTypeEnumCoreumToXRPLTransfer, coreum.TransactionResultAccepted, now),
+		newTicketUsageRecord(3, coreum.OperationTypeEnumCoreumToXRPLTransfer, coreum.TransactionResultRejected, now),
+		newTicketUsageRecord(4, coreum.OperationTypeEnumTrustSet, coreum.TransactionResultAccepted, now),
+		newTicketUsageRecord(5, coreum.OperationTypeEnumAllocateTickets, coreum.TransactionResultAccepted, now),
+		newTicketUsageRecord(6, coreum.OperationTypeEnumTrustSet, coreum.TransactionResultInvalid, now),
+		// the same result observed by the full scan
+		newTicketUsageRecord(1, coreum.OperationTypeEnumCoreumToXRPLTransfer, coreum.TransactionResultAccepted, now),
+	}
+	for _, record := range completions {
+		require.NoError(t, tracker.Record(ctx, record))
+	}
+	require.ErrorContains(t, tracker.Record(ctx, processes.TicketUsageRecord{}), "ticket sequence must not be zero")
+
+	require.Equal(t, map[[2]string]int{
+		{string(coreum.OperationTypeEnumCoreumToXRPLTransfer), string(coreum.TransactionResultAccepted)}: 2,
+		{string(coreum.OperationTypeEnumCoreumToXRPLTransfer), string(coreum.TransactionResultRejected)}: 1,
+		{string(coreum.OperationTypeEnumTrustSet), string(coreum.TransactionResultAccepted)}:             1,
+		{string(coreum.OperationTypeEnumTrustSet), string(coreum.TransactionResultInvalid)}:              1,
+		{string(coreum.OperationTypeEnumAllocateTickets), string(coreum.TransactionResultAccepted)}:      1,
+	}, counters)
+
+	records, err := processes.ReadTicketUsage(filePath, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	require.Equal(t, now, records[5].RecordedAt)
+	require.Equal(t, now, records[5].CompletedAt)
+
+	// the recorded usage isn't counted again after the restart
+	restartedTracker, err := processes.NewTicketUsageTracker(filePath, metricRegistryMock, clock)
+	require.NoError(t, err)
+	require.NoError(t, restartedTracker.Record(ctx, completions[0]))
+	records, err = processes.ReadTicketUsage(filePath, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+}
+
+func TestSummarizeTicketUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)
+	records := []processes.TicketUsageRecord{
+		// out of the window
+		newTicketUsageRecord(
+			1, coreum.OperationTypeEnumCoreumToXRPLTransfer, coreum.TransactionResultAccepted, now.Add(-11*24*time.Hour),
+		),
+	}
+	// 15 transfers, 3 rejected, and 5 trust sets within 10 days
+	for i := 0; i < 15; i++ {
+		result := coreum.TransactionResultAccepted
+		if i%5 == 0 {
+			result = coreum.TransactionResultRejected
+		}
+		records = append(records, newTicketUsageRecord(
+			uint32(10+i), coreum.OperationTypeEnumCoreumToXRPLTransfer, result, now.Add(-time.Duration(i)*time.Hour),
+		))
+	}
+	for i := 0; i < 5; i++ {
+		records = append(records, newTicketUsageRecord(
+			uint32(30+i), coreum.OperationTypeEnumTrustSet, coreum.TransactionResultAccepted, now.Add(-9*24*time.Hour),
+		))
+	}
+	records = append(
+		records,
+		newTicketUsageRecord(40, coreum.OperationTypeEnumTrustSet, coreum.TransactionResultInvalid, now),
+	)
+
+	summary, err := processes.SummarizeTicketUsage(records, 10*24*time.Hour, now, 50)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-10*24*time.Hour), summary.Since)
+	require.Equal(t, []processes.TicketUsageByType{
+		{
+			OperationType: coreum.OperationTypeEnumCoreumToXRPLTransfer,
+			Accepted:      12,
+			Rejected:      3,
+		},
+		{
+			OperationType: coreum.OperationTypeEnumTrustSet,
+			Accepted:      5,
+			Invalid:       1,
+		},
+	}, summary.ByType)
+	require.Equal(t, 20, summary.Consumed)
+	require.Equal(t, 1, summary.Invalid)
+	// 20 tickets in 10 days
+	require.InDelta(t, 2.0, summary.BurnRatePerDay, 1e-9)
+	// 50 tickets at 2 tickets per day
+	require.NotNil(t, summary.ProjectedExhaustion)
+	require.Equal(t, now.Add(25*24*time.Hour), *summary.ProjectedExhaustion)
+
+	// no consumption within the window
+	summary, err = processes.SummarizeTicketUsage(records[:1], 24*time.Hour, now, 50)
+	require.NoError(t, err)
+	require.Empty(t, summary.ByType)
+	require.Zero(t, summary.BurnRatePerDay)
+	require.Nil(t, summary.ProjectedExhaustion)
+
+	_, err = processes.SummarizeTicketUsage(records, 0, now, 50)
+	require.ErrorContains(t, err, "window must be positive")
+}
+
+func newTicketUsageRecord(
+	ticketSequence uint32,
+	operationType coreum.OperationTypeEnum,
+	result coreum.TransactionResult,
+	completedAt time.Time,
+) processes.TicketUsageRecord {
+	record := processes.TicketUsageRecord{
+		TicketSequence: ticketSequence,
+		OperationType:  operationType,
+		Result:         result,
+		CompletedAt:    completedAt,
+	}
+	if result != coreum.TransactionResultInvalid {
+		record.TxHash = fmt.Sprintf("HASH%d", ticketSequence)
+	}
+
+	return record
+}
@@ -0,0 +1,305 @@
+//nolint:tagliatelle // json lines spec
+package processes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+)
+
+// TransferHistoryFileName is the name of the transfers history file stored in the relayer home.
+const TransferHistoryFileName = "transfer-history.jsonl"
+
+const defaultTransferHistoryRetention = 90 * 24 * time.Hour
+
+// TransferHistoryDirection is the direction of the transfer in the history.
+type TransferHistoryDirection string
+
+// TransferHistoryDirection values.
+const (
+	TransferHistoryDirectionXRPLToCoreum TransferHistoryDirection = "xrpl-to-coreum"
+	TransferHistoryDirectionCoreumToXRPL TransferHistoryDirection = "coreum-to-xrpl"
+)
+
+// TransferHistoryStatus is the status of the transfer in the history.
+type TransferHistoryStatus string
+
+// TransferHistoryStatus values.
+const (
+	// TransferHistoryStatusCompleted is the status of the transfer completed on the destination chain.
+	TransferHistoryStatusCompleted TransferHistoryStatus = "completed"
+	// TransferHistoryStatusConfirmed is the status of the XRPL to Coreum transfer confirmed by the relayer, which
+	// evidence hasn't reached the threshold, so the transfer is completed by the evidence of another relayer.
+	TransferHistoryStatusConfirmed TransferHistoryStatus = "confirmed"
+	// TransferHistoryStatusRejected is the status of the Coreum to XRPL transfer rejected by the XRPL, the sent
+	// tokens are refunded to the sender.
+	TransferHistoryStatusRejected TransferHistoryStatus = "rejected"
+)
+
+// TransferHistoryRecord is the history record of the transfer the relayer has provided the evidence for.
+type TransferHistoryRecord struct {
+	Direction TransferHistoryDirection `json:"direction"`
+	// SourceTxHash is the hash of the XRPL payment of the XRPL to Coreum transfer. The Coreum to XRPL operation
+	// doesn't reference the Coreum tx which created it, so such transfer is identified by the OperationID.
+	SourceTxHash string `json:"source_tx_hash,omitempty"`
+	// DestinationTxHash is the hash of the Coreum tx which reached the evidence threshold or the hash of the XRPL
+	// payment of the Coreum to XRPL transfer.
+	DestinationTxHash string `json:"destination_tx_hash,omitempty"`
+	OperationID       uint32 `json:"operation_id,omitempty"`
+	Sender            string `json:"sender,omitempty"`
+	Recipient         string `json:"recipient"`
+	Issuer            string `json:"issuer"`
+	Currency          string `json:"currency"`
+	// Denom is the Coreum denom of the received coin, set once the delivery is found in the Coreum tx.
+	Denom string `json:"denom,omitempty"`
+	// Amount is the amount sent from the source chain in the Coreum representation of the XRPL amount.
+	Amount string `json:"amount"`
+	// Fee is the amount deducted by the bridge, set only if the sent and received amounts have the same decimals.
+	Fee         string                `json:"fee,omitempty"`
+	StartedAt   time.Time             `json:"started_at"`
+	CompletedAt time.Time             `json:"completed_at"`
+	RecordedAt  time.Time             `json:"recorded_at"`
+	Status      TransferHistoryStatus `json:"status"`
+}
+
+// TransferHistoryFilter is the filter of the transfers history records.
+type TransferHistoryFilter struct {
+	// Address matches the sender or the recipient.
+	Address string
+	// Denom matches the Coreum denom or the XRPL currency.
+	Denom string
+	// Since is the min record time.
+	Since time.Time
+}
+
+// Match returns true if the record matches the filter.
+func (f TransferHistoryFilter) Match(record TransferHistoryRecord) bool {
+	if f.Address != "" && record.Sender != f.Address && record.Recipient != f.Address {
+		return false
+	}
+	if f.Denom != "" && record.Denom != f.Denom && record.Currency != f.Denom {
+		return false
+	}
+
+	return f.Since.IsZero() || !record.RecordedAt.Before(f.Since)
+}
+
+// TransferHistoryConfig is the TransferHistory config.
+type TransferHistoryConfig struct {
+	// Path is the history file path, the empty path disables the history.
+	Path string
+	// Retention is the time the records are kept for, the older records are removed by the Prune.
+	Retention time.Duration
+}
+
+// DefaultTransferHistoryConfig returns the default TransferHistoryConfig.
+func DefaultTransferHistoryConfig(path string) TransferHistoryConfig {
+	return TransferHistoryConfig{
+		Path:      path,
+		Retention: defaultTransferHistoryRetention,
+	}
+}
+
+// TransferHistory keeps the history of the transfers the relayer has provided the evidences for in the JSON Lines
+// file, so the support requests can be answered without the chains scan.
+type TransferHistory struct {
+	cfg   TransferHistoryConfig
+	clock func() time.Time
+	mu    sync.Mutex
+}
+
+// NewTransferHistory returns a new instance of the TransferHistory.
+func NewTransferHistory(cfg TransferHistoryConfig, clock func() time.Time) (*TransferHistory, error) {
+	if cfg.Path != "" && cfg.Retention <= 0 {
+		return nil, errors.Errorf("transfer history retention must be positive, got: %s", cfg.Retention)
+	}
+
+	return &TransferHistory{
+		cfg:   cfg,
+		clock: clock,
+	}, nil
+}
+
+// Append appends the record to the history file.
+func (h *TransferHistory) Append(_ context.Context, record TransferHistoryRecord) error {
+	if h.cfg.Path == "" {
+		return nil
+	}
+	if record.RecordedAt.IsZero() {
+		record.RecordedAt = h.clock().UTC()
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal transfer history record")
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(h.cfg.Path), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create transfer history dir, path:%s", h.cfg.Path)
+	}
+	file, err := os.OpenFile(h.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open transfer history file, path:%s", h.cfg.Path)
+	}
+	if _, err := file.Write(recordBytes); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write transfer history file, path:%s", h.cfg.Path)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close transfer history file, path:%s", h.cfg.Path)
+}
+
+// Prune removes the records older than the retention and returns the number of the removed records.
+func (h *TransferHistory) Prune() (int, error) {
+	if h.cfg.Path == "" {
+		return 0, nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records, err := ReadTransferHistory(h.cfg.Path, TransferHistoryFilter{})
+	if err != nil {
+		return 0, err
+	}
+	retentionFilter := TransferHistoryFilter{
+		Since: h.clock().Add(-h.cfg.Retention),
+	}
+	keptRecords := lo.Filter(records, func(record TransferHistoryRecord, _ int) bool {
+		return retentionFilter.Match(record)
+	})
+	pruned := len(records) - len(keptRecords)
+	if pruned == 0 {
+		return 0, nil
+	}
+
+	var buf bytes.Buffer
+	for _, record := range keptRecords {
+		recordBytes, err := json.Marshal(record)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to marshal transfer history record")
+		}
+		buf.Write(recordBytes)
+		buf.WriteByte('\n')
+	}
+	if err := fsutil.WriteFileAtomically(h.cfg.Path, buf.Bytes()); err != nil {
+		return 0, errors.Wrapf(err, "failed to save transfer history, path:%s", h.cfg.Path)
+	}
+
+	return pruned, nil
+}
+
+// ReadTransferHistory reads the history records matching the filter, the empty history is returned if the file path is
+// empty or the file does not exist.
+func ReadTransferHistory(filePath string, filter TransferHistoryFilter) ([]TransferHistoryRecord, error) {
+	records := make([]TransferHistoryRecord, 0)
+	if filePath == "" {
+		return records, nil
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return records, nil
+		}
+		return nil, errors.Wrapf(err, "failed to open transfer history file, path:%s", filePath)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) != 0 {
+			var record TransferHistoryRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				return nil, errors.Wrapf(
+					err, "failed to unmarshal transfer history record, path:%s, line:%d", filePath, lineNumber,
+				)
+			}
+			if filter.Match(record) {
+				records = append(records, record)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read transfer history file, path:%s", filePath)
+		}
+	}
+}
+
+// WriteTransferHistoryCSV writes the history records in the CSV format with the header.
+func WriteTransferHistoryCSV(w io.Writer, records []TransferHistoryRecord) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{
+		"direction",
+		"source_tx_hash",
+		"destination_tx_hash",
+		"operation_id",
+		"sender",
+		"recipient",
+		"issuer",
+		"currency",
+		"denom",
+		"amount",
+		"fee",
+		"started_at",
+		"completed_at",
+		"recorded_at",
+		"status",
+	}); err != nil {
+		return errors.Wrap(err, "failed to write transfer history CSV header")
+	}
+	for _, record := range records {
+		var operationID string
+		if record.OperationID != 0 {
+			operationID = strconv.FormatUint(uint64(record.OperationID), 10)
+		}
+		if err := csvWriter.Write([]string{
+			string(record.Direction),
+			record.SourceTxHash,
+			record.DestinationTxHash,
+			operationID,
+			record.Sender,
+			record.Recipient,
+			record.Issuer,
+			record.Currency,
+			record.Denom,
+			record.Amount,
+			record.Fee,
+			formatCSVTime(record.StartedAt),
+			formatCSVTime(record.CompletedAt),
+			formatCSVTime(record.RecordedAt),
+			string(record.Status),
+		}); err != nil {
+			return errors.Wrap(err, "failed to write transfer history CSV record")
+		}
+	}
+	csvWriter.Flush()
+
+	return errors.Wrap(csvWriter.Error(), "failed to flush transfer history CSV")
+}
+
+func formatCSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
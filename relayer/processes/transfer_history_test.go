@@ -0,0 +1,228 @@
+package processes_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+func TestTransferHistory_AppendAndRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	historyPath := filepath.Join(t.TempDir(), "history", processes.TransferHistoryFileName)
+	history, err := processes.NewTransferHistory(processes.DefaultTransferHistoryConfig(historyPath), func() time.Time {
+		return now
+	})
+	require.NoError(t, err)
+
+	xrplToCoreumRecord := processes.TransferHistoryRecord{
+		Direction:         processes.TransferHistoryDirectionXRPLToCoreum,
+		SourceTxHash:      "A1",
+		DestinationTxHash: "B1",
+		Sender:            "rSender",
+		Recipient:         "core1recipient",
+		Issuer:            "rIssuer",
+		Currency:          "USD",
+		Denom:             "usd-core1contract",
+		Amount:            "100",
+		Fee:               "1",
+		StartedAt:         time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		CompletedAt:       time.Date(2024, 2, 1, 0, 0, 5, 0, time.UTC),
+		RecordedAt:        time.Date(2024, 2, 1, 0, 0, 6, 0, time.UTC),
+		Status:            processes.TransferHistoryStatusCompleted,
+	}
+	coreumToXRPLRecord := processes.TransferHistoryRecord{
+		Direction:         processes.TransferHistoryDirectionCoreumToXRPL,
+		DestinationTxHash: "C1",
+		OperationID:       11,
+		Recipient:         "rRecipient",
+		Issuer:            "rIssuer",
+		Currency:          "EUR",
+		Amount:            "200",
+		CompletedAt:       time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC),
+		Status:            processes.TransferHistoryStatusRejected,
+	}
+	require.NoError(t, history.Append(ctx, xrplToCoreumRecord))
+	require.NoError(t, history.Append(ctx, coreumToXRPLRecord))
+
+	// the record time is set by the history if not provided
+	coreumToXRPLRecord.RecordedAt = now
+	records, err := processes.ReadTransferHistory(historyPath, processes.TransferHistoryFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []processes.TransferHistoryRecord{xrplToCoreumRecord, coreumToXRPLRecord}, records)
+
+	tests := []struct {
+		name   string
+		filter processes.TransferHistoryFilter
+		want   []processes.TransferHistoryRecord
+	}{
+		{
+			name:   "sender_address",
+			filter: processes.TransferHistoryFilter{Address: "rSender"},
+			want:   []processes.TransferHistoryRecord{xrplToCoreumRecord},
+		},
+		{
+			name:   "recipient_address",
+			filter: processes.TransferHistoryFilter{Address: "rRecipient"},
+			want:   []processes.TransferHistoryRecord{coreumToXRPLRecord},
+		},
+		{
+			name:   "denom",
+			filter: processes.TransferHistoryFilter{Denom: "usd-core1contract"},
+			want:   []processes.TransferHistoryRecord{xrplToCoreumRecord},
+		},
+		{
+			name:   "currency",
+			filter: processes.TransferHistoryFilter{Denom: "EUR"},
+			want:   []processes.TransferHistoryRecord{coreumToXRPLRecord},
+		},
+		{
+			name:   "since",
+			filter: processes.TransferHistoryFilter{Since: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)},
+			want:   []processes.TransferHistoryRecord{coreumToXRPLRecord},
+		},
+		{
+			name:   "no_match",
+			filter: processes.TransferHistoryFilter{Address: "rSender", Denom: "EUR"},
+			want:   []processes.TransferHistoryRecord{},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			records, err := processes.ReadTransferHistory(historyPath, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, records)
+		})
+	}
+}
+
+func TestTransferHistory_ReadNotExistingFile(t *testing.T) {
+	t.Parallel()
+
+	records, err := processes.ReadTransferHistory(
+		filepath.Join(t.TempDir(), processes.TransferHistoryFileName), processes.TransferHistoryFilter{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func TestTransferHistory_Prune(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	historyPath := filepath.Join(t.TempDir(), processes.TransferHistoryFileName)
+	history, err := processes.NewTransferHistory(processes.DefaultTransferHistoryConfig(historyPath), func() time.Time {
+		return now
+	})
+	require.NoError(t, err)
+
+	expiredRecord := processes.TransferHistoryRecord{
+		Direction:    processes.TransferHistoryDirectionXRPLToCoreum,
+		SourceTxHash: "A1",
+		RecordedAt:   now.Add(-91 * 24 * time.Hour),
+		Status:       processes.TransferHistoryStatusConfirmed,
+	}
+	keptRecord := processes.TransferHistoryRecord{
+		Direction:    processes.TransferHistoryDirectionXRPLToCoreum,
+		SourceTxHash: "A2",
+		RecordedAt:   now.Add(-89 * 24 * time.Hour),
+		Status:       processes.TransferHistoryStatusCompleted,
+	}
+	require.NoError(t, history.Append(ctx, expiredRecord))
+	require.NoError(t, history.Append(ctx, keptRecord))
+
+	pruned, err := history.Prune()
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	records, err := processes.ReadTransferHistory(historyPath, processes.TransferHistoryFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []processes.TransferHistoryRecord{keptRecord}, records)
+
+	// nothing to prune, the file is kept as is
+	pruned, err = history.Prune()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	// the disabled history is never pruned
+	disabledHistory, err := processes.NewTransferHistory(processes.DefaultTransferHistoryConfig(""), time.Now)
+	require.NoError(t, err)
+	pruned, err = disabledHistory.Prune()
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+	require.NoError(t, disabledHistory.Append(ctx, keptRecord))
+}
+
+func TestNewTransferHistory_InvalidRetention(t *testing.T) {
+	t.Parallel()
+
+	_, err := processes.NewTransferHistory(processes.TransferHistoryConfig{
+		Path: filepath.Join(t.TempDir(), processes.TransferHistoryFileName),
+	}, time.Now)
+	require.ErrorContains(t, err, "transfer history retention must be positive")
+}
+
+func TestWriteTransferHistoryCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, processes.WriteTransferHistoryCSV(&buf, []processes.TransferHistoryRecord{
+		{
+			Direction:         processes.TransferHistoryDirectionXRPLToCoreum,
+			SourceTxHash:      "A1",
+			DestinationTxHash: "B1",
+			Sender:            "rSender",
+			Recipient:         "core1recipient",
+			Issuer:            "rIssuer",
+			Currency:          "USD",
+			Denom:             "usd-core1contract",
+			Amount:            "100",
+			Fee:               "1",
+			StartedAt:         time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			CompletedAt:       time.Date(2024, 2, 1, 0, 0, 5, 0, time.UTC),
+			RecordedAt:        time.Date(2024, 2, 1, 0, 0, 6, 0, time.UTC),
+			Status:            processes.TransferHistoryStatusCompleted,
+		},
+		{
+			Direction:         processes.TransferHistoryDirectionCoreumToXRPL,
+			DestinationTxHash: "C1",
+			OperationID:       11,
+			Recipient:         "rRecipient",
+			Issuer:            "rIssuer",
+			Currency:          "EUR",
+			Amount:            "200",
+			RecordedAt:        time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC),
+			Status:            processes.TransferHistoryStatusRejected,
+		},
+	}))
+	require.Equal(t, "direction,source_tx_hash,destination_tx_hash,operation_id,sender,recipient,issuer,currency,"+
+		"denom,amount,fee,started_at,completed_at,recorded_at,status\n"+
+		"xrpl-to-coreum,A1,B1,,rSender,core1recipient,rIssuer,USD,usd-core1contract,100,1,"+
+		"2024-02-01T00:00:00Z,2024-02-01T00:00:05Z,2024-02-01T00:00:06Z,completed\n"+
+		"coreum-to-xrpl,,C1,11,,rRecipient,rIssuer,EUR,,200,,,,2024-02-02T00:00:00Z,rejected\n",
+		buf.String(),
+	)
+}
+
+func TestReadTransferHistory_InvalidRecord(t *testing.T) {
+	t.Parallel()
+
+	historyPath := filepath.Join(t.TempDir(), processes.TransferHistoryFileName)
+	require.NoError(t, os.WriteFile(historyPath, []byte("{}\nnot-json\n"), 0o600))
+
+	_, err := processes.ReadTransferHistory(historyPath, processes.TransferHistoryFilter{})
+	require.ErrorContains(t, err, "line:2")
+}
@@ -0,0 +1,364 @@
+//nolint:tagliatelle // yaml spec
+package processes
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// TransferLatencyStoreFileName is the name of the transfers latency store file stored in the relayer home.
+const TransferLatencyStoreFileName = "transfer-latency.yaml"
+
+// TransferLatencyRecord is the start and completion time of the transfer. The chains are observed independently, so
+// the completion might be observed before the start.
+type TransferLatencyRecord struct {
+	StartedAt   time.Time `yaml:"started_at,omitempty"`
+	CompletedAt time.Time `yaml:"completed_at,omitempty"`
+	// ObservedAt is the relayer time of the first observation, used to expire the records never completed.
+	ObservedAt time.Time `yaml:"observed_at"`
+}
+
+// TransferLatencyStore is the stored state of the transfers latency tracking.
+type TransferLatencyStore struct {
+	// LastCoreumHeight is the last Coreum block height scanned for the transfer txs.
+	LastCoreumHeight int64 `yaml:"last_coreum_height"`
+	// XRPLToCoreum are the records of the XRPL to Coreum transfers by the XRPL tx hash.
+	XRPLToCoreum map[string]TransferLatencyRecord `yaml:"xrpl_to_coreum"`
+	// CoreumToXRPL are the records of the Coreum to XRPL transfers by the operation ID.
+	CoreumToXRPL map[uint32]TransferLatencyRecord `yaml:"coreum_to_xrpl"`
+}
+
+// ReadTransferLatencyStore reads the transfer latency store file, the empty store is returned if the file path is
+// empty or the file does not exist.
+func ReadTransferLatencyStore(filePath string) (TransferLatencyStore, error) {
+	store := TransferLatencyStore{}
+	if filePath != "" {
+		fileBytes, err := os.ReadFile(filePath)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			return TransferLatencyStore{}, errors.Wrapf(
+				err, "failed to read transfer latency store file, path:%s", filePath,
+			)
+		default:
+			if err := yaml.Unmarshal(fileBytes, &store); err != nil {
+				return TransferLatencyStore{}, errors.Wrapf(
+					err, "failed to unmarshal transfer latency store file, path:%s", filePath,
+				)
+			}
+		}
+	}
+	if store.XRPLToCoreum == nil {
+		store.XRPLToCoreum = make(map[string]TransferLatencyRecord)
+	}
+	if store.CoreumToXRPL == nil {
+		store.CoreumToXRPL = make(map[uint32]TransferLatencyRecord)
+	}
+
+	return store, nil
+}
+
+func saveTransferLatencyStore(filePath string, store TransferLatencyStore) error {
+	if filePath == "" {
+		return nil
+	}
+	storeBytes, err := yaml.Marshal(store)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal transfer latency store")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, storeBytes), "failed to save transfer latency store, path:%s", filePath,
+	)
+}
+
+// TransferLatencyTrackerConfig is the TransferLatencyTracker config.
+type TransferLatencyTrackerConfig struct {
+	// PollInterval is the delay between the scans of the new Coreum blocks.
+	PollInterval time.Duration
+	// MaxRecordAge is the time after which the not completed transfer record is dropped. The observations older
+	// than it, e.g. found by the XRPL full history scan, are ignored.
+	MaxRecordAge time.Duration
+	// StoreFilePath is the path of the file the not completed transfer records are kept in, the empty path keeps
+	// them in memory only.
+	StoreFilePath string
+}
+
+// DefaultTransferLatencyTrackerConfig returns the default TransferLatencyTrackerConfig.
+func DefaultTransferLatencyTrackerConfig(storeFilePath string) TransferLatencyTrackerConfig {
+	return TransferLatencyTrackerConfig{
+		PollInterval:  10 * time.Second,
+		MaxRecordAge:  24 * time.Hour,
+		StoreFilePath: storeFilePath,
+	}
+}
+
+// TransferLatencyTracker measures the end-to-end latency of the bridge transfers. The XRPL side is reported by the
+// XRPL to Coreum process, which observes all bridge account txs, and the Coreum side is found in the contract txs of
+// the new Coreum blocks, so the transfers completed by the other relayers are measured as well.
+type TransferLatencyTracker struct {
+	cfg            TransferLatencyTrackerConfig
+	log            logger.Logger
+	contractClient TransferLatencyContractClient
+	metricRegistry TransferLatencyMetricRegistry
+	clock          func() time.Time
+
+	mu    sync.Mutex
+	store TransferLatencyStore
+}
+
+// NewTransferLatencyTracker returns a new instance of the TransferLatencyTracker with the state loaded from the store.
+func NewTransferLatencyTracker(
+	cfg TransferLatencyTrackerConfig,
+	log logger.Logger,
+	contractClient TransferLatencyContractClient,
+	metricRegistry TransferLatencyMetricRegistry,
+	clock func() time.Time,
+) (*TransferLatencyTracker, error) {
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("transfer latency poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+	if cfg.MaxRecordAge <= 0 {
+		return nil, errors.Errorf("transfer latency max record age must be positive, got: %s", cfg.MaxRecordAge)
+	}
+	store, err := ReadTransferLatencyStore(cfg.StoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransferLatencyTracker{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		store:          store,
+	}, nil
+}
+
+// Start scans the new Coreum blocks with the poll interval.
+func (t *TransferLatencyTracker) Start(ctx context.Context) error {
+	for {
+		if err := t.ScanCoreumTxs(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			t.log.Error(ctx, "Failed to scan Coreum txs for the transfers latency", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(t.cfg.PollInterval):
+		}
+	}
+}
+
+// ScanCoreumTxs observes the XRPL to Coreum transfers which reached the evidence threshold and the Coreum to XRPL
+// transfers sent in the Coreum blocks produced since the previous scan. The first scan only remembers the latest
+// height, so the history is never measured.
+func (t *TransferLatencyTracker) ScanCoreumTxs(ctx context.Context) error {
+	latestHeight, err := t.contractClient.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	lastHeight := t.store.LastCoreumHeight
+	t.mu.Unlock()
+	if lastHeight >= latestHeight {
+		return nil
+	}
+
+	var (
+		xrplToCoreumTransfers []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]
+		coreumToXRPLTransfers []coreum.CoreumToXRPLTransfer
+	)
+	if lastHeight != 0 {
+		filter := coreum.TransfersFilter{
+			StartHeight: lastHeight + 1,
+			EndHeight:   latestHeight,
+		}
+		if xrplToCoreumTransfers, err = t.contractClient.GetXRPLToCoreumTransfers(ctx, filter); err != nil {
+			return err
+		}
+		if coreumToXRPLTransfers, err = t.contractClient.GetCoreumToXRPLTransfers(ctx, filter); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, transfer := range xrplToCoreumTransfers {
+		blockTime, err := parseTxTime(transfer.Tx)
+		if err != nil {
+			return err
+		}
+		t.observeXRPLToCoreum(ctx, transfer.Evidence.TxHash, TransferLatencyRecord{CompletedAt: blockTime})
+	}
+	for _, transfer := range coreumToXRPLTransfers {
+		blockTime, err := parseTxTime(transfer.Tx)
+		if err != nil {
+			return err
+		}
+		for _, operationID := range transfer.OperationIDs {
+			t.observeCoreumToXRPL(ctx, operationID, TransferLatencyRecord{StartedAt: blockTime})
+		}
+	}
+	t.store.LastCoreumHeight = latestHeight
+	t.expireRecords()
+
+	return saveTransferLatencyStore(t.cfg.StoreFilePath, t.store)
+}
+
+// ObserveXRPLToCoreumStart records the ledger close time of the incoming XRPL payment.
+func (t *TransferLatencyTracker) ObserveXRPLToCoreumStart(
+	ctx context.Context,
+	txHash string,
+	ledgerCloseTime time.Time,
+) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.observeXRPLToCoreum(ctx, txHash, TransferLatencyRecord{StartedAt: ledgerCloseTime}) {
+		return nil
+	}
+
+	return saveTransferLatencyStore(t.cfg.StoreFilePath, t.store)
+}
+
+// ObserveCoreumToXRPLCompletion records the ledger close time of the outgoing XRPL payment of the operation.
+func (t *TransferLatencyTracker) ObserveCoreumToXRPLCompletion(
+	ctx context.Context,
+	operationID uint32,
+	validationTime time.Time,
+) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.observeCoreumToXRPL(ctx, operationID, TransferLatencyRecord{CompletedAt: validationTime}) {
+		return nil
+	}
+
+	return saveTransferLatencyStore(t.cfg.StoreFilePath, t.store)
+}
+
+func (t *TransferLatencyTracker) observeXRPLToCoreum(
+	ctx context.Context,
+	txHash string,
+	observation TransferLatencyRecord,
+) bool {
+	latency, completed, changed := mergeTransferLatencyRecord(
+		t.store.XRPLToCoreum, txHash, observation, t.clock(), t.cfg.MaxRecordAge,
+	)
+	if completed {
+		t.metricRegistry.ObserveXRPLToCoreumLatency(latency.Seconds())
+		t.log.Info(
+			ctx,
+			"XRPL to Coreum transfer is completed",
+			zap.String("txHash", txHash),
+			zap.String("latency", latency.String()),
+		)
+	}
+
+	return changed
+}
+
+func (t *TransferLatencyTracker) observeCoreumToXRPL(
+	ctx context.Context,
+	operationID uint32,
+	observation TransferLatencyRecord,
+) bool {
+	latency, completed, changed := mergeTransferLatencyRecord(
+		t.store.CoreumToXRPL, operationID, observation, t.clock(), t.cfg.MaxRecordAge,
+	)
+	if completed {
+		t.metricRegistry.ObserveCoreumToXRPLLatency(latency.Seconds())
+		t.log.Info(
+			ctx,
+			"Coreum to XRPL transfer is completed",
+			zap.Uint32("operationID", operationID),
+			zap.String("latency", latency.String()),
+		)
+	}
+
+	return changed
+}
+
+// mergeTransferLatencyRecord merges the observation to the stored record and removes the record once both times
+// are known. The observations older than the max age are ignored.
+func mergeTransferLatencyRecord[K comparable](
+	records map[K]TransferLatencyRecord,
+	key K,
+	observation TransferLatencyRecord,
+	now time.Time,
+	maxAge time.Duration,
+) (latency time.Duration, completed, changed bool) {
+	observedTime := observation.StartedAt
+	if observedTime.IsZero() {
+		observedTime = observation.CompletedAt
+	}
+	if now.Sub(observedTime) > maxAge {
+		return 0, false, false
+	}
+
+	record, ok := records[key]
+	if !ok {
+		record.ObservedAt = now
+	}
+	if !observation.StartedAt.IsZero() {
+		record.StartedAt = observation.StartedAt
+	}
+	if !observation.CompletedAt.IsZero() {
+		record.CompletedAt = observation.CompletedAt
+	}
+	if record.StartedAt.IsZero() || record.CompletedAt.IsZero() {
+		records[key] = record
+		return 0, false, true
+	}
+	delete(records, key)
+
+	// the latency is never negative even if the chains clocks are skewed
+	latency = record.CompletedAt.Sub(record.StartedAt)
+	if latency < 0 {
+		latency = 0
+	}
+
+	return latency, true, true
+}
+
+func (t *TransferLatencyTracker) expireRecords() {
+	now := t.clock()
+	for txHash, record := range t.store.XRPLToCoreum {
+		if now.Sub(record.ObservedAt) > t.cfg.MaxRecordAge {
+			delete(t.store.XRPLToCoreum, txHash)
+		}
+	}
+	for operationID, record := range t.store.CoreumToXRPL {
+		if now.Sub(record.ObservedAt) > t.cfg.MaxRecordAge {
+			delete(t.store.CoreumToXRPL, operationID)
+		}
+	}
+}
+
+func parseTxTime(tx *sdk.TxResponse) (time.Time, error) {
+	if tx == nil {
+		return time.Time{}, errors.New("nil coreum tx")
+	}
+	txTime, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to parse coreum tx timestamp, tx:%s", tx.TxHash)
+	}
+
+	return txTime, nil
+}
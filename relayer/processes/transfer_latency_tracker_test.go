@@ -0,0 +1,153 @@
+package processes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+type fakeTransferLatencyContractClient struct {
+	latestHeight          int64
+	filters               []coreum.TransfersFilter
+	xrplToCoreumTransfers []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]
+	coreumToXRPLTransfers []coreum.CoreumToXRPLTransfer
+}
+
+func (c *fakeTransferLatencyContractClient) GetLatestBlockHeight(context.Context) (int64, error) {
+	return c.latestHeight, nil
+}
+
+func (c *fakeTransferLatencyContractClient) GetXRPLToCoreumTransfers(
+	_ context.Context,
+	filter coreum.TransfersFilter,
+) ([]coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence], error) {
+	c.filters = append(c.filters, filter)
+	return c.xrplToCoreumTransfers, nil
+}
+
+func (c *fakeTransferLatencyContractClient) GetCoreumToXRPLTransfers(
+	_ context.Context,
+	_ coreum.TransfersFilter,
+) ([]coreum.CoreumToXRPLTransfer, error) {
+	return c.coreumToXRPLTransfers, nil
+}
+
+func TestTransferLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.TransferLatencyStoreFileName)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	xrplToCoreumLatencies := make([]float64, 0)
+	coreumToXRPLLatencies := make([]float64, 0)
+	metricRegistryMock := NewMockTransferLatencyMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().ObserveXRPLToCoreumLatency(gomock.Any()).Do(func(seconds float64) {
+		xrplToCoreumLatencies = append(xrplToCoreumLatencies, seconds)
+	}).AnyTimes()
+	metricRegistryMock.EXPECT().ObserveCoreumToXRPLLatency(gomock.Any()).Do(func(seconds float64) {
+		coreumToXRPLLatencies = append(coreumToXRPLLatencies, seconds)
+	}).AnyTimes()
+
+	contractClient := &fakeTransferLatencyContractClient{latestHeight: 100}
+	cfg := processes.DefaultTransferLatencyTrackerConfig(storeFilePath)
+	tracker, err := processes.NewTransferLatencyTracker(
+		cfg, logger.NewAnyLogMock(ctrl), contractClient, metricRegistryMock, clock,
+	)
+	require.NoError(t, err)
+
+	// the first scan only remembers the latest height
+	require.NoError(t, tracker.ScanCoreumTxs(ctx))
+	require.Empty(t, contractClient.filters)
+
+	// XRPL to Coreum transfer completed after the start is observed
+	require.NoError(t, tracker.ObserveXRPLToCoreumStart(ctx, "HASH1", now.Add(-10*time.Second)))
+	// Coreum to XRPL transfer completed by the other relayers before the Coreum tx is scanned
+	require.NoError(t, tracker.ObserveCoreumToXRPLCompletion(ctx, 7, now.Add(5*time.Second)))
+
+	contractClient.latestHeight = 110
+	contractClient.xrplToCoreumTransfers = []coreum.DataToTx[coreum.XRPLToCoreumTransferEvidence]{
+		{
+			Evidence: coreum.XRPLToCoreumTransferEvidence{TxHash: "HASH1"},
+			Tx:       buildTxResponse(now.Add(4 * time.Second)),
+		},
+		{
+			// the start of the transfer isn't observed yet
+			Evidence: coreum.XRPLToCoreumTransferEvidence{TxHash: "HASH2"},
+			Tx:       buildTxResponse(now.Add(6 * time.Second)),
+		},
+	}
+	contractClient.coreumToXRPLTransfers = []coreum.CoreumToXRPLTransfer{
+		{
+			OperationIDs: []uint32{7, 8},
+			Tx:           buildTxResponse(now.Add(-15 * time.Second)),
+		},
+	}
+	require.NoError(t, tracker.ScanCoreumTxs(ctx))
+	require.Equal(t, []coreum.TransfersFilter{{StartHeight: 101, EndHeight: 110}}, contractClient.filters)
+	require.Equal(t, []float64{14}, xrplToCoreumLatencies)
+	require.Equal(t, []float64{20}, coreumToXRPLLatencies)
+
+	// the not completed transfers are kept after the restart
+	restartedTracker, err := processes.NewTransferLatencyTracker(
+		cfg, logger.NewAnyLogMock(ctrl), contractClient, metricRegistryMock, clock,
+	)
+	require.NoError(t, err)
+	store, err := processes.ReadTransferLatencyStore(storeFilePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), store.LastCoreumHeight)
+	require.Len(t, store.XRPLToCoreum, 1)
+	require.Len(t, store.CoreumToXRPL, 1)
+
+	require.NoError(t, restartedTracker.ObserveXRPLToCoreumStart(ctx, "HASH2", now))
+	require.NoError(t, restartedTracker.ObserveCoreumToXRPLCompletion(ctx, 8, now.Add(30*time.Second)))
+	require.Equal(t, []float64{14, 6}, xrplToCoreumLatencies)
+	require.Equal(t, []float64{20, 45}, coreumToXRPLLatencies)
+
+	// the observations older than the max record age are ignored
+	require.NoError(t, restartedTracker.ObserveXRPLToCoreumStart(ctx, "HASH3", now.Add(-cfg.MaxRecordAge-time.Second)))
+	// the records never completed are expired
+	require.NoError(t, restartedTracker.ObserveXRPLToCoreumStart(ctx, "HASH4", now))
+	now = now.Add(cfg.MaxRecordAge + time.Second)
+	contractClient.latestHeight = 120
+	contractClient.xrplToCoreumTransfers = nil
+	contractClient.coreumToXRPLTransfers = nil
+	require.NoError(t, restartedTracker.ScanCoreumTxs(ctx))
+
+	store, err = processes.ReadTransferLatencyStore(storeFilePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(120), store.LastCoreumHeight)
+	require.Empty(t, store.XRPLToCoreum)
+	require.Empty(t, store.CoreumToXRPL)
+	require.Len(t, xrplToCoreumLatencies, 2)
+	require.Len(t, coreumToXRPLLatencies, 2)
+}
+
+func TestReadTransferLatencyStore_NotExistingFile(t *testing.T) {
+	t.Parallel()
+
+	store, err := processes.ReadTransferLatencyStore(filepath.Join(t.TempDir(), processes.TransferLatencyStoreFileName))
+	require.NoError(t, err)
+	require.Empty(t, store.XRPLToCoreum)
+	require.Empty(t, store.CoreumToXRPL)
+}
+
+func buildTxResponse(blockTime time.Time) *sdk.TxResponse {
+	return &sdk.TxResponse{
+		Timestamp: blockTime.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,228 @@
+package processes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// TransferRateLimit is the max amount of the token which can be transferred from Coreum to XRPL within the rate
+// limit window.
+type TransferRateLimit struct {
+	Issuer   string
+	Currency string
+	// MaxAmount is represented the same way as the operation amount, in drops for the XRP and with the XRPL issued
+	// token decimals for other tokens.
+	MaxAmount sdkmath.Int
+}
+
+// NewTransferRateLimit returns the TransferRateLimit with the max amount converted from the XRPL value, e.g. "1000.5".
+func NewTransferRateLimit(issuer, currency, maxAmount string) (TransferRateLimit, error) {
+	maxAmountDec, err := sdkmath.LegacyNewDecFromStr(maxAmount)
+	if err != nil {
+		return TransferRateLimit{}, errors.Wrapf(err, "invalid rate limit max amount: %s", maxAmount)
+	}
+	if !maxAmountDec.IsPositive() {
+		return TransferRateLimit{}, errors.Errorf("rate limit max amount must be positive, got: %s", maxAmount)
+	}
+	decimals := xrplTokenDecimals(issuer, currency)
+	maxAmountDec = maxAmountDec.MulInt(sdkmath.NewIntWithDecimal(1, decimals))
+	if !maxAmountDec.IsInteger() {
+		return TransferRateLimit{}, errors.Errorf(
+			"rate limit max amount %s has more than %d decimals", maxAmount, decimals,
+		)
+	}
+
+	return TransferRateLimit{
+		Issuer:    issuer,
+		Currency:  currency,
+		MaxAmount: maxAmountDec.TruncateInt(),
+	}, nil
+}
+
+// TransferRateLimiterConfig is the TransferRateLimiter config.
+type TransferRateLimiterConfig struct {
+	Window time.Duration
+	Limits []TransferRateLimit
+}
+
+// DefaultTransferRateLimiterConfig returns the default TransferRateLimiterConfig with the one hour window.
+func DefaultTransferRateLimiterConfig(limits []TransferRateLimit) TransferRateLimiterConfig {
+	return TransferRateLimiterConfig{
+		Window: time.Hour,
+		Limits: limits,
+	}
+}
+
+type rateLimitedTransfer struct {
+	operationID uint32
+	amount      sdkmath.Int
+	allowedAt   time.Time
+}
+
+type transferRateLimitState struct {
+	limit     TransferRateLimit
+	transfers []rateLimitedTransfer
+}
+
+// TransferRateLimiter limits the amount of the Coreum to XRPL transfers signed by the relayer per token in the rolling
+// window. Each relayer enforces its limits independently, so the rate limited operations don't reach the signing
+// threshold until the window slides. The state is kept in memory, so it's reset with the relayer restart.
+type TransferRateLimiter struct {
+	cfg            TransferRateLimiterConfig
+	log            logger.Logger
+	metricRegistry TransferRateLimiterMetricRegistry
+	clock          func() time.Time
+
+	mu     sync.Mutex
+	states map[string]*transferRateLimitState
+}
+
+// NewTransferRateLimiter returns a new instance of the TransferRateLimiter, the clock is used to get the current time.
+func NewTransferRateLimiter(
+	cfg TransferRateLimiterConfig,
+	log logger.Logger,
+	metricRegistry TransferRateLimiterMetricRegistry,
+	clock func() time.Time,
+) (*TransferRateLimiter, error) {
+	if cfg.Window <= 0 {
+		return nil, errors.Errorf("rate limit window must be positive, got: %s", cfg.Window)
+	}
+	states := make(map[string]*transferRateLimitState, len(cfg.Limits))
+	for _, limit := range cfg.Limits {
+		key := transferRateLimitKey(limit.Issuer, limit.Currency)
+		if _, ok := states[key]; ok {
+			return nil, errors.Errorf(
+				"duplicated rate limit, issuer:%s, currency:%s", limit.Issuer, limit.Currency,
+			)
+		}
+		states[key] = &transferRateLimitState{
+			limit: limit,
+		}
+	}
+
+	return &TransferRateLimiter{
+		cfg:            cfg,
+		log:            log,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		states:         states,
+	}, nil
+}
+
+// Allow returns true if the transfer operation can be signed, the allowed operation amount is added to the window
+// consumption. The operation allowed within the current window is allowed again without the repeated accounting.
+func (l *TransferRateLimiter) Allow(
+	ctx context.Context,
+	operationID uint32,
+	issuer, currency string,
+	amount sdkmath.Int,
+) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state, ok := l.states[transferRateLimitKey(issuer, currency)]
+	if !ok {
+		return true
+	}
+	now := l.clock()
+	l.pruneState(state, now)
+	defer l.reportState(state)
+
+	consumption := sdkmath.ZeroInt()
+	for _, transfer := range state.transfers {
+		if transfer.operationID == operationID {
+			return true
+		}
+		consumption = consumption.Add(transfer.amount)
+	}
+
+	if consumption.Add(amount).GT(state.limit.MaxAmount) {
+		l.log.Warn(
+			ctx,
+			"Coreum to XRPL transfer rate limit is reached, the operation isn't signed",
+			zap.Uint32("operationID", operationID),
+			zap.String("issuer", issuer),
+			zap.String("currency", currency),
+			zap.String("amount", amount.String()),
+			zap.String("consumption", consumption.String()),
+			zap.String("maxAmount", state.limit.MaxAmount.String()),
+			zap.String("window", l.cfg.Window.String()),
+		)
+		l.metricRegistry.IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(currency, issuer)
+		return false
+	}
+
+	state.transfers = append(state.transfers, rateLimitedTransfer{
+		operationID: operationID,
+		amount:      amount,
+		allowedAt:   now,
+	})
+
+	return true
+}
+
+// ReportConsumption removes the transfers out of the window and reports the consumption of all limits.
+func (l *TransferRateLimiter) ReportConsumption() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock()
+	for _, state := range l.states {
+		l.pruneState(state, now)
+		l.reportState(state)
+	}
+}
+
+func (l *TransferRateLimiter) pruneState(state *transferRateLimitState, now time.Time) {
+	windowStart := now.Add(-l.cfg.Window)
+	firstInWindow := 0
+	for firstInWindow < len(state.transfers) && !state.transfers[firstInWindow].allowedAt.After(windowStart) {
+		firstInWindow++
+	}
+	state.transfers = state.transfers[firstInWindow:]
+}
+
+func (l *TransferRateLimiter) reportState(state *transferRateLimitState) {
+	consumption := sdkmath.ZeroInt()
+	for _, transfer := range state.transfers {
+		consumption = consumption.Add(transfer.amount)
+	}
+	decimals := xrplTokenDecimals(state.limit.Issuer, state.limit.Currency)
+	l.metricRegistry.SetCoreumToXRPLTransferRateLimitConsumption(
+		state.limit.Currency,
+		state.limit.Issuer,
+		convertIntWithDecimalsToFloat64(consumption, decimals),
+		convertIntWithDecimalsToFloat64(state.limit.MaxAmount, decimals),
+	)
+}
+
+func transferRateLimitKey(issuer, currency string) string {
+	return fmt.Sprintf("%s/%s", currency, issuer)
+}
+
+// xrplTokenDecimals returns the decimals of the XRPL amount representation in the contract operations.
+func xrplTokenDecimals(issuer, currency string) int {
+	if isXRPToken(issuer, currency) {
+		return xrpl.XRPCurrencyDecimals
+	}
+	return xrpl.XRPLIssuedTokenDecimals
+}
+
+func convertIntWithDecimalsToFloat64(amount sdkmath.Int, decimals int) float64 {
+	// the float is used for the metrics only, so the precision loss is acceptable
+	value, err := sdkmath.LegacyNewDecFromInt(amount).QuoInt(sdkmath.NewIntWithDecimal(1, decimals)).Float64()
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
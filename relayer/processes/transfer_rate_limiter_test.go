@@ -0,0 +1,179 @@
+package processes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestNewTransferRateLimit(t *testing.T) {
+	t.Parallel()
+
+	xrpIssuer := xrpl.XRPTokenIssuer.String()
+	xrpCurrency := xrpl.ConvertCurrencyToString(xrpl.XRPTokenCurrency)
+	tokenIssuer := xrpl.GenPrivKeyTxSigner().Account().String()
+
+	tests := []struct {
+		name              string
+		issuer            string
+		currency          string
+		maxAmount         string
+		expectedMaxAmount sdkmath.Int
+		expectedErr       string
+	}{
+		{
+			name:              "xrp",
+			issuer:            xrpIssuer,
+			currency:          xrpCurrency,
+			maxAmount:         "1000.5",
+			expectedMaxAmount: sdkmath.NewInt(1_000_500_000),
+		},
+		{
+			name:              "issued_token",
+			issuer:            tokenIssuer,
+			currency:          "TKN",
+			maxAmount:         "10",
+			expectedMaxAmount: sdkmath.NewIntWithDecimal(10, xrpl.XRPLIssuedTokenDecimals),
+		},
+		{
+			name:        "invalid_amount",
+			issuer:      tokenIssuer,
+			currency:    "TKN",
+			maxAmount:   "ten",
+			expectedErr: "invalid rate limit max amount",
+		},
+		{
+			name:        "zero_amount",
+			issuer:      tokenIssuer,
+			currency:    "TKN",
+			maxAmount:   "0",
+			expectedErr: "must be positive",
+		},
+		{
+			name:        "xrp_amount_with_too_many_decimals",
+			issuer:      xrpIssuer,
+			currency:    xrpCurrency,
+			maxAmount:   "1.0000001",
+			expectedErr: "has more than 6 decimals",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limit, err := processes.NewTransferRateLimit(tt.issuer, tt.currency, tt.maxAmount)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMaxAmount.String(), limit.MaxAmount.String())
+		})
+	}
+}
+
+func TestNewTransferRateLimiter_DuplicatedLimit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	limit := processes.TransferRateLimit{
+		Issuer:    xrpl.GenPrivKeyTxSigner().Account().String(),
+		Currency:  "TKN",
+		MaxAmount: sdkmath.NewInt(100),
+	}
+	_, err := processes.NewTransferRateLimiter(
+		processes.DefaultTransferRateLimiterConfig([]processes.TransferRateLimit{limit, limit}),
+		logger.NewAnyLogMock(ctrl),
+		NewMockTransferRateLimiterMetricRegistry(ctrl),
+		time.Now,
+	)
+	require.ErrorContains(t, err, "duplicated rate limit")
+}
+
+func TestTransferRateLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	issuer := xrpl.GenPrivKeyTxSigner().Account().String()
+	currency := "TKN"
+	notLimitedCurrency := "NLT"
+	maxAmount := sdkmath.NewIntWithDecimal(100, xrpl.XRPLIssuedTokenDecimals)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		return now
+	}
+
+	metricRegistryMock := NewMockTransferRateLimiterMetricRegistry(ctrl)
+	var (
+		lastConsumption     float64
+		rateLimitedCounters int
+	)
+	metricRegistryMock.EXPECT().SetCoreumToXRPLTransferRateLimitConsumption(currency, issuer, gomock.Any(), 100.0).
+		Do(func(_, _ string, consumption, _ float64) {
+			lastConsumption = consumption
+		}).AnyTimes()
+	metricRegistryMock.EXPECT().IncrementCoreumToXRPLTransferRateLimitedOperationsCounter(currency, issuer).
+		Do(func(_, _ string) {
+			rateLimitedCounters++
+		}).AnyTimes()
+
+	limiter, err := processes.NewTransferRateLimiter(
+		processes.DefaultTransferRateLimiterConfig([]processes.TransferRateLimit{
+			{
+				Issuer:    issuer,
+				Currency:  currency,
+				MaxAmount: maxAmount,
+			},
+		}),
+		logger.NewAnyLogMock(ctrl),
+		metricRegistryMock,
+		clock,
+	)
+	require.NoError(t, err)
+
+	amount := func(value int64) sdkmath.Int {
+		return sdkmath.NewIntWithDecimal(value, xrpl.XRPLIssuedTokenDecimals)
+	}
+
+	require.True(t, limiter.Allow(ctx, 1, issuer, currency, amount(60)))
+	require.Equal(t, 60.0, lastConsumption)
+	// the same operation isn't counted twice
+	require.True(t, limiter.Allow(ctx, 1, issuer, currency, amount(60)))
+	require.Equal(t, 60.0, lastConsumption)
+
+	now = now.Add(30 * time.Minute)
+	require.True(t, limiter.Allow(ctx, 2, issuer, currency, amount(40)))
+	require.Equal(t, 100.0, lastConsumption)
+	// the budget is exhausted
+	require.False(t, limiter.Allow(ctx, 3, issuer, currency, amount(1)))
+	require.Equal(t, 1, rateLimitedCounters)
+	// the token without the limit isn't limited
+	require.True(t, limiter.Allow(ctx, 4, issuer, notLimitedCurrency, amount(1000)))
+
+	// the first transfer leaves the window
+	now = now.Add(30 * time.Minute)
+	limiter.ReportConsumption()
+	require.Equal(t, 40.0, lastConsumption)
+	require.False(t, limiter.Allow(ctx, 3, issuer, currency, amount(61)))
+	require.Equal(t, 2, rateLimitedCounters)
+	require.True(t, limiter.Allow(ctx, 3, issuer, currency, amount(60)))
+	require.Equal(t, 100.0, lastConsumption)
+
+	// all transfers leave the window
+	now = now.Add(time.Hour)
+	limiter.ReportConsumption()
+	require.Equal(t, 0.0, lastConsumption)
+	require.True(t, limiter.Allow(ctx, 5, issuer, currency, amount(100)))
+}
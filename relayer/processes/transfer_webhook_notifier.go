@@ -0,0 +1,379 @@
+//nolint:tagliatelle // json spec
+package processes
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// TransferWebhookDeadLetterLogFileName is the name of the dead-letter log file of the undeliverable transfer webhooks,
+// stored in the relayer home.
+const TransferWebhookDeadLetterLogFileName = "transfer-webhook-dead-letter.log"
+
+// TransferWebhookSignatureHeader is the header of the hex encoded HMAC-SHA256 signature of the webhook body signed
+// with the shared secret.
+const TransferWebhookSignatureHeader = "X-Bridge-Signature"
+
+// TransferWebhookPayload is the body of the webhook posted on the XRPL to Coreum transfer to the watched address.
+type TransferWebhookPayload struct {
+	XRPLTxHash string `json:"xrpl_tx_hash"`
+	Issuer     string `json:"issuer"`
+	Currency   string `json:"currency"`
+	Denom      string `json:"denom"`
+	// Amount is the amount received by the recipient after the bridging fees.
+	Amount       string `json:"amount"`
+	Recipient    string `json:"recipient"`
+	CoreumTxHash string `json:"coreum_tx_hash"`
+}
+
+// TransferWebhookDeadLetterRecord is the dead-letter log record of the webhook which delivery is failed.
+type TransferWebhookDeadLetterRecord struct {
+	Timestamp time.Time              `json:"timestamp"`
+	URL       string                 `json:"url"`
+	Attempts  uint32                 `json:"attempts"`
+	Error     string                 `json:"error"`
+	Payload   TransferWebhookPayload `json:"payload"`
+}
+
+// TransferWebhookNotifierConfig is the TransferWebhookNotifier config.
+type TransferWebhookNotifierConfig struct {
+	URLs []string
+	// Secret is the shared secret the webhook body signature is computed with.
+	Secret           string
+	WatchedAddresses []sdk.AccAddress
+	// QueueSize is the max number of the webhooks waiting for the delivery, the webhooks exceeding it are written to
+	// the dead-letter log.
+	QueueSize int
+	// MaxAttempts is the number of the delivery attempts after which the webhook is written to the dead-letter log.
+	MaxAttempts uint32
+	// InitialRetryDelay is the delay before the first retry, the delay is doubled with each next retry.
+	InitialRetryDelay time.Duration
+	MaxRetryDelay     time.Duration
+	RequestTimeout    time.Duration
+	// RequestsPerSecond is the max rate of the webhook requests to all URLs.
+	RequestsPerSecond float64
+	// DeadLetterLogFilePath is the path of the dead-letter log, the empty path disables the log.
+	DeadLetterLogFilePath string
+}
+
+// DefaultTransferWebhookNotifierConfig returns the default TransferWebhookNotifierConfig.
+func DefaultTransferWebhookNotifierConfig(deadLetterLogFilePath string) TransferWebhookNotifierConfig {
+	return TransferWebhookNotifierConfig{
+		QueueSize:             1000,
+		MaxAttempts:           5,
+		InitialRetryDelay:     time.Second,
+		MaxRetryDelay:         time.Minute,
+		RequestTimeout:        10 * time.Second,
+		RequestsPerSecond:     5,
+		DeadLetterLogFilePath: deadLetterLogFilePath,
+	}
+}
+
+type transferWebhook struct {
+	url     string
+	payload TransferWebhookPayload
+}
+
+// TransferWebhookNotifier posts the XRPL to Coreum transfers to the watched addresses to the webhook URLs. The
+// webhooks are queued and delivered in the background, so the contract events processing is never blocked by them.
+type TransferWebhookNotifier struct {
+	cfg        TransferWebhookNotifierConfig
+	log        logger.Logger
+	clock      func() time.Time
+	httpClient *http.Client
+	limiter    *rate.Limiter
+	watched    map[string]struct{}
+	queue      chan transferWebhook
+
+	deadLetterMu sync.Mutex
+}
+
+// NewTransferWebhookNotifier returns a new instance of the TransferWebhookNotifier.
+func NewTransferWebhookNotifier(
+	cfg TransferWebhookNotifierConfig,
+	log logger.Logger,
+	clock func() time.Time,
+) (*TransferWebhookNotifier, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, errors.New("at least one webhook URL is required")
+	}
+	if cfg.Secret == "" {
+		return nil, errors.New("webhook secret is required")
+	}
+	if cfg.QueueSize <= 0 {
+		return nil, errors.Errorf("webhook queue size must be positive, got: %d", cfg.QueueSize)
+	}
+	if cfg.MaxAttempts == 0 {
+		return nil, errors.New("webhook max attempts must be positive")
+	}
+	if cfg.InitialRetryDelay <= 0 || cfg.MaxRetryDelay < cfg.InitialRetryDelay {
+		return nil, errors.Errorf(
+			"invalid webhook retry delays, initial:%s, max:%s", cfg.InitialRetryDelay, cfg.MaxRetryDelay,
+		)
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		return nil, errors.Errorf("webhook requests per second must be positive, got: %v", cfg.RequestsPerSecond)
+	}
+
+	watched := make(map[string]struct{}, len(cfg.WatchedAddresses))
+	for _, address := range cfg.WatchedAddresses {
+		watched[address.String()] = struct{}{}
+	}
+
+	return &TransferWebhookNotifier{
+		cfg:        cfg,
+		log:        log,
+		clock:      clock,
+		httpClient: &http.Client{Timeout: cfg.RequestTimeout},
+		limiter:    rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), 1),
+		watched:    watched,
+		queue:      make(chan transferWebhook, cfg.QueueSize),
+	}, nil
+}
+
+// Notify queues the webhooks of the contract tx transfers to the watched addresses. It never blocks, the webhooks
+// which don't fit the queue are written to the dead-letter log.
+func (n *TransferWebhookNotifier) Notify(ctx context.Context, contractTx coreum.ContractTx) {
+	for _, transfer := range contractTx.XRPLToCoreumTransfers {
+		if _, ok := n.watched[transfer.Recipient.String()]; !ok {
+			continue
+		}
+		if transfer.ReceivedCoins.IsZero() {
+			n.log.Info(
+				ctx,
+				"Skipping webhook of the transfer not received by the watched address",
+				zap.String("xrplTxHash", transfer.XRPLTxHash),
+				zap.String("recipient", transfer.Recipient.String()),
+			)
+			continue
+		}
+		for _, coin := range transfer.ReceivedCoins {
+			payload := TransferWebhookPayload{
+				XRPLTxHash:   transfer.XRPLTxHash,
+				Issuer:       transfer.Issuer,
+				Currency:     transfer.Currency,
+				Denom:        coin.Denom,
+				Amount:       coin.Amount.String(),
+				Recipient:    transfer.Recipient.String(),
+				CoreumTxHash: contractTx.Hash,
+			}
+			for _, url := range n.cfg.URLs {
+				select {
+				case n.queue <- transferWebhook{url: url, payload: payload}:
+				default:
+					n.writeDeadLetter(ctx, transferWebhook{url: url, payload: payload}, 0, errors.New("queue is full"))
+				}
+			}
+		}
+	}
+}
+
+// Start delivers the queued webhooks.
+func (n *TransferWebhookNotifier) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case webhook := <-n.queue:
+			// the undeliverable webhooks are written to the dead-letter log, so only the cancellation stops the loop
+			if err := n.deliver(ctx, webhook); err != nil && ctx.Err() != nil {
+				return errors.WithStack(ctx.Err())
+			}
+		}
+	}
+}
+
+// WrapContractEventsSubscriber returns the subscriber which passes the contract txs to the notifier before sending
+// them to the subscription channel.
+func (n *TransferWebhookNotifier) WrapContractEventsSubscriber(
+	subscriber ContractEventsSubscriber,
+) ContractEventsSubscriber {
+	return &notifyingContractEventsSubscriber{
+		subscriber: subscriber,
+		notifier:   n,
+	}
+}
+
+func (n *TransferWebhookNotifier) deliver(ctx context.Context, webhook transferWebhook) error {
+	body, err := json.Marshal(webhook.payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook payload")
+	}
+	mac := hmac.New(sha256.New, []byte(n.cfg.Secret))
+	mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	delay := n.cfg.InitialRetryDelay
+	var attempts uint32
+	for {
+		if err := n.limiter.Wait(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		attempts++
+		retryable, err := n.post(ctx, webhook.url, body, signature)
+		if err == nil {
+			n.log.Debug(
+				ctx,
+				"Transfer webhook is delivered",
+				zap.String("url", webhook.url),
+				zap.String("xrplTxHash", webhook.payload.XRPLTxHash),
+			)
+			return nil
+		}
+		if ctx.Err() != nil {
+			return errors.WithStack(ctx.Err())
+		}
+		if !retryable || attempts >= n.cfg.MaxAttempts {
+			n.writeDeadLetter(ctx, webhook, attempts, err)
+			return err
+		}
+		n.log.Warn(
+			ctx,
+			"Failed to deliver transfer webhook, retrying",
+			zap.String("url", webhook.url),
+			zap.String("xrplTxHash", webhook.payload.XRPLTxHash),
+			zap.Uint32("attempts", attempts),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > n.cfg.MaxRetryDelay {
+			delay = n.cfg.MaxRetryDelay
+		}
+	}
+}
+
+// post posts the webhook and returns whether the failed request can be retried.
+func (n *TransferWebhookNotifier) post(ctx context.Context, url string, body []byte, signature string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build webhook request, url:%s", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TransferWebhookSignatureHeader, signature)
+
+	res, err := n.httpClient.Do(req)
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to post webhook, url:%s", url)
+	}
+	defer res.Body.Close()
+	//nolint:errcheck // the body is drained to reuse the connection
+	io.Copy(io.Discard, res.Body)
+
+	switch {
+	case res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices:
+		return false, nil
+	case res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests:
+		return true, errors.Errorf("unexpected webhook response status, url:%s, status:%d", url, res.StatusCode)
+	default:
+		return false, errors.Errorf("unexpected webhook response status, url:%s, status:%d", url, res.StatusCode)
+	}
+}
+
+func (n *TransferWebhookNotifier) writeDeadLetter(
+	ctx context.Context,
+	webhook transferWebhook,
+	attempts uint32,
+	deliveryErr error,
+) {
+	n.log.Error(
+		ctx,
+		"Transfer webhook is undeliverable",
+		zap.String("url", webhook.url),
+		zap.String("xrplTxHash", webhook.payload.XRPLTxHash),
+		zap.Uint32("attempts", attempts),
+		zap.Error(deliveryErr),
+	)
+	if n.cfg.DeadLetterLogFilePath == "" {
+		return
+	}
+	if err := n.appendDeadLetterRecord(TransferWebhookDeadLetterRecord{
+		Timestamp: n.clock().UTC(),
+		URL:       webhook.url,
+		Attempts:  attempts,
+		Error:     deliveryErr.Error(),
+		Payload:   webhook.payload,
+	}); err != nil {
+		n.log.Error(ctx, "Failed to write transfer webhook dead-letter record", zap.Error(err))
+	}
+}
+
+func (n *TransferWebhookNotifier) appendDeadLetterRecord(record TransferWebhookDeadLetterRecord) error {
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal transfer webhook dead-letter record")
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	n.deadLetterMu.Lock()
+	defer n.deadLetterMu.Unlock()
+
+	filePath := n.cfg.DeadLetterLogFilePath
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create transfer webhook dead-letter log dir, path:%s", filePath)
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open transfer webhook dead-letter log file, path:%s", filePath)
+	}
+	if _, err := file.Write(recordBytes); err != nil {
+		file.Close() //nolint:errcheck // the write error is returned
+		return errors.Wrapf(err, "failed to write transfer webhook dead-letter log file, path:%s", filePath)
+	}
+
+	return errors.Wrapf(file.Close(), "failed to close transfer webhook dead-letter log file, path:%s", filePath)
+}
+
+type notifyingContractEventsSubscriber struct {
+	subscriber ContractEventsSubscriber
+	notifier   *TransferWebhookNotifier
+}
+
+func (s *notifyingContractEventsSubscriber) Subscribe(ctx context.Context, ch chan<- coreum.ContractTx) error {
+	subscriberCh := make(chan coreum.ContractTx)
+	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		spawn("subscriber", parallel.Fail, func(ctx context.Context) error {
+			return s.subscriber.Subscribe(ctx, subscriberCh)
+		})
+		spawn("notifier", parallel.Fail, func(ctx context.Context) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return errors.WithStack(ctx.Err())
+				case contractTx := <-subscriberCh:
+					s.notifier.Notify(ctx, contractTx)
+					select {
+					case <-ctx.Done():
+						return errors.WithStack(ctx.Err())
+					case ch <- contractTx:
+					}
+				}
+			}
+		})
+		return nil
+	})
+}
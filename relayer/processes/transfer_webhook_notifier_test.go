@@ -0,0 +1,274 @@
+package processes_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+)
+
+const testWebhookSecret = "secret"
+
+type receivedWebhook struct {
+	body      []byte
+	signature string
+}
+
+func TestTransferWebhookNotifier_DeliveryAndRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var requests atomic.Int32
+	receivedCh := make(chan receivedWebhook, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first two attempts fail with the retryable status
+		if requests.Add(1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		receivedCh <- receivedWebhook{
+			body:      body,
+			signature: r.Header.Get(processes.TransferWebhookSignatureHeader),
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	watchedAddress := coreum.GenAccount()
+	notifier := newTestTransferWebhookNotifier(
+		t, logger.NewAnyLogMock(gomock.NewController(t)), server.URL, watchedAddress, "",
+	)
+	go func() {
+		_ = notifier.Start(ctx)
+	}()
+
+	notifier.Notify(ctx, coreum.ContractTx{
+		Hash: "coreum-tx-hash",
+		XRPLToCoreumTransfers: []coreum.XRPLToCoreumTransfer{
+			newTestXRPLToCoreumTransfer(watchedAddress),
+			// the transfer to the not watched address is skipped
+			newTestXRPLToCoreumTransfer(coreum.GenAccount()),
+		},
+	})
+
+	var received receivedWebhook
+	select {
+	case received = <-receivedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook isn't delivered")
+	}
+	require.Equal(t, int32(3), requests.Load())
+
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write(received.body)
+	require.Equal(t, hex.EncodeToString(mac.Sum(nil)), received.signature)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(received.body, &payload))
+	require.Equal(t, map[string]any{
+		"xrpl_tx_hash":   "xrpl-tx-hash",
+		"issuer":         "issuer",
+		"currency":       "currency",
+		"denom":          "denom",
+		"amount":         "990",
+		"recipient":      watchedAddress.String(),
+		"coreum_tx_hash": "coreum-tx-hash",
+	}, payload)
+}
+
+func TestTransferWebhookNotifier_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	watchedAddress := coreum.GenAccount()
+	deadLetterLogFilePath := filepath.Join(t.TempDir(), processes.TransferWebhookDeadLetterLogFileName)
+	notifier := newTestTransferWebhookNotifier(
+		t, newDeadLetterLogMock(t), server.URL, watchedAddress, deadLetterLogFilePath,
+	)
+	go func() {
+		_ = notifier.Start(ctx)
+	}()
+
+	notifier.Notify(ctx, coreum.ContractTx{
+		Hash:                  "coreum-tx-hash",
+		XRPLToCoreumTransfers: []coreum.XRPLToCoreumTransfer{newTestXRPLToCoreumTransfer(watchedAddress)},
+	})
+
+	records := awaitTransferWebhookDeadLetterRecords(t, deadLetterLogFilePath, 1)
+	require.Equal(t, int32(3), requests.Load())
+	require.Equal(t, uint32(3), records[0].Attempts)
+	require.Equal(t, server.URL, records[0].URL)
+	require.Equal(t, "xrpl-tx-hash", records[0].Payload.XRPLTxHash)
+	require.Contains(t, records[0].Error, "status:502")
+}
+
+func TestTransferWebhookNotifier_HangingEndpointDoesNotBlockSubscription(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	releaseCh := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-releaseCh:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(releaseCh) })
+
+	watchedAddress := coreum.GenAccount()
+	deadLetterLogFilePath := filepath.Join(t.TempDir(), processes.TransferWebhookDeadLetterLogFileName)
+	notifier := newTestTransferWebhookNotifier(
+		t, newDeadLetterLogMock(t), server.URL, watchedAddress, deadLetterLogFilePath,
+	)
+	go func() {
+		_ = notifier.Start(ctx)
+	}()
+
+	const txsCount = 10
+	ctrl := gomock.NewController(t)
+	subscriberMock := NewMockContractEventsSubscriber(ctrl)
+	subscriberMock.EXPECT().Subscribe(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, ch chan<- coreum.ContractTx) error {
+			for i := 0; i < txsCount; i++ {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ch <- coreum.ContractTx{
+					Hash:   "coreum-tx-hash",
+					Height: int64(i),
+					XRPLToCoreumTransfers: []coreum.XRPLToCoreumTransfer{
+						newTestXRPLToCoreumTransfer(watchedAddress),
+					},
+				}:
+				}
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	)
+
+	ch := make(chan coreum.ContractTx)
+	go func() {
+		_ = notifier.WrapContractEventsSubscriber(subscriberMock).Subscribe(ctx, ch)
+	}()
+	for i := 0; i < txsCount; i++ {
+		select {
+		case contractTx := <-ch:
+			require.Equal(t, int64(i), contractTx.Height)
+		case <-time.After(5 * time.Second):
+			t.Fatal("contract tx isn't received")
+		}
+	}
+
+	// at most one webhook is being delivered, two fill the queue and the rest don't fit it
+	records := awaitTransferWebhookDeadLetterRecords(t, deadLetterLogFilePath, txsCount-3)
+	require.LessOrEqual(t, len(records), txsCount-2)
+	for _, record := range records {
+		require.Equal(t, "queue is full", record.Error)
+	}
+}
+
+func newTestTransferWebhookNotifier(
+	t *testing.T,
+	log logger.Logger,
+	url string,
+	watchedAddress sdk.AccAddress,
+	deadLetterLogFilePath string,
+) *processes.TransferWebhookNotifier {
+	t.Helper()
+
+	cfg := processes.DefaultTransferWebhookNotifierConfig(deadLetterLogFilePath)
+	cfg.URLs = []string{url}
+	cfg.Secret = testWebhookSecret
+	cfg.WatchedAddresses = []sdk.AccAddress{watchedAddress}
+	cfg.QueueSize = 2
+	cfg.MaxAttempts = 3
+	cfg.InitialRetryDelay = time.Millisecond
+	cfg.MaxRetryDelay = 10 * time.Millisecond
+	cfg.RequestTimeout = time.Minute
+	cfg.RequestsPerSecond = 1000
+	notifier, err := processes.NewTransferWebhookNotifier(cfg, log, time.Now)
+	require.NoError(t, err)
+
+	return notifier
+}
+
+func newDeadLetterLogMock(t *testing.T) logger.Logger {
+	t.Helper()
+
+	logMock := logger.NewAnyLogMock(gomock.NewController(t))
+	// the undeliverable webhooks are logged as errors
+	logMock.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	return logMock
+}
+
+func newTestXRPLToCoreumTransfer(recipient sdk.AccAddress) coreum.XRPLToCoreumTransfer {
+	return coreum.XRPLToCoreumTransfer{
+		XRPLTxHash:    "xrpl-tx-hash",
+		Issuer:        "issuer",
+		Currency:      "currency",
+		Amount:        sdkmath.NewInt(1000),
+		Recipient:     recipient,
+		ReceivedCoins: sdk.NewCoins(sdk.NewInt64Coin("denom", 990)),
+	}
+}
+
+func awaitTransferWebhookDeadLetterRecords(
+	t *testing.T,
+	filePath string,
+	minCount int,
+) []processes.TransferWebhookDeadLetterRecord {
+	t.Helper()
+
+	var records []processes.TransferWebhookDeadLetterRecord
+	require.Eventually(t, func() bool {
+		fileBytes, err := os.ReadFile(filePath)
+		if err != nil {
+			return false
+		}
+		records = make([]processes.TransferWebhookDeadLetterRecord, 0)
+		for _, line := range strings.Split(strings.TrimSpace(string(fileBytes)), "\n") {
+			var record processes.TransferWebhookDeadLetterRecord
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			records = append(records, record)
+		}
+		return len(records) >= minCount
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return records
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -18,10 +19,29 @@ import (
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
+var (
+	// ErrPaymentWithoutCoreumRecipient is returned if the payment memos don't include the coreum recipient.
+	ErrPaymentWithoutCoreumRecipient = errors.New("payment memos don't include the coreum recipient")
+	// ErrPaymentWithoutDeliveredAmount is returned if the payment metadata doesn't include the delivered amount.
+	ErrPaymentWithoutDeliveredAmount = errors.New("payment without delivered amount")
+)
+
 // XRPLToCoreumProcessConfig is XRPLToCoreumProcess config.
 type XRPLToCoreumProcessConfig struct {
 	BridgeXRPLAddress    rippledata.Account
 	RelayerCoreumAddress sdk.AccAddress
+	// BroadcastTimeoutRetryDelay is the delay before the repeated processing of the tx which evidence broadcast is
+	// timed out or rejected by the contract evidences rate limit.
+	BroadcastTimeoutRetryDelay time.Duration
+	// MinBridgeAmounts are the min amounts of the transfers, the payments below them are skipped.
+	MinBridgeAmounts MinBridgeAmounts
+	// TxSpans are the tracing spans of the txs started by the scanner, the tx processing continues them, nil starts
+	// a new span for each processed tx.
+	TxSpans *tracing.XRPLTxSpans
+	// NFTokenBridgingEnabled enables the NFToken transfer evidences, the NFToken offers are skipped if it's disabled.
+	// It must be enabled only with the contract supporting the xrpl_nftoken_transfer evidence, which the current
+	// contract doesn't.
+	NFTokenBridgingEnabled bool
 }
 
 // XRPLToCoreumProcess is process which observes the XRPL txs and register the evidences in the contract.
@@ -31,15 +51,31 @@ type XRPLToCoreumProcess struct {
 	txScanner      XRPLAccountTxScanner
 	contractClient ContractClient
 	metricRegistry MetricRegistry
+	auditLogger    EvidenceAuditLogger
+	blockedQueue   XRPLToCoreumBlockedDeliveryQueue
+	latencyTracker XRPLTransferLatencyObserver
+	retryQueue     XRPLToCoreumEvidenceRetryQueue
+	history        TransferHistoryRecorder
+	ticketUsage    TicketUsageRecorder
 }
 
-// NewXRPLToCoreumProcess returns a new instance of the XRPLToCoreumProcess.
+// NewXRPLToCoreumProcess returns a new instance of the XRPLToCoreumProcess. If the latencyTracker is provided, it
+// receives the XRPL times of the bridge transfers. If the retryQueue is provided, the txs failed with the unexpected
+// error are retried with it instead of waiting for the next full scan. If the history is provided, the transfers which
+// evidences are accepted are appended to it. If the ticketUsage is provided, the tickets consumed by the operation txs
+// are recorded by it.
 func NewXRPLToCoreumProcess(
 	cfg XRPLToCoreumProcessConfig,
 	log logger.Logger,
 	txScanner XRPLAccountTxScanner,
 	contractClient ContractClient,
 	metricRegistry MetricRegistry,
+	auditLogger EvidenceAuditLogger,
+	blockedQueue XRPLToCoreumBlockedDeliveryQueue,
+	latencyTracker XRPLTransferLatencyObserver,
+	retryQueue XRPLToCoreumEvidenceRetryQueue,
+	history TransferHistoryRecorder,
+	ticketUsage TicketUsageRecorder,
 ) (*XRPLToCoreumProcess, error) {
 	if cfg.RelayerCoreumAddress.Empty() {
 		return nil, errors.Errorf("failed to init process, relayer address is nil or empty")
@@ -54,6 +90,12 @@ func NewXRPLToCoreumProcess(
 		txScanner:      txScanner,
 		contractClient: contractClient,
 		metricRegistry: metricRegistry,
+		auditLogger:    auditLogger,
+		blockedQueue:   blockedQueue,
+		latencyTracker: latencyTracker,
+		retryQueue:     retryQueue,
+		history:        history,
+		ticketUsage:    ticketUsage,
 	}, nil
 }
 
@@ -61,39 +103,105 @@ func NewXRPLToCoreumProcess(
 func (p *XRPLToCoreumProcess) Start(ctx context.Context) error {
 	p.log.Info(ctx, "Starting XRPL to Coreum process")
 	txCh := make(chan rippledata.TransactionWithMetaData)
+	retryCh := make(chan rippledata.TransactionWithMetaData)
 	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
 		spawn("tx-scanner", parallel.Continue, func(ctx context.Context) error {
 			defer close(txCh)
 			return p.txScanner.ScanTxs(ctx, txCh)
 		})
+		if p.retryQueue != nil {
+			spawn("evidence-retry-queue", parallel.Fail, func(ctx context.Context) error {
+				return p.retryQueue.Start(ctx, retryCh)
+			})
+		}
 		spawn("tx-processor", parallel.Fail, func(ctx context.Context) error {
-			for tx := range txCh {
-				if err := p.processTx(ctx, tx); err != nil {
-					if errors.Is(err, context.Canceled) {
-						p.log.Warn(ctx, "Context canceled during the XRPL tx processing", zap.String("error", err.Error()))
-					} else {
-						p.log.Error(
-							ctx,
-							"Failed to process XRPL tx",
-							zap.Error(err),
-							zap.String("txHash", strings.ToUpper(tx.GetHash().String())),
-							zap.Any("tx", tx),
-						)
-						continue
+			for {
+				select {
+				case tx, ok := <-txCh:
+					if !ok {
+						return errors.WithStack(ctx.Err())
 					}
+					p.processScannedTx(ctx, tx, false)
+				case tx := <-retryCh:
+					p.processScannedTx(ctx, tx, true)
 				}
 			}
-			return errors.WithStack(ctx.Err())
 		})
 
 		return nil
 	}, parallel.WithGroupLogger(p.log))
 }
 
-func (p *XRPLToCoreumProcess) processTx(ctx context.Context, tx rippledata.TransactionWithMetaData) error {
-	ctx = tracing.WithTracingXRPLTxHash(tracing.WithTracingID(ctx), strings.ToUpper(tx.GetHash().String()))
+// processScannedTx processes the tx and passes it to the retry queue if the processing is failed.
+func (p *XRPLToCoreumProcess) processScannedTx(ctx context.Context, tx rippledata.TransactionWithMetaData, retry bool) {
+	err := p.processTxWithRequeue(ctx, tx)
+	if err == nil {
+		if retry {
+			p.retryQueue.Complete(tx)
+		}
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		p.log.Warn(ctx, "Context canceled during the XRPL tx processing", zap.String("error", err.Error()))
+		return
+	}
+	p.log.Error(
+		ctx,
+		"Failed to process XRPL tx",
+		append(p.xrplTxLogFields(tx), zap.Error(err), zap.Any("tx", tx))...,
+	)
+	if p.retryQueue == nil {
+		return
+	}
+	if pushErr := p.retryQueue.Push(ctx, tx, err); pushErr != nil {
+		p.log.Error(
+			ctx,
+			"Failed to push XRPL tx to the evidence retry queue",
+			append(p.xrplTxLogFields(tx), zap.Error(pushErr))...,
+		)
+	}
+}
+
+// processTxWithRequeue processes the tx and re-queues it if the evidence broadcast is timed out or the relayer has
+// reached the max evidences allowed by the contract in the block, since the tx isn't returned by the scanner again
+// until the next full scan. The rate limit is reset by the contract in the next block.
+func (p *XRPLToCoreumProcess) processTxWithRequeue(ctx context.Context, tx rippledata.TransactionWithMetaData) error {
+	for {
+		err := p.processTx(ctx, tx)
+		if !coreum.IsBroadcastTimeoutError(err) && !coreum.IsRateLimitExceededError(err) {
+			return err
+		}
+		p.log.Warn(
+			ctx,
+			"Coreum evidence isn't accepted in the current block, the XRPL tx is re-queued",
+			append(
+				p.xrplTxLogFields(tx),
+				zap.String("delay", p.cfg.BroadcastTimeoutRetryDelay.String()),
+				zap.String("error", err.Error()),
+			)...,
+		)
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(p.cfg.BroadcastTimeoutRetryDelay):
+		}
+	}
+}
+
+func (p *XRPLToCoreumProcess) processTx(ctx context.Context, tx rippledata.TransactionWithMetaData) (err error) {
+	txHash := strings.ToUpper(tx.GetHash().String())
+	// the span is ended once the evidence broadcast is confirmed
+	ctx, span := p.cfg.TxSpans.Take(ctx, txHash)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+	ctx = tracing.WithTracingXRPLTxHash(tracing.WithTracingID(ctx), txHash)
 	if !txIsFinal(tx) {
-		p.log.Debug(ctx, "Transaction is not final", zap.String("txStatus", tx.MetaData.TransactionResult.String()))
+		p.log.Debug(
+			ctx,
+			"Transaction is not final",
+			append(p.xrplTxLogFields(tx), zap.String("txStatus", tx.MetaData.TransactionResult.String()))...,
+		)
 		return nil
 	}
 	if p.cfg.BridgeXRPLAddress == tx.GetBase().Account {
@@ -109,81 +217,256 @@ func (p *XRPLToCoreumProcess) processIncomingTx(ctx context.Context, tx rippleda
 		p.log.Debug(
 			ctx,
 			"Skipping not successful transaction",
-			zap.String("type", txType),
-			zap.String("txResult", tx.MetaData.TransactionResult.String()),
+			append(
+				p.xrplTxLogFields(tx),
+				zap.String("type", txType),
+				zap.String("txResult", tx.MetaData.TransactionResult.String()),
+			)...,
 		)
 		return nil
 	}
 
-	p.log.Debug(ctx, "Start processing of XRPL incoming tx", zap.String("type", txType))
-	// we process only incoming payment transactions, other transactions are ignored
-	if txType != rippledata.PAYMENT.String() {
-		p.log.Debug(ctx, "Skipping not payment transaction", zap.String("type", txType))
+	p.log.Debug(ctx, "Start processing of XRPL incoming tx", append(p.xrplTxLogFields(tx), zap.String("type", txType))...)
+	// we process only incoming payment and NFToken offer transactions, other transactions are ignored
+	switch txType {
+	case rippledata.PAYMENT.String():
+		return p.processIncomingPaymentTx(ctx, tx)
+	case rippledata.NFTOKEN_CREATE_OFFER.String():
+		if !p.cfg.NFTokenBridgingEnabled {
+			p.log.Debug(ctx, "Skipping NFToken offer, the NFToken bridging is disabled", p.xrplTxLogFields(tx)...)
+			return nil
+		}
+		return p.processIncomingNFTokenCreateOfferTx(ctx, tx)
+	default:
+		p.log.Debug(ctx, "Skipping not supported transaction", append(p.xrplTxLogFields(tx), zap.String("type", txType))...)
 		return nil
 	}
-	paymentTx, ok := tx.Transaction.(*rippledata.Payment)
-	if !ok {
-		return errors.Errorf("failed to cast tx to Payment, data:%+v", tx)
+}
+
+func (p *XRPLToCoreumProcess) processIncomingPaymentTx(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+) error {
+	_, evidenceSpan := tracing.Tracer().Start(ctx, tracing.SpanBuildEvidence)
+	evidence, err := ConvertXRPLPaymentToTransferEvidence(tx)
+	tracing.EndSpan(evidenceSpan, err)
+	switch {
+	case errors.Is(err, xrpl.ErrMemoLimitExceeded):
+		p.log.Warn(ctx, "Skipping payment with memos exceeding the limits", append(p.xrplTxLogFields(tx), zap.Error(err))...)
+		p.metricRegistry.IncrementXRPLMemoLimitExceededTxsCounter()
+		return nil
+	case errors.Is(err, ErrPaymentWithoutCoreumRecipient):
+		p.log.Debug(
+			ctx,
+			"Bridge memo does not include expected structure",
+			append(p.xrplTxLogFields(tx), zap.Any("memos", tx.GetBase().Memos))...,
+		)
+		return nil
+	case errors.Is(err, ErrPaymentWithoutDeliveredAmount):
+		p.log.Warn(ctx, "Skipping payment without delivered amount", p.xrplTxLogFields(tx)...)
+		return nil
+	case errors.Is(err, ErrSDKMathIntOutOfBounds) || errors.Is(err, coreum.ErrAmountExceedsContractMax):
+		p.log.Info(
+			ctx,
+			"Found XRPL transaction with out of bounds amount",
+			append(p.xrplTxLogFields(tx), logger.AmountField(tx.MetaData.DeliveredAmount.String()))...,
+		)
+		return nil
+	case err != nil:
+		return err
 	}
-	coreumRecipient := xrpl.DecodeCoreumRecipientFromMemo(paymentTx.Memos)
-	if coreumRecipient == nil {
-		p.log.Debug(ctx, "Bridge memo does not include expected structure", zap.Any("memos", paymentTx.Memos))
+	coreumAmount := evidence.Amount
+
+	if coreumAmount.IsZero() {
+		p.log.Debug(ctx, "Nothing to send, amount is zero", xrplToCoreumTransferLogFields(evidence)...)
 		return nil
 	}
 
-	deliveredXRPLAmount := tx.MetaData.DeliveredAmount
+	minAmount := p.cfg.MinBridgeAmounts.GetXRPLToCoreumMinAmount(evidence.Issuer, evidence.Currency)
+	if coreumAmount.LT(minAmount) {
+		p.log.Info(
+			ctx,
+			"Skipping XRPL to Coreum transfer with amount below the min bridge amount",
+			append(xrplToCoreumTransferLogFields(evidence), zap.String("minAmount", minAmount.String()))...,
+		)
+		p.metricRegistry.IncrementXRPLToCoreumBelowMinAmountTransfersCounter(evidence.Currency, evidence.Issuer)
+		return nil
+	}
 
-	coreumAmount, err := ConvertXRPLAmountToCoreumAmount(*deliveredXRPLAmount)
-	if err != nil {
-		if errors.Is(err, ErrSDKMathIntOutOfBounds) || errors.Is(err, ErrContractUint128OutOfBounds) {
-			p.log.Info(
-				ctx,
-				"Found XRPL transaction with out of bounds amount",
-				zap.String("amount", deliveredXRPLAmount.String()),
-			)
-			return nil
+	if p.latencyTracker != nil {
+		// the tracking failure doesn't block the evidence submission
+		if err := p.latencyTracker.ObserveXRPLToCoreumStart(ctx, evidence.TxHash, tx.Date.Time()); err != nil {
+			p.log.Warn(ctx, "Failed to track XRPL to Coreum transfer latency", zap.Error(err))
 		}
-		return err
 	}
 
-	if coreumAmount.IsZero() {
-		p.log.Debug(ctx, "Nothing to send, amount is zero")
+	txRes, err := p.contractClient.SendXRPLToCoreumTransferEvidence(ctx, p.cfg.RelayerCoreumAddress, evidence)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeXRPLToCoreumTransfer,
+		TxHash:       evidence.TxHash,
+		Issuer:       evidence.Issuer,
+		Currency:     evidence.Currency,
+		Amount:       evidence.Amount.String(),
+		Recipient:    evidence.Recipient.String(),
+	}, txRes, err)
+	if err == nil {
+		p.log.Info(
+			ctx,
+			"Successfully sent XRPL to Coreum transfer evidence",
+			append(xrplToCoreumTransferLogFields(evidence), zap.String("recipient", evidence.Recipient.String()))...,
+		)
+		p.appendXRPLToCoreumTransferHistory(ctx, tx, evidence, txRes)
+		return nil
+	}
+
+	if coreum.IsTokenNotRegisteredError(err) {
+		p.log.Debug(ctx, "Token not registered", xrplToCoreumTransferLogFields(evidence)...)
+		return nil
+	}
+
+	if coreum.IsAssetFTStateError(err) {
+		// the delivery might be unblocked later, so the evidence is retried by the queue with the slow schedule
+		if err := p.blockedQueue.Park(ctx, evidence, err.Error()); err != nil {
+			return errors.Wrap(err, "failed to park blocked XRPL to Coreum delivery")
+		}
 		return nil
 	}
 
-	evidence := coreum.XRPLToCoreumTransferEvidence{
+	if coreum.IsRecipientBlockedError(err) {
+		p.log.Debug(
+			ctx,
+			"The evidence saving is failed because of the recipient address is blocked, the evidence is skipped",
+			append(xrplToCoreumTransferLogFields(evidence), zap.String("recipient", evidence.Recipient.String()))...,
+		)
+		return nil
+	}
+
+	if coreum.IsAmountOverflowError(err) {
+		// the amount of the Coreum originated token fits the contract max, but its conversion to the token decimals
+		// doesn't, so the evidence can never be accepted
+		p.log.Info(
+			ctx,
+			"Skipping XRPL to Coreum transfer with amount exceeding the contract max after the decimals conversion",
+			xrplToCoreumTransferLogFields(evidence)...,
+		)
+		return nil
+	}
+
+	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence)
+}
+
+// ConvertXRPLPaymentToTransferEvidence maps the incoming XRPL payment to the XRPL to Coreum transfer evidence. All
+// payment fields are controlled by the XRPL tx sender, so the memos are checked against the limits before the parsing.
+// The zero amount evidence is returned without an error, the caller decides whether it's worth sending.
+func ConvertXRPLPaymentToTransferEvidence(
+	tx rippledata.TransactionWithMetaData,
+) (coreum.XRPLToCoreumTransferEvidence, error) {
+	paymentTx, ok := tx.Transaction.(*rippledata.Payment)
+	if !ok || paymentTx == nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, errors.Errorf("failed to cast tx to Payment, data:%+v", tx)
+	}
+	if err := xrpl.ValidateMemoLimits(paymentTx.Memos); err != nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, err
+	}
+	// the recipient embedded as the plain address is preferred over the bridge memo
+	coreumRecipient, err := xrpl.ParseMemoRecipient(paymentTx)
+	if err != nil {
+		coreumRecipient = xrpl.DecodeCoreumRecipientFromMemo(paymentTx.Memos)
+	}
+	if coreumRecipient == nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, errors.WithStack(ErrPaymentWithoutCoreumRecipient)
+	}
+
+	deliveredXRPLAmount := tx.MetaData.DeliveredAmount
+	if deliveredXRPLAmount == nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, errors.WithStack(ErrPaymentWithoutDeliveredAmount)
+	}
+	coreumAmount, err := ConvertXRPLAmountToCoreumAmount(*deliveredXRPLAmount)
+	if err != nil {
+		return coreum.XRPLToCoreumTransferEvidence{}, err
+	}
+
+	return coreum.XRPLToCoreumTransferEvidence{
 		TxHash:    strings.ToUpper(paymentTx.GetHash().String()),
 		Issuer:    deliveredXRPLAmount.Issuer.String(),
 		Currency:  xrpl.ConvertCurrencyToString(deliveredXRPLAmount.Currency),
 		Amount:    coreumAmount,
 		Recipient: coreumRecipient,
-	}
+		// the contract uses it only for the tokens with the IBC delivery mode
+		DestinationChainRecipient: xrpl.DecodeDestinationChainRecipientFromMemo(paymentTx.Memos),
+	}, nil
+}
 
-	_, err = p.contractClient.SendXRPLToCoreumTransferEvidence(ctx, p.cfg.RelayerCoreumAddress, evidence)
-	if err == nil {
-		p.log.Info(ctx, "Successfully sent XRPL to Coreum transfer evidence", zap.Any("evidence", evidence))
+func (p *XRPLToCoreumProcess) processIncomingNFTokenCreateOfferTx(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+) error {
+	createOfferTx, ok := tx.Transaction.(*rippledata.NFTokenCreateOffer)
+	if !ok {
+		return errors.Errorf("failed to cast tx to NFTokenCreateOffer, data:%+v", tx)
+	}
+	// the bridge accepts only the free sell offers created for the bridge account
+	if createOfferTx.Destination == nil || *createOfferTx.Destination != p.cfg.BridgeXRPLAddress {
+		p.log.Debug(ctx, "Skipping NFToken offer not directed at the bridge account", p.xrplTxLogFields(tx)...)
 		return nil
 	}
-
-	if coreum.IsTokenNotRegisteredError(err) {
-		p.log.Debug(ctx, "Token not registered")
+	if createOfferTx.Flags == nil || *createOfferTx.Flags&xrpl.TxSellNFToken == 0 {
+		p.log.Debug(ctx, "Skipping NFToken buy offer", p.xrplTxLogFields(tx)...)
 		return nil
 	}
-
-	if coreum.IsAssetFTStateError(err) {
+	if createOfferTx.NFTokenID == nil || createOfferTx.Amount == nil || !createOfferTx.Amount.IsZero() {
 		p.log.Debug(
 			ctx,
-			"The evidence saving is failed because of the asset FT rules, the evidence is skipped",
-			zap.Any("evidence", evidence),
+			"Skipping NFToken offer with not zero amount",
+			append(p.xrplTxLogFields(tx), zap.Any("amount", createOfferTx.Amount))...,
 		)
 		return nil
 	}
-
-	if coreum.IsRecipientBlockedError(err) {
+	if err := xrpl.ValidateMemoLimits(createOfferTx.Memos); err != nil {
+		p.log.Warn(
+			ctx,
+			"Skipping NFToken offer with memos exceeding the limits",
+			append(p.xrplTxLogFields(tx), zap.Error(err))...,
+		)
+		p.metricRegistry.IncrementXRPLMemoLimitExceededTxsCounter()
+		return nil
+	}
+	coreumRecipient := xrpl.DecodeCoreumRecipientFromMemo(createOfferTx.Memos)
+	if coreumRecipient == nil {
 		p.log.Debug(
 			ctx,
-			"The evidence saving is failed because of the recipient address is blocked, the evidence is skipped",
-			zap.Any("evidence", evidence),
+			"Bridge memo does not include expected structure",
+			append(p.xrplTxLogFields(tx), zap.Any("memos", createOfferTx.Memos))...,
+		)
+		return nil
+	}
+	sellOfferID, ok := xrpl.ExtractNFTokenOfferIDFromMetaData(tx.MetaData)
+	if !ok {
+		return errors.Errorf("failed to find created NFToken offer in tx metadata, data:%+v", tx)
+	}
+
+	evidence := coreum.XRPLNFTokenTransferEvidence{
+		TxHash:      strings.ToUpper(createOfferTx.GetHash().String()),
+		NFTokenID:   strings.ToUpper(createOfferTx.NFTokenID.String()),
+		SellOfferID: strings.ToUpper(sellOfferID.String()),
+		Sender:      createOfferTx.Account.String(),
+		Recipient:   coreumRecipient,
+	}
+	txRes, err := p.contractClient.SendXRPLNFTokenTransferEvidence(ctx, p.cfg.RelayerCoreumAddress, evidence)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeXRPLNFTokenTransfer,
+		TxHash:       evidence.TxHash,
+		Recipient:    evidence.Recipient.String(),
+	}, txRes, err)
+	if err == nil {
+		p.log.Info(
+			ctx,
+			"Successfully sent XRPL NFToken transfer evidence",
+			append(
+				p.xrplTxLogFields(tx),
+				logger.TokenField(evidence.NFTokenID),
+				zap.String("recipient", evidence.Recipient.String()),
+			)...,
 		)
 		return nil
 	}
@@ -193,9 +476,8 @@ func (p *XRPLToCoreumProcess) processIncomingTx(ctx context.Context, tx rippleda
 
 func (p *XRPLToCoreumProcess) processOutgoingTx(ctx context.Context, tx rippledata.TransactionWithMetaData) error {
 	txType := tx.GetType()
-	p.log.Debug(ctx, "Start processing of XRPL outgoing tx",
-		zap.String("type", txType),
-	)
+	p.log.Debug(ctx, "Start processing of XRPL outgoing tx", append(p.xrplTxLogFields(tx), zap.String("type", txType))...)
+	p.recordTicketUsage(ctx, tx)
 
 	switch txType {
 	case rippledata.TICKET_CREATE.String():
@@ -206,13 +488,17 @@ func (p *XRPLToCoreumProcess) processOutgoingTx(ctx context.Context, tx rippleda
 		return p.sendCoreumToXRPLTransferTransactionResultEvidence(ctx, tx)
 	case rippledata.SIGNER_LIST_SET.String():
 		return p.sendKeysRotationTransactionResultEvidence(ctx, tx)
+	case rippledata.NFTOKEN_ACCEPT_OFFER.String():
+		return p.sendNFTokenTransferTransactionResultEvidence(ctx, tx)
+	case rippledata.CHECK_CASH.String():
+		return p.sendCheckCashTransactionResultEvidence(ctx, tx)
 	// types which we use initially for the account set up
 	case rippledata.ACCOUNT_SET.String():
-		p.log.Debug(ctx, "Skipped expected tx type", zap.String("txType", txType), zap.Any("tx", tx))
+		p.log.Debug(ctx, "Skipped expected tx type", append(p.xrplTxLogFields(tx), zap.String("txType", txType))...)
 		return nil
 	default:
 		p.metricRegistry.SetMaliciousBehaviourKey(fmt.Sprintf("unexpected_xrpl_tx_type_tx_hash_%s", tx.GetHash().String()))
-		p.log.Error(ctx, "Found unexpected transaction type", zap.Any("tx", tx))
+		p.log.Error(ctx, "Found unexpected transaction type", append(p.xrplTxLogFields(tx), zap.Any("tx", tx))...)
 		return nil
 	}
 }
@@ -221,7 +507,7 @@ func (p *XRPLToCoreumProcess) sendXRPLTicketsAllocationTransactionResultEvidence
 	ctx context.Context,
 	tx rippledata.TransactionWithMetaData,
 ) error {
-	tickets := extractTicketSequencesFromMetaData(tx.MetaData)
+	tickets := xrpl.ExtractTicketSequencesFromMetaData(tx.MetaData)
 	txResult := getTransactionResult(tx)
 	if txResult == coreum.TransactionResultRejected {
 		tickets = nil
@@ -243,11 +529,15 @@ func (p *XRPLToCoreumProcess) sendXRPLTicketsAllocationTransactionResultEvidence
 	if ticketCreateTx.TicketSequence != nil && *ticketCreateTx.TicketSequence != 0 {
 		evidence.TicketSequence = lo.ToPtr(*ticketCreateTx.TicketSequence)
 	}
-	_, err := p.contractClient.SendXRPLTicketsAllocationTransactionResultEvidence(
+	txRes, err := p.contractClient.SendXRPLTicketsAllocationTransactionResultEvidence(
 		ctx,
 		p.cfg.RelayerCoreumAddress,
 		evidence,
 	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeTicketsAllocationResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
 
 	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
 }
@@ -268,11 +558,15 @@ func (p *XRPLToCoreumProcess) sendXRPLTrustSetTransactionResultEvidence(
 		},
 	}
 
-	_, err := p.contractClient.SendXRPLTrustSetTransactionResultEvidence(
+	txRes, err := p.contractClient.SendXRPLTrustSetTransactionResultEvidence(
 		ctx,
 		p.cfg.RelayerCoreumAddress,
 		evidence,
 	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeTrustSetResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
 
 	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
 }
@@ -292,12 +586,29 @@ func (p *XRPLToCoreumProcess) sendCoreumToXRPLTransferTransactionResultEvidence(
 			TicketSequence:    paymentTx.TicketSequence,
 		},
 	}
+	if p.latencyTracker != nil && tx.MetaData.TransactionResult.Success() {
+		// the operation ID is the ticket sequence or the account sequence if the ticket isn't used
+		operationID := paymentTx.Sequence
+		if paymentTx.TicketSequence != nil && *paymentTx.TicketSequence != 0 {
+			operationID = *paymentTx.TicketSequence
+		}
+		if err := p.latencyTracker.ObserveCoreumToXRPLCompletion(ctx, operationID, tx.Date.Time()); err != nil {
+			p.log.Warn(ctx, "Failed to track Coreum to XRPL transfer latency", zap.Error(err))
+		}
+	}
 
-	_, err := p.contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
+	txRes, err := p.contractClient.SendCoreumToXRPLTransferTransactionResultEvidence(
 		ctx,
 		p.cfg.RelayerCoreumAddress,
 		evidence,
 	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeCoreumToXRPLTransferResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
+	if err == nil {
+		p.appendCoreumToXRPLTransferHistory(ctx, tx, paymentTx)
+	}
 
 	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
 }
@@ -328,11 +639,73 @@ func (p *XRPLToCoreumProcess) sendKeysRotationTransactionResultEvidence(
 	if signerListSetTx.TicketSequence != nil && *signerListSetTx.TicketSequence != 0 {
 		evidence.TicketSequence = lo.ToPtr(*signerListSetTx.TicketSequence)
 	}
-	_, err := p.contractClient.SendKeysRotationTransactionResultEvidence(
+	txRes, err := p.contractClient.SendKeysRotationTransactionResultEvidence(
+		ctx,
+		p.cfg.RelayerCoreumAddress,
+		evidence,
+	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeKeysRotationResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
+
+	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
+}
+
+func (p *XRPLToCoreumProcess) sendNFTokenTransferTransactionResultEvidence(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+) error {
+	acceptOfferTx, ok := tx.Transaction.(*rippledata.NFTAcceptOffer)
+	if !ok {
+		return errors.Errorf("failed to cast tx to NFTAcceptOffer, data:%+v", tx)
+	}
+	evidence := coreum.XRPLTransactionResultNFTokenTransferEvidence{
+		XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+			TxHash:            strings.ToUpper(tx.GetHash().String()),
+			TransactionResult: getTransactionResult(tx),
+			TicketSequence:    acceptOfferTx.TicketSequence,
+		},
+	}
+
+	txRes, err := p.contractClient.SendNFTokenTransferTransactionResultEvidence(
 		ctx,
 		p.cfg.RelayerCoreumAddress,
 		evidence,
 	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeNFTokenTransferResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
+
+	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
+}
+
+func (p *XRPLToCoreumProcess) sendCheckCashTransactionResultEvidence(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+) error {
+	checkCashTx, ok := tx.Transaction.(*rippledata.CheckCash)
+	if !ok {
+		return errors.Errorf("failed to cast tx to CheckCash, data:%+v", tx)
+	}
+	evidence := coreum.XRPLTransactionResultCheckCashEvidence{
+		XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+			TxHash:            strings.ToUpper(tx.GetHash().String()),
+			TransactionResult: getTransactionResult(tx),
+			TicketSequence:    checkCashTx.TicketSequence,
+		},
+	}
+
+	txRes, err := p.contractClient.SendCheckCashTransactionResultEvidence(
+		ctx,
+		p.cfg.RelayerCoreumAddress,
+		evidence,
+	)
+	p.logEvidenceAudit(ctx, EvidenceAuditRecord{
+		EvidenceType: EvidenceAuditTypeCheckCashResult,
+		TxHash:       evidence.TxHash,
+	}, txRes, err)
 
 	return p.handleOperationEvidenceSubmissionError(ctx, err, tx, evidence.XRPLTransactionResultEvidence)
 }
@@ -347,13 +720,20 @@ func (p *XRPLToCoreumProcess) handleOperationEvidenceSubmissionError(
 		p.log.Info(
 			ctx,
 			"Successfully sent operation evidence",
-			zap.String("txResult", tx.MetaData.TransactionResult.String()),
-			zap.Any("evidence", evidence),
+			append(
+				p.xrplTxLogFields(tx),
+				zap.String("txResult", tx.MetaData.TransactionResult.String()),
+				zap.Any("evidence", evidence),
+			)...,
 		)
 		return nil
 	}
 	if IsExpectedEvidenceSubmissionError(err) {
-		p.log.Debug(ctx, "Received expected evidence submission error", zap.String("errText", err.Error()))
+		p.log.Debug(
+			ctx,
+			"Received expected evidence submission error",
+			append(p.xrplTxLogFields(tx), zap.String("errText", err.Error()))...,
+		)
 		return nil
 	}
 	if IsUnexpectedEvidenceSubmissionError(err) {
@@ -363,51 +743,206 @@ func (p *XRPLToCoreumProcess) handleOperationEvidenceSubmissionError(
 	return err
 }
 
-// txIsFinal returns value which indicates whether the transaction if final and can be used.
-// Result Code	 Finality.
-// tesSUCCESS	 Final when included in a validated ledger.
-// Any tec code	 Final when included in a validated ledger.
-// Any tem code	 Final unless the protocol changes to make the transaction valid.
-// tefPAST_SEQ	 Final when another transaction with the same sequence number is included in a validated ledger.
-// tefMAX_LEDGER Final when a validated ledger has a ledger index higher than the transaction's LastLedgerSequence
-// field, and no validated ledger includes the transaction.
-func txIsFinal(tx rippledata.TransactionWithMetaData) bool {
-	txResult := tx.MetaData.TransactionResult
-	return tx.MetaData.TransactionResult.Success() ||
-		strings.HasPrefix(txResult.String(), xrpl.TecTxResultPrefix) ||
-		strings.HasPrefix(txResult.String(), xrpl.TemTxResultPrefix) ||
-		txResult == rippledata.TefPAST_SEQ ||
-		txResult == rippledata.TefMAX_LEDGER
+// xrplTxLogFields returns the structured log fields of the observed XRPL tx. The outgoing txs are the results of the
+// Coreum to XRPL operations, so they are logged with the operation ID.
+func (p *XRPLToCoreumProcess) xrplTxLogFields(tx rippledata.TransactionWithMetaData) []zap.Field {
+	txHash := strings.ToUpper(tx.GetHash().String())
+	if p.cfg.BridgeXRPLAddress == tx.GetBase().Account {
+		return []zap.Field{
+			logger.DirectionField(logger.DirectionCoreumToXRPL),
+			logger.TxHashField(txHash),
+			logger.OperationIDField(xrpl.GetTxOperationID(tx.Transaction)),
+		}
+	}
+
+	return []zap.Field{
+		logger.DirectionField(logger.DirectionXRPLToCoreum),
+		logger.TxHashField(txHash),
+	}
 }
 
-func getTransactionResult(tx rippledata.TransactionWithMetaData) coreum.TransactionResult {
-	if tx.MetaData.TransactionResult.Success() {
-		return coreum.TransactionResultAccepted
+func xrplToCoreumTransferLogFields(evidence coreum.XRPLToCoreumTransferEvidence) []zap.Field {
+	return []zap.Field{
+		logger.DirectionField(logger.DirectionXRPLToCoreum),
+		logger.TxHashField(evidence.TxHash),
+		logger.XRPLTokenField(evidence.Issuer, evidence.Currency),
+		logger.AmountField(evidence.Amount.String()),
 	}
-	return coreum.TransactionResultRejected
 }
 
-func extractTicketSequencesFromMetaData(metaData rippledata.MetaData) []uint32 {
-	ticketSequences := make([]uint32, 0)
-	for _, node := range metaData.AffectedNodes {
-		createdNode := node.CreatedNode
-		if createdNode == nil {
-			continue
-		}
-		newFields := createdNode.NewFields
-		if newFields == nil {
-			continue
+// appendXRPLToCoreumTransferHistory appends the transfer which evidence is accepted to the history. The delivery is
+// found in the tx only if the evidence has reached the threshold.
+func (p *XRPLToCoreumProcess) appendXRPLToCoreumTransferHistory(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+	evidence coreum.XRPLToCoreumTransferEvidence,
+	txRes *sdk.TxResponse,
+) {
+	if p.history == nil {
+		return
+	}
+	record := TransferHistoryRecord{
+		Direction:    TransferHistoryDirectionXRPLToCoreum,
+		SourceTxHash: evidence.TxHash,
+		Sender:       tx.GetBase().Account.String(),
+		Recipient:    evidence.Recipient.String(),
+		Issuer:       evidence.Issuer,
+		Currency:     evidence.Currency,
+		Amount:       evidence.Amount.String(),
+		StartedAt:    tx.Date.Time(),
+		Status:       TransferHistoryStatusConfirmed,
+	}
+	if coreum.IsEvidenceThresholdReached(txRes) {
+		record.Status = TransferHistoryStatusCompleted
+		record.DestinationTxHash = txRes.TxHash
+		if completedAt, err := parseTxTime(txRes); err == nil {
+			record.CompletedAt = completedAt
 		}
-		if rippledata.TICKET.String() != newFields.GetType() {
-			continue
+		receivedCoins, err := coreum.GetReceivedCoins(txRes, evidence.Recipient)
+		if err != nil {
+			p.log.Warn(ctx, "Failed to get XRPL to Coreum transfer received coins", zap.Error(err))
 		}
-		ticket, ok := newFields.(*rippledata.Ticket)
-		if !ok {
-			continue
+		if len(receivedCoins) == 1 {
+			record.Denom = receivedCoins[0].Denom
+			// the XRPL originated tokens have the same decimals on both chains, so the fee is the difference
+			if evidence.Issuer != p.cfg.BridgeXRPLAddress.String() && receivedCoins[0].Amount.LTE(evidence.Amount) {
+				record.Fee = evidence.Amount.Sub(receivedCoins[0].Amount).String()
+			}
 		}
+	}
+	p.appendTransferHistory(ctx, record)
+}
 
-		ticketSequences = append(ticketSequences, *ticket.TicketSequence)
+// appendCoreumToXRPLTransferHistory appends the Coreum to XRPL transfer which result evidence is accepted to the
+// history. The XRPL payment result is final, so the transfer status doesn't depend on the evidence threshold.
+func (p *XRPLToCoreumProcess) appendCoreumToXRPLTransferHistory(
+	ctx context.Context,
+	tx rippledata.TransactionWithMetaData,
+	paymentTx *rippledata.Payment,
+) {
+	if p.history == nil {
+		return
+	}
+	// the operation ID is the ticket sequence or the account sequence if the ticket isn't used
+	operationID := paymentTx.Sequence
+	if paymentTx.TicketSequence != nil && *paymentTx.TicketSequence != 0 {
+		operationID = *paymentTx.TicketSequence
+	}
+	record := TransferHistoryRecord{
+		Direction:         TransferHistoryDirectionCoreumToXRPL,
+		DestinationTxHash: strings.ToUpper(tx.GetHash().String()),
+		OperationID:       operationID,
+		Recipient:         paymentTx.Destination.String(),
+		Issuer:            paymentTx.Amount.Issuer.String(),
+		Currency:          xrpl.ConvertCurrencyToString(paymentTx.Amount.Currency),
+		CompletedAt:       tx.Date.Time(),
+		Status:            TransferHistoryStatusCompleted,
+	}
+	if !tx.MetaData.TransactionResult.Success() {
+		record.Status = TransferHistoryStatusRejected
 	}
+	amount, err := ConvertXRPLAmountToCoreumAmount(paymentTx.Amount)
+	if err != nil {
+		p.log.Warn(ctx, "Failed to convert Coreum to XRPL transfer amount", zap.Error(err))
+	} else {
+		record.Amount = amount.String()
+	}
+	p.appendTransferHistory(ctx, record)
+}
 
-	return ticketSequences
+func (p *XRPLToCoreumProcess) appendTransferHistory(ctx context.Context, record TransferHistoryRecord) {
+	// the history failure doesn't affect the evidence processing
+	if err := p.history.Append(ctx, record); err != nil {
+		p.log.Warn(ctx, "Failed to append transfer history record", zap.Error(err), zap.Any("record", record))
+	}
+}
+
+// recordTicketUsage records the ticket consumed by the final operation tx. The tx result is final, so the ticket is
+// consumed regardless of the evidence submission result.
+func (p *XRPLToCoreumProcess) recordTicketUsage(ctx context.Context, tx rippledata.TransactionWithMetaData) {
+	if p.ticketUsage == nil {
+		return
+	}
+	var (
+		operationType  coreum.OperationTypeEnum
+		ticketSequence *uint32
+	)
+	switch typedTx := tx.Transaction.(type) {
+	case *rippledata.TicketCreate:
+		operationType, ticketSequence = coreum.OperationTypeEnumAllocateTickets, typedTx.TicketSequence
+	case *rippledata.TrustSet:
+		operationType, ticketSequence = coreum.OperationTypeEnumTrustSet, typedTx.TicketSequence
+	case *rippledata.Payment:
+		operationType, ticketSequence = coreum.OperationTypeEnumCoreumToXRPLTransfer, typedTx.TicketSequence
+	case *rippledata.SignerListSet:
+		operationType, ticketSequence = coreum.OperationTypeEnumRotateKeys, typedTx.TicketSequence
+	case *rippledata.NFTAcceptOffer:
+		operationType, ticketSequence = coreum.OperationTypeEnumNFTokenTransfer, typedTx.TicketSequence
+	case *rippledata.CheckCash:
+		operationType, ticketSequence = coreum.OperationTypeEnumCheckCash, typedTx.TicketSequence
+	default:
+		return
+	}
+	// the txs sent with the account sequence, e.g. on the bridge bootstrapping, don't use the tickets
+	if ticketSequence == nil || *ticketSequence == 0 {
+		return
+	}
+	record := TicketUsageRecord{
+		TicketSequence: *ticketSequence,
+		OperationType:  operationType,
+		Result:         getTransactionResult(tx),
+		TxHash:         strings.ToUpper(tx.GetHash().String()),
+		CompletedAt:    tx.Date.Time(),
+	}
+	// the ticket usage failure doesn't affect the evidence processing
+	if err := p.ticketUsage.Record(ctx, record); err != nil {
+		p.log.Warn(ctx, "Failed to record ticket usage", zap.Error(err), zap.Any("record", record))
+	}
+}
+
+func (p *XRPLToCoreumProcess) logEvidenceAudit(
+	ctx context.Context,
+	record EvidenceAuditRecord,
+	txRes *sdk.TxResponse,
+	err error,
+) {
+	record.RelayerCoreumAddress = p.cfg.RelayerCoreumAddress.String()
+	switch {
+	case err == nil:
+		record.Outcome = EvidenceAuditOutcomeSuccess
+	case coreum.IsEvidenceAlreadyProvidedError(err):
+		record.Outcome = EvidenceAuditOutcomeAlreadyProvided
+	default:
+		record.Outcome = EvidenceAuditOutcomeError
+		record.Error = err.Error()
+	}
+	if txRes != nil {
+		record.CoreumTxHash = txRes.TxHash
+	}
+	// the audit log failure doesn't affect the evidence processing
+	if logErr := p.auditLogger.LogEvidence(ctx, record); logErr != nil {
+		p.log.Warn(ctx, "Failed to write evidence audit record", zap.Error(logErr), zap.Any("record", record))
+	}
+}
+
+// txIsFinal returns value which indicates whether the transaction if final and can be used as the result evidence.
+// Result Code	 Evidence.
+// tesSUCCESS	 Accepted, when included in a validated ledger.
+// Any tec code	 Rejected, when included in a validated ledger, the fee is claimed and the sequence or ticket is consumed.
+// Any tem, tel, ter or tef code	 None, such a transaction is never included in a validated ledger, so it doesn't
+// consume the ticket, and the operation transaction is re-submitted while the operation is pending.
+func txIsFinal(tx rippledata.TransactionWithMetaData) bool {
+	switch xrpl.ClassifyTxResult(tx.MetaData.TransactionResult) {
+	case xrpl.TxResultClassSuccess, xrpl.TxResultClassClaimed:
+		return true
+	default:
+		return false
+	}
+}
+
+func getTransactionResult(tx rippledata.TransactionWithMetaData) coreum.TransactionResult {
+	if tx.MetaData.TransactionResult.Success() {
+		return coreum.TransactionResultAccepted
+	}
+	return coreum.TransactionResultRejected
 }
@@ -2,9 +2,15 @@ package processes_test
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 	rippledata "github.com/rubblelabs/ripple/data"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
@@ -68,6 +74,49 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 		},
 	}
 
+	destinationChainRecipient := "osmo1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	destinationChainRecipientMemo, err := xrpl.EncodeDestinationChainRecipientToMemo(
+		coreumRecipientAddress, destinationChainRecipient,
+	)
+	require.NoError(t, err)
+	xrplOriginatedTokenPaymentWithDestinationChainRecipientTx := rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			Destination: bridgeXRPLAddress,
+			Amount:      xrplOriginatedTokenXRPLAmount,
+			TxBase: rippledata.TxBase{
+				TransactionType: rippledata.PAYMENT,
+				Memos: rippledata.Memos{
+					destinationChainRecipientMemo,
+				},
+			},
+		},
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: &xrplOriginatedTokenXRPLAmount,
+		},
+	}
+
+	memoRecipientAddress := coreum.GenAccount()
+	xrplOriginatedTokenPaymentWithMemoRecipientTx := rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			Destination: bridgeXRPLAddress,
+			Amount:      xrplOriginatedTokenXRPLAmount,
+			TxBase: rippledata.TxBase{
+				TransactionType: rippledata.PAYMENT,
+				Memos: rippledata.Memos{
+					{
+						Memo: rippledata.MemoItem{
+							MemoData: rippledata.VariableLength(memoRecipientAddress.String()),
+						},
+					},
+					memo,
+				},
+			},
+		},
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: &xrplOriginatedTokenXRPLAmount,
+		},
+	}
+
 	coreumOriginatedTokenXRPLAmount := rippledata.Amount{
 		Value:    txValue,
 		Currency: xrplCurrency,
@@ -107,25 +156,705 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 				},
 			},
 		},
-		MetaData: rippledata.MetaData{
-			DeliveredAmount: &tooHighXRPLAmount,
-		},
-	}
-
-	tests := []struct {
-		name                  string
-		errorsCount           int
-		unexpectedTxCount     int
-		txScannerBuilder      func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner
-		contractClientBuilder func(ctrl *gomock.Controller) processes.ContractClient
-	}{
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: &tooHighXRPLAmount,
+		},
+	}
+
+	oversizedMemo := rippledata.Memo{
+		Memo: rippledata.MemoItem{
+			MemoData: rippledata.VariableLength(strings.Repeat(" ", xrpl.MaxMemoDataSize) + memoRecipientAddress.String()),
+		},
+	}
+	xrplOriginatedTokenPaymentWithOversizedMemoTx := rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			Destination: bridgeXRPLAddress,
+			Amount:      xrplOriginatedTokenXRPLAmount,
+			TxBase: rippledata.TxBase{
+				TransactionType: rippledata.PAYMENT,
+				Memos: rippledata.Memos{
+					oversizedMemo,
+				},
+			},
+		},
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: &xrplOriginatedTokenXRPLAmount,
+		},
+	}
+	tooManyMemos := make(rippledata.Memos, 0, xrpl.MaxInspectedMemos+1)
+	for i := 0; i < xrpl.MaxInspectedMemos+1; i++ {
+		tooManyMemos = append(tooManyMemos, memo)
+	}
+	xrplOriginatedTokenPaymentWithTooManyMemosTx := rippledata.TransactionWithMetaData{
+		Transaction: &rippledata.Payment{
+			Destination: bridgeXRPLAddress,
+			Amount:      xrplOriginatedTokenXRPLAmount,
+			TxBase: rippledata.TxBase{
+				TransactionType: rippledata.PAYMENT,
+				Memos:           tooManyMemos,
+			},
+		},
+		MetaData: rippledata.MetaData{
+			DeliveredAmount: &xrplOriginatedTokenXRPLAmount,
+		},
+	}
+
+	nftokenID, err := rippledata.NewHash256("000800006203F49C21D5D6E022CB16DE3538F248662FC73C0000000000000001")
+	require.NoError(t, err)
+	nftokenSellOfferID, err := rippledata.NewHash256("D2D6EB33C17D4D6B7B1AF2A4D0E2A7C4B5F3A4E1C2D8E9F0A1B2C3D4E5F6A7B8")
+	require.NoError(t, err)
+	zeroXRPAmount, err := rippledata.NewAmount("0")
+	require.NoError(t, err)
+	nftokenSender := xrpl.GenPrivKeyTxSigner().Account()
+	buildNFTokenCreateOfferWithMetadataTx := func(
+		destination rippledata.Account, amount *rippledata.Amount,
+	) rippledata.TransactionWithMetaData {
+		return rippledata.TransactionWithMetaData{
+			Transaction: &rippledata.NFTokenCreateOffer{
+				TxBase: rippledata.TxBase{
+					Account:         nftokenSender,
+					TransactionType: rippledata.NFTOKEN_CREATE_OFFER,
+					Flags:           lo.ToPtr(xrpl.TxSellNFToken),
+					Memos: rippledata.Memos{
+						memo,
+					},
+				},
+				NFTokenID:   nftokenID,
+				Amount:      amount,
+				Destination: &destination,
+			},
+			MetaData: rippledata.MetaData{
+				AffectedNodes: rippledata.NodeEffects{
+					{
+						CreatedNode: &rippledata.AffectedNode{
+							LedgerEntryType: rippledata.NFTOKEN_OFFER,
+							LedgerIndex:     nftokenSellOfferID,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	buildMinBridgeAmounts := func(amount string) processes.MinBridgeAmounts {
+		minBridgeAmounts, err := processes.NewMinBridgeAmounts(processes.MinBridgeAmountsConfig{
+			Tokens: []processes.MinBridgeAmountConfig{
+				{
+					Issuer:   xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency: xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Amount:   amount,
+				},
+			},
+		})
+		require.NoError(t, err)
+		return minBridgeAmounts
+	}
+
+	evidenceResentCh := make(chan struct{})
+	rateLimitedEvidenceResentCh := make(chan struct{})
+
+	tests := []struct {
+		name                  string
+		errorsCount           int
+		unexpectedTxCount     int
+		belowMinAmountCount   int
+		memoLimitExceeded     int
+		minBridgeAmounts      processes.MinBridgeAmounts
+		nftokenBridging       bool
+		txScannerBuilder      func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner
+		contractClientBuilder func(ctrl *gomock.Controller) processes.ContractClient
+		auditLoggerBuilder    func(ctrl *gomock.Controller) processes.EvidenceAuditLogger
+		blockedQueueBuilder   func(ctrl *gomock.Controller) processes.XRPLToCoreumBlockedDeliveryQueue
+		latencyTrackerBuilder func(ctrl *gomock.Controller) processes.XRPLTransferLatencyObserver
+		retryQueueBuilder     func(ctrl *gomock.Controller, cancel func()) processes.XRPLToCoreumEvidenceRetryQueue
+		historyBuilder        func(ctrl *gomock.Controller) processes.TransferHistoryRecorder
+		ticketUsageBuilder    func(ctrl *gomock.Controller) processes.TicketUsageRecorder
+	}{
+		{
+			name: "incoming_xrpl_originated_token_valid_payment",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLToCoreumTransferEvidence{
+						TxHash:    rippledata.Hash256{}.String(),
+						Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+						Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+						Recipient: coreumRecipientAddress,
+					},
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+			latencyTrackerBuilder: func(ctrl *gomock.Controller) processes.XRPLTransferLatencyObserver {
+				latencyTrackerMock := NewMockXRPLTransferLatencyObserver(ctrl)
+				latencyTrackerMock.EXPECT().ObserveXRPLToCoreumStart(
+					gomock.Any(), rippledata.Hash256{}.String(), xrplOriginatedTokenPaymentWithMetadataTx.Date.Time(),
+				).Return(nil)
+
+				return latencyTrackerMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_payment_with_unexpected_error_is_retried",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						<-ctx.Done()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				gomock.InOrder(
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(), relayerAddress, gomock.Any(),
+					).Return(nil, errors.New("unexpected error")),
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(), relayerAddress, gomock.Any(),
+					).Return(nil, nil),
+				)
+
+				return contractClientMock
+			},
+			retryQueueBuilder: func(
+				ctrl *gomock.Controller, cancel func(),
+			) processes.XRPLToCoreumEvidenceRetryQueue {
+				retryQueueMock := NewMockXRPLToCoreumEvidenceRetryQueue(ctrl)
+				pushedTxCh := make(chan rippledata.TransactionWithMetaData, 1)
+				retryQueueMock.EXPECT().Start(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						select {
+						case <-ctx.Done():
+							return ctx.Err()
+						case tx := <-pushedTxCh:
+							ch <- tx
+						}
+						<-ctx.Done()
+						return ctx.Err()
+					})
+				retryQueueMock.EXPECT().Push(gomock.Any(), xrplOriginatedTokenPaymentWithMetadataTx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, tx rippledata.TransactionWithMetaData, _ error) error {
+						pushedTxCh <- tx
+						return nil
+					})
+				retryQueueMock.EXPECT().Complete(xrplOriginatedTokenPaymentWithMetadataTx).Do(
+					func(rippledata.TransactionWithMetaData) {
+						cancel()
+					})
+
+				return retryQueueMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_payment_with_destination_chain_recipient",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithDestinationChainRecipientTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				// the evidence is complete once sent, the IBC transfer result is tracked apart from the XRPL txs
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLToCoreumTransferEvidence{
+						TxHash:                    rippledata.Hash256{}.String(),
+						Issuer:                    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+						Currency:                  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+						Amount:                    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+						Recipient:                 coreumRecipientAddress,
+						DestinationChainRecipient: destinationChainRecipient,
+					},
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_memo_recipient",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMemoRecipientTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				// the memo recipient is preferred over the bridge memo recipient
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLToCoreumTransferEvidence{
+						TxHash:    rippledata.Hash256{}.String(),
+						Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+						Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+						Recipient: memoRecipientAddress,
+					},
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+		},
+		{
+			name:             "incoming_xrpl_originated_token_valid_payment_with_amount_equal_to_min_amount",
+			minBridgeAmounts: buildMinBridgeAmounts("999"),
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					gomock.Any(),
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+		},
+		{
+			name:                "incoming_xrpl_originated_token_valid_payment_with_amount_below_min_amount",
+			minBridgeAmounts:    buildMinBridgeAmounts("999.000000000000001"),
+			belowMinAmountCount: 1,
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_audit_record",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					gomock.Any(),
+				).Return(&sdk.TxResponse{TxHash: "C0FFEE"}, nil)
+
+				return contractClientMock
+			},
+			auditLoggerBuilder: func(ctrl *gomock.Controller) processes.EvidenceAuditLogger {
+				auditLoggerMock := NewMockEvidenceAuditLogger(ctrl)
+				auditLoggerMock.EXPECT().LogEvidence(gomock.Any(), processes.EvidenceAuditRecord{
+					RelayerCoreumAddress: relayerAddress.String(),
+					EvidenceType:         processes.EvidenceAuditTypeXRPLToCoreumTransfer,
+					TxHash:               rippledata.Hash256{}.String(),
+					Issuer:               xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency:             xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Amount:               sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals).String(),
+					Recipient:            coreumRecipientAddress.String(),
+					Outcome:              processes.EvidenceAuditOutcomeSuccess,
+					CoreumTxHash:         "C0FFEE",
+				}).Return(errors.New("disk is full"))
+
+				return auditLoggerMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_transfer_history",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					gomock.Any(),
+				).Return(&sdk.TxResponse{
+					TxHash:    "C0FFEE",
+					Timestamp: "2024-01-02T03:04:05Z",
+					Events: []abci.Event{
+						{
+							Type: "wasm",
+							Attributes: []abci.EventAttribute{
+								{Key: "threshold_reached", Value: "true"},
+							},
+						},
+						{
+							Type: "coin_received",
+							Attributes: []abci.EventAttribute{
+								{Key: "receiver", Value: coreumRecipientAddress.String()},
+								{Key: "amount", Value: "998000000000000000denom"},
+							},
+						},
+					},
+				}, nil)
+
+				return contractClientMock
+			},
+			historyBuilder: func(ctrl *gomock.Controller) processes.TransferHistoryRecorder {
+				historyMock := NewMockTransferHistoryRecorder(ctrl)
+				historyMock.EXPECT().Append(gomock.Any(), processes.TransferHistoryRecord{
+					Direction:         processes.TransferHistoryDirectionXRPLToCoreum,
+					SourceTxHash:      rippledata.Hash256{}.String(),
+					DestinationTxHash: "C0FFEE",
+					Sender:            rippledata.Account{}.String(),
+					Recipient:         coreumRecipientAddress.String(),
+					Issuer:            xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency:          xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Denom:             "denom",
+					Amount:            sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals).String(),
+					Fee:               sdkmath.NewIntWithDecimal(1, xrpl.XRPLIssuedTokenDecimals).String(),
+					StartedAt:         rippledata.RippleTime{}.Time(),
+					CompletedAt:       time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					Status:            processes.TransferHistoryStatusCompleted,
+				}).Return(nil)
+
+				return historyMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_blocked_delivery",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					gomock.Any(),
+				).Return(nil, errors.New("token is globally frozen"))
+
+				return contractClientMock
+			},
+			blockedQueueBuilder: func(ctrl *gomock.Controller) processes.XRPLToCoreumBlockedDeliveryQueue {
+				blockedQueueMock := NewMockXRPLToCoreumBlockedDeliveryQueue(ctrl)
+				blockedQueueMock.EXPECT().Park(
+					gomock.Any(),
+					coreum.XRPLToCoreumTransferEvidence{
+						TxHash:    rippledata.Hash256{}.String(),
+						Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+						Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+						Recipient: coreumRecipientAddress,
+					},
+					"token is globally frozen",
+				).Return(nil)
+
+				return blockedQueueMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_broadcast_timeout",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						// wait for the re-queued tx to be processed
+						<-evidenceResentCh
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				expectedEvidence := coreum.XRPLToCoreumTransferEvidence{
+					TxHash:    rippledata.Hash256{}.String(),
+					Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+					Recipient: coreumRecipientAddress,
+				}
+				gomock.InOrder(
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(),
+						relayerAddress,
+						expectedEvidence,
+					).Return(nil, errors.Wrap(coreum.ErrBroadcastTimeout, "timeout:1s")),
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(),
+						relayerAddress,
+						expectedEvidence,
+					).DoAndReturn(func(
+						context.Context, sdk.AccAddress, coreum.XRPLToCoreumTransferEvidence,
+					) (*sdk.TxResponse, error) {
+						close(evidenceResentCh)
+						return nil, nil
+					}),
+				)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_xrpl_originated_token_valid_payment_with_rate_limit_exceeded",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						// wait for the re-queued tx to be processed
+						<-rateLimitedEvidenceResentCh
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				expectedEvidence := coreum.XRPLToCoreumTransferEvidence{
+					TxHash:    rippledata.Hash256{}.String(),
+					Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+					Recipient: coreumRecipientAddress,
+				}
+				gomock.InOrder(
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(),
+						relayerAddress,
+						expectedEvidence,
+					).Return(nil, errors.New(
+						"RateLimitExceeded: The relayer reached the maximum amount of evidences allowed in a block",
+					)),
+					contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+						gomock.Any(),
+						relayerAddress,
+						expectedEvidence,
+					).DoAndReturn(func(
+						context.Context, sdk.AccAddress, coreum.XRPLToCoreumTransferEvidence,
+					) (*sdk.TxResponse, error) {
+						close(rateLimitedEvidenceResentCh)
+						return nil, nil
+					}),
+				)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_coreum_originated_token_valid_payment",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- coreumOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+
+				stringCurrency := xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency)
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLToCoreumTransferEvidence{
+						TxHash:    rippledata.Hash256{}.String(),
+						Issuer:    bridgeXRPLAddress.String(),
+						Currency:  stringCurrency,
+						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
+						Recipient: coreumRecipientAddress,
+					},
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_coreum_originated_token_payment_with_amount_overflow_after_decimals_conversion",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- coreumOriginatedTokenPaymentWithMetadataTx
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				// the evidence is skipped without the retry
+				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(gomock.Any(), relayerAddress, gomock.Any()).
+					Return(nil, errors.New("Cannot Mul with 340282366920938463463374607431768211455 and 100000"))
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "incoming_not_success_tx",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				return contractClientMock
+			},
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.Payment{},
+							MetaData: rippledata.MetaData{
+								TransactionResult: failTxResult,
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+		},
+		{
+			name: "incoming_not_confirmed_tx",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				return contractClientMock
+			},
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.Payment{},
+							MetaData: rippledata.MetaData{
+								TransactionResult: notTxResult,
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+		},
+		{
+			name: "incoming_not_payment_tx",
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				return contractClientMock
+			},
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.TrustSet{
+								TxBase: rippledata.TxBase{
+									TransactionType: rippledata.TRUST_SET,
+									Flags:           lo.ToPtr(rippledata.TxSetNoRipple),
+								},
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+		},
 		{
-			name: "incoming_xrpl_originated_token_valid_payment",
+			name:            "incoming_nftoken_sell_offer",
+			nftokenBridging: true,
 			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
 				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
 				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
-						ch <- xrplOriginatedTokenPaymentWithMetadataTx
+						ch <- buildNFTokenCreateOfferWithMetadataTx(bridgeXRPLAddress, zeroXRPAmount)
 						cancel()
 						return nil
 					})
@@ -135,15 +864,15 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
 				contractClientMock := NewMockContractClient(ctrl)
 				contractClientMock.EXPECT().IsInitialized().Return(true)
-				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
+				contractClientMock.EXPECT().SendXRPLNFTokenTransferEvidence(
 					gomock.Any(),
 					relayerAddress,
-					coreum.XRPLToCoreumTransferEvidence{
-						TxHash:    rippledata.Hash256{}.String(),
-						Issuer:    xrplOriginatedTokenXRPLAmount.Issuer.String(),
-						Currency:  xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
-						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
-						Recipient: coreumRecipientAddress,
+					coreum.XRPLNFTokenTransferEvidence{
+						TxHash:      rippledata.Hash256{}.String(),
+						NFTokenID:   nftokenID.String(),
+						SellOfferID: nftokenSellOfferID.String(),
+						Sender:      nftokenSender.String(),
+						Recipient:   coreumRecipientAddress,
 					},
 				).Return(nil, nil)
 
@@ -151,12 +880,12 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 			},
 		},
 		{
-			name: "incoming_coreum_originated_token_valid_payment",
+			name: "incoming_nftoken_sell_offer_with_disabled_nftoken_bridging",
 			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
 				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
 				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
-						ch <- coreumOriginatedTokenPaymentWithMetadataTx
+						ch <- buildNFTokenCreateOfferWithMetadataTx(bridgeXRPLAddress, zeroXRPAmount)
 						cancel()
 						return nil
 					})
@@ -166,49 +895,52 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
 				contractClientMock := NewMockContractClient(ctrl)
 				contractClientMock.EXPECT().IsInitialized().Return(true)
-
-				stringCurrency := xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency)
-				contractClientMock.EXPECT().SendXRPLToCoreumTransferEvidence(
-					gomock.Any(),
-					relayerAddress,
-					coreum.XRPLToCoreumTransferEvidence{
-						TxHash:    rippledata.Hash256{}.String(),
-						Issuer:    bridgeXRPLAddress.String(),
-						Currency:  stringCurrency,
-						Amount:    sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals),
-						Recipient: coreumRecipientAddress,
-					},
-				).Return(nil, nil)
-
 				return contractClientMock
 			},
 		},
 		{
-			name: "incoming_not_success_tx",
+			name:            "incoming_nftoken_sell_offer_not_for_bridge",
+			nftokenBridging: true,
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- buildNFTokenCreateOfferWithMetadataTx(recipientXRPLAddress, zeroXRPAmount)
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
 				contractClientMock := NewMockContractClient(ctrl)
 				contractClientMock.EXPECT().IsInitialized().Return(true)
 				return contractClientMock
 			},
+		},
+		{
+			name:            "incoming_nftoken_sell_offer_with_not_zero_amount",
+			nftokenBridging: true,
 			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
 				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
 				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
-						ch <- rippledata.TransactionWithMetaData{
-							Transaction: &rippledata.Payment{},
-							MetaData: rippledata.MetaData{
-								TransactionResult: failTxResult,
-							},
-						}
+						ch <- buildNFTokenCreateOfferWithMetadataTx(bridgeXRPLAddress, &xrplOriginatedTokenXRPLAmount)
 						cancel()
 						return nil
 					})
 
 				return xrplAccountTxScannerMock
 			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				return contractClientMock
+			},
 		},
 		{
-			name: "incoming_not_confirmed_tx",
+			name:              "incoming_xrpl_originated_token_payment_with_oversized_memo",
+			memoLimitExceeded: 1,
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
 				contractClientMock := NewMockContractClient(ctrl)
 				contractClientMock.EXPECT().IsInitialized().Return(true)
@@ -218,12 +950,7 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
 				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
-						ch <- rippledata.TransactionWithMetaData{
-							Transaction: &rippledata.Payment{},
-							MetaData: rippledata.MetaData{
-								TransactionResult: notTxResult,
-							},
-						}
+						ch <- xrplOriginatedTokenPaymentWithOversizedMemoTx
 						cancel()
 						return nil
 					})
@@ -232,7 +959,8 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 			},
 		},
 		{
-			name: "incoming_not_payment_tx",
+			name:              "incoming_xrpl_originated_token_payment_with_too_many_memos",
+			memoLimitExceeded: 1,
 			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
 				contractClientMock := NewMockContractClient(ctrl)
 				contractClientMock.EXPECT().IsInitialized().Return(true)
@@ -242,14 +970,7 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
 				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
 					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
-						ch <- rippledata.TransactionWithMetaData{
-							Transaction: &rippledata.TrustSet{
-								TxBase: rippledata.TxBase{
-									TransactionType: rippledata.TRUST_SET,
-									Flags:           lo.ToPtr(rippledata.TxSetNoRipple),
-								},
-							},
-						}
+						ch <- xrplOriginatedTokenPaymentWithTooManyMemosTx
 						cancel()
 						return nil
 					})
@@ -524,6 +1245,159 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 					},
 				).Return(nil, nil)
 
+				return contractClientMock
+			},
+			latencyTrackerBuilder: func(ctrl *gomock.Controller) processes.XRPLTransferLatencyObserver {
+				latencyTrackerMock := NewMockXRPLTransferLatencyObserver(ctrl)
+				latencyTrackerMock.EXPECT().ObserveCoreumToXRPLCompletion(
+					gomock.Any(), uint32(11), rippledata.RippleTime{}.Time(),
+				).Return(nil)
+
+				return latencyTrackerMock
+			},
+		},
+		{
+			name: "outgoing_payment_tx_with_transfer_history",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.Payment{
+								TxBase: rippledata.TxBase{
+									Account:         bridgeXRPLAddress,
+									TransactionType: rippledata.PAYMENT,
+								},
+								Destination:    recipientXRPLAddress,
+								Amount:         xrplOriginatedTokenXRPLAmount,
+								TicketSequence: lo.ToPtr(uint32(11)),
+							},
+							MetaData: rippledata.MetaData{
+								TransactionResult: failTxResult,
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendCoreumToXRPLTransferTransactionResultEvidence(
+					gomock.Any(),
+					relayerAddress,
+					gomock.Any(),
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+			historyBuilder: func(ctrl *gomock.Controller) processes.TransferHistoryRecorder {
+				historyMock := NewMockTransferHistoryRecorder(ctrl)
+				historyMock.EXPECT().Append(gomock.Any(), processes.TransferHistoryRecord{
+					Direction:         processes.TransferHistoryDirectionCoreumToXRPL,
+					DestinationTxHash: rippledata.Hash256{}.String(),
+					OperationID:       11,
+					Recipient:         recipientXRPLAddress.String(),
+					Issuer:            xrplOriginatedTokenXRPLAmount.Issuer.String(),
+					Currency:          xrpl.ConvertCurrencyToString(xrplOriginatedTokenXRPLAmount.Currency),
+					Amount:            sdkmath.NewIntWithDecimal(999, xrpl.XRPLIssuedTokenDecimals).String(),
+					CompletedAt:       rippledata.RippleTime{}.Time(),
+					Status:            processes.TransferHistoryStatusRejected,
+				}).Return(errors.New("disk is full"))
+
+				return historyMock
+			},
+			ticketUsageBuilder: func(ctrl *gomock.Controller) processes.TicketUsageRecorder {
+				ticketUsageMock := NewMockTicketUsageRecorder(ctrl)
+				ticketUsageMock.EXPECT().Record(gomock.Any(), processes.TicketUsageRecord{
+					TicketSequence: 11,
+					OperationType:  coreum.OperationTypeEnumCoreumToXRPLTransfer,
+					Result:         coreum.TransactionResultRejected,
+					TxHash:         rippledata.Hash256{}.String(),
+					CompletedAt:    rippledata.RippleTime{}.Time(),
+				}).Return(nil)
+
+				return ticketUsageMock
+			},
+		},
+		{
+			name: "outgoing_nftoken_accept_offer_tx",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.NFTAcceptOffer{
+								TxBase: rippledata.TxBase{
+									Account:         bridgeXRPLAddress,
+									TransactionType: rippledata.NFTOKEN_ACCEPT_OFFER,
+								},
+								NFTokenSellOffer: nftokenSellOfferID,
+								TicketSequence:   lo.ToPtr(uint32(12)),
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendNFTokenTransferTransactionResultEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLTransactionResultNFTokenTransferEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TxHash:            rippledata.Hash256{}.String(),
+							TicketSequence:    lo.ToPtr(uint32(12)),
+							TransactionResult: coreum.TransactionResultAccepted,
+						},
+					},
+				).Return(nil, nil)
+
+				return contractClientMock
+			},
+		},
+		{
+			name: "outgoing_check_cash_tx",
+			txScannerBuilder: func(ctrl *gomock.Controller, cancel func()) processes.XRPLAccountTxScanner {
+				xrplAccountTxScannerMock := NewMockXRPLAccountTxScanner(ctrl)
+				xrplAccountTxScannerMock.EXPECT().ScanTxs(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, ch chan<- rippledata.TransactionWithMetaData) error {
+						ch <- rippledata.TransactionWithMetaData{
+							Transaction: &rippledata.CheckCash{
+								TxBase: rippledata.TxBase{
+									Account:         bridgeXRPLAddress,
+									TransactionType: rippledata.CHECK_CASH,
+								},
+								TicketSequence: lo.ToPtr(uint32(13)),
+							},
+						}
+						cancel()
+						return nil
+					})
+
+				return xrplAccountTxScannerMock
+			},
+			contractClientBuilder: func(ctrl *gomock.Controller) processes.ContractClient {
+				contractClientMock := NewMockContractClient(ctrl)
+				contractClientMock.EXPECT().IsInitialized().Return(true)
+				contractClientMock.EXPECT().SendCheckCashTransactionResultEvidence(
+					gomock.Any(),
+					relayerAddress,
+					coreum.XRPLTransactionResultCheckCashEvidence{
+						XRPLTransactionResultEvidence: coreum.XRPLTransactionResultEvidence{
+							TxHash:            rippledata.Hash256{}.String(),
+							TicketSequence:    lo.ToPtr(uint32(13)),
+							TransactionResult: coreum.TransactionResultAccepted,
+						},
+					},
+				).Return(nil, nil)
+
 				return contractClientMock
 			},
 		},
@@ -570,6 +1444,10 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 
 				return contractClientMock
 			},
+			latencyTrackerBuilder: func(ctrl *gomock.Controller) processes.XRPLTransferLatencyObserver {
+				// the failed payment doesn't complete the transfer
+				return NewMockXRPLTransferLatencyObserver(ctrl)
+			},
 		},
 		{
 			name: "outgoing_signer_list_set_tx_with_ticket_seq",
@@ -725,19 +1603,63 @@ func TestXRPLToCoreumProcess_Start(t *testing.T) {
 			if tt.contractClientBuilder != nil {
 				contractClient = tt.contractClientBuilder(ctrl)
 			}
+			var auditLogger processes.EvidenceAuditLogger
+			if tt.auditLoggerBuilder != nil {
+				auditLogger = tt.auditLoggerBuilder(ctrl)
+			} else {
+				auditLoggerMock := NewMockEvidenceAuditLogger(ctrl)
+				auditLoggerMock.EXPECT().LogEvidence(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				auditLogger = auditLoggerMock
+			}
+			var blockedQueue processes.XRPLToCoreumBlockedDeliveryQueue
+			if tt.blockedQueueBuilder != nil {
+				blockedQueue = tt.blockedQueueBuilder(ctrl)
+			}
+			var latencyTracker processes.XRPLTransferLatencyObserver
+			if tt.latencyTrackerBuilder != nil {
+				latencyTracker = tt.latencyTrackerBuilder(ctrl)
+			}
+			var retryQueue processes.XRPLToCoreumEvidenceRetryQueue
+			if tt.retryQueueBuilder != nil {
+				retryQueue = tt.retryQueueBuilder(ctrl, cancel)
+			}
+			var history processes.TransferHistoryRecorder
+			if tt.historyBuilder != nil {
+				history = tt.historyBuilder(ctrl)
+			}
+			var ticketUsage processes.TicketUsageRecorder
+			if tt.ticketUsageBuilder != nil {
+				ticketUsage = tt.ticketUsageBuilder(ctrl)
+			}
 			metricRegistryMock := NewMockMetricRegistry(ctrl)
 			if tt.unexpectedTxCount > 0 {
 				metricRegistryMock.EXPECT().SetMaliciousBehaviourKey(gomock.Any()).Times(tt.unexpectedTxCount)
 			}
+			if tt.belowMinAmountCount > 0 {
+				metricRegistryMock.EXPECT().IncrementXRPLToCoreumBelowMinAmountTransfersCounter(
+					xrpl.ConvertCurrencyToString(xrplCurrency), issuerAccount.String(),
+				).Times(tt.belowMinAmountCount)
+			}
+			if tt.memoLimitExceeded > 0 {
+				metricRegistryMock.EXPECT().IncrementXRPLMemoLimitExceededTxsCounter().Times(tt.memoLimitExceeded)
+			}
 			o, err := processes.NewXRPLToCoreumProcess(
 				processes.XRPLToCoreumProcessConfig{
-					BridgeXRPLAddress:    bridgeXRPLAddress,
-					RelayerCoreumAddress: relayerAddress,
+					BridgeXRPLAddress:      bridgeXRPLAddress,
+					RelayerCoreumAddress:   relayerAddress,
+					MinBridgeAmounts:       tt.minBridgeAmounts,
+					NFTokenBridgingEnabled: tt.nftokenBridging,
 				},
 				logMock,
 				tt.txScannerBuilder(ctrl, cancel),
 				contractClient,
 				metricRegistryMock,
+				auditLogger,
+				blockedQueue,
+				latencyTracker,
+				retryQueue,
+				history,
+				ticketUsage,
 			)
 			require.NoError(t, err)
 			require.ErrorIs(t, o.Start(ctx), context.Canceled)
@@ -763,3 +1685,160 @@ func createAllocatedTicketsMetaData(ticketSequences []uint32) rippledata.MetaDat
 		AffectedNodes: nodeEffects,
 	}
 }
+
+func TestConvertXRPLPaymentToTransferEvidence(t *testing.T) {
+	t.Parallel()
+
+	coreumRecipient := coreum.GenAccount()
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreumRecipient)
+	require.NoError(t, err)
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+
+	buildPayment := func(memos rippledata.Memos, deliveredAmount string) rippledata.TransactionWithMetaData {
+		tx := rippledata.TransactionWithMetaData{
+			Transaction: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					TransactionType: rippledata.PAYMENT,
+					Memos:           memos,
+				},
+			},
+		}
+		if deliveredAmount != "" {
+			amount, err := rippledata.NewAmount(deliveredAmount + "/RCP/" + issuer.String())
+			require.NoError(t, err)
+			tx.MetaData.DeliveredAmount = amount
+		}
+		return tx
+	}
+
+	tests := []struct {
+		name    string
+		tx      rippledata.TransactionWithMetaData
+		want    coreum.XRPLToCoreumTransferEvidence
+		wantErr error
+	}{
+		{
+			name: "valid_payment",
+			tx:   buildPayment(rippledata.Memos{memo}, "10"),
+			want: coreum.XRPLToCoreumTransferEvidence{
+				TxHash:    rippledata.Hash256{}.String(),
+				Issuer:    issuer.String(),
+				Currency:  "RCP",
+				Amount:    sdkmath.NewIntWithDecimal(10, xrpl.XRPLIssuedTokenDecimals),
+				Recipient: coreumRecipient,
+			},
+		},
+		{
+			name: "oversized_memo",
+			tx: buildPayment(rippledata.Memos{
+				{
+					Memo: rippledata.MemoItem{
+						MemoData: make(rippledata.VariableLength, xrpl.MaxMemoDataSize+1),
+					},
+				},
+			}, "10"),
+			wantErr: xrpl.ErrMemoLimitExceeded,
+		},
+		{
+			name:    "too_many_memos",
+			tx:      buildPayment(lo.Times(xrpl.MaxInspectedMemos+1, func(int) rippledata.Memo { return memo }), "10"),
+			wantErr: xrpl.ErrMemoLimitExceeded,
+		},
+		{
+			name: "max_memos",
+			tx:   buildPayment(lo.Times(xrpl.MaxInspectedMemos, func(int) rippledata.Memo { return memo }), "10"),
+			want: coreum.XRPLToCoreumTransferEvidence{
+				TxHash:    rippledata.Hash256{}.String(),
+				Issuer:    issuer.String(),
+				Currency:  "RCP",
+				Amount:    sdkmath.NewIntWithDecimal(10, xrpl.XRPLIssuedTokenDecimals),
+				Recipient: coreumRecipient,
+			},
+		},
+		{
+			name:    "without_recipient",
+			tx:      buildPayment(nil, "10"),
+			wantErr: processes.ErrPaymentWithoutCoreumRecipient,
+		},
+		{
+			name:    "without_delivered_amount",
+			tx:      buildPayment(rippledata.Memos{memo}, ""),
+			wantErr: processes.ErrPaymentWithoutDeliveredAmount,
+		},
+		{
+			name:    "extreme_exponent_amount",
+			tx:      buildPayment(rippledata.Memos{memo}, "9999999999999999e80"),
+			wantErr: processes.ErrSDKMathIntOutOfBounds,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := processes.ConvertXRPLPaymentToTransferEvidence(tt.tx)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// FuzzConvertXRPLPaymentToTransferEvidence checks that the mapping of the attacker-controlled XRPL payments neither
+// crashes the relayer nor produces the invalid evidences. The CI runs it as:
+//
+//	go test ./processes/ -run=^$ -fuzz=FuzzConvertXRPLPaymentToTransferEvidence -fuzztime=30s
+func FuzzConvertXRPLPaymentToTransferEvidence(f *testing.F) {
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(coreum.GenAccount())
+	require.NoError(f, err)
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	for _, deliveredAmount := range []string{
+		"1000000",
+		"10.5/USD/" + issuer.String(),
+		"9999999999999999e80/USD/" + issuer.String(),
+		"1e-96/USD/" + issuer.String(),
+		"1/534F4C4F00000000000000000000000000000000/" + issuer.String(),
+	} {
+		amount, err := rippledata.NewAmount(deliveredAmount)
+		require.NoError(f, err)
+		blob, err := json.Marshal(rippledata.TransactionWithMetaData{
+			Transaction: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					TransactionType: rippledata.PAYMENT,
+					Memos:           rippledata.Memos{memo},
+				},
+				Amount: *amount,
+			},
+			MetaData: rippledata.MetaData{
+				DeliveredAmount: amount,
+			},
+		})
+		require.NoError(f, err)
+		f.Add(blob)
+	}
+
+	f.Fuzz(func(t *testing.T, rawTx []byte) {
+		tx, err := xrpl.DecodeTransactionWithMetaData(rawTx)
+		if err != nil {
+			return
+		}
+		payment, ok := tx.Transaction.(*rippledata.Payment)
+		if !ok || payment == nil {
+			return
+		}
+
+		evidence, err := processes.ConvertXRPLPaymentToTransferEvidence(tx)
+		if err != nil {
+			return
+		}
+		require.NoError(t, xrpl.ValidateMemoLimits(payment.Memos))
+		require.NoError(t, sdk.VerifyAddressFormat(evidence.Recipient))
+		require.False(t, evidence.Amount.IsNegative(), "amount: %s", evidence.Amount)
+		currency, err := rippledata.NewCurrency(evidence.Currency)
+		require.NoError(t, err)
+		require.Equal(t, tx.MetaData.DeliveredAmount.Currency, currency)
+	})
+}
@@ -0,0 +1,304 @@
+//nolint:tagliatelle // yaml spec
+package processes
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// XRPLTxResultStoreFileName is the name of the submitted XRPL txs store file stored in the relayer home.
+const XRPLTxResultStoreFileName = "xrpl-tx-results.yaml"
+
+// XRPLTxTrackingState is the state of the submitted XRPL tx tracked by the XRPLTxResultTracker.
+type XRPLTxTrackingState string
+
+// XRPLTxTrackingState values.
+const (
+	// XRPLTxTrackingStatePending is the state of the submitted tx waiting for the validation.
+	XRPLTxTrackingStatePending XRPLTxTrackingState = "pending"
+	// XRPLTxTrackingStateAccepted is the state of the tx validated with the tesSUCCESS result.
+	XRPLTxTrackingStateAccepted XRPLTxTrackingState = "accepted"
+	// XRPLTxTrackingStateRejected is the state of the tx validated with the tec result.
+	XRPLTxTrackingStateRejected XRPLTxTrackingState = "rejected"
+)
+
+// TrackedXRPLTx is the submitted XRPL tx of the pending operation.
+type TrackedXRPLTx struct {
+	OperationID  uint32              `yaml:"operation_id"`
+	TxHash       string              `yaml:"tx_hash"`
+	State        XRPLTxTrackingState `yaml:"state"`
+	EngineResult string              `yaml:"engine_result,omitempty"`
+	SubmittedAt  time.Time           `yaml:"submitted_at"`
+}
+
+type xrplTxResultStore struct {
+	Txs []TrackedXRPLTx `yaml:"txs"`
+}
+
+// ReadTrackedXRPLTxs reads the tracked XRPL txs from the store file, the empty list is returned if the file path is
+// empty or the file does not exist.
+func ReadTrackedXRPLTxs(filePath string) ([]TrackedXRPLTx, error) {
+	if filePath == "" {
+		return make([]TrackedXRPLTx, 0), nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return make([]TrackedXRPLTx, 0), nil
+		}
+		return nil, errors.Wrapf(err, "failed to read XRPL tx results store file, path:%s", filePath)
+	}
+	var store xrplTxResultStore
+	if err := yaml.Unmarshal(fileBytes, &store); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal XRPL tx results store file, path:%s", filePath)
+	}
+	if store.Txs == nil {
+		return make([]TrackedXRPLTx, 0), nil
+	}
+
+	return store.Txs, nil
+}
+
+func saveTrackedXRPLTxs(filePath string, txs []TrackedXRPLTx) error {
+	if filePath == "" {
+		return nil
+	}
+	storeBytes, err := yaml.Marshal(xrplTxResultStore{Txs: txs})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal XRPL tx results store")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, storeBytes), "failed to save XRPL tx results store, path:%s", filePath,
+	)
+}
+
+// XRPLTxResultTrackerConfig is the XRPLTxResultTracker config.
+type XRPLTxResultTrackerConfig struct {
+	RelayerCoreumAddress sdk.AccAddress
+	// PollInterval is the interval of the tracked txs results check.
+	PollInterval time.Duration
+	// StoreFilePath is the path of the file the submitted txs are tracked in, so their results are checked after
+	// the restart. The empty path keeps them in memory only.
+	StoreFilePath string
+}
+
+// DefaultXRPLTxResultTrackerConfig returns the default XRPLTxResultTrackerConfig.
+func DefaultXRPLTxResultTrackerConfig(
+	relayerCoreumAddress sdk.AccAddress,
+	storeFilePath string,
+) XRPLTxResultTrackerConfig {
+	return XRPLTxResultTrackerConfig{
+		RelayerCoreumAddress: relayerCoreumAddress,
+		PollInterval:         5 * time.Second,
+		StoreFilePath:        storeFilePath,
+	}
+}
+
+// XRPLTxResultTracker tracks the results of the operation txs submitted by the relayer. The submitted tx is polled
+// until it's validated, and the tx is removed once its operation isn't pending anymore, e.g. it's completed by the tx
+// submitted by another relayer.
+// The evidences of the validated txs are sent by the XRPLToCoreumProcess, which observes all bridge account txs, so
+// the tracker only records their result. The tracker never sends the Invalid evidence, since the tx of another
+// relayer might still consume the ticket or sequence of the operation. The txs failed locally (tem, tel, ter or tef)
+// aren't tracked, since they are never validated and the operation tx is re-submitted while the operation is pending.
+// The tracked txs are persisted, so the tracking is resumed after the relayer restart.
+type XRPLTxResultTracker struct {
+	cfg            XRPLTxResultTrackerConfig
+	log            logger.Logger
+	contractClient ContractClient
+	rpcClient      XRPLTxResultRPCClient
+	clock          func() time.Time
+
+	mu  sync.Mutex
+	txs []TrackedXRPLTx
+}
+
+// NewXRPLTxResultTracker returns a new instance of the XRPLTxResultTracker with the state loaded from the store.
+func NewXRPLTxResultTracker(
+	cfg XRPLTxResultTrackerConfig,
+	log logger.Logger,
+	contractClient ContractClient,
+	rpcClient XRPLTxResultRPCClient,
+	clock func() time.Time,
+) (*XRPLTxResultTracker, error) {
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("XRPL tx result poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+	if cfg.RelayerCoreumAddress.Empty() {
+		return nil, errors.New("relayer address is nil or empty")
+	}
+	txs, err := ReadTrackedXRPLTxs(cfg.StoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XRPLTxResultTracker{
+		cfg:            cfg,
+		log:            log,
+		contractClient: contractClient,
+		rpcClient:      rpcClient,
+		clock:          clock,
+		txs:            txs,
+	}, nil
+}
+
+// Start starts the checks of the tracked txs results.
+func (t *XRPLTxResultTracker) Start(ctx context.Context) error {
+	for {
+		if err := t.CheckResults(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return errors.WithStack(err)
+			}
+			t.log.Error(ctx, "Failed to check XRPL tx results", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(t.cfg.PollInterval):
+		}
+	}
+}
+
+// Track starts the tracking of the operation tx by its submission result. The tx failed locally isn't tracked.
+func (t *XRPLTxResultTracker) Track(
+	ctx context.Context,
+	operation coreum.Operation,
+	tx rippledata.Transaction,
+	submitRes xrpl.SubmitResult,
+) error {
+	txHash := strings.ToUpper(tx.GetHash().String())
+	resultClass := xrpl.ClassifyTxResult(submitRes.EngineResult)
+	// the queued tx is tracked the same way as the applied one since it might be applied from the queue
+	if resultClass != xrpl.TxResultClassSuccess &&
+		resultClass != xrpl.TxResultClassClaimed &&
+		submitRes.EngineResult != rippledata.TerQUEUED {
+		t.log.Debug(
+			ctx,
+			"XRPL tx is failed locally, it isn't tracked and will be re-submitted",
+			zap.Uint32("operationID", operation.GetOperationID()),
+			zap.String("txHash", txHash),
+			zap.String("engineResult", submitRes.EngineResult.String()),
+		)
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, trackedTx := range t.txs {
+		if trackedTx.TxHash == txHash {
+			return nil
+		}
+	}
+	t.txs = append(t.txs, TrackedXRPLTx{
+		OperationID: operation.GetOperationID(),
+		TxHash:      txHash,
+		State:       XRPLTxTrackingStatePending,
+		SubmittedAt: t.clock().UTC(),
+	})
+
+	return saveTrackedXRPLTxs(t.cfg.StoreFilePath, t.txs)
+}
+
+// CheckResults checks the results of the tracked txs and removes the txs of the completed operations.
+func (t *XRPLTxResultTracker) CheckResults(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.txs) == 0 {
+		return nil
+	}
+	operations, err := t.contractClient.GetPendingOperations(ctx)
+	if err != nil {
+		return err
+	}
+	pendingOperationIDs := make(map[uint32]struct{}, len(operations))
+	for _, operation := range operations {
+		pendingOperationIDs[operation.GetOperationID()] = struct{}{}
+	}
+
+	changed := false
+	txs := make([]TrackedXRPLTx, 0, len(t.txs))
+	for _, trackedTx := range t.txs {
+		if _, ok := pendingOperationIDs[trackedTx.OperationID]; !ok {
+			t.log.Debug(
+				ctx,
+				"Operation of the tracked XRPL tx is completed",
+				zap.Uint32("operationID", trackedTx.OperationID),
+				zap.String("txHash", trackedTx.TxHash),
+				zap.String("state", string(trackedTx.State)),
+			)
+			changed = true
+			continue
+		}
+		if trackedTx.State == XRPLTxTrackingStatePending {
+			updatedTx, err := t.checkPendingTx(ctx, trackedTx)
+			if err != nil {
+				t.log.Warn(
+					ctx,
+					"Failed to check the XRPL tx result",
+					zap.String("txHash", trackedTx.TxHash),
+					zap.Error(err),
+				)
+			}
+			changed = changed || updatedTx.State != trackedTx.State
+			trackedTx = updatedTx
+		}
+		txs = append(txs, trackedTx)
+	}
+	t.txs = txs
+
+	if !changed {
+		return nil
+	}
+
+	return saveTrackedXRPLTxs(t.cfg.StoreFilePath, t.txs)
+}
+
+func (t *XRPLTxResultTracker) checkPendingTx(ctx context.Context, trackedTx TrackedXRPLTx) (TrackedXRPLTx, error) {
+	txHash, err := rippledata.NewHash256(trackedTx.TxHash)
+	if err != nil {
+		return trackedTx, errors.Wrapf(err, "invalid tracked tx hash:%s", trackedTx.TxHash)
+	}
+	txRes, err := t.rpcClient.Tx(ctx, *txHash)
+	if err != nil && !xrpl.IsTxNotFoundError(err) {
+		return trackedTx, err
+	}
+	// the tx isn't validated yet
+	if err != nil || !txRes.Validated {
+		return trackedTx, nil
+	}
+	trackedTx.EngineResult = txRes.MetaData.TransactionResult.String()
+	switch xrpl.ClassifyTxResult(txRes.MetaData.TransactionResult) {
+	case xrpl.TxResultClassSuccess:
+		trackedTx.State = XRPLTxTrackingStateAccepted
+	case xrpl.TxResultClassClaimed:
+		trackedTx.State = XRPLTxTrackingStateRejected
+	default:
+		return trackedTx, errors.Errorf("unexpected result of the validated tx:%s", trackedTx.EngineResult)
+	}
+	t.log.Info(
+		ctx,
+		"Submitted XRPL tx is validated",
+		zap.Uint32("operationID", trackedTx.OperationID),
+		zap.String("txHash", trackedTx.TxHash),
+		zap.String("engineResult", trackedTx.EngineResult),
+		zap.String("state", string(trackedTx.State)),
+	)
+
+	return trackedTx, nil
+}
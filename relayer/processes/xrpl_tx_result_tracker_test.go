@@ -0,0 +1,249 @@
+package processes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestXRPLTxResultTracker_Track(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		engineResult rippledata.TransactionResult
+		wantTracked  bool
+	}{
+		{name: "tes", engineResult: rippledata.TesSUCCESS, wantTracked: true},
+		{name: "tec", engineResult: rippledata.TecUNFUNDED_PAYMENT, wantTracked: true},
+		{name: "ter_queued", engineResult: rippledata.TerQUEUED, wantTracked: true},
+		{name: "ter", engineResult: rippledata.TerPRE_SEQ, wantTracked: false},
+		{name: "tem", engineResult: rippledata.TemBAD_SIGNATURE, wantTracked: false},
+		{name: "tel", engineResult: rippledata.TelINSUF_FEE_P, wantTracked: false},
+		{name: "tef", engineResult: rippledata.TefNO_TICKET, wantTracked: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			storeFilePath := filepath.Join(t.TempDir(), processes.XRPLTxResultStoreFileName)
+			// neither the contract nor the XRPL is requested by the tracking
+			tracker := newXRPLTxResultTracker(
+				t, storeFilePath, NewMockContractClient(ctrl), NewMockXRPLTxResultRPCClient(ctrl),
+			)
+
+			tx := newTrackedTestTx(1)
+			require.NoError(t, tracker.Track(ctx, newTrackedTestOperation(1), tx, xrpl.SubmitResult{
+				EngineResult: tt.engineResult,
+			}))
+			// the repeated submission of the same tx is tracked once
+			require.NoError(t, tracker.Track(ctx, newTrackedTestOperation(1), tx, xrpl.SubmitResult{
+				EngineResult: tt.engineResult,
+			}))
+
+			trackedTxs, err := processes.ReadTrackedXRPLTxs(storeFilePath)
+			require.NoError(t, err)
+			if !tt.wantTracked {
+				require.Empty(t, trackedTxs)
+				return
+			}
+			require.Len(t, trackedTxs, 1)
+			require.Equal(t, uint32(1), trackedTxs[0].OperationID)
+			require.Equal(t, processes.XRPLTxTrackingStatePending, trackedTxs[0].State)
+		})
+	}
+}
+
+func TestXRPLTxResultTracker_CheckResults(t *testing.T) {
+	t.Parallel()
+
+	operation := newTrackedTestOperation(7)
+	tests := []struct {
+		name             string
+		rpcClientBuilder func(ctrl *gomock.Controller) processes.XRPLTxResultRPCClient
+		wantState        processes.XRPLTxTrackingState
+		wantEngineResult string
+	}{
+		{
+			name: "validated_tes",
+			rpcClientBuilder: func(ctrl *gomock.Controller) processes.XRPLTxResultRPCClient {
+				rpcClientMock := NewMockXRPLTxResultRPCClient(ctrl)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), gomock.Any()).Return(newValidatedTxResult(rippledata.TesSUCCESS), nil)
+				return rpcClientMock
+			},
+			wantState:        processes.XRPLTxTrackingStateAccepted,
+			wantEngineResult: rippledata.TesSUCCESS.String(),
+		},
+		{
+			name: "validated_tec",
+			rpcClientBuilder: func(ctrl *gomock.Controller) processes.XRPLTxResultRPCClient {
+				rpcClientMock := NewMockXRPLTxResultRPCClient(ctrl)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), gomock.Any()).Return(
+					newValidatedTxResult(rippledata.TecNO_DST_INSUF_XRP), nil,
+				)
+				return rpcClientMock
+			},
+			wantState:        processes.XRPLTxTrackingStateRejected,
+			wantEngineResult: rippledata.TecNO_DST_INSUF_XRP.String(),
+		},
+		{
+			name: "not_validated",
+			rpcClientBuilder: func(ctrl *gomock.Controller) processes.XRPLTxResultRPCClient {
+				rpcClientMock := NewMockXRPLTxResultRPCClient(ctrl)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), gomock.Any()).Return(xrpl.TxResult{}, newTxNotFoundError())
+				return rpcClientMock
+			},
+			wantState: processes.XRPLTxTrackingStatePending,
+		},
+		{
+			name: "rpc_error",
+			rpcClientBuilder: func(ctrl *gomock.Controller) processes.XRPLTxResultRPCClient {
+				rpcClientMock := NewMockXRPLTxResultRPCClient(ctrl)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), gomock.Any()).Return(xrpl.TxResult{}, errors.New("timeout"))
+				return rpcClientMock
+			},
+			wantState: processes.XRPLTxTrackingStatePending,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			storeFilePath := filepath.Join(t.TempDir(), processes.XRPLTxResultStoreFileName)
+			// the invalid evidence is never sent by the tracker
+			contractClientMock := NewMockContractClient(ctrl)
+			contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{operation}, nil)
+			tracker := newXRPLTxResultTracker(t, storeFilePath, contractClientMock, tt.rpcClientBuilder(ctrl))
+			require.NoError(t, tracker.Track(ctx, operation, newTrackedTestTx(1), xrpl.SubmitResult{}))
+
+			require.NoError(t, tracker.CheckResults(ctx))
+			trackedTxs, err := processes.ReadTrackedXRPLTxs(storeFilePath)
+			require.NoError(t, err)
+			require.Len(t, trackedTxs, 1)
+			require.Equal(t, tt.wantState, trackedTxs[0].State)
+			require.Equal(t, tt.wantEngineResult, trackedTxs[0].EngineResult)
+		})
+	}
+}
+
+func TestXRPLTxResultTracker_ResumeAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	storeFilePath := filepath.Join(t.TempDir(), processes.XRPLTxResultStoreFileName)
+	operation := newTrackedTestOperation(3)
+	completedOperation := newTrackedTestOperation(4)
+
+	// the relayer is stopped right after the submission
+	tracker := newXRPLTxResultTracker(
+		t, storeFilePath, NewMockContractClient(ctrl), NewMockXRPLTxResultRPCClient(ctrl),
+	)
+	tx := newTrackedTestTx(1)
+	require.NoError(t, tracker.Track(ctx, operation, tx, xrpl.SubmitResult{}))
+	require.NoError(t, tracker.Track(ctx, completedOperation, newTrackedTestTx(2), xrpl.SubmitResult{}))
+
+	contractClientMock := NewMockContractClient(ctrl)
+	rpcClientMock := NewMockXRPLTxResultRPCClient(ctrl)
+	gomock.InOrder(
+		// the tx of the operation completed during the restart isn't checked
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{operation}, nil),
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return([]coreum.Operation{operation}, nil),
+		contractClientMock.EXPECT().GetPendingOperations(gomock.Any()).Return(nil, nil),
+	)
+	gomock.InOrder(
+		rpcClientMock.EXPECT().Tx(gomock.Any(), *tx.GetHash()).Return(xrpl.TxResult{}, newTxNotFoundError()),
+		rpcClientMock.EXPECT().Tx(gomock.Any(), *tx.GetHash()).Return(newValidatedTxResult(rippledata.TesSUCCESS), nil),
+	)
+	restartedTracker := newXRPLTxResultTracker(t, storeFilePath, contractClientMock, rpcClientMock)
+
+	require.NoError(t, restartedTracker.CheckResults(ctx))
+	trackedTxs, err := processes.ReadTrackedXRPLTxs(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, trackedTxs, 1)
+	require.Equal(t, processes.XRPLTxTrackingStatePending, trackedTxs[0].State)
+
+	require.NoError(t, restartedTracker.CheckResults(ctx))
+	trackedTxs, err = processes.ReadTrackedXRPLTxs(storeFilePath)
+	require.NoError(t, err)
+	require.Len(t, trackedTxs, 1)
+	require.Equal(t, processes.XRPLTxTrackingStateAccepted, trackedTxs[0].State)
+
+	// the operation is completed by the evidence of the validated tx
+	require.NoError(t, restartedTracker.CheckResults(ctx))
+	trackedTxs, err = processes.ReadTrackedXRPLTxs(storeFilePath)
+	require.NoError(t, err)
+	require.Empty(t, trackedTxs)
+}
+
+func newXRPLTxResultTracker(
+	t *testing.T,
+	storeFilePath string,
+	contractClient processes.ContractClient,
+	rpcClient processes.XRPLTxResultRPCClient,
+) *processes.XRPLTxResultTracker {
+	t.Helper()
+
+	tracker, err := processes.NewXRPLTxResultTracker(
+		processes.DefaultXRPLTxResultTrackerConfig(coreum.GenAccount(), storeFilePath),
+		logger.NewAnyLogMock(gomock.NewController(t)),
+		contractClient,
+		rpcClient,
+		time.Now,
+	)
+	require.NoError(t, err)
+
+	return tracker
+}
+
+func newTrackedTestOperation(ticketSequence uint32) coreum.Operation {
+	return coreum.Operation{
+		TicketSequence: ticketSequence,
+		OperationType: coreum.OperationType{
+			CoreumToXRPLTransfer: &coreum.OperationTypeCoreumToXRPLTransfer{},
+		},
+	}
+}
+
+func newTrackedTestTx(hashByte byte) rippledata.Transaction {
+	var hash rippledata.Hash256
+	hash[0] = hashByte
+	return &rippledata.Payment{
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.PAYMENT,
+			Hash:            hash,
+		},
+	}
+}
+
+func newValidatedTxResult(result rippledata.TransactionResult) xrpl.TxResult {
+	return xrpl.TxResult{
+		Validated: true,
+		TransactionWithMetaData: rippledata.TransactionWithMetaData{
+			MetaData: rippledata.MetaData{
+				TransactionResult: result,
+			},
+		},
+	}
+}
+
+func newTxNotFoundError() error {
+	return errors.Wrap(&xrpl.RPCError{Name: "txnNotFound"}, "failed to call RPC")
+}
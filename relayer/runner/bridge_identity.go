@@ -0,0 +1,126 @@
+//nolint:tagliatelle // yaml spec
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// BridgeIdentityFileName is the name of the file with the identity of the bridge the relayer home is used for.
+const BridgeIdentityFileName = "bridge-identity.yaml"
+
+// ErrBridgeChanged is returned if the relayer home is used for the bridge different from the recorded one.
+var ErrBridgeChanged = errors.New("bridge is changed")
+
+// BridgeIdentity is the identity of the bridge the relayer local state is built for.
+type BridgeIdentity struct {
+	ContractAddress   string `yaml:"contract_address"`
+	CoreumChainID     string `yaml:"coreum_chain_id"`
+	BridgeXRPLAddress string `yaml:"bridge_xrpl_address"`
+}
+
+// ReadBridgeIdentity reads the bridge identity file, nil is returned if the file does not exist.
+func ReadBridgeIdentity(filePath string) (*BridgeIdentity, error) {
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil //nolint:nilnil // nil is expected value
+		}
+		return nil, errors.Wrapf(err, "failed to read bridge identity file, path:%s", filePath)
+	}
+	var identity BridgeIdentity
+	if err := yaml.Unmarshal(fileBytes, &identity); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal bridge identity file, path:%s", filePath)
+	}
+
+	return &identity, nil
+}
+
+// SaveBridgeIdentity writes the bridge identity file.
+func SaveBridgeIdentity(filePath string, identity BridgeIdentity) error {
+	identityBytes, err := yaml.Marshal(identity)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal bridge identity")
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create bridge identity dir, path:%s", filePath)
+	}
+
+	return errors.Wrapf(
+		os.WriteFile(filePath, identityBytes, 0o600), "failed to write bridge identity file, path:%s", filePath,
+	)
+}
+
+// checkBridgeIdentity compares the bridge the relayer is started for with the bridge recorded in the relayer home,
+// and refuses to start if they differ, since the local state built for another bridge must not be used. If the new
+// bridge is accepted, the local state is removed and the new bridge is recorded. The keyrings and the config are kept.
+func checkBridgeIdentity(ctx context.Context, log logger.Logger, cfg Config, identity BridgeIdentity) error {
+	if cfg.BridgeIdentityFilePath == "" {
+		return nil
+	}
+	recordedIdentity, err := ReadBridgeIdentity(cfg.BridgeIdentityFilePath)
+	if err != nil {
+		return err
+	}
+	if recordedIdentity != nil && *recordedIdentity == identity {
+		return nil
+	}
+	if recordedIdentity != nil {
+		if !cfg.AcceptNewBridge {
+			return errors.Wrapf(
+				ErrBridgeChanged,
+				"the relayer home is used for another bridge, recorded:%+v, current:%+v",
+				*recordedIdentity, identity,
+			)
+		}
+		log.Warn(
+			ctx,
+			"Bridge is changed, removing the local state of the recorded bridge",
+			zap.Any("recorded", *recordedIdentity),
+			zap.Any("current", identity),
+		)
+		if err := removeBridgeLocalState(ctx, log, cfg); err != nil {
+			return err
+		}
+	}
+
+	return SaveBridgeIdentity(cfg.BridgeIdentityFilePath, identity)
+}
+
+// removeBridgeLocalState removes the stores, checkpoints and logs built for the bridge. The key usage and evidence
+// audit logs are kept since they record the relayer actions rather than the bridge state.
+func removeBridgeLocalState(ctx context.Context, log logger.Logger, cfg Config) error {
+	filePaths := []string{
+		cfg.XRPL.Scanner.CheckpointFilePath,
+		cfg.Processes.CoreumToXRPLProcess.OperationAgeStoreFilePath,
+		cfg.Processes.CoreumToXRPLProcess.SignatureAggregationStoreFilePath,
+		cfg.Processes.CoreumToXRPLProcess.TxResultStoreFilePath,
+		cfg.Processes.XRPLToCoreumProcess.BlockedDeliveriesStoreFilePath,
+		cfg.Processes.XRPLToCoreumProcess.EvidenceDeadLetterLogFilePath,
+		cfg.Processes.TransferLatency.StoreFilePath,
+		cfg.Processes.TransferHistory.FilePath,
+		cfg.Processes.TicketUsageFilePath,
+		cfg.Notifications.DeadLetterLogFilePath,
+	}
+	for _, filePath := range filePaths {
+		if filePath == "" {
+			continue
+		}
+		if err := os.Remove(filePath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to remove bridge local state file, path:%s", filePath)
+		}
+		log.Info(ctx, "Removed bridge local state file", zap.String("path", filePath))
+	}
+
+	return nil
+}
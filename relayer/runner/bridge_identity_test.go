@@ -0,0 +1,92 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestCheckBridgeIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	log := logger.NewAnyLogMock(gomock.NewController(t))
+	home := t.TempDir()
+
+	cfg := DefaultConfig()
+	cfg.BridgeIdentityFilePath = filepath.Join(home, BridgeIdentityFileName)
+	cfg.XRPL.Scanner.CheckpointFilePath = filepath.Join(home, "checkpoint.yaml")
+	cfg.Processes.CoreumToXRPLProcess.SignatureAggregationStoreFilePath = filepath.Join(home, "signatures.yaml")
+	cfg.Processes.TransferHistory.FilePath = filepath.Join(home, "history.jsonl")
+	// the files which must be kept
+	keptFilePaths := []string{
+		filepath.Join(home, ConfigFileName),
+		filepath.Join(home, "keyring-coreum", "relayer.info"),
+		filepath.Join(home, "key-usage-audit.jsonl"),
+	}
+	stateFilePaths := []string{
+		cfg.XRPL.Scanner.CheckpointFilePath,
+		cfg.Processes.CoreumToXRPLProcess.SignatureAggregationStoreFilePath,
+		cfg.Processes.TransferHistory.FilePath,
+	}
+	for _, filePath := range append(keptFilePaths, stateFilePaths...) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o700))
+		require.NoError(t, os.WriteFile(filePath, []byte("data"), 0o600))
+	}
+
+	identity := BridgeIdentity{
+		ContractAddress:   "devcore14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sd4f0ak",
+		CoreumChainID:     "coreum-devnet-1",
+		BridgeXRPLAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+	}
+
+	// the first start records the bridge
+	require.NoError(t, checkBridgeIdentity(ctx, log, cfg, identity))
+	recordedIdentity, err := ReadBridgeIdentity(cfg.BridgeIdentityFilePath)
+	require.NoError(t, err)
+	require.Equal(t, &identity, recordedIdentity)
+
+	// the same bridge is accepted
+	require.NoError(t, checkBridgeIdentity(ctx, log, cfg, identity))
+
+	// the contract is switched
+	newIdentity := identity
+	newIdentity.ContractAddress = "devcore1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrq8cgtaf"
+	err = checkBridgeIdentity(ctx, log, cfg, newIdentity)
+	require.ErrorIs(t, err, ErrBridgeChanged)
+	for _, filePath := range append(keptFilePaths, stateFilePaths...) {
+		require.FileExists(t, filePath)
+	}
+	recordedIdentity, err = ReadBridgeIdentity(cfg.BridgeIdentityFilePath)
+	require.NoError(t, err)
+	require.Equal(t, &identity, recordedIdentity)
+
+	// the bridge XRPL address is changed
+	newXRPLAddressIdentity := identity
+	newXRPLAddressIdentity.BridgeXRPLAddress = "rnZfuixFVhyAXWZDnYsCGEg2zGtpg4ZjKn"
+	require.ErrorIs(t, checkBridgeIdentity(ctx, log, cfg, newXRPLAddressIdentity), ErrBridgeChanged)
+
+	// the new bridge is accepted
+	cfg.AcceptNewBridge = true
+	require.NoError(t, checkBridgeIdentity(ctx, log, cfg, newIdentity))
+	for _, filePath := range keptFilePaths {
+		require.FileExists(t, filePath)
+	}
+	for _, filePath := range stateFilePaths {
+		require.NoFileExists(t, filePath)
+	}
+	recordedIdentity, err = ReadBridgeIdentity(cfg.BridgeIdentityFilePath)
+	require.NoError(t, err)
+	require.Equal(t, &newIdentity, recordedIdentity)
+
+	// the check is disabled without the file path
+	cfg.AcceptNewBridge = false
+	cfg.BridgeIdentityFilePath = ""
+	require.NoError(t, checkBridgeIdentity(ctx, log, cfg, identity))
+}
@@ -2,6 +2,7 @@
 package runner
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -16,10 +17,12 @@ import (
 
 	toolshttp "github.com/CoreumFoundation/coreum-tools/pkg/http"
 	coreumchainclient "github.com/CoreumFoundation/coreum/v4/pkg/client"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/api"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/metrics"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/processes"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/tracing"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
 )
 
@@ -27,6 +30,15 @@ import (
 type LoggingConfig struct {
 	Level  string `yaml:"level"`
 	Format string `yaml:"format"`
+	// Sampling is applied to the debug and info logs only, the warn and error logs are never sampled.
+	Sampling LoggingSamplingConfig `yaml:"sampling"`
+}
+
+// LoggingSamplingConfig is the config of the repeated logs sampling. The first Initial logs with the same message are
+// written each second and then every Thereafter-th. The Initial 0 disables the sampling.
+type LoggingSamplingConfig struct {
+	Initial    int `yaml:"initial"`
+	Thereafter int `yaml:"thereafter"`
 }
 
 // HTTPClientConfig is http client config.
@@ -42,6 +54,16 @@ type XRPLRPCConfig struct {
 	PageLimit uint32 `yaml:"page_limit"`
 }
 
+// XRPLRPCRoutingConfig is XRPL RPC latency routing config.
+type XRPLRPCRoutingConfig struct {
+	// URLs are the additional XRPL RPC endpoints the requests are routed to along with the rpc.url endpoint, the
+	// empty list disables the routing.
+	URLs                 []string `yaml:"urls"`
+	ProbeIntervalSeconds uint32   `yaml:"probe_interval_seconds"`
+	// LatencyWindowSize is the number of the latest request latencies the endpoint P95 latency is estimated from.
+	LatencyWindowSize uint32 `yaml:"latency_window_size"`
+}
+
 // XRPLScannerConfig is XRPL scanner config.
 type XRPLScannerConfig struct {
 	RecentScanEnabled bool  `yaml:"recent_scan_enabled"`
@@ -52,18 +74,64 @@ type XRPLScannerConfig struct {
 	RepeatFullScan  bool `yaml:"repeat_full_scan"`
 
 	RetryDelay time.Duration `yaml:"retry_delay"`
+
+	// CheckpointFilePath is the path of the recent scan checkpoint, it's set from the relayer home.
+	CheckpointFilePath string `yaml:"-"`
+}
+
+// XRPLSubmissionMonitorConfig is the config of the monitor of the XRPL transactions submitted by the client.
+type XRPLSubmissionMonitorConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval"`
+	// LastLedgerSequenceOffset is the number of ledgers after the latest validated ledger the transaction must be
+	// validated within, otherwise it's treated as dropped and re-submitted.
+	LastLedgerSequenceOffset uint32 `yaml:"last_ledger_sequence_offset"`
+	// MaxResubmissions is the max number of the re-submissions of the dropped transaction.
+	MaxResubmissions int `yaml:"max_resubmissions"`
 }
 
 // XRPLConfig is XRPL config.
 type XRPLConfig struct {
-	MultiSignerKeyName string            `yaml:"multi_signer_key_name"`
-	HTTPClient         HTTPClientConfig  `yaml:"http_client"`
-	RPC                XRPLRPCConfig     `yaml:"rpc"`
-	Scanner            XRPLScannerConfig `yaml:"scanner"`
+	MultiSignerKeyName string `yaml:"multi_signer_key_name"`
+	// KeyringBackend is the backend of the XRPL keyring, the keyring-backend flag value is used if it's empty.
+	KeyringBackend string               `yaml:"keyring_backend"`
+	HTTPClient     HTTPClientConfig     `yaml:"http_client"`
+	RPC            XRPLRPCConfig        `yaml:"rpc"`
+	RPCRouting     XRPLRPCRoutingConfig `yaml:"rpc_routing"`
+	Scanner        XRPLScannerConfig    `yaml:"scanner"`
+	// SubmissionMonitor is applied to the transactions submitted by the client, the multi-signed relayer operation
+	// transactions aren't re-submitted by it.
+	SubmissionMonitor XRPLSubmissionMonitorConfig `yaml:"submission_monitor"`
+	// FullHistoryRPCURL is the URL of the full history node used to backfill the ledgers pruned by the RPC node before
+	// they are scanned, the empty URL disables the backfill.
+	FullHistoryRPCURL string `yaml:"full_history_rpc_url"`
+	// MaxAMMSlippageBPS is the max slippage in basis points of the client payment routed to the bridge through the
+	// token/XRP AMM pool, the routing is applied only to the sends which opt in for it.
+	MaxAMMSlippageBPS uint32 `yaml:"max_amm_slippage_bps"`
+	// EnableCheckCashing enables the cashing of the XRPL Checks created for the bridge account, the cashed amount is
+	// transferred to the Coreum recipient from the CheckCreate memo.
+	EnableCheckCashing bool `yaml:"enable_check_cashing"`
 }
 
 // CoreumGRPCConfig is coreum GRPC config.
 type CoreumGRPCConfig struct {
+	URL     string                  `yaml:"url"`
+	Routing CoreumGRPCRoutingConfig `yaml:"routing"`
+}
+
+// CoreumGRPCRoutingConfig is coreum GRPC health-based routing config.
+type CoreumGRPCRoutingConfig struct {
+	// URLs are the additional GRPC endpoints the requests are routed to along with the grpc.url endpoint, the empty
+	// list disables the routing.
+	URLs                 []string `yaml:"urls"`
+	ProbeIntervalSeconds uint32   `yaml:"probe_interval_seconds"`
+	// MaxBlockAgeSeconds is the max age of the endpoint latest block for the endpoint to be healthy.
+	MaxBlockAgeSeconds uint32 `yaml:"max_block_age_seconds"`
+	// ErrorThreshold is the number of the consecutive connectivity errors after which the endpoint is failed over.
+	ErrorThreshold uint32 `yaml:"error_threshold"`
+}
+
+// CoreumRPCConfig is coreum Tendermint RPC config.
+type CoreumRPCConfig struct {
 	URL string `yaml:"url"`
 }
 
@@ -84,36 +152,191 @@ type CoreumContractConfig struct {
 	RequestTimeout       time.Duration `yaml:"request_timeout"`
 	TxTimeout            time.Duration `yaml:"tx_timeout"`
 	TxStatusPollInterval time.Duration `yaml:"tx_status_poll_interval"`
+	// TxBroadcastTimeoutSeconds limits the time of each contract tx broadcast.
+	TxBroadcastTimeoutSeconds uint32 `yaml:"tx_broadcast_timeout_seconds"`
+	// GasPrices are the fixed gas prices of the contract txs, e.g. 0.0625ucore, the chain min gas price is used if
+	// empty.
+	GasPrices string `yaml:"gas_prices"`
+	// FeeGranter is the address of the account which sponsors the fees of the contract txs, the sender pays the fees
+	// if empty.
+	FeeGranter string `yaml:"fee_granter"`
+	// the TTLs of the contract queries cached by the relayer processes, zero disables the caching
+	ContractConfigCacheTTLMs    uint32 `yaml:"contract_config_cache_ttl_ms"`
+	AvailableTicketsCacheTTLMs  uint32 `yaml:"available_tickets_cache_ttl_ms"`
+	PendingOperationsCacheTTLMs uint32 `yaml:"pending_operations_cache_ttl_ms"`
+	TokensCacheTTLMs            uint32 `yaml:"tokens_cache_ttl_ms"`
 }
 
 // CoreumConfig is coreum config.
 type CoreumConfig struct {
-	RelayerKeyName string               `yaml:"relayer_key_name"`
+	RelayerKeyName string `yaml:"relayer_key_name"`
+	// KeyringBackend is the backend of the Coreum keyring, the keyring-backend flag value is used if it's empty.
+	KeyringBackend string               `yaml:"keyring_backend"`
 	GRPC           CoreumGRPCConfig     `yaml:"grpc"`
+	RPC            CoreumRPCConfig      `yaml:"rpc"`
 	Network        CoreumNetworkConfig  `yaml:"network"`
 	Contract       CoreumContractConfig `yaml:"contract"`
+	// EventSource defines how the new contract txs are observed, the websocket source requires the RPC URL.
+	EventSource coreum.EventSource `yaml:"event_source"`
+}
+
+// TransferRateLimitConfig is the Coreum to XRPL transfers rate limit config of the token.
+type TransferRateLimitConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Currency string `yaml:"currency"`
+	// MaxAmountPerHour is the XRPL value, e.g. "1000.5".
+	MaxAmountPerHour string `yaml:"max_amount_per_hour"`
+}
+
+// XRPLToCoreumProcessConfig is XRPLToCoreumProcess config.
+type XRPLToCoreumProcessConfig struct {
+	// AuditLogPath is the path of the evidence submissions audit log, the empty path disables the audit log.
+	AuditLogPath string `yaml:"audit_log_path"`
+	// AuditLogMaxSizeMB is the audit log file size in megabytes after which the file is rotated.
+	AuditLogMaxSizeMB uint32 `yaml:"audit_log_max_size_mb"`
+	// AuditLogMaxBackups is the number of the rotated audit log files to retain.
+	AuditLogMaxBackups uint32 `yaml:"audit_log_max_backups"`
+	// BlockedDeliveryRetryDelay is the delay between the attempts of the deliveries blocked by the asset FT rules.
+	BlockedDeliveryRetryDelay time.Duration `yaml:"blocked_delivery_retry_delay"`
+	// BlockedDeliveriesStoreFilePath is the path of the blocked deliveries store, it's set from the relayer home.
+	BlockedDeliveriesStoreFilePath string `yaml:"-"`
+	// MaxEvidenceRetries is the number of the retries of the failed evidence submission after which the XRPL tx is
+	// written to the dead-letter log.
+	MaxEvidenceRetries uint32 `yaml:"max_evidence_retries"`
+	// EvidenceDeadLetterLogFilePath is the path of the evidence dead-letter log, it's set from the relayer home.
+	EvidenceDeadLetterLogFilePath string `yaml:"-"`
+	// IBCTransferPollInterval is the interval between the checks of the IBC transfers of the tokens with the IBC
+	// delivery mode, the failed and timed out transfers are refunded to the Coreum recipients.
+	IBCTransferPollInterval time.Duration `yaml:"ibc_transfer_poll_interval"`
 }
 
 // CoreumToXRPLProcessConfig is CoreumToXRPLProcess config.
 type CoreumToXRPLProcessConfig struct {
 	RepeatDelay time.Duration `yaml:"repeat_delay"`
+	// VerifyBeforeSubmit enables the local verification of the relayer signature before it's saved to the contract,
+	// the signature which fails the verification isn't saved.
+	VerifyBeforeSubmit bool `yaml:"verify_before_submit"`
+	// RateLimits limit the Coreum to XRPL transfers signed by the relayer, the tokens without the limit aren't limited.
+	RateLimits []TransferRateLimitConfig `yaml:"rate_limits"`
+	// MaxPendingOperationAge is the age of the pending operation after which the relayer warns that it's stuck.
+	MaxPendingOperationAge time.Duration `yaml:"max_pending_operation_age"`
+	// OperationAgeStoreFilePath is the path of the pending operations first-seen time store, it's set from the
+	// relayer home, and the empty path disables the persistence.
+	OperationAgeStoreFilePath string `yaml:"-"`
+	// SignatureAggregationTimeoutHours is the time in hours without a new signature of the pending operation after
+	// which the relayer sends the invalid transaction result evidence to abandon the operation and free its ticket,
+	// zero disables the timeout.
+	SignatureAggregationTimeoutHours uint32 `yaml:"signature_aggregation_timeout_hours"`
+	// SignatureAggregationStoreFilePath is the path of the pending operations signature aggregation store, it's set
+	// from the relayer home, and the empty path disables the persistence.
+	SignatureAggregationStoreFilePath string `yaml:"-"`
+	// TxResultPollInterval is the interval of the submitted XRPL txs results check.
+	TxResultPollInterval time.Duration `yaml:"tx_result_poll_interval"`
+	// TxResultStoreFilePath is the path of the submitted XRPL txs store, it's set from the relayer home, and the empty
+	// path disables the persistence.
+	TxResultStoreFilePath string `yaml:"-"`
+}
+
+// MinBridgeAmountConfig is the min bridge amount config of the token. The token is defined either by the denom, or by
+// the issuer and currency.
+type MinBridgeAmountConfig struct {
+	// Denom is the Coreum denom, the Amount is in the denom units and applied to the Coreum to XRPL transfers.
+	Denom    string `yaml:"denom,omitempty"`
+	Issuer   string `yaml:"issuer,omitempty"`
+	Currency string `yaml:"currency,omitempty"`
+	// Amount is the denom units amount, e.g. "1000000", or the XRPL value, e.g. "0.5", for the issuer and currency.
+	Amount string `yaml:"amount"`
+}
+
+// MinBridgeAmountsConfig is the config of the min amounts of the bridged tokens, the amounts below the min are
+// rejected by the client and skipped by the relayer.
+type MinBridgeAmountsConfig struct {
+	// DefaultCoreumAmount is applied to the denoms without the config, the empty value means no min amount.
+	DefaultCoreumAmount string `yaml:"default_coreum_amount"`
+	// DefaultXRPLAmount is applied to the XRPL tokens without the config, the empty value means no min amount.
+	DefaultXRPLAmount string                  `yaml:"default_xrpl_amount"`
+	Tokens            []MinBridgeAmountConfig `yaml:"tokens"`
+}
+
+// TransferLatencyConfig is the transfers latency tracking config.
+type TransferLatencyConfig struct {
+	// PollInterval is the delay between the scans of the new Coreum blocks for the transfer txs.
+	PollInterval time.Duration `yaml:"poll_interval"`
+	// MaxRecordAge is the time after which the not completed transfer is not tracked anymore.
+	MaxRecordAge time.Duration `yaml:"max_record_age"`
+	// StoreFilePath is the path of the transfers latency store, it's set from the relayer home.
+	StoreFilePath string `yaml:"-"`
+}
+
+// TransferHistoryConfig is the config of the history of the transfers the relayer has provided the evidences for.
+type TransferHistoryConfig struct {
+	// RetentionDays is the number of days the history records are kept for, the older records are pruned on the
+	// relayer start.
+	RetentionDays uint32 `yaml:"retention_days"`
+	// FilePath is the path of the transfers history file, it's set from the relayer home.
+	FilePath string `yaml:"-"`
+}
+
+// SupervisorConfig is the config of the supervisor restarting the panicked processes.
+type SupervisorConfig struct {
+	// MaxRestarts is the max number of the restarts of the panicked process within the restart window, the process
+	// panicked once more is not restarted and the relayer exits.
+	MaxRestarts          int    `yaml:"max_restarts"`
+	RestartWindowSeconds uint32 `yaml:"restart_window_seconds"`
+	// InitialBackoff is the delay before the first restart, the delay is doubled on each next restart within the
+	// restart window.
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+// RefundsConfig is the config of the refund claims relaying.
+type RefundsConfig struct {
+	// AutoRelayFor are the Coreum addresses the relayer claims the pending refunds for, if the address has granted
+	// the relayer the contract execution via authz. The unclaimed refunds of the rest addresses are reported only.
+	AutoRelayFor []string `yaml:"auto_relay_for"`
+	// AutoRelayForAll enables the relaying for all addresses which have granted the relayer the contract execution.
+	AutoRelayForAll bool          `yaml:"auto_relay_for_all"`
+	PollInterval    time.Duration `yaml:"poll_interval"`
+	// UnclaimedAgeThreshold is the age of the pending refund after which it's reported as unclaimed.
+	UnclaimedAgeThreshold time.Duration `yaml:"unclaimed_age_threshold"`
+}
+
+// Enabled returns true if the refund claims relaying is enabled.
+func (c RefundsConfig) Enabled() bool {
+	return len(c.AutoRelayFor) > 0 || c.AutoRelayForAll
 }
 
 // ProcessesConfig  is processes config.
 type ProcessesConfig struct {
+	XRPLToCoreumProcess XRPLToCoreumProcessConfig `yaml:"xrpl_to_coreum"`
 	CoreumToXRPLProcess CoreumToXRPLProcessConfig `yaml:"coreum_to_xrpl"`
+	TransferLatency     TransferLatencyConfig     `yaml:"transfer_latency"`
+	TransferHistory     TransferHistoryConfig     `yaml:"transfer_history"`
+	Supervisor          SupervisorConfig          `yaml:"supervisor"`
 	RetryDelay          time.Duration             `yaml:"retry_delay"`
-	ExitOnError         bool                      `yaml:"-"`
+	// ShutdownTimeoutSeconds is the time the in-flight Coreum txs are allowed to complete on the relayer shutdown.
+	ShutdownTimeoutSeconds uint32 `yaml:"shutdown_timeout_seconds"`
+	// StartupTimeoutSeconds is the time the XRPL and Coreum chains are allowed to become ready on the relayer start.
+	StartupTimeoutSeconds uint32 `yaml:"startup_timeout_seconds"`
+	ExitOnError           bool   `yaml:"-"`
+	// TicketUsageFilePath is the path of the consumed tickets log, it's set from the relayer home.
+	TicketUsageFilePath string `yaml:"-"`
 }
 
 // MetricsServerConfig is metric server config.
 type MetricsServerConfig struct {
 	ListenAddress string `yaml:"listen_address"`
+	// BlockProcessingStalenessThresholdSeconds is the time without the processed XRPL ledgers after which the /readyz
+	// endpoint returns 503, zero disables the check.
+	BlockProcessingStalenessThresholdSeconds uint32 `yaml:"block_processing_staleness_threshold_seconds"`
 }
 
 // MetricsPeriodicCollectorConfig is metric periodic collector config.
 type MetricsPeriodicCollectorConfig struct {
 	RepeatDelay time.Duration `yaml:"repeat_delay"`
+	// XRPLSpendableWarningBufferDrops is the XRPL account balance above the reserve in drops, the lower balance of
+	// the bridge or relayer account is logged as a warning.
+	XRPLSpendableWarningBufferDrops int64 `yaml:"xrpl_spendable_warning_buffer_drops"`
 }
 
 // MetricsConfig is metric config.
@@ -123,38 +346,127 @@ type MetricsConfig struct {
 	PeriodicCollector MetricsPeriodicCollectorConfig `yaml:"periodic_collector"`
 }
 
+// APIRateLimitConfig is the API per client IP rate limit config.
+type APIRateLimitConfig struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+// APIConfig is the read-only API config, the API is started by the serve-api command.
+type APIConfig struct {
+	ListenAddress string `yaml:"listen_address"`
+	// CORSAllowedOrigins are the origins allowed to call the API from the browser, "*" allows any origin.
+	CORSAllowedOrigins []string           `yaml:"cors_allowed_origins"`
+	RateLimit          APIRateLimitConfig `yaml:"rate_limit"`
+}
+
+// KeyUsageAuditConfig is the config of the audit log of the XRPL signatures and Coreum txs produced by the relayer
+// keys.
+type KeyUsageAuditConfig struct {
+	// Disabled disables the audit log, it's enabled by default.
+	Disabled bool `yaml:"disabled"`
+	// FilePath is the path of the audit log, it's set from the relayer home.
+	FilePath string `yaml:"-"`
+}
+
+// NotificationsConfig is the config of the webhooks posted on the XRPL to Coreum transfers to the watched addresses.
+type NotificationsConfig struct {
+	// WebhookURLs are the URLs the webhooks are posted to, the empty list disables the notifications.
+	WebhookURLs []string `yaml:"webhook_urls"`
+	// WebhookSecret is the shared secret of the HMAC-SHA256 signature of the webhook body.
+	WebhookSecret string `yaml:"webhook_secret"`
+	// WatchedAddresses are the Coreum addresses which incoming transfers are notified.
+	WatchedAddresses  []string      `yaml:"watched_addresses"`
+	QueueSize         int           `yaml:"queue_size"`
+	MaxAttempts       uint32        `yaml:"max_attempts"`
+	InitialRetryDelay time.Duration `yaml:"initial_retry_delay"`
+	MaxRetryDelay     time.Duration `yaml:"max_retry_delay"`
+	RequestTimeout    time.Duration `yaml:"request_timeout"`
+	RequestsPerSecond float64       `yaml:"requests_per_second"`
+	// DeadLetterLogFilePath is the path of the undeliverable webhooks log, it's set from the relayer home.
+	DeadLetterLogFilePath string `yaml:"-"`
+}
+
+// Enabled returns true if the notifications are enabled.
+func (c NotificationsConfig) Enabled() bool {
+	return len(c.WebhookURLs) > 0
+}
+
 // Config is runner config.
 type Config struct {
-	Version       string          `yaml:"version"`
+	// ConfigVersion is the version of the config schema, the configs of the older versions are migrated on read.
+	ConfigVersion int             `yaml:"config_version"`
 	LoggingConfig LoggingConfig   `yaml:"logging"`
 	XRPL          XRPLConfig      `yaml:"xrpl"`
 	Coreum        CoreumConfig    `yaml:"coreum"`
 	Processes     ProcessesConfig `yaml:"processes"`
-	Metrics       MetricsConfig   `yaml:"metrics"`
+	// MinBridgeAmounts are applied by the relayer and the client.
+	MinBridgeAmounts MinBridgeAmountsConfig `yaml:"min_bridge_amounts"`
+	// Refunds relaying is disabled by default.
+	Refunds RefundsConfig `yaml:"refunds"`
+	Metrics MetricsConfig `yaml:"metrics"`
+	API     APIConfig     `yaml:"api"`
+	// Notifications are disabled by default.
+	Notifications NotificationsConfig `yaml:"notifications"`
+	KeyUsageAudit KeyUsageAuditConfig `yaml:"key_usage_audit"`
+	// TracingEndpoint is the OTLP HTTP endpoint the traces of the XRPL to Coreum transfers are exported to, e.g.
+	// http://localhost:4318 of the Jaeger collector, the empty endpoint disables the tracing.
+	TracingEndpoint    string `yaml:"tracing_endpoint"`
+	TracingServiceName string `yaml:"tracing_service_name"`
+	// BridgeIdentityFilePath is the path of the bridge identity file, it's set from the relayer home, and the empty
+	// path disables the bridge change detection.
+	BridgeIdentityFilePath string `yaml:"-"`
+	// AcceptNewBridge allows starting the relayer for the bridge different from the recorded one, the local state of
+	// the recorded bridge is removed.
+	AcceptNewBridge bool `yaml:"-"`
 }
 
 // DefaultConfig returns default runner config.
 func DefaultConfig() Config {
 	defaultXRPLRPCfg := xrpl.DefaultRPCClientConfig("")
+	defaultXRPLLatencyRouterCfg := xrpl.DefaultLatencyRouterConfig(nil)
+	defaultCoreumGRPCRouterCfg := coreum.DefaultGRPCRouterConfig(nil)
 	defaultXRPLAccountScannerCfg := xrpl.DefaultAccountScannerConfig(rippledata.Account{})
+	defaultXRPLSubmissionMonitorCfg := xrpl.DefaultSubmissionMonitorConfig()
+	defaultXRPLAMMRouterCfg := xrpl.DefaultAMMRouterConfig()
 
 	defaultCoreumContactConfig := coreum.DefaultContractClientConfig(sdk.AccAddress(nil))
+	defaultCachingContractClientConfig := coreum.DefaultCachingContractClientConfig()
 	defaultClientCtxDefaultCfg := coreumchainclient.DefaultContextConfig()
 
 	defaultProcessConfig := processes.DefaultProcessConfig(
 		rippledata.Account{},
 		sdk.AccAddress(nil),
 	)
+	defaultEvidenceAuditLogConfig := processes.DefaultEvidenceAuditLogConfig("")
+	defaultBlockedDeliveryQueueConfig := processes.DefaultBlockedDeliveryQueueConfig(
+		rippledata.Account{},
+		sdk.AccAddress(nil),
+		"",
+	)
+	defaultEvidenceRetryQueueConfig := processes.DefaultEvidenceRetryQueueConfig("")
+	defaultTransferLatencyTrackerConfig := processes.DefaultTransferLatencyTrackerConfig("")
+	defaultTransferWebhookNotifierConfig := processes.DefaultTransferWebhookNotifierConfig("")
+	defaultTransferHistoryConfig := processes.DefaultTransferHistoryConfig("")
+	defaultXRPLTxResultTrackerConfig := processes.DefaultXRPLTxResultTrackerConfig(sdk.AccAddress(nil), "")
+	defaultIBCTransferTrackerConfig := processes.DefaultIBCTransferTrackerConfig(sdk.AccAddress(nil))
+	defaultRefundRelayerConfig := processes.DefaultRefundRelayerConfig(sdk.AccAddress(nil))
 	defaultLoggerConfig := logger.DefaultZapLoggerConfig()
+	defaultOTelConfig := tracing.DefaultOTelConfig()
 
 	defaultMetricsServerConfig := metrics.DefaultServerConfig()
 	defaultMetricsPeriodicCollectorConfig := metrics.DefaultPeriodicCollectorConfig()
+	defaultAPIServerConfig := api.DefaultServerConfig()
 
 	return Config{
-		Version: configVersion,
+		ConfigVersion: LatestConfigVersion,
 		LoggingConfig: LoggingConfig{
 			Level:  defaultLoggerConfig.Level,
 			Format: defaultLoggerConfig.Format,
+			Sampling: LoggingSamplingConfig{
+				Initial:    defaultLoggerConfig.Sampling.Initial,
+				Thereafter: defaultLoggerConfig.Sampling.Thereafter,
+			},
 		},
 		XRPL: XRPLConfig{
 			// empty be default
@@ -165,6 +477,12 @@ func DefaultConfig() Config {
 				URL:       "",
 				PageLimit: defaultXRPLRPCfg.PageLimit,
 			},
+			RPCRouting: XRPLRPCRoutingConfig{
+				// empty be default
+				URLs:                 make([]string, 0),
+				ProbeIntervalSeconds: uint32(defaultXRPLLatencyRouterCfg.ProbeInterval.Seconds()),
+				LatencyWindowSize:    uint32(defaultXRPLLatencyRouterCfg.WindowSize),
+			},
 			Scanner: XRPLScannerConfig{
 				RecentScanEnabled: defaultXRPLAccountScannerCfg.RecentScanEnabled,
 				RecentScanWindow:  defaultXRPLAccountScannerCfg.RecentScanWindow,
@@ -173,6 +491,12 @@ func DefaultConfig() Config {
 				RepeatFullScan:    defaultXRPLAccountScannerCfg.RepeatFullScan,
 				RetryDelay:        defaultXRPLAccountScannerCfg.RetryDelay,
 			},
+			SubmissionMonitor: XRPLSubmissionMonitorConfig(defaultXRPLSubmissionMonitorCfg),
+			// empty be default
+			FullHistoryRPCURL: "",
+			MaxAMMSlippageBPS: defaultXRPLAMMRouterCfg.MaxSlippageBPS,
+			// disabled by default
+			EnableCheckCashing: false,
 		},
 
 		Coreum: CoreumConfig{
@@ -180,6 +504,17 @@ func DefaultConfig() Config {
 			GRPC: CoreumGRPCConfig{
 				// empty be default
 				URL: "",
+				Routing: CoreumGRPCRoutingConfig{
+					// empty be default
+					URLs:                 make([]string, 0),
+					ProbeIntervalSeconds: uint32(defaultCoreumGRPCRouterCfg.ProbeInterval.Seconds()),
+					MaxBlockAgeSeconds:   uint32(defaultCoreumGRPCRouterCfg.MaxBlockAge.Seconds()),
+					ErrorThreshold:       uint32(defaultCoreumGRPCRouterCfg.ErrorThreshold),
+				},
+			},
+			RPC: CoreumRPCConfig{
+				// empty be default
+				URL: "",
 			},
 			Network: CoreumNetworkConfig{
 				ChainID: string(DefaultCoreumChainID),
@@ -196,25 +531,116 @@ func DefaultConfig() Config {
 				RequestTimeout:       defaultClientCtxDefaultCfg.TimeoutConfig.RequestTimeout,
 				TxTimeout:            defaultClientCtxDefaultCfg.TimeoutConfig.TxTimeout,
 				TxStatusPollInterval: defaultClientCtxDefaultCfg.TimeoutConfig.TxStatusPollInterval,
+
+				TxBroadcastTimeoutSeconds: uint32(defaultCoreumContactConfig.TxBroadcastTimeout.Seconds()),
+
+				ContractConfigCacheTTLMs:    uint32(defaultCachingContractClientConfig.ContractConfigTTL.Milliseconds()),
+				AvailableTicketsCacheTTLMs:  uint32(defaultCachingContractClientConfig.AvailableTicketsTTL.Milliseconds()),
+				PendingOperationsCacheTTLMs: uint32(defaultCachingContractClientConfig.PendingOperationsTTL.Milliseconds()),
+				TokensCacheTTLMs:            uint32(defaultCachingContractClientConfig.TokensTTL.Milliseconds()),
 			},
+			EventSource: coreum.EventSourcePoll,
 		},
 
 		Processes: ProcessesConfig{
+			XRPLToCoreumProcess: XRPLToCoreumProcessConfig{
+				// empty be default
+				AuditLogPath:              "",
+				AuditLogMaxSizeMB:         uint32(defaultEvidenceAuditLogConfig.MaxSizeBytes / bytesInMB),
+				AuditLogMaxBackups:        uint32(defaultEvidenceAuditLogConfig.MaxBackups),
+				BlockedDeliveryRetryDelay: defaultBlockedDeliveryQueueConfig.RetryDelay,
+				MaxEvidenceRetries:        defaultEvidenceRetryQueueConfig.MaxRetries,
+				IBCTransferPollInterval:   defaultIBCTransferTrackerConfig.PollInterval,
+			},
 			CoreumToXRPLProcess: CoreumToXRPLProcessConfig{
-				RepeatDelay: defaultProcessConfig.CoreumToXRPL.RepeatDelay,
+				RepeatDelay:            defaultProcessConfig.CoreumToXRPL.RepeatDelay,
+				VerifyBeforeSubmit:     defaultProcessConfig.CoreumToXRPL.VerifyBeforeSubmit,
+				RateLimits:             make([]TransferRateLimitConfig, 0),
+				MaxPendingOperationAge: processes.DefaultOperationAgeTrackerConfig("").MaxAge,
+				TxResultPollInterval:   defaultXRPLTxResultTrackerConfig.PollInterval,
+			},
+			TransferLatency: TransferLatencyConfig{
+				PollInterval: defaultTransferLatencyTrackerConfig.PollInterval,
+				MaxRecordAge: defaultTransferLatencyTrackerConfig.MaxRecordAge,
+			},
+			TransferHistory: TransferHistoryConfig{
+				RetentionDays: uint32(defaultTransferHistoryConfig.Retention / (24 * time.Hour)),
+			},
+			Supervisor: SupervisorConfig{
+				MaxRestarts:          5,
+				RestartWindowSeconds: 600,
+				InitialBackoff:       time.Second,
+				MaxBackoff:           time.Minute,
 			},
-			RetryDelay: defaultProcessConfig.RetryDelay,
+			RetryDelay:             defaultProcessConfig.RetryDelay,
+			ShutdownTimeoutSeconds: 30,
+			StartupTimeoutSeconds:  60,
+		},
+
+		MinBridgeAmounts: MinBridgeAmountsConfig{
+			// empty be default
+			DefaultCoreumAmount: "",
+			DefaultXRPLAmount:   "",
+			Tokens:              make([]MinBridgeAmountConfig, 0),
+		},
+
+		Refunds: RefundsConfig{
+			// empty be default
+			AutoRelayFor:          make([]string, 0),
+			AutoRelayForAll:       false,
+			PollInterval:          defaultRefundRelayerConfig.PollInterval,
+			UnclaimedAgeThreshold: defaultRefundRelayerConfig.UnclaimedAgeThreshold,
 		},
 
 		Metrics: MetricsConfig{
 			Enabled: false,
 			Server: MetricsServerConfig{
 				ListenAddress: defaultMetricsServerConfig.ListenAddress,
+				BlockProcessingStalenessThresholdSeconds: uint32(
+					defaultMetricsServerConfig.BlockProcessingStalenessThreshold / time.Second,
+				),
 			},
 			PeriodicCollector: MetricsPeriodicCollectorConfig{
-				RepeatDelay: defaultMetricsPeriodicCollectorConfig.RepeatDelay,
+				RepeatDelay:                     defaultMetricsPeriodicCollectorConfig.RepeatDelay,
+				XRPLSpendableWarningBufferDrops: defaultMetricsPeriodicCollectorConfig.XRPLSpendableWarningBufferDrops,
+			},
+		},
+
+		API: APIConfig{
+			ListenAddress:      defaultAPIServerConfig.ListenAddress,
+			CORSAllowedOrigins: make([]string, 0),
+			RateLimit: APIRateLimitConfig{
+				RequestsPerSecond: defaultAPIServerConfig.RateLimit.RequestsPerSecond,
+				Burst:             defaultAPIServerConfig.RateLimit.Burst,
 			},
 		},
+
+		Notifications: NotificationsConfig{
+			WebhookURLs:       make([]string, 0),
+			WatchedAddresses:  make([]string, 0),
+			QueueSize:         defaultTransferWebhookNotifierConfig.QueueSize,
+			MaxAttempts:       defaultTransferWebhookNotifierConfig.MaxAttempts,
+			InitialRetryDelay: defaultTransferWebhookNotifierConfig.InitialRetryDelay,
+			MaxRetryDelay:     defaultTransferWebhookNotifierConfig.MaxRetryDelay,
+			RequestTimeout:    defaultTransferWebhookNotifierConfig.RequestTimeout,
+			RequestsPerSecond: defaultTransferWebhookNotifierConfig.RequestsPerSecond,
+		},
+		KeyUsageAudit: KeyUsageAuditConfig{
+			Disabled: false,
+		},
+		// empty be default
+		TracingEndpoint:    defaultOTelConfig.Endpoint,
+		TracingServiceName: defaultOTelConfig.ServiceName,
+	}
+}
+
+// NewCachingContractClientConfig returns the caching contract client config built from the runner config.
+func NewCachingContractClientConfig(cfg Config) coreum.CachingContractClientConfig {
+	return coreum.CachingContractClientConfig{
+		ContractConfigTTL:    time.Duration(cfg.Coreum.Contract.ContractConfigCacheTTLMs) * time.Millisecond,
+		AvailableTicketsTTL:  time.Duration(cfg.Coreum.Contract.AvailableTicketsCacheTTLMs) * time.Millisecond,
+		PendingOperationsTTL: time.Duration(cfg.Coreum.Contract.PendingOperationsCacheTTLMs) * time.Millisecond,
+		TokensTTL:            time.Duration(cfg.Coreum.Contract.TokensCacheTTLMs) * time.Millisecond,
 	}
 }
 
@@ -248,7 +674,7 @@ func InitConfig(homePath string, cfg Config) error {
 
 // ReadConfig reads config yaml file.
 func ReadConfig(ctx context.Context, log logger.Logger, homePath string) (Config, error) {
-	config, err := readConfigFromFile(homePath)
+	config, err := readConfigFromFile(ctx, log, homePath)
 	if err != nil {
 		return Config{}, err
 	}
@@ -269,9 +695,472 @@ func setConfigDefaults(ctx context.Context, log logger.Logger, config *Config) {
 		)
 		config.Processes.RetryDelay = defaultRetryDelay
 	}
+	// Set default event_source if the value is not set because of an old config version which doesn't contain it.
+	if config.Coreum.EventSource == "" {
+		defaultEventSource := DefaultConfig().Coreum.EventSource
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"coreum.event_source is not set in %s, using default value: %s",
+				ConfigFileName, defaultEventSource,
+			),
+		)
+		config.Coreum.EventSource = defaultEventSource
+	}
+	// Set default tx_broadcast_timeout_seconds if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Coreum.Contract.TxBroadcastTimeoutSeconds == 0 {
+		defaultTxBroadcastTimeoutSeconds := DefaultConfig().Coreum.Contract.TxBroadcastTimeoutSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"coreum.contract.tx_broadcast_timeout_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultTxBroadcastTimeoutSeconds,
+			),
+		)
+		config.Coreum.Contract.TxBroadcastTimeoutSeconds = defaultTxBroadcastTimeoutSeconds
+	}
+	// Set default probe_interval_seconds and latency_window_size if the values are not set because of an old config
+	// version which doesn't contain them.
+	if config.XRPL.RPCRouting.ProbeIntervalSeconds == 0 {
+		defaultProbeIntervalSeconds := DefaultConfig().XRPL.RPCRouting.ProbeIntervalSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"xrpl.rpc_routing.probe_interval_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultProbeIntervalSeconds,
+			),
+		)
+		config.XRPL.RPCRouting.ProbeIntervalSeconds = defaultProbeIntervalSeconds
+	}
+	if config.XRPL.RPCRouting.LatencyWindowSize == 0 {
+		defaultLatencyWindowSize := DefaultConfig().XRPL.RPCRouting.LatencyWindowSize
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"xrpl.rpc_routing.latency_window_size is not set in %s, using default value: %d",
+				ConfigFileName, defaultLatencyWindowSize,
+			),
+		)
+		config.XRPL.RPCRouting.LatencyWindowSize = defaultLatencyWindowSize
+	}
+	// Set default coreum grpc routing values if they are not set because of an old config version which doesn't
+	// contain them.
+	if config.Coreum.GRPC.Routing.ProbeIntervalSeconds == 0 {
+		defaultProbeIntervalSeconds := DefaultConfig().Coreum.GRPC.Routing.ProbeIntervalSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"coreum.grpc.routing.probe_interval_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultProbeIntervalSeconds,
+			),
+		)
+		config.Coreum.GRPC.Routing.ProbeIntervalSeconds = defaultProbeIntervalSeconds
+	}
+	if config.Coreum.GRPC.Routing.MaxBlockAgeSeconds == 0 {
+		defaultMaxBlockAgeSeconds := DefaultConfig().Coreum.GRPC.Routing.MaxBlockAgeSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"coreum.grpc.routing.max_block_age_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultMaxBlockAgeSeconds,
+			),
+		)
+		config.Coreum.GRPC.Routing.MaxBlockAgeSeconds = defaultMaxBlockAgeSeconds
+	}
+	if config.Coreum.GRPC.Routing.ErrorThreshold == 0 {
+		defaultErrorThreshold := DefaultConfig().Coreum.GRPC.Routing.ErrorThreshold
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"coreum.grpc.routing.error_threshold is not set in %s, using default value: %d",
+				ConfigFileName, defaultErrorThreshold,
+			),
+		)
+		config.Coreum.GRPC.Routing.ErrorThreshold = defaultErrorThreshold
+	}
+	// Set default submission_monitor values if they are not set because of an old config version which doesn't contain
+	// them.
+	if config.XRPL.SubmissionMonitor.PollInterval == 0 {
+		defaultPollInterval := DefaultConfig().XRPL.SubmissionMonitor.PollInterval
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"xrpl.submission_monitor.poll_interval is not set in %s, using default value: %s",
+				ConfigFileName, defaultPollInterval,
+			),
+		)
+		config.XRPL.SubmissionMonitor.PollInterval = defaultPollInterval
+	}
+	if config.XRPL.SubmissionMonitor.LastLedgerSequenceOffset == 0 {
+		defaultLastLedgerSequenceOffset := DefaultConfig().XRPL.SubmissionMonitor.LastLedgerSequenceOffset
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"xrpl.submission_monitor.last_ledger_sequence_offset is not set in %s, using default value: %d",
+				ConfigFileName, defaultLastLedgerSequenceOffset,
+			),
+		)
+		config.XRPL.SubmissionMonitor.LastLedgerSequenceOffset = defaultLastLedgerSequenceOffset
+	}
+	if config.XRPL.SubmissionMonitor.MaxResubmissions == 0 {
+		defaultMaxResubmissions := DefaultConfig().XRPL.SubmissionMonitor.MaxResubmissions
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"xrpl.submission_monitor.max_resubmissions is not set in %s, using default value: %d",
+				ConfigFileName, defaultMaxResubmissions,
+			),
+		)
+		config.XRPL.SubmissionMonitor.MaxResubmissions = defaultMaxResubmissions
+	}
+	// Set default supervisor if it is not set because of an old config version which doesn't contain it.
+	if config.Processes.Supervisor == (SupervisorConfig{}) {
+		defaultSupervisor := DefaultConfig().Processes.Supervisor
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.supervisor is not set in %s, using default value: %+v",
+				ConfigFileName, defaultSupervisor,
+			),
+		)
+		config.Processes.Supervisor = defaultSupervisor
+	}
+	// Set default max_pending_operation_age if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.CoreumToXRPLProcess.MaxPendingOperationAge == 0 {
+		defaultMaxPendingOperationAge := DefaultConfig().Processes.CoreumToXRPLProcess.MaxPendingOperationAge
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.coreum_to_xrpl.max_pending_operation_age is not set in %s, using default value: %s",
+				ConfigFileName, defaultMaxPendingOperationAge,
+			),
+		)
+		config.Processes.CoreumToXRPLProcess.MaxPendingOperationAge = defaultMaxPendingOperationAge
+	}
+	// Set default tx_result_poll_interval if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.CoreumToXRPLProcess.TxResultPollInterval == 0 {
+		defaultTxResultPollInterval := DefaultConfig().Processes.CoreumToXRPLProcess.TxResultPollInterval
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.coreum_to_xrpl.tx_result_poll_interval is not set in %s, using default value: %s",
+				ConfigFileName, defaultTxResultPollInterval,
+			),
+		)
+		config.Processes.CoreumToXRPLProcess.TxResultPollInterval = defaultTxResultPollInterval
+	}
+	// Set default audit_log_max_size_mb if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.XRPLToCoreumProcess.AuditLogMaxSizeMB == 0 {
+		defaultAuditLogMaxSizeMB := DefaultConfig().Processes.XRPLToCoreumProcess.AuditLogMaxSizeMB
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.xrpl_to_coreum.audit_log_max_size_mb is not set in %s, using default value: %d",
+				ConfigFileName, defaultAuditLogMaxSizeMB,
+			),
+		)
+		config.Processes.XRPLToCoreumProcess.AuditLogMaxSizeMB = defaultAuditLogMaxSizeMB
+	}
+	// Set default blocked_delivery_retry_delay if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.XRPLToCoreumProcess.BlockedDeliveryRetryDelay == 0 {
+		defaultBlockedDeliveryRetryDelay := DefaultConfig().Processes.XRPLToCoreumProcess.BlockedDeliveryRetryDelay
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.xrpl_to_coreum.blocked_delivery_retry_delay is not set in %s, using default value: %s",
+				ConfigFileName, defaultBlockedDeliveryRetryDelay,
+			),
+		)
+		config.Processes.XRPLToCoreumProcess.BlockedDeliveryRetryDelay = defaultBlockedDeliveryRetryDelay
+	}
+	// Set default max_evidence_retries if the value is not set because of an old config version which doesn't contain
+	// it.
+	if config.Processes.XRPLToCoreumProcess.MaxEvidenceRetries == 0 {
+		defaultMaxEvidenceRetries := DefaultConfig().Processes.XRPLToCoreumProcess.MaxEvidenceRetries
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.xrpl_to_coreum.max_evidence_retries is not set in %s, using default value: %d",
+				ConfigFileName, defaultMaxEvidenceRetries,
+			),
+		)
+		config.Processes.XRPLToCoreumProcess.MaxEvidenceRetries = defaultMaxEvidenceRetries
+	}
+	// Set default ibc_transfer_poll_interval if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.XRPLToCoreumProcess.IBCTransferPollInterval == 0 {
+		defaultIBCTransferPollInterval := DefaultConfig().Processes.XRPLToCoreumProcess.IBCTransferPollInterval
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.xrpl_to_coreum.ibc_transfer_poll_interval is not set in %s, using default value: %s",
+				ConfigFileName, defaultIBCTransferPollInterval,
+			),
+		)
+		config.Processes.XRPLToCoreumProcess.IBCTransferPollInterval = defaultIBCTransferPollInterval
+	}
+	// Set default transfer_latency if the values are not set because of an old config version which doesn't
+	// contain them.
+	if config.Processes.TransferLatency.PollInterval == 0 {
+		defaultPollInterval := DefaultConfig().Processes.TransferLatency.PollInterval
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.transfer_latency.poll_interval is not set in %s, using default value: %s",
+				ConfigFileName, defaultPollInterval,
+			),
+		)
+		config.Processes.TransferLatency.PollInterval = defaultPollInterval
+	}
+	if config.Processes.TransferLatency.MaxRecordAge == 0 {
+		defaultMaxRecordAge := DefaultConfig().Processes.TransferLatency.MaxRecordAge
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.transfer_latency.max_record_age is not set in %s, using default value: %s",
+				ConfigFileName, defaultMaxRecordAge,
+			),
+		)
+		config.Processes.TransferLatency.MaxRecordAge = defaultMaxRecordAge
+	}
+	// Set default transfer_history retention if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.TransferHistory.RetentionDays == 0 {
+		defaultRetentionDays := DefaultConfig().Processes.TransferHistory.RetentionDays
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.transfer_history.retention_days is not set in %s, using default value: %d",
+				ConfigFileName, defaultRetentionDays,
+			),
+		)
+		config.Processes.TransferHistory.RetentionDays = defaultRetentionDays
+	}
+	// Set default shutdown_timeout_seconds if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.ShutdownTimeoutSeconds == 0 {
+		defaultShutdownTimeoutSeconds := DefaultConfig().Processes.ShutdownTimeoutSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.shutdown_timeout_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultShutdownTimeoutSeconds,
+			),
+		)
+		config.Processes.ShutdownTimeoutSeconds = defaultShutdownTimeoutSeconds
+	}
+	// Set default startup_timeout_seconds if the value is not set because of an old config version which doesn't
+	// contain it.
+	if config.Processes.StartupTimeoutSeconds == 0 {
+		defaultStartupTimeoutSeconds := DefaultConfig().Processes.StartupTimeoutSeconds
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"processes.startup_timeout_seconds is not set in %s, using default value: %d",
+				ConfigFileName, defaultStartupTimeoutSeconds,
+			),
+		)
+		config.Processes.StartupTimeoutSeconds = defaultStartupTimeoutSeconds
+	}
+	// Set default refunds durations if the values are not set because of an old config version which doesn't
+	// contain them.
+	if config.Refunds.PollInterval == 0 {
+		defaultPollInterval := DefaultConfig().Refunds.PollInterval
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"refunds.poll_interval is not set in %s, using default value: %s",
+				ConfigFileName, defaultPollInterval,
+			),
+		)
+		config.Refunds.PollInterval = defaultPollInterval
+	}
+	if config.Refunds.UnclaimedAgeThreshold == 0 {
+		defaultUnclaimedAgeThreshold := DefaultConfig().Refunds.UnclaimedAgeThreshold
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"refunds.unclaimed_age_threshold is not set in %s, using default value: %s",
+				ConfigFileName, defaultUnclaimedAgeThreshold,
+			),
+		)
+		config.Refunds.UnclaimedAgeThreshold = defaultUnclaimedAgeThreshold
+	}
+	// Set default API config if the values are not set because of an old config version which doesn't contain them.
+	if config.API.ListenAddress == "" {
+		defaultListenAddress := DefaultConfig().API.ListenAddress
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"api.listen_address is not set in %s, using default value: %s",
+				ConfigFileName, defaultListenAddress,
+			),
+		)
+		config.API.ListenAddress = defaultListenAddress
+	}
+	if config.API.RateLimit.RequestsPerSecond == 0 {
+		defaultRequestsPerSecond := DefaultConfig().API.RateLimit.RequestsPerSecond
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"api.rate_limit.requests_per_second is not set in %s, using default value: %v",
+				ConfigFileName, defaultRequestsPerSecond,
+			),
+		)
+		config.API.RateLimit.RequestsPerSecond = defaultRequestsPerSecond
+	}
+	if config.API.RateLimit.Burst == 0 {
+		defaultBurst := DefaultConfig().API.RateLimit.Burst
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"api.rate_limit.burst is not set in %s, using default value: %d",
+				ConfigFileName, defaultBurst,
+			),
+		)
+		config.API.RateLimit.Burst = defaultBurst
+	}
+	if config.TracingServiceName == "" {
+		defaultTracingServiceName := DefaultConfig().TracingServiceName
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"tracing_service_name is not set in %s, using default value: %s",
+				ConfigFileName, defaultTracingServiceName,
+			),
+		)
+		config.TracingServiceName = defaultTracingServiceName
+	}
+	setNotificationsConfigDefaults(ctx, log, &config.Notifications)
 }
 
-func readConfigFromFile(homePath string) (Config, error) {
+// setNotificationsConfigDefaults sets the default delivery settings of the enabled notifications if they are not set
+// because of an old config version which doesn't contain them.
+func setNotificationsConfigDefaults(ctx context.Context, log logger.Logger, config *NotificationsConfig) {
+	if !config.Enabled() {
+		return
+	}
+	defaultConfig := DefaultConfig().Notifications
+	if config.QueueSize == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.queue_size is not set in %s, using default value: %d",
+			ConfigFileName, defaultConfig.QueueSize,
+		))
+		config.QueueSize = defaultConfig.QueueSize
+	}
+	if config.MaxAttempts == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.max_attempts is not set in %s, using default value: %d",
+			ConfigFileName, defaultConfig.MaxAttempts,
+		))
+		config.MaxAttempts = defaultConfig.MaxAttempts
+	}
+	if config.InitialRetryDelay == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.initial_retry_delay is not set in %s, using default value: %s",
+			ConfigFileName, defaultConfig.InitialRetryDelay,
+		))
+		config.InitialRetryDelay = defaultConfig.InitialRetryDelay
+	}
+	if config.MaxRetryDelay == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.max_retry_delay is not set in %s, using default value: %s",
+			ConfigFileName, defaultConfig.MaxRetryDelay,
+		))
+		config.MaxRetryDelay = defaultConfig.MaxRetryDelay
+	}
+	if config.RequestTimeout == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.request_timeout is not set in %s, using default value: %s",
+			ConfigFileName, defaultConfig.RequestTimeout,
+		))
+		config.RequestTimeout = defaultConfig.RequestTimeout
+	}
+	if config.RequestsPerSecond == 0 {
+		log.Warn(ctx, fmt.Sprintf(
+			"notifications.requests_per_second is not set in %s, using default value: %v",
+			ConfigFileName, defaultConfig.RequestsPerSecond,
+		))
+		config.RequestsPerSecond = defaultConfig.RequestsPerSecond
+	}
+}
+
+// ValidateConfig validates the config values which can be checked without the connection to the chains.
+func ValidateConfig(cfg Config) error {
+	if cfg.ConfigVersion != LatestConfigVersion {
+		return errors.Errorf(
+			"unexpected config version %d, expected %d", cfg.ConfigVersion, LatestConfigVersion,
+		)
+	}
+	if _, err := logger.NewZapLogger(logger.ZapLoggerConfig{
+		Level:  cfg.LoggingConfig.Level,
+		Format: cfg.LoggingConfig.Format,
+		Sampling: logger.SamplingConfig{
+			Initial:    cfg.LoggingConfig.Sampling.Initial,
+			Thereafter: cfg.LoggingConfig.Sampling.Thereafter,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "invalid logging config")
+	}
+	if _, err := sdk.ParseDecCoins(cfg.Coreum.Contract.GasPrices); err != nil {
+		return errors.Wrapf(err, "invalid coreum gas prices %q", cfg.Coreum.Contract.GasPrices)
+	}
+	switch cfg.Coreum.EventSource {
+	case coreum.EventSourcePoll:
+	case coreum.EventSourceWebSocket:
+		if cfg.Coreum.RPC.URL == "" {
+			return errors.Errorf("coreum RPC URL is required for the %s event source", coreum.EventSourceWebSocket)
+		}
+	default:
+		return errors.Errorf(
+			"unknown coreum event source %q, expected %s or %s",
+			cfg.Coreum.EventSource, coreum.EventSourcePoll, coreum.EventSourceWebSocket,
+		)
+	}
+	for _, rateLimitCfg := range cfg.Processes.CoreumToXRPLProcess.RateLimits {
+		if _, err := processes.NewTransferRateLimit(
+			rateLimitCfg.Issuer, rateLimitCfg.Currency, rateLimitCfg.MaxAmountPerHour,
+		); err != nil {
+			return errors.Wrapf(
+				err, "invalid rate limit, issuer:%s, currency:%s", rateLimitCfg.Issuer, rateLimitCfg.Currency,
+			)
+		}
+	}
+	if _, err := newMinBridgeAmounts(cfg.MinBridgeAmounts); err != nil {
+		return err
+	}
+	if cfg.XRPL.MaxAMMSlippageBPS > xrpl.MaxAMMSlippageBPS {
+		return errors.Errorf(
+			"invalid XRPL max AMM slippage, max:%d bps, got:%d bps", xrpl.MaxAMMSlippageBPS, cfg.XRPL.MaxAMMSlippageBPS,
+		)
+	}
+	if cfg.API.RateLimit.RequestsPerSecond < 0 || cfg.API.RateLimit.Burst < 0 {
+		return errors.Errorf(
+			"invalid API rate limit, requests per second and burst must not be negative, got:%v, %d",
+			cfg.API.RateLimit.RequestsPerSecond, cfg.API.RateLimit.Burst,
+		)
+	}
+	if cfg.Notifications.Enabled() {
+		if cfg.Coreum.EventSource != coreum.EventSourceWebSocket {
+			return errors.Errorf(
+				"notifications require the %s coreum event source, got:%s",
+				coreum.EventSourceWebSocket, cfg.Coreum.EventSource,
+			)
+		}
+		if _, err := newTransferWebhookNotifierConfig(cfg.Notifications); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readConfigFromFile(ctx context.Context, log logger.Logger, homePath string) (Config, error) {
 	path := BuildFilePath(homePath)
 	file, err := os.OpenFile(path, os.O_RDONLY, 0o600)
 	defer file.Close() //nolint:staticcheck //we accept the error ignoring
@@ -283,10 +1172,25 @@ func readConfigFromFile(homePath string) (Config, error) {
 		return Config{}, errors.Wrapf(err, "failed to read bytes from file does not exist, path:%s", path)
 	}
 
-	var config Config
-	if err := yaml.Unmarshal(fileBytes, &config); err != nil {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(fileBytes, &doc); err != nil {
 		return Config{}, errors.Wrapf(err, "failed to unmarshal file to yaml, path:%s", path)
 	}
+	if err := migrateConfig(ctx, log, &doc); err != nil {
+		return Config{}, errors.Wrapf(err, "failed to migrate config, path:%s", path)
+	}
+	migratedBytes, err := yaml.Marshal(&doc)
+	if err != nil {
+		return Config{}, errors.Wrapf(err, "failed to marshal migrated config, path:%s", path)
+	}
+
+	// the unknown fields are rejected to prevent the silent usage of the default values in case of typos
+	decoder := yaml.NewDecoder(bytes.NewReader(migratedBytes))
+	decoder.KnownFields(true)
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return Config{}, errors.Wrapf(err, "failed to decode config, path:%s", path)
+	}
 
 	return config, nil
 }
@@ -0,0 +1,176 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+const (
+	// LatestConfigVersion is the version of the config schema generated by the relayer.
+	LatestConfigVersion = 3
+
+	configVersionField = "config_version"
+	// legacyConfigVersionField is the version field of the configs generated before the config_version was added,
+	// the only value used in that field is legacyConfigVersion.
+	legacyConfigVersionField = "version"
+	legacyConfigVersion      = "v1"
+
+	processesField           = "processes"
+	coreumToXRPLProcessField = "coreum_to_xrpl"
+	verifyBeforeSubmitField  = "verify_before_submit"
+)
+
+// configMigration migrates the config document of the version to the next version.
+type configMigration func(ctx context.Context, log logger.Logger, root *yaml.Node) error
+
+// configMigrations are the config migrations, the migration with index i migrates the config of version i+1.
+var configMigrations = []configMigration{
+	migrateConfigV1ToV2,
+	migrateConfigV2ToV3,
+}
+
+// migrateConfigV1ToV2 replaces the deprecated version field with the config_version.
+func migrateConfigV1ToV2(ctx context.Context, log logger.Logger, root *yaml.Node) error {
+	if removeMappingField(root, legacyConfigVersionField) {
+		log.Warn(
+			ctx,
+			fmt.Sprintf(
+				"%s is deprecated and replaced by the %s in %s, regenerate the config to remove the warning",
+				legacyConfigVersionField, configVersionField, ConfigFileName,
+			),
+		)
+	}
+
+	return nil
+}
+
+// migrateConfigV2ToV3 enables the verify_before_submit, since the configs without it are decoded with the
+// verification disabled.
+func migrateConfigV2ToV3(ctx context.Context, log logger.Logger, root *yaml.Node) error {
+	processesNode, err := getOrAddMappingField(root, processesField)
+	if err != nil {
+		return err
+	}
+	coreumToXRPLProcessNode, err := getOrAddMappingField(processesNode, coreumToXRPLProcessField)
+	if err != nil {
+		return err
+	}
+	if getMappingField(coreumToXRPLProcessNode, verifyBeforeSubmitField) != nil {
+		return nil
+	}
+	log.Warn(
+		ctx,
+		fmt.Sprintf(
+			"%s.%s.%s is not set in %s, enabling it",
+			processesField, coreumToXRPLProcessField, verifyBeforeSubmitField, ConfigFileName,
+		),
+	)
+	setMappingScalarField(coreumToXRPLProcessNode, verifyBeforeSubmitField, "true")
+
+	return nil
+}
+
+// migrateConfig migrates the config document to the latest version.
+func migrateConfig(ctx context.Context, log logger.Logger, doc *yaml.Node) error {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return errors.New("config must be a yaml mapping")
+	}
+	root := doc.Content[0]
+
+	version, err := getConfigVersion(root)
+	if err != nil {
+		return err
+	}
+	if version > LatestConfigVersion {
+		return errors.Errorf(
+			"config version %d is not supported, the latest supported version is %d", version, LatestConfigVersion,
+		)
+	}
+
+	for ; version < LatestConfigVersion; version++ {
+		log.Warn(ctx, fmt.Sprintf("Migrating %s from version %d to %d", ConfigFileName, version, version+1))
+		if err := configMigrations[version-1](ctx, log, root); err != nil {
+			return errors.Wrapf(err, "failed to migrate config from version %d", version)
+		}
+		setMappingScalarField(root, configVersionField, strconv.Itoa(version+1))
+	}
+
+	return nil
+}
+
+func getConfigVersion(root *yaml.Node) (int, error) {
+	if versionNode := getMappingField(root, configVersionField); versionNode != nil {
+		version, err := strconv.Atoi(versionNode.Value)
+		if err != nil || version < 1 {
+			return 0, errors.Errorf("invalid %s:%q, expected positive integer", configVersionField, versionNode.Value)
+		}
+		return version, nil
+	}
+
+	legacyVersionNode := getMappingField(root, legacyConfigVersionField)
+	if legacyVersionNode == nil {
+		return 0, errors.Errorf("%s is not set", configVersionField)
+	}
+	if legacyVersionNode.Value != legacyConfigVersion {
+		return 0, errors.Errorf(
+			"invalid %s:%q, expected %s", legacyConfigVersionField, legacyVersionNode.Value, legacyConfigVersion,
+		)
+	}
+
+	return 1, nil
+}
+
+func getMappingField(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// getOrAddMappingField returns the mapping field of the mapping, the empty field is added if it's not set.
+func getOrAddMappingField(mapping *yaml.Node, key string) (*yaml.Node, error) {
+	valueNode := getMappingField(mapping, key)
+	if valueNode == nil {
+		valueNode = &yaml.Node{Kind: yaml.MappingNode}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, valueNode)
+	}
+	if valueNode.Kind != yaml.MappingNode {
+		return nil, errors.Errorf("%s must be a yaml mapping", key)
+	}
+
+	return valueNode, nil
+}
+
+func removeMappingField(mapping *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func setMappingScalarField(mapping *yaml.Node, key, value string) {
+	if valueNode := getMappingField(mapping, key); valueNode != nil {
+		valueNode.Kind = yaml.ScalarNode
+		valueNode.Tag = ""
+		valueNode.Value = value
+		return
+	}
+	// the field is added as the first one to keep the version on top of the config
+	mapping.Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Value: key},
+		{Kind: yaml.ScalarNode, Value: value},
+	}, mapping.Content...)
+}
@@ -2,11 +2,16 @@ package runner_test
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
 )
@@ -45,6 +50,152 @@ func TestInitAndReadConfig(t *testing.T) {
 			},
 			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
 		},
+		{
+			name: "empty_event_source", // version 1.1.0 or earlier.
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Coreum.EventSource = ""
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_tx_broadcast_timeout_seconds", // version 1.1.0 or earlier.
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Coreum.Contract.TxBroadcastTimeoutSeconds = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_max_pending_operation_age",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.CoreumToXRPLProcess.MaxPendingOperationAge = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_tx_result_poll_interval",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.CoreumToXRPLProcess.TxResultPollInterval = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_audit_log_max_size_mb",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.XRPLToCoreumProcess.AuditLogMaxSizeMB = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_blocked_delivery_retry_delay",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.XRPLToCoreumProcess.BlockedDeliveryRetryDelay = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_max_evidence_retries",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.XRPLToCoreumProcess.MaxEvidenceRetries = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_ibc_transfer_poll_interval",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.XRPLToCoreumProcess.IBCTransferPollInterval = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_transfer_latency",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.TransferLatency = runner.TransferLatencyConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_transfer_history",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.TransferHistory = runner.TransferHistoryConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_xrpl_rpc_routing",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.XRPL.RPCRouting = runner.XRPLRPCRoutingConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_coreum_grpc_routing",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Coreum.GRPC.Routing = runner.CoreumGRPCRoutingConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_xrpl_submission_monitor",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.XRPL.SubmissionMonitor = runner.XRPLSubmissionMonitorConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_processes_supervisor",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.Supervisor = runner.SupervisorConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_processes_shutdown_timeout_seconds",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.ShutdownTimeoutSeconds = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_processes_startup_timeout_seconds",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Processes.StartupTimeoutSeconds = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_refunds_durations",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.Refunds.PollInterval = 0
+				config.Refunds.UnclaimedAgeThreshold = 0
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
+		{
+			name: "empty_api",
+			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
+				config.API.ListenAddress = ""
+				config.API.RateLimit = runner.APIRateLimitConfig{}
+				return config
+			},
+			expectedConfigFunc: func(config runner.Config) runner.Config { return config },
+		},
 		{
 			name: "custom_retry_delay",
 			beforeWriteModifyFunc: func(config runner.Config) runner.Config {
@@ -86,14 +237,242 @@ func TestInitAndReadConfig(t *testing.T) {
 	}
 }
 
+func TestReadConfig_Strict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultCfgString := getDefaultConfigString()
+
+	tests := []struct {
+		name          string
+		configString  string
+		expectedError string
+	}{
+		{
+			name: "unknown_top_level_field",
+			configString: strings.Replace(
+				defaultCfgString, "logging:\n", "evidence_threshhold: 2\nlogging:\n", 1,
+			),
+			expectedError: "field evidence_threshhold not found",
+		},
+		{
+			name: "unknown_nested_field",
+			configString: strings.Replace(
+				defaultCfgString, "    retry_delay: 10s\n", "    retry_dellay: 10s\n", 1,
+			),
+			expectedError: "field retry_dellay not found",
+		},
+		{
+			name:          "missing_config_version",
+			configString:  strings.Replace(defaultCfgString, "config_version: 3\n", "", 1),
+			expectedError: "config_version is not set",
+		},
+		{
+			name:          "invalid_config_version",
+			configString:  strings.Replace(defaultCfgString, "config_version: 3\n", "config_version: v2\n", 1),
+			expectedError: "invalid config_version",
+		},
+		{
+			name: "unsupported_config_version",
+			configString: strings.Replace(
+				defaultCfgString, "config_version: 3\n", fmt.Sprintf("config_version: %d\n", runner.LatestConfigVersion+1), 1,
+			),
+			expectedError: "is not supported",
+		},
+		{
+			name:          "invalid_legacy_version",
+			configString:  strings.Replace(defaultCfgString, "config_version: 3\n", "version: v2\n", 1),
+			expectedError: "invalid version",
+		},
+		{
+			name:          "empty_config",
+			configString:  "",
+			expectedError: "config must be a yaml mapping",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			homePath := t.TempDir()
+			require.NoError(t, os.WriteFile(runner.BuildFilePath(homePath), []byte(tt.configString), 0o600))
+			_, err := runner.ReadConfig(ctx, logger.NewZapLoggerFromLogger(zap.NewNop()), homePath)
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestReadConfig_Migrations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		configString string
+	}{
+		{
+			name:         "v1_to_latest",
+			configString: strings.Replace(getDefaultConfigString(), "config_version: 3\n", "version: v1\n", 1),
+		},
+		{
+			name: "v1_to_latest_with_old_fields_missing",
+			configString: strings.Replace(
+				strings.Replace(getDefaultConfigString(), "config_version: 3\n", "version: v1\n", 1),
+				"    event_source: poll\n", "", 1,
+			),
+		},
+		{
+			name: "v2_to_latest_without_verify_before_submit",
+			configString: strings.Replace(
+				strings.Replace(getDefaultConfigString(), "config_version: 3\n", "config_version: 2\n", 1),
+				"        verify_before_submit: true\n", "", 1,
+			),
+		},
+		{
+			name:         "latest",
+			configString: getDefaultConfigString(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			homePath := t.TempDir()
+			require.NoError(t, os.WriteFile(runner.BuildFilePath(homePath), []byte(tt.configString), 0o600))
+			cfg, err := runner.ReadConfig(ctx, logger.NewZapLoggerFromLogger(zap.NewNop()), homePath)
+			require.NoError(t, err)
+			require.Equal(t, runner.DefaultConfig(), cfg)
+			require.NoError(t, runner.ValidateConfig(cfg))
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		modifyFunc    func(cfg runner.Config) runner.Config
+		expectedError string
+	}{
+		{
+			name:       "default_config",
+			modifyFunc: func(cfg runner.Config) runner.Config { return cfg },
+		},
+		{
+			name: "invalid_logging_level",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.LoggingConfig.Level = "verbose"
+				return cfg
+			},
+			expectedError: "invalid logging config",
+		},
+		{
+			name: "negative_logging_sampling",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.LoggingConfig.Sampling.Thereafter = -1
+				return cfg
+			},
+			expectedError: "invalid logging config",
+		},
+		{
+			name: "websocket_event_source_without_rpc_url",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.Coreum.EventSource = coreum.EventSourceWebSocket
+				return cfg
+			},
+			expectedError: "coreum RPC URL is required",
+		},
+		{
+			name: "unknown_event_source",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.Coreum.EventSource = "grpc"
+				return cfg
+			},
+			expectedError: "unknown coreum event source",
+		},
+		{
+			name: "invalid_gas_prices",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.Coreum.Contract.GasPrices = "ucore"
+				return cfg
+			},
+			expectedError: "invalid coreum gas prices",
+		},
+		{
+			name: "invalid_min_bridge_amount",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.MinBridgeAmounts.DefaultXRPLAmount = "ten"
+				return cfg
+			},
+			expectedError: "invalid min bridge amounts config",
+		},
+		{
+			name: "invalid_max_amm_slippage",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.XRPL.MaxAMMSlippageBPS = 10_001
+				return cfg
+			},
+			expectedError: "invalid XRPL max AMM slippage",
+		},
+		{
+			name: "negative_api_rate_limit",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.API.RateLimit.Burst = -1
+				return cfg
+			},
+			expectedError: "invalid API rate limit",
+		},
+		{
+			name: "notifications_with_poll_event_source",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.Notifications.WebhookURLs = []string{"https://example.com/webhook"}
+				cfg.Notifications.WebhookSecret = "secret"
+				return cfg
+			},
+			expectedError: "notifications require the websocket coreum event source",
+		},
+		{
+			name: "notifications_without_webhook_secret",
+			modifyFunc: func(cfg runner.Config) runner.Config {
+				cfg.Coreum.EventSource = coreum.EventSourceWebSocket
+				cfg.Coreum.RPC.URL = "http://localhost:26657"
+				cfg.Notifications.WebhookURLs = []string{"https://example.com/webhook"}
+				return cfg
+			},
+			expectedError: "notifications webhook secret is required",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := runner.ValidateConfig(tt.modifyFunc(runner.DefaultConfig()))
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
 // the func returns the default config snapshot as string.
 func getDefaultConfigString() string {
-	return `version: v1
+	return `config_version: 3
 logging:
     level: info
     format: console
+    sampling:
+        initial: 100
+        thereafter: 100
 xrpl:
     multi_signer_key_name: xrpl-relayer
+    keyring_backend: ""
     http_client:
         request_timeout: 5s
         do_timeout: 30s
@@ -101,6 +480,10 @@ xrpl:
     rpc:
         url: ""
         page_limit: 100
+    rpc_routing:
+        urls: []
+        probe_interval_seconds: 30
+        latency_window_size: 100
     scanner:
         recent_scan_enabled: true
         recent_scan_window: 10000
@@ -108,10 +491,25 @@ xrpl:
         full_scan_enabled: true
         repeat_full_scan: true
         retry_delay: 10s
+    submission_monitor:
+        poll_interval: 1s
+        last_ledger_sequence_offset: 20
+        max_resubmissions: 3
+    full_history_rpc_url: ""
+    max_amm_slippage_bps: 100
+    enable_check_cashing: false
 coreum:
     relayer_key_name: coreum-relayer
+    keyring_backend: ""
     grpc:
         url: ""
+        routing:
+            urls: []
+            probe_interval_seconds: 15
+            max_block_age_seconds: 60
+            error_threshold: 3
+    rpc:
+        url: ""
     network:
         chain_id: coreum-mainnet-1
     contract:
@@ -124,15 +522,78 @@ coreum:
         request_timeout: 10s
         tx_timeout: 1m0s
         tx_status_poll_interval: 500ms
+        tx_broadcast_timeout_seconds: 60
+        gas_prices: ""
+        fee_granter: ""
+        contract_config_cache_ttl_ms: 60000
+        available_tickets_cache_ttl_ms: 5000
+        pending_operations_cache_ttl_ms: 1000
+        tokens_cache_ttl_ms: 300000
+    event_source: poll
 processes:
+    xrpl_to_coreum:
+        audit_log_path: ""
+        audit_log_max_size_mb: 100
+        audit_log_max_backups: 5
+        blocked_delivery_retry_delay: 1h0m0s
+        max_evidence_retries: 10
+        ibc_transfer_poll_interval: 30s
     coreum_to_xrpl:
         repeat_delay: 10s
+        verify_before_submit: true
+        rate_limits: []
+        max_pending_operation_age: 1h0m0s
+        signature_aggregation_timeout_hours: 0
+        tx_result_poll_interval: 5s
+    transfer_latency:
+        poll_interval: 10s
+        max_record_age: 24h0m0s
+    transfer_history:
+        retention_days: 90
+    supervisor:
+        max_restarts: 5
+        restart_window_seconds: 600
+        initial_backoff: 1s
+        max_backoff: 1m0s
     retry_delay: 10s
+    shutdown_timeout_seconds: 30
+    startup_timeout_seconds: 60
+min_bridge_amounts:
+    default_coreum_amount: ""
+    default_xrpl_amount: ""
+    tokens: []
+refunds:
+    auto_relay_for: []
+    auto_relay_for_all: false
+    poll_interval: 1m0s
+    unclaimed_age_threshold: 24h0m0s
 metrics:
     enabled: false
     server:
         listen_address: localhost:9090
+        block_processing_staleness_threshold_seconds: 300
     periodic_collector:
         repeat_delay: 1m0s
+        xrpl_spendable_warning_buffer_drops: 5000000
+api:
+    listen_address: localhost:8080
+    cors_allowed_origins: []
+    rate_limit:
+        requests_per_second: 10
+        burst: 20
+notifications:
+    webhook_urls: []
+    webhook_secret: ""
+    watched_addresses: []
+    queue_size: 1000
+    max_attempts: 5
+    initial_retry_delay: 1s
+    max_retry_delay: 1m0s
+    request_timeout: 10s
+    requests_per_second: 5
+key_usage_audit:
+    disabled: false
+tracing_endpoint: ""
+tracing_service_name: coreumbridge-xrpl-relayer
 `
 }
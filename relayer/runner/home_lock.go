@@ -0,0 +1,77 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// HomeLockFileName is the name of the lock file held by the running relayer in its home.
+const HomeLockFileName = "relayer.lock"
+
+// ErrHomeLocked is returned if the relayer home is locked by another relayer instance.
+var ErrHomeLocked = errors.New("relayer home is locked")
+
+// HomeLock is the advisory lock of the relayer home which prevents two relayer instances from sharing the home. The
+// lock is released by the OS if the process is terminated, so the lock file left after a crash doesn't block the start.
+type HomeLock struct {
+	file *os.File
+}
+
+// LockHome acquires the lock of the relayer home, the ErrHomeLocked is returned if the home is locked by another
+// relayer instance.
+func LockHome(home string) (*HomeLock, error) {
+	filePath := filepath.Join(home, HomeLockFileName)
+	if err := os.MkdirAll(home, 0o700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create relayer home dir, path:%s", home)
+	}
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open home lock file, path:%s", filePath)
+	}
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		// the PID written by the lock holder is read only to make the error more informative
+		holderPID, _ := os.ReadFile(filePath)
+		_ = file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, errors.Wrapf(
+				ErrHomeLocked,
+				"another relayer instance is running with the same home, path:%s, pid:%s",
+				filePath, string(holderPID),
+			)
+		}
+		return nil, errors.Wrapf(err, "failed to lock home lock file, path:%s", filePath)
+	}
+	if err := writeHolderPID(file); err != nil {
+		_ = file.Close()
+		return nil, errors.Wrapf(err, "failed to write home lock file, path:%s", filePath)
+	}
+
+	return &HomeLock{
+		file: file,
+	}, nil
+}
+
+// Unlock releases the home lock.
+func (l *HomeLock) Unlock() error {
+	if err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN); err != nil {
+		_ = l.file.Close()
+		return errors.Wrapf(err, "failed to unlock home lock file, path:%s", l.file.Name())
+	}
+
+	return errors.Wrapf(l.file.Close(), "failed to close home lock file, path:%s", l.file.Name())
+}
+
+func writeHolderPID(file *os.File) error {
+	if err := file.Truncate(0); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := file.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
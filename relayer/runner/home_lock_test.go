@@ -0,0 +1,33 @@
+package runner_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/runner"
+)
+
+func TestLockHome(t *testing.T) {
+	t.Parallel()
+
+	home := t.TempDir()
+	lock, err := runner.LockHome(home)
+	require.NoError(t, err)
+
+	// the second instance with the same home can't start
+	_, err = runner.LockHome(home)
+	require.True(t, errors.Is(err, runner.ErrHomeLocked), err)
+
+	// the instance with another home isn't affected
+	anotherHomeLock, err := runner.LockHome(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, anotherHomeLock.Unlock())
+
+	// the home can be locked again once it's unlocked
+	require.NoError(t, lock.Unlock())
+	lock, err = runner.LockHome(home)
+	require.NoError(t, err)
+	require.NoError(t, lock.Unlock())
+}
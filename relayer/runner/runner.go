@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"net/url"
 	"runtime/debug"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -34,11 +34,16 @@ import (
 )
 
 const (
-	configVersion = "v1"
 	// ConfigFileName is file name used for the relayer config.
 	ConfigFileName = "relayer.yaml"
 	// DefaultCoreumChainID is default chain id.
 	DefaultCoreumChainID = coreumchainconstant.ChainIDMain
+
+	bytesInMB = 1024 * 1024
+	// tracingShutdownTimeout limits the time the pending tracing spans are flushed on the relayer stop.
+	tracingShutdownTimeout = 5 * time.Second
+	// chainReadinessRetryDelay is the delay between the chain readiness checks on the relayer start.
+	chainReadinessRetryDelay = time.Second
 )
 
 // Runner is relayer runner which aggregates all relayer components.
@@ -47,9 +52,21 @@ type Runner struct {
 	log           logger.Logger
 	components    Components
 	metricsServer *metrics.Server
+	supervisor    *Supervisor
 
-	xrplToCoreumProcess *processes.XRPLToCoreumProcess
-	coreumToXRPLProcess *processes.CoreumToXRPLProcess
+	evidenceAuditLog       *processes.EvidenceAuditLog
+	xrplToCoreumProcess    *processes.XRPLToCoreumProcess
+	blockedDeliveryQueue   *processes.BlockedDeliveryQueue
+	transferLatencyTracker *processes.TransferLatencyTracker
+	coreumToXRPLProcess    *processes.CoreumToXRPLProcess
+	xrplTxResultTracker    *processes.XRPLTxResultTracker
+	ibcTransferTracker     *processes.IBCTransferTracker
+	// refundRelayer is nil if the refunds relaying is disabled
+	refundRelayer *processes.RefundRelayer
+	// checkCasher is nil if the check cashing is disabled
+	checkCasher *processes.CheckCasher
+	// transferWebhookNotifier is nil if the notifications are disabled
+	transferWebhookNotifier *processes.TransferWebhookNotifier
 }
 
 // NewRunner return new runner from the config.
@@ -73,35 +90,212 @@ func NewRunner(ctx context.Context, components Components, cfg Config) (*Runner,
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get contract config for the runner initialization")
 	}
+	// the check is done before the local state is loaded, since it's removed if the new bridge is accepted
+	if err := checkBridgeIdentity(ctx, components.Log, cfg, BridgeIdentity{
+		ContractAddress:   cfg.Coreum.Contract.ContractAddress,
+		CoreumChainID:     cfg.Coreum.Network.ChainID,
+		BridgeXRPLAddress: contractConfig.BridgeXRPLAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	// the processes query the contract on each iteration, so the queries are cached to reduce the node load
+	cachingContractClient := coreum.NewCachingContractClient(
+		NewCachingContractClientConfig(cfg),
+		components.CoreumContractClient,
+		components.MetricsRegistry,
+		time.Now,
+	)
 
 	bridgeXRPLAddress, err := rippledata.NewAccountFromAddress(contractConfig.BridgeXRPLAddress)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get xrpl account from string, string:%s", contractConfig.BridgeXRPLAddress)
 	}
 
+	// the spans of the scanned payments are continued by the XRPL to Coreum process
+	xrplTxSpans := tracing.NewXRPLTxSpans()
 	xrplScanner := xrpl.NewAccountScanner(xrpl.AccountScannerConfig{
-		Account:           *bridgeXRPLAddress,
-		RecentScanEnabled: cfg.XRPL.Scanner.RecentScanEnabled,
-		RecentScanWindow:  cfg.XRPL.Scanner.RecentScanWindow,
-		RepeatRecentScan:  cfg.XRPL.Scanner.RepeatRecentScan,
-		FullScanEnabled:   cfg.XRPL.Scanner.FullScanEnabled,
-		RepeatFullScan:    cfg.XRPL.Scanner.RepeatFullScan,
-		RetryDelay:        cfg.XRPL.Scanner.RetryDelay,
+		Account:            *bridgeXRPLAddress,
+		RecentScanEnabled:  cfg.XRPL.Scanner.RecentScanEnabled,
+		RecentScanWindow:   cfg.XRPL.Scanner.RecentScanWindow,
+		RepeatRecentScan:   cfg.XRPL.Scanner.RepeatRecentScan,
+		FullScanEnabled:    cfg.XRPL.Scanner.FullScanEnabled,
+		RepeatFullScan:     cfg.XRPL.Scanner.RepeatFullScan,
+		RetryDelay:         cfg.XRPL.Scanner.RetryDelay,
+		CheckpointFilePath: cfg.XRPL.Scanner.CheckpointFilePath,
+		TxSpans:            xrplTxSpans,
 	},
 		components.Log,
 		components.XRPLRPCClient,
+		newXRPLFullHistoryRPCTxProvider(cfg, components),
 		components.MetricsRegistry,
 	)
 
-	xrplToCoreumProcess, err := processes.NewXRPLToCoreumProcess(
-		processes.XRPLToCoreumProcessConfig{
+	evidenceAuditLog, err := processes.NewEvidenceAuditLog(processes.EvidenceAuditLogConfig{
+		Path:         cfg.Processes.XRPLToCoreumProcess.AuditLogPath,
+		MaxSizeBytes: int64(cfg.Processes.XRPLToCoreumProcess.AuditLogMaxSizeMB) * bytesInMB,
+		MaxBackups:   int(cfg.Processes.XRPLToCoreumProcess.AuditLogMaxBackups),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blockedDeliveryQueue, err := processes.NewBlockedDeliveryQueue(
+		processes.BlockedDeliveryQueueConfig{
 			BridgeXRPLAddress:    *bridgeXRPLAddress,
 			RelayerCoreumAddress: coreumRelayerAddress,
+			RetryDelay:           cfg.Processes.XRPLToCoreumProcess.BlockedDeliveryRetryDelay,
+			StoreFilePath:        cfg.Processes.XRPLToCoreumProcess.BlockedDeliveriesStoreFilePath,
+		},
+		components.Log,
+		cachingContractClient,
+		components.MetricsRegistry,
+		time.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	evidenceRetryQueueConfig := processes.DefaultEvidenceRetryQueueConfig(
+		cfg.Processes.XRPLToCoreumProcess.EvidenceDeadLetterLogFilePath,
+	)
+	evidenceRetryQueueConfig.MaxRetries = cfg.Processes.XRPLToCoreumProcess.MaxEvidenceRetries
+	evidenceRetryQueue, err := processes.NewEvidenceRetryQueue(evidenceRetryQueueConfig, components.Log, time.Now, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	transferLatencyTracker, err := processes.NewTransferLatencyTracker(
+		processes.TransferLatencyTrackerConfig{
+			PollInterval:  cfg.Processes.TransferLatency.PollInterval,
+			MaxRecordAge:  cfg.Processes.TransferLatency.MaxRecordAge,
+			StoreFilePath: cfg.Processes.TransferLatency.StoreFilePath,
 		},
 		components.Log,
-		xrplScanner,
 		components.CoreumContractClient,
 		components.MetricsRegistry,
+		time.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transferHistory, err := processes.NewTransferHistory(
+		processes.TransferHistoryConfig{
+			Path:      cfg.Processes.TransferHistory.FilePath,
+			Retention: time.Duration(cfg.Processes.TransferHistory.RetentionDays) * 24 * time.Hour,
+		},
+		time.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	prunedTransfers, err := transferHistory.Prune()
+	if err != nil {
+		return nil, err
+	}
+	if prunedTransfers > 0 {
+		components.Log.Info(ctx, "Pruned expired transfer history records", zap.Int("count", prunedTransfers))
+	}
+
+	ticketUsageTracker, err := processes.NewTicketUsageTracker(
+		cfg.Processes.TicketUsageFilePath,
+		components.MetricsRegistry,
+		time.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	xrplToCoreumProcess, err := processes.NewXRPLToCoreumProcess(
+		processes.XRPLToCoreumProcessConfig{
+			BridgeXRPLAddress:          *bridgeXRPLAddress,
+			RelayerCoreumAddress:       coreumRelayerAddress,
+			BroadcastTimeoutRetryDelay: cfg.Processes.RetryDelay,
+			MinBridgeAmounts:           components.MinBridgeAmounts,
+			TxSpans:                    xrplTxSpans,
+		},
+		components.Log,
+		xrplScanner,
+		cachingContractClient,
+		components.MetricsRegistry,
+		evidenceAuditLog,
+		blockedDeliveryQueue,
+		transferLatencyTracker,
+		evidenceRetryQueue,
+		transferHistory,
+		ticketUsageTracker,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractEventsSubscriber processes.ContractEventsSubscriber
+	switch cfg.Coreum.EventSource {
+	case coreum.EventSourcePoll:
+	case coreum.EventSourceWebSocket:
+		if cfg.Coreum.RPC.URL == "" {
+			return nil, errors.Errorf("coreum RPC URL is required for the %s event source", coreum.EventSourceWebSocket)
+		}
+		contractEventsSubscriber = coreum.NewContractEventsSubscriber(
+			coreum.DefaultContractEventsSubscriberConfig(
+				cfg.Coreum.RPC.URL, components.CoreumContractClient.GetContractAddress(),
+			),
+			components.Log,
+			components.CoreumContractClient,
+			components.MetricsRegistry,
+		)
+	default:
+		return nil, errors.Errorf(
+			"unknown coreum event source %q, expected %s or %s",
+			cfg.Coreum.EventSource, coreum.EventSourcePoll, coreum.EventSourceWebSocket,
+		)
+	}
+
+	transferWebhookNotifier, err := newTransferWebhookNotifier(cfg.Notifications, components)
+	if err != nil {
+		return nil, err
+	}
+	if transferWebhookNotifier != nil {
+		if contractEventsSubscriber == nil {
+			return nil, errors.Errorf("notifications require the %s coreum event source", coreum.EventSourceWebSocket)
+		}
+		contractEventsSubscriber = transferWebhookNotifier.WrapContractEventsSubscriber(contractEventsSubscriber)
+	}
+
+	transferRateLimiter, err := newTransferRateLimiter(cfg.Processes.CoreumToXRPLProcess.RateLimits, components)
+	if err != nil {
+		return nil, err
+	}
+
+	operationAgeTracker, err := processes.NewOperationAgeTracker(
+		processes.OperationAgeTrackerConfig{
+			MaxAge:        cfg.Processes.CoreumToXRPLProcess.MaxPendingOperationAge,
+			StoreFilePath: cfg.Processes.CoreumToXRPLProcess.OperationAgeStoreFilePath,
+		},
+		components.Log,
+		components.MetricsRegistry,
+		time.Now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureAggregationTracker, err := newSignatureAggregationTracker(cfg.Processes.CoreumToXRPLProcess, components)
+	if err != nil {
+		return nil, err
+	}
+
+	xrplTxResultTracker, err := processes.NewXRPLTxResultTracker(
+		processes.XRPLTxResultTrackerConfig{
+			RelayerCoreumAddress: coreumRelayerAddress,
+			PollInterval:         cfg.Processes.CoreumToXRPLProcess.TxResultPollInterval,
+			StoreFilePath:        cfg.Processes.CoreumToXRPLProcess.TxResultStoreFilePath,
+		},
+		components.Log,
+		components.CoreumContractClient,
+		components.XRPLRPCClient,
+		time.Now,
 	)
 	if err != nil {
 		return nil, err
@@ -110,16 +304,50 @@ func NewRunner(ctx context.Context, components Components, cfg Config) (*Runner,
 	coreumToXRPLProcess, err := processes.NewCoreumToXRPLProcess(
 		processes.CoreumToXRPLProcessConfig{
 			BridgeXRPLAddress:    *bridgeXRPLAddress,
+			SourceTag:            contractConfig.SourceTag,
 			RelayerCoreumAddress: coreumRelayerAddress,
 			XRPLTxSignerKeyName:  cfg.XRPL.MultiSignerKeyName,
 			RepeatRecentScan:     true,
 			RepeatDelay:          cfg.Processes.CoreumToXRPLProcess.RepeatDelay,
+			VerifyBeforeSubmit:   cfg.Processes.CoreumToXRPLProcess.VerifyBeforeSubmit,
 		},
 		components.Log,
-		components.CoreumContractClient,
+		cachingContractClient,
 		components.XRPLRPCClient,
 		components.XRPLKeyringTxSigner,
 		components.MetricsRegistry,
+		contractEventsSubscriber,
+		transferRateLimiter,
+		operationAgeTracker,
+		cachingContractClient,
+		xrplTxResultTracker,
+		signatureAggregationTracker,
+		cachingContractClient,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ibcTransferTracker, err := processes.NewIBCTransferTracker(
+		processes.IBCTransferTrackerConfig{
+			RelayerCoreumAddress: coreumRelayerAddress,
+			PollInterval:         cfg.Processes.XRPLToCoreumProcess.IBCTransferPollInterval,
+		},
+		components.Log,
+		components.CoreumContractClient,
+		evidenceAuditLog,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	refundRelayer, err := newRefundRelayer(cfg.Refunds, coreumRelayerAddress, components)
+	if err != nil {
+		return nil, err
+	}
+
+	checkCasher, err := newCheckCasher(
+		cfg.XRPL, *bridgeXRPLAddress, coreumRelayerAddress, components, cachingContractClient, evidenceAuditLog,
 	)
 	if err != nil {
 		return nil, err
@@ -127,41 +355,286 @@ func NewRunner(ctx context.Context, components Components, cfg Config) (*Runner,
 
 	metricsServerCfg := metrics.ServerConfig{
 		ListenAddress: cfg.Metrics.Server.ListenAddress,
+		BlockProcessingStalenessThreshold: time.Duration(
+			cfg.Metrics.Server.BlockProcessingStalenessThresholdSeconds,
+		) * time.Second,
+	}
+	readinessProbe := metrics.NewReadinessProbe(metricsServerCfg.BlockProcessingStalenessThreshold, time.Now)
+	// the ledgers are tracked by the recent history scanner only, the full history scan might take much longer
+	if cfg.XRPL.Scanner.RecentScanEnabled {
+		readinessProbe.RegisterChain(metrics.XRPLChainName, xrplScanner.LastProcessedLedgerTime)
+	}
+	metricsServer := metrics.NewServer(metricsServerCfg, components.MetricsRegistry, readinessProbe)
+
+	supervisor, err := NewSupervisor(cfg.Processes.Supervisor, components.Log, time.Now)
+	if err != nil {
+		return nil, err
 	}
-	metricsServer := metrics.NewServer(metricsServerCfg, components.MetricsRegistry)
 
 	return &Runner{
 		cfg:           cfg,
 		log:           components.Log,
 		components:    components,
 		metricsServer: metricsServer,
+		supervisor:    supervisor,
+
+		evidenceAuditLog:       evidenceAuditLog,
+		xrplToCoreumProcess:    xrplToCoreumProcess,
+		blockedDeliveryQueue:   blockedDeliveryQueue,
+		transferLatencyTracker: transferLatencyTracker,
+		coreumToXRPLProcess:    coreumToXRPLProcess,
+		xrplTxResultTracker:    xrplTxResultTracker,
+		ibcTransferTracker:     ibcTransferTracker,
+		refundRelayer:          refundRelayer,
+		checkCasher:            checkCasher,
+
+		transferWebhookNotifier: transferWebhookNotifier,
+	}, nil
+}
+
+// newXRPLFullHistoryRPCTxProvider returns the full history RPC client or nil if the URL isn't set.
+func newXRPLFullHistoryRPCTxProvider(cfg Config, components Components) xrpl.RPCTxProvider {
+	if cfg.XRPL.FullHistoryRPCURL == "" {
+		return nil
+	}
+	rpcClientCfg := xrpl.RPCClientConfig(cfg.XRPL.RPC)
+	rpcClientCfg.URL = cfg.XRPL.FullHistoryRPCURL
+
+	return xrpl.NewRPCClient(
+		rpcClientCfg,
+		components.Log,
+		toolshttp.NewRetryableClient(toolshttp.RetryableClientConfig(cfg.XRPL.HTTPClient)),
+		components.MetricsRegistry,
+	)
+}
+
+// newSignatureAggregationTracker returns the signature aggregation tracker or nil if the timeout is disabled.
+func newSignatureAggregationTracker(
+	cfg CoreumToXRPLProcessConfig,
+	components Components,
+) (processes.CoreumToXRPLSignatureAggregationTracker, error) {
+	if cfg.SignatureAggregationTimeoutHours == 0 {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+
+	return processes.NewSignatureAggregationTracker(
+		processes.SignatureAggregationTrackerConfig{
+			Timeout:       time.Duration(cfg.SignatureAggregationTimeoutHours) * time.Hour,
+			StoreFilePath: cfg.SignatureAggregationStoreFilePath,
+		},
+		components.Log,
+		time.Now,
+	)
+}
+
+// newTransferRateLimiter returns the transfer rate limiter or nil if there are no rate limits.
+func newTransferRateLimiter(
+	rateLimitsCfg []TransferRateLimitConfig,
+	components Components,
+) (processes.CoreumToXRPLTransferRateLimiter, error) {
+	if len(rateLimitsCfg) == 0 {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	limits := make([]processes.TransferRateLimit, 0, len(rateLimitsCfg))
+	for _, rateLimitCfg := range rateLimitsCfg {
+		limit, err := processes.NewTransferRateLimit(
+			rateLimitCfg.Issuer, rateLimitCfg.Currency, rateLimitCfg.MaxAmountPerHour,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "invalid rate limit, issuer:%s, currency:%s", rateLimitCfg.Issuer, rateLimitCfg.Currency,
+			)
+		}
+		limits = append(limits, limit)
+	}
+
+	return processes.NewTransferRateLimiter(
+		processes.DefaultTransferRateLimiterConfig(limits),
+		components.Log,
+		components.MetricsRegistry,
+		time.Now,
+	)
+}
+
+// newTransferWebhookNotifier returns the transfer webhook notifier or nil if the notifications are disabled.
+func newTransferWebhookNotifier(
+	notificationsCfg NotificationsConfig,
+	components Components,
+) (*processes.TransferWebhookNotifier, error) {
+	if !notificationsCfg.Enabled() {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	notifierCfg, err := newTransferWebhookNotifierConfig(notificationsCfg)
+	if err != nil {
+		return nil, err
+	}
 
-		xrplToCoreumProcess: xrplToCoreumProcess,
-		coreumToXRPLProcess: coreumToXRPLProcess,
+	return processes.NewTransferWebhookNotifier(notifierCfg, components.Log, time.Now)
+}
+
+func newTransferWebhookNotifierConfig(
+	notificationsCfg NotificationsConfig,
+) (processes.TransferWebhookNotifierConfig, error) {
+	if notificationsCfg.WebhookSecret == "" {
+		return processes.TransferWebhookNotifierConfig{}, errors.New("notifications webhook secret is required")
+	}
+	watchedAddresses := make([]sdk.AccAddress, 0, len(notificationsCfg.WatchedAddresses))
+	for _, address := range notificationsCfg.WatchedAddresses {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return processes.TransferWebhookNotifierConfig{}, errors.Wrapf(
+				err, "invalid notifications watched address:%s", address,
+			)
+		}
+		watchedAddresses = append(watchedAddresses, accAddress)
+	}
+
+	return processes.TransferWebhookNotifierConfig{
+		URLs:                  notificationsCfg.WebhookURLs,
+		Secret:                notificationsCfg.WebhookSecret,
+		WatchedAddresses:      watchedAddresses,
+		QueueSize:             notificationsCfg.QueueSize,
+		MaxAttempts:           notificationsCfg.MaxAttempts,
+		InitialRetryDelay:     notificationsCfg.InitialRetryDelay,
+		MaxRetryDelay:         notificationsCfg.MaxRetryDelay,
+		RequestTimeout:        notificationsCfg.RequestTimeout,
+		RequestsPerSecond:     notificationsCfg.RequestsPerSecond,
+		DeadLetterLogFilePath: notificationsCfg.DeadLetterLogFilePath,
 	}, nil
 }
 
-// Start starts runner.
+// newRefundRelayer returns the refund relayer or nil if the refunds relaying is disabled.
+func newRefundRelayer(
+	refundsCfg RefundsConfig,
+	relayerCoreumAddress sdk.AccAddress,
+	components Components,
+) (*processes.RefundRelayer, error) {
+	if !refundsCfg.Enabled() {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	addresses := make([]sdk.AccAddress, 0, len(refundsCfg.AutoRelayFor))
+	for _, address := range refundsCfg.AutoRelayFor {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid refunds auto relay address:%s", address)
+		}
+		addresses = append(addresses, accAddress)
+	}
+
+	return processes.NewRefundRelayer(
+		processes.RefundRelayerConfig{
+			RelayerCoreumAddress:  relayerCoreumAddress,
+			Addresses:             addresses,
+			AllGranters:           refundsCfg.AutoRelayForAll,
+			PollInterval:          refundsCfg.PollInterval,
+			UnclaimedAgeThreshold: refundsCfg.UnclaimedAgeThreshold,
+		},
+		components.Log,
+		components.CoreumContractClient,
+		components.MetricsRegistry,
+		time.Now,
+	)
+}
+
+// newCheckCasher returns the check casher or nil if the check cashing is disabled.
+func newCheckCasher(
+	xrplCfg XRPLConfig,
+	bridgeXRPLAddress rippledata.Account,
+	relayerCoreumAddress sdk.AccAddress,
+	components Components,
+	contractClient processes.CheckCasherContractClient,
+	auditLogger processes.EvidenceAuditLogger,
+) (*processes.CheckCasher, error) {
+	if !xrplCfg.EnableCheckCashing {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+	checkScanner := xrpl.NewCheckScanner(bridgeXRPLAddress, components.Log, components.XRPLRPCClient, time.Now)
+
+	return processes.NewCheckCasher(
+		processes.DefaultCheckCasherConfig(relayerCoreumAddress),
+		components.Log,
+		checkScanner,
+		contractClient,
+		auditLogger,
+	)
+}
+
+// Start starts runner. Once the context is canceled, the processes stop taking the new XRPL txs and Coreum
+// operations, the in-flight Coreum txs are awaited up to the shutdown timeout, and the audit log is closed.
 func (r *Runner) Start(ctx context.Context) error {
-	runnerProcesses := map[string]func(context.Context) error{
-		"XRPL-to-Coreum": taskWithRestartOnError(
-			r.xrplToCoreumProcess.Start,
-			r.log,
-			r.cfg.Processes.ExitOnError,
-			r.cfg.Processes.RetryDelay,
-		),
-		"Coreum-to-XRPL": taskWithRestartOnError(
-			r.coreumToXRPLProcess.Start,
+	shutdownTracing, err := tracing.InitOTelTracerProvider(ctx, tracing.OTelConfig{
+		Endpoint:    r.cfg.TracingEndpoint,
+		ServiceName: r.cfg.TracingServiceName,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the pending spans are flushed after the processes are stopped
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.WithoutCancel(ctx), tracingShutdownTimeout)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			r.log.Warn(ctx, "Failed to flush the tracing spans", zap.Error(err))
+		}
+	}()
+
+	// the processes are started only once both chains are ready, since each of them uses both
+	if err := waitForChainsReadiness(
+		ctx,
+		r.log,
+		time.Duration(r.cfg.Processes.StartupTimeoutSeconds)*time.Second,
+		chainReadinessRetryDelay,
+		map[string]chainReadinessCheck{
+			xrplChainName: func(ctx context.Context) error {
+				_, err := r.components.XRPLRPCClient.ServerState(ctx)
+				return err
+			},
+			coreumChainName: func(ctx context.Context) error {
+				_, err := r.components.CoreumContractClient.GetContractConfig(ctx)
+				return err
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	restartableProcesses := map[string]parallel.Task{
+		"XRPL-to-Coreum":                    r.xrplToCoreumProcess.Start,
+		"XRPL-to-Coreum-blocked-deliveries": r.blockedDeliveryQueue.Start,
+		"transfer-latency-tracker":          r.transferLatencyTracker.Start,
+		"Coreum-to-XRPL":                    r.coreumToXRPLProcess.Start,
+		"XRPL-tx-result-tracker":            r.xrplTxResultTracker.Start,
+		"IBC-transfer-tracker":              r.ibcTransferTracker.Start,
+	}
+	if r.components.XRPLRPCLatencyRouter != nil {
+		restartableProcesses["XRPL-RPC-latency-router"] = r.components.XRPLRPCLatencyRouter.Start
+	}
+	if r.components.CoreumGRPCRouter != nil {
+		restartableProcesses["Coreum-GRPC-router"] = r.components.CoreumGRPCRouter.Start
+	}
+	if r.refundRelayer != nil {
+		restartableProcesses["refund-relayer"] = r.refundRelayer.Start
+	}
+	if r.checkCasher != nil {
+		restartableProcesses["check-casher"] = r.checkCasher.Start
+	}
+	if r.transferWebhookNotifier != nil {
+		restartableProcesses["transfer-webhook-notifier"] = r.transferWebhookNotifier.Start
+	}
+	runnerProcesses := make(map[string]func(context.Context) error, len(restartableProcesses))
+	for name, start := range restartableProcesses {
+		runnerProcesses[name] = taskWithRestartOnError(
+			r.supervisor.Supervise(name, start),
 			r.log,
 			r.cfg.Processes.ExitOnError,
 			r.cfg.Processes.RetryDelay,
-		),
+		)
 	}
 	if r.cfg.Metrics.Enabled {
 		runnerProcesses["metrics-server"] = r.metricsServer.Start
 		runnerProcesses["metrics-periodic-collector"] = r.components.MetricsPeriodicCollector.Start
 	}
-	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+	err = parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
 		for name, start := range runnerProcesses {
 			name := name
 			start := start
@@ -170,8 +643,21 @@ func (r *Runner) Start(ctx context.Context) error {
 				return start(ctx)
 			})
 		}
+		spawn("shutdown-notifier", parallel.Continue, func(ctx context.Context) error {
+			<-ctx.Done()
+			r.log.Info(
+				ctx,
+				"Relayer shutdown is started, waiting for the in-flight Coreum txs",
+				zap.Uint32("shutdownTimeoutSeconds", r.cfg.Processes.ShutdownTimeoutSeconds),
+			)
+			return errors.WithStack(ctx.Err())
+		})
 		return nil
 	})
+	r.evidenceAuditLog.Close()
+	r.log.Info(ctx, "Relayer is stopped")
+
+	return err
 }
 
 func taskWithRestartOnError(
@@ -195,6 +681,10 @@ func taskWithRestartOnError(
 			if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return nil
 			}
+			if errors.Is(err, ErrSupervisorGaveUp) {
+				log.Error(ctx, "The process is panicked too many times, exiting", zap.Error(err))
+				return err
+			}
 
 			// restart the process if it is restartable
 			log.Error(ctx, "Received unexpected error from the process", zap.Error(err))
@@ -218,6 +708,42 @@ func taskWithRestartOnError(
 	}
 }
 
+func newXRPLRPCLatencyRouter(
+	cfg Config,
+	log logger.Logger,
+	httpClient xrpl.HTTPClient,
+	metricsRegistry *metrics.Registry,
+) (*xrpl.LatencyRouter, error) {
+	if len(cfg.XRPL.RPCRouting.URLs) == 0 {
+		return nil, nil //nolint:nilnil // the nil router means that the routing is disabled
+	}
+
+	urls := lo.Uniq(lo.Compact(append([]string{cfg.XRPL.RPC.URL}, cfg.XRPL.RPCRouting.URLs...)))
+	latencyRouterCfg := xrpl.DefaultLatencyRouterConfig(urls)
+	latencyRouterCfg.ProbeInterval = time.Duration(cfg.XRPL.RPCRouting.ProbeIntervalSeconds) * time.Second
+	latencyRouterCfg.WindowSize = int(cfg.XRPL.RPCRouting.LatencyWindowSize)
+
+	return xrpl.NewLatencyRouter(latencyRouterCfg, log, httpClient, metricsRegistry, time.Now)
+}
+
+func newCoreumGRPCRouter(cfg Config, log logger.Logger, metricsRegistry *metrics.Registry) (*coreum.GRPCRouter, error) {
+	if len(cfg.Coreum.GRPC.Routing.URLs) == 0 {
+		return nil, nil //nolint:nilnil // the nil router means that the routing is disabled
+	}
+
+	codecOption, err := getGRPCCodecDialOption()
+	if err != nil {
+		return nil, err
+	}
+	urls := lo.Uniq(lo.Compact(append([]string{cfg.Coreum.GRPC.URL}, cfg.Coreum.GRPC.Routing.URLs...)))
+	grpcRouterCfg := coreum.DefaultGRPCRouterConfig(urls)
+	grpcRouterCfg.ProbeInterval = time.Duration(cfg.Coreum.GRPC.Routing.ProbeIntervalSeconds) * time.Second
+	grpcRouterCfg.MaxBlockAge = time.Duration(cfg.Coreum.GRPC.Routing.MaxBlockAgeSeconds) * time.Second
+	grpcRouterCfg.ErrorThreshold = int(cfg.Coreum.GRPC.Routing.ErrorThreshold)
+
+	return coreum.NewGRPCRouter(grpcRouterCfg, log, metricsRegistry, time.Now, codecOption)
+}
+
 // Components groups components required by runner.
 type Components struct {
 	Log                      logger.Logger
@@ -226,10 +752,15 @@ type Components struct {
 	RunnerConfig             Config
 	XRPLSDKClietCtx          client.Context
 	XRPLRPCClient            *xrpl.RPCClient
+	XRPLRPCLatencyRouter     *xrpl.LatencyRouter
+	CoreumGRPCRouter         *coreum.GRPCRouter
+	XRPLSubmissionMonitor    *xrpl.SubmissionMonitor
+	XRPLAMMRouter            *xrpl.AMMRouter
 	XRPLKeyringTxSigner      *xrpl.KeyringTxSigner
 	CoreumSDKClientCtx       client.Context
 	CoreumClientCtx          coreumchainclient.Context
 	CoreumContractClient     *coreum.ContractClient
+	MinBridgeAmounts         processes.MinBridgeAmounts
 }
 
 // NewComponents creates components required by runner and other CLI commands.
@@ -246,8 +777,35 @@ func NewComponents(
 
 	retryableXRPLRPCHTTPClient := toolshttp.NewRetryableClient(toolshttp.RetryableClientConfig(cfg.XRPL.HTTPClient))
 
+	xrplRPCLatencyRouter, err := newXRPLRPCLatencyRouter(cfg, log, retryableXRPLRPCHTTPClient, metricsRegistry)
+	if err != nil {
+		return Components{}, err
+	}
+	var xrplRPCHTTPClient xrpl.HTTPClient = retryableXRPLRPCHTTPClient
+	if xrplRPCLatencyRouter != nil {
+		xrplRPCHTTPClient = xrplRPCLatencyRouter
+	}
+
 	xrplRPCClientCfg := xrpl.RPCClientConfig(cfg.XRPL.RPC)
-	xrplRPCClient := xrpl.NewRPCClient(xrplRPCClientCfg, log, retryableXRPLRPCHTTPClient, metricsRegistry)
+	xrplRPCClient := xrpl.NewRPCClient(xrplRPCClientCfg, log, xrplRPCHTTPClient, metricsRegistry)
+	xrplSubmissionMonitor, err := xrpl.NewSubmissionMonitor(
+		xrpl.SubmissionMonitorConfig(cfg.XRPL.SubmissionMonitor),
+		log,
+		xrplRPCClient,
+	)
+	if err != nil {
+		return Components{}, errors.Wrap(err, "failed to create XRPL submission monitor")
+	}
+	xrplAMMRouter, err := xrpl.NewAMMRouter(
+		xrpl.AMMRouterConfig{
+			MaxSlippageBPS: cfg.XRPL.MaxAMMSlippageBPS,
+		},
+		log,
+		xrplRPCClient,
+	)
+	if err != nil {
+		return Components{}, errors.Wrap(err, "failed to create XRPL AMM router")
+	}
 
 	coreumClientContextCfg := coreumchainclient.DefaultContextConfig()
 	coreumClientContextCfg.TimeoutConfig.RequestTimeout = cfg.Coreum.Contract.RequestTimeout
@@ -293,8 +851,33 @@ func NewComponents(
 	contractClientCfg.PageLimit = cfg.Coreum.Contract.PageLimit
 	contractClientCfg.OutOfGasRetryDelay = cfg.Coreum.Contract.OutOfGasRetryDelay
 	contractClientCfg.OutOfGasRetryAttempts = cfg.Coreum.Contract.OutOfGasRetryAttempts
+	contractClientCfg.TxBroadcastTimeout = time.Duration(cfg.Coreum.Contract.TxBroadcastTimeoutSeconds) * time.Second
+	contractClientCfg.ShutdownTimeout = time.Duration(cfg.Processes.ShutdownTimeoutSeconds) * time.Second
+	contractClientCfg.GasPrices, err = sdk.ParseDecCoins(cfg.Coreum.Contract.GasPrices)
+	if err != nil {
+		return Components{}, errors.Wrapf(
+			err, "failed to parse coreum gas prices, gas prices:%s", cfg.Coreum.Contract.GasPrices,
+		)
+	}
+	if cfg.Coreum.Contract.FeeGranter != "" {
+		contractClientCfg.FeeGranter, err = sdk.AccAddressFromBech32(cfg.Coreum.Contract.FeeGranter)
+		if err != nil {
+			return Components{}, errors.Wrapf(
+				err,
+				"failed to decode fee granter address to sdk.AccAddress, address:%s",
+				cfg.Coreum.Contract.FeeGranter,
+			)
+		}
+	}
 
-	if cfg.Coreum.GRPC.URL != "" {
+	coreumGRPCRouter, err := newCoreumGRPCRouter(cfg, log, metricsRegistry)
+	if err != nil {
+		return Components{}, errors.Wrap(err, "failed to create coreum GRPC router")
+	}
+	switch {
+	case coreumGRPCRouter != nil:
+		coreumClientCtx = coreumClientCtx.WithGRPCClient(coreumGRPCRouter.Conn())
+	case cfg.Coreum.GRPC.URL != "":
 		grpcClient, err := getGRPCClientConn(cfg.Coreum.GRPC.URL)
 		if err != nil {
 			return Components{}, errors.Wrapf(err, "failed to create coreum GRPC client, URL:%s", cfg.Coreum.GRPC.URL)
@@ -302,10 +885,27 @@ func NewComponents(
 		coreumClientCtx = coreumClientCtx.WithGRPCClient(grpcClient)
 	}
 
-	contractClient := coreum.NewContractClient(contractClientCfg, log, coreumClientCtx)
+	keyUsageAuditLog, err := newKeyUsageAuditLog(cfg, log, metricsRegistry)
+	if err != nil {
+		return Components{}, err
+	}
+	var (
+		coreumKeyUsageAuditLogger coreum.KeyUsageAuditLogger
+		xrplKeyUsageAuditLogger   xrpl.KeyUsageAuditLogger
+	)
+	if keyUsageAuditLog != nil {
+		coreumKeyUsageAuditLogger = keyUsageAuditLog
+		xrplKeyUsageAuditLogger = keyUsageAuditLog
+	}
+
+	contractClient := coreum.NewContractClient(
+		contractClientCfg, log, coreumClientCtx, metricsRegistry, coreumKeyUsageAuditLogger,
+	)
 
 	metricsPeriodicCollectorCfg := metrics.DefaultPeriodicCollectorConfig()
 	metricsPeriodicCollectorCfg.RepeatDelay = cfg.Metrics.PeriodicCollector.RepeatDelay
+	metricsPeriodicCollectorCfg.XRPLSpendableWarningBufferDrops =
+		cfg.Metrics.PeriodicCollector.XRPLSpendableWarningBufferDrops
 	metricsPeriodicCollector := metrics.NewPeriodicCollector(
 		metricsPeriodicCollectorCfg,
 		log,
@@ -315,9 +915,14 @@ func NewComponents(
 		coreumClientCtx,
 	)
 
+	minBridgeAmounts, err := newMinBridgeAmounts(cfg.MinBridgeAmounts)
+	if err != nil {
+		return Components{}, err
+	}
+
 	var xrplKeyringTxSigner *xrpl.KeyringTxSigner
 	if xrplSDKClientCtx.Keyring != nil {
-		xrplKeyringTxSigner = xrpl.NewKeyringTxSigner(xrplSDKClientCtx.Keyring)
+		xrplKeyringTxSigner = xrpl.NewKeyringTxSigner(xrplSDKClientCtx.Keyring, xrplKeyUsageAuditLogger)
 	}
 
 	return Components{
@@ -327,13 +932,48 @@ func NewComponents(
 		MetricsPeriodicCollector: metricsPeriodicCollector,
 		XRPLSDKClietCtx:          xrplSDKClientCtx,
 		XRPLRPCClient:            xrplRPCClient,
+		XRPLRPCLatencyRouter:     xrplRPCLatencyRouter,
+		CoreumGRPCRouter:         coreumGRPCRouter,
+		XRPLSubmissionMonitor:    xrplSubmissionMonitor,
+		XRPLAMMRouter:            xrplAMMRouter,
 		XRPLKeyringTxSigner:      xrplKeyringTxSigner,
 		CoreumSDKClientCtx:       coreumSDKClientCtx,
 		CoreumClientCtx:          coreumClientCtx,
 		CoreumContractClient:     contractClient,
+		MinBridgeAmounts:         minBridgeAmounts,
 	}, nil
 }
 
+// newKeyUsageAuditLog returns the key usage audit log or nil if it's disabled or the path isn't set.
+func newKeyUsageAuditLog(
+	cfg Config,
+	log logger.Logger,
+	metricRegistry processes.KeyUsageAuditMetricRegistry,
+) (*processes.KeyUsageAuditLog, error) {
+	if cfg.KeyUsageAudit.Disabled || cfg.KeyUsageAudit.FilePath == "" {
+		return nil, nil //nolint:nilnil // nil is expected value
+	}
+
+	return processes.NewKeyUsageAuditLog(cfg.KeyUsageAudit.FilePath, log, metricRegistry, time.Now)
+}
+
+func newMinBridgeAmounts(cfg MinBridgeAmountsConfig) (processes.MinBridgeAmounts, error) {
+	tokens := make([]processes.MinBridgeAmountConfig, 0, len(cfg.Tokens))
+	for _, tokenCfg := range cfg.Tokens {
+		tokens = append(tokens, processes.MinBridgeAmountConfig(tokenCfg))
+	}
+	minBridgeAmounts, err := processes.NewMinBridgeAmounts(processes.MinBridgeAmountsConfig{
+		DefaultCoreumAmount: cfg.DefaultCoreumAmount,
+		DefaultXRPLAmount:   cfg.DefaultXRPLAmount,
+		Tokens:              tokens,
+	})
+	if err != nil {
+		return processes.MinBridgeAmounts{}, errors.Wrap(err, "invalid min bridge amounts config")
+	}
+
+	return minBridgeAmounts, nil
+}
+
 func getAddressFromKeyring(kr keyring.Keyring, keyName string) (sdk.AccAddress, error) {
 	keyRecord, err := kr.Key(keyName)
 	if err != nil {
@@ -351,24 +991,21 @@ func getAddressFromKeyring(kr keyring.Keyring, keyName string) (sdk.AccAddress,
 }
 
 func getGRPCClientConn(grpcURL string) (*grpc.ClientConn, error) {
-	parsedURL, err := url.Parse(grpcURL)
+	host, secure, err := coreum.ParseGRPCURL(grpcURL)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse grpc URL")
+		return nil, err
 	}
 
-	encodingConfig := coreumchainconfig.NewEncodingConfig(coreumapp.ModuleBasics)
-	pc, ok := encodingConfig.Codec.(codec.GRPCCodecProvider)
-	if !ok {
-		return nil, errors.New("failed to cast codec to codec.GRPCCodecProvider)")
+	codecOption, err := getGRPCCodecDialOption()
+	if err != nil {
+		return nil, err
 	}
 
-	host := parsedURL.Host
-
 	// https - tls grpc
-	if parsedURL.Scheme == "https" {
+	if secure {
 		grpcClient, err := grpc.Dial(
 			host,
-			grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())),
+			codecOption,
 			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
 		)
 		if err != nil {
@@ -377,14 +1014,10 @@ func getGRPCClientConn(grpcURL string) (*grpc.ClientConn, error) {
 		return grpcClient, nil
 	}
 
-	// handling of host:port URL without the protocol
-	if host == "" {
-		host = fmt.Sprintf("%s:%s", parsedURL.Scheme, parsedURL.Opaque)
-	}
 	// http - insecure
 	grpcClient, err := grpc.Dial(
 		host,
-		grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())),
+		codecOption,
 		grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc")
@@ -392,3 +1025,13 @@ func getGRPCClientConn(grpcURL string) (*grpc.ClientConn, error) {
 
 	return grpcClient, nil
 }
+
+func getGRPCCodecDialOption() (grpc.DialOption, error) {
+	encodingConfig := coreumchainconfig.NewEncodingConfig(coreumapp.ModuleBasics)
+	pc, ok := encodingConfig.Codec.(codec.GRPCCodecProvider)
+	if !ok {
+		return nil, errors.New("failed to cast codec to codec.GRPCCodecProvider)")
+	}
+
+	return grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())), nil
+}
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// Chain names used in the readiness statuses.
+const (
+	xrplChainName   = "XRPL"
+	coreumChainName = "Coreum"
+)
+
+// chainReadinessCheck returns nil if the chain is ready to be used by the relayer processes.
+type chainReadinessCheck func(ctx context.Context) error
+
+// waitForChainsReadiness runs the chain readiness checks concurrently and returns once all of them pass, so the slow
+// chain doesn't delay the check of the other. Each check is repeated with the retry delay, and if any chain isn't
+// ready in the timeout the error names it.
+func waitForChainsReadiness(
+	ctx context.Context,
+	log logger.Logger,
+	timeout, retryDelay time.Duration,
+	checks map[string]chainReadinessCheck,
+) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return parallel.Run(timeoutCtx, func(_ context.Context, spawn parallel.SpawnFn) error {
+		for chain, check := range checks {
+			chain := chain
+			check := check
+			spawn(chain+"-readiness", parallel.Continue, func(groupCtx context.Context) error {
+				err := waitForChainReadiness(groupCtx, log, chain, retryDelay, check)
+				if err == nil {
+					return nil
+				}
+				// the check is stopped because the relayer is stopped or the other chain isn't ready
+				if !errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+					return errors.WithStack(groupCtx.Err())
+				}
+				log.Error(ctx, "Chain is not ready", zap.String("chain", chain), zap.Error(err))
+
+				return errors.Wrapf(err, "%s chain is not ready in %s", chain, timeout)
+			})
+		}
+		return nil
+	})
+}
+
+func waitForChainReadiness(
+	ctx context.Context,
+	log logger.Logger,
+	chain string,
+	retryDelay time.Duration,
+	check chainReadinessCheck,
+) error {
+	log.Info(ctx, "Waiting for the chain readiness", zap.String("chain", chain))
+	for {
+		err := check(ctx)
+		if err == nil {
+			log.Info(ctx, "Chain is ready", zap.String("chain", chain))
+			return nil
+		}
+		log.Warn(ctx, "Chain is not ready yet", zap.String("chain", chain), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(retryDelay):
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestWaitForChainsReadiness(t *testing.T) {
+	t.Parallel()
+
+	notReadyErr := errors.New("connection refused")
+	readyAfter := func(failures int64) chainReadinessCheck {
+		var calls atomic.Int64
+		return func(ctx context.Context) error {
+			if calls.Add(1) <= failures {
+				return notReadyErr
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		checks        map[string]chainReadinessCheck
+		expectedError string
+	}{
+		{
+			name: "both_chains_are_ready",
+			checks: map[string]chainReadinessCheck{
+				xrplChainName:   readyAfter(0),
+				coreumChainName: readyAfter(0),
+			},
+		},
+		{
+			name: "both_chains_become_ready",
+			checks: map[string]chainReadinessCheck{
+				xrplChainName:   readyAfter(3),
+				coreumChainName: readyAfter(2),
+			},
+		},
+		{
+			name: "xrpl_chain_is_not_ready",
+			checks: map[string]chainReadinessCheck{
+				xrplChainName:   readyAfter(1_000),
+				coreumChainName: readyAfter(0),
+			},
+			expectedError: "XRPL chain is not ready in 500ms: connection refused",
+		},
+		{
+			name: "coreum_chain_is_not_ready",
+			checks: map[string]chainReadinessCheck{
+				xrplChainName:   readyAfter(1),
+				coreumChainName: readyAfter(1_000),
+			},
+			expectedError: "Coreum chain is not ready in 500ms: connection refused",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := logger.NewAnyLogMock(gomock.NewController(t))
+			if tt.expectedError != "" {
+				logMock.EXPECT().Error(gomock.Any(), "Chain is not ready", gomock.Any())
+			}
+			startTime := time.Now()
+			err := waitForChainsReadiness(
+				context.Background(), logMock, 500*time.Millisecond, 10*time.Millisecond, tt.checks,
+			)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.expectedError)
+			// the failure is returned right after the timeout
+			require.Less(t, time.Since(startTime), 2*time.Second)
+		})
+	}
+}
+
+func TestWaitForChainsReadiness_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := waitForChainsReadiness(
+		ctx,
+		logger.NewAnyLogMock(gomock.NewController(t)),
+		time.Minute,
+		10*time.Millisecond,
+		map[string]chainReadinessCheck{
+			xrplChainName: func(ctx context.Context) error {
+				return errors.New("connection refused")
+			},
+		},
+	)
+	require.ErrorIs(t, err, context.Canceled)
+}
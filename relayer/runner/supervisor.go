@@ -0,0 +1,119 @@
+package runner
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+// panicRecoveredLogMessage is the message of the log written on each restart of the panicked process.
+const panicRecoveredLogMessage = "PANIC_RECOVERED"
+
+// ErrSupervisorGaveUp is returned by the supervised process which has panicked more than the max restarts times
+// within the restart window.
+var ErrSupervisorGaveUp = errors.New("process supervisor gave up")
+
+// Supervisor restarts the panicked processes with the exponential back-off between the restarts.
+type Supervisor struct {
+	cfg   SupervisorConfig
+	log   logger.Logger
+	clock func() time.Time
+}
+
+// NewSupervisor returns a new instance of the Supervisor.
+func NewSupervisor(cfg SupervisorConfig, log logger.Logger, clock func() time.Time) (*Supervisor, error) {
+	if cfg.MaxRestarts < 0 {
+		return nil, errors.Errorf("max restarts must not be negative, got: %d", cfg.MaxRestarts)
+	}
+	if cfg.RestartWindowSeconds == 0 {
+		return nil, errors.New("restart window must be positive")
+	}
+	if cfg.InitialBackoff < 0 {
+		return nil, errors.Errorf("initial back-off must not be negative, got: %s", cfg.InitialBackoff)
+	}
+	if cfg.MaxBackoff < cfg.InitialBackoff {
+		return nil, errors.Errorf("max back-off must not be less than the initial back-off, got: %s", cfg.MaxBackoff)
+	}
+
+	return &Supervisor{
+		cfg:   cfg,
+		log:   log,
+		clock: clock,
+	}, nil
+}
+
+// Supervise returns the task restarting the provided one on panic. The errors returned by the task aren't handled.
+// If the task panics more than the max restarts times within the restart window, the supervised task returns the
+// ErrSupervisorGaveUp.
+func (s *Supervisor) Supervise(name string, task parallel.Task) parallel.Task {
+	return func(ctx context.Context) error {
+		restartWindow := time.Duration(s.cfg.RestartWindowSeconds) * time.Second
+		restarts := make([]time.Time, 0)
+		for {
+			panicValue, stack, err := runRecovering(ctx, task)
+			if stack == nil {
+				return err
+			}
+
+			now := s.clock()
+			restarts = lo.Filter(restarts, func(restart time.Time, _ int) bool {
+				return now.Sub(restart) < restartWindow
+			})
+			if len(restarts) >= s.cfg.MaxRestarts {
+				return errors.Wrapf(
+					ErrSupervisorGaveUp,
+					"process %s panicked after %d restarts within %s, panic: %v",
+					name, len(restarts), restartWindow, panicValue,
+				)
+			}
+			restarts = append(restarts, now)
+
+			backoff := s.backoff(len(restarts))
+			s.log.Error(
+				ctx,
+				panicRecoveredLogMessage,
+				zap.String("process", name),
+				zap.Any("panic", panicValue),
+				zap.String("stack", string(stack)),
+				zap.Int("restart", len(restarts)),
+				zap.Int("maxRestarts", s.cfg.MaxRestarts),
+				zap.Duration("backoff", backoff),
+			)
+			select {
+			case <-ctx.Done():
+				return errors.WithStack(ctx.Err())
+			case <-time.After(backoff):
+			}
+		}
+	}
+}
+
+// backoff returns the delay before the restart, doubled on each restart within the window and limited by the max
+// back-off.
+func (s *Supervisor) backoff(restart int) time.Duration {
+	backoff := s.cfg.InitialBackoff
+	for i := 1; i < restart && backoff < s.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return lo.Min([]time.Duration{backoff, s.cfg.MaxBackoff})
+}
+
+// runRecovering runs the task and returns the panic value and the stack trace if the task has panicked.
+func runRecovering(ctx context.Context, task parallel.Task) (panicValue any, stack []byte, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			panicValue = p
+			stack = debug.Stack()
+		}
+	}()
+
+	return nil, nil, task(ctx)
+}
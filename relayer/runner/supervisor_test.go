@@ -0,0 +1,192 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+func TestSupervisor_Supervise(t *testing.T) {
+	t.Parallel()
+
+	cfg := SupervisorConfig{
+		MaxRestarts:          2,
+		RestartWindowSeconds: 60,
+		InitialBackoff:       time.Millisecond,
+		MaxBackoff:           5 * time.Millisecond,
+	}
+	taskErr := errors.New("task error")
+
+	tests := []struct {
+		name string
+		// panics is the number of the first task runs which panic
+		panics int
+		// clockStep is the time passed between the task runs
+		clockStep      time.Duration
+		taskErr        error
+		wantRuns       int64
+		wantRecoveries int
+		wantErr        error
+	}{
+		{
+			name:     "no_panic",
+			wantRuns: 1,
+		},
+		{
+			name:     "error_is_not_handled",
+			taskErr:  taskErr,
+			wantRuns: 1,
+			wantErr:  taskErr,
+		},
+		{
+			name:           "restarted_within_max_restarts",
+			panics:         2,
+			wantRuns:       3,
+			wantRecoveries: 2,
+		},
+		{
+			name:           "gave_up_after_max_restarts",
+			panics:         100,
+			wantRuns:       3,
+			wantRecoveries: 2,
+			wantErr:        ErrSupervisorGaveUp,
+		},
+		{
+			name:           "restarts_outside_window_are_not_counted",
+			panics:         5,
+			clockStep:      time.Minute,
+			wantRuns:       6,
+			wantRecoveries: 5,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			logMock := logger.NewAnyLogMock(ctrl)
+			logMock.EXPECT().
+				Error(gomock.Any(), panicRecoveredLogMessage, gomock.Any()).
+				Times(tt.wantRecoveries)
+
+			var (
+				runs atomic.Int64
+				now  = time.Now()
+			)
+			clock := func() time.Time {
+				return now.Add(time.Duration(runs.Load()) * tt.clockStep)
+			}
+			supervisor, err := NewSupervisor(cfg, logMock, clock)
+			require.NoError(t, err)
+
+			task := supervisor.Supervise("test", func(ctx context.Context) error {
+				if runs.Add(1) <= int64(tt.panics) {
+					panic("test panic")
+				}
+				return tt.taskErr
+			})
+			err = task(context.Background())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantRuns, runs.Load())
+		})
+	}
+}
+
+func TestSupervisor_backoff(t *testing.T) {
+	t.Parallel()
+
+	supervisor, err := NewSupervisor(SupervisorConfig{
+		MaxRestarts:          10,
+		RestartWindowSeconds: 60,
+		InitialBackoff:       time.Second,
+		MaxBackoff:           5 * time.Second,
+	}, logger.NewAnyLogMock(gomock.NewController(t)), time.Now)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Second, supervisor.backoff(1))
+	require.Equal(t, 2*time.Second, supervisor.backoff(2))
+	require.Equal(t, 4*time.Second, supervisor.backoff(3))
+	require.Equal(t, 5*time.Second, supervisor.backoff(4))
+	require.Equal(t, 5*time.Second, supervisor.backoff(100))
+}
+
+func TestSupervisor_taskWithRestartOnError_ExitsWhenSupervisorGaveUp(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	logMock := logger.NewAnyLogMock(ctrl)
+	logMock.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	supervisor, err := NewSupervisor(SupervisorConfig{
+		MaxRestarts:          1,
+		RestartWindowSeconds: 60,
+		MaxBackoff:           time.Millisecond,
+	}, logMock, time.Now)
+	require.NoError(t, err)
+
+	var runs atomic.Int64
+	task := taskWithRestartOnError(
+		supervisor.Supervise("test", func(ctx context.Context) error {
+			runs.Add(1)
+			panic("test panic")
+		}),
+		logMock,
+		false,
+		time.Millisecond,
+	)
+	require.ErrorIs(t, task(context.Background()), ErrSupervisorGaveUp)
+	require.Equal(t, int64(2), runs.Load())
+}
+
+func TestNewSupervisor_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cfg         SupervisorConfig
+		errContains string
+	}{
+		{
+			name: "negative_max_restarts",
+			cfg: SupervisorConfig{
+				MaxRestarts:          -1,
+				RestartWindowSeconds: 1,
+			},
+			errContains: "max restarts must not be negative",
+		},
+		{
+			name:        "zero_restart_window",
+			cfg:         SupervisorConfig{},
+			errContains: "restart window must be positive",
+		},
+		{
+			name: "max_backoff_less_than_initial",
+			cfg: SupervisorConfig{
+				RestartWindowSeconds: 1,
+				InitialBackoff:       time.Second,
+				MaxBackoff:           time.Millisecond,
+			},
+			errContains: "max back-off must not be less than the initial back-off",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewSupervisor(tt.cfg, logger.NewAnyLogMock(gomock.NewController(t)), time.Now)
+			require.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the relayer OpenTelemetry tracer.
+const TracerName = "github.com/CoreumFoundation/coreumbridge-xrpl/relayer"
+
+// OTelConfig is the OpenTelemetry tracing config.
+type OTelConfig struct {
+	// Endpoint is the OTLP HTTP endpoint URL, e.g. http://localhost:4318 of the Jaeger collector, the empty endpoint
+	// disables the tracing.
+	Endpoint    string
+	ServiceName string
+}
+
+// DefaultOTelConfig returns the default OTelConfig.
+func DefaultOTelConfig() OTelConfig {
+	return OTelConfig{
+		Endpoint:    "",
+		ServiceName: "coreumbridge-xrpl-relayer",
+	}
+}
+
+// Tracer returns the relayer tracer of the global tracer provider, the tracer is no-op if the provider isn't set.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// InitOTelTracerProvider sets the global tracer provider exporting the spans to the OTLP HTTP endpoint and returns the
+// function which flushes the pending spans and stops the provider. Nothing is set if the endpoint is empty.
+func InitOTelTracerProvider(ctx context.Context, cfg OTelConfig) (func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporterOpts, err := otlpHTTPExporterOptions(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create OTLP trace exporter, endpoint:%s", cfg.Endpoint)
+	}
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build tracing resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return func(ctx context.Context) error {
+		return errors.Wrap(provider.Shutdown(ctx), "failed to shutdown tracer provider")
+	}, nil
+}
+
+func otlpHTTPExporterOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse tracing endpoint %s", endpoint)
+	}
+	if endpointURL.Host == "" {
+		return nil, errors.Errorf("invalid tracing endpoint %s, expected URL with host", endpoint)
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(endpointURL.Host),
+	}
+	switch endpointURL.Scheme {
+	case "http":
+		opts = append(opts, otlptracehttp.WithInsecure())
+	case "https":
+	default:
+		return nil, errors.Errorf("invalid tracing endpoint %s, expected http or https scheme", endpoint)
+	}
+	if endpointURL.Path != "" && endpointURL.Path != "/" {
+		opts = append(opts, otlptracehttp.WithURLPath(endpointURL.Path))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span names of the XRPL to Coreum evidence pipeline.
+const (
+	SpanXRPLScanPayment    = "xrpl.scan_payment"
+	SpanXRPLProcessTx      = "xrpl.process_tx"
+	SpanBuildEvidence      = "evidence.build"
+	SpanCoreumSaveEvidence = "coreum.save_evidence"
+	SpanCoreumBroadcastTx  = "coreum.broadcast_tx"
+)
+
+// Span attribute keys.
+const (
+	AttributeXRPLTxHash     = "xrpl.tx_hash"
+	AttributeXRPLLedger     = "xrpl.ledger_index"
+	AttributeCoreumTxHash   = "coreum.tx_hash"
+	AttributeCoreumTxHeight = "coreum.tx_height"
+)
+
+// XRPLTxSpans passes the spans of the XRPL txs from the scanner to the tx processor, since the scanned txs are passed
+// through the channel without the context.
+type XRPLTxSpans struct {
+	mu    sync.Mutex
+	spans map[string]trace.Span
+}
+
+// NewXRPLTxSpans returns a new instance of the XRPLTxSpans.
+func NewXRPLTxSpans() *XRPLTxSpans {
+	return &XRPLTxSpans{
+		spans: make(map[string]trace.Span),
+	}
+}
+
+// Start starts the root span of the scanned XRPL tx, the span is kept until it's taken by the tx processor. The span
+// isn't started if the span of the same tx is already kept, e.g. when the tx is returned by both recent and full
+// scans.
+func (s *XRPLTxSpans) Start(ctx context.Context, txHash string, attrs ...attribute.KeyValue) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.spans[txHash]; ok {
+		return
+	}
+	_, span := Tracer().Start(
+		ctx,
+		SpanXRPLScanPayment,
+		trace.WithNewRoot(),
+		trace.WithAttributes(append(attrs, attribute.String(AttributeXRPLTxHash, txHash))...),
+	)
+	s.spans[txHash] = span
+}
+
+// Discard ends and removes the span of the tx which won't be processed.
+func (s *XRPLTxSpans) Discard(txHash string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	span, ok := s.spans[txHash]
+	delete(s.spans, txHash)
+	s.mu.Unlock()
+	if ok {
+		span.End()
+	}
+}
+
+// Take removes the span of the tx started by the scanner and returns the context with it. The new span is started if
+// the tx has no span, e.g. when the tx is re-processed from the retry queue. The caller must end the returned span.
+func (s *XRPLTxSpans) Take(ctx context.Context, txHash string) (context.Context, trace.Span) {
+	if s != nil {
+		s.mu.Lock()
+		span, ok := s.spans[txHash]
+		delete(s.spans, txHash)
+		s.mu.Unlock()
+		if ok {
+			return trace.ContextWithSpan(ctx, span), span
+		}
+	}
+
+	return Tracer().Start(
+		ctx,
+		SpanXRPLProcessTx,
+		trace.WithNewRoot(),
+		trace.WithAttributes(attribute.String(AttributeXRPLTxHash, txHash)),
+	)
+}
+
+// EndSpan records the error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,105 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/tracing"
+)
+
+func TestXRPLTxSpans_ScannerSpanIsContinuedByProcessor(t *testing.T) {
+	recorder := setTestTracerProvider(t)
+	ctx := context.Background()
+
+	spans := tracing.NewXRPLTxSpans()
+	spans.Start(ctx, "HASH", attribute.Int64(tracing.AttributeXRPLLedger, 10))
+	// the second scan of the same tx keeps the first span
+	spans.Start(ctx, "HASH")
+
+	ctx, span := spans.Take(ctx, "HASH")
+	_, evidenceSpan := tracing.Tracer().Start(ctx, tracing.SpanBuildEvidence)
+	tracing.EndSpan(evidenceSpan, nil)
+	_, broadcastSpan := tracing.Tracer().Start(ctx, tracing.SpanCoreumBroadcastTx)
+	tracing.EndSpan(broadcastSpan, errors.New("broadcast failed"))
+	tracing.EndSpan(span, nil)
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 3)
+	root := ended[2]
+	require.Equal(t, tracing.SpanXRPLScanPayment, root.Name())
+	require.False(t, root.Parent().IsValid())
+	require.ElementsMatch(t, []attribute.KeyValue{
+		attribute.Int64(tracing.AttributeXRPLLedger, 10),
+		attribute.String(tracing.AttributeXRPLTxHash, "HASH"),
+	}, root.Attributes())
+	for _, child := range ended[:2] {
+		require.Equal(t, root.SpanContext().TraceID(), child.SpanContext().TraceID())
+		require.Equal(t, root.SpanContext().SpanID(), child.Parent().SpanID())
+	}
+	require.Equal(t, codes.Unset, ended[0].Status().Code)
+	require.Equal(t, codes.Error, ended[1].Status().Code)
+
+	// the span is taken once, so the re-processing starts the new trace
+	_, span = spans.Take(context.Background(), "HASH")
+	tracing.EndSpan(span, nil)
+	ended = recorder.Ended()
+	require.Len(t, ended, 4)
+	require.Equal(t, tracing.SpanXRPLProcessTx, ended[3].Name())
+	require.NotEqual(t, root.SpanContext().TraceID(), ended[3].SpanContext().TraceID())
+}
+
+func TestXRPLTxSpans_Discard(t *testing.T) {
+	recorder := setTestTracerProvider(t)
+
+	spans := tracing.NewXRPLTxSpans()
+	spans.Start(context.Background(), "HASH")
+	spans.Discard("HASH")
+	require.Len(t, recorder.Ended(), 1)
+
+	_, span := spans.Take(context.Background(), "HASH")
+	require.NotEqual(t, recorder.Ended()[0].SpanContext().SpanID(), span.SpanContext().SpanID())
+}
+
+func TestXRPLTxSpans_Nil(t *testing.T) {
+	setTestTracerProvider(t)
+
+	var spans *tracing.XRPLTxSpans
+	spans.Start(context.Background(), "HASH")
+	spans.Discard("HASH")
+	_, span := spans.Take(context.Background(), "HASH")
+	require.True(t, span.SpanContext().IsValid())
+}
+
+func TestInitOTelTracerProvider(t *testing.T) {
+	ctx := context.Background()
+
+	shutdown, err := tracing.InitOTelTracerProvider(ctx, tracing.DefaultOTelConfig())
+	require.NoError(t, err)
+	require.NoError(t, shutdown(ctx))
+
+	cfg := tracing.DefaultOTelConfig()
+	cfg.Endpoint = "localhost:4318"
+	_, err = tracing.InitOTelTracerProvider(ctx, cfg)
+	require.ErrorContains(t, err, "invalid tracing endpoint")
+}
+
+func setTestTracerProvider(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+	})
+
+	return recorder
+}
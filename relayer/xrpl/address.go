@@ -0,0 +1,146 @@
+package xrpl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+
+	"github.com/pkg/errors"
+	ripplecrypto "github.com/rubblelabs/ripple/crypto"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+)
+
+const (
+	xAddressLength = 31
+	// xAddressTagFlagOffset is the offset of the flag byte indicating whether the X-address has the destination tag.
+	xAddressTagFlagOffset = 22
+	xAddressTagOffset     = 23
+)
+
+var (
+	xAddressMainnetPrefix = []byte{0x05, 0x44}
+	xAddressTestnetPrefix = []byte{0x04, 0x93}
+)
+
+// XAddress is the decoded X-address, the packed format of the classic address and destination tag.
+type XAddress struct {
+	Account rippledata.Account
+	Tag     *uint32
+	Testnet bool
+}
+
+// IsXAddress returns true if the address has the X-address format prefix.
+func IsXAddress(address string) bool {
+	return strings.HasPrefix(address, "X") || strings.HasPrefix(address, "T")
+}
+
+// DecodeXAddress decodes the mainnet (X prefixed) or testnet (T prefixed) X-address.
+func DecodeXAddress(address string) (XAddress, error) {
+	decoded, err := ripplecrypto.Base58Decode(address, ripplecrypto.ALPHABET)
+	if err != nil {
+		return XAddress{}, errors.Wrapf(err, "invalid X-address %s", address)
+	}
+	// the decoded bytes include the 4 bytes checksum
+	if len(decoded) != xAddressLength+4 {
+		return XAddress{}, errors.Errorf("invalid X-address %s, unexpected length", address)
+	}
+	decoded = decoded[:xAddressLength]
+
+	var xAddress XAddress
+	switch prefix := decoded[:2]; {
+	case bytes.Equal(prefix, xAddressMainnetPrefix):
+	case bytes.Equal(prefix, xAddressTestnetPrefix):
+		xAddress.Testnet = true
+	default:
+		return XAddress{}, errors.Errorf("invalid X-address %s, unexpected prefix", address)
+	}
+	copy(xAddress.Account[:], decoded[2:xAddressTagFlagOffset])
+
+	tag := binary.LittleEndian.Uint64(decoded[xAddressTagOffset:])
+	switch decoded[xAddressTagFlagOffset] {
+	case 0:
+		if tag != 0 {
+			return XAddress{}, errors.Errorf("invalid X-address %s, the tag is set without the flag", address)
+		}
+	case 1:
+		// the 64-bit tags are reserved, and the XRPL supports only 32-bit ones
+		if tag > uint64(^uint32(0)) {
+			return XAddress{}, errors.Errorf("invalid X-address %s, unsupported 64-bit tag", address)
+		}
+		xAddress.Tag = lo.ToPtr(uint32(tag))
+	default:
+		return XAddress{}, errors.Errorf("invalid X-address %s, unexpected tag flag", address)
+	}
+
+	return xAddress, nil
+}
+
+// EncodeXAddress encodes the account and optional destination tag to the X-address.
+func EncodeXAddress(account rippledata.Account, tag *uint32, testnet bool) string {
+	decoded := make([]byte, 0, xAddressLength)
+	if testnet {
+		decoded = append(decoded, xAddressTestnetPrefix...)
+	} else {
+		decoded = append(decoded, xAddressMainnetPrefix...)
+	}
+	decoded = append(decoded, account[:]...)
+	tagBytes := make([]byte, 8)
+	if tag == nil {
+		decoded = append(decoded, 0)
+	} else {
+		decoded = append(decoded, 1)
+		binary.LittleEndian.PutUint64(tagBytes, uint64(*tag))
+	}
+	decoded = append(decoded, tagBytes...)
+
+	return ripplecrypto.Base58Encode(decoded, ripplecrypto.ALPHABET)
+}
+
+// ParseAddress parses the classic address or X-address and returns the account with the destination tag encoded in
+// the X-address. The X-address of the network not matching the mainnet flag is rejected.
+func ParseAddress(address string, mainnet bool) (rippledata.Account, *uint32, error) {
+	if !IsXAddress(address) {
+		account, err := rippledata.NewAccountFromAddress(address)
+		if err != nil {
+			return rippledata.Account{}, nil, errors.Wrapf(err, "invalid XRPL address %s", address)
+		}
+		return *account, nil, nil
+	}
+
+	xAddress, err := DecodeXAddress(address)
+	if err != nil {
+		return rippledata.Account{}, nil, err
+	}
+	if xAddress.Testnet && mainnet {
+		return rippledata.Account{}, nil, errors.Errorf(
+			"the X-address %s belongs to the XRPL test network and can't be used with the mainnet", address,
+		)
+	}
+	if !xAddress.Testnet && !mainnet {
+		return rippledata.Account{}, nil, errors.Errorf(
+			"the X-address %s belongs to the XRPL mainnet and can't be used with the test network", address,
+		)
+	}
+
+	return xAddress.Account, xAddress.Tag, nil
+}
+
+// ParseAccount parses the classic address or X-address without the destination tag. The X-address with the tag is
+// rejected since the tag can't be used, the sending to the classic address without the tag the destination requires
+// might lead to the funds loss.
+func ParseAccount(address string, mainnet bool) (rippledata.Account, error) {
+	account, tag, err := ParseAddress(address, mainnet)
+	if err != nil {
+		return rippledata.Account{}, err
+	}
+	if tag != nil {
+		return rippledata.Account{}, errors.Errorf(
+			"the X-address %s contains the destination tag %d, but the destination tags are not supported, "+
+				"the classic address of the X-address is %s, use it only if the destination doesn't require the tag",
+			address, *tag, account.String(),
+		)
+	}
+
+	return account, nil
+}
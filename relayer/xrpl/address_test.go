@@ -0,0 +1,188 @@
+package xrpl_test
+
+import (
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const classicAddress = "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"
+
+func TestDecodeXAddress(t *testing.T) {
+	t.Parallel()
+
+	account, err := rippledata.NewAccountFromAddress(classicAddress)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		address     string
+		want        xrpl.XAddress
+		errContains string
+	}{
+		{
+			name:    "mainnet_without_tag",
+			address: "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb",
+			want: xrpl.XAddress{
+				Account: *account,
+			},
+		},
+		{
+			name:    "testnet_without_tag",
+			address: "TVE26TYGhfLC7tQDno7G8dGtxSkYQn49b3qD26PK7FcGSKE",
+			want: xrpl.XAddress{
+				Account: *account,
+				Testnet: true,
+			},
+		},
+		{
+			name:    "mainnet_with_tag",
+			address: xrpl.EncodeXAddress(*account, lo.ToPtr(uint32(12345)), false),
+			want: xrpl.XAddress{
+				Account: *account,
+				Tag:     lo.ToPtr(uint32(12345)),
+			},
+		},
+		{
+			name:    "testnet_with_max_tag",
+			address: xrpl.EncodeXAddress(*account, lo.ToPtr(uint32(4294967295)), true),
+			want: xrpl.XAddress{
+				Account: *account,
+				Tag:     lo.ToPtr(uint32(4294967295)),
+				Testnet: true,
+			},
+		},
+		{
+			name:        "invalid_checksum",
+			address:     "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXc",
+			errContains: "invalid X-address",
+		},
+		{
+			name:        "invalid_chars",
+			address:     "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQX0",
+			errContains: "invalid X-address",
+		},
+		{
+			name:        "classic_address",
+			address:     classicAddress,
+			errContains: "unexpected length",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := xrpl.DecodeXAddress(tt.address)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.address, xrpl.EncodeXAddress(got.Account, got.Tag, got.Testnet))
+		})
+	}
+}
+
+func TestParseAccount(t *testing.T) {
+	t.Parallel()
+
+	account, err := rippledata.NewAccountFromAddress(classicAddress)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		address     string
+		mainnet     bool
+		errContains string
+	}{
+		{
+			name:    "classic_address_mainnet",
+			address: classicAddress,
+			mainnet: true,
+		},
+		{
+			name:    "classic_address_testnet",
+			address: classicAddress,
+		},
+		{
+			name:    "mainnet_x_address_without_tag",
+			address: xrpl.EncodeXAddress(*account, nil, false),
+			mainnet: true,
+		},
+		{
+			name:    "testnet_x_address_without_tag",
+			address: xrpl.EncodeXAddress(*account, nil, true),
+		},
+		{
+			name:        "testnet_x_address_on_mainnet",
+			address:     xrpl.EncodeXAddress(*account, nil, true),
+			mainnet:     true,
+			errContains: "belongs to the XRPL test network",
+		},
+		{
+			name:        "mainnet_x_address_on_testnet",
+			address:     xrpl.EncodeXAddress(*account, nil, false),
+			errContains: "belongs to the XRPL mainnet",
+		},
+		{
+			name:        "x_address_with_tag",
+			address:     xrpl.EncodeXAddress(*account, lo.ToPtr(uint32(1)), false),
+			mainnet:     true,
+			errContains: "contains the destination tag 1, but the destination tags are not supported",
+		},
+		{
+			name:        "x_address_with_zero_tag",
+			address:     xrpl.EncodeXAddress(*account, lo.ToPtr(uint32(0)), true),
+			errContains: "contains the destination tag 0",
+		},
+		{
+			name:        "malformed_x_address",
+			address:     "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQ",
+			mainnet:     true,
+			errContains: "invalid X-address",
+		},
+		{
+			name:        "malformed_classic_address",
+			address:     "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpe",
+			mainnet:     true,
+			errContains: "invalid XRPL address",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := xrpl.ParseAccount(tt.address, tt.mainnet)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, classicAddress, got.String())
+		})
+	}
+}
+
+func TestParseAddress_WithTag(t *testing.T) {
+	t.Parallel()
+
+	account, err := rippledata.NewAccountFromAddress(classicAddress)
+	require.NoError(t, err)
+
+	got, tag, err := xrpl.ParseAddress(xrpl.EncodeXAddress(*account, lo.ToPtr(uint32(777)), false), true)
+	require.NoError(t, err)
+	require.Equal(t, *account, got)
+	require.Equal(t, lo.ToPtr(uint32(777)), tag)
+
+	got, tag, err = xrpl.ParseAddress(classicAddress, true)
+	require.NoError(t, err)
+	require.Equal(t, *account, got)
+	require.Nil(t, tag)
+}
@@ -0,0 +1,217 @@
+package xrpl
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=amm_router_mocks_test.go -package=xrpl_test . AMMRouterRPCClient
+
+const (
+	ammNotFoundRPCErrorName = "actNotFound"
+	// MaxAMMSlippageBPS is the max allowed AMM slippage in basis points.
+	MaxAMMSlippageBPS = uint32(10_000)
+	// ammTradingFeeDenominator is the denominator of the AMM trading fee.
+	ammTradingFeeDenominator = 100_000
+)
+
+// AMMRouterRPCClient is the RPC client used by the AMMRouter.
+type AMMRouterRPCClient interface {
+	AccountLines(
+		ctx context.Context,
+		account rippledata.Account,
+		ledgerIndex any,
+		marker string,
+	) (AccountLinesResult, error)
+	AMMInfo(ctx context.Context, asset, asset2 rippledata.Asset) (AMMInfoResult, error)
+}
+
+// AMMRouterConfig is the AMMRouter config.
+type AMMRouterConfig struct {
+	// MaxSlippageBPS is the max difference in basis points between the XRP amount expected at the pool spot price and
+	// the XRP amount delivered to the bridge, the routed payment is rejected if the pool can't provide it.
+	MaxSlippageBPS uint32
+}
+
+// DefaultAMMRouterConfig returns the default AMMRouterConfig.
+func DefaultAMMRouterConfig() AMMRouterConfig {
+	return AMMRouterConfig{
+		MaxSlippageBPS: 100,
+	}
+}
+
+// AMMRouter routes the payments of the XRPL tokens to the bridge through the token/XRP AMM (XLS-30) pool when the
+// bridge trust line can't receive the token, so the bridge receives XRP instead. The routing changes the bridged
+// asset, so it's applied only to the payments the sender explicitly opts in for.
+type AMMRouter struct {
+	cfg       AMMRouterConfig
+	log       logger.Logger
+	rpcClient AMMRouterRPCClient
+}
+
+// NewAMMRouter returns a new instance of the AMMRouter.
+func NewAMMRouter(cfg AMMRouterConfig, log logger.Logger, rpcClient AMMRouterRPCClient) (*AMMRouter, error) {
+	if cfg.MaxSlippageBPS > MaxAMMSlippageBPS {
+		return nil, errors.Errorf(
+			"invalid max AMM slippage, max:%d bps, got:%d bps", MaxAMMSlippageBPS, cfg.MaxSlippageBPS,
+		)
+	}
+
+	return &AMMRouter{
+		cfg:       cfg,
+		log:       log,
+		rpcClient: rpcClient,
+	}, nil
+}
+
+// RoutePayment checks whether the payment token can be received by the payment destination directly, and if not,
+// updates the payment to deliver XRP through the token/XRP AMM pool, spending not more than the original amount.
+// Returns true if the payment is routed.
+func (r *AMMRouter) RoutePayment(ctx context.Context, payment *rippledata.Payment) (bool, error) {
+	if payment.Amount.IsNative() {
+		return false, nil
+	}
+
+	fits, err := r.fitsTrustLine(ctx, payment.Destination, payment.Amount)
+	if err != nil {
+		return false, err
+	}
+	if fits {
+		return false, nil
+	}
+
+	pool, err := r.rpcClient.AMMInfo(
+		ctx,
+		rippledata.Asset{Currency: XRPTokenCurrency.String()},
+		rippledata.Asset{
+			Currency: payment.Amount.Currency.String(),
+			Issuer:   payment.Amount.Issuer.String(),
+		},
+	)
+	if err != nil {
+		if IsAMMNotFoundError(err) {
+			r.log.Info(
+				ctx,
+				"AMM pool for the token is not found, the payment is sent directly",
+				zap.String("amount", payment.Amount.String()),
+			)
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get AMM pool, amount:%s", payment.Amount.String())
+	}
+
+	xrpAmount, err := r.quoteXRPAmount(pool.AMM, payment.Amount)
+	if err != nil {
+		return false, err
+	}
+
+	tokenAmount := payment.Amount
+	payment.SendMax = &tokenAmount
+	payment.Amount = xrpAmount
+	r.log.Info(
+		ctx,
+		"Payment is routed through the AMM pool",
+		zap.String("ammAccount", pool.AMM.Account.String()),
+		zap.String("sendMax", tokenAmount.String()),
+		zap.String("amount", xrpAmount.String()),
+	)
+
+	return true, nil
+}
+
+// IsAMMNotFoundError returns true if the error is the RPC error of the AMM pool which isn't found.
+func IsAMMNotFoundError(err error) bool {
+	var rpcErr *RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Name == ammNotFoundRPCErrorName
+}
+
+func (r *AMMRouter) fitsTrustLine(
+	ctx context.Context,
+	account rippledata.Account,
+	amount rippledata.Amount,
+) (bool, error) {
+	marker := ""
+	for {
+		accLines, err := r.rpcClient.AccountLines(ctx, account, "validated", marker)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get XRPL account lines, address:%s", account.String())
+		}
+		for _, line := range accLines.Lines {
+			if line.Account.String() != amount.Issuer.String() ||
+				line.Currency.String() != amount.Currency.String() {
+				continue
+			}
+			available := new(big.Rat).Sub(line.Limit.Value.Rat(), line.Balance.Value.Rat())
+			return available.Cmp(amount.Value.Rat()) >= 0, nil
+		}
+		if accLines.Marker == "" {
+			// the account doesn't have the trust line
+			return false, nil
+		}
+		marker = accLines.Marker
+	}
+}
+
+// quoteXRPAmount returns the XRP amount the pool is expected to deliver for the token amount with the max slippage
+// applied. The amount is computed with the constant product formula, and is rejected if it's lower than the amount
+// at the spot price by more than the max slippage.
+func (r *AMMRouter) quoteXRPAmount(pool AMMDescription, tokenAmount rippledata.Amount) (rippledata.Amount, error) {
+	var xrpPoolAmount, tokenPoolAmount rippledata.Amount
+	switch {
+	case pool.Amount.IsNative() && !pool.Amount2.IsNative():
+		xrpPoolAmount, tokenPoolAmount = pool.Amount, pool.Amount2
+	case !pool.Amount.IsNative() && pool.Amount2.IsNative():
+		xrpPoolAmount, tokenPoolAmount = pool.Amount2, pool.Amount
+	default:
+		return rippledata.Amount{}, errors.Errorf("AMM pool %s is not the token/XRP pool", pool.Account.String())
+	}
+	if xrpPoolAmount.Value.IsZero() || tokenPoolAmount.Value.IsZero() {
+		return rippledata.Amount{}, errors.Errorf("AMM pool %s is empty", pool.Account.String())
+	}
+
+	// token amount in without the trading fee
+	tokenIn := new(big.Rat).Mul(
+		tokenAmount.Value.Rat(),
+		big.NewRat(int64(ammTradingFeeDenominator-int64(pool.TradingFee)), ammTradingFeeDenominator),
+	)
+	xrpPool := xrpPoolAmount.Value.Rat()
+	tokenPool := tokenPoolAmount.Value.Rat()
+
+	// xrpOut = xrpPool * tokenIn / (tokenPool + tokenIn)
+	xrpOut := new(big.Rat).Mul(xrpPool, tokenIn)
+	xrpOut.Quo(xrpOut, new(big.Rat).Add(tokenPool, tokenIn))
+	// spotXRPOut = tokenIn * xrpPool / tokenPool
+	spotXRPOut := new(big.Rat).Mul(tokenIn, xrpPool)
+	spotXRPOut.Quo(spotXRPOut, tokenPool)
+
+	minXRPOut := new(big.Rat).Mul(
+		spotXRPOut,
+		big.NewRat(int64(MaxAMMSlippageBPS-r.cfg.MaxSlippageBPS), int64(MaxAMMSlippageBPS)),
+	)
+	if xrpOut.Cmp(minXRPOut) < 0 {
+		return rippledata.Amount{}, errors.Errorf(
+			"AMM pool slippage exceeds the max slippage of %d bps, expected XRP amount:%s, min XRP amount:%s",
+			r.cfg.MaxSlippageBPS, xrpOut.FloatString(0), minXRPOut.FloatString(0),
+		)
+	}
+
+	// the rounding down keeps the delivered amount within the quoted amount
+	minDrops := new(big.Int).Quo(minXRPOut.Num(), minXRPOut.Denom())
+	if minDrops.Sign() <= 0 || !minDrops.IsInt64() {
+		return rippledata.Amount{}, errors.Errorf("invalid AMM XRP amount in drops:%s", minDrops.String())
+	}
+	value, err := rippledata.NewNativeValue(minDrops.Int64())
+	if err != nil {
+		return rippledata.Amount{}, errors.Wrapf(err, "failed to build XRP amount, drops:%s", minDrops.String())
+	}
+
+	return rippledata.Amount{
+		Value: value,
+	}, nil
+}
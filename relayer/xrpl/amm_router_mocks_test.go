@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl (interfaces: AMMRouterRPCClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=amm_router_mocks_test.go -package=xrpl_test . AMMRouterRPCClient
+//
+
+// Package xrpl_test is a generated GoMock package.
+package xrpl_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+	data "github.com/rubblelabs/ripple/data"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAMMRouterRPCClient is a mock of AMMRouterRPCClient interface.
+type MockAMMRouterRPCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAMMRouterRPCClientMockRecorder
+}
+
+// MockAMMRouterRPCClientMockRecorder is the mock recorder for MockAMMRouterRPCClient.
+type MockAMMRouterRPCClientMockRecorder struct {
+	mock *MockAMMRouterRPCClient
+}
+
+// NewMockAMMRouterRPCClient creates a new mock instance.
+func NewMockAMMRouterRPCClient(ctrl *gomock.Controller) *MockAMMRouterRPCClient {
+	mock := &MockAMMRouterRPCClient{ctrl: ctrl}
+	mock.recorder = &MockAMMRouterRPCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAMMRouterRPCClient) EXPECT() *MockAMMRouterRPCClientMockRecorder {
+	return m.recorder
+}
+
+// AMMInfo mocks base method.
+func (m *MockAMMRouterRPCClient) AMMInfo(arg0 context.Context, arg1, arg2 data.Asset) (xrpl.AMMInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AMMInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(xrpl.AMMInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AMMInfo indicates an expected call of AMMInfo.
+func (mr *MockAMMRouterRPCClientMockRecorder) AMMInfo(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AMMInfo", reflect.TypeOf((*MockAMMRouterRPCClient)(nil).AMMInfo), arg0, arg1, arg2)
+}
+
+// AccountLines mocks base method.
+func (m *MockAMMRouterRPCClient) AccountLines(arg0 context.Context, arg1 data.Account, arg2 any, arg3 string) (xrpl.AccountLinesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountLines", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(xrpl.AccountLinesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountLines indicates an expected call of AccountLines.
+func (mr *MockAMMRouterRPCClientMockRecorder) AccountLines(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountLines", reflect.TypeOf((*MockAMMRouterRPCClient)(nil).AccountLines), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,229 @@
+package xrpl_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestAMMRouter_RoutePayment(t *testing.T) {
+	t.Parallel()
+
+	bridgeAccount := xrpl.GenPrivKeyTxSigner().Account()
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+	ammAccount := xrpl.GenPrivKeyTxSigner().Account()
+	currency, err := rippledata.NewCurrency("USD")
+	require.NoError(t, err)
+
+	tokenAmount := func(value string) rippledata.Amount {
+		amount, err := rippledata.NewAmount(value + "/" + currency.String() + "/" + issuer.String())
+		require.NoError(t, err)
+		return *amount
+	}
+	xrpAmount := func(drops int64) rippledata.Amount {
+		amount, err := rippledata.NewAmount(drops)
+		require.NoError(t, err)
+		return *amount
+	}
+	trustLine := func(limit, balance string) rippledata.AccountLine {
+		return rippledata.AccountLine{
+			Account:  issuer,
+			Currency: currency,
+			Limit:    rippledata.NonNativeValue{Value: *tokenAmount(limit).Value},
+			Balance:  rippledata.NonNativeValue{Value: *tokenAmount(balance).Value},
+		}
+	}
+	// the pool with 1M XRP and 1M tokens without the trading fee
+	pool := xrpl.AMMInfoResult{
+		AMM: xrpl.AMMDescription{
+			Account: ammAccount,
+			Amount:  xrpAmount(1_000_000_000_000),
+			Amount2: tokenAmount("1000000"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		cfg              xrpl.AMMRouterConfig
+		amount           rippledata.Amount
+		rpcClientBuilder func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient
+		wantRouted       bool
+		wantAmount       rippledata.Amount
+		wantErr          bool
+	}{
+		{
+			name: "xrp_amount",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: xrpAmount(10_000_000),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				return NewMockAMMRouterRPCClient(ctrl)
+			},
+			wantRouted: false,
+		},
+		{
+			name: "amount_fits_trust_line",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: tokenAmount("10"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{
+						Lines: rippledata.AccountLineSlice{trustLine("100", "90")},
+					}, nil)
+				return rpcClientMock
+			},
+			wantRouted: false,
+		},
+		{
+			name: "amm_pool_not_found",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: tokenAmount("10"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{
+						Lines: rippledata.AccountLineSlice{trustLine("100", "95")},
+					}, nil)
+				rpcClientMock.EXPECT().AMMInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(xrpl.AMMInfoResult{}, errors.Wrap(&xrpl.RPCError{Name: "actNotFound"}, "failed to call RPC"))
+				return rpcClientMock
+			},
+			wantRouted: false,
+		},
+		{
+			name: "routed_through_amm_pool",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: tokenAmount("10"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{
+						Lines: rippledata.AccountLineSlice{trustLine("100", "95")},
+					}, nil)
+				rpcClientMock.EXPECT().AMMInfo(
+					gomock.Any(),
+					rippledata.Asset{Currency: "XRP"},
+					rippledata.Asset{Currency: currency.String(), Issuer: issuer.String()},
+				).Return(pool, nil)
+				return rpcClientMock
+			},
+			wantRouted: true,
+			// 10 XRP at the spot price minus 1% slippage
+			wantAmount: xrpAmount(9_900_000),
+		},
+		{
+			name: "routed_without_trust_line",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 500,
+			},
+			amount: tokenAmount("10"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{
+						Marker: "next",
+					}, nil)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "next").
+					Return(xrpl.AccountLinesResult{}, nil)
+				poolWithFee := pool
+				// 1% trading fee
+				poolWithFee.AMM.TradingFee = 1000
+				rpcClientMock.EXPECT().AMMInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(poolWithFee, nil)
+				return rpcClientMock
+			},
+			wantRouted: true,
+			// 9.9 XRP at the spot price without fee minus 5% slippage
+			wantAmount: xrpAmount(9_405_000),
+		},
+		{
+			name: "max_slippage_exceeded",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: tokenAmount("100000"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{
+						Lines: rippledata.AccountLineSlice{trustLine("100", "95")},
+					}, nil)
+				rpcClientMock.EXPECT().AMMInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(pool, nil)
+				return rpcClientMock
+			},
+			wantErr: true,
+		},
+		{
+			name: "rpc_error",
+			cfg: xrpl.AMMRouterConfig{
+				MaxSlippageBPS: 100,
+			},
+			amount: tokenAmount("10"),
+			rpcClientBuilder: func(ctrl *gomock.Controller) xrpl.AMMRouterRPCClient {
+				rpcClientMock := NewMockAMMRouterRPCClient(ctrl)
+				rpcClientMock.EXPECT().AccountLines(gomock.Any(), bridgeAccount, "validated", "").
+					Return(xrpl.AccountLinesResult{}, errors.New("connection error"))
+				return rpcClientMock
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			router, err := xrpl.NewAMMRouter(tt.cfg, logger.NewAnyLogMock(ctrl), tt.rpcClientBuilder(ctrl))
+			require.NoError(t, err)
+			payment := rippledata.Payment{
+				Destination: bridgeAccount,
+				Amount:      tt.amount,
+			}
+
+			routed, err := router.RoutePayment(context.Background(), &payment)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRouted, routed)
+			if !tt.wantRouted {
+				require.Equal(t, tt.amount.String(), payment.Amount.String())
+				require.Nil(t, payment.SendMax)
+				return
+			}
+			require.Equal(t, tt.wantAmount.String(), payment.Amount.String())
+			require.NotNil(t, payment.SendMax)
+			require.Equal(t, tt.amount.String(), payment.SendMax.String())
+		})
+	}
+}
+
+func TestNewAMMRouter_InvalidMaxSlippage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	_, err := xrpl.NewAMMRouter(
+		xrpl.AMMRouterConfig{
+			MaxSlippageBPS: xrpl.MaxAMMSlippageBPS + 1,
+		},
+		logger.NewAnyLogMock(ctrl),
+		NewMockAMMRouterRPCClient(ctrl),
+	)
+	require.ErrorContains(t, err, "invalid max AMM slippage")
+}
@@ -0,0 +1,303 @@
+package xrpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+//go:generate mockgen -destination=bridge_account_mocks_test.go -package=xrpl_test . BridgeAccountRPCClient
+
+// BridgeAccountMisconfigurationReason is the reason of the XRPL bridge account misconfiguration.
+type BridgeAccountMisconfigurationReason string
+
+// BridgeAccountMisconfigurationReason values.
+const (
+	BridgeAccountMasterKeyEnabled      BridgeAccountMisconfigurationReason = "master_key_enabled"
+	BridgeAccountRegularKeySet         BridgeAccountMisconfigurationReason = "regular_key_set"
+	BridgeAccountDefaultRippleDisabled BridgeAccountMisconfigurationReason = "default_ripple_disabled"
+	BridgeAccountSignerListMissing     BridgeAccountMisconfigurationReason = "signer_list_missing"
+	BridgeAccountSignerQuorumMismatch  BridgeAccountMisconfigurationReason = "signer_quorum_mismatch"
+	BridgeAccountSignerEntriesMismatch BridgeAccountMisconfigurationReason = "signer_entries_mismatch"
+	BridgeAccountInsufficientReserve   BridgeAccountMisconfigurationReason = "insufficient_reserve"
+)
+
+const (
+	accountObjectsTypeTicket = "ticket"
+	accountObjectsTypeState  = "state"
+	// each relayer signs with the weight 1, so the quorum is equal to the evidence threshold
+	bridgeAccountSignerWeight = uint16(1)
+	dropsInXRP                = float64(1_000_000)
+)
+
+// BridgeAccountMisconfiguration is the found XRPL bridge account misconfiguration.
+type BridgeAccountMisconfiguration struct {
+	Reason  BridgeAccountMisconfigurationReason
+	Details string
+}
+
+// BridgeAccountExpectedConfig is the XRPL bridge account config expected by the contract.
+type BridgeAccountExpectedConfig struct {
+	// SignerQuorum is the expected signer list quorum, equal to the contract evidence threshold.
+	SignerQuorum uint32
+	// SignerAddresses are the XRPL addresses of the contract relayers.
+	SignerAddresses []string
+}
+
+// BridgeAccountState is the XRPL bridge account state used for the validation.
+type BridgeAccountState struct {
+	AccountInfo AccountInfoResult
+	// TicketsCount is the count of the ticket objects owned by the account.
+	TicketsCount uint32
+	// TrustLinesCount is the count of the trust lines the account holds the reserve for.
+	TrustLinesCount uint32
+	ReserveBase     int64
+	ReserveInc      int64
+}
+
+// BridgeAccountRPCClient is the RPC client used to fetch the XRPL bridge account state.
+type BridgeAccountRPCClient interface {
+	AccountInfo(ctx context.Context, acc rippledata.Account) (AccountInfoResult, error)
+	AccountObjects(
+		ctx context.Context,
+		account rippledata.Account,
+		objectType string,
+		marker any,
+	) (AccountObjectsResult, error)
+	ServerState(ctx context.Context) (ServerStateResult, error)
+}
+
+// VerifyBridgeAccount fetches the XRPL bridge account state and returns the found misconfigurations.
+func VerifyBridgeAccount(
+	ctx context.Context,
+	rpcClient BridgeAccountRPCClient,
+	bridgeAccount rippledata.Account,
+	expectedCfg BridgeAccountExpectedConfig,
+) ([]BridgeAccountMisconfiguration, error) {
+	state, err := FetchBridgeAccountState(ctx, rpcClient, bridgeAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateBridgeAccount(state, expectedCfg), nil
+}
+
+// FetchBridgeAccountState fetches the XRPL bridge account state.
+func FetchBridgeAccountState(
+	ctx context.Context,
+	rpcClient BridgeAccountRPCClient,
+	bridgeAccount rippledata.Account,
+) (BridgeAccountState, error) {
+	accountInfo, err := rpcClient.AccountInfo(ctx, bridgeAccount)
+	if err != nil {
+		return BridgeAccountState{}, errors.Wrap(err, "failed to get XRPL bridge account info")
+	}
+
+	var ticketsCount uint32
+	if err := iterateAccountObjects(
+		ctx, rpcClient, bridgeAccount, accountObjectsTypeTicket, func(json.RawMessage) error {
+			ticketsCount++
+			return nil
+		},
+	); err != nil {
+		return BridgeAccountState{}, err
+	}
+
+	var trustLinesCount uint32
+	if err := iterateAccountObjects(
+		ctx, rpcClient, bridgeAccount, accountObjectsTypeState, func(object json.RawMessage) error {
+			var state rippleStateObject
+			if err := json.Unmarshal(object, &state); err != nil {
+				return errors.Wrapf(err, "failed to decode ripple state object, object:%s", string(object))
+			}
+			if state.isReservedBy(bridgeAccount) {
+				trustLinesCount++
+			}
+			return nil
+		},
+	); err != nil {
+		return BridgeAccountState{}, err
+	}
+
+	serverState, err := rpcClient.ServerState(ctx)
+	if err != nil {
+		return BridgeAccountState{}, errors.Wrap(err, "failed to get XRPL server state")
+	}
+
+	return BridgeAccountState{
+		AccountInfo:     accountInfo,
+		TicketsCount:    ticketsCount,
+		TrustLinesCount: trustLinesCount,
+		ReserveBase:     serverState.State.ValidatedLedger.ReserveBase,
+		ReserveInc:      serverState.State.ValidatedLedger.ReserveInc,
+	}, nil
+}
+
+// ValidateBridgeAccount validates the XRPL bridge account state and returns the found misconfigurations.
+func ValidateBridgeAccount(
+	state BridgeAccountState,
+	expectedCfg BridgeAccountExpectedConfig,
+) []BridgeAccountMisconfiguration {
+	misconfigurations := make([]BridgeAccountMisconfiguration, 0)
+	accountData := state.AccountInfo.AccountData
+
+	var flags rippledata.LedgerEntryFlag
+	if accountData.Flags != nil {
+		flags = *accountData.Flags
+	}
+	if flags&rippledata.LsDisableMaster == 0 {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason:  BridgeAccountMasterKeyEnabled,
+			Details: "lsfDisableMaster flag is not set",
+		})
+	}
+	if flags&rippledata.LsDefaultRipple == 0 {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason:  BridgeAccountDefaultRippleDisabled,
+			Details: "lsfDefaultRipple flag is not set",
+		})
+	}
+	if accountData.RegularKey != nil {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason:  BridgeAccountRegularKeySet,
+			Details: fmt.Sprintf("regular key is set, key:%s", accountData.RegularKey.String()),
+		})
+	}
+
+	misconfigurations = append(misconfigurations, validateBridgeAccountSignerList(accountData, expectedCfg)...)
+
+	// the signer list reserve is included in the multi-signing reserve
+	requiredReserveDrops := state.ReserveBase +
+		state.ReserveInc*int64(state.TicketsCount+state.TrustLinesCount) +
+		MultiSigningReserveDrops
+	requiredReserve := float64(requiredReserveDrops) / dropsInXRP
+	var balance float64
+	if accountData.Balance != nil {
+		balance = accountData.Balance.Float()
+	}
+	if balance < requiredReserve {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason: BridgeAccountInsufficientReserve,
+			Details: fmt.Sprintf(
+				"balance is lower than the required reserve, balance:%f, reserve:%f, tickets:%d, trust lines:%d",
+				balance, requiredReserve, state.TicketsCount, state.TrustLinesCount,
+			),
+		})
+	}
+
+	return misconfigurations
+}
+
+func validateBridgeAccountSignerList(
+	accountData AccountDataWithSigners,
+	expectedCfg BridgeAccountExpectedConfig,
+) []BridgeAccountMisconfiguration {
+	if len(accountData.SignerList) == 0 {
+		return []BridgeAccountMisconfiguration{{
+			Reason:  BridgeAccountSignerListMissing,
+			Details: "account has no signer list",
+		}}
+	}
+
+	misconfigurations := make([]BridgeAccountMisconfiguration, 0)
+	signerList := accountData.SignerList[0]
+	var signerQuorum uint32
+	if signerList.SignerQuorum != nil {
+		signerQuorum = *signerList.SignerQuorum
+	}
+	if signerQuorum != expectedCfg.SignerQuorum {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason: BridgeAccountSignerQuorumMismatch,
+			Details: fmt.Sprintf(
+				"signer quorum doesn't match the evidence threshold, quorum:%d, threshold:%d",
+				signerQuorum, expectedCfg.SignerQuorum,
+			),
+		})
+	}
+
+	signerAddresses := make([]string, 0, len(signerList.SignerEntries))
+	for _, entry := range signerList.SignerEntries {
+		if entry.SignerEntry.Account == nil {
+			continue
+		}
+		address := entry.SignerEntry.Account.String()
+		if entry.SignerEntry.SignerWeight == nil || *entry.SignerEntry.SignerWeight != bridgeAccountSignerWeight {
+			misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+				Reason:  BridgeAccountSignerEntriesMismatch,
+				Details: fmt.Sprintf("unexpected signer weight, signer:%s", address),
+			})
+		}
+		signerAddresses = append(signerAddresses, address)
+	}
+	expectedSignerAddresses := append([]string{}, expectedCfg.SignerAddresses...)
+	sort.Strings(signerAddresses)
+	sort.Strings(expectedSignerAddresses)
+	if strings.Join(signerAddresses, ",") != strings.Join(expectedSignerAddresses, ",") {
+		misconfigurations = append(misconfigurations, BridgeAccountMisconfiguration{
+			Reason: BridgeAccountSignerEntriesMismatch,
+			Details: fmt.Sprintf(
+				"signer entries don't match the contract relayers, signers:%v, relayers:%v",
+				signerAddresses, expectedSignerAddresses,
+			),
+		})
+	}
+
+	return misconfigurations
+}
+
+func iterateAccountObjects(
+	ctx context.Context,
+	rpcClient accountObjectsProvider,
+	account rippledata.Account,
+	objectType string,
+	handler func(object json.RawMessage) error,
+) error {
+	var marker any
+	for {
+		res, err := rpcClient.AccountObjects(ctx, account, objectType, marker)
+		if err != nil {
+			return errors.Wrapf(
+				err, "failed to get XRPL account objects, address:%s, type:%s", account.String(), objectType,
+			)
+		}
+		for _, object := range res.AccountObjects {
+			if err := handler(object); err != nil {
+				return err
+			}
+		}
+		if res.Marker == nil {
+			return nil
+		}
+		marker = res.Marker
+	}
+}
+
+// accountObjectsProvider is the RPC client used to iterate the account objects.
+type accountObjectsProvider interface {
+	AccountObjects(
+		ctx context.Context,
+		account rippledata.Account,
+		objectType string,
+		marker any,
+	) (AccountObjectsResult, error)
+}
+
+// rippleStateObject is the part of the ripple state object used to find the side holding the reserve.
+type rippleStateObject struct {
+	Flags     rippledata.LedgerEntryFlag `json:"Flags"`
+	LowLimit  rippledata.Amount          `json:"LowLimit"`
+	HighLimit rippledata.Amount          `json:"HighLimit"`
+}
+
+func (s rippleStateObject) isReservedBy(account rippledata.Account) bool {
+	if s.LowLimit.Issuer == account && s.Flags&rippledata.LsLowReserve != 0 {
+		return true
+	}
+
+	return s.HighLimit.Issuer == account && s.Flags&rippledata.LsHighReserve != 0
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl (interfaces: BridgeAccountRPCClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=bridge_account_mocks_test.go -package=xrpl_test . BridgeAccountRPCClient
+//
+
+// Package xrpl_test is a generated GoMock package.
+package xrpl_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+	data "github.com/rubblelabs/ripple/data"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBridgeAccountRPCClient is a mock of BridgeAccountRPCClient interface.
+type MockBridgeAccountRPCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeAccountRPCClientMockRecorder
+}
+
+// MockBridgeAccountRPCClientMockRecorder is the mock recorder for MockBridgeAccountRPCClient.
+type MockBridgeAccountRPCClientMockRecorder struct {
+	mock *MockBridgeAccountRPCClient
+}
+
+// NewMockBridgeAccountRPCClient creates a new mock instance.
+func NewMockBridgeAccountRPCClient(ctrl *gomock.Controller) *MockBridgeAccountRPCClient {
+	mock := &MockBridgeAccountRPCClient{ctrl: ctrl}
+	mock.recorder = &MockBridgeAccountRPCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeAccountRPCClient) EXPECT() *MockBridgeAccountRPCClientMockRecorder {
+	return m.recorder
+}
+
+// AccountInfo mocks base method.
+func (m *MockBridgeAccountRPCClient) AccountInfo(arg0 context.Context, arg1 data.Account) (xrpl.AccountInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountInfo", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.AccountInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountInfo indicates an expected call of AccountInfo.
+func (mr *MockBridgeAccountRPCClientMockRecorder) AccountInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountInfo", reflect.TypeOf((*MockBridgeAccountRPCClient)(nil).AccountInfo), arg0, arg1)
+}
+
+// AccountObjects mocks base method.
+func (m *MockBridgeAccountRPCClient) AccountObjects(arg0 context.Context, arg1 data.Account, arg2 string, arg3 any) (xrpl.AccountObjectsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountObjects", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(xrpl.AccountObjectsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountObjects indicates an expected call of AccountObjects.
+func (mr *MockBridgeAccountRPCClientMockRecorder) AccountObjects(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountObjects", reflect.TypeOf((*MockBridgeAccountRPCClient)(nil).AccountObjects), arg0, arg1, arg2, arg3)
+}
+
+// ServerState mocks base method.
+func (m *MockBridgeAccountRPCClient) ServerState(arg0 context.Context) (xrpl.ServerStateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerState", arg0)
+	ret0, _ := ret[0].(xrpl.ServerStateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerState indicates an expected call of ServerState.
+func (mr *MockBridgeAccountRPCClientMockRecorder) ServerState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerState", reflect.TypeOf((*MockBridgeAccountRPCClient)(nil).ServerState), arg0)
+}
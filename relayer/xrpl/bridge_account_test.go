@@ -0,0 +1,311 @@
+package xrpl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const (
+	// lsfDisableMaster | lsfDefaultRipple
+	validBridgeAccountFlags = 9437184
+	// lsfDefaultRipple
+	defaultRippleFlag = 8388608
+	// 10 XRP
+	reserveBaseDrops = 10_000_000
+	// 2 XRP
+	reserveIncDrops = 2_000_000
+)
+
+type bridgeAccountFixture struct {
+	Flags         uint32
+	BalanceDrops  uint64
+	RegularKey    string
+	SignerQuorum  uint32
+	SignerWeight  uint16
+	SignerAccount []string
+	NoSignerList  bool
+}
+
+func TestValidateBridgeAccount(t *testing.T) {
+	t.Parallel()
+
+	bridgeAccount := xrpl.GenPrivKeyTxSigner().Account()
+	relayerAddresses := []string{
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+		xrpl.GenPrivKeyTxSigner().Account().String(),
+	}
+	expectedCfg := xrpl.BridgeAccountExpectedConfig{
+		SignerQuorum:    2,
+		SignerAddresses: relayerAddresses,
+	}
+	validFixture := func() bridgeAccountFixture {
+		return bridgeAccountFixture{
+			Flags:         validBridgeAccountFlags,
+			BalanceDrops:  100_000_000,
+			SignerQuorum:  2,
+			SignerWeight:  1,
+			SignerAccount: []string{relayerAddresses[2], relayerAddresses[0], relayerAddresses[1]},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		fixture         func() bridgeAccountFixture
+		ticketsCount    uint32
+		trustLinesCount uint32
+		wantReasons     []xrpl.BridgeAccountMisconfigurationReason
+	}{
+		{
+			name:         "valid",
+			fixture:      validFixture,
+			ticketsCount: 10,
+			wantReasons:  []xrpl.BridgeAccountMisconfigurationReason{},
+		},
+		{
+			name: "master_key_enabled",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.Flags = defaultRippleFlag
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountMasterKeyEnabled},
+		},
+		{
+			name: "default_ripple_disabled",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.Flags = validBridgeAccountFlags - defaultRippleFlag
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountDefaultRippleDisabled},
+		},
+		{
+			name: "regular_key_set",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.RegularKey = xrpl.GenPrivKeyTxSigner().Account().String()
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountRegularKeySet},
+		},
+		{
+			name: "signer_list_missing",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.NoSignerList = true
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountSignerListMissing},
+		},
+		{
+			name: "signer_quorum_mismatch",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.SignerQuorum = 1
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountSignerQuorumMismatch},
+		},
+		{
+			name: "unknown_signer",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.SignerAccount[0] = xrpl.GenPrivKeyTxSigner().Account().String()
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountSignerEntriesMismatch},
+		},
+		{
+			name: "missing_signer",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.SignerAccount = fixture.SignerAccount[:2]
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountSignerEntriesMismatch},
+		},
+		{
+			name: "unexpected_signer_weight",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.SignerWeight = 2
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{
+				xrpl.BridgeAccountSignerEntriesMismatch,
+				xrpl.BridgeAccountSignerEntriesMismatch,
+				xrpl.BridgeAccountSignerEntriesMismatch,
+			},
+		},
+		{
+			name:    "insufficient_reserve",
+			fixture: validFixture,
+			// 10 + 2 * (40 + 5) + 2 = 102 XRP
+			ticketsCount:    40,
+			trustLinesCount: 5,
+			wantReasons:     []xrpl.BridgeAccountMisconfigurationReason{xrpl.BridgeAccountInsufficientReserve},
+		},
+		{
+			name: "multiple_misconfigurations",
+			fixture: func() bridgeAccountFixture {
+				fixture := validFixture()
+				fixture.Flags = 0
+				fixture.SignerQuorum = 3
+				return fixture
+			},
+			wantReasons: []xrpl.BridgeAccountMisconfigurationReason{
+				xrpl.BridgeAccountMasterKeyEnabled,
+				xrpl.BridgeAccountDefaultRippleDisabled,
+				xrpl.BridgeAccountSignerQuorumMismatch,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := xrpl.BridgeAccountState{
+				AccountInfo:     decodeAccountInfoFixture(t, bridgeAccount, tt.fixture()),
+				TicketsCount:    tt.ticketsCount,
+				TrustLinesCount: tt.trustLinesCount,
+				ReserveBase:     reserveBaseDrops,
+				ReserveInc:      reserveIncDrops,
+			}
+			misconfigurations := xrpl.ValidateBridgeAccount(state, expectedCfg)
+			reasons := make([]xrpl.BridgeAccountMisconfigurationReason, 0, len(misconfigurations))
+			for _, misconfiguration := range misconfigurations {
+				require.NotEmpty(t, misconfiguration.Details)
+				reasons = append(reasons, misconfiguration.Reason)
+			}
+			require.Equal(t, tt.wantReasons, reasons)
+		})
+	}
+}
+
+func TestFetchBridgeAccountState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	rpcClientMock := NewMockBridgeAccountRPCClient(ctrl)
+
+	bridgeAccount := xrpl.GenPrivKeyTxSigner().Account()
+	holder := xrpl.GenPrivKeyTxSigner().Account()
+	issuer := xrpl.GenPrivKeyTxSigner().Account()
+
+	accountInfo := decodeAccountInfoFixture(t, bridgeAccount, bridgeAccountFixture{
+		Flags:        validBridgeAccountFlags,
+		BalanceDrops: 100_000_000,
+		NoSignerList: true,
+	})
+	rpcClientMock.EXPECT().AccountInfo(ctx, bridgeAccount).Return(accountInfo, nil)
+
+	ticket := json.RawMessage(`{"LedgerEntryType":"Ticket","TicketSequence":1}`)
+	rpcClientMock.EXPECT().AccountObjects(ctx, bridgeAccount, "ticket", nil).
+		Return(xrpl.AccountObjectsResult{
+			AccountObjects: []json.RawMessage{ticket, ticket},
+			Marker:         "next",
+		}, nil)
+	rpcClientMock.EXPECT().AccountObjects(ctx, bridgeAccount, "ticket", "next").
+		Return(xrpl.AccountObjectsResult{
+			AccountObjects: []json.RawMessage{ticket},
+		}, nil)
+
+	rpcClientMock.EXPECT().AccountObjects(ctx, bridgeAccount, "state", nil).
+		Return(xrpl.AccountObjectsResult{
+			AccountObjects: []json.RawMessage{
+				// the bridge account holds the token issued by the issuer, the reserve is on the bridge side
+				rippleStateFixture(bridgeAccount, issuer, 65536),
+				// the holder holds the token issued by the bridge account, the reserve is on the holder side
+				rippleStateFixture(bridgeAccount, holder, 131072),
+			},
+		}, nil)
+
+	rpcClientMock.EXPECT().ServerState(ctx).Return(xrpl.ServerStateResult{
+		State: xrpl.ServerState{
+			ValidatedLedger: xrpl.ServerStateValidatedLedger{
+				ReserveBase: reserveBaseDrops,
+				ReserveInc:  reserveIncDrops,
+			},
+		},
+	}, nil)
+
+	state, err := xrpl.FetchBridgeAccountState(ctx, rpcClientMock, bridgeAccount)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), state.TicketsCount)
+	require.Equal(t, uint32(1), state.TrustLinesCount)
+	require.Equal(t, int64(reserveBaseDrops), state.ReserveBase)
+	require.Equal(t, int64(reserveIncDrops), state.ReserveInc)
+}
+
+func decodeAccountInfoFixture(
+	t *testing.T,
+	bridgeAccount rippledata.Account,
+	fixture bridgeAccountFixture,
+) xrpl.AccountInfoResult {
+	t.Helper()
+
+	regularKey := ""
+	if fixture.RegularKey != "" {
+		regularKey = fmt.Sprintf(`"RegularKey": %q,`, fixture.RegularKey)
+	}
+	signerEntries := make([]string, 0, len(fixture.SignerAccount))
+	for _, signer := range fixture.SignerAccount {
+		signerEntries = append(
+			signerEntries,
+			fmt.Sprintf(`{"SignerEntry": {"Account": %q, "SignerWeight": %d}}`, signer, fixture.SignerWeight),
+		)
+	}
+	signerLists := "[]"
+	if !fixture.NoSignerList {
+		signerLists = fmt.Sprintf(`[{
+			"Flags": 65536,
+			"LedgerEntryType": "SignerList",
+			"OwnerNode": "0",
+			"SignerListID": 0,
+			"SignerQuorum": %d,
+			"SignerEntries": [%s]
+		}]`, fixture.SignerQuorum, strings.Join(signerEntries, ","))
+	}
+
+	accountInfoJSON := fmt.Sprintf(`{
+		"account_data": {
+			"Account": %q,
+			"Balance": "%d",
+			"Flags": %d,
+			"LedgerEntryType": "AccountRoot",
+			"OwnerCount": 4,
+			%s
+			"Sequence": 5,
+			"signer_lists": %s
+		},
+		"ledger_current_index": 100,
+		"validated": false
+	}`, bridgeAccount.String(), fixture.BalanceDrops, fixture.Flags, regularKey, signerLists)
+
+	var accountInfo xrpl.AccountInfoResult
+	require.NoError(t, json.Unmarshal([]byte(accountInfoJSON), &accountInfo))
+
+	return accountInfo
+}
+
+func rippleStateFixture(low, high rippledata.Account, flags uint32) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf(`{
+		"LedgerEntryType": "RippleState",
+		"Flags": %d,
+		"Balance": {"currency": "USD", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "0"},
+		"LowLimit": {"currency": "USD", "issuer": %q, "value": "100"},
+		"HighLimit": {"currency": "USD", "issuer": %q, "value": "0"}
+	}`, flags, low.String(), high.String()))
+}
@@ -0,0 +1,201 @@
+package xrpl
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=check_scanner_mocks_test.go -package=xrpl_test . CheckScannerRPCClient
+
+const accountObjectsTypeCheck = "check"
+
+// CheckScannerRPCClient is the RPC client used by the CheckScanner.
+type CheckScannerRPCClient interface {
+	AccountObjects(
+		ctx context.Context,
+		account rippledata.Account,
+		objectType string,
+		marker any,
+	) (AccountObjectsResult, error)
+	Tx(ctx context.Context, hash rippledata.Hash256) (TxResult, error)
+}
+
+// Check is the XRPL Check created for the bridge account.
+type Check struct {
+	ID rippledata.Hash256
+	// TxHash is the hash of the CheckCreate transaction.
+	TxHash    rippledata.Hash256
+	Sender    rippledata.Account
+	SendMax   rippledata.Amount
+	Recipient sdk.AccAddress
+	// Expiration is the time after which the check can't be cashed, nil if the check doesn't expire.
+	Expiration *time.Time
+}
+
+// CheckScanner finds the not expired XRPL Checks created for the bridge account with the Coreum recipient in the
+// CheckCreate memo.
+type CheckScanner struct {
+	bridgeAccount rippledata.Account
+	log           logger.Logger
+	rpcClient     CheckScannerRPCClient
+	clock         func() time.Time
+
+	mu sync.Mutex
+	// checks are the known checks by ID, the CheckCreate tx is fetched once per check
+	checks map[rippledata.Hash256]Check
+	// skipped are the IDs of the checks created without the Coreum recipient
+	skipped map[rippledata.Hash256]struct{}
+}
+
+// NewCheckScanner returns a new instance of the CheckScanner.
+func NewCheckScanner(
+	bridgeAccount rippledata.Account,
+	log logger.Logger,
+	rpcClient CheckScannerRPCClient,
+	clock func() time.Time,
+) *CheckScanner {
+	return &CheckScanner{
+		bridgeAccount: bridgeAccount,
+		log:           log,
+		rpcClient:     rpcClient,
+		clock:         clock,
+		checks:        make(map[rippledata.Hash256]Check),
+		skipped:       make(map[rippledata.Hash256]struct{}),
+	}
+}
+
+// ScanChecks returns the checks currently open for the bridge account. The checks which are cashed or canceled are
+// removed from the known checks.
+func (s *CheckScanner) ScanChecks(ctx context.Context) ([]Check, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock()
+	openCheckIDs := make(map[rippledata.Hash256]struct{})
+	checks := make([]Check, 0)
+	if err := iterateAccountObjects(
+		ctx, s.rpcClient, s.bridgeAccount, accountObjectsTypeCheck, func(object json.RawMessage) error {
+			var ledgerCheck rippledata.Check
+			if err := json.Unmarshal(object, &ledgerCheck); err != nil {
+				return errors.Wrapf(err, "failed to decode check object, object:%s", string(object))
+			}
+			if ledgerCheck.LedgerIndex == nil {
+				return errors.Errorf("check object without index, object:%s", string(object))
+			}
+			checkID := *ledgerCheck.LedgerIndex
+			openCheckIDs[checkID] = struct{}{}
+			// the account objects include the checks created by the bridge account
+			if ledgerCheck.Destination == nil || *ledgerCheck.Destination != s.bridgeAccount {
+				return nil
+			}
+			check, ok, err := s.getCheck(ctx, ledgerCheck)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			if check.Expiration != nil && !check.Expiration.After(now) {
+				s.log.Debug(ctx, "Skipping expired XRPL check", zap.String("checkID", checkID.String()))
+				return nil
+			}
+			checks = append(checks, check)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	for checkID := range s.checks {
+		if _, ok := openCheckIDs[checkID]; !ok {
+			delete(s.checks, checkID)
+		}
+	}
+	for checkID := range s.skipped {
+		if _, ok := openCheckIDs[checkID]; !ok {
+			delete(s.skipped, checkID)
+		}
+	}
+
+	return checks, nil
+}
+
+func (s *CheckScanner) getCheck(ctx context.Context, ledgerCheck rippledata.Check) (Check, bool, error) {
+	checkID := *ledgerCheck.LedgerIndex
+	if check, ok := s.checks[checkID]; ok {
+		return check, true, nil
+	}
+	if _, ok := s.skipped[checkID]; ok {
+		return Check{}, false, nil
+	}
+	if ledgerCheck.Account == nil || ledgerCheck.SendMax == nil || ledgerCheck.PreviousTxnID == nil {
+		return Check{}, false, errors.Errorf("check object with missing fields, check:%+v", ledgerCheck)
+	}
+
+	// the check isn't modified after the creation, so the previous tx is the CheckCreate tx
+	txRes, err := s.rpcClient.Tx(ctx, *ledgerCheck.PreviousTxnID)
+	if err != nil {
+		return Check{}, false, errors.Wrapf(
+			err, "failed to get XRPL CheckCreate tx, hash:%s", ledgerCheck.PreviousTxnID.String(),
+		)
+	}
+	checkCreateTx, ok := txRes.Transaction.(*rippledata.CheckCreate)
+	if !ok {
+		return Check{}, false, errors.Errorf(
+			"unexpected XRPL check previous tx type, checkID:%s, type:%s", checkID.String(), txRes.GetType(),
+		)
+	}
+	if err := ValidateMemoLimits(checkCreateTx.Memos); err != nil {
+		s.log.Warn(
+			ctx,
+			"Skipping XRPL check with memos exceeding the limits",
+			zap.String("checkID", checkID.String()),
+			zap.Error(err),
+		)
+		s.skipped[checkID] = struct{}{}
+		return Check{}, false, nil
+	}
+	recipient := DecodeCoreumRecipientFromMemo(checkCreateTx.Memos)
+	if recipient == nil {
+		s.log.Debug(
+			ctx,
+			"Skipping XRPL check without Coreum recipient in memo",
+			zap.String("checkID", checkID.String()),
+			zap.Any("memos", checkCreateTx.Memos),
+		)
+		s.skipped[checkID] = struct{}{}
+		return Check{}, false, nil
+	}
+
+	check := Check{
+		ID:        checkID,
+		TxHash:    *ledgerCheck.PreviousTxnID,
+		Sender:    *ledgerCheck.Account,
+		SendMax:   *ledgerCheck.SendMax,
+		Recipient: recipient,
+	}
+	if ledgerCheck.Expiration != nil {
+		expiration := rippledata.NewRippleTime(*ledgerCheck.Expiration).Time()
+		check.Expiration = &expiration
+	}
+	s.log.Info(
+		ctx,
+		"Found new XRPL check",
+		zap.String("checkID", strings.ToUpper(checkID.String())),
+		zap.String("sender", check.Sender.String()),
+		zap.String("recipient", check.Recipient.String()),
+	)
+	s.checks[checkID] = check
+
+	return check, true, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl (interfaces: CheckScannerRPCClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=check_scanner_mocks_test.go -package=xrpl_test . CheckScannerRPCClient
+//
+
+// Package xrpl_test is a generated GoMock package.
+package xrpl_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+	data "github.com/rubblelabs/ripple/data"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCheckScannerRPCClient is a mock of CheckScannerRPCClient interface.
+type MockCheckScannerRPCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckScannerRPCClientMockRecorder
+}
+
+// MockCheckScannerRPCClientMockRecorder is the mock recorder for MockCheckScannerRPCClient.
+type MockCheckScannerRPCClientMockRecorder struct {
+	mock *MockCheckScannerRPCClient
+}
+
+// NewMockCheckScannerRPCClient creates a new mock instance.
+func NewMockCheckScannerRPCClient(ctrl *gomock.Controller) *MockCheckScannerRPCClient {
+	mock := &MockCheckScannerRPCClient{ctrl: ctrl}
+	mock.recorder = &MockCheckScannerRPCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckScannerRPCClient) EXPECT() *MockCheckScannerRPCClientMockRecorder {
+	return m.recorder
+}
+
+// AccountObjects mocks base method.
+func (m *MockCheckScannerRPCClient) AccountObjects(arg0 context.Context, arg1 data.Account, arg2 string, arg3 any) (xrpl.AccountObjectsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountObjects", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(xrpl.AccountObjectsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountObjects indicates an expected call of AccountObjects.
+func (mr *MockCheckScannerRPCClientMockRecorder) AccountObjects(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountObjects", reflect.TypeOf((*MockCheckScannerRPCClient)(nil).AccountObjects), arg0, arg1, arg2, arg3)
+}
+
+// Tx mocks base method.
+func (m *MockCheckScannerRPCClient) Tx(arg0 context.Context, arg1 data.Hash256) (xrpl.TxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tx", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.TxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockCheckScannerRPCClientMockRecorder) Tx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockCheckScannerRPCClient)(nil).Tx), arg0, arg1)
+}
@@ -0,0 +1,137 @@
+package xrpl_test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/coreum"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestCheckScanner_ScanChecks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+	bridgeAccount := xrpl.GenPrivKeyTxSigner().Account()
+	sender := xrpl.GenPrivKeyTxSigner().Account()
+	recipient := coreum.GenAccount()
+	sendMax, err := rippledata.NewAmount("100")
+	require.NoError(t, err)
+
+	validCheck, validCheckObject, validCheckTx := genCheck(t, sender, bridgeAccount, *sendMax, recipient, nil)
+	_, noMemoCheckObject, noMemoCheckTx := genCheck(t, sender, bridgeAccount, *sendMax, nil, nil)
+	_, expiredCheckObject, expiredCheckTx := genCheck(
+		t, sender, bridgeAccount, *sendMax, recipient, lo.ToPtr(now.Add(-time.Minute)),
+	)
+	// the check created by the bridge account
+	_, outgoingCheckObject, _ := genCheck(t, bridgeAccount, sender, *sendMax, recipient, nil)
+
+	rpcClientMock := NewMockCheckScannerRPCClient(ctrl)
+	rpcClientMock.EXPECT().AccountObjects(gomock.Any(), bridgeAccount, "check", nil).Return(xrpl.AccountObjectsResult{
+		AccountObjects: []json.RawMessage{validCheckObject, noMemoCheckObject},
+		Marker:         "marker",
+	}, nil)
+	rpcClientMock.EXPECT().AccountObjects(gomock.Any(), bridgeAccount, "check", "marker").Return(
+		xrpl.AccountObjectsResult{
+			AccountObjects: []json.RawMessage{expiredCheckObject, outgoingCheckObject},
+		}, nil,
+	)
+	// the CheckCreate txs are fetched once
+	for _, tx := range []*rippledata.CheckCreate{validCheckTx, noMemoCheckTx, expiredCheckTx} {
+		rpcClientMock.EXPECT().Tx(gomock.Any(), *tx.GetHash()).Return(xrpl.TxResult{
+			TransactionWithMetaData: rippledata.TransactionWithMetaData{Transaction: tx},
+		}, nil)
+	}
+
+	scanner := xrpl.NewCheckScanner(bridgeAccount, logger.NewAnyLogMock(ctrl), rpcClientMock, func() time.Time {
+		return now
+	})
+	checks, err := scanner.ScanChecks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []xrpl.Check{validCheck}, checks)
+
+	rpcClientMock.EXPECT().AccountObjects(gomock.Any(), bridgeAccount, "check", nil).Return(xrpl.AccountObjectsResult{
+		AccountObjects: []json.RawMessage{validCheckObject, noMemoCheckObject, expiredCheckObject},
+	}, nil)
+	checks, err = scanner.ScanChecks(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []xrpl.Check{validCheck}, checks)
+
+	// the cashed check is removed
+	rpcClientMock.EXPECT().AccountObjects(gomock.Any(), bridgeAccount, "check", nil).Return(xrpl.AccountObjectsResult{
+		AccountObjects: []json.RawMessage{noMemoCheckObject},
+	}, nil)
+	checks, err = scanner.ScanChecks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, checks)
+}
+
+func genCheck(
+	t *testing.T,
+	sender, destination rippledata.Account,
+	sendMax rippledata.Amount,
+	recipient sdk.AccAddress,
+	expiration *time.Time,
+) (xrpl.Check, json.RawMessage, *rippledata.CheckCreate) {
+	t.Helper()
+
+	tx := &rippledata.CheckCreate{
+		TxBase: rippledata.TxBase{
+			Account:         sender,
+			TransactionType: rippledata.CHECK_CREATE,
+		},
+		Destination: destination,
+		SendMax:     sendMax,
+	}
+	if recipient != nil {
+		memo, err := xrpl.EncodeCoreumRecipientToMemo(recipient)
+		require.NoError(t, err)
+		tx.Memos = rippledata.Memos{memo}
+	}
+	_, err := rand.Read(tx.GetHash()[:])
+	require.NoError(t, err)
+	var checkID rippledata.Hash256
+	_, err = rand.Read(checkID[:])
+	require.NoError(t, err)
+	ledgerCheck := rippledata.Check{
+		Account:     &sender,
+		Destination: &destination,
+		SendMax:     &sendMax,
+	}
+	ledgerCheck.LedgerEntryType = rippledata.CHECK
+	ledgerCheck.LedgerIndex = &checkID
+	ledgerCheck.PreviousTxnID = tx.GetHash()
+
+	check := xrpl.Check{
+		ID:        checkID,
+		TxHash:    *tx.GetHash(),
+		Sender:    sender,
+		SendMax:   sendMax,
+		Recipient: recipient,
+	}
+	if expiration != nil {
+		rippleExpiration := rippledata.NewRippleTime(uint32(expiration.Unix() - rippleEpochOffset))
+		ledgerCheck.Expiration = lo.ToPtr(rippleExpiration.Uint32())
+		check.Expiration = lo.ToPtr(rippleExpiration.Time())
+	}
+	object, err := json.Marshal(ledgerCheck)
+	require.NoError(t, err)
+
+	return check, object, tx
+}
+
+// rippleEpochOffset is the offset of the ripple epoch (2000-01-01) from the unix epoch in seconds.
+const rippleEpochOffset = 946684800
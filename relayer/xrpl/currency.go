@@ -9,12 +9,13 @@ import (
 
 // ConvertCurrencyToString decodes XRPL currency to string which matches the contract expectation.
 func ConvertCurrencyToString(currency rippledata.Currency) string {
-	currencyString := currency.String()
-	if len(currencyString) == 3 {
-		return currencyString
+	switch currency.Type() {
+	case rippledata.CT_XRP, rippledata.CT_STANDARD:
+		// the standard currency with unprintable chars is encoded as hex by the String
+		if currencyString := currency.String(); len(currencyString) == 3 {
+			return currencyString
+		}
 	}
-	hexString := hex.EncodeToString([]byte(currencyString))
-	// append tailing zeros to match the contract expectation
-	hexString += strings.Repeat("0", 40-len(hexString))
-	return strings.ToUpper(hexString)
+	// all other currencies are represented as hex of the full currency bytes, including the tailing zeros
+	return strings.ToUpper(hex.EncodeToString(currency[:]))
 }
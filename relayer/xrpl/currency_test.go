@@ -44,6 +44,21 @@ func TestConvertCurrencyToString(t *testing.T) {
 			currency: mustCurrency(t, "636f7265756d3939663062653133663900000000"),
 			want:     "636F7265756D3939663062653133663900000000",
 		},
+		{
+			name:     "standard_currency_with_non_ascii_bytes",
+			currency: mustStandardCurrency([3]byte{0x01, 0x02, 0x03}),
+			want:     "0000000000000000000000000102030000000000",
+		},
+		{
+			name:     "hex_currency_with_non_ascii_bytes",
+			currency: mustCurrency(t, "0102030000000000000000000000000000000000"),
+			want:     "0102030000000000000000000000000000000000",
+		},
+		{
+			name:     "hex_currency_with_three_printable_chars",
+			currency: mustCurrency(t, "4142430000000000000000000000000000000000"),
+			want:     "4142430000000000000000000000000000000000",
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -65,3 +80,9 @@ func mustCurrency(t *testing.T, currencyString string) rippledata.Currency {
 	require.NoError(t, err)
 	return currency
 }
+
+func mustStandardCurrency(code [3]byte) rippledata.Currency {
+	var currency rippledata.Currency
+	copy(currency[12:], code[:])
+	return currency
+}
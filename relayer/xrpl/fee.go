@@ -10,16 +10,40 @@ const (
 	DefaultXRPLBaseFee = uint32(10)
 )
 
-// GetMultiSigningTxFee is static fee we use for the XRPL transaction submission.
+// GetMultiSigningTxFee returns the fee we use for the XRPL multi-signing transaction submission.
 // According to https://xrpl.org/transaction-cost.html multisigned transaction require fee equal to
 // xrpl_base_fee * (1 + Number of Signatures Provided).
-// For simplicity, we assume that there are maximum 32 signatures.
-func GetMultiSigningTxFee(xrplBaseFee uint32) (rippledata.Value, error) {
-	fee, err := rippledata.NewNativeValue(int64(xrplBaseFee * (1 + MaxAllowedXRPLSigners)))
+// If the signersCount is unknown (zero) the maximum 32 signatures are assumed. The fee is capped by the maxFee if it
+// isn't zero.
+func GetMultiSigningTxFee(xrplBaseFee, signersCount uint32, maxFee uint64) (rippledata.Value, error) {
+	if signersCount == 0 || signersCount > MaxAllowedXRPLSigners {
+		signersCount = MaxAllowedXRPLSigners
+	}
+	// uint64 to avoid the overflow with the high base fee
+	fee := uint64(xrplBaseFee) * uint64(1+signersCount)
+	if maxFee != 0 && fee > maxFee {
+		fee = maxFee
+	}
+	feeValue, err := rippledata.NewNativeValue(int64(fee))
 	if err != nil {
 		return rippledata.Value{}, errors.Wrapf(err, "failed to convert fee to ripple fee")
 	}
-	return *fee, nil
+	return *feeValue, nil
+}
+
+// GetMultiSigningTxSignersCount returns the number of signatures provided in the submitted multi-signing
+// transaction. Each relayer has the signer weight 1, so the transaction is submitted with the evidence threshold
+// signatures.
+func GetMultiSigningTxSignersCount(evidenceThreshold uint32, relayersCount int) uint32 {
+	signersCount := evidenceThreshold
+	if relayersCount > 0 && uint32(relayersCount) < signersCount {
+		signersCount = uint32(relayersCount)
+	}
+	if signersCount > MaxAllowedXRPLSigners {
+		signersCount = MaxAllowedXRPLSigners
+	}
+
+	return signersCount
 }
 
 // ComputeXRPLBaseFee computes the required XRPL base with load factor.
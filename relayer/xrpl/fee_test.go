@@ -0,0 +1,95 @@
+package xrpl_test
+
+import (
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestGetMultiSigningTxFee(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		xrplBaseFee  uint32
+		signersCount uint32
+		maxFee       uint64
+		expectedFee  int64
+	}{
+		{
+			name:         "2_signers",
+			xrplBaseFee:  10,
+			signersCount: 2,
+			expectedFee:  30,
+		},
+		{
+			name:         "16_signers",
+			xrplBaseFee:  10,
+			signersCount: 16,
+			expectedFee:  170,
+		},
+		{
+			name:         "32_signers",
+			xrplBaseFee:  35,
+			signersCount: 32,
+			expectedFee:  1155,
+		},
+		{
+			name:        "unknown_signers_count",
+			xrplBaseFee: 10,
+			expectedFee: 330,
+		},
+		{
+			name:         "signers_count_above_max",
+			xrplBaseFee:  10,
+			signersCount: 40,
+			expectedFee:  330,
+		},
+		{
+			name:         "capped_by_max_fee",
+			xrplBaseFee:  100,
+			signersCount: 32,
+			maxFee:       1000,
+			expectedFee:  1000,
+		},
+		{
+			name:         "below_max_fee",
+			xrplBaseFee:  10,
+			signersCount: 32,
+			maxFee:       1000,
+			expectedFee:  330,
+		},
+		{
+			name:         "no_overflow",
+			xrplBaseFee:  1 << 31,
+			signersCount: 32,
+			expectedFee:  70866960384,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fee, err := xrpl.GetMultiSigningTxFee(tt.xrplBaseFee, tt.signersCount, tt.maxFee)
+			require.NoError(t, err)
+			expectedFee, err := rippledata.NewNativeValue(tt.expectedFee)
+			require.NoError(t, err)
+			require.Equal(t, expectedFee.String(), fee.String())
+		})
+	}
+}
+
+func TestGetMultiSigningTxSignersCount(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint32(2), xrpl.GetMultiSigningTxSignersCount(2, 3))
+	require.Equal(t, uint32(16), xrpl.GetMultiSigningTxSignersCount(16, 20))
+	require.Equal(t, uint32(32), xrpl.GetMultiSigningTxSignersCount(32, 32))
+	// the threshold can't be reached with fewer relayers
+	require.Equal(t, uint32(3), xrpl.GetMultiSigningTxSignersCount(5, 3))
+	require.Equal(t, xrpl.MaxAllowedXRPLSigners, xrpl.GetMultiSigningTxSignersCount(40, 40))
+}
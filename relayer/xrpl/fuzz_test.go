@@ -0,0 +1,229 @@
+package xrpl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// seedPaymentBlobs are payment txs in the form returned by the account_tx and tx RPC methods, the same as the relayer
+// observes sending the tokens from XRPL in the integration tests.
+var seedPaymentBlobs = []string{
+	// XRP payment with the bridge memo
+	`{
+  "tx": {
+    "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+    "Amount": "100000000",
+    "Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+    "Fee": "10",
+    "Flags": 2147483648,
+    "Memos": [
+      {
+        "Memo": {
+          "MemoData": "7B2274797065223A22636F7265756D6272696467652D7872706C2D7631222C22636F7265756D5F726563697069656E74223A2274657374636F7265316C6B7966376D68346D396E3964686E6C6E71376B6D7874356D6A63676E6A686B6A717A717170227D"
+        }
+      }
+    ],
+    "Sequence": 4,
+    "SigningPubKey": "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020",
+    "TransactionType": "Payment",
+    "TxnSignature": "3045022100D184EB4AE5956FF600E7536EE459345C7BBCF097A84CC61A93B9AF7197EDB98702201CEA8009B7BEEBAA2AACC0359B41C427C1C5B550A4CA4B80CF2174AF2D6D5DCE",
+    "hash": "5C1D9C7D9D84A3E1B9C9F4F3A4B25D9F1D1E4B7C9B0AEF1C6B4E2D0C7A1B2C3D"
+  },
+  "meta": {
+    "AffectedNodes": [],
+    "TransactionIndex": 0,
+    "TransactionResult": "tesSUCCESS",
+    "delivered_amount": "100000000"
+  },
+  "validated": true,
+  "ledger_index": 56,
+  "date": 765432100
+}`,
+	// issued currency payment in the flat form
+	`{
+  "Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+  "Amount": {
+    "currency": "534F4C4F00000000000000000000000000000000",
+    "issuer": "rsoLo2S1kiGeCcn6hCUXVrCpGMWLrRrLZz",
+    "value": "1.5e10"
+  },
+  "Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+  "Fee": "12",
+  "Flags": 131072,
+  "SendMax": {
+    "currency": "USD",
+    "issuer": "rsoLo2S1kiGeCcn6hCUXVrCpGMWLrRrLZz",
+    "value": "100"
+  },
+  "Sequence": 0,
+  "TicketSequence": 12,
+  "TransactionType": "Payment",
+  "hash": "0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0",
+  "metaData": {
+    "AffectedNodes": [],
+    "TransactionIndex": 1,
+    "TransactionResult": "tecPATH_PARTIAL"
+  },
+  "validated": false,
+  "ledger_index": 72
+}`,
+}
+
+// FuzzDecodePayment checks that the decoding of the malformed XRPL txs doesn't crash the relayer. The CI runs it as:
+//
+//	go test ./xrpl/ -run=^$ -fuzz=FuzzDecodePayment -fuzztime=30s
+func FuzzDecodePayment(f *testing.F) {
+	for _, blob := range seedPaymentBlobs {
+		f.Add([]byte(blob))
+	}
+	for _, tx := range buildSeedPayments(f) {
+		blob, err := json.Marshal(tx)
+		require.NoError(f, err)
+		f.Add(blob)
+	}
+
+	f.Fuzz(func(t *testing.T, rawTx []byte) {
+		tx, err := xrpl.DecodeTransactionWithMetaData(rawTx)
+		if err != nil {
+			return
+		}
+		var txResult xrpl.TxResult
+		_ = json.Unmarshal(rawTx, &txResult)
+
+		payment, ok := tx.Transaction.(*rippledata.Payment)
+		if !ok || payment == nil {
+			return
+		}
+		// the same accessors the relayer uses processing the observed payment
+		_ = payment.GetHash().String()
+		_ = payment.Amount.String()
+		_ = xrpl.ConvertCurrencyToString(payment.Amount.Currency)
+		_ = xrpl.DecodeCoreumRecipientFromMemo(payment.Memos)
+		_, _ = xrpl.ParseMemoRecipient(payment)
+		_ = tx.MetaData.TransactionResult.Success()
+		if tx.MetaData.DeliveredAmount != nil {
+			_ = tx.MetaData.DeliveredAmount.String()
+		}
+	})
+}
+
+// FuzzDecodeCoreumRecipientFromMemo checks that the memo decoding neither crashes nor accepts the memos exceeding the
+// limits.
+//
+//	go test ./xrpl/ -run=^$ -fuzz=FuzzDecodeCoreumRecipientFromMemo -fuzztime=30s
+func FuzzDecodeCoreumRecipientFromMemo(f *testing.F) {
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(nil)
+	require.NoError(f, err)
+	f.Add([]byte(memo.Memo.MemoData), uint8(1))
+	f.Add(
+		[]byte(`{"type":"coreumbridge-xrpl-v1","coreum_recipient":"testcore1lkyf7mh4m9n9dhnlnq7kmxt5mjcgnjhkjqzqqp"}`),
+		uint8(2),
+	)
+	f.Add([]byte("testcore1lkyf7mh4m9n9dhnlnq7kmxt5mjcgnjhkjqzqqp"), uint8(1))
+	f.Add([]byte{0xff, 0xfe, 0x00}, uint8(xrpl.MaxInspectedMemos+1))
+	f.Add(bytes.Repeat([]byte("a"), xrpl.MaxMemoDataSize+1), uint8(1))
+
+	f.Fuzz(func(t *testing.T, memoData []byte, memosCount uint8) {
+		memos := make(rippledata.Memos, 0, memosCount)
+		for i := uint8(0); i < memosCount; i++ {
+			memos = append(memos, rippledata.Memo{
+				Memo: rippledata.MemoItem{
+					MemoData: memoData,
+				},
+			})
+		}
+		limitsErr := xrpl.ValidateMemoLimits(memos)
+
+		recipient := xrpl.DecodeCoreumRecipientFromMemo(memos)
+		parsedRecipient, err := xrpl.ParseMemoRecipient(&rippledata.Payment{
+			TxBase: rippledata.TxBase{
+				Memos: memos,
+			},
+		})
+		if limitsErr != nil {
+			require.ErrorIs(t, limitsErr, xrpl.ErrMemoLimitExceeded)
+			require.Nil(t, recipient)
+			require.ErrorIs(t, err, xrpl.ErrMemoLimitExceeded)
+			return
+		}
+		if recipient != nil {
+			require.NoError(t, sdk.VerifyAddressFormat(recipient))
+		}
+		if err == nil {
+			require.NoError(t, sdk.VerifyAddressFormat(parsedRecipient))
+		}
+	})
+}
+
+// FuzzConvertCurrencyToString checks that any XRPL currency is converted to the string, which is converted back to the
+// same currency.
+//
+//	go test ./xrpl/ -run=^$ -fuzz=FuzzConvertCurrencyToString -fuzztime=30s
+func FuzzConvertCurrencyToString(f *testing.F) {
+	for _, currencyString := range []string{
+		"XRP",
+		"USD",
+		"abc",
+		"\x00\x01\x02",
+		"534F4C4F00000000000000000000000000000000",
+		"0000000000000000000000000102030000000000",
+		"0102030000000000000000000000000000000000",
+	} {
+		f.Add([]byte(currencyString))
+	}
+
+	f.Fuzz(func(t *testing.T, currencyBytes []byte) {
+		// the fuzzed bytes are used both as the raw currency and as the currency string
+		var rawCurrency rippledata.Currency
+		copy(rawCurrency[:], currencyBytes)
+		currencies := []rippledata.Currency{rawCurrency}
+		if currency, err := rippledata.NewCurrency(string(currencyBytes)); err == nil {
+			currencies = append(currencies, currency)
+		}
+
+		for _, currency := range currencies {
+			currencyString := xrpl.ConvertCurrencyToString(currency)
+			convertedCurrency, err := rippledata.NewCurrency(currencyString)
+			require.NoError(t, err, "currency: %X, string: %s", currency[:], currencyString)
+			require.Equal(t, currency, convertedCurrency, "string: %s", currencyString)
+		}
+	})
+}
+
+func buildSeedPayments(f *testing.F) []rippledata.TransactionWithMetaData {
+	f.Helper()
+
+	memo, err := xrpl.EncodeCoreumRecipientToMemo(nil)
+	require.NoError(f, err)
+	xrpAmount, err := rippledata.NewAmount("1000000")
+	require.NoError(f, err)
+	tokenAmount, err := rippledata.NewAmount("10.5/USD/rsoLo2S1kiGeCcn6hCUXVrCpGMWLrRrLZz")
+	require.NoError(f, err)
+
+	seeds := make([]rippledata.TransactionWithMetaData, 0)
+	for _, amount := range []*rippledata.Amount{xrpAmount, tokenAmount} {
+		seeds = append(seeds, rippledata.TransactionWithMetaData{
+			LedgerSequence: 10,
+			Transaction: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					TransactionType: rippledata.PAYMENT,
+					Memos:           rippledata.Memos{memo},
+				},
+				Amount: *amount,
+			},
+			MetaData: rippledata.MetaData{
+				TransactionResult: rippledata.TransactionResult(0),
+				DeliveredAmount:   amount,
+			},
+		})
+	}
+
+	return seeds
+}
@@ -0,0 +1,272 @@
+package xrpl
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=latency_router_mocks_test.go -package=xrpl_test . LatencyRouterMetricRegistry
+
+const (
+	latencyRouterProbeMethod = "server_info"
+	latencyPercentile        = 0.95
+)
+
+// LatencyRouterMetricRegistry is latency router metric registry.
+type LatencyRouterMetricRegistry interface {
+	SetXRPLRPCEndpointLatency(url string, seconds float64)
+}
+
+// LatencyRouterConfig is LatencyRouter config.
+type LatencyRouterConfig struct {
+	URLs          []string
+	ProbeInterval time.Duration
+	// WindowSize is the number of the latest request latencies the endpoint P95 latency is estimated from.
+	WindowSize int
+}
+
+// DefaultLatencyRouterConfig returns default LatencyRouterConfig.
+func DefaultLatencyRouterConfig(urls []string) LatencyRouterConfig {
+	return LatencyRouterConfig{
+		URLs:          urls,
+		ProbeInterval: 30 * time.Second,
+		WindowSize:    100,
+	}
+}
+
+// LatencyRouter is the HTTPClient routing the XRPL RPC requests to the available endpoint with the lowest P95
+// latency. The endpoint is unavailable from the failed request without the response till the next response.
+type LatencyRouter struct {
+	cfg            LatencyRouterConfig
+	log            logger.Logger
+	httpClient     HTTPClient
+	metricRegistry LatencyRouterMetricRegistry
+	clock          func() time.Time
+
+	mu        sync.Mutex
+	endpoints []*endpointLatency
+}
+
+type endpointLatency struct {
+	url       string
+	samples   []time.Duration
+	next      int
+	available bool
+}
+
+// NewLatencyRouter returns a new instance of the LatencyRouter.
+func NewLatencyRouter(
+	cfg LatencyRouterConfig,
+	log logger.Logger,
+	httpClient HTTPClient,
+	metricRegistry LatencyRouterMetricRegistry,
+	clock func() time.Time,
+) (*LatencyRouter, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, errors.New("at least one XRPL RPC URL is required")
+	}
+	if cfg.ProbeInterval <= 0 {
+		return nil, errors.Errorf("probe interval must be positive, got: %s", cfg.ProbeInterval)
+	}
+	if cfg.WindowSize <= 0 {
+		return nil, errors.Errorf("window size must be positive, got: %d", cfg.WindowSize)
+	}
+
+	endpoints := make([]*endpointLatency, 0, len(cfg.URLs))
+	urls := make(map[string]struct{}, len(cfg.URLs))
+	for _, url := range cfg.URLs {
+		if url == "" {
+			return nil, errors.New("XRPL RPC URL must not be empty")
+		}
+		if _, ok := urls[url]; ok {
+			return nil, errors.Errorf("duplicated XRPL RPC URL: %s", url)
+		}
+		urls[url] = struct{}{}
+		endpoints = append(endpoints, &endpointLatency{
+			url:       url,
+			samples:   make([]time.Duration, 0, cfg.WindowSize),
+			available: true,
+		})
+	}
+
+	return &LatencyRouter{
+		cfg:            cfg,
+		log:            log,
+		httpClient:     httpClient,
+		metricRegistry: metricRegistry,
+		clock:          clock,
+		endpoints:      endpoints,
+	}, nil
+}
+
+// Start probes all endpoints with the server_info request every probe interval.
+func (r *LatencyRouter) Start(ctx context.Context) error {
+	for {
+		if err := r.ProbeEndpoints(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-time.After(r.cfg.ProbeInterval):
+		}
+	}
+}
+
+// ProbeEndpoints measures the latency of all endpoints in parallel.
+func (r *LatencyRouter) ProbeEndpoints(ctx context.Context) error {
+	return parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+		for _, url := range r.cfg.URLs {
+			url := url
+			spawn(url, parallel.Continue, func(ctx context.Context) error {
+				request := RPCRequest{
+					Method: latencyRouterProbeMethod,
+					Params: []any{struct{}{}},
+				}
+				// the probe failure is handled by the endpoint availability
+				_ = r.doJSON(ctx, http.MethodPost, url, request, func([]byte) error {
+					return nil
+				})
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// DoJSON executes the request on the selected endpoint, the url argument is ignored.
+func (r *LatencyRouter) DoJSON(
+	ctx context.Context,
+	method, _ string,
+	reqBody any,
+	resDecoder func([]byte) error,
+) error {
+	return r.doJSON(ctx, method, r.SelectURL(), reqBody, resDecoder)
+}
+
+// SelectURL returns the available endpoint URL with the lowest P95 latency, or the lowest latency endpoint URL if
+// all of them are unavailable. The endpoints without the measured latency are selected first to measure it.
+func (r *LatencyRouter) SelectURL() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var selected *endpointLatency
+	for _, endpoint := range r.endpoints {
+		if selected == nil {
+			selected = endpoint
+			continue
+		}
+		if endpoint.available != selected.available {
+			if endpoint.available {
+				selected = endpoint
+			}
+			continue
+		}
+		if endpoint.p95() < selected.p95() {
+			selected = endpoint
+		}
+	}
+
+	return selected.url
+}
+
+// EndpointLatencies returns the P95 latency of each endpoint.
+func (r *LatencyRouter) EndpointLatencies() map[string]time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latencies := make(map[string]time.Duration, len(r.endpoints))
+	for _, endpoint := range r.endpoints {
+		latencies[endpoint.url] = endpoint.p95()
+	}
+
+	return latencies
+}
+
+func (r *LatencyRouter) doJSON(
+	ctx context.Context,
+	method, url string,
+	reqBody any,
+	resDecoder func([]byte) error,
+) error {
+	startedAt := r.clock()
+	// the response decoder is called only if the endpoint has responded, so the RPC errors don't affect the routing
+	responded := false
+	err := r.httpClient.DoJSON(ctx, method, url, reqBody, func(resBytes []byte) error {
+		responded = true
+		return resDecoder(resBytes)
+	})
+	if responded {
+		r.observeLatency(url, r.clock().Sub(startedAt))
+		return err
+	}
+	if err != nil && ctx.Err() == nil {
+		r.markUnavailable(ctx, url, err)
+	}
+
+	return err
+}
+
+func (r *LatencyRouter) observeLatency(url string, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	endpoint := r.findEndpoint(url)
+	if endpoint == nil {
+		return
+	}
+	endpoint.available = true
+	if len(endpoint.samples) < r.cfg.WindowSize {
+		endpoint.samples = append(endpoint.samples, latency)
+	} else {
+		endpoint.samples[endpoint.next] = latency
+	}
+	endpoint.next = (endpoint.next + 1) % r.cfg.WindowSize
+
+	r.metricRegistry.SetXRPLRPCEndpointLatency(url, endpoint.p95().Seconds())
+}
+
+func (r *LatencyRouter) markUnavailable(ctx context.Context, url string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	endpoint := r.findEndpoint(url)
+	if endpoint == nil || !endpoint.available {
+		return
+	}
+	endpoint.available = false
+	r.log.Warn(ctx, "XRPL RPC endpoint is unavailable", zap.String("url", url), zap.Error(err))
+}
+
+func (r *LatencyRouter) findEndpoint(url string) *endpointLatency {
+	for _, endpoint := range r.endpoints {
+		if endpoint.url == url {
+			return endpoint
+		}
+	}
+
+	return nil
+}
+
+func (e *endpointLatency) p95() time.Duration {
+	if len(e.samples) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(e.samples))
+	copy(sorted, e.samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted[int(math.Ceil(latencyPercentile*float64(len(sorted))))-1]
+}
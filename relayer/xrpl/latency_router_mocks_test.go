@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl (interfaces: LatencyRouterMetricRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -destination=latency_router_mocks_test.go -package=xrpl_test . LatencyRouterMetricRegistry
+//
+
+// Package xrpl_test is a generated GoMock package.
+package xrpl_test
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLatencyRouterMetricRegistry is a mock of LatencyRouterMetricRegistry interface.
+type MockLatencyRouterMetricRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockLatencyRouterMetricRegistryMockRecorder
+}
+
+// MockLatencyRouterMetricRegistryMockRecorder is the mock recorder for MockLatencyRouterMetricRegistry.
+type MockLatencyRouterMetricRegistryMockRecorder struct {
+	mock *MockLatencyRouterMetricRegistry
+}
+
+// NewMockLatencyRouterMetricRegistry creates a new mock instance.
+func NewMockLatencyRouterMetricRegistry(ctrl *gomock.Controller) *MockLatencyRouterMetricRegistry {
+	mock := &MockLatencyRouterMetricRegistry{ctrl: ctrl}
+	mock.recorder = &MockLatencyRouterMetricRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLatencyRouterMetricRegistry) EXPECT() *MockLatencyRouterMetricRegistryMockRecorder {
+	return m.recorder
+}
+
+// SetXRPLRPCEndpointLatency mocks base method.
+func (m *MockLatencyRouterMetricRegistry) SetXRPLRPCEndpointLatency(arg0 string, arg1 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetXRPLRPCEndpointLatency", arg0, arg1)
+}
+
+// SetXRPLRPCEndpointLatency indicates an expected call of SetXRPLRPCEndpointLatency.
+func (mr *MockLatencyRouterMetricRegistryMockRecorder) SetXRPLRPCEndpointLatency(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetXRPLRPCEndpointLatency", reflect.TypeOf((*MockLatencyRouterMetricRegistry)(nil).SetXRPLRPCEndpointLatency), arg0, arg1)
+}
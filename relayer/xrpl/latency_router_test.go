@@ -0,0 +1,279 @@
+package xrpl_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const (
+	fastURL   = "http://fast"
+	mediumURL = "http://medium"
+	slowURL   = "http://slow"
+)
+
+func TestLatencyRouter_SelectURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	httpClient := newFakeLatencyHTTPClient(map[string]time.Duration{
+		slowURL:   300 * time.Millisecond,
+		fastURL:   20 * time.Millisecond,
+		mediumURL: 100 * time.Millisecond,
+	})
+	metricRegistryMock := NewMockLatencyRouterMetricRegistry(ctrl)
+	metricRegistryMock.EXPECT().SetXRPLRPCEndpointLatency(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cfg := xrpl.DefaultLatencyRouterConfig([]string{slowURL, fastURL, mediumURL})
+	cfg.WindowSize = 20
+	router, err := xrpl.NewLatencyRouter(cfg, logger.NewAnyLogMock(ctrl), httpClient, metricRegistryMock, httpClient.now)
+	require.NoError(t, err)
+
+	// the endpoints without the measured latency are selected first
+	for i := 0; i < 3; i++ {
+		require.NoError(t, doLatencyRouterRequest(ctx, router))
+	}
+	require.Equal(t, []string{slowURL, fastURL, mediumURL}, httpClient.calledURLs())
+	require.Equal(t, map[string]time.Duration{
+		slowURL:   300 * time.Millisecond,
+		fastURL:   20 * time.Millisecond,
+		mediumURL: 100 * time.Millisecond,
+	}, router.EndpointLatencies())
+
+	// then the lowest latency endpoint is used
+	for i := 0; i < 18; i++ {
+		require.NoError(t, doLatencyRouterRequest(ctx, router))
+	}
+	require.Equal(t, fastURL, router.SelectURL())
+	require.Len(t, httpClient.calledURLs(), 21)
+	require.Equal(t, 19, httpClient.callsCount(fastURL))
+
+	// the single spike in the window doesn't affect the P95 latency
+	httpClient.setLatency(fastURL, time.Second)
+	require.NoError(t, doLatencyRouterRequest(ctx, router))
+	httpClient.setLatency(fastURL, 20*time.Millisecond)
+	require.Equal(t, 20*time.Millisecond, router.EndpointLatencies()[fastURL])
+	require.Equal(t, fastURL, router.SelectURL())
+
+	// the repeated spikes raise the P95 latency, so the next lowest latency endpoint is used
+	httpClient.setLatency(fastURL, time.Second)
+	require.NoError(t, doLatencyRouterRequest(ctx, router))
+	require.Equal(t, time.Second, router.EndpointLatencies()[fastURL])
+	require.Equal(t, mediumURL, router.SelectURL())
+
+	// the RPC error response doesn't make the endpoint unavailable
+	httpClient.setRPCError(mediumURL, true)
+	require.Error(t, doLatencyRouterRequest(ctx, router))
+	require.Equal(t, mediumURL, router.SelectURL())
+
+	// the endpoint without the response is unavailable
+	httpClient.setRPCError(mediumURL, false)
+	httpClient.setFailing(mediumURL, true)
+	require.Error(t, doLatencyRouterRequest(ctx, router))
+	require.Equal(t, slowURL, router.SelectURL())
+
+	// the lowest latency endpoint is used if all endpoints are unavailable
+	httpClient.setFailing(slowURL, true)
+	httpClient.setFailing(fastURL, true)
+	require.Error(t, doLatencyRouterRequest(ctx, router))
+	require.Error(t, doLatencyRouterRequest(ctx, router))
+	require.Equal(t, mediumURL, router.SelectURL())
+
+	// the probe makes the recovered endpoint available
+	httpClient.setFailing(slowURL, false)
+	require.NoError(t, router.ProbeEndpoints(ctx))
+	require.Equal(t, slowURL, router.SelectURL())
+	require.Equal(t, []string{"server_info"}, httpClient.calledMethods(slowURL))
+}
+
+func TestNewLatencyRouter_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	tests := []struct {
+		name        string
+		cfg         xrpl.LatencyRouterConfig
+		expectedErr string
+	}{
+		{
+			name:        "no_urls",
+			cfg:         xrpl.DefaultLatencyRouterConfig(nil),
+			expectedErr: "at least one XRPL RPC URL is required",
+		},
+		{
+			name:        "duplicated_url",
+			cfg:         xrpl.DefaultLatencyRouterConfig([]string{fastURL, fastURL}),
+			expectedErr: "duplicated XRPL RPC URL",
+		},
+		{
+			name: "zero_window_size",
+			cfg: func() xrpl.LatencyRouterConfig {
+				cfg := xrpl.DefaultLatencyRouterConfig([]string{fastURL})
+				cfg.WindowSize = 0
+				return cfg
+			}(),
+			expectedErr: "window size must be positive",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := xrpl.NewLatencyRouter(
+				tt.cfg, logger.NewAnyLogMock(ctrl), NewMockHTTPClient(ctrl), nil, time.Now,
+			)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func doLatencyRouterRequest(ctx context.Context, router *xrpl.LatencyRouter) error {
+	request := xrpl.RPCRequest{
+		Method: "ledger_current",
+	}
+	return router.DoJSON(ctx, http.MethodPost, "", request, func(resBytes []byte) error {
+		var response struct {
+			Result xrpl.RPCError `json:"result"`
+		}
+		if err := json.Unmarshal(resBytes, &response); err != nil {
+			return errors.WithStack(err)
+		}
+		if response.Result.Name != "" {
+			return &response.Result
+		}
+		return nil
+	})
+}
+
+type fakeLatencyHTTPClient struct {
+	mu        sync.Mutex
+	time      time.Time
+	latencies map[string]time.Duration
+	failing   map[string]bool
+	rpcErrors map[string]bool
+	calls     []fakeLatencyHTTPCall
+}
+
+type fakeLatencyHTTPCall struct {
+	url    string
+	method string
+}
+
+func newFakeLatencyHTTPClient(latencies map[string]time.Duration) *fakeLatencyHTTPClient {
+	return &fakeLatencyHTTPClient{
+		time:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		latencies: latencies,
+		failing:   make(map[string]bool),
+		rpcErrors: make(map[string]bool),
+	}
+}
+
+func (c *fakeLatencyHTTPClient) DoJSON(
+	_ context.Context,
+	_, url string,
+	reqBody any,
+	resDecoder func([]byte) error,
+) error {
+	c.mu.Lock()
+	request, ok := reqBody.(xrpl.RPCRequest)
+	if !ok {
+		c.mu.Unlock()
+		return errors.Errorf("unexpected request %T", reqBody)
+	}
+	c.calls = append(c.calls, fakeLatencyHTTPCall{url: url, method: request.Method})
+	if c.failing[url] {
+		c.mu.Unlock()
+		return errors.Errorf("endpoint %s is down", url)
+	}
+	// the latency is simulated by the clock shift
+	c.time = c.time.Add(c.latencies[url])
+	result := map[string]any{}
+	if c.rpcErrors[url] {
+		result = map[string]any{"error": "lgrNotFound", "error_code": 21}
+	}
+	c.mu.Unlock()
+
+	resBytes, err := json.Marshal(map[string]any{"result": result})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return resDecoder(resBytes)
+}
+
+func (c *fakeLatencyHTTPClient) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.time
+}
+
+func (c *fakeLatencyHTTPClient) setLatency(url string, latency time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.latencies[url] = latency
+}
+
+func (c *fakeLatencyHTTPClient) setFailing(url string, failing bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failing[url] = failing
+}
+
+func (c *fakeLatencyHTTPClient) setRPCError(url string, rpcError bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rpcErrors[url] = rpcError
+}
+
+func (c *fakeLatencyHTTPClient) calledURLs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	urls := make([]string, 0, len(c.calls))
+	for _, call := range c.calls {
+		urls = append(urls, call.url)
+	}
+	return urls
+}
+
+func (c *fakeLatencyHTTPClient) callsCount(url string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for _, call := range c.calls {
+		if call.url == url {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *fakeLatencyHTTPClient) calledMethods(url string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	methods := make([]string, 0)
+	for _, call := range c.calls {
+		if call.url == url && call.method != "ledger_current" {
+			methods = append(methods, call.method)
+		}
+	}
+	return methods
+}
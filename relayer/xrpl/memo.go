@@ -2,6 +2,7 @@ package xrpl
 
 import (
 	"encoding/json"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -11,19 +12,75 @@ import (
 const (
 	// BridgeMemoType is the string type with version of the current memo, used in the memo json we expect.
 	BridgeMemoType = "coreumbridge-xrpl-v1"
+	// MaxMemoDataSize is the max size of the memo data the relayer parses. The bridge memo is ~120 bytes, or ~200
+	// bytes with the destination chain recipient, the limit leaves the room for the future memo versions.
+	MaxMemoDataSize = 1024
+	// MaxInspectedMemos is the max number of the memos of the tx the relayer parses.
+	MaxInspectedMemos = 8
 )
 
+// ErrMemoLimitExceeded is returned if the tx memos exceed the memo size or count limits.
+var ErrMemoLimitExceeded = errors.New("memo limit exceeded")
+
+// ValidateMemoLimits returns ErrMemoLimitExceeded if the memos count or the size of any memo data exceeds the limits.
+// The memos are set by the XRPL tx sender, so the validation must be done before any parsing.
+func ValidateMemoLimits(memos rippledata.Memos) error {
+	if len(memos) > MaxInspectedMemos {
+		return errors.Wrapf(ErrMemoLimitExceeded, "memos count %d exceeds %d", len(memos), MaxInspectedMemos)
+	}
+	for i, memo := range memos {
+		if len(memo.Memo.MemoData) > MaxMemoDataSize {
+			return errors.Wrapf(
+				ErrMemoLimitExceeded,
+				"memo %d data size %d exceeds %d", i, len(memo.Memo.MemoData), MaxMemoDataSize,
+			)
+		}
+	}
+
+	return nil
+}
+
 // BridgeMemo is struct we expect to be in the memo to indicate that the operation is the bridge related.
 //
 //nolint:tagliatelle // we use the same style as the contract
 type BridgeMemo struct {
 	Type            string `json:"type"`
 	CoreumRecipient string `json:"coreum_recipient"`
+	// DestinationChainRecipient is the recipient on the IBC-connected chain of the token with the IBC delivery mode,
+	// the tokens are refunded to the CoreumRecipient if the IBC transfer fails.
+	DestinationChainRecipient string `json:"destination_chain_recipient,omitempty"`
 }
 
 // DecodeCoreumRecipientFromMemo decodes the coreum recipient from memo or returns nil in case the memo
-// is not as expected.
+// is not as expected or the memos exceed the limits.
 func DecodeCoreumRecipientFromMemo(memos rippledata.Memos) sdk.AccAddress {
+	bridgeMemo, ok := decodeBridgeMemo(memos)
+	if !ok {
+		return nil
+	}
+	acc, err := sdk.AccAddressFromBech32(bridgeMemo.CoreumRecipient)
+	if err != nil {
+		return nil
+	}
+
+	return acc
+}
+
+// DecodeDestinationChainRecipientFromMemo decodes the recipient on the IBC-connected chain from memo or returns an
+// empty string in case the memo is not as expected or doesn't include it.
+func DecodeDestinationChainRecipientFromMemo(memos rippledata.Memos) string {
+	bridgeMemo, ok := decodeBridgeMemo(memos)
+	if !ok {
+		return ""
+	}
+
+	return bridgeMemo.DestinationChainRecipient
+}
+
+func decodeBridgeMemo(memos rippledata.Memos) (BridgeMemo, bool) {
+	if ValidateMemoLimits(memos) != nil {
+		return BridgeMemo{}, false
+	}
 	var bridgeMemo BridgeMemo
 	for _, memo := range memos {
 		if len(memo.Memo.MemoData) == 0 {
@@ -31,27 +88,57 @@ func DecodeCoreumRecipientFromMemo(memos rippledata.Memos) sdk.AccAddress {
 		}
 
 		if err := json.Unmarshal(memo.Memo.MemoData, &bridgeMemo); err != nil {
-			return nil
+			return BridgeMemo{}, false
 		}
 		if bridgeMemo.Type != BridgeMemoType {
-			return nil
-		}
-		acc, err := sdk.AccAddressFromBech32(bridgeMemo.CoreumRecipient)
-		if err != nil {
-			return nil
+			return BridgeMemo{}, false
 		}
 
-		return acc
+		return bridgeMemo, true
 	}
 
-	return nil
+	return BridgeMemo{}, false
+}
+
+// ParseMemoRecipient extracts the coreum recipient Bech32 address embedded as the plain text to the MemoData of the
+// first memo of the payment.
+func ParseMemoRecipient(tx *rippledata.Payment) (sdk.AccAddress, error) {
+	if tx == nil {
+		return nil, errors.New("payment tx is nil")
+	}
+	if len(tx.Memos) == 0 {
+		return nil, errors.New("payment tx has no memos")
+	}
+	if err := ValidateMemoLimits(tx.Memos); err != nil {
+		return nil, err
+	}
+	memoData := strings.TrimSpace(string(tx.Memos[0].Memo.MemoData))
+	if memoData == "" {
+		return nil, errors.New("first memo data is empty")
+	}
+	acc, err := sdk.AccAddressFromBech32(memoData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid coreum recipient in memo data, data:%s", memoData)
+	}
+
+	return acc, nil
 }
 
 // EncodeCoreumRecipientToMemo encodes the bridge memo with the coreum recipient.
 func EncodeCoreumRecipientToMemo(coreumRecipient sdk.AccAddress) (rippledata.Memo, error) {
+	return EncodeDestinationChainRecipientToMemo(coreumRecipient, "")
+}
+
+// EncodeDestinationChainRecipientToMemo encodes the bridge memo with the coreum recipient and the recipient on the
+// IBC-connected chain.
+func EncodeDestinationChainRecipientToMemo(
+	coreumRecipient sdk.AccAddress,
+	destinationChainRecipient string,
+) (rippledata.Memo, error) {
 	data, err := json.Marshal(BridgeMemo{
-		Type:            BridgeMemoType,
-		CoreumRecipient: coreumRecipient.String(),
+		Type:                      BridgeMemoType,
+		CoreumRecipient:           coreumRecipient.String(),
+		DestinationChainRecipient: destinationChainRecipient,
 	})
 	if err != nil {
 		return rippledata.Memo{}, errors.Wrapf(err, "failed to marshal BridgeMemo")
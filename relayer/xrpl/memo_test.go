@@ -3,6 +3,7 @@ package xrpl_test
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types"
@@ -61,6 +62,25 @@ func TestDecodeCoreumRecipientFromMemo(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "valid_memo_exceeding_memos_count",
+			memos: append(
+				make(rippledata.Memos, xrpl.MaxInspectedMemos),
+				encodeToCoreumBridgeMemos(t, xrpl.BridgeMemoType, accAddress.String())...,
+			),
+			want: nil,
+		},
+		{
+			name: "valid_memo_exceeding_memo_size",
+			memos: rippledata.Memos{
+				{
+					Memo: rippledata.MemoItem{
+						MemoData: rippledata.VariableLength(staticJSONMemo + strings.Repeat(" ", xrpl.MaxMemoDataSize)),
+					},
+				},
+			},
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -71,6 +91,138 @@ func TestDecodeCoreumRecipientFromMemo(t *testing.T) {
 	}
 }
 
+func TestDecodeDestinationChainRecipientFromMemo(t *testing.T) {
+	t.Parallel()
+
+	coreumRecipient := coreum.GenAccount()
+	destinationChainRecipient := "osmo1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	memo, err := xrpl.EncodeDestinationChainRecipientToMemo(coreumRecipient, destinationChainRecipient)
+	require.NoError(t, err)
+	memos := rippledata.Memos{memo}
+	require.Equal(t, coreumRecipient, xrpl.DecodeCoreumRecipientFromMemo(memos))
+	require.Equal(t, destinationChainRecipient, xrpl.DecodeDestinationChainRecipientFromMemo(memos))
+
+	// the memo without the destination chain recipient
+	require.Empty(
+		t,
+		xrpl.DecodeDestinationChainRecipientFromMemo(
+			encodeToCoreumBridgeMemos(t, xrpl.BridgeMemoType, coreumRecipient.String()),
+		),
+	)
+
+	// the memo of the unexpected type
+	data, err := json.Marshal(xrpl.BridgeMemo{
+		Type:                      "invalid",
+		CoreumRecipient:           coreumRecipient.String(),
+		DestinationChainRecipient: destinationChainRecipient,
+	})
+	require.NoError(t, err)
+	require.Empty(t, xrpl.DecodeDestinationChainRecipientFromMemo(rippledata.Memos{
+		{
+			Memo: rippledata.MemoItem{
+				MemoData: data,
+			},
+		},
+	}))
+}
+
+func TestParseMemoRecipient(t *testing.T) {
+	t.Parallel()
+
+	accAddress := coreum.GenAccount()
+
+	tests := []struct {
+		name        string
+		tx          *rippledata.Payment
+		want        types.AccAddress
+		expectedErr string
+	}{
+		{
+			name: "valid_address",
+			tx:   buildPaymentWithMemoData(accAddress.String()),
+			want: accAddress,
+		},
+		{
+			name: "valid_address_with_spaces",
+			tx:   buildPaymentWithMemoData(" " + accAddress.String() + "\n"),
+			want: accAddress,
+		},
+		{
+			name: "valid_address_in_first_memo_only",
+			tx: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					Memos: append(
+						buildPaymentWithMemoData(accAddress.String()).Memos,
+						encodeToCoreumBridgeMemos(t, xrpl.BridgeMemoType, coreum.GenAccount().String())...,
+					),
+				},
+			},
+			want: accAddress,
+		},
+		{
+			name:        "nil_tx",
+			expectedErr: "payment tx is nil",
+		},
+		{
+			name:        "no_memos",
+			tx:          &rippledata.Payment{},
+			expectedErr: "payment tx has no memos",
+		},
+		{
+			name:        "empty_memo_data",
+			tx:          buildPaymentWithMemoData(""),
+			expectedErr: "first memo data is empty",
+		},
+		{
+			name:        "invalid_address",
+			tx:          buildPaymentWithMemoData("coreum123"),
+			expectedErr: "invalid coreum recipient in memo data",
+		},
+		{
+			name: "bridge_memo",
+			tx: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					Memos: encodeToCoreumBridgeMemos(t, xrpl.BridgeMemoType, accAddress.String()),
+				},
+			},
+			expectedErr: "invalid coreum recipient in memo data",
+		},
+		{
+			name:        "memo_data_exceeding_size",
+			tx:          buildPaymentWithMemoData(accAddress.String() + strings.Repeat(" ", xrpl.MaxMemoDataSize)),
+			expectedErr: xrpl.ErrMemoLimitExceeded.Error(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := xrpl.ParseMemoRecipient(tt.tx)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func buildPaymentWithMemoData(data string) *rippledata.Payment {
+	return &rippledata.Payment{
+		TxBase: rippledata.TxBase{
+			Memos: rippledata.Memos{
+				{
+					Memo: rippledata.MemoItem{
+						MemoData: rippledata.VariableLength(data),
+					},
+				},
+			},
+		},
+	}
+}
+
 func encodeToCoreumBridgeMemos(t *testing.T, mtype, address string) rippledata.Memos {
 	t.Helper()
 
@@ -87,3 +239,57 @@ func encodeToCoreumBridgeMemos(t *testing.T, mtype, address string) rippledata.M
 		},
 	}
 }
+
+func TestValidateMemoLimits(t *testing.T) {
+	t.Parallel()
+
+	buildMemos := func(count, size int) rippledata.Memos {
+		memos := make(rippledata.Memos, 0, count)
+		for i := 0; i < count; i++ {
+			memos = append(memos, rippledata.Memo{
+				Memo: rippledata.MemoItem{
+					MemoData: make(rippledata.VariableLength, size),
+				},
+			})
+		}
+		return memos
+	}
+
+	tests := []struct {
+		name    string
+		memos   rippledata.Memos
+		wantErr bool
+	}{
+		{
+			name:  "no_memos",
+			memos: nil,
+		},
+		{
+			name:  "max_memos_with_max_size",
+			memos: buildMemos(xrpl.MaxInspectedMemos, xrpl.MaxMemoDataSize),
+		},
+		{
+			name:    "too_many_memos",
+			memos:   buildMemos(xrpl.MaxInspectedMemos+1, 1),
+			wantErr: true,
+		},
+		{
+			name:    "too_large_memo",
+			memos:   append(buildMemos(1, 1), buildMemos(1, xrpl.MaxMemoDataSize+1)...),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := xrpl.ValidateMemoLimits(tt.memos)
+			if tt.wantErr {
+				require.ErrorIs(t, err, xrpl.ErrMemoLimitExceeded)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
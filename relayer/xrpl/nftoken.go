@@ -0,0 +1,25 @@
+package xrpl
+
+import (
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+// TxSellNFToken is the NFTokenCreateOffer flag which indicates that the offer is a sell offer.
+const TxSellNFToken = rippledata.TransactionFlag(0x00000001)
+
+// ExtractNFTokenOfferIDFromMetaData returns the ID of the NFToken offer created by the transaction.
+func ExtractNFTokenOfferIDFromMetaData(metaData rippledata.MetaData) (rippledata.Hash256, bool) {
+	for _, node := range metaData.AffectedNodes {
+		createdNode := node.CreatedNode
+		if createdNode == nil || createdNode.LedgerIndex == nil {
+			continue
+		}
+		if createdNode.LedgerEntryType != rippledata.NFTOKEN_OFFER {
+			continue
+		}
+
+		return *createdNode.LedgerIndex, true
+	}
+
+	return rippledata.Hash256{}, false
+}
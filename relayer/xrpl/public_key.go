@@ -0,0 +1,72 @@
+package xrpl
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	ripplecrypto "github.com/rubblelabs/ripple/crypto"
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+const (
+	// publicKeyLength is the length of the compressed secp256k1 and the 0xED prefixed ed25519 XRPL public keys.
+	publicKeyLength = 33
+
+	ed25519PublicKeyPrefix = 0xED
+)
+
+// PublicKeyAccount derives the XRPL classic account from the hex encoded secp256k1 or ed25519 public key.
+func PublicKeyAccount(pubKey string) (rippledata.Account, error) {
+	pubKeyBytes, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return rippledata.Account{}, errors.Wrap(err, "failed to decode public key hex")
+	}
+	if len(pubKeyBytes) != publicKeyLength {
+		return rippledata.Account{}, errors.Errorf(
+			"invalid public key length, expected: %d, got: %d", publicKeyLength, len(pubKeyBytes),
+		)
+	}
+	// the compressed secp256k1 key starts with 0x02 or 0x03, and the ed25519 key is prefixed with 0xED
+	switch pubKeyBytes[0] {
+	case 0x02, 0x03, ed25519PublicKeyPrefix:
+	default:
+		return rippledata.Account{}, errors.Errorf("invalid public key prefix: %X", pubKeyBytes[0])
+	}
+
+	var account rippledata.Account
+	copy(account[:], ripplecrypto.Sha256RipeMD160(pubKeyBytes))
+
+	return account, nil
+}
+
+// VerifyPublicKeyAddress returns an error if the classic address or X-address isn't derived from the public key.
+func VerifyPublicKeyAddress(pubKey, address string) error {
+	pubKeyAccount, err := PublicKeyAccount(pubKey)
+	if err != nil {
+		return errors.Wrapf(err, "invalid XRPL public key: %s", pubKey)
+	}
+
+	var account rippledata.Account
+	if IsXAddress(address) {
+		xAddress, err := DecodeXAddress(address)
+		if err != nil {
+			return err
+		}
+		account = xAddress.Account
+	} else {
+		parsedAccount, err := rippledata.NewAccountFromAddress(address)
+		if err != nil {
+			return errors.Wrapf(err, "invalid XRPL address: %s", address)
+		}
+		account = *parsedAccount
+	}
+
+	if account != pubKeyAccount {
+		return errors.Errorf(
+			"the XRPL address %s doesn't match the public key %s, the address of the key is %s",
+			address, pubKey, pubKeyAccount.String(),
+		)
+	}
+
+	return nil
+}
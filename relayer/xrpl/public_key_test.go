@@ -0,0 +1,93 @@
+package xrpl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// the keys are derived from the "masterpassphrase" seed.
+const (
+	testSecp256k1PubKey  = "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
+	testSecp256k1Address = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	testEd25519PubKey    = "EDAAC3F98BB94F451804EF5993C847DAAA4E6154F455635659D88AA5C80F156303"
+	testEd25519Address   = "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"
+)
+
+func TestVerifyPublicKeyAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pubKey       string
+		address      string
+		wantErrorMsg string
+	}{
+		{
+			name:    "secp256k1",
+			pubKey:  testSecp256k1PubKey,
+			address: testSecp256k1Address,
+		},
+		{
+			name:    "ed25519",
+			pubKey:  testEd25519PubKey,
+			address: testEd25519Address,
+		},
+		{
+			name:    "ed25519_x_address",
+			pubKey:  testEd25519PubKey,
+			address: "XVLhHMPHU98es4dbozjVtdWzVrDjtV5fdx1mHp98tDMoQXb",
+		},
+		{
+			name:         "ed25519_with_secp256k1_address",
+			pubKey:       testEd25519PubKey,
+			address:      testSecp256k1Address,
+			wantErrorMsg: "the address of the key is " + testEd25519Address,
+		},
+		{
+			name:         "secp256k1_with_ed25519_address",
+			pubKey:       testSecp256k1PubKey,
+			address:      testEd25519Address,
+			wantErrorMsg: "the address of the key is " + testSecp256k1Address,
+		},
+		{
+			name:         "invalid_prefix",
+			pubKey:       "04" + testSecp256k1PubKey[2:],
+			address:      testSecp256k1Address,
+			wantErrorMsg: "invalid public key prefix",
+		},
+		{
+			name:         "invalid_length",
+			pubKey:       testSecp256k1PubKey[:64],
+			address:      testSecp256k1Address,
+			wantErrorMsg: "invalid public key length",
+		},
+		{
+			name:         "invalid_hex",
+			pubKey:       "not-hex",
+			address:      testSecp256k1Address,
+			wantErrorMsg: "failed to decode public key hex",
+		},
+		{
+			name:         "invalid_address",
+			pubKey:       testSecp256k1PubKey,
+			address:      "invalid",
+			wantErrorMsg: "invalid XRPL address",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := xrpl.VerifyPublicKeyAddress(tt.pubKey, tt.address)
+			if tt.wantErrorMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package xrpl
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+// AccountReserves is the XRPL account reserve breakdown.
+type AccountReserves struct {
+	BalanceDrops     int64
+	BaseReserveDrops int64
+	// OwnerReserveDrops is the reserve of each object owned by the account.
+	OwnerReserveDrops int64
+	OwnerCount        uint32
+	TicketsCount      uint32
+	TrustLinesCount   uint32
+	SignerListsCount  uint32
+	// OtherObjectsCount is the count of the owned objects not counted by the type, e.g. offers or escrows.
+	OtherObjectsCount    uint32
+	RequiredReserveDrops int64
+	// SpendableDrops is the balance above the required reserve, it's negative if the reserve isn't covered.
+	SpendableDrops int64
+}
+
+// ComputeAccountReserves computes the reserve breakdown of the account state. The required reserve is the base
+// reserve plus the owner reserve for each object counted by the account OwnerCount.
+func ComputeAccountReserves(state BridgeAccountState) (AccountReserves, error) {
+	accountData := state.AccountInfo.AccountData
+	if accountData.OwnerCount == nil {
+		return AccountReserves{}, errors.New("owner count of the XRPL account is nil")
+	}
+	ownerCount := *accountData.OwnerCount
+	var balanceDrops int64
+	if accountData.Balance != nil {
+		balanceDrops = int64(math.Round(accountData.Balance.Float() * dropsInXRP))
+	}
+
+	signerListsCount := uint32(len(accountData.SignerList))
+	countedObjects := state.TicketsCount + state.TrustLinesCount + signerListsCount
+	var otherObjectsCount uint32
+	if ownerCount > countedObjects {
+		otherObjectsCount = ownerCount - countedObjects
+	}
+
+	requiredReserveDrops := state.ReserveBase + state.ReserveInc*int64(ownerCount)
+
+	return AccountReserves{
+		BalanceDrops:         balanceDrops,
+		BaseReserveDrops:     state.ReserveBase,
+		OwnerReserveDrops:    state.ReserveInc,
+		OwnerCount:           ownerCount,
+		TicketsCount:         state.TicketsCount,
+		TrustLinesCount:      state.TrustLinesCount,
+		SignerListsCount:     signerListsCount,
+		OtherObjectsCount:    otherObjectsCount,
+		RequiredReserveDrops: requiredReserveDrops,
+		SpendableDrops:       balanceDrops - requiredReserveDrops,
+	}, nil
+}
+
+// ComputeAccountReservesFromInfo computes the reserve breakdown from the account info and server state only. The
+// tickets are taken from the account TicketCount, and the trust lines are counted as other objects.
+func ComputeAccountReservesFromInfo(
+	accountInfo AccountInfoResult,
+	serverState ServerStateResult,
+) (AccountReserves, error) {
+	var ticketsCount uint32
+	if accountInfo.AccountData.TicketCount != nil {
+		ticketsCount = *accountInfo.AccountData.TicketCount
+	}
+
+	return ComputeAccountReserves(BridgeAccountState{
+		AccountInfo:  accountInfo,
+		TicketsCount: ticketsCount,
+		ReserveBase:  serverState.State.ValidatedLedger.ReserveBase,
+		ReserveInc:   serverState.State.ValidatedLedger.ReserveInc,
+	})
+}
+
+// FetchAccountReserves fetches the account state and returns its reserve breakdown.
+func FetchAccountReserves(
+	ctx context.Context,
+	rpcClient BridgeAccountRPCClient,
+	account rippledata.Account,
+) (AccountReserves, error) {
+	state, err := FetchBridgeAccountState(ctx, rpcClient, account)
+	if err != nil {
+		return AccountReserves{}, err
+	}
+
+	return ComputeAccountReserves(state)
+}
+
+// DropsToXRP converts the drops amount to XRP.
+func DropsToXRP(drops int64) float64 {
+	return float64(drops) / dropsInXRP
+}
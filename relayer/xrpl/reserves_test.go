@@ -0,0 +1,181 @@
+package xrpl_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+const serverStateReservesFixture = `{
+	"state": {
+		"build_version": "2.2.0",
+		"load_base": 256,
+		"load_factor": 256,
+		"validated_ledger": {
+			"base_fee": 10,
+			"close_time": 769000000,
+			"hash": "E6DB7365949BF9814D76BCC730B01818EB9136A89DB224F3F9F5AAE4569D758E",
+			"reserve_base": 10000000,
+			"reserve_inc": 2000000,
+			"seq": 100
+		}
+	},
+	"status": "success"
+}`
+
+func TestComputeAccountReservesFromInfo(t *testing.T) {
+	t.Parallel()
+
+	var serverState xrpl.ServerStateResult
+	require.NoError(t, json.Unmarshal([]byte(serverStateReservesFixture), &serverState))
+
+	tests := []struct {
+		name          string
+		balanceDrops  int64
+		ownerCount    uint32
+		ticketCount   uint32
+		withSigners   bool
+		wantReserves  xrpl.AccountReserves
+		wantSpendable float64
+	}{
+		{
+			name:         "no_owned_objects",
+			balanceDrops: 25_000_000,
+			wantReserves: xrpl.AccountReserves{
+				BalanceDrops:         25_000_000,
+				BaseReserveDrops:     10_000_000,
+				OwnerReserveDrops:    2_000_000,
+				RequiredReserveDrops: 10_000_000,
+				SpendableDrops:       15_000_000,
+			},
+			wantSpendable: 15,
+		},
+		{
+			name:         "tickets_and_signer_list",
+			balanceDrops: 100_000_000,
+			ownerCount:   11,
+			ticketCount:  10,
+			withSigners:  true,
+			wantReserves: xrpl.AccountReserves{
+				BalanceDrops:         100_000_000,
+				BaseReserveDrops:     10_000_000,
+				OwnerReserveDrops:    2_000_000,
+				OwnerCount:           11,
+				TicketsCount:         10,
+				SignerListsCount:     1,
+				RequiredReserveDrops: 32_000_000,
+				SpendableDrops:       68_000_000,
+			},
+			wantSpendable: 68,
+		},
+		{
+			name:         "trust_lines_counted_as_other_objects",
+			balanceDrops: 60_123_456,
+			ownerCount:   25,
+			ticketCount:  4,
+			withSigners:  true,
+			wantReserves: xrpl.AccountReserves{
+				BalanceDrops:         60_123_456,
+				BaseReserveDrops:     10_000_000,
+				OwnerReserveDrops:    2_000_000,
+				OwnerCount:           25,
+				TicketsCount:         4,
+				SignerListsCount:     1,
+				OtherObjectsCount:    20,
+				RequiredReserveDrops: 60_000_000,
+				SpendableDrops:       123_456,
+			},
+			wantSpendable: 0.123456,
+		},
+		{
+			name:         "insufficient_reserve",
+			balanceDrops: 50_000_000,
+			ownerCount:   250,
+			ticketCount:  250,
+			wantReserves: xrpl.AccountReserves{
+				BalanceDrops:         50_000_000,
+				BaseReserveDrops:     10_000_000,
+				OwnerReserveDrops:    2_000_000,
+				OwnerCount:           250,
+				TicketsCount:         250,
+				RequiredReserveDrops: 510_000_000,
+				SpendableDrops:       -460_000_000,
+			},
+			wantSpendable: -460,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signerLists := "[]"
+			if tt.withSigners {
+				signerLists = `[{
+					"Flags": 0,
+					"LedgerEntryType": "SignerList",
+					"OwnerNode": "0",
+					"SignerListID": 0,
+					"SignerQuorum": 1,
+					"SignerEntries": [{"SignerEntry": {"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "SignerWeight": 1}}]
+				}]`
+			}
+			ticketCount := ""
+			if tt.ticketCount != 0 {
+				ticketCount = fmt.Sprintf(`"TicketCount": %d,`, tt.ticketCount)
+			}
+			accountInfoJSON := fmt.Sprintf(`{
+				"account_data": {
+					"Account": "r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+					"Balance": "%d",
+					"Flags": 0,
+					"LedgerEntryType": "AccountRoot",
+					"OwnerCount": %d,
+					"Sequence": 5,
+					%s
+					"signer_lists": %s
+				},
+				"ledger_current_index": 100,
+				"validated": false
+			}`, tt.balanceDrops, tt.ownerCount, ticketCount, signerLists)
+			var accountInfo xrpl.AccountInfoResult
+			require.NoError(t, json.Unmarshal([]byte(accountInfoJSON), &accountInfo))
+
+			reserves, err := xrpl.ComputeAccountReservesFromInfo(accountInfo, serverState)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReserves, reserves)
+			require.InDelta(t, tt.wantSpendable, xrpl.DropsToXRP(reserves.SpendableDrops), 1e-9)
+		})
+	}
+}
+
+func TestComputeAccountReserves_TrustLines(t *testing.T) {
+	t.Parallel()
+
+	accountInfo := decodeAccountInfoFixture(t, xrpl.GenPrivKeyTxSigner().Account(), bridgeAccountFixture{
+		Flags:        validBridgeAccountFlags,
+		BalanceDrops: 30_000_000,
+		NoSignerList: true,
+	})
+	// the fixture account owns 4 objects
+	reserves, err := xrpl.ComputeAccountReserves(xrpl.BridgeAccountState{
+		AccountInfo:     accountInfo,
+		TicketsCount:    1,
+		TrustLinesCount: 2,
+		ReserveBase:     reserveBaseDrops,
+		ReserveInc:      reserveIncDrops,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), reserves.OtherObjectsCount)
+	require.Equal(t, int64(18_000_000), reserves.RequiredReserveDrops)
+	require.Equal(t, int64(12_000_000), reserves.SpendableDrops)
+
+	// the owner count is required
+	accountInfo.AccountData.OwnerCount = nil
+	_, err = xrpl.ComputeAccountReserves(xrpl.BridgeAccountState{AccountInfo: accountInfo})
+	require.ErrorContains(t, err, "owner count of the XRPL account is nil")
+}
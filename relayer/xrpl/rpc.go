@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,6 +24,8 @@ import (
 // UnknownTransactionResultErrorText error text for the unexpected tx code.
 const UnknownTransactionResultErrorText = "Unknown TransactionResult"
 
+const accountNotFoundRPCErrorName = "actNotFound"
+
 // ******************** RPC command request objects ********************
 
 // RPCError is RPC error result.
@@ -39,6 +42,12 @@ func (e *RPCError) Error() string {
 		e.Name, e.Code, e.Message, e.Exception)
 }
 
+// IsAccountNotFoundError returns true if the error is the RPC error of the account which isn't found.
+func IsAccountNotFoundError(err error) bool {
+	var rpcErr *RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Name == accountNotFoundRPCErrorName
+}
+
 // AccountDataWithSigners is account data with the signers list.
 type AccountDataWithSigners struct {
 	rippledata.AccountRoot
@@ -74,6 +83,21 @@ type AccountLinesResult struct {
 	Lines          rippledata.AccountLineSlice `json:"lines"`
 }
 
+// AccountObjectsRequest is `account_objects` method request.
+type AccountObjectsRequest struct {
+	Account rippledata.Account `json:"account"`
+	Type    string             `json:"type,omitempty"`
+	Limit   uint32             `json:"limit"`
+	Marker  any                `json:"marker,omitempty"`
+}
+
+// AccountObjectsResult is `account_objects` method result.
+type AccountObjectsResult struct {
+	Account        rippledata.Account `json:"account"`
+	AccountObjects []json.RawMessage  `json:"account_objects"`
+	Marker         any                `json:"marker,omitempty"`
+}
+
 // SubmitRequest is `submit` method request.
 type SubmitRequest struct {
 	TxBlob string `json:"tx_blob"`
@@ -115,7 +139,13 @@ func (txr *TxResult) UnmarshalJSON(b []byte) error {
 		txr.Validated = validatedVal
 	}
 
-	return json.Unmarshal(b, &txr.TransactionWithMetaData)
+	tx, err := DecodeTransactionWithMetaData(b)
+	if err != nil {
+		return err
+	}
+	txr.TransactionWithMetaData = tx
+
+	return nil
 }
 
 // LedgerCurrentResult is `ledger_current` method request.
@@ -137,6 +167,8 @@ type AccountTxRequest struct {
 
 // AccountTxResult is `account_tx` method result.
 type AccountTxResult struct {
+	// LedgerMax is the max ledger index used for the search.
+	LedgerMax    int64                       `json:"ledger_index_max"`
 	Marker       map[string]any              `json:"marker,omitempty"`
 	Transactions rippledata.TransactionSlice `json:"transactions,omitempty"`
 	Validated    bool                        `json:"validated"`
@@ -144,6 +176,7 @@ type AccountTxResult struct {
 
 // AccountTxWithRawTxsResult is `account_tx` method result with json.RawMessage transactions.
 type AccountTxWithRawTxsResult struct {
+	LedgerMax    int64             `json:"ledger_index_max"`
 	Marker       map[string]any    `json:"marker,omitempty"`
 	Transactions []json.RawMessage `json:"transactions,omitempty"`
 	Validated    bool              `json:"validated"`
@@ -174,6 +207,42 @@ type ServerState struct {
 	ValidatedLedger         ServerStateValidatedLedger `json:"validated_ledger"`
 }
 
+// LedgerRange is the inclusive range of the ledgers.
+type LedgerRange struct {
+	Min int64
+	Max int64
+}
+
+// CompleteLedgerRanges parses the CompleteLedgers, e.g. "32570-6595042,6595100-6595200", to the ledger ranges.
+func (s ServerState) CompleteLedgerRanges() ([]LedgerRange, error) {
+	completeLedgers := strings.TrimSpace(s.CompleteLedgers)
+	if completeLedgers == "" || completeLedgers == "empty" {
+		return nil, nil
+	}
+	parts := strings.Split(completeLedgers, ",")
+	ranges := make([]LedgerRange, 0, len(parts))
+	for _, part := range parts {
+		minLedgerStr, maxLedgerStr, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		if !isRange {
+			maxLedgerStr = minLedgerStr
+		}
+		minLedger, err := strconv.ParseInt(minLedgerStr, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid complete ledgers: %s", s.CompleteLedgers)
+		}
+		maxLedger, err := strconv.ParseInt(maxLedgerStr, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid complete ledgers: %s", s.CompleteLedgers)
+		}
+		if minLedger > maxLedger {
+			return nil, errors.Errorf("invalid complete ledgers range %s in: %s", part, s.CompleteLedgers)
+		}
+		ranges = append(ranges, LedgerRange{Min: minLedger, Max: maxLedger})
+	}
+
+	return ranges, nil
+}
+
 // ServerStateResult is `server_state` method result.
 type ServerStateResult struct {
 	State  ServerState `json:"state"`
@@ -204,6 +273,26 @@ type RipplePathFindResult struct {
 	DestCurrencies []rippledata.Currency `json:"destination_currencies"`
 }
 
+// AMMInfoRequest is amm_info request.
+type AMMInfoRequest struct {
+	Asset  rippledata.Asset `json:"asset"`
+	Asset2 rippledata.Asset `json:"asset2"`
+}
+
+// AMMDescription is the AMM pool description.
+type AMMDescription struct {
+	Account rippledata.Account `json:"account"`
+	Amount  rippledata.Amount  `json:"amount"`
+	Amount2 rippledata.Amount  `json:"amount2"`
+	// TradingFee is the pool trading fee in units of 1/100,000, e.g. 1000 is 1%.
+	TradingFee uint16 `json:"trading_fee"`
+}
+
+// AMMInfoResult is amm_info result.
+type AMMInfoResult struct {
+	AMM AMMDescription `json:"amm"`
+}
+
 // ******************** RPC transport objects ********************
 
 // RPCRequest is general RPC request.
@@ -368,6 +457,27 @@ func (c *RPCClient) AccountLines(
 	return result, nil
 }
 
+// AccountObjects returns the account objects of the provided type for a given account.
+func (c *RPCClient) AccountObjects(
+	ctx context.Context,
+	account rippledata.Account,
+	objectType string,
+	marker any,
+) (AccountObjectsResult, error) {
+	params := AccountObjectsRequest{
+		Account: account,
+		Type:    objectType,
+		Limit:   c.cfg.PageLimit,
+		Marker:  marker,
+	}
+	var result AccountObjectsResult
+	if err := c.callRPC(ctx, "account_objects", params, &result); err != nil {
+		return AccountObjectsResult{}, err
+	}
+
+	return result, nil
+}
+
 // Submit submits a transaction to the RPC server.
 func (c *RPCClient) Submit(ctx context.Context, tx rippledata.Transaction) (SubmitResult, error) {
 	_, raw, err := rippledata.Raw(tx)
@@ -438,8 +548,8 @@ func (c *RPCClient) AccountTx(
 
 	txs := make(rippledata.TransactionSlice, 0)
 	for i, rawTx := range result.Transactions {
-		var tx rippledata.TransactionWithMetaData
-		if err := json.Unmarshal(rawTx, &tx); err != nil {
+		tx, err := DecodeTransactionWithMetaData(rawTx)
+		if err != nil {
 			c.log.Error(
 				ctx,
 				"Failed to decode json tx to rippledata.TransactionWithMetaData",
@@ -458,12 +568,33 @@ func (c *RPCClient) AccountTx(
 	}
 
 	return AccountTxResult{
+		LedgerMax:    result.LedgerMax,
 		Marker:       result.Marker,
 		Transactions: txs,
 		Validated:    result.Validated,
 	}, nil
 }
 
+// DecodeTransactionWithMetaData decodes the JSON transaction with metadata. The decoder panic caused by the
+// malformed input is returned as an error, so a faulty or compromised node can't crash the relayer.
+func DecodeTransactionWithMetaData(rawTx []byte) (tx rippledata.TransactionWithMetaData, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tx = rippledata.TransactionWithMetaData{}
+			err = errors.Errorf("panic on XRPL transaction decoding: %v", r)
+		}
+	}()
+	if err := json.Unmarshal(rawTx, &tx); err != nil {
+		return rippledata.TransactionWithMetaData{}, errors.Wrap(err, "failed to decode json tx")
+	}
+	// the amount is required for the payment, but the decoder accepts the tx without it
+	if payment, ok := tx.Transaction.(*rippledata.Payment); ok && payment.Amount.Value == nil {
+		return rippledata.TransactionWithMetaData{}, errors.New("failed to decode json tx, payment amount is missing")
+	}
+
+	return tx, nil
+}
+
 // ServerState returns the server state information.
 func (c *RPCClient) ServerState(ctx context.Context) (ServerStateResult, error) {
 	var result ServerStateResult
@@ -510,6 +641,20 @@ func (c *RPCClient) RipplePathFind(
 	return result, nil
 }
 
+// AMMInfo returns the AMM pool of the assets.
+func (c *RPCClient) AMMInfo(ctx context.Context, asset, asset2 rippledata.Asset) (AMMInfoResult, error) {
+	params := AMMInfoRequest{
+		Asset:  asset,
+		Asset2: asset2,
+	}
+	var result AMMInfoResult
+	if err := c.callRPC(ctx, "amm_info", params, &result); err != nil {
+		return AMMInfoResult{}, err
+	}
+
+	return result, nil
+}
+
 func (c *RPCClient) callRPC(ctx context.Context, method string, params, result any) error {
 	request := RPCRequest{
 		Method: method,
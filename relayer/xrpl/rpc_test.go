@@ -157,3 +157,57 @@ func TestRPCClient_AccountTx(t *testing.T) {
 	require.Equal(t, tx1.LedgerSequence, txRes.Transactions[0].LedgerSequence)
 	require.Equal(t, tx2.LedgerSequence, txRes.Transactions[1].LedgerSequence)
 }
+
+func TestServerState_CompleteLedgerRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		completeLedgers string
+		want            []xrpl.LedgerRange
+		wantErr         bool
+	}{
+		{
+			name:            "empty",
+			completeLedgers: "empty",
+		},
+		{
+			name:            "single_range",
+			completeLedgers: "32570-6595042",
+			want:            []xrpl.LedgerRange{{Min: 32570, Max: 6595042}},
+		},
+		{
+			name:            "multiple_ranges_and_single_ledger",
+			completeLedgers: "1-20,25,500-1000",
+			want: []xrpl.LedgerRange{
+				{Min: 1, Max: 20},
+				{Min: 25, Max: 25},
+				{Min: 500, Max: 1000},
+			},
+		},
+		{
+			name:            "invalid_range",
+			completeLedgers: "1000-500",
+			wantErr:         true,
+		},
+		{
+			name:            "invalid_ledger",
+			completeLedgers: "1-a",
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := xrpl.ServerState{CompleteLedgers: tt.completeLedgers}.CompleteLedgerRanges()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
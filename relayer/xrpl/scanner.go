@@ -2,14 +2,18 @@ package xrpl
 
 import (
 	"context"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	rippledata "github.com/rubblelabs/ripple/data"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/tracing"
 )
 
 //go:generate mockgen -destination=scanner_mocks_test.go -package=xrpl_test . RPCTxProvider,ScannerMetricRegistry
@@ -18,11 +22,13 @@ import (
 type ScannerMetricRegistry interface {
 	SetXRPLAccountRecentHistoryScanLedgerIndex(index float64)
 	SetXRPLAccountFullHistoryScanLedgerIndex(index float64)
+	SetXRPLHistoryGap(gap float64)
 }
 
 // RPCTxProvider is RPC transactions provider.
 type RPCTxProvider interface {
 	LedgerCurrent(ctx context.Context) (LedgerCurrentResult, error)
+	ServerState(ctx context.Context) (ServerStateResult, error)
 	AccountTx(
 		ctx context.Context,
 		account rippledata.Account,
@@ -43,6 +49,12 @@ type AccountScannerConfig struct {
 	RepeatFullScan  bool
 
 	RetryDelay time.Duration
+
+	// CheckpointFilePath is the path of the recent history scanner checkpoint, the empty path disables the
+	// persistence.
+	CheckpointFilePath string
+	// TxSpans receives the tracing spans of the scanned payments, nil disables the spans.
+	TxSpans *tracing.XRPLTxSpans
 }
 
 // DefaultAccountScannerConfig returns the default AccountScannerConfig.
@@ -62,10 +74,14 @@ func DefaultAccountScannerConfig(account rippledata.Account) AccountScannerConfi
 
 // AccountScanner is XRPL transactions scanner.
 type AccountScanner struct {
-	cfg            AccountScannerConfig
-	log            logger.Logger
-	rpcTxProvider  RPCTxProvider
-	metricRegistry ScannerMetricRegistry
+	cfg           AccountScannerConfig
+	log           logger.Logger
+	rpcTxProvider RPCTxProvider
+	// fullHistoryRPCTxProvider is used to backfill the history gaps, it might be nil.
+	fullHistoryRPCTxProvider RPCTxProvider
+	metricRegistry           ScannerMetricRegistry
+	// lastProcessedLedgerTime is the unix nano time of the last successful recent history scan.
+	lastProcessedLedgerTime atomic.Int64
 }
 
 // NewAccountScanner returns a nw instance of the AccountScanner.
@@ -73,13 +89,15 @@ func NewAccountScanner(
 	cfg AccountScannerConfig,
 	log logger.Logger,
 	rpcTxProvider RPCTxProvider,
+	fullHistoryRPCTxProvider RPCTxProvider,
 	metricRegistry ScannerMetricRegistry,
 ) *AccountScanner {
 	return &AccountScanner{
-		cfg:            cfg,
-		log:            log,
-		rpcTxProvider:  rpcTxProvider,
-		metricRegistry: metricRegistry,
+		cfg:                      cfg,
+		log:                      log,
+		rpcTxProvider:            rpcTxProvider,
+		fullHistoryRPCTxProvider: fullHistoryRPCTxProvider,
+		metricRegistry:           metricRegistry,
 	}
 }
 
@@ -115,6 +133,16 @@ func (s *AccountScanner) ScanTxs(ctx context.Context, ch chan<- rippledata.Trans
 	}, parallel.WithGroupLogger(s.log))
 }
 
+// LastProcessedLedgerTime returns the time the recent history scanner has processed the validated ledgers last time,
+// the zero time is returned if no ledger is processed yet.
+func (s *AccountScanner) LastProcessedLedgerTime() time.Time {
+	lastProcessedLedgerTime := s.lastProcessedLedgerTime.Load()
+	if lastProcessedLedgerTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, lastProcessedLedgerTime)
+}
+
 func (s *AccountScanner) scanRecentHistory(
 	ctx context.Context,
 	currentLedger int64,
@@ -125,19 +153,42 @@ func (s *AccountScanner) scanRecentHistory(
 	if currentLedger > s.cfg.RecentScanWindow {
 		minLedger = currentLedger - s.cfg.RecentScanWindow
 	}
+	checkpoint, err := ReadScannerCheckpoint(s.cfg.CheckpointFilePath)
+	if err != nil {
+		s.log.Error(ctx, "Failed to read XRPL scanner checkpoint, starting from the recent scan window", zap.Error(err))
+	}
+	lastScannedLedger := checkpoint.LastScannedLedger
+	// the relayer might be stopped for longer than the window, so the scanning is continued from the checkpoint
+	if lastScannedLedger > 0 && lastScannedLedger+1 < minLedger {
+		minLedger = lastScannedLedger + 1
+	}
 
 	s.doWithRepeat(ctx, s.cfg.RepeatRecentScan, func() error {
+		var err error
+		minLedger, err = s.handleHistoryGap(ctx, minLedger, lastScannedLedger, ch)
+		if err != nil {
+			return err
+		}
 		s.log.Debug(
 			ctx,
 			"Scanning recent XRPL account history",
 			zap.Int64("minLedger", minLedger),
 			zap.String("account", s.cfg.Account.String()),
 		)
-		lastLedger, err := s.scanTransactions(ctx, minLedger, s.metricRegistry.SetXRPLAccountRecentHistoryScanLedgerIndex, ch)
+		lastLedger, err := s.scanTransactions(
+			ctx, s.rpcTxProvider, minLedger, -1, s.metricRegistry.SetXRPLAccountRecentHistoryScanLedgerIndex, ch,
+		)
 		// set minLedger to start with it in next iteration
 		// even if the error was returned we still re-scan from the lastLedger
 		if lastLedger > 0 {
+			s.lastProcessedLedgerTime.Store(time.Now().UnixNano())
 			minLedger = lastLedger + 1
+			lastScannedLedger = lastLedger
+			if err := saveScannerCheckpoint(s.cfg.CheckpointFilePath, ScannerCheckpoint{
+				LastScannedLedger: lastScannedLedger,
+			}); err != nil {
+				s.log.Error(ctx, "Failed to save XRPL scanner checkpoint", zap.Error(err))
+			}
 		}
 		if err != nil {
 			return err
@@ -151,7 +202,9 @@ func (s *AccountScanner) scanFullHistory(ctx context.Context, ch chan<- rippleda
 	minLedger := int64(-1)
 	s.doWithRepeat(ctx, s.cfg.RepeatFullScan, func() error {
 		s.log.Debug(ctx, "Scanning XRPL account full history", zap.String("account", s.cfg.Account.String()))
-		lastLedger, err := s.scanTransactions(ctx, minLedger, s.metricRegistry.SetXRPLAccountFullHistoryScanLedgerIndex, ch)
+		lastLedger, err := s.scanTransactions(
+			ctx, s.rpcTxProvider, minLedger, -1, s.metricRegistry.SetXRPLAccountFullHistoryScanLedgerIndex, ch,
+		)
 		if err != nil {
 			// set minLedger to start with it in next iteration to complete the scanning
 			minLedger = lastLedger + 1
@@ -164,9 +217,83 @@ func (s *AccountScanner) scanFullHistory(ctx context.Context, ch chan<- rippleda
 	})
 }
 
+// handleHistoryGap detects the ledgers after the last scanned ledger which are not available on the node anymore, and
+// backfills them from the full history provider. It returns the ledger to continue the scanning from.
+func (s *AccountScanner) handleHistoryGap(
+	ctx context.Context,
+	minLedger, lastScannedLedger int64,
+	ch chan<- rippledata.TransactionWithMetaData,
+) (int64, error) {
+	serverState, err := s.rpcTxProvider.ServerState(ctx)
+	if err != nil {
+		// the detection doesn't block the scanning, since the node might still have the required ledgers
+		s.log.Warn(ctx, "Failed to get XRPL server state to detect the history gap", zap.Error(err))
+		return minLedger, nil
+	}
+	completeLedgers, err := serverState.State.CompleteLedgerRanges()
+	if err != nil || len(completeLedgers) == 0 {
+		s.log.Warn(
+			ctx,
+			"Failed to get XRPL node complete ledgers to detect the history gap",
+			zap.String("completeLedgers", serverState.State.CompleteLedgers),
+			zap.Error(err),
+		)
+		return minLedger, nil
+	}
+	// the node serves the account history from the range which contains the latest ledgers
+	earliestLedger := completeLedgers[len(completeLedgers)-1].Min
+	if minLedger >= earliestLedger {
+		return minLedger, nil
+	}
+	// the ledgers of the window start which are before the checkpoint were scanned before
+	if lastScannedLedger <= 0 || lastScannedLedger+1 >= earliestLedger {
+		return earliestLedger, nil
+	}
+
+	gap := LedgerRange{
+		Min: lastScannedLedger + 1,
+		Max: earliestLedger - 1,
+	}
+	s.metricRegistry.SetXRPLHistoryGap(float64(gap.Max - gap.Min + 1))
+	s.log.Error(
+		ctx,
+		"Found XRPL account history gap, the node doesn't have the ledgers after the last scanned ledger",
+		zap.Int64("gapMinLedger", gap.Min),
+		zap.Int64("gapMaxLedger", gap.Max),
+		zap.String("completeLedgers", serverState.State.CompleteLedgers),
+	)
+	if s.fullHistoryRPCTxProvider == nil {
+		s.log.Error(
+			ctx,
+			"The XRPL full history RPC is not configured, the history gap transactions are skipped",
+			zap.Int64("gapMinLedger", gap.Min),
+			zap.Int64("gapMaxLedger", gap.Max),
+		)
+		return earliestLedger, nil
+	}
+
+	// the backfilled transactions are processed as the scanned, so the evidences are deduplicated by the contract
+	_, err = s.scanTransactions(ctx, s.fullHistoryRPCTxProvider, gap.Min, gap.Max, func(float64) {}, ch)
+	if err != nil {
+		return minLedger, errors.Wrapf(
+			err, "failed to backfill XRPL account history gap, minLedger:%d, maxLedger:%d", gap.Min, gap.Max,
+		)
+	}
+	s.metricRegistry.SetXRPLHistoryGap(0)
+	s.log.Info(
+		ctx,
+		"XRPL account history gap is backfilled",
+		zap.Int64("gapMinLedger", gap.Min),
+		zap.Int64("gapMaxLedger", gap.Max),
+	)
+
+	return earliestLedger, nil
+}
+
 func (s *AccountScanner) scanTransactions(
 	ctx context.Context,
-	minLedger int64,
+	rpcTxProvider RPCTxProvider,
+	minLedger, maxLedger int64,
 	indexRegistryFunc func(float64),
 	ch chan<- rippledata.TransactionWithMetaData,
 ) (int64, error) {
@@ -179,7 +306,7 @@ func (s *AccountScanner) scanTransactions(
 		prevProcessedLedger int64
 	)
 	for {
-		accountTxResult, err := s.rpcTxProvider.AccountTx(ctx, s.cfg.Account, minLedger, -1, marker)
+		accountTxResult, err := rpcTxProvider.AccountTx(ctx, s.cfg.Account, minLedger, maxLedger, marker)
 		if err != nil {
 			return lastLedger, errors.Wrapf(
 				err,
@@ -202,8 +329,13 @@ func (s *AccountScanner) scanTransactions(
 				if tx == nil {
 					continue
 				}
+				txHash := strings.ToUpper(tx.GetHash().String())
+				if tx.GetType() == rippledata.PAYMENT.String() {
+					s.cfg.TxSpans.Start(ctx, txHash, attribute.Int64(tracing.AttributeXRPLLedger, int64(tx.LedgerSequence)))
+				}
 				select {
 				case <-ctx.Done():
+					s.cfg.TxSpans.Discard(txHash)
 					return lastLedger, ctx.Err()
 				case ch <- *tx:
 				}
@@ -211,6 +343,10 @@ func (s *AccountScanner) scanTransactions(
 		}
 		if len(accountTxResult.Marker) == 0 {
 			lastLedger = prevProcessedLedger
+			// all ledgers of the search are scanned even if they don't contain the account transactions
+			if accountTxResult.Validated && accountTxResult.LedgerMax > lastLedger {
+				lastLedger = accountTxResult.LedgerMax
+			}
 			break
 		}
 		marker = accountTxResult.Marker
@@ -0,0 +1,54 @@
+//nolint:tagliatelle // yaml spec
+package xrpl
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/fsutil"
+)
+
+// ScannerCheckpointFileName is the name of the XRPL scanner checkpoint file stored in the relayer home.
+const ScannerCheckpointFileName = "xrpl-scanner-checkpoint.yaml"
+
+// ScannerCheckpoint is the last ledger of the contiguous ledgers range scanned by the recent history scanner.
+type ScannerCheckpoint struct {
+	LastScannedLedger int64 `yaml:"last_scanned_ledger"`
+}
+
+// ReadScannerCheckpoint reads the scanner checkpoint file, the empty checkpoint is returned if the file path is empty
+// or the file does not exist.
+func ReadScannerCheckpoint(filePath string) (ScannerCheckpoint, error) {
+	if filePath == "" {
+		return ScannerCheckpoint{}, nil
+	}
+	fileBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ScannerCheckpoint{}, nil
+		}
+		return ScannerCheckpoint{}, errors.Wrapf(err, "failed to read scanner checkpoint file, path:%s", filePath)
+	}
+	var checkpoint ScannerCheckpoint
+	if err := yaml.Unmarshal(fileBytes, &checkpoint); err != nil {
+		return ScannerCheckpoint{}, errors.Wrapf(err, "failed to unmarshal scanner checkpoint file, path:%s", filePath)
+	}
+
+	return checkpoint, nil
+}
+
+func saveScannerCheckpoint(filePath string, checkpoint ScannerCheckpoint) error {
+	if filePath == "" {
+		return nil
+	}
+	checkpointBytes, err := yaml.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal scanner checkpoint")
+	}
+
+	return errors.Wrapf(
+		fsutil.WriteFileAtomically(filePath, checkpointBytes), "failed to save scanner checkpoint, path:%s", filePath,
+	)
+}
@@ -71,6 +71,21 @@ func (mr *MockRPCTxProviderMockRecorder) LedgerCurrent(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LedgerCurrent", reflect.TypeOf((*MockRPCTxProvider)(nil).LedgerCurrent), arg0)
 }
 
+// ServerState mocks base method.
+func (m *MockRPCTxProvider) ServerState(arg0 context.Context) (xrpl.ServerStateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerState", arg0)
+	ret0, _ := ret[0].(xrpl.ServerStateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerState indicates an expected call of ServerState.
+func (mr *MockRPCTxProviderMockRecorder) ServerState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerState", reflect.TypeOf((*MockRPCTxProvider)(nil).ServerState), arg0)
+}
+
 // MockScannerMetricRegistry is a mock of ScannerMetricRegistry interface.
 type MockScannerMetricRegistry struct {
 	ctrl     *gomock.Controller
@@ -117,3 +132,15 @@ func (mr *MockScannerMetricRegistryMockRecorder) SetXRPLAccountRecentHistoryScan
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetXRPLAccountRecentHistoryScanLedgerIndex", reflect.TypeOf((*MockScannerMetricRegistry)(nil).SetXRPLAccountRecentHistoryScanLedgerIndex), arg0)
 }
+
+// SetXRPLHistoryGap mocks base method.
+func (m *MockScannerMetricRegistry) SetXRPLHistoryGap(arg0 float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetXRPLHistoryGap", arg0)
+}
+
+// SetXRPLHistoryGap indicates an expected call of SetXRPLHistoryGap.
+func (mr *MockScannerMetricRegistryMockRecorder) SetXRPLHistoryGap(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetXRPLHistoryGap", reflect.TypeOf((*MockScannerMetricRegistry)(nil).SetXRPLHistoryGap), arg0)
+}
@@ -3,6 +3,8 @@ package xrpl_test
 import (
 	"context"
 	"encoding/hex"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gopkg.in/yaml.v3"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/parallel"
 	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
@@ -123,6 +126,11 @@ func TestAccountScanner_ScanTxs(t *testing.T) {
 				mockedProvider.EXPECT().LedgerCurrent(gomock.Any()).Return(xrpl.LedgerCurrentResult{
 					LedgerCurrentIndex: 100,
 				}, nil)
+				mockedProvider.EXPECT().ServerState(gomock.Any()).Return(xrpl.ServerStateResult{
+					State: xrpl.ServerState{
+						CompleteLedgers: "1-100",
+					},
+				}, nil).AnyTimes()
 
 				callNumber := 0
 				mockedProvider.EXPECT().AccountTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
@@ -229,6 +237,7 @@ func TestAccountScanner_ScanTxs(t *testing.T) {
 				tt.cfg,
 				logMock,
 				rpcTxProvider,
+				nil,
 				metricRegistryMock,
 			)
 			txsCh := make(chan rippledata.TransactionWithMetaData)
@@ -255,6 +264,171 @@ func TestAccountScanner_ScanTxs(t *testing.T) {
 	}
 }
 
+func TestAccountScanner_ScanTxsWithHistoryGap(t *testing.T) {
+	t.Parallel()
+
+	account := xrpl.GenPrivKeyTxSigner().Account()
+
+	tests := []struct {
+		name                     string
+		fullHistoryRPCTxProvider func(ctrl *gomock.Controller) xrpl.RPCTxProvider
+		expectedGaps             []float64
+		repeatRecentScan         bool
+		wantTxHashes             []string
+		errorsCount              int
+	}{
+		{
+			name: "gap_backfilled",
+			fullHistoryRPCTxProvider: func(ctrl *gomock.Controller) xrpl.RPCTxProvider {
+				mockedProvider := NewMockRPCTxProvider(ctrl)
+				mockedProvider.EXPECT().AccountTx(gomock.Any(), account, int64(51), int64(499), gomock.Any()).
+					Return(xrpl.AccountTxResult{
+						LedgerMax: 499,
+						Validated: true,
+						Transactions: buildEmptyTransactions([]txTemplate{
+							{
+								Hash:           "backfilled",
+								LedgerSequence: 60,
+							},
+						}),
+					}, nil)
+				return mockedProvider
+			},
+			expectedGaps: []float64{449, 0},
+			wantTxHashes: []string{"backfilled", "recent"},
+			// the gap detection
+			errorsCount: 1,
+		},
+		{
+			name: "gap_backfilled_after_failure",
+			fullHistoryRPCTxProvider: func(ctrl *gomock.Controller) xrpl.RPCTxProvider {
+				mockedProvider := NewMockRPCTxProvider(ctrl)
+				gomock.InOrder(
+					mockedProvider.EXPECT().AccountTx(gomock.Any(), account, int64(51), int64(499), gomock.Any()).
+						Return(xrpl.AccountTxResult{}, errors.New("timeout")),
+					mockedProvider.EXPECT().AccountTx(gomock.Any(), account, int64(51), int64(499), gomock.Any()).
+						Return(xrpl.AccountTxResult{
+							LedgerMax: 499,
+							Validated: true,
+							Transactions: buildEmptyTransactions([]txTemplate{
+								{
+									Hash:           "backfilled",
+									LedgerSequence: 60,
+								},
+							}),
+						}, nil),
+				)
+				return mockedProvider
+			},
+			expectedGaps:     []float64{449, 449, 0},
+			repeatRecentScan: true,
+			wantTxHashes:     []string{"backfilled", "recent"},
+			// two gap detections and the failed backfill
+			errorsCount: 3,
+		},
+		{
+			name: "gap_without_full_history_provider",
+			fullHistoryRPCTxProvider: func(ctrl *gomock.Controller) xrpl.RPCTxProvider {
+				return nil
+			},
+			expectedGaps: []float64{449},
+			wantTxHashes: []string{"recent"},
+			// the gap detection and missing backfill
+			errorsCount: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			logMock := logger.NewAnyLogMock(ctrl)
+			logMock.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any()).Times(tt.errorsCount)
+
+			checkpointFilePath := filepath.Join(t.TempDir(), xrpl.ScannerCheckpointFileName)
+			checkpointBytes, err := yaml.Marshal(xrpl.ScannerCheckpoint{LastScannedLedger: 50})
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(checkpointFilePath, checkpointBytes, 0o600))
+
+			// the node has pruned the ledgers after the checkpoint
+			rpcTxProvider := NewMockRPCTxProvider(ctrl)
+			rpcTxProvider.EXPECT().LedgerCurrent(gomock.Any()).Return(xrpl.LedgerCurrentResult{
+				LedgerCurrentIndex: 1000,
+			}, nil)
+			rpcTxProvider.EXPECT().ServerState(gomock.Any()).Return(xrpl.ServerStateResult{
+				State: xrpl.ServerState{
+					CompleteLedgers: "1-20,500-1000",
+				},
+			}, nil).AnyTimes()
+			rpcTxProvider.EXPECT().AccountTx(gomock.Any(), account, int64(500), int64(-1), gomock.Any()).
+				Return(xrpl.AccountTxResult{
+					LedgerMax: 1000,
+					Validated: true,
+					Transactions: buildEmptyTransactions([]txTemplate{
+						{
+							Hash:           "recent",
+							LedgerSequence: 600,
+						},
+					}),
+				}, nil)
+			// the next iterations continue from the last ledger of the search
+			rpcTxProvider.EXPECT().AccountTx(gomock.Any(), account, int64(1001), int64(-1), gomock.Any()).
+				Return(xrpl.AccountTxResult{}, nil).AnyTimes()
+
+			metricRegistryMock := NewMockScannerMetricRegistry(ctrl)
+			metricRegistryMock.EXPECT().SetXRPLAccountRecentHistoryScanLedgerIndex(gomock.Any()).AnyTimes()
+			gapCalls := make([]any, 0, len(tt.expectedGaps))
+			for _, gap := range tt.expectedGaps {
+				gapCalls = append(gapCalls, metricRegistryMock.EXPECT().SetXRPLHistoryGap(gap))
+			}
+			gomock.InOrder(gapCalls...)
+
+			s := xrpl.NewAccountScanner(
+				xrpl.AccountScannerConfig{
+					Account:            account,
+					RecentScanEnabled:  true,
+					RecentScanWindow:   100,
+					RepeatRecentScan:   tt.repeatRecentScan,
+					RetryDelay:         time.Millisecond,
+					CheckpointFilePath: checkpointFilePath,
+				},
+				logMock,
+				rpcTxProvider,
+				tt.fullHistoryRPCTxProvider(ctrl),
+				metricRegistryMock,
+			)
+			txsCh := make(chan rippledata.TransactionWithMetaData)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			t.Cleanup(cancel)
+			require.NoError(t, parallel.Run(ctx, func(ctx context.Context, spawn parallel.SpawnFn) error {
+				spawn("scan", parallel.Continue, func(ctx context.Context) error {
+					return s.ScanTxs(ctx, txsCh)
+				})
+				spawn("read", parallel.Exit, func(ctx context.Context) error {
+					gotTxHashes := readTxHashesFromChannels(ctx, t, txsCh, len(tt.wantTxHashes))
+					expectedTxHashes := lo.SliceToMap(tt.wantTxHashes, func(hash string) (string, struct{}) {
+						return hash, struct{}{}
+					})
+					if !reflect.DeepEqual(expectedTxHashes, gotTxHashes) {
+						return errors.Errorf("expectec tx hashes:%v, got:%v", expectedTxHashes, gotTxHashes)
+					}
+					return nil
+				})
+				return nil
+			}))
+
+			// the checkpoint is moved to the last ledger of the search
+			require.Eventually(t, func() bool {
+				checkpoint, err := xrpl.ReadScannerCheckpoint(checkpointFilePath)
+				require.NoError(t, err)
+				return checkpoint.LastScannedLedger == 1000
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
 func readTxHashesFromChannels(
 	ctx context.Context,
 	t *testing.T,
@@ -55,15 +55,23 @@ func (k xrplPrivKey) ExtractPubKeyFromXRPLKey() rippledata.PublicKey {
 
 // ********** KeyringTxSigner **********
 
+// KeyUsageAuditLogger records the signatures produced with the relayer keys.
+type KeyUsageAuditLogger interface {
+	LogXRPLSign(operationID uint32, payloadDigest []byte)
+}
+
 // KeyringTxSigner is XRPL singer for the cosmos keyring.
 type KeyringTxSigner struct {
-	kr keyring.Keyring
+	kr          keyring.Keyring
+	auditLogger KeyUsageAuditLogger
 }
 
-// NewKeyringTxSigner returns new instance of the KeyringTxSigner.
-func NewKeyringTxSigner(kr keyring.Keyring) *KeyringTxSigner {
+// NewKeyringTxSigner returns new instance of the KeyringTxSigner. If the auditLogger is provided, each produced
+// signature is recorded with it.
+func NewKeyringTxSigner(kr keyring.Keyring, auditLogger KeyUsageAuditLogger) *KeyringTxSigner {
 	return &KeyringTxSigner{
-		kr: kr,
+		kr:          kr,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -77,6 +85,13 @@ func (s *KeyringTxSigner) Sign(tx rippledata.Transaction, keyName string) error
 	if err = rippledata.Sign(tx, key, zeroSeq); err != nil {
 		return errors.Wrapf(err, "failed to sign XRPL transaction with keyring")
 	}
+	if s.auditLogger != nil {
+		hash, _, err := rippledata.SigningHash(tx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get XRPL transaction signing hash")
+		}
+		s.auditLogger.LogXRPLSign(GetTxOperationID(tx), hash.Bytes())
+	}
 
 	return nil
 }
@@ -91,6 +106,17 @@ func (s *KeyringTxSigner) MultiSign(tx rippledata.MultiSignable, keyName string)
 	if err := rippledata.MultiSign(tx, key, zeroSeq, acc); err != nil {
 		return rippledata.Signer{}, err
 	}
+	if s.auditLogger != nil {
+		hash, _, err := rippledata.MultiSigningHash(tx, acc)
+		if err != nil {
+			return rippledata.Signer{}, errors.Wrapf(err, "failed to get XRPL transaction multi-signing hash")
+		}
+		var operationID uint32
+		if tx, ok := tx.(rippledata.Transaction); ok {
+			operationID = GetTxOperationID(tx)
+		}
+		s.auditLogger.LogXRPLSign(operationID, hash.Bytes())
+	}
 
 	return rippledata.Signer{
 		Signer: rippledata.SignerItem{
@@ -101,6 +127,20 @@ func (s *KeyringTxSigner) MultiSign(tx rippledata.MultiSignable, keyName string)
 	}, nil
 }
 
+// VerifySignature returns true if the signer signature is the valid multi-signature of the transaction by the signer
+// account and public key. The transaction signers are replaced with the verified signer.
+func VerifySignature(tx rippledata.MultiSignable, signer rippledata.Signer) (bool, error) {
+	if err := rippledata.SetSigners(tx, signer); err != nil {
+		return false, errors.Wrapf(err, "failed to set transaction signer, signer:%+v", signer)
+	}
+	isValid, _, err := rippledata.CheckMultiSignature(tx)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check transaction multi-signature, signer:%+v", signer)
+	}
+
+	return isValid, nil
+}
+
 // Account returns account from the keyring for the provided key name.
 func (s *KeyringTxSigner) Account(keyName string) (rippledata.Account, error) {
 	key, err := s.extractXRPLPrivKey(keyName)
@@ -0,0 +1,103 @@
+package xrpl
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+)
+
+const signerListSignerWeight = uint16(1)
+
+// SignerListRelayer is the relayer included into the bridge account signer list.
+type SignerListRelayer struct {
+	XRPLAddress string `json:"xrpl_address"`
+	XRPLPubKey  string `json:"xrpl_pub_key"`
+}
+
+// SignerListConfig is the config of the bridge account signer list.
+type SignerListConfig struct {
+	Relayers []SignerListRelayer `json:"relayers"`
+}
+
+// GetSignerListReserve returns the reserve in XRP locked by the signer list with the provided signers count.
+func GetSignerListReserve(signersCount int) float64 {
+	return ReservePerItem * float64(1+signersCount)
+}
+
+// BuildSignerListSetTx builds the SignerListSet transaction which sets the relayers with the weight 1 as the signers
+// of the account. The signer entries are sorted by the account ID, so the same relayers always produce the same
+// transaction.
+func BuildSignerListSetTx(
+	account rippledata.Account,
+	sequence uint32,
+	quorum uint32,
+	relayers []SignerListRelayer,
+) (*rippledata.SignerListSet, error) {
+	if len(relayers) == 0 || len(relayers) > int(MaxAllowedXRPLSigners) {
+		return nil, errors.Errorf(
+			"invalid relayers count, expected from 1 to %d, got: %d", MaxAllowedXRPLSigners, len(relayers),
+		)
+	}
+	if quorum == 0 || quorum > uint32(len(relayers)) {
+		return nil, errors.Errorf("invalid quorum, expected from 1 to %d, got: %d", len(relayers), quorum)
+	}
+
+	signerAccounts := make([]rippledata.Account, 0, len(relayers))
+	for i, relayer := range relayers {
+		signerAccount, err := rippledata.NewAccountFromAddress(relayer.XRPLAddress)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid XRPL address of the relayer %d: %s", i, relayer.XRPLAddress)
+		}
+		if _, err := PublicKeyAccount(relayer.XRPLPubKey); err != nil {
+			return nil, errors.Wrapf(err, "invalid XRPL public key of the relayer %d: %s", i, relayer.XRPLPubKey)
+		}
+		if *signerAccount == account {
+			return nil, errors.Errorf("the account can't be the signer of its own signer list: %s", account.String())
+		}
+		if lo.Contains(signerAccounts, *signerAccount) {
+			return nil, errors.Errorf("duplicate XRPL address of the relayer %d: %s", i, relayer.XRPLAddress)
+		}
+		signerAccounts = append(signerAccounts, *signerAccount)
+	}
+	sort.Slice(signerAccounts, func(i, j int) bool {
+		return bytes.Compare(signerAccounts[i][:], signerAccounts[j][:]) < 0
+	})
+
+	fee, err := rippledata.NewNativeValue(int64(DefaultXRPLBaseFee))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert fee to ripple fee")
+	}
+
+	return &rippledata.SignerListSet{
+		TxBase: rippledata.TxBase{
+			TransactionType: rippledata.SIGNER_LIST_SET,
+			Account:         account,
+			Sequence:        sequence,
+			Fee:             *fee,
+		},
+		SignerQuorum: quorum,
+		SignerEntries: lo.Map(signerAccounts, func(signerAccount rippledata.Account, _ int) rippledata.SignerEntry {
+			return rippledata.SignerEntry{
+				SignerEntry: rippledata.SignerEntryItem{
+					Account:      lo.ToPtr(signerAccount),
+					SignerWeight: lo.ToPtr(signerListSignerWeight),
+				},
+			}
+		}),
+	}, nil
+}
+
+// EncodeSignerListSetTx encodes the SignerListSet transaction to the hex blob.
+func EncodeSignerListSetTx(tx *rippledata.SignerListSet) (string, error) {
+	_, raw, err := rippledata.Raw(tx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode XRPL SignerListSet transaction")
+	}
+
+	return strings.ToUpper(hex.EncodeToString(raw)), nil
+}
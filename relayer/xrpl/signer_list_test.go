@@ -0,0 +1,164 @@
+package xrpl_test
+
+import (
+	"fmt"
+	"testing"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+// the genesis account, rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh, has the account ID B5F762798A53D543A014CAF8B297CFF8F2F937E8.
+const signerListTestBridgeAddress = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+
+func TestBuildSignerListSetTx(t *testing.T) {
+	t.Parallel()
+
+	bridgeAccount, err := rippledata.NewAccountFromAddress(signerListTestBridgeAddress)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		sequence     uint32
+		quorum       uint32
+		addresses    []string
+		wantTxBlob   string
+		wantReserve  float64
+		wantErrorMsg string
+	}{
+		{
+			name:     "3_of_5",
+			sequence: 1,
+			quorum:   3,
+			// the account IDs are 5001..01, 4102..02, 3203..03, 2304..04 and 1405..05
+			addresses: []string{
+				"r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+				"ravjKydV4McJxGSDbDfmiS2rT5Gm4zFWwQ",
+				"rnZSPiT9ke6GvpcJjaqAgsfb7kfVMYZUSC",
+				"rhU9TjGF3A8N188Piz2fVdCBVmxDHTjHUX",
+				"rpFiXca7XN1UqYx7ztJHKNUmWo8AJhedVk",
+			},
+			wantTxBlob: "12000C2400000001202300000003" +
+				"68400000000000000A" +
+				"8114B5F762798A53D543A014CAF8B297CFF8F2F937E8" +
+				"F4" +
+				"EB13000181141405050505050505050505050505050505050505E1" +
+				"EB13000181142304040404040404040404040404040404040404E1" +
+				"EB13000181143203030303030303030303030303030303030303E1" +
+				"EB13000181144102020202020202020202020202020202020202E1" +
+				"EB13000181145001010101010101010101010101010101010101E1" +
+				"F1",
+			wantReserve: 12,
+		},
+		{
+			name:     "5_of_8",
+			sequence: 7,
+			quorum:   5,
+			// the account IDs are 8001..01, 7102..02, 6203..03, 5304..04, 4405..05, 3506..06, 2607..07 and 1708..08
+			addresses: []string{
+				"rUCF6J1hzXitGebmeT27dftW4eC5oUoFqC",
+				"rBJXmBgj4FAiNQmi8MJeS5SaPgWSecXcGY",
+				"r9ANqhWPm3pFUwAAvEpFGJygQ5MweifV6f",
+				"r3ZAtALh9Rf89A3sh3kznuXGRkBtZG4bsP",
+				"rfUexF95X5Ukf6J3UpV9uWhiS8pcVH5aBL",
+				"rnq4pgy4vrH5nT7DKvDKjacSTo1LTEqBy7",
+				"rh7naZosJJ4gsDeJToAVY6wp7q5hRTnxmj",
+				"rsa8wSc6gbTdzyFPb6CC4H6cV1Y8P9fw1M",
+			},
+			wantTxBlob: "12000C2400000007202300000005" +
+				"68400000000000000A" +
+				"8114B5F762798A53D543A014CAF8B297CFF8F2F937E8" +
+				"F4" +
+				"EB13000181141708080808080808080808080808080808080808E1" +
+				"EB13000181142607070707070707070707070707070707070707E1" +
+				"EB13000181143506060606060606060606060606060606060606E1" +
+				"EB13000181144405050505050505050505050505050505050505E1" +
+				"EB13000181145304040404040404040404040404040404040404E1" +
+				"EB13000181146203030303030303030303030303030303030303E1" +
+				"EB13000181147102020202020202020202020202020202020202E1" +
+				"EB13000181148001010101010101010101010101010101010101E1" +
+				"F1",
+			wantReserve: 18,
+		},
+		{
+			name:         "zero_quorum",
+			addresses:    []string{"r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L"},
+			wantErrorMsg: "invalid quorum",
+		},
+		{
+			name:         "quorum_above_relayers_count",
+			quorum:       2,
+			addresses:    []string{"r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L"},
+			wantErrorMsg: "invalid quorum",
+		},
+		{
+			name:         "no_relayers",
+			quorum:       1,
+			wantErrorMsg: "invalid relayers count",
+		},
+		{
+			name:   "duplicate_address",
+			quorum: 1,
+			addresses: []string{
+				"r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+				"r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+			},
+			wantErrorMsg: "duplicate XRPL address of the relayer 1",
+		},
+		{
+			name:         "bridge_account_signer",
+			quorum:       1,
+			addresses:    []string{signerListTestBridgeAddress},
+			wantErrorMsg: "the account can't be the signer of its own signer list",
+		},
+		{
+			name:         "invalid_address",
+			quorum:       1,
+			addresses:    []string{"invalid"},
+			wantErrorMsg: "invalid XRPL address of the relayer 0",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relayers := make([]xrpl.SignerListRelayer, 0, len(tt.addresses))
+			for i, address := range tt.addresses {
+				relayers = append(relayers, xrpl.SignerListRelayer{
+					XRPLAddress: address,
+					XRPLPubKey:  fmt.Sprintf("02%064X", i),
+				})
+			}
+			tx, err := xrpl.BuildSignerListSetTx(*bridgeAccount, tt.sequence, tt.quorum, relayers)
+			if tt.wantErrorMsg != "" {
+				require.ErrorContains(t, err, tt.wantErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			txBlob, err := xrpl.EncodeSignerListSetTx(tx)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTxBlob, txBlob)
+			require.InDelta(t, tt.wantReserve, xrpl.GetSignerListReserve(len(relayers)), 0)
+		})
+	}
+}
+
+func TestBuildSignerListSetTx_InvalidPubKey(t *testing.T) {
+	t.Parallel()
+
+	bridgeAccount, err := rippledata.NewAccountFromAddress(signerListTestBridgeAddress)
+	require.NoError(t, err)
+
+	for _, pubKey := range []string{"", "not-hex", "02AB"} {
+		_, err := xrpl.BuildSignerListSetTx(*bridgeAccount, 1, 1, []xrpl.SignerListRelayer{
+			{
+				XRPLAddress: "r3JpGaoFzhXLzWG3TKPcpipRSgSs77oK4L",
+				XRPLPubKey:  pubKey,
+			},
+		})
+		require.ErrorContains(t, err, "invalid XRPL public key of the relayer 0")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	coreumapp "github.com/CoreumFoundation/coreum/v4/app"
@@ -29,7 +30,7 @@ func TestKeyringTxSigner_MultiSignWithSignatureVerification(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	signer := xrpl.NewKeyringTxSigner(kr)
+	signer := xrpl.NewKeyringTxSigner(kr, nil)
 	signerAcc, err := signer.Account(keyName)
 	require.NoError(t, err)
 	// check that account is expected correct
@@ -62,6 +63,42 @@ func TestKeyringTxSigner_MultiSignWithSignatureVerification(t *testing.T) {
 	require.True(t, valid)
 }
 
+func TestKeyringTxSigner_KeyUsageAudit(t *testing.T) {
+	t.Parallel()
+
+	encodingConfig := coreumconfig.NewEncodingConfig(coreumapp.ModuleBasics)
+	kr := keyring.NewInMemory(encodingConfig.Codec)
+	const keyName = "xrpl"
+	_, _, err := kr.NewMnemonic(keyName, keyring.English, xrpl.XRPLHDPath, "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	auditLogger := &fakeKeyUsageAuditLogger{}
+	signer := xrpl.NewKeyringTxSigner(kr, auditLogger)
+	signerAcc, err := signer.Account(keyName)
+	require.NoError(t, err)
+
+	recipientAccount := xrpl.GenPrivKeyTxSigner().Account()
+	xrpAmount, err := rippledata.NewAmount("100000")
+	require.NoError(t, err)
+
+	xrpPaymentTx := buildPaymentTx(&recipientAccount, xrpAmount, signerAcc)
+	require.NoError(t, signer.Sign(&xrpPaymentTx, keyName))
+	signingHash, _, err := rippledata.SigningHash(&xrpPaymentTx)
+	require.NoError(t, err)
+
+	// the ticket sequence is the operation ID of the tx sent with the ticket
+	xrpPaymentTx = buildPaymentTx(&recipientAccount, xrpAmount, signerAcc)
+	xrpPaymentTx.Sequence = 0
+	xrpPaymentTx.TicketSequence = lo.ToPtr(uint32(7))
+	_, err = signer.MultiSign(&xrpPaymentTx, keyName)
+	require.NoError(t, err)
+	multiSigningHash, _, err := rippledata.MultiSigningHash(&xrpPaymentTx, signerAcc)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint32{1, 7}, auditLogger.operationIDs)
+	require.Equal(t, [][]byte{signingHash.Bytes(), multiSigningHash.Bytes()}, auditLogger.payloadDigests)
+}
+
 func TestPrivKeyTxSigner_MultiSignWithSignatureVerification(t *testing.T) {
 	t.Parallel()
 
@@ -92,6 +129,55 @@ func TestPrivKeyTxSigner_MultiSignWithSignatureVerification(t *testing.T) {
 	require.True(t, valid)
 }
 
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	signer := xrpl.GenPrivKeyTxSigner()
+	signerAcc := signer.Account()
+
+	recipientAccount, err := rippledata.NewAccountFromAddress("rnZfuixFVhyAXWZDnYsCGEg2zGtpg4ZjKn")
+	require.NoError(t, err)
+	xrpAmount, err := rippledata.NewAmount("100000")
+	require.NoError(t, err)
+
+	xrpPaymentTx := buildPaymentTx(recipientAccount, xrpAmount, signerAcc)
+	txSigner, err := signer.MultiSign(&xrpPaymentTx)
+	require.NoError(t, err)
+
+	// valid signature
+	xrpPaymentTx = buildPaymentTx(recipientAccount, xrpAmount, signerAcc)
+	valid, err := xrpl.VerifySignature(&xrpPaymentTx, txSigner)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// the signature of another transaction
+	otherXRPAmount, err := rippledata.NewAmount("100001")
+	require.NoError(t, err)
+	xrpPaymentTx = buildPaymentTx(recipientAccount, otherXRPAmount, signerAcc)
+	valid, err = xrpl.VerifySignature(&xrpPaymentTx, txSigner)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// the signature verified with another public key
+	otherPubKey := xrpl.GenPrivKeyTxSigner().PubKey()
+	signerWithOtherPubKey := txSigner
+	signerWithOtherPubKey.Signer.SigningPubKey = &otherPubKey
+	xrpPaymentTx = buildPaymentTx(recipientAccount, xrpAmount, signerAcc)
+	valid, err = xrpl.VerifySignature(&xrpPaymentTx, signerWithOtherPubKey)
+	require.NoError(t, err)
+	require.False(t, valid)
+}
+
+type fakeKeyUsageAuditLogger struct {
+	operationIDs   []uint32
+	payloadDigests [][]byte
+}
+
+func (l *fakeKeyUsageAuditLogger) LogXRPLSign(operationID uint32, payloadDigest []byte) {
+	l.operationIDs = append(l.operationIDs, operationID)
+	l.payloadDigests = append(l.payloadDigests, payloadDigest)
+}
+
 func buildPaymentTx(
 	recipientAccount *rippledata.Account,
 	xrpAmount *rippledata.Amount,
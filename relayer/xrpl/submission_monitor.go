@@ -0,0 +1,229 @@
+package xrpl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+)
+
+//go:generate mockgen -destination=submission_monitor_mocks_test.go -package=xrpl_test . SubmissionMonitorRPCClient
+
+// SubmissionMonitorRPCClient is the RPC client used by the SubmissionMonitor.
+type SubmissionMonitorRPCClient interface {
+	AccountInfo(ctx context.Context, acc rippledata.Account) (AccountInfoResult, error)
+	ServerState(ctx context.Context) (ServerStateResult, error)
+	Submit(ctx context.Context, tx rippledata.Transaction) (SubmitResult, error)
+	Tx(ctx context.Context, hash rippledata.Hash256) (TxResult, error)
+}
+
+// SignFunc signs the transaction with the sequence and last ledger sequence set by the SubmissionMonitor.
+type SignFunc func(ctx context.Context, tx rippledata.Transaction) error
+
+// SubmissionMonitorConfig is SubmissionMonitor config.
+type SubmissionMonitorConfig struct {
+	PollInterval time.Duration
+	// LastLedgerSequenceOffset is the number of ledgers after the latest validated ledger the submitted transaction
+	// must be validated within, otherwise it can't be validated anymore and is treated as dropped.
+	LastLedgerSequenceOffset uint32
+	// MaxResubmissions is the max number of the re-submissions of the dropped transaction, zero disables them.
+	MaxResubmissions int
+}
+
+// DefaultSubmissionMonitorConfig returns default SubmissionMonitorConfig.
+func DefaultSubmissionMonitorConfig() SubmissionMonitorConfig {
+	return SubmissionMonitorConfig{
+		PollInterval:             time.Second,
+		LastLedgerSequenceOffset: 20,
+		MaxResubmissions:         3,
+	}
+}
+
+// SubmissionMonitor submits the XRPL transactions signed by a single key and polls them until they are validated.
+// Each submission is signed with the LastLedgerSequence, and the transaction is re-submitted with the sequence
+// re-fetched from the sender account only once the validated ledger has passed it and the transaction isn't validated,
+// hence the dropped transaction can't be validated after the re-submission.
+// The monitor isn't used for the relayer operations, since they are multi-signed with the ticket or sequence
+// allocated by the contract, so a single relayer can neither re-sign them with another sequence nor set the
+// LastLedgerSequence. The operation transactions are re-submitted by the CoreumToXRPLProcess while the operation is
+// pending instead.
+type SubmissionMonitor struct {
+	cfg       SubmissionMonitorConfig
+	log       logger.Logger
+	rpcClient SubmissionMonitorRPCClient
+}
+
+// NewSubmissionMonitor returns a new instance of the SubmissionMonitor.
+func NewSubmissionMonitor(
+	cfg SubmissionMonitorConfig,
+	log logger.Logger,
+	rpcClient SubmissionMonitorRPCClient,
+) (*SubmissionMonitor, error) {
+	if cfg.PollInterval <= 0 {
+		return nil, errors.Errorf("poll interval must be positive, got: %s", cfg.PollInterval)
+	}
+	if cfg.LastLedgerSequenceOffset == 0 {
+		return nil, errors.New("last ledger sequence offset must be positive")
+	}
+	if cfg.MaxResubmissions < 0 {
+		return nil, errors.Errorf("max resubmissions must not be negative, got: %d", cfg.MaxResubmissions)
+	}
+
+	return &SubmissionMonitor{
+		cfg:       cfg,
+		log:       log,
+		rpcClient: rpcClient,
+	}, nil
+}
+
+// SubmitAndMonitor sets the LastLedgerSequence of the transaction, signs it with the sign func, submits it and waits
+// for it to be validated. The transactions of all submissions are tracked by hash, and the validated transaction is
+// returned regardless of its result.
+func (m *SubmissionMonitor) SubmitAndMonitor(
+	ctx context.Context,
+	tx rippledata.Transaction,
+	sign SignFunc,
+) (TxResult, error) {
+	lastLedgerSequence, err := m.signAndSubmit(ctx, tx, sign)
+	if err != nil {
+		return TxResult{}, err
+	}
+	txHashes := []rippledata.Hash256{*tx.GetHash()}
+	for resubmissions := 0; ; resubmissions++ {
+		txRes, validated, err := m.awaitValidation(ctx, txHashes, lastLedgerSequence)
+		if err != nil {
+			return TxResult{}, err
+		}
+		if validated {
+			return txRes, nil
+		}
+		if resubmissions >= m.cfg.MaxResubmissions {
+			return TxResult{}, errors.Errorf(
+				"transaction is not validated up to the last ledger sequence %d after %d re-submissions, tx hashes: %s",
+				lastLedgerSequence, resubmissions, formatTxHashes(txHashes),
+			)
+		}
+
+		base := tx.GetBase()
+		accInfo, err := m.rpcClient.AccountInfo(ctx, base.Account)
+		if err != nil {
+			return TxResult{}, err
+		}
+		m.log.Warn(
+			ctx,
+			"XRPL transaction is not validated up to the last ledger sequence, re-submitting",
+			zap.String("txHash", strings.ToUpper(tx.GetHash().String())),
+			zap.Uint32("lastLedgerSequence", lastLedgerSequence),
+			zap.Uint32("prevSequence", base.Sequence),
+			zap.Uint32("sequence", *accInfo.AccountData.Sequence),
+			zap.Int("resubmission", resubmissions+1),
+		)
+		base.Sequence = *accInfo.AccountData.Sequence
+		if lastLedgerSequence, err = m.signAndSubmit(ctx, tx, sign); err != nil {
+			return TxResult{}, err
+		}
+		txHashes = append(txHashes, *tx.GetHash())
+	}
+}
+
+func (m *SubmissionMonitor) signAndSubmit(
+	ctx context.Context,
+	tx rippledata.Transaction,
+	sign SignFunc,
+) (uint32, error) {
+	validatedLedgerSequence, err := m.getValidatedLedgerSequence(ctx)
+	if err != nil {
+		return 0, err
+	}
+	lastLedgerSequence := validatedLedgerSequence + m.cfg.LastLedgerSequenceOffset
+	tx.GetBase().LastLedgerSequence = &lastLedgerSequence
+	if err := sign(ctx, tx); err != nil {
+		return 0, errors.Wrap(err, "failed to sign the submitted transaction")
+	}
+
+	m.log.Info(
+		ctx,
+		"Submitting XRPL transaction",
+		zap.String("txHash", strings.ToUpper(tx.GetHash().String())),
+		zap.Uint32("lastLedgerSequence", lastLedgerSequence),
+	)
+	res, err := m.rpcClient.Submit(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	// the queued transaction is tracked the same way as the applied one since it might be dropped from the queue
+	if !res.EngineResult.Success() && res.EngineResult != rippledata.TerQUEUED {
+		return 0, errors.Errorf("the tx submition is failed, %+v", res)
+	}
+
+	return lastLedgerSequence, nil
+}
+
+// awaitValidation polls the transactions until one of them is validated or the validated ledger passes the last
+// ledger sequence while none of them is validated. The transaction lookup failed with the error other than the not
+// found one postpones the re-submission, since the transaction might be validated.
+func (m *SubmissionMonitor) awaitValidation(
+	ctx context.Context,
+	txHashes []rippledata.Hash256,
+	lastLedgerSequence uint32,
+) (TxResult, bool, error) {
+	ticker := time.NewTicker(m.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return TxResult{}, false, errors.WithStack(ctx.Err())
+		case <-ticker.C:
+			// the validated ledger is fetched before the transactions, so the transactions not found or not validated
+			// are missing in all ledgers up to it, and can't be validated after it passes their last ledger sequence
+			validatedLedgerSequence, err := m.getValidatedLedgerSequence(ctx)
+			if err != nil {
+				m.log.Debug(ctx, "Failed to get XRPL validated ledger sequence", zap.Error(err))
+				continue
+			}
+			allMissing := true
+			for _, txHash := range txHashes {
+				txRes, err := m.rpcClient.Tx(ctx, txHash)
+				if err != nil {
+					allMissing = allMissing && IsTxNotFoundError(err)
+					m.log.Debug(
+						ctx,
+						"Failed to get XRPL transaction",
+						zap.String("txHash", strings.ToUpper(txHash.String())),
+						zap.Error(err),
+					)
+					continue
+				}
+				if txRes.Validated {
+					return txRes, true, nil
+				}
+			}
+			if allMissing && validatedLedgerSequence > lastLedgerSequence {
+				return TxResult{}, false, nil
+			}
+		}
+	}
+}
+
+func (m *SubmissionMonitor) getValidatedLedgerSequence(ctx context.Context) (uint32, error) {
+	serverState, err := m.rpcClient.ServerState(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(serverState.State.ValidatedLedger.Seq), nil
+}
+
+func formatTxHashes(txHashes []rippledata.Hash256) string {
+	formatted := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		formatted = append(formatted, strings.ToUpper(txHash.String()))
+	}
+
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl (interfaces: SubmissionMonitorRPCClient)
+//
+// Generated by this command:
+//
+//	mockgen -destination=submission_monitor_mocks_test.go -package=xrpl_test . SubmissionMonitorRPCClient
+//
+
+// Package xrpl_test is a generated GoMock package.
+package xrpl_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	xrpl "github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+	data "github.com/rubblelabs/ripple/data"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSubmissionMonitorRPCClient is a mock of SubmissionMonitorRPCClient interface.
+type MockSubmissionMonitorRPCClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubmissionMonitorRPCClientMockRecorder
+}
+
+// MockSubmissionMonitorRPCClientMockRecorder is the mock recorder for MockSubmissionMonitorRPCClient.
+type MockSubmissionMonitorRPCClientMockRecorder struct {
+	mock *MockSubmissionMonitorRPCClient
+}
+
+// NewMockSubmissionMonitorRPCClient creates a new mock instance.
+func NewMockSubmissionMonitorRPCClient(ctrl *gomock.Controller) *MockSubmissionMonitorRPCClient {
+	mock := &MockSubmissionMonitorRPCClient{ctrl: ctrl}
+	mock.recorder = &MockSubmissionMonitorRPCClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubmissionMonitorRPCClient) EXPECT() *MockSubmissionMonitorRPCClientMockRecorder {
+	return m.recorder
+}
+
+// AccountInfo mocks base method.
+func (m *MockSubmissionMonitorRPCClient) AccountInfo(arg0 context.Context, arg1 data.Account) (xrpl.AccountInfoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountInfo", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.AccountInfoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountInfo indicates an expected call of AccountInfo.
+func (mr *MockSubmissionMonitorRPCClientMockRecorder) AccountInfo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountInfo", reflect.TypeOf((*MockSubmissionMonitorRPCClient)(nil).AccountInfo), arg0, arg1)
+}
+
+// ServerState mocks base method.
+func (m *MockSubmissionMonitorRPCClient) ServerState(arg0 context.Context) (xrpl.ServerStateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerState", arg0)
+	ret0, _ := ret[0].(xrpl.ServerStateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerState indicates an expected call of ServerState.
+func (mr *MockSubmissionMonitorRPCClientMockRecorder) ServerState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerState", reflect.TypeOf((*MockSubmissionMonitorRPCClient)(nil).ServerState), arg0)
+}
+
+// Submit mocks base method.
+func (m *MockSubmissionMonitorRPCClient) Submit(arg0 context.Context, arg1 data.Transaction) (xrpl.SubmitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.SubmitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockSubmissionMonitorRPCClientMockRecorder) Submit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockSubmissionMonitorRPCClient)(nil).Submit), arg0, arg1)
+}
+
+// Tx mocks base method.
+func (m *MockSubmissionMonitorRPCClient) Tx(arg0 context.Context, arg1 data.Hash256) (xrpl.TxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tx", arg0, arg1)
+	ret0, _ := ret[0].(xrpl.TxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockSubmissionMonitorRPCClientMockRecorder) Tx(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockSubmissionMonitorRPCClient)(nil).Tx), arg0, arg1)
+}
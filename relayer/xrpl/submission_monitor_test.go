@@ -0,0 +1,300 @@
+package xrpl_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/logger"
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestSubmissionMonitor_SubmitAndMonitor(t *testing.T) {
+	t.Parallel()
+
+	sender := xrpl.GenPrivKeyTxSigner().Account()
+	// the sign func of the test sets the hash to the index of the signing starting from one
+	firstTxHash := rippledata.Hash256{1}
+	secondTxHash := rippledata.Hash256{2}
+	notFoundErr := &xrpl.RPCError{Name: "txnNotFound"}
+
+	tests := []struct {
+		name           string
+		maxResubmits   int
+		rpcClientBuild func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient
+		wantTxHash     rippledata.Hash256
+		wantSigned     []signedTestTx
+		errContains    string
+	}{
+		{
+			name:         "validated_without_resubmission",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TesSUCCESS), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(xrpl.TxResult{}, notFoundErr)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(validatedTxResult(firstTxHash), nil)
+				return rpcClientMock
+			},
+			wantTxHash: firstTxHash,
+			wantSigned: []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+		},
+		{
+			name:         "queued_and_validated",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TerQUEUED), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(validatedTxResult(firstTxHash), nil)
+				return rpcClientMock
+			},
+			wantTxHash: firstTxHash,
+			wantSigned: []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+		},
+		{
+			name:         "not_resubmitted_before_last_ledger_sequence",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				// the validated ledger reaches the last ledger sequence, but doesn't pass it
+				expectValidatedLedgerSequences(rpcClientMock, 100, 110, 120)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TesSUCCESS), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(xrpl.TxResult{}, notFoundErr).Times(3)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(validatedTxResult(firstTxHash), nil)
+				return rpcClientMock
+			},
+			wantTxHash: firstTxHash,
+			wantSigned: []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+		},
+		{
+			name:         "lookup_error_postpones_resubmission",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100, 121)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TesSUCCESS), nil)
+				// the tx can't be confirmed as missing, so it's polled until its validation is observed
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(xrpl.TxResult{}, errors.New("timeout"))
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(validatedTxResult(firstTxHash), nil)
+				return rpcClientMock
+			},
+			wantTxHash: firstTxHash,
+			wantSigned: []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+		},
+		{
+			name:         "dropped_and_resubmitted",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100, 121)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).
+					Return(submitResult(rippledata.TesSUCCESS), nil).Times(2)
+				rpcClientMock.EXPECT().AccountInfo(gomock.Any(), sender).Return(accountInfoResult(2), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(xrpl.TxResult{}, notFoundErr).Times(2)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), secondTxHash).Return(validatedTxResult(secondTxHash), nil)
+				return rpcClientMock
+			},
+			wantTxHash: secondTxHash,
+			wantSigned: []signedTestTx{
+				{Sequence: 1, LastLedgerSequence: 120},
+				{Sequence: 2, LastLedgerSequence: 141},
+			},
+		},
+		{
+			name:         "dropped_after_max_resubmissions",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100, 121, 121, 142)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).
+					Return(submitResult(rippledata.TesSUCCESS), nil).Times(2)
+				rpcClientMock.EXPECT().AccountInfo(gomock.Any(), sender).Return(accountInfoResult(2), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), gomock.Any()).Return(xrpl.TxResult{}, notFoundErr).AnyTimes()
+				return rpcClientMock
+			},
+			wantSigned: []signedTestTx{
+				{Sequence: 1, LastLedgerSequence: 120},
+				{Sequence: 2, LastLedgerSequence: 141},
+			},
+			errContains: "transaction is not validated up to the last ledger sequence 141 after 1 re-submissions",
+		},
+		{
+			name:         "resubmissions_disabled",
+			maxResubmits: 0,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100, 121)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TesSUCCESS), nil)
+				rpcClientMock.EXPECT().Tx(gomock.Any(), firstTxHash).Return(xrpl.TxResult{}, notFoundErr)
+				return rpcClientMock
+			},
+			wantSigned:  []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+			errContains: "transaction is not validated up to the last ledger sequence 120 after 0 re-submissions",
+		},
+		{
+			name:         "submission_failed",
+			maxResubmits: 1,
+			rpcClientBuild: func(ctrl *gomock.Controller) xrpl.SubmissionMonitorRPCClient {
+				rpcClientMock := NewMockSubmissionMonitorRPCClient(ctrl)
+				expectValidatedLedgerSequences(rpcClientMock, 100)
+				rpcClientMock.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(submitResult(rippledata.TefPAST_SEQ), nil)
+				return rpcClientMock
+			},
+			wantSigned:  []signedTestTx{{Sequence: 1, LastLedgerSequence: 120}},
+			errContains: "the tx submition is failed",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			monitor, err := xrpl.NewSubmissionMonitor(
+				xrpl.SubmissionMonitorConfig{
+					PollInterval:             time.Millisecond,
+					LastLedgerSequenceOffset: 20,
+					MaxResubmissions:         tt.maxResubmits,
+				},
+				logger.NewAnyLogMock(ctrl),
+				tt.rpcClientBuild(ctrl),
+			)
+			require.NoError(t, err)
+
+			tx := &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					TransactionType: rippledata.PAYMENT,
+					Account:         sender,
+					Sequence:        1,
+				},
+			}
+			signed := make([]signedTestTx, 0)
+			sign := func(_ context.Context, tx rippledata.Transaction) error {
+				base := tx.GetBase()
+				signed = append(signed, signedTestTx{
+					Sequence:           base.Sequence,
+					LastLedgerSequence: lo.FromPtr(base.LastLedgerSequence),
+				})
+				base.Hash = rippledata.Hash256{byte(len(signed))}
+				return nil
+			}
+
+			txRes, err := monitor.SubmitAndMonitor(context.Background(), tx, sign)
+			require.Equal(t, tt.wantSigned, signed)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, txRes.Validated)
+			require.Equal(t, tt.wantTxHash, *txRes.GetHash())
+		})
+	}
+}
+
+func TestNewSubmissionMonitor_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cfg         xrpl.SubmissionMonitorConfig
+		errContains string
+	}{
+		{
+			name: "zero_poll_interval",
+			cfg: xrpl.SubmissionMonitorConfig{
+				LastLedgerSequenceOffset: 1,
+			},
+			errContains: "poll interval must be positive",
+		},
+		{
+			name: "zero_last_ledger_sequence_offset",
+			cfg: xrpl.SubmissionMonitorConfig{
+				PollInterval: time.Second,
+			},
+			errContains: "last ledger sequence offset must be positive",
+		},
+		{
+			name: "negative_max_resubmissions",
+			cfg: xrpl.SubmissionMonitorConfig{
+				PollInterval:             time.Second,
+				LastLedgerSequenceOffset: 1,
+				MaxResubmissions:         -1,
+			},
+			errContains: "max resubmissions must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := xrpl.NewSubmissionMonitor(tt.cfg, logger.NewAnyLogMock(gomock.NewController(t)), nil)
+			require.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
+
+type signedTestTx struct {
+	Sequence           uint32
+	LastLedgerSequence uint32
+}
+
+// expectValidatedLedgerSequences returns the validated ledger sequences one by one and repeats the last one.
+func expectValidatedLedgerSequences(rpcClientMock *MockSubmissionMonitorRPCClient, sequences ...int64) {
+	calls := 0
+	rpcClientMock.EXPECT().ServerState(gomock.Any()).DoAndReturn(
+		func(_ context.Context) (xrpl.ServerStateResult, error) {
+			sequence := sequences[lo.Min([]int{calls, len(sequences) - 1})]
+			calls++
+			return xrpl.ServerStateResult{
+				State: xrpl.ServerState{
+					ValidatedLedger: xrpl.ServerStateValidatedLedger{
+						Seq: sequence,
+					},
+				},
+			}, nil
+		},
+	).AnyTimes()
+}
+
+func submitResult(engineResult rippledata.TransactionResult) xrpl.SubmitResult {
+	return xrpl.SubmitResult{
+		EngineResult: engineResult,
+	}
+}
+
+func validatedTxResult(txHash rippledata.Hash256) xrpl.TxResult {
+	return xrpl.TxResult{
+		Validated: true,
+		TransactionWithMetaData: rippledata.TransactionWithMetaData{
+			Transaction: &rippledata.Payment{
+				TxBase: rippledata.TxBase{
+					TransactionType: rippledata.PAYMENT,
+					Hash:            txHash,
+				},
+			},
+			MetaData: rippledata.MetaData{
+				TransactionResult: rippledata.TesSUCCESS,
+			},
+		},
+	}
+}
+
+func accountInfoResult(sequence uint32) xrpl.AccountInfoResult {
+	return xrpl.AccountInfoResult{
+		AccountData: xrpl.AccountDataWithSigners{
+			AccountRoot: rippledata.AccountRoot{
+				Sequence: lo.ToPtr(sequence),
+			},
+		},
+	}
+}
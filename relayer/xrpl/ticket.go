@@ -0,0 +1,49 @@
+package xrpl
+
+import (
+	"reflect"
+
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+// ExtractTicketSequencesFromMetaData returns the sequences of the tickets created by the transaction.
+func ExtractTicketSequencesFromMetaData(metaData rippledata.MetaData) []uint32 {
+	ticketSequences := make([]uint32, 0)
+	for _, node := range metaData.AffectedNodes {
+		createdNode := node.CreatedNode
+		if createdNode == nil {
+			continue
+		}
+		newFields := createdNode.NewFields
+		if newFields == nil {
+			continue
+		}
+		if rippledata.TICKET.String() != newFields.GetType() {
+			continue
+		}
+		ticket, ok := newFields.(*rippledata.Ticket)
+		if !ok {
+			continue
+		}
+
+		ticketSequences = append(ticketSequences, *ticket.TicketSequence)
+	}
+
+	return ticketSequences
+}
+
+// GetTxOperationID returns the ticket sequence of the tx or the account sequence if the ticket isn't used, the
+// contract operations are identified by them.
+func GetTxOperationID(tx rippledata.Transaction) uint32 {
+	// the ticket sequence is defined by each tx type separately, so it's taken by the field name
+	txValue := reflect.Indirect(reflect.ValueOf(tx))
+	if txValue.Kind() == reflect.Struct {
+		if field := txValue.FieldByName("TicketSequence"); field.IsValid() {
+			if ticketSequence, ok := field.Interface().(*uint32); ok && ticketSequence != nil && *ticketSequence != 0 {
+				return *ticketSequence
+			}
+		}
+	}
+
+	return tx.GetBase().Sequence
+}
@@ -0,0 +1,60 @@
+package xrpl
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+)
+
+const txNotFoundRPCErrorName = "txnNotFound"
+
+// TxResultClass is the class of the XRPL transaction engine result defined by the result code prefix.
+type TxResultClass string
+
+// TxResultClass values.
+const (
+	// TxResultClassSuccess is the tesSUCCESS result, the transaction is applied.
+	TxResultClassSuccess TxResultClass = "tes"
+	// TxResultClassClaimed is the tec result, the transaction is failed, but it's included in the ledger to claim the
+	// fee, and its sequence or ticket is consumed.
+	TxResultClassClaimed TxResultClass = "tec"
+	// TxResultClassFailure is the tef result, the transaction can't be applied, e.g. its sequence is consumed.
+	TxResultClassFailure TxResultClass = "tef"
+	// TxResultClassLocal is the tel result, the transaction is failed on the local server and isn't relayed.
+	TxResultClassLocal TxResultClass = "tel"
+	// TxResultClassMalformed is the tem result, the transaction is malformed.
+	TxResultClassMalformed TxResultClass = "tem"
+	// TxResultClassRetry is the ter result, the transaction can't be applied now, but might be applied later.
+	TxResultClassRetry TxResultClass = "ter"
+	// TxResultClassUnknown is the result with the unknown prefix.
+	TxResultClassUnknown TxResultClass = "unknown"
+)
+
+// ClassifyTxResult returns the class of the transaction engine result. Only the tes and tec results can be included
+// in a validated ledger.
+func ClassifyTxResult(result rippledata.TransactionResult) TxResultClass {
+	if result.Success() {
+		return TxResultClassSuccess
+	}
+	resultCode := result.String()
+	for _, class := range []TxResultClass{
+		TxResultClassClaimed,
+		TxResultClassFailure,
+		TxResultClassLocal,
+		TxResultClassMalformed,
+		TxResultClassRetry,
+	} {
+		if strings.HasPrefix(resultCode, string(class)) {
+			return class
+		}
+	}
+
+	return TxResultClassUnknown
+}
+
+// IsTxNotFoundError returns true if the error is the RPC error of the transaction which isn't found.
+func IsTxNotFoundError(err error) bool {
+	var rpcErr *RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Name == txNotFoundRPCErrorName
+}
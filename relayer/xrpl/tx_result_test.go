@@ -0,0 +1,48 @@
+package xrpl_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	rippledata "github.com/rubblelabs/ripple/data"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreumbridge-xrpl/relayer/xrpl"
+)
+
+func TestClassifyTxResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		result rippledata.TransactionResult
+		want   xrpl.TxResultClass
+	}{
+		{result: rippledata.TesSUCCESS, want: xrpl.TxResultClassSuccess},
+		{result: rippledata.TecUNFUNDED_PAYMENT, want: xrpl.TxResultClassClaimed},
+		{result: rippledata.TecNO_DST_INSUF_XRP, want: xrpl.TxResultClassClaimed},
+		{result: rippledata.TefPAST_SEQ, want: xrpl.TxResultClassFailure},
+		{result: rippledata.TefNO_TICKET, want: xrpl.TxResultClassFailure},
+		{result: rippledata.TefMAX_LEDGER, want: xrpl.TxResultClassFailure},
+		{result: rippledata.TelINSUF_FEE_P, want: xrpl.TxResultClassLocal},
+		{result: rippledata.TemBAD_SIGNATURE, want: xrpl.TxResultClassMalformed},
+		{result: rippledata.TemBAD_FEE, want: xrpl.TxResultClassMalformed},
+		{result: rippledata.TerQUEUED, want: xrpl.TxResultClassRetry},
+		{result: rippledata.TerPRE_SEQ, want: xrpl.TxResultClassRetry},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.result.String(), func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, xrpl.ClassifyTxResult(tt.result))
+		})
+	}
+}
+
+func TestIsTxNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, xrpl.IsTxNotFoundError(errors.Wrap(&xrpl.RPCError{Name: "txnNotFound"}, "failed to call RPC")))
+	require.False(t, xrpl.IsTxNotFoundError(&xrpl.RPCError{Name: "actNotFound"}))
+	require.False(t, xrpl.IsTxNotFoundError(errors.New("timeout")))
+	require.False(t, xrpl.IsTxNotFoundError(nil))
+}